@@ -1,7 +1,9 @@
 package tgclient
 
 import (
+	"crypto/rsa"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -23,14 +25,30 @@ func clampI(a, v, b int) int {
 	return v
 }
 
+const (
+	getFileFlagPrecise      = 1 << 0
+	getFileFlagCDNSupported = 1 << 1
+)
+
+const (
+	filePartSizeStep   = 4 * 1024
+	filePartMaxSize    = 1024 * 1024
+	filePartHashedSize = 128 * 1024 //file hashes cover 128 KB ranges, verified parts must be aligned to them
+	partMaxMigrations  = 3
+)
+
+var ErrWrongDownloaderParams = merry.New("wrong downloader params")
+var ErrWrongFilePart = merry.New("wrong file part")
+
 type FileProgressHandler interface {
 	OnProgress(fileLocation mtproto.TL, offset, size int64)
 }
 
 type FileResponse struct {
-	DcID int32
-	Data []byte
-	Err  error
+	DcID           int32
+	Data           []byte
+	Err            error
+	HashMismatches int
 }
 
 type filePart struct {
@@ -38,43 +56,158 @@ type filePart struct {
 	location      mtproto.TL
 	outChan       chan *FileResponse
 	offset, limit int32
+	precise       bool
 }
 
 type FilePartsResult struct {
-	Finished      bool
-	ActualDcID    int32
-	BytesRecieved int
-	BytesWritten  int
+	Finished       bool
+	ActualDcID     int32
+	BytesRecieved  int
+	BytesWritten   int
+	Verified       bool  //downloaded (and resumed) data was checked with hashes, see SetVerifyFileHashes
+	ResumedBytes   int64 //valid data size of existing .temp file (DownloadFileToPath)
+	CorruptedParts int   //parts with wrong hashes (downloaded again or truncated from .temp file)
+}
+
+// DownloaderParams configures downloading, zero fields are replaced with defaults
+type DownloaderParams struct {
+	PartSize         int64 //part size of DownloadFileToPath, multiple of 4 KB that divides 1 MB (512 KB by default), see SetVerifyFileHashes
+	ConnectionsPerDC int   //connections to each file DC, requests are distributed among them (1 by default)
+	Workers          int   //parts downloaded simultaneously (4 by default)
+	PipelineDepth    int   //parts requested ahead by DownloadFileParts (4 by default)
+}
+
+func (p *DownloaderParams) setDefaults() {
+	if p.PartSize == 0 {
+		p.PartSize = 512 * 1024
+	}
+	if p.ConnectionsPerDC == 0 {
+		p.ConnectionsPerDC = 1
+	}
+	if p.Workers == 0 {
+		p.Workers = 4
+	}
+	if p.PipelineDepth == 0 {
+		p.PipelineDepth = 4
+	}
+}
+
+func (p *DownloaderParams) validate() error {
+	if p.PartSize < filePartSizeStep || p.PartSize > filePartMaxSize ||
+		p.PartSize%filePartSizeStep != 0 || filePartMaxSize%p.PartSize != 0 {
+		return ErrWrongDownloaderParams.Here().WithMessagef(
+			"part size must be multiple of %d that divides %d, got %d", filePartSizeStep, filePartMaxSize, p.PartSize)
+	}
+	if p.ConnectionsPerDC < 0 || p.Workers < 0 || p.PipelineDepth < 0 {
+		return ErrWrongDownloaderParams.Here().WithMessagef("negative value in %#v", *p)
+	}
+	return nil
 }
 
 type Downloader struct {
 	tg             *TGClient
-	fileMTs        map[int32]*mtproto.MTProto
-	fileMTsMutex   *sync.Mutex
+	params         DownloaderParams
+	started        bool
+	paramsMutex    *sync.Mutex       //for params and started
+	partsRoutine   func(*Downloader) //receives Downloader explicitly since it is copied into TGClient
+	fileMTs        map[int32][]*mtproto.MTProto
+	fileMTsNext    map[int32]int //index of next connection to use for each DC
+	cdnMTs         map[int32]*mtproto.MTProto
+	cdnKeys        map[int32][]*rsa.PublicKey
+	fileMTsMutex   *sync.Mutex //for fileMTs, fileMTsNext, cdnMTs and cdnKeys
+	cdnRedirects   map[string]*cdnRedirect
+	fileHashes     map[string]*fileHashes
+	locationRefs   map[string]int //number of downloads and readers of location, its redirect and hashes are removed after the last one
+	verifyHashes   bool
+	locationsMutex *sync.Mutex //for cdnRedirects, fileHashes, locationRefs and verifyHashes
 	filePartsQueue chan *filePart
 	log            mtproto.Logger
 }
 
 func NewDownloader(tg *TGClient) *Downloader {
-	return &Downloader{
+	d := &Downloader{
 		tg:             tg,
-		fileMTs:        make(map[int32]*mtproto.MTProto),
+		paramsMutex:    &sync.Mutex{},
+		fileMTs:        make(map[int32][]*mtproto.MTProto),
+		fileMTsNext:    make(map[int32]int),
+		cdnMTs:         make(map[int32]*mtproto.MTProto),
+		cdnKeys:        make(map[int32][]*rsa.PublicKey),
 		fileMTsMutex:   &sync.Mutex{},
-		filePartsQueue: make(chan *filePart, 4),
+		cdnRedirects:   make(map[string]*cdnRedirect),
+		fileHashes:     make(map[string]*fileHashes),
+		locationRefs:   make(map[string]int),
+		locationsMutex: &sync.Mutex{},
 		log:            tg.log,
 	}
+	d.params.setDefaults()
+	d.partsRoutine = (*Downloader).partsDownloadRoutine
+	return d
+}
+
+// SetDownloaderParams changes downloading params. It must be called before first download.
+func (d *Downloader) SetDownloaderParams(params DownloaderParams) error {
+	params.setDefaults()
+	if err := params.validate(); err != nil {
+		return merry.Wrap(err)
+	}
+	d.paramsMutex.Lock()
+	defer d.paramsMutex.Unlock()
+	if d.started {
+		return ErrWrongDownloaderParams.Here().WithMessage("downloading has already started")
+	}
+	d.params = params
+	return nil
+}
+
+func (d *Downloader) getParams() DownloaderParams {
+	d.paramsMutex.Lock()
+	defer d.paramsMutex.Unlock()
+	return d.params
+}
+
+// useLocation marks file location as being downloaded, its CDN redirect and hashes are kept until releaseLocation
+func (d *Downloader) useLocation(fileLocation mtproto.TL) {
+	d.locationsMutex.Lock()
+	defer d.locationsMutex.Unlock()
+	d.locationRefs[fileLocationKey(fileLocation)]++
+}
+
+// releaseLocation removes CDN redirect and hashes of file location if there are no more its downloads or readers
+func (d *Downloader) releaseLocation(fileLocation mtproto.TL) {
+	key := fileLocationKey(fileLocation)
+	d.locationsMutex.Lock()
+	defer d.locationsMutex.Unlock()
+	if d.locationRefs[key] > 1 {
+		d.locationRefs[key]--
+		return
+	}
+	delete(d.locationRefs, key)
+	delete(d.cdnRedirects, key)
+	delete(d.fileHashes, key)
+}
+
+// startUnlocked starts download workers (on first part request, so params may be changed before it), paramsMutex must be locked
+func (d *Downloader) startUnlocked() {
+	d.started = true
+	d.filePartsQueue = make(chan *filePart, d.params.Workers)
+	for i := 0; i < d.params.Workers; i++ {
+		go d.partsRoutine(d)
+	}
 }
 
 func (d *Downloader) DownloadFileToPath(
 	fpath string, fileLocation mtproto.TL, dcID int32, size int64, progressHnd FileProgressHandler,
 ) (*FilePartsResult, error) {
-	partSize := int64(512 * 1024)
+	d.useLocation(fileLocation)
+	defer d.releaseLocation(fileLocation)
+
+	partSize := d.getParams().PartSize
 	tempFpath := fpath + ".temp"
 	if err := os.MkdirAll(filepath.Dir(tempFpath), os.ModePerm); err != nil {
 		return nil, merry.Wrap(err)
 	}
 
-	fd, err := os.OpenFile(tempFpath, os.O_CREATE|os.O_WRONLY, 0644)
+	fd, err := os.OpenFile(tempFpath, os.O_CREATE|os.O_RDWR, 0644)
 	if err != nil {
 		return nil, merry.Wrap(err)
 	}
@@ -84,22 +217,44 @@ func (d *Downloader) DownloadFileToPath(
 	if err != nil {
 		return nil, merry.Wrap(err)
 	}
-	if offset%int64(partSize) != 0 {
-		d.log.Warn("file '%s' exists but size is not multiple of block size (%d % %d != 0), moving to start",
-			tempFpath, offset, partSize)
-		offset, err = fd.Seek(0, io.SeekStart)
-		if err != nil {
+	if offset%partSize != 0 {
+		// may happen if part size was changed or last part was partially written
+		newOffset := offset - offset%partSize
+		d.log.Warn("file '%s' exists but size is not multiple of block size (%d %% %d != 0), truncating to %d",
+			tempFpath, offset, partSize, newOffset)
+		if err := fd.Truncate(newOffset); err != nil {
 			return nil, merry.Wrap(err)
 		}
-		if err := fd.Truncate(0); err != nil {
+		if offset, err = fd.Seek(newOffset, io.SeekStart); err != nil {
 			return nil, merry.Wrap(err)
 		}
 	}
 
+	corruptedParts := 0
+	if offset > 0 && d.verifyHashesEnabled() {
+		validSize, err := d.validDownloadedSize(fd, fileLocation, dcID, offset)
+		if err != nil {
+			return nil, merry.Wrap(err)
+		}
+		if validSize < offset {
+			validSize -= validSize % partSize
+			corruptedParts = int((offset - validSize + partSize - 1) / partSize)
+			d.log.Warn("file '%s' is corrupted at %d, truncating to %d", tempFpath, validSize, validSize)
+			if err := fd.Truncate(validSize); err != nil {
+				return nil, merry.Wrap(err)
+			}
+			if offset, err = fd.Seek(validSize, io.SeekStart); err != nil {
+				return nil, merry.Wrap(err)
+			}
+		}
+	}
+
 	partsRes, err := d.DownloadFileParts(fd, fileLocation, dcID, size, partSize, offset, progressHnd)
 	if err != nil {
 		return nil, merry.Wrap(err)
 	}
+	partsRes.ResumedBytes = offset
+	partsRes.CorruptedParts += corruptedParts
 
 	if err := fd.Close(); err != nil {
 		return nil, merry.Wrap(err)
@@ -115,10 +270,16 @@ func (d *Downloader) DownloadFileParts(
 	dcID int32, size, partSize, offset int64,
 	progressHnd FileProgressHandler,
 ) (*FilePartsResult, error) {
-	partsRes := &FilePartsResult{ActualDcID: dcID}
+	partsRes := &FilePartsResult{ActualDcID: dcID, Verified: d.verifyHashesEnabled()}
+	if partsRes.Verified && (partSize%filePartHashedSize != 0 || offset%filePartHashedSize != 0) {
+		return nil, ErrWrongFilePart.Here().WithMessagef(
+			"part size and offset must be multiples of %d to verify hashes, got %d and %d", filePartHashedSize, partSize, offset)
+	}
+	d.useLocation(fileLocation)
+	defer d.releaseLocation(fileLocation)
 
 	partsCount := int((size - offset + partSize - 1) / partSize)
-	resChans := make([]chan *FileResponse, clampI(1, partsCount, 4))
+	resChans := make([]chan *FileResponse, clampI(1, partsCount, d.getParams().PipelineDepth))
 
 	for i := 0; i < len(resChans); i++ {
 		resChans[i] = d.ReqestFilePart(dcID, fileLocation,
@@ -140,13 +301,15 @@ func (d *Downloader) DownloadFileParts(
 			resChans[i-1] = resChans[i]
 		}
 		newPartOffset := offset + partSize*int64(len(resChans)-1)
-		if newPartOffset < size || len(resChans) == 1 {
-			resChans[len(resChans)-1] = d.ReqestFilePart(dcID, fileLocation, newPartOffset, partSize)
+		// if size is unknown, parts are requested until short one is received
+		if newPartOffset < size || (size <= 0 && len(resChans) == 1) {
+			resChans[len(resChans)-1] = d.ReqestFilePart(res.DcID, fileLocation, newPartOffset, partSize)
 		} else {
 			resChans = resChans[:len(resChans)-1]
 		}
 
 		partsRes.ActualDcID = res.DcID
+		partsRes.CorruptedParts += res.HashMismatches
 		partsRes.BytesRecieved += len(res.Data)
 
 		n, err := file.Write(res.Data)
@@ -155,7 +318,7 @@ func (d *Downloader) DownloadFileParts(
 		}
 		partsRes.BytesWritten += n
 
-		if len(res.Data) < int(partSize) {
+		if len(res.Data) < int(partSize) || len(resChans) == 0 {
 			partsRes.Finished = true
 			break
 		}
@@ -164,78 +327,149 @@ func (d *Downloader) DownloadFileParts(
 }
 
 func (d *Downloader) ReqestFilePart(dcID int32, fileLocation mtproto.TL, offset, limit int64) chan *FileResponse {
+	return d.requestFilePart(dcID, fileLocation, offset, limit, false)
+}
+
+// requestFilePart queues part request. Precise requests may have any offset and limit divisible by 1 KB
+// (part must not cross 1 MB boundary), otherwise limit must divide 1 MB and offset must be divisible by limit.
+func (d *Downloader) requestFilePart(dcID int32, fileLocation mtproto.TL, offset, limit int64, precise bool) chan *FileResponse {
 	part := &filePart{
 		dcID:     dcID,
 		location: fileLocation,
 		outChan:  make(chan *FileResponse, 1),
 		limit:    int32(limit),
 		offset:   int32(offset),
+		precise:  precise,
+	}
+	// offsets are int in current layer (files are limited to 2000 MB anyway)
+	var err error
+	if offset < 0 || limit <= 0 || limit > filePartMaxSize {
+		err = ErrWrongFilePart.Here().WithMessagef("wrong file part: offset %d, limit %d", offset, limit)
+	} else if offset > math.MaxInt32 {
+		err = ErrFileTooLarge.Here().WithMessagef("file part offset is too large: %d > %d", offset, math.MaxInt32)
+	}
+	if err != nil {
+		part.outChan <- &FileResponse{DcID: dcID, Err: err}
+		close(part.outChan)
+		return part.outChan
+	}
+
+	d.paramsMutex.Lock()
+	if !d.started {
+		d.startUnlocked()
 	}
-	d.filePartsQueue <- part
+	queue := d.filePartsQueue
+	d.paramsMutex.Unlock()
+
+	queue <- part
 	return part.outChan
 }
 
 func (d *Downloader) partsDownloadRoutine() {
 	for part := range d.filePartsQueue {
 		fileResp := FileResponse{DcID: part.dcID}
-
-		mt, err := d.getFileMT(part.dcID)
-		if err != nil {
-			fileResp.Err = merry.Wrap(err)
-			part.outChan <- &fileResp
-			continue
-		}
-
-		resTL := mt.SendSyncRetry(mtproto.TL_upload_getFile{
-			Location: part.location,
-			Offset:   part.offset,
-			Limit:    part.limit,
-		}, time.Second, 5, 10*time.Second)
-
-		switch res := resTL.(type) {
-		case mtproto.TL_upload_file:
-			fileResp.Data = res.Bytes
-		case mtproto.TL_upload_fileCdnRedirect:
-			fileResp.Err = merry.New("cdn redirect: " + mtproto.Sprint(res))
-		case mtproto.TL_rpc_error:
-			if strings.HasPrefix(res.ErrorMessage, "FILE_MIGRATE_") {
-				d.log.Warn("got %s, part DC is %d", res.ErrorMessage, part.dcID)
-				id, _ := strconv.Atoi(res.ErrorMessage[13:])
-				part.dcID = int32(id)
-				select {
-				case d.filePartsQueue <- part:
-					continue
-				default:
-					fileResp.Err = merry.New("file queue overflow while handling DC migration error")
-				}
-			} else {
-				fileResp.Err = merry.New(mtproto.UnexpectedTL("file part", resTL))
+		for attempt := 1; ; attempt++ {
+			fileResp.Data, fileResp.Err = d.downloadPart(part)
+			if merry.Is(fileResp.Err, ErrFileHashMismatch) && attempt < partMaxAttempts {
+				d.log.Warn("%s, downloading part again", fileResp.Err)
+				fileResp.HashMismatches++
+				continue
 			}
-		default:
-			fileResp.Err = merry.New(mtproto.UnexpectedTL("file part", resTL))
+			break
 		}
-
 		part.outChan <- &fileResp
 		close(part.outChan)
 	}
 }
 
+// downloadPart requests part from its DC (or from CDN if file was redirected there) and checks its hashes if needed
+func (d *Downloader) downloadPart(part *filePart) ([]byte, error) {
+	if redirect := d.findCDNRedirect(part.location); redirect != nil {
+		data, err := d.downloadCDNPart(part, redirect)
+		if !merry.Is(err, errCDNFileTokenInvalid) {
+			return data, err
+		}
+		d.log.Info("CDN file token expired, requesting new one")
+		d.forgetCDNRedirect(part.location)
+	}
+
+	for migrations := 0; ; migrations++ {
+		data, newDcID, err := d.downloadPartFromDC(part)
+		if newDcID == 0 {
+			return data, err
+		}
+		if migrations >= partMaxMigrations {
+			return nil, merry.Errorf("too many DC migrations, last DC is %d", newDcID)
+		}
+		d.log.Warn("part DC has changed: %d -> %d", part.dcID, newDcID)
+		part.dcID = newDcID
+	}
+}
+
+// downloadPartFromDC requests part from part.dcID. If file is on another DC, returns its ID.
+func (d *Downloader) downloadPartFromDC(part *filePart) ([]byte, int32, error) {
+	mt, err := d.getFileMT(part.dcID)
+	if err != nil {
+		return nil, 0, merry.Wrap(err)
+	}
+
+	req := mtproto.TL_upload_getFile{
+		Flags:        getFileFlagCDNSupported,
+		CdnSupported: true,
+		Location:     part.location,
+		Offset:       part.offset,
+		Limit:        part.limit,
+	}
+	if part.precise {
+		req.Precise = true
+		req.Flags |= getFileFlagPrecise
+	}
+	resTL := mt.SendSyncRetry(req, time.Second, 5, 10*time.Second)
+
+	switch res := resTL.(type) {
+	case mtproto.TL_upload_file:
+		if d.verifyHashesEnabled() {
+			if err := d.checkPartHashes(part, res.Bytes); err != nil {
+				return nil, 0, merry.Wrap(err)
+			}
+		}
+		return res.Bytes, 0, nil
+	case mtproto.TL_upload_fileCdnRedirect:
+		redirect := d.rememberCDNRedirect(part.location, res)
+		data, err := d.downloadCDNPart(part, redirect)
+		return data, 0, err
+	case mtproto.TL_rpc_error:
+		if strings.HasPrefix(res.ErrorMessage, "FILE_MIGRATE_") {
+			id, err := strconv.Atoi(res.ErrorMessage[13:])
+			if err != nil || id <= 0 {
+				return nil, 0, merry.Errorf("wrong migration error: %s", res.ErrorMessage)
+			}
+			return nil, int32(id), nil
+		}
+	}
+	return nil, 0, merry.New(mtproto.UnexpectedTL("file part", resTL))
+}
+
 func (d *Downloader) getFileMT(dcID int32) (*mtproto.MTProto, error) {
+	connsCount := d.getParams().ConnectionsPerDC
+
 	d.fileMTsMutex.Lock()
 	defer d.fileMTsMutex.Unlock()
 
-	mt, _ := d.fileMTs[dcID]
-	if mt != nil {
-		return mt, nil
+	mts := d.fileMTs[dcID]
+	if len(mts) >= connsCount {
+		// reusing connections one by one
+		next := d.fileMTsNext[dcID] % len(mts)
+		d.fileMTsNext[dcID] = next + 1
+		return mts[next], nil
 	}
 
-	var err error
-	mt, err = d.tg.mt.NewConnection(dcID)
+	mt, err := d.tg.mt.NewConnection(dcID)
 	if err != nil {
 		return nil, merry.Wrap(err)
 	}
 
-	d.log.Info("connected to file DC %d", dcID)
-	d.fileMTs[dcID] = mt
+	d.log.Info("connected to file DC %d (connection #%d)", dcID, len(mts)+1)
+	d.fileMTs[dcID] = append(mts, mt)
 	return mt, nil
 }
@@ -0,0 +1,187 @@
+package tgclient
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"encoding/binary"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+// Some files are served through CDN DCs: upload.getFile returns upload.fileCdnRedirect,
+// file parts should be requested from CDN DC (with upload.getCdnFile), decrypted with AES-256-CTR
+// and checked with SHA256 hashes received from main DC.
+// https://core.telegram.org/cdn
+
+const cdnMaxReuploads = 3
+
+var errCDNFileTokenInvalid = merry.New("CDN file token is invalid")
+
+type cdnRedirect struct {
+	dcID      int32
+	fileToken []byte
+	key, iv   []byte
+	hashes    *fileHashes
+}
+
+func newCDNRedirect(res mtproto.TL_upload_fileCdnRedirect) *cdnRedirect {
+	r := &cdnRedirect{
+		dcID:      res.DcID,
+		fileToken: res.FileToken,
+		key:       res.EncryptionKey,
+		iv:        res.EncryptionIv,
+		hashes:    newFileHashes(),
+	}
+	r.hashes.add(res.FileHashes)
+	return r
+}
+
+// decryptCDNPart decrypts part with AES-256-CTR, IV ends with big-endian offset/16
+func decryptCDNPart(key, iv []byte, offset int32, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, merry.Errorf("wrong CDN IV length: %d", len(iv))
+	}
+	partIV := append([]byte(nil), iv...)
+	binary.BigEndian.PutUint32(partIV[12:], uint32(offset/16))
+	res := make([]byte, len(data))
+	cipher.NewCTR(block, partIV).XORKeyStream(res, data)
+	return res, nil
+}
+
+func fileLocationKey(fileLocation mtproto.TL) string {
+	return string(mtproto.Encode(fileLocation))
+}
+
+func (d *Downloader) findCDNRedirect(fileLocation mtproto.TL) *cdnRedirect {
+	d.locationsMutex.Lock()
+	defer d.locationsMutex.Unlock()
+	return d.cdnRedirects[fileLocationKey(fileLocation)]
+}
+
+func (d *Downloader) rememberCDNRedirect(fileLocation mtproto.TL, res mtproto.TL_upload_fileCdnRedirect) *cdnRedirect {
+	redirect := newCDNRedirect(res)
+	d.locationsMutex.Lock()
+	defer d.locationsMutex.Unlock()
+	d.cdnRedirects[fileLocationKey(fileLocation)] = redirect
+	return redirect
+}
+
+func (d *Downloader) forgetCDNRedirect(fileLocation mtproto.TL) {
+	d.locationsMutex.Lock()
+	defer d.locationsMutex.Unlock()
+	delete(d.cdnRedirects, fileLocationKey(fileLocation))
+}
+
+// downloadCDNPart requests part from CDN DC, decrypts and verifies it.
+// Reupload and hashes requests are sent to the main DC (part.dcID) that returned redirect.
+func (d *Downloader) downloadCDNPart(part *filePart, redirect *cdnRedirect) ([]byte, error) {
+	cdnMT, err := d.getCDNMT(redirect.dcID)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+
+	for reuploads := 0; ; {
+		resTL := cdnMT.SendSyncRetry(mtproto.TL_upload_getCdnFile{
+			FileToken: redirect.fileToken,
+			Offset:    part.offset,
+			Limit:     part.limit,
+		}, time.Second, 5, 10*time.Second)
+
+		switch res := resTL.(type) {
+		case mtproto.TL_upload_cdnFile:
+			data, err := decryptCDNPart(redirect.key, redirect.iv, part.offset, res.Bytes)
+			if err != nil {
+				return nil, merry.Wrap(err)
+			}
+			if err := d.checkCDNPartHashes(part, redirect, data); err != nil {
+				return nil, merry.Wrap(err)
+			}
+			return data, nil
+		case mtproto.TL_upload_cdnFileReuploadNeeded:
+			if reuploads >= cdnMaxReuploads {
+				return nil, merry.Errorf("CDN file is still not uploaded after %d reuploads", reuploads)
+			}
+			reuploads++
+			d.log.Info("CDN DC %d asks for file reupload (%d)", redirect.dcID, reuploads)
+			err := d.requestHashes(redirect.hashes, part.dcID, mtproto.TL_upload_reuploadCdnFile{
+				FileToken:    redirect.fileToken,
+				RequestToken: res.RequestToken,
+			})
+			if err != nil {
+				return nil, merry.Wrap(err)
+			}
+		case mtproto.TL_rpc_error:
+			if res.ErrorMessage == "FILE_TOKEN_INVALID" {
+				return nil, errCDNFileTokenInvalid.Here()
+			}
+			return nil, merry.New(mtproto.UnexpectedTL("CDN file part", resTL))
+		default:
+			return nil, merry.New(mtproto.UnexpectedTL("CDN file part", resTL))
+		}
+	}
+}
+
+func (d *Downloader) checkCDNPartHashes(part *filePart, redirect *cdnRedirect, data []byte) error {
+	return d.checkHashes(redirect.hashes, part.offset, data, part.dcID, func(offset int32) mtproto.TLReq {
+		return mtproto.TL_upload_getCdnFileHashes{FileToken: redirect.fileToken, Offset: offset}
+	})
+}
+
+func (d *Downloader) getCDNMT(dcID int32) (*mtproto.MTProto, error) {
+	d.fileMTsMutex.Lock()
+	defer d.fileMTsMutex.Unlock()
+
+	if mt := d.cdnMTs[dcID]; mt != nil {
+		return mt, nil
+	}
+
+	keys, err := d.cdnPublicKeysUnlocked(dcID)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	mt, err := d.tg.mt.NewCDNConnection(dcID, keys)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+
+	d.log.Info("connected to CDN DC %d", dcID)
+	d.cdnMTs[dcID] = mt
+	return mt, nil
+}
+
+// cdnPublicKeysUnlocked returns CDN DC keys (loading them with help.getCdnConfig if needed), fileMTsMutex must be locked
+func (d *Downloader) cdnPublicKeysUnlocked(dcID int32) ([]*rsa.PublicKey, error) {
+	if keys, ok := d.cdnKeys[dcID]; ok {
+		return keys, nil
+	}
+	res := d.tg.mt.SendSyncRetry(mtproto.TL_help_getCdnConfig{}, time.Second, 5, 10*time.Second)
+	cfg, ok := res.(mtproto.TL_cdnConfig)
+	if !ok {
+		return nil, merry.New(mtproto.UnexpectedTL("CDN config", res))
+	}
+	d.cdnKeys = make(map[int32][]*rsa.PublicKey)
+	for _, keyTL := range cfg.PublicKeys {
+		cdnKey, ok := keyTL.(mtproto.TL_cdnPublicKey)
+		if !ok {
+			continue
+		}
+		key, err := mtproto.ParsePublicKey(cdnKey.PublicKey)
+		if err != nil {
+			d.log.Warn("failed to parse public key of CDN DC %d: %s", cdnKey.DcID, err)
+			continue
+		}
+		d.cdnKeys[cdnKey.DcID] = append(d.cdnKeys[cdnKey.DcID], key)
+	}
+	keys, ok := d.cdnKeys[dcID]
+	if !ok {
+		return nil, merry.Errorf("no public keys for CDN DC %d", dcID)
+	}
+	return keys, nil
+}
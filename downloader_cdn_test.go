@@ -0,0 +1,60 @@
+package tgclient
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+func TestDecryptCDNPart(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, 32)
+	iv := bytes.Repeat([]byte{2}, 16)
+	iv[12], iv[13], iv[14], iv[15] = 0, 0, 0, 0
+	data := testFileData(3 * 1024)
+
+	block, _ := aes.NewCipher(key)
+	encrypted := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(encrypted, data)
+
+	for _, offset := range []int32{0, 1024, 2048} {
+		part, err := decryptCDNPart(key, iv, offset, encrypted[offset:offset+1024])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(part, data[offset:offset+1024]) {
+			t.Errorf("wrong decrypted part at %d", offset)
+		}
+	}
+}
+
+func TestCDNRedirectHashes(t *testing.T) {
+	data := testFileData(2500)
+	hash := func(offset, limit int32) mtproto.TL {
+		end := offset + limit
+		if end > int32(len(data)) {
+			end = int32(len(data))
+		}
+		sum := sha256.Sum256(data[offset:end])
+		return mtproto.TL_fileHash{Offset: offset, Limit: limit, Hash: sum[:]}
+	}
+	redirect := newCDNRedirect(mtproto.TL_upload_fileCdnRedirect{FileHashes: []mtproto.TL{hash(1000, 1000)}})
+
+	if missing, ok, err := redirect.hashes.check(1000, data[1000:]); err != nil || ok || missing != 2000 {
+		t.Errorf("expected missing hash at 2000, got %d %v %v", missing, ok, err)
+	}
+	redirect.hashes.add([]mtproto.TL{hash(2000, 1000)})
+	if _, ok, err := redirect.hashes.check(1000, data[1000:]); err != nil || !ok {
+		t.Errorf("expected valid hashes, got %v %v", ok, err)
+	}
+
+	corrupted := append([]byte(nil), data[1000:]...)
+	corrupted[1200] ^= 1
+	if _, _, err := redirect.hashes.check(1000, corrupted); !merry.Is(err, ErrFileHashMismatch) {
+		t.Errorf("expected hash mismatch, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package tgclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+// Downloaded parts may be checked with SHA256 hashes of file ranges (usually 128 KB each)
+// from upload.getFileHashes (or upload.getCdnFileHashes for CDN files, these are always checked).
+
+const partMaxAttempts = 3 //part is downloaded again if its hash does not match
+
+var ErrFileHashMismatch = merry.New("file part hash mismatch")
+
+type fileHashes struct {
+	mutex  *sync.Mutex
+	hashes map[int32]mtproto.TL_fileHash //by offset
+}
+
+func newFileHashes() *fileHashes {
+	return &fileHashes{
+		mutex:  &sync.Mutex{},
+		hashes: make(map[int32]mtproto.TL_fileHash),
+	}
+}
+
+func (h *fileHashes) add(hashes []mtproto.TL) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for _, item := range hashes {
+		if hash, ok := item.(mtproto.TL_fileHash); ok {
+			h.hashes[hash.Offset] = hash
+		}
+	}
+}
+
+func (h *fileHashes) find(offset int32) (mtproto.TL_fileHash, bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	hash, ok := h.hashes[offset]
+	return hash, ok
+}
+
+// check verifies data (starting at offset) with known hashes.
+// If some hash is unknown, returns its offset and false.
+func (h *fileHashes) check(offset int32, data []byte) (int32, bool, error) {
+	for pos := int32(0); pos < int32(len(data)); {
+		hash, ok := h.find(offset + pos)
+		if !ok {
+			return offset + pos, false, nil
+		}
+		if hash.Limit <= 0 {
+			return 0, false, merry.Errorf("wrong file hash limit %d at %d", hash.Limit, hash.Offset)
+		}
+		end := pos + hash.Limit
+		if end > int32(len(data)) {
+			end = int32(len(data))
+		}
+		sum := sha256.Sum256(data[pos:end])
+		if !bytes.Equal(sum[:], hash.Hash) {
+			return 0, false, ErrFileHashMismatch.Here().WithMessagef("file part hash mismatch at %d", offset+pos)
+		}
+		pos = end
+	}
+	return 0, true, nil
+}
+
+// SetVerifyFileHashes enables checking of downloaded parts (and parts of resumed .temp files)
+// with hashes from upload.getFileHashes. Parts with wrong hashes are downloaded again.
+// Hashes cover 128 KB ranges, so part size and offset of checked downloads must be multiples of 128 KB.
+func (d *Downloader) SetVerifyFileHashes(verify bool) {
+	d.locationsMutex.Lock()
+	defer d.locationsMutex.Unlock()
+	d.verifyHashes = verify
+}
+
+func (d *Downloader) verifyHashesEnabled() bool {
+	d.locationsMutex.Lock()
+	defer d.locationsMutex.Unlock()
+	return d.verifyHashes
+}
+
+func (d *Downloader) fileHashesFor(fileLocation mtproto.TL) *fileHashes {
+	key := fileLocationKey(fileLocation)
+	d.locationsMutex.Lock()
+	defer d.locationsMutex.Unlock()
+	hashes, ok := d.fileHashes[key]
+	if !ok {
+		hashes = newFileHashes()
+		d.fileHashes[key] = hashes
+	}
+	return hashes
+}
+
+// checkHashes verifies data with hashes, requesting missing ones (with hashesReq) from DC dcID
+func (d *Downloader) checkHashes(
+	hashes *fileHashes, offset int32, data []byte, dcID int32, hashesReq func(offset int32) mtproto.TLReq,
+) error {
+	for requested := false; ; requested = true {
+		missingOffset, ok, err := hashes.check(offset, data)
+		if err != nil {
+			return merry.Wrap(err)
+		}
+		if ok {
+			return nil
+		}
+		if requested {
+			return merry.Errorf("no file hash for offset %d", missingOffset)
+		}
+		if err := d.requestHashes(hashes, dcID, hashesReq(missingOffset)); err != nil {
+			return merry.Wrap(err)
+		}
+	}
+}
+
+func (d *Downloader) requestHashes(hashes *fileHashes, dcID int32, req mtproto.TLReq) error {
+	mt, err := d.getFileMT(dcID)
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	res := mt.SendSyncRetry(req, time.Second, 5, 10*time.Second)
+	items, ok := res.(mtproto.VectorObject)
+	if !ok {
+		return merry.New(mtproto.UnexpectedTL("file hashes", res))
+	}
+	hashes.add(items)
+	return nil
+}
+
+func (d *Downloader) checkPartHashes(part *filePart, data []byte) error {
+	return d.checkHashes(d.fileHashesFor(part.location), part.offset, data, part.dcID, func(offset int32) mtproto.TLReq {
+		return mtproto.TL_upload_getFileHashes{Location: part.location, Offset: offset}
+	})
+}
+
+// validDownloadedSize checks already downloaded file beginning (of given size) with hashes
+// and returns size of its valid part
+func (d *Downloader) validDownloadedSize(file io.ReaderAt, fileLocation mtproto.TL, dcID int32, size int64) (int64, error) {
+	hashes := d.fileHashesFor(fileLocation)
+	buf := []byte{}
+	for pos := int64(0); pos < size; {
+		hash, ok := hashes.find(int32(pos))
+		if !ok {
+			req := mtproto.TL_upload_getFileHashes{Location: fileLocation, Offset: int32(pos)}
+			if err := d.requestHashes(hashes, dcID, req); err != nil {
+				return 0, merry.Wrap(err)
+			}
+			if hash, ok = hashes.find(int32(pos)); !ok || hash.Limit <= 0 {
+				return 0, merry.Errorf("no file hash for offset %d", pos)
+			}
+		}
+
+		// last range may be shorter than hash limit if downloaded data is the whole file
+		end := minI64(pos+int64(hash.Limit), size)
+		if int64(cap(buf)) < end-pos {
+			buf = make([]byte, end-pos)
+		}
+		buf = buf[:end-pos]
+		if _, err := file.ReadAt(buf, pos); err != nil {
+			return 0, merry.Wrap(err)
+		}
+		sum := sha256.Sum256(buf)
+		if !bytes.Equal(sum[:], hash.Hash) {
+			return pos, nil
+		}
+		pos = end
+	}
+	return size, nil
+}
@@ -0,0 +1,59 @@
+package tgclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+func TestValidDownloadedSize(t *testing.T) {
+	d := NewDownloader(newTestClient())
+	location := mtproto.TL_inputDocumentFileLocation{ID: 1, AccessHash: 2}
+	data := testFileData(2500)
+
+	var hashes []mtproto.TL
+	for offset := 0; offset < len(data); offset += 1000 {
+		end := offset + 1000
+		if end > len(data) {
+			end = len(data)
+		}
+		sum := sha256.Sum256(data[offset:end])
+		hashes = append(hashes, mtproto.TL_fileHash{Offset: int32(offset), Limit: 1000, Hash: sum[:]})
+	}
+	d.fileHashesFor(location).add(hashes)
+
+	for _, c := range []struct {
+		size, corruptAt, expected int64
+	}{
+		{2000, -1, 2000},
+		{2500, -1, 2500}, //whole file, last range is shorter than limit
+		{2500, 1500, 1000},
+		{2000, 10, 0},
+	} {
+		buf := append([]byte(nil), data[:c.size]...)
+		if c.corruptAt >= 0 {
+			buf[c.corruptAt] ^= 1
+		}
+		validSize, err := d.validDownloadedSize(bytes.NewReader(buf), location, 2, c.size)
+		if err != nil {
+			t.Errorf("%#v: %s", c, err)
+		} else if validSize != c.expected {
+			t.Errorf("%#v: wrong valid size %d", c, validSize)
+		}
+	}
+}
+
+func TestVerifiedPartsAlignment(t *testing.T) {
+	d := NewDownloader(newTestClient())
+	d.SetVerifyFileHashes(true)
+	location := mtproto.TL_inputDocumentFileLocation{ID: 1}
+	for _, c := range []struct{ partSize, offset int64 }{{4096, 0}, {128 * 1024, 4096}} {
+		_, err := d.DownloadFileParts(&bytes.Buffer{}, location, 2, 1024*1024, c.partSize, c.offset, nil)
+		if !merry.Is(err, ErrWrongFilePart) {
+			t.Errorf("%#v: expected wrong part error, got %v", c, err)
+		}
+	}
+}
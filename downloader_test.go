@@ -0,0 +1,140 @@
+package tgclient
+
+import (
+	"bytes"
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+func TestSetDownloaderParams(t *testing.T) {
+	d := NewDownloader(newTestClient())
+	for _, c := range []struct {
+		params DownloaderParams
+		ok     bool
+	}{
+		{DownloaderParams{PartSize: 1024 * 1024, ConnectionsPerDC: 4, Workers: 8, PipelineDepth: 8}, true},
+		{DownloaderParams{PartSize: 4 * 1024}, true},
+		{DownloaderParams{PartSize: 1024}, false},
+		{DownloaderParams{PartSize: 12 * 1024}, false}, //does not divide 1 MB
+		{DownloaderParams{PartSize: 2 * 1024 * 1024}, false},
+		{DownloaderParams{Workers: -1}, false},
+		{DownloaderParams{}, true}, //restores defaults
+	} {
+		err := d.SetDownloaderParams(c.params)
+		if c.ok && err != nil {
+			t.Errorf("%#v: unexpected error: %s", c.params, err)
+		}
+		if !c.ok && !merry.Is(err, ErrWrongDownloaderParams) {
+			t.Errorf("%#v: expected params error, got %v", c.params, err)
+		}
+	}
+	if p := d.getParams(); p.PartSize != 512*1024 || p.ConnectionsPerDC != 1 || p.Workers != 4 || p.PipelineDepth != 4 {
+		t.Errorf("expected default params, got %#v", p)
+	}
+
+	d.partsRoutine = func(*Downloader) {}
+	d.ReqestFilePart(2, mtproto.TL_inputDocumentFileLocation{ID: 1}, 0, 1024)
+	if err := d.SetDownloaderParams(DownloaderParams{}); !merry.Is(err, ErrWrongDownloaderParams) {
+		t.Errorf("params should not be changed after start, got %v", err)
+	}
+}
+
+func TestDownloadFileParts(t *testing.T) {
+	for _, c := range []struct {
+		size, partSize, offset int64
+		knownSize              bool
+		expectedRequests       int32
+	}{
+		{4096 * 10, 4096, 0, true, 10}, //exact multiple, nothing is requested beyond the end
+		{4096*10 + 1, 4096, 0, true, 11},
+		{4096 * 10, 4096, 4096 * 7, true, 3},
+		{4096*3 + 5, 4096, 0, false, 4},
+		{4096 * 3, 4096, 0, false, 4}, //unknown size, empty part marks the end
+	} {
+		d := NewDownloader(newTestClient())
+		if err := d.SetDownloaderParams(DownloaderParams{Workers: 2, PipelineDepth: 3}); err != nil {
+			t.Fatal(err)
+		}
+		data := testFileData(int(c.size))
+		dataSize := c.size //workers outlive loop iteration
+		var requests int32
+		d.partsRoutine = func(d *Downloader) {
+			for part := range d.filePartsQueue {
+				atomic.AddInt32(&requests, 1)
+				resp := &FileResponse{DcID: part.dcID}
+				if int64(part.offset) < dataSize {
+					resp.Data = data[part.offset:minI64(int64(part.offset+part.limit), dataSize)]
+				}
+				part.outChan <- resp
+				close(part.outChan)
+			}
+		}
+
+		size := c.size
+		if !c.knownSize {
+			size = 0
+		}
+		buf := &bytes.Buffer{}
+		res, err := d.DownloadFileParts(buf, mtproto.TL_inputDocumentFileLocation{ID: 1}, 2, size, c.partSize, c.offset, nil)
+		if err != nil {
+			t.Errorf("%#v: %s", c, err)
+			continue
+		}
+		if !res.Finished || !bytes.Equal(buf.Bytes(), data[c.offset:]) {
+			t.Errorf("%#v: wrong result %#v (%d bytes)", c, res, buf.Len())
+		}
+		if n := atomic.LoadInt32(&requests); n != c.expectedRequests {
+			t.Errorf("%#v: expected %d requests, got %d", c, c.expectedRequests, n)
+		}
+	}
+}
+
+func TestRequestFilePartLimits(t *testing.T) {
+	d := NewDownloader(newTestClient())
+	offsets := make(chan int32, 1)
+	d.partsRoutine = func(d *Downloader) {
+		for part := range d.filePartsQueue {
+			offsets <- part.offset
+		}
+	}
+	loc := mtproto.TL_inputDocumentFileLocation{ID: 1}
+
+	res := <-d.ReqestFilePart(2, loc, math.MaxInt32+1, 1024*1024)
+	if !merry.Is(res.Err, ErrFileTooLarge) {
+		t.Errorf("expected too large file error, got %v", res.Err)
+	}
+	res = <-d.ReqestFilePart(2, loc, 0, 2*1024*1024)
+	if !merry.Is(res.Err, ErrWrongFilePart) {
+		t.Errorf("expected wrong part error, got %v", res.Err)
+	}
+	// last part of file near 2 GB limit: offset fits int32 even if offset+limit does not
+	d.ReqestFilePart(2, loc, 2047*1024*1024, 1024*1024)
+	if offset := <-offsets; offset != 2047*1024*1024 {
+		t.Errorf("wrong part offset %d", offset)
+	}
+}
+
+func TestDownloaderInClient(t *testing.T) {
+	tg := NewTGClientExt(&mtproto.AppConfig{}, &mtproto.SessNoopStore{}, noopLogHandler{}, nil)
+	defer tg.Stop()
+	data := testFileData(300 * 1024)
+	var requests int32
+	// Downloader is copied into client, workers must use the copy
+	tg.partsRoutine = func(d *Downloader) {
+		if d != &tg.Downloader {
+			t.Error("parts routine is started with wrong downloader")
+		}
+		serveTestParts(d, data, &requests)
+	}
+
+	r := tg.Open(mtproto.TL_inputDocumentFileLocation{ID: 1}, 2, int64(len(data)))
+	defer r.Close()
+	buf := make([]byte, 1000)
+	if _, err := r.ReadAt(buf, 200*1024); err != nil || !bytes.Equal(buf, data[200*1024:200*1024+1000]) {
+		t.Errorf("wrong data: %v", err)
+	}
+}
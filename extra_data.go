@@ -1,26 +1,50 @@
 package tgclient
 
 import (
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
 )
 
+const peersSaveInterval = 5 * time.Second
+
 type extraData struct {
 	tg       *TGClient
 	mutex    *sync.RWMutex
 	users    map[int32]*mtproto.TL_user
 	chats    map[int32]*mtproto.TL_chat
 	channels map[int32]*mtproto.TL_channel
+	selfID   int32 //zero until current user is received
+
+	// chats and channels that are no longer available, kept separately from available ones
+	forbiddenChats    map[int32]*mtproto.TL_chatForbidden
+	forbiddenChannels map[int32]*mtproto.TL_channelForbidden
+
+	// messages in which users and channels were seen, used for ones known only by min constructors
+	fromMessage map[updatePeerKey]peerMessage
+
+	store      PeerStore   //may be nil
+	storeMutex *sync.Mutex //serializes store.Save calls
+	saveMutex  *sync.Mutex //guards saveTimer
+	saveTimer  *time.Timer
 }
 
 func newExtraData(tg *TGClient) *extraData {
 	return &extraData{
-		tg:       tg,
-		mutex:    &sync.RWMutex{},
-		users:    make(map[int32]*mtproto.TL_user),
-		chats:    make(map[int32]*mtproto.TL_chat),
-		channels: make(map[int32]*mtproto.TL_channel),
+		tg:          tg,
+		mutex:       &sync.RWMutex{},
+		users:       make(map[int32]*mtproto.TL_user),
+		chats:       make(map[int32]*mtproto.TL_chat),
+		channels:    make(map[int32]*mtproto.TL_channel),
+		fromMessage: make(map[updatePeerKey]peerMessage),
+
+		forbiddenChats:    make(map[int32]*mtproto.TL_chatForbidden),
+		forbiddenChannels: make(map[int32]*mtproto.TL_channelForbidden),
+		storeMutex:        &sync.Mutex{},
+		saveMutex:         &sync.Mutex{},
 	}
 }
 
@@ -28,25 +52,279 @@ func (e *extraData) rememberEventExtraData(objs []mtproto.TL) {
 	if len(objs) == 0 {
 		return
 	}
+	defer e.schedulePeersSave()
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 	for _, obj := range objs {
 		switch x := obj.(type) {
 		case mtproto.TL_user:
+			if old, ok := e.users[x.ID]; ok && x.Min && !old.Min {
+				x = mergeMinUser(*old, x)
+			}
 			e.users[x.ID] = &x
-			e.tg.log.Debug("extra: user: %d %s", x.ID, x.Username)
+			if x.Self {
+				e.selfID = x.ID
+			}
+			e.tg.log.Debug("extra: user: %d %s (min: %t)", x.ID, x.Username, x.Min)
 		case mtproto.TL_chat:
 			e.chats[x.ID] = &x
+			delete(e.forbiddenChats, x.ID)
 			e.tg.log.Debug("extra: chat: %d %s", x.ID, x.Title)
 		case mtproto.TL_channel:
+			if old, ok := e.channels[x.ID]; ok && x.Min && !old.Min {
+				x = mergeMinChannel(*old, x)
+			}
 			e.channels[x.ID] = &x
-			e.tg.log.Debug("extra: channel: %d %s", x.ID, x.Username)
+			delete(e.forbiddenChannels, x.ID)
+			e.tg.log.Debug("extra: channel: %d %s (min: %t)", x.ID, x.Username, x.Min)
+		case mtproto.TL_chatForbidden:
+			e.forbiddenChats[x.ID] = &x
+			delete(e.chats, x.ID)
+			e.tg.log.Debug("extra: forbidden chat: %d %s", x.ID, x.Title)
+		case mtproto.TL_channelForbidden:
+			e.forbiddenChannels[x.ID] = &x
+			delete(e.channels, x.ID)
+			e.tg.log.Debug("extra: forbidden channel: %d %s", x.ID, x.Title)
+		case mtproto.TL_userEmpty:
+			// deleted user without any info, nothing to remember
 		default:
 			e.tg.log.Warn(mtproto.UnexpectedTL("extra data", obj))
 		}
 	}
 }
 
+// Min constructors of users and channels are sent when the client may not have seen them yet
+// (for example, sender of a message in a big group). Their access hashes can not be used directly,
+// such peers are referenced with inputPeerUserFromMessage and inputPeerChannelFromMessage instead.
+// https://core.telegram.org/api/min
+
+const (
+	userFlagFirstName   = 1 << 1
+	userFlagLastName    = 1 << 2
+	userFlagUsername    = 1 << 3
+	userFlagPhoto       = 1 << 5
+	userFlagStatus      = 1 << 6
+	channelFlagUsername = 1 << 6
+)
+
+// mergeMinUser updates full user with fields of min one keeping access hash and other fields
+func mergeMinUser(full, min mtproto.TL_user) mtproto.TL_user {
+	res := full
+	mask := int32(userFlagFirstName | userFlagLastName | userFlagUsername)
+	res.FirstName, res.LastName, res.Username = min.FirstName, min.LastName, min.Username
+	if min.Flags&userFlagStatus != 0 {
+		mask |= userFlagStatus
+		res.Status = min.Status
+	}
+	if min.ApplyMinPhoto {
+		mask |= userFlagPhoto
+		res.Photo = min.Photo
+	}
+	res.Flags = res.Flags&^mask | min.Flags&mask
+	return res
+}
+
+// mergeMinChannel updates full channel with fields of min one keeping access hash and other fields
+func mergeMinChannel(full, min mtproto.TL_channel) mtproto.TL_channel {
+	res := full
+	res.Title, res.Username, res.Photo = min.Title, min.Username, min.Photo
+	res.Flags = res.Flags&^channelFlagUsername | min.Flags&channelFlagUsername
+	return res
+}
+
+type peerMessage struct {
+	peer  mtproto.TL //peer (mtproto.TL_peer*) of chat with message
+	msgID int32
+}
+
+// rememberMessagePeers remembers message in which its sender and forward source were seen
+func (e *extraData) rememberMessagePeers(update mtproto.TL) {
+	var message mtproto.TL
+	switch u := update.(type) {
+	case mtproto.TL_updateNewMessage:
+		message = u.Message
+	case mtproto.TL_updateNewChannelMessage:
+		message = u.Message
+	case mtproto.TL_updateEditMessage:
+		message = u.Message
+	case mtproto.TL_updateEditChannelMessage:
+		message = u.Message
+	}
+	msg, ok := message.(mtproto.TL_message)
+	if !ok || msg.PeerID == nil {
+		return
+	}
+	var peers []mtproto.TL
+	if msg.FromID != nil {
+		peers = append(peers, msg.FromID)
+	}
+	if fwd, ok := msg.FwdFrom.(mtproto.TL_messageFwdHeader); ok && fwd.FromID != nil {
+		peers = append(peers, fwd.FromID)
+	}
+	if len(peers) == 0 {
+		return
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for _, peer := range peers {
+		if key := peerKey(peer); key.kind == updatePeerUser || key.kind == updatePeerChannel {
+			e.fromMessage[key] = peerMessage{peer: msg.PeerID, msgID: msg.ID}
+		}
+	}
+}
+
+// inputPeer returns InputPeer of user, chat or channel (mtproto.TL_peer*)
+func (e *extraData) inputPeer(peer mtproto.TL) (mtproto.TL, bool) {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	if inputPeer, ok := e.directInputPeerUnlocked(peer); ok {
+		return inputPeer, true
+	}
+	return e.fromMessageInputPeerUnlocked(peer)
+}
+
+// directInputPeerUnlocked returns InputPeer of received (not min) user, chat or channel
+func (e *extraData) directInputPeerUnlocked(peer mtproto.TL) (mtproto.TL, bool) {
+	switch p := peer.(type) {
+	case mtproto.TL_peerUser:
+		if user, ok := e.users[p.UserID]; ok && !user.Min {
+			if user.Self {
+				return mtproto.TL_inputPeerSelf{}, true
+			}
+			return mtproto.TL_inputPeerUser{UserID: user.ID, AccessHash: user.AccessHash}, true
+		}
+	case mtproto.TL_peerChat:
+		return mtproto.TL_inputPeerChat{ChatID: p.ChatID}, true
+	case mtproto.TL_peerChannel:
+		if channel, ok := e.channels[p.ChannelID]; ok && !channel.Min {
+			return mtproto.TL_inputPeerChannel{ChannelID: channel.ID, AccessHash: channel.AccessHash}, true
+		}
+		if channel, ok := e.forbiddenChannels[p.ChannelID]; ok {
+			return mtproto.TL_inputPeerChannel{ChannelID: channel.ID, AccessHash: channel.AccessHash}, true
+		}
+	}
+	return nil, false
+}
+
+// fromMessageInputPeerUnlocked returns inputPeerUserFromMessage or inputPeerChannelFromMessage
+// if user or channel was seen in some message of available chat
+func (e *extraData) fromMessageInputPeerUnlocked(peer mtproto.TL) (mtproto.TL, bool) {
+	pm, ok := e.fromMessage[peerKey(peer)]
+	if !ok {
+		return nil, false
+	}
+	chatPeer, ok := e.directInputPeerUnlocked(pm.peer)
+	if !ok {
+		return nil, false
+	}
+	switch p := peer.(type) {
+	case mtproto.TL_peerUser:
+		return mtproto.TL_inputPeerUserFromMessage{Peer: chatPeer, MsgID: pm.msgID, UserID: p.UserID}, true
+	case mtproto.TL_peerChannel:
+		return mtproto.TL_inputPeerChannelFromMessage{Peer: chatPeer, MsgID: pm.msgID, ChannelID: p.ChannelID}, true
+	}
+	return nil, false
+}
+
+// rememberResponseExtraData remembers users and chats of any response (like messages.dialogs)
+func (e *extraData) rememberResponseExtraData(res mtproto.TL) {
+	if r, ok := res.(mtproto.TLWithUsers); ok {
+		e.rememberEventExtraData(r.GetUsers())
+	}
+	if r, ok := res.(mtproto.TLWithChats); ok {
+		e.rememberEventExtraData(r.GetChats())
+	}
+}
+
+// loadPeers fills cache with peers from store
+func (e *extraData) loadPeers() error {
+	peers, err := e.store.Load()
+	if merry.Is(err, ErrNoPeers) {
+		return nil
+	}
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for _, obj := range peers {
+		switch x := obj.(type) {
+		case mtproto.TL_user:
+			e.users[x.ID] = &x
+			if x.Self {
+				e.selfID = x.ID
+			}
+		case mtproto.TL_chat:
+			e.chats[x.ID] = &x
+		case mtproto.TL_channel:
+			e.channels[x.ID] = &x
+		case mtproto.TL_chatForbidden:
+			e.forbiddenChats[x.ID] = &x
+		case mtproto.TL_channelForbidden:
+			e.forbiddenChannels[x.ID] = &x
+		default:
+			e.tg.log.Warn(mtproto.UnexpectedTL("saved peer", obj))
+		}
+	}
+	e.tg.log.Debug("extra: loaded %d users, %d chats and %d channels (%d and %d forbidden)",
+		len(e.users), len(e.chats), len(e.channels), len(e.forbiddenChats), len(e.forbiddenChannels))
+	return nil
+}
+
+func (e *extraData) allPeers() []mtproto.TL {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	peers := make([]mtproto.TL, 0, len(e.users)+len(e.chats)+len(e.channels)+len(e.forbiddenChats)+len(e.forbiddenChannels))
+	for _, user := range e.users {
+		peers = append(peers, *user)
+	}
+	for _, chat := range e.chats {
+		peers = append(peers, *chat)
+	}
+	for _, channel := range e.channels {
+		peers = append(peers, *channel)
+	}
+	for _, chat := range e.forbiddenChats {
+		peers = append(peers, *chat)
+	}
+	for _, channel := range e.forbiddenChannels {
+		peers = append(peers, *channel)
+	}
+	return peers
+}
+
+func (e *extraData) savePeers() error {
+	if e.store == nil {
+		return nil
+	}
+	e.saveMutex.Lock()
+	if e.saveTimer != nil {
+		e.saveTimer.Stop()
+		e.saveTimer = nil
+	}
+	e.saveMutex.Unlock()
+
+	e.storeMutex.Lock()
+	defer e.storeMutex.Unlock()
+	return merry.Wrap(e.store.Save(e.allPeers()))
+}
+
+// schedulePeersSave saves peers a bit later, so frequent updates will not cause frequent writes
+func (e *extraData) schedulePeersSave() {
+	if e.store == nil {
+		return
+	}
+	e.saveMutex.Lock()
+	defer e.saveMutex.Unlock()
+	if e.saveTimer == nil {
+		e.saveTimer = time.AfterFunc(peersSaveInterval, func() {
+			if err := e.savePeers(); err != nil {
+				e.tg.log.Error(err, "failed to save peers")
+			}
+		})
+	}
+}
+
 func (e *extraData) FindExtraUser(userID int32) *mtproto.TL_user {
 	e.mutex.RLock()
 	defer e.mutex.RUnlock()
@@ -62,3 +340,81 @@ func (e *extraData) FindExtraChannel(channelID int32) *mtproto.TL_channel {
 	defer e.mutex.RUnlock()
 	return e.channels[channelID]
 }
+
+// FindForbiddenChat returns chat that is no longer available (kicked from or deleted), nil if there is no such chat
+func (e *extraData) FindForbiddenChat(chatID int32) *mtproto.TL_chatForbidden {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return e.forbiddenChats[chatID]
+}
+
+// FindForbiddenChannel returns channel that is no longer available (banned from or deleted), nil if there is no such channel
+func (e *extraData) FindForbiddenChannel(channelID int32) *mtproto.TL_channelForbidden {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return e.forbiddenChannels[channelID]
+}
+
+// selfUserID returns current user ID (zero if it is unknown yet)
+func (e *extraData) selfUserID() int32 {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return e.selfID
+}
+
+// peerKnown checks whether user, chat or channel (mtproto.TL_peer*) has been received
+func (e *extraData) peerKnown(peer mtproto.TL) bool {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	switch p := peer.(type) {
+	case mtproto.TL_peerUser:
+		_, ok := e.users[p.UserID]
+		return ok
+	case mtproto.TL_peerChat:
+		_, ok := e.chats[p.ChatID]
+		_, forbidden := e.forbiddenChats[p.ChatID]
+		return ok || forbidden
+	case mtproto.TL_peerChannel:
+		_, ok := e.channels[p.ChannelID]
+		_, forbidden := e.forbiddenChannels[p.ChannelID]
+		return ok || forbidden
+	}
+	return true
+}
+
+// findExtraByUsername returns InputPeer of user or channel with given username (case-insensitive)
+func (e *extraData) findExtraByUsername(username string) (mtproto.TL, bool) {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	for _, user := range e.users {
+		if user.Username != "" && strings.EqualFold(user.Username, username) {
+			peer := mtproto.TL_peerUser{UserID: user.ID}
+			if inputPeer, ok := e.directInputPeerUnlocked(peer); ok {
+				return inputPeer, true
+			}
+			return e.fromMessageInputPeerUnlocked(peer)
+		}
+	}
+	for _, channel := range e.channels {
+		if channel.Username != "" && strings.EqualFold(channel.Username, username) {
+			peer := mtproto.TL_peerChannel{ChannelID: channel.ID}
+			if inputPeer, ok := e.directInputPeerUnlocked(peer); ok {
+				return inputPeer, true
+			}
+			return e.fromMessageInputPeerUnlocked(peer)
+		}
+	}
+	return nil, false
+}
+
+// findExtraUserByPhone returns InputPeer of user with given phone (digits only)
+func (e *extraData) findExtraUserByPhone(phone string) (mtproto.TL, bool) {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	for _, user := range e.users {
+		if user.Phone != "" && user.Phone == phone {
+			return e.directInputPeerUnlocked(mtproto.TL_peerUser{UserID: user.ID})
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,146 @@
+package tgclient
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+func TestMinUserMerge(t *testing.T) {
+	tg := newTestClient()
+	full := mtproto.TL_user{
+		Flags: 1<<0 | userFlagFirstName | userFlagUsername | userFlagPhoto | 1<<4,
+		ID:    1, AccessHash: 10, FirstName: "Bob", Username: "bob", Phone: "123",
+		Photo: mtproto.TL_userProfilePhoto{PhotoID: 5},
+	}
+	min := mtproto.TL_user{
+		Flags: 1<<20 | 1<<0 | userFlagFirstName | userFlagLastName | userFlagPhoto,
+		Min:   true, ID: 1, AccessHash: 99, FirstName: "Robert", LastName: "B",
+		Photo: mtproto.TL_userProfilePhoto{PhotoID: 6},
+	}
+	tg.rememberEventExtraData([]mtproto.TL{full, min})
+
+	expected := full
+	expected.Flags = 1<<0 | userFlagFirstName | userFlagLastName | userFlagPhoto | 1<<4
+	expected.FirstName, expected.LastName, expected.Username = "Robert", "B", ""
+	if user := tg.FindExtraUser(1); !reflect.DeepEqual(*user, expected) {
+		t.Errorf("wrong merged user: %#v", *user)
+	}
+
+	full.FirstName = "Bobby"
+	tg.rememberEventExtraData([]mtproto.TL{full}) //full replaces min
+	if user := tg.FindExtraUser(1); !reflect.DeepEqual(*user, full) {
+		t.Errorf("wrong updated user: %#v", *user)
+	}
+}
+
+func TestMinChannelMerge(t *testing.T) {
+	tg := newTestClient()
+	full := mtproto.TL_channel{Flags: 1<<13 | channelFlagUsername, ID: 4, AccessHash: 40, Title: "news", Username: "news"}
+	min := mtproto.TL_channel{Flags: 1 << 12, Min: true, ID: 4, Title: "News!"}
+	tg.rememberEventExtraData([]mtproto.TL{full, min})
+
+	expected := mtproto.TL_channel{Flags: 1 << 13, ID: 4, AccessHash: 40, Title: "News!"}
+	if channel := tg.FindExtraChannel(4); !reflect.DeepEqual(*channel, expected) {
+		t.Errorf("wrong merged channel: %#v", *channel)
+	}
+}
+
+func TestMinPeersFromMessage(t *testing.T) {
+	tg := newTestClient()
+	tg.rememberEventExtraData([]mtproto.TL{
+		mtproto.TL_channel{ID: 4, AccessHash: 40},
+		mtproto.TL_user{Min: true, ID: 1, AccessHash: 99},
+		mtproto.TL_channel{Min: true, ID: 5},
+	})
+
+	if _, err := tg.InputPeer(mtproto.TL_peerUser{UserID: 1}); err == nil {
+		t.Errorf("min user should not be available before it is seen in message")
+	}
+
+	tg.rememberMessagePeers(mtproto.TL_updateNewChannelMessage{Message: mtproto.TL_message{
+		ID: 7, FromID: mtproto.TL_peerUser{UserID: 1}, PeerID: mtproto.TL_peerChannel{ChannelID: 4},
+		FwdFrom: mtproto.TL_messageFwdHeader{FromID: mtproto.TL_peerChannel{ChannelID: 5}},
+	}})
+
+	chatPeer := mtproto.TL_inputPeerChannel{ChannelID: 4, AccessHash: 40}
+	if inputPeer, err := tg.InputPeer(mtproto.TL_peerUser{UserID: 1}); err != nil ||
+		!reflect.DeepEqual(inputPeer, mtproto.TL_inputPeerUserFromMessage{Peer: chatPeer, MsgID: 7, UserID: 1}) {
+		t.Errorf("wrong user input peer: %#v %v", inputPeer, err)
+	}
+	if inputPeer, err := tg.InputPeer(mtproto.TL_peerChannel{ChannelID: 5}); err != nil ||
+		!reflect.DeepEqual(inputPeer, mtproto.TL_inputPeerChannelFromMessage{Peer: chatPeer, MsgID: 7, ChannelID: 5}) {
+		t.Errorf("wrong channel input peer: %#v %v", inputPeer, err)
+	}
+	if inputPeer, err := tg.InputPeer(mtproto.TL_peerChannel{ChannelID: 4}); err != nil || !reflect.DeepEqual(inputPeer, chatPeer) {
+		t.Errorf("full channel should be referenced directly: %#v %v", inputPeer, err)
+	}
+}
+
+func TestForbiddenPeers(t *testing.T) {
+	tg := newTestClient()
+	tg.store = &PeerMemStore{}
+	tg.rememberResponseExtraData(mtproto.TL_messages_chats{Chats: []mtproto.TL{
+		mtproto.TL_chat{ID: 1, Title: "old chat"},
+		mtproto.TL_chatForbidden{ID: 1, Title: "old chat"},
+		mtproto.TL_channelForbidden{Flags: 1 << 8, Megagroup: true, ID: 2, AccessHash: 20, Title: "old group"},
+	}})
+	tg.rememberEventExtraData([]mtproto.TL{mtproto.TL_userEmpty{ID: 3}})
+	if user := tg.FindExtraUser(3); user != nil {
+		t.Errorf("empty user should not be remembered: %#v", user)
+	}
+	if err := tg.savePeers(); err != nil {
+		t.Fatal(err)
+	}
+
+	// forbidden peers must stay forbidden after restart
+	restored := newTestClient()
+	restored.store = tg.store
+	if err := restored.loadPeers(); err != nil {
+		t.Fatal(err)
+	}
+	if chat := restored.FindExtraChat(1); chat != nil {
+		t.Errorf("forbidden chat should not be returned as available: %#v", chat)
+	}
+	if chat := restored.FindForbiddenChat(1); chat == nil || chat.Title != "old chat" {
+		t.Errorf("wrong forbidden chat: %#v", chat)
+	}
+	if channel := restored.FindExtraChannel(2); channel != nil {
+		t.Errorf("forbidden channel should not be returned as available: %#v", channel)
+	}
+	expected := mtproto.TL_channelForbidden{Flags: 1 << 8, Megagroup: true, ID: 2, AccessHash: 20, Title: "old group"}
+	if channel := restored.FindForbiddenChannel(2); channel == nil || !reflect.DeepEqual(*channel, expected) {
+		t.Errorf("wrong forbidden channel: %#v", channel)
+	}
+	if peer := restored.FindExtraPeer(-1000000000002); !reflect.DeepEqual(peer, expected) {
+		t.Errorf("wrong forbidden peer: %#v", peer)
+	}
+	if inputPeer, ok := restored.inputPeer(mtproto.TL_peerChannel{ChannelID: 2}); !ok ||
+		inputPeer != (mtproto.TL_inputPeerChannel{ChannelID: 2, AccessHash: 20}) {
+		t.Errorf("wrong input peer of forbidden channel: %#v", inputPeer)
+	}
+
+	// chat becomes available again
+	restored.rememberEventExtraData([]mtproto.TL{mtproto.TL_chat{ID: 1, Title: "new chat"}})
+	if chat := restored.FindForbiddenChat(1); chat != nil {
+		t.Errorf("available chat should not be forbidden: %#v", chat)
+	}
+	if chat := restored.FindExtraChat(1); chat == nil || chat.Title != "new chat" {
+		t.Errorf("wrong chat: %#v", chat)
+	}
+}
+
+func TestMinChannelAccessHash(t *testing.T) {
+	tg := newTestClient()
+	tg.rememberEventExtraData([]mtproto.TL{
+		mtproto.TL_channel{Flags: 1<<12 | 1<<13, Min: true, ID: 5, AccessHash: 50},
+		mtproto.TL_channel{Flags: 1 << 13, ID: 6, AccessHash: 60},
+	})
+	if _, ok := tg.channelAccessHash(5); ok {
+		t.Error("access hash of min channel should not be used")
+	}
+	if hash, ok := tg.channelAccessHash(6); !ok || hash != 60 {
+		t.Errorf("wrong access hash: %d %v", hash, ok)
+	}
+}
@@ -0,0 +1,268 @@
+package tgclient
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	_ "image/gif" //for image.DecodeConfig
+	"image/jpeg"
+	_ "image/png" //for image.DecodeConfig
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ansel1/merry"
+)
+
+const thumbMaxSide = 320
+
+// FileInfo describes file content, see InspectFile
+type FileInfo struct {
+	Name       string
+	Size       int64
+	MimeType   string
+	Width      int32   //images and videos, zero if unknown
+	Height     int32   //images and videos, zero if unknown
+	Duration   float64 //seconds, audio and videos, zero if unknown
+	Streamable bool    //MP4 video with metadata (moov) before content (mdat), may be played while downloading
+}
+
+// InspectFile detects MIME type (by content, then by extension), dimensions of images,
+// dimensions and durations of MP4 videos and durations of WAV files.
+func InspectFile(fpath string) (*FileInfo, error) {
+	fd, err := os.Open(fpath)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	defer fd.Close()
+	stat, err := fd.Stat()
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return InspectFileReader(fd, filepath.Base(fpath), stat.Size())
+}
+
+// InspectFileReader is same as InspectFile but for any io.ReaderAt
+func InspectFileReader(file io.ReaderAt, name string, size int64) (*FileInfo, error) {
+	head := make([]byte, 512)
+	n, err := file.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return nil, merry.Wrap(err)
+	}
+	head = head[:n]
+
+	info := &FileInfo{Name: name, Size: size, MimeType: detectMimeType(head, name)}
+	switch {
+	case strings.HasPrefix(info.MimeType, "image/"):
+		if cfg, _, err := image.DecodeConfig(io.NewSectionReader(file, 0, size)); err == nil {
+			info.Width, info.Height = int32(cfg.Width), int32(cfg.Height)
+		}
+	case info.MimeType == "video/mp4" || info.MimeType == "audio/mp4" || info.MimeType == "video/quicktime":
+		readMP4Info(file, size, info)
+	case info.MimeType == "audio/wave" || info.MimeType == "audio/wav":
+		readWAVInfo(file, size, info)
+	}
+	return info, nil
+}
+
+func detectMimeType(head []byte, name string) string {
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	byExt, _, _ := mime.ParseMediaType(mime.TypeByExtension(strings.ToLower(filepath.Ext(name))))
+	switch {
+	case sniffed == "application/octet-stream" || sniffed == "text/plain" || sniffed == "":
+		if byExt != "" {
+			return byExt
+		}
+		if sniffed == "" {
+			return "application/octet-stream"
+		}
+	case sniffed == "video/mp4" && strings.HasPrefix(byExt, "audio/"):
+		return byExt //M4A has same signature as MP4
+	case sniffed == "application/ogg":
+		if strings.HasPrefix(byExt, "video/") {
+			return byExt
+		}
+		return "audio/ogg"
+	}
+	return sniffed
+}
+
+// mp4Boxes calls fn for every box in [start, end) range
+func mp4Boxes(file io.ReaderAt, start, end int64, fn func(typ string, bodyStart, bodyEnd int64) error) error {
+	header := make([]byte, 16)
+	for pos := start; pos+8 <= end; {
+		if _, err := file.ReadAt(header[:8], pos); err != nil {
+			return merry.Wrap(err)
+		}
+		size := int64(binary.BigEndian.Uint32(header))
+		typ := string(header[4:8])
+		headerSize := int64(8)
+		switch size {
+		case 0:
+			size = end - pos
+		case 1:
+			if _, err := file.ReadAt(header[8:16], pos+8); err != nil {
+				return merry.Wrap(err)
+			}
+			size = int64(binary.BigEndian.Uint64(header[8:]))
+			headerSize = 16
+		}
+		if size < headerSize || pos+size > end {
+			return merry.Errorf("wrong MP4 box '%s' size %d at %d", typ, size, pos)
+		}
+		if err := fn(typ, pos+headerSize, pos+size); err != nil {
+			return err
+		}
+		pos += size
+	}
+	return nil
+}
+
+func readMP4Info(file io.ReaderAt, size int64, info *FileInfo) {
+	mdatFound := false
+	_ = mp4Boxes(file, 0, size, func(typ string, start, end int64) error {
+		switch typ {
+		case "mdat":
+			mdatFound = true
+		case "moov":
+			info.Streamable = !mdatFound
+			return mp4Boxes(file, start, end, func(typ string, start, end int64) error {
+				switch typ {
+				case "mvhd":
+					readMP4Duration(file, start, info)
+				case "trak":
+					readMP4TrackSize(file, start, end, info)
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+func readMP4Duration(file io.ReaderAt, start int64, info *FileInfo) {
+	buf := make([]byte, 32)
+	if _, err := file.ReadAt(buf, start); err != nil {
+		return
+	}
+	var timescale uint32
+	var duration uint64
+	if buf[0] == 1 {
+		timescale = binary.BigEndian.Uint32(buf[20:])
+		duration = binary.BigEndian.Uint64(buf[24:])
+	} else {
+		timescale = binary.BigEndian.Uint32(buf[12:])
+		duration = uint64(binary.BigEndian.Uint32(buf[16:]))
+	}
+	if timescale > 0 {
+		info.Duration = float64(duration) / float64(timescale)
+	}
+}
+
+func readMP4TrackSize(file io.ReaderAt, start, end int64, info *FileInfo) {
+	var isVideo bool
+	var width, height int32
+	_ = mp4Boxes(file, start, end, func(typ string, start, end int64) error {
+		switch typ {
+		case "tkhd":
+			buf := make([]byte, 96)
+			n, _ := file.ReadAt(buf, start)
+			sizeOffset := 76 //version 0
+			if buf[0] == 1 {
+				sizeOffset = 88
+			}
+			if n >= sizeOffset+8 {
+				width = int32(binary.BigEndian.Uint32(buf[sizeOffset:]) >> 16)
+				height = int32(binary.BigEndian.Uint32(buf[sizeOffset+4:]) >> 16)
+			}
+		case "mdia":
+			return mp4Boxes(file, start, end, func(typ string, start, end int64) error {
+				if typ == "hdlr" {
+					buf := make([]byte, 12)
+					if _, err := file.ReadAt(buf, start); err == nil {
+						isVideo = string(buf[8:12]) == "vide"
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	if isVideo && info.Width == 0 {
+		info.Width, info.Height = width, height
+	}
+}
+
+func readWAVInfo(file io.ReaderAt, size int64, info *FileInfo) {
+	header := make([]byte, 12)
+	if _, err := file.ReadAt(header, 0); err != nil || string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return
+	}
+	var byteRate uint32
+	chunk := make([]byte, 16)
+	for pos := int64(12); pos+8 <= size; {
+		if _, err := file.ReadAt(chunk[:8], pos); err != nil {
+			return
+		}
+		chunkSize := int64(binary.LittleEndian.Uint32(chunk[4:]))
+		switch string(chunk[:4]) {
+		case "fmt ":
+			if _, err := file.ReadAt(chunk, pos+8); err != nil {
+				return
+			}
+			byteRate = binary.LittleEndian.Uint32(chunk[8:])
+		case "data":
+			if byteRate > 0 {
+				info.Duration = float64(chunkSize) / float64(byteRate)
+			}
+			return
+		}
+		pos += 8 + chunkSize + chunkSize%2
+	}
+}
+
+// makeThumbnail decodes image and returns its downscaled JPEG copy (with max side of 320px)
+func makeThumbnail(file io.ReaderAt, size int64) ([]byte, error) {
+	img, _, err := image.Decode(io.NewSectionReader(file, 0, size))
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 || h == 0 {
+		return nil, merry.New("empty image")
+	}
+	tw, th := w, h
+	if w > thumbMaxSide || h > thumbMaxSide {
+		if w > h {
+			tw, th = thumbMaxSide, h*thumbMaxSide/w
+		} else {
+			tw, th = w*thumbMaxSide/h, thumbMaxSide
+		}
+		if tw == 0 {
+			tw = 1
+		}
+		if th == 0 {
+			th = 1
+		}
+	}
+
+	//nearest neighbor, transparent pixels become white
+	thumb := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		for x := 0; x < tw; x++ {
+			r, g, b, a := img.At(bounds.Min.X+x*w/tw, bounds.Min.Y+y*h/th).RGBA()
+			white := 0xFFFF - a
+			thumb.Set(x, y, color.RGBA64{R: uint16(r + white), G: uint16(g + white), B: uint16(b + white), A: 0xFFFF})
+		}
+	}
+	buf := &bytes.Buffer{}
+	if err := jpeg.Encode(buf, thumb, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
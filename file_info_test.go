@@ -0,0 +1,102 @@
+package tgclient
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func mp4Box(typ string, content ...[]byte) []byte {
+	body := bytes.Join(content, nil)
+	buf := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(buf, uint32(8+len(body)))
+	copy(buf[4:], typ)
+	return append(buf, body...)
+}
+
+func testMP4(moovFirst bool) []byte {
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)  //timescale
+	binary.BigEndian.PutUint32(mvhd[16:], 12500) //duration
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], 640<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], 360<<16)
+	audioHdlr := append(make([]byte, 8), "soun"...)
+	videoHdlr := append(make([]byte, 8), "vide"...)
+
+	ftyp := mp4Box("ftyp", []byte("isom\x00\x00\x02\x00isomiso2mp41"))
+	moov := mp4Box("moov",
+		mp4Box("mvhd", mvhd),
+		mp4Box("trak", mp4Box("tkhd", make([]byte, 84)), mp4Box("mdia", mp4Box("hdlr", audioHdlr))),
+		mp4Box("trak", mp4Box("tkhd", tkhd), mp4Box("mdia", mp4Box("hdlr", videoHdlr))),
+	)
+	mdat := mp4Box("mdat", make([]byte, 64))
+	if moovFirst {
+		return bytes.Join([][]byte{ftyp, moov, mdat}, nil)
+	}
+	return bytes.Join([][]byte{ftyp, mdat, moov}, nil)
+}
+
+func testWAV(seconds int) []byte {
+	const byteRate = 8000 * 2
+	buf := &bytes.Buffer{}
+	buf.WriteString("RIFF")
+	binary.Write(buf, binary.LittleEndian, uint32(36+byteRate*seconds))
+	buf.WriteString("WAVEfmt ")
+	binary.Write(buf, binary.LittleEndian, []uint32{16, 0x00010001, 8000, byteRate, 0x00100002})
+	buf.WriteString("data")
+	binary.Write(buf, binary.LittleEndian, uint32(byteRate*seconds))
+	buf.Write(make([]byte, byteRate*seconds))
+	return buf.Bytes()
+}
+
+func testPNG(w, h int) []byte {
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, image.NewGray(image.Rect(0, 0, w, h))); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func TestInspectFile(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		data     []byte
+		expected FileInfo
+	}{
+		{"img.png", testPNG(30, 20), FileInfo{MimeType: "image/png", Width: 30, Height: 20}},
+		{"video.mp4", testMP4(true), FileInfo{MimeType: "video/mp4", Width: 640, Height: 360, Duration: 12.5, Streamable: true}},
+		{"video.mp4", testMP4(false), FileInfo{MimeType: "video/mp4", Width: 640, Height: 360, Duration: 12.5}},
+		{"sound.wav", testWAV(3), FileInfo{MimeType: "audio/wave", Duration: 3}},
+		{"doc.pdf", []byte("%PDF-1.4\n..."), FileInfo{MimeType: "application/pdf"}},
+		{"data.bin", []byte{0, 1, 2, 3}, FileInfo{MimeType: "application/octet-stream"}},
+		{"voice.ogg", []byte("OggS\x00\x02"), FileInfo{MimeType: "audio/ogg"}},
+	} {
+		info, err := InspectFileReader(bytes.NewReader(c.data), c.name, int64(len(c.data)))
+		if err != nil {
+			t.Errorf("%s: %s", c.name, err)
+			continue
+		}
+		c.expected.Name, c.expected.Size = c.name, int64(len(c.data))
+		if *info != c.expected {
+			t.Errorf("%s: expected %#v, got %#v", c.name, c.expected, *info)
+		}
+	}
+}
+
+func TestMakeThumbnail(t *testing.T) {
+	data := testPNG(1000, 500)
+	thumbData, err := makeThumbnail(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(thumbData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "jpeg" || cfg.Width != 320 || cfg.Height != 160 {
+		t.Errorf("wrong thumbnail: %s %dx%d", format, cfg.Width, cfg.Height)
+	}
+}
@@ -0,0 +1,202 @@
+package tgclient
+
+import (
+	"container/list"
+	"io"
+	"sync"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+const (
+	readerChunkSize      = 128 * 1024
+	readerPrefetchChunks = 4  //chunks requested ahead of the one being read
+	readerCacheChunks    = 32 //downloaded chunks kept in memory (least recently used are removed first)
+)
+
+var ErrFileReaderClosed = merry.New("file reader is closed")
+
+type fileChunk struct {
+	index int64
+	data  []byte
+	err   error
+	done  chan struct{} //closed when data or err is set
+}
+
+// FileReader reads Telegram file with random access (io.ReadSeeker and io.ReaderAt),
+// so it can be used, for example, with http.ServeContent. File is downloaded in aligned chunks on demand,
+// next chunks are prefetched and recently used ones are cached. It is safe for concurrent ReadAt calls.
+type FileReader struct {
+	d        *Downloader
+	location mtproto.TL
+	size     int64
+
+	mutex  *sync.Mutex
+	dcID   int32
+	chunks map[int64]*list.Element
+	lru    *list.List //of *fileChunk, most recently used at front
+	closed bool
+
+	posMutex *sync.Mutex
+	pos      int64 //for Read and Seek
+}
+
+// Open returns reader of file of given size (in bytes) located on DC dcID.
+// Reader should be closed after use.
+func (d *Downloader) Open(fileLocation mtproto.TL, dcID int32, size int64) *FileReader {
+	d.useLocation(fileLocation)
+	return &FileReader{
+		d:        d,
+		location: fileLocation,
+		size:     size,
+		mutex:    &sync.Mutex{},
+		dcID:     dcID,
+		chunks:   make(map[int64]*list.Element),
+		lru:      list.New(),
+		posMutex: &sync.Mutex{},
+	}
+}
+
+func (r *FileReader) Size() int64 {
+	return r.size
+}
+
+func (r *FileReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, merry.Errorf("negative offset: %d", off)
+	}
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		if pos >= r.size {
+			return n, io.EOF
+		}
+		data, err := r.chunk(pos / readerChunkSize)
+		if err != nil {
+			return n, merry.Wrap(err)
+		}
+		n += copy(p[n:], data[pos%readerChunkSize:])
+	}
+	return n, nil
+}
+
+func (r *FileReader) Read(p []byte) (int, error) {
+	r.posMutex.Lock()
+	defer r.posMutex.Unlock()
+	n, err := r.ReadAt(p, r.pos)
+	r.pos += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *FileReader) Seek(offset int64, whence int) (int64, error) {
+	r.posMutex.Lock()
+	defer r.posMutex.Unlock()
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = r.pos + offset
+	case io.SeekEnd:
+		pos = r.size + offset
+	default:
+		return 0, merry.Errorf("wrong whence: %d", whence)
+	}
+	if pos < 0 {
+		return 0, merry.Errorf("negative position: %d", pos)
+	}
+	r.pos = pos
+	return pos, nil
+}
+
+// Close drops cached chunks. Already requested chunks are still downloaded but ignored.
+func (r *FileReader) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	r.chunks = make(map[int64]*list.Element)
+	r.lru.Init()
+	r.d.releaseLocation(r.location)
+	return nil
+}
+
+func (r *FileReader) chunk(index int64) ([]byte, error) {
+	r.mutex.Lock()
+	if r.closed {
+		r.mutex.Unlock()
+		return nil, ErrFileReaderClosed.Here()
+	}
+	for i := int64(readerPrefetchChunks); i >= 1; i-- {
+		if (index+i)*readerChunkSize < r.size {
+			r.startChunkUnlocked(index + i)
+		}
+	}
+	c := r.startChunkUnlocked(index)
+	r.mutex.Unlock()
+
+	<-c.done
+	if c.err != nil {
+		// removing failed chunk, so it will be requested again on next read
+		r.mutex.Lock()
+		if el, ok := r.chunks[index]; ok && el.Value == c {
+			r.lru.Remove(el)
+			delete(r.chunks, index)
+		}
+		r.mutex.Unlock()
+		return nil, c.err
+	}
+	return c.data, nil
+}
+
+func (r *FileReader) startChunkUnlocked(index int64) *fileChunk {
+	if el, ok := r.chunks[index]; ok {
+		r.lru.MoveToFront(el)
+		return el.Value.(*fileChunk)
+	}
+
+	c := &fileChunk{index: index, done: make(chan struct{})}
+	r.chunks[index] = r.lru.PushFront(c)
+	r.evictUnlocked()
+
+	offset := index * readerChunkSize
+	expectedSize := int(minI64(readerChunkSize, r.size-offset))
+	dcID := r.dcID
+	go func() {
+		res := <-r.d.requestFilePart(dcID, r.location, offset, readerChunkSize, true)
+		switch {
+		case res.Err != nil:
+			c.err = res.Err
+		case len(res.Data) < expectedSize:
+			c.err = merry.Errorf("file chunk at %d is too short: %d < %d", offset, len(res.Data), expectedSize)
+		default:
+			c.data = res.Data[:expectedSize]
+			r.mutex.Lock()
+			r.dcID = res.DcID
+			r.mutex.Unlock()
+		}
+		close(c.done)
+	}()
+	return c
+}
+
+// evictUnlocked removes least recently used downloaded chunks if there are too many of them
+func (r *FileReader) evictUnlocked() {
+	for el := r.lru.Back(); el != nil && r.lru.Len() > readerCacheChunks; {
+		prev := el.Prev()
+		c := el.Value.(*fileChunk)
+		select {
+		case <-c.done:
+			r.lru.Remove(el)
+			delete(r.chunks, c.index)
+		default: //still downloading
+		}
+		el = prev
+	}
+}
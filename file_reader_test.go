@@ -0,0 +1,95 @@
+package tgclient
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"sync/atomic"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+// serveTestParts answers queued part requests with data slices
+func serveTestParts(d *Downloader, data []byte, requests *int32) {
+	for part := range d.filePartsQueue {
+		atomic.AddInt32(requests, 1)
+		resp := &FileResponse{DcID: part.dcID}
+		if !part.precise || part.offset%1024 != 0 {
+			resp.Err = merry.Errorf("wrong request: %#v", part)
+		} else if int(part.offset) < len(data) {
+			resp.Data = data[part.offset:minI64(int64(part.offset+part.limit), int64(len(data)))]
+		}
+		part.outChan <- resp
+		close(part.outChan)
+	}
+}
+
+func TestFileReader(t *testing.T) {
+	d := NewDownloader(newTestClient())
+	data := testFileData(readerChunkSize*40 + 123)
+	var requests int32
+	d.partsRoutine = func(d *Downloader) { serveTestParts(d, data, &requests) }
+
+	r := d.Open(mtproto.TL_inputDocumentFileLocation{ID: 1}, 2, int64(len(data)))
+	all, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(all, data) {
+		t.Fatalf("wrong data read")
+	}
+	if n := atomic.LoadInt32(&requests); n != 41 {
+		t.Errorf("expected each chunk to be requested once, got %d requests", n)
+	}
+
+	// last chunks are cached
+	buf := make([]byte, 1000)
+	if _, err := r.Seek(-1000, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadFull(r, buf); err != nil || !bytes.Equal(buf, data[len(data)-1000:]) {
+		t.Errorf("wrong data at the end: %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 41 {
+		t.Errorf("expected cached chunk, got %d requests", n)
+	}
+
+	// chunk boundary crossing
+	n, err := r.ReadAt(buf, readerChunkSize-500)
+	if err != nil || n != len(buf) || !bytes.Equal(buf, data[readerChunkSize-500:readerChunkSize+500]) {
+		t.Errorf("wrong data at chunk boundary: %d %v", n, err)
+	}
+	if n, err := r.ReadAt(buf, int64(len(data))-10); n != 10 || err != io.EOF {
+		t.Errorf("expected EOF after 10 bytes, got %d %v", n, err)
+	}
+
+	r.Close()
+	if _, err := r.ReadAt(buf, 0); !merry.Is(err, ErrFileReaderClosed) {
+		t.Errorf("expected ErrFileReaderClosed, got %v", err)
+	}
+}
+
+func TestFileReaderSharedLocationState(t *testing.T) {
+	d := NewDownloader(newTestClient())
+	location := mtproto.TL_inputDocumentFileLocation{ID: 1}
+	r1 := d.Open(location, 2, 100)
+	r2 := d.Open(location, 2, 100)
+	redirect := d.rememberCDNRedirect(location, mtproto.TL_upload_fileCdnRedirect{DcID: 3})
+	hashes := d.fileHashesFor(location)
+
+	// closing reader must not remove state still used by another one
+	r1.Close()
+	r1.Close()
+	if d.findCDNRedirect(location) != redirect || d.fileHashesFor(location) != hashes {
+		t.Fatal("location state was removed while another reader uses it")
+	}
+	r2.Close()
+	if d.findCDNRedirect(location) != nil {
+		t.Error("CDN redirect was not removed after last reader")
+	}
+	if len(d.locationRefs) != 0 {
+		t.Errorf("location references were not removed: %v", d.locationRefs)
+	}
+}
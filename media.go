@@ -0,0 +1,298 @@
+package tgclient
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+const (
+	photoMaxSize       = 10 * 1024 * 1024
+	photoMaxSidesSum   = 10000
+	albumMaxItemsCount = 10
+)
+
+const (
+	inputMediaFlagThumb     = 1 << 2
+	inputMediaFlagForceFile = 1 << 4
+	videoFlagRoundMessage   = 1 << 0
+	videoFlagStreaming      = 1 << 1
+	audioFlagTitle          = 1 << 0
+	audioFlagPerformer      = 1 << 1
+	audioFlagVoice          = 1 << 10
+	sendFlagReplyTo         = 1 << 0
+	sendFlagEntities        = 1 << 3
+	sendFlagSilent          = 1 << 5
+	singleMediaFlagEntities = 1 << 0
+)
+
+var ErrWrongAlbum = merry.New("wrong album")
+
+// MediaOptions are used while building and sending media, all fields are optional
+type MediaOptions struct {
+	Caption         string
+	Entities        []mtproto.TL // MessageEntity
+	ReplyToMsgID    int32
+	Silent          bool
+	ForceDocument   bool   //send images and videos as files
+	ThumbPath       string //document thumbnail (JPEG, up to 320x320), generated automatically for images
+	Voice           bool   //send audio as voice message (should be OGG/Opus)
+	RoundMessage    bool   //send video as round video message
+	Title           string //audio title
+	Performer       string //audio performer
+	ProgressHandler FileProgressHandler
+}
+
+// UploadInputMedia uploads file (and thumbnail) and returns mtproto.TL_inputMediaUploadedPhoto
+// (for JPEG and PNG images) or mtproto.TL_inputMediaUploadedDocument with attributes based on file content (see InspectFile).
+func (c *TGClient) UploadInputMedia(fpath string, opts *MediaOptions) (mtproto.TL, error) {
+	if opts == nil {
+		opts = &MediaOptions{}
+	}
+	info, err := InspectFile(fpath)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	inputFile, err := c.UploadFileFromPath(fpath, opts.ProgressHandler)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	if isPhotoFile(info, opts) {
+		return inputMediaFromInfo(info, inputFile, nil, opts), nil
+	}
+
+	var thumb mtproto.TL
+	thumbData, err := c.readThumbnail(fpath, info, opts)
+	if err != nil {
+		c.log.Warn("failed to make thumbnail for '%s': %s", fpath, err)
+	} else if thumbData != nil {
+		thumb, err = c.UploadFile(bytes.NewReader(thumbData), "thumb.jpg", int64(len(thumbData)), nil)
+		if err != nil {
+			return nil, merry.Wrap(err)
+		}
+	}
+	return inputMediaFromInfo(info, inputFile, thumb, opts), nil
+}
+
+// SendFile uploads file and sends it as photo or document (see UploadInputMedia).
+// Returns request response (Updates).
+func (c *TGClient) SendFile(inputPeer mtproto.TL, fpath string, opts *MediaOptions) (mtproto.TL, error) {
+	media, err := c.UploadInputMedia(fpath, opts)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return c.SendMedia(inputPeer, media, opts)
+}
+
+// SendMedia sends InputMedia (uploaded one or, for example, from UploadMedia or InputMediaFromMessageMedia)
+// with caption and other options. Returns request response (Updates).
+func (c *TGClient) SendMedia(inputPeer, media mtproto.TL, opts *MediaOptions) (mtproto.TL, error) {
+	if opts == nil {
+		opts = &MediaOptions{}
+	}
+	req := mtproto.TL_messages_sendMedia{
+		Peer:         inputPeer,
+		Media:        media,
+		Message:      opts.Caption,
+		RandomID:     randomInt64(),
+		Silent:       opts.Silent,
+		ReplyToMsgID: opts.ReplyToMsgID,
+		Entities:     opts.Entities,
+	}
+	if opts.Silent {
+		req.Flags |= sendFlagSilent
+	}
+	if opts.ReplyToMsgID != 0 {
+		req.Flags |= sendFlagReplyTo
+	}
+	if len(opts.Entities) > 0 {
+		req.Flags |= sendFlagEntities
+	}
+	res := c.SendSyncRetry(req, time.Second, 5, 10*time.Second)
+	if _, ok := res.(mtproto.TL_rpc_error); ok {
+		return nil, mtproto.WrongRespError(res)
+	}
+	return res, nil
+}
+
+// SendAlbum uploads files (2-10 photos and videos, or documents, or audios) and sends them as single album
+// with messages.sendMultiMedia. Caption and entities are attached to the first item.
+func (c *TGClient) SendAlbum(inputPeer mtproto.TL, fpaths []string, opts *MediaOptions) (mtproto.TL, error) {
+	if opts == nil {
+		opts = &MediaOptions{}
+	}
+	if len(fpaths) < 2 || len(fpaths) > albumMaxItemsCount {
+		return nil, ErrWrongAlbum.Here().WithMessagef("album should contain 2-%d items, got %d", albumMaxItemsCount, len(fpaths))
+	}
+	items := make([]mtproto.TL, len(fpaths))
+	for i, fpath := range fpaths {
+		media, err := c.UploadInputMedia(fpath, opts)
+		if err != nil {
+			return nil, merry.Wrap(err)
+		}
+		// album items must be already uploaded photos or documents
+		if media, err = c.UploadMedia(inputPeer, media); err != nil {
+			return nil, merry.Wrap(err)
+		}
+		item := mtproto.TL_inputSingleMedia{Media: media, RandomID: randomInt64()}
+		if i == 0 {
+			item.Message = opts.Caption
+			if len(opts.Entities) > 0 {
+				item.Entities = opts.Entities
+				item.Flags |= singleMediaFlagEntities
+			}
+		}
+		items[i] = item
+	}
+
+	req := mtproto.TL_messages_sendMultiMedia{
+		Peer:         inputPeer,
+		MultiMedia:   items,
+		Silent:       opts.Silent,
+		ReplyToMsgID: opts.ReplyToMsgID,
+	}
+	if opts.Silent {
+		req.Flags |= sendFlagSilent
+	}
+	if opts.ReplyToMsgID != 0 {
+		req.Flags |= sendFlagReplyTo
+	}
+	res := c.SendSyncRetry(req, time.Second, 5, 10*time.Second)
+	if _, ok := res.(mtproto.TL_rpc_error); ok {
+		return nil, mtproto.WrongRespError(res)
+	}
+	return res, nil
+}
+
+// UploadMedia uploads InputMedia (with messages.uploadMedia) without sending it
+// and returns mtproto.TL_inputMediaPhoto or mtproto.TL_inputMediaDocument which may be sent (many times) later.
+func (c *TGClient) UploadMedia(inputPeer, media mtproto.TL) (mtproto.TL, error) {
+	res := c.SendSyncRetry(mtproto.TL_messages_uploadMedia{Peer: inputPeer, Media: media}, time.Second, 5, 10*time.Second)
+	if inputMedia, ok := InputMediaFromMessageMedia(res); ok {
+		return inputMedia, nil
+	}
+	return nil, mtproto.WrongRespError(res)
+}
+
+// InputMediaFromMessageMedia converts received MessageMedia (mtproto.TL_messageMediaPhoto or TL_messageMediaDocument)
+// to InputMedia, so it can be sent again without uploading
+func InputMediaFromMessageMedia(media mtproto.TL) (mtproto.TL, bool) {
+	switch m := media.(type) {
+	case mtproto.TL_messageMediaPhoto:
+		if photo, ok := m.Photo.(mtproto.TL_photo); ok {
+			return mtproto.TL_inputMediaPhoto{
+				ID: mtproto.TL_inputPhoto{ID: photo.ID, AccessHash: photo.AccessHash, FileReference: photo.FileReference},
+			}, true
+		}
+	case mtproto.TL_messageMediaDocument:
+		if doc, ok := m.Document.(mtproto.TL_document); ok {
+			return mtproto.TL_inputMediaDocument{
+				ID: mtproto.TL_inputDocument{ID: doc.ID, AccessHash: doc.AccessHash, FileReference: doc.FileReference},
+			}, true
+		}
+	}
+	return nil, false
+}
+
+func isPhotoFile(info *FileInfo, opts *MediaOptions) bool {
+	return !opts.ForceDocument &&
+		(info.MimeType == "image/jpeg" || info.MimeType == "image/png") &&
+		info.Size <= photoMaxSize &&
+		info.Width > 0 && info.Height > 0 && info.Width+info.Height <= photoMaxSidesSum
+}
+
+func (c *TGClient) readThumbnail(fpath string, info *FileInfo, opts *MediaOptions) ([]byte, error) {
+	if opts.ThumbPath != "" {
+		f, err := os.Open(opts.ThumbPath)
+		if err != nil {
+			return nil, merry.Wrap(err)
+		}
+		defer f.Close()
+		stat, err := f.Stat()
+		if err != nil {
+			return nil, merry.Wrap(err)
+		}
+		return makeThumbnail(f, stat.Size())
+	}
+	if strings.HasPrefix(info.MimeType, "image/") && info.Width > 0 {
+		f, err := os.Open(fpath)
+		if err != nil {
+			return nil, merry.Wrap(err)
+		}
+		defer f.Close()
+		return makeThumbnail(f, info.Size)
+	}
+	return nil, nil
+}
+
+// inputMediaFromInfo builds inputMediaUploadedPhoto or inputMediaUploadedDocument (with attributes) for uploaded file
+func inputMediaFromInfo(info *FileInfo, inputFile, thumb mtproto.TL, opts *MediaOptions) mtproto.TL {
+	if isPhotoFile(info, opts) {
+		return mtproto.TL_inputMediaUploadedPhoto{File: inputFile}
+	}
+
+	media := mtproto.TL_inputMediaUploadedDocument{
+		File:       inputFile,
+		MimeType:   info.MimeType,
+		Attributes: []mtproto.TL{mtproto.TL_documentAttributeFilename{FileName: filepath.Base(info.Name)}},
+	}
+	if thumb != nil {
+		media.Thumb = thumb
+		media.Flags |= inputMediaFlagThumb
+	}
+	duration := int32(math.Round(info.Duration))
+	switch {
+	case opts.ForceDocument:
+		media.ForceFile = true
+		media.Flags |= inputMediaFlagForceFile
+	case strings.HasPrefix(info.MimeType, "image/") && info.Width > 0:
+		media.Attributes = append(media.Attributes, mtproto.TL_documentAttributeImageSize{W: info.Width, H: info.Height})
+		if info.MimeType == "image/gif" {
+			media.Attributes = append(media.Attributes, mtproto.TL_documentAttributeAnimated{})
+		}
+	case strings.HasPrefix(info.MimeType, "video/"):
+		attr := mtproto.TL_documentAttributeVideo{
+			Duration:          duration,
+			W:                 info.Width,
+			H:                 info.Height,
+			SupportsStreaming: info.Streamable,
+			RoundMessage:      opts.RoundMessage,
+		}
+		if attr.SupportsStreaming {
+			attr.Flags |= videoFlagStreaming
+		}
+		if attr.RoundMessage {
+			attr.Flags |= videoFlagRoundMessage
+		}
+		media.Attributes = append(media.Attributes, attr)
+	case strings.HasPrefix(info.MimeType, "audio/"):
+		attr := mtproto.TL_documentAttributeAudio{
+			Duration:  duration,
+			Voice:     opts.Voice,
+			Title:     opts.Title,
+			Performer: opts.Performer,
+		}
+		if attr.Voice {
+			attr.Flags |= audioFlagVoice
+		}
+		if attr.Title != "" {
+			attr.Flags |= audioFlagTitle
+		}
+		if attr.Performer != "" {
+			attr.Flags |= audioFlagPerformer
+		}
+		media.Attributes = append(media.Attributes, attr)
+	}
+	return media
+}
+
+func randomInt64() int64 {
+	return int64(binary.LittleEndian.Uint64(mtproto.GenerateNonce(8)))
+}
@@ -0,0 +1,77 @@
+package tgclient
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+func TestInputMediaFromInfo(t *testing.T) {
+	file := mtproto.TL_inputFile{ID: 1, Parts: 1, Name: "f"}
+	thumb := mtproto.TL_inputFile{ID: 2, Parts: 1, Name: "thumb.jpg"}
+	filename := func(name string) mtproto.TL { return mtproto.TL_documentAttributeFilename{FileName: name} }
+
+	for i, c := range []struct {
+		info     FileInfo
+		thumb    mtproto.TL
+		opts     MediaOptions
+		expected mtproto.TL
+	}{
+		{
+			FileInfo{Name: "a.jpg", Size: 100, MimeType: "image/jpeg", Width: 800, Height: 600}, nil, MediaOptions{},
+			mtproto.TL_inputMediaUploadedPhoto{File: file},
+		},
+		{
+			FileInfo{Name: "a.jpg", Size: 100, MimeType: "image/jpeg", Width: 800, Height: 600}, thumb, MediaOptions{ForceDocument: true},
+			mtproto.TL_inputMediaUploadedDocument{
+				Flags: inputMediaFlagThumb | inputMediaFlagForceFile, ForceFile: true, File: file, Thumb: thumb,
+				MimeType: "image/jpeg", Attributes: []mtproto.TL{filename("a.jpg")},
+			},
+		},
+		{
+			FileInfo{Name: "a.gif", Size: 100, MimeType: "image/gif", Width: 80, Height: 60}, thumb, MediaOptions{},
+			mtproto.TL_inputMediaUploadedDocument{
+				Flags: inputMediaFlagThumb, File: file, Thumb: thumb, MimeType: "image/gif",
+				Attributes: []mtproto.TL{filename("a.gif"), mtproto.TL_documentAttributeImageSize{W: 80, H: 60}, mtproto.TL_documentAttributeAnimated{}},
+			},
+		},
+		{
+			FileInfo{Name: "v.mp4", Size: 100, MimeType: "video/mp4", Width: 640, Height: 360, Duration: 12.6, Streamable: true}, nil, MediaOptions{},
+			mtproto.TL_inputMediaUploadedDocument{
+				File: file, MimeType: "video/mp4",
+				Attributes: []mtproto.TL{filename("v.mp4"), mtproto.TL_documentAttributeVideo{
+					Flags: videoFlagStreaming, SupportsStreaming: true, Duration: 13, W: 640, H: 360}},
+			},
+		},
+		{
+			FileInfo{Name: "s.ogg", Size: 100, MimeType: "audio/ogg"}, nil, MediaOptions{Voice: true, Title: "T"},
+			mtproto.TL_inputMediaUploadedDocument{
+				File: file, MimeType: "audio/ogg",
+				Attributes: []mtproto.TL{filename("s.ogg"), mtproto.TL_documentAttributeAudio{
+					Flags: audioFlagVoice | audioFlagTitle, Voice: true, Title: "T"}},
+			},
+		},
+	} {
+		media := inputMediaFromInfo(&c.info, file, c.thumb, &c.opts)
+		if !reflect.DeepEqual(media, c.expected) {
+			t.Errorf("#%d: expected %#v, got %#v", i, c.expected, media)
+		}
+		if decoded := mtproto.NewDecodeBuf(mtproto.Encode(media)).Object(); !reflect.DeepEqual(decoded, media) {
+			t.Errorf("#%d: media changed after encoding:\n%#v", i, decoded)
+		}
+	}
+}
+
+func TestInputMediaFromMessageMedia(t *testing.T) {
+	media, ok := InputMediaFromMessageMedia(mtproto.TL_messageMediaDocument{
+		Document: mtproto.TL_document{ID: 1, AccessHash: 2, FileReference: []byte{3}},
+	})
+	expected := mtproto.TL_inputMediaDocument{ID: mtproto.TL_inputDocument{ID: 1, AccessHash: 2, FileReference: []byte{3}}}
+	if !ok || !reflect.DeepEqual(media, expected) {
+		t.Errorf("wrong document media %#v", media)
+	}
+	if _, ok := InputMediaFromMessageMedia(mtproto.TL_messageMediaPhoto{Photo: mtproto.TL_photoEmpty{}}); ok {
+		t.Errorf("empty photo should not be converted")
+	}
+}
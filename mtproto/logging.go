@@ -5,6 +5,7 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/ansel1/merry"
 )
@@ -23,9 +24,18 @@ type LogHandler interface {
 	Message(bool, TL, int64)
 }
 
-type SimpleLogHandler struct{}
+type SimpleLogHandler struct {
+	// FullMessages enables logging of message bodies (in TL text format) instead of just names.
+	// Bodies may contain sensitive data (auth keys, codes, passwords, message texts).
+	FullMessages bool
+}
 
 func (h SimpleLogHandler) TLName(obj interface{}) string {
+	if tl, ok := obj.(TL); ok {
+		if name := NameOf(tl); name != "" {
+			return name
+		}
+	}
 	return reflect.TypeOf(obj).Name()
 }
 
@@ -67,17 +77,24 @@ func (h SimpleLogHandler) AddLevelColor(level LogLevel, text string) string {
 
 func (h SimpleLogHandler) StringifyMessage(isIncoming bool, msg TL, id int64) string {
 	var text string
-	switch x := msg.(type) {
-	case TL_msg_container:
-		names := make([]string, len(x.Items))
-		for i, item := range x.Items {
-			names[i] = h.TLName(item)
+	if h.FullMessages {
+		text = SprintTextShort(msg, 64)
+		if len(text) > 1024 {
+			text = truncateUTF8(text, 1024) + fmt.Sprintf("... (%d)", len(text))
+		}
+	} else {
+		switch x := msg.(type) {
+		case TL_msg_container:
+			names := make([]string, len(x.Items))
+			for i, item := range x.Items {
+				names[i] = h.TLName(item)
+			}
+			text = h.TLName(x) + " -> [" + strings.Join(names, ", ") + "]"
+		case TL_rpc_result:
+			text = h.TLName(x) + " -> " + h.TLName(x.obj)
+		default:
+			text = h.TLName(x)
 		}
-		text = h.TLName(x) + " -> [" + strings.Join(names, ", ") + "]"
-	case TL_rpc_result:
-		text = h.TLName(x) + " -> " + h.TLName(x.obj)
-	default:
-		text = h.TLName(x)
 	}
 	if isIncoming {
 		text = ">>> " + text
@@ -87,6 +104,17 @@ func (h SimpleLogHandler) StringifyMessage(isIncoming bool, msg TL, id int64) st
 	return text
 }
 
+// truncateUTF8 cuts string to at most maxLen bytes without splitting multibyte characters
+func truncateUTF8(text string, maxLen int) string {
+	if len(text) <= maxLen {
+		return text
+	}
+	for maxLen > 0 && !utf8.RuneStart(text[maxLen]) {
+		maxLen--
+	}
+	return text[:maxLen]
+}
+
 func (h SimpleLogHandler) Log(level LogLevel, err error, msg string, args ...interface{}) {
 	text := h.StringifyLog(level, err, msg, args...)
 	text = h.AddLevelPrevix(level, text)
@@ -0,0 +1,41 @@
+package mtproto
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestStringifyMessage(t *testing.T) {
+	msg := TL_auth_signIn{PhoneNumber: "79991234567", PhoneCodeHash: "hash", PhoneCode: "12345"}
+	if text := (SimpleLogHandler{}).StringifyMessage(false, msg, 1); text != "<<< auth.signIn (#1)" {
+		t.Errorf("expected only name by default, got %q", text)
+	}
+	if text := (SimpleLogHandler{FullMessages: true}).StringifyMessage(false, msg, 1); !strings.Contains(text, "12345") {
+		t.Errorf("expected message body, got %q", text)
+	}
+
+	long := TL_messages_sendMessage{Peer: TL_inputPeerSelf{}, Message: strings.Repeat("я", 2000)}
+	text := (SimpleLogHandler{FullMessages: true}).StringifyMessage(true, long, 0)
+	if !utf8.ValidString(text) || len(text) > 1100 || strings.Contains(text, `\x`) {
+		t.Errorf("wrong truncated message (%d bytes): %q", len(text), text)
+	}
+}
+
+func TestTruncateUTF8(t *testing.T) {
+	for _, c := range []struct {
+		text     string
+		maxLen   int
+		expected string
+	}{
+		{"abc", 5, "abc"},
+		{"abcdef", 3, "abc"},
+		{"aяb", 2, "a"},
+		{"aяb", 3, "aя"},
+		{"яя", 1, ""},
+	} {
+		if res := truncateUTF8(c.text, c.maxLen); res != c.expected {
+			t.Errorf("truncateUTF8(%q, %d): expected %q, got %q", c.text, c.maxLen, c.expected, res)
+		}
+	}
+}
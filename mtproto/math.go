@@ -6,6 +6,9 @@ import (
 	sha1lib "crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
 	"math/big"
 	"math/rand"
 	"time"
@@ -53,17 +56,43 @@ func bigIntPaddedBytes(num *big.Int, size int) []byte {
 	return buf
 }
 
-func doRSAencrypt(em []byte) []byte {
+func doRSAencrypt(em []byte, key *rsa.PublicKey) []byte {
 	z := make([]byte, 255)
 	copy(z, em)
 
 	c := new(big.Int)
-	c.Exp(new(big.Int).SetBytes(z), big.NewInt(int64(telegramPublicKey.E)), telegramPublicKey.N)
+	c.Exp(new(big.Int).SetBytes(z), big.NewInt(int64(key.E)), key.N)
 
-	res := make([]byte, 256)
-	copy(res, c.Bytes())
+	return bigIntPaddedBytes(c, 256)
+}
+
+// ParsePublicKey parses server RSA public key in PEM format
+// (like "-----BEGIN RSA PUBLIC KEY-----..." from help.getCdnConfig)
+func ParsePublicKey(pemStr string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemStr))
+	if block == nil {
+		return nil, merry.New("public key: no PEM data found")
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, merry.Errorf("public key: expected RSA key, got %T", key)
+	}
+	return rsaKey, nil
+}
 
-	return res
+// PublicKeyFingerprint returns lower 64 bits of SHA1(n:bytes e:bytes), it is used in req_DH_params
+func PublicKeyFingerprint(key *rsa.PublicKey) int64 {
+	x := NewEncodeBuf(512)
+	x.StringBytes(key.N.Bytes())
+	x.StringBytes(big.NewInt(int64(key.E)).Bytes())
+	return int64(binary.LittleEndian.Uint64(sha1(x.Buf())[12:20]))
 }
 
 func splitPQ(pq *big.Int) (p1, p2 *big.Int) {
@@ -200,6 +229,11 @@ func generateAES(msg_key, auth_key []byte, decode bool) ([]byte, []byte) {
 }
 
 func doAES256IGEencrypt(data, key, iv []byte) ([]byte, error) {
+	return appendAES256IGEencrypted(make([]byte, 0, len(data)), data, key, iv)
+}
+
+// appendAES256IGEencrypted appends encrypted data to dst and returns updated slice
+func appendAES256IGEencrypted(dst, data, key, iv []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
@@ -211,27 +245,30 @@ func doAES256IGEencrypt(data, key, iv []byte) ([]byte, error) {
 		return nil, merry.Errorf("AES256IGE: data not divisible by block size: %d %% %d != 0", len(data), aes.BlockSize)
 	}
 
-	t := make([]byte, aes.BlockSize)
-	x := make([]byte, aes.BlockSize)
-	y := make([]byte, aes.BlockSize)
+	var xBuf [aes.BlockSize]byte
+	x := xBuf[:]
 	copy(x, iv[:aes.BlockSize])
-	copy(y, iv[aes.BlockSize:])
-	encrypted := make([]byte, len(data))
+	y := iv[aes.BlockSize:]
 
-	i := 0
-	for i < len(data) {
+	start := len(dst)
+	for i := 0; i < len(data); i += aes.BlockSize {
+		dst = append(dst, x...) //just reserving space for block
+		t := dst[start+i : start+i+aes.BlockSize]
 		xor(x, data[i:i+aes.BlockSize])
 		block.Encrypt(t, x)
 		xor(t, y)
-		x, y = t, data[i:i+aes.BlockSize]
-		copy(encrypted[i:], t)
-		i += aes.BlockSize
+		copy(x, t)
+		y = data[i : i+aes.BlockSize]
 	}
-
-	return encrypted, nil
+	return dst, nil
 }
 
 func doAES256IGEdecrypt(data, key, iv []byte) ([]byte, error) {
+	return appendAES256IGEdecrypted(make([]byte, 0, len(data)), data, key, iv)
+}
+
+// appendAES256IGEdecrypted appends decrypted data to dst and returns updated slice
+func appendAES256IGEdecrypted(dst, data, key, iv []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
@@ -243,25 +280,22 @@ func doAES256IGEdecrypt(data, key, iv []byte) ([]byte, error) {
 		return nil, merry.Errorf("AES256IGE: data not divisible by block size: %d %% %d != 0", len(data), aes.BlockSize)
 	}
 
-	t := make([]byte, aes.BlockSize)
-	x := make([]byte, aes.BlockSize)
-	y := make([]byte, aes.BlockSize)
-	copy(x, iv[:aes.BlockSize])
+	var yBuf [aes.BlockSize]byte
+	y := yBuf[:]
 	copy(y, iv[aes.BlockSize:])
-	decrypted := make([]byte, len(data))
+	x := iv[:aes.BlockSize]
 
-	i := 0
-	for i < len(data) {
+	start := len(dst)
+	for i := 0; i < len(data); i += aes.BlockSize {
+		dst = append(dst, y...) //just reserving space for block
+		t := dst[start+i : start+i+aes.BlockSize]
 		xor(y, data[i:i+aes.BlockSize])
 		block.Decrypt(t, y)
 		xor(t, x)
-		y, x = t, data[i:i+aes.BlockSize]
-		copy(decrypted[i:], t)
-		i += aes.BlockSize
+		copy(y, t)
+		x = data[i : i+aes.BlockSize]
 	}
-
-	return decrypted, nil
-
+	return dst, nil
 }
 
 func calcInputCheckPasswordSRP(
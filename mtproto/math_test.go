@@ -2,6 +2,8 @@ package mtproto
 
 import (
 	"bytes"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/hex"
 	"math/big"
 	"testing"
@@ -120,3 +122,23 @@ func TestCalcInputCheckPasswordSRP(t *testing.T) {
 		}
 	}
 }
+
+func TestPublicKeyFingerprint(t *testing.T) {
+	if fp := PublicKeyFingerprint(&telegramPublicKey); fp != telegramPublicKey_FP {
+		t.Errorf("wrong fingerprint: %d", fp)
+	}
+
+	pemStr := "-----BEGIN RSA PUBLIC KEY-----\n" +
+		base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PublicKey(&telegramPublicKey)) +
+		"\n-----END RSA PUBLIC KEY-----\n"
+	key, err := ParsePublicKey(pemStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.E != telegramPublicKey.E || key.N.Cmp(telegramPublicKey.N) != 0 {
+		t.Errorf("wrong parsed key")
+	}
+	if _, err := ParsePublicKey("not a key"); err == nil {
+		t.Errorf("expected error")
+	}
+}
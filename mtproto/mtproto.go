@@ -2,6 +2,7 @@ package mtproto
 
 import (
 	cryptoRand "crypto/rand"
+	"crypto/rsa"
 	"fmt"
 	"math/rand"
 	"net"
@@ -16,12 +17,16 @@ import (
 	"golang.org/x/sync/semaphore"
 )
 
-//go:generate go run scheme/generate_tl_schema.go 126 scheme/tl-schema-126.tl tl_schema.go
-//go:generate gofmt -w tl_schema.go
+//go:generate go run ./scheme 126 scheme/tl-schema-126.tl tl_schema.go 122:scheme/tl-schema-122.tl
+//go:generate gofmt -w tl_schema.go tl_schema_json.go tl_schema_text.go tl_schema_accessors.go tl_schema_registry.go tl_schema_test.go
 
 const ROUTINES_COUNT = 4
 
+// max number of events waiting for handler, see pushEvent
+const eventsQueueLimit = 16 * 1024
+
 var ErrNoSessionData = merry.New("no session data")
+var ErrUnsupportedLayer = merry.New("unsupported layer")
 
 type SessionInfo struct {
 	DcID        int32  `json:"dc_id"`
@@ -51,6 +56,11 @@ type MTProto struct {
 	conn         net.Conn
 	log          Logger
 
+	aliasDecodedBytes bool
+	layer             int32
+	publicKeys        []*rsa.PublicKey
+	isCDN             bool //CDN DCs do not need initConnection and config
+
 	// Two queues here.
 	// First (external) has limited size and contains external requests.
 	// Second (internal) is unlimited. Special goroutine transfers messages
@@ -76,6 +86,16 @@ type MTProto struct {
 	handleEvent        func(TL)
 	handleReconnection func() error
 
+	// Received events (updates) are passed to handleEvent one by one in receive order
+	// by eventsRoutine (it is started with first handler and stopped on Disconnect).
+	// Queue does not block since readRoutine must not block: it also receives responses
+	// for requests, including ones sent from event handler.
+	eventsMutex   *sync.Mutex
+	events        []TL
+	eventsSignal  chan struct{}
+	eventsStop    chan struct{}
+	eventsStarted bool
+
 	dcOptions []*TL_dcOption
 }
 
@@ -99,6 +119,18 @@ type MTParams struct {
 	ConnDialer proxy.Dialer
 	SessStore  SessionStore
 	Session    *SessionInfo
+	// If true, bytes fields of received objects (like upload.file.bytes) will
+	// point to internal read buffer instead of being copied. Such buffers are not reused,
+	// so it saves memory copying for large responses (file parts) but costs extra allocation
+	// for small ones. Objects must not be modified in this case.
+	AliasDecodedBytes bool
+	// API layer sent in invokeWithLayer, TL_Layer by default. Requests are always encoded
+	// with current layer constructors, so Connect fails with ErrUnsupportedLayer for any other layer.
+	// Constructors of TL_LegacyLayers (generated with "_layerN" suffix) are used only for decoding.
+	Layer int32
+	// Server RSA public keys used while creating auth key, built-in Telegram key by default.
+	// CDN DCs have their own keys (see help.getCdnConfig and NewCDNConnection).
+	PublicKeys []*rsa.PublicKey
 }
 
 func NewMTProto(appID int32, appHash string) *MTProto {
@@ -134,6 +166,14 @@ func NewMTProtoExt(params MTParams) *MTProto {
 		params.ConnDialer = &net.Dialer{}
 	}
 
+	if params.Layer == 0 {
+		params.Layer = TL_Layer
+	}
+
+	if len(params.PublicKeys) == 0 {
+		params.PublicKeys = []*rsa.PublicKey{&telegramPublicKey}
+	}
+
 	if params.SessStore == nil {
 		var exPath string
 		ex, err := os.Executable()
@@ -153,6 +193,10 @@ func NewMTProtoExt(params MTParams) *MTProto {
 		appCfg:       params.AppConfig,
 		log:          Logger{params.LogHandler},
 
+		aliasDecodedBytes: params.AliasDecodedBytes,
+		layer:             params.Layer,
+		publicKeys:        params.PublicKeys,
+
 		extSendQueue: make(chan *packetToSend, 64),
 		sendQueue:    make(chan *packetToSend, 1024),
 		routinesStop: make(chan struct{}, ROUTINES_COUNT),
@@ -162,11 +206,33 @@ func NewMTProtoExt(params MTParams) *MTProto {
 
 		connectSemaphore: semaphore.NewWeighted(1),
 		reconnSemaphore:  semaphore.NewWeighted(1),
+
+		eventsMutex:  &sync.Mutex{},
+		eventsSignal: make(chan struct{}, 1),
+		eventsStop:   make(chan struct{}),
 	}
 	go m.debugRoutine()
 	return m
 }
 
+// IsLayerSupported reports whether objects of this layer can be decoded,
+// i.e. it is current layer or one of generated legacy ones (requests can be sent only with current layer).
+func IsLayerSupported(layer int32) bool {
+	if layer == TL_Layer {
+		return true
+	}
+	for _, l := range TL_LegacyLayers {
+		if int32(l) == layer {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MTProto) Layer() int32 {
+	return m.layer
+}
+
 func (m *MTProto) InitSessAndConnect() error {
 	if err := m.InitSession(false); err != nil {
 		return merry.Wrap(err)
@@ -210,8 +276,17 @@ func (m *MTProto) SaveSessionLogged() {
 }
 
 func (m *MTProto) DCAddr(dcID int32, ipv6 bool) (string, bool) {
+	return m.dcAddr(dcID, ipv6, false)
+}
+
+// CDNAddr returns address of CDN DC (from dc_options of received config)
+func (m *MTProto) CDNAddr(dcID int32, ipv6 bool) (string, bool) {
+	return m.dcAddr(dcID, ipv6, true)
+}
+
+func (m *MTProto) dcAddr(dcID int32, ipv6, cdn bool) (string, bool) {
 	for _, o := range m.dcOptions {
-		if o.ID == dcID && o.Ipv6 == ipv6 && !o.Cdn {
+		if o.ID == dcID && o.Ipv6 == ipv6 && o.Cdn == cdn {
 			return fmt.Sprintf("%s:%d", o.IpAddress, o.Port), true
 		}
 	}
@@ -219,7 +294,15 @@ func (m *MTProto) DCAddr(dcID int32, ipv6 bool) (string, bool) {
 }
 
 func (m *MTProto) SetEventsHandler(handler func(TL)) {
+	m.eventsMutex.Lock()
+	defer m.eventsMutex.Unlock()
 	m.handleEvent = handler
+	if handler == nil {
+		m.events = nil
+	} else if !m.eventsStarted {
+		m.eventsStarted = true
+		go m.eventsRoutine()
+	}
 }
 
 func (m *MTProto) SetReconnectionHandler(handler func() error) {
@@ -249,10 +332,15 @@ func (m *MTProto) initConection() error {
 		m.encryptionReady = true
 	}
 
+	if m.isCDN {
+		// CDN DCs have no config, and their layer does not matter for upload.getCdnFile
+		return nil
+	}
+
 	// getting connection configs
 	m.log.Debug("connecting: getting config...")
 	x, err := m.sendAndReadDirect(TL_invokeWithLayer{
-		TL_Layer,
+		m.layer,
 		TL_initConnection{
 			Flags:          0,
 			ApiID:          m.appCfg.AppID,
@@ -281,6 +369,9 @@ func (m *MTProto) initConection() error {
 	return nil
 }
 func (m *MTProto) Connect() error {
+	if m.layer != TL_Layer {
+		return ErrUnsupportedLayer.Here().WithMessagef("layer %d is not supported, requests can be encoded only with layer %d", m.layer, TL_Layer)
+	}
 	if !m.connectSemaphore.TryAcquire(1) {
 		m.log.Info("connection already in progress, aborting")
 		return nil
@@ -334,10 +425,15 @@ func (m *MTProto) Reconnect() error {
 	return m.reconnect(0, true)
 }
 
-func (m *MTProto) reconnect(newDcID int32, mayPassToHandler bool) error {
-	m.log.Info("reconnecting: DC %d -> %d", m.session.DcID, newDcID)
+// Disconnect stops connection routines, closes connection and stops passing events to handler
+// (queued ones are dropped). It must be called only once, MTProto must not be used after it.
+func (m *MTProto) Disconnect() error {
+	close(m.eventsStop)
+	return merry.Wrap(m.stopRoutines())
+}
 
-	// stopping routines
+// stopRoutines stops routines started in Connect and closes connection
+func (m *MTProto) stopRoutines() error {
 	m.log.Debug("stopping routines...")
 	for i := 0; i < ROUTINES_COUNT; i++ {
 		m.routinesStop <- struct{}{}
@@ -363,6 +459,15 @@ func (m *MTProto) reconnect(newDcID int32, mayPassToHandler bool) error {
 			empty = true
 		}
 	}
+	return nil
+}
+
+func (m *MTProto) reconnect(newDcID int32, mayPassToHandler bool) error {
+	m.log.Info("reconnecting: DC %d -> %d", m.session.DcID, newDcID)
+
+	if err := m.stopRoutines(); err != nil {
+		return merry.Wrap(err)
+	}
 
 	// saving IDs of messages from msgsByID[],
 	// some of them may not have been sent, so we'll resend them after reconnection
@@ -440,6 +545,9 @@ func (m *MTProto) NewConnection(dcID int32) (*MTProto, error) {
 		Session:    session,
 		LogHandler: m.log.Hnd,
 		ConnDialer: m.connDialer,
+
+		AliasDecodedBytes: m.aliasDecodedBytes,
+		Layer:             m.layer,
 	})
 	if err := newMT.InitSession(encrIsReady); err != nil {
 		return nil, merry.Wrap(err)
@@ -462,6 +570,37 @@ func (m *MTProto) NewConnection(dcID int32) (*MTProto, error) {
 	return newMT, nil
 }
 
+// NewCDNConnection connects to CDN DC (without authorization) using its public keys
+// (from help.getCdnConfig, see ParsePublicKey). Such connection is suitable only for upload.getCdnFile.
+func (m *MTProto) NewCDNConnection(dcID int32, publicKeys []*rsa.PublicKey) (*MTProto, error) {
+	m.log.Info("making new connection to CDN DC %d", dcID)
+	addr, ok := m.CDNAddr(dcID, false)
+	if !ok {
+		return nil, merry.Errorf("unable find address for CDN DC #%d", dcID)
+	}
+	session := &SessionInfo{DcID: dcID, Addr: addr}
+
+	newMT := NewMTProtoExt(MTParams{
+		AppConfig:  m.appCfg,
+		SessStore:  &SessNoopStore{},
+		Session:    session,
+		LogHandler: m.log.Hnd,
+		ConnDialer: m.connDialer,
+
+		AliasDecodedBytes: m.aliasDecodedBytes,
+		Layer:             m.layer,
+		PublicKeys:        publicKeys,
+	})
+	newMT.isCDN = true
+	if err := newMT.InitSession(false); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	if err := newMT.Connect(); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return newMT, nil
+}
+
 func (m *MTProto) Send(msg TLReq) chan TL {
 	resp := make(chan TL, 1)
 	m.extSendQueue <- newPacket(msg, resp)
@@ -846,6 +985,62 @@ func (m *MTProto) queueTransferRoutine() {
 	}
 }
 
+// pushEvent queues event for handler (it is dropped if there is no handler).
+// If handler falls behind for too long, queued events are replaced with updatesTooLong,
+// so handler may fetch missing updates with updates.getDifference.
+func (m *MTProto) pushEvent(event TL) {
+	m.eventsMutex.Lock()
+	if m.handleEvent == nil {
+		m.eventsMutex.Unlock()
+		return
+	}
+	if len(m.events) >= eventsQueueLimit {
+		m.log.Warn("events queue is full, dropping %d event(s)", len(m.events)+1)
+		m.events = []TL{TL_updatesTooLong{}}
+	} else {
+		m.events = append(m.events, event)
+	}
+	m.eventsMutex.Unlock()
+	select {
+	case m.eventsSignal <- struct{}{}:
+	default:
+	}
+}
+
+func (m *MTProto) popEvent() (TL, func(TL), bool) {
+	m.eventsMutex.Lock()
+	defer m.eventsMutex.Unlock()
+	if len(m.events) == 0 {
+		return nil, nil, false
+	}
+	event := m.events[0]
+	m.events[0] = nil
+	m.events = m.events[1:]
+	return event, m.handleEvent, true
+}
+
+func (m *MTProto) eventsRoutine() {
+	for {
+		select {
+		case <-m.eventsStop:
+			return
+		case <-m.eventsSignal:
+		}
+		for {
+			select {
+			case <-m.eventsStop:
+				return
+			default:
+			}
+			event, handle, ok := m.popEvent()
+			if !ok {
+				break
+			}
+			handle(event)
+		}
+	}
+}
+
 // Periodically checks messages in "msgsByID" and warns if they stay there too long
 func (m *MTProto) debugRoutine() {
 	for {
@@ -938,8 +1133,8 @@ func (m *MTProto) process(msgId int64, seqNo int32, dataTL TL, mayPassToHandler
 		m.respAndClearPacketData(data.reqMsgID, data.obj)
 
 	default:
-		if mayPassToHandler && m.handleEvent != nil {
-			go m.handleEvent(dataTL)
+		if mayPassToHandler {
+			m.pushEvent(dataTL)
 		}
 	}
 
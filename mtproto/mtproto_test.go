@@ -0,0 +1,91 @@
+package mtproto
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ansel1/merry"
+)
+
+func TestEventsOrder(t *testing.T) {
+	m := NewMTProtoExt(MTParams{LogHandler: noopLogHandler{}, SessStore: &SessNoopStore{}})
+	const count = 1000
+	var wg sync.WaitGroup
+	wg.Add(count)
+	var received []int32
+	var active int
+	m.SetEventsHandler(func(event TL) {
+		active++
+		if active > 1 {
+			t.Error("handler is called concurrently")
+		}
+		received = append(received, event.(TL_updateShort).Date)
+		active--
+		wg.Done()
+	})
+
+	items := make([]TL_MT_message, count/2)
+	for i := range items {
+		items[i] = TL_MT_message{MsgID: int64(i), Data: TL_updateShort{Date: int32(i)}}
+	}
+	m.process(0, 0, TL_msg_container{Items: items}, true)
+	for i := count / 2; i < count; i++ {
+		m.process(int64(i), 0, TL_updateShort{Date: int32(i)}, true)
+	}
+	wg.Wait()
+
+	for i, date := range received {
+		if date != int32(i) {
+			t.Fatalf("wrong events order at #%d: %d", i, date)
+		}
+	}
+}
+
+func TestEventsQueueLimit(t *testing.T) {
+	m := NewMTProtoExt(MTParams{LogHandler: noopLogHandler{}, SessStore: &SessNoopStore{}})
+	m.process(0, 0, TL_updateShort{}, true)
+	if len(m.events) != 0 || m.eventsStarted {
+		t.Fatal("events should not be queued without handler")
+	}
+
+	m.handleEvent = func(TL) {} //without routine, events stay in queue
+	for i := 0; i < eventsQueueLimit; i++ {
+		m.process(int64(i), 0, TL_updateShort{Date: int32(i)}, true)
+	}
+	if len(m.events) != eventsQueueLimit {
+		t.Fatalf("expected %d queued events, got %d", eventsQueueLimit, len(m.events))
+	}
+	m.process(0, 0, TL_updateShort{}, true)
+	if !reflect.DeepEqual(m.events, []TL{TL_updatesTooLong{}}) {
+		t.Errorf("queue should be replaced with updatesTooLong, got %d events", len(m.events))
+	}
+}
+
+func TestEventsRoutineStop(t *testing.T) {
+	m := NewMTProtoExt(MTParams{LogHandler: noopLogHandler{}, SessStore: &SessNoopStore{}})
+	received := make(chan TL, 10)
+	m.SetEventsHandler(func(event TL) { received <- event })
+	m.process(0, 0, TL_updateShort{Date: 1}, true)
+	if event := <-received; event.(TL_updateShort).Date != 1 {
+		t.Fatalf("wrong event: %#v", event)
+	}
+
+	if err := m.Disconnect(); err != nil {
+		t.Fatal(err)
+	}
+	m.process(0, 0, TL_updateShort{Date: 2}, true)
+	select {
+	case event := <-received:
+		t.Errorf("event received after disconnect: %#v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestUnsupportedLayer(t *testing.T) {
+	m := NewMTProtoExt(MTParams{LogHandler: noopLogHandler{}, SessStore: &SessNoopStore{}, Layer: int32(TL_LegacyLayers[0])})
+	if err := m.Connect(); !merry.Is(err, ErrUnsupportedLayer) {
+		t.Errorf("expected ErrUnsupportedLayer, got %v", err)
+	}
+}
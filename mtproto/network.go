@@ -2,8 +2,9 @@ package mtproto
 
 import (
 	"bytes"
+	"crypto/rsa"
 	"encoding/binary"
-	"fmt"
+	"io"
 	"time"
 
 	"github.com/ansel1/merry"
@@ -18,9 +19,9 @@ func (m *MTProto) send(packet *packetToSend) error {
 		packet.msgID = GenerateMessageId()
 	}
 	m.log.Message(false, packet.msg, packet.msgID)
-	obj := packet.msg.encode()
 
-	x := NewEncodeBuf(256)
+	x := getEncodeBuf()
+	defer putEncodeBuf(x)
 
 	// padding for tcpsize
 	x.Int(0)
@@ -31,7 +32,8 @@ func (m *MTProto) send(packet *packetToSend) error {
 		case TL_ping, TL_msgs_ack:
 			packet.needAck = false
 		}
-		z := NewEncodeBuf(256)
+		z := getEncodeBuf()
+		defer putEncodeBuf(z)
 		z.Long(m.session.ServerSalt)
 		z.Long(m.session.sessionId)
 		z.Long(packet.msgID)
@@ -44,22 +46,25 @@ func (m *MTProto) send(packet *packetToSend) error {
 			m.lastSeqNo += 2
 		}
 		z.Int(packet.seqNo)
-		z.Int(int32(len(obj)))
-		z.Bytes(obj)
+		z.Int(0) //object length, will be set after encoding
+		packet.msg.EncodeTo(z)
+		objLen := len(z.buf) - 32
+		binary.LittleEndian.PutUint32(z.buf[28:], uint32(objLen))
 
 		msgKey := sha1(z.buf)[4:20]
 		aesKey, aesIV := generateAES(msgKey, m.session.AuthKey, false)
 
-		y := make([]byte, len(z.buf)+((16-(len(obj)%16))&15))
-		copy(y, z.buf)
-		encryptedData, err := doAES256IGEencrypt(y, aesKey, aesIV)
-		if err != nil {
-			return merry.Wrap(err)
+		for i := (16 - objLen%16) & 15; i > 0; i-- {
+			z.buf = append(z.buf, 0)
 		}
 
 		x.Bytes(m.session.AuthKeyHash)
 		x.Bytes(msgKey)
-		x.Bytes(encryptedData)
+		var err error
+		x.buf, err = appendAES256IGEencrypted(x.buf, z.buf, aesKey, aesIV)
+		if err != nil {
+			return merry.Wrap(err)
+		}
 
 		if packet.resp != nil || packet.needAck {
 			m.mutex.Lock()
@@ -69,69 +74,70 @@ func (m *MTProto) send(packet *packetToSend) error {
 	} else {
 		x.Long(0)
 		x.Long(packet.msgID)
-		x.Int(int32(len(obj)))
-		x.Bytes(obj)
+		x.Int(0) //object length, will be set after encoding
+		packet.msg.EncodeTo(x)
+		binary.LittleEndian.PutUint32(x.buf[20:], uint32(len(x.buf)-24))
 	}
 
 	// minus padding
 	size := len(x.buf)/4 - 1
 
+	buf := x.buf
 	if size < 127 {
-		x.buf[3] = byte(size)
-		x.buf = x.buf[3:]
+		buf[3] = byte(size)
+		buf = buf[3:]
 	} else {
-		binary.LittleEndian.PutUint32(x.buf, uint32(size<<8|127))
+		binary.LittleEndian.PutUint32(buf, uint32(size<<8|127))
 	}
-	if _, err := m.conn.Write(x.buf); err != nil {
+	if _, err := m.conn.Write(buf); err != nil {
 		return merry.Wrap(err)
 	}
 	return nil
 }
 
 func (m *MTProto) read() (TL, error) {
-	var err error
-	var n int
 	var size int
-	var data TL
+	var header [4]byte
 
-	err = m.conn.SetReadDeadline(time.Now().Add(90 * time.Second))
+	err := m.conn.SetReadDeadline(time.Now().Add(90 * time.Second))
 	if err != nil {
 		return nil, merry.Wrap(err)
 	}
-	b := make([]byte, 1)
-	n, err = m.conn.Read(b)
-	if err != nil {
+	if _, err := io.ReadFull(m.conn, header[:1]); err != nil {
 		return nil, merry.Wrap(err)
 	}
 
-	if b[0] < 127 {
-		size = int(b[0]) << 2
+	if header[0] < 127 {
+		size = int(header[0]) << 2
 	} else {
-		b := make([]byte, 3)
-		n, err = m.conn.Read(b)
-		if err != nil {
+		if _, err := io.ReadFull(m.conn, header[1:4]); err != nil {
 			return nil, merry.Wrap(err)
 		}
-		size = (int(b[0]) | int(b[1])<<8 | int(b[2])<<16) << 2
+		size = (int(header[1]) | int(header[2])<<8 | int(header[3])<<16) << 2
 	}
 
-	left := size
-	buf := make([]byte, size)
-	for left > 0 {
-		n, err = m.conn.Read(buf[size-left:])
-		if err != nil {
-			return nil, merry.Wrap(err)
-		}
-		left -= n
+	buf := getFrameBuf(size)
+	defer putFrameBuf(buf)
+	if _, err := io.ReadFull(m.conn, buf); err != nil {
+		return nil, merry.Wrap(err)
 	}
 
 	if size == 4 {
 		return nil, merry.Errorf("Server response error: %d", int32(binary.LittleEndian.Uint32(buf)))
 	}
+	return m.decodeFrame(buf)
+}
 
+// decodeFrame decrypts (if needed) and decodes frame data (without length header).
+// Frame buffer may be reused after return.
+func (m *MTProto) decodeFrame(buf []byte) (TL, error) {
+	var data TL
 	dbuf := NewDecodeBuf(buf)
 
 	authKeyHash := dbuf.Bytes(8)
+	if dbuf.err != nil {
+		return nil, merry.Wrap(dbuf.err)
+	}
 	if binary.LittleEndian.Uint64(authKeyHash) == 0 {
 		m.msgId = dbuf.Long()
 		messageLen := dbuf.Int()
@@ -146,39 +152,39 @@ func (m *MTProto) read() (TL, error) {
 		}
 	} else {
 		msgKey := dbuf.Bytes(16)
-		encryptedData := dbuf.Bytes(dbuf.size - 24)
+		if dbuf.err != nil {
+			return nil, merry.Wrap(dbuf.err)
+		}
 		aesKey, aesIV := generateAES(msgKey, m.session.AuthKey, true)
-		x, err := doAES256IGEdecrypt(encryptedData, aesKey, aesIV)
+		x, err := appendAES256IGEdecrypted(getFrameBuf(len(buf) - 24)[:0], buf[24:], aesKey, aesIV)
 		if err != nil {
 			return nil, merry.Wrap(err)
 		}
-		dbuf = NewDecodeBuf(x)
+		if m.aliasDecodedBytes {
+			dbuf = NewDecodeBufAliased(x)
+		} else {
+			dbuf = NewDecodeBuf(x)
+		}
+		defer func() {
+			// decoded objects may refer to decrypted data, so buffer can be reused only if they don't
+			if !dbuf.Aliased() {
+				putFrameBuf(x)
+			}
+		}()
 		_ = dbuf.Long() // salt
 		_ = dbuf.Long() // session_id
 		m.msgId = dbuf.Long()
 		m.seqNo = dbuf.Int()
 		messageLen := dbuf.Int()
-		if int(messageLen) > dbuf.size-32 {
-			return nil, merry.Errorf("Message len: %d (need <= %d)", messageLen, dbuf.size-32)
-		}
-		// DEBUG vvv
 		if dbuf.err != nil {
-			panic(dbuf.err)
-		}
-		if int(messageLen)+32 > len(dbuf.buf) {
-			panic(fmt.Sprintf("AHTUNG!!! %d(%d) + 32 > %d", messageLen, int(messageLen), len(dbuf.buf)))
+			return nil, merry.Wrap(dbuf.err)
 		}
-		hash := sha1(dbuf.buf[0 : 32+messageLen])
-		if len(hash) < 20 {
-			panic(fmt.Sprintf("AHTUNG!!! %d < 20", len(hash)))
+		if messageLen < 0 || int(messageLen) > dbuf.size-32 {
+			return nil, merry.Errorf("Message len: %d (need <= %d)", messageLen, dbuf.size-32)
 		}
-		if !bytes.Equal(hash[4:20], msgKey) {
+		if !bytes.Equal(sha1(dbuf.buf[0 : 32+messageLen])[4:20], msgKey) {
 			return nil, merry.New("Wrong msg_key")
 		}
-		// if !bytes.Equal(sha1(dbuf.buf[0 : 32+messageLen])[4:20], msgKey) {
-		// 	return nil, merry.New("Wrong msg_key")
-		// }
-		// DEBUG ^^^
 
 		data = m.decodeMessage(dbuf, nil)
 		if dbuf.err != nil {
@@ -218,30 +224,36 @@ func (m *MTProto) makeAuthKey() error {
 	if !bytes.Equal(nonceFirst, res.Nonce) {
 		return merry.New("Handshake: Wrong nonce")
 	}
-	found := false
-	for _, b := range res.ServerPublicKeyFingerprints {
-		if b == telegramPublicKey_FP {
-			found = true
+	var publicKey *rsa.PublicKey
+	var publicKeyFP int64
+	for _, fp := range res.ServerPublicKeyFingerprints {
+		for _, key := range m.publicKeys {
+			if PublicKeyFingerprint(key) == fp {
+				publicKey, publicKeyFP = key, fp
+				break
+			}
+		}
+		if publicKey != nil {
 			break
 		}
 	}
-	if !found {
-		return merry.New("Handshake: No fingerprint")
+	if publicKey == nil {
+		return merry.Errorf("Handshake: No fingerprint (server has %v)", res.ServerPublicKeyFingerprints)
 	}
 
 	// (encoding) p_q_inner_data
 	p, q := splitPQ(str2big(res.Pq))
 	nonceSecond := GenerateNonce(32)
 	nonceServer := res.ServerNonce
-	innerData1 := (TL_p_q_inner_data{res.Pq, big2str(p), big2str(q), nonceFirst, nonceServer, nonceSecond}).encode()
+	innerData1 := Encode(TL_p_q_inner_data{res.Pq, big2str(p), big2str(q), nonceFirst, nonceServer, nonceSecond})
 
 	x = make([]byte, 255)
 	copy(x[0:], sha1(innerData1))
 	copy(x[20:], innerData1)
-	encryptedData1 := doRSAencrypt(x)
+	encryptedData1 := doRSAencrypt(x, publicKey)
 
 	// (send) req_DH_params
-	err = m.justSend(TL_req_DH_params{nonceFirst, nonceServer, big2str(p), big2str(q), telegramPublicKey_FP, string(encryptedData1)})
+	err = m.justSend(TL_req_DH_params{nonceFirst, nonceServer, big2str(p), big2str(q), publicKeyFP, string(encryptedData1)})
 	if err != nil {
 		return merry.Wrap(err)
 	}
@@ -324,7 +336,7 @@ func (m *MTProto) makeAuthKey() error {
 	m.session.ServerSalt = int64(binary.LittleEndian.Uint64(saltBuf))
 
 	// (encoding) client_DH_inner_data
-	innerData2 := (TL_client_DH_inner_data{nonceFirst, nonceServer, 0, big2str(g_b)}).encode()
+	innerData2 := Encode(TL_client_DH_inner_data{nonceFirst, nonceServer, 0, big2str(g_b)})
 	x = make([]byte, 20+len(innerData2)+(16-((20+len(innerData2))%16))&15)
 	copy(x[0:], sha1(innerData2))
 	copy(x[20:], innerData2)
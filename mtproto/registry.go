@@ -0,0 +1,148 @@
+package mtproto
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/ansel1/merry"
+)
+
+// FieldInfo describes one field of TL combinator.
+type FieldInfo struct {
+	Name    string //schema name, like "offset_id"
+	GoName  string //struct field name, like "OffsetID"
+	Type    string //schema type, like "int", "InputPeer", "Vector<MessageEntity>", "#" or "true"
+	FlagBit int    //bit number in flags field or -1 if field is not optional
+}
+
+func (f FieldInfo) IsFlag() bool {
+	return f.FlagBit >= 0
+}
+
+// TypeInfo describes TL combinator (constructor or function) and its Go type.
+type TypeInfo struct {
+	CRC        uint32
+	Name       string //schema name, like "messages.getHistory"
+	Type       string //constructor type or function result type, like "messages.Messages"
+	Layer      int    //0 for current layer, N for constructors generated from older layer schema (named like "message_layer120")
+	IsFunction bool
+	Fields     []FieldInfo
+	New        func() TL //returns zero value of Go type
+	// Decode is used for constructors missing in generated schema (registered at runtime).
+	// It is called after constructor ID is read. May be nil for generated types.
+	Decode func(*DecodeBuf) TL
+	GoType reflect.Type
+}
+
+type registry struct {
+	mutex    sync.RWMutex
+	byCRC    map[uint32]*TypeInfo
+	byName   map[string]*TypeInfo
+	byGoType map[reflect.Type]*TypeInfo
+}
+
+var typesRegistry = &registry{
+	byCRC:    make(map[uint32]*TypeInfo),
+	byName:   make(map[string]*TypeInfo),
+	byGoType: make(map[reflect.Type]*TypeInfo),
+}
+
+func registerGenerated(info *TypeInfo) {
+	if err := Register(info); err != nil {
+		panic(err)
+	}
+}
+
+// Register adds constructor to runtime registry. It may be used to decode constructors
+// that are missing in generated schema (Decode func must be set in this case).
+// Already used CRC or name causes error. Go type may be shared by several constructors,
+// first registered one will be used for NameOf and Fields.
+func Register(info *TypeInfo) error {
+	if info.Name == "" || info.New == nil {
+		return merry.Errorf("constructor name and New() are required (CRC %08x)", info.CRC)
+	}
+	if info.GoType == nil {
+		info.GoType = reflect.TypeOf(info.New())
+	}
+
+	r := typesRegistry
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if prev, ok := r.byCRC[info.CRC]; ok {
+		return merry.Errorf("constructor %08x is already registered as %s", info.CRC, prev.Name)
+	}
+	if prev, ok := r.byName[info.Name]; ok {
+		return merry.Errorf("constructor %s is already registered with CRC %08x", info.Name, prev.CRC)
+	}
+	r.byCRC[info.CRC] = info
+	r.byName[info.Name] = info
+	if _, ok := r.byGoType[info.GoType]; !ok {
+		r.byGoType[info.GoType] = info
+	}
+	return nil
+}
+
+// unregister removes constructor registered at runtime (used by tests)
+func unregister(crc uint32) {
+	r := typesRegistry
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	info, ok := r.byCRC[crc]
+	if !ok {
+		return
+	}
+	delete(r.byCRC, crc)
+	delete(r.byName, info.Name)
+	if r.byGoType[info.GoType] == info {
+		delete(r.byGoType, info.GoType)
+	}
+}
+
+// TypeByCRC returns info for constructor ID or nil if it is unknown.
+func TypeByCRC(crc uint32) *TypeInfo {
+	typesRegistry.mutex.RLock()
+	defer typesRegistry.mutex.RUnlock()
+	return typesRegistry.byCRC[crc]
+}
+
+// TypeByName returns info for schema name (like "messages.getHistory") or nil if it is unknown.
+func TypeByName(name string) *TypeInfo {
+	typesRegistry.mutex.RLock()
+	defer typesRegistry.mutex.RUnlock()
+	return typesRegistry.byName[name]
+}
+
+// TypeOf returns info for TL object or nil if its type is not registered.
+func TypeOf(obj TL) *TypeInfo {
+	if obj == nil {
+		return nil
+	}
+	typesRegistry.mutex.RLock()
+	defer typesRegistry.mutex.RUnlock()
+	return typesRegistry.byGoType[reflect.TypeOf(obj)]
+}
+
+// NewByName returns zero value for schema name (like "messages.getHistory").
+func NewByName(name string) (TL, error) {
+	info := TypeByName(name)
+	if info == nil {
+		return nil, merry.Errorf("unknown constructor: %s", name)
+	}
+	return info.New(), nil
+}
+
+// NameOf returns schema name of TL object or empty string if its type is not registered.
+func NameOf(obj TL) string {
+	if info := TypeOf(obj); info != nil {
+		return info.Name
+	}
+	return ""
+}
+
+// Fields returns fields info of TL object or nil if its type is not registered.
+func Fields(obj TL) []FieldInfo {
+	if info := TypeOf(obj); info != nil {
+		return info.Fields
+	}
+	return nil
+}
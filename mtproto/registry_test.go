@@ -0,0 +1,69 @@
+package mtproto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegistryLookup(t *testing.T) {
+	obj, err := NewByName("messages.getHistory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := obj.(TL_messages_getHistory); !ok {
+		t.Fatalf("unexpected type %T", obj)
+	}
+	info := TypeOf(obj)
+	if info == nil || info.CRC != CRC_messages_getHistory || !info.IsFunction || info.Type != "messages.Messages" {
+		t.Fatalf("wrong info: %#v", info)
+	}
+	if NameOf(TL_inputPeerSelf{}) != "inputPeerSelf" {
+		t.Errorf("wrong name: %s", NameOf(TL_inputPeerSelf{}))
+	}
+	if TypeByCRC(CRC_inputPeerSelf).IsFunction {
+		t.Error("inputPeerSelf is not a function")
+	}
+
+	fields := Fields(TL_message{})
+	var fromID FieldInfo
+	for _, f := range fields {
+		if f.Name == "from_id" {
+			fromID = f
+		}
+	}
+	if !reflect.DeepEqual(fromID, FieldInfo{"from_id", "FromID", "Peer", 8}) {
+		t.Errorf("wrong from_id field info: %#v", fromID)
+	}
+}
+
+func TestRegistryRuntimeConstructor(t *testing.T) {
+	const crc = 0x0badc0de
+	err := Register(&TypeInfo{
+		CRC:  crc,
+		Name: "test.oldInputPeerUser",
+		Type: "InputPeer",
+		New:  func() TL { return TL_inputPeerUser{} },
+		Decode: func(m *DecodeBuf) TL {
+			return TL_inputPeerUser{UserID: m.Int()}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { unregister(crc) })
+	if err := Register(&TypeInfo{CRC: crc, Name: "test.other", New: func() TL { return TL_inputPeerSelf{} }}); err == nil {
+		t.Error("expected error for duplicate CRC")
+	}
+
+	buf := NewEncodeBuf(8)
+	buf.UInt(crc)
+	buf.Int(123)
+	obj := NewDecodeBuf(buf.buf).Object()
+	if !reflect.DeepEqual(obj, TL_inputPeerUser{UserID: 123}) {
+		t.Errorf("wrong decoded object: %#v", obj)
+	}
+	// generated type keeps its own name
+	if NameOf(TL_inputPeerUser{}) != "inputPeerUser" {
+		t.Errorf("wrong name: %s", NameOf(TL_inputPeerUser{}))
+	}
+}
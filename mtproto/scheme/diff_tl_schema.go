@@ -0,0 +1,252 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Schema diff: go run ./scheme diff old.tl new.tl [module_dir]
+//
+// Prints added, removed and changed constructors and functions (ID, result type and fields changes).
+// If module_dir is set, also prints Go code locations that use changed types and fields.
+
+type combinatorChange struct {
+	old, new *Combinator
+	lines    []string
+	// Go names of fields that were removed or changed type/flag
+	changedFields []string
+}
+
+type schemaDiff struct {
+	added   []*Combinator
+	removed []*Combinator
+	changed []*combinatorChange
+}
+
+func fieldDescr(f Field) string {
+	if f.isFlag() {
+		return fmt.Sprintf("%s:flags.%d?%s", f.tlName, f.flagBit, f.tlTypeName)
+	}
+	return f.tlName + ":" + f.tlTypeName
+}
+
+func combinatorDescr(c *Combinator) string {
+	parts := []string{fmt.Sprintf("%s#%08x", c.tlName, c.name)}
+	for _, f := range c.fields {
+		parts = append(parts, fieldDescr(f))
+	}
+	return strings.Join(parts, " ") + " = " + c.tlTypeName
+}
+
+func diffCombinator(old, new *Combinator) *combinatorChange {
+	ch := &combinatorChange{old: old, new: new}
+	if old.name != new.name {
+		ch.lines = append(ch.lines, fmt.Sprintf("ID: %08x -> %08x", old.name, new.name))
+	}
+	if old.tlTypeName != new.tlTypeName {
+		ch.lines = append(ch.lines, fmt.Sprintf("type: %s -> %s", old.tlTypeName, new.tlTypeName))
+	}
+
+	newFields := make(map[string]Field)
+	for _, f := range new.fields {
+		newFields[f.tlName] = f
+	}
+	oldFields := make(map[string]Field)
+	for _, f := range old.fields {
+		oldFields[f.tlName] = f
+		nf, ok := newFields[f.tlName]
+		if !ok {
+			ch.lines = append(ch.lines, "- field "+fieldDescr(f))
+			ch.changedFields = append(ch.changedFields, normalizeAttr(f.name))
+			continue
+		}
+		if f.tlTypeName != nf.tlTypeName || f.flagBit != nf.flagBit {
+			ch.lines = append(ch.lines, fmt.Sprintf("~ field %s -> %s", fieldDescr(f), fieldDescr(nf)))
+			ch.changedFields = append(ch.changedFields, normalizeAttr(f.name))
+		}
+	}
+	for _, f := range new.fields {
+		if _, ok := oldFields[f.tlName]; !ok {
+			ch.lines = append(ch.lines, "+ field "+fieldDescr(f))
+		}
+	}
+	if len(ch.lines) == 0 {
+		return nil
+	}
+	return ch
+}
+
+func diffSchemas(oldCombinators, newCombinators []*Combinator) *schemaDiff {
+	key := func(c *Combinator) string {
+		if c.isFunction {
+			return "fn:" + c.tlName
+		}
+		return c.tlName
+	}
+	oldByKey := make(map[string]*Combinator)
+	for _, c := range oldCombinators {
+		oldByKey[key(c)] = c
+	}
+	newByKey := make(map[string]*Combinator)
+	for _, c := range newCombinators {
+		newByKey[key(c)] = c
+	}
+
+	diff := &schemaDiff{}
+	for _, c := range newCombinators {
+		old, ok := oldByKey[key(c)]
+		if !ok {
+			diff.added = append(diff.added, c)
+		} else if ch := diffCombinator(old, c); ch != nil {
+			diff.changed = append(diff.changed, ch)
+		}
+	}
+	for _, c := range oldCombinators {
+		if _, ok := newByKey[key(c)]; !ok {
+			diff.removed = append(diff.removed, c)
+		}
+	}
+	return diff
+}
+
+func writeSchemaDiff(w io.Writer, diff *schemaDiff) {
+	for _, isFunction := range []bool{false, true} {
+		title := "Constructors"
+		if isFunction {
+			title = "Methods"
+		}
+		fmt.Fprintf(w, "## %s\n\n", title)
+		count := 0
+		for _, c := range diff.added {
+			if c.isFunction == isFunction {
+				fmt.Fprintf(w, "+ %s\n", combinatorDescr(c))
+				count++
+			}
+		}
+		for _, c := range diff.removed {
+			if c.isFunction == isFunction {
+				fmt.Fprintf(w, "- %s\n", combinatorDescr(c))
+				count++
+			}
+		}
+		for _, ch := range diff.changed {
+			if ch.new.isFunction == isFunction {
+				fmt.Fprintf(w, "~ %s\n", ch.new.tlName)
+				for _, line := range ch.lines {
+					fmt.Fprintf(w, "    %s\n", line)
+				}
+				count++
+			}
+		}
+		if count == 0 {
+			fmt.Fprintf(w, "no changes\n")
+		}
+		fmt.Fprintf(w, "\n")
+	}
+}
+
+type callSite struct {
+	pos  token.Position
+	text string
+}
+
+// findCallSites searches Go files in dir for usages of changed and removed types
+// (TL_xxx identifiers) and fields (selectors and composite literal keys).
+// Fields are matched only by name (without type checking), so some results may be false positives.
+func findCallSites(dir string, diff *schemaDiff) ([]callSite, error) {
+	typeReasons := make(map[string]string)
+	fieldReasons := make(map[string][]string)
+	for _, c := range diff.removed {
+		typeReasons["TL_"+c.id] = "removed " + c.tlName
+	}
+	for _, ch := range diff.changed {
+		typeReasons["TL_"+ch.old.id] = "changed " + ch.old.tlName
+		for _, name := range ch.changedFields {
+			fieldReasons[name] = append(fieldReasons[name], ch.old.tlName)
+		}
+	}
+
+	var sites []callSite
+	fset := token.NewFileSet()
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name := info.Name()
+		if info.IsDir() {
+			if path != dir && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") || strings.HasPrefix(name, "tl_schema") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.Ident:
+				if reason, ok := typeReasons[n.Name]; ok {
+					sites = append(sites, callSite{fset.Position(n.Pos()), n.Name + ": " + reason})
+				}
+			case *ast.SelectorExpr:
+				if owners, ok := fieldReasons[n.Sel.Name]; ok {
+					sites = append(sites, callSite{fset.Position(n.Sel.Pos()),
+						"." + n.Sel.Name + ": field changed in " + strings.Join(owners, ", ")})
+				}
+			case *ast.KeyValueExpr:
+				if key, ok := n.Key.(*ast.Ident); ok {
+					if owners, ok := fieldReasons[key.Name]; ok {
+						sites = append(sites, callSite{fset.Position(key.Pos()),
+							key.Name + ": field changed in " + strings.Join(owners, ", ")})
+					}
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	sort.SliceStable(sites, func(i, j int) bool {
+		a, b := sites[i].pos, sites[j].pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Line < b.Line
+	})
+	return sites, err
+}
+
+func diffMain(args []string) {
+	if len(args) != 2 && len(args) != 3 {
+		println("Usage: " + os.Args[0] + " diff old_schema.tl new_schema.tl [module_dir]")
+		os.Exit(2)
+	}
+	diff := diffSchemas(parseTLSchema(args[0]), parseTLSchema(args[1]))
+	fmt.Printf("# Schema diff: %s -> %s\n\n", args[0], args[1])
+	writeSchemaDiff(os.Stdout, diff)
+
+	if len(args) == 3 {
+		sites, err := findCallSites(args[2], diff)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("## Usages in %s\n\n", args[2])
+		for _, site := range sites {
+			fmt.Printf("%s:%d: %s\n", site.pos.Filename, site.pos.Line, site.text)
+		}
+		if len(sites) == 0 {
+			fmt.Printf("not found\n")
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func combinatorNames(combinators []*Combinator) []string {
+	var names []string
+	for _, c := range combinators {
+		names = append(names, c.tlName)
+	}
+	return names
+}
+
+func TestDiffSchemas(t *testing.T) {
+	// test schemas have fake IDs, so generator warns about wrong CRCs
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	base := `
+peerUser#00000001 user_id:int = Peer;
+user#00000002 flags:# id:int bot:flags.1?true name:flags.2?string = User;
+---functions---
+users.getUser#00000010 id:int = User;
+`
+	for _, c := range []struct {
+		name     string
+		new      string
+		added    []string
+		removed  []string
+		changes  map[string][]string
+		fields   map[string][]string
+		contains []string
+	}{
+		{
+			name:     "same",
+			new:      base,
+			contains: []string{"## Constructors\n\nno changes\n\n## Methods\n\nno changes\n"},
+		},
+		{
+			name: "added and removed",
+			new: `
+peerUser#00000001 user_id:int = Peer;
+peerChat#00000003 chat_id:int = Peer;
+user#00000002 flags:# id:int bot:flags.1?true name:flags.2?string = User;
+---functions---
+users.getUsers#00000011 id:int = User;
+`,
+			added:   []string{"peerChat", "users.getUsers"},
+			removed: []string{"users.getUser"},
+			contains: []string{
+				"## Constructors\n\n+ peerChat#00000003 chat_id:int = Peer\n",
+				"## Methods\n\n+ users.getUsers#00000011 id:int = User\n- users.getUser#00000010 id:int = User\n",
+			},
+		},
+		{
+			name: "changed",
+			new: `
+peerUser#00000004 user_id:long = Peer;
+user#00000005 flags:# id:int bot:flags.3?true about:flags.4?string = User;
+---functions---
+users.getUser#00000010 id:int = Vector<User>;
+`,
+			changes: map[string][]string{
+				"peerUser": {"ID: 00000001 -> 00000004", "~ field user_id:int -> user_id:long"},
+				"user": {
+					"ID: 00000002 -> 00000005",
+					"~ field bot:flags.1?true -> bot:flags.3?true",
+					"- field name:flags.2?string",
+					"+ field about:flags.4?string",
+				},
+				"users.getUser": {"type: User -> Vector<User>"},
+			},
+			fields: map[string][]string{
+				"peerUser": {"UserID"},
+				"user":     {"Bot", "Name"},
+			},
+			contains: []string{"~ user\n    ID: 00000002 -> 00000005\n", "## Methods\n\n~ users.getUser\n    type: User -> Vector<User>\n"},
+		},
+	} {
+		diff := diffSchemas(parseTLSchemaText(base), parseTLSchemaText(c.new))
+		if names := combinatorNames(diff.added); !reflect.DeepEqual(names, c.added) {
+			t.Errorf("%s: expected added %v, got %v", c.name, c.added, names)
+		}
+		if names := combinatorNames(diff.removed); !reflect.DeepEqual(names, c.removed) {
+			t.Errorf("%s: expected removed %v, got %v", c.name, c.removed, names)
+		}
+		if len(diff.changed) != len(c.changes) {
+			t.Errorf("%s: expected %d changes, got %d", c.name, len(c.changes), len(diff.changed))
+		}
+		for _, ch := range diff.changed {
+			if !reflect.DeepEqual(ch.lines, c.changes[ch.new.tlName]) {
+				t.Errorf("%s: %s: expected changes %q, got %q", c.name, ch.new.tlName, c.changes[ch.new.tlName], ch.lines)
+			}
+			if !reflect.DeepEqual(ch.changedFields, c.fields[ch.new.tlName]) {
+				t.Errorf("%s: %s: expected changed fields %v, got %v", c.name, ch.new.tlName, c.fields[ch.new.tlName], ch.changedFields)
+			}
+		}
+
+		buf := &bytes.Buffer{}
+		writeSchemaDiff(buf, diff)
+		for _, text := range c.contains {
+			if !strings.Contains(buf.String(), text) {
+				t.Errorf("%s: expected output to contain %q:\n%s", c.name, text, buf.String())
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -27,9 +28,11 @@ import (
 //  invokeWithLayer#da9b0d0d {X:Type} layer:int query:!X = X;
 
 type Field struct {
-	name     string
-	typeName string
-	flagBit  int
+	name       string
+	tlName     string
+	typeName   string
+	tlTypeName string
+	flagBit    int
 }
 
 func (f Field) isFlag() bool {
@@ -38,10 +41,13 @@ func (f Field) isFlag() bool {
 
 type Combinator struct {
 	id         string
+	tlName     string
 	name       uint32
 	fields     []Field
 	typeName   string
+	tlTypeName string
 	isFunction bool
+	layer      int //0 for current layer, N for constructors from older layer schemas
 }
 
 func (c Combinator) hasFlags() bool {
@@ -112,7 +118,7 @@ func makeField(name, typeName string) Field {
 			log.Fatalf("parsing %s: %s", typeName, err)
 		}
 	}
-	return Field{normalize(name), normalize(typeName), flagBit}
+	return Field{normalize(name), name, normalize(typeName), typeName, flagBit}
 }
 
 var fieldsFixForCrcRegexp = regexp.MustCompile(`([Vv])ector<(.*?)>`)
@@ -153,16 +159,27 @@ func parseTLSchema(fpath string) []*Combinator {
 	if err != nil {
 		log.Fatal(err)
 	}
+	return parseTLSchemaText(string(data))
+}
 
+func parseTLSchemaText(text string) []*Combinator {
 	// processing constructors
 	combinators := []*Combinator{}
 	isFunction := false
 
 	lineRegexp := regexp.MustCompile(`^(.*?)(#[a-f0-9]*)? (.*)= (.*);$`)
 	fieldRegexp := regexp.MustCompile(`^(.*?):(.*)$`)
-	for lineNum, line := range strings.Split(string(data), "\n") {
+	for lineNum, line := range strings.Split(text, "\n") {
 		line = strings.TrimSpace(line)
-		if line == "" || strings.HasPrefix(line, "//") {
+		if strings.HasPrefix(line, "//") {
+			// schema from core.telegram.org is MTProto schema (ending with functions) followed by API schema
+			// which starts with types without "---types---" line
+			if strings.Contains(line, "Main application API") {
+				isFunction = false
+			}
+			continue
+		}
+		if line == "" {
 			continue
 		}
 		if line == "---functions---" {
@@ -198,6 +215,7 @@ func parseTLSchema(fpath string) []*Combinator {
 			log.Printf("WARN: line %d: wrong crc32 sum, expected %08x: %s", lineNum+1, crc32sum, line)
 		}
 
+		tlName, tlTypeName := id, typeName
 		id = normalize(id)
 		typeName = normalize(typeName)
 
@@ -218,14 +236,574 @@ func parseTLSchema(fpath string) []*Combinator {
 			fields = append(fields, makeField(name, typeName))
 		}
 
-		combinators = append(combinators, &Combinator{id, name, fields, typeName, isFunction})
+		combinators = append(combinators, &Combinator{id, tlName, name, fields, typeName, tlTypeName, isFunction, 0})
 	}
 	return combinators
 }
 
+type writeFunc func(format string, a ...interface{})
+
+func createOutFile(fpath string) (*os.File, writeFunc) {
+	outFile, err := os.Create(fpath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	write := func(format string, a ...interface{}) {
+		if _, err := fmt.Fprintf(outFile, format, a...); err != nil {
+			log.Fatal(err)
+		}
+	}
+	return outFile, write
+}
+
+// siblingFPath makes path for additional generated file: tl_schema.go -> tl_schema_json.go
+func siblingFPath(fpath, suffix string) string {
+	return strings.TrimSuffix(fpath, ".go") + "_" + suffix + ".go"
+}
+
+func isObjectVector(typeName string) bool {
+	var inner string
+	n, _ := fmt.Sscanf(typeName, "Vector<%s", &inner)
+	return n == 1
+}
+
+// bareVectorItem returns item type of bare vector (without vector constructor ID), like "vector<IpPort>".
+// Items of lowercase type (like "vector<future_salt>") are bare objects too (without constructor IDs).
+func bareVectorItem(typeName string) (string, bool) {
+	if !strings.HasPrefix(typeName, "vector<") || !strings.HasSuffix(typeName, ">") {
+		return "", false
+	}
+	return typeName[len("vector<") : len(typeName)-1], true
+}
+
+func isBareItem(itemType string) bool {
+	return itemType != "" && itemType[0] >= 'a' && itemType[0] <= 'z'
+}
+
+// jsonField returns field type for JSON shadow struct and expressions
+// for converting struct field value to and from it.
+func jsonField(t Field) (_type, toJSON, fromJSON string) {
+	attrName := normalizeAttr(t.name)
+	e, v := "e."+attrName, "v."+attrName
+	switch t.typeName {
+	case "true":
+		return "bool", e, v
+	case "int", "#":
+		return "int32", e, v
+	case "long":
+		return "int64", e, v
+	case "int128", "int256", "bytes":
+		return "[]byte", e, v
+	case "string":
+		return "string", e, v
+	case "double":
+		return "float64", e, v
+	case "Vector<int>":
+		return "[]int32", e, v
+	case "Vector<long>":
+		return "jsonLongs", "jsonLongs(" + e + ")", "[]int64(" + v + ")"
+	case "Vector<string>":
+		return "[]string", e, v
+	case "Vector<double>":
+		return "[]float64", e, v
+	case "Vector<bytes>":
+		return "[][]byte", e, v
+	}
+	if _, ok := bareVectorItem(t.typeName); ok || isObjectVector(t.typeName) {
+		return "jsonObjects", "jsonObjects(" + e + ")", "[]TL(" + v + ")"
+	}
+	return "jsonObject", "jsonObject{" + e + "}", v + ".TL"
+}
+
+func writeJSONStructFields(write writeFunc, c *Combinator) {
+	for _, t := range c.fields {
+		_type, _, _ := jsonField(t)
+		tag := t.tlName
+		if t.typeName == "long" {
+			tag += ",string"
+		}
+		if t.isFlag() {
+			tag += ",omitempty"
+		}
+		write("%s %s `json:\"%s\"`\n", normalizeAttr(t.name), _type, tag)
+	}
+}
+
+func writeJSONFuncs(write writeFunc, combinators []*Combinator) {
+	write(`package mtproto
+import (
+	"encoding/json"
+
+	"github.com/ansel1/merry"
+)
+`)
+
+	for _, c := range combinators {
+		write("func (e TL_%s) MarshalJSON() ([]byte, error) {\n", c.id)
+		write("return json.Marshal(struct {\n")
+		write("JSONType string `json:\"_\"`\n")
+		writeJSONStructFields(write, c)
+		write("}{\n")
+		write("%q,\n", c.tlName)
+		for _, t := range c.fields {
+			_, toJSON, _ := jsonField(t)
+			write("%s,\n", toJSON)
+		}
+		write("})\n")
+		write("}\n\n")
+
+		write("func (e *TL_%s) UnmarshalJSON(data []byte) error {\n", c.id)
+		write("var v struct {\n")
+		write("JSONType string `json:\"_\"`\n")
+		writeJSONStructFields(write, c)
+		write("}\n")
+		write("if err := json.Unmarshal(data, &v); err != nil {\nreturn merry.Wrap(err)\n}\n")
+		write("if err := checkJSONType(v.JSONType, %q, e); err != nil {\nreturn merry.Wrap(err)\n}\n", c.tlName)
+		write("*e = TL_%s{\n", c.id)
+		for _, t := range c.fields {
+			_, _, fromJSON := jsonField(t)
+			write("%s: %s,\n", normalizeAttr(t.name), fromJSON)
+		}
+		write("}\n")
+		write("return nil\n")
+		write("}\n\n")
+	}
+}
+
+// textMethod returns textWriter/textParser method name for field type
+func textMethod(t Field) string {
+	switch t.typeName {
+	case "true":
+		return "Bool"
+	case "int", "#":
+		return "Int"
+	case "long":
+		return "Long"
+	case "int128", "int256", "bytes":
+		return "Bytes"
+	case "string":
+		return "String"
+	case "double":
+		return "Double"
+	case "Vector<int>":
+		return "VectorInt"
+	case "Vector<long>":
+		return "VectorLong"
+	case "Vector<string>":
+		return "VectorString"
+	case "Vector<double>":
+		return "VectorDouble"
+	case "Vector<bytes>":
+		return "VectorBytes"
+	}
+	if _, ok := bareVectorItem(t.typeName); ok || isObjectVector(t.typeName) {
+		return "Vector"
+	}
+	return "Object"
+}
+
+func writeTextFuncs(write writeFunc, combinators []*Combinator) {
+	write("package mtproto\n\n")
+
+	for _, c := range combinators {
+		write("func (e TL_%s) writeText(w *textWriter) {\n", c.id)
+		write("w.name(%q)\n", c.tlName)
+		for _, t := range c.fields {
+			attrName := normalizeAttr(t.name)
+			switch {
+			case t.typeName == "#":
+				// flags are not printed, they are restored from flagged fields while parsing
+			case t.typeName == "true":
+				write("if e.Flags & %d != 0 {\n", 1<<uint(t.flagBit))
+				write("w.field(%q)\n", t.tlName)
+				write("w.Bool(true)\n")
+				write("}\n")
+			case t.isFlag():
+				write("if e.Flags & %d != 0 {\n", 1<<uint(t.flagBit))
+				write("w.field(%q)\n", t.tlName)
+				write("w.%s(e.%s)\n", textMethod(t), attrName)
+				write("}\n")
+			default:
+				write("w.field(%q)\n", t.tlName)
+				write("w.%s(e.%s)\n", textMethod(t), attrName)
+			}
+		}
+		write("}\n\n")
+	}
+
+	// filled in init() since parsers refer to textParsers themselves (via nested objects)
+	write("var textParsers map[string]func(*textParser) TL\n\n")
+	write("func init() {\n")
+	write("textParsers = map[string]func(*textParser) TL{\n")
+	for _, c := range combinators {
+		write("%q: func(p *textParser) TL {\n", c.tlName)
+		write("var e TL_%s\n", c.id)
+		write("for p.field() {\n")
+		write("switch p.fieldName {\n")
+		for _, t := range c.fields {
+			attrName := normalizeAttr(t.name)
+			write("case %q:\n", t.tlName)
+			switch {
+			case t.typeName == "#":
+				write("e.%s |= p.Int()\n", attrName)
+			case t.typeName == "true":
+				write("e.%s = p.Bool()\n", attrName)
+				write("if e.%s {\n", attrName)
+				write("e.Flags |= %d\n", 1<<uint(t.flagBit))
+				write("}\n")
+			default:
+				write("e.%s = p.%s()\n", attrName, textMethod(t))
+				if t.isFlag() {
+					write("e.Flags |= %d\n", 1<<uint(t.flagBit))
+				}
+			}
+		}
+		write("default:\n")
+		write("p.unknownField(%q)\n", c.tlName)
+		write("}\n")
+		write("}\n")
+		write("return e\n")
+		write("},\n")
+	}
+	write("}\n")
+	write("}\n")
+}
+
+type accessor struct {
+	method   string
+	goType   string
+	tlNames  []string
+	typeName string //field TL type (after normalization)
+}
+
+// common fields, for which getters are generated (so they can be used without reflection)
+var accessors = []accessor{
+	{"GetPts", "int32", []string{"pts"}, "int"},
+	{"GetPtsCount", "int32", []string{"pts_count"}, "int"},
+	{"GetQts", "int32", []string{"qts"}, "int"},
+	{"GetSeq", "int32", []string{"seq"}, "int"},
+	{"GetChannelID", "int32", []string{"channel_id"}, "int"},
+	{"GetPeer", "TL", []string{"peer", "peer_id"}, "Peer"},
+	{"GetDate", "int32", []string{"date"}, "int"},
+	{"GetID", "int32", []string{"id"}, "int"},
+	{"GetUsers", "[]TL", []string{"users"}, "Vector<User>"},
+	{"GetChats", "[]TL", []string{"chats"}, "Vector<Chat>"},
+}
+
+func writeAccessorFuncs(write writeFunc, combinators []*Combinator) {
+	write("package mtproto\n\n")
+	for _, c := range combinators {
+		for _, a := range accessors {
+			for _, t := range c.fields {
+				if t.typeName == a.typeName && contains(a.tlNames, t.tlName) {
+					write("func (e TL_%s) %s() %s { return e.%s }\n", c.id, a.method, a.goType, normalizeAttr(t.name))
+					break
+				}
+			}
+		}
+	}
+}
+
+func contains(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+func writeRegistryFuncs(write writeFunc, combinators []*Combinator) {
+	write("package mtproto\n\n")
+	write("func init() {\n")
+	for _, c := range combinators {
+		write("registerGenerated(&TypeInfo{\n")
+		write("CRC: CRC_%s,\n", c.id)
+		write("Name: %q,\n", c.tlName)
+		write("Type: %q,\n", c.tlTypeName)
+		if c.layer != 0 {
+			write("Layer: %d,\n", c.layer)
+		}
+		if c.isFunction {
+			write("IsFunction: true,\n")
+		}
+		if len(c.fields) > 0 {
+			write("Fields: []FieldInfo{\n")
+			for _, t := range c.fields {
+				write("{%q, %q, %q, %d},\n", t.tlName, normalizeAttr(t.name), t.tlTypeName, t.flagBit)
+			}
+			write("},\n")
+		}
+		write("New: func() TL { return TL_%s{} },\n", c.id)
+		write("})\n")
+	}
+	write("}\n")
+}
+
+// testValues builds field values for generated round-trip tests.
+// Objects are filled with "cheapest" constructor of its type (with least number of nested objects).
+type testValues struct {
+	constructors map[string][]*Combinator //by type name (functions excluded)
+	typeNames    []string                 //in schema order, for stable output
+	cost         map[string]int           //nested objects count for type, missing if type can not be filled
+	best         map[string]*Combinator
+	byName       map[string]*Combinator //by schema name, for bare vector items
+}
+
+func newTestValues(combinators []*Combinator) *testValues {
+	v := &testValues{
+		constructors: make(map[string][]*Combinator),
+		cost:         make(map[string]int),
+		best:         make(map[string]*Combinator),
+		byName:       make(map[string]*Combinator),
+	}
+	for _, c := range combinators {
+		if !c.isFunction {
+			v.byName[c.tlName] = c
+			if _, ok := v.constructors[c.typeName]; !ok {
+				v.typeNames = append(v.typeNames, c.typeName)
+			}
+			v.constructors[c.typeName] = append(v.constructors[c.typeName], c)
+		}
+	}
+	// fixed-point iteration: type cost is known when at least one of its constructors has all required fields fillable
+	for changed := true; changed; {
+		changed = false
+		for _, typeName := range v.typeNames {
+			for _, c := range v.constructors[typeName] {
+				cost, ok := v.requiredCost(c)
+				if ok && (v.best[typeName] == nil || cost < v.cost[typeName]) {
+					v.cost[typeName] = cost
+					v.best[typeName] = c
+					changed = true
+				}
+			}
+		}
+	}
+	return v
+}
+
+// requiredCost returns nested objects count needed to fill c with unset flags
+func (v *testValues) requiredCost(c *Combinator) (int, bool) {
+	cost := 1
+	for _, t := range c.fields {
+		if t.isFlag() {
+			continue
+		}
+		if _, isVector := v.vectorItem(t.typeName); isVector || t.typeName == "!X" || !v.isObject(t.typeName) {
+			continue //vectors are left empty in nested objects
+		}
+		typeCost, ok := v.cost[t.typeName]
+		if !ok {
+			return 0, false
+		}
+		cost += typeCost
+	}
+	return cost, true
+}
+
+func (v *testValues) isObject(typeName string) bool {
+	switch typeName {
+	case "true", "int", "#", "long", "int128", "int256", "string", "double", "bytes",
+		"Vector<int>", "Vector<long>", "Vector<string>", "Vector<double>", "Vector<bytes>":
+		return false
+	}
+	return true
+}
+
+// vectorItem returns constructor for items of object vector (boxed or bare) or nil if there is no suitable one
+func (v *testValues) vectorItem(typeName string) (*Combinator, bool) {
+	if isObjectVector(typeName) {
+		return v.best[typeName[len("Vector<"):len(typeName)-1]], true
+	}
+	if item, ok := bareVectorItem(typeName); ok {
+		if isBareItem(item) {
+			return v.byName[item], true
+		}
+		return v.best[item], true
+	}
+	return nil, false
+}
+
+// canFill reports whether all fields (including flagged ones) of c can be filled
+func (v *testValues) canFill(c *Combinator) bool {
+	for _, t := range c.fields {
+		if t.typeName == "!X" || !v.isObject(t.typeName) {
+			continue
+		}
+		if item, isVector := v.vectorItem(t.typeName); isVector {
+			if item == nil {
+				return false
+			}
+		} else if v.best[t.typeName] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// object returns Go expression for c with all flags set (if withFlags) or unset
+func (v *testValues) object(c *Combinator, withFlags, nested bool) string {
+	flags := 0
+	if withFlags {
+		for _, t := range c.fields {
+			if t.isFlag() {
+				flags |= 1 << uint(t.flagBit)
+			}
+		}
+	}
+	var items []string
+	for i, t := range c.fields {
+		if t.isFlag() && !withFlags {
+			continue
+		}
+		var value string
+		switch t.typeName {
+		case "true":
+			value = "true"
+		case "#":
+			value = strconv.Itoa(flags)
+		case "int":
+			value = strconv.Itoa(i + 1)
+		case "long":
+			value = fmt.Sprintf("0x0102030405060700 + %d", i)
+		case "int128":
+			value = `[]byte("0123456789abcdef")`
+		case "int256":
+			value = `[]byte("0123456789abcdef0123456789abcdef")`
+		case "string":
+			value = fmt.Sprintf("%q", t.tlName)
+		case "double":
+			value = "1.5"
+		case "bytes":
+			value = fmt.Sprintf("[]byte(%q)", t.tlName)
+		case "Vector<int>", "Vector<long>", "Vector<string>", "Vector<double>", "Vector<bytes>":
+			goType := map[string]string{"Vector<int>": "[]int32", "Vector<long>": "[]int64", "Vector<string>": "[]string", "Vector<double>": "[]float64", "Vector<bytes>": "[][]byte"}[t.typeName]
+			elem := map[string]string{"Vector<int>": "1", "Vector<long>": "2", "Vector<string>": `"s"`, "Vector<double>": "1.5", "Vector<bytes>": `[]byte("b")`}[t.typeName]
+			if nested {
+				value = goType + "{}"
+			} else {
+				value = goType + "{" + elem + "}"
+			}
+		case "!X":
+			value = "TL_boolTrue{}"
+		default:
+			if item, isVector := v.vectorItem(t.typeName); isVector {
+				if nested {
+					value = "[]TL{}"
+				} else {
+					value = "[]TL{" + v.object(item, false, true) + "}"
+				}
+			} else {
+				value = v.object(v.best[t.typeName], false, true)
+			}
+		}
+		items = append(items, normalizeAttr(t.name)+": "+value)
+	}
+	return "TL_" + c.id + "{" + strings.Join(items, ", ") + "}"
+}
+
+func writeRoundTripTests(write writeFunc, combinators []*Combinator) {
+	v := newTestValues(combinators)
+	write("package mtproto\n\n")
+	write("import (\n\"reflect\"\n\"testing\"\n)\n\n")
+
+	var unfilled []string
+	write("var generatedRoundTripObjects = []TL{\n")
+	for _, c := range combinators {
+		if !v.canFill(c) {
+			unfilled = append(unfilled, c.tlName)
+			continue
+		}
+		write("%s,\n", v.object(c, true, false))
+		if c.hasFlags() {
+			write("%s,\n", v.object(c, false, false))
+		}
+	}
+	write("}\n\n")
+	// every constructor must be tested, unsupported field type is a generator bug
+	if len(unfilled) > 0 {
+		log.Fatalf("can not make round-trip test values (some field types have no suitable constructors): %s",
+			strings.Join(unfilled, ", "))
+	}
+
+	write(`func TestGeneratedRoundTrip(t *testing.T) {
+	for _, obj := range generatedRoundTripObjects {
+		buf := Encode(obj)
+		dbuf := NewDecodeBuf(buf)
+		res := dbuf.Object()
+		if dbuf.err != nil {
+			t.Errorf("%%T: %%s", obj, dbuf.err)
+			continue
+		}
+		if dbuf.off != len(buf) {
+			t.Errorf("%%T: %%d of %%d bytes decoded", obj, dbuf.off, len(buf))
+		}
+		if !reflect.DeepEqual(obj, res) {
+			t.Errorf("%%T: encode-decode mismatch:\n%%#v\n%%#v", obj, obj, res)
+		}
+	}
+}
+`)
+}
+
+// parseLegacySchemas parses older layer schemas (args like "120:scheme/tl-schema-120.tl")
+// and returns constructors that are missing in current schema (or have different ID).
+// They are renamed with layer suffix (message -> message_layer120), so they can be generated
+// alongside current ones and used to decode data received under older layers.
+// If constructor is same in several old layers, the newest one is used.
+// Also returns all parsed layers (newest first), including ones without new constructors.
+func parseLegacySchemas(args []string, current []*Combinator) ([]int, []*Combinator) {
+	type legacySchema struct {
+		layer int
+		fpath string
+	}
+	schemas := make([]legacySchema, len(args))
+	for i, arg := range args {
+		colonPos := strings.Index(arg, ":")
+		if colonPos == -1 {
+			log.Fatalf("wrong legacy schema argument, expected layer:path, got %s", arg)
+		}
+		layer, err := strconv.Atoi(arg[:colonPos])
+		if err != nil {
+			log.Fatalf("wrong legacy schema layer %s: %s", arg, err)
+		}
+		schemas[i] = legacySchema{layer, arg[colonPos+1:]}
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].layer > schemas[j].layer })
+
+	knownIDs := make(map[uint32]bool)
+	for _, c := range current {
+		knownIDs[c.name] = true
+	}
+
+	var layers []int
+	var legacy []*Combinator
+	for _, schema := range schemas {
+		layers = append(layers, schema.layer)
+		for _, c := range parseTLSchema(schema.fpath) {
+			if c.isFunction || knownIDs[c.name] {
+				continue
+			}
+			knownIDs[c.name] = true
+			suffix := fmt.Sprintf("_layer%d", schema.layer)
+			c.id += suffix
+			c.tlName += suffix
+			c.layer = schema.layer
+			legacy = append(legacy, c)
+		}
+	}
+	return layers, legacy
+}
+
 func main() {
-	if len(os.Args) != 4 {
-		println("Usage: " + os.Args[0] + " layer tl_schema.tl tl_schema.go")
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		diffMain(os.Args[2:])
+		return
+	}
+	if len(os.Args) < 4 {
+		println("Usage: " + os.Args[0] + " layer tl_schema.tl tl_schema.go [old_layer:old_tl_schema.tl ...]")
+		println("       " + os.Args[0] + " diff old_schema.tl new_schema.tl [module_dir]")
 		os.Exit(2)
 	}
 	layer, err := strconv.Atoi(os.Args[1])
@@ -236,18 +814,12 @@ func main() {
 
 	// parsing
 	combinators := parseTLSchema(fpath)
+	legacyLayers, legacyCombinators := parseLegacySchemas(os.Args[4:], combinators)
+	combinators = append(combinators, legacyCombinators...)
 
 	// opening out file
-	outFile, err := os.Create(os.Args[3])
-	if err != nil {
-		log.Fatal(err)
-	}
+	outFile, write := createOutFile(os.Args[3])
 	defer outFile.Close()
-	write := func(format string, a ...interface{}) {
-		if _, err := fmt.Fprintf(outFile, format, a...); err != nil {
-			log.Fatal(err)
-		}
-	}
 
 	// constants
 	write(`package mtproto
@@ -257,6 +829,16 @@ import (
 `)
 	write("const (\n")
 	write("TL_Layer = %d\n", layer)
+	write(")\n\n")
+
+	// layers of additional (older) schemas, their constructors are generated with "_layerN" suffix
+	legacyLayerStrs := make([]string, len(legacyLayers))
+	for i, l := range legacyLayers {
+		legacyLayerStrs[i] = strconv.Itoa(l)
+	}
+	write("var TL_LegacyLayers = []int{%s}\n\n", strings.Join(legacyLayerStrs, ", "))
+
+	write("const (\n")
 	for _, c := range combinators {
 		write("CRC_%s = 0x%08x\n", c.id, c.name)
 	}
@@ -292,6 +874,8 @@ import (
 				write("[]string")
 			case "Vector<double>":
 				write("[]float64")
+			case "Vector<bytes>":
+				write("[][]byte")
 			case "!X":
 				write("TL")
 			default:
@@ -299,6 +883,8 @@ import (
 				n, _ := fmt.Sscanf(t.typeName, "Vector<%s", &inner)
 				if n == 1 {
 					write("[]TL // %s", inner[:len(inner)-1])
+				} else if _, ok := bareVectorItem(t.typeName); ok {
+					write("[]TL // %s", t.typeName)
 				} else {
 					write("TL // %s", t.typeName)
 				}
@@ -313,8 +899,7 @@ import (
 
 	// encode funcs
 	for _, c := range combinators {
-		write("func (e TL_%s) encode() []byte {\n", c.id)
-		write("x := NewEncodeBuf(512)\n")
+		write("func (e TL_%s) EncodeTo(x *EncodeBuf) {\n", c.id)
 		write("x.UInt(CRC_%s)\n", c.id)
 		for _, t := range c.fields {
 			attrName := normalizeAttr(t.name)
@@ -346,22 +931,29 @@ import (
 				write("x.VectorString(e.%s)\n", attrName)
 			case "Vector<double>":
 				write("x.VectorDouble(e.%s)\n", attrName)
+			case "Vector<bytes>":
+				write("x.VectorBytes(e.%s)\n", attrName)
 			case "!X":
-				write("x.Bytes(e.%s.encode())\n", attrName)
+				write("x.Object(e.%s)\n", attrName)
 			default:
 				var inner string
 				n, _ := fmt.Sscanf(t.typeName, "Vector<%s", &inner)
 				if n == 1 {
 					write("x.Vector(e.%s)\n", attrName)
+				} else if item, ok := bareVectorItem(t.typeName); ok {
+					if isBareItem(item) {
+						write("x.BareVectorOfBare(e.%s)\n", attrName)
+					} else {
+						write("x.BareVector(e.%s)\n", attrName)
+					}
 				} else {
-					write("x.Bytes(e.%s.encode())\n", attrName)
+					write("x.Object(e.%s)\n", attrName)
 				}
 			}
 			if t.isFlag() && t.typeName != "true" {
 				write("}\n")
 			}
 		}
-		write("return x.buf\n")
 		write("}\n\n")
 	}
 
@@ -429,6 +1021,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 				write(maybeFlagged("VectorString", isFlag, t.flagBit))
 			case "Vector<double>":
 				write(maybeFlagged("VectorDouble", isFlag, t.flagBit))
+			case "Vector<bytes>":
+				write(maybeFlagged("VectorBytes", isFlag, t.flagBit))
 			case "!X":
 				write(maybeFlagged("Object", isFlag, t.flagBit))
 			default:
@@ -436,6 +1030,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 				n, _ := fmt.Sscanf(t.typeName, "Vector<%s", &inner)
 				if n == 1 {
 					write(maybeFlagged("Vector", isFlag, t.flagBit))
+				} else if item, ok := bareVectorItem(t.typeName); ok {
+					if isBareItem(item) {
+						write(maybeFlagged("BareVectorOfBare", isFlag, t.flagBit, "CRC_"+normalize(item)))
+					} else {
+						write(maybeFlagged("BareVector", isFlag, t.flagBit))
+					}
 				} else {
 					write(maybeFlagged("Object", isFlag, t.flagBit))
 				}
@@ -446,8 +1046,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 
 	write(`
 	default:
-		m.err = merry.Errorf("Unknown constructor: %%08x", constructor)
-		return nil
+		info := TypeByCRC(constructor)
+		if info == nil || info.Decode == nil {
+			m.err = merry.Errorf("Unknown constructor: %%08x", constructor)
+			return nil
+		}
+		r = info.Decode(m)
 
 	}
 
@@ -458,4 +1062,29 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 
 	return
 }`)
+
+	// JSON (un)marshaling
+	jsonFile, writeJSON := createOutFile(siblingFPath(os.Args[3], "json"))
+	defer jsonFile.Close()
+	writeJSONFuncs(writeJSON, combinators)
+
+	// text printing and parsing
+	textFile, writeText := createOutFile(siblingFPath(os.Args[3], "text"))
+	defer textFile.Close()
+	writeTextFuncs(writeText, combinators)
+
+	// common fields getters
+	accessorsFile, writeAccessors := createOutFile(siblingFPath(os.Args[3], "accessors"))
+	defer accessorsFile.Close()
+	writeAccessorFuncs(writeAccessors, combinators)
+
+	// runtime metadata
+	registryFile, writeRegistry := createOutFile(siblingFPath(os.Args[3], "registry"))
+	defer registryFile.Close()
+	writeRegistryFuncs(writeRegistry, combinators)
+
+	// encode-decode tests
+	testFile, writeTest := createOutFile(siblingFPath(os.Args[3], "test"))
+	defer testFile.Close()
+	writeRoundTripTests(writeTest, combinators)
 }
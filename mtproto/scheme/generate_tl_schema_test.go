@@ -0,0 +1,30 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLegacySchemas(t *testing.T) {
+	current := parseTLSchema("testdata/current.tl")
+	layers, legacy := parseLegacySchemas([]string{"119:testdata/legacy-119.tl", "120:testdata/legacy-120.tl"}, current)
+
+	// layer 119 adds nothing (its inputPeerUser is same as in 120) but still must be listed
+	if !reflect.DeepEqual(layers, []int{120, 119}) {
+		t.Errorf("wrong layers: %v", layers)
+	}
+
+	var names []string
+	for _, c := range legacy {
+		names = append(names, c.tlName)
+		if c.layer != 120 || c.isFunction {
+			t.Errorf("%s: wrong layer %d or function flag %v", c.tlName, c.layer, c.isFunction)
+		}
+	}
+	if !reflect.DeepEqual(names, []string{"inputPeerUser_layer120", "peerOld_layer120"}) {
+		t.Errorf("wrong legacy constructors: %v", names)
+	}
+	if legacy[0].id != "inputPeerUser_layer120" || legacy[0].name != 0x34fb31c0 {
+		t.Errorf("wrong legacy inputPeerUser: %s %08x", legacy[0].id, legacy[0].name)
+	}
+}
@@ -1,7 +1,8 @@
 package mtproto
 
 type TL interface {
-	encode() []byte
+	// EncodeTo appends serialized object to buffer
+	EncodeTo(*EncodeBuf)
 }
 
 type TLReq interface {
@@ -13,7 +14,7 @@ type TL_msg_container struct {
 	Items []TL_MT_message
 }
 
-func (e TL_msg_container) encode() []byte { return nil }
+func (e TL_msg_container) EncodeTo(x *EncodeBuf) {}
 
 type TL_MT_message struct {
 	MsgID int64
@@ -27,16 +28,16 @@ type TL_rpc_result struct {
 	obj      TL
 }
 
-func (e TL_rpc_result) encode() []byte { return nil }
+func (e TL_rpc_result) EncodeTo(x *EncodeBuf) {}
 
 type VectorInt []int32
 
-func (e VectorInt) encode() []byte { return nil }
+func (e VectorInt) EncodeTo(x *EncodeBuf) {}
 
 type VectorLong []int64
 
-func (e VectorLong) encode() []byte { return nil }
+func (e VectorLong) EncodeTo(x *EncodeBuf) {}
 
 type VectorObject []TL
 
-func (e VectorObject) encode() []byte { return nil }
+func (e VectorObject) EncodeTo(x *EncodeBuf) {}
@@ -0,0 +1,58 @@
+package mtproto
+
+// Interfaces for common fields. Getters are generated for every constructor
+// that has corresponding field, so type switches or reflection are not needed, for example:
+//   if u, ok := update.(mtproto.TLWithPts); ok { pts = u.GetPts() }
+// Flagged fields return zero values if not set.
+
+type TLWithPts interface {
+	TL
+	GetPts() int32
+}
+
+type TLWithPtsCount interface {
+	TL
+	GetPtsCount() int32
+}
+
+type TLWithQts interface {
+	TL
+	GetQts() int32
+}
+
+type TLWithSeq interface {
+	TL
+	GetSeq() int32
+}
+
+type TLWithChannelID interface {
+	TL
+	GetChannelID() int32
+}
+
+// TLWithPeer is implemented by objects with "peer:Peer" or "peer_id:Peer" field
+type TLWithPeer interface {
+	TL
+	GetPeer() TL
+}
+
+type TLWithDate interface {
+	TL
+	GetDate() int32
+}
+
+// TLWithID is implemented by objects with "id:int" field (messages, users, chats, etc.)
+type TLWithID interface {
+	TL
+	GetID() int32
+}
+
+type TLWithUsers interface {
+	TL
+	GetUsers() []TL
+}
+
+type TLWithChats interface {
+	TL
+	GetChats() []TL
+}
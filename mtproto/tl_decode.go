@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sync"
 
 	"github.com/ansel1/merry"
 )
@@ -29,10 +30,41 @@ type DecodeBuf struct {
 	off  int
 	size int
 	err  error
+	// If true, decoded bytes fields will point to buf instead of being copied.
+	// buf must not be modified or reused after that (see aliased).
+	aliasBytes bool
+	aliased    bool
 }
 
 func NewDecodeBuf(b []byte) *DecodeBuf {
-	return &DecodeBuf{b, 0, len(b), nil}
+	return &DecodeBuf{buf: b, size: len(b)}
+}
+
+// NewDecodeBufAliased returns buffer that does not copy bytes fields (like upload.file.bytes):
+// decoded objects will share memory with b, so b must not be modified later.
+func NewDecodeBufAliased(b []byte) *DecodeBuf {
+	return &DecodeBuf{buf: b, size: len(b), aliasBytes: true}
+}
+
+// sub returns buffer for nested data (like gzip-packed one) with same settings
+func (m *DecodeBuf) sub(b []byte) *DecodeBuf {
+	return &DecodeBuf{buf: b, size: len(b), aliasBytes: m.aliasBytes}
+}
+
+// Aliased reports whether some decoded bytes still point to underlying buffer.
+func (m *DecodeBuf) Aliased() bool {
+	return m.aliased
+}
+
+// bytesResult copies or aliases (if enabled) b
+func (m *DecodeBuf) bytesResult(b []byte) []byte {
+	if m.aliasBytes {
+		m.aliased = true
+		return b[:len(b):len(b)]
+	}
+	x := make([]byte, len(b))
+	copy(x, b)
+	return x
 }
 
 func (m *DecodeBuf) SeekBack(n int) {
@@ -135,13 +167,21 @@ func (m *DecodeBuf) Bytes(size int) []byte {
 		m.err = notEnoughBytesErr("DecodeBytes", m.off, size, m.size)
 		return nil
 	}
-	x := make([]byte, size)
-	copy(x, m.buf[m.off:m.off+size])
+	x := m.bytesResult(m.buf[m.off : m.off+size])
 	m.off += size
 	return x
 }
 
 func (m *DecodeBuf) StringBytes() []byte {
+	b := m.stringBytesRef()
+	if m.err != nil {
+		return nil
+	}
+	return m.bytesResult(b)
+}
+
+// stringBytesRef reads bytes without copying, result must not be retained
+func (m *DecodeBuf) stringBytesRef() []byte {
 	if m.err != nil {
 		return nil
 	}
@@ -168,8 +208,7 @@ func (m *DecodeBuf) StringBytes() []byte {
 		m.err = notEnoughBytesErr("DecodeStringBytes", m.off, size, m.size)
 		return nil
 	}
-	x := make([]byte, size)
-	copy(x, m.buf[m.off:m.off+size])
+	x := m.buf[m.off : m.off+size]
 	m.off += size
 
 	if m.off+padding > m.size {
@@ -190,7 +229,7 @@ func (m *DecodeBuf) FlaggedStringBytes(flags, num int32) []byte {
 }
 
 func (m *DecodeBuf) String() string {
-	b := m.StringBytes()
+	b := m.stringBytesRef()
 	if m.err != nil {
 		return ""
 	}
@@ -207,15 +246,11 @@ func (m *DecodeBuf) FlaggedString(flags, num int32) string {
 }
 
 func (m *DecodeBuf) BigInt() *big.Int {
-	b := m.StringBytes()
+	b := m.stringBytesRef()
 	if m.err != nil {
 		return nil
 	}
-	y := make([]byte, len(b)+1)
-	y[0] = 0
-	copy(y[1:], b)
-	x := new(big.Int).SetBytes(y)
-	return x
+	return new(big.Int).SetBytes(b)
 }
 
 func (m *DecodeBuf) FlaggedBigInt(flags, num int32) *big.Int {
@@ -243,6 +278,10 @@ func (m *DecodeBuf) VectorInt() []int32 {
 		m.err = merry.Errorf("DecodeVectorInt: negative size: %d", size)
 		return nil
 	}
+	if int(size) > (m.size-m.off)/4 {
+		m.err = merry.Errorf("DecodeVectorInt: size %d is too big for %d remaining bytes", size, m.size-m.off)
+		return nil
+	}
 	x := make([]int32, size)
 	i := int32(0)
 	for i < size {
@@ -281,6 +320,10 @@ func (m *DecodeBuf) VectorLong() []int64 {
 		m.err = merry.Errorf("DecodeVectorLong: negative size: %d", size)
 		return nil
 	}
+	if int(size) > (m.size-m.off)/8 {
+		m.err = merry.Errorf("DecodeVectorLong: size %d is too big for %d remaining bytes", size, m.size-m.off)
+		return nil
+	}
 	x := make([]int64, size)
 	i := int32(0)
 	for i < size {
@@ -319,6 +362,10 @@ func (m *DecodeBuf) VectorString() []string {
 		m.err = merry.Errorf("DecodeVectorString: negative size: %d", size)
 		return nil
 	}
+	if int(size) > (m.size-m.off)/4 {
+		m.err = merry.Errorf("DecodeVectorString: size %d is too big for %d remaining bytes", size, m.size-m.off)
+		return nil
+	}
 	x := make([]string, size)
 	i := int32(0)
 	for i < size {
@@ -371,6 +418,10 @@ func (m *DecodeBuf) Vector() []TL {
 		m.err = merry.Errorf("DecodeVector: negative size: %d", size)
 		return nil
 	}
+	if int(size) > (m.size-m.off)/4 {
+		m.err = merry.Errorf("DecodeVector: size %d is too big for %d remaining bytes", size, m.size-m.off)
+		return nil
+	}
 	x := make([]TL, size)
 	i := int32(0)
 	for i < size {
@@ -392,6 +443,99 @@ func (m *DecodeBuf) FlaggedVector(flags, num int32) []TL {
 	return m.Vector()
 }
 
+func (m *DecodeBuf) VectorBytes() [][]byte {
+	constructor := m.UInt()
+	if m.err != nil {
+		return nil
+	}
+	if constructor != CRC_vector {
+		m.err = merry.Errorf("DecodeVectorBytes: wrong constructor (0x%08x)", constructor)
+		return nil
+	}
+	size := m.Int()
+	if m.err != nil {
+		return nil
+	}
+	if size < 0 {
+		m.err = merry.Errorf("DecodeVectorBytes: negative size: %d", size)
+		return nil
+	}
+	if int(size) > (m.size-m.off)/4 {
+		m.err = merry.Errorf("DecodeVectorBytes: size %d is too big for %d remaining bytes", size, m.size-m.off)
+		return nil
+	}
+	x := make([][]byte, size)
+	i := int32(0)
+	for i < size {
+		y := m.StringBytes()
+		if m.err != nil {
+			return nil
+		}
+		x[i] = y
+		i++
+	}
+	return x
+}
+
+func (m *DecodeBuf) FlaggedVectorBytes(flags, num int32) [][]byte {
+	bit := int32(1 << uint(num))
+	if flags&bit == 0 {
+		return nil
+	}
+	return m.VectorBytes()
+}
+
+// bareVector decodes vector without vector constructor ID, items are read with readItem
+func (m *DecodeBuf) bareVector(readItem func() TL) []TL {
+	size := m.Int()
+	if m.err != nil {
+		return nil
+	}
+	if size < 0 {
+		m.err = merry.Errorf("DecodeBareVector: negative size: %d", size)
+		return nil
+	}
+	if int(size) > (m.size-m.off)/4 {
+		m.err = merry.Errorf("DecodeBareVector: size %d is too big for %d remaining bytes", size, m.size-m.off)
+		return nil
+	}
+	x := make([]TL, size)
+	for i := range x {
+		x[i] = readItem()
+		if m.err != nil {
+			return nil
+		}
+	}
+	return x
+}
+
+// BareVector decodes vector without vector constructor ID (like vector<IpPort>)
+func (m *DecodeBuf) BareVector() []TL {
+	return m.bareVector(m.Object)
+}
+
+func (m *DecodeBuf) FlaggedBareVector(flags, num int32) []TL {
+	bit := int32(1 << uint(num))
+	if flags&bit == 0 {
+		return nil
+	}
+	return m.BareVector()
+}
+
+// BareVectorOfBare decodes bare vector of bare objects (like vector<future_salt>),
+// items have no constructor IDs, so constructor is passed explicitly
+func (m *DecodeBuf) BareVectorOfBare(constructor uint32) []TL {
+	return m.bareVector(func() TL { return m.ObjectGenerated(constructor) })
+}
+
+func (m *DecodeBuf) FlaggedBareVectorOfBare(flags, num int32, constructor uint32) []TL {
+	bit := int32(1 << uint(num))
+	if flags&bit == 0 {
+		return nil
+	}
+	return m.BareVectorOfBare(constructor)
+}
+
 func (m *DecodeBuf) Object() TL {
 	constructor := m.UInt()
 	if m.err != nil {
@@ -440,6 +584,52 @@ func (d *DecodeBuf) pushToErrBufStack(initialOffset int, constructor uint32) {
 	d.err = merry.WithValue(d.err, ErrorBufStackKey, bufStack)
 }
 
+// frame buffers larger than this are not returned to pool
+const maxPooledFrameBufCap = 2 * 1024 * 1024
+
+var frameBufPool sync.Pool
+
+// getFrameBuf returns buffer for incoming data (possibly reused one), it should be returned with putFrameBuf
+func getFrameBuf(size int) []byte {
+	if buf, ok := frameBufPool.Get().(*[]byte); ok && cap(*buf) >= size {
+		return (*buf)[:size]
+	}
+	return make([]byte, size)
+}
+
+func putFrameBuf(buf []byte) {
+	if cap(buf) <= maxPooledFrameBufCap {
+		frameBufPool.Put(&buf)
+	}
+}
+
+var gzipReaderPool sync.Pool
+
+// gunzip decompresses data appending it to dst[:0]
+func gunzip(dst, data []byte) ([]byte, error) {
+	var err error
+	src := bytes.NewReader(data)
+	gz, ok := gzipReaderPool.Get().(*gzip.Reader)
+	if ok {
+		err = gz.Reset(src)
+	} else {
+		gz, err = gzip.NewReader(src)
+	}
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	defer gzipReaderPool.Put(gz)
+
+	buf := bytes.NewBuffer(dst[:0])
+	if _, err := buf.ReadFrom(gz); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
 func toBool(x TL) bool {
 	_, ok := x.(TL_boolTrue)
 	return ok
@@ -473,6 +663,13 @@ func (m *MTProto) decodeMessage(dbuf *DecodeBuf, reqMsg TLReq) (r TL) {
 	switch constructor {
 	case CRC_msg_container:
 		size := dbuf.Int()
+		if dbuf.err != nil {
+			return nil
+		}
+		if size < 0 || int(size) > (dbuf.size-dbuf.off)/16 {
+			dbuf.err = merry.Errorf("msg_container: wrong size %d for %d remaining bytes", size, dbuf.size-dbuf.off)
+			return nil
+		}
 		arr := make([]TL_MT_message, size)
 		for i := int32(0); i < size; i++ {
 			arr[i] = TL_MT_message{dbuf.Long(), dbuf.Int(), dbuf.Int(), m.decodeMessage(dbuf, reqMsg)}
@@ -502,23 +699,21 @@ func (m *MTProto) decodeMessage(dbuf *DecodeBuf, reqMsg TLReq) (r TL) {
 		r = TL_rpc_result{requestID, r}
 
 	case CRC_gzip_packed:
-		obj := make([]byte, 0, 4096)
-
-		var buf bytes.Buffer
-		_, _ = buf.Write(dbuf.StringBytes())
-		gz, _ := gzip.NewReader(&buf)
-
-		b := make([]byte, 4096)
-		for true {
-			n, _ := gz.Read(b)
-			obj = append(obj, b[0:n]...)
-			if n <= 0 {
-				break
-			}
+		packed := dbuf.stringBytesRef()
+		if dbuf.err != nil {
+			return nil
 		}
-		d := NewDecodeBuf(obj)
+		obj, err := gunzip(getFrameBuf(0), packed)
+		if err != nil {
+			dbuf.err = merry.Wrap(err)
+			return nil
+		}
+		d := dbuf.sub(obj)
 		r = m.decodeMessage(d, reqMsg)
 		dbuf.err = d.err
+		if !d.Aliased() {
+			putFrameBuf(obj)
+		}
 
 	default:
 		dbuf.SeekBack(4) //returning constructor ID
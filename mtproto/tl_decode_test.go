@@ -0,0 +1,191 @@
+package mtproto
+
+import (
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testAuthKey = bytes.Repeat([]byte{7}, 256)
+
+// makeServerFrame encrypts message as server does (with length header)
+func makeServerFrame(msgBody []byte) []byte {
+	z := NewEncodeBuf(len(msgBody) + 64)
+	z.Long(0)         //salt
+	z.Long(0)         //session_id
+	z.Long(1<<32 | 1) //msg_id
+	z.Int(0)          //seq_no
+	z.Int(int32(len(msgBody)))
+	z.Bytes(msgBody)
+	msgKey := sha1(z.buf)[4:20]
+	for len(z.buf)%16 != 0 {
+		z.buf = append(z.buf, 0)
+	}
+	aesKey, aesIV := generateAES(msgKey, testAuthKey, true)
+	encrypted, err := doAES256IGEencrypt(z.buf, aesKey, aesIV)
+	if err != nil {
+		panic(err)
+	}
+
+	x := NewEncodeBuf(len(encrypted) + 32)
+	x.Int(0) //length header
+	x.Bytes([]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	x.Bytes(msgKey)
+	x.Bytes(encrypted)
+	size := len(x.buf)/4 - 1
+	x.buf[0] = 127
+	x.buf[1], x.buf[2], x.buf[3] = byte(size), byte(size>>8), byte(size>>16)
+	return x.buf
+}
+
+func gzipPacked(obj TL) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(Encode(obj)); err != nil {
+		panic(err)
+	}
+	if err := gz.Close(); err != nil {
+		panic(err)
+	}
+	x := NewEncodeBuf(buf.Len() + 8)
+	x.UInt(CRC_gzip_packed)
+	x.StringBytes(buf.Bytes())
+	return x.buf
+}
+
+func testMessages(count int) TL_messages_messages {
+	res := TL_messages_messages{}
+	for i := 0; i < count; i++ {
+		res.Messages = append(res.Messages, TL_message{
+			Flags:   1 << 8,
+			ID:      int32(i),
+			FromID:  TL_peerUser{UserID: int32(i % 10)},
+			PeerID:  TL_peerChat{ChatID: 1},
+			Date:    1600000000 + int32(i),
+			Message: strings.Repeat("text ", i%50),
+		})
+	}
+	for i := 0; i < 10; i++ {
+		res.Users = append(res.Users, TL_user{
+			Flags:      1<<0 | 1<<1,
+			ID:         int32(i),
+			AccessHash: int64(i) * 1000,
+			FirstName:  "First",
+		})
+	}
+	res.Chats = []TL{}
+	return res
+}
+
+type frameConn struct {
+	discardConn
+	data []byte
+	r    *bytes.Reader
+}
+
+func (c *frameConn) Read(b []byte) (int, error) { return c.r.Read(b) }
+func (c *frameConn) reset()                     { c.r.Reset(c.data) }
+
+func newFrameTestMTProto(frame []byte, alias bool) (*MTProto, *frameConn) {
+	m := NewMTProtoExt(MTParams{LogHandler: noopLogHandler{}, SessStore: &SessNoopStore{}, AliasDecodedBytes: alias})
+	m.session = &SessionInfo{AuthKey: testAuthKey}
+	conn := &frameConn{data: frame, r: bytes.NewReader(frame)}
+	m.conn = conn
+	return m, conn
+}
+
+func TestReadFrame(t *testing.T) {
+	fileData := bytes.Repeat([]byte("0123456789abcdef"), 1000)
+	messages := testMessages(20)
+	cases := []struct {
+		name string
+		body []byte
+		obj  TL
+	}{
+		{"upload.file", Encode(TL_upload_file{Type: TL_storage_filePartial{}, Mtime: 1, Bytes: fileData}),
+			TL_upload_file{Type: TL_storage_filePartial{}, Mtime: 1, Bytes: fileData}},
+		{"gzipped messages", gzipPacked(messages), messages},
+	}
+	for _, c := range cases {
+		for _, alias := range []bool{false, true} {
+			m, _ := newFrameTestMTProto(makeServerFrame(c.body), alias)
+			res, err := m.read()
+			if err != nil {
+				t.Fatalf("%s (alias=%t): %s", c.name, alias, err)
+			}
+			if !reflect.DeepEqual(c.obj, res) {
+				t.Errorf("%s (alias=%t): wrong result:\n%#v\n%#v", c.name, alias, c.obj, res)
+			}
+		}
+	}
+}
+
+func TestGunzipErrors(t *testing.T) {
+	if _, err := gunzip(nil, []byte("not a gzip")); err == nil {
+		t.Error("expected error for wrong header")
+	}
+	packed := gzipPacked(TL_inputPeerSelf{})
+	truncated := packed[8 : len(packed)-8] //skipping crc and string header, cutting tail
+	if _, err := gunzip(nil, truncated); err == nil {
+		t.Error("expected error for truncated data")
+	}
+}
+
+func benchmarkRead(b *testing.B, body []byte, alias bool) {
+	frame := makeServerFrame(body)
+	m, conn := newFrameTestMTProto(frame, alias)
+	b.SetBytes(int64(len(frame)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		conn.reset()
+		if _, err := m.read(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReadUploadFile(b *testing.B) {
+	body := Encode(TL_upload_file{Type: TL_storage_filePartial{}, Bytes: make([]byte, 512*1024)})
+	b.Run("copy", func(b *testing.B) { benchmarkRead(b, body, false) })
+	b.Run("alias", func(b *testing.B) { benchmarkRead(b, body, true) })
+}
+
+func BenchmarkReadMessages(b *testing.B) {
+	body := gzipPacked(testMessages(100))
+	b.Run("copy", func(b *testing.B) { benchmarkRead(b, body, false) })
+	b.Run("alias", func(b *testing.B) { benchmarkRead(b, body, true) })
+}
+
+func TestDecodeLegacyConstructor(t *testing.T) {
+	// message as server sends it under layer 122 (without ttl_period)
+	x := NewEncodeBuf(64)
+	x.UInt(0x58ae39c9)
+	x.Int(1<<1 | 1<<8) //out, from_id
+	x.Int(5)           //id
+	x.UInt(CRC_peerUser)
+	x.Int(1) //from_id
+	x.UInt(CRC_peerChat)
+	x.Int(2)    //peer_id
+	x.Int(1000) //date
+	x.String("hello")
+
+	expected := TL_message_layer122{Flags: 1<<1 | 1<<8, Out: true, ID: 5,
+		FromID: TL_peerUser{UserID: 1}, PeerID: TL_peerChat{ChatID: 2}, Date: 1000, Message: "hello"}
+	dbuf := NewDecodeBuf(x.buf)
+	obj := dbuf.Object()
+	if dbuf.err != nil {
+		t.Fatal(dbuf.err)
+	}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("wrong legacy message: %#v", obj)
+	}
+	if info := TypeByName("message_layer122"); info == nil || info.CRC != 0x58ae39c9 || info.Layer != 122 {
+		t.Errorf("legacy constructor is not registered: %#v", info)
+	}
+	if !IsLayerSupported(122) || IsLayerSupported(121) {
+		t.Errorf("wrong supported layers: %v", TL_LegacyLayers)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"math"
 	"math/big"
+	"sync"
 	"time"
 )
 
@@ -29,6 +30,39 @@ func NewEncodeBuf(cap int) *EncodeBuf {
 	return &EncodeBuf{make([]byte, 0, cap)}
 }
 
+// buffers larger than this are not returned to pool (so that occasional
+// huge requests will not keep memory forever)
+const maxPooledEncodeBufCap = 2 * 1024 * 1024
+
+var encodeBufPool = sync.Pool{
+	New: func() interface{} { return NewEncodeBuf(1024) },
+}
+
+// getEncodeBuf takes empty buffer from pool, it should be returned with putEncodeBuf
+func getEncodeBuf() *EncodeBuf {
+	x := encodeBufPool.Get().(*EncodeBuf)
+	x.buf = x.buf[:0]
+	return x
+}
+
+func putEncodeBuf(x *EncodeBuf) {
+	if cap(x.buf) <= maxPooledEncodeBufCap {
+		encodeBufPool.Put(x)
+	}
+}
+
+// Encode serializes object to new byte slice
+func Encode(obj TL) []byte {
+	x := NewEncodeBuf(512)
+	obj.EncodeTo(x)
+	return x.buf
+}
+
+// Buf returns encoded data. It is valid until next buffer modification.
+func (e *EncodeBuf) Buf() []byte {
+	return e.buf
+}
+
 func (e *EncodeBuf) Int(s int32) {
 	e.buf = append(e.buf, 0, 0, 0, 0)
 	binary.LittleEndian.PutUint32(e.buf[len(e.buf)-4:], uint32(s))
@@ -49,8 +83,30 @@ func (e *EncodeBuf) Double(s float64) {
 	binary.LittleEndian.PutUint64(e.buf[len(e.buf)-8:], math.Float64bits(s))
 }
 
+func (e *EncodeBuf) stringHeader(size int) {
+	if size < 254 {
+		e.buf = append(e.buf, byte(size))
+	} else {
+		e.UInt(uint32(size<<8 | 254))
+	}
+}
+
+func (e *EncodeBuf) stringPadding(size int) {
+	var padding int
+	if size < 254 {
+		padding = (4 - (size+1)%4) & 3
+	} else {
+		padding = (4 - size%4) & 3
+	}
+	for i := 0; i < padding; i++ {
+		e.buf = append(e.buf, 0)
+	}
+}
+
 func (e *EncodeBuf) String(s string) {
-	e.StringBytes([]byte(s))
+	e.stringHeader(len(s))
+	e.buf = append(e.buf, s...)
+	e.stringPadding(len(s))
 }
 
 func (e *EncodeBuf) BigInt(s *big.Int) {
@@ -58,22 +114,9 @@ func (e *EncodeBuf) BigInt(s *big.Int) {
 }
 
 func (e *EncodeBuf) StringBytes(s []byte) {
-	var res []byte
-	size := len(s)
-	if size < 254 {
-		nl := 1 + size + (4-(size+1)%4)&3
-		res = make([]byte, nl)
-		res[0] = byte(size)
-		copy(res[1:], s)
-
-	} else {
-		nl := 4 + size + (4-size%4)&3
-		res = make([]byte, nl)
-		binary.LittleEndian.PutUint32(res, uint32(size<<8|254))
-		copy(res[4:], s)
-
-	}
-	e.buf = append(e.buf, res...)
+	e.stringHeader(len(s))
+	e.buf = append(e.buf, s...)
+	e.stringPadding(len(s))
 }
 
 func (e *EncodeBuf) Bytes(s []byte) {
@@ -81,45 +124,71 @@ func (e *EncodeBuf) Bytes(s []byte) {
 }
 
 func (e *EncodeBuf) VectorInt(v []int32) {
-	x := make([]byte, 4+4+len(v)*4)
-	binary.LittleEndian.PutUint32(x, CRC_vector)
-	binary.LittleEndian.PutUint32(x[4:], uint32(len(v)))
-	i := 8
+	e.UInt(CRC_vector)
+	e.Int(int32(len(v)))
 	for _, v := range v {
-		binary.LittleEndian.PutUint32(x[i:], uint32(v))
-		i += 4
+		e.Int(v)
 	}
-	e.buf = append(e.buf, x...)
 }
 
 func (e *EncodeBuf) VectorLong(v []int64) {
-	x := make([]byte, 4+4+len(v)*8)
-	binary.LittleEndian.PutUint32(x, CRC_vector)
-	binary.LittleEndian.PutUint32(x[4:], uint32(len(v)))
-	i := 8
+	e.UInt(CRC_vector)
+	e.Int(int32(len(v)))
 	for _, v := range v {
-		binary.LittleEndian.PutUint64(x[i:], uint64(v))
-		i += 8
+		e.Long(v)
 	}
-	e.buf = append(e.buf, x...)
 }
 
 func (e *EncodeBuf) VectorString(v []string) {
-	x := make([]byte, 8)
-	binary.LittleEndian.PutUint32(x, CRC_vector)
-	binary.LittleEndian.PutUint32(x[4:], uint32(len(v)))
-	e.buf = append(e.buf, x...)
+	e.UInt(CRC_vector)
+	e.Int(int32(len(v)))
 	for _, v := range v {
 		e.String(v)
 	}
 }
 
+func (e *EncodeBuf) VectorDouble(v []float64) {
+	e.UInt(CRC_vector)
+	e.Int(int32(len(v)))
+	for _, v := range v {
+		e.Double(v)
+	}
+}
+
+func (e *EncodeBuf) VectorBytes(v [][]byte) {
+	e.UInt(CRC_vector)
+	e.Int(int32(len(v)))
+	for _, v := range v {
+		e.StringBytes(v)
+	}
+}
+
 func (e *EncodeBuf) Vector(v []TL) {
-	x := make([]byte, 8)
-	binary.LittleEndian.PutUint32(x, CRC_vector)
-	binary.LittleEndian.PutUint32(x[4:], uint32(len(v)))
-	e.buf = append(e.buf, x...)
+	e.UInt(CRC_vector)
+	e.Int(int32(len(v)))
+	for _, v := range v {
+		v.EncodeTo(e)
+	}
+}
+
+// BareVector encodes vector without vector constructor ID (like vector<IpPort>)
+func (e *EncodeBuf) BareVector(v []TL) {
+	e.Int(int32(len(v)))
 	for _, v := range v {
-		e.buf = append(e.buf, v.encode()...)
+		v.EncodeTo(e)
 	}
 }
+
+// BareVectorOfBare encodes bare vector of bare objects (like vector<future_salt>): without their constructor IDs
+func (e *EncodeBuf) BareVectorOfBare(v []TL) {
+	e.Int(int32(len(v)))
+	for _, v := range v {
+		start := len(e.buf)
+		v.EncodeTo(e)
+		e.buf = append(e.buf[:start], e.buf[start+4:]...)
+	}
+}
+
+func (e *EncodeBuf) Object(obj TL) {
+	obj.EncodeTo(e)
+}
@@ -0,0 +1,153 @@
+package mtproto
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testMultiMedia(count int) TL_messages_sendMultiMedia {
+	media := make([]TL, count)
+	for i := range media {
+		media[i] = TL_inputSingleMedia{
+			Flags: 1,
+			Media: TL_inputMediaUploadedPhoto{
+				File: TL_inputFile{ID: int64(i), Parts: 3, Name: "photo.jpg", Md5Checksum: "0123456789abcdef0123456789abcdef"},
+			},
+			RandomID: int64(i) * 12345,
+			Message:  "caption",
+			Entities: []TL{TL_messageEntityBold{Offset: 0, Length: 7}},
+		}
+	}
+	return TL_messages_sendMultiMedia{
+		Peer:       TL_inputPeerChannel{ChannelID: 1, AccessHash: 2},
+		MultiMedia: media,
+	}
+}
+
+func TestEncodeStrings(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 3, 4, 253, 254, 255, 256, 1000} {
+		str := strings.Repeat("a", size)
+		x := NewEncodeBuf(0)
+		x.String(str)
+		if len(x.buf)%4 != 0 {
+			t.Errorf("size %d: encoded length %d is not padded", size, len(x.buf))
+		}
+		if res := NewDecodeBuf(x.buf).String(); res != str {
+			t.Errorf("size %d: decoded %d bytes", size, len(res))
+		}
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	obj := testMultiMedia(3)
+	res := NewDecodeBuf(Encode(obj)).Object()
+	if !reflect.DeepEqual(TL(obj), res) {
+		t.Errorf("encode-decode mismatch:\n%#v\n%#v", obj, res)
+	}
+}
+
+func TestEncodeVectorsLayout(t *testing.T) {
+	expected := NewEncodeBuf(0)
+	expected.UInt(CRC_updateMessagePollVote)
+	expected.Long(1)
+	expected.Int(2)
+	expected.UInt(CRC_vector) //Vector<bytes>: boxed vector of strings
+	expected.Int(1)
+	expected.StringBytes([]byte{3})
+	if buf := Encode(TL_updateMessagePollVote{PollID: 1, UserID: 2, Options: [][]byte{{3}}}); !bytes.Equal(buf, expected.buf) {
+		t.Errorf("wrong Vector<bytes> encoding:\n%x\n%x", buf, expected.buf)
+	}
+
+	expected = NewEncodeBuf(0)
+	expected.UInt(CRC_accessPointRule)
+	expected.String("")
+	expected.Int(2)
+	expected.Int(1) //vector<IpPort>: no vector ID, boxed items
+	expected.UInt(CRC_ipPort)
+	expected.Int(3)
+	expected.Int(4)
+	if buf := Encode(TL_accessPointRule{DcID: 2, Ips: []TL{TL_ipPort{Ipv4: 3, Port: 4}}}); !bytes.Equal(buf, expected.buf) {
+		t.Errorf("wrong bare vector encoding:\n%x\n%x", buf, expected.buf)
+	}
+
+	expected = NewEncodeBuf(0)
+	expected.UInt(CRC_future_salts)
+	expected.Long(1)
+	expected.Int(2)
+	expected.Int(1) //vector<future_salt>: no vector ID, bare items
+	expected.Int(3)
+	expected.Int(4)
+	expected.Long(5)
+	if buf := Encode(TL_future_salts{ReqMsgID: 1, Now: 2, Salts: []TL{TL_future_salt{ValidSince: 3, ValidUntil: 4, Salt: 5}}}); !bytes.Equal(buf, expected.buf) {
+		t.Errorf("wrong bare vector of bare objects encoding:\n%x\n%x", buf, expected.buf)
+	}
+}
+
+func TestAES256IGEencryptDecrypt(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, 32)
+	iv := bytes.Repeat([]byte{2}, 32)
+	data := []byte("0123456789abcdef0123456789abcdef0123456789abcdef")
+	prefix := []byte("prefix")
+	encrypted, err := appendAES256IGEencrypted(append([]byte{}, prefix...), data, key, iv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encrypted[:len(prefix)], prefix) {
+		t.Fatalf("prefix was modified: %q", encrypted[:len(prefix)])
+	}
+	decrypted, err := doAES256IGEdecrypt(encrypted[len(prefix):], key, iv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted, data) {
+		t.Errorf("wrong decrypted data: %q", decrypted)
+	}
+}
+
+// BenchmarkEncodeNewBuf measures encoding to fresh buffer (no pooling)
+func BenchmarkEncodeNewBuf(b *testing.B) {
+	obj := testMultiMedia(10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Encode(obj)
+	}
+}
+
+// BenchmarkEncodePooled measures encoding to pooled buffer (as done in MTProto.send)
+func BenchmarkEncodePooled(b *testing.B) {
+	obj := testMultiMedia(10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x := getEncodeBuf()
+		obj.EncodeTo(x)
+		putEncodeBuf(x)
+	}
+}
+
+type discardConn struct{ net.Conn }
+
+func (c discardConn) Write(b []byte) (int, error)       { return len(b), nil }
+func (c discardConn) SetReadDeadline(t time.Time) error { return nil }
+
+type noopLogHandler struct{}
+
+func (h noopLogHandler) Log(LogLevel, error, string, ...interface{}) {}
+func (h noopLogHandler) Message(bool, TL, int64)                     {}
+
+func BenchmarkSendEncrypted(b *testing.B) {
+	m := NewMTProtoExt(MTParams{LogHandler: noopLogHandler{}, SessStore: &SessNoopStore{}})
+	m.session = &SessionInfo{AuthKey: bytes.Repeat([]byte{7}, 256), AuthKeyHash: make([]byte, 8)}
+	m.conn = discardConn{}
+	m.encryptionReady = true
+	obj := testMultiMedia(10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := m.send(newPacket(obj, nil)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+//go:build go1.18
+// +build go1.18
+
+package mtproto
+
+import (
+	"testing"
+)
+
+// Run with: go test -run - -fuzz FuzzDecodeObject ./mtproto
+
+func FuzzDecodeObject(f *testing.F) {
+	for _, obj := range generatedRoundTripObjects {
+		f.Add(Encode(obj))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		NewDecodeBuf(data).Object()
+		NewDecodeBufAliased(data).Object()
+	})
+}
+
+func FuzzDecodeMessage(f *testing.F) {
+	f.Add(Encode(TL_inputPeerSelf{}))
+	f.Add(gzipPacked(testMessages(3)))
+	container := NewEncodeBuf(64)
+	container.UInt(CRC_msg_container)
+	container.Int(2)
+	for i := 0; i < 2; i++ {
+		container.Long(int64(i)<<32 | 1)
+		container.Int(int32(i))
+		container.Int(16) //length
+		container.UInt(CRC_rpc_result)
+		container.Long(1)
+		container.UInt(CRC_boolTrue)
+	}
+	f.Add(container.buf)
+
+	m, _ := newFrameTestMTProto(nil, false)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m.decodeMessage(NewDecodeBuf(data), nil)
+	})
+}
+
+func FuzzRead(f *testing.F) {
+	f.Add(makeServerFrame(Encode(TL_upload_file{Type: TL_storage_filePartial{}, Bytes: []byte("data")})))
+	f.Add(makeServerFrame(gzipPacked(testMessages(3))))
+	plain := NewEncodeBuf(64)
+	plain.Long(0) //auth_key_id
+	plain.Long(1) //msg_id
+	plain.Int(4)  //length
+	plain.UInt(CRC_boolTrue)
+	f.Add(append([]byte{byte(len(plain.buf) / 4)}, plain.buf...))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m, _ := newFrameTestMTProto(data, false)
+		m.read()
+	})
+}
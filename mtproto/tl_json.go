@@ -0,0 +1,142 @@
+package mtproto
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/ansel1/merry"
+)
+
+// JSON representation of TL objects is similar to the official one:
+//   {"_": "messages.getHistory", "peer": {"_": "inputPeerSelf"}, "offset_id": 0, ...}
+// Constructor name is stored in "_" field, bytes are encoded as base64
+// and longs are encoded as strings (JS can not handle int64 without precision loss).
+
+// jsonObject wraps TL interface field so it can be decoded using "_" discriminator
+type jsonObject struct {
+	TL
+}
+
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	if o.TL == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.TL)
+}
+
+func (o *jsonObject) UnmarshalJSON(data []byte) error {
+	obj, err := UnmarshalJSON(data)
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	o.TL = obj
+	return nil
+}
+
+type jsonObjects []TL
+
+func (o jsonObjects) MarshalJSON() ([]byte, error) {
+	if o == nil {
+		return []byte("null"), nil
+	}
+	items := make([]jsonObject, len(o))
+	for i, obj := range o {
+		items[i] = jsonObject{obj}
+	}
+	return json.Marshal(items)
+}
+
+func (o *jsonObjects) UnmarshalJSON(data []byte) error {
+	var items []jsonObject
+	if err := json.Unmarshal(data, &items); err != nil {
+		return merry.Wrap(err)
+	}
+	if items == nil {
+		*o = nil
+		return nil
+	}
+	*o = make(jsonObjects, len(items))
+	for i, item := range items {
+		(*o)[i] = item.TL
+	}
+	return nil
+}
+
+type jsonLongs []int64
+
+func (l jsonLongs) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("null"), nil
+	}
+	strs := make([]string, len(l))
+	for i, v := range l {
+		strs[i] = strconv.FormatInt(v, 10)
+	}
+	return json.Marshal(strs)
+}
+
+func (l *jsonLongs) UnmarshalJSON(data []byte) error {
+	var strs []json.Number
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return merry.Wrap(err)
+	}
+	if strs == nil {
+		*l = nil
+		return nil
+	}
+	*l = make(jsonLongs, len(strs))
+	for i, s := range strs {
+		v, err := strconv.ParseInt(string(s), 10, 64)
+		if err != nil {
+			return merry.Wrap(err)
+		}
+		(*l)[i] = v
+	}
+	return nil
+}
+
+// checkJSONType checks "_" field of object decoded into obj (pointer to generated type).
+// Names of constructors registered at runtime with the same Go type are accepted too.
+func checkJSONType(jsonType, expected string, obj interface{}) error {
+	if jsonType == "" || jsonType == expected {
+		return nil
+	}
+	if info := TypeByName(jsonType); info != nil && info.GoType == reflect.TypeOf(obj).Elem() {
+		return nil
+	}
+	return merry.Errorf("wrong JSON object type: expected %q, got %q", expected, jsonType)
+}
+
+// UnmarshalJSON decodes TL object of any type using "_" field as constructor name.
+// JSON "null" is decoded as nil.
+func UnmarshalJSON(data []byte) (TL, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+	var head struct {
+		JSONType string `json:"_"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	info := TypeByName(head.JSONType)
+	if info == nil {
+		return nil, merry.Errorf("unknown JSON object type: %q", head.JSONType)
+	}
+	ptr := reflect.New(info.GoType)
+	if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return ptr.Elem().Interface().(TL), nil
+}
+
+// SprintJSON is like Sprint but uses JSON representation with schema names.
+func SprintJSON(obj TL) string {
+	buf, err := json.Marshal(jsonObject{obj})
+	if err != nil {
+		return Sprint(obj)
+	}
+	return string(buf)
+}
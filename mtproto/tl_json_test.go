@@ -0,0 +1,95 @@
+package mtproto
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	objs := []TL{
+		TL_messages_getHistory{
+			Peer:     TL_inputPeerChannel{ChannelID: 123, AccessHash: -9007199254740993},
+			OffsetID: 10,
+			Limit:    100,
+		},
+		TL_message{
+			Flags:    1<<1 | 1<<7,
+			Out:      true,
+			ID:       5,
+			PeerID:   TL_peerUser{UserID: 42},
+			Message:  "hello",
+			Entities: []TL{TL_messageEntityBold{Offset: 0, Length: 5}},
+		},
+		TL_resPQ{
+			Nonce:                       []byte{1, 2, 3},
+			ServerPublicKeyFingerprints: []int64{-1, 1 << 62},
+		},
+	}
+	for _, obj := range objs {
+		buf, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := UnmarshalJSON(buf)
+		if err != nil {
+			t.Fatalf("%s: %s", buf, err)
+		}
+		if !reflect.DeepEqual(obj, res) {
+			t.Errorf("JSON round trip mismatch:\n%#v\n%#v\n%s", obj, res, buf)
+		}
+	}
+}
+
+func TestJSONFormat(t *testing.T) {
+	buf, err := json.Marshal(TL_messages_getHistory{Peer: TL_inputPeerChannel{ChannelID: 1, AccessHash: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	str := string(buf)
+	for _, part := range []string{
+		`"_":"messages.getHistory"`,
+		`"peer":{"_":"inputPeerChannel","channel_id":1,"access_hash":"2"}`,
+		`"offset_id":0`,
+	} {
+		if !strings.Contains(str, part) {
+			t.Errorf("%s not found in %s", part, str)
+		}
+	}
+}
+
+func TestJSONUnknownType(t *testing.T) {
+	if _, err := UnmarshalJSON([]byte(`{"_":"noSuchConstructor"}`)); err == nil {
+		t.Error("expected error for unknown type")
+	}
+	if obj, err := UnmarshalJSON([]byte(`null`)); obj != nil || err != nil {
+		t.Errorf("expected nil for null, got %#v, %v", obj, err)
+	}
+}
+
+func TestJSONRuntimeAlias(t *testing.T) {
+	const crc = 0x0badc0df
+	err := Register(&TypeInfo{
+		CRC:  crc,
+		Name: "test.inputPeerUserAlias",
+		Type: "InputPeer",
+		New:  func() TL { return TL_inputPeerUser{} },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { unregister(crc) })
+
+	obj, err := UnmarshalJSON([]byte(`{"_":"test.inputPeerUserAlias","user_id":12,"access_hash":"34"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, TL_inputPeerUser{UserID: 12, AccessHash: 34}) {
+		t.Errorf("wrong decoded alias: %#v", obj)
+	}
+	var peer TL_inputPeerSelf
+	if err := json.Unmarshal([]byte(`{"_":"test.inputPeerUserAlias"}`), &peer); err == nil {
+		t.Error("expected error for alias of another type")
+	}
+}
@@ -5,7 +5,12 @@ import (
 )
 
 const (
-	TL_Layer                                                              = 126
+	TL_Layer = 126
+)
+
+var TL_LegacyLayers = []int{122}
+
+const (
 	CRC_resPQ                                                             = 0x05162463
 	CRC_p_q_inner_data                                                    = 0x83c95aec
 	CRC_p_q_inner_data_dc                                                 = 0xa9f55f95
@@ -1342,6 +1347,8 @@ const (
 	CRC_stats_getMegagroupStats                                           = 0xdcdf8607
 	CRC_stats_getMessagePublicForwards                                    = 0x5630281b
 	CRC_stats_getMessageStats                                             = 0xb6e0a3f5
+	CRC_message_layer122                                                  = 0x58ae39c9
+	CRC_messageService_layer122                                           = 0x286fa604
 )
 
 type TL_resPQ struct {
@@ -1554,7 +1561,7 @@ type TL_future_salt struct {
 type TL_future_salts struct {
 	ReqMsgID int64
 	Now      int32
-	Salts    TL // vector<future_salt>
+	Salts    []TL // vector<future_salt>
 }
 
 type TL_pong struct {
@@ -1596,17 +1603,17 @@ type TL_ipPortSecret struct {
 type TL_accessPointRule struct {
 	PhonePrefixRules string
 	DcID             int32
-	Ips              TL // vector<IpPort>
+	Ips              []TL // vector<IpPort>
 }
 
 type TL_help_configSimple struct {
 	Date    int32
 	Expires int32
-	Rules   TL // vector<AccessPointRule>
+	Rules   []TL // vector<AccessPointRule>
 }
 
 type TL_tlsClientHello struct {
-	Blocks TL // vector<TlsBlock>
+	Blocks []TL // vector<TlsBlock>
 }
 
 type TL_tlsBlockString struct {
@@ -1836,10 +1843,10 @@ type TL_inputMediaGeoLive struct {
 
 type TL_inputMediaPoll struct {
 	Flags            int32
-	Poll             TL     // Poll
-	CorrectAnswers   []TL   // bytes //flag
-	Solution         string //flag
-	SolutionEntities []TL   // MessageEntity //flag
+	Poll             TL       // Poll
+	CorrectAnswers   [][]byte //flag
+	Solution         string   //flag
+	SolutionEntities []TL     // MessageEntity //flag
 }
 
 type TL_inputMediaDice struct {
@@ -3279,7 +3286,7 @@ type TL_updateLoginToken struct {
 type TL_updateMessagePollVote struct {
 	PollID  int64
 	UserID  int32
-	Options []TL // bytes
+	Options [][]byte
 }
 
 type TL_updateDialogFilter struct {
@@ -5988,8 +5995,8 @@ type TL_secureValueErrorFile struct {
 }
 
 type TL_secureValueErrorFiles struct {
-	Type     TL   // SecureValueType
-	FileHash []TL // bytes
+	Type     TL // SecureValueType
+	FileHash [][]byte
 	Text     string
 }
 
@@ -6006,8 +6013,8 @@ type TL_secureValueErrorTranslationFile struct {
 }
 
 type TL_secureValueErrorTranslationFiles struct {
-	Type     TL   // SecureValueType
-	FileHash []TL // bytes
+	Type     TL // SecureValueType
+	FileHash [][]byte
 	Text     string
 }
 
@@ -6554,7 +6561,7 @@ type TL_messageUserVoteInputOption struct {
 
 type TL_messageUserVoteMultiple struct {
 	UserID  int32
-	Options []TL // bytes
+	Options [][]byte
 	Date    int32
 }
 
@@ -8160,7 +8167,7 @@ type TL_messages_updatePinnedMessage struct {
 type TL_messages_sendVote struct {
 	Peer    TL // InputPeer
 	MsgID   int32
-	Options []TL // bytes
+	Options [][]byte
 }
 
 type TL_messages_getPollResults struct {
@@ -9060,18 +9067,62 @@ type TL_stats_getMessageStats struct {
 	MsgID   int32
 }
 
-func (e TL_resPQ) encode() []byte {
-	x := NewEncodeBuf(512)
+type TL_message_layer122 struct {
+	Flags             int32
+	Out               bool //flag
+	Mentioned         bool //flag
+	MediaUnread       bool //flag
+	Silent            bool //flag
+	Post              bool //flag
+	FromScheduled     bool //flag
+	Legacy            bool //flag
+	EditHide          bool //flag
+	Pinned            bool //flag
+	ID                int32
+	FromID            TL    // Peer //flag
+	PeerID            TL    // Peer
+	FwdFrom           TL    // MessageFwdHeader //flag
+	ViaBotID          int32 //flag
+	ReplyTo           TL    // MessageReplyHeader //flag
+	Date              int32
+	Message           string
+	Media             TL     // MessageMedia //flag
+	ReplyMarkup       TL     // ReplyMarkup //flag
+	Entities          []TL   // MessageEntity //flag
+	Views             int32  //flag
+	Forwards          int32  //flag
+	Replies           TL     // MessageReplies //flag
+	EditDate          int32  //flag
+	PostAuthor        string //flag
+	GroupedID         int64  //flag
+	RestrictionReason []TL   // RestrictionReason //flag
+}
+
+type TL_messageService_layer122 struct {
+	Flags       int32
+	Out         bool //flag
+	Mentioned   bool //flag
+	MediaUnread bool //flag
+	Silent      bool //flag
+	Post        bool //flag
+	Legacy      bool //flag
+	ID          int32
+	FromID      TL // Peer //flag
+	PeerID      TL // Peer
+	ReplyTo     TL // MessageReplyHeader //flag
+	Date        int32
+	Action      TL // MessageAction
+}
+
+func (e TL_resPQ) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_resPQ)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.String(e.Pq)
 	x.VectorLong(e.ServerPublicKeyFingerprints)
-	return x.buf
 }
 
-func (e TL_p_q_inner_data) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_p_q_inner_data) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_p_q_inner_data)
 	x.String(e.Pq)
 	x.String(e.P)
@@ -9079,11 +9130,9 @@ func (e TL_p_q_inner_data) encode() []byte {
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.Bytes(e.NewNonce)
-	return x.buf
 }
 
-func (e TL_p_q_inner_data_dc) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_p_q_inner_data_dc) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_p_q_inner_data_dc)
 	x.String(e.Pq)
 	x.String(e.P)
@@ -9092,11 +9141,9 @@ func (e TL_p_q_inner_data_dc) encode() []byte {
 	x.Bytes(e.ServerNonce)
 	x.Bytes(e.NewNonce)
 	x.Int(e.Dc)
-	return x.buf
 }
 
-func (e TL_p_q_inner_data_temp) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_p_q_inner_data_temp) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_p_q_inner_data_temp)
 	x.String(e.Pq)
 	x.String(e.P)
@@ -9105,11 +9152,9 @@ func (e TL_p_q_inner_data_temp) encode() []byte {
 	x.Bytes(e.ServerNonce)
 	x.Bytes(e.NewNonce)
 	x.Int(e.ExpiresIn)
-	return x.buf
 }
 
-func (e TL_p_q_inner_data_temp_dc) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_p_q_inner_data_temp_dc) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_p_q_inner_data_temp_dc)
 	x.String(e.Pq)
 	x.String(e.P)
@@ -9119,40 +9164,32 @@ func (e TL_p_q_inner_data_temp_dc) encode() []byte {
 	x.Bytes(e.NewNonce)
 	x.Int(e.Dc)
 	x.Int(e.ExpiresIn)
-	return x.buf
 }
 
-func (e TL_bind_auth_key_inner) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_bind_auth_key_inner) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_bind_auth_key_inner)
 	x.Long(e.Nonce)
 	x.Long(e.TempAuthKeyID)
 	x.Long(e.PermAuthKeyID)
 	x.Long(e.TempSessionID)
 	x.Int(e.ExpiresAt)
-	return x.buf
 }
 
-func (e TL_server_DH_params_fail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_server_DH_params_fail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_server_DH_params_fail)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.Bytes(e.NewNonceHash)
-	return x.buf
 }
 
-func (e TL_server_DH_params_ok) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_server_DH_params_ok) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_server_DH_params_ok)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.String(e.EncryptedAnswer)
-	return x.buf
 }
 
-func (e TL_server_DH_inner_data) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_server_DH_inner_data) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_server_DH_inner_data)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
@@ -9160,80 +9197,60 @@ func (e TL_server_DH_inner_data) encode() []byte {
 	x.String(e.DhPrime)
 	x.String(e.GA)
 	x.Int(e.ServerTime)
-	return x.buf
 }
 
-func (e TL_client_DH_inner_data) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_client_DH_inner_data) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_client_DH_inner_data)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.Long(e.RetryID)
 	x.String(e.GB)
-	return x.buf
 }
 
-func (e TL_dh_gen_ok) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dh_gen_ok) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dh_gen_ok)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.Bytes(e.NewNonceHash1)
-	return x.buf
 }
 
-func (e TL_dh_gen_retry) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dh_gen_retry) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dh_gen_retry)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.Bytes(e.NewNonceHash2)
-	return x.buf
 }
 
-func (e TL_dh_gen_fail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dh_gen_fail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dh_gen_fail)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.Bytes(e.NewNonceHash3)
-	return x.buf
 }
 
-func (e TL_destroy_auth_key_ok) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_destroy_auth_key_ok) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_destroy_auth_key_ok)
-	return x.buf
 }
 
-func (e TL_destroy_auth_key_none) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_destroy_auth_key_none) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_destroy_auth_key_none)
-	return x.buf
 }
 
-func (e TL_destroy_auth_key_fail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_destroy_auth_key_fail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_destroy_auth_key_fail)
-	return x.buf
 }
 
-func (e TL_req_pq) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_req_pq) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_req_pq)
 	x.Bytes(e.Nonce)
-	return x.buf
 }
 
-func (e TL_req_pq_multi) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_req_pq_multi) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_req_pq_multi)
 	x.Bytes(e.Nonce)
-	return x.buf
 }
 
-func (e TL_req_DH_params) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_req_DH_params) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_req_DH_params)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
@@ -9241,511 +9258,383 @@ func (e TL_req_DH_params) encode() []byte {
 	x.String(e.Q)
 	x.Long(e.PublicKeyFingerprint)
 	x.String(e.EncryptedData)
-	return x.buf
 }
 
-func (e TL_set_client_DH_params) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_set_client_DH_params) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_set_client_DH_params)
 	x.Bytes(e.Nonce)
 	x.Bytes(e.ServerNonce)
 	x.String(e.EncryptedData)
-	return x.buf
 }
 
-func (e TL_destroy_auth_key) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_destroy_auth_key) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_destroy_auth_key)
-	return x.buf
 }
 
-func (e TL_msgs_ack) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_msgs_ack) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_msgs_ack)
 	x.VectorLong(e.MsgIds)
-	return x.buf
 }
 
-func (e TL_bad_msg_notification) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_bad_msg_notification) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_bad_msg_notification)
 	x.Long(e.BadMsgID)
 	x.Int(e.BadMsgSeqno)
 	x.Int(e.ErrorCode)
-	return x.buf
 }
 
-func (e TL_bad_server_salt) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_bad_server_salt) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_bad_server_salt)
 	x.Long(e.BadMsgID)
 	x.Int(e.BadMsgSeqno)
 	x.Int(e.ErrorCode)
 	x.Long(e.NewServerSalt)
-	return x.buf
 }
 
-func (e TL_msgs_state_req) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_msgs_state_req) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_msgs_state_req)
 	x.VectorLong(e.MsgIds)
-	return x.buf
 }
 
-func (e TL_msgs_state_info) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_msgs_state_info) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_msgs_state_info)
 	x.Long(e.ReqMsgID)
 	x.String(e.Info)
-	return x.buf
 }
 
-func (e TL_msgs_all_info) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_msgs_all_info) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_msgs_all_info)
 	x.VectorLong(e.MsgIds)
 	x.String(e.Info)
-	return x.buf
 }
 
-func (e TL_msg_detailed_info) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_msg_detailed_info) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_msg_detailed_info)
 	x.Long(e.MsgID)
 	x.Long(e.AnswerMsgID)
 	x.Int(e.Bytes)
 	x.Int(e.Status)
-	return x.buf
 }
 
-func (e TL_msg_new_detailed_info) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_msg_new_detailed_info) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_msg_new_detailed_info)
 	x.Long(e.AnswerMsgID)
 	x.Int(e.Bytes)
 	x.Int(e.Status)
-	return x.buf
 }
 
-func (e TL_msg_resend_req) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_msg_resend_req) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_msg_resend_req)
 	x.VectorLong(e.MsgIds)
-	return x.buf
 }
 
-func (e TL_rpc_error) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_rpc_error) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_rpc_error)
 	x.Int(e.ErrorCode)
 	x.String(e.ErrorMessage)
-	return x.buf
 }
 
-func (e TL_rpc_answer_unknown) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_rpc_answer_unknown) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_rpc_answer_unknown)
-	return x.buf
 }
 
-func (e TL_rpc_answer_dropped_running) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_rpc_answer_dropped_running) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_rpc_answer_dropped_running)
-	return x.buf
 }
 
-func (e TL_rpc_answer_dropped) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_rpc_answer_dropped) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_rpc_answer_dropped)
 	x.Long(e.MsgID)
 	x.Int(e.SeqNo)
 	x.Int(e.Bytes)
-	return x.buf
 }
 
-func (e TL_future_salt) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_future_salt) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_future_salt)
 	x.Int(e.ValidSince)
 	x.Int(e.ValidUntil)
 	x.Long(e.Salt)
-	return x.buf
 }
 
-func (e TL_future_salts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_future_salts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_future_salts)
 	x.Long(e.ReqMsgID)
 	x.Int(e.Now)
-	x.Bytes(e.Salts.encode())
-	return x.buf
+	x.BareVectorOfBare(e.Salts)
 }
 
-func (e TL_pong) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pong) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pong)
 	x.Long(e.MsgID)
 	x.Long(e.PingID)
-	return x.buf
 }
 
-func (e TL_destroy_session_ok) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_destroy_session_ok) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_destroy_session_ok)
 	x.Long(e.SessionID)
-	return x.buf
 }
 
-func (e TL_destroy_session_none) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_destroy_session_none) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_destroy_session_none)
 	x.Long(e.SessionID)
-	return x.buf
 }
 
-func (e TL_new_session_created) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_new_session_created) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_new_session_created)
 	x.Long(e.FirstMsgID)
 	x.Long(e.UniqueID)
 	x.Long(e.ServerSalt)
-	return x.buf
 }
 
-func (e TL_http_wait) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_http_wait) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_http_wait)
 	x.Int(e.MaxDelay)
 	x.Int(e.WaitAfter)
 	x.Int(e.MaxWait)
-	return x.buf
 }
 
-func (e TL_ipPort) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_ipPort) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_ipPort)
 	x.Int(e.Ipv4)
 	x.Int(e.Port)
-	return x.buf
 }
 
-func (e TL_ipPortSecret) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_ipPortSecret) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_ipPortSecret)
 	x.Int(e.Ipv4)
 	x.Int(e.Port)
 	x.StringBytes(e.Secret)
-	return x.buf
 }
 
-func (e TL_accessPointRule) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_accessPointRule) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_accessPointRule)
 	x.String(e.PhonePrefixRules)
 	x.Int(e.DcID)
-	x.Bytes(e.Ips.encode())
-	return x.buf
+	x.BareVector(e.Ips)
 }
 
-func (e TL_help_configSimple) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_configSimple) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_configSimple)
 	x.Int(e.Date)
 	x.Int(e.Expires)
-	x.Bytes(e.Rules.encode())
-	return x.buf
+	x.BareVector(e.Rules)
 }
 
-func (e TL_tlsClientHello) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_tlsClientHello) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_tlsClientHello)
-	x.Bytes(e.Blocks.encode())
-	return x.buf
+	x.BareVector(e.Blocks)
 }
 
-func (e TL_tlsBlockString) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_tlsBlockString) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_tlsBlockString)
 	x.String(e.Data)
-	return x.buf
 }
 
-func (e TL_tlsBlockRandom) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_tlsBlockRandom) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_tlsBlockRandom)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_tlsBlockZero) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_tlsBlockZero) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_tlsBlockZero)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_tlsBlockDomain) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_tlsBlockDomain) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_tlsBlockDomain)
-	return x.buf
 }
 
-func (e TL_tlsBlockGrease) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_tlsBlockGrease) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_tlsBlockGrease)
 	x.Int(e.Seed)
-	return x.buf
 }
 
-func (e TL_tlsBlockPublicKey) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_tlsBlockPublicKey) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_tlsBlockPublicKey)
-	return x.buf
 }
 
-func (e TL_tlsBlockScope) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_tlsBlockScope) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_tlsBlockScope)
 	x.Vector(e.Entries)
-	return x.buf
 }
 
-func (e TL_rpc_drop_answer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_rpc_drop_answer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_rpc_drop_answer)
 	x.Long(e.ReqMsgID)
-	return x.buf
 }
 
-func (e TL_get_future_salts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_get_future_salts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_get_future_salts)
 	x.Int(e.Num)
-	return x.buf
 }
 
-func (e TL_ping) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_ping) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_ping)
 	x.Long(e.PingID)
-	return x.buf
 }
 
-func (e TL_ping_delay_disconnect) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_ping_delay_disconnect) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_ping_delay_disconnect)
 	x.Long(e.PingID)
 	x.Int(e.DisconnectDelay)
-	return x.buf
 }
 
-func (e TL_destroy_session) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_destroy_session) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_destroy_session)
 	x.Long(e.SessionID)
-	return x.buf
 }
 
-func (e TL_boolFalse) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_boolFalse) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_boolFalse)
-	return x.buf
 }
 
-func (e TL_boolTrue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_boolTrue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_boolTrue)
-	return x.buf
 }
 
-func (e TL_true) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_true) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_true)
-	return x.buf
 }
 
-func (e TL_error) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_error) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_error)
 	x.Int(e.Code)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_null) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_null) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_null)
-	return x.buf
 }
 
-func (e TL_inputPeerEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerEmpty)
-	return x.buf
 }
 
-func (e TL_inputPeerSelf) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerSelf) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerSelf)
-	return x.buf
 }
 
-func (e TL_inputPeerChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerChat)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_inputPeerUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerUser)
 	x.Int(e.UserID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputPeerChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerChannel)
 	x.Int(e.ChannelID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputPeerUserFromMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerUserFromMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerUserFromMessage)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
 	x.Int(e.UserID)
-	return x.buf
 }
 
-func (e TL_inputPeerChannelFromMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerChannelFromMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerChannelFromMessage)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
 	x.Int(e.ChannelID)
-	return x.buf
 }
 
-func (e TL_inputUserEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputUserEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputUserEmpty)
-	return x.buf
 }
 
-func (e TL_inputUserSelf) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputUserSelf) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputUserSelf)
-	return x.buf
 }
 
-func (e TL_inputUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputUser)
 	x.Int(e.UserID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputUserFromMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputUserFromMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputUserFromMessage)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
 	x.Int(e.UserID)
-	return x.buf
 }
 
-func (e TL_inputPhoneContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPhoneContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPhoneContact)
 	x.Long(e.ClientID)
 	x.String(e.Phone)
 	x.String(e.FirstName)
 	x.String(e.LastName)
-	return x.buf
 }
 
-func (e TL_inputFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputFile)
 	x.Long(e.ID)
 	x.Int(e.Parts)
 	x.String(e.Name)
 	x.String(e.Md5Checksum)
-	return x.buf
 }
 
-func (e TL_inputFileBig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputFileBig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputFileBig)
 	x.Long(e.ID)
 	x.Int(e.Parts)
 	x.String(e.Name)
-	return x.buf
 }
 
-func (e TL_inputMediaEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaEmpty)
-	return x.buf
 }
 
-func (e TL_inputMediaUploadedPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaUploadedPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaUploadedPhoto)
 	x.Int(e.Flags)
-	x.Bytes(e.File.encode())
+	x.Object(e.File)
 	if e.Flags&1 != 0 {
 		x.Vector(e.Stickers)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.TtlSeconds)
 	}
-	return x.buf
 }
 
-func (e TL_inputMediaPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaPhoto)
 	x.Int(e.Flags)
-	x.Bytes(e.ID.encode())
+	x.Object(e.ID)
 	if e.Flags&1 != 0 {
 		x.Int(e.TtlSeconds)
 	}
-	return x.buf
 }
 
-func (e TL_inputMediaGeoPoint) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaGeoPoint) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaGeoPoint)
-	x.Bytes(e.GeoPoint.encode())
-	return x.buf
+	x.Object(e.GeoPoint)
 }
 
-func (e TL_inputMediaContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaContact)
 	x.String(e.PhoneNumber)
 	x.String(e.FirstName)
 	x.String(e.LastName)
 	x.String(e.Vcard)
-	return x.buf
 }
 
-func (e TL_inputMediaUploadedDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaUploadedDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaUploadedDocument)
 	x.Int(e.Flags)
 	//flag NosoundVideo
 	//flag ForceFile
-	x.Bytes(e.File.encode())
+	x.Object(e.File)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Thumb.encode())
+		x.Object(e.Thumb)
 	}
 	x.String(e.MimeType)
 	x.Vector(e.Attributes)
@@ -9755,87 +9644,73 @@ func (e TL_inputMediaUploadedDocument) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Int(e.TtlSeconds)
 	}
-	return x.buf
 }
 
-func (e TL_inputMediaDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaDocument)
 	x.Int(e.Flags)
-	x.Bytes(e.ID.encode())
+	x.Object(e.ID)
 	if e.Flags&1 != 0 {
 		x.Int(e.TtlSeconds)
 	}
 	if e.Flags&2 != 0 {
 		x.String(e.Query)
 	}
-	return x.buf
 }
 
-func (e TL_inputMediaVenue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaVenue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaVenue)
-	x.Bytes(e.GeoPoint.encode())
+	x.Object(e.GeoPoint)
 	x.String(e.Title)
 	x.String(e.Address)
 	x.String(e.Provider)
 	x.String(e.VenueID)
 	x.String(e.VenueType)
-	return x.buf
 }
 
-func (e TL_inputMediaPhotoExternal) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaPhotoExternal) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaPhotoExternal)
 	x.Int(e.Flags)
 	x.String(e.Url)
 	if e.Flags&1 != 0 {
 		x.Int(e.TtlSeconds)
 	}
-	return x.buf
 }
 
-func (e TL_inputMediaDocumentExternal) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaDocumentExternal) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaDocumentExternal)
 	x.Int(e.Flags)
 	x.String(e.Url)
 	if e.Flags&1 != 0 {
 		x.Int(e.TtlSeconds)
 	}
-	return x.buf
 }
 
-func (e TL_inputMediaGame) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaGame) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaGame)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_inputMediaInvoice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaInvoice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaInvoice)
 	x.Int(e.Flags)
 	x.String(e.Title)
 	x.String(e.Description)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Photo.encode())
+		x.Object(e.Photo)
 	}
-	x.Bytes(e.Invoice.encode())
+	x.Object(e.Invoice)
 	x.StringBytes(e.Payload)
 	x.String(e.Provider)
-	x.Bytes(e.ProviderData.encode())
+	x.Object(e.ProviderData)
 	x.String(e.StartParam)
-	return x.buf
 }
 
-func (e TL_inputMediaGeoLive) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaGeoLive) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaGeoLive)
 	x.Int(e.Flags)
 	//flag Stopped
-	x.Bytes(e.GeoPoint.encode())
+	x.Object(e.GeoPoint)
 	if e.Flags&4 != 0 {
 		x.Int(e.Heading)
 	}
@@ -9845,16 +9720,14 @@ func (e TL_inputMediaGeoLive) encode() []byte {
 	if e.Flags&8 != 0 {
 		x.Int(e.ProximityNotificationRadius)
 	}
-	return x.buf
 }
 
-func (e TL_inputMediaPoll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaPoll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaPoll)
 	x.Int(e.Flags)
-	x.Bytes(e.Poll.encode())
+	x.Object(e.Poll)
 	if e.Flags&1 != 0 {
-		x.Vector(e.CorrectAnswers)
+		x.VectorBytes(e.CorrectAnswers)
 	}
 	if e.Flags&2 != 0 {
 		x.String(e.Solution)
@@ -9862,53 +9735,41 @@ func (e TL_inputMediaPoll) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Vector(e.SolutionEntities)
 	}
-	return x.buf
 }
 
-func (e TL_inputMediaDice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMediaDice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMediaDice)
 	x.String(e.Emoticon)
-	return x.buf
 }
 
-func (e TL_inputChatPhotoEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputChatPhotoEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputChatPhotoEmpty)
-	return x.buf
 }
 
-func (e TL_inputChatUploadedPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputChatUploadedPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputChatUploadedPhoto)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.File.encode())
+		x.Object(e.File)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Video.encode())
+		x.Object(e.Video)
 	}
 	if e.Flags&4 != 0 {
 		x.Double(e.VideoStartTs)
 	}
-	return x.buf
 }
 
-func (e TL_inputChatPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputChatPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputChatPhoto)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_inputGeoPointEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputGeoPointEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputGeoPointEmpty)
-	return x.buf
 }
 
-func (e TL_inputGeoPoint) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputGeoPoint) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputGeoPoint)
 	x.Int(e.Flags)
 	x.Double(e.Lat)
@@ -9916,78 +9777,60 @@ func (e TL_inputGeoPoint) encode() []byte {
 	if e.Flags&1 != 0 {
 		x.Int(e.AccuracyRadius)
 	}
-	return x.buf
 }
 
-func (e TL_inputPhotoEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPhotoEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPhotoEmpty)
-	return x.buf
 }
 
-func (e TL_inputPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPhoto)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
 	x.StringBytes(e.FileReference)
-	return x.buf
 }
 
-func (e TL_inputFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputFileLocation)
 	x.Long(e.VolumeID)
 	x.Int(e.LocalID)
 	x.Long(e.Secret)
 	x.StringBytes(e.FileReference)
-	return x.buf
 }
 
-func (e TL_inputEncryptedFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputEncryptedFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputEncryptedFileLocation)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputDocumentFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputDocumentFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputDocumentFileLocation)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
 	x.StringBytes(e.FileReference)
 	x.String(e.ThumbSize)
-	return x.buf
 }
 
-func (e TL_inputSecureFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputSecureFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputSecureFileLocation)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputTakeoutFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputTakeoutFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputTakeoutFileLocation)
-	return x.buf
 }
 
-func (e TL_inputPhotoFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPhotoFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPhotoFileLocation)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
 	x.StringBytes(e.FileReference)
 	x.String(e.ThumbSize)
-	return x.buf
 }
 
-func (e TL_inputPhotoLegacyFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPhotoLegacyFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPhotoLegacyFileLocation)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
@@ -9995,128 +9838,92 @@ func (e TL_inputPhotoLegacyFileLocation) encode() []byte {
 	x.Long(e.VolumeID)
 	x.Int(e.LocalID)
 	x.Long(e.Secret)
-	return x.buf
 }
 
-func (e TL_inputPeerPhotoFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerPhotoFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerPhotoFileLocation)
 	x.Int(e.Flags)
 	//flag Big
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Long(e.VolumeID)
 	x.Int(e.LocalID)
-	return x.buf
 }
 
-func (e TL_inputStickerSetThumb) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickerSetThumb) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickerSetThumb)
-	x.Bytes(e.Stickerset.encode())
+	x.Object(e.Stickerset)
 	x.Long(e.VolumeID)
 	x.Int(e.LocalID)
-	return x.buf
 }
 
-func (e TL_inputGroupCallStream) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputGroupCallStream) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputGroupCallStream)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.Long(e.TimeMs)
 	x.Int(e.Scale)
-	return x.buf
 }
 
-func (e TL_peerUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_peerUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_peerUser)
 	x.Int(e.UserID)
-	return x.buf
 }
 
-func (e TL_peerChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_peerChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_peerChat)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_peerChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_peerChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_peerChannel)
 	x.Int(e.ChannelID)
-	return x.buf
 }
 
-func (e TL_storage_fileUnknown) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_fileUnknown) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_fileUnknown)
-	return x.buf
 }
 
-func (e TL_storage_filePartial) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_filePartial) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_filePartial)
-	return x.buf
 }
 
-func (e TL_storage_fileJpeg) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_fileJpeg) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_fileJpeg)
-	return x.buf
 }
 
-func (e TL_storage_fileGif) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_fileGif) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_fileGif)
-	return x.buf
 }
 
-func (e TL_storage_filePng) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_filePng) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_filePng)
-	return x.buf
 }
 
-func (e TL_storage_filePdf) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_filePdf) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_filePdf)
-	return x.buf
 }
 
-func (e TL_storage_fileMp3) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_fileMp3) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_fileMp3)
-	return x.buf
 }
 
-func (e TL_storage_fileMov) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_fileMov) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_fileMov)
-	return x.buf
 }
 
-func (e TL_storage_fileMp4) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_fileMp4) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_fileMp4)
-	return x.buf
 }
 
-func (e TL_storage_fileWebp) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_storage_fileWebp) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_storage_fileWebp)
-	return x.buf
 }
 
-func (e TL_userEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userEmpty)
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_user) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_user) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_user)
 	x.Int(e.Flags)
 	//flag Self
@@ -10151,10 +9958,10 @@ func (e TL_user) encode() []byte {
 		x.String(e.Phone)
 	}
 	if e.Flags&32 != 0 {
-		x.Bytes(e.Photo.encode())
+		x.Object(e.Photo)
 	}
 	if e.Flags&64 != 0 {
-		x.Bytes(e.Status.encode())
+		x.Object(e.Status)
 	}
 	if e.Flags&16384 != 0 {
 		x.Int(e.BotInfoVersion)
@@ -10168,74 +9975,54 @@ func (e TL_user) encode() []byte {
 	if e.Flags&4194304 != 0 {
 		x.String(e.LangCode)
 	}
-	return x.buf
 }
 
-func (e TL_userProfilePhotoEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userProfilePhotoEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userProfilePhotoEmpty)
-	return x.buf
 }
 
-func (e TL_userProfilePhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userProfilePhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userProfilePhoto)
 	x.Int(e.Flags)
 	//flag HasVideo
 	x.Long(e.PhotoID)
-	x.Bytes(e.PhotoSmall.encode())
-	x.Bytes(e.PhotoBig.encode())
+	x.Object(e.PhotoSmall)
+	x.Object(e.PhotoBig)
 	x.Int(e.DcID)
-	return x.buf
 }
 
-func (e TL_userStatusEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userStatusEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userStatusEmpty)
-	return x.buf
 }
 
-func (e TL_userStatusOnline) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userStatusOnline) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userStatusOnline)
 	x.Int(e.Expires)
-	return x.buf
 }
 
-func (e TL_userStatusOffline) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userStatusOffline) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userStatusOffline)
 	x.Int(e.WasOnline)
-	return x.buf
 }
 
-func (e TL_userStatusRecently) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userStatusRecently) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userStatusRecently)
-	return x.buf
 }
 
-func (e TL_userStatusLastWeek) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userStatusLastWeek) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userStatusLastWeek)
-	return x.buf
 }
 
-func (e TL_userStatusLastMonth) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userStatusLastMonth) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userStatusLastMonth)
-	return x.buf
 }
 
-func (e TL_chatEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatEmpty)
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_chat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chat)
 	x.Int(e.Flags)
 	//flag Creator
@@ -10246,32 +10033,28 @@ func (e TL_chat) encode() []byte {
 	//flag CallNotEmpty
 	x.Int(e.ID)
 	x.String(e.Title)
-	x.Bytes(e.Photo.encode())
+	x.Object(e.Photo)
 	x.Int(e.ParticipantsCount)
 	x.Int(e.Date)
 	x.Int(e.Version)
 	if e.Flags&64 != 0 {
-		x.Bytes(e.MigratedTo.encode())
+		x.Object(e.MigratedTo)
 	}
 	if e.Flags&16384 != 0 {
-		x.Bytes(e.AdminRights.encode())
+		x.Object(e.AdminRights)
 	}
 	if e.Flags&262144 != 0 {
-		x.Bytes(e.DefaultBannedRights.encode())
+		x.Object(e.DefaultBannedRights)
 	}
-	return x.buf
 }
 
-func (e TL_chatForbidden) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatForbidden) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatForbidden)
 	x.Int(e.ID)
 	x.String(e.Title)
-	return x.buf
 }
 
-func (e TL_channel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channel)
 	x.Int(e.Flags)
 	//flag Creator
@@ -10298,29 +10081,27 @@ func (e TL_channel) encode() []byte {
 	if e.Flags&64 != 0 {
 		x.String(e.Username)
 	}
-	x.Bytes(e.Photo.encode())
+	x.Object(e.Photo)
 	x.Int(e.Date)
 	x.Int(e.Version)
 	if e.Flags&512 != 0 {
 		x.Vector(e.RestrictionReason)
 	}
 	if e.Flags&16384 != 0 {
-		x.Bytes(e.AdminRights.encode())
+		x.Object(e.AdminRights)
 	}
 	if e.Flags&32768 != 0 {
-		x.Bytes(e.BannedRights.encode())
+		x.Object(e.BannedRights)
 	}
 	if e.Flags&262144 != 0 {
-		x.Bytes(e.DefaultBannedRights.encode())
+		x.Object(e.DefaultBannedRights)
 	}
 	if e.Flags&131072 != 0 {
 		x.Int(e.ParticipantsCount)
 	}
-	return x.buf
 }
 
-func (e TL_channelForbidden) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelForbidden) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelForbidden)
 	x.Int(e.Flags)
 	//flag Broadcast
@@ -10331,24 +10112,22 @@ func (e TL_channelForbidden) encode() []byte {
 	if e.Flags&65536 != 0 {
 		x.Int(e.UntilDate)
 	}
-	return x.buf
 }
 
-func (e TL_chatFull) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatFull) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatFull)
 	x.Int(e.Flags)
 	//flag CanSetUsername
 	//flag HasScheduled
 	x.Int(e.ID)
 	x.String(e.About)
-	x.Bytes(e.Participants.encode())
+	x.Object(e.Participants)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ChatPhoto.encode())
+		x.Object(e.ChatPhoto)
 	}
-	x.Bytes(e.NotifySettings.encode())
+	x.Object(e.NotifySettings)
 	if e.Flags&8192 != 0 {
-		x.Bytes(e.ExportedInvite.encode())
+		x.Object(e.ExportedInvite)
 	}
 	if e.Flags&8 != 0 {
 		x.Vector(e.BotInfo)
@@ -10360,19 +10139,17 @@ func (e TL_chatFull) encode() []byte {
 		x.Int(e.FolderID)
 	}
 	if e.Flags&4096 != 0 {
-		x.Bytes(e.Call.encode())
+		x.Object(e.Call)
 	}
 	if e.Flags&16384 != 0 {
 		x.Int(e.TtlPeriod)
 	}
 	if e.Flags&32768 != 0 {
-		x.Bytes(e.GroupcallDefaultJoinAs.encode())
+		x.Object(e.GroupcallDefaultJoinAs)
 	}
-	return x.buf
 }
 
-func (e TL_channelFull) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelFull) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelFull)
 	x.Int(e.Flags)
 	//flag CanViewParticipants
@@ -10403,10 +10180,10 @@ func (e TL_channelFull) encode() []byte {
 	x.Int(e.ReadInboxMaxID)
 	x.Int(e.ReadOutboxMaxID)
 	x.Int(e.UnreadCount)
-	x.Bytes(e.ChatPhoto.encode())
-	x.Bytes(e.NotifySettings.encode())
+	x.Object(e.ChatPhoto)
+	x.Object(e.NotifySettings)
 	if e.Flags&8388608 != 0 {
-		x.Bytes(e.ExportedInvite.encode())
+		x.Object(e.ExportedInvite)
 	}
 	x.Vector(e.BotInfo)
 	if e.Flags&16 != 0 {
@@ -10419,7 +10196,7 @@ func (e TL_channelFull) encode() []byte {
 		x.Int(e.PinnedMsgID)
 	}
 	if e.Flags&256 != 0 {
-		x.Bytes(e.Stickerset.encode())
+		x.Object(e.Stickerset)
 	}
 	if e.Flags&512 != 0 {
 		x.Int(e.AvailableMinID)
@@ -10431,7 +10208,7 @@ func (e TL_channelFull) encode() []byte {
 		x.Int(e.LinkedChatID)
 	}
 	if e.Flags&32768 != 0 {
-		x.Bytes(e.Location.encode())
+		x.Object(e.Location)
 	}
 	if e.Flags&131072 != 0 {
 		x.Int(e.SlowmodeSeconds)
@@ -10444,7 +10221,7 @@ func (e TL_channelFull) encode() []byte {
 	}
 	x.Int(e.Pts)
 	if e.Flags&2097152 != 0 {
-		x.Bytes(e.Call.encode())
+		x.Object(e.Call)
 	}
 	if e.Flags&16777216 != 0 {
 		x.Int(e.TtlPeriod)
@@ -10453,86 +10230,68 @@ func (e TL_channelFull) encode() []byte {
 		x.VectorString(e.PendingSuggestions)
 	}
 	if e.Flags&67108864 != 0 {
-		x.Bytes(e.GroupcallDefaultJoinAs.encode())
+		x.Object(e.GroupcallDefaultJoinAs)
 	}
-	return x.buf
 }
 
-func (e TL_chatParticipant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatParticipant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatParticipant)
 	x.Int(e.UserID)
 	x.Int(e.InviterID)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_chatParticipantCreator) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatParticipantCreator) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatParticipantCreator)
 	x.Int(e.UserID)
-	return x.buf
 }
 
-func (e TL_chatParticipantAdmin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatParticipantAdmin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatParticipantAdmin)
 	x.Int(e.UserID)
 	x.Int(e.InviterID)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_chatParticipantsForbidden) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatParticipantsForbidden) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatParticipantsForbidden)
 	x.Int(e.Flags)
 	x.Int(e.ChatID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.SelfParticipant.encode())
+		x.Object(e.SelfParticipant)
 	}
-	return x.buf
 }
 
-func (e TL_chatParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatParticipants)
 	x.Int(e.ChatID)
 	x.Vector(e.Participants)
 	x.Int(e.Version)
-	return x.buf
 }
 
-func (e TL_chatPhotoEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatPhotoEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatPhotoEmpty)
-	return x.buf
 }
 
-func (e TL_chatPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatPhoto)
 	x.Int(e.Flags)
 	//flag HasVideo
-	x.Bytes(e.PhotoSmall.encode())
-	x.Bytes(e.PhotoBig.encode())
+	x.Object(e.PhotoSmall)
+	x.Object(e.PhotoBig)
 	x.Int(e.DcID)
-	return x.buf
 }
 
-func (e TL_messageEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEmpty)
 	x.Int(e.Flags)
 	x.Int(e.ID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.PeerID.encode())
+		x.Object(e.PeerID)
 	}
-	return x.buf
 }
 
-func (e TL_message) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_message) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_message)
 	x.Int(e.Flags)
 	//flag Out
@@ -10546,25 +10305,25 @@ func (e TL_message) encode() []byte {
 	//flag Pinned
 	x.Int(e.ID)
 	if e.Flags&256 != 0 {
-		x.Bytes(e.FromID.encode())
+		x.Object(e.FromID)
 	}
-	x.Bytes(e.PeerID.encode())
+	x.Object(e.PeerID)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.FwdFrom.encode())
+		x.Object(e.FwdFrom)
 	}
 	if e.Flags&2048 != 0 {
 		x.Int(e.ViaBotID)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.ReplyTo.encode())
+		x.Object(e.ReplyTo)
 	}
 	x.Int(e.Date)
 	x.String(e.Message)
 	if e.Flags&512 != 0 {
-		x.Bytes(e.Media.encode())
+		x.Object(e.Media)
 	}
 	if e.Flags&64 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
 	if e.Flags&128 != 0 {
 		x.Vector(e.Entities)
@@ -10576,7 +10335,7 @@ func (e TL_message) encode() []byte {
 		x.Int(e.Forwards)
 	}
 	if e.Flags&8388608 != 0 {
-		x.Bytes(e.Replies.encode())
+		x.Object(e.Replies)
 	}
 	if e.Flags&32768 != 0 {
 		x.Int(e.EditDate)
@@ -10593,11 +10352,9 @@ func (e TL_message) encode() []byte {
 	if e.Flags&33554432 != 0 {
 		x.Int(e.TtlPeriod)
 	}
-	return x.buf
 }
 
-func (e TL_messageService) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageService) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageService)
 	x.Int(e.Flags)
 	//flag Out
@@ -10608,104 +10365,84 @@ func (e TL_messageService) encode() []byte {
 	//flag Legacy
 	x.Int(e.ID)
 	if e.Flags&256 != 0 {
-		x.Bytes(e.FromID.encode())
+		x.Object(e.FromID)
 	}
-	x.Bytes(e.PeerID.encode())
+	x.Object(e.PeerID)
 	if e.Flags&8 != 0 {
-		x.Bytes(e.ReplyTo.encode())
+		x.Object(e.ReplyTo)
 	}
 	x.Int(e.Date)
-	x.Bytes(e.Action.encode())
+	x.Object(e.Action)
 	if e.Flags&33554432 != 0 {
 		x.Int(e.TtlPeriod)
 	}
-	return x.buf
 }
 
-func (e TL_messageMediaEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaEmpty)
-	return x.buf
 }
 
-func (e TL_messageMediaPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaPhoto)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Photo.encode())
+		x.Object(e.Photo)
 	}
 	if e.Flags&4 != 0 {
 		x.Int(e.TtlSeconds)
 	}
-	return x.buf
 }
 
-func (e TL_messageMediaGeo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaGeo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaGeo)
-	x.Bytes(e.Geo.encode())
-	return x.buf
+	x.Object(e.Geo)
 }
 
-func (e TL_messageMediaContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaContact)
 	x.String(e.PhoneNumber)
 	x.String(e.FirstName)
 	x.String(e.LastName)
 	x.String(e.Vcard)
 	x.Int(e.UserID)
-	return x.buf
 }
 
-func (e TL_messageMediaUnsupported) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaUnsupported) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaUnsupported)
-	return x.buf
 }
 
-func (e TL_messageMediaDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaDocument)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Document.encode())
+		x.Object(e.Document)
 	}
 	if e.Flags&4 != 0 {
 		x.Int(e.TtlSeconds)
 	}
-	return x.buf
 }
 
-func (e TL_messageMediaWebPage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaWebPage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaWebPage)
-	x.Bytes(e.Webpage.encode())
-	return x.buf
+	x.Object(e.Webpage)
 }
 
-func (e TL_messageMediaVenue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaVenue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaVenue)
-	x.Bytes(e.Geo.encode())
+	x.Object(e.Geo)
 	x.String(e.Title)
 	x.String(e.Address)
 	x.String(e.Provider)
 	x.String(e.VenueID)
 	x.String(e.VenueType)
-	return x.buf
 }
 
-func (e TL_messageMediaGame) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaGame) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaGame)
-	x.Bytes(e.Game.encode())
-	return x.buf
+	x.Object(e.Game)
 }
 
-func (e TL_messageMediaInvoice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaInvoice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaInvoice)
 	x.Int(e.Flags)
 	//flag ShippingAddressRequested
@@ -10713,7 +10450,7 @@ func (e TL_messageMediaInvoice) encode() []byte {
 	x.String(e.Title)
 	x.String(e.Description)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Photo.encode())
+		x.Object(e.Photo)
 	}
 	if e.Flags&4 != 0 {
 		x.Int(e.ReceiptMsgID)
@@ -10721,14 +10458,12 @@ func (e TL_messageMediaInvoice) encode() []byte {
 	x.String(e.Currency)
 	x.Long(e.TotalAmount)
 	x.String(e.StartParam)
-	return x.buf
 }
 
-func (e TL_messageMediaGeoLive) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaGeoLive) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaGeoLive)
 	x.Int(e.Flags)
-	x.Bytes(e.Geo.encode())
+	x.Object(e.Geo)
 	if e.Flags&1 != 0 {
 		x.Int(e.Heading)
 	}
@@ -10736,287 +10471,221 @@ func (e TL_messageMediaGeoLive) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Int(e.ProximityNotificationRadius)
 	}
-	return x.buf
 }
 
-func (e TL_messageMediaPoll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaPoll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaPoll)
-	x.Bytes(e.Poll.encode())
-	x.Bytes(e.Results.encode())
-	return x.buf
+	x.Object(e.Poll)
+	x.Object(e.Results)
 }
 
-func (e TL_messageMediaDice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageMediaDice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageMediaDice)
 	x.Int(e.Value)
 	x.String(e.Emoticon)
-	return x.buf
 }
 
-func (e TL_messageActionEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionEmpty)
-	return x.buf
 }
 
-func (e TL_messageActionChatCreate) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChatCreate) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChatCreate)
 	x.String(e.Title)
 	x.VectorInt(e.Users)
-	return x.buf
 }
 
-func (e TL_messageActionChatEditTitle) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChatEditTitle) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChatEditTitle)
 	x.String(e.Title)
-	return x.buf
 }
 
-func (e TL_messageActionChatEditPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChatEditPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChatEditPhoto)
-	x.Bytes(e.Photo.encode())
-	return x.buf
+	x.Object(e.Photo)
 }
 
-func (e TL_messageActionChatDeletePhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChatDeletePhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChatDeletePhoto)
-	return x.buf
 }
 
-func (e TL_messageActionChatAddUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChatAddUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChatAddUser)
 	x.VectorInt(e.Users)
-	return x.buf
 }
 
-func (e TL_messageActionChatDeleteUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChatDeleteUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChatDeleteUser)
 	x.Int(e.UserID)
-	return x.buf
 }
 
-func (e TL_messageActionChatJoinedByLink) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChatJoinedByLink) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChatJoinedByLink)
 	x.Int(e.InviterID)
-	return x.buf
 }
 
-func (e TL_messageActionChannelCreate) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChannelCreate) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChannelCreate)
 	x.String(e.Title)
-	return x.buf
 }
 
-func (e TL_messageActionChatMigrateTo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChatMigrateTo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChatMigrateTo)
 	x.Int(e.ChannelID)
-	return x.buf
 }
 
-func (e TL_messageActionChannelMigrateFrom) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionChannelMigrateFrom) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionChannelMigrateFrom)
 	x.String(e.Title)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_messageActionPinMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionPinMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionPinMessage)
-	return x.buf
 }
 
-func (e TL_messageActionHistoryClear) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionHistoryClear) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionHistoryClear)
-	return x.buf
 }
 
-func (e TL_messageActionGameScore) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionGameScore) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionGameScore)
 	x.Long(e.GameID)
 	x.Int(e.Score)
-	return x.buf
 }
 
-func (e TL_messageActionPaymentSentMe) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionPaymentSentMe) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionPaymentSentMe)
 	x.Int(e.Flags)
 	x.String(e.Currency)
 	x.Long(e.TotalAmount)
 	x.StringBytes(e.Payload)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Info.encode())
+		x.Object(e.Info)
 	}
 	if e.Flags&2 != 0 {
 		x.String(e.ShippingOptionID)
 	}
-	x.Bytes(e.Charge.encode())
-	return x.buf
+	x.Object(e.Charge)
 }
 
-func (e TL_messageActionPaymentSent) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionPaymentSent) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionPaymentSent)
 	x.String(e.Currency)
 	x.Long(e.TotalAmount)
-	return x.buf
 }
 
-func (e TL_messageActionPhoneCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionPhoneCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionPhoneCall)
 	x.Int(e.Flags)
 	//flag Video
 	x.Long(e.CallID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Reason.encode())
+		x.Object(e.Reason)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.Duration)
 	}
-	return x.buf
 }
 
-func (e TL_messageActionScreenshotTaken) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionScreenshotTaken) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionScreenshotTaken)
-	return x.buf
 }
 
-func (e TL_messageActionCustomAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionCustomAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionCustomAction)
 	x.String(e.Message)
-	return x.buf
 }
 
-func (e TL_messageActionBotAllowed) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionBotAllowed) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionBotAllowed)
 	x.String(e.Domain)
-	return x.buf
 }
 
-func (e TL_messageActionSecureValuesSentMe) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionSecureValuesSentMe) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionSecureValuesSentMe)
 	x.Vector(e.Values)
-	x.Bytes(e.Credentials.encode())
-	return x.buf
+	x.Object(e.Credentials)
 }
 
-func (e TL_messageActionSecureValuesSent) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionSecureValuesSent) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionSecureValuesSent)
 	x.Vector(e.Types)
-	return x.buf
 }
 
-func (e TL_messageActionContactSignUp) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionContactSignUp) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionContactSignUp)
-	return x.buf
 }
 
-func (e TL_messageActionGeoProximityReached) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionGeoProximityReached) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionGeoProximityReached)
-	x.Bytes(e.FromID.encode())
-	x.Bytes(e.ToID.encode())
+	x.Object(e.FromID)
+	x.Object(e.ToID)
 	x.Int(e.Distance)
-	return x.buf
 }
 
-func (e TL_messageActionGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionGroupCall)
 	x.Int(e.Flags)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	if e.Flags&1 != 0 {
 		x.Int(e.Duration)
 	}
-	return x.buf
 }
 
-func (e TL_messageActionInviteToGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionInviteToGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionInviteToGroupCall)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.VectorInt(e.Users)
-	return x.buf
 }
 
-func (e TL_messageActionSetMessagesTTL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageActionSetMessagesTTL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageActionSetMessagesTTL)
 	x.Int(e.Period)
-	return x.buf
 }
 
-func (e TL_dialog) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dialog) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dialog)
 	x.Int(e.Flags)
 	//flag Pinned
 	//flag UnreadMark
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.TopMessage)
 	x.Int(e.ReadInboxMaxID)
 	x.Int(e.ReadOutboxMaxID)
 	x.Int(e.UnreadCount)
 	x.Int(e.UnreadMentionsCount)
-	x.Bytes(e.NotifySettings.encode())
+	x.Object(e.NotifySettings)
 	if e.Flags&1 != 0 {
 		x.Int(e.Pts)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Draft.encode())
+		x.Object(e.Draft)
 	}
 	if e.Flags&16 != 0 {
 		x.Int(e.FolderID)
 	}
-	return x.buf
 }
 
-func (e TL_dialogFolder) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dialogFolder) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dialogFolder)
 	x.Int(e.Flags)
 	//flag Pinned
-	x.Bytes(e.Folder.encode())
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Folder)
+	x.Object(e.Peer)
 	x.Int(e.TopMessage)
 	x.Int(e.UnreadMutedPeersCount)
 	x.Int(e.UnreadUnmutedPeersCount)
 	x.Int(e.UnreadMutedMessagesCount)
 	x.Int(e.UnreadUnmutedMessagesCount)
-	return x.buf
 }
 
-func (e TL_photoEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photoEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photoEmpty)
 	x.Long(e.ID)
-	return x.buf
 }
 
-func (e TL_photo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photo)
 	x.Int(e.Flags)
 	//flag HasStickers
@@ -11029,73 +10698,57 @@ func (e TL_photo) encode() []byte {
 		x.Vector(e.VideoSizes)
 	}
 	x.Int(e.DcID)
-	return x.buf
 }
 
-func (e TL_photoSizeEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photoSizeEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photoSizeEmpty)
 	x.String(e.Type)
-	return x.buf
 }
 
-func (e TL_photoSize) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photoSize) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photoSize)
 	x.String(e.Type)
-	x.Bytes(e.Location.encode())
+	x.Object(e.Location)
 	x.Int(e.W)
 	x.Int(e.H)
 	x.Int(e.Size)
-	return x.buf
 }
 
-func (e TL_photoCachedSize) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photoCachedSize) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photoCachedSize)
 	x.String(e.Type)
-	x.Bytes(e.Location.encode())
+	x.Object(e.Location)
 	x.Int(e.W)
 	x.Int(e.H)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_photoStrippedSize) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photoStrippedSize) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photoStrippedSize)
 	x.String(e.Type)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_photoSizeProgressive) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photoSizeProgressive) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photoSizeProgressive)
 	x.String(e.Type)
-	x.Bytes(e.Location.encode())
+	x.Object(e.Location)
 	x.Int(e.W)
 	x.Int(e.H)
 	x.VectorInt(e.Sizes)
-	return x.buf
 }
 
-func (e TL_photoPathSize) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photoPathSize) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photoPathSize)
 	x.String(e.Type)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_geoPointEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_geoPointEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_geoPointEmpty)
-	return x.buf
 }
 
-func (e TL_geoPoint) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_geoPoint) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_geoPoint)
 	x.Int(e.Flags)
 	x.Double(e.Long)
@@ -11104,87 +10757,69 @@ func (e TL_geoPoint) encode() []byte {
 	if e.Flags&1 != 0 {
 		x.Int(e.AccuracyRadius)
 	}
-	return x.buf
 }
 
-func (e TL_auth_sentCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_sentCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_sentCode)
 	x.Int(e.Flags)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.String(e.PhoneCodeHash)
 	if e.Flags&2 != 0 {
-		x.Bytes(e.NextType.encode())
+		x.Object(e.NextType)
 	}
 	if e.Flags&4 != 0 {
 		x.Int(e.Timeout)
 	}
-	return x.buf
 }
 
-func (e TL_auth_authorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_authorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_authorization)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
 		x.Int(e.TmpSessions)
 	}
-	x.Bytes(e.User.encode())
-	return x.buf
+	x.Object(e.User)
 }
 
-func (e TL_auth_authorizationSignUpRequired) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_authorizationSignUpRequired) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_authorizationSignUpRequired)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.TermsOfService.encode())
+		x.Object(e.TermsOfService)
 	}
-	return x.buf
 }
 
-func (e TL_auth_exportedAuthorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_exportedAuthorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_exportedAuthorization)
 	x.Int(e.ID)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_inputNotifyPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputNotifyPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputNotifyPeer)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_inputNotifyUsers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputNotifyUsers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputNotifyUsers)
-	return x.buf
 }
 
-func (e TL_inputNotifyChats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputNotifyChats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputNotifyChats)
-	return x.buf
 }
 
-func (e TL_inputNotifyBroadcasts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputNotifyBroadcasts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputNotifyBroadcasts)
-	return x.buf
 }
 
-func (e TL_inputPeerNotifySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPeerNotifySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPeerNotifySettings)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.ShowPreviews.encode())
+		x.Object(e.ShowPreviews)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Silent.encode())
+		x.Object(e.Silent)
 	}
 	if e.Flags&4 != 0 {
 		x.Int(e.MuteUntil)
@@ -11192,18 +10827,16 @@ func (e TL_inputPeerNotifySettings) encode() []byte {
 	if e.Flags&8 != 0 {
 		x.String(e.Sound)
 	}
-	return x.buf
 }
 
-func (e TL_peerNotifySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_peerNotifySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_peerNotifySettings)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.ShowPreviews.encode())
+		x.Object(e.ShowPreviews)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Silent.encode())
+		x.Object(e.Silent)
 	}
 	if e.Flags&4 != 0 {
 		x.Int(e.MuteUntil)
@@ -11211,29 +10844,25 @@ func (e TL_peerNotifySettings) encode() []byte {
 	if e.Flags&8 != 0 {
 		x.String(e.Sound)
 	}
-	return x.buf
 }
 
-func (e TL_peerSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_peerSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_peerSettings)
 	x.Int(e.Flags)
-	//flag ReportSpam
-	//flag AddContact
-	//flag BlockContact
-	//flag ShareContact
-	//flag NeedContactsException
-	//flag ReportGeo
-	//flag Autoarchived
-	//flag InviteMembers
+	// flag ReportSpam
+	// flag AddContact
+	// flag BlockContact
+	// flag ShareContact
+	// flag NeedContactsException
+	// flag ReportGeo
+	// flag Autoarchived
+	// flag InviteMembers
 	if e.Flags&64 != 0 {
 		x.Int(e.GeoDistance)
 	}
-	return x.buf
 }
 
-func (e TL_wallPaper) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_wallPaper) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_wallPaper)
 	x.Long(e.ID)
 	x.Int(e.Flags)
@@ -11243,75 +10872,55 @@ func (e TL_wallPaper) encode() []byte {
 	//flag Dark
 	x.Long(e.AccessHash)
 	x.String(e.Slug)
-	x.Bytes(e.Document.encode())
+	x.Object(e.Document)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Settings.encode())
+		x.Object(e.Settings)
 	}
-	return x.buf
 }
 
-func (e TL_wallPaperNoFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_wallPaperNoFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_wallPaperNoFile)
 	x.Int(e.Flags)
-	//flag Default
-	//flag Dark
+	// flag Default
+	// flag Dark
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Settings.encode())
+		x.Object(e.Settings)
 	}
-	return x.buf
 }
 
-func (e TL_inputReportReasonSpam) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputReportReasonSpam) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputReportReasonSpam)
-	return x.buf
 }
 
-func (e TL_inputReportReasonViolence) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputReportReasonViolence) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputReportReasonViolence)
-	return x.buf
 }
 
-func (e TL_inputReportReasonPornography) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputReportReasonPornography) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputReportReasonPornography)
-	return x.buf
 }
 
-func (e TL_inputReportReasonChildAbuse) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputReportReasonChildAbuse) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputReportReasonChildAbuse)
-	return x.buf
 }
 
-func (e TL_inputReportReasonOther) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputReportReasonOther) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputReportReasonOther)
-	return x.buf
 }
 
-func (e TL_inputReportReasonCopyright) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputReportReasonCopyright) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputReportReasonCopyright)
-	return x.buf
 }
 
-func (e TL_inputReportReasonGeoIrrelevant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputReportReasonGeoIrrelevant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputReportReasonGeoIrrelevant)
-	return x.buf
 }
 
-func (e TL_inputReportReasonFake) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputReportReasonFake) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputReportReasonFake)
-	return x.buf
 }
 
-func (e TL_userFull) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_userFull) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_userFull)
 	x.Int(e.Flags)
 	//flag Blocked
@@ -11320,17 +10929,17 @@ func (e TL_userFull) encode() []byte {
 	//flag CanPinMessage
 	//flag HasScheduled
 	//flag VideoCallsAvailable
-	x.Bytes(e.User.encode())
+	x.Object(e.User)
 	if e.Flags&2 != 0 {
 		x.String(e.About)
 	}
-	x.Bytes(e.Settings.encode())
+	x.Object(e.Settings)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ProfilePhoto.encode())
+		x.Object(e.ProfilePhoto)
 	}
-	x.Bytes(e.NotifySettings.encode())
+	x.Object(e.NotifySettings)
 	if e.Flags&8 != 0 {
-		x.Bytes(e.BotInfo.encode())
+		x.Object(e.BotInfo)
 	}
 	if e.Flags&64 != 0 {
 		x.Int(e.PinnedMsgID)
@@ -11342,116 +10951,90 @@ func (e TL_userFull) encode() []byte {
 	if e.Flags&16384 != 0 {
 		x.Int(e.TtlPeriod)
 	}
-	return x.buf
 }
 
-func (e TL_contact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contact)
 	x.Int(e.UserID)
-	x.Bytes(e.Mutual.encode())
-	return x.buf
+	x.Object(e.Mutual)
 }
 
-func (e TL_importedContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_importedContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_importedContact)
 	x.Int(e.UserID)
 	x.Long(e.ClientID)
-	return x.buf
 }
 
-func (e TL_contactStatus) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contactStatus) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contactStatus)
 	x.Int(e.UserID)
-	x.Bytes(e.Status.encode())
-	return x.buf
+	x.Object(e.Status)
 }
 
-func (e TL_contacts_contactsNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_contactsNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_contactsNotModified)
-	return x.buf
 }
 
-func (e TL_contacts_contacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_contacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_contacts)
 	x.Vector(e.Contacts)
 	x.Int(e.SavedCount)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_contacts_importedContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_importedContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_importedContacts)
 	x.Vector(e.Imported)
 	x.Vector(e.PopularInvites)
 	x.VectorLong(e.RetryContacts)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_contacts_blocked) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_blocked) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_blocked)
 	x.Vector(e.Blocked)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_contacts_blockedSlice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_blockedSlice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_blockedSlice)
 	x.Int(e.Count)
 	x.Vector(e.Blocked)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_dialogs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_dialogs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_dialogs)
 	x.Vector(e.Dialogs)
 	x.Vector(e.Messages)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_dialogsSlice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_dialogsSlice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_dialogsSlice)
 	x.Int(e.Count)
 	x.Vector(e.Dialogs)
 	x.Vector(e.Messages)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_dialogsNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_dialogsNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_dialogsNotModified)
 	x.Int(e.Count)
-	return x.buf
 }
 
-func (e TL_messages_messages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_messages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_messages)
 	x.Vector(e.Messages)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_messagesSlice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_messagesSlice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_messagesSlice)
 	x.Int(e.Flags)
 	//flag Inexact
@@ -11465,11 +11048,9 @@ func (e TL_messages_messagesSlice) encode() []byte {
 	x.Vector(e.Messages)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_channelMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_channelMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_channelMessages)
 	x.Int(e.Flags)
 	//flag Inexact
@@ -11481,401 +11062,301 @@ func (e TL_messages_channelMessages) encode() []byte {
 	x.Vector(e.Messages)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_messagesNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_messagesNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_messagesNotModified)
 	x.Int(e.Count)
-	return x.buf
 }
 
-func (e TL_messages_chats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_chats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_chats)
 	x.Vector(e.Chats)
-	return x.buf
 }
 
-func (e TL_messages_chatsSlice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_chatsSlice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_chatsSlice)
 	x.Int(e.Count)
 	x.Vector(e.Chats)
-	return x.buf
 }
 
-func (e TL_messages_chatFull) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_chatFull) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_chatFull)
-	x.Bytes(e.FullChat.encode())
+	x.Object(e.FullChat)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_affectedHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_affectedHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_affectedHistory)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
 	x.Int(e.Offset)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterEmpty)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterPhotos) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterPhotos) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterPhotos)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterVideo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterVideo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterVideo)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterPhotoVideo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterPhotoVideo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterPhotoVideo)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterDocument)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterUrl) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterUrl) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterUrl)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterGif) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterGif) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterGif)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterVoice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterVoice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterVoice)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterMusic) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterMusic) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterMusic)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterChatPhotos) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterChatPhotos) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterChatPhotos)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterPhoneCalls) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterPhoneCalls) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterPhoneCalls)
 	x.Int(e.Flags)
-	//flag Missed
-	return x.buf
+	// flag Missed
 }
 
-func (e TL_inputMessagesFilterRoundVoice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterRoundVoice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterRoundVoice)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterRoundVideo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterRoundVideo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterRoundVideo)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterMyMentions) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterMyMentions) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterMyMentions)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterGeo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterGeo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterGeo)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterContacts)
-	return x.buf
 }
 
-func (e TL_inputMessagesFilterPinned) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagesFilterPinned) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagesFilterPinned)
-	return x.buf
 }
 
-func (e TL_updateNewMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateNewMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateNewMessage)
-	x.Bytes(e.Message.encode())
+	x.Object(e.Message)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateMessageID) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateMessageID) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateMessageID)
 	x.Int(e.ID)
 	x.Long(e.RandomID)
-	return x.buf
 }
 
-func (e TL_updateDeleteMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDeleteMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDeleteMessages)
 	x.VectorInt(e.Messages)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateUserTyping) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateUserTyping) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateUserTyping)
 	x.Int(e.UserID)
-	x.Bytes(e.Action.encode())
-	return x.buf
+	x.Object(e.Action)
 }
 
-func (e TL_updateChatUserTyping) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChatUserTyping) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChatUserTyping)
 	x.Int(e.ChatID)
-	x.Bytes(e.FromID.encode())
-	x.Bytes(e.Action.encode())
-	return x.buf
+	x.Object(e.FromID)
+	x.Object(e.Action)
 }
 
-func (e TL_updateChatParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChatParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChatParticipants)
-	x.Bytes(e.Participants.encode())
-	return x.buf
+	x.Object(e.Participants)
 }
 
-func (e TL_updateUserStatus) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateUserStatus) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateUserStatus)
 	x.Int(e.UserID)
-	x.Bytes(e.Status.encode())
-	return x.buf
+	x.Object(e.Status)
 }
 
-func (e TL_updateUserName) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateUserName) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateUserName)
 	x.Int(e.UserID)
 	x.String(e.FirstName)
 	x.String(e.LastName)
 	x.String(e.Username)
-	return x.buf
 }
 
-func (e TL_updateUserPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateUserPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateUserPhoto)
 	x.Int(e.UserID)
 	x.Int(e.Date)
-	x.Bytes(e.Photo.encode())
-	x.Bytes(e.Previous.encode())
-	return x.buf
+	x.Object(e.Photo)
+	x.Object(e.Previous)
 }
 
-func (e TL_updateNewEncryptedMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateNewEncryptedMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateNewEncryptedMessage)
-	x.Bytes(e.Message.encode())
+	x.Object(e.Message)
 	x.Int(e.Qts)
-	return x.buf
 }
 
-func (e TL_updateEncryptedChatTyping) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateEncryptedChatTyping) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateEncryptedChatTyping)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_updateEncryption) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateEncryption) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateEncryption)
-	x.Bytes(e.Chat.encode())
+	x.Object(e.Chat)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_updateEncryptedMessagesRead) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateEncryptedMessagesRead) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateEncryptedMessagesRead)
 	x.Int(e.ChatID)
 	x.Int(e.MaxDate)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_updateChatParticipantAdd) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChatParticipantAdd) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChatParticipantAdd)
 	x.Int(e.ChatID)
 	x.Int(e.UserID)
 	x.Int(e.InviterID)
 	x.Int(e.Date)
 	x.Int(e.Version)
-	return x.buf
 }
 
-func (e TL_updateChatParticipantDelete) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChatParticipantDelete) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChatParticipantDelete)
 	x.Int(e.ChatID)
 	x.Int(e.UserID)
 	x.Int(e.Version)
-	return x.buf
 }
 
-func (e TL_updateDcOptions) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDcOptions) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDcOptions)
 	x.Vector(e.DcOptions)
-	return x.buf
 }
 
-func (e TL_updateNotifySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateNotifySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateNotifySettings)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.NotifySettings.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.NotifySettings)
 }
 
-func (e TL_updateServiceNotification) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateServiceNotification) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateServiceNotification)
 	x.Int(e.Flags)
-	//flag Popup
+	// flag Popup
 	if e.Flags&2 != 0 {
 		x.Int(e.InboxDate)
 	}
 	x.String(e.Type)
 	x.String(e.Message)
-	x.Bytes(e.Media.encode())
+	x.Object(e.Media)
 	x.Vector(e.Entities)
-	return x.buf
 }
 
-func (e TL_updatePrivacy) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePrivacy) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePrivacy)
-	x.Bytes(e.Key.encode())
+	x.Object(e.Key)
 	x.Vector(e.Rules)
-	return x.buf
 }
 
-func (e TL_updateUserPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateUserPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateUserPhone)
 	x.Int(e.UserID)
 	x.String(e.Phone)
-	return x.buf
 }
 
-func (e TL_updateReadHistoryInbox) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateReadHistoryInbox) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateReadHistoryInbox)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
 		x.Int(e.FolderID)
 	}
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MaxID)
 	x.Int(e.StillUnreadCount)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateReadHistoryOutbox) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateReadHistoryOutbox) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateReadHistoryOutbox)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MaxID)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateWebPage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateWebPage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateWebPage)
-	x.Bytes(e.Webpage.encode())
+	x.Object(e.Webpage)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateReadMessagesContents) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateReadMessagesContents) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateReadMessagesContents)
 	x.VectorInt(e.Messages)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateChannelTooLong) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannelTooLong) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannelTooLong)
 	x.Int(e.Flags)
 	x.Int(e.ChannelID)
 	if e.Flags&1 != 0 {
 		x.Int(e.Pts)
 	}
-	return x.buf
 }
 
-func (e TL_updateChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannel)
 	x.Int(e.ChannelID)
-	return x.buf
 }
 
-func (e TL_updateNewChannelMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateNewChannelMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateNewChannelMessage)
-	x.Bytes(e.Message.encode())
+	x.Object(e.Message)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateReadChannelInbox) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateReadChannelInbox) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateReadChannelInbox)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
@@ -11885,115 +11366,93 @@ func (e TL_updateReadChannelInbox) encode() []byte {
 	x.Int(e.MaxID)
 	x.Int(e.StillUnreadCount)
 	x.Int(e.Pts)
-	return x.buf
 }
 
-func (e TL_updateDeleteChannelMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDeleteChannelMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDeleteChannelMessages)
 	x.Int(e.ChannelID)
 	x.VectorInt(e.Messages)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateChannelMessageViews) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannelMessageViews) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannelMessageViews)
 	x.Int(e.ChannelID)
 	x.Int(e.ID)
 	x.Int(e.Views)
-	return x.buf
 }
 
-func (e TL_updateChatParticipantAdmin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChatParticipantAdmin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChatParticipantAdmin)
 	x.Int(e.ChatID)
 	x.Int(e.UserID)
-	x.Bytes(e.IsAdmin.encode())
+	x.Object(e.IsAdmin)
 	x.Int(e.Version)
-	return x.buf
 }
 
-func (e TL_updateNewStickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateNewStickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateNewStickerSet)
-	x.Bytes(e.Stickerset.encode())
-	return x.buf
+	x.Object(e.Stickerset)
 }
 
-func (e TL_updateStickerSetsOrder) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateStickerSetsOrder) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateStickerSetsOrder)
 	x.Int(e.Flags)
 	//flag Masks
 	x.VectorLong(e.Order)
-	return x.buf
 }
 
-func (e TL_updateStickerSets) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateStickerSets) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateStickerSets)
-	return x.buf
 }
 
-func (e TL_updateSavedGifs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateSavedGifs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateSavedGifs)
-	return x.buf
 }
 
-func (e TL_updateBotInlineQuery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateBotInlineQuery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateBotInlineQuery)
 	x.Int(e.Flags)
 	x.Long(e.QueryID)
 	x.Int(e.UserID)
 	x.String(e.Query)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Geo.encode())
+		x.Object(e.Geo)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.PeerType.encode())
+		x.Object(e.PeerType)
 	}
 	x.String(e.Offset)
-	return x.buf
 }
 
-func (e TL_updateBotInlineSend) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateBotInlineSend) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateBotInlineSend)
 	x.Int(e.Flags)
 	x.Int(e.UserID)
 	x.String(e.Query)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Geo.encode())
+		x.Object(e.Geo)
 	}
 	x.String(e.ID)
 	if e.Flags&2 != 0 {
-		x.Bytes(e.MsgID.encode())
+		x.Object(e.MsgID)
 	}
-	return x.buf
 }
 
-func (e TL_updateEditChannelMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateEditChannelMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateEditChannelMessage)
-	x.Bytes(e.Message.encode())
+	x.Object(e.Message)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateBotCallbackQuery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateBotCallbackQuery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateBotCallbackQuery)
 	x.Int(e.Flags)
 	x.Long(e.QueryID)
 	x.Int(e.UserID)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
 	x.Long(e.ChatInstance)
 	if e.Flags&1 != 0 {
@@ -12002,25 +11461,21 @@ func (e TL_updateBotCallbackQuery) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.String(e.GameShortName)
 	}
-	return x.buf
 }
 
-func (e TL_updateEditMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateEditMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateEditMessage)
-	x.Bytes(e.Message.encode())
+	x.Object(e.Message)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateInlineBotCallbackQuery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateInlineBotCallbackQuery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateInlineBotCallbackQuery)
 	x.Int(e.Flags)
 	x.Long(e.QueryID)
 	x.Int(e.UserID)
-	x.Bytes(e.MsgID.encode())
+	x.Object(e.MsgID)
 	x.Long(e.ChatInstance)
 	if e.Flags&1 != 0 {
 		x.StringBytes(e.Data)
@@ -12028,73 +11483,55 @@ func (e TL_updateInlineBotCallbackQuery) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.String(e.GameShortName)
 	}
-	return x.buf
 }
 
-func (e TL_updateReadChannelOutbox) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateReadChannelOutbox) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateReadChannelOutbox)
 	x.Int(e.ChannelID)
 	x.Int(e.MaxID)
-	return x.buf
 }
 
-func (e TL_updateDraftMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDraftMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDraftMessage)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.Draft.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.Draft)
 }
 
-func (e TL_updateReadFeaturedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateReadFeaturedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateReadFeaturedStickers)
-	return x.buf
 }
 
-func (e TL_updateRecentStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateRecentStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateRecentStickers)
-	return x.buf
 }
 
-func (e TL_updateConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateConfig)
-	return x.buf
 }
 
-func (e TL_updatePtsChanged) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePtsChanged) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePtsChanged)
-	return x.buf
 }
 
-func (e TL_updateChannelWebPage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannelWebPage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannelWebPage)
 	x.Int(e.ChannelID)
-	x.Bytes(e.Webpage.encode())
+	x.Object(e.Webpage)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateDialogPinned) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDialogPinned) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDialogPinned)
 	x.Int(e.Flags)
-	//flag Pinned
+	// flag Pinned
 	if e.Flags&2 != 0 {
 		x.Int(e.FolderID)
 	}
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_updatePinnedDialogs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePinnedDialogs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePinnedDialogs)
 	x.Int(e.Flags)
 	if e.Flags&2 != 0 {
@@ -12103,244 +11540,186 @@ func (e TL_updatePinnedDialogs) encode() []byte {
 	if e.Flags&1 != 0 {
 		x.Vector(e.Order)
 	}
-	return x.buf
 }
 
-func (e TL_updateBotWebhookJSON) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateBotWebhookJSON) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateBotWebhookJSON)
-	x.Bytes(e.Data.encode())
-	return x.buf
+	x.Object(e.Data)
 }
 
-func (e TL_updateBotWebhookJSONQuery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateBotWebhookJSONQuery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateBotWebhookJSONQuery)
 	x.Long(e.QueryID)
-	x.Bytes(e.Data.encode())
+	x.Object(e.Data)
 	x.Int(e.Timeout)
-	return x.buf
 }
 
-func (e TL_updateBotShippingQuery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateBotShippingQuery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateBotShippingQuery)
 	x.Long(e.QueryID)
 	x.Int(e.UserID)
 	x.StringBytes(e.Payload)
-	x.Bytes(e.ShippingAddress.encode())
-	return x.buf
+	x.Object(e.ShippingAddress)
 }
 
-func (e TL_updateBotPrecheckoutQuery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateBotPrecheckoutQuery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateBotPrecheckoutQuery)
 	x.Int(e.Flags)
 	x.Long(e.QueryID)
 	x.Int(e.UserID)
 	x.StringBytes(e.Payload)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Info.encode())
+		x.Object(e.Info)
 	}
 	if e.Flags&2 != 0 {
 		x.String(e.ShippingOptionID)
 	}
 	x.String(e.Currency)
 	x.Long(e.TotalAmount)
-	return x.buf
 }
 
-func (e TL_updatePhoneCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePhoneCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePhoneCall)
-	x.Bytes(e.PhoneCall.encode())
-	return x.buf
+	x.Object(e.PhoneCall)
 }
 
-func (e TL_updateLangPackTooLong) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateLangPackTooLong) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateLangPackTooLong)
 	x.String(e.LangCode)
-	return x.buf
 }
 
-func (e TL_updateLangPack) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateLangPack) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateLangPack)
-	x.Bytes(e.Difference.encode())
-	return x.buf
+	x.Object(e.Difference)
 }
 
-func (e TL_updateFavedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateFavedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateFavedStickers)
-	return x.buf
 }
 
-func (e TL_updateChannelReadMessagesContents) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannelReadMessagesContents) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannelReadMessagesContents)
 	x.Int(e.ChannelID)
 	x.VectorInt(e.Messages)
-	return x.buf
 }
 
-func (e TL_updateContactsReset) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateContactsReset) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateContactsReset)
-	return x.buf
 }
 
-func (e TL_updateChannelAvailableMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannelAvailableMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannelAvailableMessages)
 	x.Int(e.ChannelID)
 	x.Int(e.AvailableMinID)
-	return x.buf
 }
 
-func (e TL_updateDialogUnreadMark) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDialogUnreadMark) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDialogUnreadMark)
 	x.Int(e.Flags)
 	//flag Unread
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_updateMessagePoll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateMessagePoll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateMessagePoll)
 	x.Int(e.Flags)
 	x.Long(e.PollID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Poll.encode())
+		x.Object(e.Poll)
 	}
-	x.Bytes(e.Results.encode())
-	return x.buf
+	x.Object(e.Results)
 }
 
-func (e TL_updateChatDefaultBannedRights) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChatDefaultBannedRights) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChatDefaultBannedRights)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.DefaultBannedRights.encode())
+	x.Object(e.Peer)
+	x.Object(e.DefaultBannedRights)
 	x.Int(e.Version)
-	return x.buf
 }
 
-func (e TL_updateFolderPeers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateFolderPeers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateFolderPeers)
 	x.Vector(e.FolderPeers)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updatePeerSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePeerSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePeerSettings)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.Settings)
 }
 
-func (e TL_updatePeerLocated) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePeerLocated) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePeerLocated)
 	x.Vector(e.Peers)
-	return x.buf
 }
 
-func (e TL_updateNewScheduledMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateNewScheduledMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateNewScheduledMessage)
-	x.Bytes(e.Message.encode())
-	return x.buf
+	x.Object(e.Message)
 }
 
-func (e TL_updateDeleteScheduledMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDeleteScheduledMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDeleteScheduledMessages)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.VectorInt(e.Messages)
-	return x.buf
 }
 
-func (e TL_updateTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateTheme)
-	x.Bytes(e.Theme.encode())
-	return x.buf
+	x.Object(e.Theme)
 }
 
-func (e TL_updateGeoLiveViewed) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateGeoLiveViewed) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateGeoLiveViewed)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
-	return x.buf
 }
 
-func (e TL_updateLoginToken) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateLoginToken) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateLoginToken)
-	return x.buf
 }
 
-func (e TL_updateMessagePollVote) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateMessagePollVote) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateMessagePollVote)
 	x.Long(e.PollID)
 	x.Int(e.UserID)
-	x.Vector(e.Options)
-	return x.buf
+	x.VectorBytes(e.Options)
 }
 
-func (e TL_updateDialogFilter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDialogFilter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDialogFilter)
 	x.Int(e.Flags)
 	x.Int(e.ID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Filter.encode())
+		x.Object(e.Filter)
 	}
-	return x.buf
 }
 
-func (e TL_updateDialogFilterOrder) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDialogFilterOrder) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDialogFilterOrder)
 	x.VectorInt(e.Order)
-	return x.buf
 }
 
-func (e TL_updateDialogFilters) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateDialogFilters) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateDialogFilters)
-	return x.buf
 }
 
-func (e TL_updatePhoneCallSignalingData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePhoneCallSignalingData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePhoneCallSignalingData)
 	x.Long(e.PhoneCallID)
 	x.StringBytes(e.Data)
-	return x.buf
 }
 
-func (e TL_updateChannelMessageForwards) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannelMessageForwards) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannelMessageForwards)
 	x.Int(e.ChannelID)
 	x.Int(e.ID)
 	x.Int(e.Forwards)
-	return x.buf
 }
 
-func (e TL_updateReadChannelDiscussionInbox) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateReadChannelDiscussionInbox) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateReadChannelDiscussionInbox)
 	x.Int(e.Flags)
 	x.Int(e.ChannelID)
@@ -12352,53 +11731,43 @@ func (e TL_updateReadChannelDiscussionInbox) encode() []byte {
 	if e.Flags&1 != 0 {
 		x.Int(e.BroadcastPost)
 	}
-	return x.buf
 }
 
-func (e TL_updateReadChannelDiscussionOutbox) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateReadChannelDiscussionOutbox) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateReadChannelDiscussionOutbox)
 	x.Int(e.ChannelID)
 	x.Int(e.TopMsgID)
 	x.Int(e.ReadMaxID)
-	return x.buf
 }
 
-func (e TL_updatePeerBlocked) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePeerBlocked) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePeerBlocked)
-	x.Bytes(e.PeerID.encode())
-	x.Bytes(e.Blocked.encode())
-	return x.buf
+	x.Object(e.PeerID)
+	x.Object(e.Blocked)
 }
 
-func (e TL_updateChannelUserTyping) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannelUserTyping) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannelUserTyping)
 	x.Int(e.Flags)
 	x.Int(e.ChannelID)
 	if e.Flags&1 != 0 {
 		x.Int(e.TopMsgID)
 	}
-	x.Bytes(e.FromID.encode())
-	x.Bytes(e.Action.encode())
-	return x.buf
+	x.Object(e.FromID)
+	x.Object(e.Action)
 }
 
-func (e TL_updatePinnedMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePinnedMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePinnedMessages)
 	x.Int(e.Flags)
 	//flag Pinned
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.VectorInt(e.Messages)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updatePinnedChannelMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePinnedChannelMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePinnedChannelMessages)
 	x.Int(e.Flags)
 	//flag Pinned
@@ -12406,46 +11775,36 @@ func (e TL_updatePinnedChannelMessages) encode() []byte {
 	x.VectorInt(e.Messages)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_updateChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChat)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_updateGroupCallParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateGroupCallParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateGroupCallParticipants)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.Vector(e.Participants)
 	x.Int(e.Version)
-	return x.buf
 }
 
-func (e TL_updateGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateGroupCall)
 	x.Int(e.ChatID)
-	x.Bytes(e.Call.encode())
-	return x.buf
+	x.Object(e.Call)
 }
 
-func (e TL_updatePeerHistoryTTL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatePeerHistoryTTL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatePeerHistoryTTL)
 	x.Int(e.Flags)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	if e.Flags&1 != 0 {
 		x.Int(e.TtlPeriod)
 	}
-	return x.buf
 }
 
-func (e TL_updateChatParticipant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChatParticipant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChatParticipant)
 	x.Int(e.Flags)
 	x.Int(e.ChatID)
@@ -12453,20 +11812,18 @@ func (e TL_updateChatParticipant) encode() []byte {
 	x.Int(e.ActorID)
 	x.Int(e.UserID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.PrevParticipant.encode())
+		x.Object(e.PrevParticipant)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.NewParticipant.encode())
+		x.Object(e.NewParticipant)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Invite.encode())
+		x.Object(e.Invite)
 	}
 	x.Int(e.Qts)
-	return x.buf
 }
 
-func (e TL_updateChannelParticipant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateChannelParticipant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateChannelParticipant)
 	x.Int(e.Flags)
 	x.Int(e.ChannelID)
@@ -12474,86 +11831,70 @@ func (e TL_updateChannelParticipant) encode() []byte {
 	x.Int(e.ActorID)
 	x.Int(e.UserID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.PrevParticipant.encode())
+		x.Object(e.PrevParticipant)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.NewParticipant.encode())
+		x.Object(e.NewParticipant)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Invite.encode())
+		x.Object(e.Invite)
 	}
 	x.Int(e.Qts)
-	return x.buf
 }
 
-func (e TL_updateBotStopped) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateBotStopped) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateBotStopped)
 	x.Int(e.UserID)
 	x.Int(e.Date)
-	x.Bytes(e.Stopped.encode())
+	x.Object(e.Stopped)
 	x.Int(e.Qts)
-	return x.buf
 }
 
-func (e TL_updates_state) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_state) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_state)
 	x.Int(e.Pts)
 	x.Int(e.Qts)
 	x.Int(e.Date)
 	x.Int(e.Seq)
 	x.Int(e.UnreadCount)
-	return x.buf
 }
 
-func (e TL_updates_differenceEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_differenceEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_differenceEmpty)
 	x.Int(e.Date)
 	x.Int(e.Seq)
-	return x.buf
 }
 
-func (e TL_updates_difference) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_difference) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_difference)
 	x.Vector(e.NewMessages)
 	x.Vector(e.NewEncryptedMessages)
 	x.Vector(e.OtherUpdates)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	x.Bytes(e.State.encode())
-	return x.buf
+	x.Object(e.State)
 }
 
-func (e TL_updates_differenceSlice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_differenceSlice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_differenceSlice)
 	x.Vector(e.NewMessages)
 	x.Vector(e.NewEncryptedMessages)
 	x.Vector(e.OtherUpdates)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	x.Bytes(e.IntermediateState.encode())
-	return x.buf
+	x.Object(e.IntermediateState)
 }
 
-func (e TL_updates_differenceTooLong) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_differenceTooLong) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_differenceTooLong)
 	x.Int(e.Pts)
-	return x.buf
 }
 
-func (e TL_updatesTooLong) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatesTooLong) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatesTooLong)
-	return x.buf
 }
 
-func (e TL_updateShortMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateShortMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateShortMessage)
 	x.Int(e.Flags)
 	//flag Out
@@ -12567,13 +11908,13 @@ func (e TL_updateShortMessage) encode() []byte {
 	x.Int(e.PtsCount)
 	x.Int(e.Date)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.FwdFrom.encode())
+		x.Object(e.FwdFrom)
 	}
 	if e.Flags&2048 != 0 {
 		x.Int(e.ViaBotID)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.ReplyTo.encode())
+		x.Object(e.ReplyTo)
 	}
 	if e.Flags&128 != 0 {
 		x.Vector(e.Entities)
@@ -12581,11 +11922,9 @@ func (e TL_updateShortMessage) encode() []byte {
 	if e.Flags&33554432 != 0 {
 		x.Int(e.TtlPeriod)
 	}
-	return x.buf
 }
 
-func (e TL_updateShortChatMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateShortChatMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateShortChatMessage)
 	x.Int(e.Flags)
 	//flag Out
@@ -12600,13 +11939,13 @@ func (e TL_updateShortChatMessage) encode() []byte {
 	x.Int(e.PtsCount)
 	x.Int(e.Date)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.FwdFrom.encode())
+		x.Object(e.FwdFrom)
 	}
 	if e.Flags&2048 != 0 {
 		x.Int(e.ViaBotID)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.ReplyTo.encode())
+		x.Object(e.ReplyTo)
 	}
 	if e.Flags&128 != 0 {
 		x.Vector(e.Entities)
@@ -12614,19 +11953,15 @@ func (e TL_updateShortChatMessage) encode() []byte {
 	if e.Flags&33554432 != 0 {
 		x.Int(e.TtlPeriod)
 	}
-	return x.buf
 }
 
-func (e TL_updateShort) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateShort) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateShort)
-	x.Bytes(e.Update.encode())
+	x.Object(e.Update)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_updatesCombined) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updatesCombined) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updatesCombined)
 	x.Vector(e.Updates)
 	x.Vector(e.Users)
@@ -12634,22 +11969,18 @@ func (e TL_updatesCombined) encode() []byte {
 	x.Int(e.Date)
 	x.Int(e.SeqStart)
 	x.Int(e.Seq)
-	return x.buf
 }
 
-func (e TL_updates) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates)
 	x.Vector(e.Updates)
 	x.Vector(e.Users)
 	x.Vector(e.Chats)
 	x.Int(e.Date)
 	x.Int(e.Seq)
-	return x.buf
 }
 
-func (e TL_updateShortSentMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updateShortSentMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updateShortSentMessage)
 	x.Int(e.Flags)
 	//flag Out
@@ -12658,7 +11989,7 @@ func (e TL_updateShortSentMessage) encode() []byte {
 	x.Int(e.PtsCount)
 	x.Int(e.Date)
 	if e.Flags&512 != 0 {
-		x.Bytes(e.Media.encode())
+		x.Object(e.Media)
 	}
 	if e.Flags&128 != 0 {
 		x.Vector(e.Entities)
@@ -12666,56 +11997,44 @@ func (e TL_updateShortSentMessage) encode() []byte {
 	if e.Flags&33554432 != 0 {
 		x.Int(e.TtlPeriod)
 	}
-	return x.buf
 }
 
-func (e TL_photos_photos) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photos_photos) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photos_photos)
 	x.Vector(e.Photos)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_photos_photosSlice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photos_photosSlice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photos_photosSlice)
 	x.Int(e.Count)
 	x.Vector(e.Photos)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_photos_photo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photos_photo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photos_photo)
-	x.Bytes(e.Photo.encode())
+	x.Object(e.Photo)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_upload_file) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_file) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_file)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.Int(e.Mtime)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_upload_fileCdnRedirect) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_fileCdnRedirect) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_fileCdnRedirect)
 	x.Int(e.DcID)
 	x.StringBytes(e.FileToken)
 	x.StringBytes(e.EncryptionKey)
 	x.StringBytes(e.EncryptionIv)
 	x.Vector(e.FileHashes)
-	return x.buf
 }
 
-func (e TL_dcOption) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dcOption) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dcOption)
 	x.Int(e.Flags)
 	//flag Ipv6
@@ -12729,11 +12048,9 @@ func (e TL_dcOption) encode() []byte {
 	if e.Flags&1024 != 0 {
 		x.StringBytes(e.Secret)
 	}
-	return x.buf
 }
 
-func (e TL_config) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_config) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_config)
 	x.Int(e.Flags)
 	//flag PhonecallsEnabled
@@ -12745,7 +12062,7 @@ func (e TL_config) encode() []byte {
 	//flag PfsEnabled
 	x.Int(e.Date)
 	x.Int(e.Expires)
-	x.Bytes(e.TestMode.encode())
+	x.Object(e.TestMode)
 	x.Int(e.ThisDc)
 	x.Vector(e.DcOptions)
 	x.String(e.DcTxtDomainName)
@@ -12805,20 +12122,16 @@ func (e TL_config) encode() []byte {
 	if e.Flags&4 != 0 {
 		x.Int(e.BaseLangPackVersion)
 	}
-	return x.buf
 }
 
-func (e TL_nearestDc) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_nearestDc) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_nearestDc)
 	x.String(e.Country)
 	x.Int(e.ThisDc)
 	x.Int(e.NearestDc)
-	return x.buf
 }
 
-func (e TL_help_appUpdate) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_appUpdate) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_appUpdate)
 	x.Int(e.Flags)
 	//flag CanNotSkip
@@ -12827,47 +12140,37 @@ func (e TL_help_appUpdate) encode() []byte {
 	x.String(e.Text)
 	x.Vector(e.Entities)
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Document.encode())
+		x.Object(e.Document)
 	}
 	if e.Flags&4 != 0 {
 		x.String(e.Url)
 	}
-	return x.buf
 }
 
-func (e TL_help_noAppUpdate) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_noAppUpdate) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_noAppUpdate)
-	return x.buf
 }
 
-func (e TL_help_inviteText) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_inviteText) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_inviteText)
 	x.String(e.Message)
-	return x.buf
 }
 
-func (e TL_encryptedChatEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedChatEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedChatEmpty)
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_encryptedChatWaiting) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedChatWaiting) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedChatWaiting)
 	x.Int(e.ID)
 	x.Long(e.AccessHash)
 	x.Int(e.Date)
 	x.Int(e.AdminID)
 	x.Int(e.ParticipantID)
-	return x.buf
 }
 
-func (e TL_encryptedChatRequested) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedChatRequested) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedChatRequested)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
@@ -12879,11 +12182,9 @@ func (e TL_encryptedChatRequested) encode() []byte {
 	x.Int(e.AdminID)
 	x.Int(e.ParticipantID)
 	x.StringBytes(e.GA)
-	return x.buf
 }
 
-func (e TL_encryptedChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedChat)
 	x.Int(e.ID)
 	x.Long(e.AccessHash)
@@ -12892,153 +12193,117 @@ func (e TL_encryptedChat) encode() []byte {
 	x.Int(e.ParticipantID)
 	x.StringBytes(e.GAOrB)
 	x.Long(e.KeyFingerprint)
-	return x.buf
 }
 
-func (e TL_encryptedChatDiscarded) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedChatDiscarded) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedChatDiscarded)
 	x.Int(e.Flags)
 	//flag HistoryDeleted
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_inputEncryptedChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputEncryptedChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputEncryptedChat)
 	x.Int(e.ChatID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_encryptedFileEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedFileEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedFileEmpty)
-	return x.buf
 }
 
-func (e TL_encryptedFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedFile)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
 	x.Int(e.Size)
 	x.Int(e.DcID)
 	x.Int(e.KeyFingerprint)
-	return x.buf
 }
 
-func (e TL_inputEncryptedFileEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputEncryptedFileEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputEncryptedFileEmpty)
-	return x.buf
 }
 
-func (e TL_inputEncryptedFileUploaded) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputEncryptedFileUploaded) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputEncryptedFileUploaded)
 	x.Long(e.ID)
 	x.Int(e.Parts)
 	x.String(e.Md5Checksum)
 	x.Int(e.KeyFingerprint)
-	return x.buf
 }
 
-func (e TL_inputEncryptedFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputEncryptedFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputEncryptedFile)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputEncryptedFileBigUploaded) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputEncryptedFileBigUploaded) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputEncryptedFileBigUploaded)
 	x.Long(e.ID)
 	x.Int(e.Parts)
 	x.Int(e.KeyFingerprint)
-	return x.buf
 }
 
-func (e TL_encryptedMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedMessage)
 	x.Long(e.RandomID)
 	x.Int(e.ChatID)
 	x.Int(e.Date)
 	x.StringBytes(e.Bytes)
-	x.Bytes(e.File.encode())
-	return x.buf
+	x.Object(e.File)
 }
 
-func (e TL_encryptedMessageService) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_encryptedMessageService) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_encryptedMessageService)
 	x.Long(e.RandomID)
 	x.Int(e.ChatID)
 	x.Int(e.Date)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_messages_dhConfigNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_dhConfigNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_dhConfigNotModified)
 	x.StringBytes(e.Random)
-	return x.buf
 }
 
-func (e TL_messages_dhConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_dhConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_dhConfig)
 	x.Int(e.G)
 	x.StringBytes(e.P)
 	x.Int(e.Version)
 	x.StringBytes(e.Random)
-	return x.buf
 }
 
-func (e TL_messages_sentEncryptedMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sentEncryptedMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sentEncryptedMessage)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_messages_sentEncryptedFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sentEncryptedFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sentEncryptedFile)
 	x.Int(e.Date)
-	x.Bytes(e.File.encode())
-	return x.buf
+	x.Object(e.File)
 }
 
-func (e TL_inputDocumentEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputDocumentEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputDocumentEmpty)
-	return x.buf
 }
 
-func (e TL_inputDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputDocument)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
 	x.StringBytes(e.FileReference)
-	return x.buf
 }
 
-func (e TL_documentEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_documentEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_documentEmpty)
 	x.Long(e.ID)
-	return x.buf
 }
 
-func (e TL_document) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_document) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_document)
 	x.Int(e.Flags)
 	x.Long(e.ID)
@@ -13055,393 +12320,275 @@ func (e TL_document) encode() []byte {
 	}
 	x.Int(e.DcID)
 	x.Vector(e.Attributes)
-	return x.buf
 }
 
-func (e TL_help_support) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_support) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_support)
 	x.String(e.PhoneNumber)
-	x.Bytes(e.User.encode())
-	return x.buf
+	x.Object(e.User)
 }
 
-func (e TL_notifyPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_notifyPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_notifyPeer)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_notifyUsers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_notifyUsers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_notifyUsers)
-	return x.buf
 }
 
-func (e TL_notifyChats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_notifyChats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_notifyChats)
-	return x.buf
 }
 
-func (e TL_notifyBroadcasts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_notifyBroadcasts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_notifyBroadcasts)
-	return x.buf
 }
 
-func (e TL_sendMessageTypingAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageTypingAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageTypingAction)
-	return x.buf
 }
 
-func (e TL_sendMessageCancelAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageCancelAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageCancelAction)
-	return x.buf
 }
 
-func (e TL_sendMessageRecordVideoAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageRecordVideoAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageRecordVideoAction)
-	return x.buf
 }
 
-func (e TL_sendMessageUploadVideoAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageUploadVideoAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageUploadVideoAction)
 	x.Int(e.Progress)
-	return x.buf
 }
 
-func (e TL_sendMessageRecordAudioAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageRecordAudioAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageRecordAudioAction)
-	return x.buf
 }
 
-func (e TL_sendMessageUploadAudioAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageUploadAudioAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageUploadAudioAction)
 	x.Int(e.Progress)
-	return x.buf
 }
 
-func (e TL_sendMessageUploadPhotoAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageUploadPhotoAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageUploadPhotoAction)
 	x.Int(e.Progress)
-	return x.buf
 }
 
-func (e TL_sendMessageUploadDocumentAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageUploadDocumentAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageUploadDocumentAction)
 	x.Int(e.Progress)
-	return x.buf
 }
 
-func (e TL_sendMessageGeoLocationAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageGeoLocationAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageGeoLocationAction)
-	return x.buf
 }
 
-func (e TL_sendMessageChooseContactAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageChooseContactAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageChooseContactAction)
-	return x.buf
 }
 
-func (e TL_sendMessageGamePlayAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageGamePlayAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageGamePlayAction)
-	return x.buf
 }
 
-func (e TL_sendMessageRecordRoundAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageRecordRoundAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageRecordRoundAction)
-	return x.buf
 }
 
-func (e TL_sendMessageUploadRoundAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageUploadRoundAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageUploadRoundAction)
 	x.Int(e.Progress)
-	return x.buf
 }
 
-func (e TL_speakingInGroupCallAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_speakingInGroupCallAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_speakingInGroupCallAction)
-	return x.buf
 }
 
-func (e TL_sendMessageHistoryImportAction) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_sendMessageHistoryImportAction) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_sendMessageHistoryImportAction)
 	x.Int(e.Progress)
-	return x.buf
 }
 
-func (e TL_contacts_found) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_found) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_found)
 	x.Vector(e.MyResults)
 	x.Vector(e.Results)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_inputPrivacyKeyStatusTimestamp) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyKeyStatusTimestamp) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyKeyStatusTimestamp)
-	return x.buf
 }
 
-func (e TL_inputPrivacyKeyChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyKeyChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyKeyChatInvite)
-	return x.buf
 }
 
-func (e TL_inputPrivacyKeyPhoneCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyKeyPhoneCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyKeyPhoneCall)
-	return x.buf
 }
 
-func (e TL_inputPrivacyKeyPhoneP2P) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyKeyPhoneP2P) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyKeyPhoneP2P)
-	return x.buf
 }
 
-func (e TL_inputPrivacyKeyForwards) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyKeyForwards) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyKeyForwards)
-	return x.buf
 }
 
-func (e TL_inputPrivacyKeyProfilePhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyKeyProfilePhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyKeyProfilePhoto)
-	return x.buf
 }
 
-func (e TL_inputPrivacyKeyPhoneNumber) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyKeyPhoneNumber) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyKeyPhoneNumber)
-	return x.buf
 }
 
-func (e TL_inputPrivacyKeyAddedByPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyKeyAddedByPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyKeyAddedByPhone)
-	return x.buf
 }
 
-func (e TL_privacyKeyStatusTimestamp) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyKeyStatusTimestamp) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyKeyStatusTimestamp)
-	return x.buf
 }
 
-func (e TL_privacyKeyChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyKeyChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyKeyChatInvite)
-	return x.buf
 }
 
-func (e TL_privacyKeyPhoneCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyKeyPhoneCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyKeyPhoneCall)
-	return x.buf
 }
 
-func (e TL_privacyKeyPhoneP2P) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyKeyPhoneP2P) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyKeyPhoneP2P)
-	return x.buf
 }
 
-func (e TL_privacyKeyForwards) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyKeyForwards) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyKeyForwards)
-	return x.buf
 }
 
-func (e TL_privacyKeyProfilePhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyKeyProfilePhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyKeyProfilePhoto)
-	return x.buf
 }
 
-func (e TL_privacyKeyPhoneNumber) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyKeyPhoneNumber) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyKeyPhoneNumber)
-	return x.buf
 }
 
-func (e TL_privacyKeyAddedByPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyKeyAddedByPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyKeyAddedByPhone)
-	return x.buf
 }
 
-func (e TL_inputPrivacyValueAllowContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyValueAllowContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyValueAllowContacts)
-	return x.buf
 }
 
-func (e TL_inputPrivacyValueAllowAll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyValueAllowAll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyValueAllowAll)
-	return x.buf
 }
 
-func (e TL_inputPrivacyValueAllowUsers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyValueAllowUsers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyValueAllowUsers)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_inputPrivacyValueDisallowContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyValueDisallowContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyValueDisallowContacts)
-	return x.buf
 }
 
-func (e TL_inputPrivacyValueDisallowAll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyValueDisallowAll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyValueDisallowAll)
-	return x.buf
 }
 
-func (e TL_inputPrivacyValueDisallowUsers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyValueDisallowUsers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyValueDisallowUsers)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_inputPrivacyValueAllowChatParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyValueAllowChatParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyValueAllowChatParticipants)
 	x.VectorInt(e.Chats)
-	return x.buf
 }
 
-func (e TL_inputPrivacyValueDisallowChatParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPrivacyValueDisallowChatParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPrivacyValueDisallowChatParticipants)
 	x.VectorInt(e.Chats)
-	return x.buf
 }
 
-func (e TL_privacyValueAllowContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyValueAllowContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyValueAllowContacts)
-	return x.buf
 }
 
-func (e TL_privacyValueAllowAll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyValueAllowAll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyValueAllowAll)
-	return x.buf
 }
 
-func (e TL_privacyValueAllowUsers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyValueAllowUsers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyValueAllowUsers)
 	x.VectorInt(e.Users)
-	return x.buf
 }
 
-func (e TL_privacyValueDisallowContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyValueDisallowContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyValueDisallowContacts)
-	return x.buf
 }
 
-func (e TL_privacyValueDisallowAll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyValueDisallowAll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyValueDisallowAll)
-	return x.buf
 }
 
-func (e TL_privacyValueDisallowUsers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyValueDisallowUsers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyValueDisallowUsers)
 	x.VectorInt(e.Users)
-	return x.buf
 }
 
-func (e TL_privacyValueAllowChatParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyValueAllowChatParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyValueAllowChatParticipants)
 	x.VectorInt(e.Chats)
-	return x.buf
 }
 
-func (e TL_privacyValueDisallowChatParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_privacyValueDisallowChatParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_privacyValueDisallowChatParticipants)
 	x.VectorInt(e.Chats)
-	return x.buf
 }
 
-func (e TL_account_privacyRules) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_privacyRules) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_privacyRules)
 	x.Vector(e.Rules)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_accountDaysTTL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_accountDaysTTL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_accountDaysTTL)
 	x.Int(e.Days)
-	return x.buf
 }
 
-func (e TL_documentAttributeImageSize) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_documentAttributeImageSize) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_documentAttributeImageSize)
 	x.Int(e.W)
 	x.Int(e.H)
-	return x.buf
 }
 
-func (e TL_documentAttributeAnimated) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_documentAttributeAnimated) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_documentAttributeAnimated)
-	return x.buf
 }
 
-func (e TL_documentAttributeSticker) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_documentAttributeSticker) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_documentAttributeSticker)
 	x.Int(e.Flags)
 	//flag Mask
 	x.String(e.Alt)
-	x.Bytes(e.Stickerset.encode())
+	x.Object(e.Stickerset)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.MaskCoords.encode())
+		x.Object(e.MaskCoords)
 	}
-	return x.buf
 }
 
-func (e TL_documentAttributeVideo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_documentAttributeVideo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_documentAttributeVideo)
 	x.Int(e.Flags)
 	//flag RoundMessage
@@ -13449,11 +12596,9 @@ func (e TL_documentAttributeVideo) encode() []byte {
 	x.Int(e.Duration)
 	x.Int(e.W)
 	x.Int(e.H)
-	return x.buf
 }
 
-func (e TL_documentAttributeAudio) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_documentAttributeAudio) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_documentAttributeAudio)
 	x.Int(e.Flags)
 	//flag Voice
@@ -13467,83 +12612,61 @@ func (e TL_documentAttributeAudio) encode() []byte {
 	if e.Flags&4 != 0 {
 		x.StringBytes(e.Waveform)
 	}
-	return x.buf
 }
 
-func (e TL_documentAttributeFilename) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_documentAttributeFilename) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_documentAttributeFilename)
 	x.String(e.FileName)
-	return x.buf
 }
 
-func (e TL_documentAttributeHasStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_documentAttributeHasStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_documentAttributeHasStickers)
-	return x.buf
 }
 
-func (e TL_messages_stickersNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_stickersNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_stickersNotModified)
-	return x.buf
 }
 
-func (e TL_messages_stickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_stickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_stickers)
 	x.Int(e.Hash)
 	x.Vector(e.Stickers)
-	return x.buf
 }
 
-func (e TL_stickerPack) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickerPack) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickerPack)
 	x.String(e.Emoticon)
 	x.VectorLong(e.Documents)
-	return x.buf
 }
 
-func (e TL_messages_allStickersNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_allStickersNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_allStickersNotModified)
-	return x.buf
 }
 
-func (e TL_messages_allStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_allStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_allStickers)
 	x.Int(e.Hash)
 	x.Vector(e.Sets)
-	return x.buf
 }
 
-func (e TL_messages_affectedMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_affectedMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_affectedMessages)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
-	return x.buf
 }
 
-func (e TL_webPageEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_webPageEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_webPageEmpty)
 	x.Long(e.ID)
-	return x.buf
 }
 
-func (e TL_webPagePending) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_webPagePending) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_webPagePending)
 	x.Long(e.ID)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_webPage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_webPage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_webPage)
 	x.Int(e.Flags)
 	x.Long(e.ID)
@@ -13563,7 +12686,7 @@ func (e TL_webPage) encode() []byte {
 		x.String(e.Description)
 	}
 	if e.Flags&16 != 0 {
-		x.Bytes(e.Photo.encode())
+		x.Object(e.Photo)
 	}
 	if e.Flags&32 != 0 {
 		x.String(e.EmbedUrl)
@@ -13584,29 +12707,25 @@ func (e TL_webPage) encode() []byte {
 		x.String(e.Author)
 	}
 	if e.Flags&512 != 0 {
-		x.Bytes(e.Document.encode())
+		x.Object(e.Document)
 	}
 	if e.Flags&1024 != 0 {
-		x.Bytes(e.CachedPage.encode())
+		x.Object(e.CachedPage)
 	}
 	if e.Flags&4096 != 0 {
 		x.Vector(e.Attributes)
 	}
-	return x.buf
 }
 
-func (e TL_webPageNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_webPageNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_webPageNotModified)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
 		x.Int(e.CachedPageViews)
 	}
-	return x.buf
 }
 
-func (e TL_authorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_authorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_authorization)
 	x.Int(e.Flags)
 	//flag Current
@@ -13624,25 +12743,21 @@ func (e TL_authorization) encode() []byte {
 	x.String(e.Ip)
 	x.String(e.Country)
 	x.String(e.Region)
-	return x.buf
 }
 
-func (e TL_account_authorizations) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_authorizations) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_authorizations)
 	x.Vector(e.Authorizations)
-	return x.buf
 }
 
-func (e TL_account_password) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_password) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_password)
 	x.Int(e.Flags)
-	//flag HasRecovery
-	//flag HasSecureValues
-	//flag HasPassword
+	// flag HasRecovery
+	// flag HasSecureValues
+	// flag HasPassword
 	if e.Flags&4 != 0 {
-		x.Bytes(e.CurrentAlgo.encode())
+		x.Object(e.CurrentAlgo)
 	}
 	if e.Flags&4 != 0 {
 		x.StringBytes(e.SrpB)
@@ -13656,31 +12771,27 @@ func (e TL_account_password) encode() []byte {
 	if e.Flags&16 != 0 {
 		x.String(e.EmailUnconfirmedPattern)
 	}
-	x.Bytes(e.NewAlgo.encode())
-	x.Bytes(e.NewSecureAlgo.encode())
+	x.Object(e.NewAlgo)
+	x.Object(e.NewSecureAlgo)
 	x.StringBytes(e.SecureRandom)
-	return x.buf
 }
 
-func (e TL_account_passwordSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_passwordSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_passwordSettings)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
 		x.String(e.Email)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.SecureSettings.encode())
+		x.Object(e.SecureSettings)
 	}
-	return x.buf
 }
 
-func (e TL_account_passwordInputSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_passwordInputSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_passwordInputSettings)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.NewAlgo.encode())
+		x.Object(e.NewAlgo)
 	}
 	if e.Flags&1 != 0 {
 		x.StringBytes(e.NewPasswordHash)
@@ -13692,28 +12803,22 @@ func (e TL_account_passwordInputSettings) encode() []byte {
 		x.String(e.Email)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.NewSecureSettings.encode())
+		x.Object(e.NewSecureSettings)
 	}
-	return x.buf
 }
 
-func (e TL_auth_passwordRecovery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_passwordRecovery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_passwordRecovery)
 	x.String(e.EmailPattern)
-	return x.buf
 }
 
-func (e TL_receivedNotifyMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_receivedNotifyMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_receivedNotifyMessage)
 	x.Int(e.ID)
 	x.Int(e.Flags)
-	return x.buf
 }
 
-func (e TL_chatInviteExported) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatInviteExported) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatInviteExported)
 	x.Int(e.Flags)
 	//flag Revoked
@@ -13733,18 +12838,14 @@ func (e TL_chatInviteExported) encode() []byte {
 	if e.Flags&8 != 0 {
 		x.Int(e.Usage)
 	}
-	return x.buf
 }
 
-func (e TL_chatInviteAlready) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatInviteAlready) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatInviteAlready)
-	x.Bytes(e.Chat.encode())
-	return x.buf
+	x.Object(e.Chat)
 }
 
-func (e TL_chatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatInvite)
 	x.Int(e.Flags)
 	//flag Channel
@@ -13752,64 +12853,50 @@ func (e TL_chatInvite) encode() []byte {
 	//flag Public
 	//flag Megagroup
 	x.String(e.Title)
-	x.Bytes(e.Photo.encode())
+	x.Object(e.Photo)
 	x.Int(e.ParticipantsCount)
 	if e.Flags&16 != 0 {
 		x.Vector(e.Participants)
 	}
-	return x.buf
 }
 
-func (e TL_chatInvitePeek) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatInvitePeek) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatInvitePeek)
-	x.Bytes(e.Chat.encode())
+	x.Object(e.Chat)
 	x.Int(e.Expires)
-	return x.buf
 }
 
-func (e TL_inputStickerSetEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickerSetEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickerSetEmpty)
-	return x.buf
 }
 
-func (e TL_inputStickerSetID) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickerSetID) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickerSetID)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputStickerSetShortName) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickerSetShortName) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickerSetShortName)
 	x.String(e.ShortName)
-	return x.buf
 }
 
-func (e TL_inputStickerSetAnimatedEmoji) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickerSetAnimatedEmoji) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickerSetAnimatedEmoji)
-	return x.buf
 }
 
-func (e TL_inputStickerSetDice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickerSetDice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickerSetDice)
 	x.String(e.Emoticon)
-	return x.buf
 }
 
-func (e TL_stickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickerSet)
 	x.Int(e.Flags)
-	//flag Archived
-	//flag Official
-	//flag Masks
-	//flag Animated
+	// flag Archived
+	// flag Official
+	// flag Masks
+	// flag Animated
 	if e.Flags&1 != 0 {
 		x.Int(e.InstalledDate)
 	}
@@ -13825,100 +12912,76 @@ func (e TL_stickerSet) encode() []byte {
 	}
 	x.Int(e.Count)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_stickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_stickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_stickerSet)
-	x.Bytes(e.Set.encode())
+	x.Object(e.Set)
 	x.Vector(e.Packs)
 	x.Vector(e.Documents)
-	return x.buf
 }
 
-func (e TL_botCommand) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botCommand) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botCommand)
 	x.String(e.Command)
 	x.String(e.Description)
-	return x.buf
 }
 
-func (e TL_botInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botInfo)
 	x.Int(e.UserID)
 	x.String(e.Description)
 	x.Vector(e.Commands)
-	return x.buf
 }
 
-func (e TL_keyboardButton) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButton) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButton)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_keyboardButtonUrl) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonUrl) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonUrl)
 	x.String(e.Text)
 	x.String(e.Url)
-	return x.buf
 }
 
-func (e TL_keyboardButtonCallback) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonCallback) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonCallback)
 	x.Int(e.Flags)
 	//flag RequiresPassword
 	x.String(e.Text)
 	x.StringBytes(e.Data)
-	return x.buf
 }
 
-func (e TL_keyboardButtonRequestPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonRequestPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonRequestPhone)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_keyboardButtonRequestGeoLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonRequestGeoLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonRequestGeoLocation)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_keyboardButtonSwitchInline) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonSwitchInline) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonSwitchInline)
 	x.Int(e.Flags)
 	//flag SamePeer
 	x.String(e.Text)
 	x.String(e.Query)
-	return x.buf
 }
 
-func (e TL_keyboardButtonGame) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonGame) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonGame)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_keyboardButtonBuy) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonBuy) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonBuy)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_keyboardButtonUrlAuth) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonUrlAuth) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonUrlAuth)
 	x.Int(e.Flags)
 	x.String(e.Text)
@@ -13927,11 +12990,9 @@ func (e TL_keyboardButtonUrlAuth) encode() []byte {
 	}
 	x.String(e.Url)
 	x.Int(e.ButtonID)
-	return x.buf
 }
 
-func (e TL_inputKeyboardButtonUrlAuth) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputKeyboardButtonUrlAuth) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputKeyboardButtonUrlAuth)
 	x.Int(e.Flags)
 	//flag RequestWriteAccess
@@ -13940,261 +13001,199 @@ func (e TL_inputKeyboardButtonUrlAuth) encode() []byte {
 		x.String(e.FwdText)
 	}
 	x.String(e.Url)
-	x.Bytes(e.Bot.encode())
-	return x.buf
+	x.Object(e.Bot)
 }
 
-func (e TL_keyboardButtonRequestPoll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonRequestPoll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonRequestPoll)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Quiz.encode())
+		x.Object(e.Quiz)
 	}
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_keyboardButtonRow) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_keyboardButtonRow) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_keyboardButtonRow)
 	x.Vector(e.Buttons)
-	return x.buf
 }
 
-func (e TL_replyKeyboardHide) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_replyKeyboardHide) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_replyKeyboardHide)
 	x.Int(e.Flags)
-	//flag Selective
-	return x.buf
+	// flag Selective
 }
 
-func (e TL_replyKeyboardForceReply) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_replyKeyboardForceReply) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_replyKeyboardForceReply)
 	x.Int(e.Flags)
-	//flag SingleUse
-	//flag Selective
-	return x.buf
+	// flag SingleUse
+	// flag Selective
 }
 
-func (e TL_replyKeyboardMarkup) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_replyKeyboardMarkup) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_replyKeyboardMarkup)
 	x.Int(e.Flags)
 	//flag Resize
 	//flag SingleUse
 	//flag Selective
 	x.Vector(e.Rows)
-	return x.buf
 }
 
-func (e TL_replyInlineMarkup) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_replyInlineMarkup) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_replyInlineMarkup)
 	x.Vector(e.Rows)
-	return x.buf
 }
 
-func (e TL_messageEntityUnknown) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityUnknown) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityUnknown)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityMention) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityMention) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityMention)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityHashtag) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityHashtag) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityHashtag)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityBotCommand) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityBotCommand) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityBotCommand)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityUrl) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityUrl) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityUrl)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityEmail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityEmail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityEmail)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityBold) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityBold) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityBold)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityItalic) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityItalic) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityItalic)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityCode)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityPre) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityPre) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityPre)
 	x.Int(e.Offset)
 	x.Int(e.Length)
 	x.String(e.Language)
-	return x.buf
 }
 
-func (e TL_messageEntityTextUrl) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityTextUrl) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityTextUrl)
 	x.Int(e.Offset)
 	x.Int(e.Length)
 	x.String(e.Url)
-	return x.buf
 }
 
-func (e TL_messageEntityMentionName) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityMentionName) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityMentionName)
 	x.Int(e.Offset)
 	x.Int(e.Length)
 	x.Int(e.UserID)
-	return x.buf
 }
 
-func (e TL_inputMessageEntityMentionName) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessageEntityMentionName) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessageEntityMentionName)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	x.Bytes(e.UserID.encode())
-	return x.buf
+	x.Object(e.UserID)
 }
 
-func (e TL_messageEntityPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityPhone)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityCashtag) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityCashtag) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityCashtag)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityUnderline) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityUnderline) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityUnderline)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityStrike) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityStrike) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityStrike)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityBlockquote) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityBlockquote) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityBlockquote)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_messageEntityBankCard) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageEntityBankCard) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageEntityBankCard)
 	x.Int(e.Offset)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_inputChannelEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputChannelEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputChannelEmpty)
-	return x.buf
 }
 
-func (e TL_inputChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputChannel)
 	x.Int(e.ChannelID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputChannelFromMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputChannelFromMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputChannelFromMessage)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
 	x.Int(e.ChannelID)
-	return x.buf
 }
 
-func (e TL_contacts_resolvedPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_resolvedPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_resolvedPeer)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messageRange) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageRange) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageRange)
 	x.Int(e.MinID)
 	x.Int(e.MaxID)
-	return x.buf
 }
 
-func (e TL_updates_channelDifferenceEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_channelDifferenceEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_channelDifferenceEmpty)
 	x.Int(e.Flags)
 	//flag Final
@@ -14202,26 +13201,22 @@ func (e TL_updates_channelDifferenceEmpty) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Int(e.Timeout)
 	}
-	return x.buf
 }
 
-func (e TL_updates_channelDifferenceTooLong) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_channelDifferenceTooLong) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_channelDifferenceTooLong)
 	x.Int(e.Flags)
-	//flag Final
+	// flag Final
 	if e.Flags&2 != 0 {
 		x.Int(e.Timeout)
 	}
-	x.Bytes(e.Dialog.encode())
+	x.Object(e.Dialog)
 	x.Vector(e.Messages)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_updates_channelDifference) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_channelDifference) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_channelDifference)
 	x.Int(e.Flags)
 	//flag Final
@@ -14233,55 +13228,43 @@ func (e TL_updates_channelDifference) encode() []byte {
 	x.Vector(e.OtherUpdates)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_channelMessagesFilterEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelMessagesFilterEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelMessagesFilterEmpty)
-	return x.buf
 }
 
-func (e TL_channelMessagesFilter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelMessagesFilter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelMessagesFilter)
 	x.Int(e.Flags)
 	//flag ExcludeNewMessages
 	x.Vector(e.Ranges)
-	return x.buf
 }
 
-func (e TL_channelParticipant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipant)
 	x.Int(e.UserID)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_channelParticipantSelf) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantSelf) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantSelf)
 	x.Int(e.UserID)
 	x.Int(e.InviterID)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_channelParticipantCreator) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantCreator) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantCreator)
 	x.Int(e.Flags)
 	x.Int(e.UserID)
-	x.Bytes(e.AdminRights.encode())
+	x.Object(e.AdminRights)
 	if e.Flags&1 != 0 {
 		x.String(e.Rank)
 	}
-	return x.buf
 }
 
-func (e TL_channelParticipantAdmin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantAdmin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantAdmin)
 	x.Int(e.Flags)
 	//flag CanEdit
@@ -14292,80 +13275,60 @@ func (e TL_channelParticipantAdmin) encode() []byte {
 	}
 	x.Int(e.PromotedBy)
 	x.Int(e.Date)
-	x.Bytes(e.AdminRights.encode())
+	x.Object(e.AdminRights)
 	if e.Flags&4 != 0 {
 		x.String(e.Rank)
 	}
-	return x.buf
 }
 
-func (e TL_channelParticipantBanned) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantBanned) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantBanned)
 	x.Int(e.Flags)
 	//flag Left
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.KickedBy)
 	x.Int(e.Date)
-	x.Bytes(e.BannedRights.encode())
-	return x.buf
+	x.Object(e.BannedRights)
 }
 
-func (e TL_channelParticipantLeft) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantLeft) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantLeft)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_channelParticipantsRecent) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantsRecent) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantsRecent)
-	return x.buf
 }
 
-func (e TL_channelParticipantsAdmins) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantsAdmins) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantsAdmins)
-	return x.buf
 }
 
-func (e TL_channelParticipantsKicked) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantsKicked) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantsKicked)
 	x.String(e.Q)
-	return x.buf
 }
 
-func (e TL_channelParticipantsBots) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantsBots) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantsBots)
-	return x.buf
 }
 
-func (e TL_channelParticipantsBanned) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantsBanned) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantsBanned)
 	x.String(e.Q)
-	return x.buf
 }
 
-func (e TL_channelParticipantsSearch) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantsSearch) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantsSearch)
 	x.String(e.Q)
-	return x.buf
 }
 
-func (e TL_channelParticipantsContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantsContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantsContacts)
 	x.String(e.Q)
-	return x.buf
 }
 
-func (e TL_channelParticipantsMentions) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelParticipantsMentions) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelParticipantsMentions)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
@@ -14374,64 +13337,50 @@ func (e TL_channelParticipantsMentions) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Int(e.TopMsgID)
 	}
-	return x.buf
 }
 
-func (e TL_channels_channelParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_channelParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_channelParticipants)
 	x.Int(e.Count)
 	x.Vector(e.Participants)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_channels_channelParticipantsNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_channelParticipantsNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_channelParticipantsNotModified)
-	return x.buf
 }
 
-func (e TL_channels_channelParticipant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_channelParticipant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_channelParticipant)
-	x.Bytes(e.Participant.encode())
+	x.Object(e.Participant)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_help_termsOfService) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_termsOfService) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_termsOfService)
 	x.Int(e.Flags)
 	//flag Popup
-	x.Bytes(e.ID.encode())
+	x.Object(e.ID)
 	x.String(e.Text)
 	x.Vector(e.Entities)
 	if e.Flags&2 != 0 {
 		x.Int(e.MinAgeConfirm)
 	}
-	return x.buf
 }
 
-func (e TL_messages_savedGifsNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_savedGifsNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_savedGifsNotModified)
-	return x.buf
 }
 
-func (e TL_messages_savedGifs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_savedGifs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_savedGifs)
 	x.Int(e.Hash)
 	x.Vector(e.Gifs)
-	return x.buf
 }
 
-func (e TL_inputBotInlineMessageMediaAuto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineMessageMediaAuto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineMessageMediaAuto)
 	x.Int(e.Flags)
 	x.String(e.Message)
@@ -14439,13 +13388,11 @@ func (e TL_inputBotInlineMessageMediaAuto) encode() []byte {
 		x.Vector(e.Entities)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_inputBotInlineMessageText) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineMessageText) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineMessageText)
 	x.Int(e.Flags)
 	//flag NoWebpage
@@ -14454,16 +13401,14 @@ func (e TL_inputBotInlineMessageText) encode() []byte {
 		x.Vector(e.Entities)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_inputBotInlineMessageMediaGeo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineMessageMediaGeo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineMessageMediaGeo)
 	x.Int(e.Flags)
-	x.Bytes(e.GeoPoint.encode())
+	x.Object(e.GeoPoint)
 	if e.Flags&1 != 0 {
 		x.Int(e.Heading)
 	}
@@ -14474,29 +13419,25 @@ func (e TL_inputBotInlineMessageMediaGeo) encode() []byte {
 		x.Int(e.ProximityNotificationRadius)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_inputBotInlineMessageMediaVenue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineMessageMediaVenue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineMessageMediaVenue)
 	x.Int(e.Flags)
-	x.Bytes(e.GeoPoint.encode())
+	x.Object(e.GeoPoint)
 	x.String(e.Title)
 	x.String(e.Address)
 	x.String(e.Provider)
 	x.String(e.VenueID)
 	x.String(e.VenueType)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_inputBotInlineMessageMediaContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineMessageMediaContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineMessageMediaContact)
 	x.Int(e.Flags)
 	x.String(e.PhoneNumber)
@@ -14504,23 +13445,19 @@ func (e TL_inputBotInlineMessageMediaContact) encode() []byte {
 	x.String(e.LastName)
 	x.String(e.Vcard)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_inputBotInlineMessageGame) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineMessageGame) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineMessageGame)
 	x.Int(e.Flags)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_inputBotInlineResult) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineResult) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineResult)
 	x.Int(e.Flags)
 	x.String(e.ID)
@@ -14535,27 +13472,23 @@ func (e TL_inputBotInlineResult) encode() []byte {
 		x.String(e.Url)
 	}
 	if e.Flags&16 != 0 {
-		x.Bytes(e.Thumb.encode())
+		x.Object(e.Thumb)
 	}
 	if e.Flags&32 != 0 {
-		x.Bytes(e.Content.encode())
+		x.Object(e.Content)
 	}
-	x.Bytes(e.SendMessage.encode())
-	return x.buf
+	x.Object(e.SendMessage)
 }
 
-func (e TL_inputBotInlineResultPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineResultPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineResultPhoto)
 	x.String(e.ID)
 	x.String(e.Type)
-	x.Bytes(e.Photo.encode())
-	x.Bytes(e.SendMessage.encode())
-	return x.buf
+	x.Object(e.Photo)
+	x.Object(e.SendMessage)
 }
 
-func (e TL_inputBotInlineResultDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineResultDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineResultDocument)
 	x.Int(e.Flags)
 	x.String(e.ID)
@@ -14566,22 +13499,18 @@ func (e TL_inputBotInlineResultDocument) encode() []byte {
 	if e.Flags&4 != 0 {
 		x.String(e.Description)
 	}
-	x.Bytes(e.Document.encode())
-	x.Bytes(e.SendMessage.encode())
-	return x.buf
+	x.Object(e.Document)
+	x.Object(e.SendMessage)
 }
 
-func (e TL_inputBotInlineResultGame) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineResultGame) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineResultGame)
 	x.String(e.ID)
 	x.String(e.ShortName)
-	x.Bytes(e.SendMessage.encode())
-	return x.buf
+	x.Object(e.SendMessage)
 }
 
-func (e TL_botInlineMessageMediaAuto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botInlineMessageMediaAuto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botInlineMessageMediaAuto)
 	x.Int(e.Flags)
 	x.String(e.Message)
@@ -14589,13 +13518,11 @@ func (e TL_botInlineMessageMediaAuto) encode() []byte {
 		x.Vector(e.Entities)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_botInlineMessageText) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botInlineMessageText) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botInlineMessageText)
 	x.Int(e.Flags)
 	//flag NoWebpage
@@ -14604,16 +13531,14 @@ func (e TL_botInlineMessageText) encode() []byte {
 		x.Vector(e.Entities)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_botInlineMessageMediaGeo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botInlineMessageMediaGeo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botInlineMessageMediaGeo)
 	x.Int(e.Flags)
-	x.Bytes(e.Geo.encode())
+	x.Object(e.Geo)
 	if e.Flags&1 != 0 {
 		x.Int(e.Heading)
 	}
@@ -14624,29 +13549,25 @@ func (e TL_botInlineMessageMediaGeo) encode() []byte {
 		x.Int(e.ProximityNotificationRadius)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_botInlineMessageMediaVenue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botInlineMessageMediaVenue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botInlineMessageMediaVenue)
 	x.Int(e.Flags)
-	x.Bytes(e.Geo.encode())
+	x.Object(e.Geo)
 	x.String(e.Title)
 	x.String(e.Address)
 	x.String(e.Provider)
 	x.String(e.VenueID)
 	x.String(e.VenueType)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_botInlineMessageMediaContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botInlineMessageMediaContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botInlineMessageMediaContact)
 	x.Int(e.Flags)
 	x.String(e.PhoneNumber)
@@ -14654,13 +13575,11 @@ func (e TL_botInlineMessageMediaContact) encode() []byte {
 	x.String(e.LastName)
 	x.String(e.Vcard)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
-	return x.buf
 }
 
-func (e TL_botInlineResult) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botInlineResult) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botInlineResult)
 	x.Int(e.Flags)
 	x.String(e.ID)
@@ -14675,26 +13594,24 @@ func (e TL_botInlineResult) encode() []byte {
 		x.String(e.Url)
 	}
 	if e.Flags&16 != 0 {
-		x.Bytes(e.Thumb.encode())
+		x.Object(e.Thumb)
 	}
 	if e.Flags&32 != 0 {
-		x.Bytes(e.Content.encode())
+		x.Object(e.Content)
 	}
-	x.Bytes(e.SendMessage.encode())
-	return x.buf
+	x.Object(e.SendMessage)
 }
 
-func (e TL_botInlineMediaResult) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_botInlineMediaResult) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_botInlineMediaResult)
 	x.Int(e.Flags)
 	x.String(e.ID)
 	x.String(e.Type)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Photo.encode())
+		x.Object(e.Photo)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Document.encode())
+		x.Object(e.Document)
 	}
 	if e.Flags&4 != 0 {
 		x.String(e.Title)
@@ -14702,12 +13619,10 @@ func (e TL_botInlineMediaResult) encode() []byte {
 	if e.Flags&8 != 0 {
 		x.String(e.Description)
 	}
-	x.Bytes(e.SendMessage.encode())
-	return x.buf
+	x.Object(e.SendMessage)
 }
 
-func (e TL_messages_botResults) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_botResults) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_botResults)
 	x.Int(e.Flags)
 	//flag Gallery
@@ -14716,29 +13631,25 @@ func (e TL_messages_botResults) encode() []byte {
 		x.String(e.NextOffset)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.SwitchPm.encode())
+		x.Object(e.SwitchPm)
 	}
 	x.Vector(e.Results)
 	x.Int(e.CacheTime)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_exportedMessageLink) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_exportedMessageLink) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_exportedMessageLink)
 	x.String(e.Link)
 	x.String(e.Html)
-	return x.buf
 }
 
-func (e TL_messageFwdHeader) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageFwdHeader) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageFwdHeader)
 	x.Int(e.Flags)
-	//flag Imported
+	// flag Imported
 	if e.Flags&1 != 0 {
-		x.Bytes(e.FromID.encode())
+		x.Object(e.FromID)
 	}
 	if e.Flags&32 != 0 {
 		x.String(e.FromName)
@@ -14751,7 +13662,7 @@ func (e TL_messageFwdHeader) encode() []byte {
 		x.String(e.PostAuthor)
 	}
 	if e.Flags&16 != 0 {
-		x.Bytes(e.SavedFromPeer.encode())
+		x.Object(e.SavedFromPeer)
 	}
 	if e.Flags&16 != 0 {
 		x.Int(e.SavedFromMsgID)
@@ -14759,62 +13670,46 @@ func (e TL_messageFwdHeader) encode() []byte {
 	if e.Flags&64 != 0 {
 		x.String(e.PsaType)
 	}
-	return x.buf
 }
 
-func (e TL_auth_codeTypeSms) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_codeTypeSms) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_codeTypeSms)
-	return x.buf
 }
 
-func (e TL_auth_codeTypeCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_codeTypeCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_codeTypeCall)
-	return x.buf
 }
 
-func (e TL_auth_codeTypeFlashCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_codeTypeFlashCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_codeTypeFlashCall)
-	return x.buf
 }
 
-func (e TL_auth_sentCodeTypeApp) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_sentCodeTypeApp) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_sentCodeTypeApp)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_auth_sentCodeTypeSms) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_sentCodeTypeSms) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_sentCodeTypeSms)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_auth_sentCodeTypeCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_sentCodeTypeCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_sentCodeTypeCall)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_auth_sentCodeTypeFlashCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_sentCodeTypeFlashCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_sentCodeTypeFlashCall)
 	x.String(e.Pattern)
-	return x.buf
 }
 
-func (e TL_messages_botCallbackAnswer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_botCallbackAnswer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_botCallbackAnswer)
 	x.Int(e.Flags)
-	//flag Alert
-	//flag HasUrl
-	//flag NativeUi
+	// flag Alert
+	// flag HasUrl
+	// flag NativeUi
 	if e.Flags&1 != 0 {
 		x.String(e.Message)
 	}
@@ -14822,146 +13717,108 @@ func (e TL_messages_botCallbackAnswer) encode() []byte {
 		x.String(e.Url)
 	}
 	x.Int(e.CacheTime)
-	return x.buf
 }
 
-func (e TL_messages_messageEditData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_messageEditData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_messageEditData)
 	x.Int(e.Flags)
-	//flag Caption
-	return x.buf
+	// flag Caption
 }
 
-func (e TL_inputBotInlineMessageID) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputBotInlineMessageID) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputBotInlineMessageID)
 	x.Int(e.DcID)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inlineBotSwitchPM) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inlineBotSwitchPM) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inlineBotSwitchPM)
 	x.String(e.Text)
 	x.String(e.StartParam)
-	return x.buf
 }
 
-func (e TL_messages_peerDialogs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_peerDialogs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_peerDialogs)
 	x.Vector(e.Dialogs)
 	x.Vector(e.Messages)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	x.Bytes(e.State.encode())
-	return x.buf
+	x.Object(e.State)
 }
 
-func (e TL_topPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeer)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Double(e.Rating)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryBotsPM) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryBotsPM) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryBotsPM)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryBotsInline) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryBotsInline) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryBotsInline)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryCorrespondents) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryCorrespondents) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryCorrespondents)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryGroups) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryGroups) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryGroups)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryChannels) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryChannels) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryChannels)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryPhoneCalls) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryPhoneCalls) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryPhoneCalls)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryForwardUsers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryForwardUsers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryForwardUsers)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryForwardChats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryForwardChats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryForwardChats)
-	return x.buf
 }
 
-func (e TL_topPeerCategoryPeers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_topPeerCategoryPeers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_topPeerCategoryPeers)
-	x.Bytes(e.Category.encode())
+	x.Object(e.Category)
 	x.Int(e.Count)
 	x.Vector(e.Peers)
-	return x.buf
 }
 
-func (e TL_contacts_topPeersNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_topPeersNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_topPeersNotModified)
-	return x.buf
 }
 
-func (e TL_contacts_topPeers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_topPeers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_topPeers)
 	x.Vector(e.Categories)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_contacts_topPeersDisabled) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_topPeersDisabled) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_topPeersDisabled)
-	return x.buf
 }
 
-func (e TL_draftMessageEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_draftMessageEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_draftMessageEmpty)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
 		x.Int(e.Date)
 	}
-	return x.buf
 }
 
-func (e TL_draftMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_draftMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_draftMessage)
 	x.Int(e.Flags)
-	//flag NoWebpage
+	// flag NoWebpage
 	if e.Flags&1 != 0 {
 		x.Int(e.ReplyToMsgID)
 	}
@@ -14970,105 +13827,79 @@ func (e TL_draftMessage) encode() []byte {
 		x.Vector(e.Entities)
 	}
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_messages_featuredStickersNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_featuredStickersNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_featuredStickersNotModified)
 	x.Int(e.Count)
-	return x.buf
 }
 
-func (e TL_messages_featuredStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_featuredStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_featuredStickers)
 	x.Int(e.Hash)
 	x.Int(e.Count)
 	x.Vector(e.Sets)
 	x.VectorLong(e.Unread)
-	return x.buf
 }
 
-func (e TL_messages_recentStickersNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_recentStickersNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_recentStickersNotModified)
-	return x.buf
 }
 
-func (e TL_messages_recentStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_recentStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_recentStickers)
 	x.Int(e.Hash)
 	x.Vector(e.Packs)
 	x.Vector(e.Stickers)
 	x.VectorInt(e.Dates)
-	return x.buf
 }
 
-func (e TL_messages_archivedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_archivedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_archivedStickers)
 	x.Int(e.Count)
 	x.Vector(e.Sets)
-	return x.buf
 }
 
-func (e TL_messages_stickerSetInstallResultSuccess) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_stickerSetInstallResultSuccess) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_stickerSetInstallResultSuccess)
-	return x.buf
 }
 
-func (e TL_messages_stickerSetInstallResultArchive) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_stickerSetInstallResultArchive) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_stickerSetInstallResultArchive)
 	x.Vector(e.Sets)
-	return x.buf
 }
 
-func (e TL_stickerSetCovered) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickerSetCovered) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickerSetCovered)
-	x.Bytes(e.Set.encode())
-	x.Bytes(e.Cover.encode())
-	return x.buf
+	x.Object(e.Set)
+	x.Object(e.Cover)
 }
 
-func (e TL_stickerSetMultiCovered) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickerSetMultiCovered) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickerSetMultiCovered)
-	x.Bytes(e.Set.encode())
+	x.Object(e.Set)
 	x.Vector(e.Covers)
-	return x.buf
 }
 
-func (e TL_maskCoords) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_maskCoords) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_maskCoords)
 	x.Int(e.N)
 	x.Double(e.X)
 	x.Double(e.Y)
 	x.Double(e.Zoom)
-	return x.buf
 }
 
-func (e TL_inputStickeredMediaPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickeredMediaPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickeredMediaPhoto)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_inputStickeredMediaDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickeredMediaDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickeredMediaDocument)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_game) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_game) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_game)
 	x.Int(e.Flags)
 	x.Long(e.ID)
@@ -15076,303 +13907,227 @@ func (e TL_game) encode() []byte {
 	x.String(e.ShortName)
 	x.String(e.Title)
 	x.String(e.Description)
-	x.Bytes(e.Photo.encode())
+	x.Object(e.Photo)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Document.encode())
+		x.Object(e.Document)
 	}
-	return x.buf
 }
 
-func (e TL_inputGameID) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputGameID) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputGameID)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputGameShortName) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputGameShortName) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputGameShortName)
-	x.Bytes(e.BotID.encode())
+	x.Object(e.BotID)
 	x.String(e.ShortName)
-	return x.buf
 }
 
-func (e TL_highScore) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_highScore) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_highScore)
 	x.Int(e.Pos)
 	x.Int(e.UserID)
 	x.Int(e.Score)
-	return x.buf
 }
 
-func (e TL_messages_highScores) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_highScores) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_highScores)
 	x.Vector(e.Scores)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_textEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textEmpty)
-	return x.buf
 }
 
-func (e TL_textPlain) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textPlain) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textPlain)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_textBold) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textBold) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textBold)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_textItalic) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textItalic) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textItalic)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_textUnderline) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textUnderline) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textUnderline)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_textStrike) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textStrike) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textStrike)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_textFixed) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textFixed) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textFixed)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_textUrl) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textUrl) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textUrl)
-	x.Bytes(e.Text.encode())
+	x.Object(e.Text)
 	x.String(e.Url)
 	x.Long(e.WebpageID)
-	return x.buf
 }
 
-func (e TL_textEmail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textEmail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textEmail)
-	x.Bytes(e.Text.encode())
+	x.Object(e.Text)
 	x.String(e.Email)
-	return x.buf
 }
 
-func (e TL_textConcat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textConcat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textConcat)
 	x.Vector(e.Texts)
-	return x.buf
 }
 
-func (e TL_textSubscript) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textSubscript) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textSubscript)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_textSuperscript) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textSuperscript) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textSuperscript)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_textMarked) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textMarked) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textMarked)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_textPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textPhone)
-	x.Bytes(e.Text.encode())
+	x.Object(e.Text)
 	x.String(e.Phone)
-	return x.buf
 }
 
-func (e TL_textImage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textImage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textImage)
 	x.Long(e.DocumentID)
 	x.Int(e.W)
 	x.Int(e.H)
-	return x.buf
 }
 
-func (e TL_textAnchor) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_textAnchor) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_textAnchor)
-	x.Bytes(e.Text.encode())
+	x.Object(e.Text)
 	x.String(e.Name)
-	return x.buf
 }
 
-func (e TL_pageBlockUnsupported) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockUnsupported) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockUnsupported)
-	return x.buf
 }
 
-func (e TL_pageBlockTitle) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockTitle) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockTitle)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageBlockSubtitle) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockSubtitle) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockSubtitle)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageBlockAuthorDate) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockAuthorDate) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockAuthorDate)
-	x.Bytes(e.Author.encode())
+	x.Object(e.Author)
 	x.Int(e.PublishedDate)
-	return x.buf
 }
 
-func (e TL_pageBlockHeader) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockHeader) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockHeader)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageBlockSubheader) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockSubheader) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockSubheader)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageBlockParagraph) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockParagraph) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockParagraph)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageBlockPreformatted) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockPreformatted) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockPreformatted)
-	x.Bytes(e.Text.encode())
+	x.Object(e.Text)
 	x.String(e.Language)
-	return x.buf
 }
 
-func (e TL_pageBlockFooter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockFooter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockFooter)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageBlockDivider) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockDivider) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockDivider)
-	return x.buf
 }
 
-func (e TL_pageBlockAnchor) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockAnchor) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockAnchor)
 	x.String(e.Name)
-	return x.buf
 }
 
-func (e TL_pageBlockList) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockList) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockList)
 	x.Vector(e.Items)
-	return x.buf
 }
 
-func (e TL_pageBlockBlockquote) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockBlockquote) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockBlockquote)
-	x.Bytes(e.Text.encode())
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Text)
+	x.Object(e.Caption)
 }
 
-func (e TL_pageBlockPullquote) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockPullquote) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockPullquote)
-	x.Bytes(e.Text.encode())
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Text)
+	x.Object(e.Caption)
 }
 
-func (e TL_pageBlockPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockPhoto)
 	x.Int(e.Flags)
 	x.Long(e.PhotoID)
-	x.Bytes(e.Caption.encode())
+	x.Object(e.Caption)
 	if e.Flags&1 != 0 {
 		x.String(e.Url)
 	}
 	if e.Flags&1 != 0 {
 		x.Long(e.WebpageID)
 	}
-	return x.buf
 }
 
-func (e TL_pageBlockVideo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockVideo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockVideo)
 	x.Int(e.Flags)
 	//flag Autoplay
 	//flag Loop
 	x.Long(e.VideoID)
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Caption)
 }
 
-func (e TL_pageBlockCover) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockCover) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockCover)
-	x.Bytes(e.Cover.encode())
-	return x.buf
+	x.Object(e.Cover)
 }
 
-func (e TL_pageBlockEmbed) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockEmbed) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockEmbed)
 	x.Int(e.Flags)
-	//flag FullWidth
-	//flag AllowScrolling
+	// flag FullWidth
+	// flag AllowScrolling
 	if e.Flags&2 != 0 {
 		x.String(e.Url)
 	}
@@ -15388,12 +14143,10 @@ func (e TL_pageBlockEmbed) encode() []byte {
 	if e.Flags&32 != 0 {
 		x.Int(e.H)
 	}
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Caption)
 }
 
-func (e TL_pageBlockEmbedPost) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockEmbedPost) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockEmbedPost)
 	x.String(e.Url)
 	x.Long(e.WebpageID)
@@ -15401,136 +14154,102 @@ func (e TL_pageBlockEmbedPost) encode() []byte {
 	x.String(e.Author)
 	x.Int(e.Date)
 	x.Vector(e.Blocks)
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Caption)
 }
 
-func (e TL_pageBlockCollage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockCollage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockCollage)
 	x.Vector(e.Items)
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Caption)
 }
 
-func (e TL_pageBlockSlideshow) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockSlideshow) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockSlideshow)
 	x.Vector(e.Items)
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Caption)
 }
 
-func (e TL_pageBlockChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockChannel)
-	x.Bytes(e.Channel.encode())
-	return x.buf
+	x.Object(e.Channel)
 }
 
-func (e TL_pageBlockAudio) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockAudio) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockAudio)
 	x.Long(e.AudioID)
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Caption)
 }
 
-func (e TL_pageBlockKicker) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockKicker) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockKicker)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageBlockTable) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockTable) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockTable)
 	x.Int(e.Flags)
 	//flag Bordered
 	//flag Striped
-	x.Bytes(e.Title.encode())
+	x.Object(e.Title)
 	x.Vector(e.Rows)
-	return x.buf
 }
 
-func (e TL_pageBlockOrderedList) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockOrderedList) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockOrderedList)
 	x.Vector(e.Items)
-	return x.buf
 }
 
-func (e TL_pageBlockDetails) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockDetails) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockDetails)
 	x.Int(e.Flags)
 	//flag Open
 	x.Vector(e.Blocks)
-	x.Bytes(e.Title.encode())
-	return x.buf
+	x.Object(e.Title)
 }
 
-func (e TL_pageBlockRelatedArticles) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockRelatedArticles) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockRelatedArticles)
-	x.Bytes(e.Title.encode())
+	x.Object(e.Title)
 	x.Vector(e.Articles)
-	return x.buf
 }
 
-func (e TL_pageBlockMap) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageBlockMap) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageBlockMap)
-	x.Bytes(e.Geo.encode())
+	x.Object(e.Geo)
 	x.Int(e.Zoom)
 	x.Int(e.W)
 	x.Int(e.H)
-	x.Bytes(e.Caption.encode())
-	return x.buf
+	x.Object(e.Caption)
 }
 
-func (e TL_phoneCallDiscardReasonMissed) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallDiscardReasonMissed) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallDiscardReasonMissed)
-	return x.buf
 }
 
-func (e TL_phoneCallDiscardReasonDisconnect) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallDiscardReasonDisconnect) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallDiscardReasonDisconnect)
-	return x.buf
 }
 
-func (e TL_phoneCallDiscardReasonHangup) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallDiscardReasonHangup) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallDiscardReasonHangup)
-	return x.buf
 }
 
-func (e TL_phoneCallDiscardReasonBusy) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallDiscardReasonBusy) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallDiscardReasonBusy)
-	return x.buf
 }
 
-func (e TL_dataJSON) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dataJSON) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dataJSON)
 	x.String(e.Data)
-	return x.buf
 }
 
-func (e TL_labeledPrice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_labeledPrice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_labeledPrice)
 	x.String(e.Label)
 	x.Long(e.Amount)
-	return x.buf
 }
 
-func (e TL_invoice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_invoice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_invoice)
 	x.Int(e.Flags)
 	//flag Test
@@ -15543,19 +14262,15 @@ func (e TL_invoice) encode() []byte {
 	//flag EmailToProvider
 	x.String(e.Currency)
 	x.Vector(e.Prices)
-	return x.buf
 }
 
-func (e TL_paymentCharge) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_paymentCharge) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_paymentCharge)
 	x.String(e.ID)
 	x.String(e.ProviderChargeID)
-	return x.buf
 }
 
-func (e TL_postAddress) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_postAddress) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_postAddress)
 	x.String(e.StreetLine1)
 	x.String(e.StreetLine2)
@@ -15563,11 +14278,9 @@ func (e TL_postAddress) encode() []byte {
 	x.String(e.State)
 	x.String(e.CountryIso2)
 	x.String(e.PostCode)
-	return x.buf
 }
 
-func (e TL_paymentRequestedInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_paymentRequestedInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_paymentRequestedInfo)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
@@ -15580,109 +14293,91 @@ func (e TL_paymentRequestedInfo) encode() []byte {
 		x.String(e.Email)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.ShippingAddress.encode())
+		x.Object(e.ShippingAddress)
 	}
-	return x.buf
 }
 
-func (e TL_paymentSavedCredentialsCard) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_paymentSavedCredentialsCard) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_paymentSavedCredentialsCard)
 	x.String(e.ID)
 	x.String(e.Title)
-	return x.buf
 }
 
-func (e TL_webDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_webDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_webDocument)
 	x.String(e.Url)
 	x.Long(e.AccessHash)
 	x.Int(e.Size)
 	x.String(e.MimeType)
 	x.Vector(e.Attributes)
-	return x.buf
 }
 
-func (e TL_webDocumentNoProxy) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_webDocumentNoProxy) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_webDocumentNoProxy)
 	x.String(e.Url)
 	x.Int(e.Size)
 	x.String(e.MimeType)
 	x.Vector(e.Attributes)
-	return x.buf
 }
 
-func (e TL_inputWebDocument) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputWebDocument) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputWebDocument)
 	x.String(e.Url)
 	x.Int(e.Size)
 	x.String(e.MimeType)
 	x.Vector(e.Attributes)
-	return x.buf
 }
 
-func (e TL_inputWebFileLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputWebFileLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputWebFileLocation)
 	x.String(e.Url)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputWebFileGeoPointLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputWebFileGeoPointLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputWebFileGeoPointLocation)
-	x.Bytes(e.GeoPoint.encode())
+	x.Object(e.GeoPoint)
 	x.Long(e.AccessHash)
 	x.Int(e.W)
 	x.Int(e.H)
 	x.Int(e.Zoom)
 	x.Int(e.Scale)
-	return x.buf
 }
 
-func (e TL_upload_webFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_webFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_webFile)
 	x.Int(e.Size)
 	x.String(e.MimeType)
-	x.Bytes(e.FileType.encode())
+	x.Object(e.FileType)
 	x.Int(e.Mtime)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_payments_paymentForm) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_paymentForm) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_paymentForm)
 	x.Int(e.Flags)
 	//flag CanSaveCredentials
 	//flag PasswordMissing
 	x.Int(e.BotID)
-	x.Bytes(e.Invoice.encode())
+	x.Object(e.Invoice)
 	x.Int(e.ProviderID)
 	x.String(e.Url)
 	if e.Flags&16 != 0 {
 		x.String(e.NativeProvider)
 	}
 	if e.Flags&16 != 0 {
-		x.Bytes(e.NativeParams.encode())
+		x.Object(e.NativeParams)
 	}
 	if e.Flags&1 != 0 {
-		x.Bytes(e.SavedInfo.encode())
+		x.Object(e.SavedInfo)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.SavedCredentials.encode())
+		x.Object(e.SavedCredentials)
 	}
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_payments_validatedRequestedInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_validatedRequestedInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_validatedRequestedInfo)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
@@ -15691,132 +14386,104 @@ func (e TL_payments_validatedRequestedInfo) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Vector(e.ShippingOptions)
 	}
-	return x.buf
 }
 
-func (e TL_payments_paymentResult) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_paymentResult) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_paymentResult)
-	x.Bytes(e.Updates.encode())
-	return x.buf
+	x.Object(e.Updates)
 }
 
-func (e TL_payments_paymentVerificationNeeded) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_paymentVerificationNeeded) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_paymentVerificationNeeded)
 	x.String(e.Url)
-	return x.buf
 }
 
-func (e TL_payments_paymentReceipt) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_paymentReceipt) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_paymentReceipt)
 	x.Int(e.Flags)
 	x.Int(e.Date)
 	x.Int(e.BotID)
-	x.Bytes(e.Invoice.encode())
+	x.Object(e.Invoice)
 	x.Int(e.ProviderID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Info.encode())
+		x.Object(e.Info)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Shipping.encode())
+		x.Object(e.Shipping)
 	}
 	x.String(e.Currency)
 	x.Long(e.TotalAmount)
 	x.String(e.CredentialsTitle)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_payments_savedInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_savedInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_savedInfo)
 	x.Int(e.Flags)
-	//flag HasSavedCredentials
+	// flag HasSavedCredentials
 	if e.Flags&1 != 0 {
-		x.Bytes(e.SavedInfo.encode())
+		x.Object(e.SavedInfo)
 	}
-	return x.buf
 }
 
-func (e TL_inputPaymentCredentialsSaved) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPaymentCredentialsSaved) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPaymentCredentialsSaved)
 	x.String(e.ID)
 	x.StringBytes(e.TmpPassword)
-	return x.buf
 }
 
-func (e TL_inputPaymentCredentials) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPaymentCredentials) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPaymentCredentials)
 	x.Int(e.Flags)
 	//flag Save
-	x.Bytes(e.Data.encode())
-	return x.buf
+	x.Object(e.Data)
 }
 
-func (e TL_inputPaymentCredentialsApplePay) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPaymentCredentialsApplePay) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPaymentCredentialsApplePay)
-	x.Bytes(e.PaymentData.encode())
-	return x.buf
+	x.Object(e.PaymentData)
 }
 
-func (e TL_inputPaymentCredentialsGooglePay) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPaymentCredentialsGooglePay) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPaymentCredentialsGooglePay)
-	x.Bytes(e.PaymentToken.encode())
-	return x.buf
+	x.Object(e.PaymentToken)
 }
 
-func (e TL_account_tmpPassword) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_tmpPassword) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_tmpPassword)
 	x.StringBytes(e.TmpPassword)
 	x.Int(e.ValidUntil)
-	return x.buf
 }
 
-func (e TL_shippingOption) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_shippingOption) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_shippingOption)
 	x.String(e.ID)
 	x.String(e.Title)
 	x.Vector(e.Prices)
-	return x.buf
 }
 
-func (e TL_inputStickerSetItem) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputStickerSetItem) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputStickerSetItem)
 	x.Int(e.Flags)
-	x.Bytes(e.Document.encode())
+	x.Object(e.Document)
 	x.String(e.Emoji)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.MaskCoords.encode())
+		x.Object(e.MaskCoords)
 	}
-	return x.buf
 }
 
-func (e TL_inputPhoneCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputPhoneCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputPhoneCall)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_phoneCallEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallEmpty)
 	x.Long(e.ID)
-	return x.buf
 }
 
-func (e TL_phoneCallWaiting) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallWaiting) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallWaiting)
 	x.Int(e.Flags)
 	//flag Video
@@ -15825,15 +14492,13 @@ func (e TL_phoneCallWaiting) encode() []byte {
 	x.Int(e.Date)
 	x.Int(e.AdminID)
 	x.Int(e.ParticipantID)
-	x.Bytes(e.Protocol.encode())
+	x.Object(e.Protocol)
 	if e.Flags&1 != 0 {
 		x.Int(e.ReceiveDate)
 	}
-	return x.buf
 }
 
-func (e TL_phoneCallRequested) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallRequested) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallRequested)
 	x.Int(e.Flags)
 	//flag Video
@@ -15843,12 +14508,10 @@ func (e TL_phoneCallRequested) encode() []byte {
 	x.Int(e.AdminID)
 	x.Int(e.ParticipantID)
 	x.StringBytes(e.GAHash)
-	x.Bytes(e.Protocol.encode())
-	return x.buf
+	x.Object(e.Protocol)
 }
 
-func (e TL_phoneCallAccepted) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallAccepted) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallAccepted)
 	x.Int(e.Flags)
 	//flag Video
@@ -15858,12 +14521,10 @@ func (e TL_phoneCallAccepted) encode() []byte {
 	x.Int(e.AdminID)
 	x.Int(e.ParticipantID)
 	x.StringBytes(e.GB)
-	x.Bytes(e.Protocol.encode())
-	return x.buf
+	x.Object(e.Protocol)
 }
 
-func (e TL_phoneCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCall)
 	x.Int(e.Flags)
 	//flag P2pAllowed
@@ -15875,14 +14536,12 @@ func (e TL_phoneCall) encode() []byte {
 	x.Int(e.ParticipantID)
 	x.StringBytes(e.GAOrB)
 	x.Long(e.KeyFingerprint)
-	x.Bytes(e.Protocol.encode())
+	x.Object(e.Protocol)
 	x.Vector(e.Connections)
 	x.Int(e.StartDate)
-	return x.buf
 }
 
-func (e TL_phoneCallDiscarded) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallDiscarded) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallDiscarded)
 	x.Int(e.Flags)
 	//flag NeedRating
@@ -15890,27 +14549,23 @@ func (e TL_phoneCallDiscarded) encode() []byte {
 	//flag Video
 	x.Long(e.ID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Reason.encode())
+		x.Object(e.Reason)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.Duration)
 	}
-	return x.buf
 }
 
-func (e TL_phoneConnection) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneConnection) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneConnection)
 	x.Long(e.ID)
 	x.String(e.Ip)
 	x.String(e.Ipv6)
 	x.Int(e.Port)
 	x.StringBytes(e.PeerTag)
-	return x.buf
 }
 
-func (e TL_phoneConnectionWebrtc) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneConnectionWebrtc) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneConnectionWebrtc)
 	x.Int(e.Flags)
 	//flag Turn
@@ -15921,11 +14576,9 @@ func (e TL_phoneConnectionWebrtc) encode() []byte {
 	x.Int(e.Port)
 	x.String(e.Username)
 	x.String(e.Password)
-	return x.buf
 }
 
-func (e TL_phoneCallProtocol) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phoneCallProtocol) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phoneCallProtocol)
 	x.Int(e.Flags)
 	//flag UdpP2p
@@ -15933,56 +14586,42 @@ func (e TL_phoneCallProtocol) encode() []byte {
 	x.Int(e.MinLayer)
 	x.Int(e.MaxLayer)
 	x.VectorString(e.LibraryVersions)
-	return x.buf
 }
 
-func (e TL_phone_phoneCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_phoneCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_phoneCall)
-	x.Bytes(e.PhoneCall.encode())
+	x.Object(e.PhoneCall)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_upload_cdnFileReuploadNeeded) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_cdnFileReuploadNeeded) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_cdnFileReuploadNeeded)
 	x.StringBytes(e.RequestToken)
-	return x.buf
 }
 
-func (e TL_upload_cdnFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_cdnFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_cdnFile)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_cdnPublicKey) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_cdnPublicKey) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_cdnPublicKey)
 	x.Int(e.DcID)
 	x.String(e.PublicKey)
-	return x.buf
 }
 
-func (e TL_cdnConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_cdnConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_cdnConfig)
 	x.Vector(e.PublicKeys)
-	return x.buf
 }
 
-func (e TL_langPackString) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langPackString) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langPackString)
 	x.String(e.Key)
 	x.String(e.Value)
-	return x.buf
 }
 
-func (e TL_langPackStringPluralized) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langPackStringPluralized) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langPackStringPluralized)
 	x.Int(e.Flags)
 	x.String(e.Key)
@@ -16002,28 +14641,22 @@ func (e TL_langPackStringPluralized) encode() []byte {
 		x.String(e.ManyValue)
 	}
 	x.String(e.OtherValue)
-	return x.buf
 }
 
-func (e TL_langPackStringDeleted) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langPackStringDeleted) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langPackStringDeleted)
 	x.String(e.Key)
-	return x.buf
 }
 
-func (e TL_langPackDifference) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langPackDifference) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langPackDifference)
 	x.String(e.LangCode)
 	x.Int(e.FromVersion)
 	x.Int(e.Version)
 	x.Vector(e.Strings)
-	return x.buf
 }
 
-func (e TL_langPackLanguage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langPackLanguage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langPackLanguage)
 	x.Int(e.Flags)
 	//flag Official
@@ -16039,373 +14672,281 @@ func (e TL_langPackLanguage) encode() []byte {
 	x.Int(e.StringsCount)
 	x.Int(e.TranslatedCount)
 	x.String(e.TranslationsUrl)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventActionChangeTitle) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionChangeTitle) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionChangeTitle)
 	x.String(e.PrevValue)
 	x.String(e.NewValue)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventActionChangeAbout) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionChangeAbout) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionChangeAbout)
 	x.String(e.PrevValue)
 	x.String(e.NewValue)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventActionChangeUsername) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionChangeUsername) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionChangeUsername)
 	x.String(e.PrevValue)
 	x.String(e.NewValue)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventActionChangePhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionChangePhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionChangePhoto)
-	x.Bytes(e.PrevPhoto.encode())
-	x.Bytes(e.NewPhoto.encode())
-	return x.buf
+	x.Object(e.PrevPhoto)
+	x.Object(e.NewPhoto)
 }
 
-func (e TL_channelAdminLogEventActionToggleInvites) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionToggleInvites) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionToggleInvites)
-	x.Bytes(e.NewValue.encode())
-	return x.buf
+	x.Object(e.NewValue)
 }
 
-func (e TL_channelAdminLogEventActionToggleSignatures) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionToggleSignatures) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionToggleSignatures)
-	x.Bytes(e.NewValue.encode())
-	return x.buf
+	x.Object(e.NewValue)
 }
 
-func (e TL_channelAdminLogEventActionUpdatePinned) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionUpdatePinned) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionUpdatePinned)
-	x.Bytes(e.Message.encode())
-	return x.buf
+	x.Object(e.Message)
 }
 
-func (e TL_channelAdminLogEventActionEditMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionEditMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionEditMessage)
-	x.Bytes(e.PrevMessage.encode())
-	x.Bytes(e.NewMessage.encode())
-	return x.buf
+	x.Object(e.PrevMessage)
+	x.Object(e.NewMessage)
 }
 
-func (e TL_channelAdminLogEventActionDeleteMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionDeleteMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionDeleteMessage)
-	x.Bytes(e.Message.encode())
-	return x.buf
+	x.Object(e.Message)
 }
 
-func (e TL_channelAdminLogEventActionParticipantJoin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantJoin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantJoin)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventActionParticipantLeave) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantLeave) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantLeave)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventActionParticipantInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantInvite)
-	x.Bytes(e.Participant.encode())
-	return x.buf
+	x.Object(e.Participant)
 }
 
-func (e TL_channelAdminLogEventActionParticipantToggleBan) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantToggleBan) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantToggleBan)
-	x.Bytes(e.PrevParticipant.encode())
-	x.Bytes(e.NewParticipant.encode())
-	return x.buf
+	x.Object(e.PrevParticipant)
+	x.Object(e.NewParticipant)
 }
 
-func (e TL_channelAdminLogEventActionParticipantToggleAdmin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantToggleAdmin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantToggleAdmin)
-	x.Bytes(e.PrevParticipant.encode())
-	x.Bytes(e.NewParticipant.encode())
-	return x.buf
+	x.Object(e.PrevParticipant)
+	x.Object(e.NewParticipant)
 }
 
-func (e TL_channelAdminLogEventActionChangeStickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionChangeStickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionChangeStickerSet)
-	x.Bytes(e.PrevStickerset.encode())
-	x.Bytes(e.NewStickerset.encode())
-	return x.buf
+	x.Object(e.PrevStickerset)
+	x.Object(e.NewStickerset)
 }
 
-func (e TL_channelAdminLogEventActionTogglePreHistoryHidden) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionTogglePreHistoryHidden) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionTogglePreHistoryHidden)
-	x.Bytes(e.NewValue.encode())
-	return x.buf
+	x.Object(e.NewValue)
 }
 
-func (e TL_channelAdminLogEventActionDefaultBannedRights) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionDefaultBannedRights) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionDefaultBannedRights)
-	x.Bytes(e.PrevBannedRights.encode())
-	x.Bytes(e.NewBannedRights.encode())
-	return x.buf
+	x.Object(e.PrevBannedRights)
+	x.Object(e.NewBannedRights)
 }
 
-func (e TL_channelAdminLogEventActionStopPoll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionStopPoll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionStopPoll)
-	x.Bytes(e.Message.encode())
-	return x.buf
+	x.Object(e.Message)
 }
 
-func (e TL_channelAdminLogEventActionChangeLinkedChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionChangeLinkedChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionChangeLinkedChat)
 	x.Int(e.PrevValue)
 	x.Int(e.NewValue)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventActionChangeLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionChangeLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionChangeLocation)
-	x.Bytes(e.PrevValue.encode())
-	x.Bytes(e.NewValue.encode())
-	return x.buf
+	x.Object(e.PrevValue)
+	x.Object(e.NewValue)
 }
 
-func (e TL_channelAdminLogEventActionToggleSlowMode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionToggleSlowMode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionToggleSlowMode)
 	x.Int(e.PrevValue)
 	x.Int(e.NewValue)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventActionStartGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionStartGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionStartGroupCall)
-	x.Bytes(e.Call.encode())
-	return x.buf
+	x.Object(e.Call)
 }
 
-func (e TL_channelAdminLogEventActionDiscardGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionDiscardGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionDiscardGroupCall)
-	x.Bytes(e.Call.encode())
-	return x.buf
+	x.Object(e.Call)
 }
 
-func (e TL_channelAdminLogEventActionParticipantMute) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantMute) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantMute)
-	x.Bytes(e.Participant.encode())
-	return x.buf
+	x.Object(e.Participant)
 }
 
-func (e TL_channelAdminLogEventActionParticipantUnmute) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantUnmute) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantUnmute)
-	x.Bytes(e.Participant.encode())
-	return x.buf
+	x.Object(e.Participant)
 }
 
-func (e TL_channelAdminLogEventActionToggleGroupCallSetting) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionToggleGroupCallSetting) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionToggleGroupCallSetting)
-	x.Bytes(e.JoinMuted.encode())
-	return x.buf
+	x.Object(e.JoinMuted)
 }
 
-func (e TL_channelAdminLogEventActionParticipantJoinByInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantJoinByInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantJoinByInvite)
-	x.Bytes(e.Invite.encode())
-	return x.buf
+	x.Object(e.Invite)
 }
 
-func (e TL_channelAdminLogEventActionExportedInviteDelete) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionExportedInviteDelete) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionExportedInviteDelete)
-	x.Bytes(e.Invite.encode())
-	return x.buf
+	x.Object(e.Invite)
 }
 
-func (e TL_channelAdminLogEventActionExportedInviteRevoke) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionExportedInviteRevoke) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionExportedInviteRevoke)
-	x.Bytes(e.Invite.encode())
-	return x.buf
+	x.Object(e.Invite)
 }
 
-func (e TL_channelAdminLogEventActionExportedInviteEdit) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionExportedInviteEdit) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionExportedInviteEdit)
-	x.Bytes(e.PrevInvite.encode())
-	x.Bytes(e.NewInvite.encode())
-	return x.buf
+	x.Object(e.PrevInvite)
+	x.Object(e.NewInvite)
 }
 
-func (e TL_channelAdminLogEventActionParticipantVolume) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionParticipantVolume) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionParticipantVolume)
-	x.Bytes(e.Participant.encode())
-	return x.buf
+	x.Object(e.Participant)
 }
 
-func (e TL_channelAdminLogEventActionChangeHistoryTTL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventActionChangeHistoryTTL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventActionChangeHistoryTTL)
 	x.Int(e.PrevValue)
 	x.Int(e.NewValue)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEvent) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEvent) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEvent)
 	x.Long(e.ID)
 	x.Int(e.Date)
 	x.Int(e.UserID)
-	x.Bytes(e.Action.encode())
-	return x.buf
+	x.Object(e.Action)
 }
 
-func (e TL_channels_adminLogResults) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_adminLogResults) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_adminLogResults)
 	x.Vector(e.Events)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_channelAdminLogEventsFilter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelAdminLogEventsFilter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelAdminLogEventsFilter)
 	x.Int(e.Flags)
-	//flag Join
-	//flag Leave
-	//flag Invite
-	//flag Ban
-	//flag Unban
-	//flag Kick
-	//flag Unkick
-	//flag Promote
-	//flag Demote
-	//flag Info
-	//flag Settings
-	//flag Pinned
-	//flag Edit
-	//flag Delete
-	//flag GroupCall
-	//flag Invites
-	return x.buf
-}
-
-func (e TL_popularContact) encode() []byte {
-	x := NewEncodeBuf(512)
+	// flag Join
+	// flag Leave
+	// flag Invite
+	// flag Ban
+	// flag Unban
+	// flag Kick
+	// flag Unkick
+	// flag Promote
+	// flag Demote
+	// flag Info
+	// flag Settings
+	// flag Pinned
+	// flag Edit
+	// flag Delete
+	// flag GroupCall
+	// flag Invites
+}
+
+func (e TL_popularContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_popularContact)
 	x.Long(e.ClientID)
 	x.Int(e.Importers)
-	return x.buf
 }
 
-func (e TL_messages_favedStickersNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_favedStickersNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_favedStickersNotModified)
-	return x.buf
 }
 
-func (e TL_messages_favedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_favedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_favedStickers)
 	x.Int(e.Hash)
 	x.Vector(e.Packs)
 	x.Vector(e.Stickers)
-	return x.buf
 }
 
-func (e TL_recentMeUrlUnknown) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_recentMeUrlUnknown) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_recentMeUrlUnknown)
 	x.String(e.Url)
-	return x.buf
 }
 
-func (e TL_recentMeUrlUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_recentMeUrlUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_recentMeUrlUser)
 	x.String(e.Url)
 	x.Int(e.UserID)
-	return x.buf
 }
 
-func (e TL_recentMeUrlChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_recentMeUrlChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_recentMeUrlChat)
 	x.String(e.Url)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_recentMeUrlChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_recentMeUrlChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_recentMeUrlChatInvite)
 	x.String(e.Url)
-	x.Bytes(e.ChatInvite.encode())
-	return x.buf
+	x.Object(e.ChatInvite)
 }
 
-func (e TL_recentMeUrlStickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_recentMeUrlStickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_recentMeUrlStickerSet)
 	x.String(e.Url)
-	x.Bytes(e.Set.encode())
-	return x.buf
+	x.Object(e.Set)
 }
 
-func (e TL_help_recentMeUrls) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_recentMeUrls) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_recentMeUrls)
 	x.Vector(e.Urls)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_inputSingleMedia) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputSingleMedia) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputSingleMedia)
 	x.Int(e.Flags)
-	x.Bytes(e.Media.encode())
+	x.Object(e.Media)
 	x.Long(e.RandomID)
 	x.String(e.Message)
 	if e.Flags&1 != 0 {
 		x.Vector(e.Entities)
 	}
-	return x.buf
 }
 
-func (e TL_webAuthorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_webAuthorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_webAuthorization)
 	x.Long(e.Hash)
 	x.Int(e.BotID)
@@ -16416,146 +14957,108 @@ func (e TL_webAuthorization) encode() []byte {
 	x.Int(e.DateActive)
 	x.String(e.Ip)
 	x.String(e.Region)
-	return x.buf
 }
 
-func (e TL_account_webAuthorizations) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_webAuthorizations) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_webAuthorizations)
 	x.Vector(e.Authorizations)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_inputMessageID) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessageID) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessageID)
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_inputMessageReplyTo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessageReplyTo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessageReplyTo)
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_inputMessagePinned) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessagePinned) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessagePinned)
-	return x.buf
 }
 
-func (e TL_inputMessageCallbackQuery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputMessageCallbackQuery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputMessageCallbackQuery)
 	x.Int(e.ID)
 	x.Long(e.QueryID)
-	return x.buf
 }
 
-func (e TL_inputDialogPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputDialogPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputDialogPeer)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_inputDialogPeerFolder) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputDialogPeerFolder) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputDialogPeerFolder)
 	x.Int(e.FolderID)
-	return x.buf
 }
 
-func (e TL_dialogPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dialogPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dialogPeer)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_dialogPeerFolder) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dialogPeerFolder) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dialogPeerFolder)
 	x.Int(e.FolderID)
-	return x.buf
 }
 
-func (e TL_messages_foundStickerSetsNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_foundStickerSetsNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_foundStickerSetsNotModified)
-	return x.buf
 }
 
-func (e TL_messages_foundStickerSets) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_foundStickerSets) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_foundStickerSets)
 	x.Int(e.Hash)
 	x.Vector(e.Sets)
-	return x.buf
 }
 
-func (e TL_fileHash) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_fileHash) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_fileHash)
 	x.Int(e.Offset)
 	x.Int(e.Limit)
 	x.StringBytes(e.Hash)
-	return x.buf
 }
 
-func (e TL_inputClientProxy) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputClientProxy) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputClientProxy)
 	x.String(e.Address)
 	x.Int(e.Port)
-	return x.buf
 }
 
-func (e TL_help_termsOfServiceUpdateEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_termsOfServiceUpdateEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_termsOfServiceUpdateEmpty)
 	x.Int(e.Expires)
-	return x.buf
 }
 
-func (e TL_help_termsOfServiceUpdate) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_termsOfServiceUpdate) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_termsOfServiceUpdate)
 	x.Int(e.Expires)
-	x.Bytes(e.TermsOfService.encode())
-	return x.buf
+	x.Object(e.TermsOfService)
 }
 
-func (e TL_inputSecureFileUploaded) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputSecureFileUploaded) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputSecureFileUploaded)
 	x.Long(e.ID)
 	x.Int(e.Parts)
 	x.String(e.Md5Checksum)
 	x.StringBytes(e.FileHash)
 	x.StringBytes(e.Secret)
-	return x.buf
 }
 
-func (e TL_inputSecureFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputSecureFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputSecureFile)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_secureFileEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureFileEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureFileEmpty)
-	return x.buf
 }
 
-func (e TL_secureFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureFile)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
@@ -16564,126 +15067,92 @@ func (e TL_secureFile) encode() []byte {
 	x.Int(e.Date)
 	x.StringBytes(e.FileHash)
 	x.StringBytes(e.Secret)
-	return x.buf
 }
 
-func (e TL_secureData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureData)
 	x.StringBytes(e.Data)
 	x.StringBytes(e.DataHash)
 	x.StringBytes(e.Secret)
-	return x.buf
 }
 
-func (e TL_securePlainPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_securePlainPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_securePlainPhone)
 	x.String(e.Phone)
-	return x.buf
 }
 
-func (e TL_securePlainEmail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_securePlainEmail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_securePlainEmail)
 	x.String(e.Email)
-	return x.buf
 }
 
-func (e TL_secureValueTypePersonalDetails) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypePersonalDetails) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypePersonalDetails)
-	return x.buf
 }
 
-func (e TL_secureValueTypePassport) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypePassport) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypePassport)
-	return x.buf
 }
 
-func (e TL_secureValueTypeDriverLicense) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeDriverLicense) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeDriverLicense)
-	return x.buf
 }
 
-func (e TL_secureValueTypeIdentityCard) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeIdentityCard) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeIdentityCard)
-	return x.buf
 }
 
-func (e TL_secureValueTypeInternalPassport) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeInternalPassport) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeInternalPassport)
-	return x.buf
 }
 
-func (e TL_secureValueTypeAddress) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeAddress) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeAddress)
-	return x.buf
 }
 
-func (e TL_secureValueTypeUtilityBill) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeUtilityBill) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeUtilityBill)
-	return x.buf
 }
 
-func (e TL_secureValueTypeBankStatement) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeBankStatement) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeBankStatement)
-	return x.buf
 }
 
-func (e TL_secureValueTypeRentalAgreement) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeRentalAgreement) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeRentalAgreement)
-	return x.buf
 }
 
-func (e TL_secureValueTypePassportRegistration) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypePassportRegistration) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypePassportRegistration)
-	return x.buf
 }
 
-func (e TL_secureValueTypeTemporaryRegistration) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeTemporaryRegistration) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeTemporaryRegistration)
-	return x.buf
 }
 
-func (e TL_secureValueTypePhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypePhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypePhone)
-	return x.buf
 }
 
-func (e TL_secureValueTypeEmail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueTypeEmail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueTypeEmail)
-	return x.buf
 }
 
-func (e TL_secureValue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValue)
 	x.Int(e.Flags)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Data.encode())
+		x.Object(e.Data)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.FrontSide.encode())
+		x.Object(e.FrontSide)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReverseSide.encode())
+		x.Object(e.ReverseSide)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.Selfie.encode())
+		x.Object(e.Selfie)
 	}
 	if e.Flags&64 != 0 {
 		x.Vector(e.Translation)
@@ -16692,28 +15161,26 @@ func (e TL_secureValue) encode() []byte {
 		x.Vector(e.Files)
 	}
 	if e.Flags&32 != 0 {
-		x.Bytes(e.PlainData.encode())
+		x.Object(e.PlainData)
 	}
 	x.StringBytes(e.Hash)
-	return x.buf
 }
 
-func (e TL_inputSecureValue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputSecureValue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputSecureValue)
 	x.Int(e.Flags)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Data.encode())
+		x.Object(e.Data)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.FrontSide.encode())
+		x.Object(e.FrontSide)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReverseSide.encode())
+		x.Object(e.ReverseSide)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.Selfie.encode())
+		x.Object(e.Selfie)
 	}
 	if e.Flags&64 != 0 {
 		x.Vector(e.Translation)
@@ -16722,112 +15189,88 @@ func (e TL_inputSecureValue) encode() []byte {
 		x.Vector(e.Files)
 	}
 	if e.Flags&32 != 0 {
-		x.Bytes(e.PlainData.encode())
+		x.Object(e.PlainData)
 	}
-	return x.buf
 }
 
-func (e TL_secureValueHash) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueHash) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueHash)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.StringBytes(e.Hash)
-	return x.buf
 }
 
-func (e TL_secureValueErrorData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueErrorData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueErrorData)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.StringBytes(e.DataHash)
 	x.String(e.Field)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureValueErrorFrontSide) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueErrorFrontSide) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueErrorFrontSide)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.StringBytes(e.FileHash)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureValueErrorReverseSide) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueErrorReverseSide) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueErrorReverseSide)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.StringBytes(e.FileHash)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureValueErrorSelfie) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueErrorSelfie) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueErrorSelfie)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.StringBytes(e.FileHash)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureValueErrorFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueErrorFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueErrorFile)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.StringBytes(e.FileHash)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureValueErrorFiles) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueErrorFiles) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueErrorFiles)
-	x.Bytes(e.Type.encode())
-	x.Vector(e.FileHash)
+	x.Object(e.Type)
+	x.VectorBytes(e.FileHash)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureValueError) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueError) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueError)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.StringBytes(e.Hash)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureValueErrorTranslationFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueErrorTranslationFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueErrorTranslationFile)
-	x.Bytes(e.Type.encode())
+	x.Object(e.Type)
 	x.StringBytes(e.FileHash)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureValueErrorTranslationFiles) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureValueErrorTranslationFiles) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureValueErrorTranslationFiles)
-	x.Bytes(e.Type.encode())
-	x.Vector(e.FileHash)
+	x.Object(e.Type)
+	x.VectorBytes(e.FileHash)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_secureCredentialsEncrypted) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureCredentialsEncrypted) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureCredentialsEncrypted)
 	x.StringBytes(e.Data)
 	x.StringBytes(e.Hash)
 	x.StringBytes(e.Secret)
-	return x.buf
 }
 
-func (e TL_account_authorizationForm) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_authorizationForm) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_authorizationForm)
 	x.Int(e.Flags)
 	x.Vector(e.RequiredTypes)
@@ -16837,25 +15280,19 @@ func (e TL_account_authorizationForm) encode() []byte {
 	if e.Flags&1 != 0 {
 		x.String(e.PrivacyPolicyUrl)
 	}
-	return x.buf
 }
 
-func (e TL_account_sentEmailCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_sentEmailCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_sentEmailCode)
 	x.String(e.EmailPattern)
 	x.Int(e.Length)
-	return x.buf
 }
 
-func (e TL_help_deepLinkInfoEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_deepLinkInfoEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_deepLinkInfoEmpty)
-	return x.buf
 }
 
-func (e TL_help_deepLinkInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_deepLinkInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_deepLinkInfo)
 	x.Int(e.Flags)
 	//flag UpdateApp
@@ -16863,188 +15300,142 @@ func (e TL_help_deepLinkInfo) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Vector(e.Entities)
 	}
-	return x.buf
 }
 
-func (e TL_savedPhoneContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_savedPhoneContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_savedPhoneContact)
 	x.String(e.Phone)
 	x.String(e.FirstName)
 	x.String(e.LastName)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_account_takeout) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_takeout) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_takeout)
 	x.Long(e.ID)
-	return x.buf
 }
 
-func (e TL_passwordKdfAlgoUnknown) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_passwordKdfAlgoUnknown) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_passwordKdfAlgoUnknown)
-	return x.buf
 }
 
-func (e TL_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow)
 	x.StringBytes(e.Salt1)
 	x.StringBytes(e.Salt2)
 	x.Int(e.G)
 	x.StringBytes(e.P)
-	return x.buf
 }
 
-func (e TL_securePasswordKdfAlgoUnknown) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_securePasswordKdfAlgoUnknown) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_securePasswordKdfAlgoUnknown)
-	return x.buf
 }
 
-func (e TL_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000)
 	x.StringBytes(e.Salt)
-	return x.buf
 }
 
-func (e TL_securePasswordKdfAlgoSHA512) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_securePasswordKdfAlgoSHA512) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_securePasswordKdfAlgoSHA512)
 	x.StringBytes(e.Salt)
-	return x.buf
 }
 
-func (e TL_secureSecretSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureSecretSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureSecretSettings)
-	x.Bytes(e.SecureAlgo.encode())
+	x.Object(e.SecureAlgo)
 	x.StringBytes(e.SecureSecret)
 	x.Long(e.SecureSecretID)
-	return x.buf
 }
 
-func (e TL_inputCheckPasswordEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputCheckPasswordEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputCheckPasswordEmpty)
-	return x.buf
 }
 
-func (e TL_inputCheckPasswordSRP) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputCheckPasswordSRP) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputCheckPasswordSRP)
 	x.Long(e.SrpID)
 	x.StringBytes(e.A)
 	x.StringBytes(e.M1)
-	return x.buf
 }
 
-func (e TL_secureRequiredType) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureRequiredType) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureRequiredType)
 	x.Int(e.Flags)
 	//flag NativeNames
 	//flag SelfieRequired
 	//flag TranslationRequired
-	x.Bytes(e.Type.encode())
-	return x.buf
+	x.Object(e.Type)
 }
 
-func (e TL_secureRequiredTypeOneOf) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_secureRequiredTypeOneOf) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_secureRequiredTypeOneOf)
 	x.Vector(e.Types)
-	return x.buf
 }
 
-func (e TL_help_passportConfigNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_passportConfigNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_passportConfigNotModified)
-	return x.buf
 }
 
-func (e TL_help_passportConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_passportConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_passportConfig)
 	x.Int(e.Hash)
-	x.Bytes(e.CountriesLangs.encode())
-	return x.buf
+	x.Object(e.CountriesLangs)
 }
 
-func (e TL_inputAppEvent) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputAppEvent) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputAppEvent)
 	x.Double(e.Time)
 	x.String(e.Type)
 	x.Long(e.Peer)
-	x.Bytes(e.Data.encode())
-	return x.buf
+	x.Object(e.Data)
 }
 
-func (e TL_jsonObjectValue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_jsonObjectValue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_jsonObjectValue)
 	x.String(e.Key)
-	x.Bytes(e.Value.encode())
-	return x.buf
+	x.Object(e.Value)
 }
 
-func (e TL_jsonNull) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_jsonNull) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_jsonNull)
-	return x.buf
 }
 
-func (e TL_jsonBool) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_jsonBool) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_jsonBool)
-	x.Bytes(e.Value.encode())
-	return x.buf
+	x.Object(e.Value)
 }
 
-func (e TL_jsonNumber) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_jsonNumber) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_jsonNumber)
 	x.Double(e.Value)
-	return x.buf
 }
 
-func (e TL_jsonString) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_jsonString) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_jsonString)
 	x.String(e.Value)
-	return x.buf
 }
 
-func (e TL_jsonArray) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_jsonArray) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_jsonArray)
 	x.Vector(e.Value)
-	return x.buf
 }
 
-func (e TL_jsonObject) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_jsonObject) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_jsonObject)
 	x.Vector(e.Value)
-	return x.buf
 }
 
-func (e TL_pageTableCell) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageTableCell) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageTableCell)
 	x.Int(e.Flags)
-	//flag Header
-	//flag AlignCenter
-	//flag AlignRight
-	//flag ValignMiddle
-	//flag ValignBottom
+	// flag Header
+	// flag AlignCenter
+	// flag AlignRight
+	// flag ValignMiddle
+	// flag ValignBottom
 	if e.Flags&128 != 0 {
-		x.Bytes(e.Text.encode())
+		x.Object(e.Text)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.Colspan)
@@ -17052,56 +15443,42 @@ func (e TL_pageTableCell) encode() []byte {
 	if e.Flags&4 != 0 {
 		x.Int(e.Rowspan)
 	}
-	return x.buf
 }
 
-func (e TL_pageTableRow) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageTableRow) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageTableRow)
 	x.Vector(e.Cells)
-	return x.buf
 }
 
-func (e TL_pageCaption) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageCaption) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageCaption)
-	x.Bytes(e.Text.encode())
-	x.Bytes(e.Credit.encode())
-	return x.buf
+	x.Object(e.Text)
+	x.Object(e.Credit)
 }
 
-func (e TL_pageListItemText) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageListItemText) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageListItemText)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageListItemBlocks) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageListItemBlocks) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageListItemBlocks)
 	x.Vector(e.Blocks)
-	return x.buf
 }
 
-func (e TL_pageListOrderedItemText) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageListOrderedItemText) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageListOrderedItemText)
 	x.String(e.Num)
-	x.Bytes(e.Text.encode())
-	return x.buf
+	x.Object(e.Text)
 }
 
-func (e TL_pageListOrderedItemBlocks) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageListOrderedItemBlocks) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageListOrderedItemBlocks)
 	x.String(e.Num)
 	x.Vector(e.Blocks)
-	return x.buf
 }
 
-func (e TL_pageRelatedArticle) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pageRelatedArticle) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pageRelatedArticle)
 	x.Int(e.Flags)
 	x.String(e.Url)
@@ -17121,11 +15498,9 @@ func (e TL_pageRelatedArticle) encode() []byte {
 	if e.Flags&16 != 0 {
 		x.Int(e.PublishedDate)
 	}
-	return x.buf
 }
 
-func (e TL_page) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_page) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_page)
 	x.Int(e.Flags)
 	//flag Part
@@ -17138,42 +15513,32 @@ func (e TL_page) encode() []byte {
 	if e.Flags&8 != 0 {
 		x.Int(e.Views)
 	}
-	return x.buf
 }
 
-func (e TL_help_supportName) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_supportName) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_supportName)
 	x.String(e.Name)
-	return x.buf
 }
 
-func (e TL_help_userInfoEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_userInfoEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_userInfoEmpty)
-	return x.buf
 }
 
-func (e TL_help_userInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_userInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_userInfo)
 	x.String(e.Message)
 	x.Vector(e.Entities)
 	x.String(e.Author)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_pollAnswer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pollAnswer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pollAnswer)
 	x.String(e.Text)
 	x.StringBytes(e.Option)
-	return x.buf
 }
 
-func (e TL_poll) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_poll) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_poll)
 	x.Long(e.ID)
 	x.Int(e.Flags)
@@ -17189,25 +15554,21 @@ func (e TL_poll) encode() []byte {
 	if e.Flags&32 != 0 {
 		x.Int(e.CloseDate)
 	}
-	return x.buf
 }
 
-func (e TL_pollAnswerVoters) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pollAnswerVoters) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pollAnswerVoters)
 	x.Int(e.Flags)
 	//flag Chosen
 	//flag Correct
 	x.StringBytes(e.Option)
 	x.Int(e.Voters)
-	return x.buf
 }
 
-func (e TL_pollResults) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_pollResults) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_pollResults)
 	x.Int(e.Flags)
-	//flag Min
+	// flag Min
 	if e.Flags&2 != 0 {
 		x.Vector(e.Results)
 	}
@@ -17223,43 +15584,35 @@ func (e TL_pollResults) encode() []byte {
 	if e.Flags&16 != 0 {
 		x.Vector(e.SolutionEntities)
 	}
-	return x.buf
 }
 
-func (e TL_chatOnlines) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatOnlines) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatOnlines)
 	x.Int(e.Onlines)
-	return x.buf
 }
 
-func (e TL_statsURL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsURL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsURL)
 	x.String(e.Url)
-	return x.buf
 }
 
-func (e TL_chatAdminRights) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatAdminRights) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatAdminRights)
 	x.Int(e.Flags)
-	//flag ChangeInfo
-	//flag PostMessages
-	//flag EditMessages
-	//flag DeleteMessages
-	//flag BanUsers
-	//flag InviteUsers
-	//flag PinMessages
-	//flag AddAdmins
-	//flag Anonymous
-	//flag ManageCall
-	//flag Other
-	return x.buf
+	// flag ChangeInfo
+	// flag PostMessages
+	// flag EditMessages
+	// flag DeleteMessages
+	// flag BanUsers
+	// flag InviteUsers
+	// flag PinMessages
+	// flag AddAdmins
+	// flag Anonymous
+	// flag ManageCall
+	// flag Other
 }
 
-func (e TL_chatBannedRights) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatBannedRights) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatBannedRights)
 	x.Int(e.Flags)
 	//flag ViewMessages
@@ -17275,60 +15628,46 @@ func (e TL_chatBannedRights) encode() []byte {
 	//flag InviteUsers
 	//flag PinMessages
 	x.Int(e.UntilDate)
-	return x.buf
 }
 
-func (e TL_inputWallPaper) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputWallPaper) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputWallPaper)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputWallPaperSlug) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputWallPaperSlug) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputWallPaperSlug)
 	x.String(e.Slug)
-	return x.buf
 }
 
-func (e TL_inputWallPaperNoFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputWallPaperNoFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputWallPaperNoFile)
-	return x.buf
 }
 
-func (e TL_account_wallPapersNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_wallPapersNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_wallPapersNotModified)
-	return x.buf
 }
 
-func (e TL_account_wallPapers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_wallPapers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_wallPapers)
 	x.Int(e.Hash)
 	x.Vector(e.Wallpapers)
-	return x.buf
 }
 
-func (e TL_codeSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_codeSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_codeSettings)
 	x.Int(e.Flags)
-	//flag AllowFlashcall
-	//flag CurrentNumber
-	//flag AllowAppHash
-	return x.buf
+	// flag AllowFlashcall
+	// flag CurrentNumber
+	// flag AllowAppHash
 }
 
-func (e TL_wallPaperSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_wallPaperSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_wallPaperSettings)
 	x.Int(e.Flags)
-	//flag Blur
-	//flag Motion
+	// flag Blur
+	// flag Motion
 	if e.Flags&1 != 0 {
 		x.Int(e.BackgroundColor)
 	}
@@ -17341,11 +15680,9 @@ func (e TL_wallPaperSettings) encode() []byte {
 	if e.Flags&16 != 0 {
 		x.Int(e.Rotation)
 	}
-	return x.buf
 }
 
-func (e TL_autoDownloadSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_autoDownloadSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_autoDownloadSettings)
 	x.Int(e.Flags)
 	//flag Disabled
@@ -17356,68 +15693,52 @@ func (e TL_autoDownloadSettings) encode() []byte {
 	x.Int(e.VideoSizeMax)
 	x.Int(e.FileSizeMax)
 	x.Int(e.VideoUploadMaxbitrate)
-	return x.buf
 }
 
-func (e TL_account_autoDownloadSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_autoDownloadSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_autoDownloadSettings)
-	x.Bytes(e.Low.encode())
-	x.Bytes(e.Medium.encode())
-	x.Bytes(e.High.encode())
-	return x.buf
+	x.Object(e.Low)
+	x.Object(e.Medium)
+	x.Object(e.High)
 }
 
-func (e TL_emojiKeyword) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_emojiKeyword) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_emojiKeyword)
 	x.String(e.Keyword)
 	x.VectorString(e.Emoticons)
-	return x.buf
 }
 
-func (e TL_emojiKeywordDeleted) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_emojiKeywordDeleted) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_emojiKeywordDeleted)
 	x.String(e.Keyword)
 	x.VectorString(e.Emoticons)
-	return x.buf
 }
 
-func (e TL_emojiKeywordsDifference) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_emojiKeywordsDifference) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_emojiKeywordsDifference)
 	x.String(e.LangCode)
 	x.Int(e.FromVersion)
 	x.Int(e.Version)
 	x.Vector(e.Keywords)
-	return x.buf
 }
 
-func (e TL_emojiURL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_emojiURL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_emojiURL)
 	x.String(e.Url)
-	return x.buf
 }
 
-func (e TL_emojiLanguage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_emojiLanguage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_emojiLanguage)
 	x.String(e.LangCode)
-	return x.buf
 }
 
-func (e TL_fileLocationToBeDeprecated) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_fileLocationToBeDeprecated) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_fileLocationToBeDeprecated)
 	x.Long(e.VolumeID)
 	x.Int(e.LocalID)
-	return x.buf
 }
 
-func (e TL_folder) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_folder) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_folder)
 	x.Int(e.Flags)
 	//flag AutofillNewBroadcasts
@@ -17426,116 +15747,88 @@ func (e TL_folder) encode() []byte {
 	x.Int(e.ID)
 	x.String(e.Title)
 	if e.Flags&8 != 0 {
-		x.Bytes(e.Photo.encode())
+		x.Object(e.Photo)
 	}
-	return x.buf
 }
 
-func (e TL_inputFolderPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputFolderPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputFolderPeer)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.FolderID)
-	return x.buf
 }
 
-func (e TL_folderPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_folderPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_folderPeer)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.FolderID)
-	return x.buf
 }
 
-func (e TL_messages_searchCounter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_searchCounter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_searchCounter)
 	x.Int(e.Flags)
 	//flag Inexact
-	x.Bytes(e.Filter.encode())
+	x.Object(e.Filter)
 	x.Int(e.Count)
-	return x.buf
 }
 
-func (e TL_urlAuthResultRequest) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_urlAuthResultRequest) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_urlAuthResultRequest)
 	x.Int(e.Flags)
 	//flag RequestWriteAccess
-	x.Bytes(e.Bot.encode())
+	x.Object(e.Bot)
 	x.String(e.Domain)
-	return x.buf
 }
 
-func (e TL_urlAuthResultAccepted) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_urlAuthResultAccepted) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_urlAuthResultAccepted)
 	x.String(e.Url)
-	return x.buf
 }
 
-func (e TL_urlAuthResultDefault) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_urlAuthResultDefault) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_urlAuthResultDefault)
-	return x.buf
 }
 
-func (e TL_channelLocationEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelLocationEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelLocationEmpty)
-	return x.buf
 }
 
-func (e TL_channelLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channelLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channelLocation)
-	x.Bytes(e.GeoPoint.encode())
+	x.Object(e.GeoPoint)
 	x.String(e.Address)
-	return x.buf
 }
 
-func (e TL_peerLocated) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_peerLocated) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_peerLocated)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.Expires)
 	x.Int(e.Distance)
-	return x.buf
 }
 
-func (e TL_peerSelfLocated) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_peerSelfLocated) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_peerSelfLocated)
 	x.Int(e.Expires)
-	return x.buf
 }
 
-func (e TL_restrictionReason) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_restrictionReason) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_restrictionReason)
 	x.String(e.Platform)
 	x.String(e.Reason)
 	x.String(e.Text)
-	return x.buf
 }
 
-func (e TL_inputTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputTheme)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_inputThemeSlug) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputThemeSlug) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputThemeSlug)
 	x.String(e.Slug)
-	return x.buf
 }
 
-func (e TL_theme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_theme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_theme)
 	x.Int(e.Flags)
 	//flag Creator
@@ -17545,105 +15838,79 @@ func (e TL_theme) encode() []byte {
 	x.String(e.Slug)
 	x.String(e.Title)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Document.encode())
+		x.Object(e.Document)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.Settings.encode())
+		x.Object(e.Settings)
 	}
 	x.Int(e.InstallsCount)
-	return x.buf
 }
 
-func (e TL_account_themesNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_themesNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_themesNotModified)
-	return x.buf
 }
 
-func (e TL_account_themes) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_themes) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_themes)
 	x.Int(e.Hash)
 	x.Vector(e.Themes)
-	return x.buf
 }
 
-func (e TL_auth_loginToken) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_loginToken) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_loginToken)
 	x.Int(e.Expires)
 	x.StringBytes(e.Token)
-	return x.buf
 }
 
-func (e TL_auth_loginTokenMigrateTo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_loginTokenMigrateTo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_loginTokenMigrateTo)
 	x.Int(e.DcID)
 	x.StringBytes(e.Token)
-	return x.buf
 }
 
-func (e TL_auth_loginTokenSuccess) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_loginTokenSuccess) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_loginTokenSuccess)
-	x.Bytes(e.Authorization.encode())
-	return x.buf
+	x.Object(e.Authorization)
 }
 
-func (e TL_account_contentSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_contentSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_contentSettings)
 	x.Int(e.Flags)
-	//flag SensitiveEnabled
-	//flag SensitiveCanChange
-	return x.buf
+	// flag SensitiveEnabled
+	// flag SensitiveCanChange
 }
 
-func (e TL_messages_inactiveChats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_inactiveChats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_inactiveChats)
 	x.VectorInt(e.Dates)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_baseThemeClassic) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_baseThemeClassic) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_baseThemeClassic)
-	return x.buf
 }
 
-func (e TL_baseThemeDay) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_baseThemeDay) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_baseThemeDay)
-	return x.buf
 }
 
-func (e TL_baseThemeNight) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_baseThemeNight) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_baseThemeNight)
-	return x.buf
 }
 
-func (e TL_baseThemeTinted) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_baseThemeTinted) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_baseThemeTinted)
-	return x.buf
 }
 
-func (e TL_baseThemeArctic) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_baseThemeArctic) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_baseThemeArctic)
-	return x.buf
 }
 
-func (e TL_inputThemeSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputThemeSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputThemeSettings)
 	x.Int(e.Flags)
-	x.Bytes(e.BaseTheme.encode())
+	x.Object(e.BaseTheme)
 	x.Int(e.AccentColor)
 	if e.Flags&1 != 0 {
 		x.Int(e.MessageTopColor)
@@ -17652,19 +15919,17 @@ func (e TL_inputThemeSettings) encode() []byte {
 		x.Int(e.MessageBottomColor)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Wallpaper.encode())
+		x.Object(e.Wallpaper)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.WallpaperSettings.encode())
+		x.Object(e.WallpaperSettings)
 	}
-	return x.buf
 }
 
-func (e TL_themeSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_themeSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_themeSettings)
 	x.Int(e.Flags)
-	x.Bytes(e.BaseTheme.encode())
+	x.Object(e.BaseTheme)
 	x.Int(e.AccentColor)
 	if e.Flags&1 != 0 {
 		x.Int(e.MessageTopColor)
@@ -17673,52 +15938,42 @@ func (e TL_themeSettings) encode() []byte {
 		x.Int(e.MessageBottomColor)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Wallpaper.encode())
+		x.Object(e.Wallpaper)
 	}
-	return x.buf
 }
 
-func (e TL_webPageAttributeTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_webPageAttributeTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_webPageAttributeTheme)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
 		x.Vector(e.Documents)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Settings.encode())
+		x.Object(e.Settings)
 	}
-	return x.buf
 }
 
-func (e TL_messageUserVote) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageUserVote) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageUserVote)
 	x.Int(e.UserID)
 	x.StringBytes(e.Option)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_messageUserVoteInputOption) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageUserVoteInputOption) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageUserVoteInputOption)
 	x.Int(e.UserID)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_messageUserVoteMultiple) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageUserVoteMultiple) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageUserVoteMultiple)
 	x.Int(e.UserID)
-	x.Vector(e.Options)
+	x.VectorBytes(e.Options)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_messages_votesList) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_votesList) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_votesList)
 	x.Int(e.Flags)
 	x.Int(e.Count)
@@ -17727,27 +15982,21 @@ func (e TL_messages_votesList) encode() []byte {
 	if e.Flags&1 != 0 {
 		x.String(e.NextOffset)
 	}
-	return x.buf
 }
 
-func (e TL_bankCardOpenUrl) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_bankCardOpenUrl) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_bankCardOpenUrl)
 	x.String(e.Url)
 	x.String(e.Name)
-	return x.buf
 }
 
-func (e TL_payments_bankCardData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_bankCardData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_bankCardData)
 	x.String(e.Title)
 	x.Vector(e.OpenUrls)
-	return x.buf
 }
 
-func (e TL_dialogFilter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dialogFilter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dialogFilter)
 	x.Int(e.Flags)
 	//flag Contacts
@@ -17766,110 +16015,88 @@ func (e TL_dialogFilter) encode() []byte {
 	x.Vector(e.PinnedPeers)
 	x.Vector(e.IncludePeers)
 	x.Vector(e.ExcludePeers)
-	return x.buf
 }
 
-func (e TL_dialogFilterSuggested) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_dialogFilterSuggested) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_dialogFilterSuggested)
-	x.Bytes(e.Filter.encode())
+	x.Object(e.Filter)
 	x.String(e.Description)
-	return x.buf
 }
 
-func (e TL_statsDateRangeDays) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsDateRangeDays) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsDateRangeDays)
 	x.Int(e.MinDate)
 	x.Int(e.MaxDate)
-	return x.buf
 }
 
-func (e TL_statsAbsValueAndPrev) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsAbsValueAndPrev) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsAbsValueAndPrev)
 	x.Double(e.Current)
 	x.Double(e.Previous)
-	return x.buf
 }
 
-func (e TL_statsPercentValue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsPercentValue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsPercentValue)
 	x.Double(e.Part)
 	x.Double(e.Total)
-	return x.buf
 }
 
-func (e TL_statsGraphAsync) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsGraphAsync) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsGraphAsync)
 	x.String(e.Token)
-	return x.buf
 }
 
-func (e TL_statsGraphError) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsGraphError) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsGraphError)
 	x.String(e.Error)
-	return x.buf
 }
 
-func (e TL_statsGraph) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsGraph) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsGraph)
 	x.Int(e.Flags)
-	x.Bytes(e.Json.encode())
+	x.Object(e.Json)
 	if e.Flags&1 != 0 {
 		x.String(e.ZoomToken)
 	}
-	return x.buf
 }
 
-func (e TL_messageInteractionCounters) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageInteractionCounters) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageInteractionCounters)
 	x.Int(e.MsgID)
 	x.Int(e.Views)
 	x.Int(e.Forwards)
-	return x.buf
 }
 
-func (e TL_stats_broadcastStats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stats_broadcastStats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stats_broadcastStats)
-	x.Bytes(e.Period.encode())
-	x.Bytes(e.Followers.encode())
-	x.Bytes(e.ViewsPerPost.encode())
-	x.Bytes(e.SharesPerPost.encode())
-	x.Bytes(e.EnabledNotifications.encode())
-	x.Bytes(e.GrowthGraph.encode())
-	x.Bytes(e.FollowersGraph.encode())
-	x.Bytes(e.MuteGraph.encode())
-	x.Bytes(e.TopHoursGraph.encode())
-	x.Bytes(e.InteractionsGraph.encode())
-	x.Bytes(e.IvInteractionsGraph.encode())
-	x.Bytes(e.ViewsBySourceGraph.encode())
-	x.Bytes(e.NewFollowersBySourceGraph.encode())
-	x.Bytes(e.LanguagesGraph.encode())
+	x.Object(e.Period)
+	x.Object(e.Followers)
+	x.Object(e.ViewsPerPost)
+	x.Object(e.SharesPerPost)
+	x.Object(e.EnabledNotifications)
+	x.Object(e.GrowthGraph)
+	x.Object(e.FollowersGraph)
+	x.Object(e.MuteGraph)
+	x.Object(e.TopHoursGraph)
+	x.Object(e.InteractionsGraph)
+	x.Object(e.IvInteractionsGraph)
+	x.Object(e.ViewsBySourceGraph)
+	x.Object(e.NewFollowersBySourceGraph)
+	x.Object(e.LanguagesGraph)
 	x.Vector(e.RecentMessageInteractions)
-	return x.buf
 }
 
-func (e TL_help_promoDataEmpty) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_promoDataEmpty) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_promoDataEmpty)
 	x.Int(e.Expires)
-	return x.buf
 }
 
-func (e TL_help_promoData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_promoData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_promoData)
 	x.Int(e.Flags)
 	//flag Proxy
 	x.Int(e.Expires)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
 	if e.Flags&2 != 0 {
@@ -17878,86 +16105,72 @@ func (e TL_help_promoData) encode() []byte {
 	if e.Flags&4 != 0 {
 		x.String(e.PsaMessage)
 	}
-	return x.buf
 }
 
-func (e TL_videoSize) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_videoSize) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_videoSize)
 	x.Int(e.Flags)
 	x.String(e.Type)
-	x.Bytes(e.Location.encode())
+	x.Object(e.Location)
 	x.Int(e.W)
 	x.Int(e.H)
 	x.Int(e.Size)
 	if e.Flags&1 != 0 {
 		x.Double(e.VideoStartTs)
 	}
-	return x.buf
 }
 
-func (e TL_statsGroupTopPoster) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsGroupTopPoster) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsGroupTopPoster)
 	x.Int(e.UserID)
 	x.Int(e.Messages)
 	x.Int(e.AvgChars)
-	return x.buf
 }
 
-func (e TL_statsGroupTopAdmin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsGroupTopAdmin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsGroupTopAdmin)
 	x.Int(e.UserID)
 	x.Int(e.Deleted)
 	x.Int(e.Kicked)
 	x.Int(e.Banned)
-	return x.buf
 }
 
-func (e TL_statsGroupTopInviter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_statsGroupTopInviter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_statsGroupTopInviter)
 	x.Int(e.UserID)
 	x.Int(e.Invitations)
-	return x.buf
 }
 
-func (e TL_stats_megagroupStats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stats_megagroupStats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stats_megagroupStats)
-	x.Bytes(e.Period.encode())
-	x.Bytes(e.Members.encode())
-	x.Bytes(e.Messages.encode())
-	x.Bytes(e.Viewers.encode())
-	x.Bytes(e.Posters.encode())
-	x.Bytes(e.GrowthGraph.encode())
-	x.Bytes(e.MembersGraph.encode())
-	x.Bytes(e.NewMembersBySourceGraph.encode())
-	x.Bytes(e.LanguagesGraph.encode())
-	x.Bytes(e.MessagesGraph.encode())
-	x.Bytes(e.ActionsGraph.encode())
-	x.Bytes(e.TopHoursGraph.encode())
-	x.Bytes(e.WeekdaysGraph.encode())
+	x.Object(e.Period)
+	x.Object(e.Members)
+	x.Object(e.Messages)
+	x.Object(e.Viewers)
+	x.Object(e.Posters)
+	x.Object(e.GrowthGraph)
+	x.Object(e.MembersGraph)
+	x.Object(e.NewMembersBySourceGraph)
+	x.Object(e.LanguagesGraph)
+	x.Object(e.MessagesGraph)
+	x.Object(e.ActionsGraph)
+	x.Object(e.TopHoursGraph)
+	x.Object(e.WeekdaysGraph)
 	x.Vector(e.TopPosters)
 	x.Vector(e.TopAdmins)
 	x.Vector(e.TopInviters)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_globalPrivacySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_globalPrivacySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_globalPrivacySettings)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.ArchiveAndMuteNewNoncontactPeers.encode())
+		x.Object(e.ArchiveAndMuteNewNoncontactPeers)
 	}
-	return x.buf
 }
 
-func (e TL_help_countryCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_countryCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_countryCode)
 	x.Int(e.Flags)
 	x.String(e.CountryCode)
@@ -17967,11 +16180,9 @@ func (e TL_help_countryCode) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.VectorString(e.Patterns)
 	}
-	return x.buf
 }
 
-func (e TL_help_country) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_country) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_country)
 	x.Int(e.Flags)
 	//flag Hidden
@@ -17981,25 +16192,19 @@ func (e TL_help_country) encode() []byte {
 		x.String(e.Name)
 	}
 	x.Vector(e.CountryCodes)
-	return x.buf
 }
 
-func (e TL_help_countriesListNotModified) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_countriesListNotModified) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_countriesListNotModified)
-	return x.buf
 }
 
-func (e TL_help_countriesList) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_countriesList) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_countriesList)
 	x.Vector(e.Countries)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messageViews) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageViews) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageViews)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
@@ -18009,22 +16214,18 @@ func (e TL_messageViews) encode() []byte {
 		x.Int(e.Forwards)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Replies.encode())
+		x.Object(e.Replies)
 	}
-	return x.buf
 }
 
-func (e TL_messages_messageViews) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_messageViews) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_messageViews)
 	x.Vector(e.Views)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_discussionMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_discussionMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_discussionMessage)
 	x.Int(e.Flags)
 	x.Vector(e.Messages)
@@ -18039,25 +16240,21 @@ func (e TL_messages_discussionMessage) encode() []byte {
 	}
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messageReplyHeader) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageReplyHeader) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageReplyHeader)
 	x.Int(e.Flags)
 	x.Int(e.ReplyToMsgID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.ReplyToPeerID.encode())
+		x.Object(e.ReplyToPeerID)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.ReplyToTopID)
 	}
-	return x.buf
 }
 
-func (e TL_messageReplies) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messageReplies) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messageReplies)
 	x.Int(e.Flags)
 	//flag Comments
@@ -18075,35 +16272,27 @@ func (e TL_messageReplies) encode() []byte {
 	if e.Flags&8 != 0 {
 		x.Int(e.ReadMaxID)
 	}
-	return x.buf
 }
 
-func (e TL_peerBlocked) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_peerBlocked) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_peerBlocked)
-	x.Bytes(e.PeerID.encode())
+	x.Object(e.PeerID)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_stats_messageStats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stats_messageStats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stats_messageStats)
-	x.Bytes(e.ViewsGraph.encode())
-	return x.buf
+	x.Object(e.ViewsGraph)
 }
 
-func (e TL_groupCallDiscarded) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_groupCallDiscarded) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_groupCallDiscarded)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
 	x.Int(e.Duration)
-	return x.buf
 }
 
-func (e TL_groupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_groupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_groupCall)
 	x.Int(e.Flags)
 	//flag JoinMuted
@@ -18113,7 +16302,7 @@ func (e TL_groupCall) encode() []byte {
 	x.Long(e.AccessHash)
 	x.Int(e.ParticipantsCount)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Params.encode())
+		x.Object(e.Params)
 	}
 	if e.Flags&8 != 0 {
 		x.String(e.Title)
@@ -18125,19 +16314,15 @@ func (e TL_groupCall) encode() []byte {
 		x.Int(e.RecordStartDate)
 	}
 	x.Int(e.Version)
-	return x.buf
 }
 
-func (e TL_inputGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inputGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inputGroupCall)
 	x.Long(e.ID)
 	x.Long(e.AccessHash)
-	return x.buf
 }
 
-func (e TL_groupCallParticipant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_groupCallParticipant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_groupCallParticipant)
 	x.Int(e.Flags)
 	//flag Muted
@@ -18149,7 +16334,7 @@ func (e TL_groupCallParticipant) encode() []byte {
 	//flag MutedByYou
 	//flag VolumeByAdmin
 	//flag Self
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.Date)
 	if e.Flags&8 != 0 {
 		x.Int(e.ActiveDate)
@@ -18164,22 +16349,18 @@ func (e TL_groupCallParticipant) encode() []byte {
 	if e.Flags&8192 != 0 {
 		x.Long(e.RaiseHandRating)
 	}
-	return x.buf
 }
 
-func (e TL_phone_groupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_groupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_groupCall)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.Vector(e.Participants)
 	x.String(e.ParticipantsNextOffset)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_phone_groupParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_groupParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_groupParticipants)
 	x.Int(e.Count)
 	x.Vector(e.Participants)
@@ -18187,169 +16368,127 @@ func (e TL_phone_groupParticipants) encode() []byte {
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
 	x.Int(e.Version)
-	return x.buf
 }
 
-func (e TL_inlineQueryPeerTypeSameBotPM) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inlineQueryPeerTypeSameBotPM) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inlineQueryPeerTypeSameBotPM)
-	return x.buf
 }
 
-func (e TL_inlineQueryPeerTypePM) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inlineQueryPeerTypePM) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inlineQueryPeerTypePM)
-	return x.buf
 }
 
-func (e TL_inlineQueryPeerTypeChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inlineQueryPeerTypeChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inlineQueryPeerTypeChat)
-	return x.buf
 }
 
-func (e TL_inlineQueryPeerTypeMegagroup) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inlineQueryPeerTypeMegagroup) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inlineQueryPeerTypeMegagroup)
-	return x.buf
 }
 
-func (e TL_inlineQueryPeerTypeBroadcast) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_inlineQueryPeerTypeBroadcast) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_inlineQueryPeerTypeBroadcast)
-	return x.buf
 }
 
-func (e TL_messages_historyImport) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_historyImport) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_historyImport)
 	x.Long(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_historyImportParsed) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_historyImportParsed) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_historyImportParsed)
 	x.Int(e.Flags)
-	//flag Pm
-	//flag Group
+	// flag Pm
+	// flag Group
 	if e.Flags&4 != 0 {
 		x.String(e.Title)
 	}
-	return x.buf
 }
 
-func (e TL_messages_affectedFoundMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_affectedFoundMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_affectedFoundMessages)
 	x.Int(e.Pts)
 	x.Int(e.PtsCount)
 	x.Int(e.Offset)
 	x.VectorInt(e.Messages)
-	return x.buf
 }
 
-func (e TL_chatInviteImporter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatInviteImporter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatInviteImporter)
 	x.Int(e.UserID)
 	x.Int(e.Date)
-	return x.buf
 }
 
-func (e TL_messages_exportedChatInvites) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_exportedChatInvites) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_exportedChatInvites)
 	x.Int(e.Count)
 	x.Vector(e.Invites)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_exportedChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_exportedChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_exportedChatInvite)
-	x.Bytes(e.Invite.encode())
+	x.Object(e.Invite)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_exportedChatInviteReplaced) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_exportedChatInviteReplaced) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_exportedChatInviteReplaced)
-	x.Bytes(e.Invite.encode())
-	x.Bytes(e.NewInvite.encode())
+	x.Object(e.Invite)
+	x.Object(e.NewInvite)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_chatInviteImporters) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_chatInviteImporters) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_chatInviteImporters)
 	x.Int(e.Count)
 	x.Vector(e.Importers)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_chatAdminWithInvites) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_chatAdminWithInvites) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_chatAdminWithInvites)
 	x.Int(e.AdminID)
 	x.Int(e.InvitesCount)
 	x.Int(e.RevokedInvitesCount)
-	return x.buf
 }
 
-func (e TL_messages_chatAdminsWithInvites) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_chatAdminsWithInvites) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_chatAdminsWithInvites)
 	x.Vector(e.Admins)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_messages_checkedHistoryImportPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_checkedHistoryImportPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_checkedHistoryImportPeer)
 	x.String(e.ConfirmText)
-	return x.buf
 }
 
-func (e TL_phone_joinAsPeers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_joinAsPeers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_joinAsPeers)
 	x.Vector(e.Peers)
 	x.Vector(e.Chats)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_phone_exportedGroupCallInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_exportedGroupCallInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_exportedGroupCallInvite)
 	x.String(e.Link)
-	return x.buf
 }
 
-func (e TL_invokeAfterMsg) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_invokeAfterMsg) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_invokeAfterMsg)
 	x.Long(e.MsgID)
-	x.Bytes(e.Query.encode())
-	return x.buf
+	x.Object(e.Query)
 }
 
-func (e TL_invokeAfterMsgs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_invokeAfterMsgs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_invokeAfterMsgs)
 	x.VectorLong(e.MsgIds)
-	x.Bytes(e.Query.encode())
-	return x.buf
+	x.Object(e.Query)
 }
 
-func (e TL_initConnection) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_initConnection) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_initConnection)
 	x.Int(e.Flags)
 	x.Int(e.ApiID)
@@ -18360,233 +16499,177 @@ func (e TL_initConnection) encode() []byte {
 	x.String(e.LangPack)
 	x.String(e.LangCode)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Proxy.encode())
+		x.Object(e.Proxy)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Params.encode())
+		x.Object(e.Params)
 	}
-	x.Bytes(e.Query.encode())
-	return x.buf
+	x.Object(e.Query)
 }
 
-func (e TL_invokeWithLayer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_invokeWithLayer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_invokeWithLayer)
 	x.Int(e.Layer)
-	x.Bytes(e.Query.encode())
-	return x.buf
+	x.Object(e.Query)
 }
 
-func (e TL_invokeWithoutUpdates) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_invokeWithoutUpdates) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_invokeWithoutUpdates)
-	x.Bytes(e.Query.encode())
-	return x.buf
+	x.Object(e.Query)
 }
 
-func (e TL_invokeWithMessagesRange) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_invokeWithMessagesRange) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_invokeWithMessagesRange)
-	x.Bytes(e.Range.encode())
-	x.Bytes(e.Query.encode())
-	return x.buf
+	x.Object(e.Range)
+	x.Object(e.Query)
 }
 
-func (e TL_invokeWithTakeout) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_invokeWithTakeout) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_invokeWithTakeout)
 	x.Long(e.TakeoutID)
-	x.Bytes(e.Query.encode())
-	return x.buf
+	x.Object(e.Query)
 }
 
-func (e TL_auth_sendCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_sendCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_sendCode)
 	x.String(e.PhoneNumber)
 	x.Int(e.ApiID)
 	x.String(e.ApiHash)
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Settings)
 }
 
-func (e TL_auth_signUp) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_signUp) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_signUp)
 	x.String(e.PhoneNumber)
 	x.String(e.PhoneCodeHash)
 	x.String(e.FirstName)
 	x.String(e.LastName)
-	return x.buf
 }
 
-func (e TL_auth_signIn) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_signIn) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_signIn)
 	x.String(e.PhoneNumber)
 	x.String(e.PhoneCodeHash)
 	x.String(e.PhoneCode)
-	return x.buf
 }
 
-func (e TL_auth_logOut) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_logOut) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_logOut)
-	return x.buf
 }
 
-func (e TL_auth_resetAuthorizations) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_resetAuthorizations) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_resetAuthorizations)
-	return x.buf
 }
 
-func (e TL_auth_exportAuthorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_exportAuthorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_exportAuthorization)
 	x.Int(e.DcID)
-	return x.buf
 }
 
-func (e TL_auth_importAuthorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_importAuthorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_importAuthorization)
 	x.Int(e.ID)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_auth_bindTempAuthKey) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_bindTempAuthKey) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_bindTempAuthKey)
 	x.Long(e.PermAuthKeyID)
 	x.Long(e.Nonce)
 	x.Int(e.ExpiresAt)
 	x.StringBytes(e.EncryptedMessage)
-	return x.buf
 }
 
-func (e TL_auth_importBotAuthorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_importBotAuthorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_importBotAuthorization)
 	x.Int(e.Flags)
 	x.Int(e.ApiID)
 	x.String(e.ApiHash)
 	x.String(e.BotAuthToken)
-	return x.buf
 }
 
-func (e TL_auth_checkPassword) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_checkPassword) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_checkPassword)
-	x.Bytes(e.Password.encode())
-	return x.buf
+	x.Object(e.Password)
 }
 
-func (e TL_auth_requestPasswordRecovery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_requestPasswordRecovery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_requestPasswordRecovery)
-	return x.buf
 }
 
-func (e TL_auth_recoverPassword) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_recoverPassword) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_recoverPassword)
 	x.String(e.Code)
-	return x.buf
 }
 
-func (e TL_auth_resendCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_resendCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_resendCode)
 	x.String(e.PhoneNumber)
 	x.String(e.PhoneCodeHash)
-	return x.buf
 }
 
-func (e TL_auth_cancelCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_cancelCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_cancelCode)
 	x.String(e.PhoneNumber)
 	x.String(e.PhoneCodeHash)
-	return x.buf
 }
 
-func (e TL_auth_dropTempAuthKeys) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_dropTempAuthKeys) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_dropTempAuthKeys)
 	x.VectorLong(e.ExceptAuthKeys)
-	return x.buf
 }
 
-func (e TL_auth_exportLoginToken) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_exportLoginToken) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_exportLoginToken)
 	x.Int(e.ApiID)
 	x.String(e.ApiHash)
 	x.VectorInt(e.ExceptIds)
-	return x.buf
 }
 
-func (e TL_auth_importLoginToken) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_importLoginToken) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_importLoginToken)
 	x.StringBytes(e.Token)
-	return x.buf
 }
 
-func (e TL_auth_acceptLoginToken) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_auth_acceptLoginToken) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_auth_acceptLoginToken)
 	x.StringBytes(e.Token)
-	return x.buf
 }
 
-func (e TL_account_registerDevice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_registerDevice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_registerDevice)
 	x.Int(e.Flags)
 	//flag NoMuted
 	x.Int(e.TokenType)
 	x.String(e.Token)
-	x.Bytes(e.AppSandbox.encode())
+	x.Object(e.AppSandbox)
 	x.StringBytes(e.Secret)
 	x.VectorInt(e.OtherUids)
-	return x.buf
 }
 
-func (e TL_account_unregisterDevice) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_unregisterDevice) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_unregisterDevice)
 	x.Int(e.TokenType)
 	x.String(e.Token)
 	x.VectorInt(e.OtherUids)
-	return x.buf
 }
 
-func (e TL_account_updateNotifySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_updateNotifySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_updateNotifySettings)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.Settings)
 }
 
-func (e TL_account_getNotifySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getNotifySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getNotifySettings)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_account_resetNotifySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_resetNotifySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_resetNotifySettings)
-	return x.buf
 }
 
-func (e TL_account_updateProfile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_updateProfile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_updateProfile)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
@@ -18598,418 +16681,314 @@ func (e TL_account_updateProfile) encode() []byte {
 	if e.Flags&4 != 0 {
 		x.String(e.About)
 	}
-	return x.buf
 }
 
-func (e TL_account_updateStatus) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_updateStatus) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_updateStatus)
-	x.Bytes(e.Offline.encode())
-	return x.buf
+	x.Object(e.Offline)
 }
 
-func (e TL_account_getWallPapers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getWallPapers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getWallPapers)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_account_reportPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_reportPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_reportPeer)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.Reason.encode())
+	x.Object(e.Peer)
+	x.Object(e.Reason)
 	x.String(e.Message)
-	return x.buf
 }
 
-func (e TL_account_checkUsername) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_checkUsername) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_checkUsername)
 	x.String(e.Username)
-	return x.buf
 }
 
-func (e TL_account_updateUsername) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_updateUsername) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_updateUsername)
 	x.String(e.Username)
-	return x.buf
 }
 
-func (e TL_account_getPrivacy) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getPrivacy) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getPrivacy)
-	x.Bytes(e.Key.encode())
-	return x.buf
+	x.Object(e.Key)
 }
 
-func (e TL_account_setPrivacy) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_setPrivacy) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_setPrivacy)
-	x.Bytes(e.Key.encode())
+	x.Object(e.Key)
 	x.Vector(e.Rules)
-	return x.buf
 }
 
-func (e TL_account_deleteAccount) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_deleteAccount) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_deleteAccount)
 	x.String(e.Reason)
-	return x.buf
 }
 
-func (e TL_account_getAccountTTL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getAccountTTL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getAccountTTL)
-	return x.buf
 }
 
-func (e TL_account_setAccountTTL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_setAccountTTL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_setAccountTTL)
-	x.Bytes(e.Ttl.encode())
-	return x.buf
+	x.Object(e.Ttl)
 }
 
-func (e TL_account_sendChangePhoneCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_sendChangePhoneCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_sendChangePhoneCode)
 	x.String(e.PhoneNumber)
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Settings)
 }
 
-func (e TL_account_changePhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_changePhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_changePhone)
 	x.String(e.PhoneNumber)
 	x.String(e.PhoneCodeHash)
 	x.String(e.PhoneCode)
-	return x.buf
 }
 
-func (e TL_account_updateDeviceLocked) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_updateDeviceLocked) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_updateDeviceLocked)
 	x.Int(e.Period)
-	return x.buf
 }
 
-func (e TL_account_getAuthorizations) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getAuthorizations) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getAuthorizations)
-	return x.buf
 }
 
-func (e TL_account_resetAuthorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_resetAuthorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_resetAuthorization)
 	x.Long(e.Hash)
-	return x.buf
 }
 
-func (e TL_account_getPassword) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getPassword) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getPassword)
-	return x.buf
 }
 
-func (e TL_account_getPasswordSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getPasswordSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getPasswordSettings)
-	x.Bytes(e.Password.encode())
-	return x.buf
+	x.Object(e.Password)
 }
 
-func (e TL_account_updatePasswordSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_updatePasswordSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_updatePasswordSettings)
-	x.Bytes(e.Password.encode())
-	x.Bytes(e.NewSettings.encode())
-	return x.buf
+	x.Object(e.Password)
+	x.Object(e.NewSettings)
 }
 
-func (e TL_account_sendConfirmPhoneCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_sendConfirmPhoneCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_sendConfirmPhoneCode)
 	x.String(e.Hash)
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Settings)
 }
 
-func (e TL_account_confirmPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_confirmPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_confirmPhone)
 	x.String(e.PhoneCodeHash)
 	x.String(e.PhoneCode)
-	return x.buf
 }
 
-func (e TL_account_getTmpPassword) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getTmpPassword) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getTmpPassword)
-	x.Bytes(e.Password.encode())
+	x.Object(e.Password)
 	x.Int(e.Period)
-	return x.buf
 }
 
-func (e TL_account_getWebAuthorizations) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getWebAuthorizations) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getWebAuthorizations)
-	return x.buf
 }
 
-func (e TL_account_resetWebAuthorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_resetWebAuthorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_resetWebAuthorization)
 	x.Long(e.Hash)
-	return x.buf
 }
 
-func (e TL_account_resetWebAuthorizations) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_resetWebAuthorizations) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_resetWebAuthorizations)
-	return x.buf
 }
 
-func (e TL_account_getAllSecureValues) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getAllSecureValues) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getAllSecureValues)
-	return x.buf
 }
 
-func (e TL_account_getSecureValue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getSecureValue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getSecureValue)
 	x.Vector(e.Types)
-	return x.buf
 }
 
-func (e TL_account_saveSecureValue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_saveSecureValue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_saveSecureValue)
-	x.Bytes(e.Value.encode())
+	x.Object(e.Value)
 	x.Long(e.SecureSecretID)
-	return x.buf
 }
 
-func (e TL_account_deleteSecureValue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_deleteSecureValue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_deleteSecureValue)
 	x.Vector(e.Types)
-	return x.buf
 }
 
-func (e TL_account_getAuthorizationForm) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getAuthorizationForm) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getAuthorizationForm)
 	x.Int(e.BotID)
 	x.String(e.Scope)
 	x.String(e.PublicKey)
-	return x.buf
 }
 
-func (e TL_account_acceptAuthorization) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_acceptAuthorization) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_acceptAuthorization)
 	x.Int(e.BotID)
 	x.String(e.Scope)
 	x.String(e.PublicKey)
 	x.Vector(e.ValueHashes)
-	x.Bytes(e.Credentials.encode())
-	return x.buf
+	x.Object(e.Credentials)
 }
 
-func (e TL_account_sendVerifyPhoneCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_sendVerifyPhoneCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_sendVerifyPhoneCode)
 	x.String(e.PhoneNumber)
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Settings)
 }
 
-func (e TL_account_verifyPhone) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_verifyPhone) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_verifyPhone)
 	x.String(e.PhoneNumber)
 	x.String(e.PhoneCodeHash)
 	x.String(e.PhoneCode)
-	return x.buf
 }
 
-func (e TL_account_sendVerifyEmailCode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_sendVerifyEmailCode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_sendVerifyEmailCode)
 	x.String(e.Email)
-	return x.buf
 }
 
-func (e TL_account_verifyEmail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_verifyEmail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_verifyEmail)
 	x.String(e.Email)
 	x.String(e.Code)
-	return x.buf
 }
 
-func (e TL_account_initTakeoutSession) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_initTakeoutSession) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_initTakeoutSession)
 	x.Int(e.Flags)
-	//flag Contacts
-	//flag MessageUsers
-	//flag MessageChats
-	//flag MessageMegagroups
-	//flag MessageChannels
-	//flag Files
+	// flag Contacts
+	// flag MessageUsers
+	// flag MessageChats
+	// flag MessageMegagroups
+	// flag MessageChannels
+	// flag Files
 	if e.Flags&32 != 0 {
 		x.Int(e.FileMaxSize)
 	}
-	return x.buf
 }
 
-func (e TL_account_finishTakeoutSession) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_finishTakeoutSession) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_finishTakeoutSession)
 	x.Int(e.Flags)
-	//flag Success
-	return x.buf
+	// flag Success
 }
 
-func (e TL_account_confirmPasswordEmail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_confirmPasswordEmail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_confirmPasswordEmail)
 	x.String(e.Code)
-	return x.buf
 }
 
-func (e TL_account_resendPasswordEmail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_resendPasswordEmail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_resendPasswordEmail)
-	return x.buf
 }
 
-func (e TL_account_cancelPasswordEmail) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_cancelPasswordEmail) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_cancelPasswordEmail)
-	return x.buf
 }
 
-func (e TL_account_getContactSignUpNotification) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getContactSignUpNotification) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getContactSignUpNotification)
-	return x.buf
 }
 
-func (e TL_account_setContactSignUpNotification) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_setContactSignUpNotification) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_setContactSignUpNotification)
-	x.Bytes(e.Silent.encode())
-	return x.buf
+	x.Object(e.Silent)
 }
 
-func (e TL_account_getNotifyExceptions) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getNotifyExceptions) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getNotifyExceptions)
 	x.Int(e.Flags)
-	//flag CompareSound
+	// flag CompareSound
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Peer.encode())
+		x.Object(e.Peer)
 	}
-	return x.buf
 }
 
-func (e TL_account_getWallPaper) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getWallPaper) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getWallPaper)
-	x.Bytes(e.Wallpaper.encode())
-	return x.buf
+	x.Object(e.Wallpaper)
 }
 
-func (e TL_account_uploadWallPaper) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_uploadWallPaper) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_uploadWallPaper)
-	x.Bytes(e.File.encode())
+	x.Object(e.File)
 	x.String(e.MimeType)
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Settings)
 }
 
-func (e TL_account_saveWallPaper) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_saveWallPaper) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_saveWallPaper)
-	x.Bytes(e.Wallpaper.encode())
-	x.Bytes(e.Unsave.encode())
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Wallpaper)
+	x.Object(e.Unsave)
+	x.Object(e.Settings)
 }
 
-func (e TL_account_installWallPaper) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_installWallPaper) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_installWallPaper)
-	x.Bytes(e.Wallpaper.encode())
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Wallpaper)
+	x.Object(e.Settings)
 }
 
-func (e TL_account_resetWallPapers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_resetWallPapers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_resetWallPapers)
-	return x.buf
 }
 
-func (e TL_account_getAutoDownloadSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getAutoDownloadSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getAutoDownloadSettings)
-	return x.buf
 }
 
-func (e TL_account_saveAutoDownloadSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_saveAutoDownloadSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_saveAutoDownloadSettings)
 	x.Int(e.Flags)
 	//flag Low
 	//flag High
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Settings)
 }
 
-func (e TL_account_uploadTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_uploadTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_uploadTheme)
 	x.Int(e.Flags)
-	x.Bytes(e.File.encode())
+	x.Object(e.File)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Thumb.encode())
+		x.Object(e.Thumb)
 	}
 	x.String(e.FileName)
 	x.String(e.MimeType)
-	return x.buf
 }
 
-func (e TL_account_createTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_createTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_createTheme)
 	x.Int(e.Flags)
 	x.String(e.Slug)
 	x.String(e.Title)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Document.encode())
+		x.Object(e.Document)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.Settings.encode())
+		x.Object(e.Settings)
 	}
-	return x.buf
 }
 
-func (e TL_account_updateTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_updateTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_updateTheme)
 	x.Int(e.Flags)
 	x.String(e.Format)
-	x.Bytes(e.Theme.encode())
+	x.Object(e.Theme)
 	if e.Flags&1 != 0 {
 		x.String(e.Slug)
 	}
@@ -19017,199 +16996,149 @@ func (e TL_account_updateTheme) encode() []byte {
 		x.String(e.Title)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Document.encode())
+		x.Object(e.Document)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.Settings.encode())
+		x.Object(e.Settings)
 	}
-	return x.buf
 }
 
-func (e TL_account_saveTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_saveTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_saveTheme)
-	x.Bytes(e.Theme.encode())
-	x.Bytes(e.Unsave.encode())
-	return x.buf
+	x.Object(e.Theme)
+	x.Object(e.Unsave)
 }
 
-func (e TL_account_installTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_installTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_installTheme)
 	x.Int(e.Flags)
-	//flag Dark
+	// flag Dark
 	if e.Flags&2 != 0 {
 		x.String(e.Format)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Theme.encode())
+		x.Object(e.Theme)
 	}
-	return x.buf
 }
 
-func (e TL_account_getTheme) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getTheme) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getTheme)
 	x.String(e.Format)
-	x.Bytes(e.Theme.encode())
+	x.Object(e.Theme)
 	x.Long(e.DocumentID)
-	return x.buf
 }
 
-func (e TL_account_getThemes) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getThemes) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getThemes)
 	x.String(e.Format)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_account_setContentSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_setContentSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_setContentSettings)
 	x.Int(e.Flags)
-	//flag SensitiveEnabled
-	return x.buf
+	// flag SensitiveEnabled
 }
 
-func (e TL_account_getContentSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getContentSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getContentSettings)
-	return x.buf
 }
 
-func (e TL_account_getMultiWallPapers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getMultiWallPapers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getMultiWallPapers)
 	x.Vector(e.Wallpapers)
-	return x.buf
 }
 
-func (e TL_account_getGlobalPrivacySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_getGlobalPrivacySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_getGlobalPrivacySettings)
-	return x.buf
 }
 
-func (e TL_account_setGlobalPrivacySettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_setGlobalPrivacySettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_setGlobalPrivacySettings)
-	x.Bytes(e.Settings.encode())
-	return x.buf
+	x.Object(e.Settings)
 }
 
-func (e TL_account_reportProfilePhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_account_reportProfilePhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_account_reportProfilePhoto)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.PhotoID.encode())
-	x.Bytes(e.Reason.encode())
+	x.Object(e.Peer)
+	x.Object(e.PhotoID)
+	x.Object(e.Reason)
 	x.String(e.Message)
-	return x.buf
 }
 
-func (e TL_users_getUsers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_users_getUsers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_users_getUsers)
 	x.Vector(e.ID)
-	return x.buf
 }
 
-func (e TL_users_getFullUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_users_getFullUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_users_getFullUser)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_users_setSecureValueErrors) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_users_setSecureValueErrors) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_users_setSecureValueErrors)
-	x.Bytes(e.ID.encode())
+	x.Object(e.ID)
 	x.Vector(e.Errors)
-	return x.buf
 }
 
-func (e TL_contacts_getContactIDs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_getContactIDs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_getContactIDs)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_contacts_getStatuses) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_getStatuses) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_getStatuses)
-	return x.buf
 }
 
-func (e TL_contacts_getContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_getContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_getContacts)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_contacts_importContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_importContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_importContacts)
 	x.Vector(e.Contacts)
-	return x.buf
 }
 
-func (e TL_contacts_deleteContacts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_deleteContacts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_deleteContacts)
 	x.Vector(e.ID)
-	return x.buf
 }
 
-func (e TL_contacts_deleteByPhones) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_deleteByPhones) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_deleteByPhones)
 	x.VectorString(e.Phones)
-	return x.buf
 }
 
-func (e TL_contacts_block) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_block) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_block)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_contacts_unblock) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_unblock) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_unblock)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_contacts_getBlocked) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_getBlocked) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_getBlocked)
 	x.Int(e.Offset)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_contacts_search) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_search) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_search)
 	x.String(e.Q)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_contacts_resolveUsername) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_resolveUsername) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_resolveUsername)
 	x.String(e.Username)
-	return x.buf
 }
 
-func (e TL_contacts_getTopPeers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_getTopPeers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_getTopPeers)
 	x.Int(e.Flags)
 	//flag Correspondents
@@ -19223,105 +17152,83 @@ func (e TL_contacts_getTopPeers) encode() []byte {
 	x.Int(e.Offset)
 	x.Int(e.Limit)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_contacts_resetTopPeerRating) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_resetTopPeerRating) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_resetTopPeerRating)
-	x.Bytes(e.Category.encode())
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Category)
+	x.Object(e.Peer)
 }
 
-func (e TL_contacts_resetSaved) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_resetSaved) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_resetSaved)
-	return x.buf
 }
 
-func (e TL_contacts_getSaved) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_getSaved) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_getSaved)
-	return x.buf
 }
 
-func (e TL_contacts_toggleTopPeers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_toggleTopPeers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_toggleTopPeers)
-	x.Bytes(e.Enabled.encode())
-	return x.buf
+	x.Object(e.Enabled)
 }
 
-func (e TL_contacts_addContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_addContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_addContact)
 	x.Int(e.Flags)
 	//flag AddPhonePrivacyException
-	x.Bytes(e.ID.encode())
+	x.Object(e.ID)
 	x.String(e.FirstName)
 	x.String(e.LastName)
 	x.String(e.Phone)
-	return x.buf
 }
 
-func (e TL_contacts_acceptContact) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_acceptContact) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_acceptContact)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_contacts_getLocated) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_getLocated) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_getLocated)
 	x.Int(e.Flags)
 	//flag Background
-	x.Bytes(e.GeoPoint.encode())
+	x.Object(e.GeoPoint)
 	if e.Flags&1 != 0 {
 		x.Int(e.SelfExpires)
 	}
-	return x.buf
 }
 
-func (e TL_contacts_blockFromReplies) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_contacts_blockFromReplies) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_contacts_blockFromReplies)
 	x.Int(e.Flags)
 	//flag DeleteMessage
 	//flag DeleteHistory
 	//flag ReportSpam
 	x.Int(e.MsgID)
-	return x.buf
 }
 
-func (e TL_messages_getMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getMessages)
 	x.Vector(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_getDialogs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getDialogs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getDialogs)
 	x.Int(e.Flags)
-	//flag ExcludePinned
+	// flag ExcludePinned
 	if e.Flags&2 != 0 {
 		x.Int(e.FolderID)
 	}
 	x.Int(e.OffsetDate)
 	x.Int(e.OffsetID)
-	x.Bytes(e.OffsetPeer.encode())
+	x.Object(e.OffsetPeer)
 	x.Int(e.Limit)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getHistory)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.OffsetID)
 	x.Int(e.OffsetDate)
 	x.Int(e.AddOffset)
@@ -19329,22 +17236,20 @@ func (e TL_messages_getHistory) encode() []byte {
 	x.Int(e.MaxID)
 	x.Int(e.MinID)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_search) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_search) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_search)
 	x.Int(e.Flags)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.Q)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.FromID.encode())
+		x.Object(e.FromID)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.TopMsgID)
 	}
-	x.Bytes(e.Filter.encode())
+	x.Object(e.Filter)
 	x.Int(e.MinDate)
 	x.Int(e.MaxDate)
 	x.Int(e.OffsetID)
@@ -19353,72 +17258,60 @@ func (e TL_messages_search) encode() []byte {
 	x.Int(e.MaxID)
 	x.Int(e.MinID)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_readHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_readHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_readHistory)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MaxID)
-	return x.buf
 }
 
-func (e TL_messages_deleteHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_deleteHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_deleteHistory)
 	x.Int(e.Flags)
 	//flag JustClear
 	//flag Revoke
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MaxID)
-	return x.buf
 }
 
-func (e TL_messages_deleteMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_deleteMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_deleteMessages)
 	x.Int(e.Flags)
 	//flag Revoke
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_receivedMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_receivedMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_receivedMessages)
 	x.Int(e.MaxID)
-	return x.buf
 }
 
-func (e TL_messages_setTyping) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setTyping) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setTyping)
 	x.Int(e.Flags)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	if e.Flags&1 != 0 {
 		x.Int(e.TopMsgID)
 	}
-	x.Bytes(e.Action.encode())
-	return x.buf
+	x.Object(e.Action)
 }
 
-func (e TL_messages_sendMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendMessage)
 	x.Int(e.Flags)
 	//flag NoWebpage
 	//flag Silent
 	//flag Background
 	//flag ClearDraft
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	if e.Flags&1 != 0 {
 		x.Int(e.ReplyToMsgID)
 	}
 	x.String(e.Message)
 	x.Long(e.RandomID)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
 	if e.Flags&8 != 0 {
 		x.Vector(e.Entities)
@@ -19426,25 +17319,23 @@ func (e TL_messages_sendMessage) encode() []byte {
 	if e.Flags&1024 != 0 {
 		x.Int(e.ScheduleDate)
 	}
-	return x.buf
 }
 
-func (e TL_messages_sendMedia) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendMedia) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendMedia)
 	x.Int(e.Flags)
 	//flag Silent
 	//flag Background
 	//flag ClearDraft
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	if e.Flags&1 != 0 {
 		x.Int(e.ReplyToMsgID)
 	}
-	x.Bytes(e.Media.encode())
+	x.Object(e.Media)
 	x.String(e.Message)
 	x.Long(e.RandomID)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
 	if e.Flags&8 != 0 {
 		x.Vector(e.Entities)
@@ -19452,390 +17343,304 @@ func (e TL_messages_sendMedia) encode() []byte {
 	if e.Flags&1024 != 0 {
 		x.Int(e.ScheduleDate)
 	}
-	return x.buf
 }
 
-func (e TL_messages_forwardMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_forwardMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_forwardMessages)
 	x.Int(e.Flags)
 	//flag Silent
 	//flag Background
 	//flag WithMyScore
-	x.Bytes(e.FromPeer.encode())
+	x.Object(e.FromPeer)
 	x.VectorInt(e.ID)
 	x.VectorLong(e.RandomID)
-	x.Bytes(e.ToPeer.encode())
+	x.Object(e.ToPeer)
 	if e.Flags&1024 != 0 {
 		x.Int(e.ScheduleDate)
 	}
-	return x.buf
 }
 
-func (e TL_messages_reportSpam) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_reportSpam) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_reportSpam)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_getPeerSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getPeerSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getPeerSettings)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_report) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_report) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_report)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.VectorInt(e.ID)
-	x.Bytes(e.Reason.encode())
+	x.Object(e.Reason)
 	x.String(e.Message)
-	return x.buf
 }
 
-func (e TL_messages_getChats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getChats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getChats)
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_getFullChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getFullChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getFullChat)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_messages_editChatTitle) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_editChatTitle) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_editChatTitle)
 	x.Int(e.ChatID)
 	x.String(e.Title)
-	return x.buf
 }
 
-func (e TL_messages_editChatPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_editChatPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_editChatPhoto)
 	x.Int(e.ChatID)
-	x.Bytes(e.Photo.encode())
-	return x.buf
+	x.Object(e.Photo)
 }
 
-func (e TL_messages_addChatUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_addChatUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_addChatUser)
 	x.Int(e.ChatID)
-	x.Bytes(e.UserID.encode())
+	x.Object(e.UserID)
 	x.Int(e.FwdLimit)
-	return x.buf
 }
 
-func (e TL_messages_deleteChatUser) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_deleteChatUser) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_deleteChatUser)
 	x.Int(e.Flags)
 	//flag RevokeHistory
 	x.Int(e.ChatID)
-	x.Bytes(e.UserID.encode())
-	return x.buf
+	x.Object(e.UserID)
 }
 
-func (e TL_messages_createChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_createChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_createChat)
 	x.Vector(e.Users)
 	x.String(e.Title)
-	return x.buf
 }
 
-func (e TL_messages_getDhConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getDhConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getDhConfig)
 	x.Int(e.Version)
 	x.Int(e.RandomLength)
-	return x.buf
 }
 
-func (e TL_messages_requestEncryption) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_requestEncryption) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_requestEncryption)
-	x.Bytes(e.UserID.encode())
+	x.Object(e.UserID)
 	x.Int(e.RandomID)
 	x.StringBytes(e.GA)
-	return x.buf
 }
 
-func (e TL_messages_acceptEncryption) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_acceptEncryption) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_acceptEncryption)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.StringBytes(e.GB)
 	x.Long(e.KeyFingerprint)
-	return x.buf
 }
 
-func (e TL_messages_discardEncryption) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_discardEncryption) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_discardEncryption)
 	x.Int(e.Flags)
 	//flag DeleteHistory
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_messages_setEncryptedTyping) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setEncryptedTyping) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setEncryptedTyping)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.Typing.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.Typing)
 }
 
-func (e TL_messages_readEncryptedHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_readEncryptedHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_readEncryptedHistory)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MaxDate)
-	return x.buf
 }
 
-func (e TL_messages_sendEncrypted) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendEncrypted) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendEncrypted)
 	x.Int(e.Flags)
 	//flag Silent
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Long(e.RandomID)
 	x.StringBytes(e.Data)
-	return x.buf
 }
 
-func (e TL_messages_sendEncryptedFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendEncryptedFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendEncryptedFile)
 	x.Int(e.Flags)
 	//flag Silent
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Long(e.RandomID)
 	x.StringBytes(e.Data)
-	x.Bytes(e.File.encode())
-	return x.buf
+	x.Object(e.File)
 }
 
-func (e TL_messages_sendEncryptedService) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendEncryptedService) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendEncryptedService)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Long(e.RandomID)
 	x.StringBytes(e.Data)
-	return x.buf
 }
 
-func (e TL_messages_receivedQueue) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_receivedQueue) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_receivedQueue)
 	x.Int(e.MaxQts)
-	return x.buf
 }
 
-func (e TL_messages_reportEncryptedSpam) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_reportEncryptedSpam) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_reportEncryptedSpam)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_readMessageContents) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_readMessageContents) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_readMessageContents)
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_getStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getStickers)
 	x.String(e.Emoticon)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getAllStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getAllStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getAllStickers)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getWebPagePreview) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getWebPagePreview) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getWebPagePreview)
 	x.Int(e.Flags)
 	x.String(e.Message)
 	if e.Flags&8 != 0 {
 		x.Vector(e.Entities)
 	}
-	return x.buf
 }
 
-func (e TL_messages_exportChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_exportChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_exportChatInvite)
 	x.Int(e.Flags)
 	//flag LegacyRevokePermanent
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	if e.Flags&1 != 0 {
 		x.Int(e.ExpireDate)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.UsageLimit)
 	}
-	return x.buf
 }
 
-func (e TL_messages_checkChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_checkChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_checkChatInvite)
 	x.String(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_importChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_importChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_importChatInvite)
 	x.String(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getStickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getStickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getStickerSet)
-	x.Bytes(e.Stickerset.encode())
-	return x.buf
+	x.Object(e.Stickerset)
 }
 
-func (e TL_messages_installStickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_installStickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_installStickerSet)
-	x.Bytes(e.Stickerset.encode())
-	x.Bytes(e.Archived.encode())
-	return x.buf
+	x.Object(e.Stickerset)
+	x.Object(e.Archived)
 }
 
-func (e TL_messages_uninstallStickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_uninstallStickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_uninstallStickerSet)
-	x.Bytes(e.Stickerset.encode())
-	return x.buf
+	x.Object(e.Stickerset)
 }
 
-func (e TL_messages_startBot) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_startBot) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_startBot)
-	x.Bytes(e.Bot.encode())
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Bot)
+	x.Object(e.Peer)
 	x.Long(e.RandomID)
 	x.String(e.StartParam)
-	return x.buf
 }
 
-func (e TL_messages_getMessagesViews) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getMessagesViews) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getMessagesViews)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.VectorInt(e.ID)
-	x.Bytes(e.Increment.encode())
-	return x.buf
+	x.Object(e.Increment)
 }
 
-func (e TL_messages_editChatAdmin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_editChatAdmin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_editChatAdmin)
 	x.Int(e.ChatID)
-	x.Bytes(e.UserID.encode())
-	x.Bytes(e.IsAdmin.encode())
-	return x.buf
+	x.Object(e.UserID)
+	x.Object(e.IsAdmin)
 }
 
-func (e TL_messages_migrateChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_migrateChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_migrateChat)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_messages_searchGlobal) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_searchGlobal) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_searchGlobal)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
 		x.Int(e.FolderID)
 	}
 	x.String(e.Q)
-	x.Bytes(e.Filter.encode())
+	x.Object(e.Filter)
 	x.Int(e.MinDate)
 	x.Int(e.MaxDate)
 	x.Int(e.OffsetRate)
-	x.Bytes(e.OffsetPeer.encode())
+	x.Object(e.OffsetPeer)
 	x.Int(e.OffsetID)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_messages_reorderStickerSets) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_reorderStickerSets) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_reorderStickerSets)
 	x.Int(e.Flags)
 	//flag Masks
 	x.VectorLong(e.Order)
-	return x.buf
 }
 
-func (e TL_messages_getDocumentByHash) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getDocumentByHash) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getDocumentByHash)
 	x.StringBytes(e.Sha256)
 	x.Int(e.Size)
 	x.String(e.MimeType)
-	return x.buf
 }
 
-func (e TL_messages_getSavedGifs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getSavedGifs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getSavedGifs)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_saveGif) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_saveGif) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_saveGif)
-	x.Bytes(e.ID.encode())
-	x.Bytes(e.Unsave.encode())
-	return x.buf
+	x.Object(e.ID)
+	x.Object(e.Unsave)
 }
 
-func (e TL_messages_getInlineBotResults) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getInlineBotResults) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getInlineBotResults)
 	x.Int(e.Flags)
-	x.Bytes(e.Bot.encode())
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Bot)
+	x.Object(e.Peer)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.GeoPoint.encode())
+		x.Object(e.GeoPoint)
 	}
 	x.String(e.Query)
 	x.String(e.Offset)
-	return x.buf
 }
 
-func (e TL_messages_setInlineBotResults) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setInlineBotResults) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setInlineBotResults)
 	x.Int(e.Flags)
 	//flag Gallery
@@ -19847,20 +17652,18 @@ func (e TL_messages_setInlineBotResults) encode() []byte {
 		x.String(e.NextOffset)
 	}
 	if e.Flags&8 != 0 {
-		x.Bytes(e.SwitchPm.encode())
+		x.Object(e.SwitchPm)
 	}
-	return x.buf
 }
 
-func (e TL_messages_sendInlineBotResult) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendInlineBotResult) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendInlineBotResult)
 	x.Int(e.Flags)
 	//flag Silent
 	//flag Background
 	//flag ClearDraft
 	//flag HideVia
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	if e.Flags&1 != 0 {
 		x.Int(e.ReplyToMsgID)
 	}
@@ -19870,32 +17673,28 @@ func (e TL_messages_sendInlineBotResult) encode() []byte {
 	if e.Flags&1024 != 0 {
 		x.Int(e.ScheduleDate)
 	}
-	return x.buf
 }
 
-func (e TL_messages_getMessageEditData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getMessageEditData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getMessageEditData)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_editMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_editMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_editMessage)
 	x.Int(e.Flags)
 	//flag NoWebpage
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.ID)
 	if e.Flags&2048 != 0 {
 		x.String(e.Message)
 	}
 	if e.Flags&16384 != 0 {
-		x.Bytes(e.Media.encode())
+		x.Object(e.Media)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
 	if e.Flags&8 != 0 {
 		x.Vector(e.Entities)
@@ -19903,48 +17702,42 @@ func (e TL_messages_editMessage) encode() []byte {
 	if e.Flags&32768 != 0 {
 		x.Int(e.ScheduleDate)
 	}
-	return x.buf
 }
 
-func (e TL_messages_editInlineBotMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_editInlineBotMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_editInlineBotMessage)
 	x.Int(e.Flags)
 	//flag NoWebpage
-	x.Bytes(e.ID.encode())
+	x.Object(e.ID)
 	if e.Flags&2048 != 0 {
 		x.String(e.Message)
 	}
 	if e.Flags&16384 != 0 {
-		x.Bytes(e.Media.encode())
+		x.Object(e.Media)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.ReplyMarkup.encode())
+		x.Object(e.ReplyMarkup)
 	}
 	if e.Flags&8 != 0 {
 		x.Vector(e.Entities)
 	}
-	return x.buf
 }
 
-func (e TL_messages_getBotCallbackAnswer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getBotCallbackAnswer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getBotCallbackAnswer)
 	x.Int(e.Flags)
 	//flag Game
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
 	if e.Flags&1 != 0 {
 		x.StringBytes(e.Data)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Password.encode())
+		x.Object(e.Password)
 	}
-	return x.buf
 }
 
-func (e TL_messages_setBotCallbackAnswer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setBotCallbackAnswer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setBotCallbackAnswer)
 	x.Int(e.Flags)
 	//flag Alert
@@ -19956,197 +17749,153 @@ func (e TL_messages_setBotCallbackAnswer) encode() []byte {
 		x.String(e.Url)
 	}
 	x.Int(e.CacheTime)
-	return x.buf
 }
 
-func (e TL_messages_getPeerDialogs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getPeerDialogs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getPeerDialogs)
 	x.Vector(e.Peers)
-	return x.buf
 }
 
-func (e TL_messages_saveDraft) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_saveDraft) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_saveDraft)
 	x.Int(e.Flags)
-	//flag NoWebpage
+	// flag NoWebpage
 	if e.Flags&1 != 0 {
 		x.Int(e.ReplyToMsgID)
 	}
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.Message)
 	if e.Flags&8 != 0 {
 		x.Vector(e.Entities)
 	}
-	return x.buf
 }
 
-func (e TL_messages_getAllDrafts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getAllDrafts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getAllDrafts)
-	return x.buf
 }
 
-func (e TL_messages_getFeaturedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getFeaturedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getFeaturedStickers)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_readFeaturedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_readFeaturedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_readFeaturedStickers)
 	x.VectorLong(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_getRecentStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getRecentStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getRecentStickers)
 	x.Int(e.Flags)
 	//flag Attached
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_saveRecentSticker) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_saveRecentSticker) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_saveRecentSticker)
 	x.Int(e.Flags)
 	//flag Attached
-	x.Bytes(e.ID.encode())
-	x.Bytes(e.Unsave.encode())
-	return x.buf
+	x.Object(e.ID)
+	x.Object(e.Unsave)
 }
 
-func (e TL_messages_clearRecentStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_clearRecentStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_clearRecentStickers)
 	x.Int(e.Flags)
-	//flag Attached
-	return x.buf
+	// flag Attached
 }
 
-func (e TL_messages_getArchivedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getArchivedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getArchivedStickers)
 	x.Int(e.Flags)
 	//flag Masks
 	x.Long(e.OffsetID)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_messages_getMaskStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getMaskStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getMaskStickers)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getAttachedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getAttachedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getAttachedStickers)
-	x.Bytes(e.Media.encode())
-	return x.buf
+	x.Object(e.Media)
 }
 
-func (e TL_messages_setGameScore) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setGameScore) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setGameScore)
 	x.Int(e.Flags)
 	//flag EditMessage
 	//flag Force
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.ID)
-	x.Bytes(e.UserID.encode())
+	x.Object(e.UserID)
 	x.Int(e.Score)
-	return x.buf
 }
 
-func (e TL_messages_setInlineGameScore) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setInlineGameScore) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setInlineGameScore)
 	x.Int(e.Flags)
 	//flag EditMessage
 	//flag Force
-	x.Bytes(e.ID.encode())
-	x.Bytes(e.UserID.encode())
+	x.Object(e.ID)
+	x.Object(e.UserID)
 	x.Int(e.Score)
-	return x.buf
 }
 
-func (e TL_messages_getGameHighScores) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getGameHighScores) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getGameHighScores)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.ID)
-	x.Bytes(e.UserID.encode())
-	return x.buf
+	x.Object(e.UserID)
 }
 
-func (e TL_messages_getInlineGameHighScores) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getInlineGameHighScores) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getInlineGameHighScores)
-	x.Bytes(e.ID.encode())
-	x.Bytes(e.UserID.encode())
-	return x.buf
+	x.Object(e.ID)
+	x.Object(e.UserID)
 }
 
-func (e TL_messages_getCommonChats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getCommonChats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getCommonChats)
-	x.Bytes(e.UserID.encode())
+	x.Object(e.UserID)
 	x.Int(e.MaxID)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_messages_getAllChats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getAllChats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getAllChats)
 	x.VectorInt(e.ExceptIds)
-	return x.buf
 }
 
-func (e TL_messages_getWebPage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getWebPage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getWebPage)
 	x.String(e.Url)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_toggleDialogPin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_toggleDialogPin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_toggleDialogPin)
 	x.Int(e.Flags)
 	//flag Pinned
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_reorderPinnedDialogs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_reorderPinnedDialogs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_reorderPinnedDialogs)
 	x.Int(e.Flags)
 	//flag Force
 	x.Int(e.FolderID)
 	x.Vector(e.Order)
-	return x.buf
 }
 
-func (e TL_messages_getPinnedDialogs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getPinnedDialogs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getPinnedDialogs)
 	x.Int(e.FolderID)
-	return x.buf
 }
 
-func (e TL_messages_setBotShippingResults) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setBotShippingResults) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setBotShippingResults)
 	x.Int(e.Flags)
 	x.Long(e.QueryID)
@@ -20156,11 +17905,9 @@ func (e TL_messages_setBotShippingResults) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Vector(e.ShippingOptions)
 	}
-	return x.buf
 }
 
-func (e TL_messages_setBotPrecheckoutResults) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setBotPrecheckoutResults) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setBotPrecheckoutResults)
 	x.Int(e.Flags)
 	//flag Success
@@ -20168,77 +17915,61 @@ func (e TL_messages_setBotPrecheckoutResults) encode() []byte {
 	if e.Flags&1 != 0 {
 		x.String(e.Error)
 	}
-	return x.buf
 }
 
-func (e TL_messages_uploadMedia) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_uploadMedia) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_uploadMedia)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.Media.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.Media)
 }
 
-func (e TL_messages_sendScreenshotNotification) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendScreenshotNotification) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendScreenshotNotification)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.ReplyToMsgID)
 	x.Long(e.RandomID)
-	return x.buf
 }
 
-func (e TL_messages_getFavedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getFavedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getFavedStickers)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_faveSticker) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_faveSticker) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_faveSticker)
-	x.Bytes(e.ID.encode())
-	x.Bytes(e.Unfave.encode())
-	return x.buf
+	x.Object(e.ID)
+	x.Object(e.Unfave)
 }
 
-func (e TL_messages_getUnreadMentions) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getUnreadMentions) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getUnreadMentions)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.OffsetID)
 	x.Int(e.AddOffset)
 	x.Int(e.Limit)
 	x.Int(e.MaxID)
 	x.Int(e.MinID)
-	return x.buf
 }
 
-func (e TL_messages_readMentions) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_readMentions) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_readMentions)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_getRecentLocations) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getRecentLocations) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getRecentLocations)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.Limit)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_sendMultiMedia) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendMultiMedia) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendMultiMedia)
 	x.Int(e.Flags)
 	//flag Silent
 	//flag Background
 	//flag ClearDraft
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	if e.Flags&1 != 0 {
 		x.Int(e.ReplyToMsgID)
 	}
@@ -20246,159 +17977,121 @@ func (e TL_messages_sendMultiMedia) encode() []byte {
 	if e.Flags&1024 != 0 {
 		x.Int(e.ScheduleDate)
 	}
-	return x.buf
 }
 
-func (e TL_messages_uploadEncryptedFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_uploadEncryptedFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_uploadEncryptedFile)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.File.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.File)
 }
 
-func (e TL_messages_searchStickerSets) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_searchStickerSets) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_searchStickerSets)
 	x.Int(e.Flags)
 	//flag ExcludeFeatured
 	x.String(e.Q)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getSplitRanges) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getSplitRanges) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getSplitRanges)
-	return x.buf
 }
 
-func (e TL_messages_markDialogUnread) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_markDialogUnread) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_markDialogUnread)
 	x.Int(e.Flags)
 	//flag Unread
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_getDialogUnreadMarks) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getDialogUnreadMarks) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getDialogUnreadMarks)
-	return x.buf
 }
 
-func (e TL_messages_clearAllDrafts) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_clearAllDrafts) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_clearAllDrafts)
-	return x.buf
 }
 
-func (e TL_messages_updatePinnedMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_updatePinnedMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_updatePinnedMessage)
 	x.Int(e.Flags)
 	//flag Silent
 	//flag Unpin
 	//flag PmOneside
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_sendVote) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendVote) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendVote)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
-	x.Vector(e.Options)
-	return x.buf
+	x.VectorBytes(e.Options)
 }
 
-func (e TL_messages_getPollResults) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getPollResults) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getPollResults)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
-	return x.buf
 }
 
-func (e TL_messages_getOnlines) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getOnlines) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getOnlines)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_getStatsURL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getStatsURL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getStatsURL)
 	x.Int(e.Flags)
 	//flag Dark
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.Params)
-	return x.buf
 }
 
-func (e TL_messages_editChatAbout) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_editChatAbout) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_editChatAbout)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.About)
-	return x.buf
 }
 
-func (e TL_messages_editChatDefaultBannedRights) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_editChatDefaultBannedRights) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_editChatDefaultBannedRights)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.BannedRights.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.BannedRights)
 }
 
-func (e TL_messages_getEmojiKeywords) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getEmojiKeywords) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getEmojiKeywords)
 	x.String(e.LangCode)
-	return x.buf
 }
 
-func (e TL_messages_getEmojiKeywordsDifference) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getEmojiKeywordsDifference) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getEmojiKeywordsDifference)
 	x.String(e.LangCode)
 	x.Int(e.FromVersion)
-	return x.buf
 }
 
-func (e TL_messages_getEmojiKeywordsLanguages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getEmojiKeywordsLanguages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getEmojiKeywordsLanguages)
 	x.VectorString(e.LangCodes)
-	return x.buf
 }
 
-func (e TL_messages_getEmojiURL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getEmojiURL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getEmojiURL)
 	x.String(e.LangCode)
-	return x.buf
 }
 
-func (e TL_messages_getSearchCounters) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getSearchCounters) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getSearchCounters)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Vector(e.Filters)
-	return x.buf
 }
 
-func (e TL_messages_requestUrlAuth) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_requestUrlAuth) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_requestUrlAuth)
 	x.Int(e.Flags)
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Peer.encode())
+		x.Object(e.Peer)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.MsgID)
@@ -20409,16 +18102,14 @@ func (e TL_messages_requestUrlAuth) encode() []byte {
 	if e.Flags&4 != 0 {
 		x.String(e.Url)
 	}
-	return x.buf
 }
 
-func (e TL_messages_acceptUrlAuth) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_acceptUrlAuth) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_acceptUrlAuth)
 	x.Int(e.Flags)
-	//flag WriteAllowed
+	// flag WriteAllowed
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Peer.encode())
+		x.Object(e.Peer)
 	}
 	if e.Flags&2 != 0 {
 		x.Int(e.MsgID)
@@ -20429,53 +18120,41 @@ func (e TL_messages_acceptUrlAuth) encode() []byte {
 	if e.Flags&4 != 0 {
 		x.String(e.Url)
 	}
-	return x.buf
 }
 
-func (e TL_messages_hidePeerSettingsBar) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_hidePeerSettingsBar) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_hidePeerSettingsBar)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_getScheduledHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getScheduledHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getScheduledHistory)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getScheduledMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getScheduledMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getScheduledMessages)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_sendScheduledMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_sendScheduledMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_sendScheduledMessages)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_deleteScheduledMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_deleteScheduledMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_deleteScheduledMessages)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_messages_getPollVotes) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getPollVotes) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getPollVotes)
 	x.Int(e.Flags)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.ID)
 	if e.Flags&1 != 0 {
 		x.StringBytes(e.Option)
@@ -20484,63 +18163,49 @@ func (e TL_messages_getPollVotes) encode() []byte {
 		x.String(e.Offset)
 	}
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_messages_toggleStickerSets) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_toggleStickerSets) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_toggleStickerSets)
 	x.Int(e.Flags)
 	//flag Uninstall
 	//flag Archive
 	//flag Unarchive
 	x.Vector(e.Stickersets)
-	return x.buf
 }
 
-func (e TL_messages_getDialogFilters) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getDialogFilters) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getDialogFilters)
-	return x.buf
 }
 
-func (e TL_messages_getSuggestedDialogFilters) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getSuggestedDialogFilters) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getSuggestedDialogFilters)
-	return x.buf
 }
 
-func (e TL_messages_updateDialogFilter) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_updateDialogFilter) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_updateDialogFilter)
 	x.Int(e.Flags)
 	x.Int(e.ID)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.Filter.encode())
+		x.Object(e.Filter)
 	}
-	return x.buf
 }
 
-func (e TL_messages_updateDialogFiltersOrder) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_updateDialogFiltersOrder) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_updateDialogFiltersOrder)
 	x.VectorInt(e.Order)
-	return x.buf
 }
 
-func (e TL_messages_getOldFeaturedStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getOldFeaturedStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getOldFeaturedStickers)
 	x.Int(e.Offset)
 	x.Int(e.Limit)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getReplies) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getReplies) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getReplies)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
 	x.Int(e.OffsetID)
 	x.Int(e.OffsetDate)
@@ -20549,89 +18214,69 @@ func (e TL_messages_getReplies) encode() []byte {
 	x.Int(e.MaxID)
 	x.Int(e.MinID)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_messages_getDiscussionMessage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getDiscussionMessage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getDiscussionMessage)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
-	return x.buf
 }
 
-func (e TL_messages_readDiscussion) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_readDiscussion) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_readDiscussion)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.MsgID)
 	x.Int(e.ReadMaxID)
-	return x.buf
 }
 
-func (e TL_messages_unpinAllMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_unpinAllMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_unpinAllMessages)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_deleteChat) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_deleteChat) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_deleteChat)
 	x.Int(e.ChatID)
-	return x.buf
 }
 
-func (e TL_messages_deletePhoneCallHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_deletePhoneCallHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_deletePhoneCallHistory)
 	x.Int(e.Flags)
-	//flag Revoke
-	return x.buf
+	// flag Revoke
 }
 
-func (e TL_messages_checkHistoryImport) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_checkHistoryImport) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_checkHistoryImport)
 	x.String(e.ImportHead)
-	return x.buf
 }
 
-func (e TL_messages_initHistoryImport) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_initHistoryImport) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_initHistoryImport)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.File.encode())
+	x.Object(e.Peer)
+	x.Object(e.File)
 	x.Int(e.MediaCount)
-	return x.buf
 }
 
-func (e TL_messages_uploadImportedMedia) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_uploadImportedMedia) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_uploadImportedMedia)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Long(e.ImportID)
 	x.String(e.FileName)
-	x.Bytes(e.Media.encode())
-	return x.buf
+	x.Object(e.Media)
 }
 
-func (e TL_messages_startHistoryImport) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_startHistoryImport) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_startHistoryImport)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Long(e.ImportID)
-	return x.buf
 }
 
-func (e TL_messages_getExportedChatInvites) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getExportedChatInvites) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getExportedChatInvites)
 	x.Int(e.Flags)
 	//flag Revoked
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.AdminID.encode())
+	x.Object(e.Peer)
+	x.Object(e.AdminID)
 	if e.Flags&4 != 0 {
 		x.Int(e.OffsetDate)
 	}
@@ -20639,23 +18284,19 @@ func (e TL_messages_getExportedChatInvites) encode() []byte {
 		x.String(e.OffsetLink)
 	}
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_messages_getExportedChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getExportedChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getExportedChatInvite)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.Link)
-	return x.buf
 }
 
-func (e TL_messages_editExportedChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_editExportedChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_editExportedChatInvite)
 	x.Int(e.Flags)
 	//flag Revoked
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.Link)
 	if e.Flags&1 != 0 {
 		x.Int(e.ExpireDate)
@@ -20663,66 +18304,50 @@ func (e TL_messages_editExportedChatInvite) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.Int(e.UsageLimit)
 	}
-	return x.buf
 }
 
-func (e TL_messages_deleteRevokedExportedChatInvites) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_deleteRevokedExportedChatInvites) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_deleteRevokedExportedChatInvites)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.AdminID.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.AdminID)
 }
 
-func (e TL_messages_deleteExportedChatInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_deleteExportedChatInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_deleteExportedChatInvite)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.Link)
-	return x.buf
 }
 
-func (e TL_messages_getAdminsWithInvites) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getAdminsWithInvites) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getAdminsWithInvites)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_messages_getChatInviteImporters) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_getChatInviteImporters) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_getChatInviteImporters)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.Link)
 	x.Int(e.OffsetDate)
-	x.Bytes(e.OffsetUser.encode())
+	x.Object(e.OffsetUser)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_messages_setHistoryTTL) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_setHistoryTTL) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_setHistoryTTL)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.Period)
-	return x.buf
 }
 
-func (e TL_messages_checkHistoryImportPeer) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_messages_checkHistoryImportPeer) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_messages_checkHistoryImportPeer)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_updates_getState) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_getState) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_getState)
-	return x.buf
 }
 
-func (e TL_updates_getDifference) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_getDifference) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_getDifference)
 	x.Int(e.Flags)
 	x.Int(e.Pts)
@@ -20731,360 +18356,270 @@ func (e TL_updates_getDifference) encode() []byte {
 	}
 	x.Int(e.Date)
 	x.Int(e.Qts)
-	return x.buf
 }
 
-func (e TL_updates_getChannelDifference) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_updates_getChannelDifference) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_updates_getChannelDifference)
 	x.Int(e.Flags)
 	//flag Force
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.Filter.encode())
+	x.Object(e.Channel)
+	x.Object(e.Filter)
 	x.Int(e.Pts)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_photos_updateProfilePhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photos_updateProfilePhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photos_updateProfilePhoto)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_photos_uploadProfilePhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photos_uploadProfilePhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photos_uploadProfilePhoto)
 	x.Int(e.Flags)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.File.encode())
+		x.Object(e.File)
 	}
 	if e.Flags&2 != 0 {
-		x.Bytes(e.Video.encode())
+		x.Object(e.Video)
 	}
 	if e.Flags&4 != 0 {
 		x.Double(e.VideoStartTs)
 	}
-	return x.buf
 }
 
-func (e TL_photos_deletePhotos) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photos_deletePhotos) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photos_deletePhotos)
 	x.Vector(e.ID)
-	return x.buf
 }
 
-func (e TL_photos_getUserPhotos) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_photos_getUserPhotos) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_photos_getUserPhotos)
-	x.Bytes(e.UserID.encode())
+	x.Object(e.UserID)
 	x.Int(e.Offset)
 	x.Long(e.MaxID)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_upload_saveFilePart) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_saveFilePart) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_saveFilePart)
 	x.Long(e.FileID)
 	x.Int(e.FilePart)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_upload_getFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_getFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_getFile)
 	x.Int(e.Flags)
 	//flag Precise
 	//flag CdnSupported
-	x.Bytes(e.Location.encode())
+	x.Object(e.Location)
 	x.Int(e.Offset)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_upload_saveBigFilePart) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_saveBigFilePart) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_saveBigFilePart)
 	x.Long(e.FileID)
 	x.Int(e.FilePart)
 	x.Int(e.FileTotalParts)
 	x.StringBytes(e.Bytes)
-	return x.buf
 }
 
-func (e TL_upload_getWebFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_getWebFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_getWebFile)
-	x.Bytes(e.Location.encode())
+	x.Object(e.Location)
 	x.Int(e.Offset)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_upload_getCdnFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_getCdnFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_getCdnFile)
 	x.StringBytes(e.FileToken)
 	x.Int(e.Offset)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_upload_reuploadCdnFile) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_reuploadCdnFile) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_reuploadCdnFile)
 	x.StringBytes(e.FileToken)
 	x.StringBytes(e.RequestToken)
-	return x.buf
 }
 
-func (e TL_upload_getCdnFileHashes) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_getCdnFileHashes) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_getCdnFileHashes)
 	x.StringBytes(e.FileToken)
 	x.Int(e.Offset)
-	return x.buf
 }
 
-func (e TL_upload_getFileHashes) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_upload_getFileHashes) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_upload_getFileHashes)
-	x.Bytes(e.Location.encode())
+	x.Object(e.Location)
 	x.Int(e.Offset)
-	return x.buf
 }
 
-func (e TL_help_getConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getConfig)
-	return x.buf
 }
 
-func (e TL_help_getNearestDc) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getNearestDc) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getNearestDc)
-	return x.buf
 }
 
-func (e TL_help_getAppUpdate) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getAppUpdate) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getAppUpdate)
 	x.String(e.Source)
-	return x.buf
 }
 
-func (e TL_help_getInviteText) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getInviteText) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getInviteText)
-	return x.buf
 }
 
-func (e TL_help_getSupport) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getSupport) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getSupport)
-	return x.buf
 }
 
-func (e TL_help_getAppChangelog) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getAppChangelog) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getAppChangelog)
 	x.String(e.PrevAppVersion)
-	return x.buf
 }
 
-func (e TL_help_setBotUpdatesStatus) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_setBotUpdatesStatus) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_setBotUpdatesStatus)
 	x.Int(e.PendingUpdatesCount)
 	x.String(e.Message)
-	return x.buf
 }
 
-func (e TL_help_getCdnConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getCdnConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getCdnConfig)
-	return x.buf
 }
 
-func (e TL_help_getRecentMeUrls) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getRecentMeUrls) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getRecentMeUrls)
 	x.String(e.Referer)
-	return x.buf
 }
 
-func (e TL_help_getTermsOfServiceUpdate) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getTermsOfServiceUpdate) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getTermsOfServiceUpdate)
-	return x.buf
 }
 
-func (e TL_help_acceptTermsOfService) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_acceptTermsOfService) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_acceptTermsOfService)
-	x.Bytes(e.ID.encode())
-	return x.buf
+	x.Object(e.ID)
 }
 
-func (e TL_help_getDeepLinkInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getDeepLinkInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getDeepLinkInfo)
 	x.String(e.Path)
-	return x.buf
 }
 
-func (e TL_help_getAppConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getAppConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getAppConfig)
-	return x.buf
 }
 
-func (e TL_help_saveAppLog) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_saveAppLog) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_saveAppLog)
 	x.Vector(e.Events)
-	return x.buf
 }
 
-func (e TL_help_getPassportConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getPassportConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getPassportConfig)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_help_getSupportName) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getSupportName) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getSupportName)
-	return x.buf
 }
 
-func (e TL_help_getUserInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getUserInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getUserInfo)
-	x.Bytes(e.UserID.encode())
-	return x.buf
+	x.Object(e.UserID)
 }
 
-func (e TL_help_editUserInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_editUserInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_editUserInfo)
-	x.Bytes(e.UserID.encode())
+	x.Object(e.UserID)
 	x.String(e.Message)
 	x.Vector(e.Entities)
-	return x.buf
 }
 
-func (e TL_help_getPromoData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getPromoData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getPromoData)
-	return x.buf
 }
 
-func (e TL_help_hidePromoData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_hidePromoData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_hidePromoData)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_help_dismissSuggestion) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_dismissSuggestion) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_dismissSuggestion)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.String(e.Suggestion)
-	return x.buf
 }
 
-func (e TL_help_getCountriesList) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_help_getCountriesList) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_help_getCountriesList)
 	x.String(e.LangCode)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_channels_readHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_readHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_readHistory)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.Int(e.MaxID)
-	return x.buf
 }
 
-func (e TL_channels_deleteMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_deleteMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_deleteMessages)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_channels_deleteUserHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_deleteUserHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_deleteUserHistory)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.UserID.encode())
-	return x.buf
+	x.Object(e.Channel)
+	x.Object(e.UserID)
 }
 
-func (e TL_channels_reportSpam) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_reportSpam) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_reportSpam)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.UserID.encode())
+	x.Object(e.Channel)
+	x.Object(e.UserID)
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_channels_getMessages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getMessages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getMessages)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.Vector(e.ID)
-	return x.buf
 }
 
-func (e TL_channels_getParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getParticipants)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.Filter.encode())
+	x.Object(e.Channel)
+	x.Object(e.Filter)
 	x.Int(e.Offset)
 	x.Int(e.Limit)
 	x.Int(e.Hash)
-	return x.buf
 }
 
-func (e TL_channels_getParticipant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getParticipant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getParticipant)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.Participant.encode())
-	return x.buf
+	x.Object(e.Channel)
+	x.Object(e.Participant)
 }
 
-func (e TL_channels_getChannels) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getChannels) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getChannels)
 	x.Vector(e.ID)
-	return x.buf
 }
 
-func (e TL_channels_getFullChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getFullChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getFullChannel)
-	x.Bytes(e.Channel.encode())
-	return x.buf
+	x.Object(e.Channel)
 }
 
-func (e TL_channels_createChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_createChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_createChannel)
 	x.Int(e.Flags)
 	//flag Broadcast
@@ -21093,130 +18628,102 @@ func (e TL_channels_createChannel) encode() []byte {
 	x.String(e.Title)
 	x.String(e.About)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.GeoPoint.encode())
+		x.Object(e.GeoPoint)
 	}
 	if e.Flags&4 != 0 {
 		x.String(e.Address)
 	}
-	return x.buf
 }
 
-func (e TL_channels_editAdmin) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_editAdmin) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_editAdmin)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.UserID.encode())
-	x.Bytes(e.AdminRights.encode())
+	x.Object(e.Channel)
+	x.Object(e.UserID)
+	x.Object(e.AdminRights)
 	x.String(e.Rank)
-	return x.buf
 }
 
-func (e TL_channels_editTitle) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_editTitle) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_editTitle)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.String(e.Title)
-	return x.buf
 }
 
-func (e TL_channels_editPhoto) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_editPhoto) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_editPhoto)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.Photo.encode())
-	return x.buf
+	x.Object(e.Channel)
+	x.Object(e.Photo)
 }
 
-func (e TL_channels_checkUsername) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_checkUsername) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_checkUsername)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.String(e.Username)
-	return x.buf
 }
 
-func (e TL_channels_updateUsername) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_updateUsername) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_updateUsername)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.String(e.Username)
-	return x.buf
 }
 
-func (e TL_channels_joinChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_joinChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_joinChannel)
-	x.Bytes(e.Channel.encode())
-	return x.buf
+	x.Object(e.Channel)
 }
 
-func (e TL_channels_leaveChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_leaveChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_leaveChannel)
-	x.Bytes(e.Channel.encode())
-	return x.buf
+	x.Object(e.Channel)
 }
 
-func (e TL_channels_inviteToChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_inviteToChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_inviteToChannel)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_channels_deleteChannel) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_deleteChannel) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_deleteChannel)
-	x.Bytes(e.Channel.encode())
-	return x.buf
+	x.Object(e.Channel)
 }
 
-func (e TL_channels_exportMessageLink) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_exportMessageLink) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_exportMessageLink)
 	x.Int(e.Flags)
 	//flag Grouped
 	//flag Thread
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.Int(e.ID)
-	return x.buf
 }
 
-func (e TL_channels_toggleSignatures) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_toggleSignatures) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_toggleSignatures)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.Enabled.encode())
-	return x.buf
+	x.Object(e.Channel)
+	x.Object(e.Enabled)
 }
 
-func (e TL_channels_getAdminedPublicChannels) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getAdminedPublicChannels) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getAdminedPublicChannels)
 	x.Int(e.Flags)
-	//flag ByLocation
-	//flag CheckLimit
-	return x.buf
+	// flag ByLocation
+	// flag CheckLimit
 }
 
-func (e TL_channels_editBanned) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_editBanned) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_editBanned)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.Participant.encode())
-	x.Bytes(e.BannedRights.encode())
-	return x.buf
+	x.Object(e.Channel)
+	x.Object(e.Participant)
+	x.Object(e.BannedRights)
 }
 
-func (e TL_channels_getAdminLog) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getAdminLog) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getAdminLog)
 	x.Int(e.Flags)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.String(e.Q)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.EventsFilter.encode())
+		x.Object(e.EventsFilter)
 	}
 	if e.Flags&2 != 0 {
 		x.Vector(e.Admins)
@@ -21224,150 +18731,112 @@ func (e TL_channels_getAdminLog) encode() []byte {
 	x.Long(e.MaxID)
 	x.Long(e.MinID)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_channels_setStickers) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_setStickers) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_setStickers)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.Stickerset.encode())
-	return x.buf
+	x.Object(e.Channel)
+	x.Object(e.Stickerset)
 }
 
-func (e TL_channels_readMessageContents) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_readMessageContents) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_readMessageContents)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.VectorInt(e.ID)
-	return x.buf
 }
 
-func (e TL_channels_deleteHistory) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_deleteHistory) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_deleteHistory)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.Int(e.MaxID)
-	return x.buf
 }
 
-func (e TL_channels_togglePreHistoryHidden) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_togglePreHistoryHidden) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_togglePreHistoryHidden)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.Enabled.encode())
-	return x.buf
+	x.Object(e.Channel)
+	x.Object(e.Enabled)
 }
 
-func (e TL_channels_getLeftChannels) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getLeftChannels) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getLeftChannels)
 	x.Int(e.Offset)
-	return x.buf
 }
 
-func (e TL_channels_getGroupsForDiscussion) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getGroupsForDiscussion) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getGroupsForDiscussion)
-	return x.buf
 }
 
-func (e TL_channels_setDiscussionGroup) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_setDiscussionGroup) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_setDiscussionGroup)
-	x.Bytes(e.Broadcast.encode())
-	x.Bytes(e.Group.encode())
-	return x.buf
+	x.Object(e.Broadcast)
+	x.Object(e.Group)
 }
 
-func (e TL_channels_editCreator) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_editCreator) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_editCreator)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.UserID.encode())
-	x.Bytes(e.Password.encode())
-	return x.buf
+	x.Object(e.Channel)
+	x.Object(e.UserID)
+	x.Object(e.Password)
 }
 
-func (e TL_channels_editLocation) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_editLocation) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_editLocation)
-	x.Bytes(e.Channel.encode())
-	x.Bytes(e.GeoPoint.encode())
+	x.Object(e.Channel)
+	x.Object(e.GeoPoint)
 	x.String(e.Address)
-	return x.buf
 }
 
-func (e TL_channels_toggleSlowMode) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_toggleSlowMode) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_toggleSlowMode)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.Int(e.Seconds)
-	return x.buf
 }
 
-func (e TL_channels_getInactiveChannels) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_getInactiveChannels) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_getInactiveChannels)
-	return x.buf
 }
 
-func (e TL_channels_convertToGigagroup) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_channels_convertToGigagroup) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_channels_convertToGigagroup)
-	x.Bytes(e.Channel.encode())
-	return x.buf
+	x.Object(e.Channel)
 }
 
-func (e TL_bots_sendCustomRequest) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_bots_sendCustomRequest) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_bots_sendCustomRequest)
 	x.String(e.CustomMethod)
-	x.Bytes(e.Params.encode())
-	return x.buf
+	x.Object(e.Params)
 }
 
-func (e TL_bots_answerWebhookJSONQuery) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_bots_answerWebhookJSONQuery) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_bots_answerWebhookJSONQuery)
 	x.Long(e.QueryID)
-	x.Bytes(e.Data.encode())
-	return x.buf
+	x.Object(e.Data)
 }
 
-func (e TL_bots_setBotCommands) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_bots_setBotCommands) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_bots_setBotCommands)
 	x.Vector(e.Commands)
-	return x.buf
 }
 
-func (e TL_payments_getPaymentForm) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_getPaymentForm) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_getPaymentForm)
 	x.Int(e.MsgID)
-	return x.buf
 }
 
-func (e TL_payments_getPaymentReceipt) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_getPaymentReceipt) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_getPaymentReceipt)
 	x.Int(e.MsgID)
-	return x.buf
 }
 
-func (e TL_payments_validateRequestedInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_validateRequestedInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_validateRequestedInfo)
 	x.Int(e.Flags)
 	//flag Save
 	x.Int(e.MsgID)
-	x.Bytes(e.Info.encode())
-	return x.buf
+	x.Object(e.Info)
 }
 
-func (e TL_payments_sendPaymentForm) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_sendPaymentForm) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_sendPaymentForm)
 	x.Int(e.Flags)
 	x.Int(e.MsgID)
@@ -21377,401 +18846,395 @@ func (e TL_payments_sendPaymentForm) encode() []byte {
 	if e.Flags&2 != 0 {
 		x.String(e.ShippingOptionID)
 	}
-	x.Bytes(e.Credentials.encode())
-	return x.buf
+	x.Object(e.Credentials)
 }
 
-func (e TL_payments_getSavedInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_getSavedInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_getSavedInfo)
-	return x.buf
 }
 
-func (e TL_payments_clearSavedInfo) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_clearSavedInfo) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_clearSavedInfo)
 	x.Int(e.Flags)
-	//flag Credentials
-	//flag Info
-	return x.buf
+	// flag Credentials
+	// flag Info
 }
 
-func (e TL_payments_getBankCardData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_payments_getBankCardData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_payments_getBankCardData)
 	x.String(e.Number)
-	return x.buf
 }
 
-func (e TL_stickers_createStickerSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickers_createStickerSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickers_createStickerSet)
 	x.Int(e.Flags)
 	//flag Masks
 	//flag Animated
-	x.Bytes(e.UserID.encode())
+	x.Object(e.UserID)
 	x.String(e.Title)
 	x.String(e.ShortName)
 	if e.Flags&4 != 0 {
-		x.Bytes(e.Thumb.encode())
+		x.Object(e.Thumb)
 	}
 	x.Vector(e.Stickers)
-	return x.buf
 }
 
-func (e TL_stickers_removeStickerFromSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickers_removeStickerFromSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickers_removeStickerFromSet)
-	x.Bytes(e.Sticker.encode())
-	return x.buf
+	x.Object(e.Sticker)
 }
 
-func (e TL_stickers_changeStickerPosition) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickers_changeStickerPosition) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickers_changeStickerPosition)
-	x.Bytes(e.Sticker.encode())
+	x.Object(e.Sticker)
 	x.Int(e.Position)
-	return x.buf
 }
 
-func (e TL_stickers_addStickerToSet) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickers_addStickerToSet) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickers_addStickerToSet)
-	x.Bytes(e.Stickerset.encode())
-	x.Bytes(e.Sticker.encode())
-	return x.buf
+	x.Object(e.Stickerset)
+	x.Object(e.Sticker)
 }
 
-func (e TL_stickers_setStickerSetThumb) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stickers_setStickerSetThumb) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stickers_setStickerSetThumb)
-	x.Bytes(e.Stickerset.encode())
-	x.Bytes(e.Thumb.encode())
-	return x.buf
+	x.Object(e.Stickerset)
+	x.Object(e.Thumb)
 }
 
-func (e TL_phone_getCallConfig) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_getCallConfig) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_getCallConfig)
-	return x.buf
 }
 
-func (e TL_phone_requestCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_requestCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_requestCall)
 	x.Int(e.Flags)
 	//flag Video
-	x.Bytes(e.UserID.encode())
+	x.Object(e.UserID)
 	x.Int(e.RandomID)
 	x.StringBytes(e.GAHash)
-	x.Bytes(e.Protocol.encode())
-	return x.buf
+	x.Object(e.Protocol)
 }
 
-func (e TL_phone_acceptCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_acceptCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_acceptCall)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.StringBytes(e.GB)
-	x.Bytes(e.Protocol.encode())
-	return x.buf
+	x.Object(e.Protocol)
 }
 
-func (e TL_phone_confirmCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_confirmCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_confirmCall)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.StringBytes(e.GA)
 	x.Long(e.KeyFingerprint)
-	x.Bytes(e.Protocol.encode())
-	return x.buf
+	x.Object(e.Protocol)
 }
 
-func (e TL_phone_receivedCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_receivedCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_receivedCall)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_phone_discardCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_discardCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_discardCall)
 	x.Int(e.Flags)
 	//flag Video
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.Duration)
-	x.Bytes(e.Reason.encode())
+	x.Object(e.Reason)
 	x.Long(e.ConnectionID)
-	return x.buf
 }
 
-func (e TL_phone_setCallRating) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_setCallRating) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_setCallRating)
 	x.Int(e.Flags)
 	//flag UserInitiative
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.Rating)
 	x.String(e.Comment)
-	return x.buf
 }
 
-func (e TL_phone_saveCallDebug) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_saveCallDebug) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_saveCallDebug)
-	x.Bytes(e.Peer.encode())
-	x.Bytes(e.Debug.encode())
-	return x.buf
+	x.Object(e.Peer)
+	x.Object(e.Debug)
 }
 
-func (e TL_phone_sendSignalingData) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_sendSignalingData) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_sendSignalingData)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.StringBytes(e.Data)
-	return x.buf
 }
 
-func (e TL_phone_createGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_createGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_createGroupCall)
-	x.Bytes(e.Peer.encode())
+	x.Object(e.Peer)
 	x.Int(e.RandomID)
-	return x.buf
 }
 
-func (e TL_phone_joinGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_joinGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_joinGroupCall)
 	x.Int(e.Flags)
 	//flag Muted
-	x.Bytes(e.Call.encode())
-	x.Bytes(e.JoinAs.encode())
+	x.Object(e.Call)
+	x.Object(e.JoinAs)
 	if e.Flags&2 != 0 {
 		x.String(e.InviteHash)
 	}
-	x.Bytes(e.Params.encode())
-	return x.buf
+	x.Object(e.Params)
 }
 
-func (e TL_phone_leaveGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_leaveGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_leaveGroupCall)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.Int(e.Source)
-	return x.buf
 }
 
-func (e TL_phone_inviteToGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_inviteToGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_inviteToGroupCall)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.Vector(e.Users)
-	return x.buf
 }
 
-func (e TL_phone_discardGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_discardGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_discardGroupCall)
-	x.Bytes(e.Call.encode())
-	return x.buf
+	x.Object(e.Call)
 }
 
-func (e TL_phone_toggleGroupCallSettings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_toggleGroupCallSettings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_toggleGroupCallSettings)
 	x.Int(e.Flags)
 	//flag ResetInviteHash
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	if e.Flags&1 != 0 {
-		x.Bytes(e.JoinMuted.encode())
+		x.Object(e.JoinMuted)
 	}
-	return x.buf
 }
 
-func (e TL_phone_getGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_getGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_getGroupCall)
-	x.Bytes(e.Call.encode())
-	return x.buf
+	x.Object(e.Call)
 }
 
-func (e TL_phone_getGroupParticipants) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_getGroupParticipants) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_getGroupParticipants)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.Vector(e.Ids)
 	x.VectorInt(e.Sources)
 	x.String(e.Offset)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_phone_checkGroupCall) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_checkGroupCall) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_checkGroupCall)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.Int(e.Source)
-	return x.buf
 }
 
-func (e TL_phone_toggleGroupCallRecord) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_toggleGroupCallRecord) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_toggleGroupCallRecord)
 	x.Int(e.Flags)
 	//flag Start
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	if e.Flags&2 != 0 {
 		x.String(e.Title)
 	}
-	return x.buf
 }
 
-func (e TL_phone_editGroupCallParticipant) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_editGroupCallParticipant) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_editGroupCallParticipant)
 	x.Int(e.Flags)
 	//flag Muted
-	x.Bytes(e.Call.encode())
-	x.Bytes(e.Participant.encode())
+	x.Object(e.Call)
+	x.Object(e.Participant)
 	if e.Flags&2 != 0 {
 		x.Int(e.Volume)
 	}
 	if e.Flags&4 != 0 {
-		x.Bytes(e.RaiseHand.encode())
+		x.Object(e.RaiseHand)
 	}
-	return x.buf
 }
 
-func (e TL_phone_editGroupCallTitle) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_editGroupCallTitle) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_editGroupCallTitle)
-	x.Bytes(e.Call.encode())
+	x.Object(e.Call)
 	x.String(e.Title)
-	return x.buf
 }
 
-func (e TL_phone_getGroupCallJoinAs) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_getGroupCallJoinAs) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_getGroupCallJoinAs)
-	x.Bytes(e.Peer.encode())
-	return x.buf
+	x.Object(e.Peer)
 }
 
-func (e TL_phone_exportGroupCallInvite) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_phone_exportGroupCallInvite) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_phone_exportGroupCallInvite)
 	x.Int(e.Flags)
 	//flag CanSelfUnmute
-	x.Bytes(e.Call.encode())
-	return x.buf
+	x.Object(e.Call)
 }
 
-func (e TL_langpack_getLangPack) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langpack_getLangPack) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langpack_getLangPack)
 	x.String(e.LangPack)
 	x.String(e.LangCode)
-	return x.buf
 }
 
-func (e TL_langpack_getStrings) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langpack_getStrings) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langpack_getStrings)
 	x.String(e.LangPack)
 	x.String(e.LangCode)
 	x.VectorString(e.Keys)
-	return x.buf
 }
 
-func (e TL_langpack_getDifference) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langpack_getDifference) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langpack_getDifference)
 	x.String(e.LangPack)
 	x.String(e.LangCode)
 	x.Int(e.FromVersion)
-	return x.buf
 }
 
-func (e TL_langpack_getLanguages) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langpack_getLanguages) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langpack_getLanguages)
 	x.String(e.LangPack)
-	return x.buf
 }
 
-func (e TL_langpack_getLanguage) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_langpack_getLanguage) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_langpack_getLanguage)
 	x.String(e.LangPack)
 	x.String(e.LangCode)
-	return x.buf
 }
 
-func (e TL_folders_editPeerFolders) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_folders_editPeerFolders) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_folders_editPeerFolders)
 	x.Vector(e.FolderPeers)
-	return x.buf
 }
 
-func (e TL_folders_deleteFolder) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_folders_deleteFolder) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_folders_deleteFolder)
 	x.Int(e.FolderID)
-	return x.buf
 }
 
-func (e TL_stats_getBroadcastStats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stats_getBroadcastStats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stats_getBroadcastStats)
 	x.Int(e.Flags)
 	//flag Dark
-	x.Bytes(e.Channel.encode())
-	return x.buf
+	x.Object(e.Channel)
 }
 
-func (e TL_stats_loadAsyncGraph) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stats_loadAsyncGraph) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stats_loadAsyncGraph)
 	x.Int(e.Flags)
 	x.String(e.Token)
 	if e.Flags&1 != 0 {
 		x.Long(e.X)
 	}
-	return x.buf
 }
 
-func (e TL_stats_getMegagroupStats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stats_getMegagroupStats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stats_getMegagroupStats)
 	x.Int(e.Flags)
 	//flag Dark
-	x.Bytes(e.Channel.encode())
-	return x.buf
+	x.Object(e.Channel)
 }
 
-func (e TL_stats_getMessagePublicForwards) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stats_getMessagePublicForwards) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stats_getMessagePublicForwards)
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.Int(e.MsgID)
 	x.Int(e.OffsetRate)
-	x.Bytes(e.OffsetPeer.encode())
+	x.Object(e.OffsetPeer)
 	x.Int(e.OffsetID)
 	x.Int(e.Limit)
-	return x.buf
 }
 
-func (e TL_stats_getMessageStats) encode() []byte {
-	x := NewEncodeBuf(512)
+func (e TL_stats_getMessageStats) EncodeTo(x *EncodeBuf) {
 	x.UInt(CRC_stats_getMessageStats)
 	x.Int(e.Flags)
 	//flag Dark
-	x.Bytes(e.Channel.encode())
+	x.Object(e.Channel)
 	x.Int(e.MsgID)
-	return x.buf
+}
+
+func (e TL_message_layer122) EncodeTo(x *EncodeBuf) {
+	x.UInt(CRC_message_layer122)
+	x.Int(e.Flags)
+	//flag Out
+	//flag Mentioned
+	//flag MediaUnread
+	//flag Silent
+	//flag Post
+	//flag FromScheduled
+	//flag Legacy
+	//flag EditHide
+	//flag Pinned
+	x.Int(e.ID)
+	if e.Flags&256 != 0 {
+		x.Object(e.FromID)
+	}
+	x.Object(e.PeerID)
+	if e.Flags&4 != 0 {
+		x.Object(e.FwdFrom)
+	}
+	if e.Flags&2048 != 0 {
+		x.Int(e.ViaBotID)
+	}
+	if e.Flags&8 != 0 {
+		x.Object(e.ReplyTo)
+	}
+	x.Int(e.Date)
+	x.String(e.Message)
+	if e.Flags&512 != 0 {
+		x.Object(e.Media)
+	}
+	if e.Flags&64 != 0 {
+		x.Object(e.ReplyMarkup)
+	}
+	if e.Flags&128 != 0 {
+		x.Vector(e.Entities)
+	}
+	if e.Flags&1024 != 0 {
+		x.Int(e.Views)
+	}
+	if e.Flags&1024 != 0 {
+		x.Int(e.Forwards)
+	}
+	if e.Flags&8388608 != 0 {
+		x.Object(e.Replies)
+	}
+	if e.Flags&32768 != 0 {
+		x.Int(e.EditDate)
+	}
+	if e.Flags&65536 != 0 {
+		x.String(e.PostAuthor)
+	}
+	if e.Flags&131072 != 0 {
+		x.Long(e.GroupedID)
+	}
+	if e.Flags&4194304 != 0 {
+		x.Vector(e.RestrictionReason)
+	}
+}
+
+func (e TL_messageService_layer122) EncodeTo(x *EncodeBuf) {
+	x.UInt(CRC_messageService_layer122)
+	x.Int(e.Flags)
+	//flag Out
+	//flag Mentioned
+	//flag MediaUnread
+	//flag Silent
+	//flag Post
+	//flag Legacy
+	x.Int(e.ID)
+	if e.Flags&256 != 0 {
+		x.Object(e.FromID)
+	}
+	x.Object(e.PeerID)
+	if e.Flags&8 != 0 {
+		x.Object(e.ReplyTo)
+	}
+	x.Int(e.Date)
+	x.Object(e.Action)
 }
 
 func (e TL_req_pq) decodeResponse(dbuf *DecodeBuf) TL {
@@ -21814,3995 +19277,407 @@ func (e TL_destroy_session) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_boolFalse) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_invokeAfterMsg) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_boolTrue) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_invokeAfterMsgs) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_true) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_initConnection) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_error) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_invokeWithLayer) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_null) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_invokeWithoutUpdates) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPeerEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_invokeWithMessagesRange) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPeerSelf) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_invokeWithTakeout) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPeerChat) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_sendCode) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPeerUser) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_signUp) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPeerChannel) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_signIn) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPeerUserFromMessage) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_logOut) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPeerChannelFromMessage) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_resetAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputUserEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_exportAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputUserSelf) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_importAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputUser) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_bindTempAuthKey) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputUserFromMessage) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_importBotAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPhoneContact) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_checkPassword) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputFile) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_requestPasswordRecovery) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputFileBig) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_recoverPassword) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_resendCode) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaUploadedPhoto) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_cancelCode) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaPhoto) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_dropTempAuthKeys) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaGeoPoint) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_exportLoginToken) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaContact) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_importLoginToken) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaUploadedDocument) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_auth_acceptLoginToken) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaDocument) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_registerDevice) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaVenue) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_unregisterDevice) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaPhotoExternal) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_updateNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaDocumentExternal) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaGame) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_resetNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaInvoice) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_updateProfile) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaGeoLive) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_updateStatus) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaPoll) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getWallPapers) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputMediaDice) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_reportPeer) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputChatPhotoEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_checkUsername) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputChatUploadedPhoto) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_updateUsername) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputChatPhoto) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getPrivacy) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputGeoPointEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_setPrivacy) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputGeoPoint) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_deleteAccount) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPhotoEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getAccountTTL) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPhoto) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_setAccountTTL) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_sendChangePhoneCode) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputEncryptedFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_changePhone) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputDocumentFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_updateDeviceLocked) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputSecureFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputTakeoutFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_resetAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPhotoFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getPassword) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPhotoLegacyFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getPasswordSettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputPeerPhotoFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_updatePasswordSettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputStickerSetThumb) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_sendConfirmPhoneCode) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_inputGroupCallStream) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_confirmPhone) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_peerUser) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getTmpPassword) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_peerChat) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getWebAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_peerChannel) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_resetWebAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_storage_fileUnknown) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_resetWebAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_storage_filePartial) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
+func (e TL_account_getAllSecureValues) decodeResponse(dbuf *DecodeBuf) TL {
+	return VectorObject(dbuf.Vector())
 }
 
-func (e TL_storage_fileJpeg) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
+func (e TL_account_getSecureValue) decodeResponse(dbuf *DecodeBuf) TL {
+	return VectorObject(dbuf.Vector())
 }
 
-func (e TL_storage_fileGif) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_saveSecureValue) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_storage_filePng) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_deleteSecureValue) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_storage_filePdf) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getAuthorizationForm) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_storage_fileMp3) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_acceptAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_storage_fileMov) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_sendVerifyPhoneCode) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_storage_fileMp4) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_verifyPhone) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_storage_fileWebp) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_sendVerifyEmailCode) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_verifyEmail) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_user) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_initTakeoutSession) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userProfilePhotoEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_finishTakeoutSession) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userProfilePhoto) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_confirmPasswordEmail) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userStatusEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_resendPasswordEmail) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userStatusOnline) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_cancelPasswordEmail) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userStatusOffline) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getContactSignUpNotification) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userStatusRecently) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_setContactSignUpNotification) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userStatusLastWeek) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getNotifyExceptions) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_userStatusLastMonth) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_uploadWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chat) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_saveWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatForbidden) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_installWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_channel) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_resetWallPapers) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_channelForbidden) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getAutoDownloadSettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatFull) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_saveAutoDownloadSettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_channelFull) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_uploadTheme) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatParticipant) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_createTheme) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatParticipantCreator) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_updateTheme) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatParticipantAdmin) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_saveTheme) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatParticipantsForbidden) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_installTheme) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatParticipants) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getTheme) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatPhotoEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getThemes) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_chatPhoto) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_setContentSettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_messageEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getContentSettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_message) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
+func (e TL_account_getMultiWallPapers) decodeResponse(dbuf *DecodeBuf) TL {
+	return VectorObject(dbuf.Vector())
 }
 
-func (e TL_messageService) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_getGlobalPrivacySettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_messageMediaEmpty) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_setGlobalPrivacySettings) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_messageMediaPhoto) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_account_reportProfilePhoto) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_messageMediaGeo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
+func (e TL_users_getUsers) decodeResponse(dbuf *DecodeBuf) TL {
+	return VectorObject(dbuf.Vector())
 }
 
-func (e TL_messageMediaContact) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_users_getFullUser) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_messageMediaUnsupported) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_users_setSecureValueErrors) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_messageMediaDocument) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
+func (e TL_contacts_getContactIDs) decodeResponse(dbuf *DecodeBuf) TL {
+	return VectorInt(dbuf.VectorInt())
 }
 
-func (e TL_messageMediaWebPage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
+func (e TL_contacts_getStatuses) decodeResponse(dbuf *DecodeBuf) TL {
+	return VectorObject(dbuf.Vector())
 }
 
-func (e TL_messageMediaVenue) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_contacts_getContacts) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_messageMediaGame) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_contacts_importContacts) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
-func (e TL_messageMediaInvoice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageMediaGeoLive) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageMediaPoll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageMediaDice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChatCreate) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChatEditTitle) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChatEditPhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChatDeletePhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChatAddUser) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChatDeleteUser) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChatJoinedByLink) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChannelCreate) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChatMigrateTo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionChannelMigrateFrom) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionPinMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionHistoryClear) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionGameScore) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionPaymentSentMe) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionPaymentSent) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionPhoneCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionScreenshotTaken) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionCustomAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionBotAllowed) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionSecureValuesSentMe) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionSecureValuesSent) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionContactSignUp) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionGeoProximityReached) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionGroupCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionInviteToGroupCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageActionSetMessagesTTL) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_dialog) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_dialogFolder) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photoEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photoSizeEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photoSize) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photoCachedSize) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photoStrippedSize) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photoSizeProgressive) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photoPathSize) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_geoPointEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_geoPoint) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_sentCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_authorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_authorizationSignUpRequired) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_exportedAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputNotifyPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputNotifyUsers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputNotifyChats) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputNotifyBroadcasts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPeerNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_peerNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_peerSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_wallPaper) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_wallPaperNoFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputReportReasonSpam) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputReportReasonViolence) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputReportReasonPornography) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputReportReasonChildAbuse) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputReportReasonOther) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputReportReasonCopyright) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputReportReasonGeoIrrelevant) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputReportReasonFake) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_userFull) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contact) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_importedContact) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contactStatus) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_contactsNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_contacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_importedContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_blocked) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_blockedSlice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_dialogs) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_dialogsSlice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_dialogsNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_messages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_messagesSlice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_channelMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_messagesNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_chats) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_chatsSlice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_chatFull) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_affectedHistory) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterPhotos) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterVideo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterPhotoVideo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterDocument) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterUrl) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterGif) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterVoice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterMusic) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterChatPhotos) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterPhoneCalls) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterRoundVoice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterRoundVideo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterMyMentions) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterGeo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagesFilterPinned) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateNewMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateMessageID) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDeleteMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateUserTyping) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChatUserTyping) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChatParticipants) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateUserStatus) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateUserName) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateUserPhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateNewEncryptedMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateEncryptedChatTyping) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateEncryption) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateEncryptedMessagesRead) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChatParticipantAdd) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChatParticipantDelete) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDcOptions) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateServiceNotification) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePrivacy) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateUserPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateReadHistoryInbox) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateReadHistoryOutbox) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateWebPage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateReadMessagesContents) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannelTooLong) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannel) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateNewChannelMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateReadChannelInbox) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDeleteChannelMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannelMessageViews) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChatParticipantAdmin) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateNewStickerSet) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateStickerSetsOrder) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateStickerSets) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateSavedGifs) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateBotInlineQuery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateBotInlineSend) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateEditChannelMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateBotCallbackQuery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateEditMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateInlineBotCallbackQuery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateReadChannelOutbox) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDraftMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateReadFeaturedStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateRecentStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateConfig) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePtsChanged) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannelWebPage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDialogPinned) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePinnedDialogs) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateBotWebhookJSON) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateBotWebhookJSONQuery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateBotShippingQuery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateBotPrecheckoutQuery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePhoneCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateLangPackTooLong) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateLangPack) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateFavedStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannelReadMessagesContents) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateContactsReset) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannelAvailableMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDialogUnreadMark) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateMessagePoll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChatDefaultBannedRights) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateFolderPeers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePeerSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePeerLocated) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateNewScheduledMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDeleteScheduledMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateGeoLiveViewed) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateLoginToken) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateMessagePollVote) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDialogFilter) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDialogFilterOrder) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateDialogFilters) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePhoneCallSignalingData) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannelMessageForwards) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateReadChannelDiscussionInbox) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateReadChannelDiscussionOutbox) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePeerBlocked) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannelUserTyping) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePinnedMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePinnedChannelMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChat) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateGroupCallParticipants) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateGroupCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatePeerHistoryTTL) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChatParticipant) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateChannelParticipant) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateBotStopped) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates_state) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates_differenceEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates_difference) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates_differenceSlice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates_differenceTooLong) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatesTooLong) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateShortMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateShortChatMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateShort) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updatesCombined) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updateShortSentMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photos_photos) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photos_photosSlice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_photos_photo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_upload_file) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_upload_fileCdnRedirect) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_dcOption) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_config) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_nearestDc) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_appUpdate) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_noAppUpdate) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_inviteText) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedChatEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedChatWaiting) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedChatRequested) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedChat) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedChatDiscarded) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputEncryptedChat) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedFileEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputEncryptedFileEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputEncryptedFileUploaded) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputEncryptedFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputEncryptedFileBigUploaded) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_encryptedMessageService) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_dhConfigNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_dhConfig) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_sentEncryptedMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_sentEncryptedFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputDocumentEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputDocument) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_documentEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_document) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_support) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_notifyPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_notifyUsers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_notifyChats) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_notifyBroadcasts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageTypingAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageCancelAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageRecordVideoAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageUploadVideoAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageRecordAudioAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageUploadAudioAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageUploadPhotoAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageUploadDocumentAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageGeoLocationAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageChooseContactAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageGamePlayAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageRecordRoundAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageUploadRoundAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_speakingInGroupCallAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_sendMessageHistoryImportAction) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_found) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyKeyStatusTimestamp) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyKeyChatInvite) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyKeyPhoneCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyKeyPhoneP2P) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyKeyForwards) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyKeyProfilePhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyKeyPhoneNumber) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyKeyAddedByPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyKeyStatusTimestamp) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyKeyChatInvite) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyKeyPhoneCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyKeyPhoneP2P) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyKeyForwards) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyKeyProfilePhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyKeyPhoneNumber) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyKeyAddedByPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyValueAllowContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyValueAllowAll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyValueAllowUsers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyValueDisallowContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyValueDisallowAll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyValueDisallowUsers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyValueAllowChatParticipants) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPrivacyValueDisallowChatParticipants) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyValueAllowContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyValueAllowAll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyValueAllowUsers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyValueDisallowContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyValueDisallowAll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyValueDisallowUsers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyValueAllowChatParticipants) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_privacyValueDisallowChatParticipants) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_privacyRules) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_accountDaysTTL) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_documentAttributeImageSize) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_documentAttributeAnimated) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_documentAttributeSticker) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_documentAttributeVideo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_documentAttributeAudio) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_documentAttributeFilename) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_documentAttributeHasStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_stickersNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_stickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_stickerPack) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_allStickersNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_allStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_affectedMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_webPageEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_webPagePending) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_webPage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_webPageNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_authorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_authorizations) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_password) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_passwordSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_passwordInputSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_passwordRecovery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_receivedNotifyMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatInviteExported) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatInviteAlready) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatInvite) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatInvitePeek) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputStickerSetEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputStickerSetID) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputStickerSetShortName) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputStickerSetAnimatedEmoji) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputStickerSetDice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_stickerSet) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_stickerSet) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botCommand) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botInfo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButton) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonUrl) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonCallback) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonRequestPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonRequestGeoLocation) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonSwitchInline) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonGame) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonBuy) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonUrlAuth) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputKeyboardButtonUrlAuth) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonRequestPoll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_keyboardButtonRow) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_replyKeyboardHide) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_replyKeyboardForceReply) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_replyKeyboardMarkup) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_replyInlineMarkup) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityUnknown) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityMention) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityHashtag) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityBotCommand) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityUrl) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityEmail) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityBold) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityItalic) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityPre) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityTextUrl) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityMentionName) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessageEntityMentionName) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityCashtag) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityUnderline) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityStrike) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityBlockquote) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageEntityBankCard) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputChannelEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputChannel) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputChannelFromMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_resolvedPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageRange) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates_channelDifferenceEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates_channelDifferenceTooLong) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_updates_channelDifference) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelMessagesFilterEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelMessagesFilter) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipant) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantSelf) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantCreator) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantAdmin) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantBanned) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantLeft) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantsRecent) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantsAdmins) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantsKicked) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantsBots) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantsBanned) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantsSearch) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantsContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelParticipantsMentions) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channels_channelParticipants) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channels_channelParticipantsNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channels_channelParticipant) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_termsOfService) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_savedGifsNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_savedGifs) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineMessageMediaAuto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineMessageText) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineMessageMediaGeo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineMessageMediaVenue) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineMessageMediaContact) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineMessageGame) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineResult) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineResultPhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineResultDocument) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineResultGame) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botInlineMessageMediaAuto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botInlineMessageText) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botInlineMessageMediaGeo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botInlineMessageMediaVenue) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botInlineMessageMediaContact) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botInlineResult) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_botInlineMediaResult) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_botResults) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_exportedMessageLink) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageFwdHeader) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_codeTypeSms) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_codeTypeCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_codeTypeFlashCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_sentCodeTypeApp) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_sentCodeTypeSms) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_sentCodeTypeCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_sentCodeTypeFlashCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_botCallbackAnswer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_messageEditData) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputBotInlineMessageID) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inlineBotSwitchPM) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_peerDialogs) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryBotsPM) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryBotsInline) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryCorrespondents) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryGroups) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryChannels) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryPhoneCalls) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryForwardUsers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryForwardChats) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_topPeerCategoryPeers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_topPeersNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_topPeers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_topPeersDisabled) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_draftMessageEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_draftMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_featuredStickersNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_featuredStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_recentStickersNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_recentStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_archivedStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_stickerSetInstallResultSuccess) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_stickerSetInstallResultArchive) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_stickerSetCovered) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_stickerSetMultiCovered) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_maskCoords) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputStickeredMediaPhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputStickeredMediaDocument) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_game) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputGameID) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputGameShortName) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_highScore) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_highScores) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textPlain) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textBold) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textItalic) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textUnderline) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textStrike) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textFixed) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textUrl) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textEmail) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textConcat) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textSubscript) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textSuperscript) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textMarked) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textImage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_textAnchor) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockUnsupported) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockTitle) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockSubtitle) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockAuthorDate) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockHeader) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockSubheader) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockParagraph) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockPreformatted) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockFooter) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockDivider) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockAnchor) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockList) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockBlockquote) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockPullquote) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockPhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockVideo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockCover) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockEmbed) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockEmbedPost) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockCollage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockSlideshow) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockChannel) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockAudio) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockKicker) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockTable) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockOrderedList) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockDetails) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockRelatedArticles) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageBlockMap) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallDiscardReasonMissed) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallDiscardReasonDisconnect) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallDiscardReasonHangup) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallDiscardReasonBusy) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_dataJSON) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_labeledPrice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_invoice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_paymentCharge) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_postAddress) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_paymentRequestedInfo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_paymentSavedCredentialsCard) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_webDocument) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_webDocumentNoProxy) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputWebDocument) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputWebFileLocation) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputWebFileGeoPointLocation) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_upload_webFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_payments_paymentForm) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_payments_validatedRequestedInfo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_payments_paymentResult) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_payments_paymentVerificationNeeded) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_payments_paymentReceipt) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_payments_savedInfo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPaymentCredentialsSaved) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPaymentCredentials) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPaymentCredentialsApplePay) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPaymentCredentialsGooglePay) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_tmpPassword) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_shippingOption) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputStickerSetItem) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputPhoneCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallWaiting) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallRequested) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallAccepted) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallDiscarded) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneConnection) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneConnectionWebrtc) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phoneCallProtocol) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phone_phoneCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_upload_cdnFileReuploadNeeded) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_upload_cdnFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_cdnPublicKey) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_cdnConfig) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_langPackString) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_langPackStringPluralized) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_langPackStringDeleted) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_langPackDifference) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_langPackLanguage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionChangeTitle) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionChangeAbout) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionChangeUsername) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionChangePhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionToggleInvites) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionToggleSignatures) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionUpdatePinned) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionEditMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionDeleteMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantJoin) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantLeave) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantInvite) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantToggleBan) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantToggleAdmin) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionChangeStickerSet) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionTogglePreHistoryHidden) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionDefaultBannedRights) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionStopPoll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionChangeLinkedChat) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionChangeLocation) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionToggleSlowMode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionStartGroupCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionDiscardGroupCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantMute) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantUnmute) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionToggleGroupCallSetting) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantJoinByInvite) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionExportedInviteDelete) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionExportedInviteRevoke) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionExportedInviteEdit) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionParticipantVolume) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventActionChangeHistoryTTL) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEvent) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channels_adminLogResults) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelAdminLogEventsFilter) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_popularContact) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_favedStickersNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_favedStickers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_recentMeUrlUnknown) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_recentMeUrlUser) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_recentMeUrlChat) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_recentMeUrlChatInvite) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_recentMeUrlStickerSet) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_recentMeUrls) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputSingleMedia) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_webAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_webAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessageID) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessageReplyTo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessagePinned) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputMessageCallbackQuery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputDialogPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputDialogPeerFolder) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_dialogPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_dialogPeerFolder) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_foundStickerSetsNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_foundStickerSets) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_fileHash) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputClientProxy) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_termsOfServiceUpdateEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_termsOfServiceUpdate) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputSecureFileUploaded) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputSecureFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureFileEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureData) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_securePlainPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_securePlainEmail) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypePersonalDetails) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypePassport) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeDriverLicense) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeIdentityCard) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeInternalPassport) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeAddress) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeUtilityBill) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeBankStatement) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeRentalAgreement) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypePassportRegistration) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeTemporaryRegistration) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypePhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueTypeEmail) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValue) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputSecureValue) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueHash) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueErrorData) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueErrorFrontSide) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueErrorReverseSide) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueErrorSelfie) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueErrorFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueErrorFiles) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueError) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueErrorTranslationFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureValueErrorTranslationFiles) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureCredentialsEncrypted) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_authorizationForm) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_sentEmailCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_deepLinkInfoEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_deepLinkInfo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_savedPhoneContact) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_takeout) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_passwordKdfAlgoUnknown) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_securePasswordKdfAlgoUnknown) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_securePasswordKdfAlgoSHA512) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureSecretSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputCheckPasswordEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputCheckPasswordSRP) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureRequiredType) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_secureRequiredTypeOneOf) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_passportConfigNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_passportConfig) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputAppEvent) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_jsonObjectValue) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_jsonNull) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_jsonBool) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_jsonNumber) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_jsonString) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_jsonArray) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_jsonObject) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageTableCell) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageTableRow) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageCaption) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageListItemText) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageListItemBlocks) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageListOrderedItemText) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageListOrderedItemBlocks) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pageRelatedArticle) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_page) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_supportName) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_userInfoEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_userInfo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pollAnswer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_poll) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pollAnswerVoters) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_pollResults) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatOnlines) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsURL) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatAdminRights) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatBannedRights) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputWallPaperSlug) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputWallPaperNoFile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_wallPapersNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_wallPapers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_codeSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_wallPaperSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_autoDownloadSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_autoDownloadSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_emojiKeyword) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_emojiKeywordDeleted) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_emojiKeywordsDifference) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_emojiURL) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_emojiLanguage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_fileLocationToBeDeprecated) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_folder) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputFolderPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_folderPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_searchCounter) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_urlAuthResultRequest) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_urlAuthResultAccepted) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_urlAuthResultDefault) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelLocationEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_channelLocation) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_peerLocated) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_peerSelfLocated) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_restrictionReason) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputThemeSlug) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_theme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_themesNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_themes) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_loginToken) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_loginTokenMigrateTo) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_loginTokenSuccess) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_contentSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_inactiveChats) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_baseThemeClassic) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_baseThemeDay) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_baseThemeNight) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_baseThemeTinted) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_baseThemeArctic) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputThemeSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_themeSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_webPageAttributeTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageUserVote) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageUserVoteInputOption) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageUserVoteMultiple) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_votesList) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_bankCardOpenUrl) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_payments_bankCardData) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_dialogFilter) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_dialogFilterSuggested) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsDateRangeDays) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsAbsValueAndPrev) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsPercentValue) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsGraphAsync) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsGraphError) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsGraph) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageInteractionCounters) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_stats_broadcastStats) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_promoDataEmpty) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_promoData) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_videoSize) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsGroupTopPoster) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsGroupTopAdmin) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_statsGroupTopInviter) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_stats_megagroupStats) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_globalPrivacySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_countryCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_country) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_countriesListNotModified) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_help_countriesList) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageViews) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_messageViews) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_discussionMessage) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageReplyHeader) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messageReplies) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_peerBlocked) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_stats_messageStats) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_groupCallDiscarded) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_groupCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inputGroupCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_groupCallParticipant) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phone_groupCall) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phone_groupParticipants) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inlineQueryPeerTypeSameBotPM) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inlineQueryPeerTypePM) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inlineQueryPeerTypeChat) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inlineQueryPeerTypeMegagroup) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_inlineQueryPeerTypeBroadcast) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_historyImport) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_historyImportParsed) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_affectedFoundMessages) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatInviteImporter) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_exportedChatInvites) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_exportedChatInvite) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_exportedChatInviteReplaced) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_chatInviteImporters) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_chatAdminWithInvites) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_chatAdminsWithInvites) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_messages_checkedHistoryImportPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phone_joinAsPeers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_phone_exportedGroupCallInvite) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_invokeAfterMsg) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_invokeAfterMsgs) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_initConnection) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_invokeWithLayer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_invokeWithoutUpdates) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_invokeWithMessagesRange) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_invokeWithTakeout) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_sendCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_signUp) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_signIn) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_logOut) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_resetAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_exportAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_importAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_bindTempAuthKey) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_importBotAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_checkPassword) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_requestPasswordRecovery) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_recoverPassword) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_resendCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_cancelCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_dropTempAuthKeys) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_exportLoginToken) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_importLoginToken) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_auth_acceptLoginToken) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_registerDevice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_unregisterDevice) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_updateNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_resetNotifySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_updateProfile) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_updateStatus) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getWallPapers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_reportPeer) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_checkUsername) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_updateUsername) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getPrivacy) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_setPrivacy) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_deleteAccount) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getAccountTTL) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_setAccountTTL) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_sendChangePhoneCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_changePhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_updateDeviceLocked) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_resetAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getPassword) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getPasswordSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_updatePasswordSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_sendConfirmPhoneCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_confirmPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getTmpPassword) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getWebAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_resetWebAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_resetWebAuthorizations) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getAllSecureValues) decodeResponse(dbuf *DecodeBuf) TL {
-	return VectorObject(dbuf.Vector())
-}
-
-func (e TL_account_getSecureValue) decodeResponse(dbuf *DecodeBuf) TL {
-	return VectorObject(dbuf.Vector())
-}
-
-func (e TL_account_saveSecureValue) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_deleteSecureValue) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getAuthorizationForm) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_acceptAuthorization) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_sendVerifyPhoneCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_verifyPhone) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_sendVerifyEmailCode) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_verifyEmail) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_initTakeoutSession) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_finishTakeoutSession) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_confirmPasswordEmail) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_resendPasswordEmail) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_cancelPasswordEmail) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getContactSignUpNotification) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_setContactSignUpNotification) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getNotifyExceptions) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_uploadWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_saveWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_installWallPaper) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_resetWallPapers) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getAutoDownloadSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_saveAutoDownloadSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_uploadTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_createTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_updateTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_saveTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_installTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getTheme) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getThemes) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_setContentSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getContentSettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_getMultiWallPapers) decodeResponse(dbuf *DecodeBuf) TL {
-	return VectorObject(dbuf.Vector())
-}
-
-func (e TL_account_getGlobalPrivacySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_setGlobalPrivacySettings) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_account_reportProfilePhoto) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_users_getUsers) decodeResponse(dbuf *DecodeBuf) TL {
-	return VectorObject(dbuf.Vector())
-}
-
-func (e TL_users_getFullUser) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_users_setSecureValueErrors) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_getContactIDs) decodeResponse(dbuf *DecodeBuf) TL {
-	return VectorInt(dbuf.VectorInt())
-}
-
-func (e TL_contacts_getStatuses) decodeResponse(dbuf *DecodeBuf) TL {
-	return VectorObject(dbuf.Vector())
-}
-
-func (e TL_contacts_getContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_importContacts) decodeResponse(dbuf *DecodeBuf) TL {
-	return dbuf.Object()
-}
-
-func (e TL_contacts_deleteContacts) decodeResponse(dbuf *DecodeBuf) TL {
+func (e TL_contacts_deleteContacts) decodeResponse(dbuf *DecodeBuf) TL {
 	return dbuf.Object()
 }
 
@@ -27175,7 +21050,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_future_salts{
 			m.Long(),
 			m.Int(),
-			m.Object(),
+			m.BareVectorOfBare(CRC_future_salt),
 		}
 
 	case CRC_pong:
@@ -27225,19 +21100,19 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_accessPointRule{
 			m.String(),
 			m.Int(),
-			m.Object(),
+			m.BareVector(),
 		}
 
 	case CRC_help_configSimple:
 		r = TL_help_configSimple{
 			m.Int(),
 			m.Int(),
-			m.Object(),
+			m.BareVector(),
 		}
 
 	case CRC_tlsClientHello:
 		r = TL_tlsClientHello{
-			m.Object(),
+			m.BareVector(),
 		}
 
 	case CRC_tlsBlockString:
@@ -27511,7 +21386,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_inputMediaPoll{
 			readFlags(m, &flags),
 			m.Object(),
-			m.FlaggedVector(flags, 0),
+			m.FlaggedVectorBytes(flags, 0),
 			m.FlaggedString(flags, 1),
 			m.FlaggedVector(flags, 1),
 		}
@@ -29180,7 +23055,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_updateMessagePollVote{
 			m.Long(),
 			m.Int(),
-			m.Vector(),
+			m.VectorBytes(),
 		}
 
 	case CRC_updateDialogFilter:
@@ -32357,7 +26232,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case CRC_secureValueErrorFiles:
 		r = TL_secureValueErrorFiles{
 			m.Object(),
-			m.Vector(),
+			m.VectorBytes(),
 			m.String(),
 		}
 
@@ -32378,7 +26253,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case CRC_secureValueErrorTranslationFiles:
 		r = TL_secureValueErrorTranslationFiles{
 			m.Object(),
-			m.Vector(),
+			m.VectorBytes(),
 			m.String(),
 		}
 
@@ -33025,7 +26900,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case CRC_messageUserVoteMultiple:
 		r = TL_messageUserVoteMultiple{
 			m.Int(),
-			m.Vector(),
+			m.VectorBytes(),
 			m.Int(),
 		}
 
@@ -34941,7 +28816,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_messages_sendVote{
 			m.Object(),
 			m.Int(),
-			m.Vector(),
+			m.VectorBytes(),
 		}
 
 	case CRC_messages_getPollResults:
@@ -36024,9 +29899,64 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			m.Int(),
 		}
 
+	case CRC_message_layer122:
+		var flags int32
+		r = TL_message_layer122{
+			readFlags(m, &flags),
+			flags&2 != 0,        //flag #1
+			flags&16 != 0,       //flag #4
+			flags&32 != 0,       //flag #5
+			flags&8192 != 0,     //flag #13
+			flags&16384 != 0,    //flag #14
+			flags&262144 != 0,   //flag #18
+			flags&524288 != 0,   //flag #19
+			flags&2097152 != 0,  //flag #21
+			flags&16777216 != 0, //flag #24
+			m.Int(),
+			m.FlaggedObject(flags, 8),
+			m.Object(),
+			m.FlaggedObject(flags, 2),
+			m.FlaggedInt(flags, 11),
+			m.FlaggedObject(flags, 3),
+			m.Int(),
+			m.String(),
+			m.FlaggedObject(flags, 9),
+			m.FlaggedObject(flags, 6),
+			m.FlaggedVector(flags, 7),
+			m.FlaggedInt(flags, 10),
+			m.FlaggedInt(flags, 10),
+			m.FlaggedObject(flags, 23),
+			m.FlaggedInt(flags, 15),
+			m.FlaggedString(flags, 16),
+			m.FlaggedLong(flags, 17),
+			m.FlaggedVector(flags, 22),
+		}
+
+	case CRC_messageService_layer122:
+		var flags int32
+		r = TL_messageService_layer122{
+			readFlags(m, &flags),
+			flags&2 != 0,      //flag #1
+			flags&16 != 0,     //flag #4
+			flags&32 != 0,     //flag #5
+			flags&8192 != 0,   //flag #13
+			flags&16384 != 0,  //flag #14
+			flags&524288 != 0, //flag #19
+			m.Int(),
+			m.FlaggedObject(flags, 8),
+			m.Object(),
+			m.FlaggedObject(flags, 3),
+			m.Int(),
+			m.Object(),
+		}
+
 	default:
-		m.err = merry.Errorf("Unknown constructor: %08x", constructor)
-		return nil
+		info := TypeByCRC(constructor)
+		if info == nil || info.Decode == nil {
+			m.err = merry.Errorf("Unknown constructor: %08x", constructor)
+			return nil
+		}
+		r = info.Decode(m)
 
 	}
 
@@ -0,0 +1,299 @@
+package mtproto
+
+func (e TL_help_configSimple) GetDate() int32                      { return e.Date }
+func (e TL_inputPeerChannel) GetChannelID() int32                  { return e.ChannelID }
+func (e TL_inputPeerChannelFromMessage) GetChannelID() int32       { return e.ChannelID }
+func (e TL_peerChannel) GetChannelID() int32                       { return e.ChannelID }
+func (e TL_userEmpty) GetID() int32                                { return e.ID }
+func (e TL_user) GetID() int32                                     { return e.ID }
+func (e TL_chatEmpty) GetID() int32                                { return e.ID }
+func (e TL_chat) GetDate() int32                                   { return e.Date }
+func (e TL_chat) GetID() int32                                     { return e.ID }
+func (e TL_chatForbidden) GetID() int32                            { return e.ID }
+func (e TL_channel) GetDate() int32                                { return e.Date }
+func (e TL_channel) GetID() int32                                  { return e.ID }
+func (e TL_channelForbidden) GetID() int32                         { return e.ID }
+func (e TL_chatFull) GetID() int32                                 { return e.ID }
+func (e TL_channelFull) GetPts() int32                             { return e.Pts }
+func (e TL_channelFull) GetID() int32                              { return e.ID }
+func (e TL_chatParticipant) GetDate() int32                        { return e.Date }
+func (e TL_chatParticipantAdmin) GetDate() int32                   { return e.Date }
+func (e TL_messageEmpty) GetPeer() TL                              { return e.PeerID }
+func (e TL_messageEmpty) GetID() int32                             { return e.ID }
+func (e TL_message) GetPeer() TL                                   { return e.PeerID }
+func (e TL_message) GetDate() int32                                { return e.Date }
+func (e TL_message) GetID() int32                                  { return e.ID }
+func (e TL_messageService) GetPeer() TL                            { return e.PeerID }
+func (e TL_messageService) GetDate() int32                         { return e.Date }
+func (e TL_messageService) GetID() int32                           { return e.ID }
+func (e TL_messageActionChatMigrateTo) GetChannelID() int32        { return e.ChannelID }
+func (e TL_dialog) GetPts() int32                                  { return e.Pts }
+func (e TL_dialog) GetPeer() TL                                    { return e.Peer }
+func (e TL_dialogFolder) GetPeer() TL                              { return e.Peer }
+func (e TL_photo) GetDate() int32                                  { return e.Date }
+func (e TL_auth_exportedAuthorization) GetID() int32               { return e.ID }
+func (e TL_contacts_contacts) GetUsers() []TL                      { return e.Users }
+func (e TL_contacts_importedContacts) GetUsers() []TL              { return e.Users }
+func (e TL_contacts_blocked) GetUsers() []TL                       { return e.Users }
+func (e TL_contacts_blocked) GetChats() []TL                       { return e.Chats }
+func (e TL_contacts_blockedSlice) GetUsers() []TL                  { return e.Users }
+func (e TL_contacts_blockedSlice) GetChats() []TL                  { return e.Chats }
+func (e TL_messages_dialogs) GetUsers() []TL                       { return e.Users }
+func (e TL_messages_dialogs) GetChats() []TL                       { return e.Chats }
+func (e TL_messages_dialogsSlice) GetUsers() []TL                  { return e.Users }
+func (e TL_messages_dialogsSlice) GetChats() []TL                  { return e.Chats }
+func (e TL_messages_messages) GetUsers() []TL                      { return e.Users }
+func (e TL_messages_messages) GetChats() []TL                      { return e.Chats }
+func (e TL_messages_messagesSlice) GetUsers() []TL                 { return e.Users }
+func (e TL_messages_messagesSlice) GetChats() []TL                 { return e.Chats }
+func (e TL_messages_channelMessages) GetPts() int32                { return e.Pts }
+func (e TL_messages_channelMessages) GetUsers() []TL               { return e.Users }
+func (e TL_messages_channelMessages) GetChats() []TL               { return e.Chats }
+func (e TL_messages_chats) GetChats() []TL                         { return e.Chats }
+func (e TL_messages_chatsSlice) GetChats() []TL                    { return e.Chats }
+func (e TL_messages_chatFull) GetUsers() []TL                      { return e.Users }
+func (e TL_messages_chatFull) GetChats() []TL                      { return e.Chats }
+func (e TL_messages_affectedHistory) GetPts() int32                { return e.Pts }
+func (e TL_messages_affectedHistory) GetPtsCount() int32           { return e.PtsCount }
+func (e TL_updateNewMessage) GetPts() int32                        { return e.Pts }
+func (e TL_updateNewMessage) GetPtsCount() int32                   { return e.PtsCount }
+func (e TL_updateMessageID) GetID() int32                          { return e.ID }
+func (e TL_updateDeleteMessages) GetPts() int32                    { return e.Pts }
+func (e TL_updateDeleteMessages) GetPtsCount() int32               { return e.PtsCount }
+func (e TL_updateUserPhoto) GetDate() int32                        { return e.Date }
+func (e TL_updateNewEncryptedMessage) GetQts() int32               { return e.Qts }
+func (e TL_updateEncryption) GetDate() int32                       { return e.Date }
+func (e TL_updateEncryptedMessagesRead) GetDate() int32            { return e.Date }
+func (e TL_updateChatParticipantAdd) GetDate() int32               { return e.Date }
+func (e TL_updateReadHistoryInbox) GetPts() int32                  { return e.Pts }
+func (e TL_updateReadHistoryInbox) GetPtsCount() int32             { return e.PtsCount }
+func (e TL_updateReadHistoryInbox) GetPeer() TL                    { return e.Peer }
+func (e TL_updateReadHistoryOutbox) GetPts() int32                 { return e.Pts }
+func (e TL_updateReadHistoryOutbox) GetPtsCount() int32            { return e.PtsCount }
+func (e TL_updateReadHistoryOutbox) GetPeer() TL                   { return e.Peer }
+func (e TL_updateWebPage) GetPts() int32                           { return e.Pts }
+func (e TL_updateWebPage) GetPtsCount() int32                      { return e.PtsCount }
+func (e TL_updateReadMessagesContents) GetPts() int32              { return e.Pts }
+func (e TL_updateReadMessagesContents) GetPtsCount() int32         { return e.PtsCount }
+func (e TL_updateChannelTooLong) GetPts() int32                    { return e.Pts }
+func (e TL_updateChannelTooLong) GetChannelID() int32              { return e.ChannelID }
+func (e TL_updateChannel) GetChannelID() int32                     { return e.ChannelID }
+func (e TL_updateNewChannelMessage) GetPts() int32                 { return e.Pts }
+func (e TL_updateNewChannelMessage) GetPtsCount() int32            { return e.PtsCount }
+func (e TL_updateReadChannelInbox) GetPts() int32                  { return e.Pts }
+func (e TL_updateReadChannelInbox) GetChannelID() int32            { return e.ChannelID }
+func (e TL_updateDeleteChannelMessages) GetPts() int32             { return e.Pts }
+func (e TL_updateDeleteChannelMessages) GetPtsCount() int32        { return e.PtsCount }
+func (e TL_updateDeleteChannelMessages) GetChannelID() int32       { return e.ChannelID }
+func (e TL_updateChannelMessageViews) GetChannelID() int32         { return e.ChannelID }
+func (e TL_updateChannelMessageViews) GetID() int32                { return e.ID }
+func (e TL_updateEditChannelMessage) GetPts() int32                { return e.Pts }
+func (e TL_updateEditChannelMessage) GetPtsCount() int32           { return e.PtsCount }
+func (e TL_updateBotCallbackQuery) GetPeer() TL                    { return e.Peer }
+func (e TL_updateEditMessage) GetPts() int32                       { return e.Pts }
+func (e TL_updateEditMessage) GetPtsCount() int32                  { return e.PtsCount }
+func (e TL_updateReadChannelOutbox) GetChannelID() int32           { return e.ChannelID }
+func (e TL_updateDraftMessage) GetPeer() TL                        { return e.Peer }
+func (e TL_updateChannelWebPage) GetPts() int32                    { return e.Pts }
+func (e TL_updateChannelWebPage) GetPtsCount() int32               { return e.PtsCount }
+func (e TL_updateChannelWebPage) GetChannelID() int32              { return e.ChannelID }
+func (e TL_updateChannelReadMessagesContents) GetChannelID() int32 { return e.ChannelID }
+func (e TL_updateChannelAvailableMessages) GetChannelID() int32    { return e.ChannelID }
+func (e TL_updateChatDefaultBannedRights) GetPeer() TL             { return e.Peer }
+func (e TL_updateFolderPeers) GetPts() int32                       { return e.Pts }
+func (e TL_updateFolderPeers) GetPtsCount() int32                  { return e.PtsCount }
+func (e TL_updatePeerSettings) GetPeer() TL                        { return e.Peer }
+func (e TL_updateDeleteScheduledMessages) GetPeer() TL             { return e.Peer }
+func (e TL_updateGeoLiveViewed) GetPeer() TL                       { return e.Peer }
+func (e TL_updateDialogFilter) GetID() int32                       { return e.ID }
+func (e TL_updateChannelMessageForwards) GetChannelID() int32      { return e.ChannelID }
+func (e TL_updateChannelMessageForwards) GetID() int32             { return e.ID }
+func (e TL_updateReadChannelDiscussionInbox) GetChannelID() int32  { return e.ChannelID }
+func (e TL_updateReadChannelDiscussionOutbox) GetChannelID() int32 { return e.ChannelID }
+func (e TL_updatePeerBlocked) GetPeer() TL                         { return e.PeerID }
+func (e TL_updateChannelUserTyping) GetChannelID() int32           { return e.ChannelID }
+func (e TL_updatePinnedMessages) GetPts() int32                    { return e.Pts }
+func (e TL_updatePinnedMessages) GetPtsCount() int32               { return e.PtsCount }
+func (e TL_updatePinnedMessages) GetPeer() TL                      { return e.Peer }
+func (e TL_updatePinnedChannelMessages) GetPts() int32             { return e.Pts }
+func (e TL_updatePinnedChannelMessages) GetPtsCount() int32        { return e.PtsCount }
+func (e TL_updatePinnedChannelMessages) GetChannelID() int32       { return e.ChannelID }
+func (e TL_updatePeerHistoryTTL) GetPeer() TL                      { return e.Peer }
+func (e TL_updateChatParticipant) GetQts() int32                   { return e.Qts }
+func (e TL_updateChatParticipant) GetDate() int32                  { return e.Date }
+func (e TL_updateChannelParticipant) GetQts() int32                { return e.Qts }
+func (e TL_updateChannelParticipant) GetChannelID() int32          { return e.ChannelID }
+func (e TL_updateChannelParticipant) GetDate() int32               { return e.Date }
+func (e TL_updateBotStopped) GetQts() int32                        { return e.Qts }
+func (e TL_updateBotStopped) GetDate() int32                       { return e.Date }
+func (e TL_updates_state) GetPts() int32                           { return e.Pts }
+func (e TL_updates_state) GetQts() int32                           { return e.Qts }
+func (e TL_updates_state) GetSeq() int32                           { return e.Seq }
+func (e TL_updates_state) GetDate() int32                          { return e.Date }
+func (e TL_updates_differenceEmpty) GetSeq() int32                 { return e.Seq }
+func (e TL_updates_differenceEmpty) GetDate() int32                { return e.Date }
+func (e TL_updates_difference) GetUsers() []TL                     { return e.Users }
+func (e TL_updates_difference) GetChats() []TL                     { return e.Chats }
+func (e TL_updates_differenceSlice) GetUsers() []TL                { return e.Users }
+func (e TL_updates_differenceSlice) GetChats() []TL                { return e.Chats }
+func (e TL_updates_differenceTooLong) GetPts() int32               { return e.Pts }
+func (e TL_updateShortMessage) GetPts() int32                      { return e.Pts }
+func (e TL_updateShortMessage) GetPtsCount() int32                 { return e.PtsCount }
+func (e TL_updateShortMessage) GetDate() int32                     { return e.Date }
+func (e TL_updateShortMessage) GetID() int32                       { return e.ID }
+func (e TL_updateShortChatMessage) GetPts() int32                  { return e.Pts }
+func (e TL_updateShortChatMessage) GetPtsCount() int32             { return e.PtsCount }
+func (e TL_updateShortChatMessage) GetDate() int32                 { return e.Date }
+func (e TL_updateShortChatMessage) GetID() int32                   { return e.ID }
+func (e TL_updateShort) GetDate() int32                            { return e.Date }
+func (e TL_updatesCombined) GetSeq() int32                         { return e.Seq }
+func (e TL_updatesCombined) GetDate() int32                        { return e.Date }
+func (e TL_updatesCombined) GetUsers() []TL                        { return e.Users }
+func (e TL_updatesCombined) GetChats() []TL                        { return e.Chats }
+func (e TL_updates) GetSeq() int32                                 { return e.Seq }
+func (e TL_updates) GetDate() int32                                { return e.Date }
+func (e TL_updates) GetUsers() []TL                                { return e.Users }
+func (e TL_updates) GetChats() []TL                                { return e.Chats }
+func (e TL_updateShortSentMessage) GetPts() int32                  { return e.Pts }
+func (e TL_updateShortSentMessage) GetPtsCount() int32             { return e.PtsCount }
+func (e TL_updateShortSentMessage) GetDate() int32                 { return e.Date }
+func (e TL_updateShortSentMessage) GetID() int32                   { return e.ID }
+func (e TL_photos_photos) GetUsers() []TL                          { return e.Users }
+func (e TL_photos_photosSlice) GetUsers() []TL                     { return e.Users }
+func (e TL_photos_photo) GetUsers() []TL                           { return e.Users }
+func (e TL_dcOption) GetID() int32                                 { return e.ID }
+func (e TL_config) GetDate() int32                                 { return e.Date }
+func (e TL_help_appUpdate) GetID() int32                           { return e.ID }
+func (e TL_encryptedChatEmpty) GetID() int32                       { return e.ID }
+func (e TL_encryptedChatWaiting) GetDate() int32                   { return e.Date }
+func (e TL_encryptedChatWaiting) GetID() int32                     { return e.ID }
+func (e TL_encryptedChatRequested) GetDate() int32                 { return e.Date }
+func (e TL_encryptedChatRequested) GetID() int32                   { return e.ID }
+func (e TL_encryptedChat) GetDate() int32                          { return e.Date }
+func (e TL_encryptedChat) GetID() int32                            { return e.ID }
+func (e TL_encryptedChatDiscarded) GetID() int32                   { return e.ID }
+func (e TL_encryptedMessage) GetDate() int32                       { return e.Date }
+func (e TL_encryptedMessageService) GetDate() int32                { return e.Date }
+func (e TL_messages_sentEncryptedMessage) GetDate() int32          { return e.Date }
+func (e TL_messages_sentEncryptedFile) GetDate() int32             { return e.Date }
+func (e TL_document) GetDate() int32                               { return e.Date }
+func (e TL_notifyPeer) GetPeer() TL                                { return e.Peer }
+func (e TL_contacts_found) GetUsers() []TL                         { return e.Users }
+func (e TL_contacts_found) GetChats() []TL                         { return e.Chats }
+func (e TL_account_privacyRules) GetUsers() []TL                   { return e.Users }
+func (e TL_account_privacyRules) GetChats() []TL                   { return e.Chats }
+func (e TL_messages_affectedMessages) GetPts() int32               { return e.Pts }
+func (e TL_messages_affectedMessages) GetPtsCount() int32          { return e.PtsCount }
+func (e TL_webPagePending) GetDate() int32                         { return e.Date }
+func (e TL_receivedNotifyMessage) GetID() int32                    { return e.ID }
+func (e TL_chatInviteExported) GetDate() int32                     { return e.Date }
+func (e TL_inputChannel) GetChannelID() int32                      { return e.ChannelID }
+func (e TL_inputChannelFromMessage) GetChannelID() int32           { return e.ChannelID }
+func (e TL_contacts_resolvedPeer) GetPeer() TL                     { return e.Peer }
+func (e TL_contacts_resolvedPeer) GetUsers() []TL                  { return e.Users }
+func (e TL_contacts_resolvedPeer) GetChats() []TL                  { return e.Chats }
+func (e TL_updates_channelDifferenceEmpty) GetPts() int32          { return e.Pts }
+func (e TL_updates_channelDifferenceTooLong) GetUsers() []TL       { return e.Users }
+func (e TL_updates_channelDifferenceTooLong) GetChats() []TL       { return e.Chats }
+func (e TL_updates_channelDifference) GetPts() int32               { return e.Pts }
+func (e TL_updates_channelDifference) GetUsers() []TL              { return e.Users }
+func (e TL_updates_channelDifference) GetChats() []TL              { return e.Chats }
+func (e TL_channelParticipant) GetDate() int32                     { return e.Date }
+func (e TL_channelParticipantSelf) GetDate() int32                 { return e.Date }
+func (e TL_channelParticipantAdmin) GetDate() int32                { return e.Date }
+func (e TL_channelParticipantBanned) GetPeer() TL                  { return e.Peer }
+func (e TL_channelParticipantBanned) GetDate() int32               { return e.Date }
+func (e TL_channelParticipantLeft) GetPeer() TL                    { return e.Peer }
+func (e TL_channels_channelParticipants) GetUsers() []TL           { return e.Users }
+func (e TL_channels_channelParticipants) GetChats() []TL           { return e.Chats }
+func (e TL_channels_channelParticipant) GetUsers() []TL            { return e.Users }
+func (e TL_channels_channelParticipant) GetChats() []TL            { return e.Chats }
+func (e TL_messages_botResults) GetUsers() []TL                    { return e.Users }
+func (e TL_messageFwdHeader) GetDate() int32                       { return e.Date }
+func (e TL_messages_peerDialogs) GetUsers() []TL                   { return e.Users }
+func (e TL_messages_peerDialogs) GetChats() []TL                   { return e.Chats }
+func (e TL_topPeer) GetPeer() TL                                   { return e.Peer }
+func (e TL_contacts_topPeers) GetUsers() []TL                      { return e.Users }
+func (e TL_contacts_topPeers) GetChats() []TL                      { return e.Chats }
+func (e TL_draftMessageEmpty) GetDate() int32                      { return e.Date }
+func (e TL_draftMessage) GetDate() int32                           { return e.Date }
+func (e TL_messages_highScores) GetUsers() []TL                    { return e.Users }
+func (e TL_pageBlockEmbedPost) GetDate() int32                     { return e.Date }
+func (e TL_payments_paymentForm) GetUsers() []TL                   { return e.Users }
+func (e TL_payments_paymentReceipt) GetDate() int32                { return e.Date }
+func (e TL_payments_paymentReceipt) GetUsers() []TL                { return e.Users }
+func (e TL_phoneCallWaiting) GetDate() int32                       { return e.Date }
+func (e TL_phoneCallRequested) GetDate() int32                     { return e.Date }
+func (e TL_phoneCallAccepted) GetDate() int32                      { return e.Date }
+func (e TL_phoneCall) GetDate() int32                              { return e.Date }
+func (e TL_phone_phoneCall) GetUsers() []TL                        { return e.Users }
+func (e TL_channelAdminLogEvent) GetDate() int32                   { return e.Date }
+func (e TL_channels_adminLogResults) GetUsers() []TL               { return e.Users }
+func (e TL_channels_adminLogResults) GetChats() []TL               { return e.Chats }
+func (e TL_help_recentMeUrls) GetUsers() []TL                      { return e.Users }
+func (e TL_help_recentMeUrls) GetChats() []TL                      { return e.Chats }
+func (e TL_account_webAuthorizations) GetUsers() []TL              { return e.Users }
+func (e TL_inputMessageID) GetID() int32                           { return e.ID }
+func (e TL_inputMessageReplyTo) GetID() int32                      { return e.ID }
+func (e TL_inputMessageCallbackQuery) GetID() int32                { return e.ID }
+func (e TL_dialogPeer) GetPeer() TL                                { return e.Peer }
+func (e TL_secureFile) GetDate() int32                             { return e.Date }
+func (e TL_account_authorizationForm) GetUsers() []TL              { return e.Users }
+func (e TL_savedPhoneContact) GetDate() int32                      { return e.Date }
+func (e TL_help_userInfo) GetDate() int32                          { return e.Date }
+func (e TL_folder) GetID() int32                                   { return e.ID }
+func (e TL_folderPeer) GetPeer() TL                                { return e.Peer }
+func (e TL_peerLocated) GetPeer() TL                               { return e.Peer }
+func (e TL_messages_inactiveChats) GetUsers() []TL                 { return e.Users }
+func (e TL_messages_inactiveChats) GetChats() []TL                 { return e.Chats }
+func (e TL_messageUserVote) GetDate() int32                        { return e.Date }
+func (e TL_messageUserVoteInputOption) GetDate() int32             { return e.Date }
+func (e TL_messageUserVoteMultiple) GetDate() int32                { return e.Date }
+func (e TL_messages_votesList) GetUsers() []TL                     { return e.Users }
+func (e TL_dialogFilter) GetID() int32                             { return e.ID }
+func (e TL_help_promoData) GetPeer() TL                            { return e.Peer }
+func (e TL_help_promoData) GetUsers() []TL                         { return e.Users }
+func (e TL_help_promoData) GetChats() []TL                         { return e.Chats }
+func (e TL_stats_megagroupStats) GetUsers() []TL                   { return e.Users }
+func (e TL_messages_messageViews) GetUsers() []TL                  { return e.Users }
+func (e TL_messages_messageViews) GetChats() []TL                  { return e.Chats }
+func (e TL_messages_discussionMessage) GetUsers() []TL             { return e.Users }
+func (e TL_messages_discussionMessage) GetChats() []TL             { return e.Chats }
+func (e TL_messageReplies) GetChannelID() int32                    { return e.ChannelID }
+func (e TL_peerBlocked) GetPeer() TL                               { return e.PeerID }
+func (e TL_peerBlocked) GetDate() int32                            { return e.Date }
+func (e TL_groupCallParticipant) GetPeer() TL                      { return e.Peer }
+func (e TL_groupCallParticipant) GetDate() int32                   { return e.Date }
+func (e TL_phone_groupCall) GetUsers() []TL                        { return e.Users }
+func (e TL_phone_groupCall) GetChats() []TL                        { return e.Chats }
+func (e TL_phone_groupParticipants) GetUsers() []TL                { return e.Users }
+func (e TL_phone_groupParticipants) GetChats() []TL                { return e.Chats }
+func (e TL_messages_affectedFoundMessages) GetPts() int32          { return e.Pts }
+func (e TL_messages_affectedFoundMessages) GetPtsCount() int32     { return e.PtsCount }
+func (e TL_chatInviteImporter) GetDate() int32                     { return e.Date }
+func (e TL_messages_exportedChatInvites) GetUsers() []TL           { return e.Users }
+func (e TL_messages_exportedChatInvite) GetUsers() []TL            { return e.Users }
+func (e TL_messages_exportedChatInviteReplaced) GetUsers() []TL    { return e.Users }
+func (e TL_messages_chatInviteImporters) GetUsers() []TL           { return e.Users }
+func (e TL_messages_chatAdminsWithInvites) GetUsers() []TL         { return e.Users }
+func (e TL_phone_joinAsPeers) GetUsers() []TL                      { return e.Users }
+func (e TL_phone_joinAsPeers) GetChats() []TL                      { return e.Chats }
+func (e TL_auth_importAuthorization) GetID() int32                 { return e.ID }
+func (e TL_messages_getMessageEditData) GetID() int32              { return e.ID }
+func (e TL_messages_editMessage) GetID() int32                     { return e.ID }
+func (e TL_messages_setGameScore) GetID() int32                    { return e.ID }
+func (e TL_messages_getGameHighScores) GetID() int32               { return e.ID }
+func (e TL_messages_updatePinnedMessage) GetID() int32             { return e.ID }
+func (e TL_messages_getPollVotes) GetID() int32                    { return e.ID }
+func (e TL_messages_updateDialogFilter) GetID() int32              { return e.ID }
+func (e TL_updates_getDifference) GetPts() int32                   { return e.Pts }
+func (e TL_updates_getDifference) GetQts() int32                   { return e.Qts }
+func (e TL_updates_getDifference) GetDate() int32                  { return e.Date }
+func (e TL_updates_getChannelDifference) GetPts() int32            { return e.Pts }
+func (e TL_channels_exportMessageLink) GetID() int32               { return e.ID }
+func (e TL_message_layer122) GetPeer() TL                          { return e.PeerID }
+func (e TL_message_layer122) GetDate() int32                       { return e.Date }
+func (e TL_message_layer122) GetID() int32                         { return e.ID }
+func (e TL_messageService_layer122) GetPeer() TL                   { return e.PeerID }
+func (e TL_messageService_layer122) GetDate() int32                { return e.Date }
+func (e TL_messageService_layer122) GetID() int32                  { return e.ID }
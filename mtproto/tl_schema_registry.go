@@ -0,0 +1,14366 @@
+package mtproto
+
+func init() {
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_resPQ,
+		Name: "resPQ",
+		Type: "ResPQ",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"pq", "Pq", "string", -1},
+			{"server_public_key_fingerprints", "ServerPublicKeyFingerprints", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_resPQ{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_p_q_inner_data,
+		Name: "p_q_inner_data",
+		Type: "P_Q_inner_data",
+		Fields: []FieldInfo{
+			{"pq", "Pq", "string", -1},
+			{"p", "P", "string", -1},
+			{"q", "Q", "string", -1},
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"new_nonce", "NewNonce", "int256", -1},
+		},
+		New: func() TL { return TL_p_q_inner_data{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_p_q_inner_data_dc,
+		Name: "p_q_inner_data_dc",
+		Type: "P_Q_inner_data",
+		Fields: []FieldInfo{
+			{"pq", "Pq", "string", -1},
+			{"p", "P", "string", -1},
+			{"q", "Q", "string", -1},
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"new_nonce", "NewNonce", "int256", -1},
+			{"dc", "Dc", "int", -1},
+		},
+		New: func() TL { return TL_p_q_inner_data_dc{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_p_q_inner_data_temp,
+		Name: "p_q_inner_data_temp",
+		Type: "P_Q_inner_data",
+		Fields: []FieldInfo{
+			{"pq", "Pq", "string", -1},
+			{"p", "P", "string", -1},
+			{"q", "Q", "string", -1},
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"new_nonce", "NewNonce", "int256", -1},
+			{"expires_in", "ExpiresIn", "int", -1},
+		},
+		New: func() TL { return TL_p_q_inner_data_temp{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_p_q_inner_data_temp_dc,
+		Name: "p_q_inner_data_temp_dc",
+		Type: "P_Q_inner_data",
+		Fields: []FieldInfo{
+			{"pq", "Pq", "string", -1},
+			{"p", "P", "string", -1},
+			{"q", "Q", "string", -1},
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"new_nonce", "NewNonce", "int256", -1},
+			{"dc", "Dc", "int", -1},
+			{"expires_in", "ExpiresIn", "int", -1},
+		},
+		New: func() TL { return TL_p_q_inner_data_temp_dc{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_bind_auth_key_inner,
+		Name: "bind_auth_key_inner",
+		Type: "BindAuthKeyInner",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "long", -1},
+			{"temp_auth_key_id", "TempAuthKeyID", "long", -1},
+			{"perm_auth_key_id", "PermAuthKeyID", "long", -1},
+			{"temp_session_id", "TempSessionID", "long", -1},
+			{"expires_at", "ExpiresAt", "int", -1},
+		},
+		New: func() TL { return TL_bind_auth_key_inner{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_server_DH_params_fail,
+		Name: "server_DH_params_fail",
+		Type: "Server_DH_Params",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"new_nonce_hash", "NewNonceHash", "int128", -1},
+		},
+		New: func() TL { return TL_server_DH_params_fail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_server_DH_params_ok,
+		Name: "server_DH_params_ok",
+		Type: "Server_DH_Params",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"encrypted_answer", "EncryptedAnswer", "string", -1},
+		},
+		New: func() TL { return TL_server_DH_params_ok{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_server_DH_inner_data,
+		Name: "server_DH_inner_data",
+		Type: "Server_DH_inner_data",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"g", "G", "int", -1},
+			{"dh_prime", "DhPrime", "string", -1},
+			{"g_a", "GA", "string", -1},
+			{"server_time", "ServerTime", "int", -1},
+		},
+		New: func() TL { return TL_server_DH_inner_data{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_client_DH_inner_data,
+		Name: "client_DH_inner_data",
+		Type: "Client_DH_Inner_Data",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"retry_id", "RetryID", "long", -1},
+			{"g_b", "GB", "string", -1},
+		},
+		New: func() TL { return TL_client_DH_inner_data{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dh_gen_ok,
+		Name: "dh_gen_ok",
+		Type: "Set_client_DH_params_answer",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"new_nonce_hash1", "NewNonceHash1", "int128", -1},
+		},
+		New: func() TL { return TL_dh_gen_ok{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dh_gen_retry,
+		Name: "dh_gen_retry",
+		Type: "Set_client_DH_params_answer",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"new_nonce_hash2", "NewNonceHash2", "int128", -1},
+		},
+		New: func() TL { return TL_dh_gen_retry{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dh_gen_fail,
+		Name: "dh_gen_fail",
+		Type: "Set_client_DH_params_answer",
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"new_nonce_hash3", "NewNonceHash3", "int128", -1},
+		},
+		New: func() TL { return TL_dh_gen_fail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_destroy_auth_key_ok,
+		Name: "destroy_auth_key_ok",
+		Type: "DestroyAuthKeyRes",
+		New:  func() TL { return TL_destroy_auth_key_ok{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_destroy_auth_key_none,
+		Name: "destroy_auth_key_none",
+		Type: "DestroyAuthKeyRes",
+		New:  func() TL { return TL_destroy_auth_key_none{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_destroy_auth_key_fail,
+		Name: "destroy_auth_key_fail",
+		Type: "DestroyAuthKeyRes",
+		New:  func() TL { return TL_destroy_auth_key_fail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_req_pq,
+		Name:       "req_pq",
+		Type:       "ResPQ",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+		},
+		New: func() TL { return TL_req_pq{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_req_pq_multi,
+		Name:       "req_pq_multi",
+		Type:       "ResPQ",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+		},
+		New: func() TL { return TL_req_pq_multi{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_req_DH_params,
+		Name:       "req_DH_params",
+		Type:       "Server_DH_Params",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"p", "P", "string", -1},
+			{"q", "Q", "string", -1},
+			{"public_key_fingerprint", "PublicKeyFingerprint", "long", -1},
+			{"encrypted_data", "EncryptedData", "string", -1},
+		},
+		New: func() TL { return TL_req_DH_params{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_set_client_DH_params,
+		Name:       "set_client_DH_params",
+		Type:       "Set_client_DH_params_answer",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"nonce", "Nonce", "int128", -1},
+			{"server_nonce", "ServerNonce", "int128", -1},
+			{"encrypted_data", "EncryptedData", "string", -1},
+		},
+		New: func() TL { return TL_set_client_DH_params{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_destroy_auth_key,
+		Name:       "destroy_auth_key",
+		Type:       "DestroyAuthKeyRes",
+		IsFunction: true,
+		New:        func() TL { return TL_destroy_auth_key{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_msgs_ack,
+		Name: "msgs_ack",
+		Type: "MsgsAck",
+		Fields: []FieldInfo{
+			{"msg_ids", "MsgIds", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_msgs_ack{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_bad_msg_notification,
+		Name: "bad_msg_notification",
+		Type: "BadMsgNotification",
+		Fields: []FieldInfo{
+			{"bad_msg_id", "BadMsgID", "long", -1},
+			{"bad_msg_seqno", "BadMsgSeqno", "int", -1},
+			{"error_code", "ErrorCode", "int", -1},
+		},
+		New: func() TL { return TL_bad_msg_notification{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_bad_server_salt,
+		Name: "bad_server_salt",
+		Type: "BadMsgNotification",
+		Fields: []FieldInfo{
+			{"bad_msg_id", "BadMsgID", "long", -1},
+			{"bad_msg_seqno", "BadMsgSeqno", "int", -1},
+			{"error_code", "ErrorCode", "int", -1},
+			{"new_server_salt", "NewServerSalt", "long", -1},
+		},
+		New: func() TL { return TL_bad_server_salt{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_msgs_state_req,
+		Name: "msgs_state_req",
+		Type: "MsgsStateReq",
+		Fields: []FieldInfo{
+			{"msg_ids", "MsgIds", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_msgs_state_req{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_msgs_state_info,
+		Name: "msgs_state_info",
+		Type: "MsgsStateInfo",
+		Fields: []FieldInfo{
+			{"req_msg_id", "ReqMsgID", "long", -1},
+			{"info", "Info", "string", -1},
+		},
+		New: func() TL { return TL_msgs_state_info{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_msgs_all_info,
+		Name: "msgs_all_info",
+		Type: "MsgsAllInfo",
+		Fields: []FieldInfo{
+			{"msg_ids", "MsgIds", "Vector<long>", -1},
+			{"info", "Info", "string", -1},
+		},
+		New: func() TL { return TL_msgs_all_info{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_msg_detailed_info,
+		Name: "msg_detailed_info",
+		Type: "MsgDetailedInfo",
+		Fields: []FieldInfo{
+			{"msg_id", "MsgID", "long", -1},
+			{"answer_msg_id", "AnswerMsgID", "long", -1},
+			{"bytes", "Bytes", "int", -1},
+			{"status", "Status", "int", -1},
+		},
+		New: func() TL { return TL_msg_detailed_info{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_msg_new_detailed_info,
+		Name: "msg_new_detailed_info",
+		Type: "MsgDetailedInfo",
+		Fields: []FieldInfo{
+			{"answer_msg_id", "AnswerMsgID", "long", -1},
+			{"bytes", "Bytes", "int", -1},
+			{"status", "Status", "int", -1},
+		},
+		New: func() TL { return TL_msg_new_detailed_info{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_msg_resend_req,
+		Name: "msg_resend_req",
+		Type: "MsgResendReq",
+		Fields: []FieldInfo{
+			{"msg_ids", "MsgIds", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_msg_resend_req{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_rpc_error,
+		Name: "rpc_error",
+		Type: "RpcError",
+		Fields: []FieldInfo{
+			{"error_code", "ErrorCode", "int", -1},
+			{"error_message", "ErrorMessage", "string", -1},
+		},
+		New: func() TL { return TL_rpc_error{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_rpc_answer_unknown,
+		Name: "rpc_answer_unknown",
+		Type: "RpcDropAnswer",
+		New:  func() TL { return TL_rpc_answer_unknown{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_rpc_answer_dropped_running,
+		Name: "rpc_answer_dropped_running",
+		Type: "RpcDropAnswer",
+		New:  func() TL { return TL_rpc_answer_dropped_running{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_rpc_answer_dropped,
+		Name: "rpc_answer_dropped",
+		Type: "RpcDropAnswer",
+		Fields: []FieldInfo{
+			{"msg_id", "MsgID", "long", -1},
+			{"seq_no", "SeqNo", "int", -1},
+			{"bytes", "Bytes", "int", -1},
+		},
+		New: func() TL { return TL_rpc_answer_dropped{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_future_salt,
+		Name: "future_salt",
+		Type: "FutureSalt",
+		Fields: []FieldInfo{
+			{"valid_since", "ValidSince", "int", -1},
+			{"valid_until", "ValidUntil", "int", -1},
+			{"salt", "Salt", "long", -1},
+		},
+		New: func() TL { return TL_future_salt{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_future_salts,
+		Name: "future_salts",
+		Type: "FutureSalts",
+		Fields: []FieldInfo{
+			{"req_msg_id", "ReqMsgID", "long", -1},
+			{"now", "Now", "int", -1},
+			{"salts", "Salts", "vector<future_salt>", -1},
+		},
+		New: func() TL { return TL_future_salts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pong,
+		Name: "pong",
+		Type: "Pong",
+		Fields: []FieldInfo{
+			{"msg_id", "MsgID", "long", -1},
+			{"ping_id", "PingID", "long", -1},
+		},
+		New: func() TL { return TL_pong{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_destroy_session_ok,
+		Name: "destroy_session_ok",
+		Type: "DestroySessionRes",
+		Fields: []FieldInfo{
+			{"session_id", "SessionID", "long", -1},
+		},
+		New: func() TL { return TL_destroy_session_ok{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_destroy_session_none,
+		Name: "destroy_session_none",
+		Type: "DestroySessionRes",
+		Fields: []FieldInfo{
+			{"session_id", "SessionID", "long", -1},
+		},
+		New: func() TL { return TL_destroy_session_none{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_new_session_created,
+		Name: "new_session_created",
+		Type: "NewSession",
+		Fields: []FieldInfo{
+			{"first_msg_id", "FirstMsgID", "long", -1},
+			{"unique_id", "UniqueID", "long", -1},
+			{"server_salt", "ServerSalt", "long", -1},
+		},
+		New: func() TL { return TL_new_session_created{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_http_wait,
+		Name: "http_wait",
+		Type: "HttpWait",
+		Fields: []FieldInfo{
+			{"max_delay", "MaxDelay", "int", -1},
+			{"wait_after", "WaitAfter", "int", -1},
+			{"max_wait", "MaxWait", "int", -1},
+		},
+		New: func() TL { return TL_http_wait{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_ipPort,
+		Name: "ipPort",
+		Type: "IpPort",
+		Fields: []FieldInfo{
+			{"ipv4", "Ipv4", "int", -1},
+			{"port", "Port", "int", -1},
+		},
+		New: func() TL { return TL_ipPort{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_ipPortSecret,
+		Name: "ipPortSecret",
+		Type: "IpPort",
+		Fields: []FieldInfo{
+			{"ipv4", "Ipv4", "int", -1},
+			{"port", "Port", "int", -1},
+			{"secret", "Secret", "bytes", -1},
+		},
+		New: func() TL { return TL_ipPortSecret{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_accessPointRule,
+		Name: "accessPointRule",
+		Type: "AccessPointRule",
+		Fields: []FieldInfo{
+			{"phone_prefix_rules", "PhonePrefixRules", "string", -1},
+			{"dc_id", "DcID", "int", -1},
+			{"ips", "Ips", "vector<IpPort>", -1},
+		},
+		New: func() TL { return TL_accessPointRule{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_configSimple,
+		Name: "help.configSimple",
+		Type: "help.ConfigSimple",
+		Fields: []FieldInfo{
+			{"date", "Date", "int", -1},
+			{"expires", "Expires", "int", -1},
+			{"rules", "Rules", "vector<AccessPointRule>", -1},
+		},
+		New: func() TL { return TL_help_configSimple{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_tlsClientHello,
+		Name: "tlsClientHello",
+		Type: "TlsClientHello",
+		Fields: []FieldInfo{
+			{"blocks", "Blocks", "vector<TlsBlock>", -1},
+		},
+		New: func() TL { return TL_tlsClientHello{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_tlsBlockString,
+		Name: "tlsBlockString",
+		Type: "TlsBlock",
+		Fields: []FieldInfo{
+			{"data", "Data", "string", -1},
+		},
+		New: func() TL { return TL_tlsBlockString{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_tlsBlockRandom,
+		Name: "tlsBlockRandom",
+		Type: "TlsBlock",
+		Fields: []FieldInfo{
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_tlsBlockRandom{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_tlsBlockZero,
+		Name: "tlsBlockZero",
+		Type: "TlsBlock",
+		Fields: []FieldInfo{
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_tlsBlockZero{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_tlsBlockDomain,
+		Name: "tlsBlockDomain",
+		Type: "TlsBlock",
+		New:  func() TL { return TL_tlsBlockDomain{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_tlsBlockGrease,
+		Name: "tlsBlockGrease",
+		Type: "TlsBlock",
+		Fields: []FieldInfo{
+			{"seed", "Seed", "int", -1},
+		},
+		New: func() TL { return TL_tlsBlockGrease{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_tlsBlockPublicKey,
+		Name: "tlsBlockPublicKey",
+		Type: "TlsBlock",
+		New:  func() TL { return TL_tlsBlockPublicKey{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_tlsBlockScope,
+		Name: "tlsBlockScope",
+		Type: "TlsBlock",
+		Fields: []FieldInfo{
+			{"entries", "Entries", "Vector<TlsBlock>", -1},
+		},
+		New: func() TL { return TL_tlsBlockScope{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_rpc_drop_answer,
+		Name:       "rpc_drop_answer",
+		Type:       "RpcDropAnswer",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"req_msg_id", "ReqMsgID", "long", -1},
+		},
+		New: func() TL { return TL_rpc_drop_answer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_get_future_salts,
+		Name:       "get_future_salts",
+		Type:       "FutureSalts",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"num", "Num", "int", -1},
+		},
+		New: func() TL { return TL_get_future_salts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_ping,
+		Name:       "ping",
+		Type:       "Pong",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"ping_id", "PingID", "long", -1},
+		},
+		New: func() TL { return TL_ping{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_ping_delay_disconnect,
+		Name:       "ping_delay_disconnect",
+		Type:       "Pong",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"ping_id", "PingID", "long", -1},
+			{"disconnect_delay", "DisconnectDelay", "int", -1},
+		},
+		New: func() TL { return TL_ping_delay_disconnect{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_destroy_session,
+		Name:       "destroy_session",
+		Type:       "DestroySessionRes",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"session_id", "SessionID", "long", -1},
+		},
+		New: func() TL { return TL_destroy_session{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_boolFalse,
+		Name: "boolFalse",
+		Type: "Bool",
+		New:  func() TL { return TL_boolFalse{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_boolTrue,
+		Name: "boolTrue",
+		Type: "Bool",
+		New:  func() TL { return TL_boolTrue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_true,
+		Name: "true",
+		Type: "True",
+		New:  func() TL { return TL_true{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_error,
+		Name: "error",
+		Type: "Error",
+		Fields: []FieldInfo{
+			{"code", "Code", "int", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_error{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_null,
+		Name: "null",
+		Type: "Null",
+		New:  func() TL { return TL_null{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerEmpty,
+		Name: "inputPeerEmpty",
+		Type: "InputPeer",
+		New:  func() TL { return TL_inputPeerEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerSelf,
+		Name: "inputPeerSelf",
+		Type: "InputPeer",
+		New:  func() TL { return TL_inputPeerSelf{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerChat,
+		Name: "inputPeerChat",
+		Type: "InputPeer",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_inputPeerChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerUser,
+		Name: "inputPeerUser",
+		Type: "InputPeer",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputPeerUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerChannel,
+		Name: "inputPeerChannel",
+		Type: "InputPeer",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputPeerChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerUserFromMessage,
+		Name: "inputPeerUserFromMessage",
+		Type: "InputPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"user_id", "UserID", "int", -1},
+		},
+		New: func() TL { return TL_inputPeerUserFromMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerChannelFromMessage,
+		Name: "inputPeerChannelFromMessage",
+		Type: "InputPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"channel_id", "ChannelID", "int", -1},
+		},
+		New: func() TL { return TL_inputPeerChannelFromMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputUserEmpty,
+		Name: "inputUserEmpty",
+		Type: "InputUser",
+		New:  func() TL { return TL_inputUserEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputUserSelf,
+		Name: "inputUserSelf",
+		Type: "InputUser",
+		New:  func() TL { return TL_inputUserSelf{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputUser,
+		Name: "inputUser",
+		Type: "InputUser",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputUserFromMessage,
+		Name: "inputUserFromMessage",
+		Type: "InputUser",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"user_id", "UserID", "int", -1},
+		},
+		New: func() TL { return TL_inputUserFromMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPhoneContact,
+		Name: "inputPhoneContact",
+		Type: "InputContact",
+		Fields: []FieldInfo{
+			{"client_id", "ClientID", "long", -1},
+			{"phone", "Phone", "string", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+		},
+		New: func() TL { return TL_inputPhoneContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputFile,
+		Name: "inputFile",
+		Type: "InputFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"parts", "Parts", "int", -1},
+			{"name", "Name", "string", -1},
+			{"md5_checksum", "Md5Checksum", "string", -1},
+		},
+		New: func() TL { return TL_inputFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputFileBig,
+		Name: "inputFileBig",
+		Type: "InputFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"parts", "Parts", "int", -1},
+			{"name", "Name", "string", -1},
+		},
+		New: func() TL { return TL_inputFileBig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaEmpty,
+		Name: "inputMediaEmpty",
+		Type: "InputMedia",
+		New:  func() TL { return TL_inputMediaEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaUploadedPhoto,
+		Name: "inputMediaUploadedPhoto",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"file", "File", "InputFile", -1},
+			{"stickers", "Stickers", "Vector<InputDocument>", 0},
+			{"ttl_seconds", "TtlSeconds", "int", 1},
+		},
+		New: func() TL { return TL_inputMediaUploadedPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaPhoto,
+		Name: "inputMediaPhoto",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "InputPhoto", -1},
+			{"ttl_seconds", "TtlSeconds", "int", 0},
+		},
+		New: func() TL { return TL_inputMediaPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaGeoPoint,
+		Name: "inputMediaGeoPoint",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"geo_point", "GeoPoint", "InputGeoPoint", -1},
+		},
+		New: func() TL { return TL_inputMediaGeoPoint{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaContact,
+		Name: "inputMediaContact",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+			{"vcard", "Vcard", "string", -1},
+		},
+		New: func() TL { return TL_inputMediaContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaUploadedDocument,
+		Name: "inputMediaUploadedDocument",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"nosound_video", "NosoundVideo", "true", 3},
+			{"force_file", "ForceFile", "true", 4},
+			{"file", "File", "InputFile", -1},
+			{"thumb", "Thumb", "InputFile", 2},
+			{"mime_type", "MimeType", "string", -1},
+			{"attributes", "Attributes", "Vector<DocumentAttribute>", -1},
+			{"stickers", "Stickers", "Vector<InputDocument>", 0},
+			{"ttl_seconds", "TtlSeconds", "int", 1},
+		},
+		New: func() TL { return TL_inputMediaUploadedDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaDocument,
+		Name: "inputMediaDocument",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "InputDocument", -1},
+			{"ttl_seconds", "TtlSeconds", "int", 0},
+			{"query", "Query", "string", 1},
+		},
+		New: func() TL { return TL_inputMediaDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaVenue,
+		Name: "inputMediaVenue",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"geo_point", "GeoPoint", "InputGeoPoint", -1},
+			{"title", "Title", "string", -1},
+			{"address", "Address", "string", -1},
+			{"provider", "Provider", "string", -1},
+			{"venue_id", "VenueID", "string", -1},
+			{"venue_type", "VenueType", "string", -1},
+		},
+		New: func() TL { return TL_inputMediaVenue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaPhotoExternal,
+		Name: "inputMediaPhotoExternal",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"url", "Url", "string", -1},
+			{"ttl_seconds", "TtlSeconds", "int", 0},
+		},
+		New: func() TL { return TL_inputMediaPhotoExternal{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaDocumentExternal,
+		Name: "inputMediaDocumentExternal",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"url", "Url", "string", -1},
+			{"ttl_seconds", "TtlSeconds", "int", 0},
+		},
+		New: func() TL { return TL_inputMediaDocumentExternal{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaGame,
+		Name: "inputMediaGame",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"id", "ID", "InputGame", -1},
+		},
+		New: func() TL { return TL_inputMediaGame{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaInvoice,
+		Name: "inputMediaInvoice",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"title", "Title", "string", -1},
+			{"description", "Description", "string", -1},
+			{"photo", "Photo", "InputWebDocument", 0},
+			{"invoice", "Invoice", "Invoice", -1},
+			{"payload", "Payload", "bytes", -1},
+			{"provider", "Provider", "string", -1},
+			{"provider_data", "ProviderData", "DataJSON", -1},
+			{"start_param", "StartParam", "string", -1},
+		},
+		New: func() TL { return TL_inputMediaInvoice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaGeoLive,
+		Name: "inputMediaGeoLive",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"stopped", "Stopped", "true", 0},
+			{"geo_point", "GeoPoint", "InputGeoPoint", -1},
+			{"heading", "Heading", "int", 2},
+			{"period", "Period", "int", 1},
+			{"proximity_notification_radius", "ProximityNotificationRadius", "int", 3},
+		},
+		New: func() TL { return TL_inputMediaGeoLive{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaPoll,
+		Name: "inputMediaPoll",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"poll", "Poll", "Poll", -1},
+			{"correct_answers", "CorrectAnswers", "Vector<bytes>", 0},
+			{"solution", "Solution", "string", 1},
+			{"solution_entities", "SolutionEntities", "Vector<MessageEntity>", 1},
+		},
+		New: func() TL { return TL_inputMediaPoll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMediaDice,
+		Name: "inputMediaDice",
+		Type: "InputMedia",
+		Fields: []FieldInfo{
+			{"emoticon", "Emoticon", "string", -1},
+		},
+		New: func() TL { return TL_inputMediaDice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputChatPhotoEmpty,
+		Name: "inputChatPhotoEmpty",
+		Type: "InputChatPhoto",
+		New:  func() TL { return TL_inputChatPhotoEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputChatUploadedPhoto,
+		Name: "inputChatUploadedPhoto",
+		Type: "InputChatPhoto",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"file", "File", "InputFile", 0},
+			{"video", "Video", "InputFile", 1},
+			{"video_start_ts", "VideoStartTs", "double", 2},
+		},
+		New: func() TL { return TL_inputChatUploadedPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputChatPhoto,
+		Name: "inputChatPhoto",
+		Type: "InputChatPhoto",
+		Fields: []FieldInfo{
+			{"id", "ID", "InputPhoto", -1},
+		},
+		New: func() TL { return TL_inputChatPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputGeoPointEmpty,
+		Name: "inputGeoPointEmpty",
+		Type: "InputGeoPoint",
+		New:  func() TL { return TL_inputGeoPointEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputGeoPoint,
+		Name: "inputGeoPoint",
+		Type: "InputGeoPoint",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"lat", "Lat", "double", -1},
+			{"long", "Long", "double", -1},
+			{"accuracy_radius", "AccuracyRadius", "int", 0},
+		},
+		New: func() TL { return TL_inputGeoPoint{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPhotoEmpty,
+		Name: "inputPhotoEmpty",
+		Type: "InputPhoto",
+		New:  func() TL { return TL_inputPhotoEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPhoto,
+		Name: "inputPhoto",
+		Type: "InputPhoto",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"file_reference", "FileReference", "bytes", -1},
+		},
+		New: func() TL { return TL_inputPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputFileLocation,
+		Name: "inputFileLocation",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"volume_id", "VolumeID", "long", -1},
+			{"local_id", "LocalID", "int", -1},
+			{"secret", "Secret", "long", -1},
+			{"file_reference", "FileReference", "bytes", -1},
+		},
+		New: func() TL { return TL_inputFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputEncryptedFileLocation,
+		Name: "inputEncryptedFileLocation",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputEncryptedFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputDocumentFileLocation,
+		Name: "inputDocumentFileLocation",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"file_reference", "FileReference", "bytes", -1},
+			{"thumb_size", "ThumbSize", "string", -1},
+		},
+		New: func() TL { return TL_inputDocumentFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputSecureFileLocation,
+		Name: "inputSecureFileLocation",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputSecureFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputTakeoutFileLocation,
+		Name: "inputTakeoutFileLocation",
+		Type: "InputFileLocation",
+		New:  func() TL { return TL_inputTakeoutFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPhotoFileLocation,
+		Name: "inputPhotoFileLocation",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"file_reference", "FileReference", "bytes", -1},
+			{"thumb_size", "ThumbSize", "string", -1},
+		},
+		New: func() TL { return TL_inputPhotoFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPhotoLegacyFileLocation,
+		Name: "inputPhotoLegacyFileLocation",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"file_reference", "FileReference", "bytes", -1},
+			{"volume_id", "VolumeID", "long", -1},
+			{"local_id", "LocalID", "int", -1},
+			{"secret", "Secret", "long", -1},
+		},
+		New: func() TL { return TL_inputPhotoLegacyFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerPhotoFileLocation,
+		Name: "inputPeerPhotoFileLocation",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"big", "Big", "true", 0},
+			{"peer", "Peer", "InputPeer", -1},
+			{"volume_id", "VolumeID", "long", -1},
+			{"local_id", "LocalID", "int", -1},
+		},
+		New: func() TL { return TL_inputPeerPhotoFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickerSetThumb,
+		Name: "inputStickerSetThumb",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"stickerset", "Stickerset", "InputStickerSet", -1},
+			{"volume_id", "VolumeID", "long", -1},
+			{"local_id", "LocalID", "int", -1},
+		},
+		New: func() TL { return TL_inputStickerSetThumb{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputGroupCallStream,
+		Name: "inputGroupCallStream",
+		Type: "InputFileLocation",
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+			{"time_ms", "TimeMs", "long", -1},
+			{"scale", "Scale", "int", -1},
+		},
+		New: func() TL { return TL_inputGroupCallStream{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_peerUser,
+		Name: "peerUser",
+		Type: "Peer",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+		},
+		New: func() TL { return TL_peerUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_peerChat,
+		Name: "peerChat",
+		Type: "Peer",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_peerChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_peerChannel,
+		Name: "peerChannel",
+		Type: "Peer",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+		},
+		New: func() TL { return TL_peerChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_fileUnknown,
+		Name: "storage.fileUnknown",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_fileUnknown{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_filePartial,
+		Name: "storage.filePartial",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_filePartial{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_fileJpeg,
+		Name: "storage.fileJpeg",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_fileJpeg{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_fileGif,
+		Name: "storage.fileGif",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_fileGif{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_filePng,
+		Name: "storage.filePng",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_filePng{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_filePdf,
+		Name: "storage.filePdf",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_filePdf{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_fileMp3,
+		Name: "storage.fileMp3",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_fileMp3{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_fileMov,
+		Name: "storage.fileMov",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_fileMov{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_fileMp4,
+		Name: "storage.fileMp4",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_fileMp4{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_storage_fileWebp,
+		Name: "storage.fileWebp",
+		Type: "storage.FileType",
+		New:  func() TL { return TL_storage_fileWebp{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userEmpty,
+		Name: "userEmpty",
+		Type: "User",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_userEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_user,
+		Name: "user",
+		Type: "User",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"self", "Self", "true", 10},
+			{"contact", "Contact", "true", 11},
+			{"mutual_contact", "MutualContact", "true", 12},
+			{"deleted", "Deleted", "true", 13},
+			{"bot", "Bot", "true", 14},
+			{"bot_chat_history", "BotChatHistory", "true", 15},
+			{"bot_nochats", "BotNochats", "true", 16},
+			{"verified", "Verified", "true", 17},
+			{"restricted", "Restricted", "true", 18},
+			{"min", "Min", "true", 20},
+			{"bot_inline_geo", "BotInlineGeo", "true", 21},
+			{"support", "Support", "true", 23},
+			{"scam", "Scam", "true", 24},
+			{"apply_min_photo", "ApplyMinPhoto", "true", 25},
+			{"fake", "Fake", "true", 26},
+			{"id", "ID", "int", -1},
+			{"access_hash", "AccessHash", "long", 0},
+			{"first_name", "FirstName", "string", 1},
+			{"last_name", "LastName", "string", 2},
+			{"username", "Username", "string", 3},
+			{"phone", "Phone", "string", 4},
+			{"photo", "Photo", "UserProfilePhoto", 5},
+			{"status", "Status", "UserStatus", 6},
+			{"bot_info_version", "BotInfoVersion", "int", 14},
+			{"restriction_reason", "RestrictionReason", "Vector<RestrictionReason>", 18},
+			{"bot_inline_placeholder", "BotInlinePlaceholder", "string", 19},
+			{"lang_code", "LangCode", "string", 22},
+		},
+		New: func() TL { return TL_user{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userProfilePhotoEmpty,
+		Name: "userProfilePhotoEmpty",
+		Type: "UserProfilePhoto",
+		New:  func() TL { return TL_userProfilePhotoEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userProfilePhoto,
+		Name: "userProfilePhoto",
+		Type: "UserProfilePhoto",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"has_video", "HasVideo", "true", 0},
+			{"photo_id", "PhotoID", "long", -1},
+			{"photo_small", "PhotoSmall", "FileLocation", -1},
+			{"photo_big", "PhotoBig", "FileLocation", -1},
+			{"dc_id", "DcID", "int", -1},
+		},
+		New: func() TL { return TL_userProfilePhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userStatusEmpty,
+		Name: "userStatusEmpty",
+		Type: "UserStatus",
+		New:  func() TL { return TL_userStatusEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userStatusOnline,
+		Name: "userStatusOnline",
+		Type: "UserStatus",
+		Fields: []FieldInfo{
+			{"expires", "Expires", "int", -1},
+		},
+		New: func() TL { return TL_userStatusOnline{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userStatusOffline,
+		Name: "userStatusOffline",
+		Type: "UserStatus",
+		Fields: []FieldInfo{
+			{"was_online", "WasOnline", "int", -1},
+		},
+		New: func() TL { return TL_userStatusOffline{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userStatusRecently,
+		Name: "userStatusRecently",
+		Type: "UserStatus",
+		New:  func() TL { return TL_userStatusRecently{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userStatusLastWeek,
+		Name: "userStatusLastWeek",
+		Type: "UserStatus",
+		New:  func() TL { return TL_userStatusLastWeek{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userStatusLastMonth,
+		Name: "userStatusLastMonth",
+		Type: "UserStatus",
+		New:  func() TL { return TL_userStatusLastMonth{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatEmpty,
+		Name: "chatEmpty",
+		Type: "Chat",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_chatEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chat,
+		Name: "chat",
+		Type: "Chat",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"creator", "Creator", "true", 0},
+			{"kicked", "Kicked", "true", 1},
+			{"left", "Left", "true", 2},
+			{"deactivated", "Deactivated", "true", 5},
+			{"call_active", "CallActive", "true", 23},
+			{"call_not_empty", "CallNotEmpty", "true", 24},
+			{"id", "ID", "int", -1},
+			{"title", "Title", "string", -1},
+			{"photo", "Photo", "ChatPhoto", -1},
+			{"participants_count", "ParticipantsCount", "int", -1},
+			{"date", "Date", "int", -1},
+			{"version", "Version", "int", -1},
+			{"migrated_to", "MigratedTo", "InputChannel", 6},
+			{"admin_rights", "AdminRights", "ChatAdminRights", 14},
+			{"default_banned_rights", "DefaultBannedRights", "ChatBannedRights", 18},
+		},
+		New: func() TL { return TL_chat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatForbidden,
+		Name: "chatForbidden",
+		Type: "Chat",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+			{"title", "Title", "string", -1},
+		},
+		New: func() TL { return TL_chatForbidden{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channel,
+		Name: "channel",
+		Type: "Chat",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"creator", "Creator", "true", 0},
+			{"left", "Left", "true", 2},
+			{"broadcast", "Broadcast", "true", 5},
+			{"verified", "Verified", "true", 7},
+			{"megagroup", "Megagroup", "true", 8},
+			{"restricted", "Restricted", "true", 9},
+			{"signatures", "Signatures", "true", 11},
+			{"min", "Min", "true", 12},
+			{"scam", "Scam", "true", 19},
+			{"has_link", "HasLink", "true", 20},
+			{"has_geo", "HasGeo", "true", 21},
+			{"slowmode_enabled", "SlowmodeEnabled", "true", 22},
+			{"call_active", "CallActive", "true", 23},
+			{"call_not_empty", "CallNotEmpty", "true", 24},
+			{"fake", "Fake", "true", 25},
+			{"gigagroup", "Gigagroup", "true", 26},
+			{"id", "ID", "int", -1},
+			{"access_hash", "AccessHash", "long", 13},
+			{"title", "Title", "string", -1},
+			{"username", "Username", "string", 6},
+			{"photo", "Photo", "ChatPhoto", -1},
+			{"date", "Date", "int", -1},
+			{"version", "Version", "int", -1},
+			{"restriction_reason", "RestrictionReason", "Vector<RestrictionReason>", 9},
+			{"admin_rights", "AdminRights", "ChatAdminRights", 14},
+			{"banned_rights", "BannedRights", "ChatBannedRights", 15},
+			{"default_banned_rights", "DefaultBannedRights", "ChatBannedRights", 18},
+			{"participants_count", "ParticipantsCount", "int", 17},
+		},
+		New: func() TL { return TL_channel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelForbidden,
+		Name: "channelForbidden",
+		Type: "Chat",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"broadcast", "Broadcast", "true", 5},
+			{"megagroup", "Megagroup", "true", 8},
+			{"id", "ID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"title", "Title", "string", -1},
+			{"until_date", "UntilDate", "int", 16},
+		},
+		New: func() TL { return TL_channelForbidden{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatFull,
+		Name: "chatFull",
+		Type: "ChatFull",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"can_set_username", "CanSetUsername", "true", 7},
+			{"has_scheduled", "HasScheduled", "true", 8},
+			{"id", "ID", "int", -1},
+			{"about", "About", "string", -1},
+			{"participants", "Participants", "ChatParticipants", -1},
+			{"chat_photo", "ChatPhoto", "Photo", 2},
+			{"notify_settings", "NotifySettings", "PeerNotifySettings", -1},
+			{"exported_invite", "ExportedInvite", "ExportedChatInvite", 13},
+			{"bot_info", "BotInfo", "Vector<BotInfo>", 3},
+			{"pinned_msg_id", "PinnedMsgID", "int", 6},
+			{"folder_id", "FolderID", "int", 11},
+			{"call", "Call", "InputGroupCall", 12},
+			{"ttl_period", "TtlPeriod", "int", 14},
+			{"groupcall_default_join_as", "GroupcallDefaultJoinAs", "Peer", 15},
+		},
+		New: func() TL { return TL_chatFull{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelFull,
+		Name: "channelFull",
+		Type: "ChatFull",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"can_view_participants", "CanViewParticipants", "true", 3},
+			{"can_set_username", "CanSetUsername", "true", 6},
+			{"can_set_stickers", "CanSetStickers", "true", 7},
+			{"hidden_prehistory", "HiddenPrehistory", "true", 10},
+			{"can_set_location", "CanSetLocation", "true", 16},
+			{"has_scheduled", "HasScheduled", "true", 19},
+			{"can_view_stats", "CanViewStats", "true", 20},
+			{"blocked", "Blocked", "true", 22},
+			{"id", "ID", "int", -1},
+			{"about", "About", "string", -1},
+			{"participants_count", "ParticipantsCount", "int", 0},
+			{"admins_count", "AdminsCount", "int", 1},
+			{"kicked_count", "KickedCount", "int", 2},
+			{"banned_count", "BannedCount", "int", 2},
+			{"online_count", "OnlineCount", "int", 13},
+			{"read_inbox_max_id", "ReadInboxMaxID", "int", -1},
+			{"read_outbox_max_id", "ReadOutboxMaxID", "int", -1},
+			{"unread_count", "UnreadCount", "int", -1},
+			{"chat_photo", "ChatPhoto", "Photo", -1},
+			{"notify_settings", "NotifySettings", "PeerNotifySettings", -1},
+			{"exported_invite", "ExportedInvite", "ExportedChatInvite", 23},
+			{"bot_info", "BotInfo", "Vector<BotInfo>", -1},
+			{"migrated_from_chat_id", "MigratedFromChatID", "int", 4},
+			{"migrated_from_max_id", "MigratedFromMaxID", "int", 4},
+			{"pinned_msg_id", "PinnedMsgID", "int", 5},
+			{"stickerset", "Stickerset", "StickerSet", 8},
+			{"available_min_id", "AvailableMinID", "int", 9},
+			{"folder_id", "FolderID", "int", 11},
+			{"linked_chat_id", "LinkedChatID", "int", 14},
+			{"location", "Location", "ChannelLocation", 15},
+			{"slowmode_seconds", "SlowmodeSeconds", "int", 17},
+			{"slowmode_next_send_date", "SlowmodeNextSendDate", "int", 18},
+			{"stats_dc", "StatsDc", "int", 12},
+			{"pts", "Pts", "int", -1},
+			{"call", "Call", "InputGroupCall", 21},
+			{"ttl_period", "TtlPeriod", "int", 24},
+			{"pending_suggestions", "PendingSuggestions", "Vector<string>", 25},
+			{"groupcall_default_join_as", "GroupcallDefaultJoinAs", "Peer", 26},
+		},
+		New: func() TL { return TL_channelFull{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatParticipant,
+		Name: "chatParticipant",
+		Type: "ChatParticipant",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"inviter_id", "InviterID", "int", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_chatParticipant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatParticipantCreator,
+		Name: "chatParticipantCreator",
+		Type: "ChatParticipant",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+		},
+		New: func() TL { return TL_chatParticipantCreator{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatParticipantAdmin,
+		Name: "chatParticipantAdmin",
+		Type: "ChatParticipant",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"inviter_id", "InviterID", "int", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_chatParticipantAdmin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatParticipantsForbidden,
+		Name: "chatParticipantsForbidden",
+		Type: "ChatParticipants",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"chat_id", "ChatID", "int", -1},
+			{"self_participant", "SelfParticipant", "ChatParticipant", 0},
+		},
+		New: func() TL { return TL_chatParticipantsForbidden{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatParticipants,
+		Name: "chatParticipants",
+		Type: "ChatParticipants",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"participants", "Participants", "Vector<ChatParticipant>", -1},
+			{"version", "Version", "int", -1},
+		},
+		New: func() TL { return TL_chatParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatPhotoEmpty,
+		Name: "chatPhotoEmpty",
+		Type: "ChatPhoto",
+		New:  func() TL { return TL_chatPhotoEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatPhoto,
+		Name: "chatPhoto",
+		Type: "ChatPhoto",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"has_video", "HasVideo", "true", 0},
+			{"photo_small", "PhotoSmall", "FileLocation", -1},
+			{"photo_big", "PhotoBig", "FileLocation", -1},
+			{"dc_id", "DcID", "int", -1},
+		},
+		New: func() TL { return TL_chatPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEmpty,
+		Name: "messageEmpty",
+		Type: "Message",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "int", -1},
+			{"peer_id", "PeerID", "Peer", 0},
+		},
+		New: func() TL { return TL_messageEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_message,
+		Name: "message",
+		Type: "Message",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"out", "Out", "true", 1},
+			{"mentioned", "Mentioned", "true", 4},
+			{"media_unread", "MediaUnread", "true", 5},
+			{"silent", "Silent", "true", 13},
+			{"post", "Post", "true", 14},
+			{"from_scheduled", "FromScheduled", "true", 18},
+			{"legacy", "Legacy", "true", 19},
+			{"edit_hide", "EditHide", "true", 21},
+			{"pinned", "Pinned", "true", 24},
+			{"id", "ID", "int", -1},
+			{"from_id", "FromID", "Peer", 8},
+			{"peer_id", "PeerID", "Peer", -1},
+			{"fwd_from", "FwdFrom", "MessageFwdHeader", 2},
+			{"via_bot_id", "ViaBotID", "int", 11},
+			{"reply_to", "ReplyTo", "MessageReplyHeader", 3},
+			{"date", "Date", "int", -1},
+			{"message", "Message", "string", -1},
+			{"media", "Media", "MessageMedia", 9},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 6},
+			{"entities", "Entities", "Vector<MessageEntity>", 7},
+			{"views", "Views", "int", 10},
+			{"forwards", "Forwards", "int", 10},
+			{"replies", "Replies", "MessageReplies", 23},
+			{"edit_date", "EditDate", "int", 15},
+			{"post_author", "PostAuthor", "string", 16},
+			{"grouped_id", "GroupedID", "long", 17},
+			{"restriction_reason", "RestrictionReason", "Vector<RestrictionReason>", 22},
+			{"ttl_period", "TtlPeriod", "int", 25},
+		},
+		New: func() TL { return TL_message{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageService,
+		Name: "messageService",
+		Type: "Message",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"out", "Out", "true", 1},
+			{"mentioned", "Mentioned", "true", 4},
+			{"media_unread", "MediaUnread", "true", 5},
+			{"silent", "Silent", "true", 13},
+			{"post", "Post", "true", 14},
+			{"legacy", "Legacy", "true", 19},
+			{"id", "ID", "int", -1},
+			{"from_id", "FromID", "Peer", 8},
+			{"peer_id", "PeerID", "Peer", -1},
+			{"reply_to", "ReplyTo", "MessageReplyHeader", 3},
+			{"date", "Date", "int", -1},
+			{"action", "Action", "MessageAction", -1},
+			{"ttl_period", "TtlPeriod", "int", 25},
+		},
+		New: func() TL { return TL_messageService{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaEmpty,
+		Name: "messageMediaEmpty",
+		Type: "MessageMedia",
+		New:  func() TL { return TL_messageMediaEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaPhoto,
+		Name: "messageMediaPhoto",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"photo", "Photo", "Photo", 0},
+			{"ttl_seconds", "TtlSeconds", "int", 2},
+		},
+		New: func() TL { return TL_messageMediaPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaGeo,
+		Name: "messageMediaGeo",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"geo", "Geo", "GeoPoint", -1},
+		},
+		New: func() TL { return TL_messageMediaGeo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaContact,
+		Name: "messageMediaContact",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+			{"vcard", "Vcard", "string", -1},
+			{"user_id", "UserID", "int", -1},
+		},
+		New: func() TL { return TL_messageMediaContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaUnsupported,
+		Name: "messageMediaUnsupported",
+		Type: "MessageMedia",
+		New:  func() TL { return TL_messageMediaUnsupported{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaDocument,
+		Name: "messageMediaDocument",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"document", "Document", "Document", 0},
+			{"ttl_seconds", "TtlSeconds", "int", 2},
+		},
+		New: func() TL { return TL_messageMediaDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaWebPage,
+		Name: "messageMediaWebPage",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"webpage", "Webpage", "WebPage", -1},
+		},
+		New: func() TL { return TL_messageMediaWebPage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaVenue,
+		Name: "messageMediaVenue",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"geo", "Geo", "GeoPoint", -1},
+			{"title", "Title", "string", -1},
+			{"address", "Address", "string", -1},
+			{"provider", "Provider", "string", -1},
+			{"venue_id", "VenueID", "string", -1},
+			{"venue_type", "VenueType", "string", -1},
+		},
+		New: func() TL { return TL_messageMediaVenue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaGame,
+		Name: "messageMediaGame",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"game", "Game", "Game", -1},
+		},
+		New: func() TL { return TL_messageMediaGame{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaInvoice,
+		Name: "messageMediaInvoice",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"shipping_address_requested", "ShippingAddressRequested", "true", 1},
+			{"test", "Test", "true", 3},
+			{"title", "Title", "string", -1},
+			{"description", "Description", "string", -1},
+			{"photo", "Photo", "WebDocument", 0},
+			{"receipt_msg_id", "ReceiptMsgID", "int", 2},
+			{"currency", "Currency", "string", -1},
+			{"total_amount", "TotalAmount", "long", -1},
+			{"start_param", "StartParam", "string", -1},
+		},
+		New: func() TL { return TL_messageMediaInvoice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaGeoLive,
+		Name: "messageMediaGeoLive",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"geo", "Geo", "GeoPoint", -1},
+			{"heading", "Heading", "int", 0},
+			{"period", "Period", "int", -1},
+			{"proximity_notification_radius", "ProximityNotificationRadius", "int", 1},
+		},
+		New: func() TL { return TL_messageMediaGeoLive{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaPoll,
+		Name: "messageMediaPoll",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"poll", "Poll", "Poll", -1},
+			{"results", "Results", "PollResults", -1},
+		},
+		New: func() TL { return TL_messageMediaPoll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageMediaDice,
+		Name: "messageMediaDice",
+		Type: "MessageMedia",
+		Fields: []FieldInfo{
+			{"value", "Value", "int", -1},
+			{"emoticon", "Emoticon", "string", -1},
+		},
+		New: func() TL { return TL_messageMediaDice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionEmpty,
+		Name: "messageActionEmpty",
+		Type: "MessageAction",
+		New:  func() TL { return TL_messageActionEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChatCreate,
+		Name: "messageActionChatCreate",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"title", "Title", "string", -1},
+			{"users", "Users", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messageActionChatCreate{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChatEditTitle,
+		Name: "messageActionChatEditTitle",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"title", "Title", "string", -1},
+		},
+		New: func() TL { return TL_messageActionChatEditTitle{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChatEditPhoto,
+		Name: "messageActionChatEditPhoto",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"photo", "Photo", "Photo", -1},
+		},
+		New: func() TL { return TL_messageActionChatEditPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChatDeletePhoto,
+		Name: "messageActionChatDeletePhoto",
+		Type: "MessageAction",
+		New:  func() TL { return TL_messageActionChatDeletePhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChatAddUser,
+		Name: "messageActionChatAddUser",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"users", "Users", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messageActionChatAddUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChatDeleteUser,
+		Name: "messageActionChatDeleteUser",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+		},
+		New: func() TL { return TL_messageActionChatDeleteUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChatJoinedByLink,
+		Name: "messageActionChatJoinedByLink",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"inviter_id", "InviterID", "int", -1},
+		},
+		New: func() TL { return TL_messageActionChatJoinedByLink{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChannelCreate,
+		Name: "messageActionChannelCreate",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"title", "Title", "string", -1},
+		},
+		New: func() TL { return TL_messageActionChannelCreate{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChatMigrateTo,
+		Name: "messageActionChatMigrateTo",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+		},
+		New: func() TL { return TL_messageActionChatMigrateTo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionChannelMigrateFrom,
+		Name: "messageActionChannelMigrateFrom",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"title", "Title", "string", -1},
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_messageActionChannelMigrateFrom{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionPinMessage,
+		Name: "messageActionPinMessage",
+		Type: "MessageAction",
+		New:  func() TL { return TL_messageActionPinMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionHistoryClear,
+		Name: "messageActionHistoryClear",
+		Type: "MessageAction",
+		New:  func() TL { return TL_messageActionHistoryClear{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionGameScore,
+		Name: "messageActionGameScore",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"game_id", "GameID", "long", -1},
+			{"score", "Score", "int", -1},
+		},
+		New: func() TL { return TL_messageActionGameScore{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionPaymentSentMe,
+		Name: "messageActionPaymentSentMe",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"currency", "Currency", "string", -1},
+			{"total_amount", "TotalAmount", "long", -1},
+			{"payload", "Payload", "bytes", -1},
+			{"info", "Info", "PaymentRequestedInfo", 0},
+			{"shipping_option_id", "ShippingOptionID", "string", 1},
+			{"charge", "Charge", "PaymentCharge", -1},
+		},
+		New: func() TL { return TL_messageActionPaymentSentMe{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionPaymentSent,
+		Name: "messageActionPaymentSent",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"currency", "Currency", "string", -1},
+			{"total_amount", "TotalAmount", "long", -1},
+		},
+		New: func() TL { return TL_messageActionPaymentSent{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionPhoneCall,
+		Name: "messageActionPhoneCall",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"video", "Video", "true", 2},
+			{"call_id", "CallID", "long", -1},
+			{"reason", "Reason", "PhoneCallDiscardReason", 0},
+			{"duration", "Duration", "int", 1},
+		},
+		New: func() TL { return TL_messageActionPhoneCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionScreenshotTaken,
+		Name: "messageActionScreenshotTaken",
+		Type: "MessageAction",
+		New:  func() TL { return TL_messageActionScreenshotTaken{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionCustomAction,
+		Name: "messageActionCustomAction",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"message", "Message", "string", -1},
+		},
+		New: func() TL { return TL_messageActionCustomAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionBotAllowed,
+		Name: "messageActionBotAllowed",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"domain", "Domain", "string", -1},
+		},
+		New: func() TL { return TL_messageActionBotAllowed{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionSecureValuesSentMe,
+		Name: "messageActionSecureValuesSentMe",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"values", "Values", "Vector<SecureValue>", -1},
+			{"credentials", "Credentials", "SecureCredentialsEncrypted", -1},
+		},
+		New: func() TL { return TL_messageActionSecureValuesSentMe{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionSecureValuesSent,
+		Name: "messageActionSecureValuesSent",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"types", "Types", "Vector<SecureValueType>", -1},
+		},
+		New: func() TL { return TL_messageActionSecureValuesSent{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionContactSignUp,
+		Name: "messageActionContactSignUp",
+		Type: "MessageAction",
+		New:  func() TL { return TL_messageActionContactSignUp{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionGeoProximityReached,
+		Name: "messageActionGeoProximityReached",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"from_id", "FromID", "Peer", -1},
+			{"to_id", "ToID", "Peer", -1},
+			{"distance", "Distance", "int", -1},
+		},
+		New: func() TL { return TL_messageActionGeoProximityReached{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionGroupCall,
+		Name: "messageActionGroupCall",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"call", "Call", "InputGroupCall", -1},
+			{"duration", "Duration", "int", 0},
+		},
+		New: func() TL { return TL_messageActionGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionInviteToGroupCall,
+		Name: "messageActionInviteToGroupCall",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+			{"users", "Users", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messageActionInviteToGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageActionSetMessagesTTL,
+		Name: "messageActionSetMessagesTTL",
+		Type: "MessageAction",
+		Fields: []FieldInfo{
+			{"period", "Period", "int", -1},
+		},
+		New: func() TL { return TL_messageActionSetMessagesTTL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dialog,
+		Name: "dialog",
+		Type: "Dialog",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"pinned", "Pinned", "true", 2},
+			{"unread_mark", "UnreadMark", "true", 3},
+			{"peer", "Peer", "Peer", -1},
+			{"top_message", "TopMessage", "int", -1},
+			{"read_inbox_max_id", "ReadInboxMaxID", "int", -1},
+			{"read_outbox_max_id", "ReadOutboxMaxID", "int", -1},
+			{"unread_count", "UnreadCount", "int", -1},
+			{"unread_mentions_count", "UnreadMentionsCount", "int", -1},
+			{"notify_settings", "NotifySettings", "PeerNotifySettings", -1},
+			{"pts", "Pts", "int", 0},
+			{"draft", "Draft", "DraftMessage", 1},
+			{"folder_id", "FolderID", "int", 4},
+		},
+		New: func() TL { return TL_dialog{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dialogFolder,
+		Name: "dialogFolder",
+		Type: "Dialog",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"pinned", "Pinned", "true", 2},
+			{"folder", "Folder", "Folder", -1},
+			{"peer", "Peer", "Peer", -1},
+			{"top_message", "TopMessage", "int", -1},
+			{"unread_muted_peers_count", "UnreadMutedPeersCount", "int", -1},
+			{"unread_unmuted_peers_count", "UnreadUnmutedPeersCount", "int", -1},
+			{"unread_muted_messages_count", "UnreadMutedMessagesCount", "int", -1},
+			{"unread_unmuted_messages_count", "UnreadUnmutedMessagesCount", "int", -1},
+		},
+		New: func() TL { return TL_dialogFolder{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photoEmpty,
+		Name: "photoEmpty",
+		Type: "Photo",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+		},
+		New: func() TL { return TL_photoEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photo,
+		Name: "photo",
+		Type: "Photo",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"has_stickers", "HasStickers", "true", 0},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"file_reference", "FileReference", "bytes", -1},
+			{"date", "Date", "int", -1},
+			{"sizes", "Sizes", "Vector<PhotoSize>", -1},
+			{"video_sizes", "VideoSizes", "Vector<VideoSize>", 1},
+			{"dc_id", "DcID", "int", -1},
+		},
+		New: func() TL { return TL_photo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photoSizeEmpty,
+		Name: "photoSizeEmpty",
+		Type: "PhotoSize",
+		Fields: []FieldInfo{
+			{"type", "Type", "string", -1},
+		},
+		New: func() TL { return TL_photoSizeEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photoSize,
+		Name: "photoSize",
+		Type: "PhotoSize",
+		Fields: []FieldInfo{
+			{"type", "Type", "string", -1},
+			{"location", "Location", "FileLocation", -1},
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+			{"size", "Size", "int", -1},
+		},
+		New: func() TL { return TL_photoSize{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photoCachedSize,
+		Name: "photoCachedSize",
+		Type: "PhotoSize",
+		Fields: []FieldInfo{
+			{"type", "Type", "string", -1},
+			{"location", "Location", "FileLocation", -1},
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_photoCachedSize{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photoStrippedSize,
+		Name: "photoStrippedSize",
+		Type: "PhotoSize",
+		Fields: []FieldInfo{
+			{"type", "Type", "string", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_photoStrippedSize{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photoSizeProgressive,
+		Name: "photoSizeProgressive",
+		Type: "PhotoSize",
+		Fields: []FieldInfo{
+			{"type", "Type", "string", -1},
+			{"location", "Location", "FileLocation", -1},
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+			{"sizes", "Sizes", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_photoSizeProgressive{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photoPathSize,
+		Name: "photoPathSize",
+		Type: "PhotoSize",
+		Fields: []FieldInfo{
+			{"type", "Type", "string", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_photoPathSize{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_geoPointEmpty,
+		Name: "geoPointEmpty",
+		Type: "GeoPoint",
+		New:  func() TL { return TL_geoPointEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_geoPoint,
+		Name: "geoPoint",
+		Type: "GeoPoint",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"long", "Long", "double", -1},
+			{"lat", "Lat", "double", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"accuracy_radius", "AccuracyRadius", "int", 0},
+		},
+		New: func() TL { return TL_geoPoint{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_sentCode,
+		Name: "auth.sentCode",
+		Type: "auth.SentCode",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"type", "Type", "auth.SentCodeType", -1},
+			{"phone_code_hash", "PhoneCodeHash", "string", -1},
+			{"next_type", "NextType", "auth.CodeType", 1},
+			{"timeout", "Timeout", "int", 2},
+		},
+		New: func() TL { return TL_auth_sentCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_authorization,
+		Name: "auth.authorization",
+		Type: "auth.Authorization",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"tmp_sessions", "TmpSessions", "int", 0},
+			{"user", "User", "User", -1},
+		},
+		New: func() TL { return TL_auth_authorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_authorizationSignUpRequired,
+		Name: "auth.authorizationSignUpRequired",
+		Type: "auth.Authorization",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"terms_of_service", "TermsOfService", "help.TermsOfService", 0},
+		},
+		New: func() TL { return TL_auth_authorizationSignUpRequired{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_exportedAuthorization,
+		Name: "auth.exportedAuthorization",
+		Type: "auth.ExportedAuthorization",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_auth_exportedAuthorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputNotifyPeer,
+		Name: "inputNotifyPeer",
+		Type: "InputNotifyPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_inputNotifyPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputNotifyUsers,
+		Name: "inputNotifyUsers",
+		Type: "InputNotifyPeer",
+		New:  func() TL { return TL_inputNotifyUsers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputNotifyChats,
+		Name: "inputNotifyChats",
+		Type: "InputNotifyPeer",
+		New:  func() TL { return TL_inputNotifyChats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputNotifyBroadcasts,
+		Name: "inputNotifyBroadcasts",
+		Type: "InputNotifyPeer",
+		New:  func() TL { return TL_inputNotifyBroadcasts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPeerNotifySettings,
+		Name: "inputPeerNotifySettings",
+		Type: "InputPeerNotifySettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"show_previews", "ShowPreviews", "Bool", 0},
+			{"silent", "Silent", "Bool", 1},
+			{"mute_until", "MuteUntil", "int", 2},
+			{"sound", "Sound", "string", 3},
+		},
+		New: func() TL { return TL_inputPeerNotifySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_peerNotifySettings,
+		Name: "peerNotifySettings",
+		Type: "PeerNotifySettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"show_previews", "ShowPreviews", "Bool", 0},
+			{"silent", "Silent", "Bool", 1},
+			{"mute_until", "MuteUntil", "int", 2},
+			{"sound", "Sound", "string", 3},
+		},
+		New: func() TL { return TL_peerNotifySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_peerSettings,
+		Name: "peerSettings",
+		Type: "PeerSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"report_spam", "ReportSpam", "true", 0},
+			{"add_contact", "AddContact", "true", 1},
+			{"block_contact", "BlockContact", "true", 2},
+			{"share_contact", "ShareContact", "true", 3},
+			{"need_contacts_exception", "NeedContactsException", "true", 4},
+			{"report_geo", "ReportGeo", "true", 5},
+			{"autoarchived", "Autoarchived", "true", 7},
+			{"invite_members", "InviteMembers", "true", 8},
+			{"geo_distance", "GeoDistance", "int", 6},
+		},
+		New: func() TL { return TL_peerSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_wallPaper,
+		Name: "wallPaper",
+		Type: "WallPaper",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"flags", "Flags", "#", -1},
+			{"creator", "Creator", "true", 0},
+			{"default", "Default", "true", 1},
+			{"pattern", "Pattern", "true", 3},
+			{"dark", "Dark", "true", 4},
+			{"access_hash", "AccessHash", "long", -1},
+			{"slug", "Slug", "string", -1},
+			{"document", "Document", "Document", -1},
+			{"settings", "Settings", "WallPaperSettings", 2},
+		},
+		New: func() TL { return TL_wallPaper{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_wallPaperNoFile,
+		Name: "wallPaperNoFile",
+		Type: "WallPaper",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"default", "Default", "true", 1},
+			{"dark", "Dark", "true", 4},
+			{"settings", "Settings", "WallPaperSettings", 2},
+		},
+		New: func() TL { return TL_wallPaperNoFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputReportReasonSpam,
+		Name: "inputReportReasonSpam",
+		Type: "ReportReason",
+		New:  func() TL { return TL_inputReportReasonSpam{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputReportReasonViolence,
+		Name: "inputReportReasonViolence",
+		Type: "ReportReason",
+		New:  func() TL { return TL_inputReportReasonViolence{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputReportReasonPornography,
+		Name: "inputReportReasonPornography",
+		Type: "ReportReason",
+		New:  func() TL { return TL_inputReportReasonPornography{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputReportReasonChildAbuse,
+		Name: "inputReportReasonChildAbuse",
+		Type: "ReportReason",
+		New:  func() TL { return TL_inputReportReasonChildAbuse{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputReportReasonOther,
+		Name: "inputReportReasonOther",
+		Type: "ReportReason",
+		New:  func() TL { return TL_inputReportReasonOther{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputReportReasonCopyright,
+		Name: "inputReportReasonCopyright",
+		Type: "ReportReason",
+		New:  func() TL { return TL_inputReportReasonCopyright{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputReportReasonGeoIrrelevant,
+		Name: "inputReportReasonGeoIrrelevant",
+		Type: "ReportReason",
+		New:  func() TL { return TL_inputReportReasonGeoIrrelevant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputReportReasonFake,
+		Name: "inputReportReasonFake",
+		Type: "ReportReason",
+		New:  func() TL { return TL_inputReportReasonFake{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_userFull,
+		Name: "userFull",
+		Type: "UserFull",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"blocked", "Blocked", "true", 0},
+			{"phone_calls_available", "PhoneCallsAvailable", "true", 4},
+			{"phone_calls_private", "PhoneCallsPrivate", "true", 5},
+			{"can_pin_message", "CanPinMessage", "true", 7},
+			{"has_scheduled", "HasScheduled", "true", 12},
+			{"video_calls_available", "VideoCallsAvailable", "true", 13},
+			{"user", "User", "User", -1},
+			{"about", "About", "string", 1},
+			{"settings", "Settings", "PeerSettings", -1},
+			{"profile_photo", "ProfilePhoto", "Photo", 2},
+			{"notify_settings", "NotifySettings", "PeerNotifySettings", -1},
+			{"bot_info", "BotInfo", "BotInfo", 3},
+			{"pinned_msg_id", "PinnedMsgID", "int", 6},
+			{"common_chats_count", "CommonChatsCount", "int", -1},
+			{"folder_id", "FolderID", "int", 11},
+			{"ttl_period", "TtlPeriod", "int", 14},
+		},
+		New: func() TL { return TL_userFull{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contact,
+		Name: "contact",
+		Type: "Contact",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"mutual", "Mutual", "Bool", -1},
+		},
+		New: func() TL { return TL_contact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_importedContact,
+		Name: "importedContact",
+		Type: "ImportedContact",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"client_id", "ClientID", "long", -1},
+		},
+		New: func() TL { return TL_importedContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contactStatus,
+		Name: "contactStatus",
+		Type: "ContactStatus",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"status", "Status", "UserStatus", -1},
+		},
+		New: func() TL { return TL_contactStatus{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_contactsNotModified,
+		Name: "contacts.contactsNotModified",
+		Type: "contacts.Contacts",
+		New:  func() TL { return TL_contacts_contactsNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_contacts,
+		Name: "contacts.contacts",
+		Type: "contacts.Contacts",
+		Fields: []FieldInfo{
+			{"contacts", "Contacts", "Vector<Contact>", -1},
+			{"saved_count", "SavedCount", "int", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_contacts_contacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_importedContacts,
+		Name: "contacts.importedContacts",
+		Type: "contacts.ImportedContacts",
+		Fields: []FieldInfo{
+			{"imported", "Imported", "Vector<ImportedContact>", -1},
+			{"popular_invites", "PopularInvites", "Vector<PopularContact>", -1},
+			{"retry_contacts", "RetryContacts", "Vector<long>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_contacts_importedContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_blocked,
+		Name: "contacts.blocked",
+		Type: "contacts.Blocked",
+		Fields: []FieldInfo{
+			{"blocked", "Blocked", "Vector<PeerBlocked>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_contacts_blocked{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_blockedSlice,
+		Name: "contacts.blockedSlice",
+		Type: "contacts.Blocked",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"blocked", "Blocked", "Vector<PeerBlocked>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_contacts_blockedSlice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_dialogs,
+		Name: "messages.dialogs",
+		Type: "messages.Dialogs",
+		Fields: []FieldInfo{
+			{"dialogs", "Dialogs", "Vector<Dialog>", -1},
+			{"messages", "Messages", "Vector<Message>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_dialogs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_dialogsSlice,
+		Name: "messages.dialogsSlice",
+		Type: "messages.Dialogs",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"dialogs", "Dialogs", "Vector<Dialog>", -1},
+			{"messages", "Messages", "Vector<Message>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_dialogsSlice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_dialogsNotModified,
+		Name: "messages.dialogsNotModified",
+		Type: "messages.Dialogs",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+		},
+		New: func() TL { return TL_messages_dialogsNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_messages,
+		Name: "messages.messages",
+		Type: "messages.Messages",
+		Fields: []FieldInfo{
+			{"messages", "Messages", "Vector<Message>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_messages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_messagesSlice,
+		Name: "messages.messagesSlice",
+		Type: "messages.Messages",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"inexact", "Inexact", "true", 1},
+			{"count", "Count", "int", -1},
+			{"next_rate", "NextRate", "int", 0},
+			{"offset_id_offset", "OffsetIdOffset", "int", 2},
+			{"messages", "Messages", "Vector<Message>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_messagesSlice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_channelMessages,
+		Name: "messages.channelMessages",
+		Type: "messages.Messages",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"inexact", "Inexact", "true", 1},
+			{"pts", "Pts", "int", -1},
+			{"count", "Count", "int", -1},
+			{"offset_id_offset", "OffsetIdOffset", "int", 2},
+			{"messages", "Messages", "Vector<Message>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_channelMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_messagesNotModified,
+		Name: "messages.messagesNotModified",
+		Type: "messages.Messages",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+		},
+		New: func() TL { return TL_messages_messagesNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_chats,
+		Name: "messages.chats",
+		Type: "messages.Chats",
+		Fields: []FieldInfo{
+			{"chats", "Chats", "Vector<Chat>", -1},
+		},
+		New: func() TL { return TL_messages_chats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_chatsSlice,
+		Name: "messages.chatsSlice",
+		Type: "messages.Chats",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+		},
+		New: func() TL { return TL_messages_chatsSlice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_chatFull,
+		Name: "messages.chatFull",
+		Type: "messages.ChatFull",
+		Fields: []FieldInfo{
+			{"full_chat", "FullChat", "ChatFull", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_chatFull{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_affectedHistory,
+		Name: "messages.affectedHistory",
+		Type: "messages.AffectedHistory",
+		Fields: []FieldInfo{
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+			{"offset", "Offset", "int", -1},
+		},
+		New: func() TL { return TL_messages_affectedHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterEmpty,
+		Name: "inputMessagesFilterEmpty",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterPhotos,
+		Name: "inputMessagesFilterPhotos",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterPhotos{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterVideo,
+		Name: "inputMessagesFilterVideo",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterVideo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterPhotoVideo,
+		Name: "inputMessagesFilterPhotoVideo",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterPhotoVideo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterDocument,
+		Name: "inputMessagesFilterDocument",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterUrl,
+		Name: "inputMessagesFilterUrl",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterUrl{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterGif,
+		Name: "inputMessagesFilterGif",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterGif{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterVoice,
+		Name: "inputMessagesFilterVoice",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterVoice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterMusic,
+		Name: "inputMessagesFilterMusic",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterMusic{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterChatPhotos,
+		Name: "inputMessagesFilterChatPhotos",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterChatPhotos{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterPhoneCalls,
+		Name: "inputMessagesFilterPhoneCalls",
+		Type: "MessagesFilter",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"missed", "Missed", "true", 0},
+		},
+		New: func() TL { return TL_inputMessagesFilterPhoneCalls{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterRoundVoice,
+		Name: "inputMessagesFilterRoundVoice",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterRoundVoice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterRoundVideo,
+		Name: "inputMessagesFilterRoundVideo",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterRoundVideo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterMyMentions,
+		Name: "inputMessagesFilterMyMentions",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterMyMentions{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterGeo,
+		Name: "inputMessagesFilterGeo",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterGeo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterContacts,
+		Name: "inputMessagesFilterContacts",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagesFilterPinned,
+		Name: "inputMessagesFilterPinned",
+		Type: "MessagesFilter",
+		New:  func() TL { return TL_inputMessagesFilterPinned{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateNewMessage,
+		Name: "updateNewMessage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"message", "Message", "Message", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateNewMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateMessageID,
+		Name: "updateMessageID",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+			{"random_id", "RandomID", "long", -1},
+		},
+		New: func() TL { return TL_updateMessageID{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDeleteMessages,
+		Name: "updateDeleteMessages",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"messages", "Messages", "Vector<int>", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateDeleteMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateUserTyping,
+		Name: "updateUserTyping",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"action", "Action", "SendMessageAction", -1},
+		},
+		New: func() TL { return TL_updateUserTyping{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChatUserTyping,
+		Name: "updateChatUserTyping",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"from_id", "FromID", "Peer", -1},
+			{"action", "Action", "SendMessageAction", -1},
+		},
+		New: func() TL { return TL_updateChatUserTyping{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChatParticipants,
+		Name: "updateChatParticipants",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"participants", "Participants", "ChatParticipants", -1},
+		},
+		New: func() TL { return TL_updateChatParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateUserStatus,
+		Name: "updateUserStatus",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"status", "Status", "UserStatus", -1},
+		},
+		New: func() TL { return TL_updateUserStatus{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateUserName,
+		Name: "updateUserName",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+			{"username", "Username", "string", -1},
+		},
+		New: func() TL { return TL_updateUserName{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateUserPhoto,
+		Name: "updateUserPhoto",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"photo", "Photo", "UserProfilePhoto", -1},
+			{"previous", "Previous", "Bool", -1},
+		},
+		New: func() TL { return TL_updateUserPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateNewEncryptedMessage,
+		Name: "updateNewEncryptedMessage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"message", "Message", "EncryptedMessage", -1},
+			{"qts", "Qts", "int", -1},
+		},
+		New: func() TL { return TL_updateNewEncryptedMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateEncryptedChatTyping,
+		Name: "updateEncryptedChatTyping",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_updateEncryptedChatTyping{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateEncryption,
+		Name: "updateEncryption",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat", "Chat", "EncryptedChat", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_updateEncryption{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateEncryptedMessagesRead,
+		Name: "updateEncryptedMessagesRead",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"max_date", "MaxDate", "int", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_updateEncryptedMessagesRead{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChatParticipantAdd,
+		Name: "updateChatParticipantAdd",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"user_id", "UserID", "int", -1},
+			{"inviter_id", "InviterID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"version", "Version", "int", -1},
+		},
+		New: func() TL { return TL_updateChatParticipantAdd{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChatParticipantDelete,
+		Name: "updateChatParticipantDelete",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"user_id", "UserID", "int", -1},
+			{"version", "Version", "int", -1},
+		},
+		New: func() TL { return TL_updateChatParticipantDelete{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDcOptions,
+		Name: "updateDcOptions",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"dc_options", "DcOptions", "Vector<DcOption>", -1},
+		},
+		New: func() TL { return TL_updateDcOptions{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateNotifySettings,
+		Name: "updateNotifySettings",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "NotifyPeer", -1},
+			{"notify_settings", "NotifySettings", "PeerNotifySettings", -1},
+		},
+		New: func() TL { return TL_updateNotifySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateServiceNotification,
+		Name: "updateServiceNotification",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"popup", "Popup", "true", 0},
+			{"inbox_date", "InboxDate", "int", 1},
+			{"type", "Type", "string", -1},
+			{"message", "Message", "string", -1},
+			{"media", "Media", "MessageMedia", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", -1},
+		},
+		New: func() TL { return TL_updateServiceNotification{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePrivacy,
+		Name: "updatePrivacy",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"key", "Key", "PrivacyKey", -1},
+			{"rules", "Rules", "Vector<PrivacyRule>", -1},
+		},
+		New: func() TL { return TL_updatePrivacy{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateUserPhone,
+		Name: "updateUserPhone",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"phone", "Phone", "string", -1},
+		},
+		New: func() TL { return TL_updateUserPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateReadHistoryInbox,
+		Name: "updateReadHistoryInbox",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"folder_id", "FolderID", "int", 0},
+			{"peer", "Peer", "Peer", -1},
+			{"max_id", "MaxID", "int", -1},
+			{"still_unread_count", "StillUnreadCount", "int", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateReadHistoryInbox{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateReadHistoryOutbox,
+		Name: "updateReadHistoryOutbox",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"max_id", "MaxID", "int", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateReadHistoryOutbox{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateWebPage,
+		Name: "updateWebPage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"webpage", "Webpage", "WebPage", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateWebPage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateReadMessagesContents,
+		Name: "updateReadMessagesContents",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"messages", "Messages", "Vector<int>", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateReadMessagesContents{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannelTooLong,
+		Name: "updateChannelTooLong",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"channel_id", "ChannelID", "int", -1},
+			{"pts", "Pts", "int", 0},
+		},
+		New: func() TL { return TL_updateChannelTooLong{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannel,
+		Name: "updateChannel",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+		},
+		New: func() TL { return TL_updateChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateNewChannelMessage,
+		Name: "updateNewChannelMessage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"message", "Message", "Message", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateNewChannelMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateReadChannelInbox,
+		Name: "updateReadChannelInbox",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"folder_id", "FolderID", "int", 0},
+			{"channel_id", "ChannelID", "int", -1},
+			{"max_id", "MaxID", "int", -1},
+			{"still_unread_count", "StillUnreadCount", "int", -1},
+			{"pts", "Pts", "int", -1},
+		},
+		New: func() TL { return TL_updateReadChannelInbox{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDeleteChannelMessages,
+		Name: "updateDeleteChannelMessages",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"messages", "Messages", "Vector<int>", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateDeleteChannelMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannelMessageViews,
+		Name: "updateChannelMessageViews",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"id", "ID", "int", -1},
+			{"views", "Views", "int", -1},
+		},
+		New: func() TL { return TL_updateChannelMessageViews{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChatParticipantAdmin,
+		Name: "updateChatParticipantAdmin",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"user_id", "UserID", "int", -1},
+			{"is_admin", "IsAdmin", "Bool", -1},
+			{"version", "Version", "int", -1},
+		},
+		New: func() TL { return TL_updateChatParticipantAdmin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateNewStickerSet,
+		Name: "updateNewStickerSet",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"stickerset", "Stickerset", "messages.StickerSet", -1},
+		},
+		New: func() TL { return TL_updateNewStickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateStickerSetsOrder,
+		Name: "updateStickerSetsOrder",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"masks", "Masks", "true", 0},
+			{"order", "Order", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_updateStickerSetsOrder{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateStickerSets,
+		Name: "updateStickerSets",
+		Type: "Update",
+		New:  func() TL { return TL_updateStickerSets{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateSavedGifs,
+		Name: "updateSavedGifs",
+		Type: "Update",
+		New:  func() TL { return TL_updateSavedGifs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateBotInlineQuery,
+		Name: "updateBotInlineQuery",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"query_id", "QueryID", "long", -1},
+			{"user_id", "UserID", "int", -1},
+			{"query", "Query", "string", -1},
+			{"geo", "Geo", "GeoPoint", 0},
+			{"peer_type", "PeerType", "InlineQueryPeerType", 1},
+			{"offset", "Offset", "string", -1},
+		},
+		New: func() TL { return TL_updateBotInlineQuery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateBotInlineSend,
+		Name: "updateBotInlineSend",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"user_id", "UserID", "int", -1},
+			{"query", "Query", "string", -1},
+			{"geo", "Geo", "GeoPoint", 0},
+			{"id", "ID", "string", -1},
+			{"msg_id", "MsgID", "InputBotInlineMessageID", 1},
+		},
+		New: func() TL { return TL_updateBotInlineSend{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateEditChannelMessage,
+		Name: "updateEditChannelMessage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"message", "Message", "Message", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateEditChannelMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateBotCallbackQuery,
+		Name: "updateBotCallbackQuery",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"query_id", "QueryID", "long", -1},
+			{"user_id", "UserID", "int", -1},
+			{"peer", "Peer", "Peer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"chat_instance", "ChatInstance", "long", -1},
+			{"data", "Data", "bytes", 0},
+			{"game_short_name", "GameShortName", "string", 1},
+		},
+		New: func() TL { return TL_updateBotCallbackQuery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateEditMessage,
+		Name: "updateEditMessage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"message", "Message", "Message", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateEditMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateInlineBotCallbackQuery,
+		Name: "updateInlineBotCallbackQuery",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"query_id", "QueryID", "long", -1},
+			{"user_id", "UserID", "int", -1},
+			{"msg_id", "MsgID", "InputBotInlineMessageID", -1},
+			{"chat_instance", "ChatInstance", "long", -1},
+			{"data", "Data", "bytes", 0},
+			{"game_short_name", "GameShortName", "string", 1},
+		},
+		New: func() TL { return TL_updateInlineBotCallbackQuery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateReadChannelOutbox,
+		Name: "updateReadChannelOutbox",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"max_id", "MaxID", "int", -1},
+		},
+		New: func() TL { return TL_updateReadChannelOutbox{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDraftMessage,
+		Name: "updateDraftMessage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"draft", "Draft", "DraftMessage", -1},
+		},
+		New: func() TL { return TL_updateDraftMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateReadFeaturedStickers,
+		Name: "updateReadFeaturedStickers",
+		Type: "Update",
+		New:  func() TL { return TL_updateReadFeaturedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateRecentStickers,
+		Name: "updateRecentStickers",
+		Type: "Update",
+		New:  func() TL { return TL_updateRecentStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateConfig,
+		Name: "updateConfig",
+		Type: "Update",
+		New:  func() TL { return TL_updateConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePtsChanged,
+		Name: "updatePtsChanged",
+		Type: "Update",
+		New:  func() TL { return TL_updatePtsChanged{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannelWebPage,
+		Name: "updateChannelWebPage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"webpage", "Webpage", "WebPage", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateChannelWebPage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDialogPinned,
+		Name: "updateDialogPinned",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"pinned", "Pinned", "true", 0},
+			{"folder_id", "FolderID", "int", 1},
+			{"peer", "Peer", "DialogPeer", -1},
+		},
+		New: func() TL { return TL_updateDialogPinned{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePinnedDialogs,
+		Name: "updatePinnedDialogs",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"folder_id", "FolderID", "int", 1},
+			{"order", "Order", "Vector<DialogPeer>", 0},
+		},
+		New: func() TL { return TL_updatePinnedDialogs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateBotWebhookJSON,
+		Name: "updateBotWebhookJSON",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"data", "Data", "DataJSON", -1},
+		},
+		New: func() TL { return TL_updateBotWebhookJSON{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateBotWebhookJSONQuery,
+		Name: "updateBotWebhookJSONQuery",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"query_id", "QueryID", "long", -1},
+			{"data", "Data", "DataJSON", -1},
+			{"timeout", "Timeout", "int", -1},
+		},
+		New: func() TL { return TL_updateBotWebhookJSONQuery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateBotShippingQuery,
+		Name: "updateBotShippingQuery",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"query_id", "QueryID", "long", -1},
+			{"user_id", "UserID", "int", -1},
+			{"payload", "Payload", "bytes", -1},
+			{"shipping_address", "ShippingAddress", "PostAddress", -1},
+		},
+		New: func() TL { return TL_updateBotShippingQuery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateBotPrecheckoutQuery,
+		Name: "updateBotPrecheckoutQuery",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"query_id", "QueryID", "long", -1},
+			{"user_id", "UserID", "int", -1},
+			{"payload", "Payload", "bytes", -1},
+			{"info", "Info", "PaymentRequestedInfo", 0},
+			{"shipping_option_id", "ShippingOptionID", "string", 1},
+			{"currency", "Currency", "string", -1},
+			{"total_amount", "TotalAmount", "long", -1},
+		},
+		New: func() TL { return TL_updateBotPrecheckoutQuery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePhoneCall,
+		Name: "updatePhoneCall",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"phone_call", "PhoneCall", "PhoneCall", -1},
+		},
+		New: func() TL { return TL_updatePhoneCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateLangPackTooLong,
+		Name: "updateLangPackTooLong",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"lang_code", "LangCode", "string", -1},
+		},
+		New: func() TL { return TL_updateLangPackTooLong{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateLangPack,
+		Name: "updateLangPack",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"difference", "Difference", "LangPackDifference", -1},
+		},
+		New: func() TL { return TL_updateLangPack{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateFavedStickers,
+		Name: "updateFavedStickers",
+		Type: "Update",
+		New:  func() TL { return TL_updateFavedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannelReadMessagesContents,
+		Name: "updateChannelReadMessagesContents",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"messages", "Messages", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_updateChannelReadMessagesContents{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateContactsReset,
+		Name: "updateContactsReset",
+		Type: "Update",
+		New:  func() TL { return TL_updateContactsReset{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannelAvailableMessages,
+		Name: "updateChannelAvailableMessages",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"available_min_id", "AvailableMinID", "int", -1},
+		},
+		New: func() TL { return TL_updateChannelAvailableMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDialogUnreadMark,
+		Name: "updateDialogUnreadMark",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"unread", "Unread", "true", 0},
+			{"peer", "Peer", "DialogPeer", -1},
+		},
+		New: func() TL { return TL_updateDialogUnreadMark{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateMessagePoll,
+		Name: "updateMessagePoll",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"poll_id", "PollID", "long", -1},
+			{"poll", "Poll", "Poll", 0},
+			{"results", "Results", "PollResults", -1},
+		},
+		New: func() TL { return TL_updateMessagePoll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChatDefaultBannedRights,
+		Name: "updateChatDefaultBannedRights",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"default_banned_rights", "DefaultBannedRights", "ChatBannedRights", -1},
+			{"version", "Version", "int", -1},
+		},
+		New: func() TL { return TL_updateChatDefaultBannedRights{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateFolderPeers,
+		Name: "updateFolderPeers",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"folder_peers", "FolderPeers", "Vector<FolderPeer>", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updateFolderPeers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePeerSettings,
+		Name: "updatePeerSettings",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"settings", "Settings", "PeerSettings", -1},
+		},
+		New: func() TL { return TL_updatePeerSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePeerLocated,
+		Name: "updatePeerLocated",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peers", "Peers", "Vector<PeerLocated>", -1},
+		},
+		New: func() TL { return TL_updatePeerLocated{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateNewScheduledMessage,
+		Name: "updateNewScheduledMessage",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"message", "Message", "Message", -1},
+		},
+		New: func() TL { return TL_updateNewScheduledMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDeleteScheduledMessages,
+		Name: "updateDeleteScheduledMessages",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"messages", "Messages", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_updateDeleteScheduledMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateTheme,
+		Name: "updateTheme",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"theme", "Theme", "Theme", -1},
+		},
+		New: func() TL { return TL_updateTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateGeoLiveViewed,
+		Name: "updateGeoLiveViewed",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"msg_id", "MsgID", "int", -1},
+		},
+		New: func() TL { return TL_updateGeoLiveViewed{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateLoginToken,
+		Name: "updateLoginToken",
+		Type: "Update",
+		New:  func() TL { return TL_updateLoginToken{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateMessagePollVote,
+		Name: "updateMessagePollVote",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"poll_id", "PollID", "long", -1},
+			{"user_id", "UserID", "int", -1},
+			{"options", "Options", "Vector<bytes>", -1},
+		},
+		New: func() TL { return TL_updateMessagePollVote{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDialogFilter,
+		Name: "updateDialogFilter",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "int", -1},
+			{"filter", "Filter", "DialogFilter", 0},
+		},
+		New: func() TL { return TL_updateDialogFilter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDialogFilterOrder,
+		Name: "updateDialogFilterOrder",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"order", "Order", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_updateDialogFilterOrder{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateDialogFilters,
+		Name: "updateDialogFilters",
+		Type: "Update",
+		New:  func() TL { return TL_updateDialogFilters{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePhoneCallSignalingData,
+		Name: "updatePhoneCallSignalingData",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"phone_call_id", "PhoneCallID", "long", -1},
+			{"data", "Data", "bytes", -1},
+		},
+		New: func() TL { return TL_updatePhoneCallSignalingData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannelMessageForwards,
+		Name: "updateChannelMessageForwards",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"id", "ID", "int", -1},
+			{"forwards", "Forwards", "int", -1},
+		},
+		New: func() TL { return TL_updateChannelMessageForwards{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateReadChannelDiscussionInbox,
+		Name: "updateReadChannelDiscussionInbox",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"channel_id", "ChannelID", "int", -1},
+			{"top_msg_id", "TopMsgID", "int", -1},
+			{"read_max_id", "ReadMaxID", "int", -1},
+			{"broadcast_id", "BroadcastID", "int", 0},
+			{"broadcast_post", "BroadcastPost", "int", 0},
+		},
+		New: func() TL { return TL_updateReadChannelDiscussionInbox{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateReadChannelDiscussionOutbox,
+		Name: "updateReadChannelDiscussionOutbox",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"top_msg_id", "TopMsgID", "int", -1},
+			{"read_max_id", "ReadMaxID", "int", -1},
+		},
+		New: func() TL { return TL_updateReadChannelDiscussionOutbox{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePeerBlocked,
+		Name: "updatePeerBlocked",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"peer_id", "PeerID", "Peer", -1},
+			{"blocked", "Blocked", "Bool", -1},
+		},
+		New: func() TL { return TL_updatePeerBlocked{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannelUserTyping,
+		Name: "updateChannelUserTyping",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"channel_id", "ChannelID", "int", -1},
+			{"top_msg_id", "TopMsgID", "int", 0},
+			{"from_id", "FromID", "Peer", -1},
+			{"action", "Action", "SendMessageAction", -1},
+		},
+		New: func() TL { return TL_updateChannelUserTyping{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePinnedMessages,
+		Name: "updatePinnedMessages",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"pinned", "Pinned", "true", 0},
+			{"peer", "Peer", "Peer", -1},
+			{"messages", "Messages", "Vector<int>", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updatePinnedMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePinnedChannelMessages,
+		Name: "updatePinnedChannelMessages",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"pinned", "Pinned", "true", 0},
+			{"channel_id", "ChannelID", "int", -1},
+			{"messages", "Messages", "Vector<int>", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_updatePinnedChannelMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChat,
+		Name: "updateChat",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_updateChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateGroupCallParticipants,
+		Name: "updateGroupCallParticipants",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+			{"participants", "Participants", "Vector<GroupCallParticipant>", -1},
+			{"version", "Version", "int", -1},
+		},
+		New: func() TL { return TL_updateGroupCallParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateGroupCall,
+		Name: "updateGroupCall",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"call", "Call", "GroupCall", -1},
+		},
+		New: func() TL { return TL_updateGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatePeerHistoryTTL,
+		Name: "updatePeerHistoryTTL",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"peer", "Peer", "Peer", -1},
+			{"ttl_period", "TtlPeriod", "int", 0},
+		},
+		New: func() TL { return TL_updatePeerHistoryTTL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChatParticipant,
+		Name: "updateChatParticipant",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"chat_id", "ChatID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"actor_id", "ActorID", "int", -1},
+			{"user_id", "UserID", "int", -1},
+			{"prev_participant", "PrevParticipant", "ChatParticipant", 0},
+			{"new_participant", "NewParticipant", "ChatParticipant", 1},
+			{"invite", "Invite", "ExportedChatInvite", 2},
+			{"qts", "Qts", "int", -1},
+		},
+		New: func() TL { return TL_updateChatParticipant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateChannelParticipant,
+		Name: "updateChannelParticipant",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"channel_id", "ChannelID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"actor_id", "ActorID", "int", -1},
+			{"user_id", "UserID", "int", -1},
+			{"prev_participant", "PrevParticipant", "ChannelParticipant", 0},
+			{"new_participant", "NewParticipant", "ChannelParticipant", 1},
+			{"invite", "Invite", "ExportedChatInvite", 2},
+			{"qts", "Qts", "int", -1},
+		},
+		New: func() TL { return TL_updateChannelParticipant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateBotStopped,
+		Name: "updateBotStopped",
+		Type: "Update",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"stopped", "Stopped", "Bool", -1},
+			{"qts", "Qts", "int", -1},
+		},
+		New: func() TL { return TL_updateBotStopped{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates_state,
+		Name: "updates.state",
+		Type: "updates.State",
+		Fields: []FieldInfo{
+			{"pts", "Pts", "int", -1},
+			{"qts", "Qts", "int", -1},
+			{"date", "Date", "int", -1},
+			{"seq", "Seq", "int", -1},
+			{"unread_count", "UnreadCount", "int", -1},
+		},
+		New: func() TL { return TL_updates_state{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates_differenceEmpty,
+		Name: "updates.differenceEmpty",
+		Type: "updates.Difference",
+		Fields: []FieldInfo{
+			{"date", "Date", "int", -1},
+			{"seq", "Seq", "int", -1},
+		},
+		New: func() TL { return TL_updates_differenceEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates_difference,
+		Name: "updates.difference",
+		Type: "updates.Difference",
+		Fields: []FieldInfo{
+			{"new_messages", "NewMessages", "Vector<Message>", -1},
+			{"new_encrypted_messages", "NewEncryptedMessages", "Vector<EncryptedMessage>", -1},
+			{"other_updates", "OtherUpdates", "Vector<Update>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"state", "State", "updates.State", -1},
+		},
+		New: func() TL { return TL_updates_difference{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates_differenceSlice,
+		Name: "updates.differenceSlice",
+		Type: "updates.Difference",
+		Fields: []FieldInfo{
+			{"new_messages", "NewMessages", "Vector<Message>", -1},
+			{"new_encrypted_messages", "NewEncryptedMessages", "Vector<EncryptedMessage>", -1},
+			{"other_updates", "OtherUpdates", "Vector<Update>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"intermediate_state", "IntermediateState", "updates.State", -1},
+		},
+		New: func() TL { return TL_updates_differenceSlice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates_differenceTooLong,
+		Name: "updates.differenceTooLong",
+		Type: "updates.Difference",
+		Fields: []FieldInfo{
+			{"pts", "Pts", "int", -1},
+		},
+		New: func() TL { return TL_updates_differenceTooLong{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatesTooLong,
+		Name: "updatesTooLong",
+		Type: "Updates",
+		New:  func() TL { return TL_updatesTooLong{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateShortMessage,
+		Name: "updateShortMessage",
+		Type: "Updates",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"out", "Out", "true", 1},
+			{"mentioned", "Mentioned", "true", 4},
+			{"media_unread", "MediaUnread", "true", 5},
+			{"silent", "Silent", "true", 13},
+			{"id", "ID", "int", -1},
+			{"user_id", "UserID", "int", -1},
+			{"message", "Message", "string", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+			{"date", "Date", "int", -1},
+			{"fwd_from", "FwdFrom", "MessageFwdHeader", 2},
+			{"via_bot_id", "ViaBotID", "int", 11},
+			{"reply_to", "ReplyTo", "MessageReplyHeader", 3},
+			{"entities", "Entities", "Vector<MessageEntity>", 7},
+			{"ttl_period", "TtlPeriod", "int", 25},
+		},
+		New: func() TL { return TL_updateShortMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateShortChatMessage,
+		Name: "updateShortChatMessage",
+		Type: "Updates",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"out", "Out", "true", 1},
+			{"mentioned", "Mentioned", "true", 4},
+			{"media_unread", "MediaUnread", "true", 5},
+			{"silent", "Silent", "true", 13},
+			{"id", "ID", "int", -1},
+			{"from_id", "FromID", "int", -1},
+			{"chat_id", "ChatID", "int", -1},
+			{"message", "Message", "string", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+			{"date", "Date", "int", -1},
+			{"fwd_from", "FwdFrom", "MessageFwdHeader", 2},
+			{"via_bot_id", "ViaBotID", "int", 11},
+			{"reply_to", "ReplyTo", "MessageReplyHeader", 3},
+			{"entities", "Entities", "Vector<MessageEntity>", 7},
+			{"ttl_period", "TtlPeriod", "int", 25},
+		},
+		New: func() TL { return TL_updateShortChatMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateShort,
+		Name: "updateShort",
+		Type: "Updates",
+		Fields: []FieldInfo{
+			{"update", "Update", "Update", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_updateShort{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updatesCombined,
+		Name: "updatesCombined",
+		Type: "Updates",
+		Fields: []FieldInfo{
+			{"updates", "Updates", "Vector<Update>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"date", "Date", "int", -1},
+			{"seq_start", "SeqStart", "int", -1},
+			{"seq", "Seq", "int", -1},
+		},
+		New: func() TL { return TL_updatesCombined{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates,
+		Name: "updates",
+		Type: "Updates",
+		Fields: []FieldInfo{
+			{"updates", "Updates", "Vector<Update>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"date", "Date", "int", -1},
+			{"seq", "Seq", "int", -1},
+		},
+		New: func() TL { return TL_updates{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updateShortSentMessage,
+		Name: "updateShortSentMessage",
+		Type: "Updates",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"out", "Out", "true", 1},
+			{"id", "ID", "int", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+			{"date", "Date", "int", -1},
+			{"media", "Media", "MessageMedia", 9},
+			{"entities", "Entities", "Vector<MessageEntity>", 7},
+			{"ttl_period", "TtlPeriod", "int", 25},
+		},
+		New: func() TL { return TL_updateShortSentMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photos_photos,
+		Name: "photos.photos",
+		Type: "photos.Photos",
+		Fields: []FieldInfo{
+			{"photos", "Photos", "Vector<Photo>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_photos_photos{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photos_photosSlice,
+		Name: "photos.photosSlice",
+		Type: "photos.Photos",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"photos", "Photos", "Vector<Photo>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_photos_photosSlice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_photos_photo,
+		Name: "photos.photo",
+		Type: "photos.Photo",
+		Fields: []FieldInfo{
+			{"photo", "Photo", "Photo", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_photos_photo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_upload_file,
+		Name: "upload.file",
+		Type: "upload.File",
+		Fields: []FieldInfo{
+			{"type", "Type", "storage.FileType", -1},
+			{"mtime", "Mtime", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_upload_file{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_upload_fileCdnRedirect,
+		Name: "upload.fileCdnRedirect",
+		Type: "upload.File",
+		Fields: []FieldInfo{
+			{"dc_id", "DcID", "int", -1},
+			{"file_token", "FileToken", "bytes", -1},
+			{"encryption_key", "EncryptionKey", "bytes", -1},
+			{"encryption_iv", "EncryptionIv", "bytes", -1},
+			{"file_hashes", "FileHashes", "Vector<FileHash>", -1},
+		},
+		New: func() TL { return TL_upload_fileCdnRedirect{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dcOption,
+		Name: "dcOption",
+		Type: "DcOption",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"ipv6", "Ipv6", "true", 0},
+			{"media_only", "MediaOnly", "true", 1},
+			{"tcpo_only", "TcpoOnly", "true", 2},
+			{"cdn", "Cdn", "true", 3},
+			{"static", "Static", "true", 4},
+			{"id", "ID", "int", -1},
+			{"ip_address", "IpAddress", "string", -1},
+			{"port", "Port", "int", -1},
+			{"secret", "Secret", "bytes", 10},
+		},
+		New: func() TL { return TL_dcOption{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_config,
+		Name: "config",
+		Type: "Config",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"phonecalls_enabled", "PhonecallsEnabled", "true", 1},
+			{"default_p2p_contacts", "DefaultP2pContacts", "true", 3},
+			{"preload_featured_stickers", "PreloadFeaturedStickers", "true", 4},
+			{"ignore_phone_entities", "IgnorePhoneEntities", "true", 5},
+			{"revoke_pm_inbox", "RevokePmInbox", "true", 6},
+			{"blocked_mode", "BlockedMode", "true", 8},
+			{"pfs_enabled", "PfsEnabled", "true", 13},
+			{"date", "Date", "int", -1},
+			{"expires", "Expires", "int", -1},
+			{"test_mode", "TestMode", "Bool", -1},
+			{"this_dc", "ThisDc", "int", -1},
+			{"dc_options", "DcOptions", "Vector<DcOption>", -1},
+			{"dc_txt_domain_name", "DcTxtDomainName", "string", -1},
+			{"chat_size_max", "ChatSizeMax", "int", -1},
+			{"megagroup_size_max", "MegagroupSizeMax", "int", -1},
+			{"forwarded_count_max", "ForwardedCountMax", "int", -1},
+			{"online_update_period_ms", "OnlineUpdatePeriodMs", "int", -1},
+			{"offline_blur_timeout_ms", "OfflineBlurTimeoutMs", "int", -1},
+			{"offline_idle_timeout_ms", "OfflineIdleTimeoutMs", "int", -1},
+			{"online_cloud_timeout_ms", "OnlineCloudTimeoutMs", "int", -1},
+			{"notify_cloud_delay_ms", "NotifyCloudDelayMs", "int", -1},
+			{"notify_default_delay_ms", "NotifyDefaultDelayMs", "int", -1},
+			{"push_chat_period_ms", "PushChatPeriodMs", "int", -1},
+			{"push_chat_limit", "PushChatLimit", "int", -1},
+			{"saved_gifs_limit", "SavedGifsLimit", "int", -1},
+			{"edit_time_limit", "EditTimeLimit", "int", -1},
+			{"revoke_time_limit", "RevokeTimeLimit", "int", -1},
+			{"revoke_pm_time_limit", "RevokePmTimeLimit", "int", -1},
+			{"rating_e_decay", "RatingEDecay", "int", -1},
+			{"stickers_recent_limit", "StickersRecentLimit", "int", -1},
+			{"stickers_faved_limit", "StickersFavedLimit", "int", -1},
+			{"channels_read_media_period", "ChannelsReadMediaPeriod", "int", -1},
+			{"tmp_sessions", "TmpSessions", "int", 0},
+			{"pinned_dialogs_count_max", "PinnedDialogsCountMax", "int", -1},
+			{"pinned_infolder_count_max", "PinnedInfolderCountMax", "int", -1},
+			{"call_receive_timeout_ms", "CallReceiveTimeoutMs", "int", -1},
+			{"call_ring_timeout_ms", "CallRingTimeoutMs", "int", -1},
+			{"call_connect_timeout_ms", "CallConnectTimeoutMs", "int", -1},
+			{"call_packet_timeout_ms", "CallPacketTimeoutMs", "int", -1},
+			{"me_url_prefix", "MeUrlPrefix", "string", -1},
+			{"autoupdate_url_prefix", "AutoupdateUrlPrefix", "string", 7},
+			{"gif_search_username", "GifSearchUsername", "string", 9},
+			{"venue_search_username", "VenueSearchUsername", "string", 10},
+			{"img_search_username", "ImgSearchUsername", "string", 11},
+			{"static_maps_provider", "StaticMapsProvider", "string", 12},
+			{"caption_length_max", "CaptionLengthMax", "int", -1},
+			{"message_length_max", "MessageLengthMax", "int", -1},
+			{"webfile_dc_id", "WebfileDcID", "int", -1},
+			{"suggested_lang_code", "SuggestedLangCode", "string", 2},
+			{"lang_pack_version", "LangPackVersion", "int", 2},
+			{"base_lang_pack_version", "BaseLangPackVersion", "int", 2},
+		},
+		New: func() TL { return TL_config{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_nearestDc,
+		Name: "nearestDc",
+		Type: "NearestDc",
+		Fields: []FieldInfo{
+			{"country", "Country", "string", -1},
+			{"this_dc", "ThisDc", "int", -1},
+			{"nearest_dc", "NearestDc", "int", -1},
+		},
+		New: func() TL { return TL_nearestDc{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_appUpdate,
+		Name: "help.appUpdate",
+		Type: "help.AppUpdate",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"can_not_skip", "CanNotSkip", "true", 0},
+			{"id", "ID", "int", -1},
+			{"version", "Version", "string", -1},
+			{"text", "Text", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", -1},
+			{"document", "Document", "Document", 1},
+			{"url", "Url", "string", 2},
+		},
+		New: func() TL { return TL_help_appUpdate{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_noAppUpdate,
+		Name: "help.noAppUpdate",
+		Type: "help.AppUpdate",
+		New:  func() TL { return TL_help_noAppUpdate{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_inviteText,
+		Name: "help.inviteText",
+		Type: "help.InviteText",
+		Fields: []FieldInfo{
+			{"message", "Message", "string", -1},
+		},
+		New: func() TL { return TL_help_inviteText{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedChatEmpty,
+		Name: "encryptedChatEmpty",
+		Type: "EncryptedChat",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_encryptedChatEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedChatWaiting,
+		Name: "encryptedChatWaiting",
+		Type: "EncryptedChat",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"date", "Date", "int", -1},
+			{"admin_id", "AdminID", "int", -1},
+			{"participant_id", "ParticipantID", "int", -1},
+		},
+		New: func() TL { return TL_encryptedChatWaiting{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedChatRequested,
+		Name: "encryptedChatRequested",
+		Type: "EncryptedChat",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"folder_id", "FolderID", "int", 0},
+			{"id", "ID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"date", "Date", "int", -1},
+			{"admin_id", "AdminID", "int", -1},
+			{"participant_id", "ParticipantID", "int", -1},
+			{"g_a", "GA", "bytes", -1},
+		},
+		New: func() TL { return TL_encryptedChatRequested{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedChat,
+		Name: "encryptedChat",
+		Type: "EncryptedChat",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"date", "Date", "int", -1},
+			{"admin_id", "AdminID", "int", -1},
+			{"participant_id", "ParticipantID", "int", -1},
+			{"g_a_or_b", "GAOrB", "bytes", -1},
+			{"key_fingerprint", "KeyFingerprint", "long", -1},
+		},
+		New: func() TL { return TL_encryptedChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedChatDiscarded,
+		Name: "encryptedChatDiscarded",
+		Type: "EncryptedChat",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"history_deleted", "HistoryDeleted", "true", 0},
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_encryptedChatDiscarded{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputEncryptedChat,
+		Name: "inputEncryptedChat",
+		Type: "InputEncryptedChat",
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputEncryptedChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedFileEmpty,
+		Name: "encryptedFileEmpty",
+		Type: "EncryptedFile",
+		New:  func() TL { return TL_encryptedFileEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedFile,
+		Name: "encryptedFile",
+		Type: "EncryptedFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"size", "Size", "int", -1},
+			{"dc_id", "DcID", "int", -1},
+			{"key_fingerprint", "KeyFingerprint", "int", -1},
+		},
+		New: func() TL { return TL_encryptedFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputEncryptedFileEmpty,
+		Name: "inputEncryptedFileEmpty",
+		Type: "InputEncryptedFile",
+		New:  func() TL { return TL_inputEncryptedFileEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputEncryptedFileUploaded,
+		Name: "inputEncryptedFileUploaded",
+		Type: "InputEncryptedFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"parts", "Parts", "int", -1},
+			{"md5_checksum", "Md5Checksum", "string", -1},
+			{"key_fingerprint", "KeyFingerprint", "int", -1},
+		},
+		New: func() TL { return TL_inputEncryptedFileUploaded{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputEncryptedFile,
+		Name: "inputEncryptedFile",
+		Type: "InputEncryptedFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputEncryptedFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputEncryptedFileBigUploaded,
+		Name: "inputEncryptedFileBigUploaded",
+		Type: "InputEncryptedFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"parts", "Parts", "int", -1},
+			{"key_fingerprint", "KeyFingerprint", "int", -1},
+		},
+		New: func() TL { return TL_inputEncryptedFileBigUploaded{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedMessage,
+		Name: "encryptedMessage",
+		Type: "EncryptedMessage",
+		Fields: []FieldInfo{
+			{"random_id", "RandomID", "long", -1},
+			{"chat_id", "ChatID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+			{"file", "File", "EncryptedFile", -1},
+		},
+		New: func() TL { return TL_encryptedMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_encryptedMessageService,
+		Name: "encryptedMessageService",
+		Type: "EncryptedMessage",
+		Fields: []FieldInfo{
+			{"random_id", "RandomID", "long", -1},
+			{"chat_id", "ChatID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_encryptedMessageService{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_dhConfigNotModified,
+		Name: "messages.dhConfigNotModified",
+		Type: "messages.DhConfig",
+		Fields: []FieldInfo{
+			{"random", "Random", "bytes", -1},
+		},
+		New: func() TL { return TL_messages_dhConfigNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_dhConfig,
+		Name: "messages.dhConfig",
+		Type: "messages.DhConfig",
+		Fields: []FieldInfo{
+			{"g", "G", "int", -1},
+			{"p", "P", "bytes", -1},
+			{"version", "Version", "int", -1},
+			{"random", "Random", "bytes", -1},
+		},
+		New: func() TL { return TL_messages_dhConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_sentEncryptedMessage,
+		Name: "messages.sentEncryptedMessage",
+		Type: "messages.SentEncryptedMessage",
+		Fields: []FieldInfo{
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_messages_sentEncryptedMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_sentEncryptedFile,
+		Name: "messages.sentEncryptedFile",
+		Type: "messages.SentEncryptedMessage",
+		Fields: []FieldInfo{
+			{"date", "Date", "int", -1},
+			{"file", "File", "EncryptedFile", -1},
+		},
+		New: func() TL { return TL_messages_sentEncryptedFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputDocumentEmpty,
+		Name: "inputDocumentEmpty",
+		Type: "InputDocument",
+		New:  func() TL { return TL_inputDocumentEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputDocument,
+		Name: "inputDocument",
+		Type: "InputDocument",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"file_reference", "FileReference", "bytes", -1},
+		},
+		New: func() TL { return TL_inputDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_documentEmpty,
+		Name: "documentEmpty",
+		Type: "Document",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+		},
+		New: func() TL { return TL_documentEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_document,
+		Name: "document",
+		Type: "Document",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"file_reference", "FileReference", "bytes", -1},
+			{"date", "Date", "int", -1},
+			{"mime_type", "MimeType", "string", -1},
+			{"size", "Size", "int", -1},
+			{"thumbs", "Thumbs", "Vector<PhotoSize>", 0},
+			{"video_thumbs", "VideoThumbs", "Vector<VideoSize>", 1},
+			{"dc_id", "DcID", "int", -1},
+			{"attributes", "Attributes", "Vector<DocumentAttribute>", -1},
+		},
+		New: func() TL { return TL_document{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_support,
+		Name: "help.support",
+		Type: "help.Support",
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"user", "User", "User", -1},
+		},
+		New: func() TL { return TL_help_support{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_notifyPeer,
+		Name: "notifyPeer",
+		Type: "NotifyPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+		},
+		New: func() TL { return TL_notifyPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_notifyUsers,
+		Name: "notifyUsers",
+		Type: "NotifyPeer",
+		New:  func() TL { return TL_notifyUsers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_notifyChats,
+		Name: "notifyChats",
+		Type: "NotifyPeer",
+		New:  func() TL { return TL_notifyChats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_notifyBroadcasts,
+		Name: "notifyBroadcasts",
+		Type: "NotifyPeer",
+		New:  func() TL { return TL_notifyBroadcasts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageTypingAction,
+		Name: "sendMessageTypingAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_sendMessageTypingAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageCancelAction,
+		Name: "sendMessageCancelAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_sendMessageCancelAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageRecordVideoAction,
+		Name: "sendMessageRecordVideoAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_sendMessageRecordVideoAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageUploadVideoAction,
+		Name: "sendMessageUploadVideoAction",
+		Type: "SendMessageAction",
+		Fields: []FieldInfo{
+			{"progress", "Progress", "int", -1},
+		},
+		New: func() TL { return TL_sendMessageUploadVideoAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageRecordAudioAction,
+		Name: "sendMessageRecordAudioAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_sendMessageRecordAudioAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageUploadAudioAction,
+		Name: "sendMessageUploadAudioAction",
+		Type: "SendMessageAction",
+		Fields: []FieldInfo{
+			{"progress", "Progress", "int", -1},
+		},
+		New: func() TL { return TL_sendMessageUploadAudioAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageUploadPhotoAction,
+		Name: "sendMessageUploadPhotoAction",
+		Type: "SendMessageAction",
+		Fields: []FieldInfo{
+			{"progress", "Progress", "int", -1},
+		},
+		New: func() TL { return TL_sendMessageUploadPhotoAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageUploadDocumentAction,
+		Name: "sendMessageUploadDocumentAction",
+		Type: "SendMessageAction",
+		Fields: []FieldInfo{
+			{"progress", "Progress", "int", -1},
+		},
+		New: func() TL { return TL_sendMessageUploadDocumentAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageGeoLocationAction,
+		Name: "sendMessageGeoLocationAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_sendMessageGeoLocationAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageChooseContactAction,
+		Name: "sendMessageChooseContactAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_sendMessageChooseContactAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageGamePlayAction,
+		Name: "sendMessageGamePlayAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_sendMessageGamePlayAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageRecordRoundAction,
+		Name: "sendMessageRecordRoundAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_sendMessageRecordRoundAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageUploadRoundAction,
+		Name: "sendMessageUploadRoundAction",
+		Type: "SendMessageAction",
+		Fields: []FieldInfo{
+			{"progress", "Progress", "int", -1},
+		},
+		New: func() TL { return TL_sendMessageUploadRoundAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_speakingInGroupCallAction,
+		Name: "speakingInGroupCallAction",
+		Type: "SendMessageAction",
+		New:  func() TL { return TL_speakingInGroupCallAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_sendMessageHistoryImportAction,
+		Name: "sendMessageHistoryImportAction",
+		Type: "SendMessageAction",
+		Fields: []FieldInfo{
+			{"progress", "Progress", "int", -1},
+		},
+		New: func() TL { return TL_sendMessageHistoryImportAction{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_found,
+		Name: "contacts.found",
+		Type: "contacts.Found",
+		Fields: []FieldInfo{
+			{"my_results", "MyResults", "Vector<Peer>", -1},
+			{"results", "Results", "Vector<Peer>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_contacts_found{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyKeyStatusTimestamp,
+		Name: "inputPrivacyKeyStatusTimestamp",
+		Type: "InputPrivacyKey",
+		New:  func() TL { return TL_inputPrivacyKeyStatusTimestamp{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyKeyChatInvite,
+		Name: "inputPrivacyKeyChatInvite",
+		Type: "InputPrivacyKey",
+		New:  func() TL { return TL_inputPrivacyKeyChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyKeyPhoneCall,
+		Name: "inputPrivacyKeyPhoneCall",
+		Type: "InputPrivacyKey",
+		New:  func() TL { return TL_inputPrivacyKeyPhoneCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyKeyPhoneP2P,
+		Name: "inputPrivacyKeyPhoneP2P",
+		Type: "InputPrivacyKey",
+		New:  func() TL { return TL_inputPrivacyKeyPhoneP2P{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyKeyForwards,
+		Name: "inputPrivacyKeyForwards",
+		Type: "InputPrivacyKey",
+		New:  func() TL { return TL_inputPrivacyKeyForwards{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyKeyProfilePhoto,
+		Name: "inputPrivacyKeyProfilePhoto",
+		Type: "InputPrivacyKey",
+		New:  func() TL { return TL_inputPrivacyKeyProfilePhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyKeyPhoneNumber,
+		Name: "inputPrivacyKeyPhoneNumber",
+		Type: "InputPrivacyKey",
+		New:  func() TL { return TL_inputPrivacyKeyPhoneNumber{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyKeyAddedByPhone,
+		Name: "inputPrivacyKeyAddedByPhone",
+		Type: "InputPrivacyKey",
+		New:  func() TL { return TL_inputPrivacyKeyAddedByPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyKeyStatusTimestamp,
+		Name: "privacyKeyStatusTimestamp",
+		Type: "PrivacyKey",
+		New:  func() TL { return TL_privacyKeyStatusTimestamp{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyKeyChatInvite,
+		Name: "privacyKeyChatInvite",
+		Type: "PrivacyKey",
+		New:  func() TL { return TL_privacyKeyChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyKeyPhoneCall,
+		Name: "privacyKeyPhoneCall",
+		Type: "PrivacyKey",
+		New:  func() TL { return TL_privacyKeyPhoneCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyKeyPhoneP2P,
+		Name: "privacyKeyPhoneP2P",
+		Type: "PrivacyKey",
+		New:  func() TL { return TL_privacyKeyPhoneP2P{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyKeyForwards,
+		Name: "privacyKeyForwards",
+		Type: "PrivacyKey",
+		New:  func() TL { return TL_privacyKeyForwards{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyKeyProfilePhoto,
+		Name: "privacyKeyProfilePhoto",
+		Type: "PrivacyKey",
+		New:  func() TL { return TL_privacyKeyProfilePhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyKeyPhoneNumber,
+		Name: "privacyKeyPhoneNumber",
+		Type: "PrivacyKey",
+		New:  func() TL { return TL_privacyKeyPhoneNumber{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyKeyAddedByPhone,
+		Name: "privacyKeyAddedByPhone",
+		Type: "PrivacyKey",
+		New:  func() TL { return TL_privacyKeyAddedByPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyValueAllowContacts,
+		Name: "inputPrivacyValueAllowContacts",
+		Type: "InputPrivacyRule",
+		New:  func() TL { return TL_inputPrivacyValueAllowContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyValueAllowAll,
+		Name: "inputPrivacyValueAllowAll",
+		Type: "InputPrivacyRule",
+		New:  func() TL { return TL_inputPrivacyValueAllowAll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyValueAllowUsers,
+		Name: "inputPrivacyValueAllowUsers",
+		Type: "InputPrivacyRule",
+		Fields: []FieldInfo{
+			{"users", "Users", "Vector<InputUser>", -1},
+		},
+		New: func() TL { return TL_inputPrivacyValueAllowUsers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyValueDisallowContacts,
+		Name: "inputPrivacyValueDisallowContacts",
+		Type: "InputPrivacyRule",
+		New:  func() TL { return TL_inputPrivacyValueDisallowContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyValueDisallowAll,
+		Name: "inputPrivacyValueDisallowAll",
+		Type: "InputPrivacyRule",
+		New:  func() TL { return TL_inputPrivacyValueDisallowAll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyValueDisallowUsers,
+		Name: "inputPrivacyValueDisallowUsers",
+		Type: "InputPrivacyRule",
+		Fields: []FieldInfo{
+			{"users", "Users", "Vector<InputUser>", -1},
+		},
+		New: func() TL { return TL_inputPrivacyValueDisallowUsers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyValueAllowChatParticipants,
+		Name: "inputPrivacyValueAllowChatParticipants",
+		Type: "InputPrivacyRule",
+		Fields: []FieldInfo{
+			{"chats", "Chats", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_inputPrivacyValueAllowChatParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPrivacyValueDisallowChatParticipants,
+		Name: "inputPrivacyValueDisallowChatParticipants",
+		Type: "InputPrivacyRule",
+		Fields: []FieldInfo{
+			{"chats", "Chats", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_inputPrivacyValueDisallowChatParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyValueAllowContacts,
+		Name: "privacyValueAllowContacts",
+		Type: "PrivacyRule",
+		New:  func() TL { return TL_privacyValueAllowContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyValueAllowAll,
+		Name: "privacyValueAllowAll",
+		Type: "PrivacyRule",
+		New:  func() TL { return TL_privacyValueAllowAll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyValueAllowUsers,
+		Name: "privacyValueAllowUsers",
+		Type: "PrivacyRule",
+		Fields: []FieldInfo{
+			{"users", "Users", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_privacyValueAllowUsers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyValueDisallowContacts,
+		Name: "privacyValueDisallowContacts",
+		Type: "PrivacyRule",
+		New:  func() TL { return TL_privacyValueDisallowContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyValueDisallowAll,
+		Name: "privacyValueDisallowAll",
+		Type: "PrivacyRule",
+		New:  func() TL { return TL_privacyValueDisallowAll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyValueDisallowUsers,
+		Name: "privacyValueDisallowUsers",
+		Type: "PrivacyRule",
+		Fields: []FieldInfo{
+			{"users", "Users", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_privacyValueDisallowUsers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyValueAllowChatParticipants,
+		Name: "privacyValueAllowChatParticipants",
+		Type: "PrivacyRule",
+		Fields: []FieldInfo{
+			{"chats", "Chats", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_privacyValueAllowChatParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_privacyValueDisallowChatParticipants,
+		Name: "privacyValueDisallowChatParticipants",
+		Type: "PrivacyRule",
+		Fields: []FieldInfo{
+			{"chats", "Chats", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_privacyValueDisallowChatParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_privacyRules,
+		Name: "account.privacyRules",
+		Type: "account.PrivacyRules",
+		Fields: []FieldInfo{
+			{"rules", "Rules", "Vector<PrivacyRule>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_account_privacyRules{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_accountDaysTTL,
+		Name: "accountDaysTTL",
+		Type: "AccountDaysTTL",
+		Fields: []FieldInfo{
+			{"days", "Days", "int", -1},
+		},
+		New: func() TL { return TL_accountDaysTTL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_documentAttributeImageSize,
+		Name: "documentAttributeImageSize",
+		Type: "DocumentAttribute",
+		Fields: []FieldInfo{
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+		},
+		New: func() TL { return TL_documentAttributeImageSize{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_documentAttributeAnimated,
+		Name: "documentAttributeAnimated",
+		Type: "DocumentAttribute",
+		New:  func() TL { return TL_documentAttributeAnimated{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_documentAttributeSticker,
+		Name: "documentAttributeSticker",
+		Type: "DocumentAttribute",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"mask", "Mask", "true", 1},
+			{"alt", "Alt", "string", -1},
+			{"stickerset", "Stickerset", "InputStickerSet", -1},
+			{"mask_coords", "MaskCoords", "MaskCoords", 0},
+		},
+		New: func() TL { return TL_documentAttributeSticker{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_documentAttributeVideo,
+		Name: "documentAttributeVideo",
+		Type: "DocumentAttribute",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"round_message", "RoundMessage", "true", 0},
+			{"supports_streaming", "SupportsStreaming", "true", 1},
+			{"duration", "Duration", "int", -1},
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+		},
+		New: func() TL { return TL_documentAttributeVideo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_documentAttributeAudio,
+		Name: "documentAttributeAudio",
+		Type: "DocumentAttribute",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"voice", "Voice", "true", 10},
+			{"duration", "Duration", "int", -1},
+			{"title", "Title", "string", 0},
+			{"performer", "Performer", "string", 1},
+			{"waveform", "Waveform", "bytes", 2},
+		},
+		New: func() TL { return TL_documentAttributeAudio{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_documentAttributeFilename,
+		Name: "documentAttributeFilename",
+		Type: "DocumentAttribute",
+		Fields: []FieldInfo{
+			{"file_name", "FileName", "string", -1},
+		},
+		New: func() TL { return TL_documentAttributeFilename{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_documentAttributeHasStickers,
+		Name: "documentAttributeHasStickers",
+		Type: "DocumentAttribute",
+		New:  func() TL { return TL_documentAttributeHasStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_stickersNotModified,
+		Name: "messages.stickersNotModified",
+		Type: "messages.Stickers",
+		New:  func() TL { return TL_messages_stickersNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_stickers,
+		Name: "messages.stickers",
+		Type: "messages.Stickers",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"stickers", "Stickers", "Vector<Document>", -1},
+		},
+		New: func() TL { return TL_messages_stickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_stickerPack,
+		Name: "stickerPack",
+		Type: "StickerPack",
+		Fields: []FieldInfo{
+			{"emoticon", "Emoticon", "string", -1},
+			{"documents", "Documents", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_stickerPack{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_allStickersNotModified,
+		Name: "messages.allStickersNotModified",
+		Type: "messages.AllStickers",
+		New:  func() TL { return TL_messages_allStickersNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_allStickers,
+		Name: "messages.allStickers",
+		Type: "messages.AllStickers",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"sets", "Sets", "Vector<StickerSet>", -1},
+		},
+		New: func() TL { return TL_messages_allStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_affectedMessages,
+		Name: "messages.affectedMessages",
+		Type: "messages.AffectedMessages",
+		Fields: []FieldInfo{
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+		},
+		New: func() TL { return TL_messages_affectedMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_webPageEmpty,
+		Name: "webPageEmpty",
+		Type: "WebPage",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+		},
+		New: func() TL { return TL_webPageEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_webPagePending,
+		Name: "webPagePending",
+		Type: "WebPage",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_webPagePending{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_webPage,
+		Name: "webPage",
+		Type: "WebPage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "long", -1},
+			{"url", "Url", "string", -1},
+			{"display_url", "DisplayUrl", "string", -1},
+			{"hash", "Hash", "int", -1},
+			{"type", "Type", "string", 0},
+			{"site_name", "SiteName", "string", 1},
+			{"title", "Title", "string", 2},
+			{"description", "Description", "string", 3},
+			{"photo", "Photo", "Photo", 4},
+			{"embed_url", "EmbedUrl", "string", 5},
+			{"embed_type", "EmbedType", "string", 5},
+			{"embed_width", "EmbedWidth", "int", 6},
+			{"embed_height", "EmbedHeight", "int", 6},
+			{"duration", "Duration", "int", 7},
+			{"author", "Author", "string", 8},
+			{"document", "Document", "Document", 9},
+			{"cached_page", "CachedPage", "Page", 10},
+			{"attributes", "Attributes", "Vector<WebPageAttribute>", 12},
+		},
+		New: func() TL { return TL_webPage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_webPageNotModified,
+		Name: "webPageNotModified",
+		Type: "WebPage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"cached_page_views", "CachedPageViews", "int", 0},
+		},
+		New: func() TL { return TL_webPageNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_authorization,
+		Name: "authorization",
+		Type: "Authorization",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"current", "Current", "true", 0},
+			{"official_app", "OfficialApp", "true", 1},
+			{"password_pending", "PasswordPending", "true", 2},
+			{"hash", "Hash", "long", -1},
+			{"device_model", "DeviceModel", "string", -1},
+			{"platform", "Platform", "string", -1},
+			{"system_version", "SystemVersion", "string", -1},
+			{"api_id", "ApiID", "int", -1},
+			{"app_name", "AppName", "string", -1},
+			{"app_version", "AppVersion", "string", -1},
+			{"date_created", "DateCreated", "int", -1},
+			{"date_active", "DateActive", "int", -1},
+			{"ip", "Ip", "string", -1},
+			{"country", "Country", "string", -1},
+			{"region", "Region", "string", -1},
+		},
+		New: func() TL { return TL_authorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_authorizations,
+		Name: "account.authorizations",
+		Type: "account.Authorizations",
+		Fields: []FieldInfo{
+			{"authorizations", "Authorizations", "Vector<Authorization>", -1},
+		},
+		New: func() TL { return TL_account_authorizations{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_password,
+		Name: "account.password",
+		Type: "account.Password",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"has_recovery", "HasRecovery", "true", 0},
+			{"has_secure_values", "HasSecureValues", "true", 1},
+			{"has_password", "HasPassword", "true", 2},
+			{"current_algo", "CurrentAlgo", "PasswordKdfAlgo", 2},
+			{"srp_B", "SrpB", "bytes", 2},
+			{"srp_id", "SrpID", "long", 2},
+			{"hint", "Hint", "string", 3},
+			{"email_unconfirmed_pattern", "EmailUnconfirmedPattern", "string", 4},
+			{"new_algo", "NewAlgo", "PasswordKdfAlgo", -1},
+			{"new_secure_algo", "NewSecureAlgo", "SecurePasswordKdfAlgo", -1},
+			{"secure_random", "SecureRandom", "bytes", -1},
+		},
+		New: func() TL { return TL_account_password{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_passwordSettings,
+		Name: "account.passwordSettings",
+		Type: "account.PasswordSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"email", "Email", "string", 0},
+			{"secure_settings", "SecureSettings", "SecureSecretSettings", 1},
+		},
+		New: func() TL { return TL_account_passwordSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_passwordInputSettings,
+		Name: "account.passwordInputSettings",
+		Type: "account.PasswordInputSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"new_algo", "NewAlgo", "PasswordKdfAlgo", 0},
+			{"new_password_hash", "NewPasswordHash", "bytes", 0},
+			{"hint", "Hint", "string", 0},
+			{"email", "Email", "string", 1},
+			{"new_secure_settings", "NewSecureSettings", "SecureSecretSettings", 2},
+		},
+		New: func() TL { return TL_account_passwordInputSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_passwordRecovery,
+		Name: "auth.passwordRecovery",
+		Type: "auth.PasswordRecovery",
+		Fields: []FieldInfo{
+			{"email_pattern", "EmailPattern", "string", -1},
+		},
+		New: func() TL { return TL_auth_passwordRecovery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_receivedNotifyMessage,
+		Name: "receivedNotifyMessage",
+		Type: "ReceivedNotifyMessage",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+			{"flags", "Flags", "int", -1},
+		},
+		New: func() TL { return TL_receivedNotifyMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatInviteExported,
+		Name: "chatInviteExported",
+		Type: "ExportedChatInvite",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"revoked", "Revoked", "true", 0},
+			{"permanent", "Permanent", "true", 5},
+			{"link", "Link", "string", -1},
+			{"admin_id", "AdminID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"start_date", "StartDate", "int", 4},
+			{"expire_date", "ExpireDate", "int", 1},
+			{"usage_limit", "UsageLimit", "int", 2},
+			{"usage", "Usage", "int", 3},
+		},
+		New: func() TL { return TL_chatInviteExported{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatInviteAlready,
+		Name: "chatInviteAlready",
+		Type: "ChatInvite",
+		Fields: []FieldInfo{
+			{"chat", "Chat", "Chat", -1},
+		},
+		New: func() TL { return TL_chatInviteAlready{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatInvite,
+		Name: "chatInvite",
+		Type: "ChatInvite",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"channel", "Channel", "true", 0},
+			{"broadcast", "Broadcast", "true", 1},
+			{"public", "Public", "true", 2},
+			{"megagroup", "Megagroup", "true", 3},
+			{"title", "Title", "string", -1},
+			{"photo", "Photo", "Photo", -1},
+			{"participants_count", "ParticipantsCount", "int", -1},
+			{"participants", "Participants", "Vector<User>", 4},
+		},
+		New: func() TL { return TL_chatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatInvitePeek,
+		Name: "chatInvitePeek",
+		Type: "ChatInvite",
+		Fields: []FieldInfo{
+			{"chat", "Chat", "Chat", -1},
+			{"expires", "Expires", "int", -1},
+		},
+		New: func() TL { return TL_chatInvitePeek{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickerSetEmpty,
+		Name: "inputStickerSetEmpty",
+		Type: "InputStickerSet",
+		New:  func() TL { return TL_inputStickerSetEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickerSetID,
+		Name: "inputStickerSetID",
+		Type: "InputStickerSet",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputStickerSetID{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickerSetShortName,
+		Name: "inputStickerSetShortName",
+		Type: "InputStickerSet",
+		Fields: []FieldInfo{
+			{"short_name", "ShortName", "string", -1},
+		},
+		New: func() TL { return TL_inputStickerSetShortName{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickerSetAnimatedEmoji,
+		Name: "inputStickerSetAnimatedEmoji",
+		Type: "InputStickerSet",
+		New:  func() TL { return TL_inputStickerSetAnimatedEmoji{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickerSetDice,
+		Name: "inputStickerSetDice",
+		Type: "InputStickerSet",
+		Fields: []FieldInfo{
+			{"emoticon", "Emoticon", "string", -1},
+		},
+		New: func() TL { return TL_inputStickerSetDice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_stickerSet,
+		Name: "stickerSet",
+		Type: "StickerSet",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"archived", "Archived", "true", 1},
+			{"official", "Official", "true", 2},
+			{"masks", "Masks", "true", 3},
+			{"animated", "Animated", "true", 5},
+			{"installed_date", "InstalledDate", "int", 0},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"title", "Title", "string", -1},
+			{"short_name", "ShortName", "string", -1},
+			{"thumbs", "Thumbs", "Vector<PhotoSize>", 4},
+			{"thumb_dc_id", "ThumbDcID", "int", 4},
+			{"count", "Count", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_stickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_stickerSet,
+		Name: "messages.stickerSet",
+		Type: "messages.StickerSet",
+		Fields: []FieldInfo{
+			{"set", "Set", "StickerSet", -1},
+			{"packs", "Packs", "Vector<StickerPack>", -1},
+			{"documents", "Documents", "Vector<Document>", -1},
+		},
+		New: func() TL { return TL_messages_stickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botCommand,
+		Name: "botCommand",
+		Type: "BotCommand",
+		Fields: []FieldInfo{
+			{"command", "Command", "string", -1},
+			{"description", "Description", "string", -1},
+		},
+		New: func() TL { return TL_botCommand{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botInfo,
+		Name: "botInfo",
+		Type: "BotInfo",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"description", "Description", "string", -1},
+			{"commands", "Commands", "Vector<BotCommand>", -1},
+		},
+		New: func() TL { return TL_botInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButton,
+		Name: "keyboardButton",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_keyboardButton{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonUrl,
+		Name: "keyboardButtonUrl",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+			{"url", "Url", "string", -1},
+		},
+		New: func() TL { return TL_keyboardButtonUrl{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonCallback,
+		Name: "keyboardButtonCallback",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"requires_password", "RequiresPassword", "true", 0},
+			{"text", "Text", "string", -1},
+			{"data", "Data", "bytes", -1},
+		},
+		New: func() TL { return TL_keyboardButtonCallback{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonRequestPhone,
+		Name: "keyboardButtonRequestPhone",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_keyboardButtonRequestPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonRequestGeoLocation,
+		Name: "keyboardButtonRequestGeoLocation",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_keyboardButtonRequestGeoLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonSwitchInline,
+		Name: "keyboardButtonSwitchInline",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"same_peer", "SamePeer", "true", 0},
+			{"text", "Text", "string", -1},
+			{"query", "Query", "string", -1},
+		},
+		New: func() TL { return TL_keyboardButtonSwitchInline{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonGame,
+		Name: "keyboardButtonGame",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_keyboardButtonGame{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonBuy,
+		Name: "keyboardButtonBuy",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_keyboardButtonBuy{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonUrlAuth,
+		Name: "keyboardButtonUrlAuth",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"text", "Text", "string", -1},
+			{"fwd_text", "FwdText", "string", 0},
+			{"url", "Url", "string", -1},
+			{"button_id", "ButtonID", "int", -1},
+		},
+		New: func() TL { return TL_keyboardButtonUrlAuth{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputKeyboardButtonUrlAuth,
+		Name: "inputKeyboardButtonUrlAuth",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"request_write_access", "RequestWriteAccess", "true", 0},
+			{"text", "Text", "string", -1},
+			{"fwd_text", "FwdText", "string", 1},
+			{"url", "Url", "string", -1},
+			{"bot", "Bot", "InputUser", -1},
+		},
+		New: func() TL { return TL_inputKeyboardButtonUrlAuth{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonRequestPoll,
+		Name: "keyboardButtonRequestPoll",
+		Type: "KeyboardButton",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"quiz", "Quiz", "Bool", 0},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_keyboardButtonRequestPoll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_keyboardButtonRow,
+		Name: "keyboardButtonRow",
+		Type: "KeyboardButtonRow",
+		Fields: []FieldInfo{
+			{"buttons", "Buttons", "Vector<KeyboardButton>", -1},
+		},
+		New: func() TL { return TL_keyboardButtonRow{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_replyKeyboardHide,
+		Name: "replyKeyboardHide",
+		Type: "ReplyMarkup",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"selective", "Selective", "true", 2},
+		},
+		New: func() TL { return TL_replyKeyboardHide{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_replyKeyboardForceReply,
+		Name: "replyKeyboardForceReply",
+		Type: "ReplyMarkup",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"single_use", "SingleUse", "true", 1},
+			{"selective", "Selective", "true", 2},
+		},
+		New: func() TL { return TL_replyKeyboardForceReply{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_replyKeyboardMarkup,
+		Name: "replyKeyboardMarkup",
+		Type: "ReplyMarkup",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"resize", "Resize", "true", 0},
+			{"single_use", "SingleUse", "true", 1},
+			{"selective", "Selective", "true", 2},
+			{"rows", "Rows", "Vector<KeyboardButtonRow>", -1},
+		},
+		New: func() TL { return TL_replyKeyboardMarkup{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_replyInlineMarkup,
+		Name: "replyInlineMarkup",
+		Type: "ReplyMarkup",
+		Fields: []FieldInfo{
+			{"rows", "Rows", "Vector<KeyboardButtonRow>", -1},
+		},
+		New: func() TL { return TL_replyInlineMarkup{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityUnknown,
+		Name: "messageEntityUnknown",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityUnknown{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityMention,
+		Name: "messageEntityMention",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityMention{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityHashtag,
+		Name: "messageEntityHashtag",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityHashtag{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityBotCommand,
+		Name: "messageEntityBotCommand",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityBotCommand{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityUrl,
+		Name: "messageEntityUrl",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityUrl{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityEmail,
+		Name: "messageEntityEmail",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityEmail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityBold,
+		Name: "messageEntityBold",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityBold{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityItalic,
+		Name: "messageEntityItalic",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityItalic{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityCode,
+		Name: "messageEntityCode",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityPre,
+		Name: "messageEntityPre",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+			{"language", "Language", "string", -1},
+		},
+		New: func() TL { return TL_messageEntityPre{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityTextUrl,
+		Name: "messageEntityTextUrl",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+			{"url", "Url", "string", -1},
+		},
+		New: func() TL { return TL_messageEntityTextUrl{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityMentionName,
+		Name: "messageEntityMentionName",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+			{"user_id", "UserID", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityMentionName{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessageEntityMentionName,
+		Name: "inputMessageEntityMentionName",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+			{"user_id", "UserID", "InputUser", -1},
+		},
+		New: func() TL { return TL_inputMessageEntityMentionName{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityPhone,
+		Name: "messageEntityPhone",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityCashtag,
+		Name: "messageEntityCashtag",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityCashtag{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityUnderline,
+		Name: "messageEntityUnderline",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityUnderline{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityStrike,
+		Name: "messageEntityStrike",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityStrike{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityBlockquote,
+		Name: "messageEntityBlockquote",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityBlockquote{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageEntityBankCard,
+		Name: "messageEntityBankCard",
+		Type: "MessageEntity",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_messageEntityBankCard{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputChannelEmpty,
+		Name: "inputChannelEmpty",
+		Type: "InputChannel",
+		New:  func() TL { return TL_inputChannelEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputChannel,
+		Name: "inputChannel",
+		Type: "InputChannel",
+		Fields: []FieldInfo{
+			{"channel_id", "ChannelID", "int", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputChannelFromMessage,
+		Name: "inputChannelFromMessage",
+		Type: "InputChannel",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"channel_id", "ChannelID", "int", -1},
+		},
+		New: func() TL { return TL_inputChannelFromMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_resolvedPeer,
+		Name: "contacts.resolvedPeer",
+		Type: "contacts.ResolvedPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_contacts_resolvedPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageRange,
+		Name: "messageRange",
+		Type: "MessageRange",
+		Fields: []FieldInfo{
+			{"min_id", "MinID", "int", -1},
+			{"max_id", "MaxID", "int", -1},
+		},
+		New: func() TL { return TL_messageRange{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates_channelDifferenceEmpty,
+		Name: "updates.channelDifferenceEmpty",
+		Type: "updates.ChannelDifference",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"final", "Final", "true", 0},
+			{"pts", "Pts", "int", -1},
+			{"timeout", "Timeout", "int", 1},
+		},
+		New: func() TL { return TL_updates_channelDifferenceEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates_channelDifferenceTooLong,
+		Name: "updates.channelDifferenceTooLong",
+		Type: "updates.ChannelDifference",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"final", "Final", "true", 0},
+			{"timeout", "Timeout", "int", 1},
+			{"dialog", "Dialog", "Dialog", -1},
+			{"messages", "Messages", "Vector<Message>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_updates_channelDifferenceTooLong{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_updates_channelDifference,
+		Name: "updates.channelDifference",
+		Type: "updates.ChannelDifference",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"final", "Final", "true", 0},
+			{"pts", "Pts", "int", -1},
+			{"timeout", "Timeout", "int", 1},
+			{"new_messages", "NewMessages", "Vector<Message>", -1},
+			{"other_updates", "OtherUpdates", "Vector<Update>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_updates_channelDifference{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelMessagesFilterEmpty,
+		Name: "channelMessagesFilterEmpty",
+		Type: "ChannelMessagesFilter",
+		New:  func() TL { return TL_channelMessagesFilterEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelMessagesFilter,
+		Name: "channelMessagesFilter",
+		Type: "ChannelMessagesFilter",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"exclude_new_messages", "ExcludeNewMessages", "true", 1},
+			{"ranges", "Ranges", "Vector<MessageRange>", -1},
+		},
+		New: func() TL { return TL_channelMessagesFilter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipant,
+		Name: "channelParticipant",
+		Type: "ChannelParticipant",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_channelParticipant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantSelf,
+		Name: "channelParticipantSelf",
+		Type: "ChannelParticipant",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"inviter_id", "InviterID", "int", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_channelParticipantSelf{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantCreator,
+		Name: "channelParticipantCreator",
+		Type: "ChannelParticipant",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"user_id", "UserID", "int", -1},
+			{"admin_rights", "AdminRights", "ChatAdminRights", -1},
+			{"rank", "Rank", "string", 0},
+		},
+		New: func() TL { return TL_channelParticipantCreator{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantAdmin,
+		Name: "channelParticipantAdmin",
+		Type: "ChannelParticipant",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"can_edit", "CanEdit", "true", 0},
+			{"self", "Self", "true", 1},
+			{"user_id", "UserID", "int", -1},
+			{"inviter_id", "InviterID", "int", 1},
+			{"promoted_by", "PromotedBy", "int", -1},
+			{"date", "Date", "int", -1},
+			{"admin_rights", "AdminRights", "ChatAdminRights", -1},
+			{"rank", "Rank", "string", 2},
+		},
+		New: func() TL { return TL_channelParticipantAdmin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantBanned,
+		Name: "channelParticipantBanned",
+		Type: "ChannelParticipant",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"left", "Left", "true", 0},
+			{"peer", "Peer", "Peer", -1},
+			{"kicked_by", "KickedBy", "int", -1},
+			{"date", "Date", "int", -1},
+			{"banned_rights", "BannedRights", "ChatBannedRights", -1},
+		},
+		New: func() TL { return TL_channelParticipantBanned{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantLeft,
+		Name: "channelParticipantLeft",
+		Type: "ChannelParticipant",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+		},
+		New: func() TL { return TL_channelParticipantLeft{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantsRecent,
+		Name: "channelParticipantsRecent",
+		Type: "ChannelParticipantsFilter",
+		New:  func() TL { return TL_channelParticipantsRecent{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantsAdmins,
+		Name: "channelParticipantsAdmins",
+		Type: "ChannelParticipantsFilter",
+		New:  func() TL { return TL_channelParticipantsAdmins{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantsKicked,
+		Name: "channelParticipantsKicked",
+		Type: "ChannelParticipantsFilter",
+		Fields: []FieldInfo{
+			{"q", "Q", "string", -1},
+		},
+		New: func() TL { return TL_channelParticipantsKicked{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantsBots,
+		Name: "channelParticipantsBots",
+		Type: "ChannelParticipantsFilter",
+		New:  func() TL { return TL_channelParticipantsBots{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantsBanned,
+		Name: "channelParticipantsBanned",
+		Type: "ChannelParticipantsFilter",
+		Fields: []FieldInfo{
+			{"q", "Q", "string", -1},
+		},
+		New: func() TL { return TL_channelParticipantsBanned{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantsSearch,
+		Name: "channelParticipantsSearch",
+		Type: "ChannelParticipantsFilter",
+		Fields: []FieldInfo{
+			{"q", "Q", "string", -1},
+		},
+		New: func() TL { return TL_channelParticipantsSearch{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantsContacts,
+		Name: "channelParticipantsContacts",
+		Type: "ChannelParticipantsFilter",
+		Fields: []FieldInfo{
+			{"q", "Q", "string", -1},
+		},
+		New: func() TL { return TL_channelParticipantsContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelParticipantsMentions,
+		Name: "channelParticipantsMentions",
+		Type: "ChannelParticipantsFilter",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"q", "Q", "string", 0},
+			{"top_msg_id", "TopMsgID", "int", 1},
+		},
+		New: func() TL { return TL_channelParticipantsMentions{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channels_channelParticipants,
+		Name: "channels.channelParticipants",
+		Type: "channels.ChannelParticipants",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"participants", "Participants", "Vector<ChannelParticipant>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_channels_channelParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channels_channelParticipantsNotModified,
+		Name: "channels.channelParticipantsNotModified",
+		Type: "channels.ChannelParticipants",
+		New:  func() TL { return TL_channels_channelParticipantsNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channels_channelParticipant,
+		Name: "channels.channelParticipant",
+		Type: "channels.ChannelParticipant",
+		Fields: []FieldInfo{
+			{"participant", "Participant", "ChannelParticipant", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_channels_channelParticipant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_termsOfService,
+		Name: "help.termsOfService",
+		Type: "help.TermsOfService",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"popup", "Popup", "true", 0},
+			{"id", "ID", "DataJSON", -1},
+			{"text", "Text", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", -1},
+			{"min_age_confirm", "MinAgeConfirm", "int", 1},
+		},
+		New: func() TL { return TL_help_termsOfService{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_savedGifsNotModified,
+		Name: "messages.savedGifsNotModified",
+		Type: "messages.SavedGifs",
+		New:  func() TL { return TL_messages_savedGifsNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_savedGifs,
+		Name: "messages.savedGifs",
+		Type: "messages.SavedGifs",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"gifs", "Gifs", "Vector<Document>", -1},
+		},
+		New: func() TL { return TL_messages_savedGifs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineMessageMediaAuto,
+		Name: "inputBotInlineMessageMediaAuto",
+		Type: "InputBotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_inputBotInlineMessageMediaAuto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineMessageText,
+		Name: "inputBotInlineMessageText",
+		Type: "InputBotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"no_webpage", "NoWebpage", "true", 0},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_inputBotInlineMessageText{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineMessageMediaGeo,
+		Name: "inputBotInlineMessageMediaGeo",
+		Type: "InputBotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"geo_point", "GeoPoint", "InputGeoPoint", -1},
+			{"heading", "Heading", "int", 0},
+			{"period", "Period", "int", 1},
+			{"proximity_notification_radius", "ProximityNotificationRadius", "int", 3},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_inputBotInlineMessageMediaGeo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineMessageMediaVenue,
+		Name: "inputBotInlineMessageMediaVenue",
+		Type: "InputBotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"geo_point", "GeoPoint", "InputGeoPoint", -1},
+			{"title", "Title", "string", -1},
+			{"address", "Address", "string", -1},
+			{"provider", "Provider", "string", -1},
+			{"venue_id", "VenueID", "string", -1},
+			{"venue_type", "VenueType", "string", -1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_inputBotInlineMessageMediaVenue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineMessageMediaContact,
+		Name: "inputBotInlineMessageMediaContact",
+		Type: "InputBotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+			{"vcard", "Vcard", "string", -1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_inputBotInlineMessageMediaContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineMessageGame,
+		Name: "inputBotInlineMessageGame",
+		Type: "InputBotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_inputBotInlineMessageGame{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineResult,
+		Name: "inputBotInlineResult",
+		Type: "InputBotInlineResult",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "string", -1},
+			{"type", "Type", "string", -1},
+			{"title", "Title", "string", 1},
+			{"description", "Description", "string", 2},
+			{"url", "Url", "string", 3},
+			{"thumb", "Thumb", "InputWebDocument", 4},
+			{"content", "Content", "InputWebDocument", 5},
+			{"send_message", "SendMessage", "InputBotInlineMessage", -1},
+		},
+		New: func() TL { return TL_inputBotInlineResult{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineResultPhoto,
+		Name: "inputBotInlineResultPhoto",
+		Type: "InputBotInlineResult",
+		Fields: []FieldInfo{
+			{"id", "ID", "string", -1},
+			{"type", "Type", "string", -1},
+			{"photo", "Photo", "InputPhoto", -1},
+			{"send_message", "SendMessage", "InputBotInlineMessage", -1},
+		},
+		New: func() TL { return TL_inputBotInlineResultPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineResultDocument,
+		Name: "inputBotInlineResultDocument",
+		Type: "InputBotInlineResult",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "string", -1},
+			{"type", "Type", "string", -1},
+			{"title", "Title", "string", 1},
+			{"description", "Description", "string", 2},
+			{"document", "Document", "InputDocument", -1},
+			{"send_message", "SendMessage", "InputBotInlineMessage", -1},
+		},
+		New: func() TL { return TL_inputBotInlineResultDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineResultGame,
+		Name: "inputBotInlineResultGame",
+		Type: "InputBotInlineResult",
+		Fields: []FieldInfo{
+			{"id", "ID", "string", -1},
+			{"short_name", "ShortName", "string", -1},
+			{"send_message", "SendMessage", "InputBotInlineMessage", -1},
+		},
+		New: func() TL { return TL_inputBotInlineResultGame{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botInlineMessageMediaAuto,
+		Name: "botInlineMessageMediaAuto",
+		Type: "BotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_botInlineMessageMediaAuto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botInlineMessageText,
+		Name: "botInlineMessageText",
+		Type: "BotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"no_webpage", "NoWebpage", "true", 0},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_botInlineMessageText{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botInlineMessageMediaGeo,
+		Name: "botInlineMessageMediaGeo",
+		Type: "BotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"geo", "Geo", "GeoPoint", -1},
+			{"heading", "Heading", "int", 0},
+			{"period", "Period", "int", 1},
+			{"proximity_notification_radius", "ProximityNotificationRadius", "int", 3},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_botInlineMessageMediaGeo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botInlineMessageMediaVenue,
+		Name: "botInlineMessageMediaVenue",
+		Type: "BotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"geo", "Geo", "GeoPoint", -1},
+			{"title", "Title", "string", -1},
+			{"address", "Address", "string", -1},
+			{"provider", "Provider", "string", -1},
+			{"venue_id", "VenueID", "string", -1},
+			{"venue_type", "VenueType", "string", -1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_botInlineMessageMediaVenue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botInlineMessageMediaContact,
+		Name: "botInlineMessageMediaContact",
+		Type: "BotInlineMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+			{"vcard", "Vcard", "string", -1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+		},
+		New: func() TL { return TL_botInlineMessageMediaContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botInlineResult,
+		Name: "botInlineResult",
+		Type: "BotInlineResult",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "string", -1},
+			{"type", "Type", "string", -1},
+			{"title", "Title", "string", 1},
+			{"description", "Description", "string", 2},
+			{"url", "Url", "string", 3},
+			{"thumb", "Thumb", "WebDocument", 4},
+			{"content", "Content", "WebDocument", 5},
+			{"send_message", "SendMessage", "BotInlineMessage", -1},
+		},
+		New: func() TL { return TL_botInlineResult{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_botInlineMediaResult,
+		Name: "botInlineMediaResult",
+		Type: "BotInlineResult",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "string", -1},
+			{"type", "Type", "string", -1},
+			{"photo", "Photo", "Photo", 0},
+			{"document", "Document", "Document", 1},
+			{"title", "Title", "string", 2},
+			{"description", "Description", "string", 3},
+			{"send_message", "SendMessage", "BotInlineMessage", -1},
+		},
+		New: func() TL { return TL_botInlineMediaResult{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_botResults,
+		Name: "messages.botResults",
+		Type: "messages.BotResults",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"gallery", "Gallery", "true", 0},
+			{"query_id", "QueryID", "long", -1},
+			{"next_offset", "NextOffset", "string", 1},
+			{"switch_pm", "SwitchPm", "InlineBotSwitchPM", 2},
+			{"results", "Results", "Vector<BotInlineResult>", -1},
+			{"cache_time", "CacheTime", "int", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_botResults{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_exportedMessageLink,
+		Name: "exportedMessageLink",
+		Type: "ExportedMessageLink",
+		Fields: []FieldInfo{
+			{"link", "Link", "string", -1},
+			{"html", "Html", "string", -1},
+		},
+		New: func() TL { return TL_exportedMessageLink{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageFwdHeader,
+		Name: "messageFwdHeader",
+		Type: "MessageFwdHeader",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"imported", "Imported", "true", 7},
+			{"from_id", "FromID", "Peer", 0},
+			{"from_name", "FromName", "string", 5},
+			{"date", "Date", "int", -1},
+			{"channel_post", "ChannelPost", "int", 2},
+			{"post_author", "PostAuthor", "string", 3},
+			{"saved_from_peer", "SavedFromPeer", "Peer", 4},
+			{"saved_from_msg_id", "SavedFromMsgID", "int", 4},
+			{"psa_type", "PsaType", "string", 6},
+		},
+		New: func() TL { return TL_messageFwdHeader{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_codeTypeSms,
+		Name: "auth.codeTypeSms",
+		Type: "auth.CodeType",
+		New:  func() TL { return TL_auth_codeTypeSms{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_codeTypeCall,
+		Name: "auth.codeTypeCall",
+		Type: "auth.CodeType",
+		New:  func() TL { return TL_auth_codeTypeCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_codeTypeFlashCall,
+		Name: "auth.codeTypeFlashCall",
+		Type: "auth.CodeType",
+		New:  func() TL { return TL_auth_codeTypeFlashCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_sentCodeTypeApp,
+		Name: "auth.sentCodeTypeApp",
+		Type: "auth.SentCodeType",
+		Fields: []FieldInfo{
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_auth_sentCodeTypeApp{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_sentCodeTypeSms,
+		Name: "auth.sentCodeTypeSms",
+		Type: "auth.SentCodeType",
+		Fields: []FieldInfo{
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_auth_sentCodeTypeSms{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_sentCodeTypeCall,
+		Name: "auth.sentCodeTypeCall",
+		Type: "auth.SentCodeType",
+		Fields: []FieldInfo{
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_auth_sentCodeTypeCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_sentCodeTypeFlashCall,
+		Name: "auth.sentCodeTypeFlashCall",
+		Type: "auth.SentCodeType",
+		Fields: []FieldInfo{
+			{"pattern", "Pattern", "string", -1},
+		},
+		New: func() TL { return TL_auth_sentCodeTypeFlashCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_botCallbackAnswer,
+		Name: "messages.botCallbackAnswer",
+		Type: "messages.BotCallbackAnswer",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"alert", "Alert", "true", 1},
+			{"has_url", "HasUrl", "true", 3},
+			{"native_ui", "NativeUi", "true", 4},
+			{"message", "Message", "string", 0},
+			{"url", "Url", "string", 2},
+			{"cache_time", "CacheTime", "int", -1},
+		},
+		New: func() TL { return TL_messages_botCallbackAnswer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_messageEditData,
+		Name: "messages.messageEditData",
+		Type: "messages.MessageEditData",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"caption", "Caption", "true", 0},
+		},
+		New: func() TL { return TL_messages_messageEditData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputBotInlineMessageID,
+		Name: "inputBotInlineMessageID",
+		Type: "InputBotInlineMessageID",
+		Fields: []FieldInfo{
+			{"dc_id", "DcID", "int", -1},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputBotInlineMessageID{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inlineBotSwitchPM,
+		Name: "inlineBotSwitchPM",
+		Type: "InlineBotSwitchPM",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+			{"start_param", "StartParam", "string", -1},
+		},
+		New: func() TL { return TL_inlineBotSwitchPM{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_peerDialogs,
+		Name: "messages.peerDialogs",
+		Type: "messages.PeerDialogs",
+		Fields: []FieldInfo{
+			{"dialogs", "Dialogs", "Vector<Dialog>", -1},
+			{"messages", "Messages", "Vector<Message>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"state", "State", "updates.State", -1},
+		},
+		New: func() TL { return TL_messages_peerDialogs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeer,
+		Name: "topPeer",
+		Type: "TopPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"rating", "Rating", "double", -1},
+		},
+		New: func() TL { return TL_topPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryBotsPM,
+		Name: "topPeerCategoryBotsPM",
+		Type: "TopPeerCategory",
+		New:  func() TL { return TL_topPeerCategoryBotsPM{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryBotsInline,
+		Name: "topPeerCategoryBotsInline",
+		Type: "TopPeerCategory",
+		New:  func() TL { return TL_topPeerCategoryBotsInline{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryCorrespondents,
+		Name: "topPeerCategoryCorrespondents",
+		Type: "TopPeerCategory",
+		New:  func() TL { return TL_topPeerCategoryCorrespondents{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryGroups,
+		Name: "topPeerCategoryGroups",
+		Type: "TopPeerCategory",
+		New:  func() TL { return TL_topPeerCategoryGroups{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryChannels,
+		Name: "topPeerCategoryChannels",
+		Type: "TopPeerCategory",
+		New:  func() TL { return TL_topPeerCategoryChannels{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryPhoneCalls,
+		Name: "topPeerCategoryPhoneCalls",
+		Type: "TopPeerCategory",
+		New:  func() TL { return TL_topPeerCategoryPhoneCalls{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryForwardUsers,
+		Name: "topPeerCategoryForwardUsers",
+		Type: "TopPeerCategory",
+		New:  func() TL { return TL_topPeerCategoryForwardUsers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryForwardChats,
+		Name: "topPeerCategoryForwardChats",
+		Type: "TopPeerCategory",
+		New:  func() TL { return TL_topPeerCategoryForwardChats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_topPeerCategoryPeers,
+		Name: "topPeerCategoryPeers",
+		Type: "TopPeerCategoryPeers",
+		Fields: []FieldInfo{
+			{"category", "Category", "TopPeerCategory", -1},
+			{"count", "Count", "int", -1},
+			{"peers", "Peers", "Vector<TopPeer>", -1},
+		},
+		New: func() TL { return TL_topPeerCategoryPeers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_topPeersNotModified,
+		Name: "contacts.topPeersNotModified",
+		Type: "contacts.TopPeers",
+		New:  func() TL { return TL_contacts_topPeersNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_topPeers,
+		Name: "contacts.topPeers",
+		Type: "contacts.TopPeers",
+		Fields: []FieldInfo{
+			{"categories", "Categories", "Vector<TopPeerCategoryPeers>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_contacts_topPeers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_contacts_topPeersDisabled,
+		Name: "contacts.topPeersDisabled",
+		Type: "contacts.TopPeers",
+		New:  func() TL { return TL_contacts_topPeersDisabled{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_draftMessageEmpty,
+		Name: "draftMessageEmpty",
+		Type: "DraftMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"date", "Date", "int", 0},
+		},
+		New: func() TL { return TL_draftMessageEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_draftMessage,
+		Name: "draftMessage",
+		Type: "DraftMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"no_webpage", "NoWebpage", "true", 1},
+			{"reply_to_msg_id", "ReplyToMsgID", "int", 0},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 3},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_draftMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_featuredStickersNotModified,
+		Name: "messages.featuredStickersNotModified",
+		Type: "messages.FeaturedStickers",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+		},
+		New: func() TL { return TL_messages_featuredStickersNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_featuredStickers,
+		Name: "messages.featuredStickers",
+		Type: "messages.FeaturedStickers",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"count", "Count", "int", -1},
+			{"sets", "Sets", "Vector<StickerSetCovered>", -1},
+			{"unread", "Unread", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_messages_featuredStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_recentStickersNotModified,
+		Name: "messages.recentStickersNotModified",
+		Type: "messages.RecentStickers",
+		New:  func() TL { return TL_messages_recentStickersNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_recentStickers,
+		Name: "messages.recentStickers",
+		Type: "messages.RecentStickers",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"packs", "Packs", "Vector<StickerPack>", -1},
+			{"stickers", "Stickers", "Vector<Document>", -1},
+			{"dates", "Dates", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_recentStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_archivedStickers,
+		Name: "messages.archivedStickers",
+		Type: "messages.ArchivedStickers",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"sets", "Sets", "Vector<StickerSetCovered>", -1},
+		},
+		New: func() TL { return TL_messages_archivedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_stickerSetInstallResultSuccess,
+		Name: "messages.stickerSetInstallResultSuccess",
+		Type: "messages.StickerSetInstallResult",
+		New:  func() TL { return TL_messages_stickerSetInstallResultSuccess{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_stickerSetInstallResultArchive,
+		Name: "messages.stickerSetInstallResultArchive",
+		Type: "messages.StickerSetInstallResult",
+		Fields: []FieldInfo{
+			{"sets", "Sets", "Vector<StickerSetCovered>", -1},
+		},
+		New: func() TL { return TL_messages_stickerSetInstallResultArchive{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_stickerSetCovered,
+		Name: "stickerSetCovered",
+		Type: "StickerSetCovered",
+		Fields: []FieldInfo{
+			{"set", "Set", "StickerSet", -1},
+			{"cover", "Cover", "Document", -1},
+		},
+		New: func() TL { return TL_stickerSetCovered{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_stickerSetMultiCovered,
+		Name: "stickerSetMultiCovered",
+		Type: "StickerSetCovered",
+		Fields: []FieldInfo{
+			{"set", "Set", "StickerSet", -1},
+			{"covers", "Covers", "Vector<Document>", -1},
+		},
+		New: func() TL { return TL_stickerSetMultiCovered{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_maskCoords,
+		Name: "maskCoords",
+		Type: "MaskCoords",
+		Fields: []FieldInfo{
+			{"n", "N", "int", -1},
+			{"x", "X", "double", -1},
+			{"y", "Y", "double", -1},
+			{"zoom", "Zoom", "double", -1},
+		},
+		New: func() TL { return TL_maskCoords{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickeredMediaPhoto,
+		Name: "inputStickeredMediaPhoto",
+		Type: "InputStickeredMedia",
+		Fields: []FieldInfo{
+			{"id", "ID", "InputPhoto", -1},
+		},
+		New: func() TL { return TL_inputStickeredMediaPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickeredMediaDocument,
+		Name: "inputStickeredMediaDocument",
+		Type: "InputStickeredMedia",
+		Fields: []FieldInfo{
+			{"id", "ID", "InputDocument", -1},
+		},
+		New: func() TL { return TL_inputStickeredMediaDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_game,
+		Name: "game",
+		Type: "Game",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"short_name", "ShortName", "string", -1},
+			{"title", "Title", "string", -1},
+			{"description", "Description", "string", -1},
+			{"photo", "Photo", "Photo", -1},
+			{"document", "Document", "Document", 0},
+		},
+		New: func() TL { return TL_game{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputGameID,
+		Name: "inputGameID",
+		Type: "InputGame",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputGameID{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputGameShortName,
+		Name: "inputGameShortName",
+		Type: "InputGame",
+		Fields: []FieldInfo{
+			{"bot_id", "BotID", "InputUser", -1},
+			{"short_name", "ShortName", "string", -1},
+		},
+		New: func() TL { return TL_inputGameShortName{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_highScore,
+		Name: "highScore",
+		Type: "HighScore",
+		Fields: []FieldInfo{
+			{"pos", "Pos", "int", -1},
+			{"user_id", "UserID", "int", -1},
+			{"score", "Score", "int", -1},
+		},
+		New: func() TL { return TL_highScore{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_highScores,
+		Name: "messages.highScores",
+		Type: "messages.HighScores",
+		Fields: []FieldInfo{
+			{"scores", "Scores", "Vector<HighScore>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_highScores{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textEmpty,
+		Name: "textEmpty",
+		Type: "RichText",
+		New:  func() TL { return TL_textEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textPlain,
+		Name: "textPlain",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_textPlain{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textBold,
+		Name: "textBold",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_textBold{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textItalic,
+		Name: "textItalic",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_textItalic{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textUnderline,
+		Name: "textUnderline",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_textUnderline{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textStrike,
+		Name: "textStrike",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_textStrike{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textFixed,
+		Name: "textFixed",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_textFixed{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textUrl,
+		Name: "textUrl",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+			{"url", "Url", "string", -1},
+			{"webpage_id", "WebpageID", "long", -1},
+		},
+		New: func() TL { return TL_textUrl{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textEmail,
+		Name: "textEmail",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+			{"email", "Email", "string", -1},
+		},
+		New: func() TL { return TL_textEmail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textConcat,
+		Name: "textConcat",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"texts", "Texts", "Vector<RichText>", -1},
+		},
+		New: func() TL { return TL_textConcat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textSubscript,
+		Name: "textSubscript",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_textSubscript{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textSuperscript,
+		Name: "textSuperscript",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_textSuperscript{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textMarked,
+		Name: "textMarked",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_textMarked{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textPhone,
+		Name: "textPhone",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+			{"phone", "Phone", "string", -1},
+		},
+		New: func() TL { return TL_textPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textImage,
+		Name: "textImage",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"document_id", "DocumentID", "long", -1},
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+		},
+		New: func() TL { return TL_textImage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_textAnchor,
+		Name: "textAnchor",
+		Type: "RichText",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+			{"name", "Name", "string", -1},
+		},
+		New: func() TL { return TL_textAnchor{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockUnsupported,
+		Name: "pageBlockUnsupported",
+		Type: "PageBlock",
+		New:  func() TL { return TL_pageBlockUnsupported{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockTitle,
+		Name: "pageBlockTitle",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockTitle{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockSubtitle,
+		Name: "pageBlockSubtitle",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockSubtitle{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockAuthorDate,
+		Name: "pageBlockAuthorDate",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"author", "Author", "RichText", -1},
+			{"published_date", "PublishedDate", "int", -1},
+		},
+		New: func() TL { return TL_pageBlockAuthorDate{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockHeader,
+		Name: "pageBlockHeader",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockHeader{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockSubheader,
+		Name: "pageBlockSubheader",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockSubheader{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockParagraph,
+		Name: "pageBlockParagraph",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockParagraph{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockPreformatted,
+		Name: "pageBlockPreformatted",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+			{"language", "Language", "string", -1},
+		},
+		New: func() TL { return TL_pageBlockPreformatted{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockFooter,
+		Name: "pageBlockFooter",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockFooter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockDivider,
+		Name: "pageBlockDivider",
+		Type: "PageBlock",
+		New:  func() TL { return TL_pageBlockDivider{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockAnchor,
+		Name: "pageBlockAnchor",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"name", "Name", "string", -1},
+		},
+		New: func() TL { return TL_pageBlockAnchor{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockList,
+		Name: "pageBlockList",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"items", "Items", "Vector<PageListItem>", -1},
+		},
+		New: func() TL { return TL_pageBlockList{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockBlockquote,
+		Name: "pageBlockBlockquote",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+			{"caption", "Caption", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockBlockquote{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockPullquote,
+		Name: "pageBlockPullquote",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+			{"caption", "Caption", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockPullquote{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockPhoto,
+		Name: "pageBlockPhoto",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"photo_id", "PhotoID", "long", -1},
+			{"caption", "Caption", "PageCaption", -1},
+			{"url", "Url", "string", 0},
+			{"webpage_id", "WebpageID", "long", 0},
+		},
+		New: func() TL { return TL_pageBlockPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockVideo,
+		Name: "pageBlockVideo",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"autoplay", "Autoplay", "true", 0},
+			{"loop", "Loop", "true", 1},
+			{"video_id", "VideoID", "long", -1},
+			{"caption", "Caption", "PageCaption", -1},
+		},
+		New: func() TL { return TL_pageBlockVideo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockCover,
+		Name: "pageBlockCover",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"cover", "Cover", "PageBlock", -1},
+		},
+		New: func() TL { return TL_pageBlockCover{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockEmbed,
+		Name: "pageBlockEmbed",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"full_width", "FullWidth", "true", 0},
+			{"allow_scrolling", "AllowScrolling", "true", 3},
+			{"url", "Url", "string", 1},
+			{"html", "Html", "string", 2},
+			{"poster_photo_id", "PosterPhotoID", "long", 4},
+			{"w", "W", "int", 5},
+			{"h", "H", "int", 5},
+			{"caption", "Caption", "PageCaption", -1},
+		},
+		New: func() TL { return TL_pageBlockEmbed{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockEmbedPost,
+		Name: "pageBlockEmbedPost",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"webpage_id", "WebpageID", "long", -1},
+			{"author_photo_id", "AuthorPhotoID", "long", -1},
+			{"author", "Author", "string", -1},
+			{"date", "Date", "int", -1},
+			{"blocks", "Blocks", "Vector<PageBlock>", -1},
+			{"caption", "Caption", "PageCaption", -1},
+		},
+		New: func() TL { return TL_pageBlockEmbedPost{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockCollage,
+		Name: "pageBlockCollage",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"items", "Items", "Vector<PageBlock>", -1},
+			{"caption", "Caption", "PageCaption", -1},
+		},
+		New: func() TL { return TL_pageBlockCollage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockSlideshow,
+		Name: "pageBlockSlideshow",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"items", "Items", "Vector<PageBlock>", -1},
+			{"caption", "Caption", "PageCaption", -1},
+		},
+		New: func() TL { return TL_pageBlockSlideshow{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockChannel,
+		Name: "pageBlockChannel",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"channel", "Channel", "Chat", -1},
+		},
+		New: func() TL { return TL_pageBlockChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockAudio,
+		Name: "pageBlockAudio",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"audio_id", "AudioID", "long", -1},
+			{"caption", "Caption", "PageCaption", -1},
+		},
+		New: func() TL { return TL_pageBlockAudio{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockKicker,
+		Name: "pageBlockKicker",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockKicker{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockTable,
+		Name: "pageBlockTable",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"bordered", "Bordered", "true", 0},
+			{"striped", "Striped", "true", 1},
+			{"title", "Title", "RichText", -1},
+			{"rows", "Rows", "Vector<PageTableRow>", -1},
+		},
+		New: func() TL { return TL_pageBlockTable{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockOrderedList,
+		Name: "pageBlockOrderedList",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"items", "Items", "Vector<PageListOrderedItem>", -1},
+		},
+		New: func() TL { return TL_pageBlockOrderedList{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockDetails,
+		Name: "pageBlockDetails",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"open", "Open", "true", 0},
+			{"blocks", "Blocks", "Vector<PageBlock>", -1},
+			{"title", "Title", "RichText", -1},
+		},
+		New: func() TL { return TL_pageBlockDetails{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockRelatedArticles,
+		Name: "pageBlockRelatedArticles",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"title", "Title", "RichText", -1},
+			{"articles", "Articles", "Vector<PageRelatedArticle>", -1},
+		},
+		New: func() TL { return TL_pageBlockRelatedArticles{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageBlockMap,
+		Name: "pageBlockMap",
+		Type: "PageBlock",
+		Fields: []FieldInfo{
+			{"geo", "Geo", "GeoPoint", -1},
+			{"zoom", "Zoom", "int", -1},
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+			{"caption", "Caption", "PageCaption", -1},
+		},
+		New: func() TL { return TL_pageBlockMap{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallDiscardReasonMissed,
+		Name: "phoneCallDiscardReasonMissed",
+		Type: "PhoneCallDiscardReason",
+		New:  func() TL { return TL_phoneCallDiscardReasonMissed{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallDiscardReasonDisconnect,
+		Name: "phoneCallDiscardReasonDisconnect",
+		Type: "PhoneCallDiscardReason",
+		New:  func() TL { return TL_phoneCallDiscardReasonDisconnect{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallDiscardReasonHangup,
+		Name: "phoneCallDiscardReasonHangup",
+		Type: "PhoneCallDiscardReason",
+		New:  func() TL { return TL_phoneCallDiscardReasonHangup{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallDiscardReasonBusy,
+		Name: "phoneCallDiscardReasonBusy",
+		Type: "PhoneCallDiscardReason",
+		New:  func() TL { return TL_phoneCallDiscardReasonBusy{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dataJSON,
+		Name: "dataJSON",
+		Type: "DataJSON",
+		Fields: []FieldInfo{
+			{"data", "Data", "string", -1},
+		},
+		New: func() TL { return TL_dataJSON{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_labeledPrice,
+		Name: "labeledPrice",
+		Type: "LabeledPrice",
+		Fields: []FieldInfo{
+			{"label", "Label", "string", -1},
+			{"amount", "Amount", "long", -1},
+		},
+		New: func() TL { return TL_labeledPrice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_invoice,
+		Name: "invoice",
+		Type: "Invoice",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"test", "Test", "true", 0},
+			{"name_requested", "NameRequested", "true", 1},
+			{"phone_requested", "PhoneRequested", "true", 2},
+			{"email_requested", "EmailRequested", "true", 3},
+			{"shipping_address_requested", "ShippingAddressRequested", "true", 4},
+			{"flexible", "Flexible", "true", 5},
+			{"phone_to_provider", "PhoneToProvider", "true", 6},
+			{"email_to_provider", "EmailToProvider", "true", 7},
+			{"currency", "Currency", "string", -1},
+			{"prices", "Prices", "Vector<LabeledPrice>", -1},
+		},
+		New: func() TL { return TL_invoice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_paymentCharge,
+		Name: "paymentCharge",
+		Type: "PaymentCharge",
+		Fields: []FieldInfo{
+			{"id", "ID", "string", -1},
+			{"provider_charge_id", "ProviderChargeID", "string", -1},
+		},
+		New: func() TL { return TL_paymentCharge{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_postAddress,
+		Name: "postAddress",
+		Type: "PostAddress",
+		Fields: []FieldInfo{
+			{"street_line1", "StreetLine1", "string", -1},
+			{"street_line2", "StreetLine2", "string", -1},
+			{"city", "City", "string", -1},
+			{"state", "State", "string", -1},
+			{"country_iso2", "CountryIso2", "string", -1},
+			{"post_code", "PostCode", "string", -1},
+		},
+		New: func() TL { return TL_postAddress{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_paymentRequestedInfo,
+		Name: "paymentRequestedInfo",
+		Type: "PaymentRequestedInfo",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"name", "Name", "string", 0},
+			{"phone", "Phone", "string", 1},
+			{"email", "Email", "string", 2},
+			{"shipping_address", "ShippingAddress", "PostAddress", 3},
+		},
+		New: func() TL { return TL_paymentRequestedInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_paymentSavedCredentialsCard,
+		Name: "paymentSavedCredentialsCard",
+		Type: "PaymentSavedCredentials",
+		Fields: []FieldInfo{
+			{"id", "ID", "string", -1},
+			{"title", "Title", "string", -1},
+		},
+		New: func() TL { return TL_paymentSavedCredentialsCard{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_webDocument,
+		Name: "webDocument",
+		Type: "WebDocument",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"size", "Size", "int", -1},
+			{"mime_type", "MimeType", "string", -1},
+			{"attributes", "Attributes", "Vector<DocumentAttribute>", -1},
+		},
+		New: func() TL { return TL_webDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_webDocumentNoProxy,
+		Name: "webDocumentNoProxy",
+		Type: "WebDocument",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"size", "Size", "int", -1},
+			{"mime_type", "MimeType", "string", -1},
+			{"attributes", "Attributes", "Vector<DocumentAttribute>", -1},
+		},
+		New: func() TL { return TL_webDocumentNoProxy{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputWebDocument,
+		Name: "inputWebDocument",
+		Type: "InputWebDocument",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"size", "Size", "int", -1},
+			{"mime_type", "MimeType", "string", -1},
+			{"attributes", "Attributes", "Vector<DocumentAttribute>", -1},
+		},
+		New: func() TL { return TL_inputWebDocument{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputWebFileLocation,
+		Name: "inputWebFileLocation",
+		Type: "InputWebFileLocation",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputWebFileLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputWebFileGeoPointLocation,
+		Name: "inputWebFileGeoPointLocation",
+		Type: "InputWebFileLocation",
+		Fields: []FieldInfo{
+			{"geo_point", "GeoPoint", "InputGeoPoint", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+			{"zoom", "Zoom", "int", -1},
+			{"scale", "Scale", "int", -1},
+		},
+		New: func() TL { return TL_inputWebFileGeoPointLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_upload_webFile,
+		Name: "upload.webFile",
+		Type: "upload.WebFile",
+		Fields: []FieldInfo{
+			{"size", "Size", "int", -1},
+			{"mime_type", "MimeType", "string", -1},
+			{"file_type", "FileType", "storage.FileType", -1},
+			{"mtime", "Mtime", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_upload_webFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_payments_paymentForm,
+		Name: "payments.paymentForm",
+		Type: "payments.PaymentForm",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"can_save_credentials", "CanSaveCredentials", "true", 2},
+			{"password_missing", "PasswordMissing", "true", 3},
+			{"bot_id", "BotID", "int", -1},
+			{"invoice", "Invoice", "Invoice", -1},
+			{"provider_id", "ProviderID", "int", -1},
+			{"url", "Url", "string", -1},
+			{"native_provider", "NativeProvider", "string", 4},
+			{"native_params", "NativeParams", "DataJSON", 4},
+			{"saved_info", "SavedInfo", "PaymentRequestedInfo", 0},
+			{"saved_credentials", "SavedCredentials", "PaymentSavedCredentials", 1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_payments_paymentForm{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_payments_validatedRequestedInfo,
+		Name: "payments.validatedRequestedInfo",
+		Type: "payments.ValidatedRequestedInfo",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "string", 0},
+			{"shipping_options", "ShippingOptions", "Vector<ShippingOption>", 1},
+		},
+		New: func() TL { return TL_payments_validatedRequestedInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_payments_paymentResult,
+		Name: "payments.paymentResult",
+		Type: "payments.PaymentResult",
+		Fields: []FieldInfo{
+			{"updates", "Updates", "Updates", -1},
+		},
+		New: func() TL { return TL_payments_paymentResult{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_payments_paymentVerificationNeeded,
+		Name: "payments.paymentVerificationNeeded",
+		Type: "payments.PaymentResult",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+		},
+		New: func() TL { return TL_payments_paymentVerificationNeeded{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_payments_paymentReceipt,
+		Name: "payments.paymentReceipt",
+		Type: "payments.PaymentReceipt",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"date", "Date", "int", -1},
+			{"bot_id", "BotID", "int", -1},
+			{"invoice", "Invoice", "Invoice", -1},
+			{"provider_id", "ProviderID", "int", -1},
+			{"info", "Info", "PaymentRequestedInfo", 0},
+			{"shipping", "Shipping", "ShippingOption", 1},
+			{"currency", "Currency", "string", -1},
+			{"total_amount", "TotalAmount", "long", -1},
+			{"credentials_title", "CredentialsTitle", "string", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_payments_paymentReceipt{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_payments_savedInfo,
+		Name: "payments.savedInfo",
+		Type: "payments.SavedInfo",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"has_saved_credentials", "HasSavedCredentials", "true", 1},
+			{"saved_info", "SavedInfo", "PaymentRequestedInfo", 0},
+		},
+		New: func() TL { return TL_payments_savedInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPaymentCredentialsSaved,
+		Name: "inputPaymentCredentialsSaved",
+		Type: "InputPaymentCredentials",
+		Fields: []FieldInfo{
+			{"id", "ID", "string", -1},
+			{"tmp_password", "TmpPassword", "bytes", -1},
+		},
+		New: func() TL { return TL_inputPaymentCredentialsSaved{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPaymentCredentials,
+		Name: "inputPaymentCredentials",
+		Type: "InputPaymentCredentials",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"save", "Save", "true", 0},
+			{"data", "Data", "DataJSON", -1},
+		},
+		New: func() TL { return TL_inputPaymentCredentials{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPaymentCredentialsApplePay,
+		Name: "inputPaymentCredentialsApplePay",
+		Type: "InputPaymentCredentials",
+		Fields: []FieldInfo{
+			{"payment_data", "PaymentData", "DataJSON", -1},
+		},
+		New: func() TL { return TL_inputPaymentCredentialsApplePay{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPaymentCredentialsGooglePay,
+		Name: "inputPaymentCredentialsGooglePay",
+		Type: "InputPaymentCredentials",
+		Fields: []FieldInfo{
+			{"payment_token", "PaymentToken", "DataJSON", -1},
+		},
+		New: func() TL { return TL_inputPaymentCredentialsGooglePay{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_tmpPassword,
+		Name: "account.tmpPassword",
+		Type: "account.TmpPassword",
+		Fields: []FieldInfo{
+			{"tmp_password", "TmpPassword", "bytes", -1},
+			{"valid_until", "ValidUntil", "int", -1},
+		},
+		New: func() TL { return TL_account_tmpPassword{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_shippingOption,
+		Name: "shippingOption",
+		Type: "ShippingOption",
+		Fields: []FieldInfo{
+			{"id", "ID", "string", -1},
+			{"title", "Title", "string", -1},
+			{"prices", "Prices", "Vector<LabeledPrice>", -1},
+		},
+		New: func() TL { return TL_shippingOption{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputStickerSetItem,
+		Name: "inputStickerSetItem",
+		Type: "InputStickerSetItem",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"document", "Document", "InputDocument", -1},
+			{"emoji", "Emoji", "string", -1},
+			{"mask_coords", "MaskCoords", "MaskCoords", 0},
+		},
+		New: func() TL { return TL_inputStickerSetItem{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputPhoneCall,
+		Name: "inputPhoneCall",
+		Type: "InputPhoneCall",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputPhoneCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallEmpty,
+		Name: "phoneCallEmpty",
+		Type: "PhoneCall",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+		},
+		New: func() TL { return TL_phoneCallEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallWaiting,
+		Name: "phoneCallWaiting",
+		Type: "PhoneCall",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"video", "Video", "true", 6},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"date", "Date", "int", -1},
+			{"admin_id", "AdminID", "int", -1},
+			{"participant_id", "ParticipantID", "int", -1},
+			{"protocol", "Protocol", "PhoneCallProtocol", -1},
+			{"receive_date", "ReceiveDate", "int", 0},
+		},
+		New: func() TL { return TL_phoneCallWaiting{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallRequested,
+		Name: "phoneCallRequested",
+		Type: "PhoneCall",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"video", "Video", "true", 6},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"date", "Date", "int", -1},
+			{"admin_id", "AdminID", "int", -1},
+			{"participant_id", "ParticipantID", "int", -1},
+			{"g_a_hash", "GAHash", "bytes", -1},
+			{"protocol", "Protocol", "PhoneCallProtocol", -1},
+		},
+		New: func() TL { return TL_phoneCallRequested{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallAccepted,
+		Name: "phoneCallAccepted",
+		Type: "PhoneCall",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"video", "Video", "true", 6},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"date", "Date", "int", -1},
+			{"admin_id", "AdminID", "int", -1},
+			{"participant_id", "ParticipantID", "int", -1},
+			{"g_b", "GB", "bytes", -1},
+			{"protocol", "Protocol", "PhoneCallProtocol", -1},
+		},
+		New: func() TL { return TL_phoneCallAccepted{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCall,
+		Name: "phoneCall",
+		Type: "PhoneCall",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"p2p_allowed", "P2pAllowed", "true", 5},
+			{"video", "Video", "true", 6},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"date", "Date", "int", -1},
+			{"admin_id", "AdminID", "int", -1},
+			{"participant_id", "ParticipantID", "int", -1},
+			{"g_a_or_b", "GAOrB", "bytes", -1},
+			{"key_fingerprint", "KeyFingerprint", "long", -1},
+			{"protocol", "Protocol", "PhoneCallProtocol", -1},
+			{"connections", "Connections", "Vector<PhoneConnection>", -1},
+			{"start_date", "StartDate", "int", -1},
+		},
+		New: func() TL { return TL_phoneCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallDiscarded,
+		Name: "phoneCallDiscarded",
+		Type: "PhoneCall",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"need_rating", "NeedRating", "true", 2},
+			{"need_debug", "NeedDebug", "true", 3},
+			{"video", "Video", "true", 6},
+			{"id", "ID", "long", -1},
+			{"reason", "Reason", "PhoneCallDiscardReason", 0},
+			{"duration", "Duration", "int", 1},
+		},
+		New: func() TL { return TL_phoneCallDiscarded{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneConnection,
+		Name: "phoneConnection",
+		Type: "PhoneConnection",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"ip", "Ip", "string", -1},
+			{"ipv6", "Ipv6", "string", -1},
+			{"port", "Port", "int", -1},
+			{"peer_tag", "PeerTag", "bytes", -1},
+		},
+		New: func() TL { return TL_phoneConnection{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneConnectionWebrtc,
+		Name: "phoneConnectionWebrtc",
+		Type: "PhoneConnection",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"turn", "Turn", "true", 0},
+			{"stun", "Stun", "true", 1},
+			{"id", "ID", "long", -1},
+			{"ip", "Ip", "string", -1},
+			{"ipv6", "Ipv6", "string", -1},
+			{"port", "Port", "int", -1},
+			{"username", "Username", "string", -1},
+			{"password", "Password", "string", -1},
+		},
+		New: func() TL { return TL_phoneConnectionWebrtc{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phoneCallProtocol,
+		Name: "phoneCallProtocol",
+		Type: "PhoneCallProtocol",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"udp_p2p", "UdpP2p", "true", 0},
+			{"udp_reflector", "UdpReflector", "true", 1},
+			{"min_layer", "MinLayer", "int", -1},
+			{"max_layer", "MaxLayer", "int", -1},
+			{"library_versions", "LibraryVersions", "Vector<string>", -1},
+		},
+		New: func() TL { return TL_phoneCallProtocol{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phone_phoneCall,
+		Name: "phone.phoneCall",
+		Type: "phone.PhoneCall",
+		Fields: []FieldInfo{
+			{"phone_call", "PhoneCall", "PhoneCall", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_phone_phoneCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_upload_cdnFileReuploadNeeded,
+		Name: "upload.cdnFileReuploadNeeded",
+		Type: "upload.CdnFile",
+		Fields: []FieldInfo{
+			{"request_token", "RequestToken", "bytes", -1},
+		},
+		New: func() TL { return TL_upload_cdnFileReuploadNeeded{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_upload_cdnFile,
+		Name: "upload.cdnFile",
+		Type: "upload.CdnFile",
+		Fields: []FieldInfo{
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_upload_cdnFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_cdnPublicKey,
+		Name: "cdnPublicKey",
+		Type: "CdnPublicKey",
+		Fields: []FieldInfo{
+			{"dc_id", "DcID", "int", -1},
+			{"public_key", "PublicKey", "string", -1},
+		},
+		New: func() TL { return TL_cdnPublicKey{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_cdnConfig,
+		Name: "cdnConfig",
+		Type: "CdnConfig",
+		Fields: []FieldInfo{
+			{"public_keys", "PublicKeys", "Vector<CdnPublicKey>", -1},
+		},
+		New: func() TL { return TL_cdnConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_langPackString,
+		Name: "langPackString",
+		Type: "LangPackString",
+		Fields: []FieldInfo{
+			{"key", "Key", "string", -1},
+			{"value", "Value", "string", -1},
+		},
+		New: func() TL { return TL_langPackString{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_langPackStringPluralized,
+		Name: "langPackStringPluralized",
+		Type: "LangPackString",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"key", "Key", "string", -1},
+			{"zero_value", "ZeroValue", "string", 0},
+			{"one_value", "OneValue", "string", 1},
+			{"two_value", "TwoValue", "string", 2},
+			{"few_value", "FewValue", "string", 3},
+			{"many_value", "ManyValue", "string", 4},
+			{"other_value", "OtherValue", "string", -1},
+		},
+		New: func() TL { return TL_langPackStringPluralized{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_langPackStringDeleted,
+		Name: "langPackStringDeleted",
+		Type: "LangPackString",
+		Fields: []FieldInfo{
+			{"key", "Key", "string", -1},
+		},
+		New: func() TL { return TL_langPackStringDeleted{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_langPackDifference,
+		Name: "langPackDifference",
+		Type: "LangPackDifference",
+		Fields: []FieldInfo{
+			{"lang_code", "LangCode", "string", -1},
+			{"from_version", "FromVersion", "int", -1},
+			{"version", "Version", "int", -1},
+			{"strings", "Strings", "Vector<LangPackString>", -1},
+		},
+		New: func() TL { return TL_langPackDifference{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_langPackLanguage,
+		Name: "langPackLanguage",
+		Type: "LangPackLanguage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"official", "Official", "true", 0},
+			{"rtl", "Rtl", "true", 2},
+			{"beta", "Beta", "true", 3},
+			{"name", "Name", "string", -1},
+			{"native_name", "NativeName", "string", -1},
+			{"lang_code", "LangCode", "string", -1},
+			{"base_lang_code", "BaseLangCode", "string", 1},
+			{"plural_code", "PluralCode", "string", -1},
+			{"strings_count", "StringsCount", "int", -1},
+			{"translated_count", "TranslatedCount", "int", -1},
+			{"translations_url", "TranslationsUrl", "string", -1},
+		},
+		New: func() TL { return TL_langPackLanguage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionChangeTitle,
+		Name: "channelAdminLogEventActionChangeTitle",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_value", "PrevValue", "string", -1},
+			{"new_value", "NewValue", "string", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionChangeTitle{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionChangeAbout,
+		Name: "channelAdminLogEventActionChangeAbout",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_value", "PrevValue", "string", -1},
+			{"new_value", "NewValue", "string", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionChangeAbout{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionChangeUsername,
+		Name: "channelAdminLogEventActionChangeUsername",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_value", "PrevValue", "string", -1},
+			{"new_value", "NewValue", "string", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionChangeUsername{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionChangePhoto,
+		Name: "channelAdminLogEventActionChangePhoto",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_photo", "PrevPhoto", "Photo", -1},
+			{"new_photo", "NewPhoto", "Photo", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionChangePhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionToggleInvites,
+		Name: "channelAdminLogEventActionToggleInvites",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"new_value", "NewValue", "Bool", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionToggleInvites{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionToggleSignatures,
+		Name: "channelAdminLogEventActionToggleSignatures",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"new_value", "NewValue", "Bool", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionToggleSignatures{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionUpdatePinned,
+		Name: "channelAdminLogEventActionUpdatePinned",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"message", "Message", "Message", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionUpdatePinned{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionEditMessage,
+		Name: "channelAdminLogEventActionEditMessage",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_message", "PrevMessage", "Message", -1},
+			{"new_message", "NewMessage", "Message", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionEditMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionDeleteMessage,
+		Name: "channelAdminLogEventActionDeleteMessage",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"message", "Message", "Message", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionDeleteMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantJoin,
+		Name: "channelAdminLogEventActionParticipantJoin",
+		Type: "ChannelAdminLogEventAction",
+		New:  func() TL { return TL_channelAdminLogEventActionParticipantJoin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantLeave,
+		Name: "channelAdminLogEventActionParticipantLeave",
+		Type: "ChannelAdminLogEventAction",
+		New:  func() TL { return TL_channelAdminLogEventActionParticipantLeave{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantInvite,
+		Name: "channelAdminLogEventActionParticipantInvite",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"participant", "Participant", "ChannelParticipant", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionParticipantInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantToggleBan,
+		Name: "channelAdminLogEventActionParticipantToggleBan",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_participant", "PrevParticipant", "ChannelParticipant", -1},
+			{"new_participant", "NewParticipant", "ChannelParticipant", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionParticipantToggleBan{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantToggleAdmin,
+		Name: "channelAdminLogEventActionParticipantToggleAdmin",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_participant", "PrevParticipant", "ChannelParticipant", -1},
+			{"new_participant", "NewParticipant", "ChannelParticipant", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionParticipantToggleAdmin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionChangeStickerSet,
+		Name: "channelAdminLogEventActionChangeStickerSet",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_stickerset", "PrevStickerset", "InputStickerSet", -1},
+			{"new_stickerset", "NewStickerset", "InputStickerSet", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionChangeStickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionTogglePreHistoryHidden,
+		Name: "channelAdminLogEventActionTogglePreHistoryHidden",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"new_value", "NewValue", "Bool", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionTogglePreHistoryHidden{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionDefaultBannedRights,
+		Name: "channelAdminLogEventActionDefaultBannedRights",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_banned_rights", "PrevBannedRights", "ChatBannedRights", -1},
+			{"new_banned_rights", "NewBannedRights", "ChatBannedRights", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionDefaultBannedRights{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionStopPoll,
+		Name: "channelAdminLogEventActionStopPoll",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"message", "Message", "Message", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionStopPoll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionChangeLinkedChat,
+		Name: "channelAdminLogEventActionChangeLinkedChat",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_value", "PrevValue", "int", -1},
+			{"new_value", "NewValue", "int", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionChangeLinkedChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionChangeLocation,
+		Name: "channelAdminLogEventActionChangeLocation",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_value", "PrevValue", "ChannelLocation", -1},
+			{"new_value", "NewValue", "ChannelLocation", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionChangeLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionToggleSlowMode,
+		Name: "channelAdminLogEventActionToggleSlowMode",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_value", "PrevValue", "int", -1},
+			{"new_value", "NewValue", "int", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionToggleSlowMode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionStartGroupCall,
+		Name: "channelAdminLogEventActionStartGroupCall",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionStartGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionDiscardGroupCall,
+		Name: "channelAdminLogEventActionDiscardGroupCall",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionDiscardGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantMute,
+		Name: "channelAdminLogEventActionParticipantMute",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"participant", "Participant", "GroupCallParticipant", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionParticipantMute{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantUnmute,
+		Name: "channelAdminLogEventActionParticipantUnmute",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"participant", "Participant", "GroupCallParticipant", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionParticipantUnmute{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionToggleGroupCallSetting,
+		Name: "channelAdminLogEventActionToggleGroupCallSetting",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"join_muted", "JoinMuted", "Bool", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionToggleGroupCallSetting{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantJoinByInvite,
+		Name: "channelAdminLogEventActionParticipantJoinByInvite",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"invite", "Invite", "ExportedChatInvite", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionParticipantJoinByInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionExportedInviteDelete,
+		Name: "channelAdminLogEventActionExportedInviteDelete",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"invite", "Invite", "ExportedChatInvite", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionExportedInviteDelete{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionExportedInviteRevoke,
+		Name: "channelAdminLogEventActionExportedInviteRevoke",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"invite", "Invite", "ExportedChatInvite", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionExportedInviteRevoke{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionExportedInviteEdit,
+		Name: "channelAdminLogEventActionExportedInviteEdit",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_invite", "PrevInvite", "ExportedChatInvite", -1},
+			{"new_invite", "NewInvite", "ExportedChatInvite", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionExportedInviteEdit{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionParticipantVolume,
+		Name: "channelAdminLogEventActionParticipantVolume",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"participant", "Participant", "GroupCallParticipant", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionParticipantVolume{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventActionChangeHistoryTTL,
+		Name: "channelAdminLogEventActionChangeHistoryTTL",
+		Type: "ChannelAdminLogEventAction",
+		Fields: []FieldInfo{
+			{"prev_value", "PrevValue", "int", -1},
+			{"new_value", "NewValue", "int", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEventActionChangeHistoryTTL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEvent,
+		Name: "channelAdminLogEvent",
+		Type: "ChannelAdminLogEvent",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"date", "Date", "int", -1},
+			{"user_id", "UserID", "int", -1},
+			{"action", "Action", "ChannelAdminLogEventAction", -1},
+		},
+		New: func() TL { return TL_channelAdminLogEvent{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channels_adminLogResults,
+		Name: "channels.adminLogResults",
+		Type: "channels.AdminLogResults",
+		Fields: []FieldInfo{
+			{"events", "Events", "Vector<ChannelAdminLogEvent>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_channels_adminLogResults{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelAdminLogEventsFilter,
+		Name: "channelAdminLogEventsFilter",
+		Type: "ChannelAdminLogEventsFilter",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"join", "Join", "true", 0},
+			{"leave", "Leave", "true", 1},
+			{"invite", "Invite", "true", 2},
+			{"ban", "Ban", "true", 3},
+			{"unban", "Unban", "true", 4},
+			{"kick", "Kick", "true", 5},
+			{"unkick", "Unkick", "true", 6},
+			{"promote", "Promote", "true", 7},
+			{"demote", "Demote", "true", 8},
+			{"info", "Info", "true", 9},
+			{"settings", "Settings", "true", 10},
+			{"pinned", "Pinned", "true", 11},
+			{"edit", "Edit", "true", 12},
+			{"delete", "Delete", "true", 13},
+			{"group_call", "GroupCall", "true", 14},
+			{"invites", "Invites", "true", 15},
+		},
+		New: func() TL { return TL_channelAdminLogEventsFilter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_popularContact,
+		Name: "popularContact",
+		Type: "PopularContact",
+		Fields: []FieldInfo{
+			{"client_id", "ClientID", "long", -1},
+			{"importers", "Importers", "int", -1},
+		},
+		New: func() TL { return TL_popularContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_favedStickersNotModified,
+		Name: "messages.favedStickersNotModified",
+		Type: "messages.FavedStickers",
+		New:  func() TL { return TL_messages_favedStickersNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_favedStickers,
+		Name: "messages.favedStickers",
+		Type: "messages.FavedStickers",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"packs", "Packs", "Vector<StickerPack>", -1},
+			{"stickers", "Stickers", "Vector<Document>", -1},
+		},
+		New: func() TL { return TL_messages_favedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_recentMeUrlUnknown,
+		Name: "recentMeUrlUnknown",
+		Type: "RecentMeUrl",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+		},
+		New: func() TL { return TL_recentMeUrlUnknown{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_recentMeUrlUser,
+		Name: "recentMeUrlUser",
+		Type: "RecentMeUrl",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"user_id", "UserID", "int", -1},
+		},
+		New: func() TL { return TL_recentMeUrlUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_recentMeUrlChat,
+		Name: "recentMeUrlChat",
+		Type: "RecentMeUrl",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_recentMeUrlChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_recentMeUrlChatInvite,
+		Name: "recentMeUrlChatInvite",
+		Type: "RecentMeUrl",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"chat_invite", "ChatInvite", "ChatInvite", -1},
+		},
+		New: func() TL { return TL_recentMeUrlChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_recentMeUrlStickerSet,
+		Name: "recentMeUrlStickerSet",
+		Type: "RecentMeUrl",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"set", "Set", "StickerSetCovered", -1},
+		},
+		New: func() TL { return TL_recentMeUrlStickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_recentMeUrls,
+		Name: "help.recentMeUrls",
+		Type: "help.RecentMeUrls",
+		Fields: []FieldInfo{
+			{"urls", "Urls", "Vector<RecentMeUrl>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_help_recentMeUrls{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputSingleMedia,
+		Name: "inputSingleMedia",
+		Type: "InputSingleMedia",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"media", "Media", "InputMedia", -1},
+			{"random_id", "RandomID", "long", -1},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 0},
+		},
+		New: func() TL { return TL_inputSingleMedia{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_webAuthorization,
+		Name: "webAuthorization",
+		Type: "WebAuthorization",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "long", -1},
+			{"bot_id", "BotID", "int", -1},
+			{"domain", "Domain", "string", -1},
+			{"browser", "Browser", "string", -1},
+			{"platform", "Platform", "string", -1},
+			{"date_created", "DateCreated", "int", -1},
+			{"date_active", "DateActive", "int", -1},
+			{"ip", "Ip", "string", -1},
+			{"region", "Region", "string", -1},
+		},
+		New: func() TL { return TL_webAuthorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_webAuthorizations,
+		Name: "account.webAuthorizations",
+		Type: "account.WebAuthorizations",
+		Fields: []FieldInfo{
+			{"authorizations", "Authorizations", "Vector<WebAuthorization>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_account_webAuthorizations{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessageID,
+		Name: "inputMessageID",
+		Type: "InputMessage",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_inputMessageID{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessageReplyTo,
+		Name: "inputMessageReplyTo",
+		Type: "InputMessage",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_inputMessageReplyTo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessagePinned,
+		Name: "inputMessagePinned",
+		Type: "InputMessage",
+		New:  func() TL { return TL_inputMessagePinned{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputMessageCallbackQuery,
+		Name: "inputMessageCallbackQuery",
+		Type: "InputMessage",
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+			{"query_id", "QueryID", "long", -1},
+		},
+		New: func() TL { return TL_inputMessageCallbackQuery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputDialogPeer,
+		Name: "inputDialogPeer",
+		Type: "InputDialogPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_inputDialogPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputDialogPeerFolder,
+		Name: "inputDialogPeerFolder",
+		Type: "InputDialogPeer",
+		Fields: []FieldInfo{
+			{"folder_id", "FolderID", "int", -1},
+		},
+		New: func() TL { return TL_inputDialogPeerFolder{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dialogPeer,
+		Name: "dialogPeer",
+		Type: "DialogPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+		},
+		New: func() TL { return TL_dialogPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dialogPeerFolder,
+		Name: "dialogPeerFolder",
+		Type: "DialogPeer",
+		Fields: []FieldInfo{
+			{"folder_id", "FolderID", "int", -1},
+		},
+		New: func() TL { return TL_dialogPeerFolder{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_foundStickerSetsNotModified,
+		Name: "messages.foundStickerSetsNotModified",
+		Type: "messages.FoundStickerSets",
+		New:  func() TL { return TL_messages_foundStickerSetsNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_foundStickerSets,
+		Name: "messages.foundStickerSets",
+		Type: "messages.FoundStickerSets",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"sets", "Sets", "Vector<StickerSetCovered>", -1},
+		},
+		New: func() TL { return TL_messages_foundStickerSets{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_fileHash,
+		Name: "fileHash",
+		Type: "FileHash",
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"limit", "Limit", "int", -1},
+			{"hash", "Hash", "bytes", -1},
+		},
+		New: func() TL { return TL_fileHash{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputClientProxy,
+		Name: "inputClientProxy",
+		Type: "InputClientProxy",
+		Fields: []FieldInfo{
+			{"address", "Address", "string", -1},
+			{"port", "Port", "int", -1},
+		},
+		New: func() TL { return TL_inputClientProxy{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_termsOfServiceUpdateEmpty,
+		Name: "help.termsOfServiceUpdateEmpty",
+		Type: "help.TermsOfServiceUpdate",
+		Fields: []FieldInfo{
+			{"expires", "Expires", "int", -1},
+		},
+		New: func() TL { return TL_help_termsOfServiceUpdateEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_termsOfServiceUpdate,
+		Name: "help.termsOfServiceUpdate",
+		Type: "help.TermsOfServiceUpdate",
+		Fields: []FieldInfo{
+			{"expires", "Expires", "int", -1},
+			{"terms_of_service", "TermsOfService", "help.TermsOfService", -1},
+		},
+		New: func() TL { return TL_help_termsOfServiceUpdate{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputSecureFileUploaded,
+		Name: "inputSecureFileUploaded",
+		Type: "InputSecureFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"parts", "Parts", "int", -1},
+			{"md5_checksum", "Md5Checksum", "string", -1},
+			{"file_hash", "FileHash", "bytes", -1},
+			{"secret", "Secret", "bytes", -1},
+		},
+		New: func() TL { return TL_inputSecureFileUploaded{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputSecureFile,
+		Name: "inputSecureFile",
+		Type: "InputSecureFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputSecureFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureFileEmpty,
+		Name: "secureFileEmpty",
+		Type: "SecureFile",
+		New:  func() TL { return TL_secureFileEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureFile,
+		Name: "secureFile",
+		Type: "SecureFile",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"size", "Size", "int", -1},
+			{"dc_id", "DcID", "int", -1},
+			{"date", "Date", "int", -1},
+			{"file_hash", "FileHash", "bytes", -1},
+			{"secret", "Secret", "bytes", -1},
+		},
+		New: func() TL { return TL_secureFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureData,
+		Name: "secureData",
+		Type: "SecureData",
+		Fields: []FieldInfo{
+			{"data", "Data", "bytes", -1},
+			{"data_hash", "DataHash", "bytes", -1},
+			{"secret", "Secret", "bytes", -1},
+		},
+		New: func() TL { return TL_secureData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_securePlainPhone,
+		Name: "securePlainPhone",
+		Type: "SecurePlainData",
+		Fields: []FieldInfo{
+			{"phone", "Phone", "string", -1},
+		},
+		New: func() TL { return TL_securePlainPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_securePlainEmail,
+		Name: "securePlainEmail",
+		Type: "SecurePlainData",
+		Fields: []FieldInfo{
+			{"email", "Email", "string", -1},
+		},
+		New: func() TL { return TL_securePlainEmail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypePersonalDetails,
+		Name: "secureValueTypePersonalDetails",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypePersonalDetails{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypePassport,
+		Name: "secureValueTypePassport",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypePassport{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeDriverLicense,
+		Name: "secureValueTypeDriverLicense",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeDriverLicense{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeIdentityCard,
+		Name: "secureValueTypeIdentityCard",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeIdentityCard{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeInternalPassport,
+		Name: "secureValueTypeInternalPassport",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeInternalPassport{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeAddress,
+		Name: "secureValueTypeAddress",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeAddress{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeUtilityBill,
+		Name: "secureValueTypeUtilityBill",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeUtilityBill{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeBankStatement,
+		Name: "secureValueTypeBankStatement",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeBankStatement{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeRentalAgreement,
+		Name: "secureValueTypeRentalAgreement",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeRentalAgreement{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypePassportRegistration,
+		Name: "secureValueTypePassportRegistration",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypePassportRegistration{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeTemporaryRegistration,
+		Name: "secureValueTypeTemporaryRegistration",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeTemporaryRegistration{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypePhone,
+		Name: "secureValueTypePhone",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypePhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueTypeEmail,
+		Name: "secureValueTypeEmail",
+		Type: "SecureValueType",
+		New:  func() TL { return TL_secureValueTypeEmail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValue,
+		Name: "secureValue",
+		Type: "SecureValue",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"type", "Type", "SecureValueType", -1},
+			{"data", "Data", "SecureData", 0},
+			{"front_side", "FrontSide", "SecureFile", 1},
+			{"reverse_side", "ReverseSide", "SecureFile", 2},
+			{"selfie", "Selfie", "SecureFile", 3},
+			{"translation", "Translation", "Vector<SecureFile>", 6},
+			{"files", "Files", "Vector<SecureFile>", 4},
+			{"plain_data", "PlainData", "SecurePlainData", 5},
+			{"hash", "Hash", "bytes", -1},
+		},
+		New: func() TL { return TL_secureValue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputSecureValue,
+		Name: "inputSecureValue",
+		Type: "InputSecureValue",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"type", "Type", "SecureValueType", -1},
+			{"data", "Data", "SecureData", 0},
+			{"front_side", "FrontSide", "InputSecureFile", 1},
+			{"reverse_side", "ReverseSide", "InputSecureFile", 2},
+			{"selfie", "Selfie", "InputSecureFile", 3},
+			{"translation", "Translation", "Vector<InputSecureFile>", 6},
+			{"files", "Files", "Vector<InputSecureFile>", 4},
+			{"plain_data", "PlainData", "SecurePlainData", 5},
+		},
+		New: func() TL { return TL_inputSecureValue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueHash,
+		Name: "secureValueHash",
+		Type: "SecureValueHash",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"hash", "Hash", "bytes", -1},
+		},
+		New: func() TL { return TL_secureValueHash{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueErrorData,
+		Name: "secureValueErrorData",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"data_hash", "DataHash", "bytes", -1},
+			{"field", "Field", "string", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueErrorData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueErrorFrontSide,
+		Name: "secureValueErrorFrontSide",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"file_hash", "FileHash", "bytes", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueErrorFrontSide{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueErrorReverseSide,
+		Name: "secureValueErrorReverseSide",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"file_hash", "FileHash", "bytes", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueErrorReverseSide{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueErrorSelfie,
+		Name: "secureValueErrorSelfie",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"file_hash", "FileHash", "bytes", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueErrorSelfie{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueErrorFile,
+		Name: "secureValueErrorFile",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"file_hash", "FileHash", "bytes", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueErrorFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueErrorFiles,
+		Name: "secureValueErrorFiles",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"file_hash", "FileHash", "Vector<bytes>", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueErrorFiles{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueError,
+		Name: "secureValueError",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"hash", "Hash", "bytes", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueError{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueErrorTranslationFile,
+		Name: "secureValueErrorTranslationFile",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"file_hash", "FileHash", "bytes", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueErrorTranslationFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureValueErrorTranslationFiles,
+		Name: "secureValueErrorTranslationFiles",
+		Type: "SecureValueError",
+		Fields: []FieldInfo{
+			{"type", "Type", "SecureValueType", -1},
+			{"file_hash", "FileHash", "Vector<bytes>", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_secureValueErrorTranslationFiles{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureCredentialsEncrypted,
+		Name: "secureCredentialsEncrypted",
+		Type: "SecureCredentialsEncrypted",
+		Fields: []FieldInfo{
+			{"data", "Data", "bytes", -1},
+			{"hash", "Hash", "bytes", -1},
+			{"secret", "Secret", "bytes", -1},
+		},
+		New: func() TL { return TL_secureCredentialsEncrypted{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_authorizationForm,
+		Name: "account.authorizationForm",
+		Type: "account.AuthorizationForm",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"required_types", "RequiredTypes", "Vector<SecureRequiredType>", -1},
+			{"values", "Values", "Vector<SecureValue>", -1},
+			{"errors", "Errors", "Vector<SecureValueError>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"privacy_policy_url", "PrivacyPolicyUrl", "string", 0},
+		},
+		New: func() TL { return TL_account_authorizationForm{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_sentEmailCode,
+		Name: "account.sentEmailCode",
+		Type: "account.SentEmailCode",
+		Fields: []FieldInfo{
+			{"email_pattern", "EmailPattern", "string", -1},
+			{"length", "Length", "int", -1},
+		},
+		New: func() TL { return TL_account_sentEmailCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_deepLinkInfoEmpty,
+		Name: "help.deepLinkInfoEmpty",
+		Type: "help.DeepLinkInfo",
+		New:  func() TL { return TL_help_deepLinkInfoEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_deepLinkInfo,
+		Name: "help.deepLinkInfo",
+		Type: "help.DeepLinkInfo",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"update_app", "UpdateApp", "true", 0},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 1},
+		},
+		New: func() TL { return TL_help_deepLinkInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_savedPhoneContact,
+		Name: "savedPhoneContact",
+		Type: "SavedContact",
+		Fields: []FieldInfo{
+			{"phone", "Phone", "string", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_savedPhoneContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_takeout,
+		Name: "account.takeout",
+		Type: "account.Takeout",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+		},
+		New: func() TL { return TL_account_takeout{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_passwordKdfAlgoUnknown,
+		Name: "passwordKdfAlgoUnknown",
+		Type: "PasswordKdfAlgo",
+		New:  func() TL { return TL_passwordKdfAlgoUnknown{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow,
+		Name: "passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow",
+		Type: "PasswordKdfAlgo",
+		Fields: []FieldInfo{
+			{"salt1", "Salt1", "bytes", -1},
+			{"salt2", "Salt2", "bytes", -1},
+			{"g", "G", "int", -1},
+			{"p", "P", "bytes", -1},
+		},
+		New: func() TL { return TL_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_securePasswordKdfAlgoUnknown,
+		Name: "securePasswordKdfAlgoUnknown",
+		Type: "SecurePasswordKdfAlgo",
+		New:  func() TL { return TL_securePasswordKdfAlgoUnknown{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000,
+		Name: "securePasswordKdfAlgoPBKDF2HMACSHA512iter100000",
+		Type: "SecurePasswordKdfAlgo",
+		Fields: []FieldInfo{
+			{"salt", "Salt", "bytes", -1},
+		},
+		New: func() TL { return TL_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_securePasswordKdfAlgoSHA512,
+		Name: "securePasswordKdfAlgoSHA512",
+		Type: "SecurePasswordKdfAlgo",
+		Fields: []FieldInfo{
+			{"salt", "Salt", "bytes", -1},
+		},
+		New: func() TL { return TL_securePasswordKdfAlgoSHA512{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureSecretSettings,
+		Name: "secureSecretSettings",
+		Type: "SecureSecretSettings",
+		Fields: []FieldInfo{
+			{"secure_algo", "SecureAlgo", "SecurePasswordKdfAlgo", -1},
+			{"secure_secret", "SecureSecret", "bytes", -1},
+			{"secure_secret_id", "SecureSecretID", "long", -1},
+		},
+		New: func() TL { return TL_secureSecretSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputCheckPasswordEmpty,
+		Name: "inputCheckPasswordEmpty",
+		Type: "InputCheckPasswordSRP",
+		New:  func() TL { return TL_inputCheckPasswordEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputCheckPasswordSRP,
+		Name: "inputCheckPasswordSRP",
+		Type: "InputCheckPasswordSRP",
+		Fields: []FieldInfo{
+			{"srp_id", "SrpID", "long", -1},
+			{"A", "A", "bytes", -1},
+			{"M1", "M1", "bytes", -1},
+		},
+		New: func() TL { return TL_inputCheckPasswordSRP{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureRequiredType,
+		Name: "secureRequiredType",
+		Type: "SecureRequiredType",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"native_names", "NativeNames", "true", 0},
+			{"selfie_required", "SelfieRequired", "true", 1},
+			{"translation_required", "TranslationRequired", "true", 2},
+			{"type", "Type", "SecureValueType", -1},
+		},
+		New: func() TL { return TL_secureRequiredType{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_secureRequiredTypeOneOf,
+		Name: "secureRequiredTypeOneOf",
+		Type: "SecureRequiredType",
+		Fields: []FieldInfo{
+			{"types", "Types", "Vector<SecureRequiredType>", -1},
+		},
+		New: func() TL { return TL_secureRequiredTypeOneOf{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_passportConfigNotModified,
+		Name: "help.passportConfigNotModified",
+		Type: "help.PassportConfig",
+		New:  func() TL { return TL_help_passportConfigNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_passportConfig,
+		Name: "help.passportConfig",
+		Type: "help.PassportConfig",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"countries_langs", "CountriesLangs", "DataJSON", -1},
+		},
+		New: func() TL { return TL_help_passportConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputAppEvent,
+		Name: "inputAppEvent",
+		Type: "InputAppEvent",
+		Fields: []FieldInfo{
+			{"time", "Time", "double", -1},
+			{"type", "Type", "string", -1},
+			{"peer", "Peer", "long", -1},
+			{"data", "Data", "JSONValue", -1},
+		},
+		New: func() TL { return TL_inputAppEvent{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_jsonObjectValue,
+		Name: "jsonObjectValue",
+		Type: "JSONObjectValue",
+		Fields: []FieldInfo{
+			{"key", "Key", "string", -1},
+			{"value", "Value", "JSONValue", -1},
+		},
+		New: func() TL { return TL_jsonObjectValue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_jsonNull,
+		Name: "jsonNull",
+		Type: "JSONValue",
+		New:  func() TL { return TL_jsonNull{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_jsonBool,
+		Name: "jsonBool",
+		Type: "JSONValue",
+		Fields: []FieldInfo{
+			{"value", "Value", "Bool", -1},
+		},
+		New: func() TL { return TL_jsonBool{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_jsonNumber,
+		Name: "jsonNumber",
+		Type: "JSONValue",
+		Fields: []FieldInfo{
+			{"value", "Value", "double", -1},
+		},
+		New: func() TL { return TL_jsonNumber{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_jsonString,
+		Name: "jsonString",
+		Type: "JSONValue",
+		Fields: []FieldInfo{
+			{"value", "Value", "string", -1},
+		},
+		New: func() TL { return TL_jsonString{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_jsonArray,
+		Name: "jsonArray",
+		Type: "JSONValue",
+		Fields: []FieldInfo{
+			{"value", "Value", "Vector<JSONValue>", -1},
+		},
+		New: func() TL { return TL_jsonArray{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_jsonObject,
+		Name: "jsonObject",
+		Type: "JSONValue",
+		Fields: []FieldInfo{
+			{"value", "Value", "Vector<JSONObjectValue>", -1},
+		},
+		New: func() TL { return TL_jsonObject{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageTableCell,
+		Name: "pageTableCell",
+		Type: "PageTableCell",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"header", "Header", "true", 0},
+			{"align_center", "AlignCenter", "true", 3},
+			{"align_right", "AlignRight", "true", 4},
+			{"valign_middle", "ValignMiddle", "true", 5},
+			{"valign_bottom", "ValignBottom", "true", 6},
+			{"text", "Text", "RichText", 7},
+			{"colspan", "Colspan", "int", 1},
+			{"rowspan", "Rowspan", "int", 2},
+		},
+		New: func() TL { return TL_pageTableCell{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageTableRow,
+		Name: "pageTableRow",
+		Type: "PageTableRow",
+		Fields: []FieldInfo{
+			{"cells", "Cells", "Vector<PageTableCell>", -1},
+		},
+		New: func() TL { return TL_pageTableRow{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageCaption,
+		Name: "pageCaption",
+		Type: "PageCaption",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+			{"credit", "Credit", "RichText", -1},
+		},
+		New: func() TL { return TL_pageCaption{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageListItemText,
+		Name: "pageListItemText",
+		Type: "PageListItem",
+		Fields: []FieldInfo{
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageListItemText{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageListItemBlocks,
+		Name: "pageListItemBlocks",
+		Type: "PageListItem",
+		Fields: []FieldInfo{
+			{"blocks", "Blocks", "Vector<PageBlock>", -1},
+		},
+		New: func() TL { return TL_pageListItemBlocks{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageListOrderedItemText,
+		Name: "pageListOrderedItemText",
+		Type: "PageListOrderedItem",
+		Fields: []FieldInfo{
+			{"num", "Num", "string", -1},
+			{"text", "Text", "RichText", -1},
+		},
+		New: func() TL { return TL_pageListOrderedItemText{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageListOrderedItemBlocks,
+		Name: "pageListOrderedItemBlocks",
+		Type: "PageListOrderedItem",
+		Fields: []FieldInfo{
+			{"num", "Num", "string", -1},
+			{"blocks", "Blocks", "Vector<PageBlock>", -1},
+		},
+		New: func() TL { return TL_pageListOrderedItemBlocks{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pageRelatedArticle,
+		Name: "pageRelatedArticle",
+		Type: "PageRelatedArticle",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"url", "Url", "string", -1},
+			{"webpage_id", "WebpageID", "long", -1},
+			{"title", "Title", "string", 0},
+			{"description", "Description", "string", 1},
+			{"photo_id", "PhotoID", "long", 2},
+			{"author", "Author", "string", 3},
+			{"published_date", "PublishedDate", "int", 4},
+		},
+		New: func() TL { return TL_pageRelatedArticle{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_page,
+		Name: "page",
+		Type: "Page",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"part", "Part", "true", 0},
+			{"rtl", "Rtl", "true", 1},
+			{"v2", "V2", "true", 2},
+			{"url", "Url", "string", -1},
+			{"blocks", "Blocks", "Vector<PageBlock>", -1},
+			{"photos", "Photos", "Vector<Photo>", -1},
+			{"documents", "Documents", "Vector<Document>", -1},
+			{"views", "Views", "int", 3},
+		},
+		New: func() TL { return TL_page{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_supportName,
+		Name: "help.supportName",
+		Type: "help.SupportName",
+		Fields: []FieldInfo{
+			{"name", "Name", "string", -1},
+		},
+		New: func() TL { return TL_help_supportName{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_userInfoEmpty,
+		Name: "help.userInfoEmpty",
+		Type: "help.UserInfo",
+		New:  func() TL { return TL_help_userInfoEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_userInfo,
+		Name: "help.userInfo",
+		Type: "help.UserInfo",
+		Fields: []FieldInfo{
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", -1},
+			{"author", "Author", "string", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_help_userInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pollAnswer,
+		Name: "pollAnswer",
+		Type: "PollAnswer",
+		Fields: []FieldInfo{
+			{"text", "Text", "string", -1},
+			{"option", "Option", "bytes", -1},
+		},
+		New: func() TL { return TL_pollAnswer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_poll,
+		Name: "poll",
+		Type: "Poll",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"flags", "Flags", "#", -1},
+			{"closed", "Closed", "true", 0},
+			{"public_voters", "PublicVoters", "true", 1},
+			{"multiple_choice", "MultipleChoice", "true", 2},
+			{"quiz", "Quiz", "true", 3},
+			{"question", "Question", "string", -1},
+			{"answers", "Answers", "Vector<PollAnswer>", -1},
+			{"close_period", "ClosePeriod", "int", 4},
+			{"close_date", "CloseDate", "int", 5},
+		},
+		New: func() TL { return TL_poll{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pollAnswerVoters,
+		Name: "pollAnswerVoters",
+		Type: "PollAnswerVoters",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"chosen", "Chosen", "true", 0},
+			{"correct", "Correct", "true", 1},
+			{"option", "Option", "bytes", -1},
+			{"voters", "Voters", "int", -1},
+		},
+		New: func() TL { return TL_pollAnswerVoters{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_pollResults,
+		Name: "pollResults",
+		Type: "PollResults",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"min", "Min", "true", 0},
+			{"results", "Results", "Vector<PollAnswerVoters>", 1},
+			{"total_voters", "TotalVoters", "int", 2},
+			{"recent_voters", "RecentVoters", "Vector<int>", 3},
+			{"solution", "Solution", "string", 4},
+			{"solution_entities", "SolutionEntities", "Vector<MessageEntity>", 4},
+		},
+		New: func() TL { return TL_pollResults{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatOnlines,
+		Name: "chatOnlines",
+		Type: "ChatOnlines",
+		Fields: []FieldInfo{
+			{"onlines", "Onlines", "int", -1},
+		},
+		New: func() TL { return TL_chatOnlines{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsURL,
+		Name: "statsURL",
+		Type: "StatsURL",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+		},
+		New: func() TL { return TL_statsURL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatAdminRights,
+		Name: "chatAdminRights",
+		Type: "ChatAdminRights",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"change_info", "ChangeInfo", "true", 0},
+			{"post_messages", "PostMessages", "true", 1},
+			{"edit_messages", "EditMessages", "true", 2},
+			{"delete_messages", "DeleteMessages", "true", 3},
+			{"ban_users", "BanUsers", "true", 4},
+			{"invite_users", "InviteUsers", "true", 5},
+			{"pin_messages", "PinMessages", "true", 7},
+			{"add_admins", "AddAdmins", "true", 9},
+			{"anonymous", "Anonymous", "true", 10},
+			{"manage_call", "ManageCall", "true", 11},
+			{"other", "Other", "true", 12},
+		},
+		New: func() TL { return TL_chatAdminRights{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatBannedRights,
+		Name: "chatBannedRights",
+		Type: "ChatBannedRights",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"view_messages", "ViewMessages", "true", 0},
+			{"send_messages", "SendMessages", "true", 1},
+			{"send_media", "SendMedia", "true", 2},
+			{"send_stickers", "SendStickers", "true", 3},
+			{"send_gifs", "SendGifs", "true", 4},
+			{"send_games", "SendGames", "true", 5},
+			{"send_inline", "SendInline", "true", 6},
+			{"embed_links", "EmbedLinks", "true", 7},
+			{"send_polls", "SendPolls", "true", 8},
+			{"change_info", "ChangeInfo", "true", 10},
+			{"invite_users", "InviteUsers", "true", 15},
+			{"pin_messages", "PinMessages", "true", 17},
+			{"until_date", "UntilDate", "int", -1},
+		},
+		New: func() TL { return TL_chatBannedRights{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputWallPaper,
+		Name: "inputWallPaper",
+		Type: "InputWallPaper",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputWallPaper{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputWallPaperSlug,
+		Name: "inputWallPaperSlug",
+		Type: "InputWallPaper",
+		Fields: []FieldInfo{
+			{"slug", "Slug", "string", -1},
+		},
+		New: func() TL { return TL_inputWallPaperSlug{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputWallPaperNoFile,
+		Name: "inputWallPaperNoFile",
+		Type: "InputWallPaper",
+		New:  func() TL { return TL_inputWallPaperNoFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_wallPapersNotModified,
+		Name: "account.wallPapersNotModified",
+		Type: "account.WallPapers",
+		New:  func() TL { return TL_account_wallPapersNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_wallPapers,
+		Name: "account.wallPapers",
+		Type: "account.WallPapers",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"wallpapers", "Wallpapers", "Vector<WallPaper>", -1},
+		},
+		New: func() TL { return TL_account_wallPapers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_codeSettings,
+		Name: "codeSettings",
+		Type: "CodeSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"allow_flashcall", "AllowFlashcall", "true", 0},
+			{"current_number", "CurrentNumber", "true", 1},
+			{"allow_app_hash", "AllowAppHash", "true", 4},
+		},
+		New: func() TL { return TL_codeSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_wallPaperSettings,
+		Name: "wallPaperSettings",
+		Type: "WallPaperSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"blur", "Blur", "true", 1},
+			{"motion", "Motion", "true", 2},
+			{"background_color", "BackgroundColor", "int", 0},
+			{"second_background_color", "SecondBackgroundColor", "int", 4},
+			{"intensity", "Intensity", "int", 3},
+			{"rotation", "Rotation", "int", 4},
+		},
+		New: func() TL { return TL_wallPaperSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_autoDownloadSettings,
+		Name: "autoDownloadSettings",
+		Type: "AutoDownloadSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"disabled", "Disabled", "true", 0},
+			{"video_preload_large", "VideoPreloadLarge", "true", 1},
+			{"audio_preload_next", "AudioPreloadNext", "true", 2},
+			{"phonecalls_less_data", "PhonecallsLessData", "true", 3},
+			{"photo_size_max", "PhotoSizeMax", "int", -1},
+			{"video_size_max", "VideoSizeMax", "int", -1},
+			{"file_size_max", "FileSizeMax", "int", -1},
+			{"video_upload_maxbitrate", "VideoUploadMaxbitrate", "int", -1},
+		},
+		New: func() TL { return TL_autoDownloadSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_autoDownloadSettings,
+		Name: "account.autoDownloadSettings",
+		Type: "account.AutoDownloadSettings",
+		Fields: []FieldInfo{
+			{"low", "Low", "AutoDownloadSettings", -1},
+			{"medium", "Medium", "AutoDownloadSettings", -1},
+			{"high", "High", "AutoDownloadSettings", -1},
+		},
+		New: func() TL { return TL_account_autoDownloadSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_emojiKeyword,
+		Name: "emojiKeyword",
+		Type: "EmojiKeyword",
+		Fields: []FieldInfo{
+			{"keyword", "Keyword", "string", -1},
+			{"emoticons", "Emoticons", "Vector<string>", -1},
+		},
+		New: func() TL { return TL_emojiKeyword{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_emojiKeywordDeleted,
+		Name: "emojiKeywordDeleted",
+		Type: "EmojiKeyword",
+		Fields: []FieldInfo{
+			{"keyword", "Keyword", "string", -1},
+			{"emoticons", "Emoticons", "Vector<string>", -1},
+		},
+		New: func() TL { return TL_emojiKeywordDeleted{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_emojiKeywordsDifference,
+		Name: "emojiKeywordsDifference",
+		Type: "EmojiKeywordsDifference",
+		Fields: []FieldInfo{
+			{"lang_code", "LangCode", "string", -1},
+			{"from_version", "FromVersion", "int", -1},
+			{"version", "Version", "int", -1},
+			{"keywords", "Keywords", "Vector<EmojiKeyword>", -1},
+		},
+		New: func() TL { return TL_emojiKeywordsDifference{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_emojiURL,
+		Name: "emojiURL",
+		Type: "EmojiURL",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+		},
+		New: func() TL { return TL_emojiURL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_emojiLanguage,
+		Name: "emojiLanguage",
+		Type: "EmojiLanguage",
+		Fields: []FieldInfo{
+			{"lang_code", "LangCode", "string", -1},
+		},
+		New: func() TL { return TL_emojiLanguage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_fileLocationToBeDeprecated,
+		Name: "fileLocationToBeDeprecated",
+		Type: "FileLocation",
+		Fields: []FieldInfo{
+			{"volume_id", "VolumeID", "long", -1},
+			{"local_id", "LocalID", "int", -1},
+		},
+		New: func() TL { return TL_fileLocationToBeDeprecated{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_folder,
+		Name: "folder",
+		Type: "Folder",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"autofill_new_broadcasts", "AutofillNewBroadcasts", "true", 0},
+			{"autofill_public_groups", "AutofillPublicGroups", "true", 1},
+			{"autofill_new_correspondents", "AutofillNewCorrespondents", "true", 2},
+			{"id", "ID", "int", -1},
+			{"title", "Title", "string", -1},
+			{"photo", "Photo", "ChatPhoto", 3},
+		},
+		New: func() TL { return TL_folder{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputFolderPeer,
+		Name: "inputFolderPeer",
+		Type: "InputFolderPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"folder_id", "FolderID", "int", -1},
+		},
+		New: func() TL { return TL_inputFolderPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_folderPeer,
+		Name: "folderPeer",
+		Type: "FolderPeer",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"folder_id", "FolderID", "int", -1},
+		},
+		New: func() TL { return TL_folderPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_searchCounter,
+		Name: "messages.searchCounter",
+		Type: "messages.SearchCounter",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"inexact", "Inexact", "true", 1},
+			{"filter", "Filter", "MessagesFilter", -1},
+			{"count", "Count", "int", -1},
+		},
+		New: func() TL { return TL_messages_searchCounter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_urlAuthResultRequest,
+		Name: "urlAuthResultRequest",
+		Type: "UrlAuthResult",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"request_write_access", "RequestWriteAccess", "true", 0},
+			{"bot", "Bot", "User", -1},
+			{"domain", "Domain", "string", -1},
+		},
+		New: func() TL { return TL_urlAuthResultRequest{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_urlAuthResultAccepted,
+		Name: "urlAuthResultAccepted",
+		Type: "UrlAuthResult",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+		},
+		New: func() TL { return TL_urlAuthResultAccepted{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_urlAuthResultDefault,
+		Name: "urlAuthResultDefault",
+		Type: "UrlAuthResult",
+		New:  func() TL { return TL_urlAuthResultDefault{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelLocationEmpty,
+		Name: "channelLocationEmpty",
+		Type: "ChannelLocation",
+		New:  func() TL { return TL_channelLocationEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_channelLocation,
+		Name: "channelLocation",
+		Type: "ChannelLocation",
+		Fields: []FieldInfo{
+			{"geo_point", "GeoPoint", "GeoPoint", -1},
+			{"address", "Address", "string", -1},
+		},
+		New: func() TL { return TL_channelLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_peerLocated,
+		Name: "peerLocated",
+		Type: "PeerLocated",
+		Fields: []FieldInfo{
+			{"peer", "Peer", "Peer", -1},
+			{"expires", "Expires", "int", -1},
+			{"distance", "Distance", "int", -1},
+		},
+		New: func() TL { return TL_peerLocated{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_peerSelfLocated,
+		Name: "peerSelfLocated",
+		Type: "PeerLocated",
+		Fields: []FieldInfo{
+			{"expires", "Expires", "int", -1},
+		},
+		New: func() TL { return TL_peerSelfLocated{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_restrictionReason,
+		Name: "restrictionReason",
+		Type: "RestrictionReason",
+		Fields: []FieldInfo{
+			{"platform", "Platform", "string", -1},
+			{"reason", "Reason", "string", -1},
+			{"text", "Text", "string", -1},
+		},
+		New: func() TL { return TL_restrictionReason{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputTheme,
+		Name: "inputTheme",
+		Type: "InputTheme",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputThemeSlug,
+		Name: "inputThemeSlug",
+		Type: "InputTheme",
+		Fields: []FieldInfo{
+			{"slug", "Slug", "string", -1},
+		},
+		New: func() TL { return TL_inputThemeSlug{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_theme,
+		Name: "theme",
+		Type: "Theme",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"creator", "Creator", "true", 0},
+			{"default", "Default", "true", 1},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"slug", "Slug", "string", -1},
+			{"title", "Title", "string", -1},
+			{"document", "Document", "Document", 2},
+			{"settings", "Settings", "ThemeSettings", 3},
+			{"installs_count", "InstallsCount", "int", -1},
+		},
+		New: func() TL { return TL_theme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_themesNotModified,
+		Name: "account.themesNotModified",
+		Type: "account.Themes",
+		New:  func() TL { return TL_account_themesNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_themes,
+		Name: "account.themes",
+		Type: "account.Themes",
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+			{"themes", "Themes", "Vector<Theme>", -1},
+		},
+		New: func() TL { return TL_account_themes{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_loginToken,
+		Name: "auth.loginToken",
+		Type: "auth.LoginToken",
+		Fields: []FieldInfo{
+			{"expires", "Expires", "int", -1},
+			{"token", "Token", "bytes", -1},
+		},
+		New: func() TL { return TL_auth_loginToken{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_loginTokenMigrateTo,
+		Name: "auth.loginTokenMigrateTo",
+		Type: "auth.LoginToken",
+		Fields: []FieldInfo{
+			{"dc_id", "DcID", "int", -1},
+			{"token", "Token", "bytes", -1},
+		},
+		New: func() TL { return TL_auth_loginTokenMigrateTo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_auth_loginTokenSuccess,
+		Name: "auth.loginTokenSuccess",
+		Type: "auth.LoginToken",
+		Fields: []FieldInfo{
+			{"authorization", "Authorization", "auth.Authorization", -1},
+		},
+		New: func() TL { return TL_auth_loginTokenSuccess{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_account_contentSettings,
+		Name: "account.contentSettings",
+		Type: "account.ContentSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"sensitive_enabled", "SensitiveEnabled", "true", 0},
+			{"sensitive_can_change", "SensitiveCanChange", "true", 1},
+		},
+		New: func() TL { return TL_account_contentSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_inactiveChats,
+		Name: "messages.inactiveChats",
+		Type: "messages.InactiveChats",
+		Fields: []FieldInfo{
+			{"dates", "Dates", "Vector<int>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_inactiveChats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_baseThemeClassic,
+		Name: "baseThemeClassic",
+		Type: "BaseTheme",
+		New:  func() TL { return TL_baseThemeClassic{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_baseThemeDay,
+		Name: "baseThemeDay",
+		Type: "BaseTheme",
+		New:  func() TL { return TL_baseThemeDay{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_baseThemeNight,
+		Name: "baseThemeNight",
+		Type: "BaseTheme",
+		New:  func() TL { return TL_baseThemeNight{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_baseThemeTinted,
+		Name: "baseThemeTinted",
+		Type: "BaseTheme",
+		New:  func() TL { return TL_baseThemeTinted{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_baseThemeArctic,
+		Name: "baseThemeArctic",
+		Type: "BaseTheme",
+		New:  func() TL { return TL_baseThemeArctic{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputThemeSettings,
+		Name: "inputThemeSettings",
+		Type: "InputThemeSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"base_theme", "BaseTheme", "BaseTheme", -1},
+			{"accent_color", "AccentColor", "int", -1},
+			{"message_top_color", "MessageTopColor", "int", 0},
+			{"message_bottom_color", "MessageBottomColor", "int", 0},
+			{"wallpaper", "Wallpaper", "InputWallPaper", 1},
+			{"wallpaper_settings", "WallpaperSettings", "WallPaperSettings", 1},
+		},
+		New: func() TL { return TL_inputThemeSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_themeSettings,
+		Name: "themeSettings",
+		Type: "ThemeSettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"base_theme", "BaseTheme", "BaseTheme", -1},
+			{"accent_color", "AccentColor", "int", -1},
+			{"message_top_color", "MessageTopColor", "int", 0},
+			{"message_bottom_color", "MessageBottomColor", "int", 0},
+			{"wallpaper", "Wallpaper", "WallPaper", 1},
+		},
+		New: func() TL { return TL_themeSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_webPageAttributeTheme,
+		Name: "webPageAttributeTheme",
+		Type: "WebPageAttribute",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"documents", "Documents", "Vector<Document>", 0},
+			{"settings", "Settings", "ThemeSettings", 1},
+		},
+		New: func() TL { return TL_webPageAttributeTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageUserVote,
+		Name: "messageUserVote",
+		Type: "MessageUserVote",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"option", "Option", "bytes", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_messageUserVote{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageUserVoteInputOption,
+		Name: "messageUserVoteInputOption",
+		Type: "MessageUserVote",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_messageUserVoteInputOption{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageUserVoteMultiple,
+		Name: "messageUserVoteMultiple",
+		Type: "MessageUserVote",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"options", "Options", "Vector<bytes>", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_messageUserVoteMultiple{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_votesList,
+		Name: "messages.votesList",
+		Type: "messages.VotesList",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"count", "Count", "int", -1},
+			{"votes", "Votes", "Vector<MessageUserVote>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"next_offset", "NextOffset", "string", 0},
+		},
+		New: func() TL { return TL_messages_votesList{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_bankCardOpenUrl,
+		Name: "bankCardOpenUrl",
+		Type: "BankCardOpenUrl",
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"name", "Name", "string", -1},
+		},
+		New: func() TL { return TL_bankCardOpenUrl{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_payments_bankCardData,
+		Name: "payments.bankCardData",
+		Type: "payments.BankCardData",
+		Fields: []FieldInfo{
+			{"title", "Title", "string", -1},
+			{"open_urls", "OpenUrls", "Vector<BankCardOpenUrl>", -1},
+		},
+		New: func() TL { return TL_payments_bankCardData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dialogFilter,
+		Name: "dialogFilter",
+		Type: "DialogFilter",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"contacts", "Contacts", "true", 0},
+			{"non_contacts", "NonContacts", "true", 1},
+			{"groups", "Groups", "true", 2},
+			{"broadcasts", "Broadcasts", "true", 3},
+			{"bots", "Bots", "true", 4},
+			{"exclude_muted", "ExcludeMuted", "true", 11},
+			{"exclude_read", "ExcludeRead", "true", 12},
+			{"exclude_archived", "ExcludeArchived", "true", 13},
+			{"id", "ID", "int", -1},
+			{"title", "Title", "string", -1},
+			{"emoticon", "Emoticon", "string", 25},
+			{"pinned_peers", "PinnedPeers", "Vector<InputPeer>", -1},
+			{"include_peers", "IncludePeers", "Vector<InputPeer>", -1},
+			{"exclude_peers", "ExcludePeers", "Vector<InputPeer>", -1},
+		},
+		New: func() TL { return TL_dialogFilter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_dialogFilterSuggested,
+		Name: "dialogFilterSuggested",
+		Type: "DialogFilterSuggested",
+		Fields: []FieldInfo{
+			{"filter", "Filter", "DialogFilter", -1},
+			{"description", "Description", "string", -1},
+		},
+		New: func() TL { return TL_dialogFilterSuggested{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsDateRangeDays,
+		Name: "statsDateRangeDays",
+		Type: "StatsDateRangeDays",
+		Fields: []FieldInfo{
+			{"min_date", "MinDate", "int", -1},
+			{"max_date", "MaxDate", "int", -1},
+		},
+		New: func() TL { return TL_statsDateRangeDays{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsAbsValueAndPrev,
+		Name: "statsAbsValueAndPrev",
+		Type: "StatsAbsValueAndPrev",
+		Fields: []FieldInfo{
+			{"current", "Current", "double", -1},
+			{"previous", "Previous", "double", -1},
+		},
+		New: func() TL { return TL_statsAbsValueAndPrev{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsPercentValue,
+		Name: "statsPercentValue",
+		Type: "StatsPercentValue",
+		Fields: []FieldInfo{
+			{"part", "Part", "double", -1},
+			{"total", "Total", "double", -1},
+		},
+		New: func() TL { return TL_statsPercentValue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsGraphAsync,
+		Name: "statsGraphAsync",
+		Type: "StatsGraph",
+		Fields: []FieldInfo{
+			{"token", "Token", "string", -1},
+		},
+		New: func() TL { return TL_statsGraphAsync{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsGraphError,
+		Name: "statsGraphError",
+		Type: "StatsGraph",
+		Fields: []FieldInfo{
+			{"error", "Error", "string", -1},
+		},
+		New: func() TL { return TL_statsGraphError{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsGraph,
+		Name: "statsGraph",
+		Type: "StatsGraph",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"json", "Json", "DataJSON", -1},
+			{"zoom_token", "ZoomToken", "string", 0},
+		},
+		New: func() TL { return TL_statsGraph{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageInteractionCounters,
+		Name: "messageInteractionCounters",
+		Type: "MessageInteractionCounters",
+		Fields: []FieldInfo{
+			{"msg_id", "MsgID", "int", -1},
+			{"views", "Views", "int", -1},
+			{"forwards", "Forwards", "int", -1},
+		},
+		New: func() TL { return TL_messageInteractionCounters{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_stats_broadcastStats,
+		Name: "stats.broadcastStats",
+		Type: "stats.BroadcastStats",
+		Fields: []FieldInfo{
+			{"period", "Period", "StatsDateRangeDays", -1},
+			{"followers", "Followers", "StatsAbsValueAndPrev", -1},
+			{"views_per_post", "ViewsPerPost", "StatsAbsValueAndPrev", -1},
+			{"shares_per_post", "SharesPerPost", "StatsAbsValueAndPrev", -1},
+			{"enabled_notifications", "EnabledNotifications", "StatsPercentValue", -1},
+			{"growth_graph", "GrowthGraph", "StatsGraph", -1},
+			{"followers_graph", "FollowersGraph", "StatsGraph", -1},
+			{"mute_graph", "MuteGraph", "StatsGraph", -1},
+			{"top_hours_graph", "TopHoursGraph", "StatsGraph", -1},
+			{"interactions_graph", "InteractionsGraph", "StatsGraph", -1},
+			{"iv_interactions_graph", "IvInteractionsGraph", "StatsGraph", -1},
+			{"views_by_source_graph", "ViewsBySourceGraph", "StatsGraph", -1},
+			{"new_followers_by_source_graph", "NewFollowersBySourceGraph", "StatsGraph", -1},
+			{"languages_graph", "LanguagesGraph", "StatsGraph", -1},
+			{"recent_message_interactions", "RecentMessageInteractions", "Vector<MessageInteractionCounters>", -1},
+		},
+		New: func() TL { return TL_stats_broadcastStats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_promoDataEmpty,
+		Name: "help.promoDataEmpty",
+		Type: "help.PromoData",
+		Fields: []FieldInfo{
+			{"expires", "Expires", "int", -1},
+		},
+		New: func() TL { return TL_help_promoDataEmpty{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_promoData,
+		Name: "help.promoData",
+		Type: "help.PromoData",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"proxy", "Proxy", "true", 0},
+			{"expires", "Expires", "int", -1},
+			{"peer", "Peer", "Peer", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"psa_type", "PsaType", "string", 1},
+			{"psa_message", "PsaMessage", "string", 2},
+		},
+		New: func() TL { return TL_help_promoData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_videoSize,
+		Name: "videoSize",
+		Type: "VideoSize",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"type", "Type", "string", -1},
+			{"location", "Location", "FileLocation", -1},
+			{"w", "W", "int", -1},
+			{"h", "H", "int", -1},
+			{"size", "Size", "int", -1},
+			{"video_start_ts", "VideoStartTs", "double", 0},
+		},
+		New: func() TL { return TL_videoSize{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsGroupTopPoster,
+		Name: "statsGroupTopPoster",
+		Type: "StatsGroupTopPoster",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"messages", "Messages", "int", -1},
+			{"avg_chars", "AvgChars", "int", -1},
+		},
+		New: func() TL { return TL_statsGroupTopPoster{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsGroupTopAdmin,
+		Name: "statsGroupTopAdmin",
+		Type: "StatsGroupTopAdmin",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"deleted", "Deleted", "int", -1},
+			{"kicked", "Kicked", "int", -1},
+			{"banned", "Banned", "int", -1},
+		},
+		New: func() TL { return TL_statsGroupTopAdmin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_statsGroupTopInviter,
+		Name: "statsGroupTopInviter",
+		Type: "StatsGroupTopInviter",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"invitations", "Invitations", "int", -1},
+		},
+		New: func() TL { return TL_statsGroupTopInviter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_stats_megagroupStats,
+		Name: "stats.megagroupStats",
+		Type: "stats.MegagroupStats",
+		Fields: []FieldInfo{
+			{"period", "Period", "StatsDateRangeDays", -1},
+			{"members", "Members", "StatsAbsValueAndPrev", -1},
+			{"messages", "Messages", "StatsAbsValueAndPrev", -1},
+			{"viewers", "Viewers", "StatsAbsValueAndPrev", -1},
+			{"posters", "Posters", "StatsAbsValueAndPrev", -1},
+			{"growth_graph", "GrowthGraph", "StatsGraph", -1},
+			{"members_graph", "MembersGraph", "StatsGraph", -1},
+			{"new_members_by_source_graph", "NewMembersBySourceGraph", "StatsGraph", -1},
+			{"languages_graph", "LanguagesGraph", "StatsGraph", -1},
+			{"messages_graph", "MessagesGraph", "StatsGraph", -1},
+			{"actions_graph", "ActionsGraph", "StatsGraph", -1},
+			{"top_hours_graph", "TopHoursGraph", "StatsGraph", -1},
+			{"weekdays_graph", "WeekdaysGraph", "StatsGraph", -1},
+			{"top_posters", "TopPosters", "Vector<StatsGroupTopPoster>", -1},
+			{"top_admins", "TopAdmins", "Vector<StatsGroupTopAdmin>", -1},
+			{"top_inviters", "TopInviters", "Vector<StatsGroupTopInviter>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_stats_megagroupStats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_globalPrivacySettings,
+		Name: "globalPrivacySettings",
+		Type: "GlobalPrivacySettings",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"archive_and_mute_new_noncontact_peers", "ArchiveAndMuteNewNoncontactPeers", "Bool", 0},
+		},
+		New: func() TL { return TL_globalPrivacySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_countryCode,
+		Name: "help.countryCode",
+		Type: "help.CountryCode",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"country_code", "CountryCode", "string", -1},
+			{"prefixes", "Prefixes", "Vector<string>", 0},
+			{"patterns", "Patterns", "Vector<string>", 1},
+		},
+		New: func() TL { return TL_help_countryCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_country,
+		Name: "help.country",
+		Type: "help.Country",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"hidden", "Hidden", "true", 0},
+			{"iso2", "Iso2", "string", -1},
+			{"default_name", "DefaultName", "string", -1},
+			{"name", "Name", "string", 1},
+			{"country_codes", "CountryCodes", "Vector<help.CountryCode>", -1},
+		},
+		New: func() TL { return TL_help_country{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_countriesListNotModified,
+		Name: "help.countriesListNotModified",
+		Type: "help.CountriesList",
+		New:  func() TL { return TL_help_countriesListNotModified{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_help_countriesList,
+		Name: "help.countriesList",
+		Type: "help.CountriesList",
+		Fields: []FieldInfo{
+			{"countries", "Countries", "Vector<help.Country>", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_help_countriesList{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageViews,
+		Name: "messageViews",
+		Type: "MessageViews",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"views", "Views", "int", 0},
+			{"forwards", "Forwards", "int", 1},
+			{"replies", "Replies", "MessageReplies", 2},
+		},
+		New: func() TL { return TL_messageViews{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_messageViews,
+		Name: "messages.messageViews",
+		Type: "messages.MessageViews",
+		Fields: []FieldInfo{
+			{"views", "Views", "Vector<MessageViews>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_messageViews{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_discussionMessage,
+		Name: "messages.discussionMessage",
+		Type: "messages.DiscussionMessage",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"messages", "Messages", "Vector<Message>", -1},
+			{"max_id", "MaxID", "int", 0},
+			{"read_inbox_max_id", "ReadInboxMaxID", "int", 1},
+			{"read_outbox_max_id", "ReadOutboxMaxID", "int", 2},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_discussionMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageReplyHeader,
+		Name: "messageReplyHeader",
+		Type: "MessageReplyHeader",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"reply_to_msg_id", "ReplyToMsgID", "int", -1},
+			{"reply_to_peer_id", "ReplyToPeerID", "Peer", 0},
+			{"reply_to_top_id", "ReplyToTopID", "int", 1},
+		},
+		New: func() TL { return TL_messageReplyHeader{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messageReplies,
+		Name: "messageReplies",
+		Type: "MessageReplies",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"comments", "Comments", "true", 0},
+			{"replies", "Replies", "int", -1},
+			{"replies_pts", "RepliesPts", "int", -1},
+			{"recent_repliers", "RecentRepliers", "Vector<Peer>", 1},
+			{"channel_id", "ChannelID", "int", 0},
+			{"max_id", "MaxID", "int", 2},
+			{"read_max_id", "ReadMaxID", "int", 3},
+		},
+		New: func() TL { return TL_messageReplies{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_peerBlocked,
+		Name: "peerBlocked",
+		Type: "PeerBlocked",
+		Fields: []FieldInfo{
+			{"peer_id", "PeerID", "Peer", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_peerBlocked{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_stats_messageStats,
+		Name: "stats.messageStats",
+		Type: "stats.MessageStats",
+		Fields: []FieldInfo{
+			{"views_graph", "ViewsGraph", "StatsGraph", -1},
+		},
+		New: func() TL { return TL_stats_messageStats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_groupCallDiscarded,
+		Name: "groupCallDiscarded",
+		Type: "GroupCall",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"duration", "Duration", "int", -1},
+		},
+		New: func() TL { return TL_groupCallDiscarded{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_groupCall,
+		Name: "groupCall",
+		Type: "GroupCall",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"join_muted", "JoinMuted", "true", 1},
+			{"can_change_join_muted", "CanChangeJoinMuted", "true", 2},
+			{"join_date_asc", "JoinDateAsc", "true", 6},
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+			{"participants_count", "ParticipantsCount", "int", -1},
+			{"params", "Params", "DataJSON", 0},
+			{"title", "Title", "string", 3},
+			{"stream_dc_id", "StreamDcID", "int", 4},
+			{"record_start_date", "RecordStartDate", "int", 5},
+			{"version", "Version", "int", -1},
+		},
+		New: func() TL { return TL_groupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inputGroupCall,
+		Name: "inputGroupCall",
+		Type: "InputGroupCall",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+			{"access_hash", "AccessHash", "long", -1},
+		},
+		New: func() TL { return TL_inputGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_groupCallParticipant,
+		Name: "groupCallParticipant",
+		Type: "GroupCallParticipant",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"muted", "Muted", "true", 0},
+			{"left", "Left", "true", 1},
+			{"can_self_unmute", "CanSelfUnmute", "true", 2},
+			{"just_joined", "JustJoined", "true", 4},
+			{"versioned", "Versioned", "true", 5},
+			{"min", "Min", "true", 8},
+			{"muted_by_you", "MutedByYou", "true", 9},
+			{"volume_by_admin", "VolumeByAdmin", "true", 10},
+			{"self", "Self", "true", 12},
+			{"peer", "Peer", "Peer", -1},
+			{"date", "Date", "int", -1},
+			{"active_date", "ActiveDate", "int", 3},
+			{"source", "Source", "int", -1},
+			{"volume", "Volume", "int", 7},
+			{"about", "About", "string", 11},
+			{"raise_hand_rating", "RaiseHandRating", "long", 13},
+		},
+		New: func() TL { return TL_groupCallParticipant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phone_groupCall,
+		Name: "phone.groupCall",
+		Type: "phone.GroupCall",
+		Fields: []FieldInfo{
+			{"call", "Call", "GroupCall", -1},
+			{"participants", "Participants", "Vector<GroupCallParticipant>", -1},
+			{"participants_next_offset", "ParticipantsNextOffset", "string", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_phone_groupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phone_groupParticipants,
+		Name: "phone.groupParticipants",
+		Type: "phone.GroupParticipants",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"participants", "Participants", "Vector<GroupCallParticipant>", -1},
+			{"next_offset", "NextOffset", "string", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+			{"version", "Version", "int", -1},
+		},
+		New: func() TL { return TL_phone_groupParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inlineQueryPeerTypeSameBotPM,
+		Name: "inlineQueryPeerTypeSameBotPM",
+		Type: "InlineQueryPeerType",
+		New:  func() TL { return TL_inlineQueryPeerTypeSameBotPM{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inlineQueryPeerTypePM,
+		Name: "inlineQueryPeerTypePM",
+		Type: "InlineQueryPeerType",
+		New:  func() TL { return TL_inlineQueryPeerTypePM{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inlineQueryPeerTypeChat,
+		Name: "inlineQueryPeerTypeChat",
+		Type: "InlineQueryPeerType",
+		New:  func() TL { return TL_inlineQueryPeerTypeChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inlineQueryPeerTypeMegagroup,
+		Name: "inlineQueryPeerTypeMegagroup",
+		Type: "InlineQueryPeerType",
+		New:  func() TL { return TL_inlineQueryPeerTypeMegagroup{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_inlineQueryPeerTypeBroadcast,
+		Name: "inlineQueryPeerTypeBroadcast",
+		Type: "InlineQueryPeerType",
+		New:  func() TL { return TL_inlineQueryPeerTypeBroadcast{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_historyImport,
+		Name: "messages.historyImport",
+		Type: "messages.HistoryImport",
+		Fields: []FieldInfo{
+			{"id", "ID", "long", -1},
+		},
+		New: func() TL { return TL_messages_historyImport{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_historyImportParsed,
+		Name: "messages.historyImportParsed",
+		Type: "messages.HistoryImportParsed",
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"pm", "Pm", "true", 0},
+			{"group", "Group", "true", 1},
+			{"title", "Title", "string", 2},
+		},
+		New: func() TL { return TL_messages_historyImportParsed{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_affectedFoundMessages,
+		Name: "messages.affectedFoundMessages",
+		Type: "messages.AffectedFoundMessages",
+		Fields: []FieldInfo{
+			{"pts", "Pts", "int", -1},
+			{"pts_count", "PtsCount", "int", -1},
+			{"offset", "Offset", "int", -1},
+			{"messages", "Messages", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_affectedFoundMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatInviteImporter,
+		Name: "chatInviteImporter",
+		Type: "ChatInviteImporter",
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "int", -1},
+			{"date", "Date", "int", -1},
+		},
+		New: func() TL { return TL_chatInviteImporter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_exportedChatInvites,
+		Name: "messages.exportedChatInvites",
+		Type: "messages.ExportedChatInvites",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"invites", "Invites", "Vector<ExportedChatInvite>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_exportedChatInvites{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_exportedChatInvite,
+		Name: "messages.exportedChatInvite",
+		Type: "messages.ExportedChatInvite",
+		Fields: []FieldInfo{
+			{"invite", "Invite", "ExportedChatInvite", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_exportedChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_exportedChatInviteReplaced,
+		Name: "messages.exportedChatInviteReplaced",
+		Type: "messages.ExportedChatInvite",
+		Fields: []FieldInfo{
+			{"invite", "Invite", "ExportedChatInvite", -1},
+			{"new_invite", "NewInvite", "ExportedChatInvite", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_exportedChatInviteReplaced{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_chatInviteImporters,
+		Name: "messages.chatInviteImporters",
+		Type: "messages.ChatInviteImporters",
+		Fields: []FieldInfo{
+			{"count", "Count", "int", -1},
+			{"importers", "Importers", "Vector<ChatInviteImporter>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_chatInviteImporters{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_chatAdminWithInvites,
+		Name: "chatAdminWithInvites",
+		Type: "ChatAdminWithInvites",
+		Fields: []FieldInfo{
+			{"admin_id", "AdminID", "int", -1},
+			{"invites_count", "InvitesCount", "int", -1},
+			{"revoked_invites_count", "RevokedInvitesCount", "int", -1},
+		},
+		New: func() TL { return TL_chatAdminWithInvites{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_chatAdminsWithInvites,
+		Name: "messages.chatAdminsWithInvites",
+		Type: "messages.ChatAdminsWithInvites",
+		Fields: []FieldInfo{
+			{"admins", "Admins", "Vector<ChatAdminWithInvites>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_messages_chatAdminsWithInvites{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_messages_checkedHistoryImportPeer,
+		Name: "messages.checkedHistoryImportPeer",
+		Type: "messages.CheckedHistoryImportPeer",
+		Fields: []FieldInfo{
+			{"confirm_text", "ConfirmText", "string", -1},
+		},
+		New: func() TL { return TL_messages_checkedHistoryImportPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phone_joinAsPeers,
+		Name: "phone.joinAsPeers",
+		Type: "phone.JoinAsPeers",
+		Fields: []FieldInfo{
+			{"peers", "Peers", "Vector<Peer>", -1},
+			{"chats", "Chats", "Vector<Chat>", -1},
+			{"users", "Users", "Vector<User>", -1},
+		},
+		New: func() TL { return TL_phone_joinAsPeers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:  CRC_phone_exportedGroupCallInvite,
+		Name: "phone.exportedGroupCallInvite",
+		Type: "phone.ExportedGroupCallInvite",
+		Fields: []FieldInfo{
+			{"link", "Link", "string", -1},
+		},
+		New: func() TL { return TL_phone_exportedGroupCallInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_invokeAfterMsg,
+		Name:       "invokeAfterMsg",
+		Type:       "X",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"msg_id", "MsgID", "long", -1},
+			{"query", "Query", "!X", -1},
+		},
+		New: func() TL { return TL_invokeAfterMsg{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_invokeAfterMsgs,
+		Name:       "invokeAfterMsgs",
+		Type:       "X",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"msg_ids", "MsgIds", "Vector<long>", -1},
+			{"query", "Query", "!X", -1},
+		},
+		New: func() TL { return TL_invokeAfterMsgs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_initConnection,
+		Name:       "initConnection",
+		Type:       "X",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"api_id", "ApiID", "int", -1},
+			{"device_model", "DeviceModel", "string", -1},
+			{"system_version", "SystemVersion", "string", -1},
+			{"app_version", "AppVersion", "string", -1},
+			{"system_lang_code", "SystemLangCode", "string", -1},
+			{"lang_pack", "LangPack", "string", -1},
+			{"lang_code", "LangCode", "string", -1},
+			{"proxy", "Proxy", "InputClientProxy", 0},
+			{"params", "Params", "JSONValue", 1},
+			{"query", "Query", "!X", -1},
+		},
+		New: func() TL { return TL_initConnection{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_invokeWithLayer,
+		Name:       "invokeWithLayer",
+		Type:       "X",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"layer", "Layer", "int", -1},
+			{"query", "Query", "!X", -1},
+		},
+		New: func() TL { return TL_invokeWithLayer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_invokeWithoutUpdates,
+		Name:       "invokeWithoutUpdates",
+		Type:       "X",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"query", "Query", "!X", -1},
+		},
+		New: func() TL { return TL_invokeWithoutUpdates{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_invokeWithMessagesRange,
+		Name:       "invokeWithMessagesRange",
+		Type:       "X",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"range", "Range", "MessageRange", -1},
+			{"query", "Query", "!X", -1},
+		},
+		New: func() TL { return TL_invokeWithMessagesRange{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_invokeWithTakeout,
+		Name:       "invokeWithTakeout",
+		Type:       "X",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"takeout_id", "TakeoutID", "long", -1},
+			{"query", "Query", "!X", -1},
+		},
+		New: func() TL { return TL_invokeWithTakeout{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_sendCode,
+		Name:       "auth.sendCode",
+		Type:       "auth.SentCode",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"api_id", "ApiID", "int", -1},
+			{"api_hash", "ApiHash", "string", -1},
+			{"settings", "Settings", "CodeSettings", -1},
+		},
+		New: func() TL { return TL_auth_sendCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_signUp,
+		Name:       "auth.signUp",
+		Type:       "auth.Authorization",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"phone_code_hash", "PhoneCodeHash", "string", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+		},
+		New: func() TL { return TL_auth_signUp{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_signIn,
+		Name:       "auth.signIn",
+		Type:       "auth.Authorization",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"phone_code_hash", "PhoneCodeHash", "string", -1},
+			{"phone_code", "PhoneCode", "string", -1},
+		},
+		New: func() TL { return TL_auth_signIn{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_logOut,
+		Name:       "auth.logOut",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_auth_logOut{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_resetAuthorizations,
+		Name:       "auth.resetAuthorizations",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_auth_resetAuthorizations{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_exportAuthorization,
+		Name:       "auth.exportAuthorization",
+		Type:       "auth.ExportedAuthorization",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"dc_id", "DcID", "int", -1},
+		},
+		New: func() TL { return TL_auth_exportAuthorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_importAuthorization,
+		Name:       "auth.importAuthorization",
+		Type:       "auth.Authorization",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_auth_importAuthorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_bindTempAuthKey,
+		Name:       "auth.bindTempAuthKey",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"perm_auth_key_id", "PermAuthKeyID", "long", -1},
+			{"nonce", "Nonce", "long", -1},
+			{"expires_at", "ExpiresAt", "int", -1},
+			{"encrypted_message", "EncryptedMessage", "bytes", -1},
+		},
+		New: func() TL { return TL_auth_bindTempAuthKey{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_importBotAuthorization,
+		Name:       "auth.importBotAuthorization",
+		Type:       "auth.Authorization",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "int", -1},
+			{"api_id", "ApiID", "int", -1},
+			{"api_hash", "ApiHash", "string", -1},
+			{"bot_auth_token", "BotAuthToken", "string", -1},
+		},
+		New: func() TL { return TL_auth_importBotAuthorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_checkPassword,
+		Name:       "auth.checkPassword",
+		Type:       "auth.Authorization",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"password", "Password", "InputCheckPasswordSRP", -1},
+		},
+		New: func() TL { return TL_auth_checkPassword{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_requestPasswordRecovery,
+		Name:       "auth.requestPasswordRecovery",
+		Type:       "auth.PasswordRecovery",
+		IsFunction: true,
+		New:        func() TL { return TL_auth_requestPasswordRecovery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_recoverPassword,
+		Name:       "auth.recoverPassword",
+		Type:       "auth.Authorization",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"code", "Code", "string", -1},
+		},
+		New: func() TL { return TL_auth_recoverPassword{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_resendCode,
+		Name:       "auth.resendCode",
+		Type:       "auth.SentCode",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"phone_code_hash", "PhoneCodeHash", "string", -1},
+		},
+		New: func() TL { return TL_auth_resendCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_cancelCode,
+		Name:       "auth.cancelCode",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"phone_code_hash", "PhoneCodeHash", "string", -1},
+		},
+		New: func() TL { return TL_auth_cancelCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_dropTempAuthKeys,
+		Name:       "auth.dropTempAuthKeys",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"except_auth_keys", "ExceptAuthKeys", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_auth_dropTempAuthKeys{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_exportLoginToken,
+		Name:       "auth.exportLoginToken",
+		Type:       "auth.LoginToken",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"api_id", "ApiID", "int", -1},
+			{"api_hash", "ApiHash", "string", -1},
+			{"except_ids", "ExceptIds", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_auth_exportLoginToken{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_importLoginToken,
+		Name:       "auth.importLoginToken",
+		Type:       "auth.LoginToken",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"token", "Token", "bytes", -1},
+		},
+		New: func() TL { return TL_auth_importLoginToken{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_auth_acceptLoginToken,
+		Name:       "auth.acceptLoginToken",
+		Type:       "Authorization",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"token", "Token", "bytes", -1},
+		},
+		New: func() TL { return TL_auth_acceptLoginToken{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_registerDevice,
+		Name:       "account.registerDevice",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"no_muted", "NoMuted", "true", 0},
+			{"token_type", "TokenType", "int", -1},
+			{"token", "Token", "string", -1},
+			{"app_sandbox", "AppSandbox", "Bool", -1},
+			{"secret", "Secret", "bytes", -1},
+			{"other_uids", "OtherUids", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_account_registerDevice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_unregisterDevice,
+		Name:       "account.unregisterDevice",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"token_type", "TokenType", "int", -1},
+			{"token", "Token", "string", -1},
+			{"other_uids", "OtherUids", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_account_unregisterDevice{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_updateNotifySettings,
+		Name:       "account.updateNotifySettings",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputNotifyPeer", -1},
+			{"settings", "Settings", "InputPeerNotifySettings", -1},
+		},
+		New: func() TL { return TL_account_updateNotifySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getNotifySettings,
+		Name:       "account.getNotifySettings",
+		Type:       "PeerNotifySettings",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputNotifyPeer", -1},
+		},
+		New: func() TL { return TL_account_getNotifySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_resetNotifySettings,
+		Name:       "account.resetNotifySettings",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_account_resetNotifySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_updateProfile,
+		Name:       "account.updateProfile",
+		Type:       "User",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"first_name", "FirstName", "string", 0},
+			{"last_name", "LastName", "string", 1},
+			{"about", "About", "string", 2},
+		},
+		New: func() TL { return TL_account_updateProfile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_updateStatus,
+		Name:       "account.updateStatus",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"offline", "Offline", "Bool", -1},
+		},
+		New: func() TL { return TL_account_updateStatus{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getWallPapers,
+		Name:       "account.getWallPapers",
+		Type:       "account.WallPapers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_account_getWallPapers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_reportPeer,
+		Name:       "account.reportPeer",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"reason", "Reason", "ReportReason", -1},
+			{"message", "Message", "string", -1},
+		},
+		New: func() TL { return TL_account_reportPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_checkUsername,
+		Name:       "account.checkUsername",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"username", "Username", "string", -1},
+		},
+		New: func() TL { return TL_account_checkUsername{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_updateUsername,
+		Name:       "account.updateUsername",
+		Type:       "User",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"username", "Username", "string", -1},
+		},
+		New: func() TL { return TL_account_updateUsername{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getPrivacy,
+		Name:       "account.getPrivacy",
+		Type:       "account.PrivacyRules",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"key", "Key", "InputPrivacyKey", -1},
+		},
+		New: func() TL { return TL_account_getPrivacy{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_setPrivacy,
+		Name:       "account.setPrivacy",
+		Type:       "account.PrivacyRules",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"key", "Key", "InputPrivacyKey", -1},
+			{"rules", "Rules", "Vector<InputPrivacyRule>", -1},
+		},
+		New: func() TL { return TL_account_setPrivacy{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_deleteAccount,
+		Name:       "account.deleteAccount",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"reason", "Reason", "string", -1},
+		},
+		New: func() TL { return TL_account_deleteAccount{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getAccountTTL,
+		Name:       "account.getAccountTTL",
+		Type:       "AccountDaysTTL",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getAccountTTL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_setAccountTTL,
+		Name:       "account.setAccountTTL",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"ttl", "Ttl", "AccountDaysTTL", -1},
+		},
+		New: func() TL { return TL_account_setAccountTTL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_sendChangePhoneCode,
+		Name:       "account.sendChangePhoneCode",
+		Type:       "auth.SentCode",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"settings", "Settings", "CodeSettings", -1},
+		},
+		New: func() TL { return TL_account_sendChangePhoneCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_changePhone,
+		Name:       "account.changePhone",
+		Type:       "User",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"phone_code_hash", "PhoneCodeHash", "string", -1},
+			{"phone_code", "PhoneCode", "string", -1},
+		},
+		New: func() TL { return TL_account_changePhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_updateDeviceLocked,
+		Name:       "account.updateDeviceLocked",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"period", "Period", "int", -1},
+		},
+		New: func() TL { return TL_account_updateDeviceLocked{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getAuthorizations,
+		Name:       "account.getAuthorizations",
+		Type:       "account.Authorizations",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getAuthorizations{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_resetAuthorization,
+		Name:       "account.resetAuthorization",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "long", -1},
+		},
+		New: func() TL { return TL_account_resetAuthorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getPassword,
+		Name:       "account.getPassword",
+		Type:       "account.Password",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getPassword{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getPasswordSettings,
+		Name:       "account.getPasswordSettings",
+		Type:       "account.PasswordSettings",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"password", "Password", "InputCheckPasswordSRP", -1},
+		},
+		New: func() TL { return TL_account_getPasswordSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_updatePasswordSettings,
+		Name:       "account.updatePasswordSettings",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"password", "Password", "InputCheckPasswordSRP", -1},
+			{"new_settings", "NewSettings", "account.PasswordInputSettings", -1},
+		},
+		New: func() TL { return TL_account_updatePasswordSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_sendConfirmPhoneCode,
+		Name:       "account.sendConfirmPhoneCode",
+		Type:       "auth.SentCode",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "string", -1},
+			{"settings", "Settings", "CodeSettings", -1},
+		},
+		New: func() TL { return TL_account_sendConfirmPhoneCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_confirmPhone,
+		Name:       "account.confirmPhone",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_code_hash", "PhoneCodeHash", "string", -1},
+			{"phone_code", "PhoneCode", "string", -1},
+		},
+		New: func() TL { return TL_account_confirmPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getTmpPassword,
+		Name:       "account.getTmpPassword",
+		Type:       "account.TmpPassword",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"password", "Password", "InputCheckPasswordSRP", -1},
+			{"period", "Period", "int", -1},
+		},
+		New: func() TL { return TL_account_getTmpPassword{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getWebAuthorizations,
+		Name:       "account.getWebAuthorizations",
+		Type:       "account.WebAuthorizations",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getWebAuthorizations{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_resetWebAuthorization,
+		Name:       "account.resetWebAuthorization",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "long", -1},
+		},
+		New: func() TL { return TL_account_resetWebAuthorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_resetWebAuthorizations,
+		Name:       "account.resetWebAuthorizations",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_account_resetWebAuthorizations{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getAllSecureValues,
+		Name:       "account.getAllSecureValues",
+		Type:       "Vector<SecureValue>",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getAllSecureValues{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getSecureValue,
+		Name:       "account.getSecureValue",
+		Type:       "Vector<SecureValue>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"types", "Types", "Vector<SecureValueType>", -1},
+		},
+		New: func() TL { return TL_account_getSecureValue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_saveSecureValue,
+		Name:       "account.saveSecureValue",
+		Type:       "SecureValue",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"value", "Value", "InputSecureValue", -1},
+			{"secure_secret_id", "SecureSecretID", "long", -1},
+		},
+		New: func() TL { return TL_account_saveSecureValue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_deleteSecureValue,
+		Name:       "account.deleteSecureValue",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"types", "Types", "Vector<SecureValueType>", -1},
+		},
+		New: func() TL { return TL_account_deleteSecureValue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getAuthorizationForm,
+		Name:       "account.getAuthorizationForm",
+		Type:       "account.AuthorizationForm",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"bot_id", "BotID", "int", -1},
+			{"scope", "Scope", "string", -1},
+			{"public_key", "PublicKey", "string", -1},
+		},
+		New: func() TL { return TL_account_getAuthorizationForm{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_acceptAuthorization,
+		Name:       "account.acceptAuthorization",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"bot_id", "BotID", "int", -1},
+			{"scope", "Scope", "string", -1},
+			{"public_key", "PublicKey", "string", -1},
+			{"value_hashes", "ValueHashes", "Vector<SecureValueHash>", -1},
+			{"credentials", "Credentials", "SecureCredentialsEncrypted", -1},
+		},
+		New: func() TL { return TL_account_acceptAuthorization{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_sendVerifyPhoneCode,
+		Name:       "account.sendVerifyPhoneCode",
+		Type:       "auth.SentCode",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"settings", "Settings", "CodeSettings", -1},
+		},
+		New: func() TL { return TL_account_sendVerifyPhoneCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_verifyPhone,
+		Name:       "account.verifyPhone",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phone_number", "PhoneNumber", "string", -1},
+			{"phone_code_hash", "PhoneCodeHash", "string", -1},
+			{"phone_code", "PhoneCode", "string", -1},
+		},
+		New: func() TL { return TL_account_verifyPhone{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_sendVerifyEmailCode,
+		Name:       "account.sendVerifyEmailCode",
+		Type:       "account.SentEmailCode",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"email", "Email", "string", -1},
+		},
+		New: func() TL { return TL_account_sendVerifyEmailCode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_verifyEmail,
+		Name:       "account.verifyEmail",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"email", "Email", "string", -1},
+			{"code", "Code", "string", -1},
+		},
+		New: func() TL { return TL_account_verifyEmail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_initTakeoutSession,
+		Name:       "account.initTakeoutSession",
+		Type:       "account.Takeout",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"contacts", "Contacts", "true", 0},
+			{"message_users", "MessageUsers", "true", 1},
+			{"message_chats", "MessageChats", "true", 2},
+			{"message_megagroups", "MessageMegagroups", "true", 3},
+			{"message_channels", "MessageChannels", "true", 4},
+			{"files", "Files", "true", 5},
+			{"file_max_size", "FileMaxSize", "int", 5},
+		},
+		New: func() TL { return TL_account_initTakeoutSession{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_finishTakeoutSession,
+		Name:       "account.finishTakeoutSession",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"success", "Success", "true", 0},
+		},
+		New: func() TL { return TL_account_finishTakeoutSession{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_confirmPasswordEmail,
+		Name:       "account.confirmPasswordEmail",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"code", "Code", "string", -1},
+		},
+		New: func() TL { return TL_account_confirmPasswordEmail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_resendPasswordEmail,
+		Name:       "account.resendPasswordEmail",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_account_resendPasswordEmail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_cancelPasswordEmail,
+		Name:       "account.cancelPasswordEmail",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_account_cancelPasswordEmail{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getContactSignUpNotification,
+		Name:       "account.getContactSignUpNotification",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getContactSignUpNotification{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_setContactSignUpNotification,
+		Name:       "account.setContactSignUpNotification",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"silent", "Silent", "Bool", -1},
+		},
+		New: func() TL { return TL_account_setContactSignUpNotification{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getNotifyExceptions,
+		Name:       "account.getNotifyExceptions",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"compare_sound", "CompareSound", "true", 1},
+			{"peer", "Peer", "InputNotifyPeer", 0},
+		},
+		New: func() TL { return TL_account_getNotifyExceptions{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getWallPaper,
+		Name:       "account.getWallPaper",
+		Type:       "WallPaper",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"wallpaper", "Wallpaper", "InputWallPaper", -1},
+		},
+		New: func() TL { return TL_account_getWallPaper{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_uploadWallPaper,
+		Name:       "account.uploadWallPaper",
+		Type:       "WallPaper",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"file", "File", "InputFile", -1},
+			{"mime_type", "MimeType", "string", -1},
+			{"settings", "Settings", "WallPaperSettings", -1},
+		},
+		New: func() TL { return TL_account_uploadWallPaper{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_saveWallPaper,
+		Name:       "account.saveWallPaper",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"wallpaper", "Wallpaper", "InputWallPaper", -1},
+			{"unsave", "Unsave", "Bool", -1},
+			{"settings", "Settings", "WallPaperSettings", -1},
+		},
+		New: func() TL { return TL_account_saveWallPaper{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_installWallPaper,
+		Name:       "account.installWallPaper",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"wallpaper", "Wallpaper", "InputWallPaper", -1},
+			{"settings", "Settings", "WallPaperSettings", -1},
+		},
+		New: func() TL { return TL_account_installWallPaper{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_resetWallPapers,
+		Name:       "account.resetWallPapers",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_account_resetWallPapers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getAutoDownloadSettings,
+		Name:       "account.getAutoDownloadSettings",
+		Type:       "account.AutoDownloadSettings",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getAutoDownloadSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_saveAutoDownloadSettings,
+		Name:       "account.saveAutoDownloadSettings",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"low", "Low", "true", 0},
+			{"high", "High", "true", 1},
+			{"settings", "Settings", "AutoDownloadSettings", -1},
+		},
+		New: func() TL { return TL_account_saveAutoDownloadSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_uploadTheme,
+		Name:       "account.uploadTheme",
+		Type:       "Document",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"file", "File", "InputFile", -1},
+			{"thumb", "Thumb", "InputFile", 0},
+			{"file_name", "FileName", "string", -1},
+			{"mime_type", "MimeType", "string", -1},
+		},
+		New: func() TL { return TL_account_uploadTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_createTheme,
+		Name:       "account.createTheme",
+		Type:       "Theme",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"slug", "Slug", "string", -1},
+			{"title", "Title", "string", -1},
+			{"document", "Document", "InputDocument", 2},
+			{"settings", "Settings", "InputThemeSettings", 3},
+		},
+		New: func() TL { return TL_account_createTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_updateTheme,
+		Name:       "account.updateTheme",
+		Type:       "Theme",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"format", "Format", "string", -1},
+			{"theme", "Theme", "InputTheme", -1},
+			{"slug", "Slug", "string", 0},
+			{"title", "Title", "string", 1},
+			{"document", "Document", "InputDocument", 2},
+			{"settings", "Settings", "InputThemeSettings", 3},
+		},
+		New: func() TL { return TL_account_updateTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_saveTheme,
+		Name:       "account.saveTheme",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"theme", "Theme", "InputTheme", -1},
+			{"unsave", "Unsave", "Bool", -1},
+		},
+		New: func() TL { return TL_account_saveTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_installTheme,
+		Name:       "account.installTheme",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"dark", "Dark", "true", 0},
+			{"format", "Format", "string", 1},
+			{"theme", "Theme", "InputTheme", 1},
+		},
+		New: func() TL { return TL_account_installTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getTheme,
+		Name:       "account.getTheme",
+		Type:       "Theme",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"format", "Format", "string", -1},
+			{"theme", "Theme", "InputTheme", -1},
+			{"document_id", "DocumentID", "long", -1},
+		},
+		New: func() TL { return TL_account_getTheme{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getThemes,
+		Name:       "account.getThemes",
+		Type:       "account.Themes",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"format", "Format", "string", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_account_getThemes{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_setContentSettings,
+		Name:       "account.setContentSettings",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"sensitive_enabled", "SensitiveEnabled", "true", 0},
+		},
+		New: func() TL { return TL_account_setContentSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getContentSettings,
+		Name:       "account.getContentSettings",
+		Type:       "account.ContentSettings",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getContentSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getMultiWallPapers,
+		Name:       "account.getMultiWallPapers",
+		Type:       "Vector<WallPaper>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"wallpapers", "Wallpapers", "Vector<InputWallPaper>", -1},
+		},
+		New: func() TL { return TL_account_getMultiWallPapers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_getGlobalPrivacySettings,
+		Name:       "account.getGlobalPrivacySettings",
+		Type:       "GlobalPrivacySettings",
+		IsFunction: true,
+		New:        func() TL { return TL_account_getGlobalPrivacySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_setGlobalPrivacySettings,
+		Name:       "account.setGlobalPrivacySettings",
+		Type:       "GlobalPrivacySettings",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"settings", "Settings", "GlobalPrivacySettings", -1},
+		},
+		New: func() TL { return TL_account_setGlobalPrivacySettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_account_reportProfilePhoto,
+		Name:       "account.reportProfilePhoto",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"photo_id", "PhotoID", "InputPhoto", -1},
+			{"reason", "Reason", "ReportReason", -1},
+			{"message", "Message", "string", -1},
+		},
+		New: func() TL { return TL_account_reportProfilePhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_users_getUsers,
+		Name:       "users.getUsers",
+		Type:       "Vector<User>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "Vector<InputUser>", -1},
+		},
+		New: func() TL { return TL_users_getUsers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_users_getFullUser,
+		Name:       "users.getFullUser",
+		Type:       "UserFull",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputUser", -1},
+		},
+		New: func() TL { return TL_users_getFullUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_users_setSecureValueErrors,
+		Name:       "users.setSecureValueErrors",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputUser", -1},
+			{"errors", "Errors", "Vector<SecureValueError>", -1},
+		},
+		New: func() TL { return TL_users_setSecureValueErrors{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_getContactIDs,
+		Name:       "contacts.getContactIDs",
+		Type:       "Vector<int>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_contacts_getContactIDs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_getStatuses,
+		Name:       "contacts.getStatuses",
+		Type:       "Vector<ContactStatus>",
+		IsFunction: true,
+		New:        func() TL { return TL_contacts_getStatuses{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_getContacts,
+		Name:       "contacts.getContacts",
+		Type:       "contacts.Contacts",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_contacts_getContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_importContacts,
+		Name:       "contacts.importContacts",
+		Type:       "contacts.ImportedContacts",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"contacts", "Contacts", "Vector<InputContact>", -1},
+		},
+		New: func() TL { return TL_contacts_importContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_deleteContacts,
+		Name:       "contacts.deleteContacts",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "Vector<InputUser>", -1},
+		},
+		New: func() TL { return TL_contacts_deleteContacts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_deleteByPhones,
+		Name:       "contacts.deleteByPhones",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"phones", "Phones", "Vector<string>", -1},
+		},
+		New: func() TL { return TL_contacts_deleteByPhones{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_block,
+		Name:       "contacts.block",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputPeer", -1},
+		},
+		New: func() TL { return TL_contacts_block{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_unblock,
+		Name:       "contacts.unblock",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputPeer", -1},
+		},
+		New: func() TL { return TL_contacts_unblock{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_getBlocked,
+		Name:       "contacts.getBlocked",
+		Type:       "contacts.Blocked",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_contacts_getBlocked{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_search,
+		Name:       "contacts.search",
+		Type:       "contacts.Found",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"q", "Q", "string", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_contacts_search{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_resolveUsername,
+		Name:       "contacts.resolveUsername",
+		Type:       "contacts.ResolvedPeer",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"username", "Username", "string", -1},
+		},
+		New: func() TL { return TL_contacts_resolveUsername{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_getTopPeers,
+		Name:       "contacts.getTopPeers",
+		Type:       "contacts.TopPeers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"correspondents", "Correspondents", "true", 0},
+			{"bots_pm", "BotsPm", "true", 1},
+			{"bots_inline", "BotsInline", "true", 2},
+			{"phone_calls", "PhoneCalls", "true", 3},
+			{"forward_users", "ForwardUsers", "true", 4},
+			{"forward_chats", "ForwardChats", "true", 5},
+			{"groups", "Groups", "true", 10},
+			{"channels", "Channels", "true", 15},
+			{"offset", "Offset", "int", -1},
+			{"limit", "Limit", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_contacts_getTopPeers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_resetTopPeerRating,
+		Name:       "contacts.resetTopPeerRating",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"category", "Category", "TopPeerCategory", -1},
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_contacts_resetTopPeerRating{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_resetSaved,
+		Name:       "contacts.resetSaved",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_contacts_resetSaved{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_getSaved,
+		Name:       "contacts.getSaved",
+		Type:       "Vector<SavedContact>",
+		IsFunction: true,
+		New:        func() TL { return TL_contacts_getSaved{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_toggleTopPeers,
+		Name:       "contacts.toggleTopPeers",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"enabled", "Enabled", "Bool", -1},
+		},
+		New: func() TL { return TL_contacts_toggleTopPeers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_addContact,
+		Name:       "contacts.addContact",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"add_phone_privacy_exception", "AddPhonePrivacyException", "true", 0},
+			{"id", "ID", "InputUser", -1},
+			{"first_name", "FirstName", "string", -1},
+			{"last_name", "LastName", "string", -1},
+			{"phone", "Phone", "string", -1},
+		},
+		New: func() TL { return TL_contacts_addContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_acceptContact,
+		Name:       "contacts.acceptContact",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputUser", -1},
+		},
+		New: func() TL { return TL_contacts_acceptContact{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_getLocated,
+		Name:       "contacts.getLocated",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"background", "Background", "true", 1},
+			{"geo_point", "GeoPoint", "InputGeoPoint", -1},
+			{"self_expires", "SelfExpires", "int", 0},
+		},
+		New: func() TL { return TL_contacts_getLocated{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_contacts_blockFromReplies,
+		Name:       "contacts.blockFromReplies",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"delete_message", "DeleteMessage", "true", 0},
+			{"delete_history", "DeleteHistory", "true", 1},
+			{"report_spam", "ReportSpam", "true", 2},
+			{"msg_id", "MsgID", "int", -1},
+		},
+		New: func() TL { return TL_contacts_blockFromReplies{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getMessages,
+		Name:       "messages.getMessages",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "Vector<InputMessage>", -1},
+		},
+		New: func() TL { return TL_messages_getMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getDialogs,
+		Name:       "messages.getDialogs",
+		Type:       "messages.Dialogs",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"exclude_pinned", "ExcludePinned", "true", 0},
+			{"folder_id", "FolderID", "int", 1},
+			{"offset_date", "OffsetDate", "int", -1},
+			{"offset_id", "OffsetID", "int", -1},
+			{"offset_peer", "OffsetPeer", "InputPeer", -1},
+			{"limit", "Limit", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getDialogs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getHistory,
+		Name:       "messages.getHistory",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"offset_id", "OffsetID", "int", -1},
+			{"offset_date", "OffsetDate", "int", -1},
+			{"add_offset", "AddOffset", "int", -1},
+			{"limit", "Limit", "int", -1},
+			{"max_id", "MaxID", "int", -1},
+			{"min_id", "MinID", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_search,
+		Name:       "messages.search",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"q", "Q", "string", -1},
+			{"from_id", "FromID", "InputPeer", 0},
+			{"top_msg_id", "TopMsgID", "int", 1},
+			{"filter", "Filter", "MessagesFilter", -1},
+			{"min_date", "MinDate", "int", -1},
+			{"max_date", "MaxDate", "int", -1},
+			{"offset_id", "OffsetID", "int", -1},
+			{"add_offset", "AddOffset", "int", -1},
+			{"limit", "Limit", "int", -1},
+			{"max_id", "MaxID", "int", -1},
+			{"min_id", "MinID", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_search{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_readHistory,
+		Name:       "messages.readHistory",
+		Type:       "messages.AffectedMessages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"max_id", "MaxID", "int", -1},
+		},
+		New: func() TL { return TL_messages_readHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_deleteHistory,
+		Name:       "messages.deleteHistory",
+		Type:       "messages.AffectedHistory",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"just_clear", "JustClear", "true", 0},
+			{"revoke", "Revoke", "true", 1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"max_id", "MaxID", "int", -1},
+		},
+		New: func() TL { return TL_messages_deleteHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_deleteMessages,
+		Name:       "messages.deleteMessages",
+		Type:       "messages.AffectedMessages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"revoke", "Revoke", "true", 0},
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_deleteMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_receivedMessages,
+		Name:       "messages.receivedMessages",
+		Type:       "Vector<ReceivedNotifyMessage>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"max_id", "MaxID", "int", -1},
+		},
+		New: func() TL { return TL_messages_receivedMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setTyping,
+		Name:       "messages.setTyping",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"top_msg_id", "TopMsgID", "int", 0},
+			{"action", "Action", "SendMessageAction", -1},
+		},
+		New: func() TL { return TL_messages_setTyping{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendMessage,
+		Name:       "messages.sendMessage",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"no_webpage", "NoWebpage", "true", 1},
+			{"silent", "Silent", "true", 5},
+			{"background", "Background", "true", 6},
+			{"clear_draft", "ClearDraft", "true", 7},
+			{"peer", "Peer", "InputPeer", -1},
+			{"reply_to_msg_id", "ReplyToMsgID", "int", 0},
+			{"message", "Message", "string", -1},
+			{"random_id", "RandomID", "long", -1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+			{"entities", "Entities", "Vector<MessageEntity>", 3},
+			{"schedule_date", "ScheduleDate", "int", 10},
+		},
+		New: func() TL { return TL_messages_sendMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendMedia,
+		Name:       "messages.sendMedia",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"silent", "Silent", "true", 5},
+			{"background", "Background", "true", 6},
+			{"clear_draft", "ClearDraft", "true", 7},
+			{"peer", "Peer", "InputPeer", -1},
+			{"reply_to_msg_id", "ReplyToMsgID", "int", 0},
+			{"media", "Media", "InputMedia", -1},
+			{"message", "Message", "string", -1},
+			{"random_id", "RandomID", "long", -1},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+			{"entities", "Entities", "Vector<MessageEntity>", 3},
+			{"schedule_date", "ScheduleDate", "int", 10},
+		},
+		New: func() TL { return TL_messages_sendMedia{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_forwardMessages,
+		Name:       "messages.forwardMessages",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"silent", "Silent", "true", 5},
+			{"background", "Background", "true", 6},
+			{"with_my_score", "WithMyScore", "true", 8},
+			{"from_peer", "FromPeer", "InputPeer", -1},
+			{"id", "ID", "Vector<int>", -1},
+			{"random_id", "RandomID", "Vector<long>", -1},
+			{"to_peer", "ToPeer", "InputPeer", -1},
+			{"schedule_date", "ScheduleDate", "int", 10},
+		},
+		New: func() TL { return TL_messages_forwardMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_reportSpam,
+		Name:       "messages.reportSpam",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_messages_reportSpam{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getPeerSettings,
+		Name:       "messages.getPeerSettings",
+		Type:       "PeerSettings",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_messages_getPeerSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_report,
+		Name:       "messages.report",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "Vector<int>", -1},
+			{"reason", "Reason", "ReportReason", -1},
+			{"message", "Message", "string", -1},
+		},
+		New: func() TL { return TL_messages_report{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getChats,
+		Name:       "messages.getChats",
+		Type:       "messages.Chats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_getChats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getFullChat,
+		Name:       "messages.getFullChat",
+		Type:       "messages.ChatFull",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_messages_getFullChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_editChatTitle,
+		Name:       "messages.editChatTitle",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"title", "Title", "string", -1},
+		},
+		New: func() TL { return TL_messages_editChatTitle{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_editChatPhoto,
+		Name:       "messages.editChatPhoto",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"photo", "Photo", "InputChatPhoto", -1},
+		},
+		New: func() TL { return TL_messages_editChatPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_addChatUser,
+		Name:       "messages.addChatUser",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"user_id", "UserID", "InputUser", -1},
+			{"fwd_limit", "FwdLimit", "int", -1},
+		},
+		New: func() TL { return TL_messages_addChatUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_deleteChatUser,
+		Name:       "messages.deleteChatUser",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"revoke_history", "RevokeHistory", "true", 0},
+			{"chat_id", "ChatID", "int", -1},
+			{"user_id", "UserID", "InputUser", -1},
+		},
+		New: func() TL { return TL_messages_deleteChatUser{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_createChat,
+		Name:       "messages.createChat",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"users", "Users", "Vector<InputUser>", -1},
+			{"title", "Title", "string", -1},
+		},
+		New: func() TL { return TL_messages_createChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getDhConfig,
+		Name:       "messages.getDhConfig",
+		Type:       "messages.DhConfig",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"version", "Version", "int", -1},
+			{"random_length", "RandomLength", "int", -1},
+		},
+		New: func() TL { return TL_messages_getDhConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_requestEncryption,
+		Name:       "messages.requestEncryption",
+		Type:       "EncryptedChat",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "InputUser", -1},
+			{"random_id", "RandomID", "int", -1},
+			{"g_a", "GA", "bytes", -1},
+		},
+		New: func() TL { return TL_messages_requestEncryption{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_acceptEncryption,
+		Name:       "messages.acceptEncryption",
+		Type:       "EncryptedChat",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputEncryptedChat", -1},
+			{"g_b", "GB", "bytes", -1},
+			{"key_fingerprint", "KeyFingerprint", "long", -1},
+		},
+		New: func() TL { return TL_messages_acceptEncryption{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_discardEncryption,
+		Name:       "messages.discardEncryption",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"delete_history", "DeleteHistory", "true", 0},
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_messages_discardEncryption{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setEncryptedTyping,
+		Name:       "messages.setEncryptedTyping",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputEncryptedChat", -1},
+			{"typing", "Typing", "Bool", -1},
+		},
+		New: func() TL { return TL_messages_setEncryptedTyping{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_readEncryptedHistory,
+		Name:       "messages.readEncryptedHistory",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputEncryptedChat", -1},
+			{"max_date", "MaxDate", "int", -1},
+		},
+		New: func() TL { return TL_messages_readEncryptedHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendEncrypted,
+		Name:       "messages.sendEncrypted",
+		Type:       "messages.SentEncryptedMessage",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"silent", "Silent", "true", 0},
+			{"peer", "Peer", "InputEncryptedChat", -1},
+			{"random_id", "RandomID", "long", -1},
+			{"data", "Data", "bytes", -1},
+		},
+		New: func() TL { return TL_messages_sendEncrypted{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendEncryptedFile,
+		Name:       "messages.sendEncryptedFile",
+		Type:       "messages.SentEncryptedMessage",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"silent", "Silent", "true", 0},
+			{"peer", "Peer", "InputEncryptedChat", -1},
+			{"random_id", "RandomID", "long", -1},
+			{"data", "Data", "bytes", -1},
+			{"file", "File", "InputEncryptedFile", -1},
+		},
+		New: func() TL { return TL_messages_sendEncryptedFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendEncryptedService,
+		Name:       "messages.sendEncryptedService",
+		Type:       "messages.SentEncryptedMessage",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputEncryptedChat", -1},
+			{"random_id", "RandomID", "long", -1},
+			{"data", "Data", "bytes", -1},
+		},
+		New: func() TL { return TL_messages_sendEncryptedService{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_receivedQueue,
+		Name:       "messages.receivedQueue",
+		Type:       "Vector<long>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"max_qts", "MaxQts", "int", -1},
+		},
+		New: func() TL { return TL_messages_receivedQueue{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_reportEncryptedSpam,
+		Name:       "messages.reportEncryptedSpam",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputEncryptedChat", -1},
+		},
+		New: func() TL { return TL_messages_reportEncryptedSpam{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_readMessageContents,
+		Name:       "messages.readMessageContents",
+		Type:       "messages.AffectedMessages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_readMessageContents{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getStickers,
+		Name:       "messages.getStickers",
+		Type:       "messages.Stickers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"emoticon", "Emoticon", "string", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getAllStickers,
+		Name:       "messages.getAllStickers",
+		Type:       "messages.AllStickers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getAllStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getWebPagePreview,
+		Name:       "messages.getWebPagePreview",
+		Type:       "MessageMedia",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 3},
+		},
+		New: func() TL { return TL_messages_getWebPagePreview{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_exportChatInvite,
+		Name:       "messages.exportChatInvite",
+		Type:       "ExportedChatInvite",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"legacy_revoke_permanent", "LegacyRevokePermanent", "true", 2},
+			{"peer", "Peer", "InputPeer", -1},
+			{"expire_date", "ExpireDate", "int", 0},
+			{"usage_limit", "UsageLimit", "int", 1},
+		},
+		New: func() TL { return TL_messages_exportChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_checkChatInvite,
+		Name:       "messages.checkChatInvite",
+		Type:       "ChatInvite",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "string", -1},
+		},
+		New: func() TL { return TL_messages_checkChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_importChatInvite,
+		Name:       "messages.importChatInvite",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "string", -1},
+		},
+		New: func() TL { return TL_messages_importChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getStickerSet,
+		Name:       "messages.getStickerSet",
+		Type:       "messages.StickerSet",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"stickerset", "Stickerset", "InputStickerSet", -1},
+		},
+		New: func() TL { return TL_messages_getStickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_installStickerSet,
+		Name:       "messages.installStickerSet",
+		Type:       "messages.StickerSetInstallResult",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"stickerset", "Stickerset", "InputStickerSet", -1},
+			{"archived", "Archived", "Bool", -1},
+		},
+		New: func() TL { return TL_messages_installStickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_uninstallStickerSet,
+		Name:       "messages.uninstallStickerSet",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"stickerset", "Stickerset", "InputStickerSet", -1},
+		},
+		New: func() TL { return TL_messages_uninstallStickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_startBot,
+		Name:       "messages.startBot",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"bot", "Bot", "InputUser", -1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"random_id", "RandomID", "long", -1},
+			{"start_param", "StartParam", "string", -1},
+		},
+		New: func() TL { return TL_messages_startBot{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getMessagesViews,
+		Name:       "messages.getMessagesViews",
+		Type:       "messages.MessageViews",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "Vector<int>", -1},
+			{"increment", "Increment", "Bool", -1},
+		},
+		New: func() TL { return TL_messages_getMessagesViews{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_editChatAdmin,
+		Name:       "messages.editChatAdmin",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+			{"user_id", "UserID", "InputUser", -1},
+			{"is_admin", "IsAdmin", "Bool", -1},
+		},
+		New: func() TL { return TL_messages_editChatAdmin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_migrateChat,
+		Name:       "messages.migrateChat",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_messages_migrateChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_searchGlobal,
+		Name:       "messages.searchGlobal",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"folder_id", "FolderID", "int", 0},
+			{"q", "Q", "string", -1},
+			{"filter", "Filter", "MessagesFilter", -1},
+			{"min_date", "MinDate", "int", -1},
+			{"max_date", "MaxDate", "int", -1},
+			{"offset_rate", "OffsetRate", "int", -1},
+			{"offset_peer", "OffsetPeer", "InputPeer", -1},
+			{"offset_id", "OffsetID", "int", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_messages_searchGlobal{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_reorderStickerSets,
+		Name:       "messages.reorderStickerSets",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"masks", "Masks", "true", 0},
+			{"order", "Order", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_messages_reorderStickerSets{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getDocumentByHash,
+		Name:       "messages.getDocumentByHash",
+		Type:       "Document",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"sha256", "Sha256", "bytes", -1},
+			{"size", "Size", "int", -1},
+			{"mime_type", "MimeType", "string", -1},
+		},
+		New: func() TL { return TL_messages_getDocumentByHash{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getSavedGifs,
+		Name:       "messages.getSavedGifs",
+		Type:       "messages.SavedGifs",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getSavedGifs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_saveGif,
+		Name:       "messages.saveGif",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputDocument", -1},
+			{"unsave", "Unsave", "Bool", -1},
+		},
+		New: func() TL { return TL_messages_saveGif{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getInlineBotResults,
+		Name:       "messages.getInlineBotResults",
+		Type:       "messages.BotResults",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"bot", "Bot", "InputUser", -1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"geo_point", "GeoPoint", "InputGeoPoint", 0},
+			{"query", "Query", "string", -1},
+			{"offset", "Offset", "string", -1},
+		},
+		New: func() TL { return TL_messages_getInlineBotResults{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setInlineBotResults,
+		Name:       "messages.setInlineBotResults",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"gallery", "Gallery", "true", 0},
+			{"private", "Private", "true", 1},
+			{"query_id", "QueryID", "long", -1},
+			{"results", "Results", "Vector<InputBotInlineResult>", -1},
+			{"cache_time", "CacheTime", "int", -1},
+			{"next_offset", "NextOffset", "string", 2},
+			{"switch_pm", "SwitchPm", "InlineBotSwitchPM", 3},
+		},
+		New: func() TL { return TL_messages_setInlineBotResults{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendInlineBotResult,
+		Name:       "messages.sendInlineBotResult",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"silent", "Silent", "true", 5},
+			{"background", "Background", "true", 6},
+			{"clear_draft", "ClearDraft", "true", 7},
+			{"hide_via", "HideVia", "true", 11},
+			{"peer", "Peer", "InputPeer", -1},
+			{"reply_to_msg_id", "ReplyToMsgID", "int", 0},
+			{"random_id", "RandomID", "long", -1},
+			{"query_id", "QueryID", "long", -1},
+			{"id", "ID", "string", -1},
+			{"schedule_date", "ScheduleDate", "int", 10},
+		},
+		New: func() TL { return TL_messages_sendInlineBotResult{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getMessageEditData,
+		Name:       "messages.getMessageEditData",
+		Type:       "messages.MessageEditData",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_messages_getMessageEditData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_editMessage,
+		Name:       "messages.editMessage",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"no_webpage", "NoWebpage", "true", 1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "int", -1},
+			{"message", "Message", "string", 11},
+			{"media", "Media", "InputMedia", 14},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+			{"entities", "Entities", "Vector<MessageEntity>", 3},
+			{"schedule_date", "ScheduleDate", "int", 15},
+		},
+		New: func() TL { return TL_messages_editMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_editInlineBotMessage,
+		Name:       "messages.editInlineBotMessage",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"no_webpage", "NoWebpage", "true", 1},
+			{"id", "ID", "InputBotInlineMessageID", -1},
+			{"message", "Message", "string", 11},
+			{"media", "Media", "InputMedia", 14},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 2},
+			{"entities", "Entities", "Vector<MessageEntity>", 3},
+		},
+		New: func() TL { return TL_messages_editInlineBotMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getBotCallbackAnswer,
+		Name:       "messages.getBotCallbackAnswer",
+		Type:       "messages.BotCallbackAnswer",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"game", "Game", "true", 1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"data", "Data", "bytes", 0},
+			{"password", "Password", "InputCheckPasswordSRP", 2},
+		},
+		New: func() TL { return TL_messages_getBotCallbackAnswer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setBotCallbackAnswer,
+		Name:       "messages.setBotCallbackAnswer",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"alert", "Alert", "true", 1},
+			{"query_id", "QueryID", "long", -1},
+			{"message", "Message", "string", 0},
+			{"url", "Url", "string", 2},
+			{"cache_time", "CacheTime", "int", -1},
+		},
+		New: func() TL { return TL_messages_setBotCallbackAnswer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getPeerDialogs,
+		Name:       "messages.getPeerDialogs",
+		Type:       "messages.PeerDialogs",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peers", "Peers", "Vector<InputDialogPeer>", -1},
+		},
+		New: func() TL { return TL_messages_getPeerDialogs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_saveDraft,
+		Name:       "messages.saveDraft",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"no_webpage", "NoWebpage", "true", 1},
+			{"reply_to_msg_id", "ReplyToMsgID", "int", 0},
+			{"peer", "Peer", "InputPeer", -1},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", 3},
+		},
+		New: func() TL { return TL_messages_saveDraft{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getAllDrafts,
+		Name:       "messages.getAllDrafts",
+		Type:       "Updates",
+		IsFunction: true,
+		New:        func() TL { return TL_messages_getAllDrafts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getFeaturedStickers,
+		Name:       "messages.getFeaturedStickers",
+		Type:       "messages.FeaturedStickers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getFeaturedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_readFeaturedStickers,
+		Name:       "messages.readFeaturedStickers",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "Vector<long>", -1},
+		},
+		New: func() TL { return TL_messages_readFeaturedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getRecentStickers,
+		Name:       "messages.getRecentStickers",
+		Type:       "messages.RecentStickers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"attached", "Attached", "true", 0},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getRecentStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_saveRecentSticker,
+		Name:       "messages.saveRecentSticker",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"attached", "Attached", "true", 0},
+			{"id", "ID", "InputDocument", -1},
+			{"unsave", "Unsave", "Bool", -1},
+		},
+		New: func() TL { return TL_messages_saveRecentSticker{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_clearRecentStickers,
+		Name:       "messages.clearRecentStickers",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"attached", "Attached", "true", 0},
+		},
+		New: func() TL { return TL_messages_clearRecentStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getArchivedStickers,
+		Name:       "messages.getArchivedStickers",
+		Type:       "messages.ArchivedStickers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"masks", "Masks", "true", 0},
+			{"offset_id", "OffsetID", "long", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_messages_getArchivedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getMaskStickers,
+		Name:       "messages.getMaskStickers",
+		Type:       "messages.AllStickers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getMaskStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getAttachedStickers,
+		Name:       "messages.getAttachedStickers",
+		Type:       "Vector<StickerSetCovered>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"media", "Media", "InputStickeredMedia", -1},
+		},
+		New: func() TL { return TL_messages_getAttachedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setGameScore,
+		Name:       "messages.setGameScore",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"edit_message", "EditMessage", "true", 0},
+			{"force", "Force", "true", 1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "int", -1},
+			{"user_id", "UserID", "InputUser", -1},
+			{"score", "Score", "int", -1},
+		},
+		New: func() TL { return TL_messages_setGameScore{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setInlineGameScore,
+		Name:       "messages.setInlineGameScore",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"edit_message", "EditMessage", "true", 0},
+			{"force", "Force", "true", 1},
+			{"id", "ID", "InputBotInlineMessageID", -1},
+			{"user_id", "UserID", "InputUser", -1},
+			{"score", "Score", "int", -1},
+		},
+		New: func() TL { return TL_messages_setInlineGameScore{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getGameHighScores,
+		Name:       "messages.getGameHighScores",
+		Type:       "messages.HighScores",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "int", -1},
+			{"user_id", "UserID", "InputUser", -1},
+		},
+		New: func() TL { return TL_messages_getGameHighScores{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getInlineGameHighScores,
+		Name:       "messages.getInlineGameHighScores",
+		Type:       "messages.HighScores",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputBotInlineMessageID", -1},
+			{"user_id", "UserID", "InputUser", -1},
+		},
+		New: func() TL { return TL_messages_getInlineGameHighScores{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getCommonChats,
+		Name:       "messages.getCommonChats",
+		Type:       "messages.Chats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "InputUser", -1},
+			{"max_id", "MaxID", "int", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_messages_getCommonChats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getAllChats,
+		Name:       "messages.getAllChats",
+		Type:       "messages.Chats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"except_ids", "ExceptIds", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_getAllChats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getWebPage,
+		Name:       "messages.getWebPage",
+		Type:       "WebPage",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"url", "Url", "string", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getWebPage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_toggleDialogPin,
+		Name:       "messages.toggleDialogPin",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"pinned", "Pinned", "true", 0},
+			{"peer", "Peer", "InputDialogPeer", -1},
+		},
+		New: func() TL { return TL_messages_toggleDialogPin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_reorderPinnedDialogs,
+		Name:       "messages.reorderPinnedDialogs",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"force", "Force", "true", 0},
+			{"folder_id", "FolderID", "int", -1},
+			{"order", "Order", "Vector<InputDialogPeer>", -1},
+		},
+		New: func() TL { return TL_messages_reorderPinnedDialogs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getPinnedDialogs,
+		Name:       "messages.getPinnedDialogs",
+		Type:       "messages.PeerDialogs",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"folder_id", "FolderID", "int", -1},
+		},
+		New: func() TL { return TL_messages_getPinnedDialogs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setBotShippingResults,
+		Name:       "messages.setBotShippingResults",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"query_id", "QueryID", "long", -1},
+			{"error", "Error", "string", 0},
+			{"shipping_options", "ShippingOptions", "Vector<ShippingOption>", 1},
+		},
+		New: func() TL { return TL_messages_setBotShippingResults{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setBotPrecheckoutResults,
+		Name:       "messages.setBotPrecheckoutResults",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"success", "Success", "true", 1},
+			{"query_id", "QueryID", "long", -1},
+			{"error", "Error", "string", 0},
+		},
+		New: func() TL { return TL_messages_setBotPrecheckoutResults{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_uploadMedia,
+		Name:       "messages.uploadMedia",
+		Type:       "MessageMedia",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"media", "Media", "InputMedia", -1},
+		},
+		New: func() TL { return TL_messages_uploadMedia{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendScreenshotNotification,
+		Name:       "messages.sendScreenshotNotification",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"reply_to_msg_id", "ReplyToMsgID", "int", -1},
+			{"random_id", "RandomID", "long", -1},
+		},
+		New: func() TL { return TL_messages_sendScreenshotNotification{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getFavedStickers,
+		Name:       "messages.getFavedStickers",
+		Type:       "messages.FavedStickers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getFavedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_faveSticker,
+		Name:       "messages.faveSticker",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputDocument", -1},
+			{"unfave", "Unfave", "Bool", -1},
+		},
+		New: func() TL { return TL_messages_faveSticker{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getUnreadMentions,
+		Name:       "messages.getUnreadMentions",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"offset_id", "OffsetID", "int", -1},
+			{"add_offset", "AddOffset", "int", -1},
+			{"limit", "Limit", "int", -1},
+			{"max_id", "MaxID", "int", -1},
+			{"min_id", "MinID", "int", -1},
+		},
+		New: func() TL { return TL_messages_getUnreadMentions{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_readMentions,
+		Name:       "messages.readMentions",
+		Type:       "messages.AffectedHistory",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_messages_readMentions{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getRecentLocations,
+		Name:       "messages.getRecentLocations",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"limit", "Limit", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getRecentLocations{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendMultiMedia,
+		Name:       "messages.sendMultiMedia",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"silent", "Silent", "true", 5},
+			{"background", "Background", "true", 6},
+			{"clear_draft", "ClearDraft", "true", 7},
+			{"peer", "Peer", "InputPeer", -1},
+			{"reply_to_msg_id", "ReplyToMsgID", "int", 0},
+			{"multi_media", "MultiMedia", "Vector<InputSingleMedia>", -1},
+			{"schedule_date", "ScheduleDate", "int", 10},
+		},
+		New: func() TL { return TL_messages_sendMultiMedia{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_uploadEncryptedFile,
+		Name:       "messages.uploadEncryptedFile",
+		Type:       "EncryptedFile",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputEncryptedChat", -1},
+			{"file", "File", "InputEncryptedFile", -1},
+		},
+		New: func() TL { return TL_messages_uploadEncryptedFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_searchStickerSets,
+		Name:       "messages.searchStickerSets",
+		Type:       "messages.FoundStickerSets",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"exclude_featured", "ExcludeFeatured", "true", 0},
+			{"q", "Q", "string", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_searchStickerSets{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getSplitRanges,
+		Name:       "messages.getSplitRanges",
+		Type:       "Vector<MessageRange>",
+		IsFunction: true,
+		New:        func() TL { return TL_messages_getSplitRanges{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_markDialogUnread,
+		Name:       "messages.markDialogUnread",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"unread", "Unread", "true", 0},
+			{"peer", "Peer", "InputDialogPeer", -1},
+		},
+		New: func() TL { return TL_messages_markDialogUnread{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getDialogUnreadMarks,
+		Name:       "messages.getDialogUnreadMarks",
+		Type:       "Vector<DialogPeer>",
+		IsFunction: true,
+		New:        func() TL { return TL_messages_getDialogUnreadMarks{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_clearAllDrafts,
+		Name:       "messages.clearAllDrafts",
+		Type:       "Bool",
+		IsFunction: true,
+		New:        func() TL { return TL_messages_clearAllDrafts{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_updatePinnedMessage,
+		Name:       "messages.updatePinnedMessage",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"silent", "Silent", "true", 0},
+			{"unpin", "Unpin", "true", 1},
+			{"pm_oneside", "PmOneside", "true", 2},
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_messages_updatePinnedMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendVote,
+		Name:       "messages.sendVote",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"options", "Options", "Vector<bytes>", -1},
+		},
+		New: func() TL { return TL_messages_sendVote{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getPollResults,
+		Name:       "messages.getPollResults",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+		},
+		New: func() TL { return TL_messages_getPollResults{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getOnlines,
+		Name:       "messages.getOnlines",
+		Type:       "ChatOnlines",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_messages_getOnlines{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getStatsURL,
+		Name:       "messages.getStatsURL",
+		Type:       "StatsURL",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"dark", "Dark", "true", 0},
+			{"peer", "Peer", "InputPeer", -1},
+			{"params", "Params", "string", -1},
+		},
+		New: func() TL { return TL_messages_getStatsURL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_editChatAbout,
+		Name:       "messages.editChatAbout",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"about", "About", "string", -1},
+		},
+		New: func() TL { return TL_messages_editChatAbout{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_editChatDefaultBannedRights,
+		Name:       "messages.editChatDefaultBannedRights",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"banned_rights", "BannedRights", "ChatBannedRights", -1},
+		},
+		New: func() TL { return TL_messages_editChatDefaultBannedRights{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getEmojiKeywords,
+		Name:       "messages.getEmojiKeywords",
+		Type:       "EmojiKeywordsDifference",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_code", "LangCode", "string", -1},
+		},
+		New: func() TL { return TL_messages_getEmojiKeywords{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getEmojiKeywordsDifference,
+		Name:       "messages.getEmojiKeywordsDifference",
+		Type:       "EmojiKeywordsDifference",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_code", "LangCode", "string", -1},
+			{"from_version", "FromVersion", "int", -1},
+		},
+		New: func() TL { return TL_messages_getEmojiKeywordsDifference{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getEmojiKeywordsLanguages,
+		Name:       "messages.getEmojiKeywordsLanguages",
+		Type:       "Vector<EmojiLanguage>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_codes", "LangCodes", "Vector<string>", -1},
+		},
+		New: func() TL { return TL_messages_getEmojiKeywordsLanguages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getEmojiURL,
+		Name:       "messages.getEmojiURL",
+		Type:       "EmojiURL",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_code", "LangCode", "string", -1},
+		},
+		New: func() TL { return TL_messages_getEmojiURL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getSearchCounters,
+		Name:       "messages.getSearchCounters",
+		Type:       "Vector<messages.SearchCounter>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"filters", "Filters", "Vector<MessagesFilter>", -1},
+		},
+		New: func() TL { return TL_messages_getSearchCounters{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_requestUrlAuth,
+		Name:       "messages.requestUrlAuth",
+		Type:       "UrlAuthResult",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"peer", "Peer", "InputPeer", 1},
+			{"msg_id", "MsgID", "int", 1},
+			{"button_id", "ButtonID", "int", 1},
+			{"url", "Url", "string", 2},
+		},
+		New: func() TL { return TL_messages_requestUrlAuth{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_acceptUrlAuth,
+		Name:       "messages.acceptUrlAuth",
+		Type:       "UrlAuthResult",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"write_allowed", "WriteAllowed", "true", 0},
+			{"peer", "Peer", "InputPeer", 1},
+			{"msg_id", "MsgID", "int", 1},
+			{"button_id", "ButtonID", "int", 1},
+			{"url", "Url", "string", 2},
+		},
+		New: func() TL { return TL_messages_acceptUrlAuth{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_hidePeerSettingsBar,
+		Name:       "messages.hidePeerSettingsBar",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_messages_hidePeerSettingsBar{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getScheduledHistory,
+		Name:       "messages.getScheduledHistory",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getScheduledHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getScheduledMessages,
+		Name:       "messages.getScheduledMessages",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_getScheduledMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_sendScheduledMessages,
+		Name:       "messages.sendScheduledMessages",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_sendScheduledMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_deleteScheduledMessages,
+		Name:       "messages.deleteScheduledMessages",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_deleteScheduledMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getPollVotes,
+		Name:       "messages.getPollVotes",
+		Type:       "messages.VotesList",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"peer", "Peer", "InputPeer", -1},
+			{"id", "ID", "int", -1},
+			{"option", "Option", "bytes", 0},
+			{"offset", "Offset", "string", 1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_messages_getPollVotes{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_toggleStickerSets,
+		Name:       "messages.toggleStickerSets",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"uninstall", "Uninstall", "true", 0},
+			{"archive", "Archive", "true", 1},
+			{"unarchive", "Unarchive", "true", 2},
+			{"stickersets", "Stickersets", "Vector<InputStickerSet>", -1},
+		},
+		New: func() TL { return TL_messages_toggleStickerSets{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getDialogFilters,
+		Name:       "messages.getDialogFilters",
+		Type:       "Vector<DialogFilter>",
+		IsFunction: true,
+		New:        func() TL { return TL_messages_getDialogFilters{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getSuggestedDialogFilters,
+		Name:       "messages.getSuggestedDialogFilters",
+		Type:       "Vector<DialogFilterSuggested>",
+		IsFunction: true,
+		New:        func() TL { return TL_messages_getSuggestedDialogFilters{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_updateDialogFilter,
+		Name:       "messages.updateDialogFilter",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"id", "ID", "int", -1},
+			{"filter", "Filter", "DialogFilter", 0},
+		},
+		New: func() TL { return TL_messages_updateDialogFilter{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_updateDialogFiltersOrder,
+		Name:       "messages.updateDialogFiltersOrder",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"order", "Order", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_messages_updateDialogFiltersOrder{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getOldFeaturedStickers,
+		Name:       "messages.getOldFeaturedStickers",
+		Type:       "messages.FeaturedStickers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+			{"limit", "Limit", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getOldFeaturedStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getReplies,
+		Name:       "messages.getReplies",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"offset_id", "OffsetID", "int", -1},
+			{"offset_date", "OffsetDate", "int", -1},
+			{"add_offset", "AddOffset", "int", -1},
+			{"limit", "Limit", "int", -1},
+			{"max_id", "MaxID", "int", -1},
+			{"min_id", "MinID", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_messages_getReplies{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getDiscussionMessage,
+		Name:       "messages.getDiscussionMessage",
+		Type:       "messages.DiscussionMessage",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+		},
+		New: func() TL { return TL_messages_getDiscussionMessage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_readDiscussion,
+		Name:       "messages.readDiscussion",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"read_max_id", "ReadMaxID", "int", -1},
+		},
+		New: func() TL { return TL_messages_readDiscussion{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_unpinAllMessages,
+		Name:       "messages.unpinAllMessages",
+		Type:       "messages.AffectedHistory",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_messages_unpinAllMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_deleteChat,
+		Name:       "messages.deleteChat",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"chat_id", "ChatID", "int", -1},
+		},
+		New: func() TL { return TL_messages_deleteChat{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_deletePhoneCallHistory,
+		Name:       "messages.deletePhoneCallHistory",
+		Type:       "messages.AffectedFoundMessages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"revoke", "Revoke", "true", 0},
+		},
+		New: func() TL { return TL_messages_deletePhoneCallHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_checkHistoryImport,
+		Name:       "messages.checkHistoryImport",
+		Type:       "messages.HistoryImportParsed",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"import_head", "ImportHead", "string", -1},
+		},
+		New: func() TL { return TL_messages_checkHistoryImport{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_initHistoryImport,
+		Name:       "messages.initHistoryImport",
+		Type:       "messages.HistoryImport",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"file", "File", "InputFile", -1},
+			{"media_count", "MediaCount", "int", -1},
+		},
+		New: func() TL { return TL_messages_initHistoryImport{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_uploadImportedMedia,
+		Name:       "messages.uploadImportedMedia",
+		Type:       "MessageMedia",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"import_id", "ImportID", "long", -1},
+			{"file_name", "FileName", "string", -1},
+			{"media", "Media", "InputMedia", -1},
+		},
+		New: func() TL { return TL_messages_uploadImportedMedia{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_startHistoryImport,
+		Name:       "messages.startHistoryImport",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"import_id", "ImportID", "long", -1},
+		},
+		New: func() TL { return TL_messages_startHistoryImport{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getExportedChatInvites,
+		Name:       "messages.getExportedChatInvites",
+		Type:       "messages.ExportedChatInvites",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"revoked", "Revoked", "true", 3},
+			{"peer", "Peer", "InputPeer", -1},
+			{"admin_id", "AdminID", "InputUser", -1},
+			{"offset_date", "OffsetDate", "int", 2},
+			{"offset_link", "OffsetLink", "string", 2},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_messages_getExportedChatInvites{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getExportedChatInvite,
+		Name:       "messages.getExportedChatInvite",
+		Type:       "messages.ExportedChatInvite",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"link", "Link", "string", -1},
+		},
+		New: func() TL { return TL_messages_getExportedChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_editExportedChatInvite,
+		Name:       "messages.editExportedChatInvite",
+		Type:       "messages.ExportedChatInvite",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"revoked", "Revoked", "true", 2},
+			{"peer", "Peer", "InputPeer", -1},
+			{"link", "Link", "string", -1},
+			{"expire_date", "ExpireDate", "int", 0},
+			{"usage_limit", "UsageLimit", "int", 1},
+		},
+		New: func() TL { return TL_messages_editExportedChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_deleteRevokedExportedChatInvites,
+		Name:       "messages.deleteRevokedExportedChatInvites",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"admin_id", "AdminID", "InputUser", -1},
+		},
+		New: func() TL { return TL_messages_deleteRevokedExportedChatInvites{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_deleteExportedChatInvite,
+		Name:       "messages.deleteExportedChatInvite",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"link", "Link", "string", -1},
+		},
+		New: func() TL { return TL_messages_deleteExportedChatInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getAdminsWithInvites,
+		Name:       "messages.getAdminsWithInvites",
+		Type:       "messages.ChatAdminsWithInvites",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_messages_getAdminsWithInvites{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_getChatInviteImporters,
+		Name:       "messages.getChatInviteImporters",
+		Type:       "messages.ChatInviteImporters",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"link", "Link", "string", -1},
+			{"offset_date", "OffsetDate", "int", -1},
+			{"offset_user", "OffsetUser", "InputUser", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_messages_getChatInviteImporters{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_setHistoryTTL,
+		Name:       "messages.setHistoryTTL",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"period", "Period", "int", -1},
+		},
+		New: func() TL { return TL_messages_setHistoryTTL{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_messages_checkHistoryImportPeer,
+		Name:       "messages.checkHistoryImportPeer",
+		Type:       "messages.CheckedHistoryImportPeer",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_messages_checkHistoryImportPeer{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_updates_getState,
+		Name:       "updates.getState",
+		Type:       "updates.State",
+		IsFunction: true,
+		New:        func() TL { return TL_updates_getState{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_updates_getDifference,
+		Name:       "updates.getDifference",
+		Type:       "updates.Difference",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"pts", "Pts", "int", -1},
+			{"pts_total_limit", "PtsTotalLimit", "int", 0},
+			{"date", "Date", "int", -1},
+			{"qts", "Qts", "int", -1},
+		},
+		New: func() TL { return TL_updates_getDifference{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_updates_getChannelDifference,
+		Name:       "updates.getChannelDifference",
+		Type:       "updates.ChannelDifference",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"force", "Force", "true", 0},
+			{"channel", "Channel", "InputChannel", -1},
+			{"filter", "Filter", "ChannelMessagesFilter", -1},
+			{"pts", "Pts", "int", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_updates_getChannelDifference{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_photos_updateProfilePhoto,
+		Name:       "photos.updateProfilePhoto",
+		Type:       "photos.Photo",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "InputPhoto", -1},
+		},
+		New: func() TL { return TL_photos_updateProfilePhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_photos_uploadProfilePhoto,
+		Name:       "photos.uploadProfilePhoto",
+		Type:       "photos.Photo",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"file", "File", "InputFile", 0},
+			{"video", "Video", "InputFile", 1},
+			{"video_start_ts", "VideoStartTs", "double", 2},
+		},
+		New: func() TL { return TL_photos_uploadProfilePhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_photos_deletePhotos,
+		Name:       "photos.deletePhotos",
+		Type:       "Vector<long>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "Vector<InputPhoto>", -1},
+		},
+		New: func() TL { return TL_photos_deletePhotos{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_photos_getUserPhotos,
+		Name:       "photos.getUserPhotos",
+		Type:       "photos.Photos",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "InputUser", -1},
+			{"offset", "Offset", "int", -1},
+			{"max_id", "MaxID", "long", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_photos_getUserPhotos{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_upload_saveFilePart,
+		Name:       "upload.saveFilePart",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"file_id", "FileID", "long", -1},
+			{"file_part", "FilePart", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_upload_saveFilePart{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_upload_getFile,
+		Name:       "upload.getFile",
+		Type:       "upload.File",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"precise", "Precise", "true", 0},
+			{"cdn_supported", "CdnSupported", "true", 1},
+			{"location", "Location", "InputFileLocation", -1},
+			{"offset", "Offset", "int", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_upload_getFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_upload_saveBigFilePart,
+		Name:       "upload.saveBigFilePart",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"file_id", "FileID", "long", -1},
+			{"file_part", "FilePart", "int", -1},
+			{"file_total_parts", "FileTotalParts", "int", -1},
+			{"bytes", "Bytes", "bytes", -1},
+		},
+		New: func() TL { return TL_upload_saveBigFilePart{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_upload_getWebFile,
+		Name:       "upload.getWebFile",
+		Type:       "upload.WebFile",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"location", "Location", "InputWebFileLocation", -1},
+			{"offset", "Offset", "int", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_upload_getWebFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_upload_getCdnFile,
+		Name:       "upload.getCdnFile",
+		Type:       "upload.CdnFile",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"file_token", "FileToken", "bytes", -1},
+			{"offset", "Offset", "int", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_upload_getCdnFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_upload_reuploadCdnFile,
+		Name:       "upload.reuploadCdnFile",
+		Type:       "Vector<FileHash>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"file_token", "FileToken", "bytes", -1},
+			{"request_token", "RequestToken", "bytes", -1},
+		},
+		New: func() TL { return TL_upload_reuploadCdnFile{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_upload_getCdnFileHashes,
+		Name:       "upload.getCdnFileHashes",
+		Type:       "Vector<FileHash>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"file_token", "FileToken", "bytes", -1},
+			{"offset", "Offset", "int", -1},
+		},
+		New: func() TL { return TL_upload_getCdnFileHashes{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_upload_getFileHashes,
+		Name:       "upload.getFileHashes",
+		Type:       "Vector<FileHash>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"location", "Location", "InputFileLocation", -1},
+			{"offset", "Offset", "int", -1},
+		},
+		New: func() TL { return TL_upload_getFileHashes{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getConfig,
+		Name:       "help.getConfig",
+		Type:       "Config",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getNearestDc,
+		Name:       "help.getNearestDc",
+		Type:       "NearestDc",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getNearestDc{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getAppUpdate,
+		Name:       "help.getAppUpdate",
+		Type:       "help.AppUpdate",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"source", "Source", "string", -1},
+		},
+		New: func() TL { return TL_help_getAppUpdate{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getInviteText,
+		Name:       "help.getInviteText",
+		Type:       "help.InviteText",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getInviteText{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getSupport,
+		Name:       "help.getSupport",
+		Type:       "help.Support",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getSupport{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getAppChangelog,
+		Name:       "help.getAppChangelog",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"prev_app_version", "PrevAppVersion", "string", -1},
+		},
+		New: func() TL { return TL_help_getAppChangelog{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_setBotUpdatesStatus,
+		Name:       "help.setBotUpdatesStatus",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"pending_updates_count", "PendingUpdatesCount", "int", -1},
+			{"message", "Message", "string", -1},
+		},
+		New: func() TL { return TL_help_setBotUpdatesStatus{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getCdnConfig,
+		Name:       "help.getCdnConfig",
+		Type:       "CdnConfig",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getCdnConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getRecentMeUrls,
+		Name:       "help.getRecentMeUrls",
+		Type:       "help.RecentMeUrls",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"referer", "Referer", "string", -1},
+		},
+		New: func() TL { return TL_help_getRecentMeUrls{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getTermsOfServiceUpdate,
+		Name:       "help.getTermsOfServiceUpdate",
+		Type:       "help.TermsOfServiceUpdate",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getTermsOfServiceUpdate{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_acceptTermsOfService,
+		Name:       "help.acceptTermsOfService",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "DataJSON", -1},
+		},
+		New: func() TL { return TL_help_acceptTermsOfService{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getDeepLinkInfo,
+		Name:       "help.getDeepLinkInfo",
+		Type:       "help.DeepLinkInfo",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"path", "Path", "string", -1},
+		},
+		New: func() TL { return TL_help_getDeepLinkInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getAppConfig,
+		Name:       "help.getAppConfig",
+		Type:       "JSONValue",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getAppConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_saveAppLog,
+		Name:       "help.saveAppLog",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"events", "Events", "Vector<InputAppEvent>", -1},
+		},
+		New: func() TL { return TL_help_saveAppLog{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getPassportConfig,
+		Name:       "help.getPassportConfig",
+		Type:       "help.PassportConfig",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_help_getPassportConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getSupportName,
+		Name:       "help.getSupportName",
+		Type:       "help.SupportName",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getSupportName{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getUserInfo,
+		Name:       "help.getUserInfo",
+		Type:       "help.UserInfo",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "InputUser", -1},
+		},
+		New: func() TL { return TL_help_getUserInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_editUserInfo,
+		Name:       "help.editUserInfo",
+		Type:       "help.UserInfo",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"user_id", "UserID", "InputUser", -1},
+			{"message", "Message", "string", -1},
+			{"entities", "Entities", "Vector<MessageEntity>", -1},
+		},
+		New: func() TL { return TL_help_editUserInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getPromoData,
+		Name:       "help.getPromoData",
+		Type:       "help.PromoData",
+		IsFunction: true,
+		New:        func() TL { return TL_help_getPromoData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_hidePromoData,
+		Name:       "help.hidePromoData",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_help_hidePromoData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_dismissSuggestion,
+		Name:       "help.dismissSuggestion",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"suggestion", "Suggestion", "string", -1},
+		},
+		New: func() TL { return TL_help_dismissSuggestion{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_help_getCountriesList,
+		Name:       "help.getCountriesList",
+		Type:       "help.CountriesList",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_code", "LangCode", "string", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_help_getCountriesList{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_readHistory,
+		Name:       "channels.readHistory",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"max_id", "MaxID", "int", -1},
+		},
+		New: func() TL { return TL_channels_readHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_deleteMessages,
+		Name:       "channels.deleteMessages",
+		Type:       "messages.AffectedMessages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_channels_deleteMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_deleteUserHistory,
+		Name:       "channels.deleteUserHistory",
+		Type:       "messages.AffectedHistory",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"user_id", "UserID", "InputUser", -1},
+		},
+		New: func() TL { return TL_channels_deleteUserHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_reportSpam,
+		Name:       "channels.reportSpam",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"user_id", "UserID", "InputUser", -1},
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_channels_reportSpam{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getMessages,
+		Name:       "channels.getMessages",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"id", "ID", "Vector<InputMessage>", -1},
+		},
+		New: func() TL { return TL_channels_getMessages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getParticipants,
+		Name:       "channels.getParticipants",
+		Type:       "channels.ChannelParticipants",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"filter", "Filter", "ChannelParticipantsFilter", -1},
+			{"offset", "Offset", "int", -1},
+			{"limit", "Limit", "int", -1},
+			{"hash", "Hash", "int", -1},
+		},
+		New: func() TL { return TL_channels_getParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getParticipant,
+		Name:       "channels.getParticipant",
+		Type:       "channels.ChannelParticipant",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"participant", "Participant", "InputPeer", -1},
+		},
+		New: func() TL { return TL_channels_getParticipant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getChannels,
+		Name:       "channels.getChannels",
+		Type:       "messages.Chats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"id", "ID", "Vector<InputChannel>", -1},
+		},
+		New: func() TL { return TL_channels_getChannels{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getFullChannel,
+		Name:       "channels.getFullChannel",
+		Type:       "messages.ChatFull",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+		},
+		New: func() TL { return TL_channels_getFullChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_createChannel,
+		Name:       "channels.createChannel",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"broadcast", "Broadcast", "true", 0},
+			{"megagroup", "Megagroup", "true", 1},
+			{"for_import", "ForImport", "true", 3},
+			{"title", "Title", "string", -1},
+			{"about", "About", "string", -1},
+			{"geo_point", "GeoPoint", "InputGeoPoint", 2},
+			{"address", "Address", "string", 2},
+		},
+		New: func() TL { return TL_channels_createChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_editAdmin,
+		Name:       "channels.editAdmin",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"user_id", "UserID", "InputUser", -1},
+			{"admin_rights", "AdminRights", "ChatAdminRights", -1},
+			{"rank", "Rank", "string", -1},
+		},
+		New: func() TL { return TL_channels_editAdmin{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_editTitle,
+		Name:       "channels.editTitle",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"title", "Title", "string", -1},
+		},
+		New: func() TL { return TL_channels_editTitle{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_editPhoto,
+		Name:       "channels.editPhoto",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"photo", "Photo", "InputChatPhoto", -1},
+		},
+		New: func() TL { return TL_channels_editPhoto{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_checkUsername,
+		Name:       "channels.checkUsername",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"username", "Username", "string", -1},
+		},
+		New: func() TL { return TL_channels_checkUsername{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_updateUsername,
+		Name:       "channels.updateUsername",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"username", "Username", "string", -1},
+		},
+		New: func() TL { return TL_channels_updateUsername{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_joinChannel,
+		Name:       "channels.joinChannel",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+		},
+		New: func() TL { return TL_channels_joinChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_leaveChannel,
+		Name:       "channels.leaveChannel",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+		},
+		New: func() TL { return TL_channels_leaveChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_inviteToChannel,
+		Name:       "channels.inviteToChannel",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"users", "Users", "Vector<InputUser>", -1},
+		},
+		New: func() TL { return TL_channels_inviteToChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_deleteChannel,
+		Name:       "channels.deleteChannel",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+		},
+		New: func() TL { return TL_channels_deleteChannel{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_exportMessageLink,
+		Name:       "channels.exportMessageLink",
+		Type:       "ExportedMessageLink",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"grouped", "Grouped", "true", 0},
+			{"thread", "Thread", "true", 1},
+			{"channel", "Channel", "InputChannel", -1},
+			{"id", "ID", "int", -1},
+		},
+		New: func() TL { return TL_channels_exportMessageLink{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_toggleSignatures,
+		Name:       "channels.toggleSignatures",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"enabled", "Enabled", "Bool", -1},
+		},
+		New: func() TL { return TL_channels_toggleSignatures{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getAdminedPublicChannels,
+		Name:       "channels.getAdminedPublicChannels",
+		Type:       "messages.Chats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"by_location", "ByLocation", "true", 0},
+			{"check_limit", "CheckLimit", "true", 1},
+		},
+		New: func() TL { return TL_channels_getAdminedPublicChannels{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_editBanned,
+		Name:       "channels.editBanned",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"participant", "Participant", "InputPeer", -1},
+			{"banned_rights", "BannedRights", "ChatBannedRights", -1},
+		},
+		New: func() TL { return TL_channels_editBanned{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getAdminLog,
+		Name:       "channels.getAdminLog",
+		Type:       "channels.AdminLogResults",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"channel", "Channel", "InputChannel", -1},
+			{"q", "Q", "string", -1},
+			{"events_filter", "EventsFilter", "ChannelAdminLogEventsFilter", 0},
+			{"admins", "Admins", "Vector<InputUser>", 1},
+			{"max_id", "MaxID", "long", -1},
+			{"min_id", "MinID", "long", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_channels_getAdminLog{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_setStickers,
+		Name:       "channels.setStickers",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"stickerset", "Stickerset", "InputStickerSet", -1},
+		},
+		New: func() TL { return TL_channels_setStickers{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_readMessageContents,
+		Name:       "channels.readMessageContents",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"id", "ID", "Vector<int>", -1},
+		},
+		New: func() TL { return TL_channels_readMessageContents{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_deleteHistory,
+		Name:       "channels.deleteHistory",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"max_id", "MaxID", "int", -1},
+		},
+		New: func() TL { return TL_channels_deleteHistory{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_togglePreHistoryHidden,
+		Name:       "channels.togglePreHistoryHidden",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"enabled", "Enabled", "Bool", -1},
+		},
+		New: func() TL { return TL_channels_togglePreHistoryHidden{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getLeftChannels,
+		Name:       "channels.getLeftChannels",
+		Type:       "messages.Chats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"offset", "Offset", "int", -1},
+		},
+		New: func() TL { return TL_channels_getLeftChannels{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getGroupsForDiscussion,
+		Name:       "channels.getGroupsForDiscussion",
+		Type:       "messages.Chats",
+		IsFunction: true,
+		New:        func() TL { return TL_channels_getGroupsForDiscussion{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_setDiscussionGroup,
+		Name:       "channels.setDiscussionGroup",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"broadcast", "Broadcast", "InputChannel", -1},
+			{"group", "Group", "InputChannel", -1},
+		},
+		New: func() TL { return TL_channels_setDiscussionGroup{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_editCreator,
+		Name:       "channels.editCreator",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"user_id", "UserID", "InputUser", -1},
+			{"password", "Password", "InputCheckPasswordSRP", -1},
+		},
+		New: func() TL { return TL_channels_editCreator{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_editLocation,
+		Name:       "channels.editLocation",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"geo_point", "GeoPoint", "InputGeoPoint", -1},
+			{"address", "Address", "string", -1},
+		},
+		New: func() TL { return TL_channels_editLocation{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_toggleSlowMode,
+		Name:       "channels.toggleSlowMode",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"seconds", "Seconds", "int", -1},
+		},
+		New: func() TL { return TL_channels_toggleSlowMode{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_getInactiveChannels,
+		Name:       "channels.getInactiveChannels",
+		Type:       "messages.InactiveChats",
+		IsFunction: true,
+		New:        func() TL { return TL_channels_getInactiveChannels{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_channels_convertToGigagroup,
+		Name:       "channels.convertToGigagroup",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+		},
+		New: func() TL { return TL_channels_convertToGigagroup{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_bots_sendCustomRequest,
+		Name:       "bots.sendCustomRequest",
+		Type:       "DataJSON",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"custom_method", "CustomMethod", "string", -1},
+			{"params", "Params", "DataJSON", -1},
+		},
+		New: func() TL { return TL_bots_sendCustomRequest{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_bots_answerWebhookJSONQuery,
+		Name:       "bots.answerWebhookJSONQuery",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"query_id", "QueryID", "long", -1},
+			{"data", "Data", "DataJSON", -1},
+		},
+		New: func() TL { return TL_bots_answerWebhookJSONQuery{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_bots_setBotCommands,
+		Name:       "bots.setBotCommands",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"commands", "Commands", "Vector<BotCommand>", -1},
+		},
+		New: func() TL { return TL_bots_setBotCommands{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_payments_getPaymentForm,
+		Name:       "payments.getPaymentForm",
+		Type:       "payments.PaymentForm",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"msg_id", "MsgID", "int", -1},
+		},
+		New: func() TL { return TL_payments_getPaymentForm{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_payments_getPaymentReceipt,
+		Name:       "payments.getPaymentReceipt",
+		Type:       "payments.PaymentReceipt",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"msg_id", "MsgID", "int", -1},
+		},
+		New: func() TL { return TL_payments_getPaymentReceipt{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_payments_validateRequestedInfo,
+		Name:       "payments.validateRequestedInfo",
+		Type:       "payments.ValidatedRequestedInfo",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"save", "Save", "true", 0},
+			{"msg_id", "MsgID", "int", -1},
+			{"info", "Info", "PaymentRequestedInfo", -1},
+		},
+		New: func() TL { return TL_payments_validateRequestedInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_payments_sendPaymentForm,
+		Name:       "payments.sendPaymentForm",
+		Type:       "payments.PaymentResult",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"requested_info_id", "RequestedInfoID", "string", 0},
+			{"shipping_option_id", "ShippingOptionID", "string", 1},
+			{"credentials", "Credentials", "InputPaymentCredentials", -1},
+		},
+		New: func() TL { return TL_payments_sendPaymentForm{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_payments_getSavedInfo,
+		Name:       "payments.getSavedInfo",
+		Type:       "payments.SavedInfo",
+		IsFunction: true,
+		New:        func() TL { return TL_payments_getSavedInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_payments_clearSavedInfo,
+		Name:       "payments.clearSavedInfo",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"credentials", "Credentials", "true", 0},
+			{"info", "Info", "true", 1},
+		},
+		New: func() TL { return TL_payments_clearSavedInfo{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_payments_getBankCardData,
+		Name:       "payments.getBankCardData",
+		Type:       "payments.BankCardData",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"number", "Number", "string", -1},
+		},
+		New: func() TL { return TL_payments_getBankCardData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stickers_createStickerSet,
+		Name:       "stickers.createStickerSet",
+		Type:       "messages.StickerSet",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"masks", "Masks", "true", 0},
+			{"animated", "Animated", "true", 1},
+			{"user_id", "UserID", "InputUser", -1},
+			{"title", "Title", "string", -1},
+			{"short_name", "ShortName", "string", -1},
+			{"thumb", "Thumb", "InputDocument", 2},
+			{"stickers", "Stickers", "Vector<InputStickerSetItem>", -1},
+		},
+		New: func() TL { return TL_stickers_createStickerSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stickers_removeStickerFromSet,
+		Name:       "stickers.removeStickerFromSet",
+		Type:       "messages.StickerSet",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"sticker", "Sticker", "InputDocument", -1},
+		},
+		New: func() TL { return TL_stickers_removeStickerFromSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stickers_changeStickerPosition,
+		Name:       "stickers.changeStickerPosition",
+		Type:       "messages.StickerSet",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"sticker", "Sticker", "InputDocument", -1},
+			{"position", "Position", "int", -1},
+		},
+		New: func() TL { return TL_stickers_changeStickerPosition{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stickers_addStickerToSet,
+		Name:       "stickers.addStickerToSet",
+		Type:       "messages.StickerSet",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"stickerset", "Stickerset", "InputStickerSet", -1},
+			{"sticker", "Sticker", "InputStickerSetItem", -1},
+		},
+		New: func() TL { return TL_stickers_addStickerToSet{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stickers_setStickerSetThumb,
+		Name:       "stickers.setStickerSetThumb",
+		Type:       "messages.StickerSet",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"stickerset", "Stickerset", "InputStickerSet", -1},
+			{"thumb", "Thumb", "InputDocument", -1},
+		},
+		New: func() TL { return TL_stickers_setStickerSetThumb{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_getCallConfig,
+		Name:       "phone.getCallConfig",
+		Type:       "DataJSON",
+		IsFunction: true,
+		New:        func() TL { return TL_phone_getCallConfig{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_requestCall,
+		Name:       "phone.requestCall",
+		Type:       "phone.PhoneCall",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"video", "Video", "true", 0},
+			{"user_id", "UserID", "InputUser", -1},
+			{"random_id", "RandomID", "int", -1},
+			{"g_a_hash", "GAHash", "bytes", -1},
+			{"protocol", "Protocol", "PhoneCallProtocol", -1},
+		},
+		New: func() TL { return TL_phone_requestCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_acceptCall,
+		Name:       "phone.acceptCall",
+		Type:       "phone.PhoneCall",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPhoneCall", -1},
+			{"g_b", "GB", "bytes", -1},
+			{"protocol", "Protocol", "PhoneCallProtocol", -1},
+		},
+		New: func() TL { return TL_phone_acceptCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_confirmCall,
+		Name:       "phone.confirmCall",
+		Type:       "phone.PhoneCall",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPhoneCall", -1},
+			{"g_a", "GA", "bytes", -1},
+			{"key_fingerprint", "KeyFingerprint", "long", -1},
+			{"protocol", "Protocol", "PhoneCallProtocol", -1},
+		},
+		New: func() TL { return TL_phone_confirmCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_receivedCall,
+		Name:       "phone.receivedCall",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPhoneCall", -1},
+		},
+		New: func() TL { return TL_phone_receivedCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_discardCall,
+		Name:       "phone.discardCall",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"video", "Video", "true", 0},
+			{"peer", "Peer", "InputPhoneCall", -1},
+			{"duration", "Duration", "int", -1},
+			{"reason", "Reason", "PhoneCallDiscardReason", -1},
+			{"connection_id", "ConnectionID", "long", -1},
+		},
+		New: func() TL { return TL_phone_discardCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_setCallRating,
+		Name:       "phone.setCallRating",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"user_initiative", "UserInitiative", "true", 0},
+			{"peer", "Peer", "InputPhoneCall", -1},
+			{"rating", "Rating", "int", -1},
+			{"comment", "Comment", "string", -1},
+		},
+		New: func() TL { return TL_phone_setCallRating{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_saveCallDebug,
+		Name:       "phone.saveCallDebug",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPhoneCall", -1},
+			{"debug", "Debug", "DataJSON", -1},
+		},
+		New: func() TL { return TL_phone_saveCallDebug{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_sendSignalingData,
+		Name:       "phone.sendSignalingData",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPhoneCall", -1},
+			{"data", "Data", "bytes", -1},
+		},
+		New: func() TL { return TL_phone_sendSignalingData{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_createGroupCall,
+		Name:       "phone.createGroupCall",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+			{"random_id", "RandomID", "int", -1},
+		},
+		New: func() TL { return TL_phone_createGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_joinGroupCall,
+		Name:       "phone.joinGroupCall",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"muted", "Muted", "true", 0},
+			{"call", "Call", "InputGroupCall", -1},
+			{"join_as", "JoinAs", "InputPeer", -1},
+			{"invite_hash", "InviteHash", "string", 1},
+			{"params", "Params", "DataJSON", -1},
+		},
+		New: func() TL { return TL_phone_joinGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_leaveGroupCall,
+		Name:       "phone.leaveGroupCall",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+			{"source", "Source", "int", -1},
+		},
+		New: func() TL { return TL_phone_leaveGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_inviteToGroupCall,
+		Name:       "phone.inviteToGroupCall",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+			{"users", "Users", "Vector<InputUser>", -1},
+		},
+		New: func() TL { return TL_phone_inviteToGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_discardGroupCall,
+		Name:       "phone.discardGroupCall",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+		},
+		New: func() TL { return TL_phone_discardGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_toggleGroupCallSettings,
+		Name:       "phone.toggleGroupCallSettings",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"reset_invite_hash", "ResetInviteHash", "true", 1},
+			{"call", "Call", "InputGroupCall", -1},
+			{"join_muted", "JoinMuted", "Bool", 0},
+		},
+		New: func() TL { return TL_phone_toggleGroupCallSettings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_getGroupCall,
+		Name:       "phone.getGroupCall",
+		Type:       "phone.GroupCall",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+		},
+		New: func() TL { return TL_phone_getGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_getGroupParticipants,
+		Name:       "phone.getGroupParticipants",
+		Type:       "phone.GroupParticipants",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+			{"ids", "Ids", "Vector<InputPeer>", -1},
+			{"sources", "Sources", "Vector<int>", -1},
+			{"offset", "Offset", "string", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_phone_getGroupParticipants{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_checkGroupCall,
+		Name:       "phone.checkGroupCall",
+		Type:       "Bool",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+			{"source", "Source", "int", -1},
+		},
+		New: func() TL { return TL_phone_checkGroupCall{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_toggleGroupCallRecord,
+		Name:       "phone.toggleGroupCallRecord",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"start", "Start", "true", 0},
+			{"call", "Call", "InputGroupCall", -1},
+			{"title", "Title", "string", 1},
+		},
+		New: func() TL { return TL_phone_toggleGroupCallRecord{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_editGroupCallParticipant,
+		Name:       "phone.editGroupCallParticipant",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"muted", "Muted", "true", 0},
+			{"call", "Call", "InputGroupCall", -1},
+			{"participant", "Participant", "InputPeer", -1},
+			{"volume", "Volume", "int", 1},
+			{"raise_hand", "RaiseHand", "Bool", 2},
+		},
+		New: func() TL { return TL_phone_editGroupCallParticipant{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_editGroupCallTitle,
+		Name:       "phone.editGroupCallTitle",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"call", "Call", "InputGroupCall", -1},
+			{"title", "Title", "string", -1},
+		},
+		New: func() TL { return TL_phone_editGroupCallTitle{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_getGroupCallJoinAs,
+		Name:       "phone.getGroupCallJoinAs",
+		Type:       "phone.JoinAsPeers",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"peer", "Peer", "InputPeer", -1},
+		},
+		New: func() TL { return TL_phone_getGroupCallJoinAs{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_phone_exportGroupCallInvite,
+		Name:       "phone.exportGroupCallInvite",
+		Type:       "phone.ExportedGroupCallInvite",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"can_self_unmute", "CanSelfUnmute", "true", 0},
+			{"call", "Call", "InputGroupCall", -1},
+		},
+		New: func() TL { return TL_phone_exportGroupCallInvite{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_langpack_getLangPack,
+		Name:       "langpack.getLangPack",
+		Type:       "LangPackDifference",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_pack", "LangPack", "string", -1},
+			{"lang_code", "LangCode", "string", -1},
+		},
+		New: func() TL { return TL_langpack_getLangPack{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_langpack_getStrings,
+		Name:       "langpack.getStrings",
+		Type:       "Vector<LangPackString>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_pack", "LangPack", "string", -1},
+			{"lang_code", "LangCode", "string", -1},
+			{"keys", "Keys", "Vector<string>", -1},
+		},
+		New: func() TL { return TL_langpack_getStrings{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_langpack_getDifference,
+		Name:       "langpack.getDifference",
+		Type:       "LangPackDifference",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_pack", "LangPack", "string", -1},
+			{"lang_code", "LangCode", "string", -1},
+			{"from_version", "FromVersion", "int", -1},
+		},
+		New: func() TL { return TL_langpack_getDifference{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_langpack_getLanguages,
+		Name:       "langpack.getLanguages",
+		Type:       "Vector<LangPackLanguage>",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_pack", "LangPack", "string", -1},
+		},
+		New: func() TL { return TL_langpack_getLanguages{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_langpack_getLanguage,
+		Name:       "langpack.getLanguage",
+		Type:       "LangPackLanguage",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"lang_pack", "LangPack", "string", -1},
+			{"lang_code", "LangCode", "string", -1},
+		},
+		New: func() TL { return TL_langpack_getLanguage{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_folders_editPeerFolders,
+		Name:       "folders.editPeerFolders",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"folder_peers", "FolderPeers", "Vector<InputFolderPeer>", -1},
+		},
+		New: func() TL { return TL_folders_editPeerFolders{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_folders_deleteFolder,
+		Name:       "folders.deleteFolder",
+		Type:       "Updates",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"folder_id", "FolderID", "int", -1},
+		},
+		New: func() TL { return TL_folders_deleteFolder{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stats_getBroadcastStats,
+		Name:       "stats.getBroadcastStats",
+		Type:       "stats.BroadcastStats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"dark", "Dark", "true", 0},
+			{"channel", "Channel", "InputChannel", -1},
+		},
+		New: func() TL { return TL_stats_getBroadcastStats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stats_loadAsyncGraph,
+		Name:       "stats.loadAsyncGraph",
+		Type:       "StatsGraph",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"token", "Token", "string", -1},
+			{"x", "X", "long", 0},
+		},
+		New: func() TL { return TL_stats_loadAsyncGraph{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stats_getMegagroupStats,
+		Name:       "stats.getMegagroupStats",
+		Type:       "stats.MegagroupStats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"dark", "Dark", "true", 0},
+			{"channel", "Channel", "InputChannel", -1},
+		},
+		New: func() TL { return TL_stats_getMegagroupStats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stats_getMessagePublicForwards,
+		Name:       "stats.getMessagePublicForwards",
+		Type:       "messages.Messages",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"channel", "Channel", "InputChannel", -1},
+			{"msg_id", "MsgID", "int", -1},
+			{"offset_rate", "OffsetRate", "int", -1},
+			{"offset_peer", "OffsetPeer", "InputPeer", -1},
+			{"offset_id", "OffsetID", "int", -1},
+			{"limit", "Limit", "int", -1},
+		},
+		New: func() TL { return TL_stats_getMessagePublicForwards{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:        CRC_stats_getMessageStats,
+		Name:       "stats.getMessageStats",
+		Type:       "stats.MessageStats",
+		IsFunction: true,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"dark", "Dark", "true", 0},
+			{"channel", "Channel", "InputChannel", -1},
+			{"msg_id", "MsgID", "int", -1},
+		},
+		New: func() TL { return TL_stats_getMessageStats{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:   CRC_message_layer122,
+		Name:  "message_layer122",
+		Type:  "Message",
+		Layer: 122,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"out", "Out", "true", 1},
+			{"mentioned", "Mentioned", "true", 4},
+			{"media_unread", "MediaUnread", "true", 5},
+			{"silent", "Silent", "true", 13},
+			{"post", "Post", "true", 14},
+			{"from_scheduled", "FromScheduled", "true", 18},
+			{"legacy", "Legacy", "true", 19},
+			{"edit_hide", "EditHide", "true", 21},
+			{"pinned", "Pinned", "true", 24},
+			{"id", "ID", "int", -1},
+			{"from_id", "FromID", "Peer", 8},
+			{"peer_id", "PeerID", "Peer", -1},
+			{"fwd_from", "FwdFrom", "MessageFwdHeader", 2},
+			{"via_bot_id", "ViaBotID", "int", 11},
+			{"reply_to", "ReplyTo", "MessageReplyHeader", 3},
+			{"date", "Date", "int", -1},
+			{"message", "Message", "string", -1},
+			{"media", "Media", "MessageMedia", 9},
+			{"reply_markup", "ReplyMarkup", "ReplyMarkup", 6},
+			{"entities", "Entities", "Vector<MessageEntity>", 7},
+			{"views", "Views", "int", 10},
+			{"forwards", "Forwards", "int", 10},
+			{"replies", "Replies", "MessageReplies", 23},
+			{"edit_date", "EditDate", "int", 15},
+			{"post_author", "PostAuthor", "string", 16},
+			{"grouped_id", "GroupedID", "long", 17},
+			{"restriction_reason", "RestrictionReason", "Vector<RestrictionReason>", 22},
+		},
+		New: func() TL { return TL_message_layer122{} },
+	})
+	registerGenerated(&TypeInfo{
+		CRC:   CRC_messageService_layer122,
+		Name:  "messageService_layer122",
+		Type:  "Message",
+		Layer: 122,
+		Fields: []FieldInfo{
+			{"flags", "Flags", "#", -1},
+			{"out", "Out", "true", 1},
+			{"mentioned", "Mentioned", "true", 4},
+			{"media_unread", "MediaUnread", "true", 5},
+			{"silent", "Silent", "true", 13},
+			{"post", "Post", "true", 14},
+			{"legacy", "Legacy", "true", 19},
+			{"id", "ID", "int", -1},
+			{"from_id", "FromID", "Peer", 8},
+			{"peer_id", "PeerID", "Peer", -1},
+			{"reply_to", "ReplyTo", "MessageReplyHeader", 3},
+			{"date", "Date", "int", -1},
+			{"action", "Action", "MessageAction", -1},
+		},
+		New: func() TL { return TL_messageService_layer122{} },
+	})
+}
@@ -0,0 +1,1646 @@
+package mtproto
+
+import (
+	"reflect"
+	"testing"
+)
+
+var generatedRoundTripObjects = []TL{
+	TL_resPQ{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), Pq: "pq", ServerPublicKeyFingerprints: []int64{2}},
+	TL_p_q_inner_data{Pq: "pq", P: "p", Q: "q", Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), NewNonce: []byte("0123456789abcdef0123456789abcdef")},
+	TL_p_q_inner_data_dc{Pq: "pq", P: "p", Q: "q", Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), NewNonce: []byte("0123456789abcdef0123456789abcdef"), Dc: 7},
+	TL_p_q_inner_data_temp{Pq: "pq", P: "p", Q: "q", Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), NewNonce: []byte("0123456789abcdef0123456789abcdef"), ExpiresIn: 7},
+	TL_p_q_inner_data_temp_dc{Pq: "pq", P: "p", Q: "q", Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), NewNonce: []byte("0123456789abcdef0123456789abcdef"), Dc: 7, ExpiresIn: 8},
+	TL_bind_auth_key_inner{Nonce: 0x0102030405060700 + 0, TempAuthKeyID: 0x0102030405060700 + 1, PermAuthKeyID: 0x0102030405060700 + 2, TempSessionID: 0x0102030405060700 + 3, ExpiresAt: 5},
+	TL_server_DH_params_fail{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), NewNonceHash: []byte("0123456789abcdef")},
+	TL_server_DH_params_ok{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), EncryptedAnswer: "encrypted_answer"},
+	TL_server_DH_inner_data{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), G: 3, DhPrime: "dh_prime", GA: "g_a", ServerTime: 6},
+	TL_client_DH_inner_data{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), RetryID: 0x0102030405060700 + 2, GB: "g_b"},
+	TL_dh_gen_ok{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), NewNonceHash1: []byte("0123456789abcdef")},
+	TL_dh_gen_retry{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), NewNonceHash2: []byte("0123456789abcdef")},
+	TL_dh_gen_fail{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), NewNonceHash3: []byte("0123456789abcdef")},
+	TL_destroy_auth_key_ok{},
+	TL_destroy_auth_key_none{},
+	TL_destroy_auth_key_fail{},
+	TL_req_pq{Nonce: []byte("0123456789abcdef")},
+	TL_req_pq_multi{Nonce: []byte("0123456789abcdef")},
+	TL_req_DH_params{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), P: "p", Q: "q", PublicKeyFingerprint: 0x0102030405060700 + 4, EncryptedData: "encrypted_data"},
+	TL_set_client_DH_params{Nonce: []byte("0123456789abcdef"), ServerNonce: []byte("0123456789abcdef"), EncryptedData: "encrypted_data"},
+	TL_destroy_auth_key{},
+	TL_msgs_ack{MsgIds: []int64{2}},
+	TL_bad_msg_notification{BadMsgID: 0x0102030405060700 + 0, BadMsgSeqno: 2, ErrorCode: 3},
+	TL_bad_server_salt{BadMsgID: 0x0102030405060700 + 0, BadMsgSeqno: 2, ErrorCode: 3, NewServerSalt: 0x0102030405060700 + 3},
+	TL_msgs_state_req{MsgIds: []int64{2}},
+	TL_msgs_state_info{ReqMsgID: 0x0102030405060700 + 0, Info: "info"},
+	TL_msgs_all_info{MsgIds: []int64{2}, Info: "info"},
+	TL_msg_detailed_info{MsgID: 0x0102030405060700 + 0, AnswerMsgID: 0x0102030405060700 + 1, Bytes: 3, Status: 4},
+	TL_msg_new_detailed_info{AnswerMsgID: 0x0102030405060700 + 0, Bytes: 2, Status: 3},
+	TL_msg_resend_req{MsgIds: []int64{2}},
+	TL_rpc_error{ErrorCode: 1, ErrorMessage: "error_message"},
+	TL_rpc_answer_unknown{},
+	TL_rpc_answer_dropped_running{},
+	TL_rpc_answer_dropped{MsgID: 0x0102030405060700 + 0, SeqNo: 2, Bytes: 3},
+	TL_future_salt{ValidSince: 1, ValidUntil: 2, Salt: 0x0102030405060700 + 2},
+	TL_future_salts{ReqMsgID: 0x0102030405060700 + 0, Now: 2, Salts: []TL{TL_future_salt{ValidSince: 1, ValidUntil: 2, Salt: 0x0102030405060700 + 2}}},
+	TL_pong{MsgID: 0x0102030405060700 + 0, PingID: 0x0102030405060700 + 1},
+	TL_destroy_session_ok{SessionID: 0x0102030405060700 + 0},
+	TL_destroy_session_none{SessionID: 0x0102030405060700 + 0},
+	TL_new_session_created{FirstMsgID: 0x0102030405060700 + 0, UniqueID: 0x0102030405060700 + 1, ServerSalt: 0x0102030405060700 + 2},
+	TL_http_wait{MaxDelay: 1, WaitAfter: 2, MaxWait: 3},
+	TL_ipPort{Ipv4: 1, Port: 2},
+	TL_ipPortSecret{Ipv4: 1, Port: 2, Secret: []byte("secret")},
+	TL_accessPointRule{PhonePrefixRules: "phone_prefix_rules", DcID: 2, Ips: []TL{TL_ipPort{Ipv4: 1, Port: 2}}},
+	TL_help_configSimple{Date: 1, Expires: 2, Rules: []TL{TL_accessPointRule{PhonePrefixRules: "phone_prefix_rules", DcID: 2, Ips: []TL{}}}},
+	TL_tlsClientHello{Blocks: []TL{TL_tlsBlockString{Data: "data"}}},
+	TL_tlsBlockString{Data: "data"},
+	TL_tlsBlockRandom{Length: 1},
+	TL_tlsBlockZero{Length: 1},
+	TL_tlsBlockDomain{},
+	TL_tlsBlockGrease{Seed: 1},
+	TL_tlsBlockPublicKey{},
+	TL_tlsBlockScope{Entries: []TL{TL_tlsBlockString{Data: "data"}}},
+	TL_rpc_drop_answer{ReqMsgID: 0x0102030405060700 + 0},
+	TL_get_future_salts{Num: 1},
+	TL_ping{PingID: 0x0102030405060700 + 0},
+	TL_ping_delay_disconnect{PingID: 0x0102030405060700 + 0, DisconnectDelay: 2},
+	TL_destroy_session{SessionID: 0x0102030405060700 + 0},
+	TL_boolFalse{},
+	TL_boolTrue{},
+	TL_true{},
+	TL_error{Code: 1, Text: "text"},
+	TL_null{},
+	TL_inputPeerEmpty{},
+	TL_inputPeerSelf{},
+	TL_inputPeerChat{ChatID: 1},
+	TL_inputPeerUser{UserID: 1, AccessHash: 0x0102030405060700 + 1},
+	TL_inputPeerChannel{ChannelID: 1, AccessHash: 0x0102030405060700 + 1},
+	TL_inputPeerUserFromMessage{Peer: TL_inputPeerEmpty{}, MsgID: 2, UserID: 3},
+	TL_inputPeerChannelFromMessage{Peer: TL_inputPeerEmpty{}, MsgID: 2, ChannelID: 3},
+	TL_inputUserEmpty{},
+	TL_inputUserSelf{},
+	TL_inputUser{UserID: 1, AccessHash: 0x0102030405060700 + 1},
+	TL_inputUserFromMessage{Peer: TL_inputPeerEmpty{}, MsgID: 2, UserID: 3},
+	TL_inputPhoneContact{ClientID: 0x0102030405060700 + 0, Phone: "phone", FirstName: "first_name", LastName: "last_name"},
+	TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"},
+	TL_inputFileBig{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name"},
+	TL_inputMediaEmpty{},
+	TL_inputMediaUploadedPhoto{Flags: 3, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, Stickers: []TL{TL_inputDocumentEmpty{}}, TtlSeconds: 4},
+	TL_inputMediaUploadedPhoto{Flags: 0, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}},
+	TL_inputMediaPhoto{Flags: 1, ID: TL_inputPhotoEmpty{}, TtlSeconds: 3},
+	TL_inputMediaPhoto{Flags: 0, ID: TL_inputPhotoEmpty{}},
+	TL_inputMediaGeoPoint{GeoPoint: TL_inputGeoPointEmpty{}},
+	TL_inputMediaContact{PhoneNumber: "phone_number", FirstName: "first_name", LastName: "last_name", Vcard: "vcard"},
+	TL_inputMediaUploadedDocument{Flags: 31, NosoundVideo: true, ForceFile: true, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, Thumb: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, MimeType: "mime_type", Attributes: []TL{TL_documentAttributeImageSize{W: 1, H: 2}}, Stickers: []TL{TL_inputDocumentEmpty{}}, TtlSeconds: 9},
+	TL_inputMediaUploadedDocument{Flags: 0, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, MimeType: "mime_type", Attributes: []TL{TL_documentAttributeImageSize{W: 1, H: 2}}},
+	TL_inputMediaDocument{Flags: 3, ID: TL_inputDocumentEmpty{}, TtlSeconds: 3, Query: "query"},
+	TL_inputMediaDocument{Flags: 0, ID: TL_inputDocumentEmpty{}},
+	TL_inputMediaVenue{GeoPoint: TL_inputGeoPointEmpty{}, Title: "title", Address: "address", Provider: "provider", VenueID: "venue_id", VenueType: "venue_type"},
+	TL_inputMediaPhotoExternal{Flags: 1, Url: "url", TtlSeconds: 3},
+	TL_inputMediaPhotoExternal{Flags: 0, Url: "url"},
+	TL_inputMediaDocumentExternal{Flags: 1, Url: "url", TtlSeconds: 3},
+	TL_inputMediaDocumentExternal{Flags: 0, Url: "url"},
+	TL_inputMediaGame{ID: TL_inputGameID{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_inputMediaInvoice{Flags: 1, Title: "title", Description: "description", Photo: TL_inputWebDocument{Url: "url", Size: 2, MimeType: "mime_type", Attributes: []TL{}}, Invoice: TL_invoice{Flags: 0, Currency: "currency", Prices: []TL{}}, Payload: []byte("payload"), Provider: "provider", ProviderData: TL_dataJSON{Data: "data"}, StartParam: "start_param"},
+	TL_inputMediaInvoice{Flags: 0, Title: "title", Description: "description", Invoice: TL_invoice{Flags: 0, Currency: "currency", Prices: []TL{}}, Payload: []byte("payload"), Provider: "provider", ProviderData: TL_dataJSON{Data: "data"}, StartParam: "start_param"},
+	TL_inputMediaGeoLive{Flags: 15, Stopped: true, GeoPoint: TL_inputGeoPointEmpty{}, Heading: 4, Period: 5, ProximityNotificationRadius: 6},
+	TL_inputMediaGeoLive{Flags: 0, GeoPoint: TL_inputGeoPointEmpty{}},
+	TL_inputMediaPoll{Flags: 3, Poll: TL_poll{ID: 0x0102030405060700 + 0, Flags: 0, Question: "question", Answers: []TL{}}, CorrectAnswers: [][]byte{[]byte("b")}, Solution: "solution", SolutionEntities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_inputMediaPoll{Flags: 0, Poll: TL_poll{ID: 0x0102030405060700 + 0, Flags: 0, Question: "question", Answers: []TL{}}},
+	TL_inputMediaDice{Emoticon: "emoticon"},
+	TL_inputChatPhotoEmpty{},
+	TL_inputChatUploadedPhoto{Flags: 7, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, Video: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, VideoStartTs: 1.5},
+	TL_inputChatUploadedPhoto{Flags: 0},
+	TL_inputChatPhoto{ID: TL_inputPhotoEmpty{}},
+	TL_inputGeoPointEmpty{},
+	TL_inputGeoPoint{Flags: 1, Lat: 1.5, Long: 1.5, AccuracyRadius: 4},
+	TL_inputGeoPoint{Flags: 0, Lat: 1.5, Long: 1.5},
+	TL_inputPhotoEmpty{},
+	TL_inputPhoto{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, FileReference: []byte("file_reference")},
+	TL_inputFileLocation{VolumeID: 0x0102030405060700 + 0, LocalID: 2, Secret: 0x0102030405060700 + 2, FileReference: []byte("file_reference")},
+	TL_inputEncryptedFileLocation{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_inputDocumentFileLocation{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, FileReference: []byte("file_reference"), ThumbSize: "thumb_size"},
+	TL_inputSecureFileLocation{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_inputTakeoutFileLocation{},
+	TL_inputPhotoFileLocation{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, FileReference: []byte("file_reference"), ThumbSize: "thumb_size"},
+	TL_inputPhotoLegacyFileLocation{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, FileReference: []byte("file_reference"), VolumeID: 0x0102030405060700 + 3, LocalID: 5, Secret: 0x0102030405060700 + 5},
+	TL_inputPeerPhotoFileLocation{Flags: 1, Big: true, Peer: TL_inputPeerEmpty{}, VolumeID: 0x0102030405060700 + 3, LocalID: 5},
+	TL_inputPeerPhotoFileLocation{Flags: 0, Peer: TL_inputPeerEmpty{}, VolumeID: 0x0102030405060700 + 3, LocalID: 5},
+	TL_inputStickerSetThumb{Stickerset: TL_inputStickerSetEmpty{}, VolumeID: 0x0102030405060700 + 1, LocalID: 3},
+	TL_inputGroupCallStream{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, TimeMs: 0x0102030405060700 + 1, Scale: 3},
+	TL_peerUser{UserID: 1},
+	TL_peerChat{ChatID: 1},
+	TL_peerChannel{ChannelID: 1},
+	TL_storage_fileUnknown{},
+	TL_storage_filePartial{},
+	TL_storage_fileJpeg{},
+	TL_storage_fileGif{},
+	TL_storage_filePng{},
+	TL_storage_filePdf{},
+	TL_storage_fileMp3{},
+	TL_storage_fileMov{},
+	TL_storage_fileMp4{},
+	TL_storage_fileWebp{},
+	TL_userEmpty{ID: 1},
+	TL_user{Flags: 134216831, Self: true, Contact: true, MutualContact: true, Deleted: true, Bot: true, BotChatHistory: true, BotNochats: true, Verified: true, Restricted: true, Min: true, BotInlineGeo: true, Support: true, Scam: true, ApplyMinPhoto: true, Fake: true, ID: 17, AccessHash: 0x0102030405060700 + 17, FirstName: "first_name", LastName: "last_name", Username: "username", Phone: "phone", Photo: TL_userProfilePhotoEmpty{}, Status: TL_userStatusEmpty{}, BotInfoVersion: 25, RestrictionReason: []TL{TL_restrictionReason{Platform: "platform", Reason: "reason", Text: "text"}}, BotInlinePlaceholder: "bot_inline_placeholder", LangCode: "lang_code"},
+	TL_user{Flags: 0, ID: 17},
+	TL_userProfilePhotoEmpty{},
+	TL_userProfilePhoto{Flags: 1, HasVideo: true, PhotoID: 0x0102030405060700 + 2, PhotoSmall: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, PhotoBig: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, DcID: 6},
+	TL_userProfilePhoto{Flags: 0, PhotoID: 0x0102030405060700 + 2, PhotoSmall: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, PhotoBig: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, DcID: 6},
+	TL_userStatusEmpty{},
+	TL_userStatusOnline{Expires: 1},
+	TL_userStatusOffline{WasOnline: 1},
+	TL_userStatusRecently{},
+	TL_userStatusLastWeek{},
+	TL_userStatusLastMonth{},
+	TL_chatEmpty{ID: 1},
+	TL_chat{Flags: 25444455, Creator: true, Kicked: true, Left: true, Deactivated: true, CallActive: true, CallNotEmpty: true, ID: 8, Title: "title", Photo: TL_chatPhotoEmpty{}, ParticipantsCount: 11, Date: 12, Version: 13, MigratedTo: TL_inputChannelEmpty{}, AdminRights: TL_chatAdminRights{Flags: 0}, DefaultBannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}},
+	TL_chat{Flags: 0, ID: 8, Title: "title", Photo: TL_chatPhotoEmpty{}, ParticipantsCount: 11, Date: 12, Version: 13},
+	TL_chatForbidden{ID: 1, Title: "title"},
+	TL_channel{Flags: 134151141, Creator: true, Left: true, Broadcast: true, Verified: true, Megagroup: true, Restricted: true, Signatures: true, Min: true, Scam: true, HasLink: true, HasGeo: true, SlowmodeEnabled: true, CallActive: true, CallNotEmpty: true, Fake: true, Gigagroup: true, ID: 18, AccessHash: 0x0102030405060700 + 18, Title: "title", Username: "username", Photo: TL_chatPhotoEmpty{}, Date: 23, Version: 24, RestrictionReason: []TL{TL_restrictionReason{Platform: "platform", Reason: "reason", Text: "text"}}, AdminRights: TL_chatAdminRights{Flags: 0}, BannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}, DefaultBannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}, ParticipantsCount: 29},
+	TL_channel{Flags: 0, ID: 18, Title: "title", Photo: TL_chatPhotoEmpty{}, Date: 23, Version: 24},
+	TL_channelForbidden{Flags: 65824, Broadcast: true, Megagroup: true, ID: 4, AccessHash: 0x0102030405060700 + 4, Title: "title", UntilDate: 7},
+	TL_channelForbidden{Flags: 0, ID: 4, AccessHash: 0x0102030405060700 + 4, Title: "title"},
+	TL_chatFull{Flags: 63948, CanSetUsername: true, HasScheduled: true, ID: 4, About: "about", Participants: TL_chatParticipantsForbidden{Flags: 0, ChatID: 2}, ChatPhoto: TL_photoEmpty{ID: 0x0102030405060700 + 0}, NotifySettings: TL_peerNotifySettings{Flags: 0}, ExportedInvite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}, BotInfo: []TL{TL_botInfo{UserID: 1, Description: "description", Commands: []TL{}}}, PinnedMsgID: 11, FolderID: 12, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, TtlPeriod: 14, GroupcallDefaultJoinAs: TL_peerUser{UserID: 1}},
+	TL_chatFull{Flags: 0, ID: 4, About: "about", Participants: TL_chatParticipantsForbidden{Flags: 0, ChatID: 2}, NotifySettings: TL_peerNotifySettings{Flags: 0}},
+	TL_channelFull{Flags: 134217727, CanViewParticipants: true, CanSetUsername: true, CanSetStickers: true, HiddenPrehistory: true, CanSetLocation: true, HasScheduled: true, CanViewStats: true, Blocked: true, ID: 10, About: "about", ParticipantsCount: 12, AdminsCount: 13, KickedCount: 14, BannedCount: 15, OnlineCount: 16, ReadInboxMaxID: 17, ReadOutboxMaxID: 18, UnreadCount: 19, ChatPhoto: TL_photoEmpty{ID: 0x0102030405060700 + 0}, NotifySettings: TL_peerNotifySettings{Flags: 0}, ExportedInvite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}, BotInfo: []TL{TL_botInfo{UserID: 1, Description: "description", Commands: []TL{}}}, MigratedFromChatID: 24, MigratedFromMaxID: 25, PinnedMsgID: 26, Stickerset: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, AvailableMinID: 28, FolderID: 29, LinkedChatID: 30, Location: TL_channelLocationEmpty{}, SlowmodeSeconds: 32, SlowmodeNextSendDate: 33, StatsDc: 34, Pts: 35, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, TtlPeriod: 37, PendingSuggestions: []string{"s"}, GroupcallDefaultJoinAs: TL_peerUser{UserID: 1}},
+	TL_channelFull{Flags: 0, ID: 10, About: "about", ReadInboxMaxID: 17, ReadOutboxMaxID: 18, UnreadCount: 19, ChatPhoto: TL_photoEmpty{ID: 0x0102030405060700 + 0}, NotifySettings: TL_peerNotifySettings{Flags: 0}, BotInfo: []TL{TL_botInfo{UserID: 1, Description: "description", Commands: []TL{}}}, Pts: 35},
+	TL_chatParticipant{UserID: 1, InviterID: 2, Date: 3},
+	TL_chatParticipantCreator{UserID: 1},
+	TL_chatParticipantAdmin{UserID: 1, InviterID: 2, Date: 3},
+	TL_chatParticipantsForbidden{Flags: 1, ChatID: 2, SelfParticipant: TL_chatParticipant{UserID: 1, InviterID: 2, Date: 3}},
+	TL_chatParticipantsForbidden{Flags: 0, ChatID: 2},
+	TL_chatParticipants{ChatID: 1, Participants: []TL{TL_chatParticipant{UserID: 1, InviterID: 2, Date: 3}}, Version: 3},
+	TL_chatPhotoEmpty{},
+	TL_chatPhoto{Flags: 1, HasVideo: true, PhotoSmall: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, PhotoBig: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, DcID: 5},
+	TL_chatPhoto{Flags: 0, PhotoSmall: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, PhotoBig: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, DcID: 5},
+	TL_messageEmpty{Flags: 1, ID: 2, PeerID: TL_peerUser{UserID: 1}},
+	TL_messageEmpty{Flags: 0, ID: 2},
+	TL_message{Flags: 66056190, Out: true, Mentioned: true, MediaUnread: true, Silent: true, Post: true, FromScheduled: true, Legacy: true, EditHide: true, Pinned: true, ID: 11, FromID: TL_peerUser{UserID: 1}, PeerID: TL_peerUser{UserID: 1}, FwdFrom: TL_messageFwdHeader{Flags: 0, Date: 5}, ViaBotID: 15, ReplyTo: TL_messageReplyHeader{Flags: 0, ReplyToMsgID: 2}, Date: 17, Message: "message", Media: TL_messageMediaEmpty{}, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, Views: 22, Forwards: 23, Replies: TL_messageReplies{Flags: 0, Replies: 3, RepliesPts: 4}, EditDate: 25, PostAuthor: "post_author", GroupedID: 0x0102030405060700 + 26, RestrictionReason: []TL{TL_restrictionReason{Platform: "platform", Reason: "reason", Text: "text"}}, TtlPeriod: 29},
+	TL_message{Flags: 0, ID: 11, PeerID: TL_peerUser{UserID: 1}, Date: 17, Message: "message"},
+	TL_messageService{Flags: 34103610, Out: true, Mentioned: true, MediaUnread: true, Silent: true, Post: true, Legacy: true, ID: 8, FromID: TL_peerUser{UserID: 1}, PeerID: TL_peerUser{UserID: 1}, ReplyTo: TL_messageReplyHeader{Flags: 0, ReplyToMsgID: 2}, Date: 12, Action: TL_messageActionEmpty{}, TtlPeriod: 14},
+	TL_messageService{Flags: 0, ID: 8, PeerID: TL_peerUser{UserID: 1}, Date: 12, Action: TL_messageActionEmpty{}},
+	TL_messageMediaEmpty{},
+	TL_messageMediaPhoto{Flags: 5, Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}, TtlSeconds: 3},
+	TL_messageMediaPhoto{Flags: 0},
+	TL_messageMediaGeo{Geo: TL_geoPointEmpty{}},
+	TL_messageMediaContact{PhoneNumber: "phone_number", FirstName: "first_name", LastName: "last_name", Vcard: "vcard", UserID: 5},
+	TL_messageMediaUnsupported{},
+	TL_messageMediaDocument{Flags: 5, Document: TL_documentEmpty{ID: 0x0102030405060700 + 0}, TtlSeconds: 3},
+	TL_messageMediaDocument{Flags: 0},
+	TL_messageMediaWebPage{Webpage: TL_webPageEmpty{ID: 0x0102030405060700 + 0}},
+	TL_messageMediaVenue{Geo: TL_geoPointEmpty{}, Title: "title", Address: "address", Provider: "provider", VenueID: "venue_id", VenueType: "venue_type"},
+	TL_messageMediaGame{Game: TL_game{Flags: 0, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2, ShortName: "short_name", Title: "title", Description: "description", Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}}},
+	TL_messageMediaInvoice{Flags: 15, ShippingAddressRequested: true, Test: true, Title: "title", Description: "description", Photo: TL_webDocument{Url: "url", AccessHash: 0x0102030405060700 + 1, Size: 3, MimeType: "mime_type", Attributes: []TL{}}, ReceiptMsgID: 7, Currency: "currency", TotalAmount: 0x0102030405060700 + 8, StartParam: "start_param"},
+	TL_messageMediaInvoice{Flags: 0, Title: "title", Description: "description", Currency: "currency", TotalAmount: 0x0102030405060700 + 8, StartParam: "start_param"},
+	TL_messageMediaGeoLive{Flags: 3, Geo: TL_geoPointEmpty{}, Heading: 3, Period: 4, ProximityNotificationRadius: 5},
+	TL_messageMediaGeoLive{Flags: 0, Geo: TL_geoPointEmpty{}, Period: 4},
+	TL_messageMediaPoll{Poll: TL_poll{ID: 0x0102030405060700 + 0, Flags: 0, Question: "question", Answers: []TL{}}, Results: TL_pollResults{Flags: 0}},
+	TL_messageMediaDice{Value: 1, Emoticon: "emoticon"},
+	TL_messageActionEmpty{},
+	TL_messageActionChatCreate{Title: "title", Users: []int32{1}},
+	TL_messageActionChatEditTitle{Title: "title"},
+	TL_messageActionChatEditPhoto{Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}},
+	TL_messageActionChatDeletePhoto{},
+	TL_messageActionChatAddUser{Users: []int32{1}},
+	TL_messageActionChatDeleteUser{UserID: 1},
+	TL_messageActionChatJoinedByLink{InviterID: 1},
+	TL_messageActionChannelCreate{Title: "title"},
+	TL_messageActionChatMigrateTo{ChannelID: 1},
+	TL_messageActionChannelMigrateFrom{Title: "title", ChatID: 2},
+	TL_messageActionPinMessage{},
+	TL_messageActionHistoryClear{},
+	TL_messageActionGameScore{GameID: 0x0102030405060700 + 0, Score: 2},
+	TL_messageActionPaymentSentMe{Flags: 3, Currency: "currency", TotalAmount: 0x0102030405060700 + 2, Payload: []byte("payload"), Info: TL_paymentRequestedInfo{Flags: 0}, ShippingOptionID: "shipping_option_id", Charge: TL_paymentCharge{ID: "id", ProviderChargeID: "provider_charge_id"}},
+	TL_messageActionPaymentSentMe{Flags: 0, Currency: "currency", TotalAmount: 0x0102030405060700 + 2, Payload: []byte("payload"), Charge: TL_paymentCharge{ID: "id", ProviderChargeID: "provider_charge_id"}},
+	TL_messageActionPaymentSent{Currency: "currency", TotalAmount: 0x0102030405060700 + 1},
+	TL_messageActionPhoneCall{Flags: 7, Video: true, CallID: 0x0102030405060700 + 2, Reason: TL_phoneCallDiscardReasonMissed{}, Duration: 5},
+	TL_messageActionPhoneCall{Flags: 0, CallID: 0x0102030405060700 + 2},
+	TL_messageActionScreenshotTaken{},
+	TL_messageActionCustomAction{Message: "message"},
+	TL_messageActionBotAllowed{Domain: "domain"},
+	TL_messageActionSecureValuesSentMe{Values: []TL{TL_secureValue{Flags: 0, Type: TL_secureValueTypePersonalDetails{}, Hash: []byte("hash")}}, Credentials: TL_secureCredentialsEncrypted{Data: []byte("data"), Hash: []byte("hash"), Secret: []byte("secret")}},
+	TL_messageActionSecureValuesSent{Types: []TL{TL_secureValueTypePersonalDetails{}}},
+	TL_messageActionContactSignUp{},
+	TL_messageActionGeoProximityReached{FromID: TL_peerUser{UserID: 1}, ToID: TL_peerUser{UserID: 1}, Distance: 3},
+	TL_messageActionGroupCall{Flags: 1, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Duration: 3},
+	TL_messageActionGroupCall{Flags: 0, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_messageActionInviteToGroupCall{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Users: []int32{1}},
+	TL_messageActionSetMessagesTTL{Period: 1},
+	TL_dialog{Flags: 31, Pinned: true, UnreadMark: true, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, ReadInboxMaxID: 6, ReadOutboxMaxID: 7, UnreadCount: 8, UnreadMentionsCount: 9, NotifySettings: TL_peerNotifySettings{Flags: 0}, Pts: 11, Draft: TL_draftMessageEmpty{Flags: 0}, FolderID: 13},
+	TL_dialog{Flags: 0, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, ReadInboxMaxID: 6, ReadOutboxMaxID: 7, UnreadCount: 8, UnreadMentionsCount: 9, NotifySettings: TL_peerNotifySettings{Flags: 0}},
+	TL_dialogFolder{Flags: 4, Pinned: true, Folder: TL_folder{Flags: 0, ID: 5, Title: "title"}, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, UnreadMutedPeersCount: 6, UnreadUnmutedPeersCount: 7, UnreadMutedMessagesCount: 8, UnreadUnmutedMessagesCount: 9},
+	TL_dialogFolder{Flags: 0, Folder: TL_folder{Flags: 0, ID: 5, Title: "title"}, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, UnreadMutedPeersCount: 6, UnreadUnmutedPeersCount: 7, UnreadMutedMessagesCount: 8, UnreadUnmutedMessagesCount: 9},
+	TL_photoEmpty{ID: 0x0102030405060700 + 0},
+	TL_photo{Flags: 3, HasStickers: true, ID: 0x0102030405060700 + 2, AccessHash: 0x0102030405060700 + 3, FileReference: []byte("file_reference"), Date: 6, Sizes: []TL{TL_photoSizeEmpty{Type: "type"}}, VideoSizes: []TL{TL_videoSize{Flags: 0, Type: "type", Location: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, W: 4, H: 5, Size: 6}}, DcID: 9},
+	TL_photo{Flags: 0, ID: 0x0102030405060700 + 2, AccessHash: 0x0102030405060700 + 3, FileReference: []byte("file_reference"), Date: 6, Sizes: []TL{TL_photoSizeEmpty{Type: "type"}}, DcID: 9},
+	TL_photoSizeEmpty{Type: "type"},
+	TL_photoSize{Type: "type", Location: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, W: 3, H: 4, Size: 5},
+	TL_photoCachedSize{Type: "type", Location: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, W: 3, H: 4, Bytes: []byte("bytes")},
+	TL_photoStrippedSize{Type: "type", Bytes: []byte("bytes")},
+	TL_photoSizeProgressive{Type: "type", Location: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, W: 3, H: 4, Sizes: []int32{1}},
+	TL_photoPathSize{Type: "type", Bytes: []byte("bytes")},
+	TL_geoPointEmpty{},
+	TL_geoPoint{Flags: 1, Long: 1.5, Lat: 1.5, AccessHash: 0x0102030405060700 + 3, AccuracyRadius: 5},
+	TL_geoPoint{Flags: 0, Long: 1.5, Lat: 1.5, AccessHash: 0x0102030405060700 + 3},
+	TL_auth_sentCode{Flags: 6, Type: TL_auth_sentCodeTypeApp{Length: 1}, PhoneCodeHash: "phone_code_hash", NextType: TL_auth_codeTypeSms{}, Timeout: 5},
+	TL_auth_sentCode{Flags: 0, Type: TL_auth_sentCodeTypeApp{Length: 1}, PhoneCodeHash: "phone_code_hash"},
+	TL_auth_authorization{Flags: 1, TmpSessions: 2, User: TL_userEmpty{ID: 1}},
+	TL_auth_authorization{Flags: 0, User: TL_userEmpty{ID: 1}},
+	TL_auth_authorizationSignUpRequired{Flags: 1, TermsOfService: TL_help_termsOfService{Flags: 0, ID: TL_dataJSON{Data: "data"}, Text: "text", Entities: []TL{}}},
+	TL_auth_authorizationSignUpRequired{Flags: 0},
+	TL_auth_exportedAuthorization{ID: 1, Bytes: []byte("bytes")},
+	TL_inputNotifyPeer{Peer: TL_inputPeerEmpty{}},
+	TL_inputNotifyUsers{},
+	TL_inputNotifyChats{},
+	TL_inputNotifyBroadcasts{},
+	TL_inputPeerNotifySettings{Flags: 15, ShowPreviews: TL_boolFalse{}, Silent: TL_boolFalse{}, MuteUntil: 4, Sound: "sound"},
+	TL_inputPeerNotifySettings{Flags: 0},
+	TL_peerNotifySettings{Flags: 15, ShowPreviews: TL_boolFalse{}, Silent: TL_boolFalse{}, MuteUntil: 4, Sound: "sound"},
+	TL_peerNotifySettings{Flags: 0},
+	TL_peerSettings{Flags: 511, ReportSpam: true, AddContact: true, BlockContact: true, ShareContact: true, NeedContactsException: true, ReportGeo: true, Autoarchived: true, InviteMembers: true, GeoDistance: 10},
+	TL_peerSettings{Flags: 0},
+	TL_wallPaper{ID: 0x0102030405060700 + 0, Flags: 31, Creator: true, Default: true, Pattern: true, Dark: true, AccessHash: 0x0102030405060700 + 6, Slug: "slug", Document: TL_documentEmpty{ID: 0x0102030405060700 + 0}, Settings: TL_wallPaperSettings{Flags: 0}},
+	TL_wallPaper{ID: 0x0102030405060700 + 0, Flags: 0, AccessHash: 0x0102030405060700 + 6, Slug: "slug", Document: TL_documentEmpty{ID: 0x0102030405060700 + 0}},
+	TL_wallPaperNoFile{Flags: 22, Default: true, Dark: true, Settings: TL_wallPaperSettings{Flags: 0}},
+	TL_wallPaperNoFile{Flags: 0},
+	TL_inputReportReasonSpam{},
+	TL_inputReportReasonViolence{},
+	TL_inputReportReasonPornography{},
+	TL_inputReportReasonChildAbuse{},
+	TL_inputReportReasonOther{},
+	TL_inputReportReasonCopyright{},
+	TL_inputReportReasonGeoIrrelevant{},
+	TL_inputReportReasonFake{},
+	TL_userFull{Flags: 30975, Blocked: true, PhoneCallsAvailable: true, PhoneCallsPrivate: true, CanPinMessage: true, HasScheduled: true, VideoCallsAvailable: true, User: TL_userEmpty{ID: 1}, About: "about", Settings: TL_peerSettings{Flags: 0}, ProfilePhoto: TL_photoEmpty{ID: 0x0102030405060700 + 0}, NotifySettings: TL_peerNotifySettings{Flags: 0}, BotInfo: TL_botInfo{UserID: 1, Description: "description", Commands: []TL{}}, PinnedMsgID: 14, CommonChatsCount: 15, FolderID: 16, TtlPeriod: 17},
+	TL_userFull{Flags: 0, User: TL_userEmpty{ID: 1}, Settings: TL_peerSettings{Flags: 0}, NotifySettings: TL_peerNotifySettings{Flags: 0}, CommonChatsCount: 15},
+	TL_contact{UserID: 1, Mutual: TL_boolFalse{}},
+	TL_importedContact{UserID: 1, ClientID: 0x0102030405060700 + 1},
+	TL_contactStatus{UserID: 1, Status: TL_userStatusEmpty{}},
+	TL_contacts_contactsNotModified{},
+	TL_contacts_contacts{Contacts: []TL{TL_contact{UserID: 1, Mutual: TL_boolFalse{}}}, SavedCount: 2, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_contacts_importedContacts{Imported: []TL{TL_importedContact{UserID: 1, ClientID: 0x0102030405060700 + 1}}, PopularInvites: []TL{TL_popularContact{ClientID: 0x0102030405060700 + 0, Importers: 2}}, RetryContacts: []int64{2}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_contacts_blocked{Blocked: []TL{TL_peerBlocked{PeerID: TL_peerUser{UserID: 1}, Date: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_contacts_blockedSlice{Count: 1, Blocked: []TL{TL_peerBlocked{PeerID: TL_peerUser{UserID: 1}, Date: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_dialogs{Dialogs: []TL{TL_dialog{Flags: 0, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, ReadInboxMaxID: 6, ReadOutboxMaxID: 7, UnreadCount: 8, UnreadMentionsCount: 9, NotifySettings: TL_peerNotifySettings{Flags: 0}}}, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_dialogsSlice{Count: 1, Dialogs: []TL{TL_dialog{Flags: 0, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, ReadInboxMaxID: 6, ReadOutboxMaxID: 7, UnreadCount: 8, UnreadMentionsCount: 9, NotifySettings: TL_peerNotifySettings{Flags: 0}}}, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_dialogsNotModified{Count: 1},
+	TL_messages_messages{Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_messagesSlice{Flags: 7, Inexact: true, Count: 3, NextRate: 4, OffsetIdOffset: 5, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_messagesSlice{Flags: 0, Count: 3, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_channelMessages{Flags: 6, Inexact: true, Pts: 3, Count: 4, OffsetIdOffset: 5, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_channelMessages{Flags: 0, Pts: 3, Count: 4, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_messagesNotModified{Count: 1},
+	TL_messages_chats{Chats: []TL{TL_chatEmpty{ID: 1}}},
+	TL_messages_chatsSlice{Count: 1, Chats: []TL{TL_chatEmpty{ID: 1}}},
+	TL_messages_chatFull{FullChat: TL_chatFull{Flags: 0, ID: 4, About: "about", Participants: TL_chatParticipantsForbidden{Flags: 0, ChatID: 2}, NotifySettings: TL_peerNotifySettings{Flags: 0}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_affectedHistory{Pts: 1, PtsCount: 2, Offset: 3},
+	TL_inputMessagesFilterEmpty{},
+	TL_inputMessagesFilterPhotos{},
+	TL_inputMessagesFilterVideo{},
+	TL_inputMessagesFilterPhotoVideo{},
+	TL_inputMessagesFilterDocument{},
+	TL_inputMessagesFilterUrl{},
+	TL_inputMessagesFilterGif{},
+	TL_inputMessagesFilterVoice{},
+	TL_inputMessagesFilterMusic{},
+	TL_inputMessagesFilterChatPhotos{},
+	TL_inputMessagesFilterPhoneCalls{Flags: 1, Missed: true},
+	TL_inputMessagesFilterPhoneCalls{Flags: 0},
+	TL_inputMessagesFilterRoundVoice{},
+	TL_inputMessagesFilterRoundVideo{},
+	TL_inputMessagesFilterMyMentions{},
+	TL_inputMessagesFilterGeo{},
+	TL_inputMessagesFilterContacts{},
+	TL_inputMessagesFilterPinned{},
+	TL_updateNewMessage{Message: TL_messageEmpty{Flags: 0, ID: 2}, Pts: 2, PtsCount: 3},
+	TL_updateMessageID{ID: 1, RandomID: 0x0102030405060700 + 1},
+	TL_updateDeleteMessages{Messages: []int32{1}, Pts: 2, PtsCount: 3},
+	TL_updateUserTyping{UserID: 1, Action: TL_sendMessageTypingAction{}},
+	TL_updateChatUserTyping{ChatID: 1, FromID: TL_peerUser{UserID: 1}, Action: TL_sendMessageTypingAction{}},
+	TL_updateChatParticipants{Participants: TL_chatParticipantsForbidden{Flags: 0, ChatID: 2}},
+	TL_updateUserStatus{UserID: 1, Status: TL_userStatusEmpty{}},
+	TL_updateUserName{UserID: 1, FirstName: "first_name", LastName: "last_name", Username: "username"},
+	TL_updateUserPhoto{UserID: 1, Date: 2, Photo: TL_userProfilePhotoEmpty{}, Previous: TL_boolFalse{}},
+	TL_updateNewEncryptedMessage{Message: TL_encryptedMessageService{RandomID: 0x0102030405060700 + 0, ChatID: 2, Date: 3, Bytes: []byte("bytes")}, Qts: 2},
+	TL_updateEncryptedChatTyping{ChatID: 1},
+	TL_updateEncryption{Chat: TL_encryptedChatEmpty{ID: 1}, Date: 2},
+	TL_updateEncryptedMessagesRead{ChatID: 1, MaxDate: 2, Date: 3},
+	TL_updateChatParticipantAdd{ChatID: 1, UserID: 2, InviterID: 3, Date: 4, Version: 5},
+	TL_updateChatParticipantDelete{ChatID: 1, UserID: 2, Version: 3},
+	TL_updateDcOptions{DcOptions: []TL{TL_dcOption{Flags: 0, ID: 7, IpAddress: "ip_address", Port: 9}}},
+	TL_updateNotifySettings{Peer: TL_notifyUsers{}, NotifySettings: TL_peerNotifySettings{Flags: 0}},
+	TL_updateServiceNotification{Flags: 3, Popup: true, InboxDate: 3, Type: "type", Message: "message", Media: TL_messageMediaEmpty{}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_updateServiceNotification{Flags: 0, Type: "type", Message: "message", Media: TL_messageMediaEmpty{}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_updatePrivacy{Key: TL_privacyKeyStatusTimestamp{}, Rules: []TL{TL_privacyValueAllowContacts{}}},
+	TL_updateUserPhone{UserID: 1, Phone: "phone"},
+	TL_updateReadHistoryInbox{Flags: 1, FolderID: 2, Peer: TL_peerUser{UserID: 1}, MaxID: 4, StillUnreadCount: 5, Pts: 6, PtsCount: 7},
+	TL_updateReadHistoryInbox{Flags: 0, Peer: TL_peerUser{UserID: 1}, MaxID: 4, StillUnreadCount: 5, Pts: 6, PtsCount: 7},
+	TL_updateReadHistoryOutbox{Peer: TL_peerUser{UserID: 1}, MaxID: 2, Pts: 3, PtsCount: 4},
+	TL_updateWebPage{Webpage: TL_webPageEmpty{ID: 0x0102030405060700 + 0}, Pts: 2, PtsCount: 3},
+	TL_updateReadMessagesContents{Messages: []int32{1}, Pts: 2, PtsCount: 3},
+	TL_updateChannelTooLong{Flags: 1, ChannelID: 2, Pts: 3},
+	TL_updateChannelTooLong{Flags: 0, ChannelID: 2},
+	TL_updateChannel{ChannelID: 1},
+	TL_updateNewChannelMessage{Message: TL_messageEmpty{Flags: 0, ID: 2}, Pts: 2, PtsCount: 3},
+	TL_updateReadChannelInbox{Flags: 1, FolderID: 2, ChannelID: 3, MaxID: 4, StillUnreadCount: 5, Pts: 6},
+	TL_updateReadChannelInbox{Flags: 0, ChannelID: 3, MaxID: 4, StillUnreadCount: 5, Pts: 6},
+	TL_updateDeleteChannelMessages{ChannelID: 1, Messages: []int32{1}, Pts: 3, PtsCount: 4},
+	TL_updateChannelMessageViews{ChannelID: 1, ID: 2, Views: 3},
+	TL_updateChatParticipantAdmin{ChatID: 1, UserID: 2, IsAdmin: TL_boolFalse{}, Version: 4},
+	TL_updateNewStickerSet{Stickerset: TL_messages_stickerSet{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Packs: []TL{}, Documents: []TL{}}},
+	TL_updateStickerSetsOrder{Flags: 1, Masks: true, Order: []int64{2}},
+	TL_updateStickerSetsOrder{Flags: 0, Order: []int64{2}},
+	TL_updateStickerSets{},
+	TL_updateSavedGifs{},
+	TL_updateBotInlineQuery{Flags: 3, QueryID: 0x0102030405060700 + 1, UserID: 3, Query: "query", Geo: TL_geoPointEmpty{}, PeerType: TL_inlineQueryPeerTypeSameBotPM{}, Offset: "offset"},
+	TL_updateBotInlineQuery{Flags: 0, QueryID: 0x0102030405060700 + 1, UserID: 3, Query: "query", Offset: "offset"},
+	TL_updateBotInlineSend{Flags: 3, UserID: 2, Query: "query", Geo: TL_geoPointEmpty{}, ID: "id", MsgID: TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2}},
+	TL_updateBotInlineSend{Flags: 0, UserID: 2, Query: "query", ID: "id"},
+	TL_updateEditChannelMessage{Message: TL_messageEmpty{Flags: 0, ID: 2}, Pts: 2, PtsCount: 3},
+	TL_updateBotCallbackQuery{Flags: 3, QueryID: 0x0102030405060700 + 1, UserID: 3, Peer: TL_peerUser{UserID: 1}, MsgID: 5, ChatInstance: 0x0102030405060700 + 5, Data: []byte("data"), GameShortName: "game_short_name"},
+	TL_updateBotCallbackQuery{Flags: 0, QueryID: 0x0102030405060700 + 1, UserID: 3, Peer: TL_peerUser{UserID: 1}, MsgID: 5, ChatInstance: 0x0102030405060700 + 5},
+	TL_updateEditMessage{Message: TL_messageEmpty{Flags: 0, ID: 2}, Pts: 2, PtsCount: 3},
+	TL_updateInlineBotCallbackQuery{Flags: 3, QueryID: 0x0102030405060700 + 1, UserID: 3, MsgID: TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2}, ChatInstance: 0x0102030405060700 + 4, Data: []byte("data"), GameShortName: "game_short_name"},
+	TL_updateInlineBotCallbackQuery{Flags: 0, QueryID: 0x0102030405060700 + 1, UserID: 3, MsgID: TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2}, ChatInstance: 0x0102030405060700 + 4},
+	TL_updateReadChannelOutbox{ChannelID: 1, MaxID: 2},
+	TL_updateDraftMessage{Peer: TL_peerUser{UserID: 1}, Draft: TL_draftMessageEmpty{Flags: 0}},
+	TL_updateReadFeaturedStickers{},
+	TL_updateRecentStickers{},
+	TL_updateConfig{},
+	TL_updatePtsChanged{},
+	TL_updateChannelWebPage{ChannelID: 1, Webpage: TL_webPageEmpty{ID: 0x0102030405060700 + 0}, Pts: 3, PtsCount: 4},
+	TL_updateDialogPinned{Flags: 3, Pinned: true, FolderID: 3, Peer: TL_dialogPeerFolder{FolderID: 1}},
+	TL_updateDialogPinned{Flags: 0, Peer: TL_dialogPeerFolder{FolderID: 1}},
+	TL_updatePinnedDialogs{Flags: 3, FolderID: 2, Order: []TL{TL_dialogPeerFolder{FolderID: 1}}},
+	TL_updatePinnedDialogs{Flags: 0},
+	TL_updateBotWebhookJSON{Data: TL_dataJSON{Data: "data"}},
+	TL_updateBotWebhookJSONQuery{QueryID: 0x0102030405060700 + 0, Data: TL_dataJSON{Data: "data"}, Timeout: 3},
+	TL_updateBotShippingQuery{QueryID: 0x0102030405060700 + 0, UserID: 2, Payload: []byte("payload"), ShippingAddress: TL_postAddress{StreetLine1: "street_line1", StreetLine2: "street_line2", City: "city", State: "state", CountryIso2: "country_iso2", PostCode: "post_code"}},
+	TL_updateBotPrecheckoutQuery{Flags: 3, QueryID: 0x0102030405060700 + 1, UserID: 3, Payload: []byte("payload"), Info: TL_paymentRequestedInfo{Flags: 0}, ShippingOptionID: "shipping_option_id", Currency: "currency", TotalAmount: 0x0102030405060700 + 7},
+	TL_updateBotPrecheckoutQuery{Flags: 0, QueryID: 0x0102030405060700 + 1, UserID: 3, Payload: []byte("payload"), Currency: "currency", TotalAmount: 0x0102030405060700 + 7},
+	TL_updatePhoneCall{PhoneCall: TL_phoneCallEmpty{ID: 0x0102030405060700 + 0}},
+	TL_updateLangPackTooLong{LangCode: "lang_code"},
+	TL_updateLangPack{Difference: TL_langPackDifference{LangCode: "lang_code", FromVersion: 2, Version: 3, Strings: []TL{}}},
+	TL_updateFavedStickers{},
+	TL_updateChannelReadMessagesContents{ChannelID: 1, Messages: []int32{1}},
+	TL_updateContactsReset{},
+	TL_updateChannelAvailableMessages{ChannelID: 1, AvailableMinID: 2},
+	TL_updateDialogUnreadMark{Flags: 1, Unread: true, Peer: TL_dialogPeerFolder{FolderID: 1}},
+	TL_updateDialogUnreadMark{Flags: 0, Peer: TL_dialogPeerFolder{FolderID: 1}},
+	TL_updateMessagePoll{Flags: 1, PollID: 0x0102030405060700 + 1, Poll: TL_poll{ID: 0x0102030405060700 + 0, Flags: 0, Question: "question", Answers: []TL{}}, Results: TL_pollResults{Flags: 0}},
+	TL_updateMessagePoll{Flags: 0, PollID: 0x0102030405060700 + 1, Results: TL_pollResults{Flags: 0}},
+	TL_updateChatDefaultBannedRights{Peer: TL_peerUser{UserID: 1}, DefaultBannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}, Version: 3},
+	TL_updateFolderPeers{FolderPeers: []TL{TL_folderPeer{Peer: TL_peerUser{UserID: 1}, FolderID: 2}}, Pts: 2, PtsCount: 3},
+	TL_updatePeerSettings{Peer: TL_peerUser{UserID: 1}, Settings: TL_peerSettings{Flags: 0}},
+	TL_updatePeerLocated{Peers: []TL{TL_peerSelfLocated{Expires: 1}}},
+	TL_updateNewScheduledMessage{Message: TL_messageEmpty{Flags: 0, ID: 2}},
+	TL_updateDeleteScheduledMessages{Peer: TL_peerUser{UserID: 1}, Messages: []int32{1}},
+	TL_updateTheme{Theme: TL_theme{Flags: 0, ID: 0x0102030405060700 + 3, AccessHash: 0x0102030405060700 + 4, Slug: "slug", Title: "title", InstallsCount: 10}},
+	TL_updateGeoLiveViewed{Peer: TL_peerUser{UserID: 1}, MsgID: 2},
+	TL_updateLoginToken{},
+	TL_updateMessagePollVote{PollID: 0x0102030405060700 + 0, UserID: 2, Options: [][]byte{[]byte("b")}},
+	TL_updateDialogFilter{Flags: 1, ID: 2, Filter: TL_dialogFilter{Flags: 0, ID: 10, Title: "title", PinnedPeers: []TL{}, IncludePeers: []TL{}, ExcludePeers: []TL{}}},
+	TL_updateDialogFilter{Flags: 0, ID: 2},
+	TL_updateDialogFilterOrder{Order: []int32{1}},
+	TL_updateDialogFilters{},
+	TL_updatePhoneCallSignalingData{PhoneCallID: 0x0102030405060700 + 0, Data: []byte("data")},
+	TL_updateChannelMessageForwards{ChannelID: 1, ID: 2, Forwards: 3},
+	TL_updateReadChannelDiscussionInbox{Flags: 1, ChannelID: 2, TopMsgID: 3, ReadMaxID: 4, BroadcastID: 5, BroadcastPost: 6},
+	TL_updateReadChannelDiscussionInbox{Flags: 0, ChannelID: 2, TopMsgID: 3, ReadMaxID: 4},
+	TL_updateReadChannelDiscussionOutbox{ChannelID: 1, TopMsgID: 2, ReadMaxID: 3},
+	TL_updatePeerBlocked{PeerID: TL_peerUser{UserID: 1}, Blocked: TL_boolFalse{}},
+	TL_updateChannelUserTyping{Flags: 1, ChannelID: 2, TopMsgID: 3, FromID: TL_peerUser{UserID: 1}, Action: TL_sendMessageTypingAction{}},
+	TL_updateChannelUserTyping{Flags: 0, ChannelID: 2, FromID: TL_peerUser{UserID: 1}, Action: TL_sendMessageTypingAction{}},
+	TL_updatePinnedMessages{Flags: 1, Pinned: true, Peer: TL_peerUser{UserID: 1}, Messages: []int32{1}, Pts: 5, PtsCount: 6},
+	TL_updatePinnedMessages{Flags: 0, Peer: TL_peerUser{UserID: 1}, Messages: []int32{1}, Pts: 5, PtsCount: 6},
+	TL_updatePinnedChannelMessages{Flags: 1, Pinned: true, ChannelID: 3, Messages: []int32{1}, Pts: 5, PtsCount: 6},
+	TL_updatePinnedChannelMessages{Flags: 0, ChannelID: 3, Messages: []int32{1}, Pts: 5, PtsCount: 6},
+	TL_updateChat{ChatID: 1},
+	TL_updateGroupCallParticipants{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Participants: []TL{TL_groupCallParticipant{Flags: 0, Peer: TL_peerUser{UserID: 1}, Date: 12, Source: 14}}, Version: 3},
+	TL_updateGroupCall{ChatID: 1, Call: TL_groupCallDiscarded{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, Duration: 3}},
+	TL_updatePeerHistoryTTL{Flags: 1, Peer: TL_peerUser{UserID: 1}, TtlPeriod: 3},
+	TL_updatePeerHistoryTTL{Flags: 0, Peer: TL_peerUser{UserID: 1}},
+	TL_updateChatParticipant{Flags: 7, ChatID: 2, Date: 3, ActorID: 4, UserID: 5, PrevParticipant: TL_chatParticipant{UserID: 1, InviterID: 2, Date: 3}, NewParticipant: TL_chatParticipant{UserID: 1, InviterID: 2, Date: 3}, Invite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}, Qts: 9},
+	TL_updateChatParticipant{Flags: 0, ChatID: 2, Date: 3, ActorID: 4, UserID: 5, Qts: 9},
+	TL_updateChannelParticipant{Flags: 7, ChannelID: 2, Date: 3, ActorID: 4, UserID: 5, PrevParticipant: TL_channelParticipant{UserID: 1, Date: 2}, NewParticipant: TL_channelParticipant{UserID: 1, Date: 2}, Invite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}, Qts: 9},
+	TL_updateChannelParticipant{Flags: 0, ChannelID: 2, Date: 3, ActorID: 4, UserID: 5, Qts: 9},
+	TL_updateBotStopped{UserID: 1, Date: 2, Stopped: TL_boolFalse{}, Qts: 4},
+	TL_updates_state{Pts: 1, Qts: 2, Date: 3, Seq: 4, UnreadCount: 5},
+	TL_updates_differenceEmpty{Date: 1, Seq: 2},
+	TL_updates_difference{NewMessages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, NewEncryptedMessages: []TL{TL_encryptedMessageService{RandomID: 0x0102030405060700 + 0, ChatID: 2, Date: 3, Bytes: []byte("bytes")}}, OtherUpdates: []TL{TL_updateMessageID{ID: 1, RandomID: 0x0102030405060700 + 1}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}, State: TL_updates_state{Pts: 1, Qts: 2, Date: 3, Seq: 4, UnreadCount: 5}},
+	TL_updates_differenceSlice{NewMessages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, NewEncryptedMessages: []TL{TL_encryptedMessageService{RandomID: 0x0102030405060700 + 0, ChatID: 2, Date: 3, Bytes: []byte("bytes")}}, OtherUpdates: []TL{TL_updateMessageID{ID: 1, RandomID: 0x0102030405060700 + 1}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}, IntermediateState: TL_updates_state{Pts: 1, Qts: 2, Date: 3, Seq: 4, UnreadCount: 5}},
+	TL_updates_differenceTooLong{Pts: 1},
+	TL_updatesTooLong{},
+	TL_updateShortMessage{Flags: 33564862, Out: true, Mentioned: true, MediaUnread: true, Silent: true, ID: 6, UserID: 7, Message: "message", Pts: 9, PtsCount: 10, Date: 11, FwdFrom: TL_messageFwdHeader{Flags: 0, Date: 5}, ViaBotID: 13, ReplyTo: TL_messageReplyHeader{Flags: 0, ReplyToMsgID: 2}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, TtlPeriod: 16},
+	TL_updateShortMessage{Flags: 0, ID: 6, UserID: 7, Message: "message", Pts: 9, PtsCount: 10, Date: 11},
+	TL_updateShortChatMessage{Flags: 33564862, Out: true, Mentioned: true, MediaUnread: true, Silent: true, ID: 6, FromID: 7, ChatID: 8, Message: "message", Pts: 10, PtsCount: 11, Date: 12, FwdFrom: TL_messageFwdHeader{Flags: 0, Date: 5}, ViaBotID: 14, ReplyTo: TL_messageReplyHeader{Flags: 0, ReplyToMsgID: 2}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, TtlPeriod: 17},
+	TL_updateShortChatMessage{Flags: 0, ID: 6, FromID: 7, ChatID: 8, Message: "message", Pts: 10, PtsCount: 11, Date: 12},
+	TL_updateShort{Update: TL_updateMessageID{ID: 1, RandomID: 0x0102030405060700 + 1}, Date: 2},
+	TL_updatesCombined{Updates: []TL{TL_updateMessageID{ID: 1, RandomID: 0x0102030405060700 + 1}}, Users: []TL{TL_userEmpty{ID: 1}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Date: 4, SeqStart: 5, Seq: 6},
+	TL_updates{Updates: []TL{TL_updateMessageID{ID: 1, RandomID: 0x0102030405060700 + 1}}, Users: []TL{TL_userEmpty{ID: 1}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Date: 4, Seq: 5},
+	TL_updateShortSentMessage{Flags: 33555074, Out: true, ID: 3, Pts: 4, PtsCount: 5, Date: 6, Media: TL_messageMediaEmpty{}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, TtlPeriod: 9},
+	TL_updateShortSentMessage{Flags: 0, ID: 3, Pts: 4, PtsCount: 5, Date: 6},
+	TL_photos_photos{Photos: []TL{TL_photoEmpty{ID: 0x0102030405060700 + 0}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_photos_photosSlice{Count: 1, Photos: []TL{TL_photoEmpty{ID: 0x0102030405060700 + 0}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_photos_photo{Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_upload_file{Type: TL_storage_fileUnknown{}, Mtime: 2, Bytes: []byte("bytes")},
+	TL_upload_fileCdnRedirect{DcID: 1, FileToken: []byte("file_token"), EncryptionKey: []byte("encryption_key"), EncryptionIv: []byte("encryption_iv"), FileHashes: []TL{TL_fileHash{Offset: 1, Limit: 2, Hash: []byte("hash")}}},
+	TL_dcOption{Flags: 1055, Ipv6: true, MediaOnly: true, TcpoOnly: true, Cdn: true, Static: true, ID: 7, IpAddress: "ip_address", Port: 9, Secret: []byte("secret")},
+	TL_dcOption{Flags: 0, ID: 7, IpAddress: "ip_address", Port: 9},
+	TL_config{Flags: 16383, PhonecallsEnabled: true, DefaultP2pContacts: true, PreloadFeaturedStickers: true, IgnorePhoneEntities: true, RevokePmInbox: true, BlockedMode: true, PfsEnabled: true, Date: 9, Expires: 10, TestMode: TL_boolFalse{}, ThisDc: 12, DcOptions: []TL{TL_dcOption{Flags: 0, ID: 7, IpAddress: "ip_address", Port: 9}}, DcTxtDomainName: "dc_txt_domain_name", ChatSizeMax: 15, MegagroupSizeMax: 16, ForwardedCountMax: 17, OnlineUpdatePeriodMs: 18, OfflineBlurTimeoutMs: 19, OfflineIdleTimeoutMs: 20, OnlineCloudTimeoutMs: 21, NotifyCloudDelayMs: 22, NotifyDefaultDelayMs: 23, PushChatPeriodMs: 24, PushChatLimit: 25, SavedGifsLimit: 26, EditTimeLimit: 27, RevokeTimeLimit: 28, RevokePmTimeLimit: 29, RatingEDecay: 30, StickersRecentLimit: 31, StickersFavedLimit: 32, ChannelsReadMediaPeriod: 33, TmpSessions: 34, PinnedDialogsCountMax: 35, PinnedInfolderCountMax: 36, CallReceiveTimeoutMs: 37, CallRingTimeoutMs: 38, CallConnectTimeoutMs: 39, CallPacketTimeoutMs: 40, MeUrlPrefix: "me_url_prefix", AutoupdateUrlPrefix: "autoupdate_url_prefix", GifSearchUsername: "gif_search_username", VenueSearchUsername: "venue_search_username", ImgSearchUsername: "img_search_username", StaticMapsProvider: "static_maps_provider", CaptionLengthMax: 47, MessageLengthMax: 48, WebfileDcID: 49, SuggestedLangCode: "suggested_lang_code", LangPackVersion: 51, BaseLangPackVersion: 52},
+	TL_config{Flags: 0, Date: 9, Expires: 10, TestMode: TL_boolFalse{}, ThisDc: 12, DcOptions: []TL{TL_dcOption{Flags: 0, ID: 7, IpAddress: "ip_address", Port: 9}}, DcTxtDomainName: "dc_txt_domain_name", ChatSizeMax: 15, MegagroupSizeMax: 16, ForwardedCountMax: 17, OnlineUpdatePeriodMs: 18, OfflineBlurTimeoutMs: 19, OfflineIdleTimeoutMs: 20, OnlineCloudTimeoutMs: 21, NotifyCloudDelayMs: 22, NotifyDefaultDelayMs: 23, PushChatPeriodMs: 24, PushChatLimit: 25, SavedGifsLimit: 26, EditTimeLimit: 27, RevokeTimeLimit: 28, RevokePmTimeLimit: 29, RatingEDecay: 30, StickersRecentLimit: 31, StickersFavedLimit: 32, ChannelsReadMediaPeriod: 33, PinnedDialogsCountMax: 35, PinnedInfolderCountMax: 36, CallReceiveTimeoutMs: 37, CallRingTimeoutMs: 38, CallConnectTimeoutMs: 39, CallPacketTimeoutMs: 40, MeUrlPrefix: "me_url_prefix", CaptionLengthMax: 47, MessageLengthMax: 48, WebfileDcID: 49},
+	TL_nearestDc{Country: "country", ThisDc: 2, NearestDc: 3},
+	TL_help_appUpdate{Flags: 7, CanNotSkip: true, ID: 3, Version: "version", Text: "text", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, Document: TL_documentEmpty{ID: 0x0102030405060700 + 0}, Url: "url"},
+	TL_help_appUpdate{Flags: 0, ID: 3, Version: "version", Text: "text", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_help_noAppUpdate{},
+	TL_help_inviteText{Message: "message"},
+	TL_encryptedChatEmpty{ID: 1},
+	TL_encryptedChatWaiting{ID: 1, AccessHash: 0x0102030405060700 + 1, Date: 3, AdminID: 4, ParticipantID: 5},
+	TL_encryptedChatRequested{Flags: 1, FolderID: 2, ID: 3, AccessHash: 0x0102030405060700 + 3, Date: 5, AdminID: 6, ParticipantID: 7, GA: []byte("g_a")},
+	TL_encryptedChatRequested{Flags: 0, ID: 3, AccessHash: 0x0102030405060700 + 3, Date: 5, AdminID: 6, ParticipantID: 7, GA: []byte("g_a")},
+	TL_encryptedChat{ID: 1, AccessHash: 0x0102030405060700 + 1, Date: 3, AdminID: 4, ParticipantID: 5, GAOrB: []byte("g_a_or_b"), KeyFingerprint: 0x0102030405060700 + 6},
+	TL_encryptedChatDiscarded{Flags: 1, HistoryDeleted: true, ID: 3},
+	TL_encryptedChatDiscarded{Flags: 0, ID: 3},
+	TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1},
+	TL_encryptedFileEmpty{},
+	TL_encryptedFile{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, Size: 3, DcID: 4, KeyFingerprint: 5},
+	TL_inputEncryptedFileEmpty{},
+	TL_inputEncryptedFileUploaded{ID: 0x0102030405060700 + 0, Parts: 2, Md5Checksum: "md5_checksum", KeyFingerprint: 4},
+	TL_inputEncryptedFile{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_inputEncryptedFileBigUploaded{ID: 0x0102030405060700 + 0, Parts: 2, KeyFingerprint: 3},
+	TL_encryptedMessage{RandomID: 0x0102030405060700 + 0, ChatID: 2, Date: 3, Bytes: []byte("bytes"), File: TL_encryptedFileEmpty{}},
+	TL_encryptedMessageService{RandomID: 0x0102030405060700 + 0, ChatID: 2, Date: 3, Bytes: []byte("bytes")},
+	TL_messages_dhConfigNotModified{Random: []byte("random")},
+	TL_messages_dhConfig{G: 1, P: []byte("p"), Version: 3, Random: []byte("random")},
+	TL_messages_sentEncryptedMessage{Date: 1},
+	TL_messages_sentEncryptedFile{Date: 1, File: TL_encryptedFileEmpty{}},
+	TL_inputDocumentEmpty{},
+	TL_inputDocument{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, FileReference: []byte("file_reference")},
+	TL_documentEmpty{ID: 0x0102030405060700 + 0},
+	TL_document{Flags: 3, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2, FileReference: []byte("file_reference"), Date: 5, MimeType: "mime_type", Size: 7, Thumbs: []TL{TL_photoSizeEmpty{Type: "type"}}, VideoThumbs: []TL{TL_videoSize{Flags: 0, Type: "type", Location: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, W: 4, H: 5, Size: 6}}, DcID: 10, Attributes: []TL{TL_documentAttributeImageSize{W: 1, H: 2}}},
+	TL_document{Flags: 0, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2, FileReference: []byte("file_reference"), Date: 5, MimeType: "mime_type", Size: 7, DcID: 10, Attributes: []TL{TL_documentAttributeImageSize{W: 1, H: 2}}},
+	TL_help_support{PhoneNumber: "phone_number", User: TL_userEmpty{ID: 1}},
+	TL_notifyPeer{Peer: TL_peerUser{UserID: 1}},
+	TL_notifyUsers{},
+	TL_notifyChats{},
+	TL_notifyBroadcasts{},
+	TL_sendMessageTypingAction{},
+	TL_sendMessageCancelAction{},
+	TL_sendMessageRecordVideoAction{},
+	TL_sendMessageUploadVideoAction{Progress: 1},
+	TL_sendMessageRecordAudioAction{},
+	TL_sendMessageUploadAudioAction{Progress: 1},
+	TL_sendMessageUploadPhotoAction{Progress: 1},
+	TL_sendMessageUploadDocumentAction{Progress: 1},
+	TL_sendMessageGeoLocationAction{},
+	TL_sendMessageChooseContactAction{},
+	TL_sendMessageGamePlayAction{},
+	TL_sendMessageRecordRoundAction{},
+	TL_sendMessageUploadRoundAction{Progress: 1},
+	TL_speakingInGroupCallAction{},
+	TL_sendMessageHistoryImportAction{Progress: 1},
+	TL_contacts_found{MyResults: []TL{TL_peerUser{UserID: 1}}, Results: []TL{TL_peerUser{UserID: 1}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_inputPrivacyKeyStatusTimestamp{},
+	TL_inputPrivacyKeyChatInvite{},
+	TL_inputPrivacyKeyPhoneCall{},
+	TL_inputPrivacyKeyPhoneP2P{},
+	TL_inputPrivacyKeyForwards{},
+	TL_inputPrivacyKeyProfilePhoto{},
+	TL_inputPrivacyKeyPhoneNumber{},
+	TL_inputPrivacyKeyAddedByPhone{},
+	TL_privacyKeyStatusTimestamp{},
+	TL_privacyKeyChatInvite{},
+	TL_privacyKeyPhoneCall{},
+	TL_privacyKeyPhoneP2P{},
+	TL_privacyKeyForwards{},
+	TL_privacyKeyProfilePhoto{},
+	TL_privacyKeyPhoneNumber{},
+	TL_privacyKeyAddedByPhone{},
+	TL_inputPrivacyValueAllowContacts{},
+	TL_inputPrivacyValueAllowAll{},
+	TL_inputPrivacyValueAllowUsers{Users: []TL{TL_inputUserEmpty{}}},
+	TL_inputPrivacyValueDisallowContacts{},
+	TL_inputPrivacyValueDisallowAll{},
+	TL_inputPrivacyValueDisallowUsers{Users: []TL{TL_inputUserEmpty{}}},
+	TL_inputPrivacyValueAllowChatParticipants{Chats: []int32{1}},
+	TL_inputPrivacyValueDisallowChatParticipants{Chats: []int32{1}},
+	TL_privacyValueAllowContacts{},
+	TL_privacyValueAllowAll{},
+	TL_privacyValueAllowUsers{Users: []int32{1}},
+	TL_privacyValueDisallowContacts{},
+	TL_privacyValueDisallowAll{},
+	TL_privacyValueDisallowUsers{Users: []int32{1}},
+	TL_privacyValueAllowChatParticipants{Chats: []int32{1}},
+	TL_privacyValueDisallowChatParticipants{Chats: []int32{1}},
+	TL_account_privacyRules{Rules: []TL{TL_privacyValueAllowContacts{}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_accountDaysTTL{Days: 1},
+	TL_documentAttributeImageSize{W: 1, H: 2},
+	TL_documentAttributeAnimated{},
+	TL_documentAttributeSticker{Flags: 3, Mask: true, Alt: "alt", Stickerset: TL_inputStickerSetEmpty{}, MaskCoords: TL_maskCoords{N: 1, X: 1.5, Y: 1.5, Zoom: 1.5}},
+	TL_documentAttributeSticker{Flags: 0, Alt: "alt", Stickerset: TL_inputStickerSetEmpty{}},
+	TL_documentAttributeVideo{Flags: 3, RoundMessage: true, SupportsStreaming: true, Duration: 4, W: 5, H: 6},
+	TL_documentAttributeVideo{Flags: 0, Duration: 4, W: 5, H: 6},
+	TL_documentAttributeAudio{Flags: 1031, Voice: true, Duration: 3, Title: "title", Performer: "performer", Waveform: []byte("waveform")},
+	TL_documentAttributeAudio{Flags: 0, Duration: 3},
+	TL_documentAttributeFilename{FileName: "file_name"},
+	TL_documentAttributeHasStickers{},
+	TL_messages_stickersNotModified{},
+	TL_messages_stickers{Hash: 1, Stickers: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}},
+	TL_stickerPack{Emoticon: "emoticon", Documents: []int64{2}},
+	TL_messages_allStickersNotModified{},
+	TL_messages_allStickers{Hash: 1, Sets: []TL{TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}}},
+	TL_messages_affectedMessages{Pts: 1, PtsCount: 2},
+	TL_webPageEmpty{ID: 0x0102030405060700 + 0},
+	TL_webPagePending{ID: 0x0102030405060700 + 0, Date: 2},
+	TL_webPage{Flags: 6143, ID: 0x0102030405060700 + 1, Url: "url", DisplayUrl: "display_url", Hash: 5, Type: "type", SiteName: "site_name", Title: "title", Description: "description", Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}, EmbedUrl: "embed_url", EmbedType: "embed_type", EmbedWidth: 13, EmbedHeight: 14, Duration: 15, Author: "author", Document: TL_documentEmpty{ID: 0x0102030405060700 + 0}, CachedPage: TL_page{Flags: 0, Url: "url", Blocks: []TL{}, Photos: []TL{}, Documents: []TL{}}, Attributes: []TL{TL_webPageAttributeTheme{Flags: 0}}},
+	TL_webPage{Flags: 0, ID: 0x0102030405060700 + 1, Url: "url", DisplayUrl: "display_url", Hash: 5},
+	TL_webPageNotModified{Flags: 1, CachedPageViews: 2},
+	TL_webPageNotModified{Flags: 0},
+	TL_authorization{Flags: 7, Current: true, OfficialApp: true, PasswordPending: true, Hash: 0x0102030405060700 + 4, DeviceModel: "device_model", Platform: "platform", SystemVersion: "system_version", ApiID: 9, AppName: "app_name", AppVersion: "app_version", DateCreated: 12, DateActive: 13, Ip: "ip", Country: "country", Region: "region"},
+	TL_authorization{Flags: 0, Hash: 0x0102030405060700 + 4, DeviceModel: "device_model", Platform: "platform", SystemVersion: "system_version", ApiID: 9, AppName: "app_name", AppVersion: "app_version", DateCreated: 12, DateActive: 13, Ip: "ip", Country: "country", Region: "region"},
+	TL_account_authorizations{Authorizations: []TL{TL_authorization{Flags: 0, Hash: 0x0102030405060700 + 4, DeviceModel: "device_model", Platform: "platform", SystemVersion: "system_version", ApiID: 9, AppName: "app_name", AppVersion: "app_version", DateCreated: 12, DateActive: 13, Ip: "ip", Country: "country", Region: "region"}}},
+	TL_account_password{Flags: 31, HasRecovery: true, HasSecureValues: true, HasPassword: true, CurrentAlgo: TL_passwordKdfAlgoUnknown{}, SrpB: []byte("srp_B"), SrpID: 0x0102030405060700 + 6, Hint: "hint", EmailUnconfirmedPattern: "email_unconfirmed_pattern", NewAlgo: TL_passwordKdfAlgoUnknown{}, NewSecureAlgo: TL_securePasswordKdfAlgoUnknown{}, SecureRandom: []byte("secure_random")},
+	TL_account_password{Flags: 0, NewAlgo: TL_passwordKdfAlgoUnknown{}, NewSecureAlgo: TL_securePasswordKdfAlgoUnknown{}, SecureRandom: []byte("secure_random")},
+	TL_account_passwordSettings{Flags: 3, Email: "email", SecureSettings: TL_secureSecretSettings{SecureAlgo: TL_securePasswordKdfAlgoUnknown{}, SecureSecret: []byte("secure_secret"), SecureSecretID: 0x0102030405060700 + 2}},
+	TL_account_passwordSettings{Flags: 0},
+	TL_account_passwordInputSettings{Flags: 7, NewAlgo: TL_passwordKdfAlgoUnknown{}, NewPasswordHash: []byte("new_password_hash"), Hint: "hint", Email: "email", NewSecureSettings: TL_secureSecretSettings{SecureAlgo: TL_securePasswordKdfAlgoUnknown{}, SecureSecret: []byte("secure_secret"), SecureSecretID: 0x0102030405060700 + 2}},
+	TL_account_passwordInputSettings{Flags: 0},
+	TL_auth_passwordRecovery{EmailPattern: "email_pattern"},
+	TL_receivedNotifyMessage{ID: 1, Flags: 2},
+	TL_chatInviteExported{Flags: 63, Revoked: true, Permanent: true, Link: "link", AdminID: 5, Date: 6, StartDate: 7, ExpireDate: 8, UsageLimit: 9, Usage: 10},
+	TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6},
+	TL_chatInviteAlready{Chat: TL_chatEmpty{ID: 1}},
+	TL_chatInvite{Flags: 31, Channel: true, Broadcast: true, Public: true, Megagroup: true, Title: "title", Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}, ParticipantsCount: 8, Participants: []TL{TL_userEmpty{ID: 1}}},
+	TL_chatInvite{Flags: 0, Title: "title", Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}, ParticipantsCount: 8},
+	TL_chatInvitePeek{Chat: TL_chatEmpty{ID: 1}, Expires: 2},
+	TL_inputStickerSetEmpty{},
+	TL_inputStickerSetID{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_inputStickerSetShortName{ShortName: "short_name"},
+	TL_inputStickerSetAnimatedEmoji{},
+	TL_inputStickerSetDice{Emoticon: "emoticon"},
+	TL_stickerSet{Flags: 63, Archived: true, Official: true, Masks: true, Animated: true, InstalledDate: 6, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Thumbs: []TL{TL_photoSizeEmpty{Type: "type"}}, ThumbDcID: 12, Count: 13, Hash: 14},
+	TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14},
+	TL_messages_stickerSet{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Packs: []TL{TL_stickerPack{Emoticon: "emoticon", Documents: []int64{}}}, Documents: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}},
+	TL_botCommand{Command: "command", Description: "description"},
+	TL_botInfo{UserID: 1, Description: "description", Commands: []TL{TL_botCommand{Command: "command", Description: "description"}}},
+	TL_keyboardButton{Text: "text"},
+	TL_keyboardButtonUrl{Text: "text", Url: "url"},
+	TL_keyboardButtonCallback{Flags: 1, RequiresPassword: true, Text: "text", Data: []byte("data")},
+	TL_keyboardButtonCallback{Flags: 0, Text: "text", Data: []byte("data")},
+	TL_keyboardButtonRequestPhone{Text: "text"},
+	TL_keyboardButtonRequestGeoLocation{Text: "text"},
+	TL_keyboardButtonSwitchInline{Flags: 1, SamePeer: true, Text: "text", Query: "query"},
+	TL_keyboardButtonSwitchInline{Flags: 0, Text: "text", Query: "query"},
+	TL_keyboardButtonGame{Text: "text"},
+	TL_keyboardButtonBuy{Text: "text"},
+	TL_keyboardButtonUrlAuth{Flags: 1, Text: "text", FwdText: "fwd_text", Url: "url", ButtonID: 5},
+	TL_keyboardButtonUrlAuth{Flags: 0, Text: "text", Url: "url", ButtonID: 5},
+	TL_inputKeyboardButtonUrlAuth{Flags: 3, RequestWriteAccess: true, Text: "text", FwdText: "fwd_text", Url: "url", Bot: TL_inputUserEmpty{}},
+	TL_inputKeyboardButtonUrlAuth{Flags: 0, Text: "text", Url: "url", Bot: TL_inputUserEmpty{}},
+	TL_keyboardButtonRequestPoll{Flags: 1, Quiz: TL_boolFalse{}, Text: "text"},
+	TL_keyboardButtonRequestPoll{Flags: 0, Text: "text"},
+	TL_keyboardButtonRow{Buttons: []TL{TL_keyboardButton{Text: "text"}}},
+	TL_replyKeyboardHide{Flags: 4, Selective: true},
+	TL_replyKeyboardHide{Flags: 0},
+	TL_replyKeyboardForceReply{Flags: 6, SingleUse: true, Selective: true},
+	TL_replyKeyboardForceReply{Flags: 0},
+	TL_replyKeyboardMarkup{Flags: 7, Resize: true, SingleUse: true, Selective: true, Rows: []TL{TL_keyboardButtonRow{Buttons: []TL{}}}},
+	TL_replyKeyboardMarkup{Flags: 0, Rows: []TL{TL_keyboardButtonRow{Buttons: []TL{}}}},
+	TL_replyInlineMarkup{Rows: []TL{TL_keyboardButtonRow{Buttons: []TL{}}}},
+	TL_messageEntityUnknown{Offset: 1, Length: 2},
+	TL_messageEntityMention{Offset: 1, Length: 2},
+	TL_messageEntityHashtag{Offset: 1, Length: 2},
+	TL_messageEntityBotCommand{Offset: 1, Length: 2},
+	TL_messageEntityUrl{Offset: 1, Length: 2},
+	TL_messageEntityEmail{Offset: 1, Length: 2},
+	TL_messageEntityBold{Offset: 1, Length: 2},
+	TL_messageEntityItalic{Offset: 1, Length: 2},
+	TL_messageEntityCode{Offset: 1, Length: 2},
+	TL_messageEntityPre{Offset: 1, Length: 2, Language: "language"},
+	TL_messageEntityTextUrl{Offset: 1, Length: 2, Url: "url"},
+	TL_messageEntityMentionName{Offset: 1, Length: 2, UserID: 3},
+	TL_inputMessageEntityMentionName{Offset: 1, Length: 2, UserID: TL_inputUserEmpty{}},
+	TL_messageEntityPhone{Offset: 1, Length: 2},
+	TL_messageEntityCashtag{Offset: 1, Length: 2},
+	TL_messageEntityUnderline{Offset: 1, Length: 2},
+	TL_messageEntityStrike{Offset: 1, Length: 2},
+	TL_messageEntityBlockquote{Offset: 1, Length: 2},
+	TL_messageEntityBankCard{Offset: 1, Length: 2},
+	TL_inputChannelEmpty{},
+	TL_inputChannel{ChannelID: 1, AccessHash: 0x0102030405060700 + 1},
+	TL_inputChannelFromMessage{Peer: TL_inputPeerEmpty{}, MsgID: 2, ChannelID: 3},
+	TL_contacts_resolvedPeer{Peer: TL_peerUser{UserID: 1}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messageRange{MinID: 1, MaxID: 2},
+	TL_updates_channelDifferenceEmpty{Flags: 3, Final: true, Pts: 3, Timeout: 4},
+	TL_updates_channelDifferenceEmpty{Flags: 0, Pts: 3},
+	TL_updates_channelDifferenceTooLong{Flags: 3, Final: true, Timeout: 3, Dialog: TL_dialog{Flags: 0, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, ReadInboxMaxID: 6, ReadOutboxMaxID: 7, UnreadCount: 8, UnreadMentionsCount: 9, NotifySettings: TL_peerNotifySettings{Flags: 0}}, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_updates_channelDifferenceTooLong{Flags: 0, Dialog: TL_dialog{Flags: 0, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, ReadInboxMaxID: 6, ReadOutboxMaxID: 7, UnreadCount: 8, UnreadMentionsCount: 9, NotifySettings: TL_peerNotifySettings{Flags: 0}}, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_updates_channelDifference{Flags: 3, Final: true, Pts: 3, Timeout: 4, NewMessages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, OtherUpdates: []TL{TL_updateMessageID{ID: 1, RandomID: 0x0102030405060700 + 1}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_updates_channelDifference{Flags: 0, Pts: 3, NewMessages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, OtherUpdates: []TL{TL_updateMessageID{ID: 1, RandomID: 0x0102030405060700 + 1}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_channelMessagesFilterEmpty{},
+	TL_channelMessagesFilter{Flags: 2, ExcludeNewMessages: true, Ranges: []TL{TL_messageRange{MinID: 1, MaxID: 2}}},
+	TL_channelMessagesFilter{Flags: 0, Ranges: []TL{TL_messageRange{MinID: 1, MaxID: 2}}},
+	TL_channelParticipant{UserID: 1, Date: 2},
+	TL_channelParticipantSelf{UserID: 1, InviterID: 2, Date: 3},
+	TL_channelParticipantCreator{Flags: 1, UserID: 2, AdminRights: TL_chatAdminRights{Flags: 0}, Rank: "rank"},
+	TL_channelParticipantCreator{Flags: 0, UserID: 2, AdminRights: TL_chatAdminRights{Flags: 0}},
+	TL_channelParticipantAdmin{Flags: 7, CanEdit: true, Self: true, UserID: 4, InviterID: 5, PromotedBy: 6, Date: 7, AdminRights: TL_chatAdminRights{Flags: 0}, Rank: "rank"},
+	TL_channelParticipantAdmin{Flags: 0, UserID: 4, PromotedBy: 6, Date: 7, AdminRights: TL_chatAdminRights{Flags: 0}},
+	TL_channelParticipantBanned{Flags: 1, Left: true, Peer: TL_peerUser{UserID: 1}, KickedBy: 4, Date: 5, BannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}},
+	TL_channelParticipantBanned{Flags: 0, Peer: TL_peerUser{UserID: 1}, KickedBy: 4, Date: 5, BannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}},
+	TL_channelParticipantLeft{Peer: TL_peerUser{UserID: 1}},
+	TL_channelParticipantsRecent{},
+	TL_channelParticipantsAdmins{},
+	TL_channelParticipantsKicked{Q: "q"},
+	TL_channelParticipantsBots{},
+	TL_channelParticipantsBanned{Q: "q"},
+	TL_channelParticipantsSearch{Q: "q"},
+	TL_channelParticipantsContacts{Q: "q"},
+	TL_channelParticipantsMentions{Flags: 3, Q: "q", TopMsgID: 3},
+	TL_channelParticipantsMentions{Flags: 0},
+	TL_channels_channelParticipants{Count: 1, Participants: []TL{TL_channelParticipant{UserID: 1, Date: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_channels_channelParticipantsNotModified{},
+	TL_channels_channelParticipant{Participant: TL_channelParticipant{UserID: 1, Date: 2}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_help_termsOfService{Flags: 3, Popup: true, ID: TL_dataJSON{Data: "data"}, Text: "text", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, MinAgeConfirm: 6},
+	TL_help_termsOfService{Flags: 0, ID: TL_dataJSON{Data: "data"}, Text: "text", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_messages_savedGifsNotModified{},
+	TL_messages_savedGifs{Hash: 1, Gifs: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}},
+	TL_inputBotInlineMessageMediaAuto{Flags: 6, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"},
+	TL_inputBotInlineMessageText{Flags: 7, NoWebpage: true, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_inputBotInlineMessageText{Flags: 0, Message: "message"},
+	TL_inputBotInlineMessageMediaGeo{Flags: 15, GeoPoint: TL_inputGeoPointEmpty{}, Heading: 3, Period: 4, ProximityNotificationRadius: 5, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_inputBotInlineMessageMediaGeo{Flags: 0, GeoPoint: TL_inputGeoPointEmpty{}},
+	TL_inputBotInlineMessageMediaVenue{Flags: 4, GeoPoint: TL_inputGeoPointEmpty{}, Title: "title", Address: "address", Provider: "provider", VenueID: "venue_id", VenueType: "venue_type", ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_inputBotInlineMessageMediaVenue{Flags: 0, GeoPoint: TL_inputGeoPointEmpty{}, Title: "title", Address: "address", Provider: "provider", VenueID: "venue_id", VenueType: "venue_type"},
+	TL_inputBotInlineMessageMediaContact{Flags: 4, PhoneNumber: "phone_number", FirstName: "first_name", LastName: "last_name", Vcard: "vcard", ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_inputBotInlineMessageMediaContact{Flags: 0, PhoneNumber: "phone_number", FirstName: "first_name", LastName: "last_name", Vcard: "vcard"},
+	TL_inputBotInlineMessageGame{Flags: 4, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_inputBotInlineMessageGame{Flags: 0},
+	TL_inputBotInlineResult{Flags: 62, ID: "id", Type: "type", Title: "title", Description: "description", Url: "url", Thumb: TL_inputWebDocument{Url: "url", Size: 2, MimeType: "mime_type", Attributes: []TL{}}, Content: TL_inputWebDocument{Url: "url", Size: 2, MimeType: "mime_type", Attributes: []TL{}}, SendMessage: TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_inputBotInlineResult{Flags: 0, ID: "id", Type: "type", SendMessage: TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_inputBotInlineResultPhoto{ID: "id", Type: "type", Photo: TL_inputPhotoEmpty{}, SendMessage: TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_inputBotInlineResultDocument{Flags: 6, ID: "id", Type: "type", Title: "title", Description: "description", Document: TL_inputDocumentEmpty{}, SendMessage: TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_inputBotInlineResultDocument{Flags: 0, ID: "id", Type: "type", Document: TL_inputDocumentEmpty{}, SendMessage: TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_inputBotInlineResultGame{ID: "id", ShortName: "short_name", SendMessage: TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_botInlineMessageMediaAuto{Flags: 6, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_botInlineMessageMediaAuto{Flags: 0, Message: "message"},
+	TL_botInlineMessageText{Flags: 7, NoWebpage: true, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_botInlineMessageText{Flags: 0, Message: "message"},
+	TL_botInlineMessageMediaGeo{Flags: 15, Geo: TL_geoPointEmpty{}, Heading: 3, Period: 4, ProximityNotificationRadius: 5, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_botInlineMessageMediaGeo{Flags: 0, Geo: TL_geoPointEmpty{}},
+	TL_botInlineMessageMediaVenue{Flags: 4, Geo: TL_geoPointEmpty{}, Title: "title", Address: "address", Provider: "provider", VenueID: "venue_id", VenueType: "venue_type", ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_botInlineMessageMediaVenue{Flags: 0, Geo: TL_geoPointEmpty{}, Title: "title", Address: "address", Provider: "provider", VenueID: "venue_id", VenueType: "venue_type"},
+	TL_botInlineMessageMediaContact{Flags: 4, PhoneNumber: "phone_number", FirstName: "first_name", LastName: "last_name", Vcard: "vcard", ReplyMarkup: TL_replyKeyboardHide{Flags: 0}},
+	TL_botInlineMessageMediaContact{Flags: 0, PhoneNumber: "phone_number", FirstName: "first_name", LastName: "last_name", Vcard: "vcard"},
+	TL_botInlineResult{Flags: 62, ID: "id", Type: "type", Title: "title", Description: "description", Url: "url", Thumb: TL_webDocument{Url: "url", AccessHash: 0x0102030405060700 + 1, Size: 3, MimeType: "mime_type", Attributes: []TL{}}, Content: TL_webDocument{Url: "url", AccessHash: 0x0102030405060700 + 1, Size: 3, MimeType: "mime_type", Attributes: []TL{}}, SendMessage: TL_botInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_botInlineResult{Flags: 0, ID: "id", Type: "type", SendMessage: TL_botInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_botInlineMediaResult{Flags: 15, ID: "id", Type: "type", Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}, Document: TL_documentEmpty{ID: 0x0102030405060700 + 0}, Title: "title", Description: "description", SendMessage: TL_botInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_botInlineMediaResult{Flags: 0, ID: "id", Type: "type", SendMessage: TL_botInlineMessageMediaAuto{Flags: 0, Message: "message"}},
+	TL_messages_botResults{Flags: 7, Gallery: true, QueryID: 0x0102030405060700 + 2, NextOffset: "next_offset", SwitchPm: TL_inlineBotSwitchPM{Text: "text", StartParam: "start_param"}, Results: []TL{TL_botInlineResult{Flags: 0, ID: "id", Type: "type", SendMessage: TL_botInlineMessageMediaAuto{Flags: 0, Message: "message"}}}, CacheTime: 7, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_botResults{Flags: 0, QueryID: 0x0102030405060700 + 2, Results: []TL{TL_botInlineResult{Flags: 0, ID: "id", Type: "type", SendMessage: TL_botInlineMessageMediaAuto{Flags: 0, Message: "message"}}}, CacheTime: 7, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_exportedMessageLink{Link: "link", Html: "html"},
+	TL_messageFwdHeader{Flags: 253, Imported: true, FromID: TL_peerUser{UserID: 1}, FromName: "from_name", Date: 5, ChannelPost: 6, PostAuthor: "post_author", SavedFromPeer: TL_peerUser{UserID: 1}, SavedFromMsgID: 9, PsaType: "psa_type"},
+	TL_messageFwdHeader{Flags: 0, Date: 5},
+	TL_auth_codeTypeSms{},
+	TL_auth_codeTypeCall{},
+	TL_auth_codeTypeFlashCall{},
+	TL_auth_sentCodeTypeApp{Length: 1},
+	TL_auth_sentCodeTypeSms{Length: 1},
+	TL_auth_sentCodeTypeCall{Length: 1},
+	TL_auth_sentCodeTypeFlashCall{Pattern: "pattern"},
+	TL_messages_botCallbackAnswer{Flags: 31, Alert: true, HasUrl: true, NativeUi: true, Message: "message", Url: "url", CacheTime: 7},
+	TL_messages_botCallbackAnswer{Flags: 0, CacheTime: 7},
+	TL_messages_messageEditData{Flags: 1, Caption: true},
+	TL_messages_messageEditData{Flags: 0},
+	TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2},
+	TL_inlineBotSwitchPM{Text: "text", StartParam: "start_param"},
+	TL_messages_peerDialogs{Dialogs: []TL{TL_dialog{Flags: 0, Peer: TL_peerUser{UserID: 1}, TopMessage: 5, ReadInboxMaxID: 6, ReadOutboxMaxID: 7, UnreadCount: 8, UnreadMentionsCount: 9, NotifySettings: TL_peerNotifySettings{Flags: 0}}}, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}, State: TL_updates_state{Pts: 1, Qts: 2, Date: 3, Seq: 4, UnreadCount: 5}},
+	TL_topPeer{Peer: TL_peerUser{UserID: 1}, Rating: 1.5},
+	TL_topPeerCategoryBotsPM{},
+	TL_topPeerCategoryBotsInline{},
+	TL_topPeerCategoryCorrespondents{},
+	TL_topPeerCategoryGroups{},
+	TL_topPeerCategoryChannels{},
+	TL_topPeerCategoryPhoneCalls{},
+	TL_topPeerCategoryForwardUsers{},
+	TL_topPeerCategoryForwardChats{},
+	TL_topPeerCategoryPeers{Category: TL_topPeerCategoryBotsPM{}, Count: 2, Peers: []TL{TL_topPeer{Peer: TL_peerUser{UserID: 1}, Rating: 1.5}}},
+	TL_contacts_topPeersNotModified{},
+	TL_contacts_topPeers{Categories: []TL{TL_topPeerCategoryPeers{Category: TL_topPeerCategoryBotsPM{}, Count: 2, Peers: []TL{}}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_contacts_topPeersDisabled{},
+	TL_draftMessageEmpty{Flags: 1, Date: 2},
+	TL_draftMessageEmpty{Flags: 0},
+	TL_draftMessage{Flags: 11, NoWebpage: true, ReplyToMsgID: 3, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, Date: 6},
+	TL_draftMessage{Flags: 0, Message: "message", Date: 6},
+	TL_messages_featuredStickersNotModified{Count: 1},
+	TL_messages_featuredStickers{Hash: 1, Count: 2, Sets: []TL{TL_stickerSetMultiCovered{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Covers: []TL{}}}, Unread: []int64{2}},
+	TL_messages_recentStickersNotModified{},
+	TL_messages_recentStickers{Hash: 1, Packs: []TL{TL_stickerPack{Emoticon: "emoticon", Documents: []int64{}}}, Stickers: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}, Dates: []int32{1}},
+	TL_messages_archivedStickers{Count: 1, Sets: []TL{TL_stickerSetMultiCovered{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Covers: []TL{}}}},
+	TL_messages_stickerSetInstallResultSuccess{},
+	TL_messages_stickerSetInstallResultArchive{Sets: []TL{TL_stickerSetMultiCovered{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Covers: []TL{}}}},
+	TL_stickerSetCovered{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Cover: TL_documentEmpty{ID: 0x0102030405060700 + 0}},
+	TL_stickerSetMultiCovered{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Covers: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}},
+	TL_maskCoords{N: 1, X: 1.5, Y: 1.5, Zoom: 1.5},
+	TL_inputStickeredMediaPhoto{ID: TL_inputPhotoEmpty{}},
+	TL_inputStickeredMediaDocument{ID: TL_inputDocumentEmpty{}},
+	TL_game{Flags: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2, ShortName: "short_name", Title: "title", Description: "description", Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}, Document: TL_documentEmpty{ID: 0x0102030405060700 + 0}},
+	TL_game{Flags: 0, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2, ShortName: "short_name", Title: "title", Description: "description", Photo: TL_photoEmpty{ID: 0x0102030405060700 + 0}},
+	TL_inputGameID{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_inputGameShortName{BotID: TL_inputUserEmpty{}, ShortName: "short_name"},
+	TL_highScore{Pos: 1, UserID: 2, Score: 3},
+	TL_messages_highScores{Scores: []TL{TL_highScore{Pos: 1, UserID: 2, Score: 3}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_textEmpty{},
+	TL_textPlain{Text: "text"},
+	TL_textBold{Text: TL_textEmpty{}},
+	TL_textItalic{Text: TL_textEmpty{}},
+	TL_textUnderline{Text: TL_textEmpty{}},
+	TL_textStrike{Text: TL_textEmpty{}},
+	TL_textFixed{Text: TL_textEmpty{}},
+	TL_textUrl{Text: TL_textEmpty{}, Url: "url", WebpageID: 0x0102030405060700 + 2},
+	TL_textEmail{Text: TL_textEmpty{}, Email: "email"},
+	TL_textConcat{Texts: []TL{TL_textEmpty{}}},
+	TL_textSubscript{Text: TL_textEmpty{}},
+	TL_textSuperscript{Text: TL_textEmpty{}},
+	TL_textMarked{Text: TL_textEmpty{}},
+	TL_textPhone{Text: TL_textEmpty{}, Phone: "phone"},
+	TL_textImage{DocumentID: 0x0102030405060700 + 0, W: 2, H: 3},
+	TL_textAnchor{Text: TL_textEmpty{}, Name: "name"},
+	TL_pageBlockUnsupported{},
+	TL_pageBlockTitle{Text: TL_textEmpty{}},
+	TL_pageBlockSubtitle{Text: TL_textEmpty{}},
+	TL_pageBlockAuthorDate{Author: TL_textEmpty{}, PublishedDate: 2},
+	TL_pageBlockHeader{Text: TL_textEmpty{}},
+	TL_pageBlockSubheader{Text: TL_textEmpty{}},
+	TL_pageBlockParagraph{Text: TL_textEmpty{}},
+	TL_pageBlockPreformatted{Text: TL_textEmpty{}, Language: "language"},
+	TL_pageBlockFooter{Text: TL_textEmpty{}},
+	TL_pageBlockDivider{},
+	TL_pageBlockAnchor{Name: "name"},
+	TL_pageBlockList{Items: []TL{TL_pageListItemBlocks{Blocks: []TL{}}}},
+	TL_pageBlockBlockquote{Text: TL_textEmpty{}, Caption: TL_textEmpty{}},
+	TL_pageBlockPullquote{Text: TL_textEmpty{}, Caption: TL_textEmpty{}},
+	TL_pageBlockPhoto{Flags: 1, PhotoID: 0x0102030405060700 + 1, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}, Url: "url", WebpageID: 0x0102030405060700 + 4},
+	TL_pageBlockPhoto{Flags: 0, PhotoID: 0x0102030405060700 + 1, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockVideo{Flags: 3, Autoplay: true, Loop: true, VideoID: 0x0102030405060700 + 3, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockVideo{Flags: 0, VideoID: 0x0102030405060700 + 3, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockCover{Cover: TL_pageBlockUnsupported{}},
+	TL_pageBlockEmbed{Flags: 63, FullWidth: true, AllowScrolling: true, Url: "url", Html: "html", PosterPhotoID: 0x0102030405060700 + 5, W: 7, H: 8, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockEmbed{Flags: 0, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockEmbedPost{Url: "url", WebpageID: 0x0102030405060700 + 1, AuthorPhotoID: 0x0102030405060700 + 2, Author: "author", Date: 5, Blocks: []TL{TL_pageBlockUnsupported{}}, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockCollage{Items: []TL{TL_pageBlockUnsupported{}}, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockSlideshow{Items: []TL{TL_pageBlockUnsupported{}}, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockChannel{Channel: TL_chatEmpty{ID: 1}},
+	TL_pageBlockAudio{AudioID: 0x0102030405060700 + 0, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_pageBlockKicker{Text: TL_textEmpty{}},
+	TL_pageBlockTable{Flags: 3, Bordered: true, Striped: true, Title: TL_textEmpty{}, Rows: []TL{TL_pageTableRow{Cells: []TL{}}}},
+	TL_pageBlockTable{Flags: 0, Title: TL_textEmpty{}, Rows: []TL{TL_pageTableRow{Cells: []TL{}}}},
+	TL_pageBlockOrderedList{Items: []TL{TL_pageListOrderedItemBlocks{Num: "num", Blocks: []TL{}}}},
+	TL_pageBlockDetails{Flags: 1, Open: true, Blocks: []TL{TL_pageBlockUnsupported{}}, Title: TL_textEmpty{}},
+	TL_pageBlockDetails{Flags: 0, Blocks: []TL{TL_pageBlockUnsupported{}}, Title: TL_textEmpty{}},
+	TL_pageBlockRelatedArticles{Title: TL_textEmpty{}, Articles: []TL{TL_pageRelatedArticle{Flags: 0, Url: "url", WebpageID: 0x0102030405060700 + 2}}},
+	TL_pageBlockMap{Geo: TL_geoPointEmpty{}, Zoom: 2, W: 3, H: 4, Caption: TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}}},
+	TL_phoneCallDiscardReasonMissed{},
+	TL_phoneCallDiscardReasonDisconnect{},
+	TL_phoneCallDiscardReasonHangup{},
+	TL_phoneCallDiscardReasonBusy{},
+	TL_dataJSON{Data: "data"},
+	TL_labeledPrice{Label: "label", Amount: 0x0102030405060700 + 1},
+	TL_invoice{Flags: 255, Test: true, NameRequested: true, PhoneRequested: true, EmailRequested: true, ShippingAddressRequested: true, Flexible: true, PhoneToProvider: true, EmailToProvider: true, Currency: "currency", Prices: []TL{TL_labeledPrice{Label: "label", Amount: 0x0102030405060700 + 1}}},
+	TL_invoice{Flags: 0, Currency: "currency", Prices: []TL{TL_labeledPrice{Label: "label", Amount: 0x0102030405060700 + 1}}},
+	TL_paymentCharge{ID: "id", ProviderChargeID: "provider_charge_id"},
+	TL_postAddress{StreetLine1: "street_line1", StreetLine2: "street_line2", City: "city", State: "state", CountryIso2: "country_iso2", PostCode: "post_code"},
+	TL_paymentRequestedInfo{Flags: 15, Name: "name", Phone: "phone", Email: "email", ShippingAddress: TL_postAddress{StreetLine1: "street_line1", StreetLine2: "street_line2", City: "city", State: "state", CountryIso2: "country_iso2", PostCode: "post_code"}},
+	TL_paymentRequestedInfo{Flags: 0},
+	TL_paymentSavedCredentialsCard{ID: "id", Title: "title"},
+	TL_webDocument{Url: "url", AccessHash: 0x0102030405060700 + 1, Size: 3, MimeType: "mime_type", Attributes: []TL{TL_documentAttributeImageSize{W: 1, H: 2}}},
+	TL_webDocumentNoProxy{Url: "url", Size: 2, MimeType: "mime_type", Attributes: []TL{TL_documentAttributeImageSize{W: 1, H: 2}}},
+	TL_inputWebDocument{Url: "url", Size: 2, MimeType: "mime_type", Attributes: []TL{TL_documentAttributeImageSize{W: 1, H: 2}}},
+	TL_inputWebFileLocation{Url: "url", AccessHash: 0x0102030405060700 + 1},
+	TL_inputWebFileGeoPointLocation{GeoPoint: TL_inputGeoPointEmpty{}, AccessHash: 0x0102030405060700 + 1, W: 3, H: 4, Zoom: 5, Scale: 6},
+	TL_upload_webFile{Size: 1, MimeType: "mime_type", FileType: TL_storage_fileUnknown{}, Mtime: 4, Bytes: []byte("bytes")},
+	TL_payments_paymentForm{Flags: 31, CanSaveCredentials: true, PasswordMissing: true, BotID: 4, Invoice: TL_invoice{Flags: 0, Currency: "currency", Prices: []TL{}}, ProviderID: 6, Url: "url", NativeProvider: "native_provider", NativeParams: TL_dataJSON{Data: "data"}, SavedInfo: TL_paymentRequestedInfo{Flags: 0}, SavedCredentials: TL_paymentSavedCredentialsCard{ID: "id", Title: "title"}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_payments_paymentForm{Flags: 0, BotID: 4, Invoice: TL_invoice{Flags: 0, Currency: "currency", Prices: []TL{}}, ProviderID: 6, Url: "url", Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_payments_validatedRequestedInfo{Flags: 3, ID: "id", ShippingOptions: []TL{TL_shippingOption{ID: "id", Title: "title", Prices: []TL{}}}},
+	TL_payments_validatedRequestedInfo{Flags: 0},
+	TL_payments_paymentResult{Updates: TL_updatesTooLong{}},
+	TL_payments_paymentVerificationNeeded{Url: "url"},
+	TL_payments_paymentReceipt{Flags: 3, Date: 2, BotID: 3, Invoice: TL_invoice{Flags: 0, Currency: "currency", Prices: []TL{}}, ProviderID: 5, Info: TL_paymentRequestedInfo{Flags: 0}, Shipping: TL_shippingOption{ID: "id", Title: "title", Prices: []TL{}}, Currency: "currency", TotalAmount: 0x0102030405060700 + 8, CredentialsTitle: "credentials_title", Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_payments_paymentReceipt{Flags: 0, Date: 2, BotID: 3, Invoice: TL_invoice{Flags: 0, Currency: "currency", Prices: []TL{}}, ProviderID: 5, Currency: "currency", TotalAmount: 0x0102030405060700 + 8, CredentialsTitle: "credentials_title", Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_payments_savedInfo{Flags: 3, HasSavedCredentials: true, SavedInfo: TL_paymentRequestedInfo{Flags: 0}},
+	TL_payments_savedInfo{Flags: 0},
+	TL_inputPaymentCredentialsSaved{ID: "id", TmpPassword: []byte("tmp_password")},
+	TL_inputPaymentCredentials{Flags: 1, Save: true, Data: TL_dataJSON{Data: "data"}},
+	TL_inputPaymentCredentials{Flags: 0, Data: TL_dataJSON{Data: "data"}},
+	TL_inputPaymentCredentialsApplePay{PaymentData: TL_dataJSON{Data: "data"}},
+	TL_inputPaymentCredentialsGooglePay{PaymentToken: TL_dataJSON{Data: "data"}},
+	TL_account_tmpPassword{TmpPassword: []byte("tmp_password"), ValidUntil: 2},
+	TL_shippingOption{ID: "id", Title: "title", Prices: []TL{TL_labeledPrice{Label: "label", Amount: 0x0102030405060700 + 1}}},
+	TL_inputStickerSetItem{Flags: 1, Document: TL_inputDocumentEmpty{}, Emoji: "emoji", MaskCoords: TL_maskCoords{N: 1, X: 1.5, Y: 1.5, Zoom: 1.5}},
+	TL_inputStickerSetItem{Flags: 0, Document: TL_inputDocumentEmpty{}, Emoji: "emoji"},
+	TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_phoneCallEmpty{ID: 0x0102030405060700 + 0},
+	TL_phoneCallWaiting{Flags: 65, Video: true, ID: 0x0102030405060700 + 2, AccessHash: 0x0102030405060700 + 3, Date: 5, AdminID: 6, ParticipantID: 7, Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}, ReceiveDate: 9},
+	TL_phoneCallWaiting{Flags: 0, ID: 0x0102030405060700 + 2, AccessHash: 0x0102030405060700 + 3, Date: 5, AdminID: 6, ParticipantID: 7, Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phoneCallRequested{Flags: 64, Video: true, ID: 0x0102030405060700 + 2, AccessHash: 0x0102030405060700 + 3, Date: 5, AdminID: 6, ParticipantID: 7, GAHash: []byte("g_a_hash"), Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phoneCallRequested{Flags: 0, ID: 0x0102030405060700 + 2, AccessHash: 0x0102030405060700 + 3, Date: 5, AdminID: 6, ParticipantID: 7, GAHash: []byte("g_a_hash"), Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phoneCallAccepted{Flags: 64, Video: true, ID: 0x0102030405060700 + 2, AccessHash: 0x0102030405060700 + 3, Date: 5, AdminID: 6, ParticipantID: 7, GB: []byte("g_b"), Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phoneCallAccepted{Flags: 0, ID: 0x0102030405060700 + 2, AccessHash: 0x0102030405060700 + 3, Date: 5, AdminID: 6, ParticipantID: 7, GB: []byte("g_b"), Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phoneCall{Flags: 96, P2pAllowed: true, Video: true, ID: 0x0102030405060700 + 3, AccessHash: 0x0102030405060700 + 4, Date: 6, AdminID: 7, ParticipantID: 8, GAOrB: []byte("g_a_or_b"), KeyFingerprint: 0x0102030405060700 + 9, Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}, Connections: []TL{TL_phoneConnection{ID: 0x0102030405060700 + 0, Ip: "ip", Ipv6: "ipv6", Port: 4, PeerTag: []byte("peer_tag")}}, StartDate: 13},
+	TL_phoneCall{Flags: 0, ID: 0x0102030405060700 + 3, AccessHash: 0x0102030405060700 + 4, Date: 6, AdminID: 7, ParticipantID: 8, GAOrB: []byte("g_a_or_b"), KeyFingerprint: 0x0102030405060700 + 9, Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}, Connections: []TL{TL_phoneConnection{ID: 0x0102030405060700 + 0, Ip: "ip", Ipv6: "ipv6", Port: 4, PeerTag: []byte("peer_tag")}}, StartDate: 13},
+	TL_phoneCallDiscarded{Flags: 79, NeedRating: true, NeedDebug: true, Video: true, ID: 0x0102030405060700 + 4, Reason: TL_phoneCallDiscardReasonMissed{}, Duration: 7},
+	TL_phoneCallDiscarded{Flags: 0, ID: 0x0102030405060700 + 4},
+	TL_phoneConnection{ID: 0x0102030405060700 + 0, Ip: "ip", Ipv6: "ipv6", Port: 4, PeerTag: []byte("peer_tag")},
+	TL_phoneConnectionWebrtc{Flags: 3, Turn: true, Stun: true, ID: 0x0102030405060700 + 3, Ip: "ip", Ipv6: "ipv6", Port: 7, Username: "username", Password: "password"},
+	TL_phoneConnectionWebrtc{Flags: 0, ID: 0x0102030405060700 + 3, Ip: "ip", Ipv6: "ipv6", Port: 7, Username: "username", Password: "password"},
+	TL_phoneCallProtocol{Flags: 3, UdpP2p: true, UdpReflector: true, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{"s"}},
+	TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{"s"}},
+	TL_phone_phoneCall{PhoneCall: TL_phoneCallEmpty{ID: 0x0102030405060700 + 0}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_upload_cdnFileReuploadNeeded{RequestToken: []byte("request_token")},
+	TL_upload_cdnFile{Bytes: []byte("bytes")},
+	TL_cdnPublicKey{DcID: 1, PublicKey: "public_key"},
+	TL_cdnConfig{PublicKeys: []TL{TL_cdnPublicKey{DcID: 1, PublicKey: "public_key"}}},
+	TL_langPackString{Key: "key", Value: "value"},
+	TL_langPackStringPluralized{Flags: 31, Key: "key", ZeroValue: "zero_value", OneValue: "one_value", TwoValue: "two_value", FewValue: "few_value", ManyValue: "many_value", OtherValue: "other_value"},
+	TL_langPackStringPluralized{Flags: 0, Key: "key", OtherValue: "other_value"},
+	TL_langPackStringDeleted{Key: "key"},
+	TL_langPackDifference{LangCode: "lang_code", FromVersion: 2, Version: 3, Strings: []TL{TL_langPackString{Key: "key", Value: "value"}}},
+	TL_langPackLanguage{Flags: 15, Official: true, Rtl: true, Beta: true, Name: "name", NativeName: "native_name", LangCode: "lang_code", BaseLangCode: "base_lang_code", PluralCode: "plural_code", StringsCount: 10, TranslatedCount: 11, TranslationsUrl: "translations_url"},
+	TL_langPackLanguage{Flags: 0, Name: "name", NativeName: "native_name", LangCode: "lang_code", PluralCode: "plural_code", StringsCount: 10, TranslatedCount: 11, TranslationsUrl: "translations_url"},
+	TL_channelAdminLogEventActionChangeTitle{PrevValue: "prev_value", NewValue: "new_value"},
+	TL_channelAdminLogEventActionChangeAbout{PrevValue: "prev_value", NewValue: "new_value"},
+	TL_channelAdminLogEventActionChangeUsername{PrevValue: "prev_value", NewValue: "new_value"},
+	TL_channelAdminLogEventActionChangePhoto{PrevPhoto: TL_photoEmpty{ID: 0x0102030405060700 + 0}, NewPhoto: TL_photoEmpty{ID: 0x0102030405060700 + 0}},
+	TL_channelAdminLogEventActionToggleInvites{NewValue: TL_boolFalse{}},
+	TL_channelAdminLogEventActionToggleSignatures{NewValue: TL_boolFalse{}},
+	TL_channelAdminLogEventActionUpdatePinned{Message: TL_messageEmpty{Flags: 0, ID: 2}},
+	TL_channelAdminLogEventActionEditMessage{PrevMessage: TL_messageEmpty{Flags: 0, ID: 2}, NewMessage: TL_messageEmpty{Flags: 0, ID: 2}},
+	TL_channelAdminLogEventActionDeleteMessage{Message: TL_messageEmpty{Flags: 0, ID: 2}},
+	TL_channelAdminLogEventActionParticipantJoin{},
+	TL_channelAdminLogEventActionParticipantLeave{},
+	TL_channelAdminLogEventActionParticipantInvite{Participant: TL_channelParticipant{UserID: 1, Date: 2}},
+	TL_channelAdminLogEventActionParticipantToggleBan{PrevParticipant: TL_channelParticipant{UserID: 1, Date: 2}, NewParticipant: TL_channelParticipant{UserID: 1, Date: 2}},
+	TL_channelAdminLogEventActionParticipantToggleAdmin{PrevParticipant: TL_channelParticipant{UserID: 1, Date: 2}, NewParticipant: TL_channelParticipant{UserID: 1, Date: 2}},
+	TL_channelAdminLogEventActionChangeStickerSet{PrevStickerset: TL_inputStickerSetEmpty{}, NewStickerset: TL_inputStickerSetEmpty{}},
+	TL_channelAdminLogEventActionTogglePreHistoryHidden{NewValue: TL_boolFalse{}},
+	TL_channelAdminLogEventActionDefaultBannedRights{PrevBannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}, NewBannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}},
+	TL_channelAdminLogEventActionStopPoll{Message: TL_messageEmpty{Flags: 0, ID: 2}},
+	TL_channelAdminLogEventActionChangeLinkedChat{PrevValue: 1, NewValue: 2},
+	TL_channelAdminLogEventActionChangeLocation{PrevValue: TL_channelLocationEmpty{}, NewValue: TL_channelLocationEmpty{}},
+	TL_channelAdminLogEventActionToggleSlowMode{PrevValue: 1, NewValue: 2},
+	TL_channelAdminLogEventActionStartGroupCall{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_channelAdminLogEventActionDiscardGroupCall{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_channelAdminLogEventActionParticipantMute{Participant: TL_groupCallParticipant{Flags: 0, Peer: TL_peerUser{UserID: 1}, Date: 12, Source: 14}},
+	TL_channelAdminLogEventActionParticipantUnmute{Participant: TL_groupCallParticipant{Flags: 0, Peer: TL_peerUser{UserID: 1}, Date: 12, Source: 14}},
+	TL_channelAdminLogEventActionToggleGroupCallSetting{JoinMuted: TL_boolFalse{}},
+	TL_channelAdminLogEventActionParticipantJoinByInvite{Invite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}},
+	TL_channelAdminLogEventActionExportedInviteDelete{Invite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}},
+	TL_channelAdminLogEventActionExportedInviteRevoke{Invite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}},
+	TL_channelAdminLogEventActionExportedInviteEdit{PrevInvite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}, NewInvite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}},
+	TL_channelAdminLogEventActionParticipantVolume{Participant: TL_groupCallParticipant{Flags: 0, Peer: TL_peerUser{UserID: 1}, Date: 12, Source: 14}},
+	TL_channelAdminLogEventActionChangeHistoryTTL{PrevValue: 1, NewValue: 2},
+	TL_channelAdminLogEvent{ID: 0x0102030405060700 + 0, Date: 2, UserID: 3, Action: TL_channelAdminLogEventActionChangeTitle{PrevValue: "prev_value", NewValue: "new_value"}},
+	TL_channels_adminLogResults{Events: []TL{TL_channelAdminLogEvent{ID: 0x0102030405060700 + 0, Date: 2, UserID: 3, Action: TL_channelAdminLogEventActionChangeTitle{PrevValue: "prev_value", NewValue: "new_value"}}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_channelAdminLogEventsFilter{Flags: 65535, Join: true, Leave: true, Invite: true, Ban: true, Unban: true, Kick: true, Unkick: true, Promote: true, Demote: true, Info: true, Settings: true, Pinned: true, Edit: true, Delete: true, GroupCall: true, Invites: true},
+	TL_channelAdminLogEventsFilter{Flags: 0},
+	TL_popularContact{ClientID: 0x0102030405060700 + 0, Importers: 2},
+	TL_messages_favedStickersNotModified{},
+	TL_messages_favedStickers{Hash: 1, Packs: []TL{TL_stickerPack{Emoticon: "emoticon", Documents: []int64{}}}, Stickers: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}},
+	TL_recentMeUrlUnknown{Url: "url"},
+	TL_recentMeUrlUser{Url: "url", UserID: 2},
+	TL_recentMeUrlChat{Url: "url", ChatID: 2},
+	TL_recentMeUrlChatInvite{Url: "url", ChatInvite: TL_chatInviteAlready{Chat: TL_chatEmpty{ID: 1}}},
+	TL_recentMeUrlStickerSet{Url: "url", Set: TL_stickerSetMultiCovered{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Covers: []TL{}}},
+	TL_help_recentMeUrls{Urls: []TL{TL_recentMeUrlUnknown{Url: "url"}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_inputSingleMedia{Flags: 1, Media: TL_inputMediaEmpty{}, RandomID: 0x0102030405060700 + 2, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_inputSingleMedia{Flags: 0, Media: TL_inputMediaEmpty{}, RandomID: 0x0102030405060700 + 2, Message: "message"},
+	TL_webAuthorization{Hash: 0x0102030405060700 + 0, BotID: 2, Domain: "domain", Browser: "browser", Platform: "platform", DateCreated: 6, DateActive: 7, Ip: "ip", Region: "region"},
+	TL_account_webAuthorizations{Authorizations: []TL{TL_webAuthorization{Hash: 0x0102030405060700 + 0, BotID: 2, Domain: "domain", Browser: "browser", Platform: "platform", DateCreated: 6, DateActive: 7, Ip: "ip", Region: "region"}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_inputMessageID{ID: 1},
+	TL_inputMessageReplyTo{ID: 1},
+	TL_inputMessagePinned{},
+	TL_inputMessageCallbackQuery{ID: 1, QueryID: 0x0102030405060700 + 1},
+	TL_inputDialogPeer{Peer: TL_inputPeerEmpty{}},
+	TL_inputDialogPeerFolder{FolderID: 1},
+	TL_dialogPeer{Peer: TL_peerUser{UserID: 1}},
+	TL_dialogPeerFolder{FolderID: 1},
+	TL_messages_foundStickerSetsNotModified{},
+	TL_messages_foundStickerSets{Hash: 1, Sets: []TL{TL_stickerSetMultiCovered{Set: TL_stickerSet{Flags: 0, ID: 0x0102030405060700 + 6, AccessHash: 0x0102030405060700 + 7, Title: "title", ShortName: "short_name", Count: 13, Hash: 14}, Covers: []TL{}}}},
+	TL_fileHash{Offset: 1, Limit: 2, Hash: []byte("hash")},
+	TL_inputClientProxy{Address: "address", Port: 2},
+	TL_help_termsOfServiceUpdateEmpty{Expires: 1},
+	TL_help_termsOfServiceUpdate{Expires: 1, TermsOfService: TL_help_termsOfService{Flags: 0, ID: TL_dataJSON{Data: "data"}, Text: "text", Entities: []TL{}}},
+	TL_inputSecureFileUploaded{ID: 0x0102030405060700 + 0, Parts: 2, Md5Checksum: "md5_checksum", FileHash: []byte("file_hash"), Secret: []byte("secret")},
+	TL_inputSecureFile{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_secureFileEmpty{},
+	TL_secureFile{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, Size: 3, DcID: 4, Date: 5, FileHash: []byte("file_hash"), Secret: []byte("secret")},
+	TL_secureData{Data: []byte("data"), DataHash: []byte("data_hash"), Secret: []byte("secret")},
+	TL_securePlainPhone{Phone: "phone"},
+	TL_securePlainEmail{Email: "email"},
+	TL_secureValueTypePersonalDetails{},
+	TL_secureValueTypePassport{},
+	TL_secureValueTypeDriverLicense{},
+	TL_secureValueTypeIdentityCard{},
+	TL_secureValueTypeInternalPassport{},
+	TL_secureValueTypeAddress{},
+	TL_secureValueTypeUtilityBill{},
+	TL_secureValueTypeBankStatement{},
+	TL_secureValueTypeRentalAgreement{},
+	TL_secureValueTypePassportRegistration{},
+	TL_secureValueTypeTemporaryRegistration{},
+	TL_secureValueTypePhone{},
+	TL_secureValueTypeEmail{},
+	TL_secureValue{Flags: 127, Type: TL_secureValueTypePersonalDetails{}, Data: TL_secureData{Data: []byte("data"), DataHash: []byte("data_hash"), Secret: []byte("secret")}, FrontSide: TL_secureFileEmpty{}, ReverseSide: TL_secureFileEmpty{}, Selfie: TL_secureFileEmpty{}, Translation: []TL{TL_secureFileEmpty{}}, Files: []TL{TL_secureFileEmpty{}}, PlainData: TL_securePlainPhone{Phone: "phone"}, Hash: []byte("hash")},
+	TL_secureValue{Flags: 0, Type: TL_secureValueTypePersonalDetails{}, Hash: []byte("hash")},
+	TL_inputSecureValue{Flags: 127, Type: TL_secureValueTypePersonalDetails{}, Data: TL_secureData{Data: []byte("data"), DataHash: []byte("data_hash"), Secret: []byte("secret")}, FrontSide: TL_inputSecureFileUploaded{ID: 0x0102030405060700 + 0, Parts: 2, Md5Checksum: "md5_checksum", FileHash: []byte("file_hash"), Secret: []byte("secret")}, ReverseSide: TL_inputSecureFileUploaded{ID: 0x0102030405060700 + 0, Parts: 2, Md5Checksum: "md5_checksum", FileHash: []byte("file_hash"), Secret: []byte("secret")}, Selfie: TL_inputSecureFileUploaded{ID: 0x0102030405060700 + 0, Parts: 2, Md5Checksum: "md5_checksum", FileHash: []byte("file_hash"), Secret: []byte("secret")}, Translation: []TL{TL_inputSecureFileUploaded{ID: 0x0102030405060700 + 0, Parts: 2, Md5Checksum: "md5_checksum", FileHash: []byte("file_hash"), Secret: []byte("secret")}}, Files: []TL{TL_inputSecureFileUploaded{ID: 0x0102030405060700 + 0, Parts: 2, Md5Checksum: "md5_checksum", FileHash: []byte("file_hash"), Secret: []byte("secret")}}, PlainData: TL_securePlainPhone{Phone: "phone"}},
+	TL_inputSecureValue{Flags: 0, Type: TL_secureValueTypePersonalDetails{}},
+	TL_secureValueHash{Type: TL_secureValueTypePersonalDetails{}, Hash: []byte("hash")},
+	TL_secureValueErrorData{Type: TL_secureValueTypePersonalDetails{}, DataHash: []byte("data_hash"), Field: "field", Text: "text"},
+	TL_secureValueErrorFrontSide{Type: TL_secureValueTypePersonalDetails{}, FileHash: []byte("file_hash"), Text: "text"},
+	TL_secureValueErrorReverseSide{Type: TL_secureValueTypePersonalDetails{}, FileHash: []byte("file_hash"), Text: "text"},
+	TL_secureValueErrorSelfie{Type: TL_secureValueTypePersonalDetails{}, FileHash: []byte("file_hash"), Text: "text"},
+	TL_secureValueErrorFile{Type: TL_secureValueTypePersonalDetails{}, FileHash: []byte("file_hash"), Text: "text"},
+	TL_secureValueErrorFiles{Type: TL_secureValueTypePersonalDetails{}, FileHash: [][]byte{[]byte("b")}, Text: "text"},
+	TL_secureValueError{Type: TL_secureValueTypePersonalDetails{}, Hash: []byte("hash"), Text: "text"},
+	TL_secureValueErrorTranslationFile{Type: TL_secureValueTypePersonalDetails{}, FileHash: []byte("file_hash"), Text: "text"},
+	TL_secureValueErrorTranslationFiles{Type: TL_secureValueTypePersonalDetails{}, FileHash: [][]byte{[]byte("b")}, Text: "text"},
+	TL_secureCredentialsEncrypted{Data: []byte("data"), Hash: []byte("hash"), Secret: []byte("secret")},
+	TL_account_authorizationForm{Flags: 1, RequiredTypes: []TL{TL_secureRequiredTypeOneOf{Types: []TL{}}}, Values: []TL{TL_secureValue{Flags: 0, Type: TL_secureValueTypePersonalDetails{}, Hash: []byte("hash")}}, Errors: []TL{TL_secureValueErrorData{Type: TL_secureValueTypePersonalDetails{}, DataHash: []byte("data_hash"), Field: "field", Text: "text"}}, Users: []TL{TL_userEmpty{ID: 1}}, PrivacyPolicyUrl: "privacy_policy_url"},
+	TL_account_authorizationForm{Flags: 0, RequiredTypes: []TL{TL_secureRequiredTypeOneOf{Types: []TL{}}}, Values: []TL{TL_secureValue{Flags: 0, Type: TL_secureValueTypePersonalDetails{}, Hash: []byte("hash")}}, Errors: []TL{TL_secureValueErrorData{Type: TL_secureValueTypePersonalDetails{}, DataHash: []byte("data_hash"), Field: "field", Text: "text"}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_account_sentEmailCode{EmailPattern: "email_pattern", Length: 2},
+	TL_help_deepLinkInfoEmpty{},
+	TL_help_deepLinkInfo{Flags: 3, UpdateApp: true, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_help_deepLinkInfo{Flags: 0, Message: "message"},
+	TL_savedPhoneContact{Phone: "phone", FirstName: "first_name", LastName: "last_name", Date: 4},
+	TL_account_takeout{ID: 0x0102030405060700 + 0},
+	TL_passwordKdfAlgoUnknown{},
+	TL_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow{Salt1: []byte("salt1"), Salt2: []byte("salt2"), G: 3, P: []byte("p")},
+	TL_securePasswordKdfAlgoUnknown{},
+	TL_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000{Salt: []byte("salt")},
+	TL_securePasswordKdfAlgoSHA512{Salt: []byte("salt")},
+	TL_secureSecretSettings{SecureAlgo: TL_securePasswordKdfAlgoUnknown{}, SecureSecret: []byte("secure_secret"), SecureSecretID: 0x0102030405060700 + 2},
+	TL_inputCheckPasswordEmpty{},
+	TL_inputCheckPasswordSRP{SrpID: 0x0102030405060700 + 0, A: []byte("A"), M1: []byte("M1")},
+	TL_secureRequiredType{Flags: 7, NativeNames: true, SelfieRequired: true, TranslationRequired: true, Type: TL_secureValueTypePersonalDetails{}},
+	TL_secureRequiredType{Flags: 0, Type: TL_secureValueTypePersonalDetails{}},
+	TL_secureRequiredTypeOneOf{Types: []TL{TL_secureRequiredTypeOneOf{Types: []TL{}}}},
+	TL_help_passportConfigNotModified{},
+	TL_help_passportConfig{Hash: 1, CountriesLangs: TL_dataJSON{Data: "data"}},
+	TL_inputAppEvent{Time: 1.5, Type: "type", Peer: 0x0102030405060700 + 2, Data: TL_jsonNull{}},
+	TL_jsonObjectValue{Key: "key", Value: TL_jsonNull{}},
+	TL_jsonNull{},
+	TL_jsonBool{Value: TL_boolFalse{}},
+	TL_jsonNumber{Value: 1.5},
+	TL_jsonString{Value: "value"},
+	TL_jsonArray{Value: []TL{TL_jsonNull{}}},
+	TL_jsonObject{Value: []TL{TL_jsonObjectValue{Key: "key", Value: TL_jsonNull{}}}},
+	TL_pageTableCell{Flags: 255, Header: true, AlignCenter: true, AlignRight: true, ValignMiddle: true, ValignBottom: true, Text: TL_textEmpty{}, Colspan: 8, Rowspan: 9},
+	TL_pageTableCell{Flags: 0},
+	TL_pageTableRow{Cells: []TL{TL_pageTableCell{Flags: 0}}},
+	TL_pageCaption{Text: TL_textEmpty{}, Credit: TL_textEmpty{}},
+	TL_pageListItemText{Text: TL_textEmpty{}},
+	TL_pageListItemBlocks{Blocks: []TL{TL_pageBlockUnsupported{}}},
+	TL_pageListOrderedItemText{Num: "num", Text: TL_textEmpty{}},
+	TL_pageListOrderedItemBlocks{Num: "num", Blocks: []TL{TL_pageBlockUnsupported{}}},
+	TL_pageRelatedArticle{Flags: 31, Url: "url", WebpageID: 0x0102030405060700 + 2, Title: "title", Description: "description", PhotoID: 0x0102030405060700 + 5, Author: "author", PublishedDate: 8},
+	TL_pageRelatedArticle{Flags: 0, Url: "url", WebpageID: 0x0102030405060700 + 2},
+	TL_page{Flags: 15, Part: true, Rtl: true, V2: true, Url: "url", Blocks: []TL{TL_pageBlockUnsupported{}}, Photos: []TL{TL_photoEmpty{ID: 0x0102030405060700 + 0}}, Documents: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}, Views: 9},
+	TL_page{Flags: 0, Url: "url", Blocks: []TL{TL_pageBlockUnsupported{}}, Photos: []TL{TL_photoEmpty{ID: 0x0102030405060700 + 0}}, Documents: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}},
+	TL_help_supportName{Name: "name"},
+	TL_help_userInfoEmpty{},
+	TL_help_userInfo{Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, Author: "author", Date: 4},
+	TL_pollAnswer{Text: "text", Option: []byte("option")},
+	TL_poll{ID: 0x0102030405060700 + 0, Flags: 63, Closed: true, PublicVoters: true, MultipleChoice: true, Quiz: true, Question: "question", Answers: []TL{TL_pollAnswer{Text: "text", Option: []byte("option")}}, ClosePeriod: 9, CloseDate: 10},
+	TL_poll{ID: 0x0102030405060700 + 0, Flags: 0, Question: "question", Answers: []TL{TL_pollAnswer{Text: "text", Option: []byte("option")}}},
+	TL_pollAnswerVoters{Flags: 3, Chosen: true, Correct: true, Option: []byte("option"), Voters: 5},
+	TL_pollAnswerVoters{Flags: 0, Option: []byte("option"), Voters: 5},
+	TL_pollResults{Flags: 31, Min: true, Results: []TL{TL_pollAnswerVoters{Flags: 0, Option: []byte("option"), Voters: 5}}, TotalVoters: 4, RecentVoters: []int32{1}, Solution: "solution", SolutionEntities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_pollResults{Flags: 0},
+	TL_chatOnlines{Onlines: 1},
+	TL_statsURL{Url: "url"},
+	TL_chatAdminRights{Flags: 7871, ChangeInfo: true, PostMessages: true, EditMessages: true, DeleteMessages: true, BanUsers: true, InviteUsers: true, PinMessages: true, AddAdmins: true, Anonymous: true, ManageCall: true, Other: true},
+	TL_chatAdminRights{Flags: 0},
+	TL_chatBannedRights{Flags: 165375, ViewMessages: true, SendMessages: true, SendMedia: true, SendStickers: true, SendGifs: true, SendGames: true, SendInline: true, EmbedLinks: true, SendPolls: true, ChangeInfo: true, InviteUsers: true, PinMessages: true, UntilDate: 14},
+	TL_chatBannedRights{Flags: 0, UntilDate: 14},
+	TL_inputWallPaper{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_inputWallPaperSlug{Slug: "slug"},
+	TL_inputWallPaperNoFile{},
+	TL_account_wallPapersNotModified{},
+	TL_account_wallPapers{Hash: 1, Wallpapers: []TL{TL_wallPaperNoFile{Flags: 0}}},
+	TL_codeSettings{Flags: 19, AllowFlashcall: true, CurrentNumber: true, AllowAppHash: true},
+	TL_codeSettings{Flags: 0},
+	TL_wallPaperSettings{Flags: 31, Blur: true, Motion: true, BackgroundColor: 4, SecondBackgroundColor: 5, Intensity: 6, Rotation: 7},
+	TL_wallPaperSettings{Flags: 0},
+	TL_autoDownloadSettings{Flags: 15, Disabled: true, VideoPreloadLarge: true, AudioPreloadNext: true, PhonecallsLessData: true, PhotoSizeMax: 6, VideoSizeMax: 7, FileSizeMax: 8, VideoUploadMaxbitrate: 9},
+	TL_autoDownloadSettings{Flags: 0, PhotoSizeMax: 6, VideoSizeMax: 7, FileSizeMax: 8, VideoUploadMaxbitrate: 9},
+	TL_account_autoDownloadSettings{Low: TL_autoDownloadSettings{Flags: 0, PhotoSizeMax: 6, VideoSizeMax: 7, FileSizeMax: 8, VideoUploadMaxbitrate: 9}, Medium: TL_autoDownloadSettings{Flags: 0, PhotoSizeMax: 6, VideoSizeMax: 7, FileSizeMax: 8, VideoUploadMaxbitrate: 9}, High: TL_autoDownloadSettings{Flags: 0, PhotoSizeMax: 6, VideoSizeMax: 7, FileSizeMax: 8, VideoUploadMaxbitrate: 9}},
+	TL_emojiKeyword{Keyword: "keyword", Emoticons: []string{"s"}},
+	TL_emojiKeywordDeleted{Keyword: "keyword", Emoticons: []string{"s"}},
+	TL_emojiKeywordsDifference{LangCode: "lang_code", FromVersion: 2, Version: 3, Keywords: []TL{TL_emojiKeyword{Keyword: "keyword", Emoticons: []string{}}}},
+	TL_emojiURL{Url: "url"},
+	TL_emojiLanguage{LangCode: "lang_code"},
+	TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2},
+	TL_folder{Flags: 15, AutofillNewBroadcasts: true, AutofillPublicGroups: true, AutofillNewCorrespondents: true, ID: 5, Title: "title", Photo: TL_chatPhotoEmpty{}},
+	TL_folder{Flags: 0, ID: 5, Title: "title"},
+	TL_inputFolderPeer{Peer: TL_inputPeerEmpty{}, FolderID: 2},
+	TL_folderPeer{Peer: TL_peerUser{UserID: 1}, FolderID: 2},
+	TL_messages_searchCounter{Flags: 2, Inexact: true, Filter: TL_inputMessagesFilterEmpty{}, Count: 4},
+	TL_messages_searchCounter{Flags: 0, Filter: TL_inputMessagesFilterEmpty{}, Count: 4},
+	TL_urlAuthResultRequest{Flags: 1, RequestWriteAccess: true, Bot: TL_userEmpty{ID: 1}, Domain: "domain"},
+	TL_urlAuthResultRequest{Flags: 0, Bot: TL_userEmpty{ID: 1}, Domain: "domain"},
+	TL_urlAuthResultAccepted{Url: "url"},
+	TL_urlAuthResultDefault{},
+	TL_channelLocationEmpty{},
+	TL_channelLocation{GeoPoint: TL_geoPointEmpty{}, Address: "address"},
+	TL_peerLocated{Peer: TL_peerUser{UserID: 1}, Expires: 2, Distance: 3},
+	TL_peerSelfLocated{Expires: 1},
+	TL_restrictionReason{Platform: "platform", Reason: "reason", Text: "text"},
+	TL_inputTheme{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_inputThemeSlug{Slug: "slug"},
+	TL_theme{Flags: 15, Creator: true, Default: true, ID: 0x0102030405060700 + 3, AccessHash: 0x0102030405060700 + 4, Slug: "slug", Title: "title", Document: TL_documentEmpty{ID: 0x0102030405060700 + 0}, Settings: TL_themeSettings{Flags: 0, BaseTheme: TL_baseThemeClassic{}, AccentColor: 3}, InstallsCount: 10},
+	TL_theme{Flags: 0, ID: 0x0102030405060700 + 3, AccessHash: 0x0102030405060700 + 4, Slug: "slug", Title: "title", InstallsCount: 10},
+	TL_account_themesNotModified{},
+	TL_account_themes{Hash: 1, Themes: []TL{TL_theme{Flags: 0, ID: 0x0102030405060700 + 3, AccessHash: 0x0102030405060700 + 4, Slug: "slug", Title: "title", InstallsCount: 10}}},
+	TL_auth_loginToken{Expires: 1, Token: []byte("token")},
+	TL_auth_loginTokenMigrateTo{DcID: 1, Token: []byte("token")},
+	TL_auth_loginTokenSuccess{Authorization: TL_auth_authorizationSignUpRequired{Flags: 0}},
+	TL_account_contentSettings{Flags: 3, SensitiveEnabled: true, SensitiveCanChange: true},
+	TL_account_contentSettings{Flags: 0},
+	TL_messages_inactiveChats{Dates: []int32{1}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_baseThemeClassic{},
+	TL_baseThemeDay{},
+	TL_baseThemeNight{},
+	TL_baseThemeTinted{},
+	TL_baseThemeArctic{},
+	TL_inputThemeSettings{Flags: 3, BaseTheme: TL_baseThemeClassic{}, AccentColor: 3, MessageTopColor: 4, MessageBottomColor: 5, Wallpaper: TL_inputWallPaper{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, WallpaperSettings: TL_wallPaperSettings{Flags: 0}},
+	TL_inputThemeSettings{Flags: 0, BaseTheme: TL_baseThemeClassic{}, AccentColor: 3},
+	TL_themeSettings{Flags: 3, BaseTheme: TL_baseThemeClassic{}, AccentColor: 3, MessageTopColor: 4, MessageBottomColor: 5, Wallpaper: TL_wallPaperNoFile{Flags: 0}},
+	TL_themeSettings{Flags: 0, BaseTheme: TL_baseThemeClassic{}, AccentColor: 3},
+	TL_webPageAttributeTheme{Flags: 3, Documents: []TL{TL_documentEmpty{ID: 0x0102030405060700 + 0}}, Settings: TL_themeSettings{Flags: 0, BaseTheme: TL_baseThemeClassic{}, AccentColor: 3}},
+	TL_webPageAttributeTheme{Flags: 0},
+	TL_messageUserVote{UserID: 1, Option: []byte("option"), Date: 3},
+	TL_messageUserVoteInputOption{UserID: 1, Date: 2},
+	TL_messageUserVoteMultiple{UserID: 1, Options: [][]byte{[]byte("b")}, Date: 3},
+	TL_messages_votesList{Flags: 1, Count: 2, Votes: []TL{TL_messageUserVote{UserID: 1, Option: []byte("option"), Date: 3}}, Users: []TL{TL_userEmpty{ID: 1}}, NextOffset: "next_offset"},
+	TL_messages_votesList{Flags: 0, Count: 2, Votes: []TL{TL_messageUserVote{UserID: 1, Option: []byte("option"), Date: 3}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_bankCardOpenUrl{Url: "url", Name: "name"},
+	TL_payments_bankCardData{Title: "title", OpenUrls: []TL{TL_bankCardOpenUrl{Url: "url", Name: "name"}}},
+	TL_dialogFilter{Flags: 33568799, Contacts: true, NonContacts: true, Groups: true, Broadcasts: true, Bots: true, ExcludeMuted: true, ExcludeRead: true, ExcludeArchived: true, ID: 10, Title: "title", Emoticon: "emoticon", PinnedPeers: []TL{TL_inputPeerEmpty{}}, IncludePeers: []TL{TL_inputPeerEmpty{}}, ExcludePeers: []TL{TL_inputPeerEmpty{}}},
+	TL_dialogFilter{Flags: 0, ID: 10, Title: "title", PinnedPeers: []TL{TL_inputPeerEmpty{}}, IncludePeers: []TL{TL_inputPeerEmpty{}}, ExcludePeers: []TL{TL_inputPeerEmpty{}}},
+	TL_dialogFilterSuggested{Filter: TL_dialogFilter{Flags: 0, ID: 10, Title: "title", PinnedPeers: []TL{}, IncludePeers: []TL{}, ExcludePeers: []TL{}}, Description: "description"},
+	TL_statsDateRangeDays{MinDate: 1, MaxDate: 2},
+	TL_statsAbsValueAndPrev{Current: 1.5, Previous: 1.5},
+	TL_statsPercentValue{Part: 1.5, Total: 1.5},
+	TL_statsGraphAsync{Token: "token"},
+	TL_statsGraphError{Error: "error"},
+	TL_statsGraph{Flags: 1, Json: TL_dataJSON{Data: "data"}, ZoomToken: "zoom_token"},
+	TL_statsGraph{Flags: 0, Json: TL_dataJSON{Data: "data"}},
+	TL_messageInteractionCounters{MsgID: 1, Views: 2, Forwards: 3},
+	TL_stats_broadcastStats{Period: TL_statsDateRangeDays{MinDate: 1, MaxDate: 2}, Followers: TL_statsAbsValueAndPrev{Current: 1.5, Previous: 1.5}, ViewsPerPost: TL_statsAbsValueAndPrev{Current: 1.5, Previous: 1.5}, SharesPerPost: TL_statsAbsValueAndPrev{Current: 1.5, Previous: 1.5}, EnabledNotifications: TL_statsPercentValue{Part: 1.5, Total: 1.5}, GrowthGraph: TL_statsGraphAsync{Token: "token"}, FollowersGraph: TL_statsGraphAsync{Token: "token"}, MuteGraph: TL_statsGraphAsync{Token: "token"}, TopHoursGraph: TL_statsGraphAsync{Token: "token"}, InteractionsGraph: TL_statsGraphAsync{Token: "token"}, IvInteractionsGraph: TL_statsGraphAsync{Token: "token"}, ViewsBySourceGraph: TL_statsGraphAsync{Token: "token"}, NewFollowersBySourceGraph: TL_statsGraphAsync{Token: "token"}, LanguagesGraph: TL_statsGraphAsync{Token: "token"}, RecentMessageInteractions: []TL{TL_messageInteractionCounters{MsgID: 1, Views: 2, Forwards: 3}}},
+	TL_help_promoDataEmpty{Expires: 1},
+	TL_help_promoData{Flags: 7, Proxy: true, Expires: 3, Peer: TL_peerUser{UserID: 1}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}, PsaType: "psa_type", PsaMessage: "psa_message"},
+	TL_help_promoData{Flags: 0, Expires: 3, Peer: TL_peerUser{UserID: 1}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_videoSize{Flags: 1, Type: "type", Location: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, W: 4, H: 5, Size: 6, VideoStartTs: 1.5},
+	TL_videoSize{Flags: 0, Type: "type", Location: TL_fileLocationToBeDeprecated{VolumeID: 0x0102030405060700 + 0, LocalID: 2}, W: 4, H: 5, Size: 6},
+	TL_statsGroupTopPoster{UserID: 1, Messages: 2, AvgChars: 3},
+	TL_statsGroupTopAdmin{UserID: 1, Deleted: 2, Kicked: 3, Banned: 4},
+	TL_statsGroupTopInviter{UserID: 1, Invitations: 2},
+	TL_stats_megagroupStats{Period: TL_statsDateRangeDays{MinDate: 1, MaxDate: 2}, Members: TL_statsAbsValueAndPrev{Current: 1.5, Previous: 1.5}, Messages: TL_statsAbsValueAndPrev{Current: 1.5, Previous: 1.5}, Viewers: TL_statsAbsValueAndPrev{Current: 1.5, Previous: 1.5}, Posters: TL_statsAbsValueAndPrev{Current: 1.5, Previous: 1.5}, GrowthGraph: TL_statsGraphAsync{Token: "token"}, MembersGraph: TL_statsGraphAsync{Token: "token"}, NewMembersBySourceGraph: TL_statsGraphAsync{Token: "token"}, LanguagesGraph: TL_statsGraphAsync{Token: "token"}, MessagesGraph: TL_statsGraphAsync{Token: "token"}, ActionsGraph: TL_statsGraphAsync{Token: "token"}, TopHoursGraph: TL_statsGraphAsync{Token: "token"}, WeekdaysGraph: TL_statsGraphAsync{Token: "token"}, TopPosters: []TL{TL_statsGroupTopPoster{UserID: 1, Messages: 2, AvgChars: 3}}, TopAdmins: []TL{TL_statsGroupTopAdmin{UserID: 1, Deleted: 2, Kicked: 3, Banned: 4}}, TopInviters: []TL{TL_statsGroupTopInviter{UserID: 1, Invitations: 2}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_globalPrivacySettings{Flags: 1, ArchiveAndMuteNewNoncontactPeers: TL_boolFalse{}},
+	TL_globalPrivacySettings{Flags: 0},
+	TL_help_countryCode{Flags: 3, CountryCode: "country_code", Prefixes: []string{"s"}, Patterns: []string{"s"}},
+	TL_help_countryCode{Flags: 0, CountryCode: "country_code"},
+	TL_help_country{Flags: 3, Hidden: true, Iso2: "iso2", DefaultName: "default_name", Name: "name", CountryCodes: []TL{TL_help_countryCode{Flags: 0, CountryCode: "country_code"}}},
+	TL_help_country{Flags: 0, Iso2: "iso2", DefaultName: "default_name", CountryCodes: []TL{TL_help_countryCode{Flags: 0, CountryCode: "country_code"}}},
+	TL_help_countriesListNotModified{},
+	TL_help_countriesList{Countries: []TL{TL_help_country{Flags: 0, Iso2: "iso2", DefaultName: "default_name", CountryCodes: []TL{}}}, Hash: 2},
+	TL_messageViews{Flags: 7, Views: 2, Forwards: 3, Replies: TL_messageReplies{Flags: 0, Replies: 3, RepliesPts: 4}},
+	TL_messageViews{Flags: 0},
+	TL_messages_messageViews{Views: []TL{TL_messageViews{Flags: 0}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_discussionMessage{Flags: 7, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, MaxID: 3, ReadInboxMaxID: 4, ReadOutboxMaxID: 5, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_discussionMessage{Flags: 0, Messages: []TL{TL_messageEmpty{Flags: 0, ID: 2}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messageReplyHeader{Flags: 3, ReplyToMsgID: 2, ReplyToPeerID: TL_peerUser{UserID: 1}, ReplyToTopID: 4},
+	TL_messageReplyHeader{Flags: 0, ReplyToMsgID: 2},
+	TL_messageReplies{Flags: 15, Comments: true, Replies: 3, RepliesPts: 4, RecentRepliers: []TL{TL_peerUser{UserID: 1}}, ChannelID: 6, MaxID: 7, ReadMaxID: 8},
+	TL_messageReplies{Flags: 0, Replies: 3, RepliesPts: 4},
+	TL_peerBlocked{PeerID: TL_peerUser{UserID: 1}, Date: 2},
+	TL_stats_messageStats{ViewsGraph: TL_statsGraphAsync{Token: "token"}},
+	TL_groupCallDiscarded{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, Duration: 3},
+	TL_groupCall{Flags: 127, JoinMuted: true, CanChangeJoinMuted: true, JoinDateAsc: true, ID: 0x0102030405060700 + 4, AccessHash: 0x0102030405060700 + 5, ParticipantsCount: 7, Params: TL_dataJSON{Data: "data"}, Title: "title", StreamDcID: 10, RecordStartDate: 11, Version: 12},
+	TL_groupCall{Flags: 0, ID: 0x0102030405060700 + 4, AccessHash: 0x0102030405060700 + 5, ParticipantsCount: 7, Version: 12},
+	TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1},
+	TL_groupCallParticipant{Flags: 16319, Muted: true, Left: true, CanSelfUnmute: true, JustJoined: true, Versioned: true, Min: true, MutedByYou: true, VolumeByAdmin: true, Self: true, Peer: TL_peerUser{UserID: 1}, Date: 12, ActiveDate: 13, Source: 14, Volume: 15, About: "about", RaiseHandRating: 0x0102030405060700 + 16},
+	TL_groupCallParticipant{Flags: 0, Peer: TL_peerUser{UserID: 1}, Date: 12, Source: 14},
+	TL_phone_groupCall{Call: TL_groupCallDiscarded{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1, Duration: 3}, Participants: []TL{TL_groupCallParticipant{Flags: 0, Peer: TL_peerUser{UserID: 1}, Date: 12, Source: 14}}, ParticipantsNextOffset: "participants_next_offset", Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_phone_groupParticipants{Count: 1, Participants: []TL{TL_groupCallParticipant{Flags: 0, Peer: TL_peerUser{UserID: 1}, Date: 12, Source: 14}}, NextOffset: "next_offset", Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}, Version: 6},
+	TL_inlineQueryPeerTypeSameBotPM{},
+	TL_inlineQueryPeerTypePM{},
+	TL_inlineQueryPeerTypeChat{},
+	TL_inlineQueryPeerTypeMegagroup{},
+	TL_inlineQueryPeerTypeBroadcast{},
+	TL_messages_historyImport{ID: 0x0102030405060700 + 0},
+	TL_messages_historyImportParsed{Flags: 7, Pm: true, Group: true, Title: "title"},
+	TL_messages_historyImportParsed{Flags: 0},
+	TL_messages_affectedFoundMessages{Pts: 1, PtsCount: 2, Offset: 3, Messages: []int32{1}},
+	TL_chatInviteImporter{UserID: 1, Date: 2},
+	TL_messages_exportedChatInvites{Count: 1, Invites: []TL{TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_exportedChatInvite{Invite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_exportedChatInviteReplaced{Invite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}, NewInvite: TL_chatInviteExported{Flags: 0, Link: "link", AdminID: 5, Date: 6}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_chatInviteImporters{Count: 1, Importers: []TL{TL_chatInviteImporter{UserID: 1, Date: 2}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_chatAdminWithInvites{AdminID: 1, InvitesCount: 2, RevokedInvitesCount: 3},
+	TL_messages_chatAdminsWithInvites{Admins: []TL{TL_chatAdminWithInvites{AdminID: 1, InvitesCount: 2, RevokedInvitesCount: 3}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_messages_checkedHistoryImportPeer{ConfirmText: "confirm_text"},
+	TL_phone_joinAsPeers{Peers: []TL{TL_peerUser{UserID: 1}}, Chats: []TL{TL_chatEmpty{ID: 1}}, Users: []TL{TL_userEmpty{ID: 1}}},
+	TL_phone_exportedGroupCallInvite{Link: "link"},
+	TL_invokeAfterMsg{MsgID: 0x0102030405060700 + 0, Query: TL_boolTrue{}},
+	TL_invokeAfterMsgs{MsgIds: []int64{2}, Query: TL_boolTrue{}},
+	TL_initConnection{Flags: 3, ApiID: 2, DeviceModel: "device_model", SystemVersion: "system_version", AppVersion: "app_version", SystemLangCode: "system_lang_code", LangPack: "lang_pack", LangCode: "lang_code", Proxy: TL_inputClientProxy{Address: "address", Port: 2}, Params: TL_jsonNull{}, Query: TL_boolTrue{}},
+	TL_initConnection{Flags: 0, ApiID: 2, DeviceModel: "device_model", SystemVersion: "system_version", AppVersion: "app_version", SystemLangCode: "system_lang_code", LangPack: "lang_pack", LangCode: "lang_code", Query: TL_boolTrue{}},
+	TL_invokeWithLayer{Layer: 1, Query: TL_boolTrue{}},
+	TL_invokeWithoutUpdates{Query: TL_boolTrue{}},
+	TL_invokeWithMessagesRange{Range: TL_messageRange{MinID: 1, MaxID: 2}, Query: TL_boolTrue{}},
+	TL_invokeWithTakeout{TakeoutID: 0x0102030405060700 + 0, Query: TL_boolTrue{}},
+	TL_auth_sendCode{PhoneNumber: "phone_number", ApiID: 2, ApiHash: "api_hash", Settings: TL_codeSettings{Flags: 0}},
+	TL_auth_signUp{PhoneNumber: "phone_number", PhoneCodeHash: "phone_code_hash", FirstName: "first_name", LastName: "last_name"},
+	TL_auth_signIn{PhoneNumber: "phone_number", PhoneCodeHash: "phone_code_hash", PhoneCode: "phone_code"},
+	TL_auth_logOut{},
+	TL_auth_resetAuthorizations{},
+	TL_auth_exportAuthorization{DcID: 1},
+	TL_auth_importAuthorization{ID: 1, Bytes: []byte("bytes")},
+	TL_auth_bindTempAuthKey{PermAuthKeyID: 0x0102030405060700 + 0, Nonce: 0x0102030405060700 + 1, ExpiresAt: 3, EncryptedMessage: []byte("encrypted_message")},
+	TL_auth_importBotAuthorization{Flags: 1, ApiID: 2, ApiHash: "api_hash", BotAuthToken: "bot_auth_token"},
+	TL_auth_checkPassword{Password: TL_inputCheckPasswordEmpty{}},
+	TL_auth_requestPasswordRecovery{},
+	TL_auth_recoverPassword{Code: "code"},
+	TL_auth_resendCode{PhoneNumber: "phone_number", PhoneCodeHash: "phone_code_hash"},
+	TL_auth_cancelCode{PhoneNumber: "phone_number", PhoneCodeHash: "phone_code_hash"},
+	TL_auth_dropTempAuthKeys{ExceptAuthKeys: []int64{2}},
+	TL_auth_exportLoginToken{ApiID: 1, ApiHash: "api_hash", ExceptIds: []int32{1}},
+	TL_auth_importLoginToken{Token: []byte("token")},
+	TL_auth_acceptLoginToken{Token: []byte("token")},
+	TL_account_registerDevice{Flags: 1, NoMuted: true, TokenType: 3, Token: "token", AppSandbox: TL_boolFalse{}, Secret: []byte("secret"), OtherUids: []int32{1}},
+	TL_account_registerDevice{Flags: 0, TokenType: 3, Token: "token", AppSandbox: TL_boolFalse{}, Secret: []byte("secret"), OtherUids: []int32{1}},
+	TL_account_unregisterDevice{TokenType: 1, Token: "token", OtherUids: []int32{1}},
+	TL_account_updateNotifySettings{Peer: TL_inputNotifyUsers{}, Settings: TL_inputPeerNotifySettings{Flags: 0}},
+	TL_account_getNotifySettings{Peer: TL_inputNotifyUsers{}},
+	TL_account_resetNotifySettings{},
+	TL_account_updateProfile{Flags: 7, FirstName: "first_name", LastName: "last_name", About: "about"},
+	TL_account_updateProfile{Flags: 0},
+	TL_account_updateStatus{Offline: TL_boolFalse{}},
+	TL_account_getWallPapers{Hash: 1},
+	TL_account_reportPeer{Peer: TL_inputPeerEmpty{}, Reason: TL_inputReportReasonSpam{}, Message: "message"},
+	TL_account_checkUsername{Username: "username"},
+	TL_account_updateUsername{Username: "username"},
+	TL_account_getPrivacy{Key: TL_inputPrivacyKeyStatusTimestamp{}},
+	TL_account_setPrivacy{Key: TL_inputPrivacyKeyStatusTimestamp{}, Rules: []TL{TL_inputPrivacyValueAllowContacts{}}},
+	TL_account_deleteAccount{Reason: "reason"},
+	TL_account_getAccountTTL{},
+	TL_account_setAccountTTL{Ttl: TL_accountDaysTTL{Days: 1}},
+	TL_account_sendChangePhoneCode{PhoneNumber: "phone_number", Settings: TL_codeSettings{Flags: 0}},
+	TL_account_changePhone{PhoneNumber: "phone_number", PhoneCodeHash: "phone_code_hash", PhoneCode: "phone_code"},
+	TL_account_updateDeviceLocked{Period: 1},
+	TL_account_getAuthorizations{},
+	TL_account_resetAuthorization{Hash: 0x0102030405060700 + 0},
+	TL_account_getPassword{},
+	TL_account_getPasswordSettings{Password: TL_inputCheckPasswordEmpty{}},
+	TL_account_updatePasswordSettings{Password: TL_inputCheckPasswordEmpty{}, NewSettings: TL_account_passwordInputSettings{Flags: 0}},
+	TL_account_sendConfirmPhoneCode{Hash: "hash", Settings: TL_codeSettings{Flags: 0}},
+	TL_account_confirmPhone{PhoneCodeHash: "phone_code_hash", PhoneCode: "phone_code"},
+	TL_account_getTmpPassword{Password: TL_inputCheckPasswordEmpty{}, Period: 2},
+	TL_account_getWebAuthorizations{},
+	TL_account_resetWebAuthorization{Hash: 0x0102030405060700 + 0},
+	TL_account_resetWebAuthorizations{},
+	TL_account_getAllSecureValues{},
+	TL_account_getSecureValue{Types: []TL{TL_secureValueTypePersonalDetails{}}},
+	TL_account_saveSecureValue{Value: TL_inputSecureValue{Flags: 0, Type: TL_secureValueTypePersonalDetails{}}, SecureSecretID: 0x0102030405060700 + 1},
+	TL_account_deleteSecureValue{Types: []TL{TL_secureValueTypePersonalDetails{}}},
+	TL_account_getAuthorizationForm{BotID: 1, Scope: "scope", PublicKey: "public_key"},
+	TL_account_acceptAuthorization{BotID: 1, Scope: "scope", PublicKey: "public_key", ValueHashes: []TL{TL_secureValueHash{Type: TL_secureValueTypePersonalDetails{}, Hash: []byte("hash")}}, Credentials: TL_secureCredentialsEncrypted{Data: []byte("data"), Hash: []byte("hash"), Secret: []byte("secret")}},
+	TL_account_sendVerifyPhoneCode{PhoneNumber: "phone_number", Settings: TL_codeSettings{Flags: 0}},
+	TL_account_verifyPhone{PhoneNumber: "phone_number", PhoneCodeHash: "phone_code_hash", PhoneCode: "phone_code"},
+	TL_account_sendVerifyEmailCode{Email: "email"},
+	TL_account_verifyEmail{Email: "email", Code: "code"},
+	TL_account_initTakeoutSession{Flags: 63, Contacts: true, MessageUsers: true, MessageChats: true, MessageMegagroups: true, MessageChannels: true, Files: true, FileMaxSize: 8},
+	TL_account_initTakeoutSession{Flags: 0},
+	TL_account_finishTakeoutSession{Flags: 1, Success: true},
+	TL_account_finishTakeoutSession{Flags: 0},
+	TL_account_confirmPasswordEmail{Code: "code"},
+	TL_account_resendPasswordEmail{},
+	TL_account_cancelPasswordEmail{},
+	TL_account_getContactSignUpNotification{},
+	TL_account_setContactSignUpNotification{Silent: TL_boolFalse{}},
+	TL_account_getNotifyExceptions{Flags: 3, CompareSound: true, Peer: TL_inputNotifyUsers{}},
+	TL_account_getNotifyExceptions{Flags: 0},
+	TL_account_getWallPaper{Wallpaper: TL_inputWallPaper{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_account_uploadWallPaper{File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, MimeType: "mime_type", Settings: TL_wallPaperSettings{Flags: 0}},
+	TL_account_saveWallPaper{Wallpaper: TL_inputWallPaper{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Unsave: TL_boolFalse{}, Settings: TL_wallPaperSettings{Flags: 0}},
+	TL_account_installWallPaper{Wallpaper: TL_inputWallPaper{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Settings: TL_wallPaperSettings{Flags: 0}},
+	TL_account_resetWallPapers{},
+	TL_account_getAutoDownloadSettings{},
+	TL_account_saveAutoDownloadSettings{Flags: 3, Low: true, High: true, Settings: TL_autoDownloadSettings{Flags: 0, PhotoSizeMax: 6, VideoSizeMax: 7, FileSizeMax: 8, VideoUploadMaxbitrate: 9}},
+	TL_account_saveAutoDownloadSettings{Flags: 0, Settings: TL_autoDownloadSettings{Flags: 0, PhotoSizeMax: 6, VideoSizeMax: 7, FileSizeMax: 8, VideoUploadMaxbitrate: 9}},
+	TL_account_uploadTheme{Flags: 1, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, Thumb: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, FileName: "file_name", MimeType: "mime_type"},
+	TL_account_uploadTheme{Flags: 0, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, FileName: "file_name", MimeType: "mime_type"},
+	TL_account_createTheme{Flags: 12, Slug: "slug", Title: "title", Document: TL_inputDocumentEmpty{}, Settings: TL_inputThemeSettings{Flags: 0, BaseTheme: TL_baseThemeClassic{}, AccentColor: 3}},
+	TL_account_createTheme{Flags: 0, Slug: "slug", Title: "title"},
+	TL_account_updateTheme{Flags: 15, Format: "format", Theme: TL_inputTheme{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Slug: "slug", Title: "title", Document: TL_inputDocumentEmpty{}, Settings: TL_inputThemeSettings{Flags: 0, BaseTheme: TL_baseThemeClassic{}, AccentColor: 3}},
+	TL_account_updateTheme{Flags: 0, Format: "format", Theme: TL_inputTheme{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_account_saveTheme{Theme: TL_inputTheme{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Unsave: TL_boolFalse{}},
+	TL_account_installTheme{Flags: 3, Dark: true, Format: "format", Theme: TL_inputTheme{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_account_installTheme{Flags: 0},
+	TL_account_getTheme{Format: "format", Theme: TL_inputTheme{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, DocumentID: 0x0102030405060700 + 2},
+	TL_account_getThemes{Format: "format", Hash: 2},
+	TL_account_setContentSettings{Flags: 1, SensitiveEnabled: true},
+	TL_account_setContentSettings{Flags: 0},
+	TL_account_getContentSettings{},
+	TL_account_getMultiWallPapers{Wallpapers: []TL{TL_inputWallPaper{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}}},
+	TL_account_getGlobalPrivacySettings{},
+	TL_account_setGlobalPrivacySettings{Settings: TL_globalPrivacySettings{Flags: 0}},
+	TL_account_reportProfilePhoto{Peer: TL_inputPeerEmpty{}, PhotoID: TL_inputPhotoEmpty{}, Reason: TL_inputReportReasonSpam{}, Message: "message"},
+	TL_users_getUsers{ID: []TL{TL_inputUserEmpty{}}},
+	TL_users_getFullUser{ID: TL_inputUserEmpty{}},
+	TL_users_setSecureValueErrors{ID: TL_inputUserEmpty{}, Errors: []TL{TL_secureValueErrorData{Type: TL_secureValueTypePersonalDetails{}, DataHash: []byte("data_hash"), Field: "field", Text: "text"}}},
+	TL_contacts_getContactIDs{Hash: 1},
+	TL_contacts_getStatuses{},
+	TL_contacts_getContacts{Hash: 1},
+	TL_contacts_importContacts{Contacts: []TL{TL_inputPhoneContact{ClientID: 0x0102030405060700 + 0, Phone: "phone", FirstName: "first_name", LastName: "last_name"}}},
+	TL_contacts_deleteContacts{ID: []TL{TL_inputUserEmpty{}}},
+	TL_contacts_deleteByPhones{Phones: []string{"s"}},
+	TL_contacts_block{ID: TL_inputPeerEmpty{}},
+	TL_contacts_unblock{ID: TL_inputPeerEmpty{}},
+	TL_contacts_getBlocked{Offset: 1, Limit: 2},
+	TL_contacts_search{Q: "q", Limit: 2},
+	TL_contacts_resolveUsername{Username: "username"},
+	TL_contacts_getTopPeers{Flags: 33855, Correspondents: true, BotsPm: true, BotsInline: true, PhoneCalls: true, ForwardUsers: true, ForwardChats: true, Groups: true, Channels: true, Offset: 10, Limit: 11, Hash: 12},
+	TL_contacts_getTopPeers{Flags: 0, Offset: 10, Limit: 11, Hash: 12},
+	TL_contacts_resetTopPeerRating{Category: TL_topPeerCategoryBotsPM{}, Peer: TL_inputPeerEmpty{}},
+	TL_contacts_resetSaved{},
+	TL_contacts_getSaved{},
+	TL_contacts_toggleTopPeers{Enabled: TL_boolFalse{}},
+	TL_contacts_addContact{Flags: 1, AddPhonePrivacyException: true, ID: TL_inputUserEmpty{}, FirstName: "first_name", LastName: "last_name", Phone: "phone"},
+	TL_contacts_addContact{Flags: 0, ID: TL_inputUserEmpty{}, FirstName: "first_name", LastName: "last_name", Phone: "phone"},
+	TL_contacts_acceptContact{ID: TL_inputUserEmpty{}},
+	TL_contacts_getLocated{Flags: 3, Background: true, GeoPoint: TL_inputGeoPointEmpty{}, SelfExpires: 4},
+	TL_contacts_getLocated{Flags: 0, GeoPoint: TL_inputGeoPointEmpty{}},
+	TL_contacts_blockFromReplies{Flags: 7, DeleteMessage: true, DeleteHistory: true, ReportSpam: true, MsgID: 5},
+	TL_contacts_blockFromReplies{Flags: 0, MsgID: 5},
+	TL_messages_getMessages{ID: []TL{TL_inputMessageID{ID: 1}}},
+	TL_messages_getDialogs{Flags: 3, ExcludePinned: true, FolderID: 3, OffsetDate: 4, OffsetID: 5, OffsetPeer: TL_inputPeerEmpty{}, Limit: 7, Hash: 8},
+	TL_messages_getDialogs{Flags: 0, OffsetDate: 4, OffsetID: 5, OffsetPeer: TL_inputPeerEmpty{}, Limit: 7, Hash: 8},
+	TL_messages_getHistory{Peer: TL_inputPeerEmpty{}, OffsetID: 2, OffsetDate: 3, AddOffset: 4, Limit: 5, MaxID: 6, MinID: 7, Hash: 8},
+	TL_messages_search{Flags: 3, Peer: TL_inputPeerEmpty{}, Q: "q", FromID: TL_inputPeerEmpty{}, TopMsgID: 5, Filter: TL_inputMessagesFilterEmpty{}, MinDate: 7, MaxDate: 8, OffsetID: 9, AddOffset: 10, Limit: 11, MaxID: 12, MinID: 13, Hash: 14},
+	TL_messages_search{Flags: 0, Peer: TL_inputPeerEmpty{}, Q: "q", Filter: TL_inputMessagesFilterEmpty{}, MinDate: 7, MaxDate: 8, OffsetID: 9, AddOffset: 10, Limit: 11, MaxID: 12, MinID: 13, Hash: 14},
+	TL_messages_readHistory{Peer: TL_inputPeerEmpty{}, MaxID: 2},
+	TL_messages_deleteHistory{Flags: 3, JustClear: true, Revoke: true, Peer: TL_inputPeerEmpty{}, MaxID: 5},
+	TL_messages_deleteHistory{Flags: 0, Peer: TL_inputPeerEmpty{}, MaxID: 5},
+	TL_messages_deleteMessages{Flags: 1, Revoke: true, ID: []int32{1}},
+	TL_messages_deleteMessages{Flags: 0, ID: []int32{1}},
+	TL_messages_receivedMessages{MaxID: 1},
+	TL_messages_setTyping{Flags: 1, Peer: TL_inputPeerEmpty{}, TopMsgID: 3, Action: TL_sendMessageTypingAction{}},
+	TL_messages_setTyping{Flags: 0, Peer: TL_inputPeerEmpty{}, Action: TL_sendMessageTypingAction{}},
+	TL_messages_sendMessage{Flags: 1263, NoWebpage: true, Silent: true, Background: true, ClearDraft: true, Peer: TL_inputPeerEmpty{}, ReplyToMsgID: 7, Message: "message", RandomID: 0x0102030405060700 + 8, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, ScheduleDate: 12},
+	TL_messages_sendMessage{Flags: 0, Peer: TL_inputPeerEmpty{}, Message: "message", RandomID: 0x0102030405060700 + 8},
+	TL_messages_sendMedia{Flags: 1261, Silent: true, Background: true, ClearDraft: true, Peer: TL_inputPeerEmpty{}, ReplyToMsgID: 6, Media: TL_inputMediaEmpty{}, Message: "message", RandomID: 0x0102030405060700 + 8, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, ScheduleDate: 12},
+	TL_messages_sendMedia{Flags: 0, Peer: TL_inputPeerEmpty{}, Media: TL_inputMediaEmpty{}, Message: "message", RandomID: 0x0102030405060700 + 8},
+	TL_messages_forwardMessages{Flags: 1376, Silent: true, Background: true, WithMyScore: true, FromPeer: TL_inputPeerEmpty{}, ID: []int32{1}, RandomID: []int64{2}, ToPeer: TL_inputPeerEmpty{}, ScheduleDate: 9},
+	TL_messages_forwardMessages{Flags: 0, FromPeer: TL_inputPeerEmpty{}, ID: []int32{1}, RandomID: []int64{2}, ToPeer: TL_inputPeerEmpty{}},
+	TL_messages_reportSpam{Peer: TL_inputPeerEmpty{}},
+	TL_messages_getPeerSettings{Peer: TL_inputPeerEmpty{}},
+	TL_messages_report{Peer: TL_inputPeerEmpty{}, ID: []int32{1}, Reason: TL_inputReportReasonSpam{}, Message: "message"},
+	TL_messages_getChats{ID: []int32{1}},
+	TL_messages_getFullChat{ChatID: 1},
+	TL_messages_editChatTitle{ChatID: 1, Title: "title"},
+	TL_messages_editChatPhoto{ChatID: 1, Photo: TL_inputChatPhotoEmpty{}},
+	TL_messages_addChatUser{ChatID: 1, UserID: TL_inputUserEmpty{}, FwdLimit: 3},
+	TL_messages_deleteChatUser{Flags: 1, RevokeHistory: true, ChatID: 3, UserID: TL_inputUserEmpty{}},
+	TL_messages_deleteChatUser{Flags: 0, ChatID: 3, UserID: TL_inputUserEmpty{}},
+	TL_messages_createChat{Users: []TL{TL_inputUserEmpty{}}, Title: "title"},
+	TL_messages_getDhConfig{Version: 1, RandomLength: 2},
+	TL_messages_requestEncryption{UserID: TL_inputUserEmpty{}, RandomID: 2, GA: []byte("g_a")},
+	TL_messages_acceptEncryption{Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, GB: []byte("g_b"), KeyFingerprint: 0x0102030405060700 + 2},
+	TL_messages_discardEncryption{Flags: 1, DeleteHistory: true, ChatID: 3},
+	TL_messages_discardEncryption{Flags: 0, ChatID: 3},
+	TL_messages_setEncryptedTyping{Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, Typing: TL_boolFalse{}},
+	TL_messages_readEncryptedHistory{Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, MaxDate: 2},
+	TL_messages_sendEncrypted{Flags: 1, Silent: true, Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, RandomID: 0x0102030405060700 + 3, Data: []byte("data")},
+	TL_messages_sendEncrypted{Flags: 0, Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, RandomID: 0x0102030405060700 + 3, Data: []byte("data")},
+	TL_messages_sendEncryptedFile{Flags: 1, Silent: true, Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, RandomID: 0x0102030405060700 + 3, Data: []byte("data"), File: TL_inputEncryptedFileEmpty{}},
+	TL_messages_sendEncryptedFile{Flags: 0, Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, RandomID: 0x0102030405060700 + 3, Data: []byte("data"), File: TL_inputEncryptedFileEmpty{}},
+	TL_messages_sendEncryptedService{Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, RandomID: 0x0102030405060700 + 1, Data: []byte("data")},
+	TL_messages_receivedQueue{MaxQts: 1},
+	TL_messages_reportEncryptedSpam{Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}},
+	TL_messages_readMessageContents{ID: []int32{1}},
+	TL_messages_getStickers{Emoticon: "emoticon", Hash: 2},
+	TL_messages_getAllStickers{Hash: 1},
+	TL_messages_getWebPagePreview{Flags: 8, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_messages_getWebPagePreview{Flags: 0, Message: "message"},
+	TL_messages_exportChatInvite{Flags: 7, LegacyRevokePermanent: true, Peer: TL_inputPeerEmpty{}, ExpireDate: 4, UsageLimit: 5},
+	TL_messages_exportChatInvite{Flags: 0, Peer: TL_inputPeerEmpty{}},
+	TL_messages_checkChatInvite{Hash: "hash"},
+	TL_messages_importChatInvite{Hash: "hash"},
+	TL_messages_getStickerSet{Stickerset: TL_inputStickerSetEmpty{}},
+	TL_messages_installStickerSet{Stickerset: TL_inputStickerSetEmpty{}, Archived: TL_boolFalse{}},
+	TL_messages_uninstallStickerSet{Stickerset: TL_inputStickerSetEmpty{}},
+	TL_messages_startBot{Bot: TL_inputUserEmpty{}, Peer: TL_inputPeerEmpty{}, RandomID: 0x0102030405060700 + 2, StartParam: "start_param"},
+	TL_messages_getMessagesViews{Peer: TL_inputPeerEmpty{}, ID: []int32{1}, Increment: TL_boolFalse{}},
+	TL_messages_editChatAdmin{ChatID: 1, UserID: TL_inputUserEmpty{}, IsAdmin: TL_boolFalse{}},
+	TL_messages_migrateChat{ChatID: 1},
+	TL_messages_searchGlobal{Flags: 1, FolderID: 2, Q: "q", Filter: TL_inputMessagesFilterEmpty{}, MinDate: 5, MaxDate: 6, OffsetRate: 7, OffsetPeer: TL_inputPeerEmpty{}, OffsetID: 9, Limit: 10},
+	TL_messages_searchGlobal{Flags: 0, Q: "q", Filter: TL_inputMessagesFilterEmpty{}, MinDate: 5, MaxDate: 6, OffsetRate: 7, OffsetPeer: TL_inputPeerEmpty{}, OffsetID: 9, Limit: 10},
+	TL_messages_reorderStickerSets{Flags: 1, Masks: true, Order: []int64{2}},
+	TL_messages_reorderStickerSets{Flags: 0, Order: []int64{2}},
+	TL_messages_getDocumentByHash{Sha256: []byte("sha256"), Size: 2, MimeType: "mime_type"},
+	TL_messages_getSavedGifs{Hash: 1},
+	TL_messages_saveGif{ID: TL_inputDocumentEmpty{}, Unsave: TL_boolFalse{}},
+	TL_messages_getInlineBotResults{Flags: 1, Bot: TL_inputUserEmpty{}, Peer: TL_inputPeerEmpty{}, GeoPoint: TL_inputGeoPointEmpty{}, Query: "query", Offset: "offset"},
+	TL_messages_getInlineBotResults{Flags: 0, Bot: TL_inputUserEmpty{}, Peer: TL_inputPeerEmpty{}, Query: "query", Offset: "offset"},
+	TL_messages_setInlineBotResults{Flags: 15, Gallery: true, Private: true, QueryID: 0x0102030405060700 + 3, Results: []TL{TL_inputBotInlineResult{Flags: 0, ID: "id", Type: "type", SendMessage: TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"}}}, CacheTime: 6, NextOffset: "next_offset", SwitchPm: TL_inlineBotSwitchPM{Text: "text", StartParam: "start_param"}},
+	TL_messages_setInlineBotResults{Flags: 0, QueryID: 0x0102030405060700 + 3, Results: []TL{TL_inputBotInlineResult{Flags: 0, ID: "id", Type: "type", SendMessage: TL_inputBotInlineMessageMediaAuto{Flags: 0, Message: "message"}}}, CacheTime: 6},
+	TL_messages_sendInlineBotResult{Flags: 3297, Silent: true, Background: true, ClearDraft: true, HideVia: true, Peer: TL_inputPeerEmpty{}, ReplyToMsgID: 7, RandomID: 0x0102030405060700 + 7, QueryID: 0x0102030405060700 + 8, ID: "id", ScheduleDate: 11},
+	TL_messages_sendInlineBotResult{Flags: 0, Peer: TL_inputPeerEmpty{}, RandomID: 0x0102030405060700 + 7, QueryID: 0x0102030405060700 + 8, ID: "id"},
+	TL_messages_getMessageEditData{Peer: TL_inputPeerEmpty{}, ID: 2},
+	TL_messages_editMessage{Flags: 51214, NoWebpage: true, Peer: TL_inputPeerEmpty{}, ID: 4, Message: "message", Media: TL_inputMediaEmpty{}, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, ScheduleDate: 9},
+	TL_messages_editMessage{Flags: 0, Peer: TL_inputPeerEmpty{}, ID: 4},
+	TL_messages_editInlineBotMessage{Flags: 18446, NoWebpage: true, ID: TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2}, Message: "message", Media: TL_inputMediaEmpty{}, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_messages_editInlineBotMessage{Flags: 0, ID: TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2}},
+	TL_messages_getBotCallbackAnswer{Flags: 7, Game: true, Peer: TL_inputPeerEmpty{}, MsgID: 4, Data: []byte("data"), Password: TL_inputCheckPasswordEmpty{}},
+	TL_messages_getBotCallbackAnswer{Flags: 0, Peer: TL_inputPeerEmpty{}, MsgID: 4},
+	TL_messages_setBotCallbackAnswer{Flags: 7, Alert: true, QueryID: 0x0102030405060700 + 2, Message: "message", Url: "url", CacheTime: 6},
+	TL_messages_setBotCallbackAnswer{Flags: 0, QueryID: 0x0102030405060700 + 2, CacheTime: 6},
+	TL_messages_getPeerDialogs{Peers: []TL{TL_inputDialogPeerFolder{FolderID: 1}}},
+	TL_messages_saveDraft{Flags: 11, NoWebpage: true, ReplyToMsgID: 3, Peer: TL_inputPeerEmpty{}, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_messages_saveDraft{Flags: 0, Peer: TL_inputPeerEmpty{}, Message: "message"},
+	TL_messages_getAllDrafts{},
+	TL_messages_getFeaturedStickers{Hash: 1},
+	TL_messages_readFeaturedStickers{ID: []int64{2}},
+	TL_messages_getRecentStickers{Flags: 1, Attached: true, Hash: 3},
+	TL_messages_getRecentStickers{Flags: 0, Hash: 3},
+	TL_messages_saveRecentSticker{Flags: 1, Attached: true, ID: TL_inputDocumentEmpty{}, Unsave: TL_boolFalse{}},
+	TL_messages_saveRecentSticker{Flags: 0, ID: TL_inputDocumentEmpty{}, Unsave: TL_boolFalse{}},
+	TL_messages_clearRecentStickers{Flags: 1, Attached: true},
+	TL_messages_clearRecentStickers{Flags: 0},
+	TL_messages_getArchivedStickers{Flags: 1, Masks: true, OffsetID: 0x0102030405060700 + 2, Limit: 4},
+	TL_messages_getArchivedStickers{Flags: 0, OffsetID: 0x0102030405060700 + 2, Limit: 4},
+	TL_messages_getMaskStickers{Hash: 1},
+	TL_messages_getAttachedStickers{Media: TL_inputStickeredMediaPhoto{ID: TL_inputPhotoEmpty{}}},
+	TL_messages_setGameScore{Flags: 3, EditMessage: true, Force: true, Peer: TL_inputPeerEmpty{}, ID: 5, UserID: TL_inputUserEmpty{}, Score: 7},
+	TL_messages_setGameScore{Flags: 0, Peer: TL_inputPeerEmpty{}, ID: 5, UserID: TL_inputUserEmpty{}, Score: 7},
+	TL_messages_setInlineGameScore{Flags: 3, EditMessage: true, Force: true, ID: TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2}, UserID: TL_inputUserEmpty{}, Score: 6},
+	TL_messages_setInlineGameScore{Flags: 0, ID: TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2}, UserID: TL_inputUserEmpty{}, Score: 6},
+	TL_messages_getGameHighScores{Peer: TL_inputPeerEmpty{}, ID: 2, UserID: TL_inputUserEmpty{}},
+	TL_messages_getInlineGameHighScores{ID: TL_inputBotInlineMessageID{DcID: 1, ID: 0x0102030405060700 + 1, AccessHash: 0x0102030405060700 + 2}, UserID: TL_inputUserEmpty{}},
+	TL_messages_getCommonChats{UserID: TL_inputUserEmpty{}, MaxID: 2, Limit: 3},
+	TL_messages_getAllChats{ExceptIds: []int32{1}},
+	TL_messages_getWebPage{Url: "url", Hash: 2},
+	TL_messages_toggleDialogPin{Flags: 1, Pinned: true, Peer: TL_inputDialogPeerFolder{FolderID: 1}},
+	TL_messages_toggleDialogPin{Flags: 0, Peer: TL_inputDialogPeerFolder{FolderID: 1}},
+	TL_messages_reorderPinnedDialogs{Flags: 1, Force: true, FolderID: 3, Order: []TL{TL_inputDialogPeerFolder{FolderID: 1}}},
+	TL_messages_reorderPinnedDialogs{Flags: 0, FolderID: 3, Order: []TL{TL_inputDialogPeerFolder{FolderID: 1}}},
+	TL_messages_getPinnedDialogs{FolderID: 1},
+	TL_messages_setBotShippingResults{Flags: 3, QueryID: 0x0102030405060700 + 1, Error: "error", ShippingOptions: []TL{TL_shippingOption{ID: "id", Title: "title", Prices: []TL{}}}},
+	TL_messages_setBotShippingResults{Flags: 0, QueryID: 0x0102030405060700 + 1},
+	TL_messages_setBotPrecheckoutResults{Flags: 3, Success: true, QueryID: 0x0102030405060700 + 2, Error: "error"},
+	TL_messages_setBotPrecheckoutResults{Flags: 0, QueryID: 0x0102030405060700 + 2},
+	TL_messages_uploadMedia{Peer: TL_inputPeerEmpty{}, Media: TL_inputMediaEmpty{}},
+	TL_messages_sendScreenshotNotification{Peer: TL_inputPeerEmpty{}, ReplyToMsgID: 2, RandomID: 0x0102030405060700 + 2},
+	TL_messages_getFavedStickers{Hash: 1},
+	TL_messages_faveSticker{ID: TL_inputDocumentEmpty{}, Unfave: TL_boolFalse{}},
+	TL_messages_getUnreadMentions{Peer: TL_inputPeerEmpty{}, OffsetID: 2, AddOffset: 3, Limit: 4, MaxID: 5, MinID: 6},
+	TL_messages_readMentions{Peer: TL_inputPeerEmpty{}},
+	TL_messages_getRecentLocations{Peer: TL_inputPeerEmpty{}, Limit: 2, Hash: 3},
+	TL_messages_sendMultiMedia{Flags: 1249, Silent: true, Background: true, ClearDraft: true, Peer: TL_inputPeerEmpty{}, ReplyToMsgID: 6, MultiMedia: []TL{TL_inputSingleMedia{Flags: 0, Media: TL_inputMediaEmpty{}, RandomID: 0x0102030405060700 + 2, Message: "message"}}, ScheduleDate: 8},
+	TL_messages_sendMultiMedia{Flags: 0, Peer: TL_inputPeerEmpty{}, MultiMedia: []TL{TL_inputSingleMedia{Flags: 0, Media: TL_inputMediaEmpty{}, RandomID: 0x0102030405060700 + 2, Message: "message"}}},
+	TL_messages_uploadEncryptedFile{Peer: TL_inputEncryptedChat{ChatID: 1, AccessHash: 0x0102030405060700 + 1}, File: TL_inputEncryptedFileEmpty{}},
+	TL_messages_searchStickerSets{Flags: 1, ExcludeFeatured: true, Q: "q", Hash: 4},
+	TL_messages_searchStickerSets{Flags: 0, Q: "q", Hash: 4},
+	TL_messages_getSplitRanges{},
+	TL_messages_markDialogUnread{Flags: 1, Unread: true, Peer: TL_inputDialogPeerFolder{FolderID: 1}},
+	TL_messages_markDialogUnread{Flags: 0, Peer: TL_inputDialogPeerFolder{FolderID: 1}},
+	TL_messages_getDialogUnreadMarks{},
+	TL_messages_clearAllDrafts{},
+	TL_messages_updatePinnedMessage{Flags: 7, Silent: true, Unpin: true, PmOneside: true, Peer: TL_inputPeerEmpty{}, ID: 6},
+	TL_messages_updatePinnedMessage{Flags: 0, Peer: TL_inputPeerEmpty{}, ID: 6},
+	TL_messages_sendVote{Peer: TL_inputPeerEmpty{}, MsgID: 2, Options: [][]byte{[]byte("b")}},
+	TL_messages_getPollResults{Peer: TL_inputPeerEmpty{}, MsgID: 2},
+	TL_messages_getOnlines{Peer: TL_inputPeerEmpty{}},
+	TL_messages_getStatsURL{Flags: 1, Dark: true, Peer: TL_inputPeerEmpty{}, Params: "params"},
+	TL_messages_getStatsURL{Flags: 0, Peer: TL_inputPeerEmpty{}, Params: "params"},
+	TL_messages_editChatAbout{Peer: TL_inputPeerEmpty{}, About: "about"},
+	TL_messages_editChatDefaultBannedRights{Peer: TL_inputPeerEmpty{}, BannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}},
+	TL_messages_getEmojiKeywords{LangCode: "lang_code"},
+	TL_messages_getEmojiKeywordsDifference{LangCode: "lang_code", FromVersion: 2},
+	TL_messages_getEmojiKeywordsLanguages{LangCodes: []string{"s"}},
+	TL_messages_getEmojiURL{LangCode: "lang_code"},
+	TL_messages_getSearchCounters{Peer: TL_inputPeerEmpty{}, Filters: []TL{TL_inputMessagesFilterEmpty{}}},
+	TL_messages_requestUrlAuth{Flags: 6, Peer: TL_inputPeerEmpty{}, MsgID: 3, ButtonID: 4, Url: "url"},
+	TL_messages_requestUrlAuth{Flags: 0},
+	TL_messages_acceptUrlAuth{Flags: 7, WriteAllowed: true, Peer: TL_inputPeerEmpty{}, MsgID: 4, ButtonID: 5, Url: "url"},
+	TL_messages_acceptUrlAuth{Flags: 0},
+	TL_messages_hidePeerSettingsBar{Peer: TL_inputPeerEmpty{}},
+	TL_messages_getScheduledHistory{Peer: TL_inputPeerEmpty{}, Hash: 2},
+	TL_messages_getScheduledMessages{Peer: TL_inputPeerEmpty{}, ID: []int32{1}},
+	TL_messages_sendScheduledMessages{Peer: TL_inputPeerEmpty{}, ID: []int32{1}},
+	TL_messages_deleteScheduledMessages{Peer: TL_inputPeerEmpty{}, ID: []int32{1}},
+	TL_messages_getPollVotes{Flags: 3, Peer: TL_inputPeerEmpty{}, ID: 3, Option: []byte("option"), Offset: "offset", Limit: 6},
+	TL_messages_getPollVotes{Flags: 0, Peer: TL_inputPeerEmpty{}, ID: 3, Limit: 6},
+	TL_messages_toggleStickerSets{Flags: 7, Uninstall: true, Archive: true, Unarchive: true, Stickersets: []TL{TL_inputStickerSetEmpty{}}},
+	TL_messages_toggleStickerSets{Flags: 0, Stickersets: []TL{TL_inputStickerSetEmpty{}}},
+	TL_messages_getDialogFilters{},
+	TL_messages_getSuggestedDialogFilters{},
+	TL_messages_updateDialogFilter{Flags: 1, ID: 2, Filter: TL_dialogFilter{Flags: 0, ID: 10, Title: "title", PinnedPeers: []TL{}, IncludePeers: []TL{}, ExcludePeers: []TL{}}},
+	TL_messages_updateDialogFilter{Flags: 0, ID: 2},
+	TL_messages_updateDialogFiltersOrder{Order: []int32{1}},
+	TL_messages_getOldFeaturedStickers{Offset: 1, Limit: 2, Hash: 3},
+	TL_messages_getReplies{Peer: TL_inputPeerEmpty{}, MsgID: 2, OffsetID: 3, OffsetDate: 4, AddOffset: 5, Limit: 6, MaxID: 7, MinID: 8, Hash: 9},
+	TL_messages_getDiscussionMessage{Peer: TL_inputPeerEmpty{}, MsgID: 2},
+	TL_messages_readDiscussion{Peer: TL_inputPeerEmpty{}, MsgID: 2, ReadMaxID: 3},
+	TL_messages_unpinAllMessages{Peer: TL_inputPeerEmpty{}},
+	TL_messages_deleteChat{ChatID: 1},
+	TL_messages_deletePhoneCallHistory{Flags: 1, Revoke: true},
+	TL_messages_deletePhoneCallHistory{Flags: 0},
+	TL_messages_checkHistoryImport{ImportHead: "import_head"},
+	TL_messages_initHistoryImport{Peer: TL_inputPeerEmpty{}, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, MediaCount: 3},
+	TL_messages_uploadImportedMedia{Peer: TL_inputPeerEmpty{}, ImportID: 0x0102030405060700 + 1, FileName: "file_name", Media: TL_inputMediaEmpty{}},
+	TL_messages_startHistoryImport{Peer: TL_inputPeerEmpty{}, ImportID: 0x0102030405060700 + 1},
+	TL_messages_getExportedChatInvites{Flags: 12, Revoked: true, Peer: TL_inputPeerEmpty{}, AdminID: TL_inputUserEmpty{}, OffsetDate: 5, OffsetLink: "offset_link", Limit: 7},
+	TL_messages_getExportedChatInvites{Flags: 0, Peer: TL_inputPeerEmpty{}, AdminID: TL_inputUserEmpty{}, Limit: 7},
+	TL_messages_getExportedChatInvite{Peer: TL_inputPeerEmpty{}, Link: "link"},
+	TL_messages_editExportedChatInvite{Flags: 7, Revoked: true, Peer: TL_inputPeerEmpty{}, Link: "link", ExpireDate: 5, UsageLimit: 6},
+	TL_messages_editExportedChatInvite{Flags: 0, Peer: TL_inputPeerEmpty{}, Link: "link"},
+	TL_messages_deleteRevokedExportedChatInvites{Peer: TL_inputPeerEmpty{}, AdminID: TL_inputUserEmpty{}},
+	TL_messages_deleteExportedChatInvite{Peer: TL_inputPeerEmpty{}, Link: "link"},
+	TL_messages_getAdminsWithInvites{Peer: TL_inputPeerEmpty{}},
+	TL_messages_getChatInviteImporters{Peer: TL_inputPeerEmpty{}, Link: "link", OffsetDate: 3, OffsetUser: TL_inputUserEmpty{}, Limit: 5},
+	TL_messages_setHistoryTTL{Peer: TL_inputPeerEmpty{}, Period: 2},
+	TL_messages_checkHistoryImportPeer{Peer: TL_inputPeerEmpty{}},
+	TL_updates_getState{},
+	TL_updates_getDifference{Flags: 1, Pts: 2, PtsTotalLimit: 3, Date: 4, Qts: 5},
+	TL_updates_getDifference{Flags: 0, Pts: 2, Date: 4, Qts: 5},
+	TL_updates_getChannelDifference{Flags: 1, Force: true, Channel: TL_inputChannelEmpty{}, Filter: TL_channelMessagesFilterEmpty{}, Pts: 5, Limit: 6},
+	TL_updates_getChannelDifference{Flags: 0, Channel: TL_inputChannelEmpty{}, Filter: TL_channelMessagesFilterEmpty{}, Pts: 5, Limit: 6},
+	TL_photos_updateProfilePhoto{ID: TL_inputPhotoEmpty{}},
+	TL_photos_uploadProfilePhoto{Flags: 7, File: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, Video: TL_inputFile{ID: 0x0102030405060700 + 0, Parts: 2, Name: "name", Md5Checksum: "md5_checksum"}, VideoStartTs: 1.5},
+	TL_photos_uploadProfilePhoto{Flags: 0},
+	TL_photos_deletePhotos{ID: []TL{TL_inputPhotoEmpty{}}},
+	TL_photos_getUserPhotos{UserID: TL_inputUserEmpty{}, Offset: 2, MaxID: 0x0102030405060700 + 2, Limit: 4},
+	TL_upload_saveFilePart{FileID: 0x0102030405060700 + 0, FilePart: 2, Bytes: []byte("bytes")},
+	TL_upload_getFile{Flags: 3, Precise: true, CdnSupported: true, Location: TL_inputFileLocation{VolumeID: 0x0102030405060700 + 0, LocalID: 2, Secret: 0x0102030405060700 + 2, FileReference: []byte("file_reference")}, Offset: 5, Limit: 6},
+	TL_upload_getFile{Flags: 0, Location: TL_inputFileLocation{VolumeID: 0x0102030405060700 + 0, LocalID: 2, Secret: 0x0102030405060700 + 2, FileReference: []byte("file_reference")}, Offset: 5, Limit: 6},
+	TL_upload_saveBigFilePart{FileID: 0x0102030405060700 + 0, FilePart: 2, FileTotalParts: 3, Bytes: []byte("bytes")},
+	TL_upload_getWebFile{Location: TL_inputWebFileLocation{Url: "url", AccessHash: 0x0102030405060700 + 1}, Offset: 2, Limit: 3},
+	TL_upload_getCdnFile{FileToken: []byte("file_token"), Offset: 2, Limit: 3},
+	TL_upload_reuploadCdnFile{FileToken: []byte("file_token"), RequestToken: []byte("request_token")},
+	TL_upload_getCdnFileHashes{FileToken: []byte("file_token"), Offset: 2},
+	TL_upload_getFileHashes{Location: TL_inputFileLocation{VolumeID: 0x0102030405060700 + 0, LocalID: 2, Secret: 0x0102030405060700 + 2, FileReference: []byte("file_reference")}, Offset: 2},
+	TL_help_getConfig{},
+	TL_help_getNearestDc{},
+	TL_help_getAppUpdate{Source: "source"},
+	TL_help_getInviteText{},
+	TL_help_getSupport{},
+	TL_help_getAppChangelog{PrevAppVersion: "prev_app_version"},
+	TL_help_setBotUpdatesStatus{PendingUpdatesCount: 1, Message: "message"},
+	TL_help_getCdnConfig{},
+	TL_help_getRecentMeUrls{Referer: "referer"},
+	TL_help_getTermsOfServiceUpdate{},
+	TL_help_acceptTermsOfService{ID: TL_dataJSON{Data: "data"}},
+	TL_help_getDeepLinkInfo{Path: "path"},
+	TL_help_getAppConfig{},
+	TL_help_saveAppLog{Events: []TL{TL_inputAppEvent{Time: 1.5, Type: "type", Peer: 0x0102030405060700 + 2, Data: TL_jsonNull{}}}},
+	TL_help_getPassportConfig{Hash: 1},
+	TL_help_getSupportName{},
+	TL_help_getUserInfo{UserID: TL_inputUserEmpty{}},
+	TL_help_editUserInfo{UserID: TL_inputUserEmpty{}, Message: "message", Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}},
+	TL_help_getPromoData{},
+	TL_help_hidePromoData{Peer: TL_inputPeerEmpty{}},
+	TL_help_dismissSuggestion{Peer: TL_inputPeerEmpty{}, Suggestion: "suggestion"},
+	TL_help_getCountriesList{LangCode: "lang_code", Hash: 2},
+	TL_channels_readHistory{Channel: TL_inputChannelEmpty{}, MaxID: 2},
+	TL_channels_deleteMessages{Channel: TL_inputChannelEmpty{}, ID: []int32{1}},
+	TL_channels_deleteUserHistory{Channel: TL_inputChannelEmpty{}, UserID: TL_inputUserEmpty{}},
+	TL_channels_reportSpam{Channel: TL_inputChannelEmpty{}, UserID: TL_inputUserEmpty{}, ID: []int32{1}},
+	TL_channels_getMessages{Channel: TL_inputChannelEmpty{}, ID: []TL{TL_inputMessageID{ID: 1}}},
+	TL_channels_getParticipants{Channel: TL_inputChannelEmpty{}, Filter: TL_channelParticipantsRecent{}, Offset: 3, Limit: 4, Hash: 5},
+	TL_channels_getParticipant{Channel: TL_inputChannelEmpty{}, Participant: TL_inputPeerEmpty{}},
+	TL_channels_getChannels{ID: []TL{TL_inputChannelEmpty{}}},
+	TL_channels_getFullChannel{Channel: TL_inputChannelEmpty{}},
+	TL_channels_createChannel{Flags: 15, Broadcast: true, Megagroup: true, ForImport: true, Title: "title", About: "about", GeoPoint: TL_inputGeoPointEmpty{}, Address: "address"},
+	TL_channels_createChannel{Flags: 0, Title: "title", About: "about"},
+	TL_channels_editAdmin{Channel: TL_inputChannelEmpty{}, UserID: TL_inputUserEmpty{}, AdminRights: TL_chatAdminRights{Flags: 0}, Rank: "rank"},
+	TL_channels_editTitle{Channel: TL_inputChannelEmpty{}, Title: "title"},
+	TL_channels_editPhoto{Channel: TL_inputChannelEmpty{}, Photo: TL_inputChatPhotoEmpty{}},
+	TL_channels_checkUsername{Channel: TL_inputChannelEmpty{}, Username: "username"},
+	TL_channels_updateUsername{Channel: TL_inputChannelEmpty{}, Username: "username"},
+	TL_channels_joinChannel{Channel: TL_inputChannelEmpty{}},
+	TL_channels_leaveChannel{Channel: TL_inputChannelEmpty{}},
+	TL_channels_inviteToChannel{Channel: TL_inputChannelEmpty{}, Users: []TL{TL_inputUserEmpty{}}},
+	TL_channels_deleteChannel{Channel: TL_inputChannelEmpty{}},
+	TL_channels_exportMessageLink{Flags: 3, Grouped: true, Thread: true, Channel: TL_inputChannelEmpty{}, ID: 5},
+	TL_channels_exportMessageLink{Flags: 0, Channel: TL_inputChannelEmpty{}, ID: 5},
+	TL_channels_toggleSignatures{Channel: TL_inputChannelEmpty{}, Enabled: TL_boolFalse{}},
+	TL_channels_getAdminedPublicChannels{Flags: 3, ByLocation: true, CheckLimit: true},
+	TL_channels_getAdminedPublicChannels{Flags: 0},
+	TL_channels_editBanned{Channel: TL_inputChannelEmpty{}, Participant: TL_inputPeerEmpty{}, BannedRights: TL_chatBannedRights{Flags: 0, UntilDate: 14}},
+	TL_channels_getAdminLog{Flags: 3, Channel: TL_inputChannelEmpty{}, Q: "q", EventsFilter: TL_channelAdminLogEventsFilter{Flags: 0}, Admins: []TL{TL_inputUserEmpty{}}, MaxID: 0x0102030405060700 + 5, MinID: 0x0102030405060700 + 6, Limit: 8},
+	TL_channels_getAdminLog{Flags: 0, Channel: TL_inputChannelEmpty{}, Q: "q", MaxID: 0x0102030405060700 + 5, MinID: 0x0102030405060700 + 6, Limit: 8},
+	TL_channels_setStickers{Channel: TL_inputChannelEmpty{}, Stickerset: TL_inputStickerSetEmpty{}},
+	TL_channels_readMessageContents{Channel: TL_inputChannelEmpty{}, ID: []int32{1}},
+	TL_channels_deleteHistory{Channel: TL_inputChannelEmpty{}, MaxID: 2},
+	TL_channels_togglePreHistoryHidden{Channel: TL_inputChannelEmpty{}, Enabled: TL_boolFalse{}},
+	TL_channels_getLeftChannels{Offset: 1},
+	TL_channels_getGroupsForDiscussion{},
+	TL_channels_setDiscussionGroup{Broadcast: TL_inputChannelEmpty{}, Group: TL_inputChannelEmpty{}},
+	TL_channels_editCreator{Channel: TL_inputChannelEmpty{}, UserID: TL_inputUserEmpty{}, Password: TL_inputCheckPasswordEmpty{}},
+	TL_channels_editLocation{Channel: TL_inputChannelEmpty{}, GeoPoint: TL_inputGeoPointEmpty{}, Address: "address"},
+	TL_channels_toggleSlowMode{Channel: TL_inputChannelEmpty{}, Seconds: 2},
+	TL_channels_getInactiveChannels{},
+	TL_channels_convertToGigagroup{Channel: TL_inputChannelEmpty{}},
+	TL_bots_sendCustomRequest{CustomMethod: "custom_method", Params: TL_dataJSON{Data: "data"}},
+	TL_bots_answerWebhookJSONQuery{QueryID: 0x0102030405060700 + 0, Data: TL_dataJSON{Data: "data"}},
+	TL_bots_setBotCommands{Commands: []TL{TL_botCommand{Command: "command", Description: "description"}}},
+	TL_payments_getPaymentForm{MsgID: 1},
+	TL_payments_getPaymentReceipt{MsgID: 1},
+	TL_payments_validateRequestedInfo{Flags: 1, Save: true, MsgID: 3, Info: TL_paymentRequestedInfo{Flags: 0}},
+	TL_payments_validateRequestedInfo{Flags: 0, MsgID: 3, Info: TL_paymentRequestedInfo{Flags: 0}},
+	TL_payments_sendPaymentForm{Flags: 3, MsgID: 2, RequestedInfoID: "requested_info_id", ShippingOptionID: "shipping_option_id", Credentials: TL_inputPaymentCredentialsSaved{ID: "id", TmpPassword: []byte("tmp_password")}},
+	TL_payments_sendPaymentForm{Flags: 0, MsgID: 2, Credentials: TL_inputPaymentCredentialsSaved{ID: "id", TmpPassword: []byte("tmp_password")}},
+	TL_payments_getSavedInfo{},
+	TL_payments_clearSavedInfo{Flags: 3, Credentials: true, Info: true},
+	TL_payments_clearSavedInfo{Flags: 0},
+	TL_payments_getBankCardData{Number: "number"},
+	TL_stickers_createStickerSet{Flags: 7, Masks: true, Animated: true, UserID: TL_inputUserEmpty{}, Title: "title", ShortName: "short_name", Thumb: TL_inputDocumentEmpty{}, Stickers: []TL{TL_inputStickerSetItem{Flags: 0, Document: TL_inputDocumentEmpty{}, Emoji: "emoji"}}},
+	TL_stickers_createStickerSet{Flags: 0, UserID: TL_inputUserEmpty{}, Title: "title", ShortName: "short_name", Stickers: []TL{TL_inputStickerSetItem{Flags: 0, Document: TL_inputDocumentEmpty{}, Emoji: "emoji"}}},
+	TL_stickers_removeStickerFromSet{Sticker: TL_inputDocumentEmpty{}},
+	TL_stickers_changeStickerPosition{Sticker: TL_inputDocumentEmpty{}, Position: 2},
+	TL_stickers_addStickerToSet{Stickerset: TL_inputStickerSetEmpty{}, Sticker: TL_inputStickerSetItem{Flags: 0, Document: TL_inputDocumentEmpty{}, Emoji: "emoji"}},
+	TL_stickers_setStickerSetThumb{Stickerset: TL_inputStickerSetEmpty{}, Thumb: TL_inputDocumentEmpty{}},
+	TL_phone_getCallConfig{},
+	TL_phone_requestCall{Flags: 1, Video: true, UserID: TL_inputUserEmpty{}, RandomID: 4, GAHash: []byte("g_a_hash"), Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phone_requestCall{Flags: 0, UserID: TL_inputUserEmpty{}, RandomID: 4, GAHash: []byte("g_a_hash"), Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phone_acceptCall{Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, GB: []byte("g_b"), Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phone_confirmCall{Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, GA: []byte("g_a"), KeyFingerprint: 0x0102030405060700 + 2, Protocol: TL_phoneCallProtocol{Flags: 0, MinLayer: 4, MaxLayer: 5, LibraryVersions: []string{}}},
+	TL_phone_receivedCall{Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_phone_discardCall{Flags: 1, Video: true, Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Duration: 4, Reason: TL_phoneCallDiscardReasonMissed{}, ConnectionID: 0x0102030405060700 + 5},
+	TL_phone_discardCall{Flags: 0, Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Duration: 4, Reason: TL_phoneCallDiscardReasonMissed{}, ConnectionID: 0x0102030405060700 + 5},
+	TL_phone_setCallRating{Flags: 1, UserInitiative: true, Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Rating: 4, Comment: "comment"},
+	TL_phone_setCallRating{Flags: 0, Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Rating: 4, Comment: "comment"},
+	TL_phone_saveCallDebug{Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Debug: TL_dataJSON{Data: "data"}},
+	TL_phone_sendSignalingData{Peer: TL_inputPhoneCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Data: []byte("data")},
+	TL_phone_createGroupCall{Peer: TL_inputPeerEmpty{}, RandomID: 2},
+	TL_phone_joinGroupCall{Flags: 3, Muted: true, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, JoinAs: TL_inputPeerEmpty{}, InviteHash: "invite_hash", Params: TL_dataJSON{Data: "data"}},
+	TL_phone_joinGroupCall{Flags: 0, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, JoinAs: TL_inputPeerEmpty{}, Params: TL_dataJSON{Data: "data"}},
+	TL_phone_leaveGroupCall{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Source: 2},
+	TL_phone_inviteToGroupCall{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Users: []TL{TL_inputUserEmpty{}}},
+	TL_phone_discardGroupCall{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_phone_toggleGroupCallSettings{Flags: 3, ResetInviteHash: true, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, JoinMuted: TL_boolFalse{}},
+	TL_phone_toggleGroupCallSettings{Flags: 0, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_phone_getGroupCall{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_phone_getGroupParticipants{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Ids: []TL{TL_inputPeerEmpty{}}, Sources: []int32{1}, Offset: "offset", Limit: 5},
+	TL_phone_checkGroupCall{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Source: 2},
+	TL_phone_toggleGroupCallRecord{Flags: 3, Start: true, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Title: "title"},
+	TL_phone_toggleGroupCallRecord{Flags: 0, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_phone_editGroupCallParticipant{Flags: 7, Muted: true, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Participant: TL_inputPeerEmpty{}, Volume: 5, RaiseHand: TL_boolFalse{}},
+	TL_phone_editGroupCallParticipant{Flags: 0, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Participant: TL_inputPeerEmpty{}},
+	TL_phone_editGroupCallTitle{Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}, Title: "title"},
+	TL_phone_getGroupCallJoinAs{Peer: TL_inputPeerEmpty{}},
+	TL_phone_exportGroupCallInvite{Flags: 1, CanSelfUnmute: true, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_phone_exportGroupCallInvite{Flags: 0, Call: TL_inputGroupCall{ID: 0x0102030405060700 + 0, AccessHash: 0x0102030405060700 + 1}},
+	TL_langpack_getLangPack{LangPack: "lang_pack", LangCode: "lang_code"},
+	TL_langpack_getStrings{LangPack: "lang_pack", LangCode: "lang_code", Keys: []string{"s"}},
+	TL_langpack_getDifference{LangPack: "lang_pack", LangCode: "lang_code", FromVersion: 3},
+	TL_langpack_getLanguages{LangPack: "lang_pack"},
+	TL_langpack_getLanguage{LangPack: "lang_pack", LangCode: "lang_code"},
+	TL_folders_editPeerFolders{FolderPeers: []TL{TL_inputFolderPeer{Peer: TL_inputPeerEmpty{}, FolderID: 2}}},
+	TL_folders_deleteFolder{FolderID: 1},
+	TL_stats_getBroadcastStats{Flags: 1, Dark: true, Channel: TL_inputChannelEmpty{}},
+	TL_stats_getBroadcastStats{Flags: 0, Channel: TL_inputChannelEmpty{}},
+	TL_stats_loadAsyncGraph{Flags: 1, Token: "token", X: 0x0102030405060700 + 2},
+	TL_stats_loadAsyncGraph{Flags: 0, Token: "token"},
+	TL_stats_getMegagroupStats{Flags: 1, Dark: true, Channel: TL_inputChannelEmpty{}},
+	TL_stats_getMegagroupStats{Flags: 0, Channel: TL_inputChannelEmpty{}},
+	TL_stats_getMessagePublicForwards{Channel: TL_inputChannelEmpty{}, MsgID: 2, OffsetRate: 3, OffsetPeer: TL_inputPeerEmpty{}, OffsetID: 5, Limit: 6},
+	TL_stats_getMessageStats{Flags: 1, Dark: true, Channel: TL_inputChannelEmpty{}, MsgID: 4},
+	TL_stats_getMessageStats{Flags: 0, Channel: TL_inputChannelEmpty{}, MsgID: 4},
+	TL_message_layer122{Flags: 32501758, Out: true, Mentioned: true, MediaUnread: true, Silent: true, Post: true, FromScheduled: true, Legacy: true, EditHide: true, Pinned: true, ID: 11, FromID: TL_peerUser{UserID: 1}, PeerID: TL_peerUser{UserID: 1}, FwdFrom: TL_messageFwdHeader{Flags: 0, Date: 5}, ViaBotID: 15, ReplyTo: TL_messageReplyHeader{Flags: 0, ReplyToMsgID: 2}, Date: 17, Message: "message", Media: TL_messageMediaEmpty{}, ReplyMarkup: TL_replyKeyboardHide{Flags: 0}, Entities: []TL{TL_messageEntityUnknown{Offset: 1, Length: 2}}, Views: 22, Forwards: 23, Replies: TL_messageReplies{Flags: 0, Replies: 3, RepliesPts: 4}, EditDate: 25, PostAuthor: "post_author", GroupedID: 0x0102030405060700 + 26, RestrictionReason: []TL{TL_restrictionReason{Platform: "platform", Reason: "reason", Text: "text"}}},
+	TL_message_layer122{Flags: 0, ID: 11, PeerID: TL_peerUser{UserID: 1}, Date: 17, Message: "message"},
+	TL_messageService_layer122{Flags: 549178, Out: true, Mentioned: true, MediaUnread: true, Silent: true, Post: true, Legacy: true, ID: 8, FromID: TL_peerUser{UserID: 1}, PeerID: TL_peerUser{UserID: 1}, ReplyTo: TL_messageReplyHeader{Flags: 0, ReplyToMsgID: 2}, Date: 12, Action: TL_messageActionEmpty{}},
+	TL_messageService_layer122{Flags: 0, ID: 8, PeerID: TL_peerUser{UserID: 1}, Date: 12, Action: TL_messageActionEmpty{}},
+}
+
+func TestGeneratedRoundTrip(t *testing.T) {
+	for _, obj := range generatedRoundTripObjects {
+		buf := Encode(obj)
+		dbuf := NewDecodeBuf(buf)
+		res := dbuf.Object()
+		if dbuf.err != nil {
+			t.Errorf("%T: %s", obj, dbuf.err)
+			continue
+		}
+		if dbuf.off != len(buf) {
+			t.Errorf("%T: %d of %d bytes decoded", obj, dbuf.off, len(buf))
+		}
+		if !reflect.DeepEqual(obj, res) {
+			t.Errorf("%T: encode-decode mismatch:\n%#v\n%#v", obj, obj, res)
+		}
+	}
+}
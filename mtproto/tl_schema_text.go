@@ -0,0 +1,36882 @@
+package mtproto
+
+func (e TL_resPQ) writeText(w *textWriter) {
+	w.name("resPQ")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("pq")
+	w.String(e.Pq)
+	w.field("server_public_key_fingerprints")
+	w.VectorLong(e.ServerPublicKeyFingerprints)
+}
+
+func (e TL_p_q_inner_data) writeText(w *textWriter) {
+	w.name("p_q_inner_data")
+	w.field("pq")
+	w.String(e.Pq)
+	w.field("p")
+	w.String(e.P)
+	w.field("q")
+	w.String(e.Q)
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("new_nonce")
+	w.Bytes(e.NewNonce)
+}
+
+func (e TL_p_q_inner_data_dc) writeText(w *textWriter) {
+	w.name("p_q_inner_data_dc")
+	w.field("pq")
+	w.String(e.Pq)
+	w.field("p")
+	w.String(e.P)
+	w.field("q")
+	w.String(e.Q)
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("new_nonce")
+	w.Bytes(e.NewNonce)
+	w.field("dc")
+	w.Int(e.Dc)
+}
+
+func (e TL_p_q_inner_data_temp) writeText(w *textWriter) {
+	w.name("p_q_inner_data_temp")
+	w.field("pq")
+	w.String(e.Pq)
+	w.field("p")
+	w.String(e.P)
+	w.field("q")
+	w.String(e.Q)
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("new_nonce")
+	w.Bytes(e.NewNonce)
+	w.field("expires_in")
+	w.Int(e.ExpiresIn)
+}
+
+func (e TL_p_q_inner_data_temp_dc) writeText(w *textWriter) {
+	w.name("p_q_inner_data_temp_dc")
+	w.field("pq")
+	w.String(e.Pq)
+	w.field("p")
+	w.String(e.P)
+	w.field("q")
+	w.String(e.Q)
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("new_nonce")
+	w.Bytes(e.NewNonce)
+	w.field("dc")
+	w.Int(e.Dc)
+	w.field("expires_in")
+	w.Int(e.ExpiresIn)
+}
+
+func (e TL_bind_auth_key_inner) writeText(w *textWriter) {
+	w.name("bind_auth_key_inner")
+	w.field("nonce")
+	w.Long(e.Nonce)
+	w.field("temp_auth_key_id")
+	w.Long(e.TempAuthKeyID)
+	w.field("perm_auth_key_id")
+	w.Long(e.PermAuthKeyID)
+	w.field("temp_session_id")
+	w.Long(e.TempSessionID)
+	w.field("expires_at")
+	w.Int(e.ExpiresAt)
+}
+
+func (e TL_server_DH_params_fail) writeText(w *textWriter) {
+	w.name("server_DH_params_fail")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("new_nonce_hash")
+	w.Bytes(e.NewNonceHash)
+}
+
+func (e TL_server_DH_params_ok) writeText(w *textWriter) {
+	w.name("server_DH_params_ok")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("encrypted_answer")
+	w.String(e.EncryptedAnswer)
+}
+
+func (e TL_server_DH_inner_data) writeText(w *textWriter) {
+	w.name("server_DH_inner_data")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("g")
+	w.Int(e.G)
+	w.field("dh_prime")
+	w.String(e.DhPrime)
+	w.field("g_a")
+	w.String(e.GA)
+	w.field("server_time")
+	w.Int(e.ServerTime)
+}
+
+func (e TL_client_DH_inner_data) writeText(w *textWriter) {
+	w.name("client_DH_inner_data")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("retry_id")
+	w.Long(e.RetryID)
+	w.field("g_b")
+	w.String(e.GB)
+}
+
+func (e TL_dh_gen_ok) writeText(w *textWriter) {
+	w.name("dh_gen_ok")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("new_nonce_hash1")
+	w.Bytes(e.NewNonceHash1)
+}
+
+func (e TL_dh_gen_retry) writeText(w *textWriter) {
+	w.name("dh_gen_retry")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("new_nonce_hash2")
+	w.Bytes(e.NewNonceHash2)
+}
+
+func (e TL_dh_gen_fail) writeText(w *textWriter) {
+	w.name("dh_gen_fail")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("new_nonce_hash3")
+	w.Bytes(e.NewNonceHash3)
+}
+
+func (e TL_destroy_auth_key_ok) writeText(w *textWriter) {
+	w.name("destroy_auth_key_ok")
+}
+
+func (e TL_destroy_auth_key_none) writeText(w *textWriter) {
+	w.name("destroy_auth_key_none")
+}
+
+func (e TL_destroy_auth_key_fail) writeText(w *textWriter) {
+	w.name("destroy_auth_key_fail")
+}
+
+func (e TL_req_pq) writeText(w *textWriter) {
+	w.name("req_pq")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+}
+
+func (e TL_req_pq_multi) writeText(w *textWriter) {
+	w.name("req_pq_multi")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+}
+
+func (e TL_req_DH_params) writeText(w *textWriter) {
+	w.name("req_DH_params")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("p")
+	w.String(e.P)
+	w.field("q")
+	w.String(e.Q)
+	w.field("public_key_fingerprint")
+	w.Long(e.PublicKeyFingerprint)
+	w.field("encrypted_data")
+	w.String(e.EncryptedData)
+}
+
+func (e TL_set_client_DH_params) writeText(w *textWriter) {
+	w.name("set_client_DH_params")
+	w.field("nonce")
+	w.Bytes(e.Nonce)
+	w.field("server_nonce")
+	w.Bytes(e.ServerNonce)
+	w.field("encrypted_data")
+	w.String(e.EncryptedData)
+}
+
+func (e TL_destroy_auth_key) writeText(w *textWriter) {
+	w.name("destroy_auth_key")
+}
+
+func (e TL_msgs_ack) writeText(w *textWriter) {
+	w.name("msgs_ack")
+	w.field("msg_ids")
+	w.VectorLong(e.MsgIds)
+}
+
+func (e TL_bad_msg_notification) writeText(w *textWriter) {
+	w.name("bad_msg_notification")
+	w.field("bad_msg_id")
+	w.Long(e.BadMsgID)
+	w.field("bad_msg_seqno")
+	w.Int(e.BadMsgSeqno)
+	w.field("error_code")
+	w.Int(e.ErrorCode)
+}
+
+func (e TL_bad_server_salt) writeText(w *textWriter) {
+	w.name("bad_server_salt")
+	w.field("bad_msg_id")
+	w.Long(e.BadMsgID)
+	w.field("bad_msg_seqno")
+	w.Int(e.BadMsgSeqno)
+	w.field("error_code")
+	w.Int(e.ErrorCode)
+	w.field("new_server_salt")
+	w.Long(e.NewServerSalt)
+}
+
+func (e TL_msgs_state_req) writeText(w *textWriter) {
+	w.name("msgs_state_req")
+	w.field("msg_ids")
+	w.VectorLong(e.MsgIds)
+}
+
+func (e TL_msgs_state_info) writeText(w *textWriter) {
+	w.name("msgs_state_info")
+	w.field("req_msg_id")
+	w.Long(e.ReqMsgID)
+	w.field("info")
+	w.String(e.Info)
+}
+
+func (e TL_msgs_all_info) writeText(w *textWriter) {
+	w.name("msgs_all_info")
+	w.field("msg_ids")
+	w.VectorLong(e.MsgIds)
+	w.field("info")
+	w.String(e.Info)
+}
+
+func (e TL_msg_detailed_info) writeText(w *textWriter) {
+	w.name("msg_detailed_info")
+	w.field("msg_id")
+	w.Long(e.MsgID)
+	w.field("answer_msg_id")
+	w.Long(e.AnswerMsgID)
+	w.field("bytes")
+	w.Int(e.Bytes)
+	w.field("status")
+	w.Int(e.Status)
+}
+
+func (e TL_msg_new_detailed_info) writeText(w *textWriter) {
+	w.name("msg_new_detailed_info")
+	w.field("answer_msg_id")
+	w.Long(e.AnswerMsgID)
+	w.field("bytes")
+	w.Int(e.Bytes)
+	w.field("status")
+	w.Int(e.Status)
+}
+
+func (e TL_msg_resend_req) writeText(w *textWriter) {
+	w.name("msg_resend_req")
+	w.field("msg_ids")
+	w.VectorLong(e.MsgIds)
+}
+
+func (e TL_rpc_error) writeText(w *textWriter) {
+	w.name("rpc_error")
+	w.field("error_code")
+	w.Int(e.ErrorCode)
+	w.field("error_message")
+	w.String(e.ErrorMessage)
+}
+
+func (e TL_rpc_answer_unknown) writeText(w *textWriter) {
+	w.name("rpc_answer_unknown")
+}
+
+func (e TL_rpc_answer_dropped_running) writeText(w *textWriter) {
+	w.name("rpc_answer_dropped_running")
+}
+
+func (e TL_rpc_answer_dropped) writeText(w *textWriter) {
+	w.name("rpc_answer_dropped")
+	w.field("msg_id")
+	w.Long(e.MsgID)
+	w.field("seq_no")
+	w.Int(e.SeqNo)
+	w.field("bytes")
+	w.Int(e.Bytes)
+}
+
+func (e TL_future_salt) writeText(w *textWriter) {
+	w.name("future_salt")
+	w.field("valid_since")
+	w.Int(e.ValidSince)
+	w.field("valid_until")
+	w.Int(e.ValidUntil)
+	w.field("salt")
+	w.Long(e.Salt)
+}
+
+func (e TL_future_salts) writeText(w *textWriter) {
+	w.name("future_salts")
+	w.field("req_msg_id")
+	w.Long(e.ReqMsgID)
+	w.field("now")
+	w.Int(e.Now)
+	w.field("salts")
+	w.Vector(e.Salts)
+}
+
+func (e TL_pong) writeText(w *textWriter) {
+	w.name("pong")
+	w.field("msg_id")
+	w.Long(e.MsgID)
+	w.field("ping_id")
+	w.Long(e.PingID)
+}
+
+func (e TL_destroy_session_ok) writeText(w *textWriter) {
+	w.name("destroy_session_ok")
+	w.field("session_id")
+	w.Long(e.SessionID)
+}
+
+func (e TL_destroy_session_none) writeText(w *textWriter) {
+	w.name("destroy_session_none")
+	w.field("session_id")
+	w.Long(e.SessionID)
+}
+
+func (e TL_new_session_created) writeText(w *textWriter) {
+	w.name("new_session_created")
+	w.field("first_msg_id")
+	w.Long(e.FirstMsgID)
+	w.field("unique_id")
+	w.Long(e.UniqueID)
+	w.field("server_salt")
+	w.Long(e.ServerSalt)
+}
+
+func (e TL_http_wait) writeText(w *textWriter) {
+	w.name("http_wait")
+	w.field("max_delay")
+	w.Int(e.MaxDelay)
+	w.field("wait_after")
+	w.Int(e.WaitAfter)
+	w.field("max_wait")
+	w.Int(e.MaxWait)
+}
+
+func (e TL_ipPort) writeText(w *textWriter) {
+	w.name("ipPort")
+	w.field("ipv4")
+	w.Int(e.Ipv4)
+	w.field("port")
+	w.Int(e.Port)
+}
+
+func (e TL_ipPortSecret) writeText(w *textWriter) {
+	w.name("ipPortSecret")
+	w.field("ipv4")
+	w.Int(e.Ipv4)
+	w.field("port")
+	w.Int(e.Port)
+	w.field("secret")
+	w.Bytes(e.Secret)
+}
+
+func (e TL_accessPointRule) writeText(w *textWriter) {
+	w.name("accessPointRule")
+	w.field("phone_prefix_rules")
+	w.String(e.PhonePrefixRules)
+	w.field("dc_id")
+	w.Int(e.DcID)
+	w.field("ips")
+	w.Vector(e.Ips)
+}
+
+func (e TL_help_configSimple) writeText(w *textWriter) {
+	w.name("help.configSimple")
+	w.field("date")
+	w.Int(e.Date)
+	w.field("expires")
+	w.Int(e.Expires)
+	w.field("rules")
+	w.Vector(e.Rules)
+}
+
+func (e TL_tlsClientHello) writeText(w *textWriter) {
+	w.name("tlsClientHello")
+	w.field("blocks")
+	w.Vector(e.Blocks)
+}
+
+func (e TL_tlsBlockString) writeText(w *textWriter) {
+	w.name("tlsBlockString")
+	w.field("data")
+	w.String(e.Data)
+}
+
+func (e TL_tlsBlockRandom) writeText(w *textWriter) {
+	w.name("tlsBlockRandom")
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_tlsBlockZero) writeText(w *textWriter) {
+	w.name("tlsBlockZero")
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_tlsBlockDomain) writeText(w *textWriter) {
+	w.name("tlsBlockDomain")
+}
+
+func (e TL_tlsBlockGrease) writeText(w *textWriter) {
+	w.name("tlsBlockGrease")
+	w.field("seed")
+	w.Int(e.Seed)
+}
+
+func (e TL_tlsBlockPublicKey) writeText(w *textWriter) {
+	w.name("tlsBlockPublicKey")
+}
+
+func (e TL_tlsBlockScope) writeText(w *textWriter) {
+	w.name("tlsBlockScope")
+	w.field("entries")
+	w.Vector(e.Entries)
+}
+
+func (e TL_rpc_drop_answer) writeText(w *textWriter) {
+	w.name("rpc_drop_answer")
+	w.field("req_msg_id")
+	w.Long(e.ReqMsgID)
+}
+
+func (e TL_get_future_salts) writeText(w *textWriter) {
+	w.name("get_future_salts")
+	w.field("num")
+	w.Int(e.Num)
+}
+
+func (e TL_ping) writeText(w *textWriter) {
+	w.name("ping")
+	w.field("ping_id")
+	w.Long(e.PingID)
+}
+
+func (e TL_ping_delay_disconnect) writeText(w *textWriter) {
+	w.name("ping_delay_disconnect")
+	w.field("ping_id")
+	w.Long(e.PingID)
+	w.field("disconnect_delay")
+	w.Int(e.DisconnectDelay)
+}
+
+func (e TL_destroy_session) writeText(w *textWriter) {
+	w.name("destroy_session")
+	w.field("session_id")
+	w.Long(e.SessionID)
+}
+
+func (e TL_boolFalse) writeText(w *textWriter) {
+	w.name("boolFalse")
+}
+
+func (e TL_boolTrue) writeText(w *textWriter) {
+	w.name("boolTrue")
+}
+
+func (e TL_true) writeText(w *textWriter) {
+	w.name("true")
+}
+
+func (e TL_error) writeText(w *textWriter) {
+	w.name("error")
+	w.field("code")
+	w.Int(e.Code)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_null) writeText(w *textWriter) {
+	w.name("null")
+}
+
+func (e TL_inputPeerEmpty) writeText(w *textWriter) {
+	w.name("inputPeerEmpty")
+}
+
+func (e TL_inputPeerSelf) writeText(w *textWriter) {
+	w.name("inputPeerSelf")
+}
+
+func (e TL_inputPeerChat) writeText(w *textWriter) {
+	w.name("inputPeerChat")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_inputPeerUser) writeText(w *textWriter) {
+	w.name("inputPeerUser")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputPeerChannel) writeText(w *textWriter) {
+	w.name("inputPeerChannel")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputPeerUserFromMessage) writeText(w *textWriter) {
+	w.name("inputPeerUserFromMessage")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("user_id")
+	w.Int(e.UserID)
+}
+
+func (e TL_inputPeerChannelFromMessage) writeText(w *textWriter) {
+	w.name("inputPeerChannelFromMessage")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+}
+
+func (e TL_inputUserEmpty) writeText(w *textWriter) {
+	w.name("inputUserEmpty")
+}
+
+func (e TL_inputUserSelf) writeText(w *textWriter) {
+	w.name("inputUserSelf")
+}
+
+func (e TL_inputUser) writeText(w *textWriter) {
+	w.name("inputUser")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputUserFromMessage) writeText(w *textWriter) {
+	w.name("inputUserFromMessage")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("user_id")
+	w.Int(e.UserID)
+}
+
+func (e TL_inputPhoneContact) writeText(w *textWriter) {
+	w.name("inputPhoneContact")
+	w.field("client_id")
+	w.Long(e.ClientID)
+	w.field("phone")
+	w.String(e.Phone)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+}
+
+func (e TL_inputFile) writeText(w *textWriter) {
+	w.name("inputFile")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("parts")
+	w.Int(e.Parts)
+	w.field("name")
+	w.String(e.Name)
+	w.field("md5_checksum")
+	w.String(e.Md5Checksum)
+}
+
+func (e TL_inputFileBig) writeText(w *textWriter) {
+	w.name("inputFileBig")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("parts")
+	w.Int(e.Parts)
+	w.field("name")
+	w.String(e.Name)
+}
+
+func (e TL_inputMediaEmpty) writeText(w *textWriter) {
+	w.name("inputMediaEmpty")
+}
+
+func (e TL_inputMediaUploadedPhoto) writeText(w *textWriter) {
+	w.name("inputMediaUploadedPhoto")
+	w.field("file")
+	w.Object(e.File)
+	if e.Flags&1 != 0 {
+		w.field("stickers")
+		w.Vector(e.Stickers)
+	}
+	if e.Flags&2 != 0 {
+		w.field("ttl_seconds")
+		w.Int(e.TtlSeconds)
+	}
+}
+
+func (e TL_inputMediaPhoto) writeText(w *textWriter) {
+	w.name("inputMediaPhoto")
+	w.field("id")
+	w.Object(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("ttl_seconds")
+		w.Int(e.TtlSeconds)
+	}
+}
+
+func (e TL_inputMediaGeoPoint) writeText(w *textWriter) {
+	w.name("inputMediaGeoPoint")
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+}
+
+func (e TL_inputMediaContact) writeText(w *textWriter) {
+	w.name("inputMediaContact")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+	w.field("vcard")
+	w.String(e.Vcard)
+}
+
+func (e TL_inputMediaUploadedDocument) writeText(w *textWriter) {
+	w.name("inputMediaUploadedDocument")
+	if e.Flags&8 != 0 {
+		w.field("nosound_video")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("force_file")
+		w.Bool(true)
+	}
+	w.field("file")
+	w.Object(e.File)
+	if e.Flags&4 != 0 {
+		w.field("thumb")
+		w.Object(e.Thumb)
+	}
+	w.field("mime_type")
+	w.String(e.MimeType)
+	w.field("attributes")
+	w.Vector(e.Attributes)
+	if e.Flags&1 != 0 {
+		w.field("stickers")
+		w.Vector(e.Stickers)
+	}
+	if e.Flags&2 != 0 {
+		w.field("ttl_seconds")
+		w.Int(e.TtlSeconds)
+	}
+}
+
+func (e TL_inputMediaDocument) writeText(w *textWriter) {
+	w.name("inputMediaDocument")
+	w.field("id")
+	w.Object(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("ttl_seconds")
+		w.Int(e.TtlSeconds)
+	}
+	if e.Flags&2 != 0 {
+		w.field("query")
+		w.String(e.Query)
+	}
+}
+
+func (e TL_inputMediaVenue) writeText(w *textWriter) {
+	w.name("inputMediaVenue")
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+	w.field("title")
+	w.String(e.Title)
+	w.field("address")
+	w.String(e.Address)
+	w.field("provider")
+	w.String(e.Provider)
+	w.field("venue_id")
+	w.String(e.VenueID)
+	w.field("venue_type")
+	w.String(e.VenueType)
+}
+
+func (e TL_inputMediaPhotoExternal) writeText(w *textWriter) {
+	w.name("inputMediaPhotoExternal")
+	w.field("url")
+	w.String(e.Url)
+	if e.Flags&1 != 0 {
+		w.field("ttl_seconds")
+		w.Int(e.TtlSeconds)
+	}
+}
+
+func (e TL_inputMediaDocumentExternal) writeText(w *textWriter) {
+	w.name("inputMediaDocumentExternal")
+	w.field("url")
+	w.String(e.Url)
+	if e.Flags&1 != 0 {
+		w.field("ttl_seconds")
+		w.Int(e.TtlSeconds)
+	}
+}
+
+func (e TL_inputMediaGame) writeText(w *textWriter) {
+	w.name("inputMediaGame")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_inputMediaInvoice) writeText(w *textWriter) {
+	w.name("inputMediaInvoice")
+	w.field("title")
+	w.String(e.Title)
+	w.field("description")
+	w.String(e.Description)
+	if e.Flags&1 != 0 {
+		w.field("photo")
+		w.Object(e.Photo)
+	}
+	w.field("invoice")
+	w.Object(e.Invoice)
+	w.field("payload")
+	w.Bytes(e.Payload)
+	w.field("provider")
+	w.String(e.Provider)
+	w.field("provider_data")
+	w.Object(e.ProviderData)
+	w.field("start_param")
+	w.String(e.StartParam)
+}
+
+func (e TL_inputMediaGeoLive) writeText(w *textWriter) {
+	w.name("inputMediaGeoLive")
+	if e.Flags&1 != 0 {
+		w.field("stopped")
+		w.Bool(true)
+	}
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+	if e.Flags&4 != 0 {
+		w.field("heading")
+		w.Int(e.Heading)
+	}
+	if e.Flags&2 != 0 {
+		w.field("period")
+		w.Int(e.Period)
+	}
+	if e.Flags&8 != 0 {
+		w.field("proximity_notification_radius")
+		w.Int(e.ProximityNotificationRadius)
+	}
+}
+
+func (e TL_inputMediaPoll) writeText(w *textWriter) {
+	w.name("inputMediaPoll")
+	w.field("poll")
+	w.Object(e.Poll)
+	if e.Flags&1 != 0 {
+		w.field("correct_answers")
+		w.VectorBytes(e.CorrectAnswers)
+	}
+	if e.Flags&2 != 0 {
+		w.field("solution")
+		w.String(e.Solution)
+	}
+	if e.Flags&2 != 0 {
+		w.field("solution_entities")
+		w.Vector(e.SolutionEntities)
+	}
+}
+
+func (e TL_inputMediaDice) writeText(w *textWriter) {
+	w.name("inputMediaDice")
+	w.field("emoticon")
+	w.String(e.Emoticon)
+}
+
+func (e TL_inputChatPhotoEmpty) writeText(w *textWriter) {
+	w.name("inputChatPhotoEmpty")
+}
+
+func (e TL_inputChatUploadedPhoto) writeText(w *textWriter) {
+	w.name("inputChatUploadedPhoto")
+	if e.Flags&1 != 0 {
+		w.field("file")
+		w.Object(e.File)
+	}
+	if e.Flags&2 != 0 {
+		w.field("video")
+		w.Object(e.Video)
+	}
+	if e.Flags&4 != 0 {
+		w.field("video_start_ts")
+		w.Double(e.VideoStartTs)
+	}
+}
+
+func (e TL_inputChatPhoto) writeText(w *textWriter) {
+	w.name("inputChatPhoto")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_inputGeoPointEmpty) writeText(w *textWriter) {
+	w.name("inputGeoPointEmpty")
+}
+
+func (e TL_inputGeoPoint) writeText(w *textWriter) {
+	w.name("inputGeoPoint")
+	w.field("lat")
+	w.Double(e.Lat)
+	w.field("long")
+	w.Double(e.Long)
+	if e.Flags&1 != 0 {
+		w.field("accuracy_radius")
+		w.Int(e.AccuracyRadius)
+	}
+}
+
+func (e TL_inputPhotoEmpty) writeText(w *textWriter) {
+	w.name("inputPhotoEmpty")
+}
+
+func (e TL_inputPhoto) writeText(w *textWriter) {
+	w.name("inputPhoto")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("file_reference")
+	w.Bytes(e.FileReference)
+}
+
+func (e TL_inputFileLocation) writeText(w *textWriter) {
+	w.name("inputFileLocation")
+	w.field("volume_id")
+	w.Long(e.VolumeID)
+	w.field("local_id")
+	w.Int(e.LocalID)
+	w.field("secret")
+	w.Long(e.Secret)
+	w.field("file_reference")
+	w.Bytes(e.FileReference)
+}
+
+func (e TL_inputEncryptedFileLocation) writeText(w *textWriter) {
+	w.name("inputEncryptedFileLocation")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputDocumentFileLocation) writeText(w *textWriter) {
+	w.name("inputDocumentFileLocation")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("file_reference")
+	w.Bytes(e.FileReference)
+	w.field("thumb_size")
+	w.String(e.ThumbSize)
+}
+
+func (e TL_inputSecureFileLocation) writeText(w *textWriter) {
+	w.name("inputSecureFileLocation")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputTakeoutFileLocation) writeText(w *textWriter) {
+	w.name("inputTakeoutFileLocation")
+}
+
+func (e TL_inputPhotoFileLocation) writeText(w *textWriter) {
+	w.name("inputPhotoFileLocation")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("file_reference")
+	w.Bytes(e.FileReference)
+	w.field("thumb_size")
+	w.String(e.ThumbSize)
+}
+
+func (e TL_inputPhotoLegacyFileLocation) writeText(w *textWriter) {
+	w.name("inputPhotoLegacyFileLocation")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("file_reference")
+	w.Bytes(e.FileReference)
+	w.field("volume_id")
+	w.Long(e.VolumeID)
+	w.field("local_id")
+	w.Int(e.LocalID)
+	w.field("secret")
+	w.Long(e.Secret)
+}
+
+func (e TL_inputPeerPhotoFileLocation) writeText(w *textWriter) {
+	w.name("inputPeerPhotoFileLocation")
+	if e.Flags&1 != 0 {
+		w.field("big")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("volume_id")
+	w.Long(e.VolumeID)
+	w.field("local_id")
+	w.Int(e.LocalID)
+}
+
+func (e TL_inputStickerSetThumb) writeText(w *textWriter) {
+	w.name("inputStickerSetThumb")
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+	w.field("volume_id")
+	w.Long(e.VolumeID)
+	w.field("local_id")
+	w.Int(e.LocalID)
+}
+
+func (e TL_inputGroupCallStream) writeText(w *textWriter) {
+	w.name("inputGroupCallStream")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("time_ms")
+	w.Long(e.TimeMs)
+	w.field("scale")
+	w.Int(e.Scale)
+}
+
+func (e TL_peerUser) writeText(w *textWriter) {
+	w.name("peerUser")
+	w.field("user_id")
+	w.Int(e.UserID)
+}
+
+func (e TL_peerChat) writeText(w *textWriter) {
+	w.name("peerChat")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_peerChannel) writeText(w *textWriter) {
+	w.name("peerChannel")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+}
+
+func (e TL_storage_fileUnknown) writeText(w *textWriter) {
+	w.name("storage.fileUnknown")
+}
+
+func (e TL_storage_filePartial) writeText(w *textWriter) {
+	w.name("storage.filePartial")
+}
+
+func (e TL_storage_fileJpeg) writeText(w *textWriter) {
+	w.name("storage.fileJpeg")
+}
+
+func (e TL_storage_fileGif) writeText(w *textWriter) {
+	w.name("storage.fileGif")
+}
+
+func (e TL_storage_filePng) writeText(w *textWriter) {
+	w.name("storage.filePng")
+}
+
+func (e TL_storage_filePdf) writeText(w *textWriter) {
+	w.name("storage.filePdf")
+}
+
+func (e TL_storage_fileMp3) writeText(w *textWriter) {
+	w.name("storage.fileMp3")
+}
+
+func (e TL_storage_fileMov) writeText(w *textWriter) {
+	w.name("storage.fileMov")
+}
+
+func (e TL_storage_fileMp4) writeText(w *textWriter) {
+	w.name("storage.fileMp4")
+}
+
+func (e TL_storage_fileWebp) writeText(w *textWriter) {
+	w.name("storage.fileWebp")
+}
+
+func (e TL_userEmpty) writeText(w *textWriter) {
+	w.name("userEmpty")
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_user) writeText(w *textWriter) {
+	w.name("user")
+	if e.Flags&1024 != 0 {
+		w.field("self")
+		w.Bool(true)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("contact")
+		w.Bool(true)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("mutual_contact")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("deleted")
+		w.Bool(true)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("bot")
+		w.Bool(true)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("bot_chat_history")
+		w.Bool(true)
+	}
+	if e.Flags&65536 != 0 {
+		w.field("bot_nochats")
+		w.Bool(true)
+	}
+	if e.Flags&131072 != 0 {
+		w.field("verified")
+		w.Bool(true)
+	}
+	if e.Flags&262144 != 0 {
+		w.field("restricted")
+		w.Bool(true)
+	}
+	if e.Flags&1048576 != 0 {
+		w.field("min")
+		w.Bool(true)
+	}
+	if e.Flags&2097152 != 0 {
+		w.field("bot_inline_geo")
+		w.Bool(true)
+	}
+	if e.Flags&8388608 != 0 {
+		w.field("support")
+		w.Bool(true)
+	}
+	if e.Flags&16777216 != 0 {
+		w.field("scam")
+		w.Bool(true)
+	}
+	if e.Flags&33554432 != 0 {
+		w.field("apply_min_photo")
+		w.Bool(true)
+	}
+	if e.Flags&67108864 != 0 {
+		w.field("fake")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("access_hash")
+		w.Long(e.AccessHash)
+	}
+	if e.Flags&2 != 0 {
+		w.field("first_name")
+		w.String(e.FirstName)
+	}
+	if e.Flags&4 != 0 {
+		w.field("last_name")
+		w.String(e.LastName)
+	}
+	if e.Flags&8 != 0 {
+		w.field("username")
+		w.String(e.Username)
+	}
+	if e.Flags&16 != 0 {
+		w.field("phone")
+		w.String(e.Phone)
+	}
+	if e.Flags&32 != 0 {
+		w.field("photo")
+		w.Object(e.Photo)
+	}
+	if e.Flags&64 != 0 {
+		w.field("status")
+		w.Object(e.Status)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("bot_info_version")
+		w.Int(e.BotInfoVersion)
+	}
+	if e.Flags&262144 != 0 {
+		w.field("restriction_reason")
+		w.Vector(e.RestrictionReason)
+	}
+	if e.Flags&524288 != 0 {
+		w.field("bot_inline_placeholder")
+		w.String(e.BotInlinePlaceholder)
+	}
+	if e.Flags&4194304 != 0 {
+		w.field("lang_code")
+		w.String(e.LangCode)
+	}
+}
+
+func (e TL_userProfilePhotoEmpty) writeText(w *textWriter) {
+	w.name("userProfilePhotoEmpty")
+}
+
+func (e TL_userProfilePhoto) writeText(w *textWriter) {
+	w.name("userProfilePhoto")
+	if e.Flags&1 != 0 {
+		w.field("has_video")
+		w.Bool(true)
+	}
+	w.field("photo_id")
+	w.Long(e.PhotoID)
+	w.field("photo_small")
+	w.Object(e.PhotoSmall)
+	w.field("photo_big")
+	w.Object(e.PhotoBig)
+	w.field("dc_id")
+	w.Int(e.DcID)
+}
+
+func (e TL_userStatusEmpty) writeText(w *textWriter) {
+	w.name("userStatusEmpty")
+}
+
+func (e TL_userStatusOnline) writeText(w *textWriter) {
+	w.name("userStatusOnline")
+	w.field("expires")
+	w.Int(e.Expires)
+}
+
+func (e TL_userStatusOffline) writeText(w *textWriter) {
+	w.name("userStatusOffline")
+	w.field("was_online")
+	w.Int(e.WasOnline)
+}
+
+func (e TL_userStatusRecently) writeText(w *textWriter) {
+	w.name("userStatusRecently")
+}
+
+func (e TL_userStatusLastWeek) writeText(w *textWriter) {
+	w.name("userStatusLastWeek")
+}
+
+func (e TL_userStatusLastMonth) writeText(w *textWriter) {
+	w.name("userStatusLastMonth")
+}
+
+func (e TL_chatEmpty) writeText(w *textWriter) {
+	w.name("chatEmpty")
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_chat) writeText(w *textWriter) {
+	w.name("chat")
+	if e.Flags&1 != 0 {
+		w.field("creator")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("kicked")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("left")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("deactivated")
+		w.Bool(true)
+	}
+	if e.Flags&8388608 != 0 {
+		w.field("call_active")
+		w.Bool(true)
+	}
+	if e.Flags&16777216 != 0 {
+		w.field("call_not_empty")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("title")
+	w.String(e.Title)
+	w.field("photo")
+	w.Object(e.Photo)
+	w.field("participants_count")
+	w.Int(e.ParticipantsCount)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("version")
+	w.Int(e.Version)
+	if e.Flags&64 != 0 {
+		w.field("migrated_to")
+		w.Object(e.MigratedTo)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("admin_rights")
+		w.Object(e.AdminRights)
+	}
+	if e.Flags&262144 != 0 {
+		w.field("default_banned_rights")
+		w.Object(e.DefaultBannedRights)
+	}
+}
+
+func (e TL_chatForbidden) writeText(w *textWriter) {
+	w.name("chatForbidden")
+	w.field("id")
+	w.Int(e.ID)
+	w.field("title")
+	w.String(e.Title)
+}
+
+func (e TL_channel) writeText(w *textWriter) {
+	w.name("channel")
+	if e.Flags&1 != 0 {
+		w.field("creator")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("left")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("broadcast")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("verified")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("megagroup")
+		w.Bool(true)
+	}
+	if e.Flags&512 != 0 {
+		w.field("restricted")
+		w.Bool(true)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("signatures")
+		w.Bool(true)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("min")
+		w.Bool(true)
+	}
+	if e.Flags&524288 != 0 {
+		w.field("scam")
+		w.Bool(true)
+	}
+	if e.Flags&1048576 != 0 {
+		w.field("has_link")
+		w.Bool(true)
+	}
+	if e.Flags&2097152 != 0 {
+		w.field("has_geo")
+		w.Bool(true)
+	}
+	if e.Flags&4194304 != 0 {
+		w.field("slowmode_enabled")
+		w.Bool(true)
+	}
+	if e.Flags&8388608 != 0 {
+		w.field("call_active")
+		w.Bool(true)
+	}
+	if e.Flags&16777216 != 0 {
+		w.field("call_not_empty")
+		w.Bool(true)
+	}
+	if e.Flags&33554432 != 0 {
+		w.field("fake")
+		w.Bool(true)
+	}
+	if e.Flags&67108864 != 0 {
+		w.field("gigagroup")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&8192 != 0 {
+		w.field("access_hash")
+		w.Long(e.AccessHash)
+	}
+	w.field("title")
+	w.String(e.Title)
+	if e.Flags&64 != 0 {
+		w.field("username")
+		w.String(e.Username)
+	}
+	w.field("photo")
+	w.Object(e.Photo)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("version")
+	w.Int(e.Version)
+	if e.Flags&512 != 0 {
+		w.field("restriction_reason")
+		w.Vector(e.RestrictionReason)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("admin_rights")
+		w.Object(e.AdminRights)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("banned_rights")
+		w.Object(e.BannedRights)
+	}
+	if e.Flags&262144 != 0 {
+		w.field("default_banned_rights")
+		w.Object(e.DefaultBannedRights)
+	}
+	if e.Flags&131072 != 0 {
+		w.field("participants_count")
+		w.Int(e.ParticipantsCount)
+	}
+}
+
+func (e TL_channelForbidden) writeText(w *textWriter) {
+	w.name("channelForbidden")
+	if e.Flags&32 != 0 {
+		w.field("broadcast")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("megagroup")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("title")
+	w.String(e.Title)
+	if e.Flags&65536 != 0 {
+		w.field("until_date")
+		w.Int(e.UntilDate)
+	}
+}
+
+func (e TL_chatFull) writeText(w *textWriter) {
+	w.name("chatFull")
+	if e.Flags&128 != 0 {
+		w.field("can_set_username")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("has_scheduled")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("about")
+	w.String(e.About)
+	w.field("participants")
+	w.Object(e.Participants)
+	if e.Flags&4 != 0 {
+		w.field("chat_photo")
+		w.Object(e.ChatPhoto)
+	}
+	w.field("notify_settings")
+	w.Object(e.NotifySettings)
+	if e.Flags&8192 != 0 {
+		w.field("exported_invite")
+		w.Object(e.ExportedInvite)
+	}
+	if e.Flags&8 != 0 {
+		w.field("bot_info")
+		w.Vector(e.BotInfo)
+	}
+	if e.Flags&64 != 0 {
+		w.field("pinned_msg_id")
+		w.Int(e.PinnedMsgID)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("call")
+		w.Object(e.Call)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("groupcall_default_join_as")
+		w.Object(e.GroupcallDefaultJoinAs)
+	}
+}
+
+func (e TL_channelFull) writeText(w *textWriter) {
+	w.name("channelFull")
+	if e.Flags&8 != 0 {
+		w.field("can_view_participants")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("can_set_username")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("can_set_stickers")
+		w.Bool(true)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("hidden_prehistory")
+		w.Bool(true)
+	}
+	if e.Flags&65536 != 0 {
+		w.field("can_set_location")
+		w.Bool(true)
+	}
+	if e.Flags&524288 != 0 {
+		w.field("has_scheduled")
+		w.Bool(true)
+	}
+	if e.Flags&1048576 != 0 {
+		w.field("can_view_stats")
+		w.Bool(true)
+	}
+	if e.Flags&4194304 != 0 {
+		w.field("blocked")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("about")
+	w.String(e.About)
+	if e.Flags&1 != 0 {
+		w.field("participants_count")
+		w.Int(e.ParticipantsCount)
+	}
+	if e.Flags&2 != 0 {
+		w.field("admins_count")
+		w.Int(e.AdminsCount)
+	}
+	if e.Flags&4 != 0 {
+		w.field("kicked_count")
+		w.Int(e.KickedCount)
+	}
+	if e.Flags&4 != 0 {
+		w.field("banned_count")
+		w.Int(e.BannedCount)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("online_count")
+		w.Int(e.OnlineCount)
+	}
+	w.field("read_inbox_max_id")
+	w.Int(e.ReadInboxMaxID)
+	w.field("read_outbox_max_id")
+	w.Int(e.ReadOutboxMaxID)
+	w.field("unread_count")
+	w.Int(e.UnreadCount)
+	w.field("chat_photo")
+	w.Object(e.ChatPhoto)
+	w.field("notify_settings")
+	w.Object(e.NotifySettings)
+	if e.Flags&8388608 != 0 {
+		w.field("exported_invite")
+		w.Object(e.ExportedInvite)
+	}
+	w.field("bot_info")
+	w.Vector(e.BotInfo)
+	if e.Flags&16 != 0 {
+		w.field("migrated_from_chat_id")
+		w.Int(e.MigratedFromChatID)
+	}
+	if e.Flags&16 != 0 {
+		w.field("migrated_from_max_id")
+		w.Int(e.MigratedFromMaxID)
+	}
+	if e.Flags&32 != 0 {
+		w.field("pinned_msg_id")
+		w.Int(e.PinnedMsgID)
+	}
+	if e.Flags&256 != 0 {
+		w.field("stickerset")
+		w.Object(e.Stickerset)
+	}
+	if e.Flags&512 != 0 {
+		w.field("available_min_id")
+		w.Int(e.AvailableMinID)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("linked_chat_id")
+		w.Int(e.LinkedChatID)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("location")
+		w.Object(e.Location)
+	}
+	if e.Flags&131072 != 0 {
+		w.field("slowmode_seconds")
+		w.Int(e.SlowmodeSeconds)
+	}
+	if e.Flags&262144 != 0 {
+		w.field("slowmode_next_send_date")
+		w.Int(e.SlowmodeNextSendDate)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("stats_dc")
+		w.Int(e.StatsDc)
+	}
+	w.field("pts")
+	w.Int(e.Pts)
+	if e.Flags&2097152 != 0 {
+		w.field("call")
+		w.Object(e.Call)
+	}
+	if e.Flags&16777216 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+	if e.Flags&33554432 != 0 {
+		w.field("pending_suggestions")
+		w.VectorString(e.PendingSuggestions)
+	}
+	if e.Flags&67108864 != 0 {
+		w.field("groupcall_default_join_as")
+		w.Object(e.GroupcallDefaultJoinAs)
+	}
+}
+
+func (e TL_chatParticipant) writeText(w *textWriter) {
+	w.name("chatParticipant")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("inviter_id")
+	w.Int(e.InviterID)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_chatParticipantCreator) writeText(w *textWriter) {
+	w.name("chatParticipantCreator")
+	w.field("user_id")
+	w.Int(e.UserID)
+}
+
+func (e TL_chatParticipantAdmin) writeText(w *textWriter) {
+	w.name("chatParticipantAdmin")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("inviter_id")
+	w.Int(e.InviterID)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_chatParticipantsForbidden) writeText(w *textWriter) {
+	w.name("chatParticipantsForbidden")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	if e.Flags&1 != 0 {
+		w.field("self_participant")
+		w.Object(e.SelfParticipant)
+	}
+}
+
+func (e TL_chatParticipants) writeText(w *textWriter) {
+	w.name("chatParticipants")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("participants")
+	w.Vector(e.Participants)
+	w.field("version")
+	w.Int(e.Version)
+}
+
+func (e TL_chatPhotoEmpty) writeText(w *textWriter) {
+	w.name("chatPhotoEmpty")
+}
+
+func (e TL_chatPhoto) writeText(w *textWriter) {
+	w.name("chatPhoto")
+	if e.Flags&1 != 0 {
+		w.field("has_video")
+		w.Bool(true)
+	}
+	w.field("photo_small")
+	w.Object(e.PhotoSmall)
+	w.field("photo_big")
+	w.Object(e.PhotoBig)
+	w.field("dc_id")
+	w.Int(e.DcID)
+}
+
+func (e TL_messageEmpty) writeText(w *textWriter) {
+	w.name("messageEmpty")
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("peer_id")
+		w.Object(e.PeerID)
+	}
+}
+
+func (e TL_message) writeText(w *textWriter) {
+	w.name("message")
+	if e.Flags&2 != 0 {
+		w.field("out")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("mentioned")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("media_unread")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("post")
+		w.Bool(true)
+	}
+	if e.Flags&262144 != 0 {
+		w.field("from_scheduled")
+		w.Bool(true)
+	}
+	if e.Flags&524288 != 0 {
+		w.field("legacy")
+		w.Bool(true)
+	}
+	if e.Flags&2097152 != 0 {
+		w.field("edit_hide")
+		w.Bool(true)
+	}
+	if e.Flags&16777216 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&256 != 0 {
+		w.field("from_id")
+		w.Object(e.FromID)
+	}
+	w.field("peer_id")
+	w.Object(e.PeerID)
+	if e.Flags&4 != 0 {
+		w.field("fwd_from")
+		w.Object(e.FwdFrom)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("via_bot_id")
+		w.Int(e.ViaBotID)
+	}
+	if e.Flags&8 != 0 {
+		w.field("reply_to")
+		w.Object(e.ReplyTo)
+	}
+	w.field("date")
+	w.Int(e.Date)
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&512 != 0 {
+		w.field("media")
+		w.Object(e.Media)
+	}
+	if e.Flags&64 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+	if e.Flags&128 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("views")
+		w.Int(e.Views)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("forwards")
+		w.Int(e.Forwards)
+	}
+	if e.Flags&8388608 != 0 {
+		w.field("replies")
+		w.Object(e.Replies)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("edit_date")
+		w.Int(e.EditDate)
+	}
+	if e.Flags&65536 != 0 {
+		w.field("post_author")
+		w.String(e.PostAuthor)
+	}
+	if e.Flags&131072 != 0 {
+		w.field("grouped_id")
+		w.Long(e.GroupedID)
+	}
+	if e.Flags&4194304 != 0 {
+		w.field("restriction_reason")
+		w.Vector(e.RestrictionReason)
+	}
+	if e.Flags&33554432 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+}
+
+func (e TL_messageService) writeText(w *textWriter) {
+	w.name("messageService")
+	if e.Flags&2 != 0 {
+		w.field("out")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("mentioned")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("media_unread")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("post")
+		w.Bool(true)
+	}
+	if e.Flags&524288 != 0 {
+		w.field("legacy")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&256 != 0 {
+		w.field("from_id")
+		w.Object(e.FromID)
+	}
+	w.field("peer_id")
+	w.Object(e.PeerID)
+	if e.Flags&8 != 0 {
+		w.field("reply_to")
+		w.Object(e.ReplyTo)
+	}
+	w.field("date")
+	w.Int(e.Date)
+	w.field("action")
+	w.Object(e.Action)
+	if e.Flags&33554432 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+}
+
+func (e TL_messageMediaEmpty) writeText(w *textWriter) {
+	w.name("messageMediaEmpty")
+}
+
+func (e TL_messageMediaPhoto) writeText(w *textWriter) {
+	w.name("messageMediaPhoto")
+	if e.Flags&1 != 0 {
+		w.field("photo")
+		w.Object(e.Photo)
+	}
+	if e.Flags&4 != 0 {
+		w.field("ttl_seconds")
+		w.Int(e.TtlSeconds)
+	}
+}
+
+func (e TL_messageMediaGeo) writeText(w *textWriter) {
+	w.name("messageMediaGeo")
+	w.field("geo")
+	w.Object(e.Geo)
+}
+
+func (e TL_messageMediaContact) writeText(w *textWriter) {
+	w.name("messageMediaContact")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+	w.field("vcard")
+	w.String(e.Vcard)
+	w.field("user_id")
+	w.Int(e.UserID)
+}
+
+func (e TL_messageMediaUnsupported) writeText(w *textWriter) {
+	w.name("messageMediaUnsupported")
+}
+
+func (e TL_messageMediaDocument) writeText(w *textWriter) {
+	w.name("messageMediaDocument")
+	if e.Flags&1 != 0 {
+		w.field("document")
+		w.Object(e.Document)
+	}
+	if e.Flags&4 != 0 {
+		w.field("ttl_seconds")
+		w.Int(e.TtlSeconds)
+	}
+}
+
+func (e TL_messageMediaWebPage) writeText(w *textWriter) {
+	w.name("messageMediaWebPage")
+	w.field("webpage")
+	w.Object(e.Webpage)
+}
+
+func (e TL_messageMediaVenue) writeText(w *textWriter) {
+	w.name("messageMediaVenue")
+	w.field("geo")
+	w.Object(e.Geo)
+	w.field("title")
+	w.String(e.Title)
+	w.field("address")
+	w.String(e.Address)
+	w.field("provider")
+	w.String(e.Provider)
+	w.field("venue_id")
+	w.String(e.VenueID)
+	w.field("venue_type")
+	w.String(e.VenueType)
+}
+
+func (e TL_messageMediaGame) writeText(w *textWriter) {
+	w.name("messageMediaGame")
+	w.field("game")
+	w.Object(e.Game)
+}
+
+func (e TL_messageMediaInvoice) writeText(w *textWriter) {
+	w.name("messageMediaInvoice")
+	if e.Flags&2 != 0 {
+		w.field("shipping_address_requested")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("test")
+		w.Bool(true)
+	}
+	w.field("title")
+	w.String(e.Title)
+	w.field("description")
+	w.String(e.Description)
+	if e.Flags&1 != 0 {
+		w.field("photo")
+		w.Object(e.Photo)
+	}
+	if e.Flags&4 != 0 {
+		w.field("receipt_msg_id")
+		w.Int(e.ReceiptMsgID)
+	}
+	w.field("currency")
+	w.String(e.Currency)
+	w.field("total_amount")
+	w.Long(e.TotalAmount)
+	w.field("start_param")
+	w.String(e.StartParam)
+}
+
+func (e TL_messageMediaGeoLive) writeText(w *textWriter) {
+	w.name("messageMediaGeoLive")
+	w.field("geo")
+	w.Object(e.Geo)
+	if e.Flags&1 != 0 {
+		w.field("heading")
+		w.Int(e.Heading)
+	}
+	w.field("period")
+	w.Int(e.Period)
+	if e.Flags&2 != 0 {
+		w.field("proximity_notification_radius")
+		w.Int(e.ProximityNotificationRadius)
+	}
+}
+
+func (e TL_messageMediaPoll) writeText(w *textWriter) {
+	w.name("messageMediaPoll")
+	w.field("poll")
+	w.Object(e.Poll)
+	w.field("results")
+	w.Object(e.Results)
+}
+
+func (e TL_messageMediaDice) writeText(w *textWriter) {
+	w.name("messageMediaDice")
+	w.field("value")
+	w.Int(e.Value)
+	w.field("emoticon")
+	w.String(e.Emoticon)
+}
+
+func (e TL_messageActionEmpty) writeText(w *textWriter) {
+	w.name("messageActionEmpty")
+}
+
+func (e TL_messageActionChatCreate) writeText(w *textWriter) {
+	w.name("messageActionChatCreate")
+	w.field("title")
+	w.String(e.Title)
+	w.field("users")
+	w.VectorInt(e.Users)
+}
+
+func (e TL_messageActionChatEditTitle) writeText(w *textWriter) {
+	w.name("messageActionChatEditTitle")
+	w.field("title")
+	w.String(e.Title)
+}
+
+func (e TL_messageActionChatEditPhoto) writeText(w *textWriter) {
+	w.name("messageActionChatEditPhoto")
+	w.field("photo")
+	w.Object(e.Photo)
+}
+
+func (e TL_messageActionChatDeletePhoto) writeText(w *textWriter) {
+	w.name("messageActionChatDeletePhoto")
+}
+
+func (e TL_messageActionChatAddUser) writeText(w *textWriter) {
+	w.name("messageActionChatAddUser")
+	w.field("users")
+	w.VectorInt(e.Users)
+}
+
+func (e TL_messageActionChatDeleteUser) writeText(w *textWriter) {
+	w.name("messageActionChatDeleteUser")
+	w.field("user_id")
+	w.Int(e.UserID)
+}
+
+func (e TL_messageActionChatJoinedByLink) writeText(w *textWriter) {
+	w.name("messageActionChatJoinedByLink")
+	w.field("inviter_id")
+	w.Int(e.InviterID)
+}
+
+func (e TL_messageActionChannelCreate) writeText(w *textWriter) {
+	w.name("messageActionChannelCreate")
+	w.field("title")
+	w.String(e.Title)
+}
+
+func (e TL_messageActionChatMigrateTo) writeText(w *textWriter) {
+	w.name("messageActionChatMigrateTo")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+}
+
+func (e TL_messageActionChannelMigrateFrom) writeText(w *textWriter) {
+	w.name("messageActionChannelMigrateFrom")
+	w.field("title")
+	w.String(e.Title)
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_messageActionPinMessage) writeText(w *textWriter) {
+	w.name("messageActionPinMessage")
+}
+
+func (e TL_messageActionHistoryClear) writeText(w *textWriter) {
+	w.name("messageActionHistoryClear")
+}
+
+func (e TL_messageActionGameScore) writeText(w *textWriter) {
+	w.name("messageActionGameScore")
+	w.field("game_id")
+	w.Long(e.GameID)
+	w.field("score")
+	w.Int(e.Score)
+}
+
+func (e TL_messageActionPaymentSentMe) writeText(w *textWriter) {
+	w.name("messageActionPaymentSentMe")
+	w.field("currency")
+	w.String(e.Currency)
+	w.field("total_amount")
+	w.Long(e.TotalAmount)
+	w.field("payload")
+	w.Bytes(e.Payload)
+	if e.Flags&1 != 0 {
+		w.field("info")
+		w.Object(e.Info)
+	}
+	if e.Flags&2 != 0 {
+		w.field("shipping_option_id")
+		w.String(e.ShippingOptionID)
+	}
+	w.field("charge")
+	w.Object(e.Charge)
+}
+
+func (e TL_messageActionPaymentSent) writeText(w *textWriter) {
+	w.name("messageActionPaymentSent")
+	w.field("currency")
+	w.String(e.Currency)
+	w.field("total_amount")
+	w.Long(e.TotalAmount)
+}
+
+func (e TL_messageActionPhoneCall) writeText(w *textWriter) {
+	w.name("messageActionPhoneCall")
+	if e.Flags&4 != 0 {
+		w.field("video")
+		w.Bool(true)
+	}
+	w.field("call_id")
+	w.Long(e.CallID)
+	if e.Flags&1 != 0 {
+		w.field("reason")
+		w.Object(e.Reason)
+	}
+	if e.Flags&2 != 0 {
+		w.field("duration")
+		w.Int(e.Duration)
+	}
+}
+
+func (e TL_messageActionScreenshotTaken) writeText(w *textWriter) {
+	w.name("messageActionScreenshotTaken")
+}
+
+func (e TL_messageActionCustomAction) writeText(w *textWriter) {
+	w.name("messageActionCustomAction")
+	w.field("message")
+	w.String(e.Message)
+}
+
+func (e TL_messageActionBotAllowed) writeText(w *textWriter) {
+	w.name("messageActionBotAllowed")
+	w.field("domain")
+	w.String(e.Domain)
+}
+
+func (e TL_messageActionSecureValuesSentMe) writeText(w *textWriter) {
+	w.name("messageActionSecureValuesSentMe")
+	w.field("values")
+	w.Vector(e.Values)
+	w.field("credentials")
+	w.Object(e.Credentials)
+}
+
+func (e TL_messageActionSecureValuesSent) writeText(w *textWriter) {
+	w.name("messageActionSecureValuesSent")
+	w.field("types")
+	w.Vector(e.Types)
+}
+
+func (e TL_messageActionContactSignUp) writeText(w *textWriter) {
+	w.name("messageActionContactSignUp")
+}
+
+func (e TL_messageActionGeoProximityReached) writeText(w *textWriter) {
+	w.name("messageActionGeoProximityReached")
+	w.field("from_id")
+	w.Object(e.FromID)
+	w.field("to_id")
+	w.Object(e.ToID)
+	w.field("distance")
+	w.Int(e.Distance)
+}
+
+func (e TL_messageActionGroupCall) writeText(w *textWriter) {
+	w.name("messageActionGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+	if e.Flags&1 != 0 {
+		w.field("duration")
+		w.Int(e.Duration)
+	}
+}
+
+func (e TL_messageActionInviteToGroupCall) writeText(w *textWriter) {
+	w.name("messageActionInviteToGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("users")
+	w.VectorInt(e.Users)
+}
+
+func (e TL_messageActionSetMessagesTTL) writeText(w *textWriter) {
+	w.name("messageActionSetMessagesTTL")
+	w.field("period")
+	w.Int(e.Period)
+}
+
+func (e TL_dialog) writeText(w *textWriter) {
+	w.name("dialog")
+	if e.Flags&4 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("unread_mark")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("top_message")
+	w.Int(e.TopMessage)
+	w.field("read_inbox_max_id")
+	w.Int(e.ReadInboxMaxID)
+	w.field("read_outbox_max_id")
+	w.Int(e.ReadOutboxMaxID)
+	w.field("unread_count")
+	w.Int(e.UnreadCount)
+	w.field("unread_mentions_count")
+	w.Int(e.UnreadMentionsCount)
+	w.field("notify_settings")
+	w.Object(e.NotifySettings)
+	if e.Flags&1 != 0 {
+		w.field("pts")
+		w.Int(e.Pts)
+	}
+	if e.Flags&2 != 0 {
+		w.field("draft")
+		w.Object(e.Draft)
+	}
+	if e.Flags&16 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+}
+
+func (e TL_dialogFolder) writeText(w *textWriter) {
+	w.name("dialogFolder")
+	if e.Flags&4 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	w.field("folder")
+	w.Object(e.Folder)
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("top_message")
+	w.Int(e.TopMessage)
+	w.field("unread_muted_peers_count")
+	w.Int(e.UnreadMutedPeersCount)
+	w.field("unread_unmuted_peers_count")
+	w.Int(e.UnreadUnmutedPeersCount)
+	w.field("unread_muted_messages_count")
+	w.Int(e.UnreadMutedMessagesCount)
+	w.field("unread_unmuted_messages_count")
+	w.Int(e.UnreadUnmutedMessagesCount)
+}
+
+func (e TL_photoEmpty) writeText(w *textWriter) {
+	w.name("photoEmpty")
+	w.field("id")
+	w.Long(e.ID)
+}
+
+func (e TL_photo) writeText(w *textWriter) {
+	w.name("photo")
+	if e.Flags&1 != 0 {
+		w.field("has_stickers")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("file_reference")
+	w.Bytes(e.FileReference)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("sizes")
+	w.Vector(e.Sizes)
+	if e.Flags&2 != 0 {
+		w.field("video_sizes")
+		w.Vector(e.VideoSizes)
+	}
+	w.field("dc_id")
+	w.Int(e.DcID)
+}
+
+func (e TL_photoSizeEmpty) writeText(w *textWriter) {
+	w.name("photoSizeEmpty")
+	w.field("type")
+	w.String(e.Type)
+}
+
+func (e TL_photoSize) writeText(w *textWriter) {
+	w.name("photoSize")
+	w.field("type")
+	w.String(e.Type)
+	w.field("location")
+	w.Object(e.Location)
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+	w.field("size")
+	w.Int(e.Size)
+}
+
+func (e TL_photoCachedSize) writeText(w *textWriter) {
+	w.name("photoCachedSize")
+	w.field("type")
+	w.String(e.Type)
+	w.field("location")
+	w.Object(e.Location)
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_photoStrippedSize) writeText(w *textWriter) {
+	w.name("photoStrippedSize")
+	w.field("type")
+	w.String(e.Type)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_photoSizeProgressive) writeText(w *textWriter) {
+	w.name("photoSizeProgressive")
+	w.field("type")
+	w.String(e.Type)
+	w.field("location")
+	w.Object(e.Location)
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+	w.field("sizes")
+	w.VectorInt(e.Sizes)
+}
+
+func (e TL_photoPathSize) writeText(w *textWriter) {
+	w.name("photoPathSize")
+	w.field("type")
+	w.String(e.Type)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_geoPointEmpty) writeText(w *textWriter) {
+	w.name("geoPointEmpty")
+}
+
+func (e TL_geoPoint) writeText(w *textWriter) {
+	w.name("geoPoint")
+	w.field("long")
+	w.Double(e.Long)
+	w.field("lat")
+	w.Double(e.Lat)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	if e.Flags&1 != 0 {
+		w.field("accuracy_radius")
+		w.Int(e.AccuracyRadius)
+	}
+}
+
+func (e TL_auth_sentCode) writeText(w *textWriter) {
+	w.name("auth.sentCode")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("phone_code_hash")
+	w.String(e.PhoneCodeHash)
+	if e.Flags&2 != 0 {
+		w.field("next_type")
+		w.Object(e.NextType)
+	}
+	if e.Flags&4 != 0 {
+		w.field("timeout")
+		w.Int(e.Timeout)
+	}
+}
+
+func (e TL_auth_authorization) writeText(w *textWriter) {
+	w.name("auth.authorization")
+	if e.Flags&1 != 0 {
+		w.field("tmp_sessions")
+		w.Int(e.TmpSessions)
+	}
+	w.field("user")
+	w.Object(e.User)
+}
+
+func (e TL_auth_authorizationSignUpRequired) writeText(w *textWriter) {
+	w.name("auth.authorizationSignUpRequired")
+	if e.Flags&1 != 0 {
+		w.field("terms_of_service")
+		w.Object(e.TermsOfService)
+	}
+}
+
+func (e TL_auth_exportedAuthorization) writeText(w *textWriter) {
+	w.name("auth.exportedAuthorization")
+	w.field("id")
+	w.Int(e.ID)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_inputNotifyPeer) writeText(w *textWriter) {
+	w.name("inputNotifyPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_inputNotifyUsers) writeText(w *textWriter) {
+	w.name("inputNotifyUsers")
+}
+
+func (e TL_inputNotifyChats) writeText(w *textWriter) {
+	w.name("inputNotifyChats")
+}
+
+func (e TL_inputNotifyBroadcasts) writeText(w *textWriter) {
+	w.name("inputNotifyBroadcasts")
+}
+
+func (e TL_inputPeerNotifySettings) writeText(w *textWriter) {
+	w.name("inputPeerNotifySettings")
+	if e.Flags&1 != 0 {
+		w.field("show_previews")
+		w.Object(e.ShowPreviews)
+	}
+	if e.Flags&2 != 0 {
+		w.field("silent")
+		w.Object(e.Silent)
+	}
+	if e.Flags&4 != 0 {
+		w.field("mute_until")
+		w.Int(e.MuteUntil)
+	}
+	if e.Flags&8 != 0 {
+		w.field("sound")
+		w.String(e.Sound)
+	}
+}
+
+func (e TL_peerNotifySettings) writeText(w *textWriter) {
+	w.name("peerNotifySettings")
+	if e.Flags&1 != 0 {
+		w.field("show_previews")
+		w.Object(e.ShowPreviews)
+	}
+	if e.Flags&2 != 0 {
+		w.field("silent")
+		w.Object(e.Silent)
+	}
+	if e.Flags&4 != 0 {
+		w.field("mute_until")
+		w.Int(e.MuteUntil)
+	}
+	if e.Flags&8 != 0 {
+		w.field("sound")
+		w.String(e.Sound)
+	}
+}
+
+func (e TL_peerSettings) writeText(w *textWriter) {
+	w.name("peerSettings")
+	if e.Flags&1 != 0 {
+		w.field("report_spam")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("add_contact")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("block_contact")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("share_contact")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("need_contacts_exception")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("report_geo")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("autoarchived")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("invite_members")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("geo_distance")
+		w.Int(e.GeoDistance)
+	}
+}
+
+func (e TL_wallPaper) writeText(w *textWriter) {
+	w.name("wallPaper")
+	w.field("id")
+	w.Long(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("creator")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("default")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("pattern")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("dark")
+		w.Bool(true)
+	}
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("slug")
+	w.String(e.Slug)
+	w.field("document")
+	w.Object(e.Document)
+	if e.Flags&4 != 0 {
+		w.field("settings")
+		w.Object(e.Settings)
+	}
+}
+
+func (e TL_wallPaperNoFile) writeText(w *textWriter) {
+	w.name("wallPaperNoFile")
+	if e.Flags&2 != 0 {
+		w.field("default")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("dark")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("settings")
+		w.Object(e.Settings)
+	}
+}
+
+func (e TL_inputReportReasonSpam) writeText(w *textWriter) {
+	w.name("inputReportReasonSpam")
+}
+
+func (e TL_inputReportReasonViolence) writeText(w *textWriter) {
+	w.name("inputReportReasonViolence")
+}
+
+func (e TL_inputReportReasonPornography) writeText(w *textWriter) {
+	w.name("inputReportReasonPornography")
+}
+
+func (e TL_inputReportReasonChildAbuse) writeText(w *textWriter) {
+	w.name("inputReportReasonChildAbuse")
+}
+
+func (e TL_inputReportReasonOther) writeText(w *textWriter) {
+	w.name("inputReportReasonOther")
+}
+
+func (e TL_inputReportReasonCopyright) writeText(w *textWriter) {
+	w.name("inputReportReasonCopyright")
+}
+
+func (e TL_inputReportReasonGeoIrrelevant) writeText(w *textWriter) {
+	w.name("inputReportReasonGeoIrrelevant")
+}
+
+func (e TL_inputReportReasonFake) writeText(w *textWriter) {
+	w.name("inputReportReasonFake")
+}
+
+func (e TL_userFull) writeText(w *textWriter) {
+	w.name("userFull")
+	if e.Flags&1 != 0 {
+		w.field("blocked")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("phone_calls_available")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("phone_calls_private")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("can_pin_message")
+		w.Bool(true)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("has_scheduled")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("video_calls_available")
+		w.Bool(true)
+	}
+	w.field("user")
+	w.Object(e.User)
+	if e.Flags&2 != 0 {
+		w.field("about")
+		w.String(e.About)
+	}
+	w.field("settings")
+	w.Object(e.Settings)
+	if e.Flags&4 != 0 {
+		w.field("profile_photo")
+		w.Object(e.ProfilePhoto)
+	}
+	w.field("notify_settings")
+	w.Object(e.NotifySettings)
+	if e.Flags&8 != 0 {
+		w.field("bot_info")
+		w.Object(e.BotInfo)
+	}
+	if e.Flags&64 != 0 {
+		w.field("pinned_msg_id")
+		w.Int(e.PinnedMsgID)
+	}
+	w.field("common_chats_count")
+	w.Int(e.CommonChatsCount)
+	if e.Flags&2048 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+}
+
+func (e TL_contact) writeText(w *textWriter) {
+	w.name("contact")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("mutual")
+	w.Object(e.Mutual)
+}
+
+func (e TL_importedContact) writeText(w *textWriter) {
+	w.name("importedContact")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("client_id")
+	w.Long(e.ClientID)
+}
+
+func (e TL_contactStatus) writeText(w *textWriter) {
+	w.name("contactStatus")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("status")
+	w.Object(e.Status)
+}
+
+func (e TL_contacts_contactsNotModified) writeText(w *textWriter) {
+	w.name("contacts.contactsNotModified")
+}
+
+func (e TL_contacts_contacts) writeText(w *textWriter) {
+	w.name("contacts.contacts")
+	w.field("contacts")
+	w.Vector(e.Contacts)
+	w.field("saved_count")
+	w.Int(e.SavedCount)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_contacts_importedContacts) writeText(w *textWriter) {
+	w.name("contacts.importedContacts")
+	w.field("imported")
+	w.Vector(e.Imported)
+	w.field("popular_invites")
+	w.Vector(e.PopularInvites)
+	w.field("retry_contacts")
+	w.VectorLong(e.RetryContacts)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_contacts_blocked) writeText(w *textWriter) {
+	w.name("contacts.blocked")
+	w.field("blocked")
+	w.Vector(e.Blocked)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_contacts_blockedSlice) writeText(w *textWriter) {
+	w.name("contacts.blockedSlice")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("blocked")
+	w.Vector(e.Blocked)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_dialogs) writeText(w *textWriter) {
+	w.name("messages.dialogs")
+	w.field("dialogs")
+	w.Vector(e.Dialogs)
+	w.field("messages")
+	w.Vector(e.Messages)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_dialogsSlice) writeText(w *textWriter) {
+	w.name("messages.dialogsSlice")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("dialogs")
+	w.Vector(e.Dialogs)
+	w.field("messages")
+	w.Vector(e.Messages)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_dialogsNotModified) writeText(w *textWriter) {
+	w.name("messages.dialogsNotModified")
+	w.field("count")
+	w.Int(e.Count)
+}
+
+func (e TL_messages_messages) writeText(w *textWriter) {
+	w.name("messages.messages")
+	w.field("messages")
+	w.Vector(e.Messages)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_messagesSlice) writeText(w *textWriter) {
+	w.name("messages.messagesSlice")
+	if e.Flags&2 != 0 {
+		w.field("inexact")
+		w.Bool(true)
+	}
+	w.field("count")
+	w.Int(e.Count)
+	if e.Flags&1 != 0 {
+		w.field("next_rate")
+		w.Int(e.NextRate)
+	}
+	if e.Flags&4 != 0 {
+		w.field("offset_id_offset")
+		w.Int(e.OffsetIdOffset)
+	}
+	w.field("messages")
+	w.Vector(e.Messages)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_channelMessages) writeText(w *textWriter) {
+	w.name("messages.channelMessages")
+	if e.Flags&2 != 0 {
+		w.field("inexact")
+		w.Bool(true)
+	}
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("count")
+	w.Int(e.Count)
+	if e.Flags&4 != 0 {
+		w.field("offset_id_offset")
+		w.Int(e.OffsetIdOffset)
+	}
+	w.field("messages")
+	w.Vector(e.Messages)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_messagesNotModified) writeText(w *textWriter) {
+	w.name("messages.messagesNotModified")
+	w.field("count")
+	w.Int(e.Count)
+}
+
+func (e TL_messages_chats) writeText(w *textWriter) {
+	w.name("messages.chats")
+	w.field("chats")
+	w.Vector(e.Chats)
+}
+
+func (e TL_messages_chatsSlice) writeText(w *textWriter) {
+	w.name("messages.chatsSlice")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("chats")
+	w.Vector(e.Chats)
+}
+
+func (e TL_messages_chatFull) writeText(w *textWriter) {
+	w.name("messages.chatFull")
+	w.field("full_chat")
+	w.Object(e.FullChat)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_affectedHistory) writeText(w *textWriter) {
+	w.name("messages.affectedHistory")
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+	w.field("offset")
+	w.Int(e.Offset)
+}
+
+func (e TL_inputMessagesFilterEmpty) writeText(w *textWriter) {
+	w.name("inputMessagesFilterEmpty")
+}
+
+func (e TL_inputMessagesFilterPhotos) writeText(w *textWriter) {
+	w.name("inputMessagesFilterPhotos")
+}
+
+func (e TL_inputMessagesFilterVideo) writeText(w *textWriter) {
+	w.name("inputMessagesFilterVideo")
+}
+
+func (e TL_inputMessagesFilterPhotoVideo) writeText(w *textWriter) {
+	w.name("inputMessagesFilterPhotoVideo")
+}
+
+func (e TL_inputMessagesFilterDocument) writeText(w *textWriter) {
+	w.name("inputMessagesFilterDocument")
+}
+
+func (e TL_inputMessagesFilterUrl) writeText(w *textWriter) {
+	w.name("inputMessagesFilterUrl")
+}
+
+func (e TL_inputMessagesFilterGif) writeText(w *textWriter) {
+	w.name("inputMessagesFilterGif")
+}
+
+func (e TL_inputMessagesFilterVoice) writeText(w *textWriter) {
+	w.name("inputMessagesFilterVoice")
+}
+
+func (e TL_inputMessagesFilterMusic) writeText(w *textWriter) {
+	w.name("inputMessagesFilterMusic")
+}
+
+func (e TL_inputMessagesFilterChatPhotos) writeText(w *textWriter) {
+	w.name("inputMessagesFilterChatPhotos")
+}
+
+func (e TL_inputMessagesFilterPhoneCalls) writeText(w *textWriter) {
+	w.name("inputMessagesFilterPhoneCalls")
+	if e.Flags&1 != 0 {
+		w.field("missed")
+		w.Bool(true)
+	}
+}
+
+func (e TL_inputMessagesFilterRoundVoice) writeText(w *textWriter) {
+	w.name("inputMessagesFilterRoundVoice")
+}
+
+func (e TL_inputMessagesFilterRoundVideo) writeText(w *textWriter) {
+	w.name("inputMessagesFilterRoundVideo")
+}
+
+func (e TL_inputMessagesFilterMyMentions) writeText(w *textWriter) {
+	w.name("inputMessagesFilterMyMentions")
+}
+
+func (e TL_inputMessagesFilterGeo) writeText(w *textWriter) {
+	w.name("inputMessagesFilterGeo")
+}
+
+func (e TL_inputMessagesFilterContacts) writeText(w *textWriter) {
+	w.name("inputMessagesFilterContacts")
+}
+
+func (e TL_inputMessagesFilterPinned) writeText(w *textWriter) {
+	w.name("inputMessagesFilterPinned")
+}
+
+func (e TL_updateNewMessage) writeText(w *textWriter) {
+	w.name("updateNewMessage")
+	w.field("message")
+	w.Object(e.Message)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateMessageID) writeText(w *textWriter) {
+	w.name("updateMessageID")
+	w.field("id")
+	w.Int(e.ID)
+	w.field("random_id")
+	w.Long(e.RandomID)
+}
+
+func (e TL_updateDeleteMessages) writeText(w *textWriter) {
+	w.name("updateDeleteMessages")
+	w.field("messages")
+	w.VectorInt(e.Messages)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateUserTyping) writeText(w *textWriter) {
+	w.name("updateUserTyping")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("action")
+	w.Object(e.Action)
+}
+
+func (e TL_updateChatUserTyping) writeText(w *textWriter) {
+	w.name("updateChatUserTyping")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("from_id")
+	w.Object(e.FromID)
+	w.field("action")
+	w.Object(e.Action)
+}
+
+func (e TL_updateChatParticipants) writeText(w *textWriter) {
+	w.name("updateChatParticipants")
+	w.field("participants")
+	w.Object(e.Participants)
+}
+
+func (e TL_updateUserStatus) writeText(w *textWriter) {
+	w.name("updateUserStatus")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("status")
+	w.Object(e.Status)
+}
+
+func (e TL_updateUserName) writeText(w *textWriter) {
+	w.name("updateUserName")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+	w.field("username")
+	w.String(e.Username)
+}
+
+func (e TL_updateUserPhoto) writeText(w *textWriter) {
+	w.name("updateUserPhoto")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("photo")
+	w.Object(e.Photo)
+	w.field("previous")
+	w.Object(e.Previous)
+}
+
+func (e TL_updateNewEncryptedMessage) writeText(w *textWriter) {
+	w.name("updateNewEncryptedMessage")
+	w.field("message")
+	w.Object(e.Message)
+	w.field("qts")
+	w.Int(e.Qts)
+}
+
+func (e TL_updateEncryptedChatTyping) writeText(w *textWriter) {
+	w.name("updateEncryptedChatTyping")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_updateEncryption) writeText(w *textWriter) {
+	w.name("updateEncryption")
+	w.field("chat")
+	w.Object(e.Chat)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_updateEncryptedMessagesRead) writeText(w *textWriter) {
+	w.name("updateEncryptedMessagesRead")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("max_date")
+	w.Int(e.MaxDate)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_updateChatParticipantAdd) writeText(w *textWriter) {
+	w.name("updateChatParticipantAdd")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("inviter_id")
+	w.Int(e.InviterID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("version")
+	w.Int(e.Version)
+}
+
+func (e TL_updateChatParticipantDelete) writeText(w *textWriter) {
+	w.name("updateChatParticipantDelete")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("version")
+	w.Int(e.Version)
+}
+
+func (e TL_updateDcOptions) writeText(w *textWriter) {
+	w.name("updateDcOptions")
+	w.field("dc_options")
+	w.Vector(e.DcOptions)
+}
+
+func (e TL_updateNotifySettings) writeText(w *textWriter) {
+	w.name("updateNotifySettings")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("notify_settings")
+	w.Object(e.NotifySettings)
+}
+
+func (e TL_updateServiceNotification) writeText(w *textWriter) {
+	w.name("updateServiceNotification")
+	if e.Flags&1 != 0 {
+		w.field("popup")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("inbox_date")
+		w.Int(e.InboxDate)
+	}
+	w.field("type")
+	w.String(e.Type)
+	w.field("message")
+	w.String(e.Message)
+	w.field("media")
+	w.Object(e.Media)
+	w.field("entities")
+	w.Vector(e.Entities)
+}
+
+func (e TL_updatePrivacy) writeText(w *textWriter) {
+	w.name("updatePrivacy")
+	w.field("key")
+	w.Object(e.Key)
+	w.field("rules")
+	w.Vector(e.Rules)
+}
+
+func (e TL_updateUserPhone) writeText(w *textWriter) {
+	w.name("updateUserPhone")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("phone")
+	w.String(e.Phone)
+}
+
+func (e TL_updateReadHistoryInbox) writeText(w *textWriter) {
+	w.name("updateReadHistoryInbox")
+	if e.Flags&1 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("max_id")
+	w.Int(e.MaxID)
+	w.field("still_unread_count")
+	w.Int(e.StillUnreadCount)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateReadHistoryOutbox) writeText(w *textWriter) {
+	w.name("updateReadHistoryOutbox")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("max_id")
+	w.Int(e.MaxID)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateWebPage) writeText(w *textWriter) {
+	w.name("updateWebPage")
+	w.field("webpage")
+	w.Object(e.Webpage)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateReadMessagesContents) writeText(w *textWriter) {
+	w.name("updateReadMessagesContents")
+	w.field("messages")
+	w.VectorInt(e.Messages)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateChannelTooLong) writeText(w *textWriter) {
+	w.name("updateChannelTooLong")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	if e.Flags&1 != 0 {
+		w.field("pts")
+		w.Int(e.Pts)
+	}
+}
+
+func (e TL_updateChannel) writeText(w *textWriter) {
+	w.name("updateChannel")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+}
+
+func (e TL_updateNewChannelMessage) writeText(w *textWriter) {
+	w.name("updateNewChannelMessage")
+	w.field("message")
+	w.Object(e.Message)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateReadChannelInbox) writeText(w *textWriter) {
+	w.name("updateReadChannelInbox")
+	if e.Flags&1 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("max_id")
+	w.Int(e.MaxID)
+	w.field("still_unread_count")
+	w.Int(e.StillUnreadCount)
+	w.field("pts")
+	w.Int(e.Pts)
+}
+
+func (e TL_updateDeleteChannelMessages) writeText(w *textWriter) {
+	w.name("updateDeleteChannelMessages")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("messages")
+	w.VectorInt(e.Messages)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateChannelMessageViews) writeText(w *textWriter) {
+	w.name("updateChannelMessageViews")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("id")
+	w.Int(e.ID)
+	w.field("views")
+	w.Int(e.Views)
+}
+
+func (e TL_updateChatParticipantAdmin) writeText(w *textWriter) {
+	w.name("updateChatParticipantAdmin")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("is_admin")
+	w.Object(e.IsAdmin)
+	w.field("version")
+	w.Int(e.Version)
+}
+
+func (e TL_updateNewStickerSet) writeText(w *textWriter) {
+	w.name("updateNewStickerSet")
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+}
+
+func (e TL_updateStickerSetsOrder) writeText(w *textWriter) {
+	w.name("updateStickerSetsOrder")
+	if e.Flags&1 != 0 {
+		w.field("masks")
+		w.Bool(true)
+	}
+	w.field("order")
+	w.VectorLong(e.Order)
+}
+
+func (e TL_updateStickerSets) writeText(w *textWriter) {
+	w.name("updateStickerSets")
+}
+
+func (e TL_updateSavedGifs) writeText(w *textWriter) {
+	w.name("updateSavedGifs")
+}
+
+func (e TL_updateBotInlineQuery) writeText(w *textWriter) {
+	w.name("updateBotInlineQuery")
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("query")
+	w.String(e.Query)
+	if e.Flags&1 != 0 {
+		w.field("geo")
+		w.Object(e.Geo)
+	}
+	if e.Flags&2 != 0 {
+		w.field("peer_type")
+		w.Object(e.PeerType)
+	}
+	w.field("offset")
+	w.String(e.Offset)
+}
+
+func (e TL_updateBotInlineSend) writeText(w *textWriter) {
+	w.name("updateBotInlineSend")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("query")
+	w.String(e.Query)
+	if e.Flags&1 != 0 {
+		w.field("geo")
+		w.Object(e.Geo)
+	}
+	w.field("id")
+	w.String(e.ID)
+	if e.Flags&2 != 0 {
+		w.field("msg_id")
+		w.Object(e.MsgID)
+	}
+}
+
+func (e TL_updateEditChannelMessage) writeText(w *textWriter) {
+	w.name("updateEditChannelMessage")
+	w.field("message")
+	w.Object(e.Message)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateBotCallbackQuery) writeText(w *textWriter) {
+	w.name("updateBotCallbackQuery")
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("chat_instance")
+	w.Long(e.ChatInstance)
+	if e.Flags&1 != 0 {
+		w.field("data")
+		w.Bytes(e.Data)
+	}
+	if e.Flags&2 != 0 {
+		w.field("game_short_name")
+		w.String(e.GameShortName)
+	}
+}
+
+func (e TL_updateEditMessage) writeText(w *textWriter) {
+	w.name("updateEditMessage")
+	w.field("message")
+	w.Object(e.Message)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateInlineBotCallbackQuery) writeText(w *textWriter) {
+	w.name("updateInlineBotCallbackQuery")
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("msg_id")
+	w.Object(e.MsgID)
+	w.field("chat_instance")
+	w.Long(e.ChatInstance)
+	if e.Flags&1 != 0 {
+		w.field("data")
+		w.Bytes(e.Data)
+	}
+	if e.Flags&2 != 0 {
+		w.field("game_short_name")
+		w.String(e.GameShortName)
+	}
+}
+
+func (e TL_updateReadChannelOutbox) writeText(w *textWriter) {
+	w.name("updateReadChannelOutbox")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("max_id")
+	w.Int(e.MaxID)
+}
+
+func (e TL_updateDraftMessage) writeText(w *textWriter) {
+	w.name("updateDraftMessage")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("draft")
+	w.Object(e.Draft)
+}
+
+func (e TL_updateReadFeaturedStickers) writeText(w *textWriter) {
+	w.name("updateReadFeaturedStickers")
+}
+
+func (e TL_updateRecentStickers) writeText(w *textWriter) {
+	w.name("updateRecentStickers")
+}
+
+func (e TL_updateConfig) writeText(w *textWriter) {
+	w.name("updateConfig")
+}
+
+func (e TL_updatePtsChanged) writeText(w *textWriter) {
+	w.name("updatePtsChanged")
+}
+
+func (e TL_updateChannelWebPage) writeText(w *textWriter) {
+	w.name("updateChannelWebPage")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("webpage")
+	w.Object(e.Webpage)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateDialogPinned) writeText(w *textWriter) {
+	w.name("updateDialogPinned")
+	if e.Flags&1 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_updatePinnedDialogs) writeText(w *textWriter) {
+	w.name("updatePinnedDialogs")
+	if e.Flags&2 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	if e.Flags&1 != 0 {
+		w.field("order")
+		w.Vector(e.Order)
+	}
+}
+
+func (e TL_updateBotWebhookJSON) writeText(w *textWriter) {
+	w.name("updateBotWebhookJSON")
+	w.field("data")
+	w.Object(e.Data)
+}
+
+func (e TL_updateBotWebhookJSONQuery) writeText(w *textWriter) {
+	w.name("updateBotWebhookJSONQuery")
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("data")
+	w.Object(e.Data)
+	w.field("timeout")
+	w.Int(e.Timeout)
+}
+
+func (e TL_updateBotShippingQuery) writeText(w *textWriter) {
+	w.name("updateBotShippingQuery")
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("payload")
+	w.Bytes(e.Payload)
+	w.field("shipping_address")
+	w.Object(e.ShippingAddress)
+}
+
+func (e TL_updateBotPrecheckoutQuery) writeText(w *textWriter) {
+	w.name("updateBotPrecheckoutQuery")
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("payload")
+	w.Bytes(e.Payload)
+	if e.Flags&1 != 0 {
+		w.field("info")
+		w.Object(e.Info)
+	}
+	if e.Flags&2 != 0 {
+		w.field("shipping_option_id")
+		w.String(e.ShippingOptionID)
+	}
+	w.field("currency")
+	w.String(e.Currency)
+	w.field("total_amount")
+	w.Long(e.TotalAmount)
+}
+
+func (e TL_updatePhoneCall) writeText(w *textWriter) {
+	w.name("updatePhoneCall")
+	w.field("phone_call")
+	w.Object(e.PhoneCall)
+}
+
+func (e TL_updateLangPackTooLong) writeText(w *textWriter) {
+	w.name("updateLangPackTooLong")
+	w.field("lang_code")
+	w.String(e.LangCode)
+}
+
+func (e TL_updateLangPack) writeText(w *textWriter) {
+	w.name("updateLangPack")
+	w.field("difference")
+	w.Object(e.Difference)
+}
+
+func (e TL_updateFavedStickers) writeText(w *textWriter) {
+	w.name("updateFavedStickers")
+}
+
+func (e TL_updateChannelReadMessagesContents) writeText(w *textWriter) {
+	w.name("updateChannelReadMessagesContents")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("messages")
+	w.VectorInt(e.Messages)
+}
+
+func (e TL_updateContactsReset) writeText(w *textWriter) {
+	w.name("updateContactsReset")
+}
+
+func (e TL_updateChannelAvailableMessages) writeText(w *textWriter) {
+	w.name("updateChannelAvailableMessages")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("available_min_id")
+	w.Int(e.AvailableMinID)
+}
+
+func (e TL_updateDialogUnreadMark) writeText(w *textWriter) {
+	w.name("updateDialogUnreadMark")
+	if e.Flags&1 != 0 {
+		w.field("unread")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_updateMessagePoll) writeText(w *textWriter) {
+	w.name("updateMessagePoll")
+	w.field("poll_id")
+	w.Long(e.PollID)
+	if e.Flags&1 != 0 {
+		w.field("poll")
+		w.Object(e.Poll)
+	}
+	w.field("results")
+	w.Object(e.Results)
+}
+
+func (e TL_updateChatDefaultBannedRights) writeText(w *textWriter) {
+	w.name("updateChatDefaultBannedRights")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("default_banned_rights")
+	w.Object(e.DefaultBannedRights)
+	w.field("version")
+	w.Int(e.Version)
+}
+
+func (e TL_updateFolderPeers) writeText(w *textWriter) {
+	w.name("updateFolderPeers")
+	w.field("folder_peers")
+	w.Vector(e.FolderPeers)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updatePeerSettings) writeText(w *textWriter) {
+	w.name("updatePeerSettings")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_updatePeerLocated) writeText(w *textWriter) {
+	w.name("updatePeerLocated")
+	w.field("peers")
+	w.Vector(e.Peers)
+}
+
+func (e TL_updateNewScheduledMessage) writeText(w *textWriter) {
+	w.name("updateNewScheduledMessage")
+	w.field("message")
+	w.Object(e.Message)
+}
+
+func (e TL_updateDeleteScheduledMessages) writeText(w *textWriter) {
+	w.name("updateDeleteScheduledMessages")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("messages")
+	w.VectorInt(e.Messages)
+}
+
+func (e TL_updateTheme) writeText(w *textWriter) {
+	w.name("updateTheme")
+	w.field("theme")
+	w.Object(e.Theme)
+}
+
+func (e TL_updateGeoLiveViewed) writeText(w *textWriter) {
+	w.name("updateGeoLiveViewed")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+}
+
+func (e TL_updateLoginToken) writeText(w *textWriter) {
+	w.name("updateLoginToken")
+}
+
+func (e TL_updateMessagePollVote) writeText(w *textWriter) {
+	w.name("updateMessagePollVote")
+	w.field("poll_id")
+	w.Long(e.PollID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("options")
+	w.VectorBytes(e.Options)
+}
+
+func (e TL_updateDialogFilter) writeText(w *textWriter) {
+	w.name("updateDialogFilter")
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("filter")
+		w.Object(e.Filter)
+	}
+}
+
+func (e TL_updateDialogFilterOrder) writeText(w *textWriter) {
+	w.name("updateDialogFilterOrder")
+	w.field("order")
+	w.VectorInt(e.Order)
+}
+
+func (e TL_updateDialogFilters) writeText(w *textWriter) {
+	w.name("updateDialogFilters")
+}
+
+func (e TL_updatePhoneCallSignalingData) writeText(w *textWriter) {
+	w.name("updatePhoneCallSignalingData")
+	w.field("phone_call_id")
+	w.Long(e.PhoneCallID)
+	w.field("data")
+	w.Bytes(e.Data)
+}
+
+func (e TL_updateChannelMessageForwards) writeText(w *textWriter) {
+	w.name("updateChannelMessageForwards")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("id")
+	w.Int(e.ID)
+	w.field("forwards")
+	w.Int(e.Forwards)
+}
+
+func (e TL_updateReadChannelDiscussionInbox) writeText(w *textWriter) {
+	w.name("updateReadChannelDiscussionInbox")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("top_msg_id")
+	w.Int(e.TopMsgID)
+	w.field("read_max_id")
+	w.Int(e.ReadMaxID)
+	if e.Flags&1 != 0 {
+		w.field("broadcast_id")
+		w.Int(e.BroadcastID)
+	}
+	if e.Flags&1 != 0 {
+		w.field("broadcast_post")
+		w.Int(e.BroadcastPost)
+	}
+}
+
+func (e TL_updateReadChannelDiscussionOutbox) writeText(w *textWriter) {
+	w.name("updateReadChannelDiscussionOutbox")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("top_msg_id")
+	w.Int(e.TopMsgID)
+	w.field("read_max_id")
+	w.Int(e.ReadMaxID)
+}
+
+func (e TL_updatePeerBlocked) writeText(w *textWriter) {
+	w.name("updatePeerBlocked")
+	w.field("peer_id")
+	w.Object(e.PeerID)
+	w.field("blocked")
+	w.Object(e.Blocked)
+}
+
+func (e TL_updateChannelUserTyping) writeText(w *textWriter) {
+	w.name("updateChannelUserTyping")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	if e.Flags&1 != 0 {
+		w.field("top_msg_id")
+		w.Int(e.TopMsgID)
+	}
+	w.field("from_id")
+	w.Object(e.FromID)
+	w.field("action")
+	w.Object(e.Action)
+}
+
+func (e TL_updatePinnedMessages) writeText(w *textWriter) {
+	w.name("updatePinnedMessages")
+	if e.Flags&1 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("messages")
+	w.VectorInt(e.Messages)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updatePinnedChannelMessages) writeText(w *textWriter) {
+	w.name("updatePinnedChannelMessages")
+	if e.Flags&1 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("messages")
+	w.VectorInt(e.Messages)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_updateChat) writeText(w *textWriter) {
+	w.name("updateChat")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_updateGroupCallParticipants) writeText(w *textWriter) {
+	w.name("updateGroupCallParticipants")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("participants")
+	w.Vector(e.Participants)
+	w.field("version")
+	w.Int(e.Version)
+}
+
+func (e TL_updateGroupCall) writeText(w *textWriter) {
+	w.name("updateGroupCall")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("call")
+	w.Object(e.Call)
+}
+
+func (e TL_updatePeerHistoryTTL) writeText(w *textWriter) {
+	w.name("updatePeerHistoryTTL")
+	w.field("peer")
+	w.Object(e.Peer)
+	if e.Flags&1 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+}
+
+func (e TL_updateChatParticipant) writeText(w *textWriter) {
+	w.name("updateChatParticipant")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("actor_id")
+	w.Int(e.ActorID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	if e.Flags&1 != 0 {
+		w.field("prev_participant")
+		w.Object(e.PrevParticipant)
+	}
+	if e.Flags&2 != 0 {
+		w.field("new_participant")
+		w.Object(e.NewParticipant)
+	}
+	if e.Flags&4 != 0 {
+		w.field("invite")
+		w.Object(e.Invite)
+	}
+	w.field("qts")
+	w.Int(e.Qts)
+}
+
+func (e TL_updateChannelParticipant) writeText(w *textWriter) {
+	w.name("updateChannelParticipant")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("actor_id")
+	w.Int(e.ActorID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	if e.Flags&1 != 0 {
+		w.field("prev_participant")
+		w.Object(e.PrevParticipant)
+	}
+	if e.Flags&2 != 0 {
+		w.field("new_participant")
+		w.Object(e.NewParticipant)
+	}
+	if e.Flags&4 != 0 {
+		w.field("invite")
+		w.Object(e.Invite)
+	}
+	w.field("qts")
+	w.Int(e.Qts)
+}
+
+func (e TL_updateBotStopped) writeText(w *textWriter) {
+	w.name("updateBotStopped")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("stopped")
+	w.Object(e.Stopped)
+	w.field("qts")
+	w.Int(e.Qts)
+}
+
+func (e TL_updates_state) writeText(w *textWriter) {
+	w.name("updates.state")
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("qts")
+	w.Int(e.Qts)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("seq")
+	w.Int(e.Seq)
+	w.field("unread_count")
+	w.Int(e.UnreadCount)
+}
+
+func (e TL_updates_differenceEmpty) writeText(w *textWriter) {
+	w.name("updates.differenceEmpty")
+	w.field("date")
+	w.Int(e.Date)
+	w.field("seq")
+	w.Int(e.Seq)
+}
+
+func (e TL_updates_difference) writeText(w *textWriter) {
+	w.name("updates.difference")
+	w.field("new_messages")
+	w.Vector(e.NewMessages)
+	w.field("new_encrypted_messages")
+	w.Vector(e.NewEncryptedMessages)
+	w.field("other_updates")
+	w.Vector(e.OtherUpdates)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+	w.field("state")
+	w.Object(e.State)
+}
+
+func (e TL_updates_differenceSlice) writeText(w *textWriter) {
+	w.name("updates.differenceSlice")
+	w.field("new_messages")
+	w.Vector(e.NewMessages)
+	w.field("new_encrypted_messages")
+	w.Vector(e.NewEncryptedMessages)
+	w.field("other_updates")
+	w.Vector(e.OtherUpdates)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+	w.field("intermediate_state")
+	w.Object(e.IntermediateState)
+}
+
+func (e TL_updates_differenceTooLong) writeText(w *textWriter) {
+	w.name("updates.differenceTooLong")
+	w.field("pts")
+	w.Int(e.Pts)
+}
+
+func (e TL_updatesTooLong) writeText(w *textWriter) {
+	w.name("updatesTooLong")
+}
+
+func (e TL_updateShortMessage) writeText(w *textWriter) {
+	w.name("updateShortMessage")
+	if e.Flags&2 != 0 {
+		w.field("out")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("mentioned")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("media_unread")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("message")
+	w.String(e.Message)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+	w.field("date")
+	w.Int(e.Date)
+	if e.Flags&4 != 0 {
+		w.field("fwd_from")
+		w.Object(e.FwdFrom)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("via_bot_id")
+		w.Int(e.ViaBotID)
+	}
+	if e.Flags&8 != 0 {
+		w.field("reply_to")
+		w.Object(e.ReplyTo)
+	}
+	if e.Flags&128 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&33554432 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+}
+
+func (e TL_updateShortChatMessage) writeText(w *textWriter) {
+	w.name("updateShortChatMessage")
+	if e.Flags&2 != 0 {
+		w.field("out")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("mentioned")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("media_unread")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("from_id")
+	w.Int(e.FromID)
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("message")
+	w.String(e.Message)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+	w.field("date")
+	w.Int(e.Date)
+	if e.Flags&4 != 0 {
+		w.field("fwd_from")
+		w.Object(e.FwdFrom)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("via_bot_id")
+		w.Int(e.ViaBotID)
+	}
+	if e.Flags&8 != 0 {
+		w.field("reply_to")
+		w.Object(e.ReplyTo)
+	}
+	if e.Flags&128 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&33554432 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+}
+
+func (e TL_updateShort) writeText(w *textWriter) {
+	w.name("updateShort")
+	w.field("update")
+	w.Object(e.Update)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_updatesCombined) writeText(w *textWriter) {
+	w.name("updatesCombined")
+	w.field("updates")
+	w.Vector(e.Updates)
+	w.field("users")
+	w.Vector(e.Users)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("seq_start")
+	w.Int(e.SeqStart)
+	w.field("seq")
+	w.Int(e.Seq)
+}
+
+func (e TL_updates) writeText(w *textWriter) {
+	w.name("updates")
+	w.field("updates")
+	w.Vector(e.Updates)
+	w.field("users")
+	w.Vector(e.Users)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("seq")
+	w.Int(e.Seq)
+}
+
+func (e TL_updateShortSentMessage) writeText(w *textWriter) {
+	w.name("updateShortSentMessage")
+	if e.Flags&2 != 0 {
+		w.field("out")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+	w.field("date")
+	w.Int(e.Date)
+	if e.Flags&512 != 0 {
+		w.field("media")
+		w.Object(e.Media)
+	}
+	if e.Flags&128 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&33554432 != 0 {
+		w.field("ttl_period")
+		w.Int(e.TtlPeriod)
+	}
+}
+
+func (e TL_photos_photos) writeText(w *textWriter) {
+	w.name("photos.photos")
+	w.field("photos")
+	w.Vector(e.Photos)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_photos_photosSlice) writeText(w *textWriter) {
+	w.name("photos.photosSlice")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("photos")
+	w.Vector(e.Photos)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_photos_photo) writeText(w *textWriter) {
+	w.name("photos.photo")
+	w.field("photo")
+	w.Object(e.Photo)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_upload_file) writeText(w *textWriter) {
+	w.name("upload.file")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("mtime")
+	w.Int(e.Mtime)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_upload_fileCdnRedirect) writeText(w *textWriter) {
+	w.name("upload.fileCdnRedirect")
+	w.field("dc_id")
+	w.Int(e.DcID)
+	w.field("file_token")
+	w.Bytes(e.FileToken)
+	w.field("encryption_key")
+	w.Bytes(e.EncryptionKey)
+	w.field("encryption_iv")
+	w.Bytes(e.EncryptionIv)
+	w.field("file_hashes")
+	w.Vector(e.FileHashes)
+}
+
+func (e TL_dcOption) writeText(w *textWriter) {
+	w.name("dcOption")
+	if e.Flags&1 != 0 {
+		w.field("ipv6")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("media_only")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("tcpo_only")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("cdn")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("static")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("ip_address")
+	w.String(e.IpAddress)
+	w.field("port")
+	w.Int(e.Port)
+	if e.Flags&1024 != 0 {
+		w.field("secret")
+		w.Bytes(e.Secret)
+	}
+}
+
+func (e TL_config) writeText(w *textWriter) {
+	w.name("config")
+	if e.Flags&2 != 0 {
+		w.field("phonecalls_enabled")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("default_p2p_contacts")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("preload_featured_stickers")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("ignore_phone_entities")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("revoke_pm_inbox")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("blocked_mode")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("pfs_enabled")
+		w.Bool(true)
+	}
+	w.field("date")
+	w.Int(e.Date)
+	w.field("expires")
+	w.Int(e.Expires)
+	w.field("test_mode")
+	w.Object(e.TestMode)
+	w.field("this_dc")
+	w.Int(e.ThisDc)
+	w.field("dc_options")
+	w.Vector(e.DcOptions)
+	w.field("dc_txt_domain_name")
+	w.String(e.DcTxtDomainName)
+	w.field("chat_size_max")
+	w.Int(e.ChatSizeMax)
+	w.field("megagroup_size_max")
+	w.Int(e.MegagroupSizeMax)
+	w.field("forwarded_count_max")
+	w.Int(e.ForwardedCountMax)
+	w.field("online_update_period_ms")
+	w.Int(e.OnlineUpdatePeriodMs)
+	w.field("offline_blur_timeout_ms")
+	w.Int(e.OfflineBlurTimeoutMs)
+	w.field("offline_idle_timeout_ms")
+	w.Int(e.OfflineIdleTimeoutMs)
+	w.field("online_cloud_timeout_ms")
+	w.Int(e.OnlineCloudTimeoutMs)
+	w.field("notify_cloud_delay_ms")
+	w.Int(e.NotifyCloudDelayMs)
+	w.field("notify_default_delay_ms")
+	w.Int(e.NotifyDefaultDelayMs)
+	w.field("push_chat_period_ms")
+	w.Int(e.PushChatPeriodMs)
+	w.field("push_chat_limit")
+	w.Int(e.PushChatLimit)
+	w.field("saved_gifs_limit")
+	w.Int(e.SavedGifsLimit)
+	w.field("edit_time_limit")
+	w.Int(e.EditTimeLimit)
+	w.field("revoke_time_limit")
+	w.Int(e.RevokeTimeLimit)
+	w.field("revoke_pm_time_limit")
+	w.Int(e.RevokePmTimeLimit)
+	w.field("rating_e_decay")
+	w.Int(e.RatingEDecay)
+	w.field("stickers_recent_limit")
+	w.Int(e.StickersRecentLimit)
+	w.field("stickers_faved_limit")
+	w.Int(e.StickersFavedLimit)
+	w.field("channels_read_media_period")
+	w.Int(e.ChannelsReadMediaPeriod)
+	if e.Flags&1 != 0 {
+		w.field("tmp_sessions")
+		w.Int(e.TmpSessions)
+	}
+	w.field("pinned_dialogs_count_max")
+	w.Int(e.PinnedDialogsCountMax)
+	w.field("pinned_infolder_count_max")
+	w.Int(e.PinnedInfolderCountMax)
+	w.field("call_receive_timeout_ms")
+	w.Int(e.CallReceiveTimeoutMs)
+	w.field("call_ring_timeout_ms")
+	w.Int(e.CallRingTimeoutMs)
+	w.field("call_connect_timeout_ms")
+	w.Int(e.CallConnectTimeoutMs)
+	w.field("call_packet_timeout_ms")
+	w.Int(e.CallPacketTimeoutMs)
+	w.field("me_url_prefix")
+	w.String(e.MeUrlPrefix)
+	if e.Flags&128 != 0 {
+		w.field("autoupdate_url_prefix")
+		w.String(e.AutoupdateUrlPrefix)
+	}
+	if e.Flags&512 != 0 {
+		w.field("gif_search_username")
+		w.String(e.GifSearchUsername)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("venue_search_username")
+		w.String(e.VenueSearchUsername)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("img_search_username")
+		w.String(e.ImgSearchUsername)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("static_maps_provider")
+		w.String(e.StaticMapsProvider)
+	}
+	w.field("caption_length_max")
+	w.Int(e.CaptionLengthMax)
+	w.field("message_length_max")
+	w.Int(e.MessageLengthMax)
+	w.field("webfile_dc_id")
+	w.Int(e.WebfileDcID)
+	if e.Flags&4 != 0 {
+		w.field("suggested_lang_code")
+		w.String(e.SuggestedLangCode)
+	}
+	if e.Flags&4 != 0 {
+		w.field("lang_pack_version")
+		w.Int(e.LangPackVersion)
+	}
+	if e.Flags&4 != 0 {
+		w.field("base_lang_pack_version")
+		w.Int(e.BaseLangPackVersion)
+	}
+}
+
+func (e TL_nearestDc) writeText(w *textWriter) {
+	w.name("nearestDc")
+	w.field("country")
+	w.String(e.Country)
+	w.field("this_dc")
+	w.Int(e.ThisDc)
+	w.field("nearest_dc")
+	w.Int(e.NearestDc)
+}
+
+func (e TL_help_appUpdate) writeText(w *textWriter) {
+	w.name("help.appUpdate")
+	if e.Flags&1 != 0 {
+		w.field("can_not_skip")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("version")
+	w.String(e.Version)
+	w.field("text")
+	w.String(e.Text)
+	w.field("entities")
+	w.Vector(e.Entities)
+	if e.Flags&2 != 0 {
+		w.field("document")
+		w.Object(e.Document)
+	}
+	if e.Flags&4 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+}
+
+func (e TL_help_noAppUpdate) writeText(w *textWriter) {
+	w.name("help.noAppUpdate")
+}
+
+func (e TL_help_inviteText) writeText(w *textWriter) {
+	w.name("help.inviteText")
+	w.field("message")
+	w.String(e.Message)
+}
+
+func (e TL_encryptedChatEmpty) writeText(w *textWriter) {
+	w.name("encryptedChatEmpty")
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_encryptedChatWaiting) writeText(w *textWriter) {
+	w.name("encryptedChatWaiting")
+	w.field("id")
+	w.Int(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("participant_id")
+	w.Int(e.ParticipantID)
+}
+
+func (e TL_encryptedChatRequested) writeText(w *textWriter) {
+	w.name("encryptedChatRequested")
+	if e.Flags&1 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("participant_id")
+	w.Int(e.ParticipantID)
+	w.field("g_a")
+	w.Bytes(e.GA)
+}
+
+func (e TL_encryptedChat) writeText(w *textWriter) {
+	w.name("encryptedChat")
+	w.field("id")
+	w.Int(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("participant_id")
+	w.Int(e.ParticipantID)
+	w.field("g_a_or_b")
+	w.Bytes(e.GAOrB)
+	w.field("key_fingerprint")
+	w.Long(e.KeyFingerprint)
+}
+
+func (e TL_encryptedChatDiscarded) writeText(w *textWriter) {
+	w.name("encryptedChatDiscarded")
+	if e.Flags&1 != 0 {
+		w.field("history_deleted")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_inputEncryptedChat) writeText(w *textWriter) {
+	w.name("inputEncryptedChat")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_encryptedFileEmpty) writeText(w *textWriter) {
+	w.name("encryptedFileEmpty")
+}
+
+func (e TL_encryptedFile) writeText(w *textWriter) {
+	w.name("encryptedFile")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("size")
+	w.Int(e.Size)
+	w.field("dc_id")
+	w.Int(e.DcID)
+	w.field("key_fingerprint")
+	w.Int(e.KeyFingerprint)
+}
+
+func (e TL_inputEncryptedFileEmpty) writeText(w *textWriter) {
+	w.name("inputEncryptedFileEmpty")
+}
+
+func (e TL_inputEncryptedFileUploaded) writeText(w *textWriter) {
+	w.name("inputEncryptedFileUploaded")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("parts")
+	w.Int(e.Parts)
+	w.field("md5_checksum")
+	w.String(e.Md5Checksum)
+	w.field("key_fingerprint")
+	w.Int(e.KeyFingerprint)
+}
+
+func (e TL_inputEncryptedFile) writeText(w *textWriter) {
+	w.name("inputEncryptedFile")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputEncryptedFileBigUploaded) writeText(w *textWriter) {
+	w.name("inputEncryptedFileBigUploaded")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("parts")
+	w.Int(e.Parts)
+	w.field("key_fingerprint")
+	w.Int(e.KeyFingerprint)
+}
+
+func (e TL_encryptedMessage) writeText(w *textWriter) {
+	w.name("encryptedMessage")
+	w.field("random_id")
+	w.Long(e.RandomID)
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+	w.field("file")
+	w.Object(e.File)
+}
+
+func (e TL_encryptedMessageService) writeText(w *textWriter) {
+	w.name("encryptedMessageService")
+	w.field("random_id")
+	w.Long(e.RandomID)
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_messages_dhConfigNotModified) writeText(w *textWriter) {
+	w.name("messages.dhConfigNotModified")
+	w.field("random")
+	w.Bytes(e.Random)
+}
+
+func (e TL_messages_dhConfig) writeText(w *textWriter) {
+	w.name("messages.dhConfig")
+	w.field("g")
+	w.Int(e.G)
+	w.field("p")
+	w.Bytes(e.P)
+	w.field("version")
+	w.Int(e.Version)
+	w.field("random")
+	w.Bytes(e.Random)
+}
+
+func (e TL_messages_sentEncryptedMessage) writeText(w *textWriter) {
+	w.name("messages.sentEncryptedMessage")
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_messages_sentEncryptedFile) writeText(w *textWriter) {
+	w.name("messages.sentEncryptedFile")
+	w.field("date")
+	w.Int(e.Date)
+	w.field("file")
+	w.Object(e.File)
+}
+
+func (e TL_inputDocumentEmpty) writeText(w *textWriter) {
+	w.name("inputDocumentEmpty")
+}
+
+func (e TL_inputDocument) writeText(w *textWriter) {
+	w.name("inputDocument")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("file_reference")
+	w.Bytes(e.FileReference)
+}
+
+func (e TL_documentEmpty) writeText(w *textWriter) {
+	w.name("documentEmpty")
+	w.field("id")
+	w.Long(e.ID)
+}
+
+func (e TL_document) writeText(w *textWriter) {
+	w.name("document")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("file_reference")
+	w.Bytes(e.FileReference)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("mime_type")
+	w.String(e.MimeType)
+	w.field("size")
+	w.Int(e.Size)
+	if e.Flags&1 != 0 {
+		w.field("thumbs")
+		w.Vector(e.Thumbs)
+	}
+	if e.Flags&2 != 0 {
+		w.field("video_thumbs")
+		w.Vector(e.VideoThumbs)
+	}
+	w.field("dc_id")
+	w.Int(e.DcID)
+	w.field("attributes")
+	w.Vector(e.Attributes)
+}
+
+func (e TL_help_support) writeText(w *textWriter) {
+	w.name("help.support")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("user")
+	w.Object(e.User)
+}
+
+func (e TL_notifyPeer) writeText(w *textWriter) {
+	w.name("notifyPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_notifyUsers) writeText(w *textWriter) {
+	w.name("notifyUsers")
+}
+
+func (e TL_notifyChats) writeText(w *textWriter) {
+	w.name("notifyChats")
+}
+
+func (e TL_notifyBroadcasts) writeText(w *textWriter) {
+	w.name("notifyBroadcasts")
+}
+
+func (e TL_sendMessageTypingAction) writeText(w *textWriter) {
+	w.name("sendMessageTypingAction")
+}
+
+func (e TL_sendMessageCancelAction) writeText(w *textWriter) {
+	w.name("sendMessageCancelAction")
+}
+
+func (e TL_sendMessageRecordVideoAction) writeText(w *textWriter) {
+	w.name("sendMessageRecordVideoAction")
+}
+
+func (e TL_sendMessageUploadVideoAction) writeText(w *textWriter) {
+	w.name("sendMessageUploadVideoAction")
+	w.field("progress")
+	w.Int(e.Progress)
+}
+
+func (e TL_sendMessageRecordAudioAction) writeText(w *textWriter) {
+	w.name("sendMessageRecordAudioAction")
+}
+
+func (e TL_sendMessageUploadAudioAction) writeText(w *textWriter) {
+	w.name("sendMessageUploadAudioAction")
+	w.field("progress")
+	w.Int(e.Progress)
+}
+
+func (e TL_sendMessageUploadPhotoAction) writeText(w *textWriter) {
+	w.name("sendMessageUploadPhotoAction")
+	w.field("progress")
+	w.Int(e.Progress)
+}
+
+func (e TL_sendMessageUploadDocumentAction) writeText(w *textWriter) {
+	w.name("sendMessageUploadDocumentAction")
+	w.field("progress")
+	w.Int(e.Progress)
+}
+
+func (e TL_sendMessageGeoLocationAction) writeText(w *textWriter) {
+	w.name("sendMessageGeoLocationAction")
+}
+
+func (e TL_sendMessageChooseContactAction) writeText(w *textWriter) {
+	w.name("sendMessageChooseContactAction")
+}
+
+func (e TL_sendMessageGamePlayAction) writeText(w *textWriter) {
+	w.name("sendMessageGamePlayAction")
+}
+
+func (e TL_sendMessageRecordRoundAction) writeText(w *textWriter) {
+	w.name("sendMessageRecordRoundAction")
+}
+
+func (e TL_sendMessageUploadRoundAction) writeText(w *textWriter) {
+	w.name("sendMessageUploadRoundAction")
+	w.field("progress")
+	w.Int(e.Progress)
+}
+
+func (e TL_speakingInGroupCallAction) writeText(w *textWriter) {
+	w.name("speakingInGroupCallAction")
+}
+
+func (e TL_sendMessageHistoryImportAction) writeText(w *textWriter) {
+	w.name("sendMessageHistoryImportAction")
+	w.field("progress")
+	w.Int(e.Progress)
+}
+
+func (e TL_contacts_found) writeText(w *textWriter) {
+	w.name("contacts.found")
+	w.field("my_results")
+	w.Vector(e.MyResults)
+	w.field("results")
+	w.Vector(e.Results)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_inputPrivacyKeyStatusTimestamp) writeText(w *textWriter) {
+	w.name("inputPrivacyKeyStatusTimestamp")
+}
+
+func (e TL_inputPrivacyKeyChatInvite) writeText(w *textWriter) {
+	w.name("inputPrivacyKeyChatInvite")
+}
+
+func (e TL_inputPrivacyKeyPhoneCall) writeText(w *textWriter) {
+	w.name("inputPrivacyKeyPhoneCall")
+}
+
+func (e TL_inputPrivacyKeyPhoneP2P) writeText(w *textWriter) {
+	w.name("inputPrivacyKeyPhoneP2P")
+}
+
+func (e TL_inputPrivacyKeyForwards) writeText(w *textWriter) {
+	w.name("inputPrivacyKeyForwards")
+}
+
+func (e TL_inputPrivacyKeyProfilePhoto) writeText(w *textWriter) {
+	w.name("inputPrivacyKeyProfilePhoto")
+}
+
+func (e TL_inputPrivacyKeyPhoneNumber) writeText(w *textWriter) {
+	w.name("inputPrivacyKeyPhoneNumber")
+}
+
+func (e TL_inputPrivacyKeyAddedByPhone) writeText(w *textWriter) {
+	w.name("inputPrivacyKeyAddedByPhone")
+}
+
+func (e TL_privacyKeyStatusTimestamp) writeText(w *textWriter) {
+	w.name("privacyKeyStatusTimestamp")
+}
+
+func (e TL_privacyKeyChatInvite) writeText(w *textWriter) {
+	w.name("privacyKeyChatInvite")
+}
+
+func (e TL_privacyKeyPhoneCall) writeText(w *textWriter) {
+	w.name("privacyKeyPhoneCall")
+}
+
+func (e TL_privacyKeyPhoneP2P) writeText(w *textWriter) {
+	w.name("privacyKeyPhoneP2P")
+}
+
+func (e TL_privacyKeyForwards) writeText(w *textWriter) {
+	w.name("privacyKeyForwards")
+}
+
+func (e TL_privacyKeyProfilePhoto) writeText(w *textWriter) {
+	w.name("privacyKeyProfilePhoto")
+}
+
+func (e TL_privacyKeyPhoneNumber) writeText(w *textWriter) {
+	w.name("privacyKeyPhoneNumber")
+}
+
+func (e TL_privacyKeyAddedByPhone) writeText(w *textWriter) {
+	w.name("privacyKeyAddedByPhone")
+}
+
+func (e TL_inputPrivacyValueAllowContacts) writeText(w *textWriter) {
+	w.name("inputPrivacyValueAllowContacts")
+}
+
+func (e TL_inputPrivacyValueAllowAll) writeText(w *textWriter) {
+	w.name("inputPrivacyValueAllowAll")
+}
+
+func (e TL_inputPrivacyValueAllowUsers) writeText(w *textWriter) {
+	w.name("inputPrivacyValueAllowUsers")
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_inputPrivacyValueDisallowContacts) writeText(w *textWriter) {
+	w.name("inputPrivacyValueDisallowContacts")
+}
+
+func (e TL_inputPrivacyValueDisallowAll) writeText(w *textWriter) {
+	w.name("inputPrivacyValueDisallowAll")
+}
+
+func (e TL_inputPrivacyValueDisallowUsers) writeText(w *textWriter) {
+	w.name("inputPrivacyValueDisallowUsers")
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_inputPrivacyValueAllowChatParticipants) writeText(w *textWriter) {
+	w.name("inputPrivacyValueAllowChatParticipants")
+	w.field("chats")
+	w.VectorInt(e.Chats)
+}
+
+func (e TL_inputPrivacyValueDisallowChatParticipants) writeText(w *textWriter) {
+	w.name("inputPrivacyValueDisallowChatParticipants")
+	w.field("chats")
+	w.VectorInt(e.Chats)
+}
+
+func (e TL_privacyValueAllowContacts) writeText(w *textWriter) {
+	w.name("privacyValueAllowContacts")
+}
+
+func (e TL_privacyValueAllowAll) writeText(w *textWriter) {
+	w.name("privacyValueAllowAll")
+}
+
+func (e TL_privacyValueAllowUsers) writeText(w *textWriter) {
+	w.name("privacyValueAllowUsers")
+	w.field("users")
+	w.VectorInt(e.Users)
+}
+
+func (e TL_privacyValueDisallowContacts) writeText(w *textWriter) {
+	w.name("privacyValueDisallowContacts")
+}
+
+func (e TL_privacyValueDisallowAll) writeText(w *textWriter) {
+	w.name("privacyValueDisallowAll")
+}
+
+func (e TL_privacyValueDisallowUsers) writeText(w *textWriter) {
+	w.name("privacyValueDisallowUsers")
+	w.field("users")
+	w.VectorInt(e.Users)
+}
+
+func (e TL_privacyValueAllowChatParticipants) writeText(w *textWriter) {
+	w.name("privacyValueAllowChatParticipants")
+	w.field("chats")
+	w.VectorInt(e.Chats)
+}
+
+func (e TL_privacyValueDisallowChatParticipants) writeText(w *textWriter) {
+	w.name("privacyValueDisallowChatParticipants")
+	w.field("chats")
+	w.VectorInt(e.Chats)
+}
+
+func (e TL_account_privacyRules) writeText(w *textWriter) {
+	w.name("account.privacyRules")
+	w.field("rules")
+	w.Vector(e.Rules)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_accountDaysTTL) writeText(w *textWriter) {
+	w.name("accountDaysTTL")
+	w.field("days")
+	w.Int(e.Days)
+}
+
+func (e TL_documentAttributeImageSize) writeText(w *textWriter) {
+	w.name("documentAttributeImageSize")
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+}
+
+func (e TL_documentAttributeAnimated) writeText(w *textWriter) {
+	w.name("documentAttributeAnimated")
+}
+
+func (e TL_documentAttributeSticker) writeText(w *textWriter) {
+	w.name("documentAttributeSticker")
+	if e.Flags&2 != 0 {
+		w.field("mask")
+		w.Bool(true)
+	}
+	w.field("alt")
+	w.String(e.Alt)
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+	if e.Flags&1 != 0 {
+		w.field("mask_coords")
+		w.Object(e.MaskCoords)
+	}
+}
+
+func (e TL_documentAttributeVideo) writeText(w *textWriter) {
+	w.name("documentAttributeVideo")
+	if e.Flags&1 != 0 {
+		w.field("round_message")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("supports_streaming")
+		w.Bool(true)
+	}
+	w.field("duration")
+	w.Int(e.Duration)
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+}
+
+func (e TL_documentAttributeAudio) writeText(w *textWriter) {
+	w.name("documentAttributeAudio")
+	if e.Flags&1024 != 0 {
+		w.field("voice")
+		w.Bool(true)
+	}
+	w.field("duration")
+	w.Int(e.Duration)
+	if e.Flags&1 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&2 != 0 {
+		w.field("performer")
+		w.String(e.Performer)
+	}
+	if e.Flags&4 != 0 {
+		w.field("waveform")
+		w.Bytes(e.Waveform)
+	}
+}
+
+func (e TL_documentAttributeFilename) writeText(w *textWriter) {
+	w.name("documentAttributeFilename")
+	w.field("file_name")
+	w.String(e.FileName)
+}
+
+func (e TL_documentAttributeHasStickers) writeText(w *textWriter) {
+	w.name("documentAttributeHasStickers")
+}
+
+func (e TL_messages_stickersNotModified) writeText(w *textWriter) {
+	w.name("messages.stickersNotModified")
+}
+
+func (e TL_messages_stickers) writeText(w *textWriter) {
+	w.name("messages.stickers")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("stickers")
+	w.Vector(e.Stickers)
+}
+
+func (e TL_stickerPack) writeText(w *textWriter) {
+	w.name("stickerPack")
+	w.field("emoticon")
+	w.String(e.Emoticon)
+	w.field("documents")
+	w.VectorLong(e.Documents)
+}
+
+func (e TL_messages_allStickersNotModified) writeText(w *textWriter) {
+	w.name("messages.allStickersNotModified")
+}
+
+func (e TL_messages_allStickers) writeText(w *textWriter) {
+	w.name("messages.allStickers")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("sets")
+	w.Vector(e.Sets)
+}
+
+func (e TL_messages_affectedMessages) writeText(w *textWriter) {
+	w.name("messages.affectedMessages")
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+}
+
+func (e TL_webPageEmpty) writeText(w *textWriter) {
+	w.name("webPageEmpty")
+	w.field("id")
+	w.Long(e.ID)
+}
+
+func (e TL_webPagePending) writeText(w *textWriter) {
+	w.name("webPagePending")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_webPage) writeText(w *textWriter) {
+	w.name("webPage")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("url")
+	w.String(e.Url)
+	w.field("display_url")
+	w.String(e.DisplayUrl)
+	w.field("hash")
+	w.Int(e.Hash)
+	if e.Flags&1 != 0 {
+		w.field("type")
+		w.String(e.Type)
+	}
+	if e.Flags&2 != 0 {
+		w.field("site_name")
+		w.String(e.SiteName)
+	}
+	if e.Flags&4 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&8 != 0 {
+		w.field("description")
+		w.String(e.Description)
+	}
+	if e.Flags&16 != 0 {
+		w.field("photo")
+		w.Object(e.Photo)
+	}
+	if e.Flags&32 != 0 {
+		w.field("embed_url")
+		w.String(e.EmbedUrl)
+	}
+	if e.Flags&32 != 0 {
+		w.field("embed_type")
+		w.String(e.EmbedType)
+	}
+	if e.Flags&64 != 0 {
+		w.field("embed_width")
+		w.Int(e.EmbedWidth)
+	}
+	if e.Flags&64 != 0 {
+		w.field("embed_height")
+		w.Int(e.EmbedHeight)
+	}
+	if e.Flags&128 != 0 {
+		w.field("duration")
+		w.Int(e.Duration)
+	}
+	if e.Flags&256 != 0 {
+		w.field("author")
+		w.String(e.Author)
+	}
+	if e.Flags&512 != 0 {
+		w.field("document")
+		w.Object(e.Document)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("cached_page")
+		w.Object(e.CachedPage)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("attributes")
+		w.Vector(e.Attributes)
+	}
+}
+
+func (e TL_webPageNotModified) writeText(w *textWriter) {
+	w.name("webPageNotModified")
+	if e.Flags&1 != 0 {
+		w.field("cached_page_views")
+		w.Int(e.CachedPageViews)
+	}
+}
+
+func (e TL_authorization) writeText(w *textWriter) {
+	w.name("authorization")
+	if e.Flags&1 != 0 {
+		w.field("current")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("official_app")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("password_pending")
+		w.Bool(true)
+	}
+	w.field("hash")
+	w.Long(e.Hash)
+	w.field("device_model")
+	w.String(e.DeviceModel)
+	w.field("platform")
+	w.String(e.Platform)
+	w.field("system_version")
+	w.String(e.SystemVersion)
+	w.field("api_id")
+	w.Int(e.ApiID)
+	w.field("app_name")
+	w.String(e.AppName)
+	w.field("app_version")
+	w.String(e.AppVersion)
+	w.field("date_created")
+	w.Int(e.DateCreated)
+	w.field("date_active")
+	w.Int(e.DateActive)
+	w.field("ip")
+	w.String(e.Ip)
+	w.field("country")
+	w.String(e.Country)
+	w.field("region")
+	w.String(e.Region)
+}
+
+func (e TL_account_authorizations) writeText(w *textWriter) {
+	w.name("account.authorizations")
+	w.field("authorizations")
+	w.Vector(e.Authorizations)
+}
+
+func (e TL_account_password) writeText(w *textWriter) {
+	w.name("account.password")
+	if e.Flags&1 != 0 {
+		w.field("has_recovery")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("has_secure_values")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("has_password")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("current_algo")
+		w.Object(e.CurrentAlgo)
+	}
+	if e.Flags&4 != 0 {
+		w.field("srp_B")
+		w.Bytes(e.SrpB)
+	}
+	if e.Flags&4 != 0 {
+		w.field("srp_id")
+		w.Long(e.SrpID)
+	}
+	if e.Flags&8 != 0 {
+		w.field("hint")
+		w.String(e.Hint)
+	}
+	if e.Flags&16 != 0 {
+		w.field("email_unconfirmed_pattern")
+		w.String(e.EmailUnconfirmedPattern)
+	}
+	w.field("new_algo")
+	w.Object(e.NewAlgo)
+	w.field("new_secure_algo")
+	w.Object(e.NewSecureAlgo)
+	w.field("secure_random")
+	w.Bytes(e.SecureRandom)
+}
+
+func (e TL_account_passwordSettings) writeText(w *textWriter) {
+	w.name("account.passwordSettings")
+	if e.Flags&1 != 0 {
+		w.field("email")
+		w.String(e.Email)
+	}
+	if e.Flags&2 != 0 {
+		w.field("secure_settings")
+		w.Object(e.SecureSettings)
+	}
+}
+
+func (e TL_account_passwordInputSettings) writeText(w *textWriter) {
+	w.name("account.passwordInputSettings")
+	if e.Flags&1 != 0 {
+		w.field("new_algo")
+		w.Object(e.NewAlgo)
+	}
+	if e.Flags&1 != 0 {
+		w.field("new_password_hash")
+		w.Bytes(e.NewPasswordHash)
+	}
+	if e.Flags&1 != 0 {
+		w.field("hint")
+		w.String(e.Hint)
+	}
+	if e.Flags&2 != 0 {
+		w.field("email")
+		w.String(e.Email)
+	}
+	if e.Flags&4 != 0 {
+		w.field("new_secure_settings")
+		w.Object(e.NewSecureSettings)
+	}
+}
+
+func (e TL_auth_passwordRecovery) writeText(w *textWriter) {
+	w.name("auth.passwordRecovery")
+	w.field("email_pattern")
+	w.String(e.EmailPattern)
+}
+
+func (e TL_receivedNotifyMessage) writeText(w *textWriter) {
+	w.name("receivedNotifyMessage")
+	w.field("id")
+	w.Int(e.ID)
+	w.field("flags")
+	w.Int(e.Flags)
+}
+
+func (e TL_chatInviteExported) writeText(w *textWriter) {
+	w.name("chatInviteExported")
+	if e.Flags&1 != 0 {
+		w.field("revoked")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("permanent")
+		w.Bool(true)
+	}
+	w.field("link")
+	w.String(e.Link)
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("date")
+	w.Int(e.Date)
+	if e.Flags&16 != 0 {
+		w.field("start_date")
+		w.Int(e.StartDate)
+	}
+	if e.Flags&2 != 0 {
+		w.field("expire_date")
+		w.Int(e.ExpireDate)
+	}
+	if e.Flags&4 != 0 {
+		w.field("usage_limit")
+		w.Int(e.UsageLimit)
+	}
+	if e.Flags&8 != 0 {
+		w.field("usage")
+		w.Int(e.Usage)
+	}
+}
+
+func (e TL_chatInviteAlready) writeText(w *textWriter) {
+	w.name("chatInviteAlready")
+	w.field("chat")
+	w.Object(e.Chat)
+}
+
+func (e TL_chatInvite) writeText(w *textWriter) {
+	w.name("chatInvite")
+	if e.Flags&1 != 0 {
+		w.field("channel")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("broadcast")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("public")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("megagroup")
+		w.Bool(true)
+	}
+	w.field("title")
+	w.String(e.Title)
+	w.field("photo")
+	w.Object(e.Photo)
+	w.field("participants_count")
+	w.Int(e.ParticipantsCount)
+	if e.Flags&16 != 0 {
+		w.field("participants")
+		w.Vector(e.Participants)
+	}
+}
+
+func (e TL_chatInvitePeek) writeText(w *textWriter) {
+	w.name("chatInvitePeek")
+	w.field("chat")
+	w.Object(e.Chat)
+	w.field("expires")
+	w.Int(e.Expires)
+}
+
+func (e TL_inputStickerSetEmpty) writeText(w *textWriter) {
+	w.name("inputStickerSetEmpty")
+}
+
+func (e TL_inputStickerSetID) writeText(w *textWriter) {
+	w.name("inputStickerSetID")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputStickerSetShortName) writeText(w *textWriter) {
+	w.name("inputStickerSetShortName")
+	w.field("short_name")
+	w.String(e.ShortName)
+}
+
+func (e TL_inputStickerSetAnimatedEmoji) writeText(w *textWriter) {
+	w.name("inputStickerSetAnimatedEmoji")
+}
+
+func (e TL_inputStickerSetDice) writeText(w *textWriter) {
+	w.name("inputStickerSetDice")
+	w.field("emoticon")
+	w.String(e.Emoticon)
+}
+
+func (e TL_stickerSet) writeText(w *textWriter) {
+	w.name("stickerSet")
+	if e.Flags&2 != 0 {
+		w.field("archived")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("official")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("masks")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("animated")
+		w.Bool(true)
+	}
+	if e.Flags&1 != 0 {
+		w.field("installed_date")
+		w.Int(e.InstalledDate)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("title")
+	w.String(e.Title)
+	w.field("short_name")
+	w.String(e.ShortName)
+	if e.Flags&16 != 0 {
+		w.field("thumbs")
+		w.Vector(e.Thumbs)
+	}
+	if e.Flags&16 != 0 {
+		w.field("thumb_dc_id")
+		w.Int(e.ThumbDcID)
+	}
+	w.field("count")
+	w.Int(e.Count)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_stickerSet) writeText(w *textWriter) {
+	w.name("messages.stickerSet")
+	w.field("set")
+	w.Object(e.Set)
+	w.field("packs")
+	w.Vector(e.Packs)
+	w.field("documents")
+	w.Vector(e.Documents)
+}
+
+func (e TL_botCommand) writeText(w *textWriter) {
+	w.name("botCommand")
+	w.field("command")
+	w.String(e.Command)
+	w.field("description")
+	w.String(e.Description)
+}
+
+func (e TL_botInfo) writeText(w *textWriter) {
+	w.name("botInfo")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("description")
+	w.String(e.Description)
+	w.field("commands")
+	w.Vector(e.Commands)
+}
+
+func (e TL_keyboardButton) writeText(w *textWriter) {
+	w.name("keyboardButton")
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_keyboardButtonUrl) writeText(w *textWriter) {
+	w.name("keyboardButtonUrl")
+	w.field("text")
+	w.String(e.Text)
+	w.field("url")
+	w.String(e.Url)
+}
+
+func (e TL_keyboardButtonCallback) writeText(w *textWriter) {
+	w.name("keyboardButtonCallback")
+	if e.Flags&1 != 0 {
+		w.field("requires_password")
+		w.Bool(true)
+	}
+	w.field("text")
+	w.String(e.Text)
+	w.field("data")
+	w.Bytes(e.Data)
+}
+
+func (e TL_keyboardButtonRequestPhone) writeText(w *textWriter) {
+	w.name("keyboardButtonRequestPhone")
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_keyboardButtonRequestGeoLocation) writeText(w *textWriter) {
+	w.name("keyboardButtonRequestGeoLocation")
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_keyboardButtonSwitchInline) writeText(w *textWriter) {
+	w.name("keyboardButtonSwitchInline")
+	if e.Flags&1 != 0 {
+		w.field("same_peer")
+		w.Bool(true)
+	}
+	w.field("text")
+	w.String(e.Text)
+	w.field("query")
+	w.String(e.Query)
+}
+
+func (e TL_keyboardButtonGame) writeText(w *textWriter) {
+	w.name("keyboardButtonGame")
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_keyboardButtonBuy) writeText(w *textWriter) {
+	w.name("keyboardButtonBuy")
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_keyboardButtonUrlAuth) writeText(w *textWriter) {
+	w.name("keyboardButtonUrlAuth")
+	w.field("text")
+	w.String(e.Text)
+	if e.Flags&1 != 0 {
+		w.field("fwd_text")
+		w.String(e.FwdText)
+	}
+	w.field("url")
+	w.String(e.Url)
+	w.field("button_id")
+	w.Int(e.ButtonID)
+}
+
+func (e TL_inputKeyboardButtonUrlAuth) writeText(w *textWriter) {
+	w.name("inputKeyboardButtonUrlAuth")
+	if e.Flags&1 != 0 {
+		w.field("request_write_access")
+		w.Bool(true)
+	}
+	w.field("text")
+	w.String(e.Text)
+	if e.Flags&2 != 0 {
+		w.field("fwd_text")
+		w.String(e.FwdText)
+	}
+	w.field("url")
+	w.String(e.Url)
+	w.field("bot")
+	w.Object(e.Bot)
+}
+
+func (e TL_keyboardButtonRequestPoll) writeText(w *textWriter) {
+	w.name("keyboardButtonRequestPoll")
+	if e.Flags&1 != 0 {
+		w.field("quiz")
+		w.Object(e.Quiz)
+	}
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_keyboardButtonRow) writeText(w *textWriter) {
+	w.name("keyboardButtonRow")
+	w.field("buttons")
+	w.Vector(e.Buttons)
+}
+
+func (e TL_replyKeyboardHide) writeText(w *textWriter) {
+	w.name("replyKeyboardHide")
+	if e.Flags&4 != 0 {
+		w.field("selective")
+		w.Bool(true)
+	}
+}
+
+func (e TL_replyKeyboardForceReply) writeText(w *textWriter) {
+	w.name("replyKeyboardForceReply")
+	if e.Flags&2 != 0 {
+		w.field("single_use")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("selective")
+		w.Bool(true)
+	}
+}
+
+func (e TL_replyKeyboardMarkup) writeText(w *textWriter) {
+	w.name("replyKeyboardMarkup")
+	if e.Flags&1 != 0 {
+		w.field("resize")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("single_use")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("selective")
+		w.Bool(true)
+	}
+	w.field("rows")
+	w.Vector(e.Rows)
+}
+
+func (e TL_replyInlineMarkup) writeText(w *textWriter) {
+	w.name("replyInlineMarkup")
+	w.field("rows")
+	w.Vector(e.Rows)
+}
+
+func (e TL_messageEntityUnknown) writeText(w *textWriter) {
+	w.name("messageEntityUnknown")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityMention) writeText(w *textWriter) {
+	w.name("messageEntityMention")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityHashtag) writeText(w *textWriter) {
+	w.name("messageEntityHashtag")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityBotCommand) writeText(w *textWriter) {
+	w.name("messageEntityBotCommand")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityUrl) writeText(w *textWriter) {
+	w.name("messageEntityUrl")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityEmail) writeText(w *textWriter) {
+	w.name("messageEntityEmail")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityBold) writeText(w *textWriter) {
+	w.name("messageEntityBold")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityItalic) writeText(w *textWriter) {
+	w.name("messageEntityItalic")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityCode) writeText(w *textWriter) {
+	w.name("messageEntityCode")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityPre) writeText(w *textWriter) {
+	w.name("messageEntityPre")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+	w.field("language")
+	w.String(e.Language)
+}
+
+func (e TL_messageEntityTextUrl) writeText(w *textWriter) {
+	w.name("messageEntityTextUrl")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+	w.field("url")
+	w.String(e.Url)
+}
+
+func (e TL_messageEntityMentionName) writeText(w *textWriter) {
+	w.name("messageEntityMentionName")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+	w.field("user_id")
+	w.Int(e.UserID)
+}
+
+func (e TL_inputMessageEntityMentionName) writeText(w *textWriter) {
+	w.name("inputMessageEntityMentionName")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+	w.field("user_id")
+	w.Object(e.UserID)
+}
+
+func (e TL_messageEntityPhone) writeText(w *textWriter) {
+	w.name("messageEntityPhone")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityCashtag) writeText(w *textWriter) {
+	w.name("messageEntityCashtag")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityUnderline) writeText(w *textWriter) {
+	w.name("messageEntityUnderline")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityStrike) writeText(w *textWriter) {
+	w.name("messageEntityStrike")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityBlockquote) writeText(w *textWriter) {
+	w.name("messageEntityBlockquote")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_messageEntityBankCard) writeText(w *textWriter) {
+	w.name("messageEntityBankCard")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_inputChannelEmpty) writeText(w *textWriter) {
+	w.name("inputChannelEmpty")
+}
+
+func (e TL_inputChannel) writeText(w *textWriter) {
+	w.name("inputChannel")
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputChannelFromMessage) writeText(w *textWriter) {
+	w.name("inputChannelFromMessage")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("channel_id")
+	w.Int(e.ChannelID)
+}
+
+func (e TL_contacts_resolvedPeer) writeText(w *textWriter) {
+	w.name("contacts.resolvedPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messageRange) writeText(w *textWriter) {
+	w.name("messageRange")
+	w.field("min_id")
+	w.Int(e.MinID)
+	w.field("max_id")
+	w.Int(e.MaxID)
+}
+
+func (e TL_updates_channelDifferenceEmpty) writeText(w *textWriter) {
+	w.name("updates.channelDifferenceEmpty")
+	if e.Flags&1 != 0 {
+		w.field("final")
+		w.Bool(true)
+	}
+	w.field("pts")
+	w.Int(e.Pts)
+	if e.Flags&2 != 0 {
+		w.field("timeout")
+		w.Int(e.Timeout)
+	}
+}
+
+func (e TL_updates_channelDifferenceTooLong) writeText(w *textWriter) {
+	w.name("updates.channelDifferenceTooLong")
+	if e.Flags&1 != 0 {
+		w.field("final")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("timeout")
+		w.Int(e.Timeout)
+	}
+	w.field("dialog")
+	w.Object(e.Dialog)
+	w.field("messages")
+	w.Vector(e.Messages)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_updates_channelDifference) writeText(w *textWriter) {
+	w.name("updates.channelDifference")
+	if e.Flags&1 != 0 {
+		w.field("final")
+		w.Bool(true)
+	}
+	w.field("pts")
+	w.Int(e.Pts)
+	if e.Flags&2 != 0 {
+		w.field("timeout")
+		w.Int(e.Timeout)
+	}
+	w.field("new_messages")
+	w.Vector(e.NewMessages)
+	w.field("other_updates")
+	w.Vector(e.OtherUpdates)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_channelMessagesFilterEmpty) writeText(w *textWriter) {
+	w.name("channelMessagesFilterEmpty")
+}
+
+func (e TL_channelMessagesFilter) writeText(w *textWriter) {
+	w.name("channelMessagesFilter")
+	if e.Flags&2 != 0 {
+		w.field("exclude_new_messages")
+		w.Bool(true)
+	}
+	w.field("ranges")
+	w.Vector(e.Ranges)
+}
+
+func (e TL_channelParticipant) writeText(w *textWriter) {
+	w.name("channelParticipant")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_channelParticipantSelf) writeText(w *textWriter) {
+	w.name("channelParticipantSelf")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("inviter_id")
+	w.Int(e.InviterID)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_channelParticipantCreator) writeText(w *textWriter) {
+	w.name("channelParticipantCreator")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("admin_rights")
+	w.Object(e.AdminRights)
+	if e.Flags&1 != 0 {
+		w.field("rank")
+		w.String(e.Rank)
+	}
+}
+
+func (e TL_channelParticipantAdmin) writeText(w *textWriter) {
+	w.name("channelParticipantAdmin")
+	if e.Flags&1 != 0 {
+		w.field("can_edit")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("self")
+		w.Bool(true)
+	}
+	w.field("user_id")
+	w.Int(e.UserID)
+	if e.Flags&2 != 0 {
+		w.field("inviter_id")
+		w.Int(e.InviterID)
+	}
+	w.field("promoted_by")
+	w.Int(e.PromotedBy)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("admin_rights")
+	w.Object(e.AdminRights)
+	if e.Flags&4 != 0 {
+		w.field("rank")
+		w.String(e.Rank)
+	}
+}
+
+func (e TL_channelParticipantBanned) writeText(w *textWriter) {
+	w.name("channelParticipantBanned")
+	if e.Flags&1 != 0 {
+		w.field("left")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("kicked_by")
+	w.Int(e.KickedBy)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("banned_rights")
+	w.Object(e.BannedRights)
+}
+
+func (e TL_channelParticipantLeft) writeText(w *textWriter) {
+	w.name("channelParticipantLeft")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_channelParticipantsRecent) writeText(w *textWriter) {
+	w.name("channelParticipantsRecent")
+}
+
+func (e TL_channelParticipantsAdmins) writeText(w *textWriter) {
+	w.name("channelParticipantsAdmins")
+}
+
+func (e TL_channelParticipantsKicked) writeText(w *textWriter) {
+	w.name("channelParticipantsKicked")
+	w.field("q")
+	w.String(e.Q)
+}
+
+func (e TL_channelParticipantsBots) writeText(w *textWriter) {
+	w.name("channelParticipantsBots")
+}
+
+func (e TL_channelParticipantsBanned) writeText(w *textWriter) {
+	w.name("channelParticipantsBanned")
+	w.field("q")
+	w.String(e.Q)
+}
+
+func (e TL_channelParticipantsSearch) writeText(w *textWriter) {
+	w.name("channelParticipantsSearch")
+	w.field("q")
+	w.String(e.Q)
+}
+
+func (e TL_channelParticipantsContacts) writeText(w *textWriter) {
+	w.name("channelParticipantsContacts")
+	w.field("q")
+	w.String(e.Q)
+}
+
+func (e TL_channelParticipantsMentions) writeText(w *textWriter) {
+	w.name("channelParticipantsMentions")
+	if e.Flags&1 != 0 {
+		w.field("q")
+		w.String(e.Q)
+	}
+	if e.Flags&2 != 0 {
+		w.field("top_msg_id")
+		w.Int(e.TopMsgID)
+	}
+}
+
+func (e TL_channels_channelParticipants) writeText(w *textWriter) {
+	w.name("channels.channelParticipants")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("participants")
+	w.Vector(e.Participants)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_channels_channelParticipantsNotModified) writeText(w *textWriter) {
+	w.name("channels.channelParticipantsNotModified")
+}
+
+func (e TL_channels_channelParticipant) writeText(w *textWriter) {
+	w.name("channels.channelParticipant")
+	w.field("participant")
+	w.Object(e.Participant)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_help_termsOfService) writeText(w *textWriter) {
+	w.name("help.termsOfService")
+	if e.Flags&1 != 0 {
+		w.field("popup")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Object(e.ID)
+	w.field("text")
+	w.String(e.Text)
+	w.field("entities")
+	w.Vector(e.Entities)
+	if e.Flags&2 != 0 {
+		w.field("min_age_confirm")
+		w.Int(e.MinAgeConfirm)
+	}
+}
+
+func (e TL_messages_savedGifsNotModified) writeText(w *textWriter) {
+	w.name("messages.savedGifsNotModified")
+}
+
+func (e TL_messages_savedGifs) writeText(w *textWriter) {
+	w.name("messages.savedGifs")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("gifs")
+	w.Vector(e.Gifs)
+}
+
+func (e TL_inputBotInlineMessageMediaAuto) writeText(w *textWriter) {
+	w.name("inputBotInlineMessageMediaAuto")
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&2 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_inputBotInlineMessageText) writeText(w *textWriter) {
+	w.name("inputBotInlineMessageText")
+	if e.Flags&1 != 0 {
+		w.field("no_webpage")
+		w.Bool(true)
+	}
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&2 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_inputBotInlineMessageMediaGeo) writeText(w *textWriter) {
+	w.name("inputBotInlineMessageMediaGeo")
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+	if e.Flags&1 != 0 {
+		w.field("heading")
+		w.Int(e.Heading)
+	}
+	if e.Flags&2 != 0 {
+		w.field("period")
+		w.Int(e.Period)
+	}
+	if e.Flags&8 != 0 {
+		w.field("proximity_notification_radius")
+		w.Int(e.ProximityNotificationRadius)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_inputBotInlineMessageMediaVenue) writeText(w *textWriter) {
+	w.name("inputBotInlineMessageMediaVenue")
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+	w.field("title")
+	w.String(e.Title)
+	w.field("address")
+	w.String(e.Address)
+	w.field("provider")
+	w.String(e.Provider)
+	w.field("venue_id")
+	w.String(e.VenueID)
+	w.field("venue_type")
+	w.String(e.VenueType)
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_inputBotInlineMessageMediaContact) writeText(w *textWriter) {
+	w.name("inputBotInlineMessageMediaContact")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+	w.field("vcard")
+	w.String(e.Vcard)
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_inputBotInlineMessageGame) writeText(w *textWriter) {
+	w.name("inputBotInlineMessageGame")
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_inputBotInlineResult) writeText(w *textWriter) {
+	w.name("inputBotInlineResult")
+	w.field("id")
+	w.String(e.ID)
+	w.field("type")
+	w.String(e.Type)
+	if e.Flags&2 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&4 != 0 {
+		w.field("description")
+		w.String(e.Description)
+	}
+	if e.Flags&8 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+	if e.Flags&16 != 0 {
+		w.field("thumb")
+		w.Object(e.Thumb)
+	}
+	if e.Flags&32 != 0 {
+		w.field("content")
+		w.Object(e.Content)
+	}
+	w.field("send_message")
+	w.Object(e.SendMessage)
+}
+
+func (e TL_inputBotInlineResultPhoto) writeText(w *textWriter) {
+	w.name("inputBotInlineResultPhoto")
+	w.field("id")
+	w.String(e.ID)
+	w.field("type")
+	w.String(e.Type)
+	w.field("photo")
+	w.Object(e.Photo)
+	w.field("send_message")
+	w.Object(e.SendMessage)
+}
+
+func (e TL_inputBotInlineResultDocument) writeText(w *textWriter) {
+	w.name("inputBotInlineResultDocument")
+	w.field("id")
+	w.String(e.ID)
+	w.field("type")
+	w.String(e.Type)
+	if e.Flags&2 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&4 != 0 {
+		w.field("description")
+		w.String(e.Description)
+	}
+	w.field("document")
+	w.Object(e.Document)
+	w.field("send_message")
+	w.Object(e.SendMessage)
+}
+
+func (e TL_inputBotInlineResultGame) writeText(w *textWriter) {
+	w.name("inputBotInlineResultGame")
+	w.field("id")
+	w.String(e.ID)
+	w.field("short_name")
+	w.String(e.ShortName)
+	w.field("send_message")
+	w.Object(e.SendMessage)
+}
+
+func (e TL_botInlineMessageMediaAuto) writeText(w *textWriter) {
+	w.name("botInlineMessageMediaAuto")
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&2 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_botInlineMessageText) writeText(w *textWriter) {
+	w.name("botInlineMessageText")
+	if e.Flags&1 != 0 {
+		w.field("no_webpage")
+		w.Bool(true)
+	}
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&2 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_botInlineMessageMediaGeo) writeText(w *textWriter) {
+	w.name("botInlineMessageMediaGeo")
+	w.field("geo")
+	w.Object(e.Geo)
+	if e.Flags&1 != 0 {
+		w.field("heading")
+		w.Int(e.Heading)
+	}
+	if e.Flags&2 != 0 {
+		w.field("period")
+		w.Int(e.Period)
+	}
+	if e.Flags&8 != 0 {
+		w.field("proximity_notification_radius")
+		w.Int(e.ProximityNotificationRadius)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_botInlineMessageMediaVenue) writeText(w *textWriter) {
+	w.name("botInlineMessageMediaVenue")
+	w.field("geo")
+	w.Object(e.Geo)
+	w.field("title")
+	w.String(e.Title)
+	w.field("address")
+	w.String(e.Address)
+	w.field("provider")
+	w.String(e.Provider)
+	w.field("venue_id")
+	w.String(e.VenueID)
+	w.field("venue_type")
+	w.String(e.VenueType)
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_botInlineMessageMediaContact) writeText(w *textWriter) {
+	w.name("botInlineMessageMediaContact")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+	w.field("vcard")
+	w.String(e.Vcard)
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+}
+
+func (e TL_botInlineResult) writeText(w *textWriter) {
+	w.name("botInlineResult")
+	w.field("id")
+	w.String(e.ID)
+	w.field("type")
+	w.String(e.Type)
+	if e.Flags&2 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&4 != 0 {
+		w.field("description")
+		w.String(e.Description)
+	}
+	if e.Flags&8 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+	if e.Flags&16 != 0 {
+		w.field("thumb")
+		w.Object(e.Thumb)
+	}
+	if e.Flags&32 != 0 {
+		w.field("content")
+		w.Object(e.Content)
+	}
+	w.field("send_message")
+	w.Object(e.SendMessage)
+}
+
+func (e TL_botInlineMediaResult) writeText(w *textWriter) {
+	w.name("botInlineMediaResult")
+	w.field("id")
+	w.String(e.ID)
+	w.field("type")
+	w.String(e.Type)
+	if e.Flags&1 != 0 {
+		w.field("photo")
+		w.Object(e.Photo)
+	}
+	if e.Flags&2 != 0 {
+		w.field("document")
+		w.Object(e.Document)
+	}
+	if e.Flags&4 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&8 != 0 {
+		w.field("description")
+		w.String(e.Description)
+	}
+	w.field("send_message")
+	w.Object(e.SendMessage)
+}
+
+func (e TL_messages_botResults) writeText(w *textWriter) {
+	w.name("messages.botResults")
+	if e.Flags&1 != 0 {
+		w.field("gallery")
+		w.Bool(true)
+	}
+	w.field("query_id")
+	w.Long(e.QueryID)
+	if e.Flags&2 != 0 {
+		w.field("next_offset")
+		w.String(e.NextOffset)
+	}
+	if e.Flags&4 != 0 {
+		w.field("switch_pm")
+		w.Object(e.SwitchPm)
+	}
+	w.field("results")
+	w.Vector(e.Results)
+	w.field("cache_time")
+	w.Int(e.CacheTime)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_exportedMessageLink) writeText(w *textWriter) {
+	w.name("exportedMessageLink")
+	w.field("link")
+	w.String(e.Link)
+	w.field("html")
+	w.String(e.Html)
+}
+
+func (e TL_messageFwdHeader) writeText(w *textWriter) {
+	w.name("messageFwdHeader")
+	if e.Flags&128 != 0 {
+		w.field("imported")
+		w.Bool(true)
+	}
+	if e.Flags&1 != 0 {
+		w.field("from_id")
+		w.Object(e.FromID)
+	}
+	if e.Flags&32 != 0 {
+		w.field("from_name")
+		w.String(e.FromName)
+	}
+	w.field("date")
+	w.Int(e.Date)
+	if e.Flags&4 != 0 {
+		w.field("channel_post")
+		w.Int(e.ChannelPost)
+	}
+	if e.Flags&8 != 0 {
+		w.field("post_author")
+		w.String(e.PostAuthor)
+	}
+	if e.Flags&16 != 0 {
+		w.field("saved_from_peer")
+		w.Object(e.SavedFromPeer)
+	}
+	if e.Flags&16 != 0 {
+		w.field("saved_from_msg_id")
+		w.Int(e.SavedFromMsgID)
+	}
+	if e.Flags&64 != 0 {
+		w.field("psa_type")
+		w.String(e.PsaType)
+	}
+}
+
+func (e TL_auth_codeTypeSms) writeText(w *textWriter) {
+	w.name("auth.codeTypeSms")
+}
+
+func (e TL_auth_codeTypeCall) writeText(w *textWriter) {
+	w.name("auth.codeTypeCall")
+}
+
+func (e TL_auth_codeTypeFlashCall) writeText(w *textWriter) {
+	w.name("auth.codeTypeFlashCall")
+}
+
+func (e TL_auth_sentCodeTypeApp) writeText(w *textWriter) {
+	w.name("auth.sentCodeTypeApp")
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_auth_sentCodeTypeSms) writeText(w *textWriter) {
+	w.name("auth.sentCodeTypeSms")
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_auth_sentCodeTypeCall) writeText(w *textWriter) {
+	w.name("auth.sentCodeTypeCall")
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_auth_sentCodeTypeFlashCall) writeText(w *textWriter) {
+	w.name("auth.sentCodeTypeFlashCall")
+	w.field("pattern")
+	w.String(e.Pattern)
+}
+
+func (e TL_messages_botCallbackAnswer) writeText(w *textWriter) {
+	w.name("messages.botCallbackAnswer")
+	if e.Flags&2 != 0 {
+		w.field("alert")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("has_url")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("native_ui")
+		w.Bool(true)
+	}
+	if e.Flags&1 != 0 {
+		w.field("message")
+		w.String(e.Message)
+	}
+	if e.Flags&4 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+	w.field("cache_time")
+	w.Int(e.CacheTime)
+}
+
+func (e TL_messages_messageEditData) writeText(w *textWriter) {
+	w.name("messages.messageEditData")
+	if e.Flags&1 != 0 {
+		w.field("caption")
+		w.Bool(true)
+	}
+}
+
+func (e TL_inputBotInlineMessageID) writeText(w *textWriter) {
+	w.name("inputBotInlineMessageID")
+	w.field("dc_id")
+	w.Int(e.DcID)
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inlineBotSwitchPM) writeText(w *textWriter) {
+	w.name("inlineBotSwitchPM")
+	w.field("text")
+	w.String(e.Text)
+	w.field("start_param")
+	w.String(e.StartParam)
+}
+
+func (e TL_messages_peerDialogs) writeText(w *textWriter) {
+	w.name("messages.peerDialogs")
+	w.field("dialogs")
+	w.Vector(e.Dialogs)
+	w.field("messages")
+	w.Vector(e.Messages)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+	w.field("state")
+	w.Object(e.State)
+}
+
+func (e TL_topPeer) writeText(w *textWriter) {
+	w.name("topPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("rating")
+	w.Double(e.Rating)
+}
+
+func (e TL_topPeerCategoryBotsPM) writeText(w *textWriter) {
+	w.name("topPeerCategoryBotsPM")
+}
+
+func (e TL_topPeerCategoryBotsInline) writeText(w *textWriter) {
+	w.name("topPeerCategoryBotsInline")
+}
+
+func (e TL_topPeerCategoryCorrespondents) writeText(w *textWriter) {
+	w.name("topPeerCategoryCorrespondents")
+}
+
+func (e TL_topPeerCategoryGroups) writeText(w *textWriter) {
+	w.name("topPeerCategoryGroups")
+}
+
+func (e TL_topPeerCategoryChannels) writeText(w *textWriter) {
+	w.name("topPeerCategoryChannels")
+}
+
+func (e TL_topPeerCategoryPhoneCalls) writeText(w *textWriter) {
+	w.name("topPeerCategoryPhoneCalls")
+}
+
+func (e TL_topPeerCategoryForwardUsers) writeText(w *textWriter) {
+	w.name("topPeerCategoryForwardUsers")
+}
+
+func (e TL_topPeerCategoryForwardChats) writeText(w *textWriter) {
+	w.name("topPeerCategoryForwardChats")
+}
+
+func (e TL_topPeerCategoryPeers) writeText(w *textWriter) {
+	w.name("topPeerCategoryPeers")
+	w.field("category")
+	w.Object(e.Category)
+	w.field("count")
+	w.Int(e.Count)
+	w.field("peers")
+	w.Vector(e.Peers)
+}
+
+func (e TL_contacts_topPeersNotModified) writeText(w *textWriter) {
+	w.name("contacts.topPeersNotModified")
+}
+
+func (e TL_contacts_topPeers) writeText(w *textWriter) {
+	w.name("contacts.topPeers")
+	w.field("categories")
+	w.Vector(e.Categories)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_contacts_topPeersDisabled) writeText(w *textWriter) {
+	w.name("contacts.topPeersDisabled")
+}
+
+func (e TL_draftMessageEmpty) writeText(w *textWriter) {
+	w.name("draftMessageEmpty")
+	if e.Flags&1 != 0 {
+		w.field("date")
+		w.Int(e.Date)
+	}
+}
+
+func (e TL_draftMessage) writeText(w *textWriter) {
+	w.name("draftMessage")
+	if e.Flags&2 != 0 {
+		w.field("no_webpage")
+		w.Bool(true)
+	}
+	if e.Flags&1 != 0 {
+		w.field("reply_to_msg_id")
+		w.Int(e.ReplyToMsgID)
+	}
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&8 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_messages_featuredStickersNotModified) writeText(w *textWriter) {
+	w.name("messages.featuredStickersNotModified")
+	w.field("count")
+	w.Int(e.Count)
+}
+
+func (e TL_messages_featuredStickers) writeText(w *textWriter) {
+	w.name("messages.featuredStickers")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("count")
+	w.Int(e.Count)
+	w.field("sets")
+	w.Vector(e.Sets)
+	w.field("unread")
+	w.VectorLong(e.Unread)
+}
+
+func (e TL_messages_recentStickersNotModified) writeText(w *textWriter) {
+	w.name("messages.recentStickersNotModified")
+}
+
+func (e TL_messages_recentStickers) writeText(w *textWriter) {
+	w.name("messages.recentStickers")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("packs")
+	w.Vector(e.Packs)
+	w.field("stickers")
+	w.Vector(e.Stickers)
+	w.field("dates")
+	w.VectorInt(e.Dates)
+}
+
+func (e TL_messages_archivedStickers) writeText(w *textWriter) {
+	w.name("messages.archivedStickers")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("sets")
+	w.Vector(e.Sets)
+}
+
+func (e TL_messages_stickerSetInstallResultSuccess) writeText(w *textWriter) {
+	w.name("messages.stickerSetInstallResultSuccess")
+}
+
+func (e TL_messages_stickerSetInstallResultArchive) writeText(w *textWriter) {
+	w.name("messages.stickerSetInstallResultArchive")
+	w.field("sets")
+	w.Vector(e.Sets)
+}
+
+func (e TL_stickerSetCovered) writeText(w *textWriter) {
+	w.name("stickerSetCovered")
+	w.field("set")
+	w.Object(e.Set)
+	w.field("cover")
+	w.Object(e.Cover)
+}
+
+func (e TL_stickerSetMultiCovered) writeText(w *textWriter) {
+	w.name("stickerSetMultiCovered")
+	w.field("set")
+	w.Object(e.Set)
+	w.field("covers")
+	w.Vector(e.Covers)
+}
+
+func (e TL_maskCoords) writeText(w *textWriter) {
+	w.name("maskCoords")
+	w.field("n")
+	w.Int(e.N)
+	w.field("x")
+	w.Double(e.X)
+	w.field("y")
+	w.Double(e.Y)
+	w.field("zoom")
+	w.Double(e.Zoom)
+}
+
+func (e TL_inputStickeredMediaPhoto) writeText(w *textWriter) {
+	w.name("inputStickeredMediaPhoto")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_inputStickeredMediaDocument) writeText(w *textWriter) {
+	w.name("inputStickeredMediaDocument")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_game) writeText(w *textWriter) {
+	w.name("game")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("short_name")
+	w.String(e.ShortName)
+	w.field("title")
+	w.String(e.Title)
+	w.field("description")
+	w.String(e.Description)
+	w.field("photo")
+	w.Object(e.Photo)
+	if e.Flags&1 != 0 {
+		w.field("document")
+		w.Object(e.Document)
+	}
+}
+
+func (e TL_inputGameID) writeText(w *textWriter) {
+	w.name("inputGameID")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputGameShortName) writeText(w *textWriter) {
+	w.name("inputGameShortName")
+	w.field("bot_id")
+	w.Object(e.BotID)
+	w.field("short_name")
+	w.String(e.ShortName)
+}
+
+func (e TL_highScore) writeText(w *textWriter) {
+	w.name("highScore")
+	w.field("pos")
+	w.Int(e.Pos)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("score")
+	w.Int(e.Score)
+}
+
+func (e TL_messages_highScores) writeText(w *textWriter) {
+	w.name("messages.highScores")
+	w.field("scores")
+	w.Vector(e.Scores)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_textEmpty) writeText(w *textWriter) {
+	w.name("textEmpty")
+}
+
+func (e TL_textPlain) writeText(w *textWriter) {
+	w.name("textPlain")
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_textBold) writeText(w *textWriter) {
+	w.name("textBold")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_textItalic) writeText(w *textWriter) {
+	w.name("textItalic")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_textUnderline) writeText(w *textWriter) {
+	w.name("textUnderline")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_textStrike) writeText(w *textWriter) {
+	w.name("textStrike")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_textFixed) writeText(w *textWriter) {
+	w.name("textFixed")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_textUrl) writeText(w *textWriter) {
+	w.name("textUrl")
+	w.field("text")
+	w.Object(e.Text)
+	w.field("url")
+	w.String(e.Url)
+	w.field("webpage_id")
+	w.Long(e.WebpageID)
+}
+
+func (e TL_textEmail) writeText(w *textWriter) {
+	w.name("textEmail")
+	w.field("text")
+	w.Object(e.Text)
+	w.field("email")
+	w.String(e.Email)
+}
+
+func (e TL_textConcat) writeText(w *textWriter) {
+	w.name("textConcat")
+	w.field("texts")
+	w.Vector(e.Texts)
+}
+
+func (e TL_textSubscript) writeText(w *textWriter) {
+	w.name("textSubscript")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_textSuperscript) writeText(w *textWriter) {
+	w.name("textSuperscript")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_textMarked) writeText(w *textWriter) {
+	w.name("textMarked")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_textPhone) writeText(w *textWriter) {
+	w.name("textPhone")
+	w.field("text")
+	w.Object(e.Text)
+	w.field("phone")
+	w.String(e.Phone)
+}
+
+func (e TL_textImage) writeText(w *textWriter) {
+	w.name("textImage")
+	w.field("document_id")
+	w.Long(e.DocumentID)
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+}
+
+func (e TL_textAnchor) writeText(w *textWriter) {
+	w.name("textAnchor")
+	w.field("text")
+	w.Object(e.Text)
+	w.field("name")
+	w.String(e.Name)
+}
+
+func (e TL_pageBlockUnsupported) writeText(w *textWriter) {
+	w.name("pageBlockUnsupported")
+}
+
+func (e TL_pageBlockTitle) writeText(w *textWriter) {
+	w.name("pageBlockTitle")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageBlockSubtitle) writeText(w *textWriter) {
+	w.name("pageBlockSubtitle")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageBlockAuthorDate) writeText(w *textWriter) {
+	w.name("pageBlockAuthorDate")
+	w.field("author")
+	w.Object(e.Author)
+	w.field("published_date")
+	w.Int(e.PublishedDate)
+}
+
+func (e TL_pageBlockHeader) writeText(w *textWriter) {
+	w.name("pageBlockHeader")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageBlockSubheader) writeText(w *textWriter) {
+	w.name("pageBlockSubheader")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageBlockParagraph) writeText(w *textWriter) {
+	w.name("pageBlockParagraph")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageBlockPreformatted) writeText(w *textWriter) {
+	w.name("pageBlockPreformatted")
+	w.field("text")
+	w.Object(e.Text)
+	w.field("language")
+	w.String(e.Language)
+}
+
+func (e TL_pageBlockFooter) writeText(w *textWriter) {
+	w.name("pageBlockFooter")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageBlockDivider) writeText(w *textWriter) {
+	w.name("pageBlockDivider")
+}
+
+func (e TL_pageBlockAnchor) writeText(w *textWriter) {
+	w.name("pageBlockAnchor")
+	w.field("name")
+	w.String(e.Name)
+}
+
+func (e TL_pageBlockList) writeText(w *textWriter) {
+	w.name("pageBlockList")
+	w.field("items")
+	w.Vector(e.Items)
+}
+
+func (e TL_pageBlockBlockquote) writeText(w *textWriter) {
+	w.name("pageBlockBlockquote")
+	w.field("text")
+	w.Object(e.Text)
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_pageBlockPullquote) writeText(w *textWriter) {
+	w.name("pageBlockPullquote")
+	w.field("text")
+	w.Object(e.Text)
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_pageBlockPhoto) writeText(w *textWriter) {
+	w.name("pageBlockPhoto")
+	w.field("photo_id")
+	w.Long(e.PhotoID)
+	w.field("caption")
+	w.Object(e.Caption)
+	if e.Flags&1 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+	if e.Flags&1 != 0 {
+		w.field("webpage_id")
+		w.Long(e.WebpageID)
+	}
+}
+
+func (e TL_pageBlockVideo) writeText(w *textWriter) {
+	w.name("pageBlockVideo")
+	if e.Flags&1 != 0 {
+		w.field("autoplay")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("loop")
+		w.Bool(true)
+	}
+	w.field("video_id")
+	w.Long(e.VideoID)
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_pageBlockCover) writeText(w *textWriter) {
+	w.name("pageBlockCover")
+	w.field("cover")
+	w.Object(e.Cover)
+}
+
+func (e TL_pageBlockEmbed) writeText(w *textWriter) {
+	w.name("pageBlockEmbed")
+	if e.Flags&1 != 0 {
+		w.field("full_width")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("allow_scrolling")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+	if e.Flags&4 != 0 {
+		w.field("html")
+		w.String(e.Html)
+	}
+	if e.Flags&16 != 0 {
+		w.field("poster_photo_id")
+		w.Long(e.PosterPhotoID)
+	}
+	if e.Flags&32 != 0 {
+		w.field("w")
+		w.Int(e.W)
+	}
+	if e.Flags&32 != 0 {
+		w.field("h")
+		w.Int(e.H)
+	}
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_pageBlockEmbedPost) writeText(w *textWriter) {
+	w.name("pageBlockEmbedPost")
+	w.field("url")
+	w.String(e.Url)
+	w.field("webpage_id")
+	w.Long(e.WebpageID)
+	w.field("author_photo_id")
+	w.Long(e.AuthorPhotoID)
+	w.field("author")
+	w.String(e.Author)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("blocks")
+	w.Vector(e.Blocks)
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_pageBlockCollage) writeText(w *textWriter) {
+	w.name("pageBlockCollage")
+	w.field("items")
+	w.Vector(e.Items)
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_pageBlockSlideshow) writeText(w *textWriter) {
+	w.name("pageBlockSlideshow")
+	w.field("items")
+	w.Vector(e.Items)
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_pageBlockChannel) writeText(w *textWriter) {
+	w.name("pageBlockChannel")
+	w.field("channel")
+	w.Object(e.Channel)
+}
+
+func (e TL_pageBlockAudio) writeText(w *textWriter) {
+	w.name("pageBlockAudio")
+	w.field("audio_id")
+	w.Long(e.AudioID)
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_pageBlockKicker) writeText(w *textWriter) {
+	w.name("pageBlockKicker")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageBlockTable) writeText(w *textWriter) {
+	w.name("pageBlockTable")
+	if e.Flags&1 != 0 {
+		w.field("bordered")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("striped")
+		w.Bool(true)
+	}
+	w.field("title")
+	w.Object(e.Title)
+	w.field("rows")
+	w.Vector(e.Rows)
+}
+
+func (e TL_pageBlockOrderedList) writeText(w *textWriter) {
+	w.name("pageBlockOrderedList")
+	w.field("items")
+	w.Vector(e.Items)
+}
+
+func (e TL_pageBlockDetails) writeText(w *textWriter) {
+	w.name("pageBlockDetails")
+	if e.Flags&1 != 0 {
+		w.field("open")
+		w.Bool(true)
+	}
+	w.field("blocks")
+	w.Vector(e.Blocks)
+	w.field("title")
+	w.Object(e.Title)
+}
+
+func (e TL_pageBlockRelatedArticles) writeText(w *textWriter) {
+	w.name("pageBlockRelatedArticles")
+	w.field("title")
+	w.Object(e.Title)
+	w.field("articles")
+	w.Vector(e.Articles)
+}
+
+func (e TL_pageBlockMap) writeText(w *textWriter) {
+	w.name("pageBlockMap")
+	w.field("geo")
+	w.Object(e.Geo)
+	w.field("zoom")
+	w.Int(e.Zoom)
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+	w.field("caption")
+	w.Object(e.Caption)
+}
+
+func (e TL_phoneCallDiscardReasonMissed) writeText(w *textWriter) {
+	w.name("phoneCallDiscardReasonMissed")
+}
+
+func (e TL_phoneCallDiscardReasonDisconnect) writeText(w *textWriter) {
+	w.name("phoneCallDiscardReasonDisconnect")
+}
+
+func (e TL_phoneCallDiscardReasonHangup) writeText(w *textWriter) {
+	w.name("phoneCallDiscardReasonHangup")
+}
+
+func (e TL_phoneCallDiscardReasonBusy) writeText(w *textWriter) {
+	w.name("phoneCallDiscardReasonBusy")
+}
+
+func (e TL_dataJSON) writeText(w *textWriter) {
+	w.name("dataJSON")
+	w.field("data")
+	w.String(e.Data)
+}
+
+func (e TL_labeledPrice) writeText(w *textWriter) {
+	w.name("labeledPrice")
+	w.field("label")
+	w.String(e.Label)
+	w.field("amount")
+	w.Long(e.Amount)
+}
+
+func (e TL_invoice) writeText(w *textWriter) {
+	w.name("invoice")
+	if e.Flags&1 != 0 {
+		w.field("test")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("name_requested")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("phone_requested")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("email_requested")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("shipping_address_requested")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("flexible")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("phone_to_provider")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("email_to_provider")
+		w.Bool(true)
+	}
+	w.field("currency")
+	w.String(e.Currency)
+	w.field("prices")
+	w.Vector(e.Prices)
+}
+
+func (e TL_paymentCharge) writeText(w *textWriter) {
+	w.name("paymentCharge")
+	w.field("id")
+	w.String(e.ID)
+	w.field("provider_charge_id")
+	w.String(e.ProviderChargeID)
+}
+
+func (e TL_postAddress) writeText(w *textWriter) {
+	w.name("postAddress")
+	w.field("street_line1")
+	w.String(e.StreetLine1)
+	w.field("street_line2")
+	w.String(e.StreetLine2)
+	w.field("city")
+	w.String(e.City)
+	w.field("state")
+	w.String(e.State)
+	w.field("country_iso2")
+	w.String(e.CountryIso2)
+	w.field("post_code")
+	w.String(e.PostCode)
+}
+
+func (e TL_paymentRequestedInfo) writeText(w *textWriter) {
+	w.name("paymentRequestedInfo")
+	if e.Flags&1 != 0 {
+		w.field("name")
+		w.String(e.Name)
+	}
+	if e.Flags&2 != 0 {
+		w.field("phone")
+		w.String(e.Phone)
+	}
+	if e.Flags&4 != 0 {
+		w.field("email")
+		w.String(e.Email)
+	}
+	if e.Flags&8 != 0 {
+		w.field("shipping_address")
+		w.Object(e.ShippingAddress)
+	}
+}
+
+func (e TL_paymentSavedCredentialsCard) writeText(w *textWriter) {
+	w.name("paymentSavedCredentialsCard")
+	w.field("id")
+	w.String(e.ID)
+	w.field("title")
+	w.String(e.Title)
+}
+
+func (e TL_webDocument) writeText(w *textWriter) {
+	w.name("webDocument")
+	w.field("url")
+	w.String(e.Url)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("size")
+	w.Int(e.Size)
+	w.field("mime_type")
+	w.String(e.MimeType)
+	w.field("attributes")
+	w.Vector(e.Attributes)
+}
+
+func (e TL_webDocumentNoProxy) writeText(w *textWriter) {
+	w.name("webDocumentNoProxy")
+	w.field("url")
+	w.String(e.Url)
+	w.field("size")
+	w.Int(e.Size)
+	w.field("mime_type")
+	w.String(e.MimeType)
+	w.field("attributes")
+	w.Vector(e.Attributes)
+}
+
+func (e TL_inputWebDocument) writeText(w *textWriter) {
+	w.name("inputWebDocument")
+	w.field("url")
+	w.String(e.Url)
+	w.field("size")
+	w.Int(e.Size)
+	w.field("mime_type")
+	w.String(e.MimeType)
+	w.field("attributes")
+	w.Vector(e.Attributes)
+}
+
+func (e TL_inputWebFileLocation) writeText(w *textWriter) {
+	w.name("inputWebFileLocation")
+	w.field("url")
+	w.String(e.Url)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputWebFileGeoPointLocation) writeText(w *textWriter) {
+	w.name("inputWebFileGeoPointLocation")
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+	w.field("zoom")
+	w.Int(e.Zoom)
+	w.field("scale")
+	w.Int(e.Scale)
+}
+
+func (e TL_upload_webFile) writeText(w *textWriter) {
+	w.name("upload.webFile")
+	w.field("size")
+	w.Int(e.Size)
+	w.field("mime_type")
+	w.String(e.MimeType)
+	w.field("file_type")
+	w.Object(e.FileType)
+	w.field("mtime")
+	w.Int(e.Mtime)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_payments_paymentForm) writeText(w *textWriter) {
+	w.name("payments.paymentForm")
+	if e.Flags&4 != 0 {
+		w.field("can_save_credentials")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("password_missing")
+		w.Bool(true)
+	}
+	w.field("bot_id")
+	w.Int(e.BotID)
+	w.field("invoice")
+	w.Object(e.Invoice)
+	w.field("provider_id")
+	w.Int(e.ProviderID)
+	w.field("url")
+	w.String(e.Url)
+	if e.Flags&16 != 0 {
+		w.field("native_provider")
+		w.String(e.NativeProvider)
+	}
+	if e.Flags&16 != 0 {
+		w.field("native_params")
+		w.Object(e.NativeParams)
+	}
+	if e.Flags&1 != 0 {
+		w.field("saved_info")
+		w.Object(e.SavedInfo)
+	}
+	if e.Flags&2 != 0 {
+		w.field("saved_credentials")
+		w.Object(e.SavedCredentials)
+	}
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_payments_validatedRequestedInfo) writeText(w *textWriter) {
+	w.name("payments.validatedRequestedInfo")
+	if e.Flags&1 != 0 {
+		w.field("id")
+		w.String(e.ID)
+	}
+	if e.Flags&2 != 0 {
+		w.field("shipping_options")
+		w.Vector(e.ShippingOptions)
+	}
+}
+
+func (e TL_payments_paymentResult) writeText(w *textWriter) {
+	w.name("payments.paymentResult")
+	w.field("updates")
+	w.Object(e.Updates)
+}
+
+func (e TL_payments_paymentVerificationNeeded) writeText(w *textWriter) {
+	w.name("payments.paymentVerificationNeeded")
+	w.field("url")
+	w.String(e.Url)
+}
+
+func (e TL_payments_paymentReceipt) writeText(w *textWriter) {
+	w.name("payments.paymentReceipt")
+	w.field("date")
+	w.Int(e.Date)
+	w.field("bot_id")
+	w.Int(e.BotID)
+	w.field("invoice")
+	w.Object(e.Invoice)
+	w.field("provider_id")
+	w.Int(e.ProviderID)
+	if e.Flags&1 != 0 {
+		w.field("info")
+		w.Object(e.Info)
+	}
+	if e.Flags&2 != 0 {
+		w.field("shipping")
+		w.Object(e.Shipping)
+	}
+	w.field("currency")
+	w.String(e.Currency)
+	w.field("total_amount")
+	w.Long(e.TotalAmount)
+	w.field("credentials_title")
+	w.String(e.CredentialsTitle)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_payments_savedInfo) writeText(w *textWriter) {
+	w.name("payments.savedInfo")
+	if e.Flags&2 != 0 {
+		w.field("has_saved_credentials")
+		w.Bool(true)
+	}
+	if e.Flags&1 != 0 {
+		w.field("saved_info")
+		w.Object(e.SavedInfo)
+	}
+}
+
+func (e TL_inputPaymentCredentialsSaved) writeText(w *textWriter) {
+	w.name("inputPaymentCredentialsSaved")
+	w.field("id")
+	w.String(e.ID)
+	w.field("tmp_password")
+	w.Bytes(e.TmpPassword)
+}
+
+func (e TL_inputPaymentCredentials) writeText(w *textWriter) {
+	w.name("inputPaymentCredentials")
+	if e.Flags&1 != 0 {
+		w.field("save")
+		w.Bool(true)
+	}
+	w.field("data")
+	w.Object(e.Data)
+}
+
+func (e TL_inputPaymentCredentialsApplePay) writeText(w *textWriter) {
+	w.name("inputPaymentCredentialsApplePay")
+	w.field("payment_data")
+	w.Object(e.PaymentData)
+}
+
+func (e TL_inputPaymentCredentialsGooglePay) writeText(w *textWriter) {
+	w.name("inputPaymentCredentialsGooglePay")
+	w.field("payment_token")
+	w.Object(e.PaymentToken)
+}
+
+func (e TL_account_tmpPassword) writeText(w *textWriter) {
+	w.name("account.tmpPassword")
+	w.field("tmp_password")
+	w.Bytes(e.TmpPassword)
+	w.field("valid_until")
+	w.Int(e.ValidUntil)
+}
+
+func (e TL_shippingOption) writeText(w *textWriter) {
+	w.name("shippingOption")
+	w.field("id")
+	w.String(e.ID)
+	w.field("title")
+	w.String(e.Title)
+	w.field("prices")
+	w.Vector(e.Prices)
+}
+
+func (e TL_inputStickerSetItem) writeText(w *textWriter) {
+	w.name("inputStickerSetItem")
+	w.field("document")
+	w.Object(e.Document)
+	w.field("emoji")
+	w.String(e.Emoji)
+	if e.Flags&1 != 0 {
+		w.field("mask_coords")
+		w.Object(e.MaskCoords)
+	}
+}
+
+func (e TL_inputPhoneCall) writeText(w *textWriter) {
+	w.name("inputPhoneCall")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_phoneCallEmpty) writeText(w *textWriter) {
+	w.name("phoneCallEmpty")
+	w.field("id")
+	w.Long(e.ID)
+}
+
+func (e TL_phoneCallWaiting) writeText(w *textWriter) {
+	w.name("phoneCallWaiting")
+	if e.Flags&64 != 0 {
+		w.field("video")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("participant_id")
+	w.Int(e.ParticipantID)
+	w.field("protocol")
+	w.Object(e.Protocol)
+	if e.Flags&1 != 0 {
+		w.field("receive_date")
+		w.Int(e.ReceiveDate)
+	}
+}
+
+func (e TL_phoneCallRequested) writeText(w *textWriter) {
+	w.name("phoneCallRequested")
+	if e.Flags&64 != 0 {
+		w.field("video")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("participant_id")
+	w.Int(e.ParticipantID)
+	w.field("g_a_hash")
+	w.Bytes(e.GAHash)
+	w.field("protocol")
+	w.Object(e.Protocol)
+}
+
+func (e TL_phoneCallAccepted) writeText(w *textWriter) {
+	w.name("phoneCallAccepted")
+	if e.Flags&64 != 0 {
+		w.field("video")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("participant_id")
+	w.Int(e.ParticipantID)
+	w.field("g_b")
+	w.Bytes(e.GB)
+	w.field("protocol")
+	w.Object(e.Protocol)
+}
+
+func (e TL_phoneCall) writeText(w *textWriter) {
+	w.name("phoneCall")
+	if e.Flags&32 != 0 {
+		w.field("p2p_allowed")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("video")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("participant_id")
+	w.Int(e.ParticipantID)
+	w.field("g_a_or_b")
+	w.Bytes(e.GAOrB)
+	w.field("key_fingerprint")
+	w.Long(e.KeyFingerprint)
+	w.field("protocol")
+	w.Object(e.Protocol)
+	w.field("connections")
+	w.Vector(e.Connections)
+	w.field("start_date")
+	w.Int(e.StartDate)
+}
+
+func (e TL_phoneCallDiscarded) writeText(w *textWriter) {
+	w.name("phoneCallDiscarded")
+	if e.Flags&4 != 0 {
+		w.field("need_rating")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("need_debug")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("video")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("reason")
+		w.Object(e.Reason)
+	}
+	if e.Flags&2 != 0 {
+		w.field("duration")
+		w.Int(e.Duration)
+	}
+}
+
+func (e TL_phoneConnection) writeText(w *textWriter) {
+	w.name("phoneConnection")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("ip")
+	w.String(e.Ip)
+	w.field("ipv6")
+	w.String(e.Ipv6)
+	w.field("port")
+	w.Int(e.Port)
+	w.field("peer_tag")
+	w.Bytes(e.PeerTag)
+}
+
+func (e TL_phoneConnectionWebrtc) writeText(w *textWriter) {
+	w.name("phoneConnectionWebrtc")
+	if e.Flags&1 != 0 {
+		w.field("turn")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("stun")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("ip")
+	w.String(e.Ip)
+	w.field("ipv6")
+	w.String(e.Ipv6)
+	w.field("port")
+	w.Int(e.Port)
+	w.field("username")
+	w.String(e.Username)
+	w.field("password")
+	w.String(e.Password)
+}
+
+func (e TL_phoneCallProtocol) writeText(w *textWriter) {
+	w.name("phoneCallProtocol")
+	if e.Flags&1 != 0 {
+		w.field("udp_p2p")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("udp_reflector")
+		w.Bool(true)
+	}
+	w.field("min_layer")
+	w.Int(e.MinLayer)
+	w.field("max_layer")
+	w.Int(e.MaxLayer)
+	w.field("library_versions")
+	w.VectorString(e.LibraryVersions)
+}
+
+func (e TL_phone_phoneCall) writeText(w *textWriter) {
+	w.name("phone.phoneCall")
+	w.field("phone_call")
+	w.Object(e.PhoneCall)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_upload_cdnFileReuploadNeeded) writeText(w *textWriter) {
+	w.name("upload.cdnFileReuploadNeeded")
+	w.field("request_token")
+	w.Bytes(e.RequestToken)
+}
+
+func (e TL_upload_cdnFile) writeText(w *textWriter) {
+	w.name("upload.cdnFile")
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_cdnPublicKey) writeText(w *textWriter) {
+	w.name("cdnPublicKey")
+	w.field("dc_id")
+	w.Int(e.DcID)
+	w.field("public_key")
+	w.String(e.PublicKey)
+}
+
+func (e TL_cdnConfig) writeText(w *textWriter) {
+	w.name("cdnConfig")
+	w.field("public_keys")
+	w.Vector(e.PublicKeys)
+}
+
+func (e TL_langPackString) writeText(w *textWriter) {
+	w.name("langPackString")
+	w.field("key")
+	w.String(e.Key)
+	w.field("value")
+	w.String(e.Value)
+}
+
+func (e TL_langPackStringPluralized) writeText(w *textWriter) {
+	w.name("langPackStringPluralized")
+	w.field("key")
+	w.String(e.Key)
+	if e.Flags&1 != 0 {
+		w.field("zero_value")
+		w.String(e.ZeroValue)
+	}
+	if e.Flags&2 != 0 {
+		w.field("one_value")
+		w.String(e.OneValue)
+	}
+	if e.Flags&4 != 0 {
+		w.field("two_value")
+		w.String(e.TwoValue)
+	}
+	if e.Flags&8 != 0 {
+		w.field("few_value")
+		w.String(e.FewValue)
+	}
+	if e.Flags&16 != 0 {
+		w.field("many_value")
+		w.String(e.ManyValue)
+	}
+	w.field("other_value")
+	w.String(e.OtherValue)
+}
+
+func (e TL_langPackStringDeleted) writeText(w *textWriter) {
+	w.name("langPackStringDeleted")
+	w.field("key")
+	w.String(e.Key)
+}
+
+func (e TL_langPackDifference) writeText(w *textWriter) {
+	w.name("langPackDifference")
+	w.field("lang_code")
+	w.String(e.LangCode)
+	w.field("from_version")
+	w.Int(e.FromVersion)
+	w.field("version")
+	w.Int(e.Version)
+	w.field("strings")
+	w.Vector(e.Strings)
+}
+
+func (e TL_langPackLanguage) writeText(w *textWriter) {
+	w.name("langPackLanguage")
+	if e.Flags&1 != 0 {
+		w.field("official")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("rtl")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("beta")
+		w.Bool(true)
+	}
+	w.field("name")
+	w.String(e.Name)
+	w.field("native_name")
+	w.String(e.NativeName)
+	w.field("lang_code")
+	w.String(e.LangCode)
+	if e.Flags&2 != 0 {
+		w.field("base_lang_code")
+		w.String(e.BaseLangCode)
+	}
+	w.field("plural_code")
+	w.String(e.PluralCode)
+	w.field("strings_count")
+	w.Int(e.StringsCount)
+	w.field("translated_count")
+	w.Int(e.TranslatedCount)
+	w.field("translations_url")
+	w.String(e.TranslationsUrl)
+}
+
+func (e TL_channelAdminLogEventActionChangeTitle) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionChangeTitle")
+	w.field("prev_value")
+	w.String(e.PrevValue)
+	w.field("new_value")
+	w.String(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionChangeAbout) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionChangeAbout")
+	w.field("prev_value")
+	w.String(e.PrevValue)
+	w.field("new_value")
+	w.String(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionChangeUsername) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionChangeUsername")
+	w.field("prev_value")
+	w.String(e.PrevValue)
+	w.field("new_value")
+	w.String(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionChangePhoto) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionChangePhoto")
+	w.field("prev_photo")
+	w.Object(e.PrevPhoto)
+	w.field("new_photo")
+	w.Object(e.NewPhoto)
+}
+
+func (e TL_channelAdminLogEventActionToggleInvites) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionToggleInvites")
+	w.field("new_value")
+	w.Object(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionToggleSignatures) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionToggleSignatures")
+	w.field("new_value")
+	w.Object(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionUpdatePinned) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionUpdatePinned")
+	w.field("message")
+	w.Object(e.Message)
+}
+
+func (e TL_channelAdminLogEventActionEditMessage) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionEditMessage")
+	w.field("prev_message")
+	w.Object(e.PrevMessage)
+	w.field("new_message")
+	w.Object(e.NewMessage)
+}
+
+func (e TL_channelAdminLogEventActionDeleteMessage) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionDeleteMessage")
+	w.field("message")
+	w.Object(e.Message)
+}
+
+func (e TL_channelAdminLogEventActionParticipantJoin) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantJoin")
+}
+
+func (e TL_channelAdminLogEventActionParticipantLeave) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantLeave")
+}
+
+func (e TL_channelAdminLogEventActionParticipantInvite) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantInvite")
+	w.field("participant")
+	w.Object(e.Participant)
+}
+
+func (e TL_channelAdminLogEventActionParticipantToggleBan) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantToggleBan")
+	w.field("prev_participant")
+	w.Object(e.PrevParticipant)
+	w.field("new_participant")
+	w.Object(e.NewParticipant)
+}
+
+func (e TL_channelAdminLogEventActionParticipantToggleAdmin) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantToggleAdmin")
+	w.field("prev_participant")
+	w.Object(e.PrevParticipant)
+	w.field("new_participant")
+	w.Object(e.NewParticipant)
+}
+
+func (e TL_channelAdminLogEventActionChangeStickerSet) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionChangeStickerSet")
+	w.field("prev_stickerset")
+	w.Object(e.PrevStickerset)
+	w.field("new_stickerset")
+	w.Object(e.NewStickerset)
+}
+
+func (e TL_channelAdminLogEventActionTogglePreHistoryHidden) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionTogglePreHistoryHidden")
+	w.field("new_value")
+	w.Object(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionDefaultBannedRights) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionDefaultBannedRights")
+	w.field("prev_banned_rights")
+	w.Object(e.PrevBannedRights)
+	w.field("new_banned_rights")
+	w.Object(e.NewBannedRights)
+}
+
+func (e TL_channelAdminLogEventActionStopPoll) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionStopPoll")
+	w.field("message")
+	w.Object(e.Message)
+}
+
+func (e TL_channelAdminLogEventActionChangeLinkedChat) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionChangeLinkedChat")
+	w.field("prev_value")
+	w.Int(e.PrevValue)
+	w.field("new_value")
+	w.Int(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionChangeLocation) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionChangeLocation")
+	w.field("prev_value")
+	w.Object(e.PrevValue)
+	w.field("new_value")
+	w.Object(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionToggleSlowMode) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionToggleSlowMode")
+	w.field("prev_value")
+	w.Int(e.PrevValue)
+	w.field("new_value")
+	w.Int(e.NewValue)
+}
+
+func (e TL_channelAdminLogEventActionStartGroupCall) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionStartGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+}
+
+func (e TL_channelAdminLogEventActionDiscardGroupCall) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionDiscardGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+}
+
+func (e TL_channelAdminLogEventActionParticipantMute) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantMute")
+	w.field("participant")
+	w.Object(e.Participant)
+}
+
+func (e TL_channelAdminLogEventActionParticipantUnmute) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantUnmute")
+	w.field("participant")
+	w.Object(e.Participant)
+}
+
+func (e TL_channelAdminLogEventActionToggleGroupCallSetting) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionToggleGroupCallSetting")
+	w.field("join_muted")
+	w.Object(e.JoinMuted)
+}
+
+func (e TL_channelAdminLogEventActionParticipantJoinByInvite) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantJoinByInvite")
+	w.field("invite")
+	w.Object(e.Invite)
+}
+
+func (e TL_channelAdminLogEventActionExportedInviteDelete) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionExportedInviteDelete")
+	w.field("invite")
+	w.Object(e.Invite)
+}
+
+func (e TL_channelAdminLogEventActionExportedInviteRevoke) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionExportedInviteRevoke")
+	w.field("invite")
+	w.Object(e.Invite)
+}
+
+func (e TL_channelAdminLogEventActionExportedInviteEdit) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionExportedInviteEdit")
+	w.field("prev_invite")
+	w.Object(e.PrevInvite)
+	w.field("new_invite")
+	w.Object(e.NewInvite)
+}
+
+func (e TL_channelAdminLogEventActionParticipantVolume) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionParticipantVolume")
+	w.field("participant")
+	w.Object(e.Participant)
+}
+
+func (e TL_channelAdminLogEventActionChangeHistoryTTL) writeText(w *textWriter) {
+	w.name("channelAdminLogEventActionChangeHistoryTTL")
+	w.field("prev_value")
+	w.Int(e.PrevValue)
+	w.field("new_value")
+	w.Int(e.NewValue)
+}
+
+func (e TL_channelAdminLogEvent) writeText(w *textWriter) {
+	w.name("channelAdminLogEvent")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("action")
+	w.Object(e.Action)
+}
+
+func (e TL_channels_adminLogResults) writeText(w *textWriter) {
+	w.name("channels.adminLogResults")
+	w.field("events")
+	w.Vector(e.Events)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_channelAdminLogEventsFilter) writeText(w *textWriter) {
+	w.name("channelAdminLogEventsFilter")
+	if e.Flags&1 != 0 {
+		w.field("join")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("leave")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("invite")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("ban")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("unban")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("kick")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("unkick")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("promote")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("demote")
+		w.Bool(true)
+	}
+	if e.Flags&512 != 0 {
+		w.field("info")
+		w.Bool(true)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("settings")
+		w.Bool(true)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("edit")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("delete")
+		w.Bool(true)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("group_call")
+		w.Bool(true)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("invites")
+		w.Bool(true)
+	}
+}
+
+func (e TL_popularContact) writeText(w *textWriter) {
+	w.name("popularContact")
+	w.field("client_id")
+	w.Long(e.ClientID)
+	w.field("importers")
+	w.Int(e.Importers)
+}
+
+func (e TL_messages_favedStickersNotModified) writeText(w *textWriter) {
+	w.name("messages.favedStickersNotModified")
+}
+
+func (e TL_messages_favedStickers) writeText(w *textWriter) {
+	w.name("messages.favedStickers")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("packs")
+	w.Vector(e.Packs)
+	w.field("stickers")
+	w.Vector(e.Stickers)
+}
+
+func (e TL_recentMeUrlUnknown) writeText(w *textWriter) {
+	w.name("recentMeUrlUnknown")
+	w.field("url")
+	w.String(e.Url)
+}
+
+func (e TL_recentMeUrlUser) writeText(w *textWriter) {
+	w.name("recentMeUrlUser")
+	w.field("url")
+	w.String(e.Url)
+	w.field("user_id")
+	w.Int(e.UserID)
+}
+
+func (e TL_recentMeUrlChat) writeText(w *textWriter) {
+	w.name("recentMeUrlChat")
+	w.field("url")
+	w.String(e.Url)
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_recentMeUrlChatInvite) writeText(w *textWriter) {
+	w.name("recentMeUrlChatInvite")
+	w.field("url")
+	w.String(e.Url)
+	w.field("chat_invite")
+	w.Object(e.ChatInvite)
+}
+
+func (e TL_recentMeUrlStickerSet) writeText(w *textWriter) {
+	w.name("recentMeUrlStickerSet")
+	w.field("url")
+	w.String(e.Url)
+	w.field("set")
+	w.Object(e.Set)
+}
+
+func (e TL_help_recentMeUrls) writeText(w *textWriter) {
+	w.name("help.recentMeUrls")
+	w.field("urls")
+	w.Vector(e.Urls)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_inputSingleMedia) writeText(w *textWriter) {
+	w.name("inputSingleMedia")
+	w.field("media")
+	w.Object(e.Media)
+	w.field("random_id")
+	w.Long(e.RandomID)
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&1 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+}
+
+func (e TL_webAuthorization) writeText(w *textWriter) {
+	w.name("webAuthorization")
+	w.field("hash")
+	w.Long(e.Hash)
+	w.field("bot_id")
+	w.Int(e.BotID)
+	w.field("domain")
+	w.String(e.Domain)
+	w.field("browser")
+	w.String(e.Browser)
+	w.field("platform")
+	w.String(e.Platform)
+	w.field("date_created")
+	w.Int(e.DateCreated)
+	w.field("date_active")
+	w.Int(e.DateActive)
+	w.field("ip")
+	w.String(e.Ip)
+	w.field("region")
+	w.String(e.Region)
+}
+
+func (e TL_account_webAuthorizations) writeText(w *textWriter) {
+	w.name("account.webAuthorizations")
+	w.field("authorizations")
+	w.Vector(e.Authorizations)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_inputMessageID) writeText(w *textWriter) {
+	w.name("inputMessageID")
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_inputMessageReplyTo) writeText(w *textWriter) {
+	w.name("inputMessageReplyTo")
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_inputMessagePinned) writeText(w *textWriter) {
+	w.name("inputMessagePinned")
+}
+
+func (e TL_inputMessageCallbackQuery) writeText(w *textWriter) {
+	w.name("inputMessageCallbackQuery")
+	w.field("id")
+	w.Int(e.ID)
+	w.field("query_id")
+	w.Long(e.QueryID)
+}
+
+func (e TL_inputDialogPeer) writeText(w *textWriter) {
+	w.name("inputDialogPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_inputDialogPeerFolder) writeText(w *textWriter) {
+	w.name("inputDialogPeerFolder")
+	w.field("folder_id")
+	w.Int(e.FolderID)
+}
+
+func (e TL_dialogPeer) writeText(w *textWriter) {
+	w.name("dialogPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_dialogPeerFolder) writeText(w *textWriter) {
+	w.name("dialogPeerFolder")
+	w.field("folder_id")
+	w.Int(e.FolderID)
+}
+
+func (e TL_messages_foundStickerSetsNotModified) writeText(w *textWriter) {
+	w.name("messages.foundStickerSetsNotModified")
+}
+
+func (e TL_messages_foundStickerSets) writeText(w *textWriter) {
+	w.name("messages.foundStickerSets")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("sets")
+	w.Vector(e.Sets)
+}
+
+func (e TL_fileHash) writeText(w *textWriter) {
+	w.name("fileHash")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("hash")
+	w.Bytes(e.Hash)
+}
+
+func (e TL_inputClientProxy) writeText(w *textWriter) {
+	w.name("inputClientProxy")
+	w.field("address")
+	w.String(e.Address)
+	w.field("port")
+	w.Int(e.Port)
+}
+
+func (e TL_help_termsOfServiceUpdateEmpty) writeText(w *textWriter) {
+	w.name("help.termsOfServiceUpdateEmpty")
+	w.field("expires")
+	w.Int(e.Expires)
+}
+
+func (e TL_help_termsOfServiceUpdate) writeText(w *textWriter) {
+	w.name("help.termsOfServiceUpdate")
+	w.field("expires")
+	w.Int(e.Expires)
+	w.field("terms_of_service")
+	w.Object(e.TermsOfService)
+}
+
+func (e TL_inputSecureFileUploaded) writeText(w *textWriter) {
+	w.name("inputSecureFileUploaded")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("parts")
+	w.Int(e.Parts)
+	w.field("md5_checksum")
+	w.String(e.Md5Checksum)
+	w.field("file_hash")
+	w.Bytes(e.FileHash)
+	w.field("secret")
+	w.Bytes(e.Secret)
+}
+
+func (e TL_inputSecureFile) writeText(w *textWriter) {
+	w.name("inputSecureFile")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_secureFileEmpty) writeText(w *textWriter) {
+	w.name("secureFileEmpty")
+}
+
+func (e TL_secureFile) writeText(w *textWriter) {
+	w.name("secureFile")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("size")
+	w.Int(e.Size)
+	w.field("dc_id")
+	w.Int(e.DcID)
+	w.field("date")
+	w.Int(e.Date)
+	w.field("file_hash")
+	w.Bytes(e.FileHash)
+	w.field("secret")
+	w.Bytes(e.Secret)
+}
+
+func (e TL_secureData) writeText(w *textWriter) {
+	w.name("secureData")
+	w.field("data")
+	w.Bytes(e.Data)
+	w.field("data_hash")
+	w.Bytes(e.DataHash)
+	w.field("secret")
+	w.Bytes(e.Secret)
+}
+
+func (e TL_securePlainPhone) writeText(w *textWriter) {
+	w.name("securePlainPhone")
+	w.field("phone")
+	w.String(e.Phone)
+}
+
+func (e TL_securePlainEmail) writeText(w *textWriter) {
+	w.name("securePlainEmail")
+	w.field("email")
+	w.String(e.Email)
+}
+
+func (e TL_secureValueTypePersonalDetails) writeText(w *textWriter) {
+	w.name("secureValueTypePersonalDetails")
+}
+
+func (e TL_secureValueTypePassport) writeText(w *textWriter) {
+	w.name("secureValueTypePassport")
+}
+
+func (e TL_secureValueTypeDriverLicense) writeText(w *textWriter) {
+	w.name("secureValueTypeDriverLicense")
+}
+
+func (e TL_secureValueTypeIdentityCard) writeText(w *textWriter) {
+	w.name("secureValueTypeIdentityCard")
+}
+
+func (e TL_secureValueTypeInternalPassport) writeText(w *textWriter) {
+	w.name("secureValueTypeInternalPassport")
+}
+
+func (e TL_secureValueTypeAddress) writeText(w *textWriter) {
+	w.name("secureValueTypeAddress")
+}
+
+func (e TL_secureValueTypeUtilityBill) writeText(w *textWriter) {
+	w.name("secureValueTypeUtilityBill")
+}
+
+func (e TL_secureValueTypeBankStatement) writeText(w *textWriter) {
+	w.name("secureValueTypeBankStatement")
+}
+
+func (e TL_secureValueTypeRentalAgreement) writeText(w *textWriter) {
+	w.name("secureValueTypeRentalAgreement")
+}
+
+func (e TL_secureValueTypePassportRegistration) writeText(w *textWriter) {
+	w.name("secureValueTypePassportRegistration")
+}
+
+func (e TL_secureValueTypeTemporaryRegistration) writeText(w *textWriter) {
+	w.name("secureValueTypeTemporaryRegistration")
+}
+
+func (e TL_secureValueTypePhone) writeText(w *textWriter) {
+	w.name("secureValueTypePhone")
+}
+
+func (e TL_secureValueTypeEmail) writeText(w *textWriter) {
+	w.name("secureValueTypeEmail")
+}
+
+func (e TL_secureValue) writeText(w *textWriter) {
+	w.name("secureValue")
+	w.field("type")
+	w.Object(e.Type)
+	if e.Flags&1 != 0 {
+		w.field("data")
+		w.Object(e.Data)
+	}
+	if e.Flags&2 != 0 {
+		w.field("front_side")
+		w.Object(e.FrontSide)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reverse_side")
+		w.Object(e.ReverseSide)
+	}
+	if e.Flags&8 != 0 {
+		w.field("selfie")
+		w.Object(e.Selfie)
+	}
+	if e.Flags&64 != 0 {
+		w.field("translation")
+		w.Vector(e.Translation)
+	}
+	if e.Flags&16 != 0 {
+		w.field("files")
+		w.Vector(e.Files)
+	}
+	if e.Flags&32 != 0 {
+		w.field("plain_data")
+		w.Object(e.PlainData)
+	}
+	w.field("hash")
+	w.Bytes(e.Hash)
+}
+
+func (e TL_inputSecureValue) writeText(w *textWriter) {
+	w.name("inputSecureValue")
+	w.field("type")
+	w.Object(e.Type)
+	if e.Flags&1 != 0 {
+		w.field("data")
+		w.Object(e.Data)
+	}
+	if e.Flags&2 != 0 {
+		w.field("front_side")
+		w.Object(e.FrontSide)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reverse_side")
+		w.Object(e.ReverseSide)
+	}
+	if e.Flags&8 != 0 {
+		w.field("selfie")
+		w.Object(e.Selfie)
+	}
+	if e.Flags&64 != 0 {
+		w.field("translation")
+		w.Vector(e.Translation)
+	}
+	if e.Flags&16 != 0 {
+		w.field("files")
+		w.Vector(e.Files)
+	}
+	if e.Flags&32 != 0 {
+		w.field("plain_data")
+		w.Object(e.PlainData)
+	}
+}
+
+func (e TL_secureValueHash) writeText(w *textWriter) {
+	w.name("secureValueHash")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("hash")
+	w.Bytes(e.Hash)
+}
+
+func (e TL_secureValueErrorData) writeText(w *textWriter) {
+	w.name("secureValueErrorData")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("data_hash")
+	w.Bytes(e.DataHash)
+	w.field("field")
+	w.String(e.Field)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureValueErrorFrontSide) writeText(w *textWriter) {
+	w.name("secureValueErrorFrontSide")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("file_hash")
+	w.Bytes(e.FileHash)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureValueErrorReverseSide) writeText(w *textWriter) {
+	w.name("secureValueErrorReverseSide")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("file_hash")
+	w.Bytes(e.FileHash)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureValueErrorSelfie) writeText(w *textWriter) {
+	w.name("secureValueErrorSelfie")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("file_hash")
+	w.Bytes(e.FileHash)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureValueErrorFile) writeText(w *textWriter) {
+	w.name("secureValueErrorFile")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("file_hash")
+	w.Bytes(e.FileHash)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureValueErrorFiles) writeText(w *textWriter) {
+	w.name("secureValueErrorFiles")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("file_hash")
+	w.VectorBytes(e.FileHash)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureValueError) writeText(w *textWriter) {
+	w.name("secureValueError")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("hash")
+	w.Bytes(e.Hash)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureValueErrorTranslationFile) writeText(w *textWriter) {
+	w.name("secureValueErrorTranslationFile")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("file_hash")
+	w.Bytes(e.FileHash)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureValueErrorTranslationFiles) writeText(w *textWriter) {
+	w.name("secureValueErrorTranslationFiles")
+	w.field("type")
+	w.Object(e.Type)
+	w.field("file_hash")
+	w.VectorBytes(e.FileHash)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_secureCredentialsEncrypted) writeText(w *textWriter) {
+	w.name("secureCredentialsEncrypted")
+	w.field("data")
+	w.Bytes(e.Data)
+	w.field("hash")
+	w.Bytes(e.Hash)
+	w.field("secret")
+	w.Bytes(e.Secret)
+}
+
+func (e TL_account_authorizationForm) writeText(w *textWriter) {
+	w.name("account.authorizationForm")
+	w.field("required_types")
+	w.Vector(e.RequiredTypes)
+	w.field("values")
+	w.Vector(e.Values)
+	w.field("errors")
+	w.Vector(e.Errors)
+	w.field("users")
+	w.Vector(e.Users)
+	if e.Flags&1 != 0 {
+		w.field("privacy_policy_url")
+		w.String(e.PrivacyPolicyUrl)
+	}
+}
+
+func (e TL_account_sentEmailCode) writeText(w *textWriter) {
+	w.name("account.sentEmailCode")
+	w.field("email_pattern")
+	w.String(e.EmailPattern)
+	w.field("length")
+	w.Int(e.Length)
+}
+
+func (e TL_help_deepLinkInfoEmpty) writeText(w *textWriter) {
+	w.name("help.deepLinkInfoEmpty")
+}
+
+func (e TL_help_deepLinkInfo) writeText(w *textWriter) {
+	w.name("help.deepLinkInfo")
+	if e.Flags&1 != 0 {
+		w.field("update_app")
+		w.Bool(true)
+	}
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&2 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+}
+
+func (e TL_savedPhoneContact) writeText(w *textWriter) {
+	w.name("savedPhoneContact")
+	w.field("phone")
+	w.String(e.Phone)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_account_takeout) writeText(w *textWriter) {
+	w.name("account.takeout")
+	w.field("id")
+	w.Long(e.ID)
+}
+
+func (e TL_passwordKdfAlgoUnknown) writeText(w *textWriter) {
+	w.name("passwordKdfAlgoUnknown")
+}
+
+func (e TL_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow) writeText(w *textWriter) {
+	w.name("passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow")
+	w.field("salt1")
+	w.Bytes(e.Salt1)
+	w.field("salt2")
+	w.Bytes(e.Salt2)
+	w.field("g")
+	w.Int(e.G)
+	w.field("p")
+	w.Bytes(e.P)
+}
+
+func (e TL_securePasswordKdfAlgoUnknown) writeText(w *textWriter) {
+	w.name("securePasswordKdfAlgoUnknown")
+}
+
+func (e TL_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000) writeText(w *textWriter) {
+	w.name("securePasswordKdfAlgoPBKDF2HMACSHA512iter100000")
+	w.field("salt")
+	w.Bytes(e.Salt)
+}
+
+func (e TL_securePasswordKdfAlgoSHA512) writeText(w *textWriter) {
+	w.name("securePasswordKdfAlgoSHA512")
+	w.field("salt")
+	w.Bytes(e.Salt)
+}
+
+func (e TL_secureSecretSettings) writeText(w *textWriter) {
+	w.name("secureSecretSettings")
+	w.field("secure_algo")
+	w.Object(e.SecureAlgo)
+	w.field("secure_secret")
+	w.Bytes(e.SecureSecret)
+	w.field("secure_secret_id")
+	w.Long(e.SecureSecretID)
+}
+
+func (e TL_inputCheckPasswordEmpty) writeText(w *textWriter) {
+	w.name("inputCheckPasswordEmpty")
+}
+
+func (e TL_inputCheckPasswordSRP) writeText(w *textWriter) {
+	w.name("inputCheckPasswordSRP")
+	w.field("srp_id")
+	w.Long(e.SrpID)
+	w.field("A")
+	w.Bytes(e.A)
+	w.field("M1")
+	w.Bytes(e.M1)
+}
+
+func (e TL_secureRequiredType) writeText(w *textWriter) {
+	w.name("secureRequiredType")
+	if e.Flags&1 != 0 {
+		w.field("native_names")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("selfie_required")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("translation_required")
+		w.Bool(true)
+	}
+	w.field("type")
+	w.Object(e.Type)
+}
+
+func (e TL_secureRequiredTypeOneOf) writeText(w *textWriter) {
+	w.name("secureRequiredTypeOneOf")
+	w.field("types")
+	w.Vector(e.Types)
+}
+
+func (e TL_help_passportConfigNotModified) writeText(w *textWriter) {
+	w.name("help.passportConfigNotModified")
+}
+
+func (e TL_help_passportConfig) writeText(w *textWriter) {
+	w.name("help.passportConfig")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("countries_langs")
+	w.Object(e.CountriesLangs)
+}
+
+func (e TL_inputAppEvent) writeText(w *textWriter) {
+	w.name("inputAppEvent")
+	w.field("time")
+	w.Double(e.Time)
+	w.field("type")
+	w.String(e.Type)
+	w.field("peer")
+	w.Long(e.Peer)
+	w.field("data")
+	w.Object(e.Data)
+}
+
+func (e TL_jsonObjectValue) writeText(w *textWriter) {
+	w.name("jsonObjectValue")
+	w.field("key")
+	w.String(e.Key)
+	w.field("value")
+	w.Object(e.Value)
+}
+
+func (e TL_jsonNull) writeText(w *textWriter) {
+	w.name("jsonNull")
+}
+
+func (e TL_jsonBool) writeText(w *textWriter) {
+	w.name("jsonBool")
+	w.field("value")
+	w.Object(e.Value)
+}
+
+func (e TL_jsonNumber) writeText(w *textWriter) {
+	w.name("jsonNumber")
+	w.field("value")
+	w.Double(e.Value)
+}
+
+func (e TL_jsonString) writeText(w *textWriter) {
+	w.name("jsonString")
+	w.field("value")
+	w.String(e.Value)
+}
+
+func (e TL_jsonArray) writeText(w *textWriter) {
+	w.name("jsonArray")
+	w.field("value")
+	w.Vector(e.Value)
+}
+
+func (e TL_jsonObject) writeText(w *textWriter) {
+	w.name("jsonObject")
+	w.field("value")
+	w.Vector(e.Value)
+}
+
+func (e TL_pageTableCell) writeText(w *textWriter) {
+	w.name("pageTableCell")
+	if e.Flags&1 != 0 {
+		w.field("header")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("align_center")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("align_right")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("valign_middle")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("valign_bottom")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("text")
+		w.Object(e.Text)
+	}
+	if e.Flags&2 != 0 {
+		w.field("colspan")
+		w.Int(e.Colspan)
+	}
+	if e.Flags&4 != 0 {
+		w.field("rowspan")
+		w.Int(e.Rowspan)
+	}
+}
+
+func (e TL_pageTableRow) writeText(w *textWriter) {
+	w.name("pageTableRow")
+	w.field("cells")
+	w.Vector(e.Cells)
+}
+
+func (e TL_pageCaption) writeText(w *textWriter) {
+	w.name("pageCaption")
+	w.field("text")
+	w.Object(e.Text)
+	w.field("credit")
+	w.Object(e.Credit)
+}
+
+func (e TL_pageListItemText) writeText(w *textWriter) {
+	w.name("pageListItemText")
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageListItemBlocks) writeText(w *textWriter) {
+	w.name("pageListItemBlocks")
+	w.field("blocks")
+	w.Vector(e.Blocks)
+}
+
+func (e TL_pageListOrderedItemText) writeText(w *textWriter) {
+	w.name("pageListOrderedItemText")
+	w.field("num")
+	w.String(e.Num)
+	w.field("text")
+	w.Object(e.Text)
+}
+
+func (e TL_pageListOrderedItemBlocks) writeText(w *textWriter) {
+	w.name("pageListOrderedItemBlocks")
+	w.field("num")
+	w.String(e.Num)
+	w.field("blocks")
+	w.Vector(e.Blocks)
+}
+
+func (e TL_pageRelatedArticle) writeText(w *textWriter) {
+	w.name("pageRelatedArticle")
+	w.field("url")
+	w.String(e.Url)
+	w.field("webpage_id")
+	w.Long(e.WebpageID)
+	if e.Flags&1 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&2 != 0 {
+		w.field("description")
+		w.String(e.Description)
+	}
+	if e.Flags&4 != 0 {
+		w.field("photo_id")
+		w.Long(e.PhotoID)
+	}
+	if e.Flags&8 != 0 {
+		w.field("author")
+		w.String(e.Author)
+	}
+	if e.Flags&16 != 0 {
+		w.field("published_date")
+		w.Int(e.PublishedDate)
+	}
+}
+
+func (e TL_page) writeText(w *textWriter) {
+	w.name("page")
+	if e.Flags&1 != 0 {
+		w.field("part")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("rtl")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("v2")
+		w.Bool(true)
+	}
+	w.field("url")
+	w.String(e.Url)
+	w.field("blocks")
+	w.Vector(e.Blocks)
+	w.field("photos")
+	w.Vector(e.Photos)
+	w.field("documents")
+	w.Vector(e.Documents)
+	if e.Flags&8 != 0 {
+		w.field("views")
+		w.Int(e.Views)
+	}
+}
+
+func (e TL_help_supportName) writeText(w *textWriter) {
+	w.name("help.supportName")
+	w.field("name")
+	w.String(e.Name)
+}
+
+func (e TL_help_userInfoEmpty) writeText(w *textWriter) {
+	w.name("help.userInfoEmpty")
+}
+
+func (e TL_help_userInfo) writeText(w *textWriter) {
+	w.name("help.userInfo")
+	w.field("message")
+	w.String(e.Message)
+	w.field("entities")
+	w.Vector(e.Entities)
+	w.field("author")
+	w.String(e.Author)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_pollAnswer) writeText(w *textWriter) {
+	w.name("pollAnswer")
+	w.field("text")
+	w.String(e.Text)
+	w.field("option")
+	w.Bytes(e.Option)
+}
+
+func (e TL_poll) writeText(w *textWriter) {
+	w.name("poll")
+	w.field("id")
+	w.Long(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("closed")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("public_voters")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("multiple_choice")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("quiz")
+		w.Bool(true)
+	}
+	w.field("question")
+	w.String(e.Question)
+	w.field("answers")
+	w.Vector(e.Answers)
+	if e.Flags&16 != 0 {
+		w.field("close_period")
+		w.Int(e.ClosePeriod)
+	}
+	if e.Flags&32 != 0 {
+		w.field("close_date")
+		w.Int(e.CloseDate)
+	}
+}
+
+func (e TL_pollAnswerVoters) writeText(w *textWriter) {
+	w.name("pollAnswerVoters")
+	if e.Flags&1 != 0 {
+		w.field("chosen")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("correct")
+		w.Bool(true)
+	}
+	w.field("option")
+	w.Bytes(e.Option)
+	w.field("voters")
+	w.Int(e.Voters)
+}
+
+func (e TL_pollResults) writeText(w *textWriter) {
+	w.name("pollResults")
+	if e.Flags&1 != 0 {
+		w.field("min")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("results")
+		w.Vector(e.Results)
+	}
+	if e.Flags&4 != 0 {
+		w.field("total_voters")
+		w.Int(e.TotalVoters)
+	}
+	if e.Flags&8 != 0 {
+		w.field("recent_voters")
+		w.VectorInt(e.RecentVoters)
+	}
+	if e.Flags&16 != 0 {
+		w.field("solution")
+		w.String(e.Solution)
+	}
+	if e.Flags&16 != 0 {
+		w.field("solution_entities")
+		w.Vector(e.SolutionEntities)
+	}
+}
+
+func (e TL_chatOnlines) writeText(w *textWriter) {
+	w.name("chatOnlines")
+	w.field("onlines")
+	w.Int(e.Onlines)
+}
+
+func (e TL_statsURL) writeText(w *textWriter) {
+	w.name("statsURL")
+	w.field("url")
+	w.String(e.Url)
+}
+
+func (e TL_chatAdminRights) writeText(w *textWriter) {
+	w.name("chatAdminRights")
+	if e.Flags&1 != 0 {
+		w.field("change_info")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("post_messages")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("edit_messages")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("delete_messages")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("ban_users")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("invite_users")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("pin_messages")
+		w.Bool(true)
+	}
+	if e.Flags&512 != 0 {
+		w.field("add_admins")
+		w.Bool(true)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("anonymous")
+		w.Bool(true)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("manage_call")
+		w.Bool(true)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("other")
+		w.Bool(true)
+	}
+}
+
+func (e TL_chatBannedRights) writeText(w *textWriter) {
+	w.name("chatBannedRights")
+	if e.Flags&1 != 0 {
+		w.field("view_messages")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("send_messages")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("send_media")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("send_stickers")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("send_gifs")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("send_games")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("send_inline")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("embed_links")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("send_polls")
+		w.Bool(true)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("change_info")
+		w.Bool(true)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("invite_users")
+		w.Bool(true)
+	}
+	if e.Flags&131072 != 0 {
+		w.field("pin_messages")
+		w.Bool(true)
+	}
+	w.field("until_date")
+	w.Int(e.UntilDate)
+}
+
+func (e TL_inputWallPaper) writeText(w *textWriter) {
+	w.name("inputWallPaper")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputWallPaperSlug) writeText(w *textWriter) {
+	w.name("inputWallPaperSlug")
+	w.field("slug")
+	w.String(e.Slug)
+}
+
+func (e TL_inputWallPaperNoFile) writeText(w *textWriter) {
+	w.name("inputWallPaperNoFile")
+}
+
+func (e TL_account_wallPapersNotModified) writeText(w *textWriter) {
+	w.name("account.wallPapersNotModified")
+}
+
+func (e TL_account_wallPapers) writeText(w *textWriter) {
+	w.name("account.wallPapers")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("wallpapers")
+	w.Vector(e.Wallpapers)
+}
+
+func (e TL_codeSettings) writeText(w *textWriter) {
+	w.name("codeSettings")
+	if e.Flags&1 != 0 {
+		w.field("allow_flashcall")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("current_number")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("allow_app_hash")
+		w.Bool(true)
+	}
+}
+
+func (e TL_wallPaperSettings) writeText(w *textWriter) {
+	w.name("wallPaperSettings")
+	if e.Flags&2 != 0 {
+		w.field("blur")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("motion")
+		w.Bool(true)
+	}
+	if e.Flags&1 != 0 {
+		w.field("background_color")
+		w.Int(e.BackgroundColor)
+	}
+	if e.Flags&16 != 0 {
+		w.field("second_background_color")
+		w.Int(e.SecondBackgroundColor)
+	}
+	if e.Flags&8 != 0 {
+		w.field("intensity")
+		w.Int(e.Intensity)
+	}
+	if e.Flags&16 != 0 {
+		w.field("rotation")
+		w.Int(e.Rotation)
+	}
+}
+
+func (e TL_autoDownloadSettings) writeText(w *textWriter) {
+	w.name("autoDownloadSettings")
+	if e.Flags&1 != 0 {
+		w.field("disabled")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("video_preload_large")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("audio_preload_next")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("phonecalls_less_data")
+		w.Bool(true)
+	}
+	w.field("photo_size_max")
+	w.Int(e.PhotoSizeMax)
+	w.field("video_size_max")
+	w.Int(e.VideoSizeMax)
+	w.field("file_size_max")
+	w.Int(e.FileSizeMax)
+	w.field("video_upload_maxbitrate")
+	w.Int(e.VideoUploadMaxbitrate)
+}
+
+func (e TL_account_autoDownloadSettings) writeText(w *textWriter) {
+	w.name("account.autoDownloadSettings")
+	w.field("low")
+	w.Object(e.Low)
+	w.field("medium")
+	w.Object(e.Medium)
+	w.field("high")
+	w.Object(e.High)
+}
+
+func (e TL_emojiKeyword) writeText(w *textWriter) {
+	w.name("emojiKeyword")
+	w.field("keyword")
+	w.String(e.Keyword)
+	w.field("emoticons")
+	w.VectorString(e.Emoticons)
+}
+
+func (e TL_emojiKeywordDeleted) writeText(w *textWriter) {
+	w.name("emojiKeywordDeleted")
+	w.field("keyword")
+	w.String(e.Keyword)
+	w.field("emoticons")
+	w.VectorString(e.Emoticons)
+}
+
+func (e TL_emojiKeywordsDifference) writeText(w *textWriter) {
+	w.name("emojiKeywordsDifference")
+	w.field("lang_code")
+	w.String(e.LangCode)
+	w.field("from_version")
+	w.Int(e.FromVersion)
+	w.field("version")
+	w.Int(e.Version)
+	w.field("keywords")
+	w.Vector(e.Keywords)
+}
+
+func (e TL_emojiURL) writeText(w *textWriter) {
+	w.name("emojiURL")
+	w.field("url")
+	w.String(e.Url)
+}
+
+func (e TL_emojiLanguage) writeText(w *textWriter) {
+	w.name("emojiLanguage")
+	w.field("lang_code")
+	w.String(e.LangCode)
+}
+
+func (e TL_fileLocationToBeDeprecated) writeText(w *textWriter) {
+	w.name("fileLocationToBeDeprecated")
+	w.field("volume_id")
+	w.Long(e.VolumeID)
+	w.field("local_id")
+	w.Int(e.LocalID)
+}
+
+func (e TL_folder) writeText(w *textWriter) {
+	w.name("folder")
+	if e.Flags&1 != 0 {
+		w.field("autofill_new_broadcasts")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("autofill_public_groups")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("autofill_new_correspondents")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("title")
+	w.String(e.Title)
+	if e.Flags&8 != 0 {
+		w.field("photo")
+		w.Object(e.Photo)
+	}
+}
+
+func (e TL_inputFolderPeer) writeText(w *textWriter) {
+	w.name("inputFolderPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("folder_id")
+	w.Int(e.FolderID)
+}
+
+func (e TL_folderPeer) writeText(w *textWriter) {
+	w.name("folderPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("folder_id")
+	w.Int(e.FolderID)
+}
+
+func (e TL_messages_searchCounter) writeText(w *textWriter) {
+	w.name("messages.searchCounter")
+	if e.Flags&2 != 0 {
+		w.field("inexact")
+		w.Bool(true)
+	}
+	w.field("filter")
+	w.Object(e.Filter)
+	w.field("count")
+	w.Int(e.Count)
+}
+
+func (e TL_urlAuthResultRequest) writeText(w *textWriter) {
+	w.name("urlAuthResultRequest")
+	if e.Flags&1 != 0 {
+		w.field("request_write_access")
+		w.Bool(true)
+	}
+	w.field("bot")
+	w.Object(e.Bot)
+	w.field("domain")
+	w.String(e.Domain)
+}
+
+func (e TL_urlAuthResultAccepted) writeText(w *textWriter) {
+	w.name("urlAuthResultAccepted")
+	w.field("url")
+	w.String(e.Url)
+}
+
+func (e TL_urlAuthResultDefault) writeText(w *textWriter) {
+	w.name("urlAuthResultDefault")
+}
+
+func (e TL_channelLocationEmpty) writeText(w *textWriter) {
+	w.name("channelLocationEmpty")
+}
+
+func (e TL_channelLocation) writeText(w *textWriter) {
+	w.name("channelLocation")
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+	w.field("address")
+	w.String(e.Address)
+}
+
+func (e TL_peerLocated) writeText(w *textWriter) {
+	w.name("peerLocated")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("expires")
+	w.Int(e.Expires)
+	w.field("distance")
+	w.Int(e.Distance)
+}
+
+func (e TL_peerSelfLocated) writeText(w *textWriter) {
+	w.name("peerSelfLocated")
+	w.field("expires")
+	w.Int(e.Expires)
+}
+
+func (e TL_restrictionReason) writeText(w *textWriter) {
+	w.name("restrictionReason")
+	w.field("platform")
+	w.String(e.Platform)
+	w.field("reason")
+	w.String(e.Reason)
+	w.field("text")
+	w.String(e.Text)
+}
+
+func (e TL_inputTheme) writeText(w *textWriter) {
+	w.name("inputTheme")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_inputThemeSlug) writeText(w *textWriter) {
+	w.name("inputThemeSlug")
+	w.field("slug")
+	w.String(e.Slug)
+}
+
+func (e TL_theme) writeText(w *textWriter) {
+	w.name("theme")
+	if e.Flags&1 != 0 {
+		w.field("creator")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("default")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("slug")
+	w.String(e.Slug)
+	w.field("title")
+	w.String(e.Title)
+	if e.Flags&4 != 0 {
+		w.field("document")
+		w.Object(e.Document)
+	}
+	if e.Flags&8 != 0 {
+		w.field("settings")
+		w.Object(e.Settings)
+	}
+	w.field("installs_count")
+	w.Int(e.InstallsCount)
+}
+
+func (e TL_account_themesNotModified) writeText(w *textWriter) {
+	w.name("account.themesNotModified")
+}
+
+func (e TL_account_themes) writeText(w *textWriter) {
+	w.name("account.themes")
+	w.field("hash")
+	w.Int(e.Hash)
+	w.field("themes")
+	w.Vector(e.Themes)
+}
+
+func (e TL_auth_loginToken) writeText(w *textWriter) {
+	w.name("auth.loginToken")
+	w.field("expires")
+	w.Int(e.Expires)
+	w.field("token")
+	w.Bytes(e.Token)
+}
+
+func (e TL_auth_loginTokenMigrateTo) writeText(w *textWriter) {
+	w.name("auth.loginTokenMigrateTo")
+	w.field("dc_id")
+	w.Int(e.DcID)
+	w.field("token")
+	w.Bytes(e.Token)
+}
+
+func (e TL_auth_loginTokenSuccess) writeText(w *textWriter) {
+	w.name("auth.loginTokenSuccess")
+	w.field("authorization")
+	w.Object(e.Authorization)
+}
+
+func (e TL_account_contentSettings) writeText(w *textWriter) {
+	w.name("account.contentSettings")
+	if e.Flags&1 != 0 {
+		w.field("sensitive_enabled")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("sensitive_can_change")
+		w.Bool(true)
+	}
+}
+
+func (e TL_messages_inactiveChats) writeText(w *textWriter) {
+	w.name("messages.inactiveChats")
+	w.field("dates")
+	w.VectorInt(e.Dates)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_baseThemeClassic) writeText(w *textWriter) {
+	w.name("baseThemeClassic")
+}
+
+func (e TL_baseThemeDay) writeText(w *textWriter) {
+	w.name("baseThemeDay")
+}
+
+func (e TL_baseThemeNight) writeText(w *textWriter) {
+	w.name("baseThemeNight")
+}
+
+func (e TL_baseThemeTinted) writeText(w *textWriter) {
+	w.name("baseThemeTinted")
+}
+
+func (e TL_baseThemeArctic) writeText(w *textWriter) {
+	w.name("baseThemeArctic")
+}
+
+func (e TL_inputThemeSettings) writeText(w *textWriter) {
+	w.name("inputThemeSettings")
+	w.field("base_theme")
+	w.Object(e.BaseTheme)
+	w.field("accent_color")
+	w.Int(e.AccentColor)
+	if e.Flags&1 != 0 {
+		w.field("message_top_color")
+		w.Int(e.MessageTopColor)
+	}
+	if e.Flags&1 != 0 {
+		w.field("message_bottom_color")
+		w.Int(e.MessageBottomColor)
+	}
+	if e.Flags&2 != 0 {
+		w.field("wallpaper")
+		w.Object(e.Wallpaper)
+	}
+	if e.Flags&2 != 0 {
+		w.field("wallpaper_settings")
+		w.Object(e.WallpaperSettings)
+	}
+}
+
+func (e TL_themeSettings) writeText(w *textWriter) {
+	w.name("themeSettings")
+	w.field("base_theme")
+	w.Object(e.BaseTheme)
+	w.field("accent_color")
+	w.Int(e.AccentColor)
+	if e.Flags&1 != 0 {
+		w.field("message_top_color")
+		w.Int(e.MessageTopColor)
+	}
+	if e.Flags&1 != 0 {
+		w.field("message_bottom_color")
+		w.Int(e.MessageBottomColor)
+	}
+	if e.Flags&2 != 0 {
+		w.field("wallpaper")
+		w.Object(e.Wallpaper)
+	}
+}
+
+func (e TL_webPageAttributeTheme) writeText(w *textWriter) {
+	w.name("webPageAttributeTheme")
+	if e.Flags&1 != 0 {
+		w.field("documents")
+		w.Vector(e.Documents)
+	}
+	if e.Flags&2 != 0 {
+		w.field("settings")
+		w.Object(e.Settings)
+	}
+}
+
+func (e TL_messageUserVote) writeText(w *textWriter) {
+	w.name("messageUserVote")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("option")
+	w.Bytes(e.Option)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_messageUserVoteInputOption) writeText(w *textWriter) {
+	w.name("messageUserVoteInputOption")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_messageUserVoteMultiple) writeText(w *textWriter) {
+	w.name("messageUserVoteMultiple")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("options")
+	w.VectorBytes(e.Options)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_messages_votesList) writeText(w *textWriter) {
+	w.name("messages.votesList")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("votes")
+	w.Vector(e.Votes)
+	w.field("users")
+	w.Vector(e.Users)
+	if e.Flags&1 != 0 {
+		w.field("next_offset")
+		w.String(e.NextOffset)
+	}
+}
+
+func (e TL_bankCardOpenUrl) writeText(w *textWriter) {
+	w.name("bankCardOpenUrl")
+	w.field("url")
+	w.String(e.Url)
+	w.field("name")
+	w.String(e.Name)
+}
+
+func (e TL_payments_bankCardData) writeText(w *textWriter) {
+	w.name("payments.bankCardData")
+	w.field("title")
+	w.String(e.Title)
+	w.field("open_urls")
+	w.Vector(e.OpenUrls)
+}
+
+func (e TL_dialogFilter) writeText(w *textWriter) {
+	w.name("dialogFilter")
+	if e.Flags&1 != 0 {
+		w.field("contacts")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("non_contacts")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("groups")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("broadcasts")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("bots")
+		w.Bool(true)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("exclude_muted")
+		w.Bool(true)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("exclude_read")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("exclude_archived")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	w.field("title")
+	w.String(e.Title)
+	if e.Flags&33554432 != 0 {
+		w.field("emoticon")
+		w.String(e.Emoticon)
+	}
+	w.field("pinned_peers")
+	w.Vector(e.PinnedPeers)
+	w.field("include_peers")
+	w.Vector(e.IncludePeers)
+	w.field("exclude_peers")
+	w.Vector(e.ExcludePeers)
+}
+
+func (e TL_dialogFilterSuggested) writeText(w *textWriter) {
+	w.name("dialogFilterSuggested")
+	w.field("filter")
+	w.Object(e.Filter)
+	w.field("description")
+	w.String(e.Description)
+}
+
+func (e TL_statsDateRangeDays) writeText(w *textWriter) {
+	w.name("statsDateRangeDays")
+	w.field("min_date")
+	w.Int(e.MinDate)
+	w.field("max_date")
+	w.Int(e.MaxDate)
+}
+
+func (e TL_statsAbsValueAndPrev) writeText(w *textWriter) {
+	w.name("statsAbsValueAndPrev")
+	w.field("current")
+	w.Double(e.Current)
+	w.field("previous")
+	w.Double(e.Previous)
+}
+
+func (e TL_statsPercentValue) writeText(w *textWriter) {
+	w.name("statsPercentValue")
+	w.field("part")
+	w.Double(e.Part)
+	w.field("total")
+	w.Double(e.Total)
+}
+
+func (e TL_statsGraphAsync) writeText(w *textWriter) {
+	w.name("statsGraphAsync")
+	w.field("token")
+	w.String(e.Token)
+}
+
+func (e TL_statsGraphError) writeText(w *textWriter) {
+	w.name("statsGraphError")
+	w.field("error")
+	w.String(e.Error)
+}
+
+func (e TL_statsGraph) writeText(w *textWriter) {
+	w.name("statsGraph")
+	w.field("json")
+	w.Object(e.Json)
+	if e.Flags&1 != 0 {
+		w.field("zoom_token")
+		w.String(e.ZoomToken)
+	}
+}
+
+func (e TL_messageInteractionCounters) writeText(w *textWriter) {
+	w.name("messageInteractionCounters")
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("views")
+	w.Int(e.Views)
+	w.field("forwards")
+	w.Int(e.Forwards)
+}
+
+func (e TL_stats_broadcastStats) writeText(w *textWriter) {
+	w.name("stats.broadcastStats")
+	w.field("period")
+	w.Object(e.Period)
+	w.field("followers")
+	w.Object(e.Followers)
+	w.field("views_per_post")
+	w.Object(e.ViewsPerPost)
+	w.field("shares_per_post")
+	w.Object(e.SharesPerPost)
+	w.field("enabled_notifications")
+	w.Object(e.EnabledNotifications)
+	w.field("growth_graph")
+	w.Object(e.GrowthGraph)
+	w.field("followers_graph")
+	w.Object(e.FollowersGraph)
+	w.field("mute_graph")
+	w.Object(e.MuteGraph)
+	w.field("top_hours_graph")
+	w.Object(e.TopHoursGraph)
+	w.field("interactions_graph")
+	w.Object(e.InteractionsGraph)
+	w.field("iv_interactions_graph")
+	w.Object(e.IvInteractionsGraph)
+	w.field("views_by_source_graph")
+	w.Object(e.ViewsBySourceGraph)
+	w.field("new_followers_by_source_graph")
+	w.Object(e.NewFollowersBySourceGraph)
+	w.field("languages_graph")
+	w.Object(e.LanguagesGraph)
+	w.field("recent_message_interactions")
+	w.Vector(e.RecentMessageInteractions)
+}
+
+func (e TL_help_promoDataEmpty) writeText(w *textWriter) {
+	w.name("help.promoDataEmpty")
+	w.field("expires")
+	w.Int(e.Expires)
+}
+
+func (e TL_help_promoData) writeText(w *textWriter) {
+	w.name("help.promoData")
+	if e.Flags&1 != 0 {
+		w.field("proxy")
+		w.Bool(true)
+	}
+	w.field("expires")
+	w.Int(e.Expires)
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+	if e.Flags&2 != 0 {
+		w.field("psa_type")
+		w.String(e.PsaType)
+	}
+	if e.Flags&4 != 0 {
+		w.field("psa_message")
+		w.String(e.PsaMessage)
+	}
+}
+
+func (e TL_videoSize) writeText(w *textWriter) {
+	w.name("videoSize")
+	w.field("type")
+	w.String(e.Type)
+	w.field("location")
+	w.Object(e.Location)
+	w.field("w")
+	w.Int(e.W)
+	w.field("h")
+	w.Int(e.H)
+	w.field("size")
+	w.Int(e.Size)
+	if e.Flags&1 != 0 {
+		w.field("video_start_ts")
+		w.Double(e.VideoStartTs)
+	}
+}
+
+func (e TL_statsGroupTopPoster) writeText(w *textWriter) {
+	w.name("statsGroupTopPoster")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("messages")
+	w.Int(e.Messages)
+	w.field("avg_chars")
+	w.Int(e.AvgChars)
+}
+
+func (e TL_statsGroupTopAdmin) writeText(w *textWriter) {
+	w.name("statsGroupTopAdmin")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("deleted")
+	w.Int(e.Deleted)
+	w.field("kicked")
+	w.Int(e.Kicked)
+	w.field("banned")
+	w.Int(e.Banned)
+}
+
+func (e TL_statsGroupTopInviter) writeText(w *textWriter) {
+	w.name("statsGroupTopInviter")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("invitations")
+	w.Int(e.Invitations)
+}
+
+func (e TL_stats_megagroupStats) writeText(w *textWriter) {
+	w.name("stats.megagroupStats")
+	w.field("period")
+	w.Object(e.Period)
+	w.field("members")
+	w.Object(e.Members)
+	w.field("messages")
+	w.Object(e.Messages)
+	w.field("viewers")
+	w.Object(e.Viewers)
+	w.field("posters")
+	w.Object(e.Posters)
+	w.field("growth_graph")
+	w.Object(e.GrowthGraph)
+	w.field("members_graph")
+	w.Object(e.MembersGraph)
+	w.field("new_members_by_source_graph")
+	w.Object(e.NewMembersBySourceGraph)
+	w.field("languages_graph")
+	w.Object(e.LanguagesGraph)
+	w.field("messages_graph")
+	w.Object(e.MessagesGraph)
+	w.field("actions_graph")
+	w.Object(e.ActionsGraph)
+	w.field("top_hours_graph")
+	w.Object(e.TopHoursGraph)
+	w.field("weekdays_graph")
+	w.Object(e.WeekdaysGraph)
+	w.field("top_posters")
+	w.Vector(e.TopPosters)
+	w.field("top_admins")
+	w.Vector(e.TopAdmins)
+	w.field("top_inviters")
+	w.Vector(e.TopInviters)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_globalPrivacySettings) writeText(w *textWriter) {
+	w.name("globalPrivacySettings")
+	if e.Flags&1 != 0 {
+		w.field("archive_and_mute_new_noncontact_peers")
+		w.Object(e.ArchiveAndMuteNewNoncontactPeers)
+	}
+}
+
+func (e TL_help_countryCode) writeText(w *textWriter) {
+	w.name("help.countryCode")
+	w.field("country_code")
+	w.String(e.CountryCode)
+	if e.Flags&1 != 0 {
+		w.field("prefixes")
+		w.VectorString(e.Prefixes)
+	}
+	if e.Flags&2 != 0 {
+		w.field("patterns")
+		w.VectorString(e.Patterns)
+	}
+}
+
+func (e TL_help_country) writeText(w *textWriter) {
+	w.name("help.country")
+	if e.Flags&1 != 0 {
+		w.field("hidden")
+		w.Bool(true)
+	}
+	w.field("iso2")
+	w.String(e.Iso2)
+	w.field("default_name")
+	w.String(e.DefaultName)
+	if e.Flags&2 != 0 {
+		w.field("name")
+		w.String(e.Name)
+	}
+	w.field("country_codes")
+	w.Vector(e.CountryCodes)
+}
+
+func (e TL_help_countriesListNotModified) writeText(w *textWriter) {
+	w.name("help.countriesListNotModified")
+}
+
+func (e TL_help_countriesList) writeText(w *textWriter) {
+	w.name("help.countriesList")
+	w.field("countries")
+	w.Vector(e.Countries)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messageViews) writeText(w *textWriter) {
+	w.name("messageViews")
+	if e.Flags&1 != 0 {
+		w.field("views")
+		w.Int(e.Views)
+	}
+	if e.Flags&2 != 0 {
+		w.field("forwards")
+		w.Int(e.Forwards)
+	}
+	if e.Flags&4 != 0 {
+		w.field("replies")
+		w.Object(e.Replies)
+	}
+}
+
+func (e TL_messages_messageViews) writeText(w *textWriter) {
+	w.name("messages.messageViews")
+	w.field("views")
+	w.Vector(e.Views)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_discussionMessage) writeText(w *textWriter) {
+	w.name("messages.discussionMessage")
+	w.field("messages")
+	w.Vector(e.Messages)
+	if e.Flags&1 != 0 {
+		w.field("max_id")
+		w.Int(e.MaxID)
+	}
+	if e.Flags&2 != 0 {
+		w.field("read_inbox_max_id")
+		w.Int(e.ReadInboxMaxID)
+	}
+	if e.Flags&4 != 0 {
+		w.field("read_outbox_max_id")
+		w.Int(e.ReadOutboxMaxID)
+	}
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messageReplyHeader) writeText(w *textWriter) {
+	w.name("messageReplyHeader")
+	w.field("reply_to_msg_id")
+	w.Int(e.ReplyToMsgID)
+	if e.Flags&1 != 0 {
+		w.field("reply_to_peer_id")
+		w.Object(e.ReplyToPeerID)
+	}
+	if e.Flags&2 != 0 {
+		w.field("reply_to_top_id")
+		w.Int(e.ReplyToTopID)
+	}
+}
+
+func (e TL_messageReplies) writeText(w *textWriter) {
+	w.name("messageReplies")
+	if e.Flags&1 != 0 {
+		w.field("comments")
+		w.Bool(true)
+	}
+	w.field("replies")
+	w.Int(e.Replies)
+	w.field("replies_pts")
+	w.Int(e.RepliesPts)
+	if e.Flags&2 != 0 {
+		w.field("recent_repliers")
+		w.Vector(e.RecentRepliers)
+	}
+	if e.Flags&1 != 0 {
+		w.field("channel_id")
+		w.Int(e.ChannelID)
+	}
+	if e.Flags&4 != 0 {
+		w.field("max_id")
+		w.Int(e.MaxID)
+	}
+	if e.Flags&8 != 0 {
+		w.field("read_max_id")
+		w.Int(e.ReadMaxID)
+	}
+}
+
+func (e TL_peerBlocked) writeText(w *textWriter) {
+	w.name("peerBlocked")
+	w.field("peer_id")
+	w.Object(e.PeerID)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_stats_messageStats) writeText(w *textWriter) {
+	w.name("stats.messageStats")
+	w.field("views_graph")
+	w.Object(e.ViewsGraph)
+}
+
+func (e TL_groupCallDiscarded) writeText(w *textWriter) {
+	w.name("groupCallDiscarded")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("duration")
+	w.Int(e.Duration)
+}
+
+func (e TL_groupCall) writeText(w *textWriter) {
+	w.name("groupCall")
+	if e.Flags&2 != 0 {
+		w.field("join_muted")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("can_change_join_muted")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("join_date_asc")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+	w.field("participants_count")
+	w.Int(e.ParticipantsCount)
+	if e.Flags&1 != 0 {
+		w.field("params")
+		w.Object(e.Params)
+	}
+	if e.Flags&8 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&16 != 0 {
+		w.field("stream_dc_id")
+		w.Int(e.StreamDcID)
+	}
+	if e.Flags&32 != 0 {
+		w.field("record_start_date")
+		w.Int(e.RecordStartDate)
+	}
+	w.field("version")
+	w.Int(e.Version)
+}
+
+func (e TL_inputGroupCall) writeText(w *textWriter) {
+	w.name("inputGroupCall")
+	w.field("id")
+	w.Long(e.ID)
+	w.field("access_hash")
+	w.Long(e.AccessHash)
+}
+
+func (e TL_groupCallParticipant) writeText(w *textWriter) {
+	w.name("groupCallParticipant")
+	if e.Flags&1 != 0 {
+		w.field("muted")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("left")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("can_self_unmute")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("just_joined")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("versioned")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("min")
+		w.Bool(true)
+	}
+	if e.Flags&512 != 0 {
+		w.field("muted_by_you")
+		w.Bool(true)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("volume_by_admin")
+		w.Bool(true)
+	}
+	if e.Flags&4096 != 0 {
+		w.field("self")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("date")
+	w.Int(e.Date)
+	if e.Flags&8 != 0 {
+		w.field("active_date")
+		w.Int(e.ActiveDate)
+	}
+	w.field("source")
+	w.Int(e.Source)
+	if e.Flags&128 != 0 {
+		w.field("volume")
+		w.Int(e.Volume)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("about")
+		w.String(e.About)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("raise_hand_rating")
+		w.Long(e.RaiseHandRating)
+	}
+}
+
+func (e TL_phone_groupCall) writeText(w *textWriter) {
+	w.name("phone.groupCall")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("participants")
+	w.Vector(e.Participants)
+	w.field("participants_next_offset")
+	w.String(e.ParticipantsNextOffset)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_phone_groupParticipants) writeText(w *textWriter) {
+	w.name("phone.groupParticipants")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("participants")
+	w.Vector(e.Participants)
+	w.field("next_offset")
+	w.String(e.NextOffset)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+	w.field("version")
+	w.Int(e.Version)
+}
+
+func (e TL_inlineQueryPeerTypeSameBotPM) writeText(w *textWriter) {
+	w.name("inlineQueryPeerTypeSameBotPM")
+}
+
+func (e TL_inlineQueryPeerTypePM) writeText(w *textWriter) {
+	w.name("inlineQueryPeerTypePM")
+}
+
+func (e TL_inlineQueryPeerTypeChat) writeText(w *textWriter) {
+	w.name("inlineQueryPeerTypeChat")
+}
+
+func (e TL_inlineQueryPeerTypeMegagroup) writeText(w *textWriter) {
+	w.name("inlineQueryPeerTypeMegagroup")
+}
+
+func (e TL_inlineQueryPeerTypeBroadcast) writeText(w *textWriter) {
+	w.name("inlineQueryPeerTypeBroadcast")
+}
+
+func (e TL_messages_historyImport) writeText(w *textWriter) {
+	w.name("messages.historyImport")
+	w.field("id")
+	w.Long(e.ID)
+}
+
+func (e TL_messages_historyImportParsed) writeText(w *textWriter) {
+	w.name("messages.historyImportParsed")
+	if e.Flags&1 != 0 {
+		w.field("pm")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("group")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+}
+
+func (e TL_messages_affectedFoundMessages) writeText(w *textWriter) {
+	w.name("messages.affectedFoundMessages")
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("pts_count")
+	w.Int(e.PtsCount)
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("messages")
+	w.VectorInt(e.Messages)
+}
+
+func (e TL_chatInviteImporter) writeText(w *textWriter) {
+	w.name("chatInviteImporter")
+	w.field("user_id")
+	w.Int(e.UserID)
+	w.field("date")
+	w.Int(e.Date)
+}
+
+func (e TL_messages_exportedChatInvites) writeText(w *textWriter) {
+	w.name("messages.exportedChatInvites")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("invites")
+	w.Vector(e.Invites)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_exportedChatInvite) writeText(w *textWriter) {
+	w.name("messages.exportedChatInvite")
+	w.field("invite")
+	w.Object(e.Invite)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_exportedChatInviteReplaced) writeText(w *textWriter) {
+	w.name("messages.exportedChatInviteReplaced")
+	w.field("invite")
+	w.Object(e.Invite)
+	w.field("new_invite")
+	w.Object(e.NewInvite)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_chatInviteImporters) writeText(w *textWriter) {
+	w.name("messages.chatInviteImporters")
+	w.field("count")
+	w.Int(e.Count)
+	w.field("importers")
+	w.Vector(e.Importers)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_chatAdminWithInvites) writeText(w *textWriter) {
+	w.name("chatAdminWithInvites")
+	w.field("admin_id")
+	w.Int(e.AdminID)
+	w.field("invites_count")
+	w.Int(e.InvitesCount)
+	w.field("revoked_invites_count")
+	w.Int(e.RevokedInvitesCount)
+}
+
+func (e TL_messages_chatAdminsWithInvites) writeText(w *textWriter) {
+	w.name("messages.chatAdminsWithInvites")
+	w.field("admins")
+	w.Vector(e.Admins)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_messages_checkedHistoryImportPeer) writeText(w *textWriter) {
+	w.name("messages.checkedHistoryImportPeer")
+	w.field("confirm_text")
+	w.String(e.ConfirmText)
+}
+
+func (e TL_phone_joinAsPeers) writeText(w *textWriter) {
+	w.name("phone.joinAsPeers")
+	w.field("peers")
+	w.Vector(e.Peers)
+	w.field("chats")
+	w.Vector(e.Chats)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_phone_exportedGroupCallInvite) writeText(w *textWriter) {
+	w.name("phone.exportedGroupCallInvite")
+	w.field("link")
+	w.String(e.Link)
+}
+
+func (e TL_invokeAfterMsg) writeText(w *textWriter) {
+	w.name("invokeAfterMsg")
+	w.field("msg_id")
+	w.Long(e.MsgID)
+	w.field("query")
+	w.Object(e.Query)
+}
+
+func (e TL_invokeAfterMsgs) writeText(w *textWriter) {
+	w.name("invokeAfterMsgs")
+	w.field("msg_ids")
+	w.VectorLong(e.MsgIds)
+	w.field("query")
+	w.Object(e.Query)
+}
+
+func (e TL_initConnection) writeText(w *textWriter) {
+	w.name("initConnection")
+	w.field("api_id")
+	w.Int(e.ApiID)
+	w.field("device_model")
+	w.String(e.DeviceModel)
+	w.field("system_version")
+	w.String(e.SystemVersion)
+	w.field("app_version")
+	w.String(e.AppVersion)
+	w.field("system_lang_code")
+	w.String(e.SystemLangCode)
+	w.field("lang_pack")
+	w.String(e.LangPack)
+	w.field("lang_code")
+	w.String(e.LangCode)
+	if e.Flags&1 != 0 {
+		w.field("proxy")
+		w.Object(e.Proxy)
+	}
+	if e.Flags&2 != 0 {
+		w.field("params")
+		w.Object(e.Params)
+	}
+	w.field("query")
+	w.Object(e.Query)
+}
+
+func (e TL_invokeWithLayer) writeText(w *textWriter) {
+	w.name("invokeWithLayer")
+	w.field("layer")
+	w.Int(e.Layer)
+	w.field("query")
+	w.Object(e.Query)
+}
+
+func (e TL_invokeWithoutUpdates) writeText(w *textWriter) {
+	w.name("invokeWithoutUpdates")
+	w.field("query")
+	w.Object(e.Query)
+}
+
+func (e TL_invokeWithMessagesRange) writeText(w *textWriter) {
+	w.name("invokeWithMessagesRange")
+	w.field("range")
+	w.Object(e.Range)
+	w.field("query")
+	w.Object(e.Query)
+}
+
+func (e TL_invokeWithTakeout) writeText(w *textWriter) {
+	w.name("invokeWithTakeout")
+	w.field("takeout_id")
+	w.Long(e.TakeoutID)
+	w.field("query")
+	w.Object(e.Query)
+}
+
+func (e TL_auth_sendCode) writeText(w *textWriter) {
+	w.name("auth.sendCode")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("api_id")
+	w.Int(e.ApiID)
+	w.field("api_hash")
+	w.String(e.ApiHash)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_auth_signUp) writeText(w *textWriter) {
+	w.name("auth.signUp")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("phone_code_hash")
+	w.String(e.PhoneCodeHash)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+}
+
+func (e TL_auth_signIn) writeText(w *textWriter) {
+	w.name("auth.signIn")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("phone_code_hash")
+	w.String(e.PhoneCodeHash)
+	w.field("phone_code")
+	w.String(e.PhoneCode)
+}
+
+func (e TL_auth_logOut) writeText(w *textWriter) {
+	w.name("auth.logOut")
+}
+
+func (e TL_auth_resetAuthorizations) writeText(w *textWriter) {
+	w.name("auth.resetAuthorizations")
+}
+
+func (e TL_auth_exportAuthorization) writeText(w *textWriter) {
+	w.name("auth.exportAuthorization")
+	w.field("dc_id")
+	w.Int(e.DcID)
+}
+
+func (e TL_auth_importAuthorization) writeText(w *textWriter) {
+	w.name("auth.importAuthorization")
+	w.field("id")
+	w.Int(e.ID)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_auth_bindTempAuthKey) writeText(w *textWriter) {
+	w.name("auth.bindTempAuthKey")
+	w.field("perm_auth_key_id")
+	w.Long(e.PermAuthKeyID)
+	w.field("nonce")
+	w.Long(e.Nonce)
+	w.field("expires_at")
+	w.Int(e.ExpiresAt)
+	w.field("encrypted_message")
+	w.Bytes(e.EncryptedMessage)
+}
+
+func (e TL_auth_importBotAuthorization) writeText(w *textWriter) {
+	w.name("auth.importBotAuthorization")
+	w.field("flags")
+	w.Int(e.Flags)
+	w.field("api_id")
+	w.Int(e.ApiID)
+	w.field("api_hash")
+	w.String(e.ApiHash)
+	w.field("bot_auth_token")
+	w.String(e.BotAuthToken)
+}
+
+func (e TL_auth_checkPassword) writeText(w *textWriter) {
+	w.name("auth.checkPassword")
+	w.field("password")
+	w.Object(e.Password)
+}
+
+func (e TL_auth_requestPasswordRecovery) writeText(w *textWriter) {
+	w.name("auth.requestPasswordRecovery")
+}
+
+func (e TL_auth_recoverPassword) writeText(w *textWriter) {
+	w.name("auth.recoverPassword")
+	w.field("code")
+	w.String(e.Code)
+}
+
+func (e TL_auth_resendCode) writeText(w *textWriter) {
+	w.name("auth.resendCode")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("phone_code_hash")
+	w.String(e.PhoneCodeHash)
+}
+
+func (e TL_auth_cancelCode) writeText(w *textWriter) {
+	w.name("auth.cancelCode")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("phone_code_hash")
+	w.String(e.PhoneCodeHash)
+}
+
+func (e TL_auth_dropTempAuthKeys) writeText(w *textWriter) {
+	w.name("auth.dropTempAuthKeys")
+	w.field("except_auth_keys")
+	w.VectorLong(e.ExceptAuthKeys)
+}
+
+func (e TL_auth_exportLoginToken) writeText(w *textWriter) {
+	w.name("auth.exportLoginToken")
+	w.field("api_id")
+	w.Int(e.ApiID)
+	w.field("api_hash")
+	w.String(e.ApiHash)
+	w.field("except_ids")
+	w.VectorInt(e.ExceptIds)
+}
+
+func (e TL_auth_importLoginToken) writeText(w *textWriter) {
+	w.name("auth.importLoginToken")
+	w.field("token")
+	w.Bytes(e.Token)
+}
+
+func (e TL_auth_acceptLoginToken) writeText(w *textWriter) {
+	w.name("auth.acceptLoginToken")
+	w.field("token")
+	w.Bytes(e.Token)
+}
+
+func (e TL_account_registerDevice) writeText(w *textWriter) {
+	w.name("account.registerDevice")
+	if e.Flags&1 != 0 {
+		w.field("no_muted")
+		w.Bool(true)
+	}
+	w.field("token_type")
+	w.Int(e.TokenType)
+	w.field("token")
+	w.String(e.Token)
+	w.field("app_sandbox")
+	w.Object(e.AppSandbox)
+	w.field("secret")
+	w.Bytes(e.Secret)
+	w.field("other_uids")
+	w.VectorInt(e.OtherUids)
+}
+
+func (e TL_account_unregisterDevice) writeText(w *textWriter) {
+	w.name("account.unregisterDevice")
+	w.field("token_type")
+	w.Int(e.TokenType)
+	w.field("token")
+	w.String(e.Token)
+	w.field("other_uids")
+	w.VectorInt(e.OtherUids)
+}
+
+func (e TL_account_updateNotifySettings) writeText(w *textWriter) {
+	w.name("account.updateNotifySettings")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_getNotifySettings) writeText(w *textWriter) {
+	w.name("account.getNotifySettings")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_account_resetNotifySettings) writeText(w *textWriter) {
+	w.name("account.resetNotifySettings")
+}
+
+func (e TL_account_updateProfile) writeText(w *textWriter) {
+	w.name("account.updateProfile")
+	if e.Flags&1 != 0 {
+		w.field("first_name")
+		w.String(e.FirstName)
+	}
+	if e.Flags&2 != 0 {
+		w.field("last_name")
+		w.String(e.LastName)
+	}
+	if e.Flags&4 != 0 {
+		w.field("about")
+		w.String(e.About)
+	}
+}
+
+func (e TL_account_updateStatus) writeText(w *textWriter) {
+	w.name("account.updateStatus")
+	w.field("offline")
+	w.Object(e.Offline)
+}
+
+func (e TL_account_getWallPapers) writeText(w *textWriter) {
+	w.name("account.getWallPapers")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_account_reportPeer) writeText(w *textWriter) {
+	w.name("account.reportPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("reason")
+	w.Object(e.Reason)
+	w.field("message")
+	w.String(e.Message)
+}
+
+func (e TL_account_checkUsername) writeText(w *textWriter) {
+	w.name("account.checkUsername")
+	w.field("username")
+	w.String(e.Username)
+}
+
+func (e TL_account_updateUsername) writeText(w *textWriter) {
+	w.name("account.updateUsername")
+	w.field("username")
+	w.String(e.Username)
+}
+
+func (e TL_account_getPrivacy) writeText(w *textWriter) {
+	w.name("account.getPrivacy")
+	w.field("key")
+	w.Object(e.Key)
+}
+
+func (e TL_account_setPrivacy) writeText(w *textWriter) {
+	w.name("account.setPrivacy")
+	w.field("key")
+	w.Object(e.Key)
+	w.field("rules")
+	w.Vector(e.Rules)
+}
+
+func (e TL_account_deleteAccount) writeText(w *textWriter) {
+	w.name("account.deleteAccount")
+	w.field("reason")
+	w.String(e.Reason)
+}
+
+func (e TL_account_getAccountTTL) writeText(w *textWriter) {
+	w.name("account.getAccountTTL")
+}
+
+func (e TL_account_setAccountTTL) writeText(w *textWriter) {
+	w.name("account.setAccountTTL")
+	w.field("ttl")
+	w.Object(e.Ttl)
+}
+
+func (e TL_account_sendChangePhoneCode) writeText(w *textWriter) {
+	w.name("account.sendChangePhoneCode")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_changePhone) writeText(w *textWriter) {
+	w.name("account.changePhone")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("phone_code_hash")
+	w.String(e.PhoneCodeHash)
+	w.field("phone_code")
+	w.String(e.PhoneCode)
+}
+
+func (e TL_account_updateDeviceLocked) writeText(w *textWriter) {
+	w.name("account.updateDeviceLocked")
+	w.field("period")
+	w.Int(e.Period)
+}
+
+func (e TL_account_getAuthorizations) writeText(w *textWriter) {
+	w.name("account.getAuthorizations")
+}
+
+func (e TL_account_resetAuthorization) writeText(w *textWriter) {
+	w.name("account.resetAuthorization")
+	w.field("hash")
+	w.Long(e.Hash)
+}
+
+func (e TL_account_getPassword) writeText(w *textWriter) {
+	w.name("account.getPassword")
+}
+
+func (e TL_account_getPasswordSettings) writeText(w *textWriter) {
+	w.name("account.getPasswordSettings")
+	w.field("password")
+	w.Object(e.Password)
+}
+
+func (e TL_account_updatePasswordSettings) writeText(w *textWriter) {
+	w.name("account.updatePasswordSettings")
+	w.field("password")
+	w.Object(e.Password)
+	w.field("new_settings")
+	w.Object(e.NewSettings)
+}
+
+func (e TL_account_sendConfirmPhoneCode) writeText(w *textWriter) {
+	w.name("account.sendConfirmPhoneCode")
+	w.field("hash")
+	w.String(e.Hash)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_confirmPhone) writeText(w *textWriter) {
+	w.name("account.confirmPhone")
+	w.field("phone_code_hash")
+	w.String(e.PhoneCodeHash)
+	w.field("phone_code")
+	w.String(e.PhoneCode)
+}
+
+func (e TL_account_getTmpPassword) writeText(w *textWriter) {
+	w.name("account.getTmpPassword")
+	w.field("password")
+	w.Object(e.Password)
+	w.field("period")
+	w.Int(e.Period)
+}
+
+func (e TL_account_getWebAuthorizations) writeText(w *textWriter) {
+	w.name("account.getWebAuthorizations")
+}
+
+func (e TL_account_resetWebAuthorization) writeText(w *textWriter) {
+	w.name("account.resetWebAuthorization")
+	w.field("hash")
+	w.Long(e.Hash)
+}
+
+func (e TL_account_resetWebAuthorizations) writeText(w *textWriter) {
+	w.name("account.resetWebAuthorizations")
+}
+
+func (e TL_account_getAllSecureValues) writeText(w *textWriter) {
+	w.name("account.getAllSecureValues")
+}
+
+func (e TL_account_getSecureValue) writeText(w *textWriter) {
+	w.name("account.getSecureValue")
+	w.field("types")
+	w.Vector(e.Types)
+}
+
+func (e TL_account_saveSecureValue) writeText(w *textWriter) {
+	w.name("account.saveSecureValue")
+	w.field("value")
+	w.Object(e.Value)
+	w.field("secure_secret_id")
+	w.Long(e.SecureSecretID)
+}
+
+func (e TL_account_deleteSecureValue) writeText(w *textWriter) {
+	w.name("account.deleteSecureValue")
+	w.field("types")
+	w.Vector(e.Types)
+}
+
+func (e TL_account_getAuthorizationForm) writeText(w *textWriter) {
+	w.name("account.getAuthorizationForm")
+	w.field("bot_id")
+	w.Int(e.BotID)
+	w.field("scope")
+	w.String(e.Scope)
+	w.field("public_key")
+	w.String(e.PublicKey)
+}
+
+func (e TL_account_acceptAuthorization) writeText(w *textWriter) {
+	w.name("account.acceptAuthorization")
+	w.field("bot_id")
+	w.Int(e.BotID)
+	w.field("scope")
+	w.String(e.Scope)
+	w.field("public_key")
+	w.String(e.PublicKey)
+	w.field("value_hashes")
+	w.Vector(e.ValueHashes)
+	w.field("credentials")
+	w.Object(e.Credentials)
+}
+
+func (e TL_account_sendVerifyPhoneCode) writeText(w *textWriter) {
+	w.name("account.sendVerifyPhoneCode")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_verifyPhone) writeText(w *textWriter) {
+	w.name("account.verifyPhone")
+	w.field("phone_number")
+	w.String(e.PhoneNumber)
+	w.field("phone_code_hash")
+	w.String(e.PhoneCodeHash)
+	w.field("phone_code")
+	w.String(e.PhoneCode)
+}
+
+func (e TL_account_sendVerifyEmailCode) writeText(w *textWriter) {
+	w.name("account.sendVerifyEmailCode")
+	w.field("email")
+	w.String(e.Email)
+}
+
+func (e TL_account_verifyEmail) writeText(w *textWriter) {
+	w.name("account.verifyEmail")
+	w.field("email")
+	w.String(e.Email)
+	w.field("code")
+	w.String(e.Code)
+}
+
+func (e TL_account_initTakeoutSession) writeText(w *textWriter) {
+	w.name("account.initTakeoutSession")
+	if e.Flags&1 != 0 {
+		w.field("contacts")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("message_users")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("message_chats")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("message_megagroups")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("message_channels")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("files")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("file_max_size")
+		w.Int(e.FileMaxSize)
+	}
+}
+
+func (e TL_account_finishTakeoutSession) writeText(w *textWriter) {
+	w.name("account.finishTakeoutSession")
+	if e.Flags&1 != 0 {
+		w.field("success")
+		w.Bool(true)
+	}
+}
+
+func (e TL_account_confirmPasswordEmail) writeText(w *textWriter) {
+	w.name("account.confirmPasswordEmail")
+	w.field("code")
+	w.String(e.Code)
+}
+
+func (e TL_account_resendPasswordEmail) writeText(w *textWriter) {
+	w.name("account.resendPasswordEmail")
+}
+
+func (e TL_account_cancelPasswordEmail) writeText(w *textWriter) {
+	w.name("account.cancelPasswordEmail")
+}
+
+func (e TL_account_getContactSignUpNotification) writeText(w *textWriter) {
+	w.name("account.getContactSignUpNotification")
+}
+
+func (e TL_account_setContactSignUpNotification) writeText(w *textWriter) {
+	w.name("account.setContactSignUpNotification")
+	w.field("silent")
+	w.Object(e.Silent)
+}
+
+func (e TL_account_getNotifyExceptions) writeText(w *textWriter) {
+	w.name("account.getNotifyExceptions")
+	if e.Flags&2 != 0 {
+		w.field("compare_sound")
+		w.Bool(true)
+	}
+	if e.Flags&1 != 0 {
+		w.field("peer")
+		w.Object(e.Peer)
+	}
+}
+
+func (e TL_account_getWallPaper) writeText(w *textWriter) {
+	w.name("account.getWallPaper")
+	w.field("wallpaper")
+	w.Object(e.Wallpaper)
+}
+
+func (e TL_account_uploadWallPaper) writeText(w *textWriter) {
+	w.name("account.uploadWallPaper")
+	w.field("file")
+	w.Object(e.File)
+	w.field("mime_type")
+	w.String(e.MimeType)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_saveWallPaper) writeText(w *textWriter) {
+	w.name("account.saveWallPaper")
+	w.field("wallpaper")
+	w.Object(e.Wallpaper)
+	w.field("unsave")
+	w.Object(e.Unsave)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_installWallPaper) writeText(w *textWriter) {
+	w.name("account.installWallPaper")
+	w.field("wallpaper")
+	w.Object(e.Wallpaper)
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_resetWallPapers) writeText(w *textWriter) {
+	w.name("account.resetWallPapers")
+}
+
+func (e TL_account_getAutoDownloadSettings) writeText(w *textWriter) {
+	w.name("account.getAutoDownloadSettings")
+}
+
+func (e TL_account_saveAutoDownloadSettings) writeText(w *textWriter) {
+	w.name("account.saveAutoDownloadSettings")
+	if e.Flags&1 != 0 {
+		w.field("low")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("high")
+		w.Bool(true)
+	}
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_uploadTheme) writeText(w *textWriter) {
+	w.name("account.uploadTheme")
+	w.field("file")
+	w.Object(e.File)
+	if e.Flags&1 != 0 {
+		w.field("thumb")
+		w.Object(e.Thumb)
+	}
+	w.field("file_name")
+	w.String(e.FileName)
+	w.field("mime_type")
+	w.String(e.MimeType)
+}
+
+func (e TL_account_createTheme) writeText(w *textWriter) {
+	w.name("account.createTheme")
+	w.field("slug")
+	w.String(e.Slug)
+	w.field("title")
+	w.String(e.Title)
+	if e.Flags&4 != 0 {
+		w.field("document")
+		w.Object(e.Document)
+	}
+	if e.Flags&8 != 0 {
+		w.field("settings")
+		w.Object(e.Settings)
+	}
+}
+
+func (e TL_account_updateTheme) writeText(w *textWriter) {
+	w.name("account.updateTheme")
+	w.field("format")
+	w.String(e.Format)
+	w.field("theme")
+	w.Object(e.Theme)
+	if e.Flags&1 != 0 {
+		w.field("slug")
+		w.String(e.Slug)
+	}
+	if e.Flags&2 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+	if e.Flags&4 != 0 {
+		w.field("document")
+		w.Object(e.Document)
+	}
+	if e.Flags&8 != 0 {
+		w.field("settings")
+		w.Object(e.Settings)
+	}
+}
+
+func (e TL_account_saveTheme) writeText(w *textWriter) {
+	w.name("account.saveTheme")
+	w.field("theme")
+	w.Object(e.Theme)
+	w.field("unsave")
+	w.Object(e.Unsave)
+}
+
+func (e TL_account_installTheme) writeText(w *textWriter) {
+	w.name("account.installTheme")
+	if e.Flags&1 != 0 {
+		w.field("dark")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("format")
+		w.String(e.Format)
+	}
+	if e.Flags&2 != 0 {
+		w.field("theme")
+		w.Object(e.Theme)
+	}
+}
+
+func (e TL_account_getTheme) writeText(w *textWriter) {
+	w.name("account.getTheme")
+	w.field("format")
+	w.String(e.Format)
+	w.field("theme")
+	w.Object(e.Theme)
+	w.field("document_id")
+	w.Long(e.DocumentID)
+}
+
+func (e TL_account_getThemes) writeText(w *textWriter) {
+	w.name("account.getThemes")
+	w.field("format")
+	w.String(e.Format)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_account_setContentSettings) writeText(w *textWriter) {
+	w.name("account.setContentSettings")
+	if e.Flags&1 != 0 {
+		w.field("sensitive_enabled")
+		w.Bool(true)
+	}
+}
+
+func (e TL_account_getContentSettings) writeText(w *textWriter) {
+	w.name("account.getContentSettings")
+}
+
+func (e TL_account_getMultiWallPapers) writeText(w *textWriter) {
+	w.name("account.getMultiWallPapers")
+	w.field("wallpapers")
+	w.Vector(e.Wallpapers)
+}
+
+func (e TL_account_getGlobalPrivacySettings) writeText(w *textWriter) {
+	w.name("account.getGlobalPrivacySettings")
+}
+
+func (e TL_account_setGlobalPrivacySettings) writeText(w *textWriter) {
+	w.name("account.setGlobalPrivacySettings")
+	w.field("settings")
+	w.Object(e.Settings)
+}
+
+func (e TL_account_reportProfilePhoto) writeText(w *textWriter) {
+	w.name("account.reportProfilePhoto")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("photo_id")
+	w.Object(e.PhotoID)
+	w.field("reason")
+	w.Object(e.Reason)
+	w.field("message")
+	w.String(e.Message)
+}
+
+func (e TL_users_getUsers) writeText(w *textWriter) {
+	w.name("users.getUsers")
+	w.field("id")
+	w.Vector(e.ID)
+}
+
+func (e TL_users_getFullUser) writeText(w *textWriter) {
+	w.name("users.getFullUser")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_users_setSecureValueErrors) writeText(w *textWriter) {
+	w.name("users.setSecureValueErrors")
+	w.field("id")
+	w.Object(e.ID)
+	w.field("errors")
+	w.Vector(e.Errors)
+}
+
+func (e TL_contacts_getContactIDs) writeText(w *textWriter) {
+	w.name("contacts.getContactIDs")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_contacts_getStatuses) writeText(w *textWriter) {
+	w.name("contacts.getStatuses")
+}
+
+func (e TL_contacts_getContacts) writeText(w *textWriter) {
+	w.name("contacts.getContacts")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_contacts_importContacts) writeText(w *textWriter) {
+	w.name("contacts.importContacts")
+	w.field("contacts")
+	w.Vector(e.Contacts)
+}
+
+func (e TL_contacts_deleteContacts) writeText(w *textWriter) {
+	w.name("contacts.deleteContacts")
+	w.field("id")
+	w.Vector(e.ID)
+}
+
+func (e TL_contacts_deleteByPhones) writeText(w *textWriter) {
+	w.name("contacts.deleteByPhones")
+	w.field("phones")
+	w.VectorString(e.Phones)
+}
+
+func (e TL_contacts_block) writeText(w *textWriter) {
+	w.name("contacts.block")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_contacts_unblock) writeText(w *textWriter) {
+	w.name("contacts.unblock")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_contacts_getBlocked) writeText(w *textWriter) {
+	w.name("contacts.getBlocked")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_contacts_search) writeText(w *textWriter) {
+	w.name("contacts.search")
+	w.field("q")
+	w.String(e.Q)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_contacts_resolveUsername) writeText(w *textWriter) {
+	w.name("contacts.resolveUsername")
+	w.field("username")
+	w.String(e.Username)
+}
+
+func (e TL_contacts_getTopPeers) writeText(w *textWriter) {
+	w.name("contacts.getTopPeers")
+	if e.Flags&1 != 0 {
+		w.field("correspondents")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("bots_pm")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("bots_inline")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("phone_calls")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("forward_users")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("forward_chats")
+		w.Bool(true)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("groups")
+		w.Bool(true)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("channels")
+		w.Bool(true)
+	}
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_contacts_resetTopPeerRating) writeText(w *textWriter) {
+	w.name("contacts.resetTopPeerRating")
+	w.field("category")
+	w.Object(e.Category)
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_contacts_resetSaved) writeText(w *textWriter) {
+	w.name("contacts.resetSaved")
+}
+
+func (e TL_contacts_getSaved) writeText(w *textWriter) {
+	w.name("contacts.getSaved")
+}
+
+func (e TL_contacts_toggleTopPeers) writeText(w *textWriter) {
+	w.name("contacts.toggleTopPeers")
+	w.field("enabled")
+	w.Object(e.Enabled)
+}
+
+func (e TL_contacts_addContact) writeText(w *textWriter) {
+	w.name("contacts.addContact")
+	if e.Flags&1 != 0 {
+		w.field("add_phone_privacy_exception")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Object(e.ID)
+	w.field("first_name")
+	w.String(e.FirstName)
+	w.field("last_name")
+	w.String(e.LastName)
+	w.field("phone")
+	w.String(e.Phone)
+}
+
+func (e TL_contacts_acceptContact) writeText(w *textWriter) {
+	w.name("contacts.acceptContact")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_contacts_getLocated) writeText(w *textWriter) {
+	w.name("contacts.getLocated")
+	if e.Flags&2 != 0 {
+		w.field("background")
+		w.Bool(true)
+	}
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+	if e.Flags&1 != 0 {
+		w.field("self_expires")
+		w.Int(e.SelfExpires)
+	}
+}
+
+func (e TL_contacts_blockFromReplies) writeText(w *textWriter) {
+	w.name("contacts.blockFromReplies")
+	if e.Flags&1 != 0 {
+		w.field("delete_message")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("delete_history")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("report_spam")
+		w.Bool(true)
+	}
+	w.field("msg_id")
+	w.Int(e.MsgID)
+}
+
+func (e TL_messages_getMessages) writeText(w *textWriter) {
+	w.name("messages.getMessages")
+	w.field("id")
+	w.Vector(e.ID)
+}
+
+func (e TL_messages_getDialogs) writeText(w *textWriter) {
+	w.name("messages.getDialogs")
+	if e.Flags&1 != 0 {
+		w.field("exclude_pinned")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	w.field("offset_date")
+	w.Int(e.OffsetDate)
+	w.field("offset_id")
+	w.Int(e.OffsetID)
+	w.field("offset_peer")
+	w.Object(e.OffsetPeer)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_getHistory) writeText(w *textWriter) {
+	w.name("messages.getHistory")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("offset_id")
+	w.Int(e.OffsetID)
+	w.field("offset_date")
+	w.Int(e.OffsetDate)
+	w.field("add_offset")
+	w.Int(e.AddOffset)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("max_id")
+	w.Int(e.MaxID)
+	w.field("min_id")
+	w.Int(e.MinID)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_search) writeText(w *textWriter) {
+	w.name("messages.search")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("q")
+	w.String(e.Q)
+	if e.Flags&1 != 0 {
+		w.field("from_id")
+		w.Object(e.FromID)
+	}
+	if e.Flags&2 != 0 {
+		w.field("top_msg_id")
+		w.Int(e.TopMsgID)
+	}
+	w.field("filter")
+	w.Object(e.Filter)
+	w.field("min_date")
+	w.Int(e.MinDate)
+	w.field("max_date")
+	w.Int(e.MaxDate)
+	w.field("offset_id")
+	w.Int(e.OffsetID)
+	w.field("add_offset")
+	w.Int(e.AddOffset)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("max_id")
+	w.Int(e.MaxID)
+	w.field("min_id")
+	w.Int(e.MinID)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_readHistory) writeText(w *textWriter) {
+	w.name("messages.readHistory")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("max_id")
+	w.Int(e.MaxID)
+}
+
+func (e TL_messages_deleteHistory) writeText(w *textWriter) {
+	w.name("messages.deleteHistory")
+	if e.Flags&1 != 0 {
+		w.field("just_clear")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("revoke")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("max_id")
+	w.Int(e.MaxID)
+}
+
+func (e TL_messages_deleteMessages) writeText(w *textWriter) {
+	w.name("messages.deleteMessages")
+	if e.Flags&1 != 0 {
+		w.field("revoke")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_messages_receivedMessages) writeText(w *textWriter) {
+	w.name("messages.receivedMessages")
+	w.field("max_id")
+	w.Int(e.MaxID)
+}
+
+func (e TL_messages_setTyping) writeText(w *textWriter) {
+	w.name("messages.setTyping")
+	w.field("peer")
+	w.Object(e.Peer)
+	if e.Flags&1 != 0 {
+		w.field("top_msg_id")
+		w.Int(e.TopMsgID)
+	}
+	w.field("action")
+	w.Object(e.Action)
+}
+
+func (e TL_messages_sendMessage) writeText(w *textWriter) {
+	w.name("messages.sendMessage")
+	if e.Flags&2 != 0 {
+		w.field("no_webpage")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("background")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("clear_draft")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	if e.Flags&1 != 0 {
+		w.field("reply_to_msg_id")
+		w.Int(e.ReplyToMsgID)
+	}
+	w.field("message")
+	w.String(e.Message)
+	w.field("random_id")
+	w.Long(e.RandomID)
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+	if e.Flags&8 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("schedule_date")
+		w.Int(e.ScheduleDate)
+	}
+}
+
+func (e TL_messages_sendMedia) writeText(w *textWriter) {
+	w.name("messages.sendMedia")
+	if e.Flags&32 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("background")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("clear_draft")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	if e.Flags&1 != 0 {
+		w.field("reply_to_msg_id")
+		w.Int(e.ReplyToMsgID)
+	}
+	w.field("media")
+	w.Object(e.Media)
+	w.field("message")
+	w.String(e.Message)
+	w.field("random_id")
+	w.Long(e.RandomID)
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+	if e.Flags&8 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("schedule_date")
+		w.Int(e.ScheduleDate)
+	}
+}
+
+func (e TL_messages_forwardMessages) writeText(w *textWriter) {
+	w.name("messages.forwardMessages")
+	if e.Flags&32 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("background")
+		w.Bool(true)
+	}
+	if e.Flags&256 != 0 {
+		w.field("with_my_score")
+		w.Bool(true)
+	}
+	w.field("from_peer")
+	w.Object(e.FromPeer)
+	w.field("id")
+	w.VectorInt(e.ID)
+	w.field("random_id")
+	w.VectorLong(e.RandomID)
+	w.field("to_peer")
+	w.Object(e.ToPeer)
+	if e.Flags&1024 != 0 {
+		w.field("schedule_date")
+		w.Int(e.ScheduleDate)
+	}
+}
+
+func (e TL_messages_reportSpam) writeText(w *textWriter) {
+	w.name("messages.reportSpam")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_getPeerSettings) writeText(w *textWriter) {
+	w.name("messages.getPeerSettings")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_report) writeText(w *textWriter) {
+	w.name("messages.report")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.VectorInt(e.ID)
+	w.field("reason")
+	w.Object(e.Reason)
+	w.field("message")
+	w.String(e.Message)
+}
+
+func (e TL_messages_getChats) writeText(w *textWriter) {
+	w.name("messages.getChats")
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_messages_getFullChat) writeText(w *textWriter) {
+	w.name("messages.getFullChat")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_messages_editChatTitle) writeText(w *textWriter) {
+	w.name("messages.editChatTitle")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("title")
+	w.String(e.Title)
+}
+
+func (e TL_messages_editChatPhoto) writeText(w *textWriter) {
+	w.name("messages.editChatPhoto")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("photo")
+	w.Object(e.Photo)
+}
+
+func (e TL_messages_addChatUser) writeText(w *textWriter) {
+	w.name("messages.addChatUser")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("fwd_limit")
+	w.Int(e.FwdLimit)
+}
+
+func (e TL_messages_deleteChatUser) writeText(w *textWriter) {
+	w.name("messages.deleteChatUser")
+	if e.Flags&1 != 0 {
+		w.field("revoke_history")
+		w.Bool(true)
+	}
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("user_id")
+	w.Object(e.UserID)
+}
+
+func (e TL_messages_createChat) writeText(w *textWriter) {
+	w.name("messages.createChat")
+	w.field("users")
+	w.Vector(e.Users)
+	w.field("title")
+	w.String(e.Title)
+}
+
+func (e TL_messages_getDhConfig) writeText(w *textWriter) {
+	w.name("messages.getDhConfig")
+	w.field("version")
+	w.Int(e.Version)
+	w.field("random_length")
+	w.Int(e.RandomLength)
+}
+
+func (e TL_messages_requestEncryption) writeText(w *textWriter) {
+	w.name("messages.requestEncryption")
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("random_id")
+	w.Int(e.RandomID)
+	w.field("g_a")
+	w.Bytes(e.GA)
+}
+
+func (e TL_messages_acceptEncryption) writeText(w *textWriter) {
+	w.name("messages.acceptEncryption")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("g_b")
+	w.Bytes(e.GB)
+	w.field("key_fingerprint")
+	w.Long(e.KeyFingerprint)
+}
+
+func (e TL_messages_discardEncryption) writeText(w *textWriter) {
+	w.name("messages.discardEncryption")
+	if e.Flags&1 != 0 {
+		w.field("delete_history")
+		w.Bool(true)
+	}
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_messages_setEncryptedTyping) writeText(w *textWriter) {
+	w.name("messages.setEncryptedTyping")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("typing")
+	w.Object(e.Typing)
+}
+
+func (e TL_messages_readEncryptedHistory) writeText(w *textWriter) {
+	w.name("messages.readEncryptedHistory")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("max_date")
+	w.Int(e.MaxDate)
+}
+
+func (e TL_messages_sendEncrypted) writeText(w *textWriter) {
+	w.name("messages.sendEncrypted")
+	if e.Flags&1 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("random_id")
+	w.Long(e.RandomID)
+	w.field("data")
+	w.Bytes(e.Data)
+}
+
+func (e TL_messages_sendEncryptedFile) writeText(w *textWriter) {
+	w.name("messages.sendEncryptedFile")
+	if e.Flags&1 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("random_id")
+	w.Long(e.RandomID)
+	w.field("data")
+	w.Bytes(e.Data)
+	w.field("file")
+	w.Object(e.File)
+}
+
+func (e TL_messages_sendEncryptedService) writeText(w *textWriter) {
+	w.name("messages.sendEncryptedService")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("random_id")
+	w.Long(e.RandomID)
+	w.field("data")
+	w.Bytes(e.Data)
+}
+
+func (e TL_messages_receivedQueue) writeText(w *textWriter) {
+	w.name("messages.receivedQueue")
+	w.field("max_qts")
+	w.Int(e.MaxQts)
+}
+
+func (e TL_messages_reportEncryptedSpam) writeText(w *textWriter) {
+	w.name("messages.reportEncryptedSpam")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_readMessageContents) writeText(w *textWriter) {
+	w.name("messages.readMessageContents")
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_messages_getStickers) writeText(w *textWriter) {
+	w.name("messages.getStickers")
+	w.field("emoticon")
+	w.String(e.Emoticon)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_getAllStickers) writeText(w *textWriter) {
+	w.name("messages.getAllStickers")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_getWebPagePreview) writeText(w *textWriter) {
+	w.name("messages.getWebPagePreview")
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&8 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+}
+
+func (e TL_messages_exportChatInvite) writeText(w *textWriter) {
+	w.name("messages.exportChatInvite")
+	if e.Flags&4 != 0 {
+		w.field("legacy_revoke_permanent")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	if e.Flags&1 != 0 {
+		w.field("expire_date")
+		w.Int(e.ExpireDate)
+	}
+	if e.Flags&2 != 0 {
+		w.field("usage_limit")
+		w.Int(e.UsageLimit)
+	}
+}
+
+func (e TL_messages_checkChatInvite) writeText(w *textWriter) {
+	w.name("messages.checkChatInvite")
+	w.field("hash")
+	w.String(e.Hash)
+}
+
+func (e TL_messages_importChatInvite) writeText(w *textWriter) {
+	w.name("messages.importChatInvite")
+	w.field("hash")
+	w.String(e.Hash)
+}
+
+func (e TL_messages_getStickerSet) writeText(w *textWriter) {
+	w.name("messages.getStickerSet")
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+}
+
+func (e TL_messages_installStickerSet) writeText(w *textWriter) {
+	w.name("messages.installStickerSet")
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+	w.field("archived")
+	w.Object(e.Archived)
+}
+
+func (e TL_messages_uninstallStickerSet) writeText(w *textWriter) {
+	w.name("messages.uninstallStickerSet")
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+}
+
+func (e TL_messages_startBot) writeText(w *textWriter) {
+	w.name("messages.startBot")
+	w.field("bot")
+	w.Object(e.Bot)
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("random_id")
+	w.Long(e.RandomID)
+	w.field("start_param")
+	w.String(e.StartParam)
+}
+
+func (e TL_messages_getMessagesViews) writeText(w *textWriter) {
+	w.name("messages.getMessagesViews")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.VectorInt(e.ID)
+	w.field("increment")
+	w.Object(e.Increment)
+}
+
+func (e TL_messages_editChatAdmin) writeText(w *textWriter) {
+	w.name("messages.editChatAdmin")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("is_admin")
+	w.Object(e.IsAdmin)
+}
+
+func (e TL_messages_migrateChat) writeText(w *textWriter) {
+	w.name("messages.migrateChat")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_messages_searchGlobal) writeText(w *textWriter) {
+	w.name("messages.searchGlobal")
+	if e.Flags&1 != 0 {
+		w.field("folder_id")
+		w.Int(e.FolderID)
+	}
+	w.field("q")
+	w.String(e.Q)
+	w.field("filter")
+	w.Object(e.Filter)
+	w.field("min_date")
+	w.Int(e.MinDate)
+	w.field("max_date")
+	w.Int(e.MaxDate)
+	w.field("offset_rate")
+	w.Int(e.OffsetRate)
+	w.field("offset_peer")
+	w.Object(e.OffsetPeer)
+	w.field("offset_id")
+	w.Int(e.OffsetID)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_messages_reorderStickerSets) writeText(w *textWriter) {
+	w.name("messages.reorderStickerSets")
+	if e.Flags&1 != 0 {
+		w.field("masks")
+		w.Bool(true)
+	}
+	w.field("order")
+	w.VectorLong(e.Order)
+}
+
+func (e TL_messages_getDocumentByHash) writeText(w *textWriter) {
+	w.name("messages.getDocumentByHash")
+	w.field("sha256")
+	w.Bytes(e.Sha256)
+	w.field("size")
+	w.Int(e.Size)
+	w.field("mime_type")
+	w.String(e.MimeType)
+}
+
+func (e TL_messages_getSavedGifs) writeText(w *textWriter) {
+	w.name("messages.getSavedGifs")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_saveGif) writeText(w *textWriter) {
+	w.name("messages.saveGif")
+	w.field("id")
+	w.Object(e.ID)
+	w.field("unsave")
+	w.Object(e.Unsave)
+}
+
+func (e TL_messages_getInlineBotResults) writeText(w *textWriter) {
+	w.name("messages.getInlineBotResults")
+	w.field("bot")
+	w.Object(e.Bot)
+	w.field("peer")
+	w.Object(e.Peer)
+	if e.Flags&1 != 0 {
+		w.field("geo_point")
+		w.Object(e.GeoPoint)
+	}
+	w.field("query")
+	w.String(e.Query)
+	w.field("offset")
+	w.String(e.Offset)
+}
+
+func (e TL_messages_setInlineBotResults) writeText(w *textWriter) {
+	w.name("messages.setInlineBotResults")
+	if e.Flags&1 != 0 {
+		w.field("gallery")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("private")
+		w.Bool(true)
+	}
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("results")
+	w.Vector(e.Results)
+	w.field("cache_time")
+	w.Int(e.CacheTime)
+	if e.Flags&4 != 0 {
+		w.field("next_offset")
+		w.String(e.NextOffset)
+	}
+	if e.Flags&8 != 0 {
+		w.field("switch_pm")
+		w.Object(e.SwitchPm)
+	}
+}
+
+func (e TL_messages_sendInlineBotResult) writeText(w *textWriter) {
+	w.name("messages.sendInlineBotResult")
+	if e.Flags&32 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("background")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("clear_draft")
+		w.Bool(true)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("hide_via")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	if e.Flags&1 != 0 {
+		w.field("reply_to_msg_id")
+		w.Int(e.ReplyToMsgID)
+	}
+	w.field("random_id")
+	w.Long(e.RandomID)
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("id")
+	w.String(e.ID)
+	if e.Flags&1024 != 0 {
+		w.field("schedule_date")
+		w.Int(e.ScheduleDate)
+	}
+}
+
+func (e TL_messages_getMessageEditData) writeText(w *textWriter) {
+	w.name("messages.getMessageEditData")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_messages_editMessage) writeText(w *textWriter) {
+	w.name("messages.editMessage")
+	if e.Flags&2 != 0 {
+		w.field("no_webpage")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&2048 != 0 {
+		w.field("message")
+		w.String(e.Message)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("media")
+		w.Object(e.Media)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+	if e.Flags&8 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("schedule_date")
+		w.Int(e.ScheduleDate)
+	}
+}
+
+func (e TL_messages_editInlineBotMessage) writeText(w *textWriter) {
+	w.name("messages.editInlineBotMessage")
+	if e.Flags&2 != 0 {
+		w.field("no_webpage")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Object(e.ID)
+	if e.Flags&2048 != 0 {
+		w.field("message")
+		w.String(e.Message)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("media")
+		w.Object(e.Media)
+	}
+	if e.Flags&4 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+	if e.Flags&8 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+}
+
+func (e TL_messages_getBotCallbackAnswer) writeText(w *textWriter) {
+	w.name("messages.getBotCallbackAnswer")
+	if e.Flags&2 != 0 {
+		w.field("game")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	if e.Flags&1 != 0 {
+		w.field("data")
+		w.Bytes(e.Data)
+	}
+	if e.Flags&4 != 0 {
+		w.field("password")
+		w.Object(e.Password)
+	}
+}
+
+func (e TL_messages_setBotCallbackAnswer) writeText(w *textWriter) {
+	w.name("messages.setBotCallbackAnswer")
+	if e.Flags&2 != 0 {
+		w.field("alert")
+		w.Bool(true)
+	}
+	w.field("query_id")
+	w.Long(e.QueryID)
+	if e.Flags&1 != 0 {
+		w.field("message")
+		w.String(e.Message)
+	}
+	if e.Flags&4 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+	w.field("cache_time")
+	w.Int(e.CacheTime)
+}
+
+func (e TL_messages_getPeerDialogs) writeText(w *textWriter) {
+	w.name("messages.getPeerDialogs")
+	w.field("peers")
+	w.Vector(e.Peers)
+}
+
+func (e TL_messages_saveDraft) writeText(w *textWriter) {
+	w.name("messages.saveDraft")
+	if e.Flags&2 != 0 {
+		w.field("no_webpage")
+		w.Bool(true)
+	}
+	if e.Flags&1 != 0 {
+		w.field("reply_to_msg_id")
+		w.Int(e.ReplyToMsgID)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&8 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+}
+
+func (e TL_messages_getAllDrafts) writeText(w *textWriter) {
+	w.name("messages.getAllDrafts")
+}
+
+func (e TL_messages_getFeaturedStickers) writeText(w *textWriter) {
+	w.name("messages.getFeaturedStickers")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_readFeaturedStickers) writeText(w *textWriter) {
+	w.name("messages.readFeaturedStickers")
+	w.field("id")
+	w.VectorLong(e.ID)
+}
+
+func (e TL_messages_getRecentStickers) writeText(w *textWriter) {
+	w.name("messages.getRecentStickers")
+	if e.Flags&1 != 0 {
+		w.field("attached")
+		w.Bool(true)
+	}
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_saveRecentSticker) writeText(w *textWriter) {
+	w.name("messages.saveRecentSticker")
+	if e.Flags&1 != 0 {
+		w.field("attached")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Object(e.ID)
+	w.field("unsave")
+	w.Object(e.Unsave)
+}
+
+func (e TL_messages_clearRecentStickers) writeText(w *textWriter) {
+	w.name("messages.clearRecentStickers")
+	if e.Flags&1 != 0 {
+		w.field("attached")
+		w.Bool(true)
+	}
+}
+
+func (e TL_messages_getArchivedStickers) writeText(w *textWriter) {
+	w.name("messages.getArchivedStickers")
+	if e.Flags&1 != 0 {
+		w.field("masks")
+		w.Bool(true)
+	}
+	w.field("offset_id")
+	w.Long(e.OffsetID)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_messages_getMaskStickers) writeText(w *textWriter) {
+	w.name("messages.getMaskStickers")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_getAttachedStickers) writeText(w *textWriter) {
+	w.name("messages.getAttachedStickers")
+	w.field("media")
+	w.Object(e.Media)
+}
+
+func (e TL_messages_setGameScore) writeText(w *textWriter) {
+	w.name("messages.setGameScore")
+	if e.Flags&1 != 0 {
+		w.field("edit_message")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("force")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.Int(e.ID)
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("score")
+	w.Int(e.Score)
+}
+
+func (e TL_messages_setInlineGameScore) writeText(w *textWriter) {
+	w.name("messages.setInlineGameScore")
+	if e.Flags&1 != 0 {
+		w.field("edit_message")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("force")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Object(e.ID)
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("score")
+	w.Int(e.Score)
+}
+
+func (e TL_messages_getGameHighScores) writeText(w *textWriter) {
+	w.name("messages.getGameHighScores")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.Int(e.ID)
+	w.field("user_id")
+	w.Object(e.UserID)
+}
+
+func (e TL_messages_getInlineGameHighScores) writeText(w *textWriter) {
+	w.name("messages.getInlineGameHighScores")
+	w.field("id")
+	w.Object(e.ID)
+	w.field("user_id")
+	w.Object(e.UserID)
+}
+
+func (e TL_messages_getCommonChats) writeText(w *textWriter) {
+	w.name("messages.getCommonChats")
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("max_id")
+	w.Int(e.MaxID)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_messages_getAllChats) writeText(w *textWriter) {
+	w.name("messages.getAllChats")
+	w.field("except_ids")
+	w.VectorInt(e.ExceptIds)
+}
+
+func (e TL_messages_getWebPage) writeText(w *textWriter) {
+	w.name("messages.getWebPage")
+	w.field("url")
+	w.String(e.Url)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_toggleDialogPin) writeText(w *textWriter) {
+	w.name("messages.toggleDialogPin")
+	if e.Flags&1 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_reorderPinnedDialogs) writeText(w *textWriter) {
+	w.name("messages.reorderPinnedDialogs")
+	if e.Flags&1 != 0 {
+		w.field("force")
+		w.Bool(true)
+	}
+	w.field("folder_id")
+	w.Int(e.FolderID)
+	w.field("order")
+	w.Vector(e.Order)
+}
+
+func (e TL_messages_getPinnedDialogs) writeText(w *textWriter) {
+	w.name("messages.getPinnedDialogs")
+	w.field("folder_id")
+	w.Int(e.FolderID)
+}
+
+func (e TL_messages_setBotShippingResults) writeText(w *textWriter) {
+	w.name("messages.setBotShippingResults")
+	w.field("query_id")
+	w.Long(e.QueryID)
+	if e.Flags&1 != 0 {
+		w.field("error")
+		w.String(e.Error)
+	}
+	if e.Flags&2 != 0 {
+		w.field("shipping_options")
+		w.Vector(e.ShippingOptions)
+	}
+}
+
+func (e TL_messages_setBotPrecheckoutResults) writeText(w *textWriter) {
+	w.name("messages.setBotPrecheckoutResults")
+	if e.Flags&2 != 0 {
+		w.field("success")
+		w.Bool(true)
+	}
+	w.field("query_id")
+	w.Long(e.QueryID)
+	if e.Flags&1 != 0 {
+		w.field("error")
+		w.String(e.Error)
+	}
+}
+
+func (e TL_messages_uploadMedia) writeText(w *textWriter) {
+	w.name("messages.uploadMedia")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("media")
+	w.Object(e.Media)
+}
+
+func (e TL_messages_sendScreenshotNotification) writeText(w *textWriter) {
+	w.name("messages.sendScreenshotNotification")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("reply_to_msg_id")
+	w.Int(e.ReplyToMsgID)
+	w.field("random_id")
+	w.Long(e.RandomID)
+}
+
+func (e TL_messages_getFavedStickers) writeText(w *textWriter) {
+	w.name("messages.getFavedStickers")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_faveSticker) writeText(w *textWriter) {
+	w.name("messages.faveSticker")
+	w.field("id")
+	w.Object(e.ID)
+	w.field("unfave")
+	w.Object(e.Unfave)
+}
+
+func (e TL_messages_getUnreadMentions) writeText(w *textWriter) {
+	w.name("messages.getUnreadMentions")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("offset_id")
+	w.Int(e.OffsetID)
+	w.field("add_offset")
+	w.Int(e.AddOffset)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("max_id")
+	w.Int(e.MaxID)
+	w.field("min_id")
+	w.Int(e.MinID)
+}
+
+func (e TL_messages_readMentions) writeText(w *textWriter) {
+	w.name("messages.readMentions")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_getRecentLocations) writeText(w *textWriter) {
+	w.name("messages.getRecentLocations")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_sendMultiMedia) writeText(w *textWriter) {
+	w.name("messages.sendMultiMedia")
+	if e.Flags&32 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&64 != 0 {
+		w.field("background")
+		w.Bool(true)
+	}
+	if e.Flags&128 != 0 {
+		w.field("clear_draft")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	if e.Flags&1 != 0 {
+		w.field("reply_to_msg_id")
+		w.Int(e.ReplyToMsgID)
+	}
+	w.field("multi_media")
+	w.Vector(e.MultiMedia)
+	if e.Flags&1024 != 0 {
+		w.field("schedule_date")
+		w.Int(e.ScheduleDate)
+	}
+}
+
+func (e TL_messages_uploadEncryptedFile) writeText(w *textWriter) {
+	w.name("messages.uploadEncryptedFile")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("file")
+	w.Object(e.File)
+}
+
+func (e TL_messages_searchStickerSets) writeText(w *textWriter) {
+	w.name("messages.searchStickerSets")
+	if e.Flags&1 != 0 {
+		w.field("exclude_featured")
+		w.Bool(true)
+	}
+	w.field("q")
+	w.String(e.Q)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_getSplitRanges) writeText(w *textWriter) {
+	w.name("messages.getSplitRanges")
+}
+
+func (e TL_messages_markDialogUnread) writeText(w *textWriter) {
+	w.name("messages.markDialogUnread")
+	if e.Flags&1 != 0 {
+		w.field("unread")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_getDialogUnreadMarks) writeText(w *textWriter) {
+	w.name("messages.getDialogUnreadMarks")
+}
+
+func (e TL_messages_clearAllDrafts) writeText(w *textWriter) {
+	w.name("messages.clearAllDrafts")
+}
+
+func (e TL_messages_updatePinnedMessage) writeText(w *textWriter) {
+	w.name("messages.updatePinnedMessage")
+	if e.Flags&1 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("unpin")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("pm_oneside")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_messages_sendVote) writeText(w *textWriter) {
+	w.name("messages.sendVote")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("options")
+	w.VectorBytes(e.Options)
+}
+
+func (e TL_messages_getPollResults) writeText(w *textWriter) {
+	w.name("messages.getPollResults")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+}
+
+func (e TL_messages_getOnlines) writeText(w *textWriter) {
+	w.name("messages.getOnlines")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_getStatsURL) writeText(w *textWriter) {
+	w.name("messages.getStatsURL")
+	if e.Flags&1 != 0 {
+		w.field("dark")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("params")
+	w.String(e.Params)
+}
+
+func (e TL_messages_editChatAbout) writeText(w *textWriter) {
+	w.name("messages.editChatAbout")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("about")
+	w.String(e.About)
+}
+
+func (e TL_messages_editChatDefaultBannedRights) writeText(w *textWriter) {
+	w.name("messages.editChatDefaultBannedRights")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("banned_rights")
+	w.Object(e.BannedRights)
+}
+
+func (e TL_messages_getEmojiKeywords) writeText(w *textWriter) {
+	w.name("messages.getEmojiKeywords")
+	w.field("lang_code")
+	w.String(e.LangCode)
+}
+
+func (e TL_messages_getEmojiKeywordsDifference) writeText(w *textWriter) {
+	w.name("messages.getEmojiKeywordsDifference")
+	w.field("lang_code")
+	w.String(e.LangCode)
+	w.field("from_version")
+	w.Int(e.FromVersion)
+}
+
+func (e TL_messages_getEmojiKeywordsLanguages) writeText(w *textWriter) {
+	w.name("messages.getEmojiKeywordsLanguages")
+	w.field("lang_codes")
+	w.VectorString(e.LangCodes)
+}
+
+func (e TL_messages_getEmojiURL) writeText(w *textWriter) {
+	w.name("messages.getEmojiURL")
+	w.field("lang_code")
+	w.String(e.LangCode)
+}
+
+func (e TL_messages_getSearchCounters) writeText(w *textWriter) {
+	w.name("messages.getSearchCounters")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("filters")
+	w.Vector(e.Filters)
+}
+
+func (e TL_messages_requestUrlAuth) writeText(w *textWriter) {
+	w.name("messages.requestUrlAuth")
+	if e.Flags&2 != 0 {
+		w.field("peer")
+		w.Object(e.Peer)
+	}
+	if e.Flags&2 != 0 {
+		w.field("msg_id")
+		w.Int(e.MsgID)
+	}
+	if e.Flags&2 != 0 {
+		w.field("button_id")
+		w.Int(e.ButtonID)
+	}
+	if e.Flags&4 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+}
+
+func (e TL_messages_acceptUrlAuth) writeText(w *textWriter) {
+	w.name("messages.acceptUrlAuth")
+	if e.Flags&1 != 0 {
+		w.field("write_allowed")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("peer")
+		w.Object(e.Peer)
+	}
+	if e.Flags&2 != 0 {
+		w.field("msg_id")
+		w.Int(e.MsgID)
+	}
+	if e.Flags&2 != 0 {
+		w.field("button_id")
+		w.Int(e.ButtonID)
+	}
+	if e.Flags&4 != 0 {
+		w.field("url")
+		w.String(e.Url)
+	}
+}
+
+func (e TL_messages_hidePeerSettingsBar) writeText(w *textWriter) {
+	w.name("messages.hidePeerSettingsBar")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_getScheduledHistory) writeText(w *textWriter) {
+	w.name("messages.getScheduledHistory")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_getScheduledMessages) writeText(w *textWriter) {
+	w.name("messages.getScheduledMessages")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_messages_sendScheduledMessages) writeText(w *textWriter) {
+	w.name("messages.sendScheduledMessages")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_messages_deleteScheduledMessages) writeText(w *textWriter) {
+	w.name("messages.deleteScheduledMessages")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_messages_getPollVotes) writeText(w *textWriter) {
+	w.name("messages.getPollVotes")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("option")
+		w.Bytes(e.Option)
+	}
+	if e.Flags&2 != 0 {
+		w.field("offset")
+		w.String(e.Offset)
+	}
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_messages_toggleStickerSets) writeText(w *textWriter) {
+	w.name("messages.toggleStickerSets")
+	if e.Flags&1 != 0 {
+		w.field("uninstall")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("archive")
+		w.Bool(true)
+	}
+	if e.Flags&4 != 0 {
+		w.field("unarchive")
+		w.Bool(true)
+	}
+	w.field("stickersets")
+	w.Vector(e.Stickersets)
+}
+
+func (e TL_messages_getDialogFilters) writeText(w *textWriter) {
+	w.name("messages.getDialogFilters")
+}
+
+func (e TL_messages_getSuggestedDialogFilters) writeText(w *textWriter) {
+	w.name("messages.getSuggestedDialogFilters")
+}
+
+func (e TL_messages_updateDialogFilter) writeText(w *textWriter) {
+	w.name("messages.updateDialogFilter")
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&1 != 0 {
+		w.field("filter")
+		w.Object(e.Filter)
+	}
+}
+
+func (e TL_messages_updateDialogFiltersOrder) writeText(w *textWriter) {
+	w.name("messages.updateDialogFiltersOrder")
+	w.field("order")
+	w.VectorInt(e.Order)
+}
+
+func (e TL_messages_getOldFeaturedStickers) writeText(w *textWriter) {
+	w.name("messages.getOldFeaturedStickers")
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_getReplies) writeText(w *textWriter) {
+	w.name("messages.getReplies")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("offset_id")
+	w.Int(e.OffsetID)
+	w.field("offset_date")
+	w.Int(e.OffsetDate)
+	w.field("add_offset")
+	w.Int(e.AddOffset)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("max_id")
+	w.Int(e.MaxID)
+	w.field("min_id")
+	w.Int(e.MinID)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_messages_getDiscussionMessage) writeText(w *textWriter) {
+	w.name("messages.getDiscussionMessage")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+}
+
+func (e TL_messages_readDiscussion) writeText(w *textWriter) {
+	w.name("messages.readDiscussion")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("read_max_id")
+	w.Int(e.ReadMaxID)
+}
+
+func (e TL_messages_unpinAllMessages) writeText(w *textWriter) {
+	w.name("messages.unpinAllMessages")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_deleteChat) writeText(w *textWriter) {
+	w.name("messages.deleteChat")
+	w.field("chat_id")
+	w.Int(e.ChatID)
+}
+
+func (e TL_messages_deletePhoneCallHistory) writeText(w *textWriter) {
+	w.name("messages.deletePhoneCallHistory")
+	if e.Flags&1 != 0 {
+		w.field("revoke")
+		w.Bool(true)
+	}
+}
+
+func (e TL_messages_checkHistoryImport) writeText(w *textWriter) {
+	w.name("messages.checkHistoryImport")
+	w.field("import_head")
+	w.String(e.ImportHead)
+}
+
+func (e TL_messages_initHistoryImport) writeText(w *textWriter) {
+	w.name("messages.initHistoryImport")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("file")
+	w.Object(e.File)
+	w.field("media_count")
+	w.Int(e.MediaCount)
+}
+
+func (e TL_messages_uploadImportedMedia) writeText(w *textWriter) {
+	w.name("messages.uploadImportedMedia")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("import_id")
+	w.Long(e.ImportID)
+	w.field("file_name")
+	w.String(e.FileName)
+	w.field("media")
+	w.Object(e.Media)
+}
+
+func (e TL_messages_startHistoryImport) writeText(w *textWriter) {
+	w.name("messages.startHistoryImport")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("import_id")
+	w.Long(e.ImportID)
+}
+
+func (e TL_messages_getExportedChatInvites) writeText(w *textWriter) {
+	w.name("messages.getExportedChatInvites")
+	if e.Flags&8 != 0 {
+		w.field("revoked")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("admin_id")
+	w.Object(e.AdminID)
+	if e.Flags&4 != 0 {
+		w.field("offset_date")
+		w.Int(e.OffsetDate)
+	}
+	if e.Flags&4 != 0 {
+		w.field("offset_link")
+		w.String(e.OffsetLink)
+	}
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_messages_getExportedChatInvite) writeText(w *textWriter) {
+	w.name("messages.getExportedChatInvite")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("link")
+	w.String(e.Link)
+}
+
+func (e TL_messages_editExportedChatInvite) writeText(w *textWriter) {
+	w.name("messages.editExportedChatInvite")
+	if e.Flags&4 != 0 {
+		w.field("revoked")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("link")
+	w.String(e.Link)
+	if e.Flags&1 != 0 {
+		w.field("expire_date")
+		w.Int(e.ExpireDate)
+	}
+	if e.Flags&2 != 0 {
+		w.field("usage_limit")
+		w.Int(e.UsageLimit)
+	}
+}
+
+func (e TL_messages_deleteRevokedExportedChatInvites) writeText(w *textWriter) {
+	w.name("messages.deleteRevokedExportedChatInvites")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("admin_id")
+	w.Object(e.AdminID)
+}
+
+func (e TL_messages_deleteExportedChatInvite) writeText(w *textWriter) {
+	w.name("messages.deleteExportedChatInvite")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("link")
+	w.String(e.Link)
+}
+
+func (e TL_messages_getAdminsWithInvites) writeText(w *textWriter) {
+	w.name("messages.getAdminsWithInvites")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_messages_getChatInviteImporters) writeText(w *textWriter) {
+	w.name("messages.getChatInviteImporters")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("link")
+	w.String(e.Link)
+	w.field("offset_date")
+	w.Int(e.OffsetDate)
+	w.field("offset_user")
+	w.Object(e.OffsetUser)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_messages_setHistoryTTL) writeText(w *textWriter) {
+	w.name("messages.setHistoryTTL")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("period")
+	w.Int(e.Period)
+}
+
+func (e TL_messages_checkHistoryImportPeer) writeText(w *textWriter) {
+	w.name("messages.checkHistoryImportPeer")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_updates_getState) writeText(w *textWriter) {
+	w.name("updates.getState")
+}
+
+func (e TL_updates_getDifference) writeText(w *textWriter) {
+	w.name("updates.getDifference")
+	w.field("pts")
+	w.Int(e.Pts)
+	if e.Flags&1 != 0 {
+		w.field("pts_total_limit")
+		w.Int(e.PtsTotalLimit)
+	}
+	w.field("date")
+	w.Int(e.Date)
+	w.field("qts")
+	w.Int(e.Qts)
+}
+
+func (e TL_updates_getChannelDifference) writeText(w *textWriter) {
+	w.name("updates.getChannelDifference")
+	if e.Flags&1 != 0 {
+		w.field("force")
+		w.Bool(true)
+	}
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("filter")
+	w.Object(e.Filter)
+	w.field("pts")
+	w.Int(e.Pts)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_photos_updateProfilePhoto) writeText(w *textWriter) {
+	w.name("photos.updateProfilePhoto")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_photos_uploadProfilePhoto) writeText(w *textWriter) {
+	w.name("photos.uploadProfilePhoto")
+	if e.Flags&1 != 0 {
+		w.field("file")
+		w.Object(e.File)
+	}
+	if e.Flags&2 != 0 {
+		w.field("video")
+		w.Object(e.Video)
+	}
+	if e.Flags&4 != 0 {
+		w.field("video_start_ts")
+		w.Double(e.VideoStartTs)
+	}
+}
+
+func (e TL_photos_deletePhotos) writeText(w *textWriter) {
+	w.name("photos.deletePhotos")
+	w.field("id")
+	w.Vector(e.ID)
+}
+
+func (e TL_photos_getUserPhotos) writeText(w *textWriter) {
+	w.name("photos.getUserPhotos")
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("max_id")
+	w.Long(e.MaxID)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_upload_saveFilePart) writeText(w *textWriter) {
+	w.name("upload.saveFilePart")
+	w.field("file_id")
+	w.Long(e.FileID)
+	w.field("file_part")
+	w.Int(e.FilePart)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_upload_getFile) writeText(w *textWriter) {
+	w.name("upload.getFile")
+	if e.Flags&1 != 0 {
+		w.field("precise")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("cdn_supported")
+		w.Bool(true)
+	}
+	w.field("location")
+	w.Object(e.Location)
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_upload_saveBigFilePart) writeText(w *textWriter) {
+	w.name("upload.saveBigFilePart")
+	w.field("file_id")
+	w.Long(e.FileID)
+	w.field("file_part")
+	w.Int(e.FilePart)
+	w.field("file_total_parts")
+	w.Int(e.FileTotalParts)
+	w.field("bytes")
+	w.Bytes(e.Bytes)
+}
+
+func (e TL_upload_getWebFile) writeText(w *textWriter) {
+	w.name("upload.getWebFile")
+	w.field("location")
+	w.Object(e.Location)
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_upload_getCdnFile) writeText(w *textWriter) {
+	w.name("upload.getCdnFile")
+	w.field("file_token")
+	w.Bytes(e.FileToken)
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_upload_reuploadCdnFile) writeText(w *textWriter) {
+	w.name("upload.reuploadCdnFile")
+	w.field("file_token")
+	w.Bytes(e.FileToken)
+	w.field("request_token")
+	w.Bytes(e.RequestToken)
+}
+
+func (e TL_upload_getCdnFileHashes) writeText(w *textWriter) {
+	w.name("upload.getCdnFileHashes")
+	w.field("file_token")
+	w.Bytes(e.FileToken)
+	w.field("offset")
+	w.Int(e.Offset)
+}
+
+func (e TL_upload_getFileHashes) writeText(w *textWriter) {
+	w.name("upload.getFileHashes")
+	w.field("location")
+	w.Object(e.Location)
+	w.field("offset")
+	w.Int(e.Offset)
+}
+
+func (e TL_help_getConfig) writeText(w *textWriter) {
+	w.name("help.getConfig")
+}
+
+func (e TL_help_getNearestDc) writeText(w *textWriter) {
+	w.name("help.getNearestDc")
+}
+
+func (e TL_help_getAppUpdate) writeText(w *textWriter) {
+	w.name("help.getAppUpdate")
+	w.field("source")
+	w.String(e.Source)
+}
+
+func (e TL_help_getInviteText) writeText(w *textWriter) {
+	w.name("help.getInviteText")
+}
+
+func (e TL_help_getSupport) writeText(w *textWriter) {
+	w.name("help.getSupport")
+}
+
+func (e TL_help_getAppChangelog) writeText(w *textWriter) {
+	w.name("help.getAppChangelog")
+	w.field("prev_app_version")
+	w.String(e.PrevAppVersion)
+}
+
+func (e TL_help_setBotUpdatesStatus) writeText(w *textWriter) {
+	w.name("help.setBotUpdatesStatus")
+	w.field("pending_updates_count")
+	w.Int(e.PendingUpdatesCount)
+	w.field("message")
+	w.String(e.Message)
+}
+
+func (e TL_help_getCdnConfig) writeText(w *textWriter) {
+	w.name("help.getCdnConfig")
+}
+
+func (e TL_help_getRecentMeUrls) writeText(w *textWriter) {
+	w.name("help.getRecentMeUrls")
+	w.field("referer")
+	w.String(e.Referer)
+}
+
+func (e TL_help_getTermsOfServiceUpdate) writeText(w *textWriter) {
+	w.name("help.getTermsOfServiceUpdate")
+}
+
+func (e TL_help_acceptTermsOfService) writeText(w *textWriter) {
+	w.name("help.acceptTermsOfService")
+	w.field("id")
+	w.Object(e.ID)
+}
+
+func (e TL_help_getDeepLinkInfo) writeText(w *textWriter) {
+	w.name("help.getDeepLinkInfo")
+	w.field("path")
+	w.String(e.Path)
+}
+
+func (e TL_help_getAppConfig) writeText(w *textWriter) {
+	w.name("help.getAppConfig")
+}
+
+func (e TL_help_saveAppLog) writeText(w *textWriter) {
+	w.name("help.saveAppLog")
+	w.field("events")
+	w.Vector(e.Events)
+}
+
+func (e TL_help_getPassportConfig) writeText(w *textWriter) {
+	w.name("help.getPassportConfig")
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_help_getSupportName) writeText(w *textWriter) {
+	w.name("help.getSupportName")
+}
+
+func (e TL_help_getUserInfo) writeText(w *textWriter) {
+	w.name("help.getUserInfo")
+	w.field("user_id")
+	w.Object(e.UserID)
+}
+
+func (e TL_help_editUserInfo) writeText(w *textWriter) {
+	w.name("help.editUserInfo")
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("message")
+	w.String(e.Message)
+	w.field("entities")
+	w.Vector(e.Entities)
+}
+
+func (e TL_help_getPromoData) writeText(w *textWriter) {
+	w.name("help.getPromoData")
+}
+
+func (e TL_help_hidePromoData) writeText(w *textWriter) {
+	w.name("help.hidePromoData")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_help_dismissSuggestion) writeText(w *textWriter) {
+	w.name("help.dismissSuggestion")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("suggestion")
+	w.String(e.Suggestion)
+}
+
+func (e TL_help_getCountriesList) writeText(w *textWriter) {
+	w.name("help.getCountriesList")
+	w.field("lang_code")
+	w.String(e.LangCode)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_channels_readHistory) writeText(w *textWriter) {
+	w.name("channels.readHistory")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("max_id")
+	w.Int(e.MaxID)
+}
+
+func (e TL_channels_deleteMessages) writeText(w *textWriter) {
+	w.name("channels.deleteMessages")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_channels_deleteUserHistory) writeText(w *textWriter) {
+	w.name("channels.deleteUserHistory")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("user_id")
+	w.Object(e.UserID)
+}
+
+func (e TL_channels_reportSpam) writeText(w *textWriter) {
+	w.name("channels.reportSpam")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_channels_getMessages) writeText(w *textWriter) {
+	w.name("channels.getMessages")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("id")
+	w.Vector(e.ID)
+}
+
+func (e TL_channels_getParticipants) writeText(w *textWriter) {
+	w.name("channels.getParticipants")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("filter")
+	w.Object(e.Filter)
+	w.field("offset")
+	w.Int(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+	w.field("hash")
+	w.Int(e.Hash)
+}
+
+func (e TL_channels_getParticipant) writeText(w *textWriter) {
+	w.name("channels.getParticipant")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("participant")
+	w.Object(e.Participant)
+}
+
+func (e TL_channels_getChannels) writeText(w *textWriter) {
+	w.name("channels.getChannels")
+	w.field("id")
+	w.Vector(e.ID)
+}
+
+func (e TL_channels_getFullChannel) writeText(w *textWriter) {
+	w.name("channels.getFullChannel")
+	w.field("channel")
+	w.Object(e.Channel)
+}
+
+func (e TL_channels_createChannel) writeText(w *textWriter) {
+	w.name("channels.createChannel")
+	if e.Flags&1 != 0 {
+		w.field("broadcast")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("megagroup")
+		w.Bool(true)
+	}
+	if e.Flags&8 != 0 {
+		w.field("for_import")
+		w.Bool(true)
+	}
+	w.field("title")
+	w.String(e.Title)
+	w.field("about")
+	w.String(e.About)
+	if e.Flags&4 != 0 {
+		w.field("geo_point")
+		w.Object(e.GeoPoint)
+	}
+	if e.Flags&4 != 0 {
+		w.field("address")
+		w.String(e.Address)
+	}
+}
+
+func (e TL_channels_editAdmin) writeText(w *textWriter) {
+	w.name("channels.editAdmin")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("admin_rights")
+	w.Object(e.AdminRights)
+	w.field("rank")
+	w.String(e.Rank)
+}
+
+func (e TL_channels_editTitle) writeText(w *textWriter) {
+	w.name("channels.editTitle")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("title")
+	w.String(e.Title)
+}
+
+func (e TL_channels_editPhoto) writeText(w *textWriter) {
+	w.name("channels.editPhoto")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("photo")
+	w.Object(e.Photo)
+}
+
+func (e TL_channels_checkUsername) writeText(w *textWriter) {
+	w.name("channels.checkUsername")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("username")
+	w.String(e.Username)
+}
+
+func (e TL_channels_updateUsername) writeText(w *textWriter) {
+	w.name("channels.updateUsername")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("username")
+	w.String(e.Username)
+}
+
+func (e TL_channels_joinChannel) writeText(w *textWriter) {
+	w.name("channels.joinChannel")
+	w.field("channel")
+	w.Object(e.Channel)
+}
+
+func (e TL_channels_leaveChannel) writeText(w *textWriter) {
+	w.name("channels.leaveChannel")
+	w.field("channel")
+	w.Object(e.Channel)
+}
+
+func (e TL_channels_inviteToChannel) writeText(w *textWriter) {
+	w.name("channels.inviteToChannel")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_channels_deleteChannel) writeText(w *textWriter) {
+	w.name("channels.deleteChannel")
+	w.field("channel")
+	w.Object(e.Channel)
+}
+
+func (e TL_channels_exportMessageLink) writeText(w *textWriter) {
+	w.name("channels.exportMessageLink")
+	if e.Flags&1 != 0 {
+		w.field("grouped")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("thread")
+		w.Bool(true)
+	}
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("id")
+	w.Int(e.ID)
+}
+
+func (e TL_channels_toggleSignatures) writeText(w *textWriter) {
+	w.name("channels.toggleSignatures")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("enabled")
+	w.Object(e.Enabled)
+}
+
+func (e TL_channels_getAdminedPublicChannels) writeText(w *textWriter) {
+	w.name("channels.getAdminedPublicChannels")
+	if e.Flags&1 != 0 {
+		w.field("by_location")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("check_limit")
+		w.Bool(true)
+	}
+}
+
+func (e TL_channels_editBanned) writeText(w *textWriter) {
+	w.name("channels.editBanned")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("participant")
+	w.Object(e.Participant)
+	w.field("banned_rights")
+	w.Object(e.BannedRights)
+}
+
+func (e TL_channels_getAdminLog) writeText(w *textWriter) {
+	w.name("channels.getAdminLog")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("q")
+	w.String(e.Q)
+	if e.Flags&1 != 0 {
+		w.field("events_filter")
+		w.Object(e.EventsFilter)
+	}
+	if e.Flags&2 != 0 {
+		w.field("admins")
+		w.Vector(e.Admins)
+	}
+	w.field("max_id")
+	w.Long(e.MaxID)
+	w.field("min_id")
+	w.Long(e.MinID)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_channels_setStickers) writeText(w *textWriter) {
+	w.name("channels.setStickers")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+}
+
+func (e TL_channels_readMessageContents) writeText(w *textWriter) {
+	w.name("channels.readMessageContents")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("id")
+	w.VectorInt(e.ID)
+}
+
+func (e TL_channels_deleteHistory) writeText(w *textWriter) {
+	w.name("channels.deleteHistory")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("max_id")
+	w.Int(e.MaxID)
+}
+
+func (e TL_channels_togglePreHistoryHidden) writeText(w *textWriter) {
+	w.name("channels.togglePreHistoryHidden")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("enabled")
+	w.Object(e.Enabled)
+}
+
+func (e TL_channels_getLeftChannels) writeText(w *textWriter) {
+	w.name("channels.getLeftChannels")
+	w.field("offset")
+	w.Int(e.Offset)
+}
+
+func (e TL_channels_getGroupsForDiscussion) writeText(w *textWriter) {
+	w.name("channels.getGroupsForDiscussion")
+}
+
+func (e TL_channels_setDiscussionGroup) writeText(w *textWriter) {
+	w.name("channels.setDiscussionGroup")
+	w.field("broadcast")
+	w.Object(e.Broadcast)
+	w.field("group")
+	w.Object(e.Group)
+}
+
+func (e TL_channels_editCreator) writeText(w *textWriter) {
+	w.name("channels.editCreator")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("password")
+	w.Object(e.Password)
+}
+
+func (e TL_channels_editLocation) writeText(w *textWriter) {
+	w.name("channels.editLocation")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("geo_point")
+	w.Object(e.GeoPoint)
+	w.field("address")
+	w.String(e.Address)
+}
+
+func (e TL_channels_toggleSlowMode) writeText(w *textWriter) {
+	w.name("channels.toggleSlowMode")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("seconds")
+	w.Int(e.Seconds)
+}
+
+func (e TL_channels_getInactiveChannels) writeText(w *textWriter) {
+	w.name("channels.getInactiveChannels")
+}
+
+func (e TL_channels_convertToGigagroup) writeText(w *textWriter) {
+	w.name("channels.convertToGigagroup")
+	w.field("channel")
+	w.Object(e.Channel)
+}
+
+func (e TL_bots_sendCustomRequest) writeText(w *textWriter) {
+	w.name("bots.sendCustomRequest")
+	w.field("custom_method")
+	w.String(e.CustomMethod)
+	w.field("params")
+	w.Object(e.Params)
+}
+
+func (e TL_bots_answerWebhookJSONQuery) writeText(w *textWriter) {
+	w.name("bots.answerWebhookJSONQuery")
+	w.field("query_id")
+	w.Long(e.QueryID)
+	w.field("data")
+	w.Object(e.Data)
+}
+
+func (e TL_bots_setBotCommands) writeText(w *textWriter) {
+	w.name("bots.setBotCommands")
+	w.field("commands")
+	w.Vector(e.Commands)
+}
+
+func (e TL_payments_getPaymentForm) writeText(w *textWriter) {
+	w.name("payments.getPaymentForm")
+	w.field("msg_id")
+	w.Int(e.MsgID)
+}
+
+func (e TL_payments_getPaymentReceipt) writeText(w *textWriter) {
+	w.name("payments.getPaymentReceipt")
+	w.field("msg_id")
+	w.Int(e.MsgID)
+}
+
+func (e TL_payments_validateRequestedInfo) writeText(w *textWriter) {
+	w.name("payments.validateRequestedInfo")
+	if e.Flags&1 != 0 {
+		w.field("save")
+		w.Bool(true)
+	}
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("info")
+	w.Object(e.Info)
+}
+
+func (e TL_payments_sendPaymentForm) writeText(w *textWriter) {
+	w.name("payments.sendPaymentForm")
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	if e.Flags&1 != 0 {
+		w.field("requested_info_id")
+		w.String(e.RequestedInfoID)
+	}
+	if e.Flags&2 != 0 {
+		w.field("shipping_option_id")
+		w.String(e.ShippingOptionID)
+	}
+	w.field("credentials")
+	w.Object(e.Credentials)
+}
+
+func (e TL_payments_getSavedInfo) writeText(w *textWriter) {
+	w.name("payments.getSavedInfo")
+}
+
+func (e TL_payments_clearSavedInfo) writeText(w *textWriter) {
+	w.name("payments.clearSavedInfo")
+	if e.Flags&1 != 0 {
+		w.field("credentials")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("info")
+		w.Bool(true)
+	}
+}
+
+func (e TL_payments_getBankCardData) writeText(w *textWriter) {
+	w.name("payments.getBankCardData")
+	w.field("number")
+	w.String(e.Number)
+}
+
+func (e TL_stickers_createStickerSet) writeText(w *textWriter) {
+	w.name("stickers.createStickerSet")
+	if e.Flags&1 != 0 {
+		w.field("masks")
+		w.Bool(true)
+	}
+	if e.Flags&2 != 0 {
+		w.field("animated")
+		w.Bool(true)
+	}
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("title")
+	w.String(e.Title)
+	w.field("short_name")
+	w.String(e.ShortName)
+	if e.Flags&4 != 0 {
+		w.field("thumb")
+		w.Object(e.Thumb)
+	}
+	w.field("stickers")
+	w.Vector(e.Stickers)
+}
+
+func (e TL_stickers_removeStickerFromSet) writeText(w *textWriter) {
+	w.name("stickers.removeStickerFromSet")
+	w.field("sticker")
+	w.Object(e.Sticker)
+}
+
+func (e TL_stickers_changeStickerPosition) writeText(w *textWriter) {
+	w.name("stickers.changeStickerPosition")
+	w.field("sticker")
+	w.Object(e.Sticker)
+	w.field("position")
+	w.Int(e.Position)
+}
+
+func (e TL_stickers_addStickerToSet) writeText(w *textWriter) {
+	w.name("stickers.addStickerToSet")
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+	w.field("sticker")
+	w.Object(e.Sticker)
+}
+
+func (e TL_stickers_setStickerSetThumb) writeText(w *textWriter) {
+	w.name("stickers.setStickerSetThumb")
+	w.field("stickerset")
+	w.Object(e.Stickerset)
+	w.field("thumb")
+	w.Object(e.Thumb)
+}
+
+func (e TL_phone_getCallConfig) writeText(w *textWriter) {
+	w.name("phone.getCallConfig")
+}
+
+func (e TL_phone_requestCall) writeText(w *textWriter) {
+	w.name("phone.requestCall")
+	if e.Flags&1 != 0 {
+		w.field("video")
+		w.Bool(true)
+	}
+	w.field("user_id")
+	w.Object(e.UserID)
+	w.field("random_id")
+	w.Int(e.RandomID)
+	w.field("g_a_hash")
+	w.Bytes(e.GAHash)
+	w.field("protocol")
+	w.Object(e.Protocol)
+}
+
+func (e TL_phone_acceptCall) writeText(w *textWriter) {
+	w.name("phone.acceptCall")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("g_b")
+	w.Bytes(e.GB)
+	w.field("protocol")
+	w.Object(e.Protocol)
+}
+
+func (e TL_phone_confirmCall) writeText(w *textWriter) {
+	w.name("phone.confirmCall")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("g_a")
+	w.Bytes(e.GA)
+	w.field("key_fingerprint")
+	w.Long(e.KeyFingerprint)
+	w.field("protocol")
+	w.Object(e.Protocol)
+}
+
+func (e TL_phone_receivedCall) writeText(w *textWriter) {
+	w.name("phone.receivedCall")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_phone_discardCall) writeText(w *textWriter) {
+	w.name("phone.discardCall")
+	if e.Flags&1 != 0 {
+		w.field("video")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("duration")
+	w.Int(e.Duration)
+	w.field("reason")
+	w.Object(e.Reason)
+	w.field("connection_id")
+	w.Long(e.ConnectionID)
+}
+
+func (e TL_phone_setCallRating) writeText(w *textWriter) {
+	w.name("phone.setCallRating")
+	if e.Flags&1 != 0 {
+		w.field("user_initiative")
+		w.Bool(true)
+	}
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("rating")
+	w.Int(e.Rating)
+	w.field("comment")
+	w.String(e.Comment)
+}
+
+func (e TL_phone_saveCallDebug) writeText(w *textWriter) {
+	w.name("phone.saveCallDebug")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("debug")
+	w.Object(e.Debug)
+}
+
+func (e TL_phone_sendSignalingData) writeText(w *textWriter) {
+	w.name("phone.sendSignalingData")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("data")
+	w.Bytes(e.Data)
+}
+
+func (e TL_phone_createGroupCall) writeText(w *textWriter) {
+	w.name("phone.createGroupCall")
+	w.field("peer")
+	w.Object(e.Peer)
+	w.field("random_id")
+	w.Int(e.RandomID)
+}
+
+func (e TL_phone_joinGroupCall) writeText(w *textWriter) {
+	w.name("phone.joinGroupCall")
+	if e.Flags&1 != 0 {
+		w.field("muted")
+		w.Bool(true)
+	}
+	w.field("call")
+	w.Object(e.Call)
+	w.field("join_as")
+	w.Object(e.JoinAs)
+	if e.Flags&2 != 0 {
+		w.field("invite_hash")
+		w.String(e.InviteHash)
+	}
+	w.field("params")
+	w.Object(e.Params)
+}
+
+func (e TL_phone_leaveGroupCall) writeText(w *textWriter) {
+	w.name("phone.leaveGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("source")
+	w.Int(e.Source)
+}
+
+func (e TL_phone_inviteToGroupCall) writeText(w *textWriter) {
+	w.name("phone.inviteToGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("users")
+	w.Vector(e.Users)
+}
+
+func (e TL_phone_discardGroupCall) writeText(w *textWriter) {
+	w.name("phone.discardGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+}
+
+func (e TL_phone_toggleGroupCallSettings) writeText(w *textWriter) {
+	w.name("phone.toggleGroupCallSettings")
+	if e.Flags&2 != 0 {
+		w.field("reset_invite_hash")
+		w.Bool(true)
+	}
+	w.field("call")
+	w.Object(e.Call)
+	if e.Flags&1 != 0 {
+		w.field("join_muted")
+		w.Object(e.JoinMuted)
+	}
+}
+
+func (e TL_phone_getGroupCall) writeText(w *textWriter) {
+	w.name("phone.getGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+}
+
+func (e TL_phone_getGroupParticipants) writeText(w *textWriter) {
+	w.name("phone.getGroupParticipants")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("ids")
+	w.Vector(e.Ids)
+	w.field("sources")
+	w.VectorInt(e.Sources)
+	w.field("offset")
+	w.String(e.Offset)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_phone_checkGroupCall) writeText(w *textWriter) {
+	w.name("phone.checkGroupCall")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("source")
+	w.Int(e.Source)
+}
+
+func (e TL_phone_toggleGroupCallRecord) writeText(w *textWriter) {
+	w.name("phone.toggleGroupCallRecord")
+	if e.Flags&1 != 0 {
+		w.field("start")
+		w.Bool(true)
+	}
+	w.field("call")
+	w.Object(e.Call)
+	if e.Flags&2 != 0 {
+		w.field("title")
+		w.String(e.Title)
+	}
+}
+
+func (e TL_phone_editGroupCallParticipant) writeText(w *textWriter) {
+	w.name("phone.editGroupCallParticipant")
+	if e.Flags&1 != 0 {
+		w.field("muted")
+		w.Bool(true)
+	}
+	w.field("call")
+	w.Object(e.Call)
+	w.field("participant")
+	w.Object(e.Participant)
+	if e.Flags&2 != 0 {
+		w.field("volume")
+		w.Int(e.Volume)
+	}
+	if e.Flags&4 != 0 {
+		w.field("raise_hand")
+		w.Object(e.RaiseHand)
+	}
+}
+
+func (e TL_phone_editGroupCallTitle) writeText(w *textWriter) {
+	w.name("phone.editGroupCallTitle")
+	w.field("call")
+	w.Object(e.Call)
+	w.field("title")
+	w.String(e.Title)
+}
+
+func (e TL_phone_getGroupCallJoinAs) writeText(w *textWriter) {
+	w.name("phone.getGroupCallJoinAs")
+	w.field("peer")
+	w.Object(e.Peer)
+}
+
+func (e TL_phone_exportGroupCallInvite) writeText(w *textWriter) {
+	w.name("phone.exportGroupCallInvite")
+	if e.Flags&1 != 0 {
+		w.field("can_self_unmute")
+		w.Bool(true)
+	}
+	w.field("call")
+	w.Object(e.Call)
+}
+
+func (e TL_langpack_getLangPack) writeText(w *textWriter) {
+	w.name("langpack.getLangPack")
+	w.field("lang_pack")
+	w.String(e.LangPack)
+	w.field("lang_code")
+	w.String(e.LangCode)
+}
+
+func (e TL_langpack_getStrings) writeText(w *textWriter) {
+	w.name("langpack.getStrings")
+	w.field("lang_pack")
+	w.String(e.LangPack)
+	w.field("lang_code")
+	w.String(e.LangCode)
+	w.field("keys")
+	w.VectorString(e.Keys)
+}
+
+func (e TL_langpack_getDifference) writeText(w *textWriter) {
+	w.name("langpack.getDifference")
+	w.field("lang_pack")
+	w.String(e.LangPack)
+	w.field("lang_code")
+	w.String(e.LangCode)
+	w.field("from_version")
+	w.Int(e.FromVersion)
+}
+
+func (e TL_langpack_getLanguages) writeText(w *textWriter) {
+	w.name("langpack.getLanguages")
+	w.field("lang_pack")
+	w.String(e.LangPack)
+}
+
+func (e TL_langpack_getLanguage) writeText(w *textWriter) {
+	w.name("langpack.getLanguage")
+	w.field("lang_pack")
+	w.String(e.LangPack)
+	w.field("lang_code")
+	w.String(e.LangCode)
+}
+
+func (e TL_folders_editPeerFolders) writeText(w *textWriter) {
+	w.name("folders.editPeerFolders")
+	w.field("folder_peers")
+	w.Vector(e.FolderPeers)
+}
+
+func (e TL_folders_deleteFolder) writeText(w *textWriter) {
+	w.name("folders.deleteFolder")
+	w.field("folder_id")
+	w.Int(e.FolderID)
+}
+
+func (e TL_stats_getBroadcastStats) writeText(w *textWriter) {
+	w.name("stats.getBroadcastStats")
+	if e.Flags&1 != 0 {
+		w.field("dark")
+		w.Bool(true)
+	}
+	w.field("channel")
+	w.Object(e.Channel)
+}
+
+func (e TL_stats_loadAsyncGraph) writeText(w *textWriter) {
+	w.name("stats.loadAsyncGraph")
+	w.field("token")
+	w.String(e.Token)
+	if e.Flags&1 != 0 {
+		w.field("x")
+		w.Long(e.X)
+	}
+}
+
+func (e TL_stats_getMegagroupStats) writeText(w *textWriter) {
+	w.name("stats.getMegagroupStats")
+	if e.Flags&1 != 0 {
+		w.field("dark")
+		w.Bool(true)
+	}
+	w.field("channel")
+	w.Object(e.Channel)
+}
+
+func (e TL_stats_getMessagePublicForwards) writeText(w *textWriter) {
+	w.name("stats.getMessagePublicForwards")
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+	w.field("offset_rate")
+	w.Int(e.OffsetRate)
+	w.field("offset_peer")
+	w.Object(e.OffsetPeer)
+	w.field("offset_id")
+	w.Int(e.OffsetID)
+	w.field("limit")
+	w.Int(e.Limit)
+}
+
+func (e TL_stats_getMessageStats) writeText(w *textWriter) {
+	w.name("stats.getMessageStats")
+	if e.Flags&1 != 0 {
+		w.field("dark")
+		w.Bool(true)
+	}
+	w.field("channel")
+	w.Object(e.Channel)
+	w.field("msg_id")
+	w.Int(e.MsgID)
+}
+
+func (e TL_message_layer122) writeText(w *textWriter) {
+	w.name("message_layer122")
+	if e.Flags&2 != 0 {
+		w.field("out")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("mentioned")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("media_unread")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("post")
+		w.Bool(true)
+	}
+	if e.Flags&262144 != 0 {
+		w.field("from_scheduled")
+		w.Bool(true)
+	}
+	if e.Flags&524288 != 0 {
+		w.field("legacy")
+		w.Bool(true)
+	}
+	if e.Flags&2097152 != 0 {
+		w.field("edit_hide")
+		w.Bool(true)
+	}
+	if e.Flags&16777216 != 0 {
+		w.field("pinned")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&256 != 0 {
+		w.field("from_id")
+		w.Object(e.FromID)
+	}
+	w.field("peer_id")
+	w.Object(e.PeerID)
+	if e.Flags&4 != 0 {
+		w.field("fwd_from")
+		w.Object(e.FwdFrom)
+	}
+	if e.Flags&2048 != 0 {
+		w.field("via_bot_id")
+		w.Int(e.ViaBotID)
+	}
+	if e.Flags&8 != 0 {
+		w.field("reply_to")
+		w.Object(e.ReplyTo)
+	}
+	w.field("date")
+	w.Int(e.Date)
+	w.field("message")
+	w.String(e.Message)
+	if e.Flags&512 != 0 {
+		w.field("media")
+		w.Object(e.Media)
+	}
+	if e.Flags&64 != 0 {
+		w.field("reply_markup")
+		w.Object(e.ReplyMarkup)
+	}
+	if e.Flags&128 != 0 {
+		w.field("entities")
+		w.Vector(e.Entities)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("views")
+		w.Int(e.Views)
+	}
+	if e.Flags&1024 != 0 {
+		w.field("forwards")
+		w.Int(e.Forwards)
+	}
+	if e.Flags&8388608 != 0 {
+		w.field("replies")
+		w.Object(e.Replies)
+	}
+	if e.Flags&32768 != 0 {
+		w.field("edit_date")
+		w.Int(e.EditDate)
+	}
+	if e.Flags&65536 != 0 {
+		w.field("post_author")
+		w.String(e.PostAuthor)
+	}
+	if e.Flags&131072 != 0 {
+		w.field("grouped_id")
+		w.Long(e.GroupedID)
+	}
+	if e.Flags&4194304 != 0 {
+		w.field("restriction_reason")
+		w.Vector(e.RestrictionReason)
+	}
+}
+
+func (e TL_messageService_layer122) writeText(w *textWriter) {
+	w.name("messageService_layer122")
+	if e.Flags&2 != 0 {
+		w.field("out")
+		w.Bool(true)
+	}
+	if e.Flags&16 != 0 {
+		w.field("mentioned")
+		w.Bool(true)
+	}
+	if e.Flags&32 != 0 {
+		w.field("media_unread")
+		w.Bool(true)
+	}
+	if e.Flags&8192 != 0 {
+		w.field("silent")
+		w.Bool(true)
+	}
+	if e.Flags&16384 != 0 {
+		w.field("post")
+		w.Bool(true)
+	}
+	if e.Flags&524288 != 0 {
+		w.field("legacy")
+		w.Bool(true)
+	}
+	w.field("id")
+	w.Int(e.ID)
+	if e.Flags&256 != 0 {
+		w.field("from_id")
+		w.Object(e.FromID)
+	}
+	w.field("peer_id")
+	w.Object(e.PeerID)
+	if e.Flags&8 != 0 {
+		w.field("reply_to")
+		w.Object(e.ReplyTo)
+	}
+	w.field("date")
+	w.Int(e.Date)
+	w.field("action")
+	w.Object(e.Action)
+}
+
+var textParsers map[string]func(*textParser) TL
+
+func init() {
+	textParsers = map[string]func(*textParser) TL{
+		"resPQ": func(p *textParser) TL {
+			var e TL_resPQ
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "pq":
+					e.Pq = p.String()
+				case "server_public_key_fingerprints":
+					e.ServerPublicKeyFingerprints = p.VectorLong()
+				default:
+					p.unknownField("resPQ")
+				}
+			}
+			return e
+		},
+		"p_q_inner_data": func(p *textParser) TL {
+			var e TL_p_q_inner_data
+			for p.field() {
+				switch p.fieldName {
+				case "pq":
+					e.Pq = p.String()
+				case "p":
+					e.P = p.String()
+				case "q":
+					e.Q = p.String()
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "new_nonce":
+					e.NewNonce = p.Bytes()
+				default:
+					p.unknownField("p_q_inner_data")
+				}
+			}
+			return e
+		},
+		"p_q_inner_data_dc": func(p *textParser) TL {
+			var e TL_p_q_inner_data_dc
+			for p.field() {
+				switch p.fieldName {
+				case "pq":
+					e.Pq = p.String()
+				case "p":
+					e.P = p.String()
+				case "q":
+					e.Q = p.String()
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "new_nonce":
+					e.NewNonce = p.Bytes()
+				case "dc":
+					e.Dc = p.Int()
+				default:
+					p.unknownField("p_q_inner_data_dc")
+				}
+			}
+			return e
+		},
+		"p_q_inner_data_temp": func(p *textParser) TL {
+			var e TL_p_q_inner_data_temp
+			for p.field() {
+				switch p.fieldName {
+				case "pq":
+					e.Pq = p.String()
+				case "p":
+					e.P = p.String()
+				case "q":
+					e.Q = p.String()
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "new_nonce":
+					e.NewNonce = p.Bytes()
+				case "expires_in":
+					e.ExpiresIn = p.Int()
+				default:
+					p.unknownField("p_q_inner_data_temp")
+				}
+			}
+			return e
+		},
+		"p_q_inner_data_temp_dc": func(p *textParser) TL {
+			var e TL_p_q_inner_data_temp_dc
+			for p.field() {
+				switch p.fieldName {
+				case "pq":
+					e.Pq = p.String()
+				case "p":
+					e.P = p.String()
+				case "q":
+					e.Q = p.String()
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "new_nonce":
+					e.NewNonce = p.Bytes()
+				case "dc":
+					e.Dc = p.Int()
+				case "expires_in":
+					e.ExpiresIn = p.Int()
+				default:
+					p.unknownField("p_q_inner_data_temp_dc")
+				}
+			}
+			return e
+		},
+		"bind_auth_key_inner": func(p *textParser) TL {
+			var e TL_bind_auth_key_inner
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Long()
+				case "temp_auth_key_id":
+					e.TempAuthKeyID = p.Long()
+				case "perm_auth_key_id":
+					e.PermAuthKeyID = p.Long()
+				case "temp_session_id":
+					e.TempSessionID = p.Long()
+				case "expires_at":
+					e.ExpiresAt = p.Int()
+				default:
+					p.unknownField("bind_auth_key_inner")
+				}
+			}
+			return e
+		},
+		"server_DH_params_fail": func(p *textParser) TL {
+			var e TL_server_DH_params_fail
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "new_nonce_hash":
+					e.NewNonceHash = p.Bytes()
+				default:
+					p.unknownField("server_DH_params_fail")
+				}
+			}
+			return e
+		},
+		"server_DH_params_ok": func(p *textParser) TL {
+			var e TL_server_DH_params_ok
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "encrypted_answer":
+					e.EncryptedAnswer = p.String()
+				default:
+					p.unknownField("server_DH_params_ok")
+				}
+			}
+			return e
+		},
+		"server_DH_inner_data": func(p *textParser) TL {
+			var e TL_server_DH_inner_data
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "g":
+					e.G = p.Int()
+				case "dh_prime":
+					e.DhPrime = p.String()
+				case "g_a":
+					e.GA = p.String()
+				case "server_time":
+					e.ServerTime = p.Int()
+				default:
+					p.unknownField("server_DH_inner_data")
+				}
+			}
+			return e
+		},
+		"client_DH_inner_data": func(p *textParser) TL {
+			var e TL_client_DH_inner_data
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "retry_id":
+					e.RetryID = p.Long()
+				case "g_b":
+					e.GB = p.String()
+				default:
+					p.unknownField("client_DH_inner_data")
+				}
+			}
+			return e
+		},
+		"dh_gen_ok": func(p *textParser) TL {
+			var e TL_dh_gen_ok
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "new_nonce_hash1":
+					e.NewNonceHash1 = p.Bytes()
+				default:
+					p.unknownField("dh_gen_ok")
+				}
+			}
+			return e
+		},
+		"dh_gen_retry": func(p *textParser) TL {
+			var e TL_dh_gen_retry
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "new_nonce_hash2":
+					e.NewNonceHash2 = p.Bytes()
+				default:
+					p.unknownField("dh_gen_retry")
+				}
+			}
+			return e
+		},
+		"dh_gen_fail": func(p *textParser) TL {
+			var e TL_dh_gen_fail
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "new_nonce_hash3":
+					e.NewNonceHash3 = p.Bytes()
+				default:
+					p.unknownField("dh_gen_fail")
+				}
+			}
+			return e
+		},
+		"destroy_auth_key_ok": func(p *textParser) TL {
+			var e TL_destroy_auth_key_ok
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("destroy_auth_key_ok")
+				}
+			}
+			return e
+		},
+		"destroy_auth_key_none": func(p *textParser) TL {
+			var e TL_destroy_auth_key_none
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("destroy_auth_key_none")
+				}
+			}
+			return e
+		},
+		"destroy_auth_key_fail": func(p *textParser) TL {
+			var e TL_destroy_auth_key_fail
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("destroy_auth_key_fail")
+				}
+			}
+			return e
+		},
+		"req_pq": func(p *textParser) TL {
+			var e TL_req_pq
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				default:
+					p.unknownField("req_pq")
+				}
+			}
+			return e
+		},
+		"req_pq_multi": func(p *textParser) TL {
+			var e TL_req_pq_multi
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				default:
+					p.unknownField("req_pq_multi")
+				}
+			}
+			return e
+		},
+		"req_DH_params": func(p *textParser) TL {
+			var e TL_req_DH_params
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "p":
+					e.P = p.String()
+				case "q":
+					e.Q = p.String()
+				case "public_key_fingerprint":
+					e.PublicKeyFingerprint = p.Long()
+				case "encrypted_data":
+					e.EncryptedData = p.String()
+				default:
+					p.unknownField("req_DH_params")
+				}
+			}
+			return e
+		},
+		"set_client_DH_params": func(p *textParser) TL {
+			var e TL_set_client_DH_params
+			for p.field() {
+				switch p.fieldName {
+				case "nonce":
+					e.Nonce = p.Bytes()
+				case "server_nonce":
+					e.ServerNonce = p.Bytes()
+				case "encrypted_data":
+					e.EncryptedData = p.String()
+				default:
+					p.unknownField("set_client_DH_params")
+				}
+			}
+			return e
+		},
+		"destroy_auth_key": func(p *textParser) TL {
+			var e TL_destroy_auth_key
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("destroy_auth_key")
+				}
+			}
+			return e
+		},
+		"msgs_ack": func(p *textParser) TL {
+			var e TL_msgs_ack
+			for p.field() {
+				switch p.fieldName {
+				case "msg_ids":
+					e.MsgIds = p.VectorLong()
+				default:
+					p.unknownField("msgs_ack")
+				}
+			}
+			return e
+		},
+		"bad_msg_notification": func(p *textParser) TL {
+			var e TL_bad_msg_notification
+			for p.field() {
+				switch p.fieldName {
+				case "bad_msg_id":
+					e.BadMsgID = p.Long()
+				case "bad_msg_seqno":
+					e.BadMsgSeqno = p.Int()
+				case "error_code":
+					e.ErrorCode = p.Int()
+				default:
+					p.unknownField("bad_msg_notification")
+				}
+			}
+			return e
+		},
+		"bad_server_salt": func(p *textParser) TL {
+			var e TL_bad_server_salt
+			for p.field() {
+				switch p.fieldName {
+				case "bad_msg_id":
+					e.BadMsgID = p.Long()
+				case "bad_msg_seqno":
+					e.BadMsgSeqno = p.Int()
+				case "error_code":
+					e.ErrorCode = p.Int()
+				case "new_server_salt":
+					e.NewServerSalt = p.Long()
+				default:
+					p.unknownField("bad_server_salt")
+				}
+			}
+			return e
+		},
+		"msgs_state_req": func(p *textParser) TL {
+			var e TL_msgs_state_req
+			for p.field() {
+				switch p.fieldName {
+				case "msg_ids":
+					e.MsgIds = p.VectorLong()
+				default:
+					p.unknownField("msgs_state_req")
+				}
+			}
+			return e
+		},
+		"msgs_state_info": func(p *textParser) TL {
+			var e TL_msgs_state_info
+			for p.field() {
+				switch p.fieldName {
+				case "req_msg_id":
+					e.ReqMsgID = p.Long()
+				case "info":
+					e.Info = p.String()
+				default:
+					p.unknownField("msgs_state_info")
+				}
+			}
+			return e
+		},
+		"msgs_all_info": func(p *textParser) TL {
+			var e TL_msgs_all_info
+			for p.field() {
+				switch p.fieldName {
+				case "msg_ids":
+					e.MsgIds = p.VectorLong()
+				case "info":
+					e.Info = p.String()
+				default:
+					p.unknownField("msgs_all_info")
+				}
+			}
+			return e
+		},
+		"msg_detailed_info": func(p *textParser) TL {
+			var e TL_msg_detailed_info
+			for p.field() {
+				switch p.fieldName {
+				case "msg_id":
+					e.MsgID = p.Long()
+				case "answer_msg_id":
+					e.AnswerMsgID = p.Long()
+				case "bytes":
+					e.Bytes = p.Int()
+				case "status":
+					e.Status = p.Int()
+				default:
+					p.unknownField("msg_detailed_info")
+				}
+			}
+			return e
+		},
+		"msg_new_detailed_info": func(p *textParser) TL {
+			var e TL_msg_new_detailed_info
+			for p.field() {
+				switch p.fieldName {
+				case "answer_msg_id":
+					e.AnswerMsgID = p.Long()
+				case "bytes":
+					e.Bytes = p.Int()
+				case "status":
+					e.Status = p.Int()
+				default:
+					p.unknownField("msg_new_detailed_info")
+				}
+			}
+			return e
+		},
+		"msg_resend_req": func(p *textParser) TL {
+			var e TL_msg_resend_req
+			for p.field() {
+				switch p.fieldName {
+				case "msg_ids":
+					e.MsgIds = p.VectorLong()
+				default:
+					p.unknownField("msg_resend_req")
+				}
+			}
+			return e
+		},
+		"rpc_error": func(p *textParser) TL {
+			var e TL_rpc_error
+			for p.field() {
+				switch p.fieldName {
+				case "error_code":
+					e.ErrorCode = p.Int()
+				case "error_message":
+					e.ErrorMessage = p.String()
+				default:
+					p.unknownField("rpc_error")
+				}
+			}
+			return e
+		},
+		"rpc_answer_unknown": func(p *textParser) TL {
+			var e TL_rpc_answer_unknown
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("rpc_answer_unknown")
+				}
+			}
+			return e
+		},
+		"rpc_answer_dropped_running": func(p *textParser) TL {
+			var e TL_rpc_answer_dropped_running
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("rpc_answer_dropped_running")
+				}
+			}
+			return e
+		},
+		"rpc_answer_dropped": func(p *textParser) TL {
+			var e TL_rpc_answer_dropped
+			for p.field() {
+				switch p.fieldName {
+				case "msg_id":
+					e.MsgID = p.Long()
+				case "seq_no":
+					e.SeqNo = p.Int()
+				case "bytes":
+					e.Bytes = p.Int()
+				default:
+					p.unknownField("rpc_answer_dropped")
+				}
+			}
+			return e
+		},
+		"future_salt": func(p *textParser) TL {
+			var e TL_future_salt
+			for p.field() {
+				switch p.fieldName {
+				case "valid_since":
+					e.ValidSince = p.Int()
+				case "valid_until":
+					e.ValidUntil = p.Int()
+				case "salt":
+					e.Salt = p.Long()
+				default:
+					p.unknownField("future_salt")
+				}
+			}
+			return e
+		},
+		"future_salts": func(p *textParser) TL {
+			var e TL_future_salts
+			for p.field() {
+				switch p.fieldName {
+				case "req_msg_id":
+					e.ReqMsgID = p.Long()
+				case "now":
+					e.Now = p.Int()
+				case "salts":
+					e.Salts = p.Vector()
+				default:
+					p.unknownField("future_salts")
+				}
+			}
+			return e
+		},
+		"pong": func(p *textParser) TL {
+			var e TL_pong
+			for p.field() {
+				switch p.fieldName {
+				case "msg_id":
+					e.MsgID = p.Long()
+				case "ping_id":
+					e.PingID = p.Long()
+				default:
+					p.unknownField("pong")
+				}
+			}
+			return e
+		},
+		"destroy_session_ok": func(p *textParser) TL {
+			var e TL_destroy_session_ok
+			for p.field() {
+				switch p.fieldName {
+				case "session_id":
+					e.SessionID = p.Long()
+				default:
+					p.unknownField("destroy_session_ok")
+				}
+			}
+			return e
+		},
+		"destroy_session_none": func(p *textParser) TL {
+			var e TL_destroy_session_none
+			for p.field() {
+				switch p.fieldName {
+				case "session_id":
+					e.SessionID = p.Long()
+				default:
+					p.unknownField("destroy_session_none")
+				}
+			}
+			return e
+		},
+		"new_session_created": func(p *textParser) TL {
+			var e TL_new_session_created
+			for p.field() {
+				switch p.fieldName {
+				case "first_msg_id":
+					e.FirstMsgID = p.Long()
+				case "unique_id":
+					e.UniqueID = p.Long()
+				case "server_salt":
+					e.ServerSalt = p.Long()
+				default:
+					p.unknownField("new_session_created")
+				}
+			}
+			return e
+		},
+		"http_wait": func(p *textParser) TL {
+			var e TL_http_wait
+			for p.field() {
+				switch p.fieldName {
+				case "max_delay":
+					e.MaxDelay = p.Int()
+				case "wait_after":
+					e.WaitAfter = p.Int()
+				case "max_wait":
+					e.MaxWait = p.Int()
+				default:
+					p.unknownField("http_wait")
+				}
+			}
+			return e
+		},
+		"ipPort": func(p *textParser) TL {
+			var e TL_ipPort
+			for p.field() {
+				switch p.fieldName {
+				case "ipv4":
+					e.Ipv4 = p.Int()
+				case "port":
+					e.Port = p.Int()
+				default:
+					p.unknownField("ipPort")
+				}
+			}
+			return e
+		},
+		"ipPortSecret": func(p *textParser) TL {
+			var e TL_ipPortSecret
+			for p.field() {
+				switch p.fieldName {
+				case "ipv4":
+					e.Ipv4 = p.Int()
+				case "port":
+					e.Port = p.Int()
+				case "secret":
+					e.Secret = p.Bytes()
+				default:
+					p.unknownField("ipPortSecret")
+				}
+			}
+			return e
+		},
+		"accessPointRule": func(p *textParser) TL {
+			var e TL_accessPointRule
+			for p.field() {
+				switch p.fieldName {
+				case "phone_prefix_rules":
+					e.PhonePrefixRules = p.String()
+				case "dc_id":
+					e.DcID = p.Int()
+				case "ips":
+					e.Ips = p.Vector()
+				default:
+					p.unknownField("accessPointRule")
+				}
+			}
+			return e
+		},
+		"help.configSimple": func(p *textParser) TL {
+			var e TL_help_configSimple
+			for p.field() {
+				switch p.fieldName {
+				case "date":
+					e.Date = p.Int()
+				case "expires":
+					e.Expires = p.Int()
+				case "rules":
+					e.Rules = p.Vector()
+				default:
+					p.unknownField("help.configSimple")
+				}
+			}
+			return e
+		},
+		"tlsClientHello": func(p *textParser) TL {
+			var e TL_tlsClientHello
+			for p.field() {
+				switch p.fieldName {
+				case "blocks":
+					e.Blocks = p.Vector()
+				default:
+					p.unknownField("tlsClientHello")
+				}
+			}
+			return e
+		},
+		"tlsBlockString": func(p *textParser) TL {
+			var e TL_tlsBlockString
+			for p.field() {
+				switch p.fieldName {
+				case "data":
+					e.Data = p.String()
+				default:
+					p.unknownField("tlsBlockString")
+				}
+			}
+			return e
+		},
+		"tlsBlockRandom": func(p *textParser) TL {
+			var e TL_tlsBlockRandom
+			for p.field() {
+				switch p.fieldName {
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("tlsBlockRandom")
+				}
+			}
+			return e
+		},
+		"tlsBlockZero": func(p *textParser) TL {
+			var e TL_tlsBlockZero
+			for p.field() {
+				switch p.fieldName {
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("tlsBlockZero")
+				}
+			}
+			return e
+		},
+		"tlsBlockDomain": func(p *textParser) TL {
+			var e TL_tlsBlockDomain
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("tlsBlockDomain")
+				}
+			}
+			return e
+		},
+		"tlsBlockGrease": func(p *textParser) TL {
+			var e TL_tlsBlockGrease
+			for p.field() {
+				switch p.fieldName {
+				case "seed":
+					e.Seed = p.Int()
+				default:
+					p.unknownField("tlsBlockGrease")
+				}
+			}
+			return e
+		},
+		"tlsBlockPublicKey": func(p *textParser) TL {
+			var e TL_tlsBlockPublicKey
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("tlsBlockPublicKey")
+				}
+			}
+			return e
+		},
+		"tlsBlockScope": func(p *textParser) TL {
+			var e TL_tlsBlockScope
+			for p.field() {
+				switch p.fieldName {
+				case "entries":
+					e.Entries = p.Vector()
+				default:
+					p.unknownField("tlsBlockScope")
+				}
+			}
+			return e
+		},
+		"rpc_drop_answer": func(p *textParser) TL {
+			var e TL_rpc_drop_answer
+			for p.field() {
+				switch p.fieldName {
+				case "req_msg_id":
+					e.ReqMsgID = p.Long()
+				default:
+					p.unknownField("rpc_drop_answer")
+				}
+			}
+			return e
+		},
+		"get_future_salts": func(p *textParser) TL {
+			var e TL_get_future_salts
+			for p.field() {
+				switch p.fieldName {
+				case "num":
+					e.Num = p.Int()
+				default:
+					p.unknownField("get_future_salts")
+				}
+			}
+			return e
+		},
+		"ping": func(p *textParser) TL {
+			var e TL_ping
+			for p.field() {
+				switch p.fieldName {
+				case "ping_id":
+					e.PingID = p.Long()
+				default:
+					p.unknownField("ping")
+				}
+			}
+			return e
+		},
+		"ping_delay_disconnect": func(p *textParser) TL {
+			var e TL_ping_delay_disconnect
+			for p.field() {
+				switch p.fieldName {
+				case "ping_id":
+					e.PingID = p.Long()
+				case "disconnect_delay":
+					e.DisconnectDelay = p.Int()
+				default:
+					p.unknownField("ping_delay_disconnect")
+				}
+			}
+			return e
+		},
+		"destroy_session": func(p *textParser) TL {
+			var e TL_destroy_session
+			for p.field() {
+				switch p.fieldName {
+				case "session_id":
+					e.SessionID = p.Long()
+				default:
+					p.unknownField("destroy_session")
+				}
+			}
+			return e
+		},
+		"boolFalse": func(p *textParser) TL {
+			var e TL_boolFalse
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("boolFalse")
+				}
+			}
+			return e
+		},
+		"boolTrue": func(p *textParser) TL {
+			var e TL_boolTrue
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("boolTrue")
+				}
+			}
+			return e
+		},
+		"true": func(p *textParser) TL {
+			var e TL_true
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("true")
+				}
+			}
+			return e
+		},
+		"error": func(p *textParser) TL {
+			var e TL_error
+			for p.field() {
+				switch p.fieldName {
+				case "code":
+					e.Code = p.Int()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("error")
+				}
+			}
+			return e
+		},
+		"null": func(p *textParser) TL {
+			var e TL_null
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("null")
+				}
+			}
+			return e
+		},
+		"inputPeerEmpty": func(p *textParser) TL {
+			var e TL_inputPeerEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPeerEmpty")
+				}
+			}
+			return e
+		},
+		"inputPeerSelf": func(p *textParser) TL {
+			var e TL_inputPeerSelf
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPeerSelf")
+				}
+			}
+			return e
+		},
+		"inputPeerChat": func(p *textParser) TL {
+			var e TL_inputPeerChat
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("inputPeerChat")
+				}
+			}
+			return e
+		},
+		"inputPeerUser": func(p *textParser) TL {
+			var e TL_inputPeerUser
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputPeerUser")
+				}
+			}
+			return e
+		},
+		"inputPeerChannel": func(p *textParser) TL {
+			var e TL_inputPeerChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputPeerChannel")
+				}
+			}
+			return e
+		},
+		"inputPeerUserFromMessage": func(p *textParser) TL {
+			var e TL_inputPeerUserFromMessage
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				default:
+					p.unknownField("inputPeerUserFromMessage")
+				}
+			}
+			return e
+		},
+		"inputPeerChannelFromMessage": func(p *textParser) TL {
+			var e TL_inputPeerChannelFromMessage
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "channel_id":
+					e.ChannelID = p.Int()
+				default:
+					p.unknownField("inputPeerChannelFromMessage")
+				}
+			}
+			return e
+		},
+		"inputUserEmpty": func(p *textParser) TL {
+			var e TL_inputUserEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputUserEmpty")
+				}
+			}
+			return e
+		},
+		"inputUserSelf": func(p *textParser) TL {
+			var e TL_inputUserSelf
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputUserSelf")
+				}
+			}
+			return e
+		},
+		"inputUser": func(p *textParser) TL {
+			var e TL_inputUser
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputUser")
+				}
+			}
+			return e
+		},
+		"inputUserFromMessage": func(p *textParser) TL {
+			var e TL_inputUserFromMessage
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				default:
+					p.unknownField("inputUserFromMessage")
+				}
+			}
+			return e
+		},
+		"inputPhoneContact": func(p *textParser) TL {
+			var e TL_inputPhoneContact
+			for p.field() {
+				switch p.fieldName {
+				case "client_id":
+					e.ClientID = p.Long()
+				case "phone":
+					e.Phone = p.String()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				default:
+					p.unknownField("inputPhoneContact")
+				}
+			}
+			return e
+		},
+		"inputFile": func(p *textParser) TL {
+			var e TL_inputFile
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "parts":
+					e.Parts = p.Int()
+				case "name":
+					e.Name = p.String()
+				case "md5_checksum":
+					e.Md5Checksum = p.String()
+				default:
+					p.unknownField("inputFile")
+				}
+			}
+			return e
+		},
+		"inputFileBig": func(p *textParser) TL {
+			var e TL_inputFileBig
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "parts":
+					e.Parts = p.Int()
+				case "name":
+					e.Name = p.String()
+				default:
+					p.unknownField("inputFileBig")
+				}
+			}
+			return e
+		},
+		"inputMediaEmpty": func(p *textParser) TL {
+			var e TL_inputMediaEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMediaEmpty")
+				}
+			}
+			return e
+		},
+		"inputMediaUploadedPhoto": func(p *textParser) TL {
+			var e TL_inputMediaUploadedPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "file":
+					e.File = p.Object()
+				case "stickers":
+					e.Stickers = p.Vector()
+					e.Flags |= 1
+				case "ttl_seconds":
+					e.TtlSeconds = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("inputMediaUploadedPhoto")
+				}
+			}
+			return e
+		},
+		"inputMediaPhoto": func(p *textParser) TL {
+			var e TL_inputMediaPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.Object()
+				case "ttl_seconds":
+					e.TtlSeconds = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("inputMediaPhoto")
+				}
+			}
+			return e
+		},
+		"inputMediaGeoPoint": func(p *textParser) TL {
+			var e TL_inputMediaGeoPoint
+			for p.field() {
+				switch p.fieldName {
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				default:
+					p.unknownField("inputMediaGeoPoint")
+				}
+			}
+			return e
+		},
+		"inputMediaContact": func(p *textParser) TL {
+			var e TL_inputMediaContact
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				case "vcard":
+					e.Vcard = p.String()
+				default:
+					p.unknownField("inputMediaContact")
+				}
+			}
+			return e
+		},
+		"inputMediaUploadedDocument": func(p *textParser) TL {
+			var e TL_inputMediaUploadedDocument
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "nosound_video":
+					e.NosoundVideo = p.Bool()
+					if e.NosoundVideo {
+						e.Flags |= 8
+					}
+				case "force_file":
+					e.ForceFile = p.Bool()
+					if e.ForceFile {
+						e.Flags |= 16
+					}
+				case "file":
+					e.File = p.Object()
+				case "thumb":
+					e.Thumb = p.Object()
+					e.Flags |= 4
+				case "mime_type":
+					e.MimeType = p.String()
+				case "attributes":
+					e.Attributes = p.Vector()
+				case "stickers":
+					e.Stickers = p.Vector()
+					e.Flags |= 1
+				case "ttl_seconds":
+					e.TtlSeconds = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("inputMediaUploadedDocument")
+				}
+			}
+			return e
+		},
+		"inputMediaDocument": func(p *textParser) TL {
+			var e TL_inputMediaDocument
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.Object()
+				case "ttl_seconds":
+					e.TtlSeconds = p.Int()
+					e.Flags |= 1
+				case "query":
+					e.Query = p.String()
+					e.Flags |= 2
+				default:
+					p.unknownField("inputMediaDocument")
+				}
+			}
+			return e
+		},
+		"inputMediaVenue": func(p *textParser) TL {
+			var e TL_inputMediaVenue
+			for p.field() {
+				switch p.fieldName {
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				case "title":
+					e.Title = p.String()
+				case "address":
+					e.Address = p.String()
+				case "provider":
+					e.Provider = p.String()
+				case "venue_id":
+					e.VenueID = p.String()
+				case "venue_type":
+					e.VenueType = p.String()
+				default:
+					p.unknownField("inputMediaVenue")
+				}
+			}
+			return e
+		},
+		"inputMediaPhotoExternal": func(p *textParser) TL {
+			var e TL_inputMediaPhotoExternal
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "url":
+					e.Url = p.String()
+				case "ttl_seconds":
+					e.TtlSeconds = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("inputMediaPhotoExternal")
+				}
+			}
+			return e
+		},
+		"inputMediaDocumentExternal": func(p *textParser) TL {
+			var e TL_inputMediaDocumentExternal
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "url":
+					e.Url = p.String()
+				case "ttl_seconds":
+					e.TtlSeconds = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("inputMediaDocumentExternal")
+				}
+			}
+			return e
+		},
+		"inputMediaGame": func(p *textParser) TL {
+			var e TL_inputMediaGame
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("inputMediaGame")
+				}
+			}
+			return e
+		},
+		"inputMediaInvoice": func(p *textParser) TL {
+			var e TL_inputMediaInvoice
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "title":
+					e.Title = p.String()
+				case "description":
+					e.Description = p.String()
+				case "photo":
+					e.Photo = p.Object()
+					e.Flags |= 1
+				case "invoice":
+					e.Invoice = p.Object()
+				case "payload":
+					e.Payload = p.Bytes()
+				case "provider":
+					e.Provider = p.String()
+				case "provider_data":
+					e.ProviderData = p.Object()
+				case "start_param":
+					e.StartParam = p.String()
+				default:
+					p.unknownField("inputMediaInvoice")
+				}
+			}
+			return e
+		},
+		"inputMediaGeoLive": func(p *textParser) TL {
+			var e TL_inputMediaGeoLive
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "stopped":
+					e.Stopped = p.Bool()
+					if e.Stopped {
+						e.Flags |= 1
+					}
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				case "heading":
+					e.Heading = p.Int()
+					e.Flags |= 4
+				case "period":
+					e.Period = p.Int()
+					e.Flags |= 2
+				case "proximity_notification_radius":
+					e.ProximityNotificationRadius = p.Int()
+					e.Flags |= 8
+				default:
+					p.unknownField("inputMediaGeoLive")
+				}
+			}
+			return e
+		},
+		"inputMediaPoll": func(p *textParser) TL {
+			var e TL_inputMediaPoll
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "poll":
+					e.Poll = p.Object()
+				case "correct_answers":
+					e.CorrectAnswers = p.VectorBytes()
+					e.Flags |= 1
+				case "solution":
+					e.Solution = p.String()
+					e.Flags |= 2
+				case "solution_entities":
+					e.SolutionEntities = p.Vector()
+					e.Flags |= 2
+				default:
+					p.unknownField("inputMediaPoll")
+				}
+			}
+			return e
+		},
+		"inputMediaDice": func(p *textParser) TL {
+			var e TL_inputMediaDice
+			for p.field() {
+				switch p.fieldName {
+				case "emoticon":
+					e.Emoticon = p.String()
+				default:
+					p.unknownField("inputMediaDice")
+				}
+			}
+			return e
+		},
+		"inputChatPhotoEmpty": func(p *textParser) TL {
+			var e TL_inputChatPhotoEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputChatPhotoEmpty")
+				}
+			}
+			return e
+		},
+		"inputChatUploadedPhoto": func(p *textParser) TL {
+			var e TL_inputChatUploadedPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "file":
+					e.File = p.Object()
+					e.Flags |= 1
+				case "video":
+					e.Video = p.Object()
+					e.Flags |= 2
+				case "video_start_ts":
+					e.VideoStartTs = p.Double()
+					e.Flags |= 4
+				default:
+					p.unknownField("inputChatUploadedPhoto")
+				}
+			}
+			return e
+		},
+		"inputChatPhoto": func(p *textParser) TL {
+			var e TL_inputChatPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("inputChatPhoto")
+				}
+			}
+			return e
+		},
+		"inputGeoPointEmpty": func(p *textParser) TL {
+			var e TL_inputGeoPointEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputGeoPointEmpty")
+				}
+			}
+			return e
+		},
+		"inputGeoPoint": func(p *textParser) TL {
+			var e TL_inputGeoPoint
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "lat":
+					e.Lat = p.Double()
+				case "long":
+					e.Long = p.Double()
+				case "accuracy_radius":
+					e.AccuracyRadius = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("inputGeoPoint")
+				}
+			}
+			return e
+		},
+		"inputPhotoEmpty": func(p *textParser) TL {
+			var e TL_inputPhotoEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPhotoEmpty")
+				}
+			}
+			return e
+		},
+		"inputPhoto": func(p *textParser) TL {
+			var e TL_inputPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "file_reference":
+					e.FileReference = p.Bytes()
+				default:
+					p.unknownField("inputPhoto")
+				}
+			}
+			return e
+		},
+		"inputFileLocation": func(p *textParser) TL {
+			var e TL_inputFileLocation
+			for p.field() {
+				switch p.fieldName {
+				case "volume_id":
+					e.VolumeID = p.Long()
+				case "local_id":
+					e.LocalID = p.Int()
+				case "secret":
+					e.Secret = p.Long()
+				case "file_reference":
+					e.FileReference = p.Bytes()
+				default:
+					p.unknownField("inputFileLocation")
+				}
+			}
+			return e
+		},
+		"inputEncryptedFileLocation": func(p *textParser) TL {
+			var e TL_inputEncryptedFileLocation
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputEncryptedFileLocation")
+				}
+			}
+			return e
+		},
+		"inputDocumentFileLocation": func(p *textParser) TL {
+			var e TL_inputDocumentFileLocation
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "file_reference":
+					e.FileReference = p.Bytes()
+				case "thumb_size":
+					e.ThumbSize = p.String()
+				default:
+					p.unknownField("inputDocumentFileLocation")
+				}
+			}
+			return e
+		},
+		"inputSecureFileLocation": func(p *textParser) TL {
+			var e TL_inputSecureFileLocation
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputSecureFileLocation")
+				}
+			}
+			return e
+		},
+		"inputTakeoutFileLocation": func(p *textParser) TL {
+			var e TL_inputTakeoutFileLocation
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputTakeoutFileLocation")
+				}
+			}
+			return e
+		},
+		"inputPhotoFileLocation": func(p *textParser) TL {
+			var e TL_inputPhotoFileLocation
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "file_reference":
+					e.FileReference = p.Bytes()
+				case "thumb_size":
+					e.ThumbSize = p.String()
+				default:
+					p.unknownField("inputPhotoFileLocation")
+				}
+			}
+			return e
+		},
+		"inputPhotoLegacyFileLocation": func(p *textParser) TL {
+			var e TL_inputPhotoLegacyFileLocation
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "file_reference":
+					e.FileReference = p.Bytes()
+				case "volume_id":
+					e.VolumeID = p.Long()
+				case "local_id":
+					e.LocalID = p.Int()
+				case "secret":
+					e.Secret = p.Long()
+				default:
+					p.unknownField("inputPhotoLegacyFileLocation")
+				}
+			}
+			return e
+		},
+		"inputPeerPhotoFileLocation": func(p *textParser) TL {
+			var e TL_inputPeerPhotoFileLocation
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "big":
+					e.Big = p.Bool()
+					if e.Big {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "volume_id":
+					e.VolumeID = p.Long()
+				case "local_id":
+					e.LocalID = p.Int()
+				default:
+					p.unknownField("inputPeerPhotoFileLocation")
+				}
+			}
+			return e
+		},
+		"inputStickerSetThumb": func(p *textParser) TL {
+			var e TL_inputStickerSetThumb
+			for p.field() {
+				switch p.fieldName {
+				case "stickerset":
+					e.Stickerset = p.Object()
+				case "volume_id":
+					e.VolumeID = p.Long()
+				case "local_id":
+					e.LocalID = p.Int()
+				default:
+					p.unknownField("inputStickerSetThumb")
+				}
+			}
+			return e
+		},
+		"inputGroupCallStream": func(p *textParser) TL {
+			var e TL_inputGroupCallStream
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "time_ms":
+					e.TimeMs = p.Long()
+				case "scale":
+					e.Scale = p.Int()
+				default:
+					p.unknownField("inputGroupCallStream")
+				}
+			}
+			return e
+		},
+		"peerUser": func(p *textParser) TL {
+			var e TL_peerUser
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				default:
+					p.unknownField("peerUser")
+				}
+			}
+			return e
+		},
+		"peerChat": func(p *textParser) TL {
+			var e TL_peerChat
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("peerChat")
+				}
+			}
+			return e
+		},
+		"peerChannel": func(p *textParser) TL {
+			var e TL_peerChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				default:
+					p.unknownField("peerChannel")
+				}
+			}
+			return e
+		},
+		"storage.fileUnknown": func(p *textParser) TL {
+			var e TL_storage_fileUnknown
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.fileUnknown")
+				}
+			}
+			return e
+		},
+		"storage.filePartial": func(p *textParser) TL {
+			var e TL_storage_filePartial
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.filePartial")
+				}
+			}
+			return e
+		},
+		"storage.fileJpeg": func(p *textParser) TL {
+			var e TL_storage_fileJpeg
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.fileJpeg")
+				}
+			}
+			return e
+		},
+		"storage.fileGif": func(p *textParser) TL {
+			var e TL_storage_fileGif
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.fileGif")
+				}
+			}
+			return e
+		},
+		"storage.filePng": func(p *textParser) TL {
+			var e TL_storage_filePng
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.filePng")
+				}
+			}
+			return e
+		},
+		"storage.filePdf": func(p *textParser) TL {
+			var e TL_storage_filePdf
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.filePdf")
+				}
+			}
+			return e
+		},
+		"storage.fileMp3": func(p *textParser) TL {
+			var e TL_storage_fileMp3
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.fileMp3")
+				}
+			}
+			return e
+		},
+		"storage.fileMov": func(p *textParser) TL {
+			var e TL_storage_fileMov
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.fileMov")
+				}
+			}
+			return e
+		},
+		"storage.fileMp4": func(p *textParser) TL {
+			var e TL_storage_fileMp4
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.fileMp4")
+				}
+			}
+			return e
+		},
+		"storage.fileWebp": func(p *textParser) TL {
+			var e TL_storage_fileWebp
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("storage.fileWebp")
+				}
+			}
+			return e
+		},
+		"userEmpty": func(p *textParser) TL {
+			var e TL_userEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("userEmpty")
+				}
+			}
+			return e
+		},
+		"user": func(p *textParser) TL {
+			var e TL_user
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "self":
+					e.Self = p.Bool()
+					if e.Self {
+						e.Flags |= 1024
+					}
+				case "contact":
+					e.Contact = p.Bool()
+					if e.Contact {
+						e.Flags |= 2048
+					}
+				case "mutual_contact":
+					e.MutualContact = p.Bool()
+					if e.MutualContact {
+						e.Flags |= 4096
+					}
+				case "deleted":
+					e.Deleted = p.Bool()
+					if e.Deleted {
+						e.Flags |= 8192
+					}
+				case "bot":
+					e.Bot = p.Bool()
+					if e.Bot {
+						e.Flags |= 16384
+					}
+				case "bot_chat_history":
+					e.BotChatHistory = p.Bool()
+					if e.BotChatHistory {
+						e.Flags |= 32768
+					}
+				case "bot_nochats":
+					e.BotNochats = p.Bool()
+					if e.BotNochats {
+						e.Flags |= 65536
+					}
+				case "verified":
+					e.Verified = p.Bool()
+					if e.Verified {
+						e.Flags |= 131072
+					}
+				case "restricted":
+					e.Restricted = p.Bool()
+					if e.Restricted {
+						e.Flags |= 262144
+					}
+				case "min":
+					e.Min = p.Bool()
+					if e.Min {
+						e.Flags |= 1048576
+					}
+				case "bot_inline_geo":
+					e.BotInlineGeo = p.Bool()
+					if e.BotInlineGeo {
+						e.Flags |= 2097152
+					}
+				case "support":
+					e.Support = p.Bool()
+					if e.Support {
+						e.Flags |= 8388608
+					}
+				case "scam":
+					e.Scam = p.Bool()
+					if e.Scam {
+						e.Flags |= 16777216
+					}
+				case "apply_min_photo":
+					e.ApplyMinPhoto = p.Bool()
+					if e.ApplyMinPhoto {
+						e.Flags |= 33554432
+					}
+				case "fake":
+					e.Fake = p.Bool()
+					if e.Fake {
+						e.Flags |= 67108864
+					}
+				case "id":
+					e.ID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+					e.Flags |= 1
+				case "first_name":
+					e.FirstName = p.String()
+					e.Flags |= 2
+				case "last_name":
+					e.LastName = p.String()
+					e.Flags |= 4
+				case "username":
+					e.Username = p.String()
+					e.Flags |= 8
+				case "phone":
+					e.Phone = p.String()
+					e.Flags |= 16
+				case "photo":
+					e.Photo = p.Object()
+					e.Flags |= 32
+				case "status":
+					e.Status = p.Object()
+					e.Flags |= 64
+				case "bot_info_version":
+					e.BotInfoVersion = p.Int()
+					e.Flags |= 16384
+				case "restriction_reason":
+					e.RestrictionReason = p.Vector()
+					e.Flags |= 262144
+				case "bot_inline_placeholder":
+					e.BotInlinePlaceholder = p.String()
+					e.Flags |= 524288
+				case "lang_code":
+					e.LangCode = p.String()
+					e.Flags |= 4194304
+				default:
+					p.unknownField("user")
+				}
+			}
+			return e
+		},
+		"userProfilePhotoEmpty": func(p *textParser) TL {
+			var e TL_userProfilePhotoEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("userProfilePhotoEmpty")
+				}
+			}
+			return e
+		},
+		"userProfilePhoto": func(p *textParser) TL {
+			var e TL_userProfilePhoto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "has_video":
+					e.HasVideo = p.Bool()
+					if e.HasVideo {
+						e.Flags |= 1
+					}
+				case "photo_id":
+					e.PhotoID = p.Long()
+				case "photo_small":
+					e.PhotoSmall = p.Object()
+				case "photo_big":
+					e.PhotoBig = p.Object()
+				case "dc_id":
+					e.DcID = p.Int()
+				default:
+					p.unknownField("userProfilePhoto")
+				}
+			}
+			return e
+		},
+		"userStatusEmpty": func(p *textParser) TL {
+			var e TL_userStatusEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("userStatusEmpty")
+				}
+			}
+			return e
+		},
+		"userStatusOnline": func(p *textParser) TL {
+			var e TL_userStatusOnline
+			for p.field() {
+				switch p.fieldName {
+				case "expires":
+					e.Expires = p.Int()
+				default:
+					p.unknownField("userStatusOnline")
+				}
+			}
+			return e
+		},
+		"userStatusOffline": func(p *textParser) TL {
+			var e TL_userStatusOffline
+			for p.field() {
+				switch p.fieldName {
+				case "was_online":
+					e.WasOnline = p.Int()
+				default:
+					p.unknownField("userStatusOffline")
+				}
+			}
+			return e
+		},
+		"userStatusRecently": func(p *textParser) TL {
+			var e TL_userStatusRecently
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("userStatusRecently")
+				}
+			}
+			return e
+		},
+		"userStatusLastWeek": func(p *textParser) TL {
+			var e TL_userStatusLastWeek
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("userStatusLastWeek")
+				}
+			}
+			return e
+		},
+		"userStatusLastMonth": func(p *textParser) TL {
+			var e TL_userStatusLastMonth
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("userStatusLastMonth")
+				}
+			}
+			return e
+		},
+		"chatEmpty": func(p *textParser) TL {
+			var e TL_chatEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("chatEmpty")
+				}
+			}
+			return e
+		},
+		"chat": func(p *textParser) TL {
+			var e TL_chat
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "creator":
+					e.Creator = p.Bool()
+					if e.Creator {
+						e.Flags |= 1
+					}
+				case "kicked":
+					e.Kicked = p.Bool()
+					if e.Kicked {
+						e.Flags |= 2
+					}
+				case "left":
+					e.Left = p.Bool()
+					if e.Left {
+						e.Flags |= 4
+					}
+				case "deactivated":
+					e.Deactivated = p.Bool()
+					if e.Deactivated {
+						e.Flags |= 32
+					}
+				case "call_active":
+					e.CallActive = p.Bool()
+					if e.CallActive {
+						e.Flags |= 8388608
+					}
+				case "call_not_empty":
+					e.CallNotEmpty = p.Bool()
+					if e.CallNotEmpty {
+						e.Flags |= 16777216
+					}
+				case "id":
+					e.ID = p.Int()
+				case "title":
+					e.Title = p.String()
+				case "photo":
+					e.Photo = p.Object()
+				case "participants_count":
+					e.ParticipantsCount = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "version":
+					e.Version = p.Int()
+				case "migrated_to":
+					e.MigratedTo = p.Object()
+					e.Flags |= 64
+				case "admin_rights":
+					e.AdminRights = p.Object()
+					e.Flags |= 16384
+				case "default_banned_rights":
+					e.DefaultBannedRights = p.Object()
+					e.Flags |= 262144
+				default:
+					p.unknownField("chat")
+				}
+			}
+			return e
+		},
+		"chatForbidden": func(p *textParser) TL {
+			var e TL_chatForbidden
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				case "title":
+					e.Title = p.String()
+				default:
+					p.unknownField("chatForbidden")
+				}
+			}
+			return e
+		},
+		"channel": func(p *textParser) TL {
+			var e TL_channel
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "creator":
+					e.Creator = p.Bool()
+					if e.Creator {
+						e.Flags |= 1
+					}
+				case "left":
+					e.Left = p.Bool()
+					if e.Left {
+						e.Flags |= 4
+					}
+				case "broadcast":
+					e.Broadcast = p.Bool()
+					if e.Broadcast {
+						e.Flags |= 32
+					}
+				case "verified":
+					e.Verified = p.Bool()
+					if e.Verified {
+						e.Flags |= 128
+					}
+				case "megagroup":
+					e.Megagroup = p.Bool()
+					if e.Megagroup {
+						e.Flags |= 256
+					}
+				case "restricted":
+					e.Restricted = p.Bool()
+					if e.Restricted {
+						e.Flags |= 512
+					}
+				case "signatures":
+					e.Signatures = p.Bool()
+					if e.Signatures {
+						e.Flags |= 2048
+					}
+				case "min":
+					e.Min = p.Bool()
+					if e.Min {
+						e.Flags |= 4096
+					}
+				case "scam":
+					e.Scam = p.Bool()
+					if e.Scam {
+						e.Flags |= 524288
+					}
+				case "has_link":
+					e.HasLink = p.Bool()
+					if e.HasLink {
+						e.Flags |= 1048576
+					}
+				case "has_geo":
+					e.HasGeo = p.Bool()
+					if e.HasGeo {
+						e.Flags |= 2097152
+					}
+				case "slowmode_enabled":
+					e.SlowmodeEnabled = p.Bool()
+					if e.SlowmodeEnabled {
+						e.Flags |= 4194304
+					}
+				case "call_active":
+					e.CallActive = p.Bool()
+					if e.CallActive {
+						e.Flags |= 8388608
+					}
+				case "call_not_empty":
+					e.CallNotEmpty = p.Bool()
+					if e.CallNotEmpty {
+						e.Flags |= 16777216
+					}
+				case "fake":
+					e.Fake = p.Bool()
+					if e.Fake {
+						e.Flags |= 33554432
+					}
+				case "gigagroup":
+					e.Gigagroup = p.Bool()
+					if e.Gigagroup {
+						e.Flags |= 67108864
+					}
+				case "id":
+					e.ID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+					e.Flags |= 8192
+				case "title":
+					e.Title = p.String()
+				case "username":
+					e.Username = p.String()
+					e.Flags |= 64
+				case "photo":
+					e.Photo = p.Object()
+				case "date":
+					e.Date = p.Int()
+				case "version":
+					e.Version = p.Int()
+				case "restriction_reason":
+					e.RestrictionReason = p.Vector()
+					e.Flags |= 512
+				case "admin_rights":
+					e.AdminRights = p.Object()
+					e.Flags |= 16384
+				case "banned_rights":
+					e.BannedRights = p.Object()
+					e.Flags |= 32768
+				case "default_banned_rights":
+					e.DefaultBannedRights = p.Object()
+					e.Flags |= 262144
+				case "participants_count":
+					e.ParticipantsCount = p.Int()
+					e.Flags |= 131072
+				default:
+					p.unknownField("channel")
+				}
+			}
+			return e
+		},
+		"channelForbidden": func(p *textParser) TL {
+			var e TL_channelForbidden
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "broadcast":
+					e.Broadcast = p.Bool()
+					if e.Broadcast {
+						e.Flags |= 32
+					}
+				case "megagroup":
+					e.Megagroup = p.Bool()
+					if e.Megagroup {
+						e.Flags |= 256
+					}
+				case "id":
+					e.ID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "title":
+					e.Title = p.String()
+				case "until_date":
+					e.UntilDate = p.Int()
+					e.Flags |= 65536
+				default:
+					p.unknownField("channelForbidden")
+				}
+			}
+			return e
+		},
+		"chatFull": func(p *textParser) TL {
+			var e TL_chatFull
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "can_set_username":
+					e.CanSetUsername = p.Bool()
+					if e.CanSetUsername {
+						e.Flags |= 128
+					}
+				case "has_scheduled":
+					e.HasScheduled = p.Bool()
+					if e.HasScheduled {
+						e.Flags |= 256
+					}
+				case "id":
+					e.ID = p.Int()
+				case "about":
+					e.About = p.String()
+				case "participants":
+					e.Participants = p.Object()
+				case "chat_photo":
+					e.ChatPhoto = p.Object()
+					e.Flags |= 4
+				case "notify_settings":
+					e.NotifySettings = p.Object()
+				case "exported_invite":
+					e.ExportedInvite = p.Object()
+					e.Flags |= 8192
+				case "bot_info":
+					e.BotInfo = p.Vector()
+					e.Flags |= 8
+				case "pinned_msg_id":
+					e.PinnedMsgID = p.Int()
+					e.Flags |= 64
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 2048
+				case "call":
+					e.Call = p.Object()
+					e.Flags |= 4096
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 16384
+				case "groupcall_default_join_as":
+					e.GroupcallDefaultJoinAs = p.Object()
+					e.Flags |= 32768
+				default:
+					p.unknownField("chatFull")
+				}
+			}
+			return e
+		},
+		"channelFull": func(p *textParser) TL {
+			var e TL_channelFull
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "can_view_participants":
+					e.CanViewParticipants = p.Bool()
+					if e.CanViewParticipants {
+						e.Flags |= 8
+					}
+				case "can_set_username":
+					e.CanSetUsername = p.Bool()
+					if e.CanSetUsername {
+						e.Flags |= 64
+					}
+				case "can_set_stickers":
+					e.CanSetStickers = p.Bool()
+					if e.CanSetStickers {
+						e.Flags |= 128
+					}
+				case "hidden_prehistory":
+					e.HiddenPrehistory = p.Bool()
+					if e.HiddenPrehistory {
+						e.Flags |= 1024
+					}
+				case "can_set_location":
+					e.CanSetLocation = p.Bool()
+					if e.CanSetLocation {
+						e.Flags |= 65536
+					}
+				case "has_scheduled":
+					e.HasScheduled = p.Bool()
+					if e.HasScheduled {
+						e.Flags |= 524288
+					}
+				case "can_view_stats":
+					e.CanViewStats = p.Bool()
+					if e.CanViewStats {
+						e.Flags |= 1048576
+					}
+				case "blocked":
+					e.Blocked = p.Bool()
+					if e.Blocked {
+						e.Flags |= 4194304
+					}
+				case "id":
+					e.ID = p.Int()
+				case "about":
+					e.About = p.String()
+				case "participants_count":
+					e.ParticipantsCount = p.Int()
+					e.Flags |= 1
+				case "admins_count":
+					e.AdminsCount = p.Int()
+					e.Flags |= 2
+				case "kicked_count":
+					e.KickedCount = p.Int()
+					e.Flags |= 4
+				case "banned_count":
+					e.BannedCount = p.Int()
+					e.Flags |= 4
+				case "online_count":
+					e.OnlineCount = p.Int()
+					e.Flags |= 8192
+				case "read_inbox_max_id":
+					e.ReadInboxMaxID = p.Int()
+				case "read_outbox_max_id":
+					e.ReadOutboxMaxID = p.Int()
+				case "unread_count":
+					e.UnreadCount = p.Int()
+				case "chat_photo":
+					e.ChatPhoto = p.Object()
+				case "notify_settings":
+					e.NotifySettings = p.Object()
+				case "exported_invite":
+					e.ExportedInvite = p.Object()
+					e.Flags |= 8388608
+				case "bot_info":
+					e.BotInfo = p.Vector()
+				case "migrated_from_chat_id":
+					e.MigratedFromChatID = p.Int()
+					e.Flags |= 16
+				case "migrated_from_max_id":
+					e.MigratedFromMaxID = p.Int()
+					e.Flags |= 16
+				case "pinned_msg_id":
+					e.PinnedMsgID = p.Int()
+					e.Flags |= 32
+				case "stickerset":
+					e.Stickerset = p.Object()
+					e.Flags |= 256
+				case "available_min_id":
+					e.AvailableMinID = p.Int()
+					e.Flags |= 512
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 2048
+				case "linked_chat_id":
+					e.LinkedChatID = p.Int()
+					e.Flags |= 16384
+				case "location":
+					e.Location = p.Object()
+					e.Flags |= 32768
+				case "slowmode_seconds":
+					e.SlowmodeSeconds = p.Int()
+					e.Flags |= 131072
+				case "slowmode_next_send_date":
+					e.SlowmodeNextSendDate = p.Int()
+					e.Flags |= 262144
+				case "stats_dc":
+					e.StatsDc = p.Int()
+					e.Flags |= 4096
+				case "pts":
+					e.Pts = p.Int()
+				case "call":
+					e.Call = p.Object()
+					e.Flags |= 2097152
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 16777216
+				case "pending_suggestions":
+					e.PendingSuggestions = p.VectorString()
+					e.Flags |= 33554432
+				case "groupcall_default_join_as":
+					e.GroupcallDefaultJoinAs = p.Object()
+					e.Flags |= 67108864
+				default:
+					p.unknownField("channelFull")
+				}
+			}
+			return e
+		},
+		"chatParticipant": func(p *textParser) TL {
+			var e TL_chatParticipant
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "inviter_id":
+					e.InviterID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("chatParticipant")
+				}
+			}
+			return e
+		},
+		"chatParticipantCreator": func(p *textParser) TL {
+			var e TL_chatParticipantCreator
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				default:
+					p.unknownField("chatParticipantCreator")
+				}
+			}
+			return e
+		},
+		"chatParticipantAdmin": func(p *textParser) TL {
+			var e TL_chatParticipantAdmin
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "inviter_id":
+					e.InviterID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("chatParticipantAdmin")
+				}
+			}
+			return e
+		},
+		"chatParticipantsForbidden": func(p *textParser) TL {
+			var e TL_chatParticipantsForbidden
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "self_participant":
+					e.SelfParticipant = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("chatParticipantsForbidden")
+				}
+			}
+			return e
+		},
+		"chatParticipants": func(p *textParser) TL {
+			var e TL_chatParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "participants":
+					e.Participants = p.Vector()
+				case "version":
+					e.Version = p.Int()
+				default:
+					p.unknownField("chatParticipants")
+				}
+			}
+			return e
+		},
+		"chatPhotoEmpty": func(p *textParser) TL {
+			var e TL_chatPhotoEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("chatPhotoEmpty")
+				}
+			}
+			return e
+		},
+		"chatPhoto": func(p *textParser) TL {
+			var e TL_chatPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "has_video":
+					e.HasVideo = p.Bool()
+					if e.HasVideo {
+						e.Flags |= 1
+					}
+				case "photo_small":
+					e.PhotoSmall = p.Object()
+				case "photo_big":
+					e.PhotoBig = p.Object()
+				case "dc_id":
+					e.DcID = p.Int()
+				default:
+					p.unknownField("chatPhoto")
+				}
+			}
+			return e
+		},
+		"messageEmpty": func(p *textParser) TL {
+			var e TL_messageEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.Int()
+				case "peer_id":
+					e.PeerID = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("messageEmpty")
+				}
+			}
+			return e
+		},
+		"message": func(p *textParser) TL {
+			var e TL_message
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "out":
+					e.Out = p.Bool()
+					if e.Out {
+						e.Flags |= 2
+					}
+				case "mentioned":
+					e.Mentioned = p.Bool()
+					if e.Mentioned {
+						e.Flags |= 16
+					}
+				case "media_unread":
+					e.MediaUnread = p.Bool()
+					if e.MediaUnread {
+						e.Flags |= 32
+					}
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 8192
+					}
+				case "post":
+					e.Post = p.Bool()
+					if e.Post {
+						e.Flags |= 16384
+					}
+				case "from_scheduled":
+					e.FromScheduled = p.Bool()
+					if e.FromScheduled {
+						e.Flags |= 262144
+					}
+				case "legacy":
+					e.Legacy = p.Bool()
+					if e.Legacy {
+						e.Flags |= 524288
+					}
+				case "edit_hide":
+					e.EditHide = p.Bool()
+					if e.EditHide {
+						e.Flags |= 2097152
+					}
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 16777216
+					}
+				case "id":
+					e.ID = p.Int()
+				case "from_id":
+					e.FromID = p.Object()
+					e.Flags |= 256
+				case "peer_id":
+					e.PeerID = p.Object()
+				case "fwd_from":
+					e.FwdFrom = p.Object()
+					e.Flags |= 4
+				case "via_bot_id":
+					e.ViaBotID = p.Int()
+					e.Flags |= 2048
+				case "reply_to":
+					e.ReplyTo = p.Object()
+					e.Flags |= 8
+				case "date":
+					e.Date = p.Int()
+				case "message":
+					e.Message = p.String()
+				case "media":
+					e.Media = p.Object()
+					e.Flags |= 512
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 64
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 128
+				case "views":
+					e.Views = p.Int()
+					e.Flags |= 1024
+				case "forwards":
+					e.Forwards = p.Int()
+					e.Flags |= 1024
+				case "replies":
+					e.Replies = p.Object()
+					e.Flags |= 8388608
+				case "edit_date":
+					e.EditDate = p.Int()
+					e.Flags |= 32768
+				case "post_author":
+					e.PostAuthor = p.String()
+					e.Flags |= 65536
+				case "grouped_id":
+					e.GroupedID = p.Long()
+					e.Flags |= 131072
+				case "restriction_reason":
+					e.RestrictionReason = p.Vector()
+					e.Flags |= 4194304
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 33554432
+				default:
+					p.unknownField("message")
+				}
+			}
+			return e
+		},
+		"messageService": func(p *textParser) TL {
+			var e TL_messageService
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "out":
+					e.Out = p.Bool()
+					if e.Out {
+						e.Flags |= 2
+					}
+				case "mentioned":
+					e.Mentioned = p.Bool()
+					if e.Mentioned {
+						e.Flags |= 16
+					}
+				case "media_unread":
+					e.MediaUnread = p.Bool()
+					if e.MediaUnread {
+						e.Flags |= 32
+					}
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 8192
+					}
+				case "post":
+					e.Post = p.Bool()
+					if e.Post {
+						e.Flags |= 16384
+					}
+				case "legacy":
+					e.Legacy = p.Bool()
+					if e.Legacy {
+						e.Flags |= 524288
+					}
+				case "id":
+					e.ID = p.Int()
+				case "from_id":
+					e.FromID = p.Object()
+					e.Flags |= 256
+				case "peer_id":
+					e.PeerID = p.Object()
+				case "reply_to":
+					e.ReplyTo = p.Object()
+					e.Flags |= 8
+				case "date":
+					e.Date = p.Int()
+				case "action":
+					e.Action = p.Object()
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 33554432
+				default:
+					p.unknownField("messageService")
+				}
+			}
+			return e
+		},
+		"messageMediaEmpty": func(p *textParser) TL {
+			var e TL_messageMediaEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messageMediaEmpty")
+				}
+			}
+			return e
+		},
+		"messageMediaPhoto": func(p *textParser) TL {
+			var e TL_messageMediaPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "photo":
+					e.Photo = p.Object()
+					e.Flags |= 1
+				case "ttl_seconds":
+					e.TtlSeconds = p.Int()
+					e.Flags |= 4
+				default:
+					p.unknownField("messageMediaPhoto")
+				}
+			}
+			return e
+		},
+		"messageMediaGeo": func(p *textParser) TL {
+			var e TL_messageMediaGeo
+			for p.field() {
+				switch p.fieldName {
+				case "geo":
+					e.Geo = p.Object()
+				default:
+					p.unknownField("messageMediaGeo")
+				}
+			}
+			return e
+		},
+		"messageMediaContact": func(p *textParser) TL {
+			var e TL_messageMediaContact
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				case "vcard":
+					e.Vcard = p.String()
+				case "user_id":
+					e.UserID = p.Int()
+				default:
+					p.unknownField("messageMediaContact")
+				}
+			}
+			return e
+		},
+		"messageMediaUnsupported": func(p *textParser) TL {
+			var e TL_messageMediaUnsupported
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messageMediaUnsupported")
+				}
+			}
+			return e
+		},
+		"messageMediaDocument": func(p *textParser) TL {
+			var e TL_messageMediaDocument
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "document":
+					e.Document = p.Object()
+					e.Flags |= 1
+				case "ttl_seconds":
+					e.TtlSeconds = p.Int()
+					e.Flags |= 4
+				default:
+					p.unknownField("messageMediaDocument")
+				}
+			}
+			return e
+		},
+		"messageMediaWebPage": func(p *textParser) TL {
+			var e TL_messageMediaWebPage
+			for p.field() {
+				switch p.fieldName {
+				case "webpage":
+					e.Webpage = p.Object()
+				default:
+					p.unknownField("messageMediaWebPage")
+				}
+			}
+			return e
+		},
+		"messageMediaVenue": func(p *textParser) TL {
+			var e TL_messageMediaVenue
+			for p.field() {
+				switch p.fieldName {
+				case "geo":
+					e.Geo = p.Object()
+				case "title":
+					e.Title = p.String()
+				case "address":
+					e.Address = p.String()
+				case "provider":
+					e.Provider = p.String()
+				case "venue_id":
+					e.VenueID = p.String()
+				case "venue_type":
+					e.VenueType = p.String()
+				default:
+					p.unknownField("messageMediaVenue")
+				}
+			}
+			return e
+		},
+		"messageMediaGame": func(p *textParser) TL {
+			var e TL_messageMediaGame
+			for p.field() {
+				switch p.fieldName {
+				case "game":
+					e.Game = p.Object()
+				default:
+					p.unknownField("messageMediaGame")
+				}
+			}
+			return e
+		},
+		"messageMediaInvoice": func(p *textParser) TL {
+			var e TL_messageMediaInvoice
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "shipping_address_requested":
+					e.ShippingAddressRequested = p.Bool()
+					if e.ShippingAddressRequested {
+						e.Flags |= 2
+					}
+				case "test":
+					e.Test = p.Bool()
+					if e.Test {
+						e.Flags |= 8
+					}
+				case "title":
+					e.Title = p.String()
+				case "description":
+					e.Description = p.String()
+				case "photo":
+					e.Photo = p.Object()
+					e.Flags |= 1
+				case "receipt_msg_id":
+					e.ReceiptMsgID = p.Int()
+					e.Flags |= 4
+				case "currency":
+					e.Currency = p.String()
+				case "total_amount":
+					e.TotalAmount = p.Long()
+				case "start_param":
+					e.StartParam = p.String()
+				default:
+					p.unknownField("messageMediaInvoice")
+				}
+			}
+			return e
+		},
+		"messageMediaGeoLive": func(p *textParser) TL {
+			var e TL_messageMediaGeoLive
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "geo":
+					e.Geo = p.Object()
+				case "heading":
+					e.Heading = p.Int()
+					e.Flags |= 1
+				case "period":
+					e.Period = p.Int()
+				case "proximity_notification_radius":
+					e.ProximityNotificationRadius = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("messageMediaGeoLive")
+				}
+			}
+			return e
+		},
+		"messageMediaPoll": func(p *textParser) TL {
+			var e TL_messageMediaPoll
+			for p.field() {
+				switch p.fieldName {
+				case "poll":
+					e.Poll = p.Object()
+				case "results":
+					e.Results = p.Object()
+				default:
+					p.unknownField("messageMediaPoll")
+				}
+			}
+			return e
+		},
+		"messageMediaDice": func(p *textParser) TL {
+			var e TL_messageMediaDice
+			for p.field() {
+				switch p.fieldName {
+				case "value":
+					e.Value = p.Int()
+				case "emoticon":
+					e.Emoticon = p.String()
+				default:
+					p.unknownField("messageMediaDice")
+				}
+			}
+			return e
+		},
+		"messageActionEmpty": func(p *textParser) TL {
+			var e TL_messageActionEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messageActionEmpty")
+				}
+			}
+			return e
+		},
+		"messageActionChatCreate": func(p *textParser) TL {
+			var e TL_messageActionChatCreate
+			for p.field() {
+				switch p.fieldName {
+				case "title":
+					e.Title = p.String()
+				case "users":
+					e.Users = p.VectorInt()
+				default:
+					p.unknownField("messageActionChatCreate")
+				}
+			}
+			return e
+		},
+		"messageActionChatEditTitle": func(p *textParser) TL {
+			var e TL_messageActionChatEditTitle
+			for p.field() {
+				switch p.fieldName {
+				case "title":
+					e.Title = p.String()
+				default:
+					p.unknownField("messageActionChatEditTitle")
+				}
+			}
+			return e
+		},
+		"messageActionChatEditPhoto": func(p *textParser) TL {
+			var e TL_messageActionChatEditPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "photo":
+					e.Photo = p.Object()
+				default:
+					p.unknownField("messageActionChatEditPhoto")
+				}
+			}
+			return e
+		},
+		"messageActionChatDeletePhoto": func(p *textParser) TL {
+			var e TL_messageActionChatDeletePhoto
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messageActionChatDeletePhoto")
+				}
+			}
+			return e
+		},
+		"messageActionChatAddUser": func(p *textParser) TL {
+			var e TL_messageActionChatAddUser
+			for p.field() {
+				switch p.fieldName {
+				case "users":
+					e.Users = p.VectorInt()
+				default:
+					p.unknownField("messageActionChatAddUser")
+				}
+			}
+			return e
+		},
+		"messageActionChatDeleteUser": func(p *textParser) TL {
+			var e TL_messageActionChatDeleteUser
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				default:
+					p.unknownField("messageActionChatDeleteUser")
+				}
+			}
+			return e
+		},
+		"messageActionChatJoinedByLink": func(p *textParser) TL {
+			var e TL_messageActionChatJoinedByLink
+			for p.field() {
+				switch p.fieldName {
+				case "inviter_id":
+					e.InviterID = p.Int()
+				default:
+					p.unknownField("messageActionChatJoinedByLink")
+				}
+			}
+			return e
+		},
+		"messageActionChannelCreate": func(p *textParser) TL {
+			var e TL_messageActionChannelCreate
+			for p.field() {
+				switch p.fieldName {
+				case "title":
+					e.Title = p.String()
+				default:
+					p.unknownField("messageActionChannelCreate")
+				}
+			}
+			return e
+		},
+		"messageActionChatMigrateTo": func(p *textParser) TL {
+			var e TL_messageActionChatMigrateTo
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				default:
+					p.unknownField("messageActionChatMigrateTo")
+				}
+			}
+			return e
+		},
+		"messageActionChannelMigrateFrom": func(p *textParser) TL {
+			var e TL_messageActionChannelMigrateFrom
+			for p.field() {
+				switch p.fieldName {
+				case "title":
+					e.Title = p.String()
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("messageActionChannelMigrateFrom")
+				}
+			}
+			return e
+		},
+		"messageActionPinMessage": func(p *textParser) TL {
+			var e TL_messageActionPinMessage
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messageActionPinMessage")
+				}
+			}
+			return e
+		},
+		"messageActionHistoryClear": func(p *textParser) TL {
+			var e TL_messageActionHistoryClear
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messageActionHistoryClear")
+				}
+			}
+			return e
+		},
+		"messageActionGameScore": func(p *textParser) TL {
+			var e TL_messageActionGameScore
+			for p.field() {
+				switch p.fieldName {
+				case "game_id":
+					e.GameID = p.Long()
+				case "score":
+					e.Score = p.Int()
+				default:
+					p.unknownField("messageActionGameScore")
+				}
+			}
+			return e
+		},
+		"messageActionPaymentSentMe": func(p *textParser) TL {
+			var e TL_messageActionPaymentSentMe
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "currency":
+					e.Currency = p.String()
+				case "total_amount":
+					e.TotalAmount = p.Long()
+				case "payload":
+					e.Payload = p.Bytes()
+				case "info":
+					e.Info = p.Object()
+					e.Flags |= 1
+				case "shipping_option_id":
+					e.ShippingOptionID = p.String()
+					e.Flags |= 2
+				case "charge":
+					e.Charge = p.Object()
+				default:
+					p.unknownField("messageActionPaymentSentMe")
+				}
+			}
+			return e
+		},
+		"messageActionPaymentSent": func(p *textParser) TL {
+			var e TL_messageActionPaymentSent
+			for p.field() {
+				switch p.fieldName {
+				case "currency":
+					e.Currency = p.String()
+				case "total_amount":
+					e.TotalAmount = p.Long()
+				default:
+					p.unknownField("messageActionPaymentSent")
+				}
+			}
+			return e
+		},
+		"messageActionPhoneCall": func(p *textParser) TL {
+			var e TL_messageActionPhoneCall
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "video":
+					e.Video = p.Bool()
+					if e.Video {
+						e.Flags |= 4
+					}
+				case "call_id":
+					e.CallID = p.Long()
+				case "reason":
+					e.Reason = p.Object()
+					e.Flags |= 1
+				case "duration":
+					e.Duration = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("messageActionPhoneCall")
+				}
+			}
+			return e
+		},
+		"messageActionScreenshotTaken": func(p *textParser) TL {
+			var e TL_messageActionScreenshotTaken
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messageActionScreenshotTaken")
+				}
+			}
+			return e
+		},
+		"messageActionCustomAction": func(p *textParser) TL {
+			var e TL_messageActionCustomAction
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.String()
+				default:
+					p.unknownField("messageActionCustomAction")
+				}
+			}
+			return e
+		},
+		"messageActionBotAllowed": func(p *textParser) TL {
+			var e TL_messageActionBotAllowed
+			for p.field() {
+				switch p.fieldName {
+				case "domain":
+					e.Domain = p.String()
+				default:
+					p.unknownField("messageActionBotAllowed")
+				}
+			}
+			return e
+		},
+		"messageActionSecureValuesSentMe": func(p *textParser) TL {
+			var e TL_messageActionSecureValuesSentMe
+			for p.field() {
+				switch p.fieldName {
+				case "values":
+					e.Values = p.Vector()
+				case "credentials":
+					e.Credentials = p.Object()
+				default:
+					p.unknownField("messageActionSecureValuesSentMe")
+				}
+			}
+			return e
+		},
+		"messageActionSecureValuesSent": func(p *textParser) TL {
+			var e TL_messageActionSecureValuesSent
+			for p.field() {
+				switch p.fieldName {
+				case "types":
+					e.Types = p.Vector()
+				default:
+					p.unknownField("messageActionSecureValuesSent")
+				}
+			}
+			return e
+		},
+		"messageActionContactSignUp": func(p *textParser) TL {
+			var e TL_messageActionContactSignUp
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messageActionContactSignUp")
+				}
+			}
+			return e
+		},
+		"messageActionGeoProximityReached": func(p *textParser) TL {
+			var e TL_messageActionGeoProximityReached
+			for p.field() {
+				switch p.fieldName {
+				case "from_id":
+					e.FromID = p.Object()
+				case "to_id":
+					e.ToID = p.Object()
+				case "distance":
+					e.Distance = p.Int()
+				default:
+					p.unknownField("messageActionGeoProximityReached")
+				}
+			}
+			return e
+		},
+		"messageActionGroupCall": func(p *textParser) TL {
+			var e TL_messageActionGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "call":
+					e.Call = p.Object()
+				case "duration":
+					e.Duration = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("messageActionGroupCall")
+				}
+			}
+			return e
+		},
+		"messageActionInviteToGroupCall": func(p *textParser) TL {
+			var e TL_messageActionInviteToGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "users":
+					e.Users = p.VectorInt()
+				default:
+					p.unknownField("messageActionInviteToGroupCall")
+				}
+			}
+			return e
+		},
+		"messageActionSetMessagesTTL": func(p *textParser) TL {
+			var e TL_messageActionSetMessagesTTL
+			for p.field() {
+				switch p.fieldName {
+				case "period":
+					e.Period = p.Int()
+				default:
+					p.unknownField("messageActionSetMessagesTTL")
+				}
+			}
+			return e
+		},
+		"dialog": func(p *textParser) TL {
+			var e TL_dialog
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 4
+					}
+				case "unread_mark":
+					e.UnreadMark = p.Bool()
+					if e.UnreadMark {
+						e.Flags |= 8
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "top_message":
+					e.TopMessage = p.Int()
+				case "read_inbox_max_id":
+					e.ReadInboxMaxID = p.Int()
+				case "read_outbox_max_id":
+					e.ReadOutboxMaxID = p.Int()
+				case "unread_count":
+					e.UnreadCount = p.Int()
+				case "unread_mentions_count":
+					e.UnreadMentionsCount = p.Int()
+				case "notify_settings":
+					e.NotifySettings = p.Object()
+				case "pts":
+					e.Pts = p.Int()
+					e.Flags |= 1
+				case "draft":
+					e.Draft = p.Object()
+					e.Flags |= 2
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 16
+				default:
+					p.unknownField("dialog")
+				}
+			}
+			return e
+		},
+		"dialogFolder": func(p *textParser) TL {
+			var e TL_dialogFolder
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 4
+					}
+				case "folder":
+					e.Folder = p.Object()
+				case "peer":
+					e.Peer = p.Object()
+				case "top_message":
+					e.TopMessage = p.Int()
+				case "unread_muted_peers_count":
+					e.UnreadMutedPeersCount = p.Int()
+				case "unread_unmuted_peers_count":
+					e.UnreadUnmutedPeersCount = p.Int()
+				case "unread_muted_messages_count":
+					e.UnreadMutedMessagesCount = p.Int()
+				case "unread_unmuted_messages_count":
+					e.UnreadUnmutedMessagesCount = p.Int()
+				default:
+					p.unknownField("dialogFolder")
+				}
+			}
+			return e
+		},
+		"photoEmpty": func(p *textParser) TL {
+			var e TL_photoEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				default:
+					p.unknownField("photoEmpty")
+				}
+			}
+			return e
+		},
+		"photo": func(p *textParser) TL {
+			var e TL_photo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "has_stickers":
+					e.HasStickers = p.Bool()
+					if e.HasStickers {
+						e.Flags |= 1
+					}
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "file_reference":
+					e.FileReference = p.Bytes()
+				case "date":
+					e.Date = p.Int()
+				case "sizes":
+					e.Sizes = p.Vector()
+				case "video_sizes":
+					e.VideoSizes = p.Vector()
+					e.Flags |= 2
+				case "dc_id":
+					e.DcID = p.Int()
+				default:
+					p.unknownField("photo")
+				}
+			}
+			return e
+		},
+		"photoSizeEmpty": func(p *textParser) TL {
+			var e TL_photoSizeEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.String()
+				default:
+					p.unknownField("photoSizeEmpty")
+				}
+			}
+			return e
+		},
+		"photoSize": func(p *textParser) TL {
+			var e TL_photoSize
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.String()
+				case "location":
+					e.Location = p.Object()
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				case "size":
+					e.Size = p.Int()
+				default:
+					p.unknownField("photoSize")
+				}
+			}
+			return e
+		},
+		"photoCachedSize": func(p *textParser) TL {
+			var e TL_photoCachedSize
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.String()
+				case "location":
+					e.Location = p.Object()
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("photoCachedSize")
+				}
+			}
+			return e
+		},
+		"photoStrippedSize": func(p *textParser) TL {
+			var e TL_photoStrippedSize
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.String()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("photoStrippedSize")
+				}
+			}
+			return e
+		},
+		"photoSizeProgressive": func(p *textParser) TL {
+			var e TL_photoSizeProgressive
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.String()
+				case "location":
+					e.Location = p.Object()
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				case "sizes":
+					e.Sizes = p.VectorInt()
+				default:
+					p.unknownField("photoSizeProgressive")
+				}
+			}
+			return e
+		},
+		"photoPathSize": func(p *textParser) TL {
+			var e TL_photoPathSize
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.String()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("photoPathSize")
+				}
+			}
+			return e
+		},
+		"geoPointEmpty": func(p *textParser) TL {
+			var e TL_geoPointEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("geoPointEmpty")
+				}
+			}
+			return e
+		},
+		"geoPoint": func(p *textParser) TL {
+			var e TL_geoPoint
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "long":
+					e.Long = p.Double()
+				case "lat":
+					e.Lat = p.Double()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "accuracy_radius":
+					e.AccuracyRadius = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("geoPoint")
+				}
+			}
+			return e
+		},
+		"auth.sentCode": func(p *textParser) TL {
+			var e TL_auth_sentCode
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "type":
+					e.Type = p.Object()
+				case "phone_code_hash":
+					e.PhoneCodeHash = p.String()
+				case "next_type":
+					e.NextType = p.Object()
+					e.Flags |= 2
+				case "timeout":
+					e.Timeout = p.Int()
+					e.Flags |= 4
+				default:
+					p.unknownField("auth.sentCode")
+				}
+			}
+			return e
+		},
+		"auth.authorization": func(p *textParser) TL {
+			var e TL_auth_authorization
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "tmp_sessions":
+					e.TmpSessions = p.Int()
+					e.Flags |= 1
+				case "user":
+					e.User = p.Object()
+				default:
+					p.unknownField("auth.authorization")
+				}
+			}
+			return e
+		},
+		"auth.authorizationSignUpRequired": func(p *textParser) TL {
+			var e TL_auth_authorizationSignUpRequired
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "terms_of_service":
+					e.TermsOfService = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("auth.authorizationSignUpRequired")
+				}
+			}
+			return e
+		},
+		"auth.exportedAuthorization": func(p *textParser) TL {
+			var e TL_auth_exportedAuthorization
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("auth.exportedAuthorization")
+				}
+			}
+			return e
+		},
+		"inputNotifyPeer": func(p *textParser) TL {
+			var e TL_inputNotifyPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("inputNotifyPeer")
+				}
+			}
+			return e
+		},
+		"inputNotifyUsers": func(p *textParser) TL {
+			var e TL_inputNotifyUsers
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputNotifyUsers")
+				}
+			}
+			return e
+		},
+		"inputNotifyChats": func(p *textParser) TL {
+			var e TL_inputNotifyChats
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputNotifyChats")
+				}
+			}
+			return e
+		},
+		"inputNotifyBroadcasts": func(p *textParser) TL {
+			var e TL_inputNotifyBroadcasts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputNotifyBroadcasts")
+				}
+			}
+			return e
+		},
+		"inputPeerNotifySettings": func(p *textParser) TL {
+			var e TL_inputPeerNotifySettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "show_previews":
+					e.ShowPreviews = p.Object()
+					e.Flags |= 1
+				case "silent":
+					e.Silent = p.Object()
+					e.Flags |= 2
+				case "mute_until":
+					e.MuteUntil = p.Int()
+					e.Flags |= 4
+				case "sound":
+					e.Sound = p.String()
+					e.Flags |= 8
+				default:
+					p.unknownField("inputPeerNotifySettings")
+				}
+			}
+			return e
+		},
+		"peerNotifySettings": func(p *textParser) TL {
+			var e TL_peerNotifySettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "show_previews":
+					e.ShowPreviews = p.Object()
+					e.Flags |= 1
+				case "silent":
+					e.Silent = p.Object()
+					e.Flags |= 2
+				case "mute_until":
+					e.MuteUntil = p.Int()
+					e.Flags |= 4
+				case "sound":
+					e.Sound = p.String()
+					e.Flags |= 8
+				default:
+					p.unknownField("peerNotifySettings")
+				}
+			}
+			return e
+		},
+		"peerSettings": func(p *textParser) TL {
+			var e TL_peerSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "report_spam":
+					e.ReportSpam = p.Bool()
+					if e.ReportSpam {
+						e.Flags |= 1
+					}
+				case "add_contact":
+					e.AddContact = p.Bool()
+					if e.AddContact {
+						e.Flags |= 2
+					}
+				case "block_contact":
+					e.BlockContact = p.Bool()
+					if e.BlockContact {
+						e.Flags |= 4
+					}
+				case "share_contact":
+					e.ShareContact = p.Bool()
+					if e.ShareContact {
+						e.Flags |= 8
+					}
+				case "need_contacts_exception":
+					e.NeedContactsException = p.Bool()
+					if e.NeedContactsException {
+						e.Flags |= 16
+					}
+				case "report_geo":
+					e.ReportGeo = p.Bool()
+					if e.ReportGeo {
+						e.Flags |= 32
+					}
+				case "autoarchived":
+					e.Autoarchived = p.Bool()
+					if e.Autoarchived {
+						e.Flags |= 128
+					}
+				case "invite_members":
+					e.InviteMembers = p.Bool()
+					if e.InviteMembers {
+						e.Flags |= 256
+					}
+				case "geo_distance":
+					e.GeoDistance = p.Int()
+					e.Flags |= 64
+				default:
+					p.unknownField("peerSettings")
+				}
+			}
+			return e
+		},
+		"wallPaper": func(p *textParser) TL {
+			var e TL_wallPaper
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "flags":
+					e.Flags |= p.Int()
+				case "creator":
+					e.Creator = p.Bool()
+					if e.Creator {
+						e.Flags |= 1
+					}
+				case "default":
+					e.Default = p.Bool()
+					if e.Default {
+						e.Flags |= 2
+					}
+				case "pattern":
+					e.Pattern = p.Bool()
+					if e.Pattern {
+						e.Flags |= 8
+					}
+				case "dark":
+					e.Dark = p.Bool()
+					if e.Dark {
+						e.Flags |= 16
+					}
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "slug":
+					e.Slug = p.String()
+				case "document":
+					e.Document = p.Object()
+				case "settings":
+					e.Settings = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("wallPaper")
+				}
+			}
+			return e
+		},
+		"wallPaperNoFile": func(p *textParser) TL {
+			var e TL_wallPaperNoFile
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "default":
+					e.Default = p.Bool()
+					if e.Default {
+						e.Flags |= 2
+					}
+				case "dark":
+					e.Dark = p.Bool()
+					if e.Dark {
+						e.Flags |= 16
+					}
+				case "settings":
+					e.Settings = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("wallPaperNoFile")
+				}
+			}
+			return e
+		},
+		"inputReportReasonSpam": func(p *textParser) TL {
+			var e TL_inputReportReasonSpam
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputReportReasonSpam")
+				}
+			}
+			return e
+		},
+		"inputReportReasonViolence": func(p *textParser) TL {
+			var e TL_inputReportReasonViolence
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputReportReasonViolence")
+				}
+			}
+			return e
+		},
+		"inputReportReasonPornography": func(p *textParser) TL {
+			var e TL_inputReportReasonPornography
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputReportReasonPornography")
+				}
+			}
+			return e
+		},
+		"inputReportReasonChildAbuse": func(p *textParser) TL {
+			var e TL_inputReportReasonChildAbuse
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputReportReasonChildAbuse")
+				}
+			}
+			return e
+		},
+		"inputReportReasonOther": func(p *textParser) TL {
+			var e TL_inputReportReasonOther
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputReportReasonOther")
+				}
+			}
+			return e
+		},
+		"inputReportReasonCopyright": func(p *textParser) TL {
+			var e TL_inputReportReasonCopyright
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputReportReasonCopyright")
+				}
+			}
+			return e
+		},
+		"inputReportReasonGeoIrrelevant": func(p *textParser) TL {
+			var e TL_inputReportReasonGeoIrrelevant
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputReportReasonGeoIrrelevant")
+				}
+			}
+			return e
+		},
+		"inputReportReasonFake": func(p *textParser) TL {
+			var e TL_inputReportReasonFake
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputReportReasonFake")
+				}
+			}
+			return e
+		},
+		"userFull": func(p *textParser) TL {
+			var e TL_userFull
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "blocked":
+					e.Blocked = p.Bool()
+					if e.Blocked {
+						e.Flags |= 1
+					}
+				case "phone_calls_available":
+					e.PhoneCallsAvailable = p.Bool()
+					if e.PhoneCallsAvailable {
+						e.Flags |= 16
+					}
+				case "phone_calls_private":
+					e.PhoneCallsPrivate = p.Bool()
+					if e.PhoneCallsPrivate {
+						e.Flags |= 32
+					}
+				case "can_pin_message":
+					e.CanPinMessage = p.Bool()
+					if e.CanPinMessage {
+						e.Flags |= 128
+					}
+				case "has_scheduled":
+					e.HasScheduled = p.Bool()
+					if e.HasScheduled {
+						e.Flags |= 4096
+					}
+				case "video_calls_available":
+					e.VideoCallsAvailable = p.Bool()
+					if e.VideoCallsAvailable {
+						e.Flags |= 8192
+					}
+				case "user":
+					e.User = p.Object()
+				case "about":
+					e.About = p.String()
+					e.Flags |= 2
+				case "settings":
+					e.Settings = p.Object()
+				case "profile_photo":
+					e.ProfilePhoto = p.Object()
+					e.Flags |= 4
+				case "notify_settings":
+					e.NotifySettings = p.Object()
+				case "bot_info":
+					e.BotInfo = p.Object()
+					e.Flags |= 8
+				case "pinned_msg_id":
+					e.PinnedMsgID = p.Int()
+					e.Flags |= 64
+				case "common_chats_count":
+					e.CommonChatsCount = p.Int()
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 2048
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 16384
+				default:
+					p.unknownField("userFull")
+				}
+			}
+			return e
+		},
+		"contact": func(p *textParser) TL {
+			var e TL_contact
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "mutual":
+					e.Mutual = p.Object()
+				default:
+					p.unknownField("contact")
+				}
+			}
+			return e
+		},
+		"importedContact": func(p *textParser) TL {
+			var e TL_importedContact
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "client_id":
+					e.ClientID = p.Long()
+				default:
+					p.unknownField("importedContact")
+				}
+			}
+			return e
+		},
+		"contactStatus": func(p *textParser) TL {
+			var e TL_contactStatus
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "status":
+					e.Status = p.Object()
+				default:
+					p.unknownField("contactStatus")
+				}
+			}
+			return e
+		},
+		"contacts.contactsNotModified": func(p *textParser) TL {
+			var e TL_contacts_contactsNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("contacts.contactsNotModified")
+				}
+			}
+			return e
+		},
+		"contacts.contacts": func(p *textParser) TL {
+			var e TL_contacts_contacts
+			for p.field() {
+				switch p.fieldName {
+				case "contacts":
+					e.Contacts = p.Vector()
+				case "saved_count":
+					e.SavedCount = p.Int()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("contacts.contacts")
+				}
+			}
+			return e
+		},
+		"contacts.importedContacts": func(p *textParser) TL {
+			var e TL_contacts_importedContacts
+			for p.field() {
+				switch p.fieldName {
+				case "imported":
+					e.Imported = p.Vector()
+				case "popular_invites":
+					e.PopularInvites = p.Vector()
+				case "retry_contacts":
+					e.RetryContacts = p.VectorLong()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("contacts.importedContacts")
+				}
+			}
+			return e
+		},
+		"contacts.blocked": func(p *textParser) TL {
+			var e TL_contacts_blocked
+			for p.field() {
+				switch p.fieldName {
+				case "blocked":
+					e.Blocked = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("contacts.blocked")
+				}
+			}
+			return e
+		},
+		"contacts.blockedSlice": func(p *textParser) TL {
+			var e TL_contacts_blockedSlice
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "blocked":
+					e.Blocked = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("contacts.blockedSlice")
+				}
+			}
+			return e
+		},
+		"messages.dialogs": func(p *textParser) TL {
+			var e TL_messages_dialogs
+			for p.field() {
+				switch p.fieldName {
+				case "dialogs":
+					e.Dialogs = p.Vector()
+				case "messages":
+					e.Messages = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.dialogs")
+				}
+			}
+			return e
+		},
+		"messages.dialogsSlice": func(p *textParser) TL {
+			var e TL_messages_dialogsSlice
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "dialogs":
+					e.Dialogs = p.Vector()
+				case "messages":
+					e.Messages = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.dialogsSlice")
+				}
+			}
+			return e
+		},
+		"messages.dialogsNotModified": func(p *textParser) TL {
+			var e TL_messages_dialogsNotModified
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				default:
+					p.unknownField("messages.dialogsNotModified")
+				}
+			}
+			return e
+		},
+		"messages.messages": func(p *textParser) TL {
+			var e TL_messages_messages
+			for p.field() {
+				switch p.fieldName {
+				case "messages":
+					e.Messages = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.messages")
+				}
+			}
+			return e
+		},
+		"messages.messagesSlice": func(p *textParser) TL {
+			var e TL_messages_messagesSlice
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "inexact":
+					e.Inexact = p.Bool()
+					if e.Inexact {
+						e.Flags |= 2
+					}
+				case "count":
+					e.Count = p.Int()
+				case "next_rate":
+					e.NextRate = p.Int()
+					e.Flags |= 1
+				case "offset_id_offset":
+					e.OffsetIdOffset = p.Int()
+					e.Flags |= 4
+				case "messages":
+					e.Messages = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.messagesSlice")
+				}
+			}
+			return e
+		},
+		"messages.channelMessages": func(p *textParser) TL {
+			var e TL_messages_channelMessages
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "inexact":
+					e.Inexact = p.Bool()
+					if e.Inexact {
+						e.Flags |= 2
+					}
+				case "pts":
+					e.Pts = p.Int()
+				case "count":
+					e.Count = p.Int()
+				case "offset_id_offset":
+					e.OffsetIdOffset = p.Int()
+					e.Flags |= 4
+				case "messages":
+					e.Messages = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.channelMessages")
+				}
+			}
+			return e
+		},
+		"messages.messagesNotModified": func(p *textParser) TL {
+			var e TL_messages_messagesNotModified
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				default:
+					p.unknownField("messages.messagesNotModified")
+				}
+			}
+			return e
+		},
+		"messages.chats": func(p *textParser) TL {
+			var e TL_messages_chats
+			for p.field() {
+				switch p.fieldName {
+				case "chats":
+					e.Chats = p.Vector()
+				default:
+					p.unknownField("messages.chats")
+				}
+			}
+			return e
+		},
+		"messages.chatsSlice": func(p *textParser) TL {
+			var e TL_messages_chatsSlice
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "chats":
+					e.Chats = p.Vector()
+				default:
+					p.unknownField("messages.chatsSlice")
+				}
+			}
+			return e
+		},
+		"messages.chatFull": func(p *textParser) TL {
+			var e TL_messages_chatFull
+			for p.field() {
+				switch p.fieldName {
+				case "full_chat":
+					e.FullChat = p.Object()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.chatFull")
+				}
+			}
+			return e
+		},
+		"messages.affectedHistory": func(p *textParser) TL {
+			var e TL_messages_affectedHistory
+			for p.field() {
+				switch p.fieldName {
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				case "offset":
+					e.Offset = p.Int()
+				default:
+					p.unknownField("messages.affectedHistory")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterEmpty": func(p *textParser) TL {
+			var e TL_inputMessagesFilterEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterEmpty")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterPhotos": func(p *textParser) TL {
+			var e TL_inputMessagesFilterPhotos
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterPhotos")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterVideo": func(p *textParser) TL {
+			var e TL_inputMessagesFilterVideo
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterVideo")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterPhotoVideo": func(p *textParser) TL {
+			var e TL_inputMessagesFilterPhotoVideo
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterPhotoVideo")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterDocument": func(p *textParser) TL {
+			var e TL_inputMessagesFilterDocument
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterDocument")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterUrl": func(p *textParser) TL {
+			var e TL_inputMessagesFilterUrl
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterUrl")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterGif": func(p *textParser) TL {
+			var e TL_inputMessagesFilterGif
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterGif")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterVoice": func(p *textParser) TL {
+			var e TL_inputMessagesFilterVoice
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterVoice")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterMusic": func(p *textParser) TL {
+			var e TL_inputMessagesFilterMusic
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterMusic")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterChatPhotos": func(p *textParser) TL {
+			var e TL_inputMessagesFilterChatPhotos
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterChatPhotos")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterPhoneCalls": func(p *textParser) TL {
+			var e TL_inputMessagesFilterPhoneCalls
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "missed":
+					e.Missed = p.Bool()
+					if e.Missed {
+						e.Flags |= 1
+					}
+				default:
+					p.unknownField("inputMessagesFilterPhoneCalls")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterRoundVoice": func(p *textParser) TL {
+			var e TL_inputMessagesFilterRoundVoice
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterRoundVoice")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterRoundVideo": func(p *textParser) TL {
+			var e TL_inputMessagesFilterRoundVideo
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterRoundVideo")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterMyMentions": func(p *textParser) TL {
+			var e TL_inputMessagesFilterMyMentions
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterMyMentions")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterGeo": func(p *textParser) TL {
+			var e TL_inputMessagesFilterGeo
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterGeo")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterContacts": func(p *textParser) TL {
+			var e TL_inputMessagesFilterContacts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterContacts")
+				}
+			}
+			return e
+		},
+		"inputMessagesFilterPinned": func(p *textParser) TL {
+			var e TL_inputMessagesFilterPinned
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagesFilterPinned")
+				}
+			}
+			return e
+		},
+		"updateNewMessage": func(p *textParser) TL {
+			var e TL_updateNewMessage
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateNewMessage")
+				}
+			}
+			return e
+		},
+		"updateMessageID": func(p *textParser) TL {
+			var e TL_updateMessageID
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				case "random_id":
+					e.RandomID = p.Long()
+				default:
+					p.unknownField("updateMessageID")
+				}
+			}
+			return e
+		},
+		"updateDeleteMessages": func(p *textParser) TL {
+			var e TL_updateDeleteMessages
+			for p.field() {
+				switch p.fieldName {
+				case "messages":
+					e.Messages = p.VectorInt()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateDeleteMessages")
+				}
+			}
+			return e
+		},
+		"updateUserTyping": func(p *textParser) TL {
+			var e TL_updateUserTyping
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "action":
+					e.Action = p.Object()
+				default:
+					p.unknownField("updateUserTyping")
+				}
+			}
+			return e
+		},
+		"updateChatUserTyping": func(p *textParser) TL {
+			var e TL_updateChatUserTyping
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "from_id":
+					e.FromID = p.Object()
+				case "action":
+					e.Action = p.Object()
+				default:
+					p.unknownField("updateChatUserTyping")
+				}
+			}
+			return e
+		},
+		"updateChatParticipants": func(p *textParser) TL {
+			var e TL_updateChatParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "participants":
+					e.Participants = p.Object()
+				default:
+					p.unknownField("updateChatParticipants")
+				}
+			}
+			return e
+		},
+		"updateUserStatus": func(p *textParser) TL {
+			var e TL_updateUserStatus
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "status":
+					e.Status = p.Object()
+				default:
+					p.unknownField("updateUserStatus")
+				}
+			}
+			return e
+		},
+		"updateUserName": func(p *textParser) TL {
+			var e TL_updateUserName
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				case "username":
+					e.Username = p.String()
+				default:
+					p.unknownField("updateUserName")
+				}
+			}
+			return e
+		},
+		"updateUserPhoto": func(p *textParser) TL {
+			var e TL_updateUserPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "photo":
+					e.Photo = p.Object()
+				case "previous":
+					e.Previous = p.Object()
+				default:
+					p.unknownField("updateUserPhoto")
+				}
+			}
+			return e
+		},
+		"updateNewEncryptedMessage": func(p *textParser) TL {
+			var e TL_updateNewEncryptedMessage
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				case "qts":
+					e.Qts = p.Int()
+				default:
+					p.unknownField("updateNewEncryptedMessage")
+				}
+			}
+			return e
+		},
+		"updateEncryptedChatTyping": func(p *textParser) TL {
+			var e TL_updateEncryptedChatTyping
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("updateEncryptedChatTyping")
+				}
+			}
+			return e
+		},
+		"updateEncryption": func(p *textParser) TL {
+			var e TL_updateEncryption
+			for p.field() {
+				switch p.fieldName {
+				case "chat":
+					e.Chat = p.Object()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("updateEncryption")
+				}
+			}
+			return e
+		},
+		"updateEncryptedMessagesRead": func(p *textParser) TL {
+			var e TL_updateEncryptedMessagesRead
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "max_date":
+					e.MaxDate = p.Int()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("updateEncryptedMessagesRead")
+				}
+			}
+			return e
+		},
+		"updateChatParticipantAdd": func(p *textParser) TL {
+			var e TL_updateChatParticipantAdd
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "inviter_id":
+					e.InviterID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "version":
+					e.Version = p.Int()
+				default:
+					p.unknownField("updateChatParticipantAdd")
+				}
+			}
+			return e
+		},
+		"updateChatParticipantDelete": func(p *textParser) TL {
+			var e TL_updateChatParticipantDelete
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "version":
+					e.Version = p.Int()
+				default:
+					p.unknownField("updateChatParticipantDelete")
+				}
+			}
+			return e
+		},
+		"updateDcOptions": func(p *textParser) TL {
+			var e TL_updateDcOptions
+			for p.field() {
+				switch p.fieldName {
+				case "dc_options":
+					e.DcOptions = p.Vector()
+				default:
+					p.unknownField("updateDcOptions")
+				}
+			}
+			return e
+		},
+		"updateNotifySettings": func(p *textParser) TL {
+			var e TL_updateNotifySettings
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "notify_settings":
+					e.NotifySettings = p.Object()
+				default:
+					p.unknownField("updateNotifySettings")
+				}
+			}
+			return e
+		},
+		"updateServiceNotification": func(p *textParser) TL {
+			var e TL_updateServiceNotification
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "popup":
+					e.Popup = p.Bool()
+					if e.Popup {
+						e.Flags |= 1
+					}
+				case "inbox_date":
+					e.InboxDate = p.Int()
+					e.Flags |= 2
+				case "type":
+					e.Type = p.String()
+				case "message":
+					e.Message = p.String()
+				case "media":
+					e.Media = p.Object()
+				case "entities":
+					e.Entities = p.Vector()
+				default:
+					p.unknownField("updateServiceNotification")
+				}
+			}
+			return e
+		},
+		"updatePrivacy": func(p *textParser) TL {
+			var e TL_updatePrivacy
+			for p.field() {
+				switch p.fieldName {
+				case "key":
+					e.Key = p.Object()
+				case "rules":
+					e.Rules = p.Vector()
+				default:
+					p.unknownField("updatePrivacy")
+				}
+			}
+			return e
+		},
+		"updateUserPhone": func(p *textParser) TL {
+			var e TL_updateUserPhone
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "phone":
+					e.Phone = p.String()
+				default:
+					p.unknownField("updateUserPhone")
+				}
+			}
+			return e
+		},
+		"updateReadHistoryInbox": func(p *textParser) TL {
+			var e TL_updateReadHistoryInbox
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 1
+				case "peer":
+					e.Peer = p.Object()
+				case "max_id":
+					e.MaxID = p.Int()
+				case "still_unread_count":
+					e.StillUnreadCount = p.Int()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateReadHistoryInbox")
+				}
+			}
+			return e
+		},
+		"updateReadHistoryOutbox": func(p *textParser) TL {
+			var e TL_updateReadHistoryOutbox
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "max_id":
+					e.MaxID = p.Int()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateReadHistoryOutbox")
+				}
+			}
+			return e
+		},
+		"updateWebPage": func(p *textParser) TL {
+			var e TL_updateWebPage
+			for p.field() {
+				switch p.fieldName {
+				case "webpage":
+					e.Webpage = p.Object()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateWebPage")
+				}
+			}
+			return e
+		},
+		"updateReadMessagesContents": func(p *textParser) TL {
+			var e TL_updateReadMessagesContents
+			for p.field() {
+				switch p.fieldName {
+				case "messages":
+					e.Messages = p.VectorInt()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateReadMessagesContents")
+				}
+			}
+			return e
+		},
+		"updateChannelTooLong": func(p *textParser) TL {
+			var e TL_updateChannelTooLong
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "pts":
+					e.Pts = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("updateChannelTooLong")
+				}
+			}
+			return e
+		},
+		"updateChannel": func(p *textParser) TL {
+			var e TL_updateChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				default:
+					p.unknownField("updateChannel")
+				}
+			}
+			return e
+		},
+		"updateNewChannelMessage": func(p *textParser) TL {
+			var e TL_updateNewChannelMessage
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateNewChannelMessage")
+				}
+			}
+			return e
+		},
+		"updateReadChannelInbox": func(p *textParser) TL {
+			var e TL_updateReadChannelInbox
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 1
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "max_id":
+					e.MaxID = p.Int()
+				case "still_unread_count":
+					e.StillUnreadCount = p.Int()
+				case "pts":
+					e.Pts = p.Int()
+				default:
+					p.unknownField("updateReadChannelInbox")
+				}
+			}
+			return e
+		},
+		"updateDeleteChannelMessages": func(p *textParser) TL {
+			var e TL_updateDeleteChannelMessages
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "messages":
+					e.Messages = p.VectorInt()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateDeleteChannelMessages")
+				}
+			}
+			return e
+		},
+		"updateChannelMessageViews": func(p *textParser) TL {
+			var e TL_updateChannelMessageViews
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "id":
+					e.ID = p.Int()
+				case "views":
+					e.Views = p.Int()
+				default:
+					p.unknownField("updateChannelMessageViews")
+				}
+			}
+			return e
+		},
+		"updateChatParticipantAdmin": func(p *textParser) TL {
+			var e TL_updateChatParticipantAdmin
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "is_admin":
+					e.IsAdmin = p.Object()
+				case "version":
+					e.Version = p.Int()
+				default:
+					p.unknownField("updateChatParticipantAdmin")
+				}
+			}
+			return e
+		},
+		"updateNewStickerSet": func(p *textParser) TL {
+			var e TL_updateNewStickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "stickerset":
+					e.Stickerset = p.Object()
+				default:
+					p.unknownField("updateNewStickerSet")
+				}
+			}
+			return e
+		},
+		"updateStickerSetsOrder": func(p *textParser) TL {
+			var e TL_updateStickerSetsOrder
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "masks":
+					e.Masks = p.Bool()
+					if e.Masks {
+						e.Flags |= 1
+					}
+				case "order":
+					e.Order = p.VectorLong()
+				default:
+					p.unknownField("updateStickerSetsOrder")
+				}
+			}
+			return e
+		},
+		"updateStickerSets": func(p *textParser) TL {
+			var e TL_updateStickerSets
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateStickerSets")
+				}
+			}
+			return e
+		},
+		"updateSavedGifs": func(p *textParser) TL {
+			var e TL_updateSavedGifs
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateSavedGifs")
+				}
+			}
+			return e
+		},
+		"updateBotInlineQuery": func(p *textParser) TL {
+			var e TL_updateBotInlineQuery
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "query_id":
+					e.QueryID = p.Long()
+				case "user_id":
+					e.UserID = p.Int()
+				case "query":
+					e.Query = p.String()
+				case "geo":
+					e.Geo = p.Object()
+					e.Flags |= 1
+				case "peer_type":
+					e.PeerType = p.Object()
+					e.Flags |= 2
+				case "offset":
+					e.Offset = p.String()
+				default:
+					p.unknownField("updateBotInlineQuery")
+				}
+			}
+			return e
+		},
+		"updateBotInlineSend": func(p *textParser) TL {
+			var e TL_updateBotInlineSend
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "query":
+					e.Query = p.String()
+				case "geo":
+					e.Geo = p.Object()
+					e.Flags |= 1
+				case "id":
+					e.ID = p.String()
+				case "msg_id":
+					e.MsgID = p.Object()
+					e.Flags |= 2
+				default:
+					p.unknownField("updateBotInlineSend")
+				}
+			}
+			return e
+		},
+		"updateEditChannelMessage": func(p *textParser) TL {
+			var e TL_updateEditChannelMessage
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateEditChannelMessage")
+				}
+			}
+			return e
+		},
+		"updateBotCallbackQuery": func(p *textParser) TL {
+			var e TL_updateBotCallbackQuery
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "query_id":
+					e.QueryID = p.Long()
+				case "user_id":
+					e.UserID = p.Int()
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "chat_instance":
+					e.ChatInstance = p.Long()
+				case "data":
+					e.Data = p.Bytes()
+					e.Flags |= 1
+				case "game_short_name":
+					e.GameShortName = p.String()
+					e.Flags |= 2
+				default:
+					p.unknownField("updateBotCallbackQuery")
+				}
+			}
+			return e
+		},
+		"updateEditMessage": func(p *textParser) TL {
+			var e TL_updateEditMessage
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateEditMessage")
+				}
+			}
+			return e
+		},
+		"updateInlineBotCallbackQuery": func(p *textParser) TL {
+			var e TL_updateInlineBotCallbackQuery
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "query_id":
+					e.QueryID = p.Long()
+				case "user_id":
+					e.UserID = p.Int()
+				case "msg_id":
+					e.MsgID = p.Object()
+				case "chat_instance":
+					e.ChatInstance = p.Long()
+				case "data":
+					e.Data = p.Bytes()
+					e.Flags |= 1
+				case "game_short_name":
+					e.GameShortName = p.String()
+					e.Flags |= 2
+				default:
+					p.unknownField("updateInlineBotCallbackQuery")
+				}
+			}
+			return e
+		},
+		"updateReadChannelOutbox": func(p *textParser) TL {
+			var e TL_updateReadChannelOutbox
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "max_id":
+					e.MaxID = p.Int()
+				default:
+					p.unknownField("updateReadChannelOutbox")
+				}
+			}
+			return e
+		},
+		"updateDraftMessage": func(p *textParser) TL {
+			var e TL_updateDraftMessage
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "draft":
+					e.Draft = p.Object()
+				default:
+					p.unknownField("updateDraftMessage")
+				}
+			}
+			return e
+		},
+		"updateReadFeaturedStickers": func(p *textParser) TL {
+			var e TL_updateReadFeaturedStickers
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateReadFeaturedStickers")
+				}
+			}
+			return e
+		},
+		"updateRecentStickers": func(p *textParser) TL {
+			var e TL_updateRecentStickers
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateRecentStickers")
+				}
+			}
+			return e
+		},
+		"updateConfig": func(p *textParser) TL {
+			var e TL_updateConfig
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateConfig")
+				}
+			}
+			return e
+		},
+		"updatePtsChanged": func(p *textParser) TL {
+			var e TL_updatePtsChanged
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updatePtsChanged")
+				}
+			}
+			return e
+		},
+		"updateChannelWebPage": func(p *textParser) TL {
+			var e TL_updateChannelWebPage
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "webpage":
+					e.Webpage = p.Object()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateChannelWebPage")
+				}
+			}
+			return e
+		},
+		"updateDialogPinned": func(p *textParser) TL {
+			var e TL_updateDialogPinned
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 1
+					}
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 2
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("updateDialogPinned")
+				}
+			}
+			return e
+		},
+		"updatePinnedDialogs": func(p *textParser) TL {
+			var e TL_updatePinnedDialogs
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 2
+				case "order":
+					e.Order = p.Vector()
+					e.Flags |= 1
+				default:
+					p.unknownField("updatePinnedDialogs")
+				}
+			}
+			return e
+		},
+		"updateBotWebhookJSON": func(p *textParser) TL {
+			var e TL_updateBotWebhookJSON
+			for p.field() {
+				switch p.fieldName {
+				case "data":
+					e.Data = p.Object()
+				default:
+					p.unknownField("updateBotWebhookJSON")
+				}
+			}
+			return e
+		},
+		"updateBotWebhookJSONQuery": func(p *textParser) TL {
+			var e TL_updateBotWebhookJSONQuery
+			for p.field() {
+				switch p.fieldName {
+				case "query_id":
+					e.QueryID = p.Long()
+				case "data":
+					e.Data = p.Object()
+				case "timeout":
+					e.Timeout = p.Int()
+				default:
+					p.unknownField("updateBotWebhookJSONQuery")
+				}
+			}
+			return e
+		},
+		"updateBotShippingQuery": func(p *textParser) TL {
+			var e TL_updateBotShippingQuery
+			for p.field() {
+				switch p.fieldName {
+				case "query_id":
+					e.QueryID = p.Long()
+				case "user_id":
+					e.UserID = p.Int()
+				case "payload":
+					e.Payload = p.Bytes()
+				case "shipping_address":
+					e.ShippingAddress = p.Object()
+				default:
+					p.unknownField("updateBotShippingQuery")
+				}
+			}
+			return e
+		},
+		"updateBotPrecheckoutQuery": func(p *textParser) TL {
+			var e TL_updateBotPrecheckoutQuery
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "query_id":
+					e.QueryID = p.Long()
+				case "user_id":
+					e.UserID = p.Int()
+				case "payload":
+					e.Payload = p.Bytes()
+				case "info":
+					e.Info = p.Object()
+					e.Flags |= 1
+				case "shipping_option_id":
+					e.ShippingOptionID = p.String()
+					e.Flags |= 2
+				case "currency":
+					e.Currency = p.String()
+				case "total_amount":
+					e.TotalAmount = p.Long()
+				default:
+					p.unknownField("updateBotPrecheckoutQuery")
+				}
+			}
+			return e
+		},
+		"updatePhoneCall": func(p *textParser) TL {
+			var e TL_updatePhoneCall
+			for p.field() {
+				switch p.fieldName {
+				case "phone_call":
+					e.PhoneCall = p.Object()
+				default:
+					p.unknownField("updatePhoneCall")
+				}
+			}
+			return e
+		},
+		"updateLangPackTooLong": func(p *textParser) TL {
+			var e TL_updateLangPackTooLong
+			for p.field() {
+				switch p.fieldName {
+				case "lang_code":
+					e.LangCode = p.String()
+				default:
+					p.unknownField("updateLangPackTooLong")
+				}
+			}
+			return e
+		},
+		"updateLangPack": func(p *textParser) TL {
+			var e TL_updateLangPack
+			for p.field() {
+				switch p.fieldName {
+				case "difference":
+					e.Difference = p.Object()
+				default:
+					p.unknownField("updateLangPack")
+				}
+			}
+			return e
+		},
+		"updateFavedStickers": func(p *textParser) TL {
+			var e TL_updateFavedStickers
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateFavedStickers")
+				}
+			}
+			return e
+		},
+		"updateChannelReadMessagesContents": func(p *textParser) TL {
+			var e TL_updateChannelReadMessagesContents
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "messages":
+					e.Messages = p.VectorInt()
+				default:
+					p.unknownField("updateChannelReadMessagesContents")
+				}
+			}
+			return e
+		},
+		"updateContactsReset": func(p *textParser) TL {
+			var e TL_updateContactsReset
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateContactsReset")
+				}
+			}
+			return e
+		},
+		"updateChannelAvailableMessages": func(p *textParser) TL {
+			var e TL_updateChannelAvailableMessages
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "available_min_id":
+					e.AvailableMinID = p.Int()
+				default:
+					p.unknownField("updateChannelAvailableMessages")
+				}
+			}
+			return e
+		},
+		"updateDialogUnreadMark": func(p *textParser) TL {
+			var e TL_updateDialogUnreadMark
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "unread":
+					e.Unread = p.Bool()
+					if e.Unread {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("updateDialogUnreadMark")
+				}
+			}
+			return e
+		},
+		"updateMessagePoll": func(p *textParser) TL {
+			var e TL_updateMessagePoll
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "poll_id":
+					e.PollID = p.Long()
+				case "poll":
+					e.Poll = p.Object()
+					e.Flags |= 1
+				case "results":
+					e.Results = p.Object()
+				default:
+					p.unknownField("updateMessagePoll")
+				}
+			}
+			return e
+		},
+		"updateChatDefaultBannedRights": func(p *textParser) TL {
+			var e TL_updateChatDefaultBannedRights
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "default_banned_rights":
+					e.DefaultBannedRights = p.Object()
+				case "version":
+					e.Version = p.Int()
+				default:
+					p.unknownField("updateChatDefaultBannedRights")
+				}
+			}
+			return e
+		},
+		"updateFolderPeers": func(p *textParser) TL {
+			var e TL_updateFolderPeers
+			for p.field() {
+				switch p.fieldName {
+				case "folder_peers":
+					e.FolderPeers = p.Vector()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updateFolderPeers")
+				}
+			}
+			return e
+		},
+		"updatePeerSettings": func(p *textParser) TL {
+			var e TL_updatePeerSettings
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("updatePeerSettings")
+				}
+			}
+			return e
+		},
+		"updatePeerLocated": func(p *textParser) TL {
+			var e TL_updatePeerLocated
+			for p.field() {
+				switch p.fieldName {
+				case "peers":
+					e.Peers = p.Vector()
+				default:
+					p.unknownField("updatePeerLocated")
+				}
+			}
+			return e
+		},
+		"updateNewScheduledMessage": func(p *textParser) TL {
+			var e TL_updateNewScheduledMessage
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				default:
+					p.unknownField("updateNewScheduledMessage")
+				}
+			}
+			return e
+		},
+		"updateDeleteScheduledMessages": func(p *textParser) TL {
+			var e TL_updateDeleteScheduledMessages
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "messages":
+					e.Messages = p.VectorInt()
+				default:
+					p.unknownField("updateDeleteScheduledMessages")
+				}
+			}
+			return e
+		},
+		"updateTheme": func(p *textParser) TL {
+			var e TL_updateTheme
+			for p.field() {
+				switch p.fieldName {
+				case "theme":
+					e.Theme = p.Object()
+				default:
+					p.unknownField("updateTheme")
+				}
+			}
+			return e
+		},
+		"updateGeoLiveViewed": func(p *textParser) TL {
+			var e TL_updateGeoLiveViewed
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				default:
+					p.unknownField("updateGeoLiveViewed")
+				}
+			}
+			return e
+		},
+		"updateLoginToken": func(p *textParser) TL {
+			var e TL_updateLoginToken
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateLoginToken")
+				}
+			}
+			return e
+		},
+		"updateMessagePollVote": func(p *textParser) TL {
+			var e TL_updateMessagePollVote
+			for p.field() {
+				switch p.fieldName {
+				case "poll_id":
+					e.PollID = p.Long()
+				case "user_id":
+					e.UserID = p.Int()
+				case "options":
+					e.Options = p.VectorBytes()
+				default:
+					p.unknownField("updateMessagePollVote")
+				}
+			}
+			return e
+		},
+		"updateDialogFilter": func(p *textParser) TL {
+			var e TL_updateDialogFilter
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.Int()
+				case "filter":
+					e.Filter = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("updateDialogFilter")
+				}
+			}
+			return e
+		},
+		"updateDialogFilterOrder": func(p *textParser) TL {
+			var e TL_updateDialogFilterOrder
+			for p.field() {
+				switch p.fieldName {
+				case "order":
+					e.Order = p.VectorInt()
+				default:
+					p.unknownField("updateDialogFilterOrder")
+				}
+			}
+			return e
+		},
+		"updateDialogFilters": func(p *textParser) TL {
+			var e TL_updateDialogFilters
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updateDialogFilters")
+				}
+			}
+			return e
+		},
+		"updatePhoneCallSignalingData": func(p *textParser) TL {
+			var e TL_updatePhoneCallSignalingData
+			for p.field() {
+				switch p.fieldName {
+				case "phone_call_id":
+					e.PhoneCallID = p.Long()
+				case "data":
+					e.Data = p.Bytes()
+				default:
+					p.unknownField("updatePhoneCallSignalingData")
+				}
+			}
+			return e
+		},
+		"updateChannelMessageForwards": func(p *textParser) TL {
+			var e TL_updateChannelMessageForwards
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "id":
+					e.ID = p.Int()
+				case "forwards":
+					e.Forwards = p.Int()
+				default:
+					p.unknownField("updateChannelMessageForwards")
+				}
+			}
+			return e
+		},
+		"updateReadChannelDiscussionInbox": func(p *textParser) TL {
+			var e TL_updateReadChannelDiscussionInbox
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "top_msg_id":
+					e.TopMsgID = p.Int()
+				case "read_max_id":
+					e.ReadMaxID = p.Int()
+				case "broadcast_id":
+					e.BroadcastID = p.Int()
+					e.Flags |= 1
+				case "broadcast_post":
+					e.BroadcastPost = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("updateReadChannelDiscussionInbox")
+				}
+			}
+			return e
+		},
+		"updateReadChannelDiscussionOutbox": func(p *textParser) TL {
+			var e TL_updateReadChannelDiscussionOutbox
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "top_msg_id":
+					e.TopMsgID = p.Int()
+				case "read_max_id":
+					e.ReadMaxID = p.Int()
+				default:
+					p.unknownField("updateReadChannelDiscussionOutbox")
+				}
+			}
+			return e
+		},
+		"updatePeerBlocked": func(p *textParser) TL {
+			var e TL_updatePeerBlocked
+			for p.field() {
+				switch p.fieldName {
+				case "peer_id":
+					e.PeerID = p.Object()
+				case "blocked":
+					e.Blocked = p.Object()
+				default:
+					p.unknownField("updatePeerBlocked")
+				}
+			}
+			return e
+		},
+		"updateChannelUserTyping": func(p *textParser) TL {
+			var e TL_updateChannelUserTyping
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "top_msg_id":
+					e.TopMsgID = p.Int()
+					e.Flags |= 1
+				case "from_id":
+					e.FromID = p.Object()
+				case "action":
+					e.Action = p.Object()
+				default:
+					p.unknownField("updateChannelUserTyping")
+				}
+			}
+			return e
+		},
+		"updatePinnedMessages": func(p *textParser) TL {
+			var e TL_updatePinnedMessages
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "messages":
+					e.Messages = p.VectorInt()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updatePinnedMessages")
+				}
+			}
+			return e
+		},
+		"updatePinnedChannelMessages": func(p *textParser) TL {
+			var e TL_updatePinnedChannelMessages
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 1
+					}
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "messages":
+					e.Messages = p.VectorInt()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("updatePinnedChannelMessages")
+				}
+			}
+			return e
+		},
+		"updateChat": func(p *textParser) TL {
+			var e TL_updateChat
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("updateChat")
+				}
+			}
+			return e
+		},
+		"updateGroupCallParticipants": func(p *textParser) TL {
+			var e TL_updateGroupCallParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "participants":
+					e.Participants = p.Vector()
+				case "version":
+					e.Version = p.Int()
+				default:
+					p.unknownField("updateGroupCallParticipants")
+				}
+			}
+			return e
+		},
+		"updateGroupCall": func(p *textParser) TL {
+			var e TL_updateGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "call":
+					e.Call = p.Object()
+				default:
+					p.unknownField("updateGroupCall")
+				}
+			}
+			return e
+		},
+		"updatePeerHistoryTTL": func(p *textParser) TL {
+			var e TL_updatePeerHistoryTTL
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "peer":
+					e.Peer = p.Object()
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("updatePeerHistoryTTL")
+				}
+			}
+			return e
+		},
+		"updateChatParticipant": func(p *textParser) TL {
+			var e TL_updateChatParticipant
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "actor_id":
+					e.ActorID = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "prev_participant":
+					e.PrevParticipant = p.Object()
+					e.Flags |= 1
+				case "new_participant":
+					e.NewParticipant = p.Object()
+					e.Flags |= 2
+				case "invite":
+					e.Invite = p.Object()
+					e.Flags |= 4
+				case "qts":
+					e.Qts = p.Int()
+				default:
+					p.unknownField("updateChatParticipant")
+				}
+			}
+			return e
+		},
+		"updateChannelParticipant": func(p *textParser) TL {
+			var e TL_updateChannelParticipant
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "actor_id":
+					e.ActorID = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "prev_participant":
+					e.PrevParticipant = p.Object()
+					e.Flags |= 1
+				case "new_participant":
+					e.NewParticipant = p.Object()
+					e.Flags |= 2
+				case "invite":
+					e.Invite = p.Object()
+					e.Flags |= 4
+				case "qts":
+					e.Qts = p.Int()
+				default:
+					p.unknownField("updateChannelParticipant")
+				}
+			}
+			return e
+		},
+		"updateBotStopped": func(p *textParser) TL {
+			var e TL_updateBotStopped
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "stopped":
+					e.Stopped = p.Object()
+				case "qts":
+					e.Qts = p.Int()
+				default:
+					p.unknownField("updateBotStopped")
+				}
+			}
+			return e
+		},
+		"updates.state": func(p *textParser) TL {
+			var e TL_updates_state
+			for p.field() {
+				switch p.fieldName {
+				case "pts":
+					e.Pts = p.Int()
+				case "qts":
+					e.Qts = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "seq":
+					e.Seq = p.Int()
+				case "unread_count":
+					e.UnreadCount = p.Int()
+				default:
+					p.unknownField("updates.state")
+				}
+			}
+			return e
+		},
+		"updates.differenceEmpty": func(p *textParser) TL {
+			var e TL_updates_differenceEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "date":
+					e.Date = p.Int()
+				case "seq":
+					e.Seq = p.Int()
+				default:
+					p.unknownField("updates.differenceEmpty")
+				}
+			}
+			return e
+		},
+		"updates.difference": func(p *textParser) TL {
+			var e TL_updates_difference
+			for p.field() {
+				switch p.fieldName {
+				case "new_messages":
+					e.NewMessages = p.Vector()
+				case "new_encrypted_messages":
+					e.NewEncryptedMessages = p.Vector()
+				case "other_updates":
+					e.OtherUpdates = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "state":
+					e.State = p.Object()
+				default:
+					p.unknownField("updates.difference")
+				}
+			}
+			return e
+		},
+		"updates.differenceSlice": func(p *textParser) TL {
+			var e TL_updates_differenceSlice
+			for p.field() {
+				switch p.fieldName {
+				case "new_messages":
+					e.NewMessages = p.Vector()
+				case "new_encrypted_messages":
+					e.NewEncryptedMessages = p.Vector()
+				case "other_updates":
+					e.OtherUpdates = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "intermediate_state":
+					e.IntermediateState = p.Object()
+				default:
+					p.unknownField("updates.differenceSlice")
+				}
+			}
+			return e
+		},
+		"updates.differenceTooLong": func(p *textParser) TL {
+			var e TL_updates_differenceTooLong
+			for p.field() {
+				switch p.fieldName {
+				case "pts":
+					e.Pts = p.Int()
+				default:
+					p.unknownField("updates.differenceTooLong")
+				}
+			}
+			return e
+		},
+		"updatesTooLong": func(p *textParser) TL {
+			var e TL_updatesTooLong
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updatesTooLong")
+				}
+			}
+			return e
+		},
+		"updateShortMessage": func(p *textParser) TL {
+			var e TL_updateShortMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "out":
+					e.Out = p.Bool()
+					if e.Out {
+						e.Flags |= 2
+					}
+				case "mentioned":
+					e.Mentioned = p.Bool()
+					if e.Mentioned {
+						e.Flags |= 16
+					}
+				case "media_unread":
+					e.MediaUnread = p.Bool()
+					if e.MediaUnread {
+						e.Flags |= 32
+					}
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 8192
+					}
+				case "id":
+					e.ID = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "message":
+					e.Message = p.String()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "fwd_from":
+					e.FwdFrom = p.Object()
+					e.Flags |= 4
+				case "via_bot_id":
+					e.ViaBotID = p.Int()
+					e.Flags |= 2048
+				case "reply_to":
+					e.ReplyTo = p.Object()
+					e.Flags |= 8
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 128
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 33554432
+				default:
+					p.unknownField("updateShortMessage")
+				}
+			}
+			return e
+		},
+		"updateShortChatMessage": func(p *textParser) TL {
+			var e TL_updateShortChatMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "out":
+					e.Out = p.Bool()
+					if e.Out {
+						e.Flags |= 2
+					}
+				case "mentioned":
+					e.Mentioned = p.Bool()
+					if e.Mentioned {
+						e.Flags |= 16
+					}
+				case "media_unread":
+					e.MediaUnread = p.Bool()
+					if e.MediaUnread {
+						e.Flags |= 32
+					}
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 8192
+					}
+				case "id":
+					e.ID = p.Int()
+				case "from_id":
+					e.FromID = p.Int()
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "message":
+					e.Message = p.String()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "fwd_from":
+					e.FwdFrom = p.Object()
+					e.Flags |= 4
+				case "via_bot_id":
+					e.ViaBotID = p.Int()
+					e.Flags |= 2048
+				case "reply_to":
+					e.ReplyTo = p.Object()
+					e.Flags |= 8
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 128
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 33554432
+				default:
+					p.unknownField("updateShortChatMessage")
+				}
+			}
+			return e
+		},
+		"updateShort": func(p *textParser) TL {
+			var e TL_updateShort
+			for p.field() {
+				switch p.fieldName {
+				case "update":
+					e.Update = p.Object()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("updateShort")
+				}
+			}
+			return e
+		},
+		"updatesCombined": func(p *textParser) TL {
+			var e TL_updatesCombined
+			for p.field() {
+				switch p.fieldName {
+				case "updates":
+					e.Updates = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "date":
+					e.Date = p.Int()
+				case "seq_start":
+					e.SeqStart = p.Int()
+				case "seq":
+					e.Seq = p.Int()
+				default:
+					p.unknownField("updatesCombined")
+				}
+			}
+			return e
+		},
+		"updates": func(p *textParser) TL {
+			var e TL_updates
+			for p.field() {
+				switch p.fieldName {
+				case "updates":
+					e.Updates = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "date":
+					e.Date = p.Int()
+				case "seq":
+					e.Seq = p.Int()
+				default:
+					p.unknownField("updates")
+				}
+			}
+			return e
+		},
+		"updateShortSentMessage": func(p *textParser) TL {
+			var e TL_updateShortSentMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "out":
+					e.Out = p.Bool()
+					if e.Out {
+						e.Flags |= 2
+					}
+				case "id":
+					e.ID = p.Int()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "media":
+					e.Media = p.Object()
+					e.Flags |= 512
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 128
+				case "ttl_period":
+					e.TtlPeriod = p.Int()
+					e.Flags |= 33554432
+				default:
+					p.unknownField("updateShortSentMessage")
+				}
+			}
+			return e
+		},
+		"photos.photos": func(p *textParser) TL {
+			var e TL_photos_photos
+			for p.field() {
+				switch p.fieldName {
+				case "photos":
+					e.Photos = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("photos.photos")
+				}
+			}
+			return e
+		},
+		"photos.photosSlice": func(p *textParser) TL {
+			var e TL_photos_photosSlice
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "photos":
+					e.Photos = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("photos.photosSlice")
+				}
+			}
+			return e
+		},
+		"photos.photo": func(p *textParser) TL {
+			var e TL_photos_photo
+			for p.field() {
+				switch p.fieldName {
+				case "photo":
+					e.Photo = p.Object()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("photos.photo")
+				}
+			}
+			return e
+		},
+		"upload.file": func(p *textParser) TL {
+			var e TL_upload_file
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "mtime":
+					e.Mtime = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("upload.file")
+				}
+			}
+			return e
+		},
+		"upload.fileCdnRedirect": func(p *textParser) TL {
+			var e TL_upload_fileCdnRedirect
+			for p.field() {
+				switch p.fieldName {
+				case "dc_id":
+					e.DcID = p.Int()
+				case "file_token":
+					e.FileToken = p.Bytes()
+				case "encryption_key":
+					e.EncryptionKey = p.Bytes()
+				case "encryption_iv":
+					e.EncryptionIv = p.Bytes()
+				case "file_hashes":
+					e.FileHashes = p.Vector()
+				default:
+					p.unknownField("upload.fileCdnRedirect")
+				}
+			}
+			return e
+		},
+		"dcOption": func(p *textParser) TL {
+			var e TL_dcOption
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "ipv6":
+					e.Ipv6 = p.Bool()
+					if e.Ipv6 {
+						e.Flags |= 1
+					}
+				case "media_only":
+					e.MediaOnly = p.Bool()
+					if e.MediaOnly {
+						e.Flags |= 2
+					}
+				case "tcpo_only":
+					e.TcpoOnly = p.Bool()
+					if e.TcpoOnly {
+						e.Flags |= 4
+					}
+				case "cdn":
+					e.Cdn = p.Bool()
+					if e.Cdn {
+						e.Flags |= 8
+					}
+				case "static":
+					e.Static = p.Bool()
+					if e.Static {
+						e.Flags |= 16
+					}
+				case "id":
+					e.ID = p.Int()
+				case "ip_address":
+					e.IpAddress = p.String()
+				case "port":
+					e.Port = p.Int()
+				case "secret":
+					e.Secret = p.Bytes()
+					e.Flags |= 1024
+				default:
+					p.unknownField("dcOption")
+				}
+			}
+			return e
+		},
+		"config": func(p *textParser) TL {
+			var e TL_config
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "phonecalls_enabled":
+					e.PhonecallsEnabled = p.Bool()
+					if e.PhonecallsEnabled {
+						e.Flags |= 2
+					}
+				case "default_p2p_contacts":
+					e.DefaultP2pContacts = p.Bool()
+					if e.DefaultP2pContacts {
+						e.Flags |= 8
+					}
+				case "preload_featured_stickers":
+					e.PreloadFeaturedStickers = p.Bool()
+					if e.PreloadFeaturedStickers {
+						e.Flags |= 16
+					}
+				case "ignore_phone_entities":
+					e.IgnorePhoneEntities = p.Bool()
+					if e.IgnorePhoneEntities {
+						e.Flags |= 32
+					}
+				case "revoke_pm_inbox":
+					e.RevokePmInbox = p.Bool()
+					if e.RevokePmInbox {
+						e.Flags |= 64
+					}
+				case "blocked_mode":
+					e.BlockedMode = p.Bool()
+					if e.BlockedMode {
+						e.Flags |= 256
+					}
+				case "pfs_enabled":
+					e.PfsEnabled = p.Bool()
+					if e.PfsEnabled {
+						e.Flags |= 8192
+					}
+				case "date":
+					e.Date = p.Int()
+				case "expires":
+					e.Expires = p.Int()
+				case "test_mode":
+					e.TestMode = p.Object()
+				case "this_dc":
+					e.ThisDc = p.Int()
+				case "dc_options":
+					e.DcOptions = p.Vector()
+				case "dc_txt_domain_name":
+					e.DcTxtDomainName = p.String()
+				case "chat_size_max":
+					e.ChatSizeMax = p.Int()
+				case "megagroup_size_max":
+					e.MegagroupSizeMax = p.Int()
+				case "forwarded_count_max":
+					e.ForwardedCountMax = p.Int()
+				case "online_update_period_ms":
+					e.OnlineUpdatePeriodMs = p.Int()
+				case "offline_blur_timeout_ms":
+					e.OfflineBlurTimeoutMs = p.Int()
+				case "offline_idle_timeout_ms":
+					e.OfflineIdleTimeoutMs = p.Int()
+				case "online_cloud_timeout_ms":
+					e.OnlineCloudTimeoutMs = p.Int()
+				case "notify_cloud_delay_ms":
+					e.NotifyCloudDelayMs = p.Int()
+				case "notify_default_delay_ms":
+					e.NotifyDefaultDelayMs = p.Int()
+				case "push_chat_period_ms":
+					e.PushChatPeriodMs = p.Int()
+				case "push_chat_limit":
+					e.PushChatLimit = p.Int()
+				case "saved_gifs_limit":
+					e.SavedGifsLimit = p.Int()
+				case "edit_time_limit":
+					e.EditTimeLimit = p.Int()
+				case "revoke_time_limit":
+					e.RevokeTimeLimit = p.Int()
+				case "revoke_pm_time_limit":
+					e.RevokePmTimeLimit = p.Int()
+				case "rating_e_decay":
+					e.RatingEDecay = p.Int()
+				case "stickers_recent_limit":
+					e.StickersRecentLimit = p.Int()
+				case "stickers_faved_limit":
+					e.StickersFavedLimit = p.Int()
+				case "channels_read_media_period":
+					e.ChannelsReadMediaPeriod = p.Int()
+				case "tmp_sessions":
+					e.TmpSessions = p.Int()
+					e.Flags |= 1
+				case "pinned_dialogs_count_max":
+					e.PinnedDialogsCountMax = p.Int()
+				case "pinned_infolder_count_max":
+					e.PinnedInfolderCountMax = p.Int()
+				case "call_receive_timeout_ms":
+					e.CallReceiveTimeoutMs = p.Int()
+				case "call_ring_timeout_ms":
+					e.CallRingTimeoutMs = p.Int()
+				case "call_connect_timeout_ms":
+					e.CallConnectTimeoutMs = p.Int()
+				case "call_packet_timeout_ms":
+					e.CallPacketTimeoutMs = p.Int()
+				case "me_url_prefix":
+					e.MeUrlPrefix = p.String()
+				case "autoupdate_url_prefix":
+					e.AutoupdateUrlPrefix = p.String()
+					e.Flags |= 128
+				case "gif_search_username":
+					e.GifSearchUsername = p.String()
+					e.Flags |= 512
+				case "venue_search_username":
+					e.VenueSearchUsername = p.String()
+					e.Flags |= 1024
+				case "img_search_username":
+					e.ImgSearchUsername = p.String()
+					e.Flags |= 2048
+				case "static_maps_provider":
+					e.StaticMapsProvider = p.String()
+					e.Flags |= 4096
+				case "caption_length_max":
+					e.CaptionLengthMax = p.Int()
+				case "message_length_max":
+					e.MessageLengthMax = p.Int()
+				case "webfile_dc_id":
+					e.WebfileDcID = p.Int()
+				case "suggested_lang_code":
+					e.SuggestedLangCode = p.String()
+					e.Flags |= 4
+				case "lang_pack_version":
+					e.LangPackVersion = p.Int()
+					e.Flags |= 4
+				case "base_lang_pack_version":
+					e.BaseLangPackVersion = p.Int()
+					e.Flags |= 4
+				default:
+					p.unknownField("config")
+				}
+			}
+			return e
+		},
+		"nearestDc": func(p *textParser) TL {
+			var e TL_nearestDc
+			for p.field() {
+				switch p.fieldName {
+				case "country":
+					e.Country = p.String()
+				case "this_dc":
+					e.ThisDc = p.Int()
+				case "nearest_dc":
+					e.NearestDc = p.Int()
+				default:
+					p.unknownField("nearestDc")
+				}
+			}
+			return e
+		},
+		"help.appUpdate": func(p *textParser) TL {
+			var e TL_help_appUpdate
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "can_not_skip":
+					e.CanNotSkip = p.Bool()
+					if e.CanNotSkip {
+						e.Flags |= 1
+					}
+				case "id":
+					e.ID = p.Int()
+				case "version":
+					e.Version = p.String()
+				case "text":
+					e.Text = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+				case "document":
+					e.Document = p.Object()
+					e.Flags |= 2
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 4
+				default:
+					p.unknownField("help.appUpdate")
+				}
+			}
+			return e
+		},
+		"help.noAppUpdate": func(p *textParser) TL {
+			var e TL_help_noAppUpdate
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.noAppUpdate")
+				}
+			}
+			return e
+		},
+		"help.inviteText": func(p *textParser) TL {
+			var e TL_help_inviteText
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.String()
+				default:
+					p.unknownField("help.inviteText")
+				}
+			}
+			return e
+		},
+		"encryptedChatEmpty": func(p *textParser) TL {
+			var e TL_encryptedChatEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("encryptedChatEmpty")
+				}
+			}
+			return e
+		},
+		"encryptedChatWaiting": func(p *textParser) TL {
+			var e TL_encryptedChatWaiting
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "date":
+					e.Date = p.Int()
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "participant_id":
+					e.ParticipantID = p.Int()
+				default:
+					p.unknownField("encryptedChatWaiting")
+				}
+			}
+			return e
+		},
+		"encryptedChatRequested": func(p *textParser) TL {
+			var e TL_encryptedChatRequested
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 1
+				case "id":
+					e.ID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "date":
+					e.Date = p.Int()
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "participant_id":
+					e.ParticipantID = p.Int()
+				case "g_a":
+					e.GA = p.Bytes()
+				default:
+					p.unknownField("encryptedChatRequested")
+				}
+			}
+			return e
+		},
+		"encryptedChat": func(p *textParser) TL {
+			var e TL_encryptedChat
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "date":
+					e.Date = p.Int()
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "participant_id":
+					e.ParticipantID = p.Int()
+				case "g_a_or_b":
+					e.GAOrB = p.Bytes()
+				case "key_fingerprint":
+					e.KeyFingerprint = p.Long()
+				default:
+					p.unknownField("encryptedChat")
+				}
+			}
+			return e
+		},
+		"encryptedChatDiscarded": func(p *textParser) TL {
+			var e TL_encryptedChatDiscarded
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "history_deleted":
+					e.HistoryDeleted = p.Bool()
+					if e.HistoryDeleted {
+						e.Flags |= 1
+					}
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("encryptedChatDiscarded")
+				}
+			}
+			return e
+		},
+		"inputEncryptedChat": func(p *textParser) TL {
+			var e TL_inputEncryptedChat
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputEncryptedChat")
+				}
+			}
+			return e
+		},
+		"encryptedFileEmpty": func(p *textParser) TL {
+			var e TL_encryptedFileEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("encryptedFileEmpty")
+				}
+			}
+			return e
+		},
+		"encryptedFile": func(p *textParser) TL {
+			var e TL_encryptedFile
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "size":
+					e.Size = p.Int()
+				case "dc_id":
+					e.DcID = p.Int()
+				case "key_fingerprint":
+					e.KeyFingerprint = p.Int()
+				default:
+					p.unknownField("encryptedFile")
+				}
+			}
+			return e
+		},
+		"inputEncryptedFileEmpty": func(p *textParser) TL {
+			var e TL_inputEncryptedFileEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputEncryptedFileEmpty")
+				}
+			}
+			return e
+		},
+		"inputEncryptedFileUploaded": func(p *textParser) TL {
+			var e TL_inputEncryptedFileUploaded
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "parts":
+					e.Parts = p.Int()
+				case "md5_checksum":
+					e.Md5Checksum = p.String()
+				case "key_fingerprint":
+					e.KeyFingerprint = p.Int()
+				default:
+					p.unknownField("inputEncryptedFileUploaded")
+				}
+			}
+			return e
+		},
+		"inputEncryptedFile": func(p *textParser) TL {
+			var e TL_inputEncryptedFile
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputEncryptedFile")
+				}
+			}
+			return e
+		},
+		"inputEncryptedFileBigUploaded": func(p *textParser) TL {
+			var e TL_inputEncryptedFileBigUploaded
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "parts":
+					e.Parts = p.Int()
+				case "key_fingerprint":
+					e.KeyFingerprint = p.Int()
+				default:
+					p.unknownField("inputEncryptedFileBigUploaded")
+				}
+			}
+			return e
+		},
+		"encryptedMessage": func(p *textParser) TL {
+			var e TL_encryptedMessage
+			for p.field() {
+				switch p.fieldName {
+				case "random_id":
+					e.RandomID = p.Long()
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				case "file":
+					e.File = p.Object()
+				default:
+					p.unknownField("encryptedMessage")
+				}
+			}
+			return e
+		},
+		"encryptedMessageService": func(p *textParser) TL {
+			var e TL_encryptedMessageService
+			for p.field() {
+				switch p.fieldName {
+				case "random_id":
+					e.RandomID = p.Long()
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("encryptedMessageService")
+				}
+			}
+			return e
+		},
+		"messages.dhConfigNotModified": func(p *textParser) TL {
+			var e TL_messages_dhConfigNotModified
+			for p.field() {
+				switch p.fieldName {
+				case "random":
+					e.Random = p.Bytes()
+				default:
+					p.unknownField("messages.dhConfigNotModified")
+				}
+			}
+			return e
+		},
+		"messages.dhConfig": func(p *textParser) TL {
+			var e TL_messages_dhConfig
+			for p.field() {
+				switch p.fieldName {
+				case "g":
+					e.G = p.Int()
+				case "p":
+					e.P = p.Bytes()
+				case "version":
+					e.Version = p.Int()
+				case "random":
+					e.Random = p.Bytes()
+				default:
+					p.unknownField("messages.dhConfig")
+				}
+			}
+			return e
+		},
+		"messages.sentEncryptedMessage": func(p *textParser) TL {
+			var e TL_messages_sentEncryptedMessage
+			for p.field() {
+				switch p.fieldName {
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("messages.sentEncryptedMessage")
+				}
+			}
+			return e
+		},
+		"messages.sentEncryptedFile": func(p *textParser) TL {
+			var e TL_messages_sentEncryptedFile
+			for p.field() {
+				switch p.fieldName {
+				case "date":
+					e.Date = p.Int()
+				case "file":
+					e.File = p.Object()
+				default:
+					p.unknownField("messages.sentEncryptedFile")
+				}
+			}
+			return e
+		},
+		"inputDocumentEmpty": func(p *textParser) TL {
+			var e TL_inputDocumentEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputDocumentEmpty")
+				}
+			}
+			return e
+		},
+		"inputDocument": func(p *textParser) TL {
+			var e TL_inputDocument
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "file_reference":
+					e.FileReference = p.Bytes()
+				default:
+					p.unknownField("inputDocument")
+				}
+			}
+			return e
+		},
+		"documentEmpty": func(p *textParser) TL {
+			var e TL_documentEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				default:
+					p.unknownField("documentEmpty")
+				}
+			}
+			return e
+		},
+		"document": func(p *textParser) TL {
+			var e TL_document
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "file_reference":
+					e.FileReference = p.Bytes()
+				case "date":
+					e.Date = p.Int()
+				case "mime_type":
+					e.MimeType = p.String()
+				case "size":
+					e.Size = p.Int()
+				case "thumbs":
+					e.Thumbs = p.Vector()
+					e.Flags |= 1
+				case "video_thumbs":
+					e.VideoThumbs = p.Vector()
+					e.Flags |= 2
+				case "dc_id":
+					e.DcID = p.Int()
+				case "attributes":
+					e.Attributes = p.Vector()
+				default:
+					p.unknownField("document")
+				}
+			}
+			return e
+		},
+		"help.support": func(p *textParser) TL {
+			var e TL_help_support
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "user":
+					e.User = p.Object()
+				default:
+					p.unknownField("help.support")
+				}
+			}
+			return e
+		},
+		"notifyPeer": func(p *textParser) TL {
+			var e TL_notifyPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("notifyPeer")
+				}
+			}
+			return e
+		},
+		"notifyUsers": func(p *textParser) TL {
+			var e TL_notifyUsers
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("notifyUsers")
+				}
+			}
+			return e
+		},
+		"notifyChats": func(p *textParser) TL {
+			var e TL_notifyChats
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("notifyChats")
+				}
+			}
+			return e
+		},
+		"notifyBroadcasts": func(p *textParser) TL {
+			var e TL_notifyBroadcasts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("notifyBroadcasts")
+				}
+			}
+			return e
+		},
+		"sendMessageTypingAction": func(p *textParser) TL {
+			var e TL_sendMessageTypingAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("sendMessageTypingAction")
+				}
+			}
+			return e
+		},
+		"sendMessageCancelAction": func(p *textParser) TL {
+			var e TL_sendMessageCancelAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("sendMessageCancelAction")
+				}
+			}
+			return e
+		},
+		"sendMessageRecordVideoAction": func(p *textParser) TL {
+			var e TL_sendMessageRecordVideoAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("sendMessageRecordVideoAction")
+				}
+			}
+			return e
+		},
+		"sendMessageUploadVideoAction": func(p *textParser) TL {
+			var e TL_sendMessageUploadVideoAction
+			for p.field() {
+				switch p.fieldName {
+				case "progress":
+					e.Progress = p.Int()
+				default:
+					p.unknownField("sendMessageUploadVideoAction")
+				}
+			}
+			return e
+		},
+		"sendMessageRecordAudioAction": func(p *textParser) TL {
+			var e TL_sendMessageRecordAudioAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("sendMessageRecordAudioAction")
+				}
+			}
+			return e
+		},
+		"sendMessageUploadAudioAction": func(p *textParser) TL {
+			var e TL_sendMessageUploadAudioAction
+			for p.field() {
+				switch p.fieldName {
+				case "progress":
+					e.Progress = p.Int()
+				default:
+					p.unknownField("sendMessageUploadAudioAction")
+				}
+			}
+			return e
+		},
+		"sendMessageUploadPhotoAction": func(p *textParser) TL {
+			var e TL_sendMessageUploadPhotoAction
+			for p.field() {
+				switch p.fieldName {
+				case "progress":
+					e.Progress = p.Int()
+				default:
+					p.unknownField("sendMessageUploadPhotoAction")
+				}
+			}
+			return e
+		},
+		"sendMessageUploadDocumentAction": func(p *textParser) TL {
+			var e TL_sendMessageUploadDocumentAction
+			for p.field() {
+				switch p.fieldName {
+				case "progress":
+					e.Progress = p.Int()
+				default:
+					p.unknownField("sendMessageUploadDocumentAction")
+				}
+			}
+			return e
+		},
+		"sendMessageGeoLocationAction": func(p *textParser) TL {
+			var e TL_sendMessageGeoLocationAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("sendMessageGeoLocationAction")
+				}
+			}
+			return e
+		},
+		"sendMessageChooseContactAction": func(p *textParser) TL {
+			var e TL_sendMessageChooseContactAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("sendMessageChooseContactAction")
+				}
+			}
+			return e
+		},
+		"sendMessageGamePlayAction": func(p *textParser) TL {
+			var e TL_sendMessageGamePlayAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("sendMessageGamePlayAction")
+				}
+			}
+			return e
+		},
+		"sendMessageRecordRoundAction": func(p *textParser) TL {
+			var e TL_sendMessageRecordRoundAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("sendMessageRecordRoundAction")
+				}
+			}
+			return e
+		},
+		"sendMessageUploadRoundAction": func(p *textParser) TL {
+			var e TL_sendMessageUploadRoundAction
+			for p.field() {
+				switch p.fieldName {
+				case "progress":
+					e.Progress = p.Int()
+				default:
+					p.unknownField("sendMessageUploadRoundAction")
+				}
+			}
+			return e
+		},
+		"speakingInGroupCallAction": func(p *textParser) TL {
+			var e TL_speakingInGroupCallAction
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("speakingInGroupCallAction")
+				}
+			}
+			return e
+		},
+		"sendMessageHistoryImportAction": func(p *textParser) TL {
+			var e TL_sendMessageHistoryImportAction
+			for p.field() {
+				switch p.fieldName {
+				case "progress":
+					e.Progress = p.Int()
+				default:
+					p.unknownField("sendMessageHistoryImportAction")
+				}
+			}
+			return e
+		},
+		"contacts.found": func(p *textParser) TL {
+			var e TL_contacts_found
+			for p.field() {
+				switch p.fieldName {
+				case "my_results":
+					e.MyResults = p.Vector()
+				case "results":
+					e.Results = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("contacts.found")
+				}
+			}
+			return e
+		},
+		"inputPrivacyKeyStatusTimestamp": func(p *textParser) TL {
+			var e TL_inputPrivacyKeyStatusTimestamp
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyKeyStatusTimestamp")
+				}
+			}
+			return e
+		},
+		"inputPrivacyKeyChatInvite": func(p *textParser) TL {
+			var e TL_inputPrivacyKeyChatInvite
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyKeyChatInvite")
+				}
+			}
+			return e
+		},
+		"inputPrivacyKeyPhoneCall": func(p *textParser) TL {
+			var e TL_inputPrivacyKeyPhoneCall
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyKeyPhoneCall")
+				}
+			}
+			return e
+		},
+		"inputPrivacyKeyPhoneP2P": func(p *textParser) TL {
+			var e TL_inputPrivacyKeyPhoneP2P
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyKeyPhoneP2P")
+				}
+			}
+			return e
+		},
+		"inputPrivacyKeyForwards": func(p *textParser) TL {
+			var e TL_inputPrivacyKeyForwards
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyKeyForwards")
+				}
+			}
+			return e
+		},
+		"inputPrivacyKeyProfilePhoto": func(p *textParser) TL {
+			var e TL_inputPrivacyKeyProfilePhoto
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyKeyProfilePhoto")
+				}
+			}
+			return e
+		},
+		"inputPrivacyKeyPhoneNumber": func(p *textParser) TL {
+			var e TL_inputPrivacyKeyPhoneNumber
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyKeyPhoneNumber")
+				}
+			}
+			return e
+		},
+		"inputPrivacyKeyAddedByPhone": func(p *textParser) TL {
+			var e TL_inputPrivacyKeyAddedByPhone
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyKeyAddedByPhone")
+				}
+			}
+			return e
+		},
+		"privacyKeyStatusTimestamp": func(p *textParser) TL {
+			var e TL_privacyKeyStatusTimestamp
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyKeyStatusTimestamp")
+				}
+			}
+			return e
+		},
+		"privacyKeyChatInvite": func(p *textParser) TL {
+			var e TL_privacyKeyChatInvite
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyKeyChatInvite")
+				}
+			}
+			return e
+		},
+		"privacyKeyPhoneCall": func(p *textParser) TL {
+			var e TL_privacyKeyPhoneCall
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyKeyPhoneCall")
+				}
+			}
+			return e
+		},
+		"privacyKeyPhoneP2P": func(p *textParser) TL {
+			var e TL_privacyKeyPhoneP2P
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyKeyPhoneP2P")
+				}
+			}
+			return e
+		},
+		"privacyKeyForwards": func(p *textParser) TL {
+			var e TL_privacyKeyForwards
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyKeyForwards")
+				}
+			}
+			return e
+		},
+		"privacyKeyProfilePhoto": func(p *textParser) TL {
+			var e TL_privacyKeyProfilePhoto
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyKeyProfilePhoto")
+				}
+			}
+			return e
+		},
+		"privacyKeyPhoneNumber": func(p *textParser) TL {
+			var e TL_privacyKeyPhoneNumber
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyKeyPhoneNumber")
+				}
+			}
+			return e
+		},
+		"privacyKeyAddedByPhone": func(p *textParser) TL {
+			var e TL_privacyKeyAddedByPhone
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyKeyAddedByPhone")
+				}
+			}
+			return e
+		},
+		"inputPrivacyValueAllowContacts": func(p *textParser) TL {
+			var e TL_inputPrivacyValueAllowContacts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyValueAllowContacts")
+				}
+			}
+			return e
+		},
+		"inputPrivacyValueAllowAll": func(p *textParser) TL {
+			var e TL_inputPrivacyValueAllowAll
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyValueAllowAll")
+				}
+			}
+			return e
+		},
+		"inputPrivacyValueAllowUsers": func(p *textParser) TL {
+			var e TL_inputPrivacyValueAllowUsers
+			for p.field() {
+				switch p.fieldName {
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("inputPrivacyValueAllowUsers")
+				}
+			}
+			return e
+		},
+		"inputPrivacyValueDisallowContacts": func(p *textParser) TL {
+			var e TL_inputPrivacyValueDisallowContacts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyValueDisallowContacts")
+				}
+			}
+			return e
+		},
+		"inputPrivacyValueDisallowAll": func(p *textParser) TL {
+			var e TL_inputPrivacyValueDisallowAll
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputPrivacyValueDisallowAll")
+				}
+			}
+			return e
+		},
+		"inputPrivacyValueDisallowUsers": func(p *textParser) TL {
+			var e TL_inputPrivacyValueDisallowUsers
+			for p.field() {
+				switch p.fieldName {
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("inputPrivacyValueDisallowUsers")
+				}
+			}
+			return e
+		},
+		"inputPrivacyValueAllowChatParticipants": func(p *textParser) TL {
+			var e TL_inputPrivacyValueAllowChatParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "chats":
+					e.Chats = p.VectorInt()
+				default:
+					p.unknownField("inputPrivacyValueAllowChatParticipants")
+				}
+			}
+			return e
+		},
+		"inputPrivacyValueDisallowChatParticipants": func(p *textParser) TL {
+			var e TL_inputPrivacyValueDisallowChatParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "chats":
+					e.Chats = p.VectorInt()
+				default:
+					p.unknownField("inputPrivacyValueDisallowChatParticipants")
+				}
+			}
+			return e
+		},
+		"privacyValueAllowContacts": func(p *textParser) TL {
+			var e TL_privacyValueAllowContacts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyValueAllowContacts")
+				}
+			}
+			return e
+		},
+		"privacyValueAllowAll": func(p *textParser) TL {
+			var e TL_privacyValueAllowAll
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyValueAllowAll")
+				}
+			}
+			return e
+		},
+		"privacyValueAllowUsers": func(p *textParser) TL {
+			var e TL_privacyValueAllowUsers
+			for p.field() {
+				switch p.fieldName {
+				case "users":
+					e.Users = p.VectorInt()
+				default:
+					p.unknownField("privacyValueAllowUsers")
+				}
+			}
+			return e
+		},
+		"privacyValueDisallowContacts": func(p *textParser) TL {
+			var e TL_privacyValueDisallowContacts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyValueDisallowContacts")
+				}
+			}
+			return e
+		},
+		"privacyValueDisallowAll": func(p *textParser) TL {
+			var e TL_privacyValueDisallowAll
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("privacyValueDisallowAll")
+				}
+			}
+			return e
+		},
+		"privacyValueDisallowUsers": func(p *textParser) TL {
+			var e TL_privacyValueDisallowUsers
+			for p.field() {
+				switch p.fieldName {
+				case "users":
+					e.Users = p.VectorInt()
+				default:
+					p.unknownField("privacyValueDisallowUsers")
+				}
+			}
+			return e
+		},
+		"privacyValueAllowChatParticipants": func(p *textParser) TL {
+			var e TL_privacyValueAllowChatParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "chats":
+					e.Chats = p.VectorInt()
+				default:
+					p.unknownField("privacyValueAllowChatParticipants")
+				}
+			}
+			return e
+		},
+		"privacyValueDisallowChatParticipants": func(p *textParser) TL {
+			var e TL_privacyValueDisallowChatParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "chats":
+					e.Chats = p.VectorInt()
+				default:
+					p.unknownField("privacyValueDisallowChatParticipants")
+				}
+			}
+			return e
+		},
+		"account.privacyRules": func(p *textParser) TL {
+			var e TL_account_privacyRules
+			for p.field() {
+				switch p.fieldName {
+				case "rules":
+					e.Rules = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("account.privacyRules")
+				}
+			}
+			return e
+		},
+		"accountDaysTTL": func(p *textParser) TL {
+			var e TL_accountDaysTTL
+			for p.field() {
+				switch p.fieldName {
+				case "days":
+					e.Days = p.Int()
+				default:
+					p.unknownField("accountDaysTTL")
+				}
+			}
+			return e
+		},
+		"documentAttributeImageSize": func(p *textParser) TL {
+			var e TL_documentAttributeImageSize
+			for p.field() {
+				switch p.fieldName {
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				default:
+					p.unknownField("documentAttributeImageSize")
+				}
+			}
+			return e
+		},
+		"documentAttributeAnimated": func(p *textParser) TL {
+			var e TL_documentAttributeAnimated
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("documentAttributeAnimated")
+				}
+			}
+			return e
+		},
+		"documentAttributeSticker": func(p *textParser) TL {
+			var e TL_documentAttributeSticker
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "mask":
+					e.Mask = p.Bool()
+					if e.Mask {
+						e.Flags |= 2
+					}
+				case "alt":
+					e.Alt = p.String()
+				case "stickerset":
+					e.Stickerset = p.Object()
+				case "mask_coords":
+					e.MaskCoords = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("documentAttributeSticker")
+				}
+			}
+			return e
+		},
+		"documentAttributeVideo": func(p *textParser) TL {
+			var e TL_documentAttributeVideo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "round_message":
+					e.RoundMessage = p.Bool()
+					if e.RoundMessage {
+						e.Flags |= 1
+					}
+				case "supports_streaming":
+					e.SupportsStreaming = p.Bool()
+					if e.SupportsStreaming {
+						e.Flags |= 2
+					}
+				case "duration":
+					e.Duration = p.Int()
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				default:
+					p.unknownField("documentAttributeVideo")
+				}
+			}
+			return e
+		},
+		"documentAttributeAudio": func(p *textParser) TL {
+			var e TL_documentAttributeAudio
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "voice":
+					e.Voice = p.Bool()
+					if e.Voice {
+						e.Flags |= 1024
+					}
+				case "duration":
+					e.Duration = p.Int()
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 1
+				case "performer":
+					e.Performer = p.String()
+					e.Flags |= 2
+				case "waveform":
+					e.Waveform = p.Bytes()
+					e.Flags |= 4
+				default:
+					p.unknownField("documentAttributeAudio")
+				}
+			}
+			return e
+		},
+		"documentAttributeFilename": func(p *textParser) TL {
+			var e TL_documentAttributeFilename
+			for p.field() {
+				switch p.fieldName {
+				case "file_name":
+					e.FileName = p.String()
+				default:
+					p.unknownField("documentAttributeFilename")
+				}
+			}
+			return e
+		},
+		"documentAttributeHasStickers": func(p *textParser) TL {
+			var e TL_documentAttributeHasStickers
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("documentAttributeHasStickers")
+				}
+			}
+			return e
+		},
+		"messages.stickersNotModified": func(p *textParser) TL {
+			var e TL_messages_stickersNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.stickersNotModified")
+				}
+			}
+			return e
+		},
+		"messages.stickers": func(p *textParser) TL {
+			var e TL_messages_stickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "stickers":
+					e.Stickers = p.Vector()
+				default:
+					p.unknownField("messages.stickers")
+				}
+			}
+			return e
+		},
+		"stickerPack": func(p *textParser) TL {
+			var e TL_stickerPack
+			for p.field() {
+				switch p.fieldName {
+				case "emoticon":
+					e.Emoticon = p.String()
+				case "documents":
+					e.Documents = p.VectorLong()
+				default:
+					p.unknownField("stickerPack")
+				}
+			}
+			return e
+		},
+		"messages.allStickersNotModified": func(p *textParser) TL {
+			var e TL_messages_allStickersNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.allStickersNotModified")
+				}
+			}
+			return e
+		},
+		"messages.allStickers": func(p *textParser) TL {
+			var e TL_messages_allStickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "sets":
+					e.Sets = p.Vector()
+				default:
+					p.unknownField("messages.allStickers")
+				}
+			}
+			return e
+		},
+		"messages.affectedMessages": func(p *textParser) TL {
+			var e TL_messages_affectedMessages
+			for p.field() {
+				switch p.fieldName {
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				default:
+					p.unknownField("messages.affectedMessages")
+				}
+			}
+			return e
+		},
+		"webPageEmpty": func(p *textParser) TL {
+			var e TL_webPageEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				default:
+					p.unknownField("webPageEmpty")
+				}
+			}
+			return e
+		},
+		"webPagePending": func(p *textParser) TL {
+			var e TL_webPagePending
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("webPagePending")
+				}
+			}
+			return e
+		},
+		"webPage": func(p *textParser) TL {
+			var e TL_webPage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.Long()
+				case "url":
+					e.Url = p.String()
+				case "display_url":
+					e.DisplayUrl = p.String()
+				case "hash":
+					e.Hash = p.Int()
+				case "type":
+					e.Type = p.String()
+					e.Flags |= 1
+				case "site_name":
+					e.SiteName = p.String()
+					e.Flags |= 2
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 4
+				case "description":
+					e.Description = p.String()
+					e.Flags |= 8
+				case "photo":
+					e.Photo = p.Object()
+					e.Flags |= 16
+				case "embed_url":
+					e.EmbedUrl = p.String()
+					e.Flags |= 32
+				case "embed_type":
+					e.EmbedType = p.String()
+					e.Flags |= 32
+				case "embed_width":
+					e.EmbedWidth = p.Int()
+					e.Flags |= 64
+				case "embed_height":
+					e.EmbedHeight = p.Int()
+					e.Flags |= 64
+				case "duration":
+					e.Duration = p.Int()
+					e.Flags |= 128
+				case "author":
+					e.Author = p.String()
+					e.Flags |= 256
+				case "document":
+					e.Document = p.Object()
+					e.Flags |= 512
+				case "cached_page":
+					e.CachedPage = p.Object()
+					e.Flags |= 1024
+				case "attributes":
+					e.Attributes = p.Vector()
+					e.Flags |= 4096
+				default:
+					p.unknownField("webPage")
+				}
+			}
+			return e
+		},
+		"webPageNotModified": func(p *textParser) TL {
+			var e TL_webPageNotModified
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "cached_page_views":
+					e.CachedPageViews = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("webPageNotModified")
+				}
+			}
+			return e
+		},
+		"authorization": func(p *textParser) TL {
+			var e TL_authorization
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "current":
+					e.Current = p.Bool()
+					if e.Current {
+						e.Flags |= 1
+					}
+				case "official_app":
+					e.OfficialApp = p.Bool()
+					if e.OfficialApp {
+						e.Flags |= 2
+					}
+				case "password_pending":
+					e.PasswordPending = p.Bool()
+					if e.PasswordPending {
+						e.Flags |= 4
+					}
+				case "hash":
+					e.Hash = p.Long()
+				case "device_model":
+					e.DeviceModel = p.String()
+				case "platform":
+					e.Platform = p.String()
+				case "system_version":
+					e.SystemVersion = p.String()
+				case "api_id":
+					e.ApiID = p.Int()
+				case "app_name":
+					e.AppName = p.String()
+				case "app_version":
+					e.AppVersion = p.String()
+				case "date_created":
+					e.DateCreated = p.Int()
+				case "date_active":
+					e.DateActive = p.Int()
+				case "ip":
+					e.Ip = p.String()
+				case "country":
+					e.Country = p.String()
+				case "region":
+					e.Region = p.String()
+				default:
+					p.unknownField("authorization")
+				}
+			}
+			return e
+		},
+		"account.authorizations": func(p *textParser) TL {
+			var e TL_account_authorizations
+			for p.field() {
+				switch p.fieldName {
+				case "authorizations":
+					e.Authorizations = p.Vector()
+				default:
+					p.unknownField("account.authorizations")
+				}
+			}
+			return e
+		},
+		"account.password": func(p *textParser) TL {
+			var e TL_account_password
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "has_recovery":
+					e.HasRecovery = p.Bool()
+					if e.HasRecovery {
+						e.Flags |= 1
+					}
+				case "has_secure_values":
+					e.HasSecureValues = p.Bool()
+					if e.HasSecureValues {
+						e.Flags |= 2
+					}
+				case "has_password":
+					e.HasPassword = p.Bool()
+					if e.HasPassword {
+						e.Flags |= 4
+					}
+				case "current_algo":
+					e.CurrentAlgo = p.Object()
+					e.Flags |= 4
+				case "srp_B":
+					e.SrpB = p.Bytes()
+					e.Flags |= 4
+				case "srp_id":
+					e.SrpID = p.Long()
+					e.Flags |= 4
+				case "hint":
+					e.Hint = p.String()
+					e.Flags |= 8
+				case "email_unconfirmed_pattern":
+					e.EmailUnconfirmedPattern = p.String()
+					e.Flags |= 16
+				case "new_algo":
+					e.NewAlgo = p.Object()
+				case "new_secure_algo":
+					e.NewSecureAlgo = p.Object()
+				case "secure_random":
+					e.SecureRandom = p.Bytes()
+				default:
+					p.unknownField("account.password")
+				}
+			}
+			return e
+		},
+		"account.passwordSettings": func(p *textParser) TL {
+			var e TL_account_passwordSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "email":
+					e.Email = p.String()
+					e.Flags |= 1
+				case "secure_settings":
+					e.SecureSettings = p.Object()
+					e.Flags |= 2
+				default:
+					p.unknownField("account.passwordSettings")
+				}
+			}
+			return e
+		},
+		"account.passwordInputSettings": func(p *textParser) TL {
+			var e TL_account_passwordInputSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "new_algo":
+					e.NewAlgo = p.Object()
+					e.Flags |= 1
+				case "new_password_hash":
+					e.NewPasswordHash = p.Bytes()
+					e.Flags |= 1
+				case "hint":
+					e.Hint = p.String()
+					e.Flags |= 1
+				case "email":
+					e.Email = p.String()
+					e.Flags |= 2
+				case "new_secure_settings":
+					e.NewSecureSettings = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("account.passwordInputSettings")
+				}
+			}
+			return e
+		},
+		"auth.passwordRecovery": func(p *textParser) TL {
+			var e TL_auth_passwordRecovery
+			for p.field() {
+				switch p.fieldName {
+				case "email_pattern":
+					e.EmailPattern = p.String()
+				default:
+					p.unknownField("auth.passwordRecovery")
+				}
+			}
+			return e
+		},
+		"receivedNotifyMessage": func(p *textParser) TL {
+			var e TL_receivedNotifyMessage
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				case "flags":
+					e.Flags = p.Int()
+				default:
+					p.unknownField("receivedNotifyMessage")
+				}
+			}
+			return e
+		},
+		"chatInviteExported": func(p *textParser) TL {
+			var e TL_chatInviteExported
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "revoked":
+					e.Revoked = p.Bool()
+					if e.Revoked {
+						e.Flags |= 1
+					}
+				case "permanent":
+					e.Permanent = p.Bool()
+					if e.Permanent {
+						e.Flags |= 32
+					}
+				case "link":
+					e.Link = p.String()
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "start_date":
+					e.StartDate = p.Int()
+					e.Flags |= 16
+				case "expire_date":
+					e.ExpireDate = p.Int()
+					e.Flags |= 2
+				case "usage_limit":
+					e.UsageLimit = p.Int()
+					e.Flags |= 4
+				case "usage":
+					e.Usage = p.Int()
+					e.Flags |= 8
+				default:
+					p.unknownField("chatInviteExported")
+				}
+			}
+			return e
+		},
+		"chatInviteAlready": func(p *textParser) TL {
+			var e TL_chatInviteAlready
+			for p.field() {
+				switch p.fieldName {
+				case "chat":
+					e.Chat = p.Object()
+				default:
+					p.unknownField("chatInviteAlready")
+				}
+			}
+			return e
+		},
+		"chatInvite": func(p *textParser) TL {
+			var e TL_chatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "channel":
+					e.Channel = p.Bool()
+					if e.Channel {
+						e.Flags |= 1
+					}
+				case "broadcast":
+					e.Broadcast = p.Bool()
+					if e.Broadcast {
+						e.Flags |= 2
+					}
+				case "public":
+					e.Public = p.Bool()
+					if e.Public {
+						e.Flags |= 4
+					}
+				case "megagroup":
+					e.Megagroup = p.Bool()
+					if e.Megagroup {
+						e.Flags |= 8
+					}
+				case "title":
+					e.Title = p.String()
+				case "photo":
+					e.Photo = p.Object()
+				case "participants_count":
+					e.ParticipantsCount = p.Int()
+				case "participants":
+					e.Participants = p.Vector()
+					e.Flags |= 16
+				default:
+					p.unknownField("chatInvite")
+				}
+			}
+			return e
+		},
+		"chatInvitePeek": func(p *textParser) TL {
+			var e TL_chatInvitePeek
+			for p.field() {
+				switch p.fieldName {
+				case "chat":
+					e.Chat = p.Object()
+				case "expires":
+					e.Expires = p.Int()
+				default:
+					p.unknownField("chatInvitePeek")
+				}
+			}
+			return e
+		},
+		"inputStickerSetEmpty": func(p *textParser) TL {
+			var e TL_inputStickerSetEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputStickerSetEmpty")
+				}
+			}
+			return e
+		},
+		"inputStickerSetID": func(p *textParser) TL {
+			var e TL_inputStickerSetID
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputStickerSetID")
+				}
+			}
+			return e
+		},
+		"inputStickerSetShortName": func(p *textParser) TL {
+			var e TL_inputStickerSetShortName
+			for p.field() {
+				switch p.fieldName {
+				case "short_name":
+					e.ShortName = p.String()
+				default:
+					p.unknownField("inputStickerSetShortName")
+				}
+			}
+			return e
+		},
+		"inputStickerSetAnimatedEmoji": func(p *textParser) TL {
+			var e TL_inputStickerSetAnimatedEmoji
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputStickerSetAnimatedEmoji")
+				}
+			}
+			return e
+		},
+		"inputStickerSetDice": func(p *textParser) TL {
+			var e TL_inputStickerSetDice
+			for p.field() {
+				switch p.fieldName {
+				case "emoticon":
+					e.Emoticon = p.String()
+				default:
+					p.unknownField("inputStickerSetDice")
+				}
+			}
+			return e
+		},
+		"stickerSet": func(p *textParser) TL {
+			var e TL_stickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "archived":
+					e.Archived = p.Bool()
+					if e.Archived {
+						e.Flags |= 2
+					}
+				case "official":
+					e.Official = p.Bool()
+					if e.Official {
+						e.Flags |= 4
+					}
+				case "masks":
+					e.Masks = p.Bool()
+					if e.Masks {
+						e.Flags |= 8
+					}
+				case "animated":
+					e.Animated = p.Bool()
+					if e.Animated {
+						e.Flags |= 32
+					}
+				case "installed_date":
+					e.InstalledDate = p.Int()
+					e.Flags |= 1
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "title":
+					e.Title = p.String()
+				case "short_name":
+					e.ShortName = p.String()
+				case "thumbs":
+					e.Thumbs = p.Vector()
+					e.Flags |= 16
+				case "thumb_dc_id":
+					e.ThumbDcID = p.Int()
+					e.Flags |= 16
+				case "count":
+					e.Count = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("stickerSet")
+				}
+			}
+			return e
+		},
+		"messages.stickerSet": func(p *textParser) TL {
+			var e TL_messages_stickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "set":
+					e.Set = p.Object()
+				case "packs":
+					e.Packs = p.Vector()
+				case "documents":
+					e.Documents = p.Vector()
+				default:
+					p.unknownField("messages.stickerSet")
+				}
+			}
+			return e
+		},
+		"botCommand": func(p *textParser) TL {
+			var e TL_botCommand
+			for p.field() {
+				switch p.fieldName {
+				case "command":
+					e.Command = p.String()
+				case "description":
+					e.Description = p.String()
+				default:
+					p.unknownField("botCommand")
+				}
+			}
+			return e
+		},
+		"botInfo": func(p *textParser) TL {
+			var e TL_botInfo
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "description":
+					e.Description = p.String()
+				case "commands":
+					e.Commands = p.Vector()
+				default:
+					p.unknownField("botInfo")
+				}
+			}
+			return e
+		},
+		"keyboardButton": func(p *textParser) TL {
+			var e TL_keyboardButton
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("keyboardButton")
+				}
+			}
+			return e
+		},
+		"keyboardButtonUrl": func(p *textParser) TL {
+			var e TL_keyboardButtonUrl
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				case "url":
+					e.Url = p.String()
+				default:
+					p.unknownField("keyboardButtonUrl")
+				}
+			}
+			return e
+		},
+		"keyboardButtonCallback": func(p *textParser) TL {
+			var e TL_keyboardButtonCallback
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "requires_password":
+					e.RequiresPassword = p.Bool()
+					if e.RequiresPassword {
+						e.Flags |= 1
+					}
+				case "text":
+					e.Text = p.String()
+				case "data":
+					e.Data = p.Bytes()
+				default:
+					p.unknownField("keyboardButtonCallback")
+				}
+			}
+			return e
+		},
+		"keyboardButtonRequestPhone": func(p *textParser) TL {
+			var e TL_keyboardButtonRequestPhone
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("keyboardButtonRequestPhone")
+				}
+			}
+			return e
+		},
+		"keyboardButtonRequestGeoLocation": func(p *textParser) TL {
+			var e TL_keyboardButtonRequestGeoLocation
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("keyboardButtonRequestGeoLocation")
+				}
+			}
+			return e
+		},
+		"keyboardButtonSwitchInline": func(p *textParser) TL {
+			var e TL_keyboardButtonSwitchInline
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "same_peer":
+					e.SamePeer = p.Bool()
+					if e.SamePeer {
+						e.Flags |= 1
+					}
+				case "text":
+					e.Text = p.String()
+				case "query":
+					e.Query = p.String()
+				default:
+					p.unknownField("keyboardButtonSwitchInline")
+				}
+			}
+			return e
+		},
+		"keyboardButtonGame": func(p *textParser) TL {
+			var e TL_keyboardButtonGame
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("keyboardButtonGame")
+				}
+			}
+			return e
+		},
+		"keyboardButtonBuy": func(p *textParser) TL {
+			var e TL_keyboardButtonBuy
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("keyboardButtonBuy")
+				}
+			}
+			return e
+		},
+		"keyboardButtonUrlAuth": func(p *textParser) TL {
+			var e TL_keyboardButtonUrlAuth
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "text":
+					e.Text = p.String()
+				case "fwd_text":
+					e.FwdText = p.String()
+					e.Flags |= 1
+				case "url":
+					e.Url = p.String()
+				case "button_id":
+					e.ButtonID = p.Int()
+				default:
+					p.unknownField("keyboardButtonUrlAuth")
+				}
+			}
+			return e
+		},
+		"inputKeyboardButtonUrlAuth": func(p *textParser) TL {
+			var e TL_inputKeyboardButtonUrlAuth
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "request_write_access":
+					e.RequestWriteAccess = p.Bool()
+					if e.RequestWriteAccess {
+						e.Flags |= 1
+					}
+				case "text":
+					e.Text = p.String()
+				case "fwd_text":
+					e.FwdText = p.String()
+					e.Flags |= 2
+				case "url":
+					e.Url = p.String()
+				case "bot":
+					e.Bot = p.Object()
+				default:
+					p.unknownField("inputKeyboardButtonUrlAuth")
+				}
+			}
+			return e
+		},
+		"keyboardButtonRequestPoll": func(p *textParser) TL {
+			var e TL_keyboardButtonRequestPoll
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "quiz":
+					e.Quiz = p.Object()
+					e.Flags |= 1
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("keyboardButtonRequestPoll")
+				}
+			}
+			return e
+		},
+		"keyboardButtonRow": func(p *textParser) TL {
+			var e TL_keyboardButtonRow
+			for p.field() {
+				switch p.fieldName {
+				case "buttons":
+					e.Buttons = p.Vector()
+				default:
+					p.unknownField("keyboardButtonRow")
+				}
+			}
+			return e
+		},
+		"replyKeyboardHide": func(p *textParser) TL {
+			var e TL_replyKeyboardHide
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "selective":
+					e.Selective = p.Bool()
+					if e.Selective {
+						e.Flags |= 4
+					}
+				default:
+					p.unknownField("replyKeyboardHide")
+				}
+			}
+			return e
+		},
+		"replyKeyboardForceReply": func(p *textParser) TL {
+			var e TL_replyKeyboardForceReply
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "single_use":
+					e.SingleUse = p.Bool()
+					if e.SingleUse {
+						e.Flags |= 2
+					}
+				case "selective":
+					e.Selective = p.Bool()
+					if e.Selective {
+						e.Flags |= 4
+					}
+				default:
+					p.unknownField("replyKeyboardForceReply")
+				}
+			}
+			return e
+		},
+		"replyKeyboardMarkup": func(p *textParser) TL {
+			var e TL_replyKeyboardMarkup
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "resize":
+					e.Resize = p.Bool()
+					if e.Resize {
+						e.Flags |= 1
+					}
+				case "single_use":
+					e.SingleUse = p.Bool()
+					if e.SingleUse {
+						e.Flags |= 2
+					}
+				case "selective":
+					e.Selective = p.Bool()
+					if e.Selective {
+						e.Flags |= 4
+					}
+				case "rows":
+					e.Rows = p.Vector()
+				default:
+					p.unknownField("replyKeyboardMarkup")
+				}
+			}
+			return e
+		},
+		"replyInlineMarkup": func(p *textParser) TL {
+			var e TL_replyInlineMarkup
+			for p.field() {
+				switch p.fieldName {
+				case "rows":
+					e.Rows = p.Vector()
+				default:
+					p.unknownField("replyInlineMarkup")
+				}
+			}
+			return e
+		},
+		"messageEntityUnknown": func(p *textParser) TL {
+			var e TL_messageEntityUnknown
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityUnknown")
+				}
+			}
+			return e
+		},
+		"messageEntityMention": func(p *textParser) TL {
+			var e TL_messageEntityMention
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityMention")
+				}
+			}
+			return e
+		},
+		"messageEntityHashtag": func(p *textParser) TL {
+			var e TL_messageEntityHashtag
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityHashtag")
+				}
+			}
+			return e
+		},
+		"messageEntityBotCommand": func(p *textParser) TL {
+			var e TL_messageEntityBotCommand
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityBotCommand")
+				}
+			}
+			return e
+		},
+		"messageEntityUrl": func(p *textParser) TL {
+			var e TL_messageEntityUrl
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityUrl")
+				}
+			}
+			return e
+		},
+		"messageEntityEmail": func(p *textParser) TL {
+			var e TL_messageEntityEmail
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityEmail")
+				}
+			}
+			return e
+		},
+		"messageEntityBold": func(p *textParser) TL {
+			var e TL_messageEntityBold
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityBold")
+				}
+			}
+			return e
+		},
+		"messageEntityItalic": func(p *textParser) TL {
+			var e TL_messageEntityItalic
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityItalic")
+				}
+			}
+			return e
+		},
+		"messageEntityCode": func(p *textParser) TL {
+			var e TL_messageEntityCode
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityCode")
+				}
+			}
+			return e
+		},
+		"messageEntityPre": func(p *textParser) TL {
+			var e TL_messageEntityPre
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				case "language":
+					e.Language = p.String()
+				default:
+					p.unknownField("messageEntityPre")
+				}
+			}
+			return e
+		},
+		"messageEntityTextUrl": func(p *textParser) TL {
+			var e TL_messageEntityTextUrl
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				case "url":
+					e.Url = p.String()
+				default:
+					p.unknownField("messageEntityTextUrl")
+				}
+			}
+			return e
+		},
+		"messageEntityMentionName": func(p *textParser) TL {
+			var e TL_messageEntityMentionName
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				default:
+					p.unknownField("messageEntityMentionName")
+				}
+			}
+			return e
+		},
+		"inputMessageEntityMentionName": func(p *textParser) TL {
+			var e TL_inputMessageEntityMentionName
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				case "user_id":
+					e.UserID = p.Object()
+				default:
+					p.unknownField("inputMessageEntityMentionName")
+				}
+			}
+			return e
+		},
+		"messageEntityPhone": func(p *textParser) TL {
+			var e TL_messageEntityPhone
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityPhone")
+				}
+			}
+			return e
+		},
+		"messageEntityCashtag": func(p *textParser) TL {
+			var e TL_messageEntityCashtag
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityCashtag")
+				}
+			}
+			return e
+		},
+		"messageEntityUnderline": func(p *textParser) TL {
+			var e TL_messageEntityUnderline
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityUnderline")
+				}
+			}
+			return e
+		},
+		"messageEntityStrike": func(p *textParser) TL {
+			var e TL_messageEntityStrike
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityStrike")
+				}
+			}
+			return e
+		},
+		"messageEntityBlockquote": func(p *textParser) TL {
+			var e TL_messageEntityBlockquote
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityBlockquote")
+				}
+			}
+			return e
+		},
+		"messageEntityBankCard": func(p *textParser) TL {
+			var e TL_messageEntityBankCard
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("messageEntityBankCard")
+				}
+			}
+			return e
+		},
+		"inputChannelEmpty": func(p *textParser) TL {
+			var e TL_inputChannelEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputChannelEmpty")
+				}
+			}
+			return e
+		},
+		"inputChannel": func(p *textParser) TL {
+			var e TL_inputChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel_id":
+					e.ChannelID = p.Int()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputChannel")
+				}
+			}
+			return e
+		},
+		"inputChannelFromMessage": func(p *textParser) TL {
+			var e TL_inputChannelFromMessage
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "channel_id":
+					e.ChannelID = p.Int()
+				default:
+					p.unknownField("inputChannelFromMessage")
+				}
+			}
+			return e
+		},
+		"contacts.resolvedPeer": func(p *textParser) TL {
+			var e TL_contacts_resolvedPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("contacts.resolvedPeer")
+				}
+			}
+			return e
+		},
+		"messageRange": func(p *textParser) TL {
+			var e TL_messageRange
+			for p.field() {
+				switch p.fieldName {
+				case "min_id":
+					e.MinID = p.Int()
+				case "max_id":
+					e.MaxID = p.Int()
+				default:
+					p.unknownField("messageRange")
+				}
+			}
+			return e
+		},
+		"updates.channelDifferenceEmpty": func(p *textParser) TL {
+			var e TL_updates_channelDifferenceEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "final":
+					e.Final = p.Bool()
+					if e.Final {
+						e.Flags |= 1
+					}
+				case "pts":
+					e.Pts = p.Int()
+				case "timeout":
+					e.Timeout = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("updates.channelDifferenceEmpty")
+				}
+			}
+			return e
+		},
+		"updates.channelDifferenceTooLong": func(p *textParser) TL {
+			var e TL_updates_channelDifferenceTooLong
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "final":
+					e.Final = p.Bool()
+					if e.Final {
+						e.Flags |= 1
+					}
+				case "timeout":
+					e.Timeout = p.Int()
+					e.Flags |= 2
+				case "dialog":
+					e.Dialog = p.Object()
+				case "messages":
+					e.Messages = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("updates.channelDifferenceTooLong")
+				}
+			}
+			return e
+		},
+		"updates.channelDifference": func(p *textParser) TL {
+			var e TL_updates_channelDifference
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "final":
+					e.Final = p.Bool()
+					if e.Final {
+						e.Flags |= 1
+					}
+				case "pts":
+					e.Pts = p.Int()
+				case "timeout":
+					e.Timeout = p.Int()
+					e.Flags |= 2
+				case "new_messages":
+					e.NewMessages = p.Vector()
+				case "other_updates":
+					e.OtherUpdates = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("updates.channelDifference")
+				}
+			}
+			return e
+		},
+		"channelMessagesFilterEmpty": func(p *textParser) TL {
+			var e TL_channelMessagesFilterEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channelMessagesFilterEmpty")
+				}
+			}
+			return e
+		},
+		"channelMessagesFilter": func(p *textParser) TL {
+			var e TL_channelMessagesFilter
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "exclude_new_messages":
+					e.ExcludeNewMessages = p.Bool()
+					if e.ExcludeNewMessages {
+						e.Flags |= 2
+					}
+				case "ranges":
+					e.Ranges = p.Vector()
+				default:
+					p.unknownField("channelMessagesFilter")
+				}
+			}
+			return e
+		},
+		"channelParticipant": func(p *textParser) TL {
+			var e TL_channelParticipant
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("channelParticipant")
+				}
+			}
+			return e
+		},
+		"channelParticipantSelf": func(p *textParser) TL {
+			var e TL_channelParticipantSelf
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "inviter_id":
+					e.InviterID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("channelParticipantSelf")
+				}
+			}
+			return e
+		},
+		"channelParticipantCreator": func(p *textParser) TL {
+			var e TL_channelParticipantCreator
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "admin_rights":
+					e.AdminRights = p.Object()
+				case "rank":
+					e.Rank = p.String()
+					e.Flags |= 1
+				default:
+					p.unknownField("channelParticipantCreator")
+				}
+			}
+			return e
+		},
+		"channelParticipantAdmin": func(p *textParser) TL {
+			var e TL_channelParticipantAdmin
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "can_edit":
+					e.CanEdit = p.Bool()
+					if e.CanEdit {
+						e.Flags |= 1
+					}
+				case "self":
+					e.Self = p.Bool()
+					if e.Self {
+						e.Flags |= 2
+					}
+				case "user_id":
+					e.UserID = p.Int()
+				case "inviter_id":
+					e.InviterID = p.Int()
+					e.Flags |= 2
+				case "promoted_by":
+					e.PromotedBy = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "admin_rights":
+					e.AdminRights = p.Object()
+				case "rank":
+					e.Rank = p.String()
+					e.Flags |= 4
+				default:
+					p.unknownField("channelParticipantAdmin")
+				}
+			}
+			return e
+		},
+		"channelParticipantBanned": func(p *textParser) TL {
+			var e TL_channelParticipantBanned
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "left":
+					e.Left = p.Bool()
+					if e.Left {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "kicked_by":
+					e.KickedBy = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "banned_rights":
+					e.BannedRights = p.Object()
+				default:
+					p.unknownField("channelParticipantBanned")
+				}
+			}
+			return e
+		},
+		"channelParticipantLeft": func(p *textParser) TL {
+			var e TL_channelParticipantLeft
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("channelParticipantLeft")
+				}
+			}
+			return e
+		},
+		"channelParticipantsRecent": func(p *textParser) TL {
+			var e TL_channelParticipantsRecent
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channelParticipantsRecent")
+				}
+			}
+			return e
+		},
+		"channelParticipantsAdmins": func(p *textParser) TL {
+			var e TL_channelParticipantsAdmins
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channelParticipantsAdmins")
+				}
+			}
+			return e
+		},
+		"channelParticipantsKicked": func(p *textParser) TL {
+			var e TL_channelParticipantsKicked
+			for p.field() {
+				switch p.fieldName {
+				case "q":
+					e.Q = p.String()
+				default:
+					p.unknownField("channelParticipantsKicked")
+				}
+			}
+			return e
+		},
+		"channelParticipantsBots": func(p *textParser) TL {
+			var e TL_channelParticipantsBots
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channelParticipantsBots")
+				}
+			}
+			return e
+		},
+		"channelParticipantsBanned": func(p *textParser) TL {
+			var e TL_channelParticipantsBanned
+			for p.field() {
+				switch p.fieldName {
+				case "q":
+					e.Q = p.String()
+				default:
+					p.unknownField("channelParticipantsBanned")
+				}
+			}
+			return e
+		},
+		"channelParticipantsSearch": func(p *textParser) TL {
+			var e TL_channelParticipantsSearch
+			for p.field() {
+				switch p.fieldName {
+				case "q":
+					e.Q = p.String()
+				default:
+					p.unknownField("channelParticipantsSearch")
+				}
+			}
+			return e
+		},
+		"channelParticipantsContacts": func(p *textParser) TL {
+			var e TL_channelParticipantsContacts
+			for p.field() {
+				switch p.fieldName {
+				case "q":
+					e.Q = p.String()
+				default:
+					p.unknownField("channelParticipantsContacts")
+				}
+			}
+			return e
+		},
+		"channelParticipantsMentions": func(p *textParser) TL {
+			var e TL_channelParticipantsMentions
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "q":
+					e.Q = p.String()
+					e.Flags |= 1
+				case "top_msg_id":
+					e.TopMsgID = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("channelParticipantsMentions")
+				}
+			}
+			return e
+		},
+		"channels.channelParticipants": func(p *textParser) TL {
+			var e TL_channels_channelParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "participants":
+					e.Participants = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("channels.channelParticipants")
+				}
+			}
+			return e
+		},
+		"channels.channelParticipantsNotModified": func(p *textParser) TL {
+			var e TL_channels_channelParticipantsNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channels.channelParticipantsNotModified")
+				}
+			}
+			return e
+		},
+		"channels.channelParticipant": func(p *textParser) TL {
+			var e TL_channels_channelParticipant
+			for p.field() {
+				switch p.fieldName {
+				case "participant":
+					e.Participant = p.Object()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("channels.channelParticipant")
+				}
+			}
+			return e
+		},
+		"help.termsOfService": func(p *textParser) TL {
+			var e TL_help_termsOfService
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "popup":
+					e.Popup = p.Bool()
+					if e.Popup {
+						e.Flags |= 1
+					}
+				case "id":
+					e.ID = p.Object()
+				case "text":
+					e.Text = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+				case "min_age_confirm":
+					e.MinAgeConfirm = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("help.termsOfService")
+				}
+			}
+			return e
+		},
+		"messages.savedGifsNotModified": func(p *textParser) TL {
+			var e TL_messages_savedGifsNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.savedGifsNotModified")
+				}
+			}
+			return e
+		},
+		"messages.savedGifs": func(p *textParser) TL {
+			var e TL_messages_savedGifs
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "gifs":
+					e.Gifs = p.Vector()
+				default:
+					p.unknownField("messages.savedGifs")
+				}
+			}
+			return e
+		},
+		"inputBotInlineMessageMediaAuto": func(p *textParser) TL {
+			var e TL_inputBotInlineMessageMediaAuto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 2
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("inputBotInlineMessageMediaAuto")
+				}
+			}
+			return e
+		},
+		"inputBotInlineMessageText": func(p *textParser) TL {
+			var e TL_inputBotInlineMessageText
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "no_webpage":
+					e.NoWebpage = p.Bool()
+					if e.NoWebpage {
+						e.Flags |= 1
+					}
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 2
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("inputBotInlineMessageText")
+				}
+			}
+			return e
+		},
+		"inputBotInlineMessageMediaGeo": func(p *textParser) TL {
+			var e TL_inputBotInlineMessageMediaGeo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				case "heading":
+					e.Heading = p.Int()
+					e.Flags |= 1
+				case "period":
+					e.Period = p.Int()
+					e.Flags |= 2
+				case "proximity_notification_radius":
+					e.ProximityNotificationRadius = p.Int()
+					e.Flags |= 8
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("inputBotInlineMessageMediaGeo")
+				}
+			}
+			return e
+		},
+		"inputBotInlineMessageMediaVenue": func(p *textParser) TL {
+			var e TL_inputBotInlineMessageMediaVenue
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				case "title":
+					e.Title = p.String()
+				case "address":
+					e.Address = p.String()
+				case "provider":
+					e.Provider = p.String()
+				case "venue_id":
+					e.VenueID = p.String()
+				case "venue_type":
+					e.VenueType = p.String()
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("inputBotInlineMessageMediaVenue")
+				}
+			}
+			return e
+		},
+		"inputBotInlineMessageMediaContact": func(p *textParser) TL {
+			var e TL_inputBotInlineMessageMediaContact
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				case "vcard":
+					e.Vcard = p.String()
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("inputBotInlineMessageMediaContact")
+				}
+			}
+			return e
+		},
+		"inputBotInlineMessageGame": func(p *textParser) TL {
+			var e TL_inputBotInlineMessageGame
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("inputBotInlineMessageGame")
+				}
+			}
+			return e
+		},
+		"inputBotInlineResult": func(p *textParser) TL {
+			var e TL_inputBotInlineResult
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.String()
+				case "type":
+					e.Type = p.String()
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 2
+				case "description":
+					e.Description = p.String()
+					e.Flags |= 4
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 8
+				case "thumb":
+					e.Thumb = p.Object()
+					e.Flags |= 16
+				case "content":
+					e.Content = p.Object()
+					e.Flags |= 32
+				case "send_message":
+					e.SendMessage = p.Object()
+				default:
+					p.unknownField("inputBotInlineResult")
+				}
+			}
+			return e
+		},
+		"inputBotInlineResultPhoto": func(p *textParser) TL {
+			var e TL_inputBotInlineResultPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.String()
+				case "type":
+					e.Type = p.String()
+				case "photo":
+					e.Photo = p.Object()
+				case "send_message":
+					e.SendMessage = p.Object()
+				default:
+					p.unknownField("inputBotInlineResultPhoto")
+				}
+			}
+			return e
+		},
+		"inputBotInlineResultDocument": func(p *textParser) TL {
+			var e TL_inputBotInlineResultDocument
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.String()
+				case "type":
+					e.Type = p.String()
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 2
+				case "description":
+					e.Description = p.String()
+					e.Flags |= 4
+				case "document":
+					e.Document = p.Object()
+				case "send_message":
+					e.SendMessage = p.Object()
+				default:
+					p.unknownField("inputBotInlineResultDocument")
+				}
+			}
+			return e
+		},
+		"inputBotInlineResultGame": func(p *textParser) TL {
+			var e TL_inputBotInlineResultGame
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.String()
+				case "short_name":
+					e.ShortName = p.String()
+				case "send_message":
+					e.SendMessage = p.Object()
+				default:
+					p.unknownField("inputBotInlineResultGame")
+				}
+			}
+			return e
+		},
+		"botInlineMessageMediaAuto": func(p *textParser) TL {
+			var e TL_botInlineMessageMediaAuto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 2
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("botInlineMessageMediaAuto")
+				}
+			}
+			return e
+		},
+		"botInlineMessageText": func(p *textParser) TL {
+			var e TL_botInlineMessageText
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "no_webpage":
+					e.NoWebpage = p.Bool()
+					if e.NoWebpage {
+						e.Flags |= 1
+					}
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 2
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("botInlineMessageText")
+				}
+			}
+			return e
+		},
+		"botInlineMessageMediaGeo": func(p *textParser) TL {
+			var e TL_botInlineMessageMediaGeo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "geo":
+					e.Geo = p.Object()
+				case "heading":
+					e.Heading = p.Int()
+					e.Flags |= 1
+				case "period":
+					e.Period = p.Int()
+					e.Flags |= 2
+				case "proximity_notification_radius":
+					e.ProximityNotificationRadius = p.Int()
+					e.Flags |= 8
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("botInlineMessageMediaGeo")
+				}
+			}
+			return e
+		},
+		"botInlineMessageMediaVenue": func(p *textParser) TL {
+			var e TL_botInlineMessageMediaVenue
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "geo":
+					e.Geo = p.Object()
+				case "title":
+					e.Title = p.String()
+				case "address":
+					e.Address = p.String()
+				case "provider":
+					e.Provider = p.String()
+				case "venue_id":
+					e.VenueID = p.String()
+				case "venue_type":
+					e.VenueType = p.String()
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("botInlineMessageMediaVenue")
+				}
+			}
+			return e
+		},
+		"botInlineMessageMediaContact": func(p *textParser) TL {
+			var e TL_botInlineMessageMediaContact
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				case "vcard":
+					e.Vcard = p.String()
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("botInlineMessageMediaContact")
+				}
+			}
+			return e
+		},
+		"botInlineResult": func(p *textParser) TL {
+			var e TL_botInlineResult
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.String()
+				case "type":
+					e.Type = p.String()
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 2
+				case "description":
+					e.Description = p.String()
+					e.Flags |= 4
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 8
+				case "thumb":
+					e.Thumb = p.Object()
+					e.Flags |= 16
+				case "content":
+					e.Content = p.Object()
+					e.Flags |= 32
+				case "send_message":
+					e.SendMessage = p.Object()
+				default:
+					p.unknownField("botInlineResult")
+				}
+			}
+			return e
+		},
+		"botInlineMediaResult": func(p *textParser) TL {
+			var e TL_botInlineMediaResult
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.String()
+				case "type":
+					e.Type = p.String()
+				case "photo":
+					e.Photo = p.Object()
+					e.Flags |= 1
+				case "document":
+					e.Document = p.Object()
+					e.Flags |= 2
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 4
+				case "description":
+					e.Description = p.String()
+					e.Flags |= 8
+				case "send_message":
+					e.SendMessage = p.Object()
+				default:
+					p.unknownField("botInlineMediaResult")
+				}
+			}
+			return e
+		},
+		"messages.botResults": func(p *textParser) TL {
+			var e TL_messages_botResults
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "gallery":
+					e.Gallery = p.Bool()
+					if e.Gallery {
+						e.Flags |= 1
+					}
+				case "query_id":
+					e.QueryID = p.Long()
+				case "next_offset":
+					e.NextOffset = p.String()
+					e.Flags |= 2
+				case "switch_pm":
+					e.SwitchPm = p.Object()
+					e.Flags |= 4
+				case "results":
+					e.Results = p.Vector()
+				case "cache_time":
+					e.CacheTime = p.Int()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.botResults")
+				}
+			}
+			return e
+		},
+		"exportedMessageLink": func(p *textParser) TL {
+			var e TL_exportedMessageLink
+			for p.field() {
+				switch p.fieldName {
+				case "link":
+					e.Link = p.String()
+				case "html":
+					e.Html = p.String()
+				default:
+					p.unknownField("exportedMessageLink")
+				}
+			}
+			return e
+		},
+		"messageFwdHeader": func(p *textParser) TL {
+			var e TL_messageFwdHeader
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "imported":
+					e.Imported = p.Bool()
+					if e.Imported {
+						e.Flags |= 128
+					}
+				case "from_id":
+					e.FromID = p.Object()
+					e.Flags |= 1
+				case "from_name":
+					e.FromName = p.String()
+					e.Flags |= 32
+				case "date":
+					e.Date = p.Int()
+				case "channel_post":
+					e.ChannelPost = p.Int()
+					e.Flags |= 4
+				case "post_author":
+					e.PostAuthor = p.String()
+					e.Flags |= 8
+				case "saved_from_peer":
+					e.SavedFromPeer = p.Object()
+					e.Flags |= 16
+				case "saved_from_msg_id":
+					e.SavedFromMsgID = p.Int()
+					e.Flags |= 16
+				case "psa_type":
+					e.PsaType = p.String()
+					e.Flags |= 64
+				default:
+					p.unknownField("messageFwdHeader")
+				}
+			}
+			return e
+		},
+		"auth.codeTypeSms": func(p *textParser) TL {
+			var e TL_auth_codeTypeSms
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("auth.codeTypeSms")
+				}
+			}
+			return e
+		},
+		"auth.codeTypeCall": func(p *textParser) TL {
+			var e TL_auth_codeTypeCall
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("auth.codeTypeCall")
+				}
+			}
+			return e
+		},
+		"auth.codeTypeFlashCall": func(p *textParser) TL {
+			var e TL_auth_codeTypeFlashCall
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("auth.codeTypeFlashCall")
+				}
+			}
+			return e
+		},
+		"auth.sentCodeTypeApp": func(p *textParser) TL {
+			var e TL_auth_sentCodeTypeApp
+			for p.field() {
+				switch p.fieldName {
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("auth.sentCodeTypeApp")
+				}
+			}
+			return e
+		},
+		"auth.sentCodeTypeSms": func(p *textParser) TL {
+			var e TL_auth_sentCodeTypeSms
+			for p.field() {
+				switch p.fieldName {
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("auth.sentCodeTypeSms")
+				}
+			}
+			return e
+		},
+		"auth.sentCodeTypeCall": func(p *textParser) TL {
+			var e TL_auth_sentCodeTypeCall
+			for p.field() {
+				switch p.fieldName {
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("auth.sentCodeTypeCall")
+				}
+			}
+			return e
+		},
+		"auth.sentCodeTypeFlashCall": func(p *textParser) TL {
+			var e TL_auth_sentCodeTypeFlashCall
+			for p.field() {
+				switch p.fieldName {
+				case "pattern":
+					e.Pattern = p.String()
+				default:
+					p.unknownField("auth.sentCodeTypeFlashCall")
+				}
+			}
+			return e
+		},
+		"messages.botCallbackAnswer": func(p *textParser) TL {
+			var e TL_messages_botCallbackAnswer
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "alert":
+					e.Alert = p.Bool()
+					if e.Alert {
+						e.Flags |= 2
+					}
+				case "has_url":
+					e.HasUrl = p.Bool()
+					if e.HasUrl {
+						e.Flags |= 8
+					}
+				case "native_ui":
+					e.NativeUi = p.Bool()
+					if e.NativeUi {
+						e.Flags |= 16
+					}
+				case "message":
+					e.Message = p.String()
+					e.Flags |= 1
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 4
+				case "cache_time":
+					e.CacheTime = p.Int()
+				default:
+					p.unknownField("messages.botCallbackAnswer")
+				}
+			}
+			return e
+		},
+		"messages.messageEditData": func(p *textParser) TL {
+			var e TL_messages_messageEditData
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "caption":
+					e.Caption = p.Bool()
+					if e.Caption {
+						e.Flags |= 1
+					}
+				default:
+					p.unknownField("messages.messageEditData")
+				}
+			}
+			return e
+		},
+		"inputBotInlineMessageID": func(p *textParser) TL {
+			var e TL_inputBotInlineMessageID
+			for p.field() {
+				switch p.fieldName {
+				case "dc_id":
+					e.DcID = p.Int()
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputBotInlineMessageID")
+				}
+			}
+			return e
+		},
+		"inlineBotSwitchPM": func(p *textParser) TL {
+			var e TL_inlineBotSwitchPM
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				case "start_param":
+					e.StartParam = p.String()
+				default:
+					p.unknownField("inlineBotSwitchPM")
+				}
+			}
+			return e
+		},
+		"messages.peerDialogs": func(p *textParser) TL {
+			var e TL_messages_peerDialogs
+			for p.field() {
+				switch p.fieldName {
+				case "dialogs":
+					e.Dialogs = p.Vector()
+				case "messages":
+					e.Messages = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "state":
+					e.State = p.Object()
+				default:
+					p.unknownField("messages.peerDialogs")
+				}
+			}
+			return e
+		},
+		"topPeer": func(p *textParser) TL {
+			var e TL_topPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "rating":
+					e.Rating = p.Double()
+				default:
+					p.unknownField("topPeer")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryBotsPM": func(p *textParser) TL {
+			var e TL_topPeerCategoryBotsPM
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("topPeerCategoryBotsPM")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryBotsInline": func(p *textParser) TL {
+			var e TL_topPeerCategoryBotsInline
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("topPeerCategoryBotsInline")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryCorrespondents": func(p *textParser) TL {
+			var e TL_topPeerCategoryCorrespondents
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("topPeerCategoryCorrespondents")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryGroups": func(p *textParser) TL {
+			var e TL_topPeerCategoryGroups
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("topPeerCategoryGroups")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryChannels": func(p *textParser) TL {
+			var e TL_topPeerCategoryChannels
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("topPeerCategoryChannels")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryPhoneCalls": func(p *textParser) TL {
+			var e TL_topPeerCategoryPhoneCalls
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("topPeerCategoryPhoneCalls")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryForwardUsers": func(p *textParser) TL {
+			var e TL_topPeerCategoryForwardUsers
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("topPeerCategoryForwardUsers")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryForwardChats": func(p *textParser) TL {
+			var e TL_topPeerCategoryForwardChats
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("topPeerCategoryForwardChats")
+				}
+			}
+			return e
+		},
+		"topPeerCategoryPeers": func(p *textParser) TL {
+			var e TL_topPeerCategoryPeers
+			for p.field() {
+				switch p.fieldName {
+				case "category":
+					e.Category = p.Object()
+				case "count":
+					e.Count = p.Int()
+				case "peers":
+					e.Peers = p.Vector()
+				default:
+					p.unknownField("topPeerCategoryPeers")
+				}
+			}
+			return e
+		},
+		"contacts.topPeersNotModified": func(p *textParser) TL {
+			var e TL_contacts_topPeersNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("contacts.topPeersNotModified")
+				}
+			}
+			return e
+		},
+		"contacts.topPeers": func(p *textParser) TL {
+			var e TL_contacts_topPeers
+			for p.field() {
+				switch p.fieldName {
+				case "categories":
+					e.Categories = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("contacts.topPeers")
+				}
+			}
+			return e
+		},
+		"contacts.topPeersDisabled": func(p *textParser) TL {
+			var e TL_contacts_topPeersDisabled
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("contacts.topPeersDisabled")
+				}
+			}
+			return e
+		},
+		"draftMessageEmpty": func(p *textParser) TL {
+			var e TL_draftMessageEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "date":
+					e.Date = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("draftMessageEmpty")
+				}
+			}
+			return e
+		},
+		"draftMessage": func(p *textParser) TL {
+			var e TL_draftMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "no_webpage":
+					e.NoWebpage = p.Bool()
+					if e.NoWebpage {
+						e.Flags |= 2
+					}
+				case "reply_to_msg_id":
+					e.ReplyToMsgID = p.Int()
+					e.Flags |= 1
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 8
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("draftMessage")
+				}
+			}
+			return e
+		},
+		"messages.featuredStickersNotModified": func(p *textParser) TL {
+			var e TL_messages_featuredStickersNotModified
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				default:
+					p.unknownField("messages.featuredStickersNotModified")
+				}
+			}
+			return e
+		},
+		"messages.featuredStickers": func(p *textParser) TL {
+			var e TL_messages_featuredStickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "count":
+					e.Count = p.Int()
+				case "sets":
+					e.Sets = p.Vector()
+				case "unread":
+					e.Unread = p.VectorLong()
+				default:
+					p.unknownField("messages.featuredStickers")
+				}
+			}
+			return e
+		},
+		"messages.recentStickersNotModified": func(p *textParser) TL {
+			var e TL_messages_recentStickersNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.recentStickersNotModified")
+				}
+			}
+			return e
+		},
+		"messages.recentStickers": func(p *textParser) TL {
+			var e TL_messages_recentStickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "packs":
+					e.Packs = p.Vector()
+				case "stickers":
+					e.Stickers = p.Vector()
+				case "dates":
+					e.Dates = p.VectorInt()
+				default:
+					p.unknownField("messages.recentStickers")
+				}
+			}
+			return e
+		},
+		"messages.archivedStickers": func(p *textParser) TL {
+			var e TL_messages_archivedStickers
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "sets":
+					e.Sets = p.Vector()
+				default:
+					p.unknownField("messages.archivedStickers")
+				}
+			}
+			return e
+		},
+		"messages.stickerSetInstallResultSuccess": func(p *textParser) TL {
+			var e TL_messages_stickerSetInstallResultSuccess
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.stickerSetInstallResultSuccess")
+				}
+			}
+			return e
+		},
+		"messages.stickerSetInstallResultArchive": func(p *textParser) TL {
+			var e TL_messages_stickerSetInstallResultArchive
+			for p.field() {
+				switch p.fieldName {
+				case "sets":
+					e.Sets = p.Vector()
+				default:
+					p.unknownField("messages.stickerSetInstallResultArchive")
+				}
+			}
+			return e
+		},
+		"stickerSetCovered": func(p *textParser) TL {
+			var e TL_stickerSetCovered
+			for p.field() {
+				switch p.fieldName {
+				case "set":
+					e.Set = p.Object()
+				case "cover":
+					e.Cover = p.Object()
+				default:
+					p.unknownField("stickerSetCovered")
+				}
+			}
+			return e
+		},
+		"stickerSetMultiCovered": func(p *textParser) TL {
+			var e TL_stickerSetMultiCovered
+			for p.field() {
+				switch p.fieldName {
+				case "set":
+					e.Set = p.Object()
+				case "covers":
+					e.Covers = p.Vector()
+				default:
+					p.unknownField("stickerSetMultiCovered")
+				}
+			}
+			return e
+		},
+		"maskCoords": func(p *textParser) TL {
+			var e TL_maskCoords
+			for p.field() {
+				switch p.fieldName {
+				case "n":
+					e.N = p.Int()
+				case "x":
+					e.X = p.Double()
+				case "y":
+					e.Y = p.Double()
+				case "zoom":
+					e.Zoom = p.Double()
+				default:
+					p.unknownField("maskCoords")
+				}
+			}
+			return e
+		},
+		"inputStickeredMediaPhoto": func(p *textParser) TL {
+			var e TL_inputStickeredMediaPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("inputStickeredMediaPhoto")
+				}
+			}
+			return e
+		},
+		"inputStickeredMediaDocument": func(p *textParser) TL {
+			var e TL_inputStickeredMediaDocument
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("inputStickeredMediaDocument")
+				}
+			}
+			return e
+		},
+		"game": func(p *textParser) TL {
+			var e TL_game
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "short_name":
+					e.ShortName = p.String()
+				case "title":
+					e.Title = p.String()
+				case "description":
+					e.Description = p.String()
+				case "photo":
+					e.Photo = p.Object()
+				case "document":
+					e.Document = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("game")
+				}
+			}
+			return e
+		},
+		"inputGameID": func(p *textParser) TL {
+			var e TL_inputGameID
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputGameID")
+				}
+			}
+			return e
+		},
+		"inputGameShortName": func(p *textParser) TL {
+			var e TL_inputGameShortName
+			for p.field() {
+				switch p.fieldName {
+				case "bot_id":
+					e.BotID = p.Object()
+				case "short_name":
+					e.ShortName = p.String()
+				default:
+					p.unknownField("inputGameShortName")
+				}
+			}
+			return e
+		},
+		"highScore": func(p *textParser) TL {
+			var e TL_highScore
+			for p.field() {
+				switch p.fieldName {
+				case "pos":
+					e.Pos = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "score":
+					e.Score = p.Int()
+				default:
+					p.unknownField("highScore")
+				}
+			}
+			return e
+		},
+		"messages.highScores": func(p *textParser) TL {
+			var e TL_messages_highScores
+			for p.field() {
+				switch p.fieldName {
+				case "scores":
+					e.Scores = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.highScores")
+				}
+			}
+			return e
+		},
+		"textEmpty": func(p *textParser) TL {
+			var e TL_textEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("textEmpty")
+				}
+			}
+			return e
+		},
+		"textPlain": func(p *textParser) TL {
+			var e TL_textPlain
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("textPlain")
+				}
+			}
+			return e
+		},
+		"textBold": func(p *textParser) TL {
+			var e TL_textBold
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("textBold")
+				}
+			}
+			return e
+		},
+		"textItalic": func(p *textParser) TL {
+			var e TL_textItalic
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("textItalic")
+				}
+			}
+			return e
+		},
+		"textUnderline": func(p *textParser) TL {
+			var e TL_textUnderline
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("textUnderline")
+				}
+			}
+			return e
+		},
+		"textStrike": func(p *textParser) TL {
+			var e TL_textStrike
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("textStrike")
+				}
+			}
+			return e
+		},
+		"textFixed": func(p *textParser) TL {
+			var e TL_textFixed
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("textFixed")
+				}
+			}
+			return e
+		},
+		"textUrl": func(p *textParser) TL {
+			var e TL_textUrl
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				case "url":
+					e.Url = p.String()
+				case "webpage_id":
+					e.WebpageID = p.Long()
+				default:
+					p.unknownField("textUrl")
+				}
+			}
+			return e
+		},
+		"textEmail": func(p *textParser) TL {
+			var e TL_textEmail
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				case "email":
+					e.Email = p.String()
+				default:
+					p.unknownField("textEmail")
+				}
+			}
+			return e
+		},
+		"textConcat": func(p *textParser) TL {
+			var e TL_textConcat
+			for p.field() {
+				switch p.fieldName {
+				case "texts":
+					e.Texts = p.Vector()
+				default:
+					p.unknownField("textConcat")
+				}
+			}
+			return e
+		},
+		"textSubscript": func(p *textParser) TL {
+			var e TL_textSubscript
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("textSubscript")
+				}
+			}
+			return e
+		},
+		"textSuperscript": func(p *textParser) TL {
+			var e TL_textSuperscript
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("textSuperscript")
+				}
+			}
+			return e
+		},
+		"textMarked": func(p *textParser) TL {
+			var e TL_textMarked
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("textMarked")
+				}
+			}
+			return e
+		},
+		"textPhone": func(p *textParser) TL {
+			var e TL_textPhone
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				case "phone":
+					e.Phone = p.String()
+				default:
+					p.unknownField("textPhone")
+				}
+			}
+			return e
+		},
+		"textImage": func(p *textParser) TL {
+			var e TL_textImage
+			for p.field() {
+				switch p.fieldName {
+				case "document_id":
+					e.DocumentID = p.Long()
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				default:
+					p.unknownField("textImage")
+				}
+			}
+			return e
+		},
+		"textAnchor": func(p *textParser) TL {
+			var e TL_textAnchor
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				case "name":
+					e.Name = p.String()
+				default:
+					p.unknownField("textAnchor")
+				}
+			}
+			return e
+		},
+		"pageBlockUnsupported": func(p *textParser) TL {
+			var e TL_pageBlockUnsupported
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("pageBlockUnsupported")
+				}
+			}
+			return e
+		},
+		"pageBlockTitle": func(p *textParser) TL {
+			var e TL_pageBlockTitle
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageBlockTitle")
+				}
+			}
+			return e
+		},
+		"pageBlockSubtitle": func(p *textParser) TL {
+			var e TL_pageBlockSubtitle
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageBlockSubtitle")
+				}
+			}
+			return e
+		},
+		"pageBlockAuthorDate": func(p *textParser) TL {
+			var e TL_pageBlockAuthorDate
+			for p.field() {
+				switch p.fieldName {
+				case "author":
+					e.Author = p.Object()
+				case "published_date":
+					e.PublishedDate = p.Int()
+				default:
+					p.unknownField("pageBlockAuthorDate")
+				}
+			}
+			return e
+		},
+		"pageBlockHeader": func(p *textParser) TL {
+			var e TL_pageBlockHeader
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageBlockHeader")
+				}
+			}
+			return e
+		},
+		"pageBlockSubheader": func(p *textParser) TL {
+			var e TL_pageBlockSubheader
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageBlockSubheader")
+				}
+			}
+			return e
+		},
+		"pageBlockParagraph": func(p *textParser) TL {
+			var e TL_pageBlockParagraph
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageBlockParagraph")
+				}
+			}
+			return e
+		},
+		"pageBlockPreformatted": func(p *textParser) TL {
+			var e TL_pageBlockPreformatted
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				case "language":
+					e.Language = p.String()
+				default:
+					p.unknownField("pageBlockPreformatted")
+				}
+			}
+			return e
+		},
+		"pageBlockFooter": func(p *textParser) TL {
+			var e TL_pageBlockFooter
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageBlockFooter")
+				}
+			}
+			return e
+		},
+		"pageBlockDivider": func(p *textParser) TL {
+			var e TL_pageBlockDivider
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("pageBlockDivider")
+				}
+			}
+			return e
+		},
+		"pageBlockAnchor": func(p *textParser) TL {
+			var e TL_pageBlockAnchor
+			for p.field() {
+				switch p.fieldName {
+				case "name":
+					e.Name = p.String()
+				default:
+					p.unknownField("pageBlockAnchor")
+				}
+			}
+			return e
+		},
+		"pageBlockList": func(p *textParser) TL {
+			var e TL_pageBlockList
+			for p.field() {
+				switch p.fieldName {
+				case "items":
+					e.Items = p.Vector()
+				default:
+					p.unknownField("pageBlockList")
+				}
+			}
+			return e
+		},
+		"pageBlockBlockquote": func(p *textParser) TL {
+			var e TL_pageBlockBlockquote
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockBlockquote")
+				}
+			}
+			return e
+		},
+		"pageBlockPullquote": func(p *textParser) TL {
+			var e TL_pageBlockPullquote
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockPullquote")
+				}
+			}
+			return e
+		},
+		"pageBlockPhoto": func(p *textParser) TL {
+			var e TL_pageBlockPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "photo_id":
+					e.PhotoID = p.Long()
+				case "caption":
+					e.Caption = p.Object()
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 1
+				case "webpage_id":
+					e.WebpageID = p.Long()
+					e.Flags |= 1
+				default:
+					p.unknownField("pageBlockPhoto")
+				}
+			}
+			return e
+		},
+		"pageBlockVideo": func(p *textParser) TL {
+			var e TL_pageBlockVideo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "autoplay":
+					e.Autoplay = p.Bool()
+					if e.Autoplay {
+						e.Flags |= 1
+					}
+				case "loop":
+					e.Loop = p.Bool()
+					if e.Loop {
+						e.Flags |= 2
+					}
+				case "video_id":
+					e.VideoID = p.Long()
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockVideo")
+				}
+			}
+			return e
+		},
+		"pageBlockCover": func(p *textParser) TL {
+			var e TL_pageBlockCover
+			for p.field() {
+				switch p.fieldName {
+				case "cover":
+					e.Cover = p.Object()
+				default:
+					p.unknownField("pageBlockCover")
+				}
+			}
+			return e
+		},
+		"pageBlockEmbed": func(p *textParser) TL {
+			var e TL_pageBlockEmbed
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "full_width":
+					e.FullWidth = p.Bool()
+					if e.FullWidth {
+						e.Flags |= 1
+					}
+				case "allow_scrolling":
+					e.AllowScrolling = p.Bool()
+					if e.AllowScrolling {
+						e.Flags |= 8
+					}
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 2
+				case "html":
+					e.Html = p.String()
+					e.Flags |= 4
+				case "poster_photo_id":
+					e.PosterPhotoID = p.Long()
+					e.Flags |= 16
+				case "w":
+					e.W = p.Int()
+					e.Flags |= 32
+				case "h":
+					e.H = p.Int()
+					e.Flags |= 32
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockEmbed")
+				}
+			}
+			return e
+		},
+		"pageBlockEmbedPost": func(p *textParser) TL {
+			var e TL_pageBlockEmbedPost
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "webpage_id":
+					e.WebpageID = p.Long()
+				case "author_photo_id":
+					e.AuthorPhotoID = p.Long()
+				case "author":
+					e.Author = p.String()
+				case "date":
+					e.Date = p.Int()
+				case "blocks":
+					e.Blocks = p.Vector()
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockEmbedPost")
+				}
+			}
+			return e
+		},
+		"pageBlockCollage": func(p *textParser) TL {
+			var e TL_pageBlockCollage
+			for p.field() {
+				switch p.fieldName {
+				case "items":
+					e.Items = p.Vector()
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockCollage")
+				}
+			}
+			return e
+		},
+		"pageBlockSlideshow": func(p *textParser) TL {
+			var e TL_pageBlockSlideshow
+			for p.field() {
+				switch p.fieldName {
+				case "items":
+					e.Items = p.Vector()
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockSlideshow")
+				}
+			}
+			return e
+		},
+		"pageBlockChannel": func(p *textParser) TL {
+			var e TL_pageBlockChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				default:
+					p.unknownField("pageBlockChannel")
+				}
+			}
+			return e
+		},
+		"pageBlockAudio": func(p *textParser) TL {
+			var e TL_pageBlockAudio
+			for p.field() {
+				switch p.fieldName {
+				case "audio_id":
+					e.AudioID = p.Long()
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockAudio")
+				}
+			}
+			return e
+		},
+		"pageBlockKicker": func(p *textParser) TL {
+			var e TL_pageBlockKicker
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageBlockKicker")
+				}
+			}
+			return e
+		},
+		"pageBlockTable": func(p *textParser) TL {
+			var e TL_pageBlockTable
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "bordered":
+					e.Bordered = p.Bool()
+					if e.Bordered {
+						e.Flags |= 1
+					}
+				case "striped":
+					e.Striped = p.Bool()
+					if e.Striped {
+						e.Flags |= 2
+					}
+				case "title":
+					e.Title = p.Object()
+				case "rows":
+					e.Rows = p.Vector()
+				default:
+					p.unknownField("pageBlockTable")
+				}
+			}
+			return e
+		},
+		"pageBlockOrderedList": func(p *textParser) TL {
+			var e TL_pageBlockOrderedList
+			for p.field() {
+				switch p.fieldName {
+				case "items":
+					e.Items = p.Vector()
+				default:
+					p.unknownField("pageBlockOrderedList")
+				}
+			}
+			return e
+		},
+		"pageBlockDetails": func(p *textParser) TL {
+			var e TL_pageBlockDetails
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "open":
+					e.Open = p.Bool()
+					if e.Open {
+						e.Flags |= 1
+					}
+				case "blocks":
+					e.Blocks = p.Vector()
+				case "title":
+					e.Title = p.Object()
+				default:
+					p.unknownField("pageBlockDetails")
+				}
+			}
+			return e
+		},
+		"pageBlockRelatedArticles": func(p *textParser) TL {
+			var e TL_pageBlockRelatedArticles
+			for p.field() {
+				switch p.fieldName {
+				case "title":
+					e.Title = p.Object()
+				case "articles":
+					e.Articles = p.Vector()
+				default:
+					p.unknownField("pageBlockRelatedArticles")
+				}
+			}
+			return e
+		},
+		"pageBlockMap": func(p *textParser) TL {
+			var e TL_pageBlockMap
+			for p.field() {
+				switch p.fieldName {
+				case "geo":
+					e.Geo = p.Object()
+				case "zoom":
+					e.Zoom = p.Int()
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				case "caption":
+					e.Caption = p.Object()
+				default:
+					p.unknownField("pageBlockMap")
+				}
+			}
+			return e
+		},
+		"phoneCallDiscardReasonMissed": func(p *textParser) TL {
+			var e TL_phoneCallDiscardReasonMissed
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("phoneCallDiscardReasonMissed")
+				}
+			}
+			return e
+		},
+		"phoneCallDiscardReasonDisconnect": func(p *textParser) TL {
+			var e TL_phoneCallDiscardReasonDisconnect
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("phoneCallDiscardReasonDisconnect")
+				}
+			}
+			return e
+		},
+		"phoneCallDiscardReasonHangup": func(p *textParser) TL {
+			var e TL_phoneCallDiscardReasonHangup
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("phoneCallDiscardReasonHangup")
+				}
+			}
+			return e
+		},
+		"phoneCallDiscardReasonBusy": func(p *textParser) TL {
+			var e TL_phoneCallDiscardReasonBusy
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("phoneCallDiscardReasonBusy")
+				}
+			}
+			return e
+		},
+		"dataJSON": func(p *textParser) TL {
+			var e TL_dataJSON
+			for p.field() {
+				switch p.fieldName {
+				case "data":
+					e.Data = p.String()
+				default:
+					p.unknownField("dataJSON")
+				}
+			}
+			return e
+		},
+		"labeledPrice": func(p *textParser) TL {
+			var e TL_labeledPrice
+			for p.field() {
+				switch p.fieldName {
+				case "label":
+					e.Label = p.String()
+				case "amount":
+					e.Amount = p.Long()
+				default:
+					p.unknownField("labeledPrice")
+				}
+			}
+			return e
+		},
+		"invoice": func(p *textParser) TL {
+			var e TL_invoice
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "test":
+					e.Test = p.Bool()
+					if e.Test {
+						e.Flags |= 1
+					}
+				case "name_requested":
+					e.NameRequested = p.Bool()
+					if e.NameRequested {
+						e.Flags |= 2
+					}
+				case "phone_requested":
+					e.PhoneRequested = p.Bool()
+					if e.PhoneRequested {
+						e.Flags |= 4
+					}
+				case "email_requested":
+					e.EmailRequested = p.Bool()
+					if e.EmailRequested {
+						e.Flags |= 8
+					}
+				case "shipping_address_requested":
+					e.ShippingAddressRequested = p.Bool()
+					if e.ShippingAddressRequested {
+						e.Flags |= 16
+					}
+				case "flexible":
+					e.Flexible = p.Bool()
+					if e.Flexible {
+						e.Flags |= 32
+					}
+				case "phone_to_provider":
+					e.PhoneToProvider = p.Bool()
+					if e.PhoneToProvider {
+						e.Flags |= 64
+					}
+				case "email_to_provider":
+					e.EmailToProvider = p.Bool()
+					if e.EmailToProvider {
+						e.Flags |= 128
+					}
+				case "currency":
+					e.Currency = p.String()
+				case "prices":
+					e.Prices = p.Vector()
+				default:
+					p.unknownField("invoice")
+				}
+			}
+			return e
+		},
+		"paymentCharge": func(p *textParser) TL {
+			var e TL_paymentCharge
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.String()
+				case "provider_charge_id":
+					e.ProviderChargeID = p.String()
+				default:
+					p.unknownField("paymentCharge")
+				}
+			}
+			return e
+		},
+		"postAddress": func(p *textParser) TL {
+			var e TL_postAddress
+			for p.field() {
+				switch p.fieldName {
+				case "street_line1":
+					e.StreetLine1 = p.String()
+				case "street_line2":
+					e.StreetLine2 = p.String()
+				case "city":
+					e.City = p.String()
+				case "state":
+					e.State = p.String()
+				case "country_iso2":
+					e.CountryIso2 = p.String()
+				case "post_code":
+					e.PostCode = p.String()
+				default:
+					p.unknownField("postAddress")
+				}
+			}
+			return e
+		},
+		"paymentRequestedInfo": func(p *textParser) TL {
+			var e TL_paymentRequestedInfo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "name":
+					e.Name = p.String()
+					e.Flags |= 1
+				case "phone":
+					e.Phone = p.String()
+					e.Flags |= 2
+				case "email":
+					e.Email = p.String()
+					e.Flags |= 4
+				case "shipping_address":
+					e.ShippingAddress = p.Object()
+					e.Flags |= 8
+				default:
+					p.unknownField("paymentRequestedInfo")
+				}
+			}
+			return e
+		},
+		"paymentSavedCredentialsCard": func(p *textParser) TL {
+			var e TL_paymentSavedCredentialsCard
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.String()
+				case "title":
+					e.Title = p.String()
+				default:
+					p.unknownField("paymentSavedCredentialsCard")
+				}
+			}
+			return e
+		},
+		"webDocument": func(p *textParser) TL {
+			var e TL_webDocument
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "size":
+					e.Size = p.Int()
+				case "mime_type":
+					e.MimeType = p.String()
+				case "attributes":
+					e.Attributes = p.Vector()
+				default:
+					p.unknownField("webDocument")
+				}
+			}
+			return e
+		},
+		"webDocumentNoProxy": func(p *textParser) TL {
+			var e TL_webDocumentNoProxy
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "size":
+					e.Size = p.Int()
+				case "mime_type":
+					e.MimeType = p.String()
+				case "attributes":
+					e.Attributes = p.Vector()
+				default:
+					p.unknownField("webDocumentNoProxy")
+				}
+			}
+			return e
+		},
+		"inputWebDocument": func(p *textParser) TL {
+			var e TL_inputWebDocument
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "size":
+					e.Size = p.Int()
+				case "mime_type":
+					e.MimeType = p.String()
+				case "attributes":
+					e.Attributes = p.Vector()
+				default:
+					p.unknownField("inputWebDocument")
+				}
+			}
+			return e
+		},
+		"inputWebFileLocation": func(p *textParser) TL {
+			var e TL_inputWebFileLocation
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputWebFileLocation")
+				}
+			}
+			return e
+		},
+		"inputWebFileGeoPointLocation": func(p *textParser) TL {
+			var e TL_inputWebFileGeoPointLocation
+			for p.field() {
+				switch p.fieldName {
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				case "zoom":
+					e.Zoom = p.Int()
+				case "scale":
+					e.Scale = p.Int()
+				default:
+					p.unknownField("inputWebFileGeoPointLocation")
+				}
+			}
+			return e
+		},
+		"upload.webFile": func(p *textParser) TL {
+			var e TL_upload_webFile
+			for p.field() {
+				switch p.fieldName {
+				case "size":
+					e.Size = p.Int()
+				case "mime_type":
+					e.MimeType = p.String()
+				case "file_type":
+					e.FileType = p.Object()
+				case "mtime":
+					e.Mtime = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("upload.webFile")
+				}
+			}
+			return e
+		},
+		"payments.paymentForm": func(p *textParser) TL {
+			var e TL_payments_paymentForm
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "can_save_credentials":
+					e.CanSaveCredentials = p.Bool()
+					if e.CanSaveCredentials {
+						e.Flags |= 4
+					}
+				case "password_missing":
+					e.PasswordMissing = p.Bool()
+					if e.PasswordMissing {
+						e.Flags |= 8
+					}
+				case "bot_id":
+					e.BotID = p.Int()
+				case "invoice":
+					e.Invoice = p.Object()
+				case "provider_id":
+					e.ProviderID = p.Int()
+				case "url":
+					e.Url = p.String()
+				case "native_provider":
+					e.NativeProvider = p.String()
+					e.Flags |= 16
+				case "native_params":
+					e.NativeParams = p.Object()
+					e.Flags |= 16
+				case "saved_info":
+					e.SavedInfo = p.Object()
+					e.Flags |= 1
+				case "saved_credentials":
+					e.SavedCredentials = p.Object()
+					e.Flags |= 2
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("payments.paymentForm")
+				}
+			}
+			return e
+		},
+		"payments.validatedRequestedInfo": func(p *textParser) TL {
+			var e TL_payments_validatedRequestedInfo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.String()
+					e.Flags |= 1
+				case "shipping_options":
+					e.ShippingOptions = p.Vector()
+					e.Flags |= 2
+				default:
+					p.unknownField("payments.validatedRequestedInfo")
+				}
+			}
+			return e
+		},
+		"payments.paymentResult": func(p *textParser) TL {
+			var e TL_payments_paymentResult
+			for p.field() {
+				switch p.fieldName {
+				case "updates":
+					e.Updates = p.Object()
+				default:
+					p.unknownField("payments.paymentResult")
+				}
+			}
+			return e
+		},
+		"payments.paymentVerificationNeeded": func(p *textParser) TL {
+			var e TL_payments_paymentVerificationNeeded
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				default:
+					p.unknownField("payments.paymentVerificationNeeded")
+				}
+			}
+			return e
+		},
+		"payments.paymentReceipt": func(p *textParser) TL {
+			var e TL_payments_paymentReceipt
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "bot_id":
+					e.BotID = p.Int()
+				case "invoice":
+					e.Invoice = p.Object()
+				case "provider_id":
+					e.ProviderID = p.Int()
+				case "info":
+					e.Info = p.Object()
+					e.Flags |= 1
+				case "shipping":
+					e.Shipping = p.Object()
+					e.Flags |= 2
+				case "currency":
+					e.Currency = p.String()
+				case "total_amount":
+					e.TotalAmount = p.Long()
+				case "credentials_title":
+					e.CredentialsTitle = p.String()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("payments.paymentReceipt")
+				}
+			}
+			return e
+		},
+		"payments.savedInfo": func(p *textParser) TL {
+			var e TL_payments_savedInfo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "has_saved_credentials":
+					e.HasSavedCredentials = p.Bool()
+					if e.HasSavedCredentials {
+						e.Flags |= 2
+					}
+				case "saved_info":
+					e.SavedInfo = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("payments.savedInfo")
+				}
+			}
+			return e
+		},
+		"inputPaymentCredentialsSaved": func(p *textParser) TL {
+			var e TL_inputPaymentCredentialsSaved
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.String()
+				case "tmp_password":
+					e.TmpPassword = p.Bytes()
+				default:
+					p.unknownField("inputPaymentCredentialsSaved")
+				}
+			}
+			return e
+		},
+		"inputPaymentCredentials": func(p *textParser) TL {
+			var e TL_inputPaymentCredentials
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "save":
+					e.Save = p.Bool()
+					if e.Save {
+						e.Flags |= 1
+					}
+				case "data":
+					e.Data = p.Object()
+				default:
+					p.unknownField("inputPaymentCredentials")
+				}
+			}
+			return e
+		},
+		"inputPaymentCredentialsApplePay": func(p *textParser) TL {
+			var e TL_inputPaymentCredentialsApplePay
+			for p.field() {
+				switch p.fieldName {
+				case "payment_data":
+					e.PaymentData = p.Object()
+				default:
+					p.unknownField("inputPaymentCredentialsApplePay")
+				}
+			}
+			return e
+		},
+		"inputPaymentCredentialsGooglePay": func(p *textParser) TL {
+			var e TL_inputPaymentCredentialsGooglePay
+			for p.field() {
+				switch p.fieldName {
+				case "payment_token":
+					e.PaymentToken = p.Object()
+				default:
+					p.unknownField("inputPaymentCredentialsGooglePay")
+				}
+			}
+			return e
+		},
+		"account.tmpPassword": func(p *textParser) TL {
+			var e TL_account_tmpPassword
+			for p.field() {
+				switch p.fieldName {
+				case "tmp_password":
+					e.TmpPassword = p.Bytes()
+				case "valid_until":
+					e.ValidUntil = p.Int()
+				default:
+					p.unknownField("account.tmpPassword")
+				}
+			}
+			return e
+		},
+		"shippingOption": func(p *textParser) TL {
+			var e TL_shippingOption
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.String()
+				case "title":
+					e.Title = p.String()
+				case "prices":
+					e.Prices = p.Vector()
+				default:
+					p.unknownField("shippingOption")
+				}
+			}
+			return e
+		},
+		"inputStickerSetItem": func(p *textParser) TL {
+			var e TL_inputStickerSetItem
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "document":
+					e.Document = p.Object()
+				case "emoji":
+					e.Emoji = p.String()
+				case "mask_coords":
+					e.MaskCoords = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("inputStickerSetItem")
+				}
+			}
+			return e
+		},
+		"inputPhoneCall": func(p *textParser) TL {
+			var e TL_inputPhoneCall
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputPhoneCall")
+				}
+			}
+			return e
+		},
+		"phoneCallEmpty": func(p *textParser) TL {
+			var e TL_phoneCallEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				default:
+					p.unknownField("phoneCallEmpty")
+				}
+			}
+			return e
+		},
+		"phoneCallWaiting": func(p *textParser) TL {
+			var e TL_phoneCallWaiting
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "video":
+					e.Video = p.Bool()
+					if e.Video {
+						e.Flags |= 64
+					}
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "date":
+					e.Date = p.Int()
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "participant_id":
+					e.ParticipantID = p.Int()
+				case "protocol":
+					e.Protocol = p.Object()
+				case "receive_date":
+					e.ReceiveDate = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("phoneCallWaiting")
+				}
+			}
+			return e
+		},
+		"phoneCallRequested": func(p *textParser) TL {
+			var e TL_phoneCallRequested
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "video":
+					e.Video = p.Bool()
+					if e.Video {
+						e.Flags |= 64
+					}
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "date":
+					e.Date = p.Int()
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "participant_id":
+					e.ParticipantID = p.Int()
+				case "g_a_hash":
+					e.GAHash = p.Bytes()
+				case "protocol":
+					e.Protocol = p.Object()
+				default:
+					p.unknownField("phoneCallRequested")
+				}
+			}
+			return e
+		},
+		"phoneCallAccepted": func(p *textParser) TL {
+			var e TL_phoneCallAccepted
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "video":
+					e.Video = p.Bool()
+					if e.Video {
+						e.Flags |= 64
+					}
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "date":
+					e.Date = p.Int()
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "participant_id":
+					e.ParticipantID = p.Int()
+				case "g_b":
+					e.GB = p.Bytes()
+				case "protocol":
+					e.Protocol = p.Object()
+				default:
+					p.unknownField("phoneCallAccepted")
+				}
+			}
+			return e
+		},
+		"phoneCall": func(p *textParser) TL {
+			var e TL_phoneCall
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "p2p_allowed":
+					e.P2pAllowed = p.Bool()
+					if e.P2pAllowed {
+						e.Flags |= 32
+					}
+				case "video":
+					e.Video = p.Bool()
+					if e.Video {
+						e.Flags |= 64
+					}
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "date":
+					e.Date = p.Int()
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "participant_id":
+					e.ParticipantID = p.Int()
+				case "g_a_or_b":
+					e.GAOrB = p.Bytes()
+				case "key_fingerprint":
+					e.KeyFingerprint = p.Long()
+				case "protocol":
+					e.Protocol = p.Object()
+				case "connections":
+					e.Connections = p.Vector()
+				case "start_date":
+					e.StartDate = p.Int()
+				default:
+					p.unknownField("phoneCall")
+				}
+			}
+			return e
+		},
+		"phoneCallDiscarded": func(p *textParser) TL {
+			var e TL_phoneCallDiscarded
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "need_rating":
+					e.NeedRating = p.Bool()
+					if e.NeedRating {
+						e.Flags |= 4
+					}
+				case "need_debug":
+					e.NeedDebug = p.Bool()
+					if e.NeedDebug {
+						e.Flags |= 8
+					}
+				case "video":
+					e.Video = p.Bool()
+					if e.Video {
+						e.Flags |= 64
+					}
+				case "id":
+					e.ID = p.Long()
+				case "reason":
+					e.Reason = p.Object()
+					e.Flags |= 1
+				case "duration":
+					e.Duration = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("phoneCallDiscarded")
+				}
+			}
+			return e
+		},
+		"phoneConnection": func(p *textParser) TL {
+			var e TL_phoneConnection
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "ip":
+					e.Ip = p.String()
+				case "ipv6":
+					e.Ipv6 = p.String()
+				case "port":
+					e.Port = p.Int()
+				case "peer_tag":
+					e.PeerTag = p.Bytes()
+				default:
+					p.unknownField("phoneConnection")
+				}
+			}
+			return e
+		},
+		"phoneConnectionWebrtc": func(p *textParser) TL {
+			var e TL_phoneConnectionWebrtc
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "turn":
+					e.Turn = p.Bool()
+					if e.Turn {
+						e.Flags |= 1
+					}
+				case "stun":
+					e.Stun = p.Bool()
+					if e.Stun {
+						e.Flags |= 2
+					}
+				case "id":
+					e.ID = p.Long()
+				case "ip":
+					e.Ip = p.String()
+				case "ipv6":
+					e.Ipv6 = p.String()
+				case "port":
+					e.Port = p.Int()
+				case "username":
+					e.Username = p.String()
+				case "password":
+					e.Password = p.String()
+				default:
+					p.unknownField("phoneConnectionWebrtc")
+				}
+			}
+			return e
+		},
+		"phoneCallProtocol": func(p *textParser) TL {
+			var e TL_phoneCallProtocol
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "udp_p2p":
+					e.UdpP2p = p.Bool()
+					if e.UdpP2p {
+						e.Flags |= 1
+					}
+				case "udp_reflector":
+					e.UdpReflector = p.Bool()
+					if e.UdpReflector {
+						e.Flags |= 2
+					}
+				case "min_layer":
+					e.MinLayer = p.Int()
+				case "max_layer":
+					e.MaxLayer = p.Int()
+				case "library_versions":
+					e.LibraryVersions = p.VectorString()
+				default:
+					p.unknownField("phoneCallProtocol")
+				}
+			}
+			return e
+		},
+		"phone.phoneCall": func(p *textParser) TL {
+			var e TL_phone_phoneCall
+			for p.field() {
+				switch p.fieldName {
+				case "phone_call":
+					e.PhoneCall = p.Object()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("phone.phoneCall")
+				}
+			}
+			return e
+		},
+		"upload.cdnFileReuploadNeeded": func(p *textParser) TL {
+			var e TL_upload_cdnFileReuploadNeeded
+			for p.field() {
+				switch p.fieldName {
+				case "request_token":
+					e.RequestToken = p.Bytes()
+				default:
+					p.unknownField("upload.cdnFileReuploadNeeded")
+				}
+			}
+			return e
+		},
+		"upload.cdnFile": func(p *textParser) TL {
+			var e TL_upload_cdnFile
+			for p.field() {
+				switch p.fieldName {
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("upload.cdnFile")
+				}
+			}
+			return e
+		},
+		"cdnPublicKey": func(p *textParser) TL {
+			var e TL_cdnPublicKey
+			for p.field() {
+				switch p.fieldName {
+				case "dc_id":
+					e.DcID = p.Int()
+				case "public_key":
+					e.PublicKey = p.String()
+				default:
+					p.unknownField("cdnPublicKey")
+				}
+			}
+			return e
+		},
+		"cdnConfig": func(p *textParser) TL {
+			var e TL_cdnConfig
+			for p.field() {
+				switch p.fieldName {
+				case "public_keys":
+					e.PublicKeys = p.Vector()
+				default:
+					p.unknownField("cdnConfig")
+				}
+			}
+			return e
+		},
+		"langPackString": func(p *textParser) TL {
+			var e TL_langPackString
+			for p.field() {
+				switch p.fieldName {
+				case "key":
+					e.Key = p.String()
+				case "value":
+					e.Value = p.String()
+				default:
+					p.unknownField("langPackString")
+				}
+			}
+			return e
+		},
+		"langPackStringPluralized": func(p *textParser) TL {
+			var e TL_langPackStringPluralized
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "key":
+					e.Key = p.String()
+				case "zero_value":
+					e.ZeroValue = p.String()
+					e.Flags |= 1
+				case "one_value":
+					e.OneValue = p.String()
+					e.Flags |= 2
+				case "two_value":
+					e.TwoValue = p.String()
+					e.Flags |= 4
+				case "few_value":
+					e.FewValue = p.String()
+					e.Flags |= 8
+				case "many_value":
+					e.ManyValue = p.String()
+					e.Flags |= 16
+				case "other_value":
+					e.OtherValue = p.String()
+				default:
+					p.unknownField("langPackStringPluralized")
+				}
+			}
+			return e
+		},
+		"langPackStringDeleted": func(p *textParser) TL {
+			var e TL_langPackStringDeleted
+			for p.field() {
+				switch p.fieldName {
+				case "key":
+					e.Key = p.String()
+				default:
+					p.unknownField("langPackStringDeleted")
+				}
+			}
+			return e
+		},
+		"langPackDifference": func(p *textParser) TL {
+			var e TL_langPackDifference
+			for p.field() {
+				switch p.fieldName {
+				case "lang_code":
+					e.LangCode = p.String()
+				case "from_version":
+					e.FromVersion = p.Int()
+				case "version":
+					e.Version = p.Int()
+				case "strings":
+					e.Strings = p.Vector()
+				default:
+					p.unknownField("langPackDifference")
+				}
+			}
+			return e
+		},
+		"langPackLanguage": func(p *textParser) TL {
+			var e TL_langPackLanguage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "official":
+					e.Official = p.Bool()
+					if e.Official {
+						e.Flags |= 1
+					}
+				case "rtl":
+					e.Rtl = p.Bool()
+					if e.Rtl {
+						e.Flags |= 4
+					}
+				case "beta":
+					e.Beta = p.Bool()
+					if e.Beta {
+						e.Flags |= 8
+					}
+				case "name":
+					e.Name = p.String()
+				case "native_name":
+					e.NativeName = p.String()
+				case "lang_code":
+					e.LangCode = p.String()
+				case "base_lang_code":
+					e.BaseLangCode = p.String()
+					e.Flags |= 2
+				case "plural_code":
+					e.PluralCode = p.String()
+				case "strings_count":
+					e.StringsCount = p.Int()
+				case "translated_count":
+					e.TranslatedCount = p.Int()
+				case "translations_url":
+					e.TranslationsUrl = p.String()
+				default:
+					p.unknownField("langPackLanguage")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionChangeTitle": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionChangeTitle
+			for p.field() {
+				switch p.fieldName {
+				case "prev_value":
+					e.PrevValue = p.String()
+				case "new_value":
+					e.NewValue = p.String()
+				default:
+					p.unknownField("channelAdminLogEventActionChangeTitle")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionChangeAbout": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionChangeAbout
+			for p.field() {
+				switch p.fieldName {
+				case "prev_value":
+					e.PrevValue = p.String()
+				case "new_value":
+					e.NewValue = p.String()
+				default:
+					p.unknownField("channelAdminLogEventActionChangeAbout")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionChangeUsername": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionChangeUsername
+			for p.field() {
+				switch p.fieldName {
+				case "prev_value":
+					e.PrevValue = p.String()
+				case "new_value":
+					e.NewValue = p.String()
+				default:
+					p.unknownField("channelAdminLogEventActionChangeUsername")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionChangePhoto": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionChangePhoto
+			for p.field() {
+				switch p.fieldName {
+				case "prev_photo":
+					e.PrevPhoto = p.Object()
+				case "new_photo":
+					e.NewPhoto = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionChangePhoto")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionToggleInvites": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionToggleInvites
+			for p.field() {
+				switch p.fieldName {
+				case "new_value":
+					e.NewValue = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionToggleInvites")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionToggleSignatures": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionToggleSignatures
+			for p.field() {
+				switch p.fieldName {
+				case "new_value":
+					e.NewValue = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionToggleSignatures")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionUpdatePinned": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionUpdatePinned
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionUpdatePinned")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionEditMessage": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionEditMessage
+			for p.field() {
+				switch p.fieldName {
+				case "prev_message":
+					e.PrevMessage = p.Object()
+				case "new_message":
+					e.NewMessage = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionEditMessage")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionDeleteMessage": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionDeleteMessage
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionDeleteMessage")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantJoin": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantJoin
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantJoin")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantLeave": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantLeave
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantLeave")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantInvite": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantInvite
+			for p.field() {
+				switch p.fieldName {
+				case "participant":
+					e.Participant = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantInvite")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantToggleBan": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantToggleBan
+			for p.field() {
+				switch p.fieldName {
+				case "prev_participant":
+					e.PrevParticipant = p.Object()
+				case "new_participant":
+					e.NewParticipant = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantToggleBan")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantToggleAdmin": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantToggleAdmin
+			for p.field() {
+				switch p.fieldName {
+				case "prev_participant":
+					e.PrevParticipant = p.Object()
+				case "new_participant":
+					e.NewParticipant = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantToggleAdmin")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionChangeStickerSet": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionChangeStickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "prev_stickerset":
+					e.PrevStickerset = p.Object()
+				case "new_stickerset":
+					e.NewStickerset = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionChangeStickerSet")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionTogglePreHistoryHidden": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionTogglePreHistoryHidden
+			for p.field() {
+				switch p.fieldName {
+				case "new_value":
+					e.NewValue = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionTogglePreHistoryHidden")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionDefaultBannedRights": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionDefaultBannedRights
+			for p.field() {
+				switch p.fieldName {
+				case "prev_banned_rights":
+					e.PrevBannedRights = p.Object()
+				case "new_banned_rights":
+					e.NewBannedRights = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionDefaultBannedRights")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionStopPoll": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionStopPoll
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionStopPoll")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionChangeLinkedChat": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionChangeLinkedChat
+			for p.field() {
+				switch p.fieldName {
+				case "prev_value":
+					e.PrevValue = p.Int()
+				case "new_value":
+					e.NewValue = p.Int()
+				default:
+					p.unknownField("channelAdminLogEventActionChangeLinkedChat")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionChangeLocation": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionChangeLocation
+			for p.field() {
+				switch p.fieldName {
+				case "prev_value":
+					e.PrevValue = p.Object()
+				case "new_value":
+					e.NewValue = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionChangeLocation")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionToggleSlowMode": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionToggleSlowMode
+			for p.field() {
+				switch p.fieldName {
+				case "prev_value":
+					e.PrevValue = p.Int()
+				case "new_value":
+					e.NewValue = p.Int()
+				default:
+					p.unknownField("channelAdminLogEventActionToggleSlowMode")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionStartGroupCall": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionStartGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionStartGroupCall")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionDiscardGroupCall": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionDiscardGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionDiscardGroupCall")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantMute": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantMute
+			for p.field() {
+				switch p.fieldName {
+				case "participant":
+					e.Participant = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantMute")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantUnmute": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantUnmute
+			for p.field() {
+				switch p.fieldName {
+				case "participant":
+					e.Participant = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantUnmute")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionToggleGroupCallSetting": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionToggleGroupCallSetting
+			for p.field() {
+				switch p.fieldName {
+				case "join_muted":
+					e.JoinMuted = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionToggleGroupCallSetting")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantJoinByInvite": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantJoinByInvite
+			for p.field() {
+				switch p.fieldName {
+				case "invite":
+					e.Invite = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantJoinByInvite")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionExportedInviteDelete": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionExportedInviteDelete
+			for p.field() {
+				switch p.fieldName {
+				case "invite":
+					e.Invite = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionExportedInviteDelete")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionExportedInviteRevoke": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionExportedInviteRevoke
+			for p.field() {
+				switch p.fieldName {
+				case "invite":
+					e.Invite = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionExportedInviteRevoke")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionExportedInviteEdit": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionExportedInviteEdit
+			for p.field() {
+				switch p.fieldName {
+				case "prev_invite":
+					e.PrevInvite = p.Object()
+				case "new_invite":
+					e.NewInvite = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionExportedInviteEdit")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionParticipantVolume": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionParticipantVolume
+			for p.field() {
+				switch p.fieldName {
+				case "participant":
+					e.Participant = p.Object()
+				default:
+					p.unknownField("channelAdminLogEventActionParticipantVolume")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventActionChangeHistoryTTL": func(p *textParser) TL {
+			var e TL_channelAdminLogEventActionChangeHistoryTTL
+			for p.field() {
+				switch p.fieldName {
+				case "prev_value":
+					e.PrevValue = p.Int()
+				case "new_value":
+					e.NewValue = p.Int()
+				default:
+					p.unknownField("channelAdminLogEventActionChangeHistoryTTL")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEvent": func(p *textParser) TL {
+			var e TL_channelAdminLogEvent
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "date":
+					e.Date = p.Int()
+				case "user_id":
+					e.UserID = p.Int()
+				case "action":
+					e.Action = p.Object()
+				default:
+					p.unknownField("channelAdminLogEvent")
+				}
+			}
+			return e
+		},
+		"channels.adminLogResults": func(p *textParser) TL {
+			var e TL_channels_adminLogResults
+			for p.field() {
+				switch p.fieldName {
+				case "events":
+					e.Events = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("channels.adminLogResults")
+				}
+			}
+			return e
+		},
+		"channelAdminLogEventsFilter": func(p *textParser) TL {
+			var e TL_channelAdminLogEventsFilter
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "join":
+					e.Join = p.Bool()
+					if e.Join {
+						e.Flags |= 1
+					}
+				case "leave":
+					e.Leave = p.Bool()
+					if e.Leave {
+						e.Flags |= 2
+					}
+				case "invite":
+					e.Invite = p.Bool()
+					if e.Invite {
+						e.Flags |= 4
+					}
+				case "ban":
+					e.Ban = p.Bool()
+					if e.Ban {
+						e.Flags |= 8
+					}
+				case "unban":
+					e.Unban = p.Bool()
+					if e.Unban {
+						e.Flags |= 16
+					}
+				case "kick":
+					e.Kick = p.Bool()
+					if e.Kick {
+						e.Flags |= 32
+					}
+				case "unkick":
+					e.Unkick = p.Bool()
+					if e.Unkick {
+						e.Flags |= 64
+					}
+				case "promote":
+					e.Promote = p.Bool()
+					if e.Promote {
+						e.Flags |= 128
+					}
+				case "demote":
+					e.Demote = p.Bool()
+					if e.Demote {
+						e.Flags |= 256
+					}
+				case "info":
+					e.Info = p.Bool()
+					if e.Info {
+						e.Flags |= 512
+					}
+				case "settings":
+					e.Settings = p.Bool()
+					if e.Settings {
+						e.Flags |= 1024
+					}
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 2048
+					}
+				case "edit":
+					e.Edit = p.Bool()
+					if e.Edit {
+						e.Flags |= 4096
+					}
+				case "delete":
+					e.Delete = p.Bool()
+					if e.Delete {
+						e.Flags |= 8192
+					}
+				case "group_call":
+					e.GroupCall = p.Bool()
+					if e.GroupCall {
+						e.Flags |= 16384
+					}
+				case "invites":
+					e.Invites = p.Bool()
+					if e.Invites {
+						e.Flags |= 32768
+					}
+				default:
+					p.unknownField("channelAdminLogEventsFilter")
+				}
+			}
+			return e
+		},
+		"popularContact": func(p *textParser) TL {
+			var e TL_popularContact
+			for p.field() {
+				switch p.fieldName {
+				case "client_id":
+					e.ClientID = p.Long()
+				case "importers":
+					e.Importers = p.Int()
+				default:
+					p.unknownField("popularContact")
+				}
+			}
+			return e
+		},
+		"messages.favedStickersNotModified": func(p *textParser) TL {
+			var e TL_messages_favedStickersNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.favedStickersNotModified")
+				}
+			}
+			return e
+		},
+		"messages.favedStickers": func(p *textParser) TL {
+			var e TL_messages_favedStickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "packs":
+					e.Packs = p.Vector()
+				case "stickers":
+					e.Stickers = p.Vector()
+				default:
+					p.unknownField("messages.favedStickers")
+				}
+			}
+			return e
+		},
+		"recentMeUrlUnknown": func(p *textParser) TL {
+			var e TL_recentMeUrlUnknown
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				default:
+					p.unknownField("recentMeUrlUnknown")
+				}
+			}
+			return e
+		},
+		"recentMeUrlUser": func(p *textParser) TL {
+			var e TL_recentMeUrlUser
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "user_id":
+					e.UserID = p.Int()
+				default:
+					p.unknownField("recentMeUrlUser")
+				}
+			}
+			return e
+		},
+		"recentMeUrlChat": func(p *textParser) TL {
+			var e TL_recentMeUrlChat
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("recentMeUrlChat")
+				}
+			}
+			return e
+		},
+		"recentMeUrlChatInvite": func(p *textParser) TL {
+			var e TL_recentMeUrlChatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "chat_invite":
+					e.ChatInvite = p.Object()
+				default:
+					p.unknownField("recentMeUrlChatInvite")
+				}
+			}
+			return e
+		},
+		"recentMeUrlStickerSet": func(p *textParser) TL {
+			var e TL_recentMeUrlStickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "set":
+					e.Set = p.Object()
+				default:
+					p.unknownField("recentMeUrlStickerSet")
+				}
+			}
+			return e
+		},
+		"help.recentMeUrls": func(p *textParser) TL {
+			var e TL_help_recentMeUrls
+			for p.field() {
+				switch p.fieldName {
+				case "urls":
+					e.Urls = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("help.recentMeUrls")
+				}
+			}
+			return e
+		},
+		"inputSingleMedia": func(p *textParser) TL {
+			var e TL_inputSingleMedia
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "media":
+					e.Media = p.Object()
+				case "random_id":
+					e.RandomID = p.Long()
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 1
+				default:
+					p.unknownField("inputSingleMedia")
+				}
+			}
+			return e
+		},
+		"webAuthorization": func(p *textParser) TL {
+			var e TL_webAuthorization
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Long()
+				case "bot_id":
+					e.BotID = p.Int()
+				case "domain":
+					e.Domain = p.String()
+				case "browser":
+					e.Browser = p.String()
+				case "platform":
+					e.Platform = p.String()
+				case "date_created":
+					e.DateCreated = p.Int()
+				case "date_active":
+					e.DateActive = p.Int()
+				case "ip":
+					e.Ip = p.String()
+				case "region":
+					e.Region = p.String()
+				default:
+					p.unknownField("webAuthorization")
+				}
+			}
+			return e
+		},
+		"account.webAuthorizations": func(p *textParser) TL {
+			var e TL_account_webAuthorizations
+			for p.field() {
+				switch p.fieldName {
+				case "authorizations":
+					e.Authorizations = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("account.webAuthorizations")
+				}
+			}
+			return e
+		},
+		"inputMessageID": func(p *textParser) TL {
+			var e TL_inputMessageID
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("inputMessageID")
+				}
+			}
+			return e
+		},
+		"inputMessageReplyTo": func(p *textParser) TL {
+			var e TL_inputMessageReplyTo
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("inputMessageReplyTo")
+				}
+			}
+			return e
+		},
+		"inputMessagePinned": func(p *textParser) TL {
+			var e TL_inputMessagePinned
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputMessagePinned")
+				}
+			}
+			return e
+		},
+		"inputMessageCallbackQuery": func(p *textParser) TL {
+			var e TL_inputMessageCallbackQuery
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				case "query_id":
+					e.QueryID = p.Long()
+				default:
+					p.unknownField("inputMessageCallbackQuery")
+				}
+			}
+			return e
+		},
+		"inputDialogPeer": func(p *textParser) TL {
+			var e TL_inputDialogPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("inputDialogPeer")
+				}
+			}
+			return e
+		},
+		"inputDialogPeerFolder": func(p *textParser) TL {
+			var e TL_inputDialogPeerFolder
+			for p.field() {
+				switch p.fieldName {
+				case "folder_id":
+					e.FolderID = p.Int()
+				default:
+					p.unknownField("inputDialogPeerFolder")
+				}
+			}
+			return e
+		},
+		"dialogPeer": func(p *textParser) TL {
+			var e TL_dialogPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("dialogPeer")
+				}
+			}
+			return e
+		},
+		"dialogPeerFolder": func(p *textParser) TL {
+			var e TL_dialogPeerFolder
+			for p.field() {
+				switch p.fieldName {
+				case "folder_id":
+					e.FolderID = p.Int()
+				default:
+					p.unknownField("dialogPeerFolder")
+				}
+			}
+			return e
+		},
+		"messages.foundStickerSetsNotModified": func(p *textParser) TL {
+			var e TL_messages_foundStickerSetsNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.foundStickerSetsNotModified")
+				}
+			}
+			return e
+		},
+		"messages.foundStickerSets": func(p *textParser) TL {
+			var e TL_messages_foundStickerSets
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "sets":
+					e.Sets = p.Vector()
+				default:
+					p.unknownField("messages.foundStickerSets")
+				}
+			}
+			return e
+		},
+		"fileHash": func(p *textParser) TL {
+			var e TL_fileHash
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				case "hash":
+					e.Hash = p.Bytes()
+				default:
+					p.unknownField("fileHash")
+				}
+			}
+			return e
+		},
+		"inputClientProxy": func(p *textParser) TL {
+			var e TL_inputClientProxy
+			for p.field() {
+				switch p.fieldName {
+				case "address":
+					e.Address = p.String()
+				case "port":
+					e.Port = p.Int()
+				default:
+					p.unknownField("inputClientProxy")
+				}
+			}
+			return e
+		},
+		"help.termsOfServiceUpdateEmpty": func(p *textParser) TL {
+			var e TL_help_termsOfServiceUpdateEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "expires":
+					e.Expires = p.Int()
+				default:
+					p.unknownField("help.termsOfServiceUpdateEmpty")
+				}
+			}
+			return e
+		},
+		"help.termsOfServiceUpdate": func(p *textParser) TL {
+			var e TL_help_termsOfServiceUpdate
+			for p.field() {
+				switch p.fieldName {
+				case "expires":
+					e.Expires = p.Int()
+				case "terms_of_service":
+					e.TermsOfService = p.Object()
+				default:
+					p.unknownField("help.termsOfServiceUpdate")
+				}
+			}
+			return e
+		},
+		"inputSecureFileUploaded": func(p *textParser) TL {
+			var e TL_inputSecureFileUploaded
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "parts":
+					e.Parts = p.Int()
+				case "md5_checksum":
+					e.Md5Checksum = p.String()
+				case "file_hash":
+					e.FileHash = p.Bytes()
+				case "secret":
+					e.Secret = p.Bytes()
+				default:
+					p.unknownField("inputSecureFileUploaded")
+				}
+			}
+			return e
+		},
+		"inputSecureFile": func(p *textParser) TL {
+			var e TL_inputSecureFile
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputSecureFile")
+				}
+			}
+			return e
+		},
+		"secureFileEmpty": func(p *textParser) TL {
+			var e TL_secureFileEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureFileEmpty")
+				}
+			}
+			return e
+		},
+		"secureFile": func(p *textParser) TL {
+			var e TL_secureFile
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "size":
+					e.Size = p.Int()
+				case "dc_id":
+					e.DcID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				case "file_hash":
+					e.FileHash = p.Bytes()
+				case "secret":
+					e.Secret = p.Bytes()
+				default:
+					p.unknownField("secureFile")
+				}
+			}
+			return e
+		},
+		"secureData": func(p *textParser) TL {
+			var e TL_secureData
+			for p.field() {
+				switch p.fieldName {
+				case "data":
+					e.Data = p.Bytes()
+				case "data_hash":
+					e.DataHash = p.Bytes()
+				case "secret":
+					e.Secret = p.Bytes()
+				default:
+					p.unknownField("secureData")
+				}
+			}
+			return e
+		},
+		"securePlainPhone": func(p *textParser) TL {
+			var e TL_securePlainPhone
+			for p.field() {
+				switch p.fieldName {
+				case "phone":
+					e.Phone = p.String()
+				default:
+					p.unknownField("securePlainPhone")
+				}
+			}
+			return e
+		},
+		"securePlainEmail": func(p *textParser) TL {
+			var e TL_securePlainEmail
+			for p.field() {
+				switch p.fieldName {
+				case "email":
+					e.Email = p.String()
+				default:
+					p.unknownField("securePlainEmail")
+				}
+			}
+			return e
+		},
+		"secureValueTypePersonalDetails": func(p *textParser) TL {
+			var e TL_secureValueTypePersonalDetails
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypePersonalDetails")
+				}
+			}
+			return e
+		},
+		"secureValueTypePassport": func(p *textParser) TL {
+			var e TL_secureValueTypePassport
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypePassport")
+				}
+			}
+			return e
+		},
+		"secureValueTypeDriverLicense": func(p *textParser) TL {
+			var e TL_secureValueTypeDriverLicense
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeDriverLicense")
+				}
+			}
+			return e
+		},
+		"secureValueTypeIdentityCard": func(p *textParser) TL {
+			var e TL_secureValueTypeIdentityCard
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeIdentityCard")
+				}
+			}
+			return e
+		},
+		"secureValueTypeInternalPassport": func(p *textParser) TL {
+			var e TL_secureValueTypeInternalPassport
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeInternalPassport")
+				}
+			}
+			return e
+		},
+		"secureValueTypeAddress": func(p *textParser) TL {
+			var e TL_secureValueTypeAddress
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeAddress")
+				}
+			}
+			return e
+		},
+		"secureValueTypeUtilityBill": func(p *textParser) TL {
+			var e TL_secureValueTypeUtilityBill
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeUtilityBill")
+				}
+			}
+			return e
+		},
+		"secureValueTypeBankStatement": func(p *textParser) TL {
+			var e TL_secureValueTypeBankStatement
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeBankStatement")
+				}
+			}
+			return e
+		},
+		"secureValueTypeRentalAgreement": func(p *textParser) TL {
+			var e TL_secureValueTypeRentalAgreement
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeRentalAgreement")
+				}
+			}
+			return e
+		},
+		"secureValueTypePassportRegistration": func(p *textParser) TL {
+			var e TL_secureValueTypePassportRegistration
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypePassportRegistration")
+				}
+			}
+			return e
+		},
+		"secureValueTypeTemporaryRegistration": func(p *textParser) TL {
+			var e TL_secureValueTypeTemporaryRegistration
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeTemporaryRegistration")
+				}
+			}
+			return e
+		},
+		"secureValueTypePhone": func(p *textParser) TL {
+			var e TL_secureValueTypePhone
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypePhone")
+				}
+			}
+			return e
+		},
+		"secureValueTypeEmail": func(p *textParser) TL {
+			var e TL_secureValueTypeEmail
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("secureValueTypeEmail")
+				}
+			}
+			return e
+		},
+		"secureValue": func(p *textParser) TL {
+			var e TL_secureValue
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "type":
+					e.Type = p.Object()
+				case "data":
+					e.Data = p.Object()
+					e.Flags |= 1
+				case "front_side":
+					e.FrontSide = p.Object()
+					e.Flags |= 2
+				case "reverse_side":
+					e.ReverseSide = p.Object()
+					e.Flags |= 4
+				case "selfie":
+					e.Selfie = p.Object()
+					e.Flags |= 8
+				case "translation":
+					e.Translation = p.Vector()
+					e.Flags |= 64
+				case "files":
+					e.Files = p.Vector()
+					e.Flags |= 16
+				case "plain_data":
+					e.PlainData = p.Object()
+					e.Flags |= 32
+				case "hash":
+					e.Hash = p.Bytes()
+				default:
+					p.unknownField("secureValue")
+				}
+			}
+			return e
+		},
+		"inputSecureValue": func(p *textParser) TL {
+			var e TL_inputSecureValue
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "type":
+					e.Type = p.Object()
+				case "data":
+					e.Data = p.Object()
+					e.Flags |= 1
+				case "front_side":
+					e.FrontSide = p.Object()
+					e.Flags |= 2
+				case "reverse_side":
+					e.ReverseSide = p.Object()
+					e.Flags |= 4
+				case "selfie":
+					e.Selfie = p.Object()
+					e.Flags |= 8
+				case "translation":
+					e.Translation = p.Vector()
+					e.Flags |= 64
+				case "files":
+					e.Files = p.Vector()
+					e.Flags |= 16
+				case "plain_data":
+					e.PlainData = p.Object()
+					e.Flags |= 32
+				default:
+					p.unknownField("inputSecureValue")
+				}
+			}
+			return e
+		},
+		"secureValueHash": func(p *textParser) TL {
+			var e TL_secureValueHash
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "hash":
+					e.Hash = p.Bytes()
+				default:
+					p.unknownField("secureValueHash")
+				}
+			}
+			return e
+		},
+		"secureValueErrorData": func(p *textParser) TL {
+			var e TL_secureValueErrorData
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "data_hash":
+					e.DataHash = p.Bytes()
+				case "field":
+					e.Field = p.String()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueErrorData")
+				}
+			}
+			return e
+		},
+		"secureValueErrorFrontSide": func(p *textParser) TL {
+			var e TL_secureValueErrorFrontSide
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "file_hash":
+					e.FileHash = p.Bytes()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueErrorFrontSide")
+				}
+			}
+			return e
+		},
+		"secureValueErrorReverseSide": func(p *textParser) TL {
+			var e TL_secureValueErrorReverseSide
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "file_hash":
+					e.FileHash = p.Bytes()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueErrorReverseSide")
+				}
+			}
+			return e
+		},
+		"secureValueErrorSelfie": func(p *textParser) TL {
+			var e TL_secureValueErrorSelfie
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "file_hash":
+					e.FileHash = p.Bytes()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueErrorSelfie")
+				}
+			}
+			return e
+		},
+		"secureValueErrorFile": func(p *textParser) TL {
+			var e TL_secureValueErrorFile
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "file_hash":
+					e.FileHash = p.Bytes()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueErrorFile")
+				}
+			}
+			return e
+		},
+		"secureValueErrorFiles": func(p *textParser) TL {
+			var e TL_secureValueErrorFiles
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "file_hash":
+					e.FileHash = p.VectorBytes()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueErrorFiles")
+				}
+			}
+			return e
+		},
+		"secureValueError": func(p *textParser) TL {
+			var e TL_secureValueError
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "hash":
+					e.Hash = p.Bytes()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueError")
+				}
+			}
+			return e
+		},
+		"secureValueErrorTranslationFile": func(p *textParser) TL {
+			var e TL_secureValueErrorTranslationFile
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "file_hash":
+					e.FileHash = p.Bytes()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueErrorTranslationFile")
+				}
+			}
+			return e
+		},
+		"secureValueErrorTranslationFiles": func(p *textParser) TL {
+			var e TL_secureValueErrorTranslationFiles
+			for p.field() {
+				switch p.fieldName {
+				case "type":
+					e.Type = p.Object()
+				case "file_hash":
+					e.FileHash = p.VectorBytes()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("secureValueErrorTranslationFiles")
+				}
+			}
+			return e
+		},
+		"secureCredentialsEncrypted": func(p *textParser) TL {
+			var e TL_secureCredentialsEncrypted
+			for p.field() {
+				switch p.fieldName {
+				case "data":
+					e.Data = p.Bytes()
+				case "hash":
+					e.Hash = p.Bytes()
+				case "secret":
+					e.Secret = p.Bytes()
+				default:
+					p.unknownField("secureCredentialsEncrypted")
+				}
+			}
+			return e
+		},
+		"account.authorizationForm": func(p *textParser) TL {
+			var e TL_account_authorizationForm
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "required_types":
+					e.RequiredTypes = p.Vector()
+				case "values":
+					e.Values = p.Vector()
+				case "errors":
+					e.Errors = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "privacy_policy_url":
+					e.PrivacyPolicyUrl = p.String()
+					e.Flags |= 1
+				default:
+					p.unknownField("account.authorizationForm")
+				}
+			}
+			return e
+		},
+		"account.sentEmailCode": func(p *textParser) TL {
+			var e TL_account_sentEmailCode
+			for p.field() {
+				switch p.fieldName {
+				case "email_pattern":
+					e.EmailPattern = p.String()
+				case "length":
+					e.Length = p.Int()
+				default:
+					p.unknownField("account.sentEmailCode")
+				}
+			}
+			return e
+		},
+		"help.deepLinkInfoEmpty": func(p *textParser) TL {
+			var e TL_help_deepLinkInfoEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.deepLinkInfoEmpty")
+				}
+			}
+			return e
+		},
+		"help.deepLinkInfo": func(p *textParser) TL {
+			var e TL_help_deepLinkInfo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "update_app":
+					e.UpdateApp = p.Bool()
+					if e.UpdateApp {
+						e.Flags |= 1
+					}
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 2
+				default:
+					p.unknownField("help.deepLinkInfo")
+				}
+			}
+			return e
+		},
+		"savedPhoneContact": func(p *textParser) TL {
+			var e TL_savedPhoneContact
+			for p.field() {
+				switch p.fieldName {
+				case "phone":
+					e.Phone = p.String()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("savedPhoneContact")
+				}
+			}
+			return e
+		},
+		"account.takeout": func(p *textParser) TL {
+			var e TL_account_takeout
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				default:
+					p.unknownField("account.takeout")
+				}
+			}
+			return e
+		},
+		"passwordKdfAlgoUnknown": func(p *textParser) TL {
+			var e TL_passwordKdfAlgoUnknown
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("passwordKdfAlgoUnknown")
+				}
+			}
+			return e
+		},
+		"passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow": func(p *textParser) TL {
+			var e TL_passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow
+			for p.field() {
+				switch p.fieldName {
+				case "salt1":
+					e.Salt1 = p.Bytes()
+				case "salt2":
+					e.Salt2 = p.Bytes()
+				case "g":
+					e.G = p.Int()
+				case "p":
+					e.P = p.Bytes()
+				default:
+					p.unknownField("passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow")
+				}
+			}
+			return e
+		},
+		"securePasswordKdfAlgoUnknown": func(p *textParser) TL {
+			var e TL_securePasswordKdfAlgoUnknown
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("securePasswordKdfAlgoUnknown")
+				}
+			}
+			return e
+		},
+		"securePasswordKdfAlgoPBKDF2HMACSHA512iter100000": func(p *textParser) TL {
+			var e TL_securePasswordKdfAlgoPBKDF2HMACSHA512iter100000
+			for p.field() {
+				switch p.fieldName {
+				case "salt":
+					e.Salt = p.Bytes()
+				default:
+					p.unknownField("securePasswordKdfAlgoPBKDF2HMACSHA512iter100000")
+				}
+			}
+			return e
+		},
+		"securePasswordKdfAlgoSHA512": func(p *textParser) TL {
+			var e TL_securePasswordKdfAlgoSHA512
+			for p.field() {
+				switch p.fieldName {
+				case "salt":
+					e.Salt = p.Bytes()
+				default:
+					p.unknownField("securePasswordKdfAlgoSHA512")
+				}
+			}
+			return e
+		},
+		"secureSecretSettings": func(p *textParser) TL {
+			var e TL_secureSecretSettings
+			for p.field() {
+				switch p.fieldName {
+				case "secure_algo":
+					e.SecureAlgo = p.Object()
+				case "secure_secret":
+					e.SecureSecret = p.Bytes()
+				case "secure_secret_id":
+					e.SecureSecretID = p.Long()
+				default:
+					p.unknownField("secureSecretSettings")
+				}
+			}
+			return e
+		},
+		"inputCheckPasswordEmpty": func(p *textParser) TL {
+			var e TL_inputCheckPasswordEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputCheckPasswordEmpty")
+				}
+			}
+			return e
+		},
+		"inputCheckPasswordSRP": func(p *textParser) TL {
+			var e TL_inputCheckPasswordSRP
+			for p.field() {
+				switch p.fieldName {
+				case "srp_id":
+					e.SrpID = p.Long()
+				case "A":
+					e.A = p.Bytes()
+				case "M1":
+					e.M1 = p.Bytes()
+				default:
+					p.unknownField("inputCheckPasswordSRP")
+				}
+			}
+			return e
+		},
+		"secureRequiredType": func(p *textParser) TL {
+			var e TL_secureRequiredType
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "native_names":
+					e.NativeNames = p.Bool()
+					if e.NativeNames {
+						e.Flags |= 1
+					}
+				case "selfie_required":
+					e.SelfieRequired = p.Bool()
+					if e.SelfieRequired {
+						e.Flags |= 2
+					}
+				case "translation_required":
+					e.TranslationRequired = p.Bool()
+					if e.TranslationRequired {
+						e.Flags |= 4
+					}
+				case "type":
+					e.Type = p.Object()
+				default:
+					p.unknownField("secureRequiredType")
+				}
+			}
+			return e
+		},
+		"secureRequiredTypeOneOf": func(p *textParser) TL {
+			var e TL_secureRequiredTypeOneOf
+			for p.field() {
+				switch p.fieldName {
+				case "types":
+					e.Types = p.Vector()
+				default:
+					p.unknownField("secureRequiredTypeOneOf")
+				}
+			}
+			return e
+		},
+		"help.passportConfigNotModified": func(p *textParser) TL {
+			var e TL_help_passportConfigNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.passportConfigNotModified")
+				}
+			}
+			return e
+		},
+		"help.passportConfig": func(p *textParser) TL {
+			var e TL_help_passportConfig
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "countries_langs":
+					e.CountriesLangs = p.Object()
+				default:
+					p.unknownField("help.passportConfig")
+				}
+			}
+			return e
+		},
+		"inputAppEvent": func(p *textParser) TL {
+			var e TL_inputAppEvent
+			for p.field() {
+				switch p.fieldName {
+				case "time":
+					e.Time = p.Double()
+				case "type":
+					e.Type = p.String()
+				case "peer":
+					e.Peer = p.Long()
+				case "data":
+					e.Data = p.Object()
+				default:
+					p.unknownField("inputAppEvent")
+				}
+			}
+			return e
+		},
+		"jsonObjectValue": func(p *textParser) TL {
+			var e TL_jsonObjectValue
+			for p.field() {
+				switch p.fieldName {
+				case "key":
+					e.Key = p.String()
+				case "value":
+					e.Value = p.Object()
+				default:
+					p.unknownField("jsonObjectValue")
+				}
+			}
+			return e
+		},
+		"jsonNull": func(p *textParser) TL {
+			var e TL_jsonNull
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("jsonNull")
+				}
+			}
+			return e
+		},
+		"jsonBool": func(p *textParser) TL {
+			var e TL_jsonBool
+			for p.field() {
+				switch p.fieldName {
+				case "value":
+					e.Value = p.Object()
+				default:
+					p.unknownField("jsonBool")
+				}
+			}
+			return e
+		},
+		"jsonNumber": func(p *textParser) TL {
+			var e TL_jsonNumber
+			for p.field() {
+				switch p.fieldName {
+				case "value":
+					e.Value = p.Double()
+				default:
+					p.unknownField("jsonNumber")
+				}
+			}
+			return e
+		},
+		"jsonString": func(p *textParser) TL {
+			var e TL_jsonString
+			for p.field() {
+				switch p.fieldName {
+				case "value":
+					e.Value = p.String()
+				default:
+					p.unknownField("jsonString")
+				}
+			}
+			return e
+		},
+		"jsonArray": func(p *textParser) TL {
+			var e TL_jsonArray
+			for p.field() {
+				switch p.fieldName {
+				case "value":
+					e.Value = p.Vector()
+				default:
+					p.unknownField("jsonArray")
+				}
+			}
+			return e
+		},
+		"jsonObject": func(p *textParser) TL {
+			var e TL_jsonObject
+			for p.field() {
+				switch p.fieldName {
+				case "value":
+					e.Value = p.Vector()
+				default:
+					p.unknownField("jsonObject")
+				}
+			}
+			return e
+		},
+		"pageTableCell": func(p *textParser) TL {
+			var e TL_pageTableCell
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "header":
+					e.Header = p.Bool()
+					if e.Header {
+						e.Flags |= 1
+					}
+				case "align_center":
+					e.AlignCenter = p.Bool()
+					if e.AlignCenter {
+						e.Flags |= 8
+					}
+				case "align_right":
+					e.AlignRight = p.Bool()
+					if e.AlignRight {
+						e.Flags |= 16
+					}
+				case "valign_middle":
+					e.ValignMiddle = p.Bool()
+					if e.ValignMiddle {
+						e.Flags |= 32
+					}
+				case "valign_bottom":
+					e.ValignBottom = p.Bool()
+					if e.ValignBottom {
+						e.Flags |= 64
+					}
+				case "text":
+					e.Text = p.Object()
+					e.Flags |= 128
+				case "colspan":
+					e.Colspan = p.Int()
+					e.Flags |= 2
+				case "rowspan":
+					e.Rowspan = p.Int()
+					e.Flags |= 4
+				default:
+					p.unknownField("pageTableCell")
+				}
+			}
+			return e
+		},
+		"pageTableRow": func(p *textParser) TL {
+			var e TL_pageTableRow
+			for p.field() {
+				switch p.fieldName {
+				case "cells":
+					e.Cells = p.Vector()
+				default:
+					p.unknownField("pageTableRow")
+				}
+			}
+			return e
+		},
+		"pageCaption": func(p *textParser) TL {
+			var e TL_pageCaption
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				case "credit":
+					e.Credit = p.Object()
+				default:
+					p.unknownField("pageCaption")
+				}
+			}
+			return e
+		},
+		"pageListItemText": func(p *textParser) TL {
+			var e TL_pageListItemText
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageListItemText")
+				}
+			}
+			return e
+		},
+		"pageListItemBlocks": func(p *textParser) TL {
+			var e TL_pageListItemBlocks
+			for p.field() {
+				switch p.fieldName {
+				case "blocks":
+					e.Blocks = p.Vector()
+				default:
+					p.unknownField("pageListItemBlocks")
+				}
+			}
+			return e
+		},
+		"pageListOrderedItemText": func(p *textParser) TL {
+			var e TL_pageListOrderedItemText
+			for p.field() {
+				switch p.fieldName {
+				case "num":
+					e.Num = p.String()
+				case "text":
+					e.Text = p.Object()
+				default:
+					p.unknownField("pageListOrderedItemText")
+				}
+			}
+			return e
+		},
+		"pageListOrderedItemBlocks": func(p *textParser) TL {
+			var e TL_pageListOrderedItemBlocks
+			for p.field() {
+				switch p.fieldName {
+				case "num":
+					e.Num = p.String()
+				case "blocks":
+					e.Blocks = p.Vector()
+				default:
+					p.unknownField("pageListOrderedItemBlocks")
+				}
+			}
+			return e
+		},
+		"pageRelatedArticle": func(p *textParser) TL {
+			var e TL_pageRelatedArticle
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "url":
+					e.Url = p.String()
+				case "webpage_id":
+					e.WebpageID = p.Long()
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 1
+				case "description":
+					e.Description = p.String()
+					e.Flags |= 2
+				case "photo_id":
+					e.PhotoID = p.Long()
+					e.Flags |= 4
+				case "author":
+					e.Author = p.String()
+					e.Flags |= 8
+				case "published_date":
+					e.PublishedDate = p.Int()
+					e.Flags |= 16
+				default:
+					p.unknownField("pageRelatedArticle")
+				}
+			}
+			return e
+		},
+		"page": func(p *textParser) TL {
+			var e TL_page
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "part":
+					e.Part = p.Bool()
+					if e.Part {
+						e.Flags |= 1
+					}
+				case "rtl":
+					e.Rtl = p.Bool()
+					if e.Rtl {
+						e.Flags |= 2
+					}
+				case "v2":
+					e.V2 = p.Bool()
+					if e.V2 {
+						e.Flags |= 4
+					}
+				case "url":
+					e.Url = p.String()
+				case "blocks":
+					e.Blocks = p.Vector()
+				case "photos":
+					e.Photos = p.Vector()
+				case "documents":
+					e.Documents = p.Vector()
+				case "views":
+					e.Views = p.Int()
+					e.Flags |= 8
+				default:
+					p.unknownField("page")
+				}
+			}
+			return e
+		},
+		"help.supportName": func(p *textParser) TL {
+			var e TL_help_supportName
+			for p.field() {
+				switch p.fieldName {
+				case "name":
+					e.Name = p.String()
+				default:
+					p.unknownField("help.supportName")
+				}
+			}
+			return e
+		},
+		"help.userInfoEmpty": func(p *textParser) TL {
+			var e TL_help_userInfoEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.userInfoEmpty")
+				}
+			}
+			return e
+		},
+		"help.userInfo": func(p *textParser) TL {
+			var e TL_help_userInfo
+			for p.field() {
+				switch p.fieldName {
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+				case "author":
+					e.Author = p.String()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("help.userInfo")
+				}
+			}
+			return e
+		},
+		"pollAnswer": func(p *textParser) TL {
+			var e TL_pollAnswer
+			for p.field() {
+				switch p.fieldName {
+				case "text":
+					e.Text = p.String()
+				case "option":
+					e.Option = p.Bytes()
+				default:
+					p.unknownField("pollAnswer")
+				}
+			}
+			return e
+		},
+		"poll": func(p *textParser) TL {
+			var e TL_poll
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "flags":
+					e.Flags |= p.Int()
+				case "closed":
+					e.Closed = p.Bool()
+					if e.Closed {
+						e.Flags |= 1
+					}
+				case "public_voters":
+					e.PublicVoters = p.Bool()
+					if e.PublicVoters {
+						e.Flags |= 2
+					}
+				case "multiple_choice":
+					e.MultipleChoice = p.Bool()
+					if e.MultipleChoice {
+						e.Flags |= 4
+					}
+				case "quiz":
+					e.Quiz = p.Bool()
+					if e.Quiz {
+						e.Flags |= 8
+					}
+				case "question":
+					e.Question = p.String()
+				case "answers":
+					e.Answers = p.Vector()
+				case "close_period":
+					e.ClosePeriod = p.Int()
+					e.Flags |= 16
+				case "close_date":
+					e.CloseDate = p.Int()
+					e.Flags |= 32
+				default:
+					p.unknownField("poll")
+				}
+			}
+			return e
+		},
+		"pollAnswerVoters": func(p *textParser) TL {
+			var e TL_pollAnswerVoters
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "chosen":
+					e.Chosen = p.Bool()
+					if e.Chosen {
+						e.Flags |= 1
+					}
+				case "correct":
+					e.Correct = p.Bool()
+					if e.Correct {
+						e.Flags |= 2
+					}
+				case "option":
+					e.Option = p.Bytes()
+				case "voters":
+					e.Voters = p.Int()
+				default:
+					p.unknownField("pollAnswerVoters")
+				}
+			}
+			return e
+		},
+		"pollResults": func(p *textParser) TL {
+			var e TL_pollResults
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "min":
+					e.Min = p.Bool()
+					if e.Min {
+						e.Flags |= 1
+					}
+				case "results":
+					e.Results = p.Vector()
+					e.Flags |= 2
+				case "total_voters":
+					e.TotalVoters = p.Int()
+					e.Flags |= 4
+				case "recent_voters":
+					e.RecentVoters = p.VectorInt()
+					e.Flags |= 8
+				case "solution":
+					e.Solution = p.String()
+					e.Flags |= 16
+				case "solution_entities":
+					e.SolutionEntities = p.Vector()
+					e.Flags |= 16
+				default:
+					p.unknownField("pollResults")
+				}
+			}
+			return e
+		},
+		"chatOnlines": func(p *textParser) TL {
+			var e TL_chatOnlines
+			for p.field() {
+				switch p.fieldName {
+				case "onlines":
+					e.Onlines = p.Int()
+				default:
+					p.unknownField("chatOnlines")
+				}
+			}
+			return e
+		},
+		"statsURL": func(p *textParser) TL {
+			var e TL_statsURL
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				default:
+					p.unknownField("statsURL")
+				}
+			}
+			return e
+		},
+		"chatAdminRights": func(p *textParser) TL {
+			var e TL_chatAdminRights
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "change_info":
+					e.ChangeInfo = p.Bool()
+					if e.ChangeInfo {
+						e.Flags |= 1
+					}
+				case "post_messages":
+					e.PostMessages = p.Bool()
+					if e.PostMessages {
+						e.Flags |= 2
+					}
+				case "edit_messages":
+					e.EditMessages = p.Bool()
+					if e.EditMessages {
+						e.Flags |= 4
+					}
+				case "delete_messages":
+					e.DeleteMessages = p.Bool()
+					if e.DeleteMessages {
+						e.Flags |= 8
+					}
+				case "ban_users":
+					e.BanUsers = p.Bool()
+					if e.BanUsers {
+						e.Flags |= 16
+					}
+				case "invite_users":
+					e.InviteUsers = p.Bool()
+					if e.InviteUsers {
+						e.Flags |= 32
+					}
+				case "pin_messages":
+					e.PinMessages = p.Bool()
+					if e.PinMessages {
+						e.Flags |= 128
+					}
+				case "add_admins":
+					e.AddAdmins = p.Bool()
+					if e.AddAdmins {
+						e.Flags |= 512
+					}
+				case "anonymous":
+					e.Anonymous = p.Bool()
+					if e.Anonymous {
+						e.Flags |= 1024
+					}
+				case "manage_call":
+					e.ManageCall = p.Bool()
+					if e.ManageCall {
+						e.Flags |= 2048
+					}
+				case "other":
+					e.Other = p.Bool()
+					if e.Other {
+						e.Flags |= 4096
+					}
+				default:
+					p.unknownField("chatAdminRights")
+				}
+			}
+			return e
+		},
+		"chatBannedRights": func(p *textParser) TL {
+			var e TL_chatBannedRights
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "view_messages":
+					e.ViewMessages = p.Bool()
+					if e.ViewMessages {
+						e.Flags |= 1
+					}
+				case "send_messages":
+					e.SendMessages = p.Bool()
+					if e.SendMessages {
+						e.Flags |= 2
+					}
+				case "send_media":
+					e.SendMedia = p.Bool()
+					if e.SendMedia {
+						e.Flags |= 4
+					}
+				case "send_stickers":
+					e.SendStickers = p.Bool()
+					if e.SendStickers {
+						e.Flags |= 8
+					}
+				case "send_gifs":
+					e.SendGifs = p.Bool()
+					if e.SendGifs {
+						e.Flags |= 16
+					}
+				case "send_games":
+					e.SendGames = p.Bool()
+					if e.SendGames {
+						e.Flags |= 32
+					}
+				case "send_inline":
+					e.SendInline = p.Bool()
+					if e.SendInline {
+						e.Flags |= 64
+					}
+				case "embed_links":
+					e.EmbedLinks = p.Bool()
+					if e.EmbedLinks {
+						e.Flags |= 128
+					}
+				case "send_polls":
+					e.SendPolls = p.Bool()
+					if e.SendPolls {
+						e.Flags |= 256
+					}
+				case "change_info":
+					e.ChangeInfo = p.Bool()
+					if e.ChangeInfo {
+						e.Flags |= 1024
+					}
+				case "invite_users":
+					e.InviteUsers = p.Bool()
+					if e.InviteUsers {
+						e.Flags |= 32768
+					}
+				case "pin_messages":
+					e.PinMessages = p.Bool()
+					if e.PinMessages {
+						e.Flags |= 131072
+					}
+				case "until_date":
+					e.UntilDate = p.Int()
+				default:
+					p.unknownField("chatBannedRights")
+				}
+			}
+			return e
+		},
+		"inputWallPaper": func(p *textParser) TL {
+			var e TL_inputWallPaper
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputWallPaper")
+				}
+			}
+			return e
+		},
+		"inputWallPaperSlug": func(p *textParser) TL {
+			var e TL_inputWallPaperSlug
+			for p.field() {
+				switch p.fieldName {
+				case "slug":
+					e.Slug = p.String()
+				default:
+					p.unknownField("inputWallPaperSlug")
+				}
+			}
+			return e
+		},
+		"inputWallPaperNoFile": func(p *textParser) TL {
+			var e TL_inputWallPaperNoFile
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inputWallPaperNoFile")
+				}
+			}
+			return e
+		},
+		"account.wallPapersNotModified": func(p *textParser) TL {
+			var e TL_account_wallPapersNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.wallPapersNotModified")
+				}
+			}
+			return e
+		},
+		"account.wallPapers": func(p *textParser) TL {
+			var e TL_account_wallPapers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "wallpapers":
+					e.Wallpapers = p.Vector()
+				default:
+					p.unknownField("account.wallPapers")
+				}
+			}
+			return e
+		},
+		"codeSettings": func(p *textParser) TL {
+			var e TL_codeSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "allow_flashcall":
+					e.AllowFlashcall = p.Bool()
+					if e.AllowFlashcall {
+						e.Flags |= 1
+					}
+				case "current_number":
+					e.CurrentNumber = p.Bool()
+					if e.CurrentNumber {
+						e.Flags |= 2
+					}
+				case "allow_app_hash":
+					e.AllowAppHash = p.Bool()
+					if e.AllowAppHash {
+						e.Flags |= 16
+					}
+				default:
+					p.unknownField("codeSettings")
+				}
+			}
+			return e
+		},
+		"wallPaperSettings": func(p *textParser) TL {
+			var e TL_wallPaperSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "blur":
+					e.Blur = p.Bool()
+					if e.Blur {
+						e.Flags |= 2
+					}
+				case "motion":
+					e.Motion = p.Bool()
+					if e.Motion {
+						e.Flags |= 4
+					}
+				case "background_color":
+					e.BackgroundColor = p.Int()
+					e.Flags |= 1
+				case "second_background_color":
+					e.SecondBackgroundColor = p.Int()
+					e.Flags |= 16
+				case "intensity":
+					e.Intensity = p.Int()
+					e.Flags |= 8
+				case "rotation":
+					e.Rotation = p.Int()
+					e.Flags |= 16
+				default:
+					p.unknownField("wallPaperSettings")
+				}
+			}
+			return e
+		},
+		"autoDownloadSettings": func(p *textParser) TL {
+			var e TL_autoDownloadSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "disabled":
+					e.Disabled = p.Bool()
+					if e.Disabled {
+						e.Flags |= 1
+					}
+				case "video_preload_large":
+					e.VideoPreloadLarge = p.Bool()
+					if e.VideoPreloadLarge {
+						e.Flags |= 2
+					}
+				case "audio_preload_next":
+					e.AudioPreloadNext = p.Bool()
+					if e.AudioPreloadNext {
+						e.Flags |= 4
+					}
+				case "phonecalls_less_data":
+					e.PhonecallsLessData = p.Bool()
+					if e.PhonecallsLessData {
+						e.Flags |= 8
+					}
+				case "photo_size_max":
+					e.PhotoSizeMax = p.Int()
+				case "video_size_max":
+					e.VideoSizeMax = p.Int()
+				case "file_size_max":
+					e.FileSizeMax = p.Int()
+				case "video_upload_maxbitrate":
+					e.VideoUploadMaxbitrate = p.Int()
+				default:
+					p.unknownField("autoDownloadSettings")
+				}
+			}
+			return e
+		},
+		"account.autoDownloadSettings": func(p *textParser) TL {
+			var e TL_account_autoDownloadSettings
+			for p.field() {
+				switch p.fieldName {
+				case "low":
+					e.Low = p.Object()
+				case "medium":
+					e.Medium = p.Object()
+				case "high":
+					e.High = p.Object()
+				default:
+					p.unknownField("account.autoDownloadSettings")
+				}
+			}
+			return e
+		},
+		"emojiKeyword": func(p *textParser) TL {
+			var e TL_emojiKeyword
+			for p.field() {
+				switch p.fieldName {
+				case "keyword":
+					e.Keyword = p.String()
+				case "emoticons":
+					e.Emoticons = p.VectorString()
+				default:
+					p.unknownField("emojiKeyword")
+				}
+			}
+			return e
+		},
+		"emojiKeywordDeleted": func(p *textParser) TL {
+			var e TL_emojiKeywordDeleted
+			for p.field() {
+				switch p.fieldName {
+				case "keyword":
+					e.Keyword = p.String()
+				case "emoticons":
+					e.Emoticons = p.VectorString()
+				default:
+					p.unknownField("emojiKeywordDeleted")
+				}
+			}
+			return e
+		},
+		"emojiKeywordsDifference": func(p *textParser) TL {
+			var e TL_emojiKeywordsDifference
+			for p.field() {
+				switch p.fieldName {
+				case "lang_code":
+					e.LangCode = p.String()
+				case "from_version":
+					e.FromVersion = p.Int()
+				case "version":
+					e.Version = p.Int()
+				case "keywords":
+					e.Keywords = p.Vector()
+				default:
+					p.unknownField("emojiKeywordsDifference")
+				}
+			}
+			return e
+		},
+		"emojiURL": func(p *textParser) TL {
+			var e TL_emojiURL
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				default:
+					p.unknownField("emojiURL")
+				}
+			}
+			return e
+		},
+		"emojiLanguage": func(p *textParser) TL {
+			var e TL_emojiLanguage
+			for p.field() {
+				switch p.fieldName {
+				case "lang_code":
+					e.LangCode = p.String()
+				default:
+					p.unknownField("emojiLanguage")
+				}
+			}
+			return e
+		},
+		"fileLocationToBeDeprecated": func(p *textParser) TL {
+			var e TL_fileLocationToBeDeprecated
+			for p.field() {
+				switch p.fieldName {
+				case "volume_id":
+					e.VolumeID = p.Long()
+				case "local_id":
+					e.LocalID = p.Int()
+				default:
+					p.unknownField("fileLocationToBeDeprecated")
+				}
+			}
+			return e
+		},
+		"folder": func(p *textParser) TL {
+			var e TL_folder
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "autofill_new_broadcasts":
+					e.AutofillNewBroadcasts = p.Bool()
+					if e.AutofillNewBroadcasts {
+						e.Flags |= 1
+					}
+				case "autofill_public_groups":
+					e.AutofillPublicGroups = p.Bool()
+					if e.AutofillPublicGroups {
+						e.Flags |= 2
+					}
+				case "autofill_new_correspondents":
+					e.AutofillNewCorrespondents = p.Bool()
+					if e.AutofillNewCorrespondents {
+						e.Flags |= 4
+					}
+				case "id":
+					e.ID = p.Int()
+				case "title":
+					e.Title = p.String()
+				case "photo":
+					e.Photo = p.Object()
+					e.Flags |= 8
+				default:
+					p.unknownField("folder")
+				}
+			}
+			return e
+		},
+		"inputFolderPeer": func(p *textParser) TL {
+			var e TL_inputFolderPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "folder_id":
+					e.FolderID = p.Int()
+				default:
+					p.unknownField("inputFolderPeer")
+				}
+			}
+			return e
+		},
+		"folderPeer": func(p *textParser) TL {
+			var e TL_folderPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "folder_id":
+					e.FolderID = p.Int()
+				default:
+					p.unknownField("folderPeer")
+				}
+			}
+			return e
+		},
+		"messages.searchCounter": func(p *textParser) TL {
+			var e TL_messages_searchCounter
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "inexact":
+					e.Inexact = p.Bool()
+					if e.Inexact {
+						e.Flags |= 2
+					}
+				case "filter":
+					e.Filter = p.Object()
+				case "count":
+					e.Count = p.Int()
+				default:
+					p.unknownField("messages.searchCounter")
+				}
+			}
+			return e
+		},
+		"urlAuthResultRequest": func(p *textParser) TL {
+			var e TL_urlAuthResultRequest
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "request_write_access":
+					e.RequestWriteAccess = p.Bool()
+					if e.RequestWriteAccess {
+						e.Flags |= 1
+					}
+				case "bot":
+					e.Bot = p.Object()
+				case "domain":
+					e.Domain = p.String()
+				default:
+					p.unknownField("urlAuthResultRequest")
+				}
+			}
+			return e
+		},
+		"urlAuthResultAccepted": func(p *textParser) TL {
+			var e TL_urlAuthResultAccepted
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				default:
+					p.unknownField("urlAuthResultAccepted")
+				}
+			}
+			return e
+		},
+		"urlAuthResultDefault": func(p *textParser) TL {
+			var e TL_urlAuthResultDefault
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("urlAuthResultDefault")
+				}
+			}
+			return e
+		},
+		"channelLocationEmpty": func(p *textParser) TL {
+			var e TL_channelLocationEmpty
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channelLocationEmpty")
+				}
+			}
+			return e
+		},
+		"channelLocation": func(p *textParser) TL {
+			var e TL_channelLocation
+			for p.field() {
+				switch p.fieldName {
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				case "address":
+					e.Address = p.String()
+				default:
+					p.unknownField("channelLocation")
+				}
+			}
+			return e
+		},
+		"peerLocated": func(p *textParser) TL {
+			var e TL_peerLocated
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "expires":
+					e.Expires = p.Int()
+				case "distance":
+					e.Distance = p.Int()
+				default:
+					p.unknownField("peerLocated")
+				}
+			}
+			return e
+		},
+		"peerSelfLocated": func(p *textParser) TL {
+			var e TL_peerSelfLocated
+			for p.field() {
+				switch p.fieldName {
+				case "expires":
+					e.Expires = p.Int()
+				default:
+					p.unknownField("peerSelfLocated")
+				}
+			}
+			return e
+		},
+		"restrictionReason": func(p *textParser) TL {
+			var e TL_restrictionReason
+			for p.field() {
+				switch p.fieldName {
+				case "platform":
+					e.Platform = p.String()
+				case "reason":
+					e.Reason = p.String()
+				case "text":
+					e.Text = p.String()
+				default:
+					p.unknownField("restrictionReason")
+				}
+			}
+			return e
+		},
+		"inputTheme": func(p *textParser) TL {
+			var e TL_inputTheme
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputTheme")
+				}
+			}
+			return e
+		},
+		"inputThemeSlug": func(p *textParser) TL {
+			var e TL_inputThemeSlug
+			for p.field() {
+				switch p.fieldName {
+				case "slug":
+					e.Slug = p.String()
+				default:
+					p.unknownField("inputThemeSlug")
+				}
+			}
+			return e
+		},
+		"theme": func(p *textParser) TL {
+			var e TL_theme
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "creator":
+					e.Creator = p.Bool()
+					if e.Creator {
+						e.Flags |= 1
+					}
+				case "default":
+					e.Default = p.Bool()
+					if e.Default {
+						e.Flags |= 2
+					}
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "slug":
+					e.Slug = p.String()
+				case "title":
+					e.Title = p.String()
+				case "document":
+					e.Document = p.Object()
+					e.Flags |= 4
+				case "settings":
+					e.Settings = p.Object()
+					e.Flags |= 8
+				case "installs_count":
+					e.InstallsCount = p.Int()
+				default:
+					p.unknownField("theme")
+				}
+			}
+			return e
+		},
+		"account.themesNotModified": func(p *textParser) TL {
+			var e TL_account_themesNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.themesNotModified")
+				}
+			}
+			return e
+		},
+		"account.themes": func(p *textParser) TL {
+			var e TL_account_themes
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				case "themes":
+					e.Themes = p.Vector()
+				default:
+					p.unknownField("account.themes")
+				}
+			}
+			return e
+		},
+		"auth.loginToken": func(p *textParser) TL {
+			var e TL_auth_loginToken
+			for p.field() {
+				switch p.fieldName {
+				case "expires":
+					e.Expires = p.Int()
+				case "token":
+					e.Token = p.Bytes()
+				default:
+					p.unknownField("auth.loginToken")
+				}
+			}
+			return e
+		},
+		"auth.loginTokenMigrateTo": func(p *textParser) TL {
+			var e TL_auth_loginTokenMigrateTo
+			for p.field() {
+				switch p.fieldName {
+				case "dc_id":
+					e.DcID = p.Int()
+				case "token":
+					e.Token = p.Bytes()
+				default:
+					p.unknownField("auth.loginTokenMigrateTo")
+				}
+			}
+			return e
+		},
+		"auth.loginTokenSuccess": func(p *textParser) TL {
+			var e TL_auth_loginTokenSuccess
+			for p.field() {
+				switch p.fieldName {
+				case "authorization":
+					e.Authorization = p.Object()
+				default:
+					p.unknownField("auth.loginTokenSuccess")
+				}
+			}
+			return e
+		},
+		"account.contentSettings": func(p *textParser) TL {
+			var e TL_account_contentSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "sensitive_enabled":
+					e.SensitiveEnabled = p.Bool()
+					if e.SensitiveEnabled {
+						e.Flags |= 1
+					}
+				case "sensitive_can_change":
+					e.SensitiveCanChange = p.Bool()
+					if e.SensitiveCanChange {
+						e.Flags |= 2
+					}
+				default:
+					p.unknownField("account.contentSettings")
+				}
+			}
+			return e
+		},
+		"messages.inactiveChats": func(p *textParser) TL {
+			var e TL_messages_inactiveChats
+			for p.field() {
+				switch p.fieldName {
+				case "dates":
+					e.Dates = p.VectorInt()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.inactiveChats")
+				}
+			}
+			return e
+		},
+		"baseThemeClassic": func(p *textParser) TL {
+			var e TL_baseThemeClassic
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("baseThemeClassic")
+				}
+			}
+			return e
+		},
+		"baseThemeDay": func(p *textParser) TL {
+			var e TL_baseThemeDay
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("baseThemeDay")
+				}
+			}
+			return e
+		},
+		"baseThemeNight": func(p *textParser) TL {
+			var e TL_baseThemeNight
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("baseThemeNight")
+				}
+			}
+			return e
+		},
+		"baseThemeTinted": func(p *textParser) TL {
+			var e TL_baseThemeTinted
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("baseThemeTinted")
+				}
+			}
+			return e
+		},
+		"baseThemeArctic": func(p *textParser) TL {
+			var e TL_baseThemeArctic
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("baseThemeArctic")
+				}
+			}
+			return e
+		},
+		"inputThemeSettings": func(p *textParser) TL {
+			var e TL_inputThemeSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "base_theme":
+					e.BaseTheme = p.Object()
+				case "accent_color":
+					e.AccentColor = p.Int()
+				case "message_top_color":
+					e.MessageTopColor = p.Int()
+					e.Flags |= 1
+				case "message_bottom_color":
+					e.MessageBottomColor = p.Int()
+					e.Flags |= 1
+				case "wallpaper":
+					e.Wallpaper = p.Object()
+					e.Flags |= 2
+				case "wallpaper_settings":
+					e.WallpaperSettings = p.Object()
+					e.Flags |= 2
+				default:
+					p.unknownField("inputThemeSettings")
+				}
+			}
+			return e
+		},
+		"themeSettings": func(p *textParser) TL {
+			var e TL_themeSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "base_theme":
+					e.BaseTheme = p.Object()
+				case "accent_color":
+					e.AccentColor = p.Int()
+				case "message_top_color":
+					e.MessageTopColor = p.Int()
+					e.Flags |= 1
+				case "message_bottom_color":
+					e.MessageBottomColor = p.Int()
+					e.Flags |= 1
+				case "wallpaper":
+					e.Wallpaper = p.Object()
+					e.Flags |= 2
+				default:
+					p.unknownField("themeSettings")
+				}
+			}
+			return e
+		},
+		"webPageAttributeTheme": func(p *textParser) TL {
+			var e TL_webPageAttributeTheme
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "documents":
+					e.Documents = p.Vector()
+					e.Flags |= 1
+				case "settings":
+					e.Settings = p.Object()
+					e.Flags |= 2
+				default:
+					p.unknownField("webPageAttributeTheme")
+				}
+			}
+			return e
+		},
+		"messageUserVote": func(p *textParser) TL {
+			var e TL_messageUserVote
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "option":
+					e.Option = p.Bytes()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("messageUserVote")
+				}
+			}
+			return e
+		},
+		"messageUserVoteInputOption": func(p *textParser) TL {
+			var e TL_messageUserVoteInputOption
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("messageUserVoteInputOption")
+				}
+			}
+			return e
+		},
+		"messageUserVoteMultiple": func(p *textParser) TL {
+			var e TL_messageUserVoteMultiple
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "options":
+					e.Options = p.VectorBytes()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("messageUserVoteMultiple")
+				}
+			}
+			return e
+		},
+		"messages.votesList": func(p *textParser) TL {
+			var e TL_messages_votesList
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "count":
+					e.Count = p.Int()
+				case "votes":
+					e.Votes = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "next_offset":
+					e.NextOffset = p.String()
+					e.Flags |= 1
+				default:
+					p.unknownField("messages.votesList")
+				}
+			}
+			return e
+		},
+		"bankCardOpenUrl": func(p *textParser) TL {
+			var e TL_bankCardOpenUrl
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "name":
+					e.Name = p.String()
+				default:
+					p.unknownField("bankCardOpenUrl")
+				}
+			}
+			return e
+		},
+		"payments.bankCardData": func(p *textParser) TL {
+			var e TL_payments_bankCardData
+			for p.field() {
+				switch p.fieldName {
+				case "title":
+					e.Title = p.String()
+				case "open_urls":
+					e.OpenUrls = p.Vector()
+				default:
+					p.unknownField("payments.bankCardData")
+				}
+			}
+			return e
+		},
+		"dialogFilter": func(p *textParser) TL {
+			var e TL_dialogFilter
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "contacts":
+					e.Contacts = p.Bool()
+					if e.Contacts {
+						e.Flags |= 1
+					}
+				case "non_contacts":
+					e.NonContacts = p.Bool()
+					if e.NonContacts {
+						e.Flags |= 2
+					}
+				case "groups":
+					e.Groups = p.Bool()
+					if e.Groups {
+						e.Flags |= 4
+					}
+				case "broadcasts":
+					e.Broadcasts = p.Bool()
+					if e.Broadcasts {
+						e.Flags |= 8
+					}
+				case "bots":
+					e.Bots = p.Bool()
+					if e.Bots {
+						e.Flags |= 16
+					}
+				case "exclude_muted":
+					e.ExcludeMuted = p.Bool()
+					if e.ExcludeMuted {
+						e.Flags |= 2048
+					}
+				case "exclude_read":
+					e.ExcludeRead = p.Bool()
+					if e.ExcludeRead {
+						e.Flags |= 4096
+					}
+				case "exclude_archived":
+					e.ExcludeArchived = p.Bool()
+					if e.ExcludeArchived {
+						e.Flags |= 8192
+					}
+				case "id":
+					e.ID = p.Int()
+				case "title":
+					e.Title = p.String()
+				case "emoticon":
+					e.Emoticon = p.String()
+					e.Flags |= 33554432
+				case "pinned_peers":
+					e.PinnedPeers = p.Vector()
+				case "include_peers":
+					e.IncludePeers = p.Vector()
+				case "exclude_peers":
+					e.ExcludePeers = p.Vector()
+				default:
+					p.unknownField("dialogFilter")
+				}
+			}
+			return e
+		},
+		"dialogFilterSuggested": func(p *textParser) TL {
+			var e TL_dialogFilterSuggested
+			for p.field() {
+				switch p.fieldName {
+				case "filter":
+					e.Filter = p.Object()
+				case "description":
+					e.Description = p.String()
+				default:
+					p.unknownField("dialogFilterSuggested")
+				}
+			}
+			return e
+		},
+		"statsDateRangeDays": func(p *textParser) TL {
+			var e TL_statsDateRangeDays
+			for p.field() {
+				switch p.fieldName {
+				case "min_date":
+					e.MinDate = p.Int()
+				case "max_date":
+					e.MaxDate = p.Int()
+				default:
+					p.unknownField("statsDateRangeDays")
+				}
+			}
+			return e
+		},
+		"statsAbsValueAndPrev": func(p *textParser) TL {
+			var e TL_statsAbsValueAndPrev
+			for p.field() {
+				switch p.fieldName {
+				case "current":
+					e.Current = p.Double()
+				case "previous":
+					e.Previous = p.Double()
+				default:
+					p.unknownField("statsAbsValueAndPrev")
+				}
+			}
+			return e
+		},
+		"statsPercentValue": func(p *textParser) TL {
+			var e TL_statsPercentValue
+			for p.field() {
+				switch p.fieldName {
+				case "part":
+					e.Part = p.Double()
+				case "total":
+					e.Total = p.Double()
+				default:
+					p.unknownField("statsPercentValue")
+				}
+			}
+			return e
+		},
+		"statsGraphAsync": func(p *textParser) TL {
+			var e TL_statsGraphAsync
+			for p.field() {
+				switch p.fieldName {
+				case "token":
+					e.Token = p.String()
+				default:
+					p.unknownField("statsGraphAsync")
+				}
+			}
+			return e
+		},
+		"statsGraphError": func(p *textParser) TL {
+			var e TL_statsGraphError
+			for p.field() {
+				switch p.fieldName {
+				case "error":
+					e.Error = p.String()
+				default:
+					p.unknownField("statsGraphError")
+				}
+			}
+			return e
+		},
+		"statsGraph": func(p *textParser) TL {
+			var e TL_statsGraph
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "json":
+					e.Json = p.Object()
+				case "zoom_token":
+					e.ZoomToken = p.String()
+					e.Flags |= 1
+				default:
+					p.unknownField("statsGraph")
+				}
+			}
+			return e
+		},
+		"messageInteractionCounters": func(p *textParser) TL {
+			var e TL_messageInteractionCounters
+			for p.field() {
+				switch p.fieldName {
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "views":
+					e.Views = p.Int()
+				case "forwards":
+					e.Forwards = p.Int()
+				default:
+					p.unknownField("messageInteractionCounters")
+				}
+			}
+			return e
+		},
+		"stats.broadcastStats": func(p *textParser) TL {
+			var e TL_stats_broadcastStats
+			for p.field() {
+				switch p.fieldName {
+				case "period":
+					e.Period = p.Object()
+				case "followers":
+					e.Followers = p.Object()
+				case "views_per_post":
+					e.ViewsPerPost = p.Object()
+				case "shares_per_post":
+					e.SharesPerPost = p.Object()
+				case "enabled_notifications":
+					e.EnabledNotifications = p.Object()
+				case "growth_graph":
+					e.GrowthGraph = p.Object()
+				case "followers_graph":
+					e.FollowersGraph = p.Object()
+				case "mute_graph":
+					e.MuteGraph = p.Object()
+				case "top_hours_graph":
+					e.TopHoursGraph = p.Object()
+				case "interactions_graph":
+					e.InteractionsGraph = p.Object()
+				case "iv_interactions_graph":
+					e.IvInteractionsGraph = p.Object()
+				case "views_by_source_graph":
+					e.ViewsBySourceGraph = p.Object()
+				case "new_followers_by_source_graph":
+					e.NewFollowersBySourceGraph = p.Object()
+				case "languages_graph":
+					e.LanguagesGraph = p.Object()
+				case "recent_message_interactions":
+					e.RecentMessageInteractions = p.Vector()
+				default:
+					p.unknownField("stats.broadcastStats")
+				}
+			}
+			return e
+		},
+		"help.promoDataEmpty": func(p *textParser) TL {
+			var e TL_help_promoDataEmpty
+			for p.field() {
+				switch p.fieldName {
+				case "expires":
+					e.Expires = p.Int()
+				default:
+					p.unknownField("help.promoDataEmpty")
+				}
+			}
+			return e
+		},
+		"help.promoData": func(p *textParser) TL {
+			var e TL_help_promoData
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "proxy":
+					e.Proxy = p.Bool()
+					if e.Proxy {
+						e.Flags |= 1
+					}
+				case "expires":
+					e.Expires = p.Int()
+				case "peer":
+					e.Peer = p.Object()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "psa_type":
+					e.PsaType = p.String()
+					e.Flags |= 2
+				case "psa_message":
+					e.PsaMessage = p.String()
+					e.Flags |= 4
+				default:
+					p.unknownField("help.promoData")
+				}
+			}
+			return e
+		},
+		"videoSize": func(p *textParser) TL {
+			var e TL_videoSize
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "type":
+					e.Type = p.String()
+				case "location":
+					e.Location = p.Object()
+				case "w":
+					e.W = p.Int()
+				case "h":
+					e.H = p.Int()
+				case "size":
+					e.Size = p.Int()
+				case "video_start_ts":
+					e.VideoStartTs = p.Double()
+					e.Flags |= 1
+				default:
+					p.unknownField("videoSize")
+				}
+			}
+			return e
+		},
+		"statsGroupTopPoster": func(p *textParser) TL {
+			var e TL_statsGroupTopPoster
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "messages":
+					e.Messages = p.Int()
+				case "avg_chars":
+					e.AvgChars = p.Int()
+				default:
+					p.unknownField("statsGroupTopPoster")
+				}
+			}
+			return e
+		},
+		"statsGroupTopAdmin": func(p *textParser) TL {
+			var e TL_statsGroupTopAdmin
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "deleted":
+					e.Deleted = p.Int()
+				case "kicked":
+					e.Kicked = p.Int()
+				case "banned":
+					e.Banned = p.Int()
+				default:
+					p.unknownField("statsGroupTopAdmin")
+				}
+			}
+			return e
+		},
+		"statsGroupTopInviter": func(p *textParser) TL {
+			var e TL_statsGroupTopInviter
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "invitations":
+					e.Invitations = p.Int()
+				default:
+					p.unknownField("statsGroupTopInviter")
+				}
+			}
+			return e
+		},
+		"stats.megagroupStats": func(p *textParser) TL {
+			var e TL_stats_megagroupStats
+			for p.field() {
+				switch p.fieldName {
+				case "period":
+					e.Period = p.Object()
+				case "members":
+					e.Members = p.Object()
+				case "messages":
+					e.Messages = p.Object()
+				case "viewers":
+					e.Viewers = p.Object()
+				case "posters":
+					e.Posters = p.Object()
+				case "growth_graph":
+					e.GrowthGraph = p.Object()
+				case "members_graph":
+					e.MembersGraph = p.Object()
+				case "new_members_by_source_graph":
+					e.NewMembersBySourceGraph = p.Object()
+				case "languages_graph":
+					e.LanguagesGraph = p.Object()
+				case "messages_graph":
+					e.MessagesGraph = p.Object()
+				case "actions_graph":
+					e.ActionsGraph = p.Object()
+				case "top_hours_graph":
+					e.TopHoursGraph = p.Object()
+				case "weekdays_graph":
+					e.WeekdaysGraph = p.Object()
+				case "top_posters":
+					e.TopPosters = p.Vector()
+				case "top_admins":
+					e.TopAdmins = p.Vector()
+				case "top_inviters":
+					e.TopInviters = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("stats.megagroupStats")
+				}
+			}
+			return e
+		},
+		"globalPrivacySettings": func(p *textParser) TL {
+			var e TL_globalPrivacySettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "archive_and_mute_new_noncontact_peers":
+					e.ArchiveAndMuteNewNoncontactPeers = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("globalPrivacySettings")
+				}
+			}
+			return e
+		},
+		"help.countryCode": func(p *textParser) TL {
+			var e TL_help_countryCode
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "country_code":
+					e.CountryCode = p.String()
+				case "prefixes":
+					e.Prefixes = p.VectorString()
+					e.Flags |= 1
+				case "patterns":
+					e.Patterns = p.VectorString()
+					e.Flags |= 2
+				default:
+					p.unknownField("help.countryCode")
+				}
+			}
+			return e
+		},
+		"help.country": func(p *textParser) TL {
+			var e TL_help_country
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "hidden":
+					e.Hidden = p.Bool()
+					if e.Hidden {
+						e.Flags |= 1
+					}
+				case "iso2":
+					e.Iso2 = p.String()
+				case "default_name":
+					e.DefaultName = p.String()
+				case "name":
+					e.Name = p.String()
+					e.Flags |= 2
+				case "country_codes":
+					e.CountryCodes = p.Vector()
+				default:
+					p.unknownField("help.country")
+				}
+			}
+			return e
+		},
+		"help.countriesListNotModified": func(p *textParser) TL {
+			var e TL_help_countriesListNotModified
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.countriesListNotModified")
+				}
+			}
+			return e
+		},
+		"help.countriesList": func(p *textParser) TL {
+			var e TL_help_countriesList
+			for p.field() {
+				switch p.fieldName {
+				case "countries":
+					e.Countries = p.Vector()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("help.countriesList")
+				}
+			}
+			return e
+		},
+		"messageViews": func(p *textParser) TL {
+			var e TL_messageViews
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "views":
+					e.Views = p.Int()
+					e.Flags |= 1
+				case "forwards":
+					e.Forwards = p.Int()
+					e.Flags |= 2
+				case "replies":
+					e.Replies = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("messageViews")
+				}
+			}
+			return e
+		},
+		"messages.messageViews": func(p *textParser) TL {
+			var e TL_messages_messageViews
+			for p.field() {
+				switch p.fieldName {
+				case "views":
+					e.Views = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.messageViews")
+				}
+			}
+			return e
+		},
+		"messages.discussionMessage": func(p *textParser) TL {
+			var e TL_messages_discussionMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "messages":
+					e.Messages = p.Vector()
+				case "max_id":
+					e.MaxID = p.Int()
+					e.Flags |= 1
+				case "read_inbox_max_id":
+					e.ReadInboxMaxID = p.Int()
+					e.Flags |= 2
+				case "read_outbox_max_id":
+					e.ReadOutboxMaxID = p.Int()
+					e.Flags |= 4
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.discussionMessage")
+				}
+			}
+			return e
+		},
+		"messageReplyHeader": func(p *textParser) TL {
+			var e TL_messageReplyHeader
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "reply_to_msg_id":
+					e.ReplyToMsgID = p.Int()
+				case "reply_to_peer_id":
+					e.ReplyToPeerID = p.Object()
+					e.Flags |= 1
+				case "reply_to_top_id":
+					e.ReplyToTopID = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("messageReplyHeader")
+				}
+			}
+			return e
+		},
+		"messageReplies": func(p *textParser) TL {
+			var e TL_messageReplies
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "comments":
+					e.Comments = p.Bool()
+					if e.Comments {
+						e.Flags |= 1
+					}
+				case "replies":
+					e.Replies = p.Int()
+				case "replies_pts":
+					e.RepliesPts = p.Int()
+				case "recent_repliers":
+					e.RecentRepliers = p.Vector()
+					e.Flags |= 2
+				case "channel_id":
+					e.ChannelID = p.Int()
+					e.Flags |= 1
+				case "max_id":
+					e.MaxID = p.Int()
+					e.Flags |= 4
+				case "read_max_id":
+					e.ReadMaxID = p.Int()
+					e.Flags |= 8
+				default:
+					p.unknownField("messageReplies")
+				}
+			}
+			return e
+		},
+		"peerBlocked": func(p *textParser) TL {
+			var e TL_peerBlocked
+			for p.field() {
+				switch p.fieldName {
+				case "peer_id":
+					e.PeerID = p.Object()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("peerBlocked")
+				}
+			}
+			return e
+		},
+		"stats.messageStats": func(p *textParser) TL {
+			var e TL_stats_messageStats
+			for p.field() {
+				switch p.fieldName {
+				case "views_graph":
+					e.ViewsGraph = p.Object()
+				default:
+					p.unknownField("stats.messageStats")
+				}
+			}
+			return e
+		},
+		"groupCallDiscarded": func(p *textParser) TL {
+			var e TL_groupCallDiscarded
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "duration":
+					e.Duration = p.Int()
+				default:
+					p.unknownField("groupCallDiscarded")
+				}
+			}
+			return e
+		},
+		"groupCall": func(p *textParser) TL {
+			var e TL_groupCall
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "join_muted":
+					e.JoinMuted = p.Bool()
+					if e.JoinMuted {
+						e.Flags |= 2
+					}
+				case "can_change_join_muted":
+					e.CanChangeJoinMuted = p.Bool()
+					if e.CanChangeJoinMuted {
+						e.Flags |= 4
+					}
+				case "join_date_asc":
+					e.JoinDateAsc = p.Bool()
+					if e.JoinDateAsc {
+						e.Flags |= 64
+					}
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				case "participants_count":
+					e.ParticipantsCount = p.Int()
+				case "params":
+					e.Params = p.Object()
+					e.Flags |= 1
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 8
+				case "stream_dc_id":
+					e.StreamDcID = p.Int()
+					e.Flags |= 16
+				case "record_start_date":
+					e.RecordStartDate = p.Int()
+					e.Flags |= 32
+				case "version":
+					e.Version = p.Int()
+				default:
+					p.unknownField("groupCall")
+				}
+			}
+			return e
+		},
+		"inputGroupCall": func(p *textParser) TL {
+			var e TL_inputGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				case "access_hash":
+					e.AccessHash = p.Long()
+				default:
+					p.unknownField("inputGroupCall")
+				}
+			}
+			return e
+		},
+		"groupCallParticipant": func(p *textParser) TL {
+			var e TL_groupCallParticipant
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "muted":
+					e.Muted = p.Bool()
+					if e.Muted {
+						e.Flags |= 1
+					}
+				case "left":
+					e.Left = p.Bool()
+					if e.Left {
+						e.Flags |= 2
+					}
+				case "can_self_unmute":
+					e.CanSelfUnmute = p.Bool()
+					if e.CanSelfUnmute {
+						e.Flags |= 4
+					}
+				case "just_joined":
+					e.JustJoined = p.Bool()
+					if e.JustJoined {
+						e.Flags |= 16
+					}
+				case "versioned":
+					e.Versioned = p.Bool()
+					if e.Versioned {
+						e.Flags |= 32
+					}
+				case "min":
+					e.Min = p.Bool()
+					if e.Min {
+						e.Flags |= 256
+					}
+				case "muted_by_you":
+					e.MutedByYou = p.Bool()
+					if e.MutedByYou {
+						e.Flags |= 512
+					}
+				case "volume_by_admin":
+					e.VolumeByAdmin = p.Bool()
+					if e.VolumeByAdmin {
+						e.Flags |= 1024
+					}
+				case "self":
+					e.Self = p.Bool()
+					if e.Self {
+						e.Flags |= 4096
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "date":
+					e.Date = p.Int()
+				case "active_date":
+					e.ActiveDate = p.Int()
+					e.Flags |= 8
+				case "source":
+					e.Source = p.Int()
+				case "volume":
+					e.Volume = p.Int()
+					e.Flags |= 128
+				case "about":
+					e.About = p.String()
+					e.Flags |= 2048
+				case "raise_hand_rating":
+					e.RaiseHandRating = p.Long()
+					e.Flags |= 8192
+				default:
+					p.unknownField("groupCallParticipant")
+				}
+			}
+			return e
+		},
+		"phone.groupCall": func(p *textParser) TL {
+			var e TL_phone_groupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "participants":
+					e.Participants = p.Vector()
+				case "participants_next_offset":
+					e.ParticipantsNextOffset = p.String()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("phone.groupCall")
+				}
+			}
+			return e
+		},
+		"phone.groupParticipants": func(p *textParser) TL {
+			var e TL_phone_groupParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "participants":
+					e.Participants = p.Vector()
+				case "next_offset":
+					e.NextOffset = p.String()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				case "version":
+					e.Version = p.Int()
+				default:
+					p.unknownField("phone.groupParticipants")
+				}
+			}
+			return e
+		},
+		"inlineQueryPeerTypeSameBotPM": func(p *textParser) TL {
+			var e TL_inlineQueryPeerTypeSameBotPM
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inlineQueryPeerTypeSameBotPM")
+				}
+			}
+			return e
+		},
+		"inlineQueryPeerTypePM": func(p *textParser) TL {
+			var e TL_inlineQueryPeerTypePM
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inlineQueryPeerTypePM")
+				}
+			}
+			return e
+		},
+		"inlineQueryPeerTypeChat": func(p *textParser) TL {
+			var e TL_inlineQueryPeerTypeChat
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inlineQueryPeerTypeChat")
+				}
+			}
+			return e
+		},
+		"inlineQueryPeerTypeMegagroup": func(p *textParser) TL {
+			var e TL_inlineQueryPeerTypeMegagroup
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inlineQueryPeerTypeMegagroup")
+				}
+			}
+			return e
+		},
+		"inlineQueryPeerTypeBroadcast": func(p *textParser) TL {
+			var e TL_inlineQueryPeerTypeBroadcast
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("inlineQueryPeerTypeBroadcast")
+				}
+			}
+			return e
+		},
+		"messages.historyImport": func(p *textParser) TL {
+			var e TL_messages_historyImport
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Long()
+				default:
+					p.unknownField("messages.historyImport")
+				}
+			}
+			return e
+		},
+		"messages.historyImportParsed": func(p *textParser) TL {
+			var e TL_messages_historyImportParsed
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "pm":
+					e.Pm = p.Bool()
+					if e.Pm {
+						e.Flags |= 1
+					}
+				case "group":
+					e.Group = p.Bool()
+					if e.Group {
+						e.Flags |= 2
+					}
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 4
+				default:
+					p.unknownField("messages.historyImportParsed")
+				}
+			}
+			return e
+		},
+		"messages.affectedFoundMessages": func(p *textParser) TL {
+			var e TL_messages_affectedFoundMessages
+			for p.field() {
+				switch p.fieldName {
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_count":
+					e.PtsCount = p.Int()
+				case "offset":
+					e.Offset = p.Int()
+				case "messages":
+					e.Messages = p.VectorInt()
+				default:
+					p.unknownField("messages.affectedFoundMessages")
+				}
+			}
+			return e
+		},
+		"chatInviteImporter": func(p *textParser) TL {
+			var e TL_chatInviteImporter
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Int()
+				case "date":
+					e.Date = p.Int()
+				default:
+					p.unknownField("chatInviteImporter")
+				}
+			}
+			return e
+		},
+		"messages.exportedChatInvites": func(p *textParser) TL {
+			var e TL_messages_exportedChatInvites
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "invites":
+					e.Invites = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.exportedChatInvites")
+				}
+			}
+			return e
+		},
+		"messages.exportedChatInvite": func(p *textParser) TL {
+			var e TL_messages_exportedChatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "invite":
+					e.Invite = p.Object()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.exportedChatInvite")
+				}
+			}
+			return e
+		},
+		"messages.exportedChatInviteReplaced": func(p *textParser) TL {
+			var e TL_messages_exportedChatInviteReplaced
+			for p.field() {
+				switch p.fieldName {
+				case "invite":
+					e.Invite = p.Object()
+				case "new_invite":
+					e.NewInvite = p.Object()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.exportedChatInviteReplaced")
+				}
+			}
+			return e
+		},
+		"messages.chatInviteImporters": func(p *textParser) TL {
+			var e TL_messages_chatInviteImporters
+			for p.field() {
+				switch p.fieldName {
+				case "count":
+					e.Count = p.Int()
+				case "importers":
+					e.Importers = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.chatInviteImporters")
+				}
+			}
+			return e
+		},
+		"chatAdminWithInvites": func(p *textParser) TL {
+			var e TL_chatAdminWithInvites
+			for p.field() {
+				switch p.fieldName {
+				case "admin_id":
+					e.AdminID = p.Int()
+				case "invites_count":
+					e.InvitesCount = p.Int()
+				case "revoked_invites_count":
+					e.RevokedInvitesCount = p.Int()
+				default:
+					p.unknownField("chatAdminWithInvites")
+				}
+			}
+			return e
+		},
+		"messages.chatAdminsWithInvites": func(p *textParser) TL {
+			var e TL_messages_chatAdminsWithInvites
+			for p.field() {
+				switch p.fieldName {
+				case "admins":
+					e.Admins = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("messages.chatAdminsWithInvites")
+				}
+			}
+			return e
+		},
+		"messages.checkedHistoryImportPeer": func(p *textParser) TL {
+			var e TL_messages_checkedHistoryImportPeer
+			for p.field() {
+				switch p.fieldName {
+				case "confirm_text":
+					e.ConfirmText = p.String()
+				default:
+					p.unknownField("messages.checkedHistoryImportPeer")
+				}
+			}
+			return e
+		},
+		"phone.joinAsPeers": func(p *textParser) TL {
+			var e TL_phone_joinAsPeers
+			for p.field() {
+				switch p.fieldName {
+				case "peers":
+					e.Peers = p.Vector()
+				case "chats":
+					e.Chats = p.Vector()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("phone.joinAsPeers")
+				}
+			}
+			return e
+		},
+		"phone.exportedGroupCallInvite": func(p *textParser) TL {
+			var e TL_phone_exportedGroupCallInvite
+			for p.field() {
+				switch p.fieldName {
+				case "link":
+					e.Link = p.String()
+				default:
+					p.unknownField("phone.exportedGroupCallInvite")
+				}
+			}
+			return e
+		},
+		"invokeAfterMsg": func(p *textParser) TL {
+			var e TL_invokeAfterMsg
+			for p.field() {
+				switch p.fieldName {
+				case "msg_id":
+					e.MsgID = p.Long()
+				case "query":
+					e.Query = p.Object()
+				default:
+					p.unknownField("invokeAfterMsg")
+				}
+			}
+			return e
+		},
+		"invokeAfterMsgs": func(p *textParser) TL {
+			var e TL_invokeAfterMsgs
+			for p.field() {
+				switch p.fieldName {
+				case "msg_ids":
+					e.MsgIds = p.VectorLong()
+				case "query":
+					e.Query = p.Object()
+				default:
+					p.unknownField("invokeAfterMsgs")
+				}
+			}
+			return e
+		},
+		"initConnection": func(p *textParser) TL {
+			var e TL_initConnection
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "api_id":
+					e.ApiID = p.Int()
+				case "device_model":
+					e.DeviceModel = p.String()
+				case "system_version":
+					e.SystemVersion = p.String()
+				case "app_version":
+					e.AppVersion = p.String()
+				case "system_lang_code":
+					e.SystemLangCode = p.String()
+				case "lang_pack":
+					e.LangPack = p.String()
+				case "lang_code":
+					e.LangCode = p.String()
+				case "proxy":
+					e.Proxy = p.Object()
+					e.Flags |= 1
+				case "params":
+					e.Params = p.Object()
+					e.Flags |= 2
+				case "query":
+					e.Query = p.Object()
+				default:
+					p.unknownField("initConnection")
+				}
+			}
+			return e
+		},
+		"invokeWithLayer": func(p *textParser) TL {
+			var e TL_invokeWithLayer
+			for p.field() {
+				switch p.fieldName {
+				case "layer":
+					e.Layer = p.Int()
+				case "query":
+					e.Query = p.Object()
+				default:
+					p.unknownField("invokeWithLayer")
+				}
+			}
+			return e
+		},
+		"invokeWithoutUpdates": func(p *textParser) TL {
+			var e TL_invokeWithoutUpdates
+			for p.field() {
+				switch p.fieldName {
+				case "query":
+					e.Query = p.Object()
+				default:
+					p.unknownField("invokeWithoutUpdates")
+				}
+			}
+			return e
+		},
+		"invokeWithMessagesRange": func(p *textParser) TL {
+			var e TL_invokeWithMessagesRange
+			for p.field() {
+				switch p.fieldName {
+				case "range":
+					e.Range = p.Object()
+				case "query":
+					e.Query = p.Object()
+				default:
+					p.unknownField("invokeWithMessagesRange")
+				}
+			}
+			return e
+		},
+		"invokeWithTakeout": func(p *textParser) TL {
+			var e TL_invokeWithTakeout
+			for p.field() {
+				switch p.fieldName {
+				case "takeout_id":
+					e.TakeoutID = p.Long()
+				case "query":
+					e.Query = p.Object()
+				default:
+					p.unknownField("invokeWithTakeout")
+				}
+			}
+			return e
+		},
+		"auth.sendCode": func(p *textParser) TL {
+			var e TL_auth_sendCode
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "api_id":
+					e.ApiID = p.Int()
+				case "api_hash":
+					e.ApiHash = p.String()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("auth.sendCode")
+				}
+			}
+			return e
+		},
+		"auth.signUp": func(p *textParser) TL {
+			var e TL_auth_signUp
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "phone_code_hash":
+					e.PhoneCodeHash = p.String()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				default:
+					p.unknownField("auth.signUp")
+				}
+			}
+			return e
+		},
+		"auth.signIn": func(p *textParser) TL {
+			var e TL_auth_signIn
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "phone_code_hash":
+					e.PhoneCodeHash = p.String()
+				case "phone_code":
+					e.PhoneCode = p.String()
+				default:
+					p.unknownField("auth.signIn")
+				}
+			}
+			return e
+		},
+		"auth.logOut": func(p *textParser) TL {
+			var e TL_auth_logOut
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("auth.logOut")
+				}
+			}
+			return e
+		},
+		"auth.resetAuthorizations": func(p *textParser) TL {
+			var e TL_auth_resetAuthorizations
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("auth.resetAuthorizations")
+				}
+			}
+			return e
+		},
+		"auth.exportAuthorization": func(p *textParser) TL {
+			var e TL_auth_exportAuthorization
+			for p.field() {
+				switch p.fieldName {
+				case "dc_id":
+					e.DcID = p.Int()
+				default:
+					p.unknownField("auth.exportAuthorization")
+				}
+			}
+			return e
+		},
+		"auth.importAuthorization": func(p *textParser) TL {
+			var e TL_auth_importAuthorization
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("auth.importAuthorization")
+				}
+			}
+			return e
+		},
+		"auth.bindTempAuthKey": func(p *textParser) TL {
+			var e TL_auth_bindTempAuthKey
+			for p.field() {
+				switch p.fieldName {
+				case "perm_auth_key_id":
+					e.PermAuthKeyID = p.Long()
+				case "nonce":
+					e.Nonce = p.Long()
+				case "expires_at":
+					e.ExpiresAt = p.Int()
+				case "encrypted_message":
+					e.EncryptedMessage = p.Bytes()
+				default:
+					p.unknownField("auth.bindTempAuthKey")
+				}
+			}
+			return e
+		},
+		"auth.importBotAuthorization": func(p *textParser) TL {
+			var e TL_auth_importBotAuthorization
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags = p.Int()
+				case "api_id":
+					e.ApiID = p.Int()
+				case "api_hash":
+					e.ApiHash = p.String()
+				case "bot_auth_token":
+					e.BotAuthToken = p.String()
+				default:
+					p.unknownField("auth.importBotAuthorization")
+				}
+			}
+			return e
+		},
+		"auth.checkPassword": func(p *textParser) TL {
+			var e TL_auth_checkPassword
+			for p.field() {
+				switch p.fieldName {
+				case "password":
+					e.Password = p.Object()
+				default:
+					p.unknownField("auth.checkPassword")
+				}
+			}
+			return e
+		},
+		"auth.requestPasswordRecovery": func(p *textParser) TL {
+			var e TL_auth_requestPasswordRecovery
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("auth.requestPasswordRecovery")
+				}
+			}
+			return e
+		},
+		"auth.recoverPassword": func(p *textParser) TL {
+			var e TL_auth_recoverPassword
+			for p.field() {
+				switch p.fieldName {
+				case "code":
+					e.Code = p.String()
+				default:
+					p.unknownField("auth.recoverPassword")
+				}
+			}
+			return e
+		},
+		"auth.resendCode": func(p *textParser) TL {
+			var e TL_auth_resendCode
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "phone_code_hash":
+					e.PhoneCodeHash = p.String()
+				default:
+					p.unknownField("auth.resendCode")
+				}
+			}
+			return e
+		},
+		"auth.cancelCode": func(p *textParser) TL {
+			var e TL_auth_cancelCode
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "phone_code_hash":
+					e.PhoneCodeHash = p.String()
+				default:
+					p.unknownField("auth.cancelCode")
+				}
+			}
+			return e
+		},
+		"auth.dropTempAuthKeys": func(p *textParser) TL {
+			var e TL_auth_dropTempAuthKeys
+			for p.field() {
+				switch p.fieldName {
+				case "except_auth_keys":
+					e.ExceptAuthKeys = p.VectorLong()
+				default:
+					p.unknownField("auth.dropTempAuthKeys")
+				}
+			}
+			return e
+		},
+		"auth.exportLoginToken": func(p *textParser) TL {
+			var e TL_auth_exportLoginToken
+			for p.field() {
+				switch p.fieldName {
+				case "api_id":
+					e.ApiID = p.Int()
+				case "api_hash":
+					e.ApiHash = p.String()
+				case "except_ids":
+					e.ExceptIds = p.VectorInt()
+				default:
+					p.unknownField("auth.exportLoginToken")
+				}
+			}
+			return e
+		},
+		"auth.importLoginToken": func(p *textParser) TL {
+			var e TL_auth_importLoginToken
+			for p.field() {
+				switch p.fieldName {
+				case "token":
+					e.Token = p.Bytes()
+				default:
+					p.unknownField("auth.importLoginToken")
+				}
+			}
+			return e
+		},
+		"auth.acceptLoginToken": func(p *textParser) TL {
+			var e TL_auth_acceptLoginToken
+			for p.field() {
+				switch p.fieldName {
+				case "token":
+					e.Token = p.Bytes()
+				default:
+					p.unknownField("auth.acceptLoginToken")
+				}
+			}
+			return e
+		},
+		"account.registerDevice": func(p *textParser) TL {
+			var e TL_account_registerDevice
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "no_muted":
+					e.NoMuted = p.Bool()
+					if e.NoMuted {
+						e.Flags |= 1
+					}
+				case "token_type":
+					e.TokenType = p.Int()
+				case "token":
+					e.Token = p.String()
+				case "app_sandbox":
+					e.AppSandbox = p.Object()
+				case "secret":
+					e.Secret = p.Bytes()
+				case "other_uids":
+					e.OtherUids = p.VectorInt()
+				default:
+					p.unknownField("account.registerDevice")
+				}
+			}
+			return e
+		},
+		"account.unregisterDevice": func(p *textParser) TL {
+			var e TL_account_unregisterDevice
+			for p.field() {
+				switch p.fieldName {
+				case "token_type":
+					e.TokenType = p.Int()
+				case "token":
+					e.Token = p.String()
+				case "other_uids":
+					e.OtherUids = p.VectorInt()
+				default:
+					p.unknownField("account.unregisterDevice")
+				}
+			}
+			return e
+		},
+		"account.updateNotifySettings": func(p *textParser) TL {
+			var e TL_account_updateNotifySettings
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.updateNotifySettings")
+				}
+			}
+			return e
+		},
+		"account.getNotifySettings": func(p *textParser) TL {
+			var e TL_account_getNotifySettings
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("account.getNotifySettings")
+				}
+			}
+			return e
+		},
+		"account.resetNotifySettings": func(p *textParser) TL {
+			var e TL_account_resetNotifySettings
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.resetNotifySettings")
+				}
+			}
+			return e
+		},
+		"account.updateProfile": func(p *textParser) TL {
+			var e TL_account_updateProfile
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "first_name":
+					e.FirstName = p.String()
+					e.Flags |= 1
+				case "last_name":
+					e.LastName = p.String()
+					e.Flags |= 2
+				case "about":
+					e.About = p.String()
+					e.Flags |= 4
+				default:
+					p.unknownField("account.updateProfile")
+				}
+			}
+			return e
+		},
+		"account.updateStatus": func(p *textParser) TL {
+			var e TL_account_updateStatus
+			for p.field() {
+				switch p.fieldName {
+				case "offline":
+					e.Offline = p.Object()
+				default:
+					p.unknownField("account.updateStatus")
+				}
+			}
+			return e
+		},
+		"account.getWallPapers": func(p *textParser) TL {
+			var e TL_account_getWallPapers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("account.getWallPapers")
+				}
+			}
+			return e
+		},
+		"account.reportPeer": func(p *textParser) TL {
+			var e TL_account_reportPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "reason":
+					e.Reason = p.Object()
+				case "message":
+					e.Message = p.String()
+				default:
+					p.unknownField("account.reportPeer")
+				}
+			}
+			return e
+		},
+		"account.checkUsername": func(p *textParser) TL {
+			var e TL_account_checkUsername
+			for p.field() {
+				switch p.fieldName {
+				case "username":
+					e.Username = p.String()
+				default:
+					p.unknownField("account.checkUsername")
+				}
+			}
+			return e
+		},
+		"account.updateUsername": func(p *textParser) TL {
+			var e TL_account_updateUsername
+			for p.field() {
+				switch p.fieldName {
+				case "username":
+					e.Username = p.String()
+				default:
+					p.unknownField("account.updateUsername")
+				}
+			}
+			return e
+		},
+		"account.getPrivacy": func(p *textParser) TL {
+			var e TL_account_getPrivacy
+			for p.field() {
+				switch p.fieldName {
+				case "key":
+					e.Key = p.Object()
+				default:
+					p.unknownField("account.getPrivacy")
+				}
+			}
+			return e
+		},
+		"account.setPrivacy": func(p *textParser) TL {
+			var e TL_account_setPrivacy
+			for p.field() {
+				switch p.fieldName {
+				case "key":
+					e.Key = p.Object()
+				case "rules":
+					e.Rules = p.Vector()
+				default:
+					p.unknownField("account.setPrivacy")
+				}
+			}
+			return e
+		},
+		"account.deleteAccount": func(p *textParser) TL {
+			var e TL_account_deleteAccount
+			for p.field() {
+				switch p.fieldName {
+				case "reason":
+					e.Reason = p.String()
+				default:
+					p.unknownField("account.deleteAccount")
+				}
+			}
+			return e
+		},
+		"account.getAccountTTL": func(p *textParser) TL {
+			var e TL_account_getAccountTTL
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getAccountTTL")
+				}
+			}
+			return e
+		},
+		"account.setAccountTTL": func(p *textParser) TL {
+			var e TL_account_setAccountTTL
+			for p.field() {
+				switch p.fieldName {
+				case "ttl":
+					e.Ttl = p.Object()
+				default:
+					p.unknownField("account.setAccountTTL")
+				}
+			}
+			return e
+		},
+		"account.sendChangePhoneCode": func(p *textParser) TL {
+			var e TL_account_sendChangePhoneCode
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.sendChangePhoneCode")
+				}
+			}
+			return e
+		},
+		"account.changePhone": func(p *textParser) TL {
+			var e TL_account_changePhone
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "phone_code_hash":
+					e.PhoneCodeHash = p.String()
+				case "phone_code":
+					e.PhoneCode = p.String()
+				default:
+					p.unknownField("account.changePhone")
+				}
+			}
+			return e
+		},
+		"account.updateDeviceLocked": func(p *textParser) TL {
+			var e TL_account_updateDeviceLocked
+			for p.field() {
+				switch p.fieldName {
+				case "period":
+					e.Period = p.Int()
+				default:
+					p.unknownField("account.updateDeviceLocked")
+				}
+			}
+			return e
+		},
+		"account.getAuthorizations": func(p *textParser) TL {
+			var e TL_account_getAuthorizations
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getAuthorizations")
+				}
+			}
+			return e
+		},
+		"account.resetAuthorization": func(p *textParser) TL {
+			var e TL_account_resetAuthorization
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Long()
+				default:
+					p.unknownField("account.resetAuthorization")
+				}
+			}
+			return e
+		},
+		"account.getPassword": func(p *textParser) TL {
+			var e TL_account_getPassword
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getPassword")
+				}
+			}
+			return e
+		},
+		"account.getPasswordSettings": func(p *textParser) TL {
+			var e TL_account_getPasswordSettings
+			for p.field() {
+				switch p.fieldName {
+				case "password":
+					e.Password = p.Object()
+				default:
+					p.unknownField("account.getPasswordSettings")
+				}
+			}
+			return e
+		},
+		"account.updatePasswordSettings": func(p *textParser) TL {
+			var e TL_account_updatePasswordSettings
+			for p.field() {
+				switch p.fieldName {
+				case "password":
+					e.Password = p.Object()
+				case "new_settings":
+					e.NewSettings = p.Object()
+				default:
+					p.unknownField("account.updatePasswordSettings")
+				}
+			}
+			return e
+		},
+		"account.sendConfirmPhoneCode": func(p *textParser) TL {
+			var e TL_account_sendConfirmPhoneCode
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.String()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.sendConfirmPhoneCode")
+				}
+			}
+			return e
+		},
+		"account.confirmPhone": func(p *textParser) TL {
+			var e TL_account_confirmPhone
+			for p.field() {
+				switch p.fieldName {
+				case "phone_code_hash":
+					e.PhoneCodeHash = p.String()
+				case "phone_code":
+					e.PhoneCode = p.String()
+				default:
+					p.unknownField("account.confirmPhone")
+				}
+			}
+			return e
+		},
+		"account.getTmpPassword": func(p *textParser) TL {
+			var e TL_account_getTmpPassword
+			for p.field() {
+				switch p.fieldName {
+				case "password":
+					e.Password = p.Object()
+				case "period":
+					e.Period = p.Int()
+				default:
+					p.unknownField("account.getTmpPassword")
+				}
+			}
+			return e
+		},
+		"account.getWebAuthorizations": func(p *textParser) TL {
+			var e TL_account_getWebAuthorizations
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getWebAuthorizations")
+				}
+			}
+			return e
+		},
+		"account.resetWebAuthorization": func(p *textParser) TL {
+			var e TL_account_resetWebAuthorization
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Long()
+				default:
+					p.unknownField("account.resetWebAuthorization")
+				}
+			}
+			return e
+		},
+		"account.resetWebAuthorizations": func(p *textParser) TL {
+			var e TL_account_resetWebAuthorizations
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.resetWebAuthorizations")
+				}
+			}
+			return e
+		},
+		"account.getAllSecureValues": func(p *textParser) TL {
+			var e TL_account_getAllSecureValues
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getAllSecureValues")
+				}
+			}
+			return e
+		},
+		"account.getSecureValue": func(p *textParser) TL {
+			var e TL_account_getSecureValue
+			for p.field() {
+				switch p.fieldName {
+				case "types":
+					e.Types = p.Vector()
+				default:
+					p.unknownField("account.getSecureValue")
+				}
+			}
+			return e
+		},
+		"account.saveSecureValue": func(p *textParser) TL {
+			var e TL_account_saveSecureValue
+			for p.field() {
+				switch p.fieldName {
+				case "value":
+					e.Value = p.Object()
+				case "secure_secret_id":
+					e.SecureSecretID = p.Long()
+				default:
+					p.unknownField("account.saveSecureValue")
+				}
+			}
+			return e
+		},
+		"account.deleteSecureValue": func(p *textParser) TL {
+			var e TL_account_deleteSecureValue
+			for p.field() {
+				switch p.fieldName {
+				case "types":
+					e.Types = p.Vector()
+				default:
+					p.unknownField("account.deleteSecureValue")
+				}
+			}
+			return e
+		},
+		"account.getAuthorizationForm": func(p *textParser) TL {
+			var e TL_account_getAuthorizationForm
+			for p.field() {
+				switch p.fieldName {
+				case "bot_id":
+					e.BotID = p.Int()
+				case "scope":
+					e.Scope = p.String()
+				case "public_key":
+					e.PublicKey = p.String()
+				default:
+					p.unknownField("account.getAuthorizationForm")
+				}
+			}
+			return e
+		},
+		"account.acceptAuthorization": func(p *textParser) TL {
+			var e TL_account_acceptAuthorization
+			for p.field() {
+				switch p.fieldName {
+				case "bot_id":
+					e.BotID = p.Int()
+				case "scope":
+					e.Scope = p.String()
+				case "public_key":
+					e.PublicKey = p.String()
+				case "value_hashes":
+					e.ValueHashes = p.Vector()
+				case "credentials":
+					e.Credentials = p.Object()
+				default:
+					p.unknownField("account.acceptAuthorization")
+				}
+			}
+			return e
+		},
+		"account.sendVerifyPhoneCode": func(p *textParser) TL {
+			var e TL_account_sendVerifyPhoneCode
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.sendVerifyPhoneCode")
+				}
+			}
+			return e
+		},
+		"account.verifyPhone": func(p *textParser) TL {
+			var e TL_account_verifyPhone
+			for p.field() {
+				switch p.fieldName {
+				case "phone_number":
+					e.PhoneNumber = p.String()
+				case "phone_code_hash":
+					e.PhoneCodeHash = p.String()
+				case "phone_code":
+					e.PhoneCode = p.String()
+				default:
+					p.unknownField("account.verifyPhone")
+				}
+			}
+			return e
+		},
+		"account.sendVerifyEmailCode": func(p *textParser) TL {
+			var e TL_account_sendVerifyEmailCode
+			for p.field() {
+				switch p.fieldName {
+				case "email":
+					e.Email = p.String()
+				default:
+					p.unknownField("account.sendVerifyEmailCode")
+				}
+			}
+			return e
+		},
+		"account.verifyEmail": func(p *textParser) TL {
+			var e TL_account_verifyEmail
+			for p.field() {
+				switch p.fieldName {
+				case "email":
+					e.Email = p.String()
+				case "code":
+					e.Code = p.String()
+				default:
+					p.unknownField("account.verifyEmail")
+				}
+			}
+			return e
+		},
+		"account.initTakeoutSession": func(p *textParser) TL {
+			var e TL_account_initTakeoutSession
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "contacts":
+					e.Contacts = p.Bool()
+					if e.Contacts {
+						e.Flags |= 1
+					}
+				case "message_users":
+					e.MessageUsers = p.Bool()
+					if e.MessageUsers {
+						e.Flags |= 2
+					}
+				case "message_chats":
+					e.MessageChats = p.Bool()
+					if e.MessageChats {
+						e.Flags |= 4
+					}
+				case "message_megagroups":
+					e.MessageMegagroups = p.Bool()
+					if e.MessageMegagroups {
+						e.Flags |= 8
+					}
+				case "message_channels":
+					e.MessageChannels = p.Bool()
+					if e.MessageChannels {
+						e.Flags |= 16
+					}
+				case "files":
+					e.Files = p.Bool()
+					if e.Files {
+						e.Flags |= 32
+					}
+				case "file_max_size":
+					e.FileMaxSize = p.Int()
+					e.Flags |= 32
+				default:
+					p.unknownField("account.initTakeoutSession")
+				}
+			}
+			return e
+		},
+		"account.finishTakeoutSession": func(p *textParser) TL {
+			var e TL_account_finishTakeoutSession
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "success":
+					e.Success = p.Bool()
+					if e.Success {
+						e.Flags |= 1
+					}
+				default:
+					p.unknownField("account.finishTakeoutSession")
+				}
+			}
+			return e
+		},
+		"account.confirmPasswordEmail": func(p *textParser) TL {
+			var e TL_account_confirmPasswordEmail
+			for p.field() {
+				switch p.fieldName {
+				case "code":
+					e.Code = p.String()
+				default:
+					p.unknownField("account.confirmPasswordEmail")
+				}
+			}
+			return e
+		},
+		"account.resendPasswordEmail": func(p *textParser) TL {
+			var e TL_account_resendPasswordEmail
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.resendPasswordEmail")
+				}
+			}
+			return e
+		},
+		"account.cancelPasswordEmail": func(p *textParser) TL {
+			var e TL_account_cancelPasswordEmail
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.cancelPasswordEmail")
+				}
+			}
+			return e
+		},
+		"account.getContactSignUpNotification": func(p *textParser) TL {
+			var e TL_account_getContactSignUpNotification
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getContactSignUpNotification")
+				}
+			}
+			return e
+		},
+		"account.setContactSignUpNotification": func(p *textParser) TL {
+			var e TL_account_setContactSignUpNotification
+			for p.field() {
+				switch p.fieldName {
+				case "silent":
+					e.Silent = p.Object()
+				default:
+					p.unknownField("account.setContactSignUpNotification")
+				}
+			}
+			return e
+		},
+		"account.getNotifyExceptions": func(p *textParser) TL {
+			var e TL_account_getNotifyExceptions
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "compare_sound":
+					e.CompareSound = p.Bool()
+					if e.CompareSound {
+						e.Flags |= 2
+					}
+				case "peer":
+					e.Peer = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("account.getNotifyExceptions")
+				}
+			}
+			return e
+		},
+		"account.getWallPaper": func(p *textParser) TL {
+			var e TL_account_getWallPaper
+			for p.field() {
+				switch p.fieldName {
+				case "wallpaper":
+					e.Wallpaper = p.Object()
+				default:
+					p.unknownField("account.getWallPaper")
+				}
+			}
+			return e
+		},
+		"account.uploadWallPaper": func(p *textParser) TL {
+			var e TL_account_uploadWallPaper
+			for p.field() {
+				switch p.fieldName {
+				case "file":
+					e.File = p.Object()
+				case "mime_type":
+					e.MimeType = p.String()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.uploadWallPaper")
+				}
+			}
+			return e
+		},
+		"account.saveWallPaper": func(p *textParser) TL {
+			var e TL_account_saveWallPaper
+			for p.field() {
+				switch p.fieldName {
+				case "wallpaper":
+					e.Wallpaper = p.Object()
+				case "unsave":
+					e.Unsave = p.Object()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.saveWallPaper")
+				}
+			}
+			return e
+		},
+		"account.installWallPaper": func(p *textParser) TL {
+			var e TL_account_installWallPaper
+			for p.field() {
+				switch p.fieldName {
+				case "wallpaper":
+					e.Wallpaper = p.Object()
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.installWallPaper")
+				}
+			}
+			return e
+		},
+		"account.resetWallPapers": func(p *textParser) TL {
+			var e TL_account_resetWallPapers
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.resetWallPapers")
+				}
+			}
+			return e
+		},
+		"account.getAutoDownloadSettings": func(p *textParser) TL {
+			var e TL_account_getAutoDownloadSettings
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getAutoDownloadSettings")
+				}
+			}
+			return e
+		},
+		"account.saveAutoDownloadSettings": func(p *textParser) TL {
+			var e TL_account_saveAutoDownloadSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "low":
+					e.Low = p.Bool()
+					if e.Low {
+						e.Flags |= 1
+					}
+				case "high":
+					e.High = p.Bool()
+					if e.High {
+						e.Flags |= 2
+					}
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.saveAutoDownloadSettings")
+				}
+			}
+			return e
+		},
+		"account.uploadTheme": func(p *textParser) TL {
+			var e TL_account_uploadTheme
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "file":
+					e.File = p.Object()
+				case "thumb":
+					e.Thumb = p.Object()
+					e.Flags |= 1
+				case "file_name":
+					e.FileName = p.String()
+				case "mime_type":
+					e.MimeType = p.String()
+				default:
+					p.unknownField("account.uploadTheme")
+				}
+			}
+			return e
+		},
+		"account.createTheme": func(p *textParser) TL {
+			var e TL_account_createTheme
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "slug":
+					e.Slug = p.String()
+				case "title":
+					e.Title = p.String()
+				case "document":
+					e.Document = p.Object()
+					e.Flags |= 4
+				case "settings":
+					e.Settings = p.Object()
+					e.Flags |= 8
+				default:
+					p.unknownField("account.createTheme")
+				}
+			}
+			return e
+		},
+		"account.updateTheme": func(p *textParser) TL {
+			var e TL_account_updateTheme
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "format":
+					e.Format = p.String()
+				case "theme":
+					e.Theme = p.Object()
+				case "slug":
+					e.Slug = p.String()
+					e.Flags |= 1
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 2
+				case "document":
+					e.Document = p.Object()
+					e.Flags |= 4
+				case "settings":
+					e.Settings = p.Object()
+					e.Flags |= 8
+				default:
+					p.unknownField("account.updateTheme")
+				}
+			}
+			return e
+		},
+		"account.saveTheme": func(p *textParser) TL {
+			var e TL_account_saveTheme
+			for p.field() {
+				switch p.fieldName {
+				case "theme":
+					e.Theme = p.Object()
+				case "unsave":
+					e.Unsave = p.Object()
+				default:
+					p.unknownField("account.saveTheme")
+				}
+			}
+			return e
+		},
+		"account.installTheme": func(p *textParser) TL {
+			var e TL_account_installTheme
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "dark":
+					e.Dark = p.Bool()
+					if e.Dark {
+						e.Flags |= 1
+					}
+				case "format":
+					e.Format = p.String()
+					e.Flags |= 2
+				case "theme":
+					e.Theme = p.Object()
+					e.Flags |= 2
+				default:
+					p.unknownField("account.installTheme")
+				}
+			}
+			return e
+		},
+		"account.getTheme": func(p *textParser) TL {
+			var e TL_account_getTheme
+			for p.field() {
+				switch p.fieldName {
+				case "format":
+					e.Format = p.String()
+				case "theme":
+					e.Theme = p.Object()
+				case "document_id":
+					e.DocumentID = p.Long()
+				default:
+					p.unknownField("account.getTheme")
+				}
+			}
+			return e
+		},
+		"account.getThemes": func(p *textParser) TL {
+			var e TL_account_getThemes
+			for p.field() {
+				switch p.fieldName {
+				case "format":
+					e.Format = p.String()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("account.getThemes")
+				}
+			}
+			return e
+		},
+		"account.setContentSettings": func(p *textParser) TL {
+			var e TL_account_setContentSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "sensitive_enabled":
+					e.SensitiveEnabled = p.Bool()
+					if e.SensitiveEnabled {
+						e.Flags |= 1
+					}
+				default:
+					p.unknownField("account.setContentSettings")
+				}
+			}
+			return e
+		},
+		"account.getContentSettings": func(p *textParser) TL {
+			var e TL_account_getContentSettings
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getContentSettings")
+				}
+			}
+			return e
+		},
+		"account.getMultiWallPapers": func(p *textParser) TL {
+			var e TL_account_getMultiWallPapers
+			for p.field() {
+				switch p.fieldName {
+				case "wallpapers":
+					e.Wallpapers = p.Vector()
+				default:
+					p.unknownField("account.getMultiWallPapers")
+				}
+			}
+			return e
+		},
+		"account.getGlobalPrivacySettings": func(p *textParser) TL {
+			var e TL_account_getGlobalPrivacySettings
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("account.getGlobalPrivacySettings")
+				}
+			}
+			return e
+		},
+		"account.setGlobalPrivacySettings": func(p *textParser) TL {
+			var e TL_account_setGlobalPrivacySettings
+			for p.field() {
+				switch p.fieldName {
+				case "settings":
+					e.Settings = p.Object()
+				default:
+					p.unknownField("account.setGlobalPrivacySettings")
+				}
+			}
+			return e
+		},
+		"account.reportProfilePhoto": func(p *textParser) TL {
+			var e TL_account_reportProfilePhoto
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "photo_id":
+					e.PhotoID = p.Object()
+				case "reason":
+					e.Reason = p.Object()
+				case "message":
+					e.Message = p.String()
+				default:
+					p.unknownField("account.reportProfilePhoto")
+				}
+			}
+			return e
+		},
+		"users.getUsers": func(p *textParser) TL {
+			var e TL_users_getUsers
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Vector()
+				default:
+					p.unknownField("users.getUsers")
+				}
+			}
+			return e
+		},
+		"users.getFullUser": func(p *textParser) TL {
+			var e TL_users_getFullUser
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("users.getFullUser")
+				}
+			}
+			return e
+		},
+		"users.setSecureValueErrors": func(p *textParser) TL {
+			var e TL_users_setSecureValueErrors
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				case "errors":
+					e.Errors = p.Vector()
+				default:
+					p.unknownField("users.setSecureValueErrors")
+				}
+			}
+			return e
+		},
+		"contacts.getContactIDs": func(p *textParser) TL {
+			var e TL_contacts_getContactIDs
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("contacts.getContactIDs")
+				}
+			}
+			return e
+		},
+		"contacts.getStatuses": func(p *textParser) TL {
+			var e TL_contacts_getStatuses
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("contacts.getStatuses")
+				}
+			}
+			return e
+		},
+		"contacts.getContacts": func(p *textParser) TL {
+			var e TL_contacts_getContacts
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("contacts.getContacts")
+				}
+			}
+			return e
+		},
+		"contacts.importContacts": func(p *textParser) TL {
+			var e TL_contacts_importContacts
+			for p.field() {
+				switch p.fieldName {
+				case "contacts":
+					e.Contacts = p.Vector()
+				default:
+					p.unknownField("contacts.importContacts")
+				}
+			}
+			return e
+		},
+		"contacts.deleteContacts": func(p *textParser) TL {
+			var e TL_contacts_deleteContacts
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Vector()
+				default:
+					p.unknownField("contacts.deleteContacts")
+				}
+			}
+			return e
+		},
+		"contacts.deleteByPhones": func(p *textParser) TL {
+			var e TL_contacts_deleteByPhones
+			for p.field() {
+				switch p.fieldName {
+				case "phones":
+					e.Phones = p.VectorString()
+				default:
+					p.unknownField("contacts.deleteByPhones")
+				}
+			}
+			return e
+		},
+		"contacts.block": func(p *textParser) TL {
+			var e TL_contacts_block
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("contacts.block")
+				}
+			}
+			return e
+		},
+		"contacts.unblock": func(p *textParser) TL {
+			var e TL_contacts_unblock
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("contacts.unblock")
+				}
+			}
+			return e
+		},
+		"contacts.getBlocked": func(p *textParser) TL {
+			var e TL_contacts_getBlocked
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("contacts.getBlocked")
+				}
+			}
+			return e
+		},
+		"contacts.search": func(p *textParser) TL {
+			var e TL_contacts_search
+			for p.field() {
+				switch p.fieldName {
+				case "q":
+					e.Q = p.String()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("contacts.search")
+				}
+			}
+			return e
+		},
+		"contacts.resolveUsername": func(p *textParser) TL {
+			var e TL_contacts_resolveUsername
+			for p.field() {
+				switch p.fieldName {
+				case "username":
+					e.Username = p.String()
+				default:
+					p.unknownField("contacts.resolveUsername")
+				}
+			}
+			return e
+		},
+		"contacts.getTopPeers": func(p *textParser) TL {
+			var e TL_contacts_getTopPeers
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "correspondents":
+					e.Correspondents = p.Bool()
+					if e.Correspondents {
+						e.Flags |= 1
+					}
+				case "bots_pm":
+					e.BotsPm = p.Bool()
+					if e.BotsPm {
+						e.Flags |= 2
+					}
+				case "bots_inline":
+					e.BotsInline = p.Bool()
+					if e.BotsInline {
+						e.Flags |= 4
+					}
+				case "phone_calls":
+					e.PhoneCalls = p.Bool()
+					if e.PhoneCalls {
+						e.Flags |= 8
+					}
+				case "forward_users":
+					e.ForwardUsers = p.Bool()
+					if e.ForwardUsers {
+						e.Flags |= 16
+					}
+				case "forward_chats":
+					e.ForwardChats = p.Bool()
+					if e.ForwardChats {
+						e.Flags |= 32
+					}
+				case "groups":
+					e.Groups = p.Bool()
+					if e.Groups {
+						e.Flags |= 1024
+					}
+				case "channels":
+					e.Channels = p.Bool()
+					if e.Channels {
+						e.Flags |= 32768
+					}
+				case "offset":
+					e.Offset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("contacts.getTopPeers")
+				}
+			}
+			return e
+		},
+		"contacts.resetTopPeerRating": func(p *textParser) TL {
+			var e TL_contacts_resetTopPeerRating
+			for p.field() {
+				switch p.fieldName {
+				case "category":
+					e.Category = p.Object()
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("contacts.resetTopPeerRating")
+				}
+			}
+			return e
+		},
+		"contacts.resetSaved": func(p *textParser) TL {
+			var e TL_contacts_resetSaved
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("contacts.resetSaved")
+				}
+			}
+			return e
+		},
+		"contacts.getSaved": func(p *textParser) TL {
+			var e TL_contacts_getSaved
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("contacts.getSaved")
+				}
+			}
+			return e
+		},
+		"contacts.toggleTopPeers": func(p *textParser) TL {
+			var e TL_contacts_toggleTopPeers
+			for p.field() {
+				switch p.fieldName {
+				case "enabled":
+					e.Enabled = p.Object()
+				default:
+					p.unknownField("contacts.toggleTopPeers")
+				}
+			}
+			return e
+		},
+		"contacts.addContact": func(p *textParser) TL {
+			var e TL_contacts_addContact
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "add_phone_privacy_exception":
+					e.AddPhonePrivacyException = p.Bool()
+					if e.AddPhonePrivacyException {
+						e.Flags |= 1
+					}
+				case "id":
+					e.ID = p.Object()
+				case "first_name":
+					e.FirstName = p.String()
+				case "last_name":
+					e.LastName = p.String()
+				case "phone":
+					e.Phone = p.String()
+				default:
+					p.unknownField("contacts.addContact")
+				}
+			}
+			return e
+		},
+		"contacts.acceptContact": func(p *textParser) TL {
+			var e TL_contacts_acceptContact
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("contacts.acceptContact")
+				}
+			}
+			return e
+		},
+		"contacts.getLocated": func(p *textParser) TL {
+			var e TL_contacts_getLocated
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "background":
+					e.Background = p.Bool()
+					if e.Background {
+						e.Flags |= 2
+					}
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				case "self_expires":
+					e.SelfExpires = p.Int()
+					e.Flags |= 1
+				default:
+					p.unknownField("contacts.getLocated")
+				}
+			}
+			return e
+		},
+		"contacts.blockFromReplies": func(p *textParser) TL {
+			var e TL_contacts_blockFromReplies
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "delete_message":
+					e.DeleteMessage = p.Bool()
+					if e.DeleteMessage {
+						e.Flags |= 1
+					}
+				case "delete_history":
+					e.DeleteHistory = p.Bool()
+					if e.DeleteHistory {
+						e.Flags |= 2
+					}
+				case "report_spam":
+					e.ReportSpam = p.Bool()
+					if e.ReportSpam {
+						e.Flags |= 4
+					}
+				case "msg_id":
+					e.MsgID = p.Int()
+				default:
+					p.unknownField("contacts.blockFromReplies")
+				}
+			}
+			return e
+		},
+		"messages.getMessages": func(p *textParser) TL {
+			var e TL_messages_getMessages
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Vector()
+				default:
+					p.unknownField("messages.getMessages")
+				}
+			}
+			return e
+		},
+		"messages.getDialogs": func(p *textParser) TL {
+			var e TL_messages_getDialogs
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "exclude_pinned":
+					e.ExcludePinned = p.Bool()
+					if e.ExcludePinned {
+						e.Flags |= 1
+					}
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 2
+				case "offset_date":
+					e.OffsetDate = p.Int()
+				case "offset_id":
+					e.OffsetID = p.Int()
+				case "offset_peer":
+					e.OffsetPeer = p.Object()
+				case "limit":
+					e.Limit = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getDialogs")
+				}
+			}
+			return e
+		},
+		"messages.getHistory": func(p *textParser) TL {
+			var e TL_messages_getHistory
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "offset_id":
+					e.OffsetID = p.Int()
+				case "offset_date":
+					e.OffsetDate = p.Int()
+				case "add_offset":
+					e.AddOffset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				case "max_id":
+					e.MaxID = p.Int()
+				case "min_id":
+					e.MinID = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getHistory")
+				}
+			}
+			return e
+		},
+		"messages.search": func(p *textParser) TL {
+			var e TL_messages_search
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "peer":
+					e.Peer = p.Object()
+				case "q":
+					e.Q = p.String()
+				case "from_id":
+					e.FromID = p.Object()
+					e.Flags |= 1
+				case "top_msg_id":
+					e.TopMsgID = p.Int()
+					e.Flags |= 2
+				case "filter":
+					e.Filter = p.Object()
+				case "min_date":
+					e.MinDate = p.Int()
+				case "max_date":
+					e.MaxDate = p.Int()
+				case "offset_id":
+					e.OffsetID = p.Int()
+				case "add_offset":
+					e.AddOffset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				case "max_id":
+					e.MaxID = p.Int()
+				case "min_id":
+					e.MinID = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.search")
+				}
+			}
+			return e
+		},
+		"messages.readHistory": func(p *textParser) TL {
+			var e TL_messages_readHistory
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "max_id":
+					e.MaxID = p.Int()
+				default:
+					p.unknownField("messages.readHistory")
+				}
+			}
+			return e
+		},
+		"messages.deleteHistory": func(p *textParser) TL {
+			var e TL_messages_deleteHistory
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "just_clear":
+					e.JustClear = p.Bool()
+					if e.JustClear {
+						e.Flags |= 1
+					}
+				case "revoke":
+					e.Revoke = p.Bool()
+					if e.Revoke {
+						e.Flags |= 2
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "max_id":
+					e.MaxID = p.Int()
+				default:
+					p.unknownField("messages.deleteHistory")
+				}
+			}
+			return e
+		},
+		"messages.deleteMessages": func(p *textParser) TL {
+			var e TL_messages_deleteMessages
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "revoke":
+					e.Revoke = p.Bool()
+					if e.Revoke {
+						e.Flags |= 1
+					}
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("messages.deleteMessages")
+				}
+			}
+			return e
+		},
+		"messages.receivedMessages": func(p *textParser) TL {
+			var e TL_messages_receivedMessages
+			for p.field() {
+				switch p.fieldName {
+				case "max_id":
+					e.MaxID = p.Int()
+				default:
+					p.unknownField("messages.receivedMessages")
+				}
+			}
+			return e
+		},
+		"messages.setTyping": func(p *textParser) TL {
+			var e TL_messages_setTyping
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "peer":
+					e.Peer = p.Object()
+				case "top_msg_id":
+					e.TopMsgID = p.Int()
+					e.Flags |= 1
+				case "action":
+					e.Action = p.Object()
+				default:
+					p.unknownField("messages.setTyping")
+				}
+			}
+			return e
+		},
+		"messages.sendMessage": func(p *textParser) TL {
+			var e TL_messages_sendMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "no_webpage":
+					e.NoWebpage = p.Bool()
+					if e.NoWebpage {
+						e.Flags |= 2
+					}
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 32
+					}
+				case "background":
+					e.Background = p.Bool()
+					if e.Background {
+						e.Flags |= 64
+					}
+				case "clear_draft":
+					e.ClearDraft = p.Bool()
+					if e.ClearDraft {
+						e.Flags |= 128
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "reply_to_msg_id":
+					e.ReplyToMsgID = p.Int()
+					e.Flags |= 1
+				case "message":
+					e.Message = p.String()
+				case "random_id":
+					e.RandomID = p.Long()
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 8
+				case "schedule_date":
+					e.ScheduleDate = p.Int()
+					e.Flags |= 1024
+				default:
+					p.unknownField("messages.sendMessage")
+				}
+			}
+			return e
+		},
+		"messages.sendMedia": func(p *textParser) TL {
+			var e TL_messages_sendMedia
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 32
+					}
+				case "background":
+					e.Background = p.Bool()
+					if e.Background {
+						e.Flags |= 64
+					}
+				case "clear_draft":
+					e.ClearDraft = p.Bool()
+					if e.ClearDraft {
+						e.Flags |= 128
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "reply_to_msg_id":
+					e.ReplyToMsgID = p.Int()
+					e.Flags |= 1
+				case "media":
+					e.Media = p.Object()
+				case "message":
+					e.Message = p.String()
+				case "random_id":
+					e.RandomID = p.Long()
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 8
+				case "schedule_date":
+					e.ScheduleDate = p.Int()
+					e.Flags |= 1024
+				default:
+					p.unknownField("messages.sendMedia")
+				}
+			}
+			return e
+		},
+		"messages.forwardMessages": func(p *textParser) TL {
+			var e TL_messages_forwardMessages
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 32
+					}
+				case "background":
+					e.Background = p.Bool()
+					if e.Background {
+						e.Flags |= 64
+					}
+				case "with_my_score":
+					e.WithMyScore = p.Bool()
+					if e.WithMyScore {
+						e.Flags |= 256
+					}
+				case "from_peer":
+					e.FromPeer = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				case "random_id":
+					e.RandomID = p.VectorLong()
+				case "to_peer":
+					e.ToPeer = p.Object()
+				case "schedule_date":
+					e.ScheduleDate = p.Int()
+					e.Flags |= 1024
+				default:
+					p.unknownField("messages.forwardMessages")
+				}
+			}
+			return e
+		},
+		"messages.reportSpam": func(p *textParser) TL {
+			var e TL_messages_reportSpam
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.reportSpam")
+				}
+			}
+			return e
+		},
+		"messages.getPeerSettings": func(p *textParser) TL {
+			var e TL_messages_getPeerSettings
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.getPeerSettings")
+				}
+			}
+			return e
+		},
+		"messages.report": func(p *textParser) TL {
+			var e TL_messages_report
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				case "reason":
+					e.Reason = p.Object()
+				case "message":
+					e.Message = p.String()
+				default:
+					p.unknownField("messages.report")
+				}
+			}
+			return e
+		},
+		"messages.getChats": func(p *textParser) TL {
+			var e TL_messages_getChats
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("messages.getChats")
+				}
+			}
+			return e
+		},
+		"messages.getFullChat": func(p *textParser) TL {
+			var e TL_messages_getFullChat
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("messages.getFullChat")
+				}
+			}
+			return e
+		},
+		"messages.editChatTitle": func(p *textParser) TL {
+			var e TL_messages_editChatTitle
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "title":
+					e.Title = p.String()
+				default:
+					p.unknownField("messages.editChatTitle")
+				}
+			}
+			return e
+		},
+		"messages.editChatPhoto": func(p *textParser) TL {
+			var e TL_messages_editChatPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "photo":
+					e.Photo = p.Object()
+				default:
+					p.unknownField("messages.editChatPhoto")
+				}
+			}
+			return e
+		},
+		"messages.addChatUser": func(p *textParser) TL {
+			var e TL_messages_addChatUser
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "user_id":
+					e.UserID = p.Object()
+				case "fwd_limit":
+					e.FwdLimit = p.Int()
+				default:
+					p.unknownField("messages.addChatUser")
+				}
+			}
+			return e
+		},
+		"messages.deleteChatUser": func(p *textParser) TL {
+			var e TL_messages_deleteChatUser
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "revoke_history":
+					e.RevokeHistory = p.Bool()
+					if e.RevokeHistory {
+						e.Flags |= 1
+					}
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "user_id":
+					e.UserID = p.Object()
+				default:
+					p.unknownField("messages.deleteChatUser")
+				}
+			}
+			return e
+		},
+		"messages.createChat": func(p *textParser) TL {
+			var e TL_messages_createChat
+			for p.field() {
+				switch p.fieldName {
+				case "users":
+					e.Users = p.Vector()
+				case "title":
+					e.Title = p.String()
+				default:
+					p.unknownField("messages.createChat")
+				}
+			}
+			return e
+		},
+		"messages.getDhConfig": func(p *textParser) TL {
+			var e TL_messages_getDhConfig
+			for p.field() {
+				switch p.fieldName {
+				case "version":
+					e.Version = p.Int()
+				case "random_length":
+					e.RandomLength = p.Int()
+				default:
+					p.unknownField("messages.getDhConfig")
+				}
+			}
+			return e
+		},
+		"messages.requestEncryption": func(p *textParser) TL {
+			var e TL_messages_requestEncryption
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Object()
+				case "random_id":
+					e.RandomID = p.Int()
+				case "g_a":
+					e.GA = p.Bytes()
+				default:
+					p.unknownField("messages.requestEncryption")
+				}
+			}
+			return e
+		},
+		"messages.acceptEncryption": func(p *textParser) TL {
+			var e TL_messages_acceptEncryption
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "g_b":
+					e.GB = p.Bytes()
+				case "key_fingerprint":
+					e.KeyFingerprint = p.Long()
+				default:
+					p.unknownField("messages.acceptEncryption")
+				}
+			}
+			return e
+		},
+		"messages.discardEncryption": func(p *textParser) TL {
+			var e TL_messages_discardEncryption
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "delete_history":
+					e.DeleteHistory = p.Bool()
+					if e.DeleteHistory {
+						e.Flags |= 1
+					}
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("messages.discardEncryption")
+				}
+			}
+			return e
+		},
+		"messages.setEncryptedTyping": func(p *textParser) TL {
+			var e TL_messages_setEncryptedTyping
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "typing":
+					e.Typing = p.Object()
+				default:
+					p.unknownField("messages.setEncryptedTyping")
+				}
+			}
+			return e
+		},
+		"messages.readEncryptedHistory": func(p *textParser) TL {
+			var e TL_messages_readEncryptedHistory
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "max_date":
+					e.MaxDate = p.Int()
+				default:
+					p.unknownField("messages.readEncryptedHistory")
+				}
+			}
+			return e
+		},
+		"messages.sendEncrypted": func(p *textParser) TL {
+			var e TL_messages_sendEncrypted
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "random_id":
+					e.RandomID = p.Long()
+				case "data":
+					e.Data = p.Bytes()
+				default:
+					p.unknownField("messages.sendEncrypted")
+				}
+			}
+			return e
+		},
+		"messages.sendEncryptedFile": func(p *textParser) TL {
+			var e TL_messages_sendEncryptedFile
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "random_id":
+					e.RandomID = p.Long()
+				case "data":
+					e.Data = p.Bytes()
+				case "file":
+					e.File = p.Object()
+				default:
+					p.unknownField("messages.sendEncryptedFile")
+				}
+			}
+			return e
+		},
+		"messages.sendEncryptedService": func(p *textParser) TL {
+			var e TL_messages_sendEncryptedService
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "random_id":
+					e.RandomID = p.Long()
+				case "data":
+					e.Data = p.Bytes()
+				default:
+					p.unknownField("messages.sendEncryptedService")
+				}
+			}
+			return e
+		},
+		"messages.receivedQueue": func(p *textParser) TL {
+			var e TL_messages_receivedQueue
+			for p.field() {
+				switch p.fieldName {
+				case "max_qts":
+					e.MaxQts = p.Int()
+				default:
+					p.unknownField("messages.receivedQueue")
+				}
+			}
+			return e
+		},
+		"messages.reportEncryptedSpam": func(p *textParser) TL {
+			var e TL_messages_reportEncryptedSpam
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.reportEncryptedSpam")
+				}
+			}
+			return e
+		},
+		"messages.readMessageContents": func(p *textParser) TL {
+			var e TL_messages_readMessageContents
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("messages.readMessageContents")
+				}
+			}
+			return e
+		},
+		"messages.getStickers": func(p *textParser) TL {
+			var e TL_messages_getStickers
+			for p.field() {
+				switch p.fieldName {
+				case "emoticon":
+					e.Emoticon = p.String()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getStickers")
+				}
+			}
+			return e
+		},
+		"messages.getAllStickers": func(p *textParser) TL {
+			var e TL_messages_getAllStickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getAllStickers")
+				}
+			}
+			return e
+		},
+		"messages.getWebPagePreview": func(p *textParser) TL {
+			var e TL_messages_getWebPagePreview
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 8
+				default:
+					p.unknownField("messages.getWebPagePreview")
+				}
+			}
+			return e
+		},
+		"messages.exportChatInvite": func(p *textParser) TL {
+			var e TL_messages_exportChatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "legacy_revoke_permanent":
+					e.LegacyRevokePermanent = p.Bool()
+					if e.LegacyRevokePermanent {
+						e.Flags |= 4
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "expire_date":
+					e.ExpireDate = p.Int()
+					e.Flags |= 1
+				case "usage_limit":
+					e.UsageLimit = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("messages.exportChatInvite")
+				}
+			}
+			return e
+		},
+		"messages.checkChatInvite": func(p *textParser) TL {
+			var e TL_messages_checkChatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.String()
+				default:
+					p.unknownField("messages.checkChatInvite")
+				}
+			}
+			return e
+		},
+		"messages.importChatInvite": func(p *textParser) TL {
+			var e TL_messages_importChatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.String()
+				default:
+					p.unknownField("messages.importChatInvite")
+				}
+			}
+			return e
+		},
+		"messages.getStickerSet": func(p *textParser) TL {
+			var e TL_messages_getStickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "stickerset":
+					e.Stickerset = p.Object()
+				default:
+					p.unknownField("messages.getStickerSet")
+				}
+			}
+			return e
+		},
+		"messages.installStickerSet": func(p *textParser) TL {
+			var e TL_messages_installStickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "stickerset":
+					e.Stickerset = p.Object()
+				case "archived":
+					e.Archived = p.Object()
+				default:
+					p.unknownField("messages.installStickerSet")
+				}
+			}
+			return e
+		},
+		"messages.uninstallStickerSet": func(p *textParser) TL {
+			var e TL_messages_uninstallStickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "stickerset":
+					e.Stickerset = p.Object()
+				default:
+					p.unknownField("messages.uninstallStickerSet")
+				}
+			}
+			return e
+		},
+		"messages.startBot": func(p *textParser) TL {
+			var e TL_messages_startBot
+			for p.field() {
+				switch p.fieldName {
+				case "bot":
+					e.Bot = p.Object()
+				case "peer":
+					e.Peer = p.Object()
+				case "random_id":
+					e.RandomID = p.Long()
+				case "start_param":
+					e.StartParam = p.String()
+				default:
+					p.unknownField("messages.startBot")
+				}
+			}
+			return e
+		},
+		"messages.getMessagesViews": func(p *textParser) TL {
+			var e TL_messages_getMessagesViews
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				case "increment":
+					e.Increment = p.Object()
+				default:
+					p.unknownField("messages.getMessagesViews")
+				}
+			}
+			return e
+		},
+		"messages.editChatAdmin": func(p *textParser) TL {
+			var e TL_messages_editChatAdmin
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				case "user_id":
+					e.UserID = p.Object()
+				case "is_admin":
+					e.IsAdmin = p.Object()
+				default:
+					p.unknownField("messages.editChatAdmin")
+				}
+			}
+			return e
+		},
+		"messages.migrateChat": func(p *textParser) TL {
+			var e TL_messages_migrateChat
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("messages.migrateChat")
+				}
+			}
+			return e
+		},
+		"messages.searchGlobal": func(p *textParser) TL {
+			var e TL_messages_searchGlobal
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "folder_id":
+					e.FolderID = p.Int()
+					e.Flags |= 1
+				case "q":
+					e.Q = p.String()
+				case "filter":
+					e.Filter = p.Object()
+				case "min_date":
+					e.MinDate = p.Int()
+				case "max_date":
+					e.MaxDate = p.Int()
+				case "offset_rate":
+					e.OffsetRate = p.Int()
+				case "offset_peer":
+					e.OffsetPeer = p.Object()
+				case "offset_id":
+					e.OffsetID = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("messages.searchGlobal")
+				}
+			}
+			return e
+		},
+		"messages.reorderStickerSets": func(p *textParser) TL {
+			var e TL_messages_reorderStickerSets
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "masks":
+					e.Masks = p.Bool()
+					if e.Masks {
+						e.Flags |= 1
+					}
+				case "order":
+					e.Order = p.VectorLong()
+				default:
+					p.unknownField("messages.reorderStickerSets")
+				}
+			}
+			return e
+		},
+		"messages.getDocumentByHash": func(p *textParser) TL {
+			var e TL_messages_getDocumentByHash
+			for p.field() {
+				switch p.fieldName {
+				case "sha256":
+					e.Sha256 = p.Bytes()
+				case "size":
+					e.Size = p.Int()
+				case "mime_type":
+					e.MimeType = p.String()
+				default:
+					p.unknownField("messages.getDocumentByHash")
+				}
+			}
+			return e
+		},
+		"messages.getSavedGifs": func(p *textParser) TL {
+			var e TL_messages_getSavedGifs
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getSavedGifs")
+				}
+			}
+			return e
+		},
+		"messages.saveGif": func(p *textParser) TL {
+			var e TL_messages_saveGif
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				case "unsave":
+					e.Unsave = p.Object()
+				default:
+					p.unknownField("messages.saveGif")
+				}
+			}
+			return e
+		},
+		"messages.getInlineBotResults": func(p *textParser) TL {
+			var e TL_messages_getInlineBotResults
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "bot":
+					e.Bot = p.Object()
+				case "peer":
+					e.Peer = p.Object()
+				case "geo_point":
+					e.GeoPoint = p.Object()
+					e.Flags |= 1
+				case "query":
+					e.Query = p.String()
+				case "offset":
+					e.Offset = p.String()
+				default:
+					p.unknownField("messages.getInlineBotResults")
+				}
+			}
+			return e
+		},
+		"messages.setInlineBotResults": func(p *textParser) TL {
+			var e TL_messages_setInlineBotResults
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "gallery":
+					e.Gallery = p.Bool()
+					if e.Gallery {
+						e.Flags |= 1
+					}
+				case "private":
+					e.Private = p.Bool()
+					if e.Private {
+						e.Flags |= 2
+					}
+				case "query_id":
+					e.QueryID = p.Long()
+				case "results":
+					e.Results = p.Vector()
+				case "cache_time":
+					e.CacheTime = p.Int()
+				case "next_offset":
+					e.NextOffset = p.String()
+					e.Flags |= 4
+				case "switch_pm":
+					e.SwitchPm = p.Object()
+					e.Flags |= 8
+				default:
+					p.unknownField("messages.setInlineBotResults")
+				}
+			}
+			return e
+		},
+		"messages.sendInlineBotResult": func(p *textParser) TL {
+			var e TL_messages_sendInlineBotResult
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 32
+					}
+				case "background":
+					e.Background = p.Bool()
+					if e.Background {
+						e.Flags |= 64
+					}
+				case "clear_draft":
+					e.ClearDraft = p.Bool()
+					if e.ClearDraft {
+						e.Flags |= 128
+					}
+				case "hide_via":
+					e.HideVia = p.Bool()
+					if e.HideVia {
+						e.Flags |= 2048
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "reply_to_msg_id":
+					e.ReplyToMsgID = p.Int()
+					e.Flags |= 1
+				case "random_id":
+					e.RandomID = p.Long()
+				case "query_id":
+					e.QueryID = p.Long()
+				case "id":
+					e.ID = p.String()
+				case "schedule_date":
+					e.ScheduleDate = p.Int()
+					e.Flags |= 1024
+				default:
+					p.unknownField("messages.sendInlineBotResult")
+				}
+			}
+			return e
+		},
+		"messages.getMessageEditData": func(p *textParser) TL {
+			var e TL_messages_getMessageEditData
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("messages.getMessageEditData")
+				}
+			}
+			return e
+		},
+		"messages.editMessage": func(p *textParser) TL {
+			var e TL_messages_editMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "no_webpage":
+					e.NoWebpage = p.Bool()
+					if e.NoWebpage {
+						e.Flags |= 2
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.Int()
+				case "message":
+					e.Message = p.String()
+					e.Flags |= 2048
+				case "media":
+					e.Media = p.Object()
+					e.Flags |= 16384
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 8
+				case "schedule_date":
+					e.ScheduleDate = p.Int()
+					e.Flags |= 32768
+				default:
+					p.unknownField("messages.editMessage")
+				}
+			}
+			return e
+		},
+		"messages.editInlineBotMessage": func(p *textParser) TL {
+			var e TL_messages_editInlineBotMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "no_webpage":
+					e.NoWebpage = p.Bool()
+					if e.NoWebpage {
+						e.Flags |= 2
+					}
+				case "id":
+					e.ID = p.Object()
+				case "message":
+					e.Message = p.String()
+					e.Flags |= 2048
+				case "media":
+					e.Media = p.Object()
+					e.Flags |= 16384
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 4
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 8
+				default:
+					p.unknownField("messages.editInlineBotMessage")
+				}
+			}
+			return e
+		},
+		"messages.getBotCallbackAnswer": func(p *textParser) TL {
+			var e TL_messages_getBotCallbackAnswer
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "game":
+					e.Game = p.Bool()
+					if e.Game {
+						e.Flags |= 2
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "data":
+					e.Data = p.Bytes()
+					e.Flags |= 1
+				case "password":
+					e.Password = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("messages.getBotCallbackAnswer")
+				}
+			}
+			return e
+		},
+		"messages.setBotCallbackAnswer": func(p *textParser) TL {
+			var e TL_messages_setBotCallbackAnswer
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "alert":
+					e.Alert = p.Bool()
+					if e.Alert {
+						e.Flags |= 2
+					}
+				case "query_id":
+					e.QueryID = p.Long()
+				case "message":
+					e.Message = p.String()
+					e.Flags |= 1
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 4
+				case "cache_time":
+					e.CacheTime = p.Int()
+				default:
+					p.unknownField("messages.setBotCallbackAnswer")
+				}
+			}
+			return e
+		},
+		"messages.getPeerDialogs": func(p *textParser) TL {
+			var e TL_messages_getPeerDialogs
+			for p.field() {
+				switch p.fieldName {
+				case "peers":
+					e.Peers = p.Vector()
+				default:
+					p.unknownField("messages.getPeerDialogs")
+				}
+			}
+			return e
+		},
+		"messages.saveDraft": func(p *textParser) TL {
+			var e TL_messages_saveDraft
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "no_webpage":
+					e.NoWebpage = p.Bool()
+					if e.NoWebpage {
+						e.Flags |= 2
+					}
+				case "reply_to_msg_id":
+					e.ReplyToMsgID = p.Int()
+					e.Flags |= 1
+				case "peer":
+					e.Peer = p.Object()
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 8
+				default:
+					p.unknownField("messages.saveDraft")
+				}
+			}
+			return e
+		},
+		"messages.getAllDrafts": func(p *textParser) TL {
+			var e TL_messages_getAllDrafts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.getAllDrafts")
+				}
+			}
+			return e
+		},
+		"messages.getFeaturedStickers": func(p *textParser) TL {
+			var e TL_messages_getFeaturedStickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getFeaturedStickers")
+				}
+			}
+			return e
+		},
+		"messages.readFeaturedStickers": func(p *textParser) TL {
+			var e TL_messages_readFeaturedStickers
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.VectorLong()
+				default:
+					p.unknownField("messages.readFeaturedStickers")
+				}
+			}
+			return e
+		},
+		"messages.getRecentStickers": func(p *textParser) TL {
+			var e TL_messages_getRecentStickers
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "attached":
+					e.Attached = p.Bool()
+					if e.Attached {
+						e.Flags |= 1
+					}
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getRecentStickers")
+				}
+			}
+			return e
+		},
+		"messages.saveRecentSticker": func(p *textParser) TL {
+			var e TL_messages_saveRecentSticker
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "attached":
+					e.Attached = p.Bool()
+					if e.Attached {
+						e.Flags |= 1
+					}
+				case "id":
+					e.ID = p.Object()
+				case "unsave":
+					e.Unsave = p.Object()
+				default:
+					p.unknownField("messages.saveRecentSticker")
+				}
+			}
+			return e
+		},
+		"messages.clearRecentStickers": func(p *textParser) TL {
+			var e TL_messages_clearRecentStickers
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "attached":
+					e.Attached = p.Bool()
+					if e.Attached {
+						e.Flags |= 1
+					}
+				default:
+					p.unknownField("messages.clearRecentStickers")
+				}
+			}
+			return e
+		},
+		"messages.getArchivedStickers": func(p *textParser) TL {
+			var e TL_messages_getArchivedStickers
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "masks":
+					e.Masks = p.Bool()
+					if e.Masks {
+						e.Flags |= 1
+					}
+				case "offset_id":
+					e.OffsetID = p.Long()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("messages.getArchivedStickers")
+				}
+			}
+			return e
+		},
+		"messages.getMaskStickers": func(p *textParser) TL {
+			var e TL_messages_getMaskStickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getMaskStickers")
+				}
+			}
+			return e
+		},
+		"messages.getAttachedStickers": func(p *textParser) TL {
+			var e TL_messages_getAttachedStickers
+			for p.field() {
+				switch p.fieldName {
+				case "media":
+					e.Media = p.Object()
+				default:
+					p.unknownField("messages.getAttachedStickers")
+				}
+			}
+			return e
+		},
+		"messages.setGameScore": func(p *textParser) TL {
+			var e TL_messages_setGameScore
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "edit_message":
+					e.EditMessage = p.Bool()
+					if e.EditMessage {
+						e.Flags |= 1
+					}
+				case "force":
+					e.Force = p.Bool()
+					if e.Force {
+						e.Flags |= 2
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.Int()
+				case "user_id":
+					e.UserID = p.Object()
+				case "score":
+					e.Score = p.Int()
+				default:
+					p.unknownField("messages.setGameScore")
+				}
+			}
+			return e
+		},
+		"messages.setInlineGameScore": func(p *textParser) TL {
+			var e TL_messages_setInlineGameScore
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "edit_message":
+					e.EditMessage = p.Bool()
+					if e.EditMessage {
+						e.Flags |= 1
+					}
+				case "force":
+					e.Force = p.Bool()
+					if e.Force {
+						e.Flags |= 2
+					}
+				case "id":
+					e.ID = p.Object()
+				case "user_id":
+					e.UserID = p.Object()
+				case "score":
+					e.Score = p.Int()
+				default:
+					p.unknownField("messages.setInlineGameScore")
+				}
+			}
+			return e
+		},
+		"messages.getGameHighScores": func(p *textParser) TL {
+			var e TL_messages_getGameHighScores
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.Int()
+				case "user_id":
+					e.UserID = p.Object()
+				default:
+					p.unknownField("messages.getGameHighScores")
+				}
+			}
+			return e
+		},
+		"messages.getInlineGameHighScores": func(p *textParser) TL {
+			var e TL_messages_getInlineGameHighScores
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				case "user_id":
+					e.UserID = p.Object()
+				default:
+					p.unknownField("messages.getInlineGameHighScores")
+				}
+			}
+			return e
+		},
+		"messages.getCommonChats": func(p *textParser) TL {
+			var e TL_messages_getCommonChats
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Object()
+				case "max_id":
+					e.MaxID = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("messages.getCommonChats")
+				}
+			}
+			return e
+		},
+		"messages.getAllChats": func(p *textParser) TL {
+			var e TL_messages_getAllChats
+			for p.field() {
+				switch p.fieldName {
+				case "except_ids":
+					e.ExceptIds = p.VectorInt()
+				default:
+					p.unknownField("messages.getAllChats")
+				}
+			}
+			return e
+		},
+		"messages.getWebPage": func(p *textParser) TL {
+			var e TL_messages_getWebPage
+			for p.field() {
+				switch p.fieldName {
+				case "url":
+					e.Url = p.String()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getWebPage")
+				}
+			}
+			return e
+		},
+		"messages.toggleDialogPin": func(p *textParser) TL {
+			var e TL_messages_toggleDialogPin
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.toggleDialogPin")
+				}
+			}
+			return e
+		},
+		"messages.reorderPinnedDialogs": func(p *textParser) TL {
+			var e TL_messages_reorderPinnedDialogs
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "force":
+					e.Force = p.Bool()
+					if e.Force {
+						e.Flags |= 1
+					}
+				case "folder_id":
+					e.FolderID = p.Int()
+				case "order":
+					e.Order = p.Vector()
+				default:
+					p.unknownField("messages.reorderPinnedDialogs")
+				}
+			}
+			return e
+		},
+		"messages.getPinnedDialogs": func(p *textParser) TL {
+			var e TL_messages_getPinnedDialogs
+			for p.field() {
+				switch p.fieldName {
+				case "folder_id":
+					e.FolderID = p.Int()
+				default:
+					p.unknownField("messages.getPinnedDialogs")
+				}
+			}
+			return e
+		},
+		"messages.setBotShippingResults": func(p *textParser) TL {
+			var e TL_messages_setBotShippingResults
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "query_id":
+					e.QueryID = p.Long()
+				case "error":
+					e.Error = p.String()
+					e.Flags |= 1
+				case "shipping_options":
+					e.ShippingOptions = p.Vector()
+					e.Flags |= 2
+				default:
+					p.unknownField("messages.setBotShippingResults")
+				}
+			}
+			return e
+		},
+		"messages.setBotPrecheckoutResults": func(p *textParser) TL {
+			var e TL_messages_setBotPrecheckoutResults
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "success":
+					e.Success = p.Bool()
+					if e.Success {
+						e.Flags |= 2
+					}
+				case "query_id":
+					e.QueryID = p.Long()
+				case "error":
+					e.Error = p.String()
+					e.Flags |= 1
+				default:
+					p.unknownField("messages.setBotPrecheckoutResults")
+				}
+			}
+			return e
+		},
+		"messages.uploadMedia": func(p *textParser) TL {
+			var e TL_messages_uploadMedia
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "media":
+					e.Media = p.Object()
+				default:
+					p.unknownField("messages.uploadMedia")
+				}
+			}
+			return e
+		},
+		"messages.sendScreenshotNotification": func(p *textParser) TL {
+			var e TL_messages_sendScreenshotNotification
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "reply_to_msg_id":
+					e.ReplyToMsgID = p.Int()
+				case "random_id":
+					e.RandomID = p.Long()
+				default:
+					p.unknownField("messages.sendScreenshotNotification")
+				}
+			}
+			return e
+		},
+		"messages.getFavedStickers": func(p *textParser) TL {
+			var e TL_messages_getFavedStickers
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getFavedStickers")
+				}
+			}
+			return e
+		},
+		"messages.faveSticker": func(p *textParser) TL {
+			var e TL_messages_faveSticker
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				case "unfave":
+					e.Unfave = p.Object()
+				default:
+					p.unknownField("messages.faveSticker")
+				}
+			}
+			return e
+		},
+		"messages.getUnreadMentions": func(p *textParser) TL {
+			var e TL_messages_getUnreadMentions
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "offset_id":
+					e.OffsetID = p.Int()
+				case "add_offset":
+					e.AddOffset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				case "max_id":
+					e.MaxID = p.Int()
+				case "min_id":
+					e.MinID = p.Int()
+				default:
+					p.unknownField("messages.getUnreadMentions")
+				}
+			}
+			return e
+		},
+		"messages.readMentions": func(p *textParser) TL {
+			var e TL_messages_readMentions
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.readMentions")
+				}
+			}
+			return e
+		},
+		"messages.getRecentLocations": func(p *textParser) TL {
+			var e TL_messages_getRecentLocations
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "limit":
+					e.Limit = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getRecentLocations")
+				}
+			}
+			return e
+		},
+		"messages.sendMultiMedia": func(p *textParser) TL {
+			var e TL_messages_sendMultiMedia
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 32
+					}
+				case "background":
+					e.Background = p.Bool()
+					if e.Background {
+						e.Flags |= 64
+					}
+				case "clear_draft":
+					e.ClearDraft = p.Bool()
+					if e.ClearDraft {
+						e.Flags |= 128
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "reply_to_msg_id":
+					e.ReplyToMsgID = p.Int()
+					e.Flags |= 1
+				case "multi_media":
+					e.MultiMedia = p.Vector()
+				case "schedule_date":
+					e.ScheduleDate = p.Int()
+					e.Flags |= 1024
+				default:
+					p.unknownField("messages.sendMultiMedia")
+				}
+			}
+			return e
+		},
+		"messages.uploadEncryptedFile": func(p *textParser) TL {
+			var e TL_messages_uploadEncryptedFile
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "file":
+					e.File = p.Object()
+				default:
+					p.unknownField("messages.uploadEncryptedFile")
+				}
+			}
+			return e
+		},
+		"messages.searchStickerSets": func(p *textParser) TL {
+			var e TL_messages_searchStickerSets
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "exclude_featured":
+					e.ExcludeFeatured = p.Bool()
+					if e.ExcludeFeatured {
+						e.Flags |= 1
+					}
+				case "q":
+					e.Q = p.String()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.searchStickerSets")
+				}
+			}
+			return e
+		},
+		"messages.getSplitRanges": func(p *textParser) TL {
+			var e TL_messages_getSplitRanges
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.getSplitRanges")
+				}
+			}
+			return e
+		},
+		"messages.markDialogUnread": func(p *textParser) TL {
+			var e TL_messages_markDialogUnread
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "unread":
+					e.Unread = p.Bool()
+					if e.Unread {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.markDialogUnread")
+				}
+			}
+			return e
+		},
+		"messages.getDialogUnreadMarks": func(p *textParser) TL {
+			var e TL_messages_getDialogUnreadMarks
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.getDialogUnreadMarks")
+				}
+			}
+			return e
+		},
+		"messages.clearAllDrafts": func(p *textParser) TL {
+			var e TL_messages_clearAllDrafts
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.clearAllDrafts")
+				}
+			}
+			return e
+		},
+		"messages.updatePinnedMessage": func(p *textParser) TL {
+			var e TL_messages_updatePinnedMessage
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 1
+					}
+				case "unpin":
+					e.Unpin = p.Bool()
+					if e.Unpin {
+						e.Flags |= 2
+					}
+				case "pm_oneside":
+					e.PmOneside = p.Bool()
+					if e.PmOneside {
+						e.Flags |= 4
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("messages.updatePinnedMessage")
+				}
+			}
+			return e
+		},
+		"messages.sendVote": func(p *textParser) TL {
+			var e TL_messages_sendVote
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "options":
+					e.Options = p.VectorBytes()
+				default:
+					p.unknownField("messages.sendVote")
+				}
+			}
+			return e
+		},
+		"messages.getPollResults": func(p *textParser) TL {
+			var e TL_messages_getPollResults
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				default:
+					p.unknownField("messages.getPollResults")
+				}
+			}
+			return e
+		},
+		"messages.getOnlines": func(p *textParser) TL {
+			var e TL_messages_getOnlines
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.getOnlines")
+				}
+			}
+			return e
+		},
+		"messages.getStatsURL": func(p *textParser) TL {
+			var e TL_messages_getStatsURL
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "dark":
+					e.Dark = p.Bool()
+					if e.Dark {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "params":
+					e.Params = p.String()
+				default:
+					p.unknownField("messages.getStatsURL")
+				}
+			}
+			return e
+		},
+		"messages.editChatAbout": func(p *textParser) TL {
+			var e TL_messages_editChatAbout
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "about":
+					e.About = p.String()
+				default:
+					p.unknownField("messages.editChatAbout")
+				}
+			}
+			return e
+		},
+		"messages.editChatDefaultBannedRights": func(p *textParser) TL {
+			var e TL_messages_editChatDefaultBannedRights
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "banned_rights":
+					e.BannedRights = p.Object()
+				default:
+					p.unknownField("messages.editChatDefaultBannedRights")
+				}
+			}
+			return e
+		},
+		"messages.getEmojiKeywords": func(p *textParser) TL {
+			var e TL_messages_getEmojiKeywords
+			for p.field() {
+				switch p.fieldName {
+				case "lang_code":
+					e.LangCode = p.String()
+				default:
+					p.unknownField("messages.getEmojiKeywords")
+				}
+			}
+			return e
+		},
+		"messages.getEmojiKeywordsDifference": func(p *textParser) TL {
+			var e TL_messages_getEmojiKeywordsDifference
+			for p.field() {
+				switch p.fieldName {
+				case "lang_code":
+					e.LangCode = p.String()
+				case "from_version":
+					e.FromVersion = p.Int()
+				default:
+					p.unknownField("messages.getEmojiKeywordsDifference")
+				}
+			}
+			return e
+		},
+		"messages.getEmojiKeywordsLanguages": func(p *textParser) TL {
+			var e TL_messages_getEmojiKeywordsLanguages
+			for p.field() {
+				switch p.fieldName {
+				case "lang_codes":
+					e.LangCodes = p.VectorString()
+				default:
+					p.unknownField("messages.getEmojiKeywordsLanguages")
+				}
+			}
+			return e
+		},
+		"messages.getEmojiURL": func(p *textParser) TL {
+			var e TL_messages_getEmojiURL
+			for p.field() {
+				switch p.fieldName {
+				case "lang_code":
+					e.LangCode = p.String()
+				default:
+					p.unknownField("messages.getEmojiURL")
+				}
+			}
+			return e
+		},
+		"messages.getSearchCounters": func(p *textParser) TL {
+			var e TL_messages_getSearchCounters
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "filters":
+					e.Filters = p.Vector()
+				default:
+					p.unknownField("messages.getSearchCounters")
+				}
+			}
+			return e
+		},
+		"messages.requestUrlAuth": func(p *textParser) TL {
+			var e TL_messages_requestUrlAuth
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "peer":
+					e.Peer = p.Object()
+					e.Flags |= 2
+				case "msg_id":
+					e.MsgID = p.Int()
+					e.Flags |= 2
+				case "button_id":
+					e.ButtonID = p.Int()
+					e.Flags |= 2
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 4
+				default:
+					p.unknownField("messages.requestUrlAuth")
+				}
+			}
+			return e
+		},
+		"messages.acceptUrlAuth": func(p *textParser) TL {
+			var e TL_messages_acceptUrlAuth
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "write_allowed":
+					e.WriteAllowed = p.Bool()
+					if e.WriteAllowed {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+					e.Flags |= 2
+				case "msg_id":
+					e.MsgID = p.Int()
+					e.Flags |= 2
+				case "button_id":
+					e.ButtonID = p.Int()
+					e.Flags |= 2
+				case "url":
+					e.Url = p.String()
+					e.Flags |= 4
+				default:
+					p.unknownField("messages.acceptUrlAuth")
+				}
+			}
+			return e
+		},
+		"messages.hidePeerSettingsBar": func(p *textParser) TL {
+			var e TL_messages_hidePeerSettingsBar
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.hidePeerSettingsBar")
+				}
+			}
+			return e
+		},
+		"messages.getScheduledHistory": func(p *textParser) TL {
+			var e TL_messages_getScheduledHistory
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getScheduledHistory")
+				}
+			}
+			return e
+		},
+		"messages.getScheduledMessages": func(p *textParser) TL {
+			var e TL_messages_getScheduledMessages
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("messages.getScheduledMessages")
+				}
+			}
+			return e
+		},
+		"messages.sendScheduledMessages": func(p *textParser) TL {
+			var e TL_messages_sendScheduledMessages
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("messages.sendScheduledMessages")
+				}
+			}
+			return e
+		},
+		"messages.deleteScheduledMessages": func(p *textParser) TL {
+			var e TL_messages_deleteScheduledMessages
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("messages.deleteScheduledMessages")
+				}
+			}
+			return e
+		},
+		"messages.getPollVotes": func(p *textParser) TL {
+			var e TL_messages_getPollVotes
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "peer":
+					e.Peer = p.Object()
+				case "id":
+					e.ID = p.Int()
+				case "option":
+					e.Option = p.Bytes()
+					e.Flags |= 1
+				case "offset":
+					e.Offset = p.String()
+					e.Flags |= 2
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("messages.getPollVotes")
+				}
+			}
+			return e
+		},
+		"messages.toggleStickerSets": func(p *textParser) TL {
+			var e TL_messages_toggleStickerSets
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "uninstall":
+					e.Uninstall = p.Bool()
+					if e.Uninstall {
+						e.Flags |= 1
+					}
+				case "archive":
+					e.Archive = p.Bool()
+					if e.Archive {
+						e.Flags |= 2
+					}
+				case "unarchive":
+					e.Unarchive = p.Bool()
+					if e.Unarchive {
+						e.Flags |= 4
+					}
+				case "stickersets":
+					e.Stickersets = p.Vector()
+				default:
+					p.unknownField("messages.toggleStickerSets")
+				}
+			}
+			return e
+		},
+		"messages.getDialogFilters": func(p *textParser) TL {
+			var e TL_messages_getDialogFilters
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.getDialogFilters")
+				}
+			}
+			return e
+		},
+		"messages.getSuggestedDialogFilters": func(p *textParser) TL {
+			var e TL_messages_getSuggestedDialogFilters
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("messages.getSuggestedDialogFilters")
+				}
+			}
+			return e
+		},
+		"messages.updateDialogFilter": func(p *textParser) TL {
+			var e TL_messages_updateDialogFilter
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "id":
+					e.ID = p.Int()
+				case "filter":
+					e.Filter = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("messages.updateDialogFilter")
+				}
+			}
+			return e
+		},
+		"messages.updateDialogFiltersOrder": func(p *textParser) TL {
+			var e TL_messages_updateDialogFiltersOrder
+			for p.field() {
+				switch p.fieldName {
+				case "order":
+					e.Order = p.VectorInt()
+				default:
+					p.unknownField("messages.updateDialogFiltersOrder")
+				}
+			}
+			return e
+		},
+		"messages.getOldFeaturedStickers": func(p *textParser) TL {
+			var e TL_messages_getOldFeaturedStickers
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getOldFeaturedStickers")
+				}
+			}
+			return e
+		},
+		"messages.getReplies": func(p *textParser) TL {
+			var e TL_messages_getReplies
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "offset_id":
+					e.OffsetID = p.Int()
+				case "offset_date":
+					e.OffsetDate = p.Int()
+				case "add_offset":
+					e.AddOffset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				case "max_id":
+					e.MaxID = p.Int()
+				case "min_id":
+					e.MinID = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("messages.getReplies")
+				}
+			}
+			return e
+		},
+		"messages.getDiscussionMessage": func(p *textParser) TL {
+			var e TL_messages_getDiscussionMessage
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				default:
+					p.unknownField("messages.getDiscussionMessage")
+				}
+			}
+			return e
+		},
+		"messages.readDiscussion": func(p *textParser) TL {
+			var e TL_messages_readDiscussion
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "read_max_id":
+					e.ReadMaxID = p.Int()
+				default:
+					p.unknownField("messages.readDiscussion")
+				}
+			}
+			return e
+		},
+		"messages.unpinAllMessages": func(p *textParser) TL {
+			var e TL_messages_unpinAllMessages
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.unpinAllMessages")
+				}
+			}
+			return e
+		},
+		"messages.deleteChat": func(p *textParser) TL {
+			var e TL_messages_deleteChat
+			for p.field() {
+				switch p.fieldName {
+				case "chat_id":
+					e.ChatID = p.Int()
+				default:
+					p.unknownField("messages.deleteChat")
+				}
+			}
+			return e
+		},
+		"messages.deletePhoneCallHistory": func(p *textParser) TL {
+			var e TL_messages_deletePhoneCallHistory
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "revoke":
+					e.Revoke = p.Bool()
+					if e.Revoke {
+						e.Flags |= 1
+					}
+				default:
+					p.unknownField("messages.deletePhoneCallHistory")
+				}
+			}
+			return e
+		},
+		"messages.checkHistoryImport": func(p *textParser) TL {
+			var e TL_messages_checkHistoryImport
+			for p.field() {
+				switch p.fieldName {
+				case "import_head":
+					e.ImportHead = p.String()
+				default:
+					p.unknownField("messages.checkHistoryImport")
+				}
+			}
+			return e
+		},
+		"messages.initHistoryImport": func(p *textParser) TL {
+			var e TL_messages_initHistoryImport
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "file":
+					e.File = p.Object()
+				case "media_count":
+					e.MediaCount = p.Int()
+				default:
+					p.unknownField("messages.initHistoryImport")
+				}
+			}
+			return e
+		},
+		"messages.uploadImportedMedia": func(p *textParser) TL {
+			var e TL_messages_uploadImportedMedia
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "import_id":
+					e.ImportID = p.Long()
+				case "file_name":
+					e.FileName = p.String()
+				case "media":
+					e.Media = p.Object()
+				default:
+					p.unknownField("messages.uploadImportedMedia")
+				}
+			}
+			return e
+		},
+		"messages.startHistoryImport": func(p *textParser) TL {
+			var e TL_messages_startHistoryImport
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "import_id":
+					e.ImportID = p.Long()
+				default:
+					p.unknownField("messages.startHistoryImport")
+				}
+			}
+			return e
+		},
+		"messages.getExportedChatInvites": func(p *textParser) TL {
+			var e TL_messages_getExportedChatInvites
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "revoked":
+					e.Revoked = p.Bool()
+					if e.Revoked {
+						e.Flags |= 8
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "admin_id":
+					e.AdminID = p.Object()
+				case "offset_date":
+					e.OffsetDate = p.Int()
+					e.Flags |= 4
+				case "offset_link":
+					e.OffsetLink = p.String()
+					e.Flags |= 4
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("messages.getExportedChatInvites")
+				}
+			}
+			return e
+		},
+		"messages.getExportedChatInvite": func(p *textParser) TL {
+			var e TL_messages_getExportedChatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "link":
+					e.Link = p.String()
+				default:
+					p.unknownField("messages.getExportedChatInvite")
+				}
+			}
+			return e
+		},
+		"messages.editExportedChatInvite": func(p *textParser) TL {
+			var e TL_messages_editExportedChatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "revoked":
+					e.Revoked = p.Bool()
+					if e.Revoked {
+						e.Flags |= 4
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "link":
+					e.Link = p.String()
+				case "expire_date":
+					e.ExpireDate = p.Int()
+					e.Flags |= 1
+				case "usage_limit":
+					e.UsageLimit = p.Int()
+					e.Flags |= 2
+				default:
+					p.unknownField("messages.editExportedChatInvite")
+				}
+			}
+			return e
+		},
+		"messages.deleteRevokedExportedChatInvites": func(p *textParser) TL {
+			var e TL_messages_deleteRevokedExportedChatInvites
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "admin_id":
+					e.AdminID = p.Object()
+				default:
+					p.unknownField("messages.deleteRevokedExportedChatInvites")
+				}
+			}
+			return e
+		},
+		"messages.deleteExportedChatInvite": func(p *textParser) TL {
+			var e TL_messages_deleteExportedChatInvite
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "link":
+					e.Link = p.String()
+				default:
+					p.unknownField("messages.deleteExportedChatInvite")
+				}
+			}
+			return e
+		},
+		"messages.getAdminsWithInvites": func(p *textParser) TL {
+			var e TL_messages_getAdminsWithInvites
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.getAdminsWithInvites")
+				}
+			}
+			return e
+		},
+		"messages.getChatInviteImporters": func(p *textParser) TL {
+			var e TL_messages_getChatInviteImporters
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "link":
+					e.Link = p.String()
+				case "offset_date":
+					e.OffsetDate = p.Int()
+				case "offset_user":
+					e.OffsetUser = p.Object()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("messages.getChatInviteImporters")
+				}
+			}
+			return e
+		},
+		"messages.setHistoryTTL": func(p *textParser) TL {
+			var e TL_messages_setHistoryTTL
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "period":
+					e.Period = p.Int()
+				default:
+					p.unknownField("messages.setHistoryTTL")
+				}
+			}
+			return e
+		},
+		"messages.checkHistoryImportPeer": func(p *textParser) TL {
+			var e TL_messages_checkHistoryImportPeer
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("messages.checkHistoryImportPeer")
+				}
+			}
+			return e
+		},
+		"updates.getState": func(p *textParser) TL {
+			var e TL_updates_getState
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("updates.getState")
+				}
+			}
+			return e
+		},
+		"updates.getDifference": func(p *textParser) TL {
+			var e TL_updates_getDifference
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "pts":
+					e.Pts = p.Int()
+				case "pts_total_limit":
+					e.PtsTotalLimit = p.Int()
+					e.Flags |= 1
+				case "date":
+					e.Date = p.Int()
+				case "qts":
+					e.Qts = p.Int()
+				default:
+					p.unknownField("updates.getDifference")
+				}
+			}
+			return e
+		},
+		"updates.getChannelDifference": func(p *textParser) TL {
+			var e TL_updates_getChannelDifference
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "force":
+					e.Force = p.Bool()
+					if e.Force {
+						e.Flags |= 1
+					}
+				case "channel":
+					e.Channel = p.Object()
+				case "filter":
+					e.Filter = p.Object()
+				case "pts":
+					e.Pts = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("updates.getChannelDifference")
+				}
+			}
+			return e
+		},
+		"photos.updateProfilePhoto": func(p *textParser) TL {
+			var e TL_photos_updateProfilePhoto
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("photos.updateProfilePhoto")
+				}
+			}
+			return e
+		},
+		"photos.uploadProfilePhoto": func(p *textParser) TL {
+			var e TL_photos_uploadProfilePhoto
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "file":
+					e.File = p.Object()
+					e.Flags |= 1
+				case "video":
+					e.Video = p.Object()
+					e.Flags |= 2
+				case "video_start_ts":
+					e.VideoStartTs = p.Double()
+					e.Flags |= 4
+				default:
+					p.unknownField("photos.uploadProfilePhoto")
+				}
+			}
+			return e
+		},
+		"photos.deletePhotos": func(p *textParser) TL {
+			var e TL_photos_deletePhotos
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Vector()
+				default:
+					p.unknownField("photos.deletePhotos")
+				}
+			}
+			return e
+		},
+		"photos.getUserPhotos": func(p *textParser) TL {
+			var e TL_photos_getUserPhotos
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Object()
+				case "offset":
+					e.Offset = p.Int()
+				case "max_id":
+					e.MaxID = p.Long()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("photos.getUserPhotos")
+				}
+			}
+			return e
+		},
+		"upload.saveFilePart": func(p *textParser) TL {
+			var e TL_upload_saveFilePart
+			for p.field() {
+				switch p.fieldName {
+				case "file_id":
+					e.FileID = p.Long()
+				case "file_part":
+					e.FilePart = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("upload.saveFilePart")
+				}
+			}
+			return e
+		},
+		"upload.getFile": func(p *textParser) TL {
+			var e TL_upload_getFile
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "precise":
+					e.Precise = p.Bool()
+					if e.Precise {
+						e.Flags |= 1
+					}
+				case "cdn_supported":
+					e.CdnSupported = p.Bool()
+					if e.CdnSupported {
+						e.Flags |= 2
+					}
+				case "location":
+					e.Location = p.Object()
+				case "offset":
+					e.Offset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("upload.getFile")
+				}
+			}
+			return e
+		},
+		"upload.saveBigFilePart": func(p *textParser) TL {
+			var e TL_upload_saveBigFilePart
+			for p.field() {
+				switch p.fieldName {
+				case "file_id":
+					e.FileID = p.Long()
+				case "file_part":
+					e.FilePart = p.Int()
+				case "file_total_parts":
+					e.FileTotalParts = p.Int()
+				case "bytes":
+					e.Bytes = p.Bytes()
+				default:
+					p.unknownField("upload.saveBigFilePart")
+				}
+			}
+			return e
+		},
+		"upload.getWebFile": func(p *textParser) TL {
+			var e TL_upload_getWebFile
+			for p.field() {
+				switch p.fieldName {
+				case "location":
+					e.Location = p.Object()
+				case "offset":
+					e.Offset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("upload.getWebFile")
+				}
+			}
+			return e
+		},
+		"upload.getCdnFile": func(p *textParser) TL {
+			var e TL_upload_getCdnFile
+			for p.field() {
+				switch p.fieldName {
+				case "file_token":
+					e.FileToken = p.Bytes()
+				case "offset":
+					e.Offset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("upload.getCdnFile")
+				}
+			}
+			return e
+		},
+		"upload.reuploadCdnFile": func(p *textParser) TL {
+			var e TL_upload_reuploadCdnFile
+			for p.field() {
+				switch p.fieldName {
+				case "file_token":
+					e.FileToken = p.Bytes()
+				case "request_token":
+					e.RequestToken = p.Bytes()
+				default:
+					p.unknownField("upload.reuploadCdnFile")
+				}
+			}
+			return e
+		},
+		"upload.getCdnFileHashes": func(p *textParser) TL {
+			var e TL_upload_getCdnFileHashes
+			for p.field() {
+				switch p.fieldName {
+				case "file_token":
+					e.FileToken = p.Bytes()
+				case "offset":
+					e.Offset = p.Int()
+				default:
+					p.unknownField("upload.getCdnFileHashes")
+				}
+			}
+			return e
+		},
+		"upload.getFileHashes": func(p *textParser) TL {
+			var e TL_upload_getFileHashes
+			for p.field() {
+				switch p.fieldName {
+				case "location":
+					e.Location = p.Object()
+				case "offset":
+					e.Offset = p.Int()
+				default:
+					p.unknownField("upload.getFileHashes")
+				}
+			}
+			return e
+		},
+		"help.getConfig": func(p *textParser) TL {
+			var e TL_help_getConfig
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getConfig")
+				}
+			}
+			return e
+		},
+		"help.getNearestDc": func(p *textParser) TL {
+			var e TL_help_getNearestDc
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getNearestDc")
+				}
+			}
+			return e
+		},
+		"help.getAppUpdate": func(p *textParser) TL {
+			var e TL_help_getAppUpdate
+			for p.field() {
+				switch p.fieldName {
+				case "source":
+					e.Source = p.String()
+				default:
+					p.unknownField("help.getAppUpdate")
+				}
+			}
+			return e
+		},
+		"help.getInviteText": func(p *textParser) TL {
+			var e TL_help_getInviteText
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getInviteText")
+				}
+			}
+			return e
+		},
+		"help.getSupport": func(p *textParser) TL {
+			var e TL_help_getSupport
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getSupport")
+				}
+			}
+			return e
+		},
+		"help.getAppChangelog": func(p *textParser) TL {
+			var e TL_help_getAppChangelog
+			for p.field() {
+				switch p.fieldName {
+				case "prev_app_version":
+					e.PrevAppVersion = p.String()
+				default:
+					p.unknownField("help.getAppChangelog")
+				}
+			}
+			return e
+		},
+		"help.setBotUpdatesStatus": func(p *textParser) TL {
+			var e TL_help_setBotUpdatesStatus
+			for p.field() {
+				switch p.fieldName {
+				case "pending_updates_count":
+					e.PendingUpdatesCount = p.Int()
+				case "message":
+					e.Message = p.String()
+				default:
+					p.unknownField("help.setBotUpdatesStatus")
+				}
+			}
+			return e
+		},
+		"help.getCdnConfig": func(p *textParser) TL {
+			var e TL_help_getCdnConfig
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getCdnConfig")
+				}
+			}
+			return e
+		},
+		"help.getRecentMeUrls": func(p *textParser) TL {
+			var e TL_help_getRecentMeUrls
+			for p.field() {
+				switch p.fieldName {
+				case "referer":
+					e.Referer = p.String()
+				default:
+					p.unknownField("help.getRecentMeUrls")
+				}
+			}
+			return e
+		},
+		"help.getTermsOfServiceUpdate": func(p *textParser) TL {
+			var e TL_help_getTermsOfServiceUpdate
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getTermsOfServiceUpdate")
+				}
+			}
+			return e
+		},
+		"help.acceptTermsOfService": func(p *textParser) TL {
+			var e TL_help_acceptTermsOfService
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Object()
+				default:
+					p.unknownField("help.acceptTermsOfService")
+				}
+			}
+			return e
+		},
+		"help.getDeepLinkInfo": func(p *textParser) TL {
+			var e TL_help_getDeepLinkInfo
+			for p.field() {
+				switch p.fieldName {
+				case "path":
+					e.Path = p.String()
+				default:
+					p.unknownField("help.getDeepLinkInfo")
+				}
+			}
+			return e
+		},
+		"help.getAppConfig": func(p *textParser) TL {
+			var e TL_help_getAppConfig
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getAppConfig")
+				}
+			}
+			return e
+		},
+		"help.saveAppLog": func(p *textParser) TL {
+			var e TL_help_saveAppLog
+			for p.field() {
+				switch p.fieldName {
+				case "events":
+					e.Events = p.Vector()
+				default:
+					p.unknownField("help.saveAppLog")
+				}
+			}
+			return e
+		},
+		"help.getPassportConfig": func(p *textParser) TL {
+			var e TL_help_getPassportConfig
+			for p.field() {
+				switch p.fieldName {
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("help.getPassportConfig")
+				}
+			}
+			return e
+		},
+		"help.getSupportName": func(p *textParser) TL {
+			var e TL_help_getSupportName
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getSupportName")
+				}
+			}
+			return e
+		},
+		"help.getUserInfo": func(p *textParser) TL {
+			var e TL_help_getUserInfo
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Object()
+				default:
+					p.unknownField("help.getUserInfo")
+				}
+			}
+			return e
+		},
+		"help.editUserInfo": func(p *textParser) TL {
+			var e TL_help_editUserInfo
+			for p.field() {
+				switch p.fieldName {
+				case "user_id":
+					e.UserID = p.Object()
+				case "message":
+					e.Message = p.String()
+				case "entities":
+					e.Entities = p.Vector()
+				default:
+					p.unknownField("help.editUserInfo")
+				}
+			}
+			return e
+		},
+		"help.getPromoData": func(p *textParser) TL {
+			var e TL_help_getPromoData
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("help.getPromoData")
+				}
+			}
+			return e
+		},
+		"help.hidePromoData": func(p *textParser) TL {
+			var e TL_help_hidePromoData
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("help.hidePromoData")
+				}
+			}
+			return e
+		},
+		"help.dismissSuggestion": func(p *textParser) TL {
+			var e TL_help_dismissSuggestion
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "suggestion":
+					e.Suggestion = p.String()
+				default:
+					p.unknownField("help.dismissSuggestion")
+				}
+			}
+			return e
+		},
+		"help.getCountriesList": func(p *textParser) TL {
+			var e TL_help_getCountriesList
+			for p.field() {
+				switch p.fieldName {
+				case "lang_code":
+					e.LangCode = p.String()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("help.getCountriesList")
+				}
+			}
+			return e
+		},
+		"channels.readHistory": func(p *textParser) TL {
+			var e TL_channels_readHistory
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "max_id":
+					e.MaxID = p.Int()
+				default:
+					p.unknownField("channels.readHistory")
+				}
+			}
+			return e
+		},
+		"channels.deleteMessages": func(p *textParser) TL {
+			var e TL_channels_deleteMessages
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("channels.deleteMessages")
+				}
+			}
+			return e
+		},
+		"channels.deleteUserHistory": func(p *textParser) TL {
+			var e TL_channels_deleteUserHistory
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "user_id":
+					e.UserID = p.Object()
+				default:
+					p.unknownField("channels.deleteUserHistory")
+				}
+			}
+			return e
+		},
+		"channels.reportSpam": func(p *textParser) TL {
+			var e TL_channels_reportSpam
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "user_id":
+					e.UserID = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("channels.reportSpam")
+				}
+			}
+			return e
+		},
+		"channels.getMessages": func(p *textParser) TL {
+			var e TL_channels_getMessages
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "id":
+					e.ID = p.Vector()
+				default:
+					p.unknownField("channels.getMessages")
+				}
+			}
+			return e
+		},
+		"channels.getParticipants": func(p *textParser) TL {
+			var e TL_channels_getParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "filter":
+					e.Filter = p.Object()
+				case "offset":
+					e.Offset = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				case "hash":
+					e.Hash = p.Int()
+				default:
+					p.unknownField("channels.getParticipants")
+				}
+			}
+			return e
+		},
+		"channels.getParticipant": func(p *textParser) TL {
+			var e TL_channels_getParticipant
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "participant":
+					e.Participant = p.Object()
+				default:
+					p.unknownField("channels.getParticipant")
+				}
+			}
+			return e
+		},
+		"channels.getChannels": func(p *textParser) TL {
+			var e TL_channels_getChannels
+			for p.field() {
+				switch p.fieldName {
+				case "id":
+					e.ID = p.Vector()
+				default:
+					p.unknownField("channels.getChannels")
+				}
+			}
+			return e
+		},
+		"channels.getFullChannel": func(p *textParser) TL {
+			var e TL_channels_getFullChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				default:
+					p.unknownField("channels.getFullChannel")
+				}
+			}
+			return e
+		},
+		"channels.createChannel": func(p *textParser) TL {
+			var e TL_channels_createChannel
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "broadcast":
+					e.Broadcast = p.Bool()
+					if e.Broadcast {
+						e.Flags |= 1
+					}
+				case "megagroup":
+					e.Megagroup = p.Bool()
+					if e.Megagroup {
+						e.Flags |= 2
+					}
+				case "for_import":
+					e.ForImport = p.Bool()
+					if e.ForImport {
+						e.Flags |= 8
+					}
+				case "title":
+					e.Title = p.String()
+				case "about":
+					e.About = p.String()
+				case "geo_point":
+					e.GeoPoint = p.Object()
+					e.Flags |= 4
+				case "address":
+					e.Address = p.String()
+					e.Flags |= 4
+				default:
+					p.unknownField("channels.createChannel")
+				}
+			}
+			return e
+		},
+		"channels.editAdmin": func(p *textParser) TL {
+			var e TL_channels_editAdmin
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "user_id":
+					e.UserID = p.Object()
+				case "admin_rights":
+					e.AdminRights = p.Object()
+				case "rank":
+					e.Rank = p.String()
+				default:
+					p.unknownField("channels.editAdmin")
+				}
+			}
+			return e
+		},
+		"channels.editTitle": func(p *textParser) TL {
+			var e TL_channels_editTitle
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "title":
+					e.Title = p.String()
+				default:
+					p.unknownField("channels.editTitle")
+				}
+			}
+			return e
+		},
+		"channels.editPhoto": func(p *textParser) TL {
+			var e TL_channels_editPhoto
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "photo":
+					e.Photo = p.Object()
+				default:
+					p.unknownField("channels.editPhoto")
+				}
+			}
+			return e
+		},
+		"channels.checkUsername": func(p *textParser) TL {
+			var e TL_channels_checkUsername
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "username":
+					e.Username = p.String()
+				default:
+					p.unknownField("channels.checkUsername")
+				}
+			}
+			return e
+		},
+		"channels.updateUsername": func(p *textParser) TL {
+			var e TL_channels_updateUsername
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "username":
+					e.Username = p.String()
+				default:
+					p.unknownField("channels.updateUsername")
+				}
+			}
+			return e
+		},
+		"channels.joinChannel": func(p *textParser) TL {
+			var e TL_channels_joinChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				default:
+					p.unknownField("channels.joinChannel")
+				}
+			}
+			return e
+		},
+		"channels.leaveChannel": func(p *textParser) TL {
+			var e TL_channels_leaveChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				default:
+					p.unknownField("channels.leaveChannel")
+				}
+			}
+			return e
+		},
+		"channels.inviteToChannel": func(p *textParser) TL {
+			var e TL_channels_inviteToChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("channels.inviteToChannel")
+				}
+			}
+			return e
+		},
+		"channels.deleteChannel": func(p *textParser) TL {
+			var e TL_channels_deleteChannel
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				default:
+					p.unknownField("channels.deleteChannel")
+				}
+			}
+			return e
+		},
+		"channels.exportMessageLink": func(p *textParser) TL {
+			var e TL_channels_exportMessageLink
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "grouped":
+					e.Grouped = p.Bool()
+					if e.Grouped {
+						e.Flags |= 1
+					}
+				case "thread":
+					e.Thread = p.Bool()
+					if e.Thread {
+						e.Flags |= 2
+					}
+				case "channel":
+					e.Channel = p.Object()
+				case "id":
+					e.ID = p.Int()
+				default:
+					p.unknownField("channels.exportMessageLink")
+				}
+			}
+			return e
+		},
+		"channels.toggleSignatures": func(p *textParser) TL {
+			var e TL_channels_toggleSignatures
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "enabled":
+					e.Enabled = p.Object()
+				default:
+					p.unknownField("channels.toggleSignatures")
+				}
+			}
+			return e
+		},
+		"channels.getAdminedPublicChannels": func(p *textParser) TL {
+			var e TL_channels_getAdminedPublicChannels
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "by_location":
+					e.ByLocation = p.Bool()
+					if e.ByLocation {
+						e.Flags |= 1
+					}
+				case "check_limit":
+					e.CheckLimit = p.Bool()
+					if e.CheckLimit {
+						e.Flags |= 2
+					}
+				default:
+					p.unknownField("channels.getAdminedPublicChannels")
+				}
+			}
+			return e
+		},
+		"channels.editBanned": func(p *textParser) TL {
+			var e TL_channels_editBanned
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "participant":
+					e.Participant = p.Object()
+				case "banned_rights":
+					e.BannedRights = p.Object()
+				default:
+					p.unknownField("channels.editBanned")
+				}
+			}
+			return e
+		},
+		"channels.getAdminLog": func(p *textParser) TL {
+			var e TL_channels_getAdminLog
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "channel":
+					e.Channel = p.Object()
+				case "q":
+					e.Q = p.String()
+				case "events_filter":
+					e.EventsFilter = p.Object()
+					e.Flags |= 1
+				case "admins":
+					e.Admins = p.Vector()
+					e.Flags |= 2
+				case "max_id":
+					e.MaxID = p.Long()
+				case "min_id":
+					e.MinID = p.Long()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("channels.getAdminLog")
+				}
+			}
+			return e
+		},
+		"channels.setStickers": func(p *textParser) TL {
+			var e TL_channels_setStickers
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "stickerset":
+					e.Stickerset = p.Object()
+				default:
+					p.unknownField("channels.setStickers")
+				}
+			}
+			return e
+		},
+		"channels.readMessageContents": func(p *textParser) TL {
+			var e TL_channels_readMessageContents
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "id":
+					e.ID = p.VectorInt()
+				default:
+					p.unknownField("channels.readMessageContents")
+				}
+			}
+			return e
+		},
+		"channels.deleteHistory": func(p *textParser) TL {
+			var e TL_channels_deleteHistory
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "max_id":
+					e.MaxID = p.Int()
+				default:
+					p.unknownField("channels.deleteHistory")
+				}
+			}
+			return e
+		},
+		"channels.togglePreHistoryHidden": func(p *textParser) TL {
+			var e TL_channels_togglePreHistoryHidden
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "enabled":
+					e.Enabled = p.Object()
+				default:
+					p.unknownField("channels.togglePreHistoryHidden")
+				}
+			}
+			return e
+		},
+		"channels.getLeftChannels": func(p *textParser) TL {
+			var e TL_channels_getLeftChannels
+			for p.field() {
+				switch p.fieldName {
+				case "offset":
+					e.Offset = p.Int()
+				default:
+					p.unknownField("channels.getLeftChannels")
+				}
+			}
+			return e
+		},
+		"channels.getGroupsForDiscussion": func(p *textParser) TL {
+			var e TL_channels_getGroupsForDiscussion
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channels.getGroupsForDiscussion")
+				}
+			}
+			return e
+		},
+		"channels.setDiscussionGroup": func(p *textParser) TL {
+			var e TL_channels_setDiscussionGroup
+			for p.field() {
+				switch p.fieldName {
+				case "broadcast":
+					e.Broadcast = p.Object()
+				case "group":
+					e.Group = p.Object()
+				default:
+					p.unknownField("channels.setDiscussionGroup")
+				}
+			}
+			return e
+		},
+		"channels.editCreator": func(p *textParser) TL {
+			var e TL_channels_editCreator
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "user_id":
+					e.UserID = p.Object()
+				case "password":
+					e.Password = p.Object()
+				default:
+					p.unknownField("channels.editCreator")
+				}
+			}
+			return e
+		},
+		"channels.editLocation": func(p *textParser) TL {
+			var e TL_channels_editLocation
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "geo_point":
+					e.GeoPoint = p.Object()
+				case "address":
+					e.Address = p.String()
+				default:
+					p.unknownField("channels.editLocation")
+				}
+			}
+			return e
+		},
+		"channels.toggleSlowMode": func(p *textParser) TL {
+			var e TL_channels_toggleSlowMode
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "seconds":
+					e.Seconds = p.Int()
+				default:
+					p.unknownField("channels.toggleSlowMode")
+				}
+			}
+			return e
+		},
+		"channels.getInactiveChannels": func(p *textParser) TL {
+			var e TL_channels_getInactiveChannels
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("channels.getInactiveChannels")
+				}
+			}
+			return e
+		},
+		"channels.convertToGigagroup": func(p *textParser) TL {
+			var e TL_channels_convertToGigagroup
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				default:
+					p.unknownField("channels.convertToGigagroup")
+				}
+			}
+			return e
+		},
+		"bots.sendCustomRequest": func(p *textParser) TL {
+			var e TL_bots_sendCustomRequest
+			for p.field() {
+				switch p.fieldName {
+				case "custom_method":
+					e.CustomMethod = p.String()
+				case "params":
+					e.Params = p.Object()
+				default:
+					p.unknownField("bots.sendCustomRequest")
+				}
+			}
+			return e
+		},
+		"bots.answerWebhookJSONQuery": func(p *textParser) TL {
+			var e TL_bots_answerWebhookJSONQuery
+			for p.field() {
+				switch p.fieldName {
+				case "query_id":
+					e.QueryID = p.Long()
+				case "data":
+					e.Data = p.Object()
+				default:
+					p.unknownField("bots.answerWebhookJSONQuery")
+				}
+			}
+			return e
+		},
+		"bots.setBotCommands": func(p *textParser) TL {
+			var e TL_bots_setBotCommands
+			for p.field() {
+				switch p.fieldName {
+				case "commands":
+					e.Commands = p.Vector()
+				default:
+					p.unknownField("bots.setBotCommands")
+				}
+			}
+			return e
+		},
+		"payments.getPaymentForm": func(p *textParser) TL {
+			var e TL_payments_getPaymentForm
+			for p.field() {
+				switch p.fieldName {
+				case "msg_id":
+					e.MsgID = p.Int()
+				default:
+					p.unknownField("payments.getPaymentForm")
+				}
+			}
+			return e
+		},
+		"payments.getPaymentReceipt": func(p *textParser) TL {
+			var e TL_payments_getPaymentReceipt
+			for p.field() {
+				switch p.fieldName {
+				case "msg_id":
+					e.MsgID = p.Int()
+				default:
+					p.unknownField("payments.getPaymentReceipt")
+				}
+			}
+			return e
+		},
+		"payments.validateRequestedInfo": func(p *textParser) TL {
+			var e TL_payments_validateRequestedInfo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "save":
+					e.Save = p.Bool()
+					if e.Save {
+						e.Flags |= 1
+					}
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "info":
+					e.Info = p.Object()
+				default:
+					p.unknownField("payments.validateRequestedInfo")
+				}
+			}
+			return e
+		},
+		"payments.sendPaymentForm": func(p *textParser) TL {
+			var e TL_payments_sendPaymentForm
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "requested_info_id":
+					e.RequestedInfoID = p.String()
+					e.Flags |= 1
+				case "shipping_option_id":
+					e.ShippingOptionID = p.String()
+					e.Flags |= 2
+				case "credentials":
+					e.Credentials = p.Object()
+				default:
+					p.unknownField("payments.sendPaymentForm")
+				}
+			}
+			return e
+		},
+		"payments.getSavedInfo": func(p *textParser) TL {
+			var e TL_payments_getSavedInfo
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("payments.getSavedInfo")
+				}
+			}
+			return e
+		},
+		"payments.clearSavedInfo": func(p *textParser) TL {
+			var e TL_payments_clearSavedInfo
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "credentials":
+					e.Credentials = p.Bool()
+					if e.Credentials {
+						e.Flags |= 1
+					}
+				case "info":
+					e.Info = p.Bool()
+					if e.Info {
+						e.Flags |= 2
+					}
+				default:
+					p.unknownField("payments.clearSavedInfo")
+				}
+			}
+			return e
+		},
+		"payments.getBankCardData": func(p *textParser) TL {
+			var e TL_payments_getBankCardData
+			for p.field() {
+				switch p.fieldName {
+				case "number":
+					e.Number = p.String()
+				default:
+					p.unknownField("payments.getBankCardData")
+				}
+			}
+			return e
+		},
+		"stickers.createStickerSet": func(p *textParser) TL {
+			var e TL_stickers_createStickerSet
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "masks":
+					e.Masks = p.Bool()
+					if e.Masks {
+						e.Flags |= 1
+					}
+				case "animated":
+					e.Animated = p.Bool()
+					if e.Animated {
+						e.Flags |= 2
+					}
+				case "user_id":
+					e.UserID = p.Object()
+				case "title":
+					e.Title = p.String()
+				case "short_name":
+					e.ShortName = p.String()
+				case "thumb":
+					e.Thumb = p.Object()
+					e.Flags |= 4
+				case "stickers":
+					e.Stickers = p.Vector()
+				default:
+					p.unknownField("stickers.createStickerSet")
+				}
+			}
+			return e
+		},
+		"stickers.removeStickerFromSet": func(p *textParser) TL {
+			var e TL_stickers_removeStickerFromSet
+			for p.field() {
+				switch p.fieldName {
+				case "sticker":
+					e.Sticker = p.Object()
+				default:
+					p.unknownField("stickers.removeStickerFromSet")
+				}
+			}
+			return e
+		},
+		"stickers.changeStickerPosition": func(p *textParser) TL {
+			var e TL_stickers_changeStickerPosition
+			for p.field() {
+				switch p.fieldName {
+				case "sticker":
+					e.Sticker = p.Object()
+				case "position":
+					e.Position = p.Int()
+				default:
+					p.unknownField("stickers.changeStickerPosition")
+				}
+			}
+			return e
+		},
+		"stickers.addStickerToSet": func(p *textParser) TL {
+			var e TL_stickers_addStickerToSet
+			for p.field() {
+				switch p.fieldName {
+				case "stickerset":
+					e.Stickerset = p.Object()
+				case "sticker":
+					e.Sticker = p.Object()
+				default:
+					p.unknownField("stickers.addStickerToSet")
+				}
+			}
+			return e
+		},
+		"stickers.setStickerSetThumb": func(p *textParser) TL {
+			var e TL_stickers_setStickerSetThumb
+			for p.field() {
+				switch p.fieldName {
+				case "stickerset":
+					e.Stickerset = p.Object()
+				case "thumb":
+					e.Thumb = p.Object()
+				default:
+					p.unknownField("stickers.setStickerSetThumb")
+				}
+			}
+			return e
+		},
+		"phone.getCallConfig": func(p *textParser) TL {
+			var e TL_phone_getCallConfig
+			for p.field() {
+				switch p.fieldName {
+				default:
+					p.unknownField("phone.getCallConfig")
+				}
+			}
+			return e
+		},
+		"phone.requestCall": func(p *textParser) TL {
+			var e TL_phone_requestCall
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "video":
+					e.Video = p.Bool()
+					if e.Video {
+						e.Flags |= 1
+					}
+				case "user_id":
+					e.UserID = p.Object()
+				case "random_id":
+					e.RandomID = p.Int()
+				case "g_a_hash":
+					e.GAHash = p.Bytes()
+				case "protocol":
+					e.Protocol = p.Object()
+				default:
+					p.unknownField("phone.requestCall")
+				}
+			}
+			return e
+		},
+		"phone.acceptCall": func(p *textParser) TL {
+			var e TL_phone_acceptCall
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "g_b":
+					e.GB = p.Bytes()
+				case "protocol":
+					e.Protocol = p.Object()
+				default:
+					p.unknownField("phone.acceptCall")
+				}
+			}
+			return e
+		},
+		"phone.confirmCall": func(p *textParser) TL {
+			var e TL_phone_confirmCall
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "g_a":
+					e.GA = p.Bytes()
+				case "key_fingerprint":
+					e.KeyFingerprint = p.Long()
+				case "protocol":
+					e.Protocol = p.Object()
+				default:
+					p.unknownField("phone.confirmCall")
+				}
+			}
+			return e
+		},
+		"phone.receivedCall": func(p *textParser) TL {
+			var e TL_phone_receivedCall
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("phone.receivedCall")
+				}
+			}
+			return e
+		},
+		"phone.discardCall": func(p *textParser) TL {
+			var e TL_phone_discardCall
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "video":
+					e.Video = p.Bool()
+					if e.Video {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "duration":
+					e.Duration = p.Int()
+				case "reason":
+					e.Reason = p.Object()
+				case "connection_id":
+					e.ConnectionID = p.Long()
+				default:
+					p.unknownField("phone.discardCall")
+				}
+			}
+			return e
+		},
+		"phone.setCallRating": func(p *textParser) TL {
+			var e TL_phone_setCallRating
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "user_initiative":
+					e.UserInitiative = p.Bool()
+					if e.UserInitiative {
+						e.Flags |= 1
+					}
+				case "peer":
+					e.Peer = p.Object()
+				case "rating":
+					e.Rating = p.Int()
+				case "comment":
+					e.Comment = p.String()
+				default:
+					p.unknownField("phone.setCallRating")
+				}
+			}
+			return e
+		},
+		"phone.saveCallDebug": func(p *textParser) TL {
+			var e TL_phone_saveCallDebug
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "debug":
+					e.Debug = p.Object()
+				default:
+					p.unknownField("phone.saveCallDebug")
+				}
+			}
+			return e
+		},
+		"phone.sendSignalingData": func(p *textParser) TL {
+			var e TL_phone_sendSignalingData
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "data":
+					e.Data = p.Bytes()
+				default:
+					p.unknownField("phone.sendSignalingData")
+				}
+			}
+			return e
+		},
+		"phone.createGroupCall": func(p *textParser) TL {
+			var e TL_phone_createGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				case "random_id":
+					e.RandomID = p.Int()
+				default:
+					p.unknownField("phone.createGroupCall")
+				}
+			}
+			return e
+		},
+		"phone.joinGroupCall": func(p *textParser) TL {
+			var e TL_phone_joinGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "muted":
+					e.Muted = p.Bool()
+					if e.Muted {
+						e.Flags |= 1
+					}
+				case "call":
+					e.Call = p.Object()
+				case "join_as":
+					e.JoinAs = p.Object()
+				case "invite_hash":
+					e.InviteHash = p.String()
+					e.Flags |= 2
+				case "params":
+					e.Params = p.Object()
+				default:
+					p.unknownField("phone.joinGroupCall")
+				}
+			}
+			return e
+		},
+		"phone.leaveGroupCall": func(p *textParser) TL {
+			var e TL_phone_leaveGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "source":
+					e.Source = p.Int()
+				default:
+					p.unknownField("phone.leaveGroupCall")
+				}
+			}
+			return e
+		},
+		"phone.inviteToGroupCall": func(p *textParser) TL {
+			var e TL_phone_inviteToGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "users":
+					e.Users = p.Vector()
+				default:
+					p.unknownField("phone.inviteToGroupCall")
+				}
+			}
+			return e
+		},
+		"phone.discardGroupCall": func(p *textParser) TL {
+			var e TL_phone_discardGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				default:
+					p.unknownField("phone.discardGroupCall")
+				}
+			}
+			return e
+		},
+		"phone.toggleGroupCallSettings": func(p *textParser) TL {
+			var e TL_phone_toggleGroupCallSettings
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "reset_invite_hash":
+					e.ResetInviteHash = p.Bool()
+					if e.ResetInviteHash {
+						e.Flags |= 2
+					}
+				case "call":
+					e.Call = p.Object()
+				case "join_muted":
+					e.JoinMuted = p.Object()
+					e.Flags |= 1
+				default:
+					p.unknownField("phone.toggleGroupCallSettings")
+				}
+			}
+			return e
+		},
+		"phone.getGroupCall": func(p *textParser) TL {
+			var e TL_phone_getGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				default:
+					p.unknownField("phone.getGroupCall")
+				}
+			}
+			return e
+		},
+		"phone.getGroupParticipants": func(p *textParser) TL {
+			var e TL_phone_getGroupParticipants
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "ids":
+					e.Ids = p.Vector()
+				case "sources":
+					e.Sources = p.VectorInt()
+				case "offset":
+					e.Offset = p.String()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("phone.getGroupParticipants")
+				}
+			}
+			return e
+		},
+		"phone.checkGroupCall": func(p *textParser) TL {
+			var e TL_phone_checkGroupCall
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "source":
+					e.Source = p.Int()
+				default:
+					p.unknownField("phone.checkGroupCall")
+				}
+			}
+			return e
+		},
+		"phone.toggleGroupCallRecord": func(p *textParser) TL {
+			var e TL_phone_toggleGroupCallRecord
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "start":
+					e.Start = p.Bool()
+					if e.Start {
+						e.Flags |= 1
+					}
+				case "call":
+					e.Call = p.Object()
+				case "title":
+					e.Title = p.String()
+					e.Flags |= 2
+				default:
+					p.unknownField("phone.toggleGroupCallRecord")
+				}
+			}
+			return e
+		},
+		"phone.editGroupCallParticipant": func(p *textParser) TL {
+			var e TL_phone_editGroupCallParticipant
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "muted":
+					e.Muted = p.Bool()
+					if e.Muted {
+						e.Flags |= 1
+					}
+				case "call":
+					e.Call = p.Object()
+				case "participant":
+					e.Participant = p.Object()
+				case "volume":
+					e.Volume = p.Int()
+					e.Flags |= 2
+				case "raise_hand":
+					e.RaiseHand = p.Object()
+					e.Flags |= 4
+				default:
+					p.unknownField("phone.editGroupCallParticipant")
+				}
+			}
+			return e
+		},
+		"phone.editGroupCallTitle": func(p *textParser) TL {
+			var e TL_phone_editGroupCallTitle
+			for p.field() {
+				switch p.fieldName {
+				case "call":
+					e.Call = p.Object()
+				case "title":
+					e.Title = p.String()
+				default:
+					p.unknownField("phone.editGroupCallTitle")
+				}
+			}
+			return e
+		},
+		"phone.getGroupCallJoinAs": func(p *textParser) TL {
+			var e TL_phone_getGroupCallJoinAs
+			for p.field() {
+				switch p.fieldName {
+				case "peer":
+					e.Peer = p.Object()
+				default:
+					p.unknownField("phone.getGroupCallJoinAs")
+				}
+			}
+			return e
+		},
+		"phone.exportGroupCallInvite": func(p *textParser) TL {
+			var e TL_phone_exportGroupCallInvite
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "can_self_unmute":
+					e.CanSelfUnmute = p.Bool()
+					if e.CanSelfUnmute {
+						e.Flags |= 1
+					}
+				case "call":
+					e.Call = p.Object()
+				default:
+					p.unknownField("phone.exportGroupCallInvite")
+				}
+			}
+			return e
+		},
+		"langpack.getLangPack": func(p *textParser) TL {
+			var e TL_langpack_getLangPack
+			for p.field() {
+				switch p.fieldName {
+				case "lang_pack":
+					e.LangPack = p.String()
+				case "lang_code":
+					e.LangCode = p.String()
+				default:
+					p.unknownField("langpack.getLangPack")
+				}
+			}
+			return e
+		},
+		"langpack.getStrings": func(p *textParser) TL {
+			var e TL_langpack_getStrings
+			for p.field() {
+				switch p.fieldName {
+				case "lang_pack":
+					e.LangPack = p.String()
+				case "lang_code":
+					e.LangCode = p.String()
+				case "keys":
+					e.Keys = p.VectorString()
+				default:
+					p.unknownField("langpack.getStrings")
+				}
+			}
+			return e
+		},
+		"langpack.getDifference": func(p *textParser) TL {
+			var e TL_langpack_getDifference
+			for p.field() {
+				switch p.fieldName {
+				case "lang_pack":
+					e.LangPack = p.String()
+				case "lang_code":
+					e.LangCode = p.String()
+				case "from_version":
+					e.FromVersion = p.Int()
+				default:
+					p.unknownField("langpack.getDifference")
+				}
+			}
+			return e
+		},
+		"langpack.getLanguages": func(p *textParser) TL {
+			var e TL_langpack_getLanguages
+			for p.field() {
+				switch p.fieldName {
+				case "lang_pack":
+					e.LangPack = p.String()
+				default:
+					p.unknownField("langpack.getLanguages")
+				}
+			}
+			return e
+		},
+		"langpack.getLanguage": func(p *textParser) TL {
+			var e TL_langpack_getLanguage
+			for p.field() {
+				switch p.fieldName {
+				case "lang_pack":
+					e.LangPack = p.String()
+				case "lang_code":
+					e.LangCode = p.String()
+				default:
+					p.unknownField("langpack.getLanguage")
+				}
+			}
+			return e
+		},
+		"folders.editPeerFolders": func(p *textParser) TL {
+			var e TL_folders_editPeerFolders
+			for p.field() {
+				switch p.fieldName {
+				case "folder_peers":
+					e.FolderPeers = p.Vector()
+				default:
+					p.unknownField("folders.editPeerFolders")
+				}
+			}
+			return e
+		},
+		"folders.deleteFolder": func(p *textParser) TL {
+			var e TL_folders_deleteFolder
+			for p.field() {
+				switch p.fieldName {
+				case "folder_id":
+					e.FolderID = p.Int()
+				default:
+					p.unknownField("folders.deleteFolder")
+				}
+			}
+			return e
+		},
+		"stats.getBroadcastStats": func(p *textParser) TL {
+			var e TL_stats_getBroadcastStats
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "dark":
+					e.Dark = p.Bool()
+					if e.Dark {
+						e.Flags |= 1
+					}
+				case "channel":
+					e.Channel = p.Object()
+				default:
+					p.unknownField("stats.getBroadcastStats")
+				}
+			}
+			return e
+		},
+		"stats.loadAsyncGraph": func(p *textParser) TL {
+			var e TL_stats_loadAsyncGraph
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "token":
+					e.Token = p.String()
+				case "x":
+					e.X = p.Long()
+					e.Flags |= 1
+				default:
+					p.unknownField("stats.loadAsyncGraph")
+				}
+			}
+			return e
+		},
+		"stats.getMegagroupStats": func(p *textParser) TL {
+			var e TL_stats_getMegagroupStats
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "dark":
+					e.Dark = p.Bool()
+					if e.Dark {
+						e.Flags |= 1
+					}
+				case "channel":
+					e.Channel = p.Object()
+				default:
+					p.unknownField("stats.getMegagroupStats")
+				}
+			}
+			return e
+		},
+		"stats.getMessagePublicForwards": func(p *textParser) TL {
+			var e TL_stats_getMessagePublicForwards
+			for p.field() {
+				switch p.fieldName {
+				case "channel":
+					e.Channel = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				case "offset_rate":
+					e.OffsetRate = p.Int()
+				case "offset_peer":
+					e.OffsetPeer = p.Object()
+				case "offset_id":
+					e.OffsetID = p.Int()
+				case "limit":
+					e.Limit = p.Int()
+				default:
+					p.unknownField("stats.getMessagePublicForwards")
+				}
+			}
+			return e
+		},
+		"stats.getMessageStats": func(p *textParser) TL {
+			var e TL_stats_getMessageStats
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "dark":
+					e.Dark = p.Bool()
+					if e.Dark {
+						e.Flags |= 1
+					}
+				case "channel":
+					e.Channel = p.Object()
+				case "msg_id":
+					e.MsgID = p.Int()
+				default:
+					p.unknownField("stats.getMessageStats")
+				}
+			}
+			return e
+		},
+		"message_layer122": func(p *textParser) TL {
+			var e TL_message_layer122
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "out":
+					e.Out = p.Bool()
+					if e.Out {
+						e.Flags |= 2
+					}
+				case "mentioned":
+					e.Mentioned = p.Bool()
+					if e.Mentioned {
+						e.Flags |= 16
+					}
+				case "media_unread":
+					e.MediaUnread = p.Bool()
+					if e.MediaUnread {
+						e.Flags |= 32
+					}
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 8192
+					}
+				case "post":
+					e.Post = p.Bool()
+					if e.Post {
+						e.Flags |= 16384
+					}
+				case "from_scheduled":
+					e.FromScheduled = p.Bool()
+					if e.FromScheduled {
+						e.Flags |= 262144
+					}
+				case "legacy":
+					e.Legacy = p.Bool()
+					if e.Legacy {
+						e.Flags |= 524288
+					}
+				case "edit_hide":
+					e.EditHide = p.Bool()
+					if e.EditHide {
+						e.Flags |= 2097152
+					}
+				case "pinned":
+					e.Pinned = p.Bool()
+					if e.Pinned {
+						e.Flags |= 16777216
+					}
+				case "id":
+					e.ID = p.Int()
+				case "from_id":
+					e.FromID = p.Object()
+					e.Flags |= 256
+				case "peer_id":
+					e.PeerID = p.Object()
+				case "fwd_from":
+					e.FwdFrom = p.Object()
+					e.Flags |= 4
+				case "via_bot_id":
+					e.ViaBotID = p.Int()
+					e.Flags |= 2048
+				case "reply_to":
+					e.ReplyTo = p.Object()
+					e.Flags |= 8
+				case "date":
+					e.Date = p.Int()
+				case "message":
+					e.Message = p.String()
+				case "media":
+					e.Media = p.Object()
+					e.Flags |= 512
+				case "reply_markup":
+					e.ReplyMarkup = p.Object()
+					e.Flags |= 64
+				case "entities":
+					e.Entities = p.Vector()
+					e.Flags |= 128
+				case "views":
+					e.Views = p.Int()
+					e.Flags |= 1024
+				case "forwards":
+					e.Forwards = p.Int()
+					e.Flags |= 1024
+				case "replies":
+					e.Replies = p.Object()
+					e.Flags |= 8388608
+				case "edit_date":
+					e.EditDate = p.Int()
+					e.Flags |= 32768
+				case "post_author":
+					e.PostAuthor = p.String()
+					e.Flags |= 65536
+				case "grouped_id":
+					e.GroupedID = p.Long()
+					e.Flags |= 131072
+				case "restriction_reason":
+					e.RestrictionReason = p.Vector()
+					e.Flags |= 4194304
+				default:
+					p.unknownField("message_layer122")
+				}
+			}
+			return e
+		},
+		"messageService_layer122": func(p *textParser) TL {
+			var e TL_messageService_layer122
+			for p.field() {
+				switch p.fieldName {
+				case "flags":
+					e.Flags |= p.Int()
+				case "out":
+					e.Out = p.Bool()
+					if e.Out {
+						e.Flags |= 2
+					}
+				case "mentioned":
+					e.Mentioned = p.Bool()
+					if e.Mentioned {
+						e.Flags |= 16
+					}
+				case "media_unread":
+					e.MediaUnread = p.Bool()
+					if e.MediaUnread {
+						e.Flags |= 32
+					}
+				case "silent":
+					e.Silent = p.Bool()
+					if e.Silent {
+						e.Flags |= 8192
+					}
+				case "post":
+					e.Post = p.Bool()
+					if e.Post {
+						e.Flags |= 16384
+					}
+				case "legacy":
+					e.Legacy = p.Bool()
+					if e.Legacy {
+						e.Flags |= 524288
+					}
+				case "id":
+					e.ID = p.Int()
+				case "from_id":
+					e.FromID = p.Object()
+					e.Flags |= 256
+				case "peer_id":
+					e.PeerID = p.Object()
+				case "reply_to":
+					e.ReplyTo = p.Object()
+					e.Flags |= 8
+				case "date":
+					e.Date = p.Int()
+				case "action":
+					e.Action = p.Object()
+				default:
+					p.unknownField("messageService_layer122")
+				}
+			}
+			return e
+		},
+	}
+}
@@ -0,0 +1,489 @@
+package mtproto
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ansel1/merry"
+)
+
+// Text representation of TL objects uses schema names and looks like
+//   messages.getHistory peer:(inputPeerChannel channel_id:1 access_hash:2) offset_id:0 limit:10
+// Nested objects are wrapped in parens (if they have fields), vectors are wrapped in [ ],
+// strings and bytes are Go-quoted. Flags fields are not printed, they are calculated from
+// flagged fields while parsing (but may be set explicitly with flags:123).
+
+type textWritable interface {
+	writeText(*textWriter)
+}
+
+type textWriter struct {
+	buf       []byte
+	maxStrLen int //strings and bytes longer than this will be truncated (and unparsable), 0 means no limit
+}
+
+func (w *textWriter) name(name string) {
+	w.buf = append(w.buf, name...)
+}
+
+func (w *textWriter) field(name string) {
+	w.buf = append(w.buf, ' ')
+	w.buf = append(w.buf, name...)
+	w.buf = append(w.buf, ':')
+}
+
+func (w *textWriter) Int(v int32) {
+	w.buf = strconv.AppendInt(w.buf, int64(v), 10)
+}
+
+func (w *textWriter) Long(v int64) {
+	w.buf = strconv.AppendInt(w.buf, v, 10)
+}
+
+func (w *textWriter) Double(v float64) {
+	w.buf = strconv.AppendFloat(w.buf, v, 'g', -1, 64)
+}
+
+func (w *textWriter) Bool(v bool) {
+	w.buf = strconv.AppendBool(w.buf, v)
+}
+
+func (w *textWriter) String(v string) {
+	if w.maxStrLen > 0 && len(v) > w.maxStrLen {
+		w.buf = strconv.AppendQuote(w.buf, truncateUTF8(v, w.maxStrLen))
+		w.buf = append(w.buf, fmt.Sprintf("...(%d)", len(v))...)
+		return
+	}
+	w.buf = strconv.AppendQuote(w.buf, v)
+}
+
+func (w *textWriter) Bytes(v []byte) {
+	w.String(string(v))
+}
+
+func (w *textWriter) VectorInt(v []int32) {
+	w.buf = append(w.buf, '[')
+	for i, x := range v {
+		if i > 0 {
+			w.buf = append(w.buf, ' ')
+		}
+		w.Int(x)
+	}
+	w.buf = append(w.buf, ']')
+}
+
+func (w *textWriter) VectorLong(v []int64) {
+	w.buf = append(w.buf, '[')
+	for i, x := range v {
+		if i > 0 {
+			w.buf = append(w.buf, ' ')
+		}
+		w.Long(x)
+	}
+	w.buf = append(w.buf, ']')
+}
+
+func (w *textWriter) VectorString(v []string) {
+	w.buf = append(w.buf, '[')
+	for i, x := range v {
+		if i > 0 {
+			w.buf = append(w.buf, ' ')
+		}
+		w.String(x)
+	}
+	w.buf = append(w.buf, ']')
+}
+
+func (w *textWriter) VectorBytes(v [][]byte) {
+	w.buf = append(w.buf, '[')
+	for i, x := range v {
+		if i > 0 {
+			w.buf = append(w.buf, ' ')
+		}
+		w.Bytes(x)
+	}
+	w.buf = append(w.buf, ']')
+}
+
+func (w *textWriter) VectorDouble(v []float64) {
+	w.buf = append(w.buf, '[')
+	for i, x := range v {
+		if i > 0 {
+			w.buf = append(w.buf, ' ')
+		}
+		w.Double(x)
+	}
+	w.buf = append(w.buf, ']')
+}
+
+func (w *textWriter) Vector(v []TL) {
+	w.buf = append(w.buf, '[')
+	for i, x := range v {
+		if i > 0 {
+			w.buf = append(w.buf, ' ')
+		}
+		w.Object(x)
+	}
+	w.buf = append(w.buf, ']')
+}
+
+// Object writes nested object. It is wrapped in parens if its constructor has fields
+// (even if all of them are omitted flags), vectors are written as is.
+func (w *textWriter) Object(obj TL) {
+	if !textHasFields(obj) {
+		w.topLevelObject(obj)
+		return
+	}
+	w.buf = append(w.buf, '(')
+	w.topLevelObject(obj)
+	w.buf = append(w.buf, ')')
+}
+
+func textHasFields(obj TL) bool {
+	switch obj.(type) {
+	case TL_msg_container, TL_rpc_result:
+		return true
+	case textWritable:
+		return len(Fields(obj)) > 0
+	}
+	return false
+}
+
+func (w *textWriter) topLevelObject(obj TL) {
+	switch x := obj.(type) {
+	case nil:
+		w.name("null")
+	case textWritable:
+		x.writeText(w)
+	case VectorInt:
+		w.VectorInt(x)
+	case VectorLong:
+		w.VectorLong(x)
+	case VectorObject:
+		w.Vector(x)
+	case TL_msg_container:
+		w.name("msg_container")
+		w.field("messages")
+		w.buf = append(w.buf, '[')
+		for i, item := range x.Items {
+			if i > 0 {
+				w.buf = append(w.buf, ' ')
+			}
+			w.Object(item.Data)
+		}
+		w.buf = append(w.buf, ']')
+	case TL_rpc_result:
+		w.name("rpc_result")
+		w.field("req_msg_id")
+		w.Long(x.reqMsgID)
+		w.field("result")
+		w.Object(x.obj)
+	default:
+		w.name(fmt.Sprintf("%#v", obj))
+	}
+}
+
+// SprintText returns text representation of TL object with schema names, like
+//
+//	messages.getHistory peer:inputPeerSelf offset_id:0 offset_date:0 add_offset:0 limit:10 max_id:0 min_id:0 hash:0
+//
+// Result can be parsed back with ParseText.
+func SprintText(obj TL) string {
+	w := &textWriter{}
+	w.topLevelObject(obj)
+	return string(w.buf)
+}
+
+// SprintTextShort is like SprintText but truncates long strings and bytes (result may be unparsable).
+func SprintTextShort(obj TL, maxStrLen int) string {
+	w := &textWriter{maxStrLen: maxStrLen}
+	w.topLevelObject(obj)
+	return string(w.buf)
+}
+
+type textParser struct {
+	src       string
+	pos       int
+	err       error
+	fieldName string
+	bare      bool //object without parens, it can not have fields
+}
+
+func (p *textParser) errorf(format string, args ...interface{}) {
+	if p.err == nil {
+		p.err = merry.Errorf("text TL: pos %d: "+format, append([]interface{}{p.pos}, args...)...)
+	}
+}
+
+func (p *textParser) skipSpaces() {
+	for p.pos < len(p.src) && strings.IndexByte(" \t\r\n", p.src[p.pos]) != -1 {
+		p.pos++
+	}
+}
+
+func (p *textParser) peek() byte {
+	p.skipSpaces()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *textParser) expect(c byte) {
+	if p.err != nil {
+		return
+	}
+	if p.peek() != c {
+		p.errorf("expected '%c'", c)
+		return
+	}
+	p.pos++
+}
+
+func isTextIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.'
+}
+
+func (p *textParser) ident() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.src) && isTextIdentChar(p.src[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		p.errorf("expected identifier")
+	}
+	return p.src[start:p.pos]
+}
+
+// token reads unquoted value: number, bool, name
+func (p *textParser) token() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.src) && (isTextIdentChar(p.src[p.pos]) || strings.IndexByte("+-", p.src[p.pos]) != -1) {
+		p.pos++
+	}
+	if start == p.pos {
+		p.errorf("expected value")
+	}
+	return p.src[start:p.pos]
+}
+
+// field reads next "name:" and returns true or returns false if there are no more fields
+func (p *textParser) field() bool {
+	if p.err != nil || p.bare {
+		return false
+	}
+	if c := p.peek(); c == 0 || c == ')' {
+		return false
+	}
+	p.fieldName = p.ident()
+	p.expect(':')
+	return p.err == nil
+}
+
+func (p *textParser) unknownField(objName string) {
+	p.errorf("unknown field %q for %s", p.fieldName, objName)
+}
+
+func (p *textParser) Int() int32 {
+	tok := p.token()
+	if p.err != nil {
+		return 0
+	}
+	v, err := strconv.ParseInt(tok, 0, 32)
+	if err != nil {
+		p.errorf("%s", err)
+	}
+	return int32(v)
+}
+
+func (p *textParser) Long() int64 {
+	tok := p.token()
+	if p.err != nil {
+		return 0
+	}
+	v, err := strconv.ParseInt(tok, 0, 64)
+	if err != nil {
+		p.errorf("%s", err)
+	}
+	return v
+}
+
+func (p *textParser) Double() float64 {
+	tok := p.token()
+	if p.err != nil {
+		return 0
+	}
+	v, err := strconv.ParseFloat(tok, 64)
+	if err != nil {
+		p.errorf("%s", err)
+	}
+	return v
+}
+
+func (p *textParser) Bool() bool {
+	tok := p.token()
+	if p.err != nil {
+		return false
+	}
+	v, err := strconv.ParseBool(tok)
+	if err != nil {
+		p.errorf("%s", err)
+	}
+	return v
+}
+
+func (p *textParser) String() string {
+	if p.err != nil {
+		return ""
+	}
+	if c := p.peek(); c != '"' && c != '`' {
+		p.errorf("expected quoted string")
+		return ""
+	}
+	quote := p.src[p.pos]
+	end := p.pos + 1
+	for end < len(p.src) && p.src[end] != quote {
+		if p.src[end] == '\\' && quote == '"' {
+			end++
+		}
+		end++
+	}
+	if end >= len(p.src) {
+		p.errorf("unterminated string")
+		return ""
+	}
+	quoted := p.src[p.pos : end+1]
+	p.pos = end + 1
+	v, err := strconv.Unquote(quoted)
+	if err != nil {
+		p.errorf("%s", err)
+	}
+	return v
+}
+
+func (p *textParser) Bytes() []byte {
+	v := p.String()
+	if p.err != nil {
+		return nil
+	}
+	return []byte(v)
+}
+
+// vector calls readItem for each vector item
+func (p *textParser) vector(readItem func()) {
+	p.expect('[')
+	for p.err == nil && p.peek() != ']' {
+		if p.peek() == 0 {
+			p.errorf("unexpected end of vector")
+			return
+		}
+		readItem()
+	}
+	p.expect(']')
+}
+
+func (p *textParser) VectorInt() []int32 {
+	v := []int32{}
+	p.vector(func() { v = append(v, p.Int()) })
+	return v
+}
+
+func (p *textParser) VectorLong() []int64 {
+	v := []int64{}
+	p.vector(func() { v = append(v, p.Long()) })
+	return v
+}
+
+func (p *textParser) VectorString() []string {
+	v := []string{}
+	p.vector(func() { v = append(v, p.String()) })
+	return v
+}
+
+func (p *textParser) VectorBytes() [][]byte {
+	v := [][]byte{}
+	p.vector(func() { v = append(v, p.Bytes()) })
+	return v
+}
+
+func (p *textParser) VectorDouble() []float64 {
+	v := []float64{}
+	p.vector(func() { v = append(v, p.Double()) })
+	return v
+}
+
+func (p *textParser) Vector() []TL {
+	v := []TL{}
+	p.vector(func() { v = append(v, p.Object()) })
+	return v
+}
+
+func (p *textParser) objectBody(name string) TL {
+	parse, ok := textParsers[name]
+	if !ok {
+		p.errorf("unknown constructor %q", name)
+		return nil
+	}
+	return parse(p)
+}
+
+func (p *textParser) Object() TL {
+	if p.err != nil {
+		return nil
+	}
+	if p.peek() == '[' {
+		return VectorObject(p.Vector())
+	}
+	if p.peek() == '(' {
+		p.pos++
+		obj := p.objectBody(p.ident())
+		p.expect(')')
+		return obj
+	}
+	name := p.ident()
+	if name == "null" {
+		return nil
+	}
+	p.bare = true
+	obj := p.objectBody(name)
+	p.bare = false
+	return obj
+}
+
+// ParseText builds TL object from text representation (as returned by SprintText).
+// Fields may go in any order, omitted fields are set to zero values,
+// flags are set automatically for flagged fields.
+func ParseText(text string) (TL, error) {
+	p := &textParser{src: text}
+	var obj TL
+	if p.peek() == '(' {
+		obj = p.Object()
+	} else {
+		obj = p.objectBody(p.ident())
+	}
+	if p.err == nil {
+		if c := p.peek(); c != 0 {
+			p.errorf("unexpected '%c'", c)
+		}
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return obj, nil
+}
+
+// ParseTextRequest is same as ParseText but also checks that result is a request (TL function).
+func ParseTextRequest(text string) (TLReq, error) {
+	obj, err := ParseText(text)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	req, ok := obj.(TLReq)
+	if !ok {
+		return nil, merry.Errorf("%s is not a request", SprintText(obj))
+	}
+	return req, nil
+}
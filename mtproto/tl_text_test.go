@@ -0,0 +1,100 @@
+package mtproto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTextRoundTrip(t *testing.T) {
+	objs := []TL{
+		TL_messages_getHistory{Peer: TL_inputPeerSelf{}, OffsetID: 10, Limit: 100},
+		TL_messages_getHistory{
+			Peer:  TL_inputPeerChannel{ChannelID: 123, AccessHash: -9007199254740993},
+			Limit: 1,
+		},
+		TL_message{
+			Flags:    1<<1 | 1<<7,
+			Out:      true,
+			ID:       5,
+			PeerID:   TL_peerUser{UserID: 42},
+			Message:  "hello \"world\"\n",
+			Entities: []TL{TL_messageEntityBold{Offset: 0, Length: 5}, TL_messageEntityItalic{Offset: 1, Length: 2}},
+		},
+		TL_resPQ{
+			Nonce:                       []byte{0, 1, 0xff, '"'},
+			ServerNonce:                 []byte{},
+			ServerPublicKeyFingerprints: []int64{-1, 1 << 62},
+		},
+		TL_inputGeoPoint{Lat: 1.5, Long: -0.25},
+		// vector field inside object field, object without set fields
+		TL_messages_sendMessage{Flags: 1 << 2, Peer: TL_inputPeerSelf{}, Message: "hi", RandomID: 1,
+			ReplyMarkup: TL_replyKeyboardMarkup{Rows: []TL{
+				TL_keyboardButtonRow{Buttons: []TL{TL_keyboardButton{Text: "a b"}, TL_keyboardButton{Text: "c"}}},
+				TL_keyboardButtonRow{Buttons: []TL{}},
+			}}},
+		TL_messages_sendMessage{Flags: 1 << 2, Peer: TL_inputPeerSelf{}, Message: "hi", ReplyMarkup: TL_replyKeyboardHide{}},
+		// vector as object field value
+		TL_invokeWithLayer{Layer: 126, Query: VectorObject{TL_inputPeerSelf{}, TL_inputPeerChat{ChatID: 1}}},
+	}
+	for _, obj := range objs {
+		text := SprintText(obj)
+		res, err := ParseText(text)
+		if err != nil {
+			t.Fatalf("%s: %s", text, err)
+		}
+		if !reflect.DeepEqual(obj, res) {
+			t.Errorf("text round trip mismatch:\n%#v\n%#v\n%s", obj, res, text)
+		}
+	}
+}
+
+func TestTextFormat(t *testing.T) {
+	cases := []struct {
+		obj  TL
+		text string
+	}{
+		{TL_inputPeerSelf{}, `inputPeerSelf`},
+		{TL_messages_getHistory{Peer: TL_inputPeerSelf{}, Limit: 10},
+			`messages.getHistory peer:inputPeerSelf offset_id:0 offset_date:0 add_offset:0 limit:10 max_id:0 min_id:0 hash:0`},
+		{TL_users_getUsers{ID: []TL{TL_inputUserSelf{}, TL_inputUser{UserID: 1, AccessHash: 2}}},
+			`users.getUsers id:[inputUserSelf (inputUser user_id:1 access_hash:2)]`},
+		{TL_invokeWithLayer{Layer: 1, Query: VectorObject{TL_inputPeerSelf{}, TL_inputPeerSelf{}}},
+			`invokeWithLayer layer:1 query:[inputPeerSelf inputPeerSelf]`},
+		{TL_messages_sendMessage{Flags: 1 << 2, Peer: TL_inputPeerSelf{}, ReplyMarkup: TL_replyKeyboardHide{}},
+			`messages.sendMessage peer:inputPeerSelf message:"" random_id:0 reply_markup:(replyKeyboardHide)`},
+	}
+	for _, c := range cases {
+		if text := SprintText(c.obj); text != c.text {
+			t.Errorf("wrong text:\n%s\nexpected:\n%s", text, c.text)
+		}
+	}
+}
+
+func TestTextParseFlags(t *testing.T) {
+	req, err := ParseTextRequest(`messages.sendMessage silent:true peer:inputPeerSelf message:"hi" random_id:1 reply_to_msg_id:5`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, ok := req.(TL_messages_sendMessage)
+	if !ok {
+		t.Fatalf("unexpected type %T", req)
+	}
+	if msg.Flags != 1<<5|1<<0 || !msg.Silent || msg.ReplyToMsgID != 5 || msg.Message != "hi" {
+		t.Errorf("wrong result: %#v", msg)
+	}
+}
+
+func TestTextParseErrors(t *testing.T) {
+	for _, text := range []string{
+		`noSuchConstructor`,
+		`inputPeerSelf extra`,
+		`inputUser user_id:"1"`,
+		`inputUser no_such_field:1`,
+		`users.getUsers id:[inputUserSelf`,
+		`inputPeerSelf`, //not a request
+	} {
+		if _, err := ParseTextRequest(text); err == nil {
+			t.Errorf("expected error for: %s", text)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package tgclient
+
+import (
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+// Short updates (updateShortMessage, updateShortChatMessage, updateShortSentMessage) contain only some message fields
+// and are sent instead of full updateNewMessage to save traffic. They are converted to full updates
+// before delivering, so handlers have to deal with only one kind of new message update.
+// https://core.telegram.org/api/updates#updates-sequence
+
+// message flags, short updates flags have same bit positions
+const (
+	messageFlagOut         = 1 << 1
+	messageFlagReplyTo     = 1 << 3
+	messageFlagReplyMarkup = 1 << 6
+	messageFlagEntities    = 1 << 7
+	messageFlagFromID      = 1 << 8
+	messageFlagSilent      = 1 << 13
+)
+
+// normalizeShortUpdate converts short message update to updateNewMessage.
+// selfID is used as sender of outgoing messages (if it is not zero). Other updates are returned as is.
+func normalizeShortUpdate(update mtproto.TL, selfID int32) mtproto.TL {
+	switch u := update.(type) {
+	case mtproto.TL_updateShortMessage:
+		msg := mtproto.TL_message{
+			Flags:       u.Flags,
+			Out:         u.Out,
+			Mentioned:   u.Mentioned,
+			MediaUnread: u.MediaUnread,
+			Silent:      u.Silent,
+			ID:          u.ID,
+			PeerID:      mtproto.TL_peerUser{UserID: u.UserID},
+			FwdFrom:     u.FwdFrom,
+			ViaBotID:    u.ViaBotID,
+			ReplyTo:     u.ReplyTo,
+			Date:        u.Date,
+			Message:     u.Message,
+			Entities:    u.Entities,
+			TtlPeriod:   u.TtlPeriod,
+		}
+		if !u.Out {
+			msg.Flags |= messageFlagFromID
+			msg.FromID = mtproto.TL_peerUser{UserID: u.UserID}
+		} else if selfID != 0 {
+			msg.Flags |= messageFlagFromID
+			msg.FromID = mtproto.TL_peerUser{UserID: selfID}
+		}
+		return mtproto.TL_updateNewMessage{Message: msg, Pts: u.Pts, PtsCount: u.PtsCount}
+	case mtproto.TL_updateShortChatMessage:
+		msg := mtproto.TL_message{
+			Flags:       u.Flags | messageFlagFromID,
+			Out:         u.Out,
+			Mentioned:   u.Mentioned,
+			MediaUnread: u.MediaUnread,
+			Silent:      u.Silent,
+			ID:          u.ID,
+			FromID:      mtproto.TL_peerUser{UserID: u.FromID},
+			PeerID:      mtproto.TL_peerChat{ChatID: u.ChatID},
+			FwdFrom:     u.FwdFrom,
+			ViaBotID:    u.ViaBotID,
+			ReplyTo:     u.ReplyTo,
+			Date:        u.Date,
+			Message:     u.Message,
+			Entities:    u.Entities,
+			TtlPeriod:   u.TtlPeriod,
+		}
+		return mtproto.TL_updateNewMessage{Message: msg, Pts: u.Pts, PtsCount: u.PtsCount}
+	}
+	return update
+}
+
+// normalizeSentMessage converts updateShortSentMessage (response to messages.sendMessage or messages.sendMedia)
+// to updateNewMessage taking missing fields (peer, text, reply) from request.
+// Returns false if request is unknown or its peer can not be converted.
+func normalizeSentMessage(req mtproto.TLReq, sent mtproto.TL_updateShortSentMessage, selfID int32) (mtproto.TL, bool) {
+	var inputPeer mtproto.TL
+	var text string
+	var silent bool
+	var replyToMsgID int32
+	var replyMarkup mtproto.TL
+	var entities []mtproto.TL
+	switch r := req.(type) {
+	case mtproto.TL_messages_sendMessage:
+		inputPeer, text, silent, replyToMsgID, replyMarkup, entities = r.Peer, r.Message, r.Silent, r.ReplyToMsgID, r.ReplyMarkup, r.Entities
+	case mtproto.TL_messages_sendMedia:
+		inputPeer, text, silent, replyToMsgID, replyMarkup, entities = r.Peer, r.Message, r.Silent, r.ReplyToMsgID, r.ReplyMarkup, r.Entities
+	default:
+		return nil, false
+	}
+	peer, ok := inputPeerToPeer(inputPeer, selfID)
+	if !ok {
+		return nil, false
+	}
+	if _, isChannel := peer.(mtproto.TL_peerChannel); isChannel {
+		// channel messages have their own pts, so they can not come as updateShortSentMessage
+		return nil, false
+	}
+
+	msg := mtproto.TL_message{
+		Flags:     sent.Flags | messageFlagOut,
+		Out:       true,
+		Silent:    silent,
+		ID:        sent.ID,
+		PeerID:    peer,
+		Date:      sent.Date,
+		Message:   text,
+		Media:     sent.Media,
+		Entities:  sent.Entities,
+		TtlPeriod: sent.TtlPeriod,
+	}
+	if selfID != 0 {
+		msg.Flags |= messageFlagFromID
+		msg.FromID = mtproto.TL_peerUser{UserID: selfID}
+	}
+	if silent {
+		msg.Flags |= messageFlagSilent
+	}
+	if replyToMsgID != 0 {
+		msg.Flags |= messageFlagReplyTo
+		msg.ReplyTo = mtproto.TL_messageReplyHeader{ReplyToMsgID: replyToMsgID}
+	}
+	if replyMarkup != nil {
+		msg.Flags |= messageFlagReplyMarkup
+		msg.ReplyMarkup = replyMarkup
+	}
+	if msg.Flags&messageFlagEntities == 0 && len(entities) > 0 {
+		// server returns entities only if they were changed (for example, links were found)
+		msg.Flags |= messageFlagEntities
+		msg.Entities = entities
+	}
+	return mtproto.TL_updateNewMessage{Message: msg, Pts: sent.Pts, PtsCount: sent.PtsCount}, true
+}
+
+func inputPeerToPeer(inputPeer mtproto.TL, selfID int32) (mtproto.TL, bool) {
+	switch p := inputPeer.(type) {
+	case mtproto.TL_inputPeerSelf:
+		return mtproto.TL_peerUser{UserID: selfID}, selfID != 0
+	case mtproto.TL_inputPeerUser:
+		return mtproto.TL_peerUser{UserID: p.UserID}, true
+	case mtproto.TL_inputPeerUserFromMessage:
+		return mtproto.TL_peerUser{UserID: p.UserID}, true
+	case mtproto.TL_inputPeerChat:
+		return mtproto.TL_peerChat{ChatID: p.ChatID}, true
+	case mtproto.TL_inputPeerChannel:
+		return mtproto.TL_peerChannel{ChannelID: p.ChannelID}, true
+	case mtproto.TL_inputPeerChannelFromMessage:
+		return mtproto.TL_peerChannel{ChannelID: p.ChannelID}, true
+	}
+	return nil, false
+}
+
+// messagePeers returns peers (sender, chat, forward source and inline bot) mentioned in new message update
+func messagePeers(update mtproto.TL) []mtproto.TL {
+	u, ok := update.(mtproto.TL_updateNewMessage)
+	if !ok {
+		return nil
+	}
+	msg, ok := u.Message.(mtproto.TL_message)
+	if !ok {
+		return nil
+	}
+	var peers []mtproto.TL
+	if msg.FromID != nil {
+		peers = append(peers, msg.FromID)
+	}
+	peers = append(peers, msg.PeerID)
+	if fwd, ok := msg.FwdFrom.(mtproto.TL_messageFwdHeader); ok && fwd.FromID != nil {
+		peers = append(peers, fwd.FromID)
+	}
+	if msg.ViaBotID != 0 {
+		peers = append(peers, mtproto.TL_peerUser{UserID: msg.ViaBotID})
+	}
+	return peers
+}
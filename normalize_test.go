@@ -0,0 +1,109 @@
+package tgclient
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+type testKnownPeers struct {
+	selfID int32
+	known  map[mtproto.TL]bool
+}
+
+func (p testKnownPeers) selfUserID() int32              { return p.selfID }
+func (p testKnownPeers) peerKnown(peer mtproto.TL) bool { return p.known[peer] }
+
+func checkEncodable(t *testing.T, update mtproto.TL) {
+	msg := update.(mtproto.TL_updateNewMessage).Message
+	if decoded := mtproto.NewDecodeBuf(mtproto.Encode(msg)).Object(); !reflect.DeepEqual(decoded, msg) {
+		t.Errorf("message changed after encoding:\n%#v", decoded)
+	}
+}
+
+func TestNormalizeShortUpdate(t *testing.T) {
+	update := normalizeShortUpdate(mtproto.TL_updateShortMessage{
+		Flags: messageFlagEntities, ID: 5, UserID: 2, Message: "hi", Pts: 10, PtsCount: 1, Date: 100,
+		Entities: []mtproto.TL{mtproto.TL_messageEntityBold{Offset: 0, Length: 2}},
+	}, 1)
+	expected := mtproto.TL_updateNewMessage{Pts: 10, PtsCount: 1, Message: mtproto.TL_message{
+		Flags: messageFlagEntities | messageFlagFromID, ID: 5, Date: 100, Message: "hi",
+		FromID: mtproto.TL_peerUser{UserID: 2}, PeerID: mtproto.TL_peerUser{UserID: 2},
+		Entities: []mtproto.TL{mtproto.TL_messageEntityBold{Offset: 0, Length: 2}},
+	}}
+	if !reflect.DeepEqual(update, expected) {
+		t.Errorf("wrong user message:\n%#v", update)
+	}
+	checkEncodable(t, update)
+
+	update = normalizeShortUpdate(mtproto.TL_updateShortMessage{Flags: messageFlagOut, Out: true, ID: 6, UserID: 2}, 1)
+	expected = mtproto.TL_updateNewMessage{Message: mtproto.TL_message{
+		Flags: messageFlagOut | messageFlagFromID, Out: true, ID: 6,
+		FromID: mtproto.TL_peerUser{UserID: 1}, PeerID: mtproto.TL_peerUser{UserID: 2},
+	}}
+	if !reflect.DeepEqual(update, expected) {
+		t.Errorf("wrong outgoing user message:\n%#v", update)
+	}
+
+	update = normalizeShortUpdate(mtproto.TL_updateShortChatMessage{ID: 6, FromID: 2, ChatID: 3, Pts: 11, PtsCount: 1}, 0)
+	expected = mtproto.TL_updateNewMessage{Pts: 11, PtsCount: 1, Message: mtproto.TL_message{
+		Flags: messageFlagFromID, ID: 6, FromID: mtproto.TL_peerUser{UserID: 2}, PeerID: mtproto.TL_peerChat{ChatID: 3},
+	}}
+	if !reflect.DeepEqual(update, expected) {
+		t.Errorf("wrong chat message:\n%#v", update)
+	}
+	checkEncodable(t, update)
+}
+
+func TestNormalizeSentMessage(t *testing.T) {
+	req := mtproto.TL_messages_sendMessage{
+		Silent: true, Peer: mtproto.TL_inputPeerUser{UserID: 2, AccessHash: 3}, ReplyToMsgID: 4, Message: "hi",
+		Entities: []mtproto.TL{mtproto.TL_messageEntityBold{Offset: 0, Length: 2}},
+	}
+	sent := mtproto.TL_updateShortSentMessage{Flags: messageFlagOut, Out: true, ID: 5, Pts: 10, PtsCount: 1, Date: 100}
+	update, ok := normalizeSentMessage(req, sent, 1)
+	expected := mtproto.TL_updateNewMessage{Pts: 10, PtsCount: 1, Message: mtproto.TL_message{
+		Flags: messageFlagOut | messageFlagFromID | messageFlagSilent | messageFlagReplyTo | messageFlagEntities,
+		Out:   true, Silent: true, ID: 5, Date: 100, Message: "hi",
+		FromID: mtproto.TL_peerUser{UserID: 1}, PeerID: mtproto.TL_peerUser{UserID: 2},
+		ReplyTo:  mtproto.TL_messageReplyHeader{ReplyToMsgID: 4},
+		Entities: []mtproto.TL{mtproto.TL_messageEntityBold{Offset: 0, Length: 2}},
+	}}
+	if !ok || !reflect.DeepEqual(update, expected) {
+		t.Errorf("wrong sent message:\n%#v", update)
+	}
+	checkEncodable(t, update)
+
+	if _, ok := normalizeSentMessage(mtproto.TL_messages_sendMessage{Peer: mtproto.TL_inputPeerSelf{}}, sent, 0); ok {
+		t.Errorf("message to self should not be normalized while self ID is unknown")
+	}
+	if _, ok := normalizeSentMessage(mtproto.TL_messages_forwardMessages{}, sent, 1); ok {
+		t.Errorf("message of unknown request should not be normalized")
+	}
+}
+
+func TestShortUpdateUnknownPeers(t *testing.T) {
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_updates_difference{
+			NewMessages: []mtproto.TL{mtproto.TL_message{ID: 11}},
+			Users:       []mtproto.TL{mtproto.TL_user{ID: 3}},
+			State:       mtproto.TL_updates_state{Pts: 11},
+		},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 9})
+	e.peers = testKnownPeers{known: map[mtproto.TL]bool{mtproto.TL_peerUser{UserID: 2}: true}}
+
+	e.handleEvent(mtproto.TL_updateShortMessage{ID: 10, UserID: 2, Pts: 10, PtsCount: 1}) //known
+	e.handleEvent(mtproto.TL_updateShortMessage{ID: 11, UserID: 3, Pts: 11, PtsCount: 1}) //unknown, fetched with difference
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{10, -11}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+	if len(env.requests) != 1 {
+		t.Errorf("expected one getDifference request, got %#v", env.requests)
+	}
+	if s := e.State(); s.Pts != 11 {
+		t.Errorf("wrong state: %#v", s)
+	}
+}
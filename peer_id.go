@@ -0,0 +1,95 @@
+package tgclient
+
+import (
+	"math"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+// Users, chats and channels have separate ID spaces. Bot API uses single "marked" ID:
+// user ID as is, -chatID for basic groups and -(1000000000000+channelID) for channels and supergroups
+// (like -1001234567890 for channel 1234567890).
+
+const markedChannelOffset = 1000000000000
+
+var ErrWrongMarkedID = merry.New("wrong marked peer ID")
+
+// MarkedPeerID returns Bot API-style ID of Peer or InputPeer (mtproto.TL_peer*, mtproto.TL_inputPeer*).
+// inputPeerSelf and inputPeerEmpty are not supported.
+func MarkedPeerID(peer mtproto.TL) (int64, bool) {
+	switch p := peer.(type) {
+	case mtproto.TL_peerUser:
+		return int64(p.UserID), true
+	case mtproto.TL_peerChat:
+		return -int64(p.ChatID), true
+	case mtproto.TL_peerChannel:
+		return -(markedChannelOffset + int64(p.ChannelID)), true
+	case mtproto.TL_inputPeerUser:
+		return int64(p.UserID), true
+	case mtproto.TL_inputPeerUserFromMessage:
+		return int64(p.UserID), true
+	case mtproto.TL_inputPeerChat:
+		return -int64(p.ChatID), true
+	case mtproto.TL_inputPeerChannel:
+		return -(markedChannelOffset + int64(p.ChannelID)), true
+	case mtproto.TL_inputPeerChannelFromMessage:
+		return -(markedChannelOffset + int64(p.ChannelID)), true
+	}
+	return 0, false
+}
+
+// PeerFromMarkedID converts Bot API-style ID to Peer (mtproto.TL_peerUser, TL_peerChat or TL_peerChannel)
+func PeerFromMarkedID(markedID int64) (mtproto.TL, error) {
+	switch {
+	case markedID > 0 && markedID <= math.MaxInt32:
+		return mtproto.TL_peerUser{UserID: int32(markedID)}, nil
+	case markedID < 0 && -markedID <= math.MaxInt32:
+		return mtproto.TL_peerChat{ChatID: int32(-markedID)}, nil
+	case markedID < -markedChannelOffset && -markedID-markedChannelOffset <= math.MaxInt32:
+		return mtproto.TL_peerChannel{ChannelID: int32(-markedID - markedChannelOffset)}, nil
+	}
+	return nil, ErrWrongMarkedID.Here().WithMessagef("wrong marked peer ID: %d", markedID)
+}
+
+// FindExtraPeer returns received user, chat or channel (mtproto.TL_user, TL_chat or TL_channel) by Bot API-style ID.
+// Chats and channels that are no longer available are returned as TL_chatForbidden and TL_channelForbidden.
+// Returns nil if peer is unknown.
+func (e *extraData) FindExtraPeer(markedID int64) mtproto.TL {
+	peer, err := PeerFromMarkedID(markedID)
+	if err != nil {
+		return nil
+	}
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	switch p := peer.(type) {
+	case mtproto.TL_peerUser:
+		if user, ok := e.users[p.UserID]; ok {
+			return *user
+		}
+	case mtproto.TL_peerChat:
+		if chat, ok := e.chats[p.ChatID]; ok {
+			return *chat
+		}
+		if chat, ok := e.forbiddenChats[p.ChatID]; ok {
+			return *chat
+		}
+	case mtproto.TL_peerChannel:
+		if channel, ok := e.channels[p.ChannelID]; ok {
+			return *channel
+		}
+		if channel, ok := e.forbiddenChannels[p.ChannelID]; ok {
+			return *channel
+		}
+	}
+	return nil
+}
+
+// InputPeerByMarkedID returns InputPeer of received user, chat or channel by Bot API-style ID (see InputPeer)
+func (c *TGClient) InputPeerByMarkedID(markedID int64) (mtproto.TL, error) {
+	peer, err := PeerFromMarkedID(markedID)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return c.InputPeer(peer)
+}
@@ -0,0 +1,66 @@
+package tgclient
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+func TestMarkedPeerID(t *testing.T) {
+	for _, c := range []struct {
+		peer     mtproto.TL
+		markedID int64
+	}{
+		{mtproto.TL_peerUser{UserID: 123}, 123},
+		{mtproto.TL_peerChat{ChatID: 123}, -123},
+		{mtproto.TL_peerChannel{ChannelID: 1234567890}, -1001234567890},
+		{mtproto.TL_peerChannel{ChannelID: 2147483647}, -1002147483647},
+	} {
+		if id, ok := MarkedPeerID(c.peer); !ok || id != c.markedID {
+			t.Errorf("%#v: expected %d, got %d", c.peer, c.markedID, id)
+		}
+		if peer, err := PeerFromMarkedID(c.markedID); err != nil || !reflect.DeepEqual(peer, c.peer) {
+			t.Errorf("%d: expected %#v, got %#v (%v)", c.markedID, c.peer, peer, err)
+		}
+	}
+
+	if id, ok := MarkedPeerID(mtproto.TL_inputPeerChannel{ChannelID: 5, AccessHash: 1}); !ok || id != -1000000000005 {
+		t.Errorf("wrong input channel ID: %d", id)
+	}
+	if _, ok := MarkedPeerID(mtproto.TL_inputPeerSelf{}); ok {
+		t.Errorf("self should not have marked ID")
+	}
+	for _, id := range []int64{0, 1 << 31, -(1 << 31), -1000000000000, -1000000000000 - (1 << 31)} {
+		if _, err := PeerFromMarkedID(id); !merry.Is(err, ErrWrongMarkedID) {
+			t.Errorf("%d: expected ErrWrongMarkedID, got %v", id, err)
+		}
+	}
+}
+
+func TestFindExtraPeer(t *testing.T) {
+	tg := newTestClient()
+	tg.rememberEventExtraData([]mtproto.TL{
+		mtproto.TL_user{ID: 1, AccessHash: 10},
+		mtproto.TL_chat{ID: 1},
+		mtproto.TL_channel{ID: 1, AccessHash: 30},
+	})
+
+	if user, ok := tg.FindExtraPeer(1).(mtproto.TL_user); !ok || user.AccessHash != 10 {
+		t.Errorf("wrong user: %#v", tg.FindExtraPeer(1))
+	}
+	if _, ok := tg.FindExtraPeer(-1).(mtproto.TL_chat); !ok {
+		t.Errorf("wrong chat: %#v", tg.FindExtraPeer(-1))
+	}
+	if channel, ok := tg.FindExtraPeer(-1000000000001).(mtproto.TL_channel); !ok || channel.AccessHash != 30 {
+		t.Errorf("wrong channel: %#v", tg.FindExtraPeer(-1000000000001))
+	}
+	if peer := tg.FindExtraPeer(2); peer != nil {
+		t.Errorf("unexpected peer: %#v", peer)
+	}
+	if inputPeer, err := tg.InputPeerByMarkedID(-1000000000001); err != nil ||
+		!reflect.DeepEqual(inputPeer, mtproto.TL_inputPeerChannel{ChannelID: 1, AccessHash: 30}) {
+		t.Errorf("wrong channel input peer: %#v %v", inputPeer, err)
+	}
+}
@@ -0,0 +1,97 @@
+package tgclient
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+var ErrNoPeers = merry.New("no saved peers")
+
+// PeerStore keeps received users, chats and channels (mtproto.TL_user, mtproto.TL_chat, mtproto.TL_channel,
+// also mtproto.TL_chatForbidden and mtproto.TL_channelForbidden for ones that are no longer available)
+// between restarts, so their access hashes can be used without resolving them again.
+type PeerStore interface {
+	Save([]mtproto.TL) error
+	Load() ([]mtproto.TL, error)
+}
+
+type PeerMemStore struct {
+	mutex sync.Mutex
+	peers []mtproto.TL
+}
+
+func (s *PeerMemStore) Save(peers []mtproto.TL) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.peers = append([]mtproto.TL(nil), peers...)
+	return nil
+}
+
+func (s *PeerMemStore) Load() ([]mtproto.TL, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.peers == nil {
+		return nil, ErrNoPeers.Here()
+	}
+	return append([]mtproto.TL(nil), s.peers...), nil
+}
+
+// PeerFileStore saves peers as JSON array of TL objects (see mtproto.UnmarshalJSON)
+type PeerFileStore struct {
+	FPath string
+}
+
+func (s *PeerFileStore) Save(peers []mtproto.TL) (err error) {
+	f, err := os.Create(s.FPath + ".temp")
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	defer f.Close()
+
+	items := make([]json.RawMessage, len(peers))
+	for i, peer := range peers {
+		if items[i], err = json.Marshal(peer); err != nil {
+			return merry.Wrap(err)
+		}
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(items); err != nil {
+		return merry.Wrap(err)
+	}
+	if err := f.Close(); err != nil {
+		return merry.Wrap(err)
+	}
+
+	if err := os.Rename(s.FPath+".temp", s.FPath); err != nil {
+		return merry.Wrap(err)
+	}
+	return nil
+}
+
+func (s *PeerFileStore) Load() ([]mtproto.TL, error) {
+	f, err := os.Open(s.FPath)
+	if os.IsNotExist(err) {
+		return nil, ErrNoPeers.Here()
+	}
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	defer f.Close()
+
+	var items []json.RawMessage
+	if err := json.NewDecoder(f).Decode(&items); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	peers := make([]mtproto.TL, len(items))
+	for i, item := range items {
+		if peers[i], err = mtproto.UnmarshalJSON(item); err != nil {
+			return nil, merry.Wrap(err)
+		}
+	}
+	return peers, nil
+}
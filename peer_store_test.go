@@ -0,0 +1,71 @@
+package tgclient
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+func testPeerStore(t *testing.T, store PeerStore) {
+	if _, err := store.Load(); !merry.Is(err, ErrNoPeers) {
+		t.Fatalf("expected ErrNoPeers, got %v", err)
+	}
+
+	saved := []mtproto.TL{
+		mtproto.TL_user{Flags: 1<<0 | 1<<3, ID: 1, AccessHash: -1234567890123456789, Username: "bob"},
+		mtproto.TL_chat{ID: 2, Title: "chat", Photo: mtproto.TL_chatPhotoEmpty{}},
+		mtproto.TL_channel{Flags: 1 << 13, ID: 3, AccessHash: 987654321, Title: "channel", Photo: mtproto.TL_chatPhotoEmpty{}},
+	}
+	if err := store.Save(saved); err != nil {
+		t.Fatal(err)
+	}
+	peers, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(peers, saved) {
+		t.Errorf("wrong loaded peers: %#v", peers)
+	}
+}
+
+func TestPeerMemStore(t *testing.T) {
+	testPeerStore(t, &PeerMemStore{})
+}
+
+func TestPeerFileStore(t *testing.T) {
+	testPeerStore(t, &PeerFileStore{FPath: filepath.Join(t.TempDir(), "tg.peers")})
+}
+
+func TestPeersFromResponses(t *testing.T) {
+	tg := &TGClient{log: mtproto.Logger{Hnd: noopLogHandler{}}}
+	store := &PeerMemStore{}
+	data := newExtraData(tg)
+	data.store = store
+
+	data.rememberResponseExtraData(mtproto.TL_contacts_resolvedPeer{
+		Peer:  mtproto.TL_peerChannel{ChannelID: 3},
+		Users: []mtproto.TL{mtproto.TL_user{Self: true, ID: 1, AccessHash: 10}},
+		Chats: []mtproto.TL{mtproto.TL_channel{ID: 3, AccessHash: 30}},
+	})
+	if err := data.savePeers(); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := newExtraData(tg)
+	restored.store = store
+	if err := restored.loadPeers(); err != nil {
+		t.Fatal(err)
+	}
+	if user := restored.FindExtraUser(1); user == nil || user.AccessHash != 10 {
+		t.Errorf("wrong restored user: %#v", user)
+	}
+	if channel := restored.FindExtraChannel(3); channel == nil || channel.AccessHash != 30 {
+		t.Errorf("wrong restored channel: %#v", channel)
+	}
+	if id := restored.selfUserID(); id != 1 {
+		t.Errorf("wrong self ID: %d", id)
+	}
+}
@@ -0,0 +1,264 @@
+package tgclient
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+var ErrPeerNotFound = merry.New("peer not found")
+var ErrNotChatMember = merry.New("not a member of invite link chat")
+
+// ResolvePeer finds user, chat or channel and returns its InputPeer
+// (one of mtproto.TL_inputPeerSelf, TL_inputPeerUser, TL_inputPeerChat, TL_inputPeerChannel
+// or TL_inputPeerUserFromMessage, TL_inputPeerChannelFromMessage, see InputPeer).
+// Accepted formats:
+//
+//	@username, username, t.me/username (also telegram.me and with https://)
+//	t.me/joinchat/hash, t.me/+hash (invite links of already joined chats)
+//	+phone, t.me/+phone (users from contacts or already received ones)
+//	numeric ID of already received user, negative Bot API-style ID of chat or channel (see PeerFromMarkedID)
+//
+// Users, chats and channels may have same IDs, so chats and channels must be passed as Bot API-style IDs.
+// Received peers are looked up first, requests are sent only if necessary. Cancelled ctx stops waiting
+// for response (request itself is still processed by server).
+func (c *TGClient) ResolvePeer(ctx context.Context, str string) (mtproto.TL, error) {
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return nil, ErrPeerNotFound.Here().WithMessage("empty peer string")
+	}
+
+	if link, ok := trimTGLinkPrefix(str); ok {
+		if i := strings.IndexAny(link, "/?#"); i != -1 && !strings.HasPrefix(link, "joinchat/") {
+			link = link[:i] //t.me/username/123 (message link)
+		}
+		switch {
+		case strings.HasPrefix(link, "joinchat/"):
+			return c.resolveInvite(ctx, strings.TrimPrefix(link, "joinchat/"))
+		case strings.HasPrefix(link, "+") && isPhone(link):
+			return c.resolvePhone(ctx, link)
+		case strings.HasPrefix(link, "+"):
+			return c.resolveInvite(ctx, link[1:])
+		default:
+			return c.resolveUsername(ctx, link)
+		}
+	}
+	if strings.HasPrefix(str, "+") {
+		if !isPhone(str) {
+			return nil, ErrPeerNotFound.Here().WithMessagef("wrong phone number: %s", str)
+		}
+		return c.resolvePhone(ctx, str)
+	}
+	if id, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return c.resolveMarkedID(ctx, id)
+	}
+	return c.resolveUsername(ctx, strings.TrimPrefix(str, "@"))
+}
+
+// InputPeer converts Peer (mtproto.TL_peerUser, TL_peerChat or TL_peerChannel) to InputPeer using received access hashes.
+// Users and channels known only by min constructors are returned as inputPeerUserFromMessage/inputPeerChannelFromMessage.
+func (c *TGClient) InputPeer(peer mtproto.TL) (mtproto.TL, error) {
+	switch peer.(type) {
+	case mtproto.TL_peerUser, mtproto.TL_peerChat, mtproto.TL_peerChannel:
+	default:
+		return nil, merry.Wrap(mtproto.WrongRespError(peer))
+	}
+	if inputPeer, ok := c.extraData.inputPeer(peer); ok {
+		return inputPeer, nil
+	}
+	return nil, ErrPeerNotFound.Here().WithMessagef("access hash of %s is unknown", mtproto.SprintText(peer))
+}
+
+// InputUser converts user InputPeer to InputUser
+func InputUser(inputPeer mtproto.TL) (mtproto.TL, bool) {
+	switch p := inputPeer.(type) {
+	case mtproto.TL_inputPeerSelf:
+		return mtproto.TL_inputUserSelf{}, true
+	case mtproto.TL_inputPeerUser:
+		return mtproto.TL_inputUser{UserID: p.UserID, AccessHash: p.AccessHash}, true
+	case mtproto.TL_inputPeerUserFromMessage:
+		return mtproto.TL_inputUserFromMessage{Peer: p.Peer, MsgID: p.MsgID, UserID: p.UserID}, true
+	}
+	return nil, false
+}
+
+// InputChannel converts channel InputPeer to InputChannel
+func InputChannel(inputPeer mtproto.TL) (mtproto.TL, bool) {
+	switch p := inputPeer.(type) {
+	case mtproto.TL_inputPeerChannel:
+		return mtproto.TL_inputChannel{ChannelID: p.ChannelID, AccessHash: p.AccessHash}, true
+	case mtproto.TL_inputPeerChannelFromMessage:
+		return mtproto.TL_inputChannelFromMessage{Peer: p.Peer, MsgID: p.MsgID, ChannelID: p.ChannelID}, true
+	}
+	return nil, false
+}
+
+// chatInputPeer returns InputPeer of chat or channel (mtproto.Chat)
+func chatInputPeer(chat mtproto.TL) (mtproto.TL, bool) {
+	switch x := chat.(type) {
+	case mtproto.TL_chat:
+		return mtproto.TL_inputPeerChat{ChatID: x.ID}, true
+	case mtproto.TL_chatForbidden:
+		return mtproto.TL_inputPeerChat{ChatID: x.ID}, true
+	case mtproto.TL_channel:
+		return mtproto.TL_inputPeerChannel{ChannelID: x.ID, AccessHash: x.AccessHash}, true
+	case mtproto.TL_channelForbidden:
+		return mtproto.TL_inputPeerChannel{ChannelID: x.ID, AccessHash: x.AccessHash}, true
+	}
+	return nil, false
+}
+
+// trimTGLinkPrefix removes scheme and t.me (or telegram.me) domain from link
+func trimTGLinkPrefix(str string) (string, bool) {
+	lower := strings.ToLower(str)
+	for _, scheme := range []string{"https://", "http://"} {
+		if strings.HasPrefix(lower, scheme) {
+			lower, str = lower[len(scheme):], str[len(scheme):]
+			break
+		}
+	}
+	for _, domain := range []string{"t.me/", "telegram.me/", "www.t.me/", "www.telegram.me/"} {
+		if strings.HasPrefix(lower, domain) {
+			return str[len(domain):], true
+		}
+	}
+	return "", false
+}
+
+// isPhone checks whether str looks like phone in international format (+digits, may contain spaces, dashes and parentheses)
+func isPhone(str string) bool {
+	digits := 0
+	for i, c := range str {
+		switch {
+		case c == '+' && i == 0:
+		case c >= '0' && c <= '9':
+			digits++
+		case c == ' ' || c == '-' || c == '(' || c == ')':
+		default:
+			return false
+		}
+	}
+	return digits > 0
+}
+
+func normalizePhone(phone string) string {
+	return strings.Map(func(c rune) rune {
+		if c >= '0' && c <= '9' {
+			return c
+		}
+		return -1
+	}, phone)
+}
+
+func (c *TGClient) resolveUsername(ctx context.Context, username string) (mtproto.TL, error) {
+	if username == "" {
+		return nil, ErrPeerNotFound.Here().WithMessage("empty username")
+	}
+	if peer, ok := c.findExtraByUsername(username); ok {
+		return peer, nil
+	}
+	res, err := c.sendSyncContext(ctx, mtproto.TL_contacts_resolveUsername{Username: username})
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	if mtproto.IsError(res, "USERNAME_NOT_OCCUPIED") || mtproto.IsError(res, "USERNAME_INVALID") {
+		return nil, ErrPeerNotFound.Here().WithMessagef("username %s: %s", username, mtproto.WrongRespError(res))
+	}
+	resolved, ok := res.(mtproto.TL_contacts_resolvedPeer)
+	if !ok {
+		return nil, mtproto.WrongRespError(res)
+	}
+	return c.InputPeer(resolved.Peer)
+}
+
+func (c *TGClient) resolvePhone(ctx context.Context, phone string) (mtproto.TL, error) {
+	phone = normalizePhone(phone)
+	if inputPeer, ok := c.findExtraUserByPhone(phone); ok {
+		return inputPeer, nil
+	}
+	// users of contacts will be remembered
+	res, err := c.sendSyncContext(ctx, mtproto.TL_contacts_getContacts{})
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	if _, ok := res.(mtproto.TL_contacts_contacts); !ok {
+		return nil, mtproto.WrongRespError(res)
+	}
+	if inputPeer, ok := c.findExtraUserByPhone(phone); ok {
+		return inputPeer, nil
+	}
+	return nil, ErrPeerNotFound.Here().WithMessagef("user with phone +%s not found", phone)
+}
+
+func (c *TGClient) resolveInvite(ctx context.Context, hash string) (mtproto.TL, error) {
+	if hash == "" {
+		return nil, ErrPeerNotFound.Here().WithMessage("empty invite hash")
+	}
+	res, err := c.sendSyncContext(ctx, mtproto.TL_messages_checkChatInvite{Hash: hash})
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	if mtproto.IsError(res, "INVITE_HASH_EXPIRED") || mtproto.IsError(res, "INVITE_HASH_INVALID") {
+		return nil, ErrPeerNotFound.Here().WithMessagef("invite %s: %s", hash, mtproto.WrongRespError(res))
+	}
+	var chat mtproto.TL
+	switch invite := res.(type) {
+	case mtproto.TL_chatInviteAlready:
+		chat = invite.Chat
+	case mtproto.TL_chatInvitePeek:
+		chat = invite.Chat
+	case mtproto.TL_chatInvite:
+		return nil, ErrNotChatMember.Here().WithMessagef("not a member of %q", invite.Title)
+	default:
+		return nil, mtproto.WrongRespError(res)
+	}
+	c.rememberEventExtraData([]mtproto.TL{chat})
+	if inputPeer, ok := chatInputPeer(chat); ok {
+		return inputPeer, nil
+	}
+	return nil, mtproto.WrongRespError(chat)
+}
+
+// resolveMarkedID returns InputPeer of received user (positive ID), chat or channel (Bot API-style negative ID).
+// Unknown basic groups are requested since they do not need access hash.
+func (c *TGClient) resolveMarkedID(ctx context.Context, markedID int64) (mtproto.TL, error) {
+	peer, err := PeerFromMarkedID(markedID)
+	if err != nil {
+		return nil, ErrPeerNotFound.Here().WithMessagef("wrong peer ID: %d", markedID)
+	}
+	chat, isChat := peer.(mtproto.TL_peerChat)
+	if !isChat || c.peerKnown(peer) {
+		return c.InputPeer(peer)
+	}
+	res, err := c.sendSyncContext(ctx, mtproto.TL_messages_getChats{ID: []int32{chat.ChatID}})
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	if chats, ok := res.(mtproto.TL_messages_chats); ok {
+		for _, chatTL := range chats.Chats {
+			if inputPeer, ok := chatInputPeer(chatTL); ok {
+				return inputPeer, nil
+			}
+		}
+	}
+	return nil, ErrPeerNotFound.Here().WithMessagef("chat with ID %d is unknown", chat.ChatID)
+}
+
+// sendSyncContext is SendSync that stops waiting for response when ctx is done
+// (request itself is not cancelled, its late response is still handled)
+func (c *TGClient) sendSyncContext(ctx context.Context, msg mtproto.TLReq) (mtproto.TL, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	resChan := make(chan mtproto.TL, 1)
+	go func() { resChan <- c.SendSync(msg) }()
+	select {
+	case res := <-resChan:
+		return res, nil
+	case <-ctx.Done():
+		return nil, merry.Wrap(ctx.Err())
+	}
+}
@@ -0,0 +1,112 @@
+package tgclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+func newTestClient() *TGClient {
+	tg := &TGClient{log: mtproto.Logger{Hnd: noopLogHandler{}}}
+	tg.extraData = *newExtraData(tg)
+	return tg
+}
+
+func TestResolvePeerFromReceived(t *testing.T) {
+	tg := newTestClient()
+	tg.rememberEventExtraData([]mtproto.TL{
+		mtproto.TL_user{ID: 1, AccessHash: 10, Username: "Bob", Phone: "79991234567"},
+		mtproto.TL_user{ID: 2, AccessHash: 20, Self: true},
+		mtproto.TL_chat{ID: 3},
+		mtproto.TL_channel{ID: 4, AccessHash: 40, Username: "news"},
+		mtproto.TL_user{ID: 4, AccessHash: 41},
+	})
+
+	bob := mtproto.TL_inputPeerUser{UserID: 1, AccessHash: 10}
+	news := mtproto.TL_inputPeerChannel{ChannelID: 4, AccessHash: 40}
+	for str, expected := range map[string]mtproto.TL{
+		"@bob":                      bob,
+		"bob":                       bob,
+		" https://t.me/Bob ":        bob,
+		"telegram.me/news":          news,
+		"t.me/news/123":             news,
+		"+7 (999) 123-45-67":        bob,
+		"https://t.me/+79991234567": bob,
+		"1":                         bob,
+		"2":                         mtproto.TL_inputPeerSelf{},
+		"4":                         mtproto.TL_inputPeerUser{UserID: 4, AccessHash: 41},
+		"-3":                        mtproto.TL_inputPeerChat{ChatID: 3},
+		"-1000000000004":            news,
+	} {
+		inputPeer, err := tg.ResolvePeer(context.Background(), str)
+		if err != nil {
+			t.Errorf("%q: %s", str, err)
+		} else if !reflect.DeepEqual(inputPeer, expected) {
+			t.Errorf("%q: wrong peer %#v", str, inputPeer)
+		}
+	}
+
+	if _, err := tg.ResolvePeer(context.Background(), "+12ab"); !merry.Is(err, ErrPeerNotFound) {
+		t.Errorf("expected ErrPeerNotFound for wrong phone, got %v", err)
+	}
+	// non-negative IDs are user IDs only, chat 3 must be passed as -3
+	if _, err := tg.ResolvePeer(context.Background(), "3"); !merry.Is(err, ErrPeerNotFound) {
+		t.Errorf("expected ErrPeerNotFound for unknown user, got %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := tg.ResolvePeer(ctx, "@unknown"); !merry.Is(err, context.Canceled) {
+		t.Errorf("expected context error, got %v", err)
+	}
+}
+
+func TestResolvePeerCancelInFlight(t *testing.T) {
+	// client is not connected, so request is never answered
+	tg := NewTGClientExt(&mtproto.AppConfig{}, &mtproto.SessNoopStore{}, noopLogHandler{}, nil)
+	defer tg.Stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := tg.ResolvePeer(ctx, "@unknown")
+		errChan <- err
+	}()
+	select {
+	case err := <-errChan:
+		if !merry.Is(err, context.Canceled) {
+			t.Errorf("expected context error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ResolvePeer is still waiting after context cancellation")
+	}
+}
+
+func TestInputPeerConversions(t *testing.T) {
+	tg := newTestClient()
+	tg.rememberEventExtraData([]mtproto.TL{mtproto.TL_user{ID: 1, AccessHash: 10}, mtproto.TL_channel{ID: 4, AccessHash: 40}})
+
+	if inputPeer, err := tg.InputPeer(mtproto.TL_peerChannel{ChannelID: 4}); err != nil ||
+		!reflect.DeepEqual(inputPeer, mtproto.TL_inputPeerChannel{ChannelID: 4, AccessHash: 40}) {
+		t.Errorf("wrong channel input peer: %#v %v", inputPeer, err)
+	}
+	if _, err := tg.InputPeer(mtproto.TL_peerUser{UserID: 5}); !merry.Is(err, ErrPeerNotFound) {
+		t.Errorf("expected ErrPeerNotFound for unknown user, got %v", err)
+	}
+
+	if inputUser, ok := InputUser(mtproto.TL_inputPeerUser{UserID: 1, AccessHash: 10}); !ok ||
+		!reflect.DeepEqual(inputUser, mtproto.TL_inputUser{UserID: 1, AccessHash: 10}) {
+		t.Errorf("wrong input user: %#v", inputUser)
+	}
+	if inputChannel, ok := InputChannel(mtproto.TL_inputPeerChannel{ChannelID: 4, AccessHash: 40}); !ok ||
+		!reflect.DeepEqual(inputChannel, mtproto.TL_inputChannel{ChannelID: 4, AccessHash: 40}) {
+		t.Errorf("wrong input channel: %#v", inputChannel)
+	}
+	if _, ok := InputChannel(mtproto.TL_inputPeerChat{ChatID: 3}); ok {
+		t.Errorf("chat should not be converted to input channel")
+	}
+}
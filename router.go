@@ -0,0 +1,227 @@
+package tgclient
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+// UpdateContext is passed to update handlers. Users and chats are the ones that came
+// in the same updates batch (or difference), they may be incomplete or empty.
+type UpdateContext struct {
+	Client *TGClient
+	Update mtproto.TL
+	Users  []mtproto.TL // User
+	Chats  []mtproto.TL // Chat
+}
+
+// User returns user from update batch or (if it is missing there) from previously received data
+func (c *UpdateContext) User(userID int32) *mtproto.TL_user {
+	for _, obj := range c.Users {
+		if user, ok := obj.(mtproto.TL_user); ok && user.ID == userID {
+			return &user
+		}
+	}
+	if c.Client != nil {
+		return c.Client.FindExtraUser(userID)
+	}
+	return nil
+}
+
+// Chat returns chat from update batch or (if it is missing there) from previously received data
+func (c *UpdateContext) Chat(chatID int32) *mtproto.TL_chat {
+	for _, obj := range c.Chats {
+		if chat, ok := obj.(mtproto.TL_chat); ok && chat.ID == chatID {
+			return &chat
+		}
+	}
+	if c.Client != nil {
+		return c.Client.FindExtraChat(chatID)
+	}
+	return nil
+}
+
+// Channel returns channel from update batch or (if it is missing there) from previously received data
+func (c *UpdateContext) Channel(channelID int32) *mtproto.TL_channel {
+	for _, obj := range c.Chats {
+		if channel, ok := obj.(mtproto.TL_channel); ok && channel.ID == channelID {
+			return &channel
+		}
+	}
+	if c.Client != nil {
+		return c.Client.FindExtraChannel(channelID)
+	}
+	return nil
+}
+
+type RouteHandler func(*UpdateContext)
+
+// Middleware wraps handling of every update. It may skip update (by not calling next),
+// change context or do something before/after handlers.
+type Middleware func(next RouteHandler) RouteHandler
+
+// UpdateRouter passes updates to handlers registered for their types.
+type UpdateRouter struct {
+	mutex         *sync.RWMutex
+	handlers      map[reflect.Type][]RouteHandler
+	anyHandlers   []RouteHandler //handlers for all updates
+	middlewares   []Middleware
+	updateHandler UpdateHandler //set with SetUpdateHandler, called after other handlers
+}
+
+func NewUpdateRouter() *UpdateRouter {
+	return &UpdateRouter{
+		mutex:    &sync.RWMutex{},
+		handlers: make(map[reflect.Type][]RouteHandler),
+	}
+}
+
+// Router returns router itself. It is useful with TGClient (which embeds router) and generic On.
+func (r *UpdateRouter) Router() *UpdateRouter {
+	return r
+}
+
+// Use adds middlewares. First added middleware is the outermost one.
+func (r *UpdateRouter) Use(middlewares ...Middleware) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
+// OnUpdate adds handler for updates of same type as sample (for example, mtproto.TL_updateUserTyping{}).
+// If sample is nil, handler is called for all updates.
+func (r *UpdateRouter) OnUpdate(sample mtproto.TL, handler RouteHandler) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if sample == nil {
+		r.anyHandlers = append(r.anyHandlers, handler)
+		return
+	}
+	typ := reflect.TypeOf(sample)
+	r.handlers[typ] = append(r.handlers[typ], handler)
+}
+
+// OnNewMessage adds handler for new messages (from updateNewMessage and updateNewChannelMessage).
+// Service messages are skipped.
+func (r *UpdateRouter) OnNewMessage(handler func(*UpdateContext, mtproto.TL_message)) {
+	messageHandler := func(ctx *UpdateContext, message mtproto.TL) {
+		if msg, ok := message.(mtproto.TL_message); ok {
+			handler(ctx, msg)
+		}
+	}
+	r.OnUpdate(mtproto.TL_updateNewMessage{}, func(ctx *UpdateContext) {
+		messageHandler(ctx, ctx.Update.(mtproto.TL_updateNewMessage).Message)
+	})
+	r.OnUpdate(mtproto.TL_updateNewChannelMessage{}, func(ctx *UpdateContext) {
+		messageHandler(ctx, ctx.Update.(mtproto.TL_updateNewChannelMessage).Message)
+	})
+}
+
+// OnEditMessage adds handler for edited messages (from updateEditMessage and updateEditChannelMessage).
+// Service messages are skipped.
+func (r *UpdateRouter) OnEditMessage(handler func(*UpdateContext, mtproto.TL_message)) {
+	messageHandler := func(ctx *UpdateContext, message mtproto.TL) {
+		if msg, ok := message.(mtproto.TL_message); ok {
+			handler(ctx, msg)
+		}
+	}
+	r.OnUpdate(mtproto.TL_updateEditMessage{}, func(ctx *UpdateContext) {
+		messageHandler(ctx, ctx.Update.(mtproto.TL_updateEditMessage).Message)
+	})
+	r.OnUpdate(mtproto.TL_updateEditChannelMessage{}, func(ctx *UpdateContext) {
+		messageHandler(ctx, ctx.Update.(mtproto.TL_updateEditChannelMessage).Message)
+	})
+}
+
+// OnDeleteMessages adds handler for deleted messages (from updateDeleteMessages and updateDeleteChannelMessages).
+// channelID is zero for messages of private chats and basic groups.
+func (r *UpdateRouter) OnDeleteMessages(handler func(ctx *UpdateContext, channelID int32, ids []int32)) {
+	r.OnUpdate(mtproto.TL_updateDeleteMessages{}, func(ctx *UpdateContext) {
+		handler(ctx, 0, ctx.Update.(mtproto.TL_updateDeleteMessages).Messages)
+	})
+	r.OnUpdate(mtproto.TL_updateDeleteChannelMessages{}, func(ctx *UpdateContext) {
+		u := ctx.Update.(mtproto.TL_updateDeleteChannelMessages)
+		handler(ctx, u.ChannelID, u.Messages)
+	})
+}
+
+// OnCallbackQuery adds handler for bot inline keyboard button presses
+func (r *UpdateRouter) OnCallbackQuery(handler func(*UpdateContext, mtproto.TL_updateBotCallbackQuery)) {
+	r.OnUpdate(mtproto.TL_updateBotCallbackQuery{}, func(ctx *UpdateContext) {
+		handler(ctx, ctx.Update.(mtproto.TL_updateBotCallbackQuery))
+	})
+}
+
+// OnUserStatus adds handler for user online status changes (status is one of mtproto.TL_userStatus*)
+func (r *UpdateRouter) OnUserStatus(handler func(ctx *UpdateContext, userID int32, status mtproto.TL)) {
+	r.OnUpdate(mtproto.TL_updateUserStatus{}, func(ctx *UpdateContext) {
+		u := ctx.Update.(mtproto.TL_updateUserStatus)
+		handler(ctx, u.UserID, u.Status)
+	})
+}
+
+func (r *UpdateRouter) setUpdateHandler(handler UpdateHandler) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.updateHandler = handler
+}
+
+// Route passes update (through middlewares) to matching handlers
+func (r *UpdateRouter) Route(ctx *UpdateContext) {
+	r.mutex.RLock()
+	handlers := append([]RouteHandler(nil), r.handlers[reflect.TypeOf(ctx.Update)]...)
+	handlers = append(handlers, r.anyHandlers...)
+	middlewares := r.middlewares
+	updateHandler := r.updateHandler
+	r.mutex.RUnlock()
+
+	handle := func(ctx *UpdateContext) {
+		for _, handler := range handlers {
+			handler(ctx)
+		}
+		if updateHandler != nil {
+			updateHandler(ctx.Update)
+		}
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handle = middlewares[i](handle)
+	}
+	handle(ctx)
+}
+
+// RecoverMiddleware recovers from handlers panics and logs them
+func RecoverMiddleware(log mtproto.Logger) Middleware {
+	return func(next RouteHandler) RouteHandler {
+		return func(ctx *UpdateContext) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Error(merry.Errorf("%v", r), "panic while handling %T", ctx.Update)
+				}
+			}()
+			next(ctx)
+		}
+	}
+}
+
+// FilterMiddleware skips updates for which filter returns false
+func FilterMiddleware(filter func(*UpdateContext) bool) Middleware {
+	return func(next RouteHandler) RouteHandler {
+		return func(ctx *UpdateContext) {
+			if filter(ctx) {
+				next(ctx)
+			}
+		}
+	}
+}
+
+// LogMiddleware logs every update (with debug level)
+func LogMiddleware(log mtproto.Logger) Middleware {
+	return func(next RouteHandler) RouteHandler {
+		return func(ctx *UpdateContext) {
+			log.Debug("update: %s", mtproto.SprintTextShort(ctx.Update, 64))
+			next(ctx)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+//go:build go1.21
+// +build go1.21
+
+package tgclient
+
+import (
+	"reflect"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+// UpdateRouterOwner is implemented by UpdateRouter and TGClient (which embeds it)
+type UpdateRouterOwner interface {
+	Router() *UpdateRouter
+}
+
+// On adds handler for updates of type T, for example:
+//
+//	tgclient.On(tg, func(ctx *tgclient.UpdateContext, u mtproto.TL_updateUserTyping) { ... })
+//
+// If T is an interface (like mtproto.TLWithChannelID), handler is called for all updates implementing it.
+func On[T mtproto.TL](owner UpdateRouterOwner, handler func(*UpdateContext, T)) {
+	r := owner.Router()
+	if reflect.TypeOf((*T)(nil)).Elem().Kind() == reflect.Interface {
+		r.OnUpdate(nil, func(ctx *UpdateContext) {
+			if u, ok := ctx.Update.(T); ok {
+				handler(ctx, u)
+			}
+		})
+		return
+	}
+	var sample T
+	r.OnUpdate(sample, func(ctx *UpdateContext) {
+		handler(ctx, ctx.Update.(T))
+	})
+}
@@ -0,0 +1,31 @@
+//go:build go1.21
+// +build go1.21
+
+package tgclient
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+func TestRouterOnGeneric(t *testing.T) {
+	r := NewUpdateRouter()
+	var typing []int32
+	var channels []int32
+	On(r, func(ctx *UpdateContext, u mtproto.TL_updateUserTyping) {
+		typing = append(typing, u.UserID)
+	})
+	On(r, func(ctx *UpdateContext, u mtproto.TLWithChannelID) {
+		channels = append(channels, u.GetChannelID())
+	})
+
+	r.Route(&UpdateContext{Update: mtproto.TL_updateUserTyping{UserID: 2}})
+	r.Route(&UpdateContext{Update: mtproto.TL_updateChannelTooLong{ChannelID: 3}})
+	r.Route(&UpdateContext{Update: mtproto.TL_updateUserStatus{UserID: 4}})
+
+	if !reflect.DeepEqual(typing, []int32{2}) || !reflect.DeepEqual(channels, []int32{3}) {
+		t.Errorf("wrong handled updates: %v %v", typing, channels)
+	}
+}
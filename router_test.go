@@ -0,0 +1,68 @@
+package tgclient
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+func TestUpdateRouter(t *testing.T) {
+	r := NewUpdateRouter()
+	var calls []string
+	r.Use(func(next RouteHandler) RouteHandler {
+		return func(ctx *UpdateContext) {
+			calls = append(calls, "outer")
+			next(ctx)
+		}
+	})
+	r.Use(FilterMiddleware(func(ctx *UpdateContext) bool {
+		_, isTyping := ctx.Update.(mtproto.TL_updateUserTyping)
+		return !isTyping
+	}))
+	r.OnNewMessage(func(ctx *UpdateContext, msg mtproto.TL_message) {
+		calls = append(calls, "message:"+msg.Message+":"+ctx.User(msg.FromID.(mtproto.TL_peerUser).UserID).Username)
+	})
+	r.OnDeleteMessages(func(ctx *UpdateContext, channelID int32, ids []int32) {
+		calls = append(calls, "delete")
+		if channelID != 7 || !reflect.DeepEqual(ids, []int32{1, 2}) {
+			t.Errorf("wrong deleted messages: %d %v", channelID, ids)
+		}
+	})
+	r.OnUserStatus(func(ctx *UpdateContext, userID int32, status mtproto.TL) {
+		calls = append(calls, "status")
+	})
+	r.OnUpdate(nil, func(ctx *UpdateContext) {
+		calls = append(calls, "any")
+	})
+	r.setUpdateHandler(func(update mtproto.TL) {
+		calls = append(calls, reflect.TypeOf(update).Name())
+	})
+
+	r.Route(&UpdateContext{
+		Update: mtproto.TL_updateNewMessage{Message: mtproto.TL_message{ID: 1, FromID: mtproto.TL_peerUser{UserID: 2}, Message: "hi"}},
+		Users:  []mtproto.TL{mtproto.TL_user{ID: 2, Username: "bob"}},
+	})
+	r.Route(&UpdateContext{Update: mtproto.TL_updateDeleteChannelMessages{ChannelID: 7, Messages: []int32{1, 2}}})
+	r.Route(&UpdateContext{Update: mtproto.TL_updateUserTyping{UserID: 2}})
+	r.Route(&UpdateContext{Update: mtproto.TL_updateNewMessage{Message: mtproto.TL_messageService{ID: 2}}})
+
+	expected := []string{
+		"outer", "message:hi:bob", "any", "TL_updateNewMessage",
+		"outer", "delete", "any", "TL_updateDeleteChannelMessages",
+		"outer",
+		"outer", "any", "TL_updateNewMessage",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("wrong calls: %v", calls)
+	}
+}
+
+func TestRecoverMiddleware(t *testing.T) {
+	r := NewUpdateRouter()
+	r.Use(RecoverMiddleware(mtproto.Logger{Hnd: noopLogHandler{}}))
+	r.OnCallbackQuery(func(ctx *UpdateContext, query mtproto.TL_updateBotCallbackQuery) {
+		panic("handler failed")
+	})
+	r.Route(&UpdateContext{Update: mtproto.TL_updateBotCallbackQuery{QueryID: 1}})
+}
@@ -0,0 +1,98 @@
+package tgclient
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/ansel1/merry"
+)
+
+var ErrNoUpdatesState = merry.New("no updates state")
+
+// StateInfo is a persistent part of updates state: common pts/qts/date/seq and channels pts
+type StateInfo struct {
+	Pts      int32
+	Qts      int32
+	Date     int32
+	Seq      int32
+	Channels map[int32]int32 //channel ID -> pts
+}
+
+type StateStore interface {
+	Save(*StateInfo) error
+	Load(*StateInfo) error
+}
+
+type StateMemStore struct {
+	mutex sync.Mutex
+	state *StateInfo
+}
+
+func (s *StateMemStore) Save(state *StateInfo) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.state = state.copy()
+	return nil
+}
+
+func (s *StateMemStore) Load(state *StateInfo) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.state == nil {
+		return ErrNoUpdatesState.Here()
+	}
+	*state = *s.state.copy()
+	return nil
+}
+
+type StateFileStore struct {
+	FPath string
+}
+
+func (s *StateFileStore) Save(state *StateInfo) (err error) {
+	f, err := os.Create(s.FPath + ".temp")
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(state); err != nil {
+		return merry.Wrap(err)
+	}
+	if err := f.Close(); err != nil {
+		return merry.Wrap(err)
+	}
+
+	if err := os.Rename(s.FPath+".temp", s.FPath); err != nil {
+		return merry.Wrap(err)
+	}
+	return nil
+}
+
+func (s *StateFileStore) Load(state *StateInfo) error {
+	f, err := os.Open(s.FPath)
+	if os.IsNotExist(err) {
+		return ErrNoUpdatesState.Here()
+	}
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(f).Decode(state); err != nil {
+		return merry.Wrap(err)
+	}
+	return nil
+}
+
+func (s *StateInfo) copy() *StateInfo {
+	res := *s
+	res.Channels = make(map[int32]int32, len(s.Channels))
+	for id, pts := range s.Channels {
+		res.Channels[id] = pts
+	}
+	return &res
+}
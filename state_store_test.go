@@ -0,0 +1,37 @@
+package tgclient
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ansel1/merry"
+)
+
+func testStateStore(t *testing.T, store StateStore) {
+	info := &StateInfo{}
+	if err := store.Load(info); !merry.Is(err, ErrNoUpdatesState) {
+		t.Fatalf("expected ErrNoUpdatesState, got %v", err)
+	}
+
+	saved := &StateInfo{Pts: 1, Qts: 2, Date: 3, Seq: 4, Channels: map[int32]int32{10: 100, 20: 200}}
+	if err := store.Save(saved); err != nil {
+		t.Fatal(err)
+	}
+	saved.Channels[30] = 300 //must not affect stored state
+	if err := store.Load(info); err != nil {
+		t.Fatal(err)
+	}
+	expected := &StateInfo{Pts: 1, Qts: 2, Date: 3, Seq: 4, Channels: map[int32]int32{10: 100, 20: 200}}
+	if !reflect.DeepEqual(info, expected) {
+		t.Errorf("wrong loaded state: %#v", info)
+	}
+}
+
+func TestStateMemStore(t *testing.T) {
+	testStateStore(t, &StateMemStore{})
+}
+
+func TestStateFileStore(t *testing.T) {
+	testStateStore(t, &StateFileStore{FPath: filepath.Join(t.TempDir(), "tg.updates")})
+}
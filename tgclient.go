@@ -3,7 +3,6 @@ package tgclient
 import (
 	"os"
 	"path/filepath"
-	"reflect"
 	"runtime"
 	"time"
 
@@ -13,12 +12,14 @@ import (
 )
 
 type TGClient struct {
-	mt                   *mtproto.MTProto
-	updatesState         *mtproto.TL_updates_state
-	handleUpdateExternal UpdateHandler
-	log                  mtproto.Logger
+	mt         *mtproto.MTProto
+	updates    *updatesEngine
+	dispatcher *updatesDispatcher
+	log        mtproto.Logger
 	extraData
 	Downloader
+	Uploader
+	UpdateRouter
 }
 
 type UpdateHandler func(mtproto.TL)
@@ -56,22 +57,67 @@ func NewTGClientExt(cfg *mtproto.AppConfig, sessStore mtproto.SessionStore, logH
 	})
 
 	client := &TGClient{
-		mt:           mt,
-		updatesState: &mtproto.TL_updates_state{},
-		log:          mtproto.Logger{logHnd},
+		mt:  mt,
+		log: mtproto.Logger{logHnd},
 	}
 	client.Downloader = *NewDownloader(client)
+	client.Uploader = *NewUploader(client)
 	client.extraData = *newExtraData(client)
+	client.UpdateRouter = *NewUpdateRouter()
+	client.dispatcher = newUpdatesDispatcher(updateWorkersCount, updatesQueueLimit)
+	client.dispatcher.setHandler(client.handleUpdate)
+	client.updates = newUpdatesEngine(
+		client.sendUpdatesRequest, client.rememberEventExtraData, client.deliverUpdate, client.channelAccessHash, client.log)
+	client.updates.waitDeliveryRoom = client.dispatcher.waitForRoom
+	client.updates.peers = &client.extraData
 
 	mt.SetEventsHandler(client.handleEvent)
-	for i := 0; i < 4; i++ {
-		go client.partsDownloadRoutine()
-	}
+	go client.updates.channelsPollRoutine()
 	return client
 }
 
+// SetUpdateHandler sets handler for all updates, it is called after router handlers (see UpdateRouter).
+// Handlers are called from several goroutines: updates of same chat (or user or channel)
+// are handled one by one in order, updates of different chats may be handled in parallel.
 func (c *TGClient) SetUpdateHandler(handleUpdate UpdateHandler) {
-	c.handleUpdateExternal = handleUpdate
+	c.UpdateRouter.setUpdateHandler(handleUpdate)
+}
+
+// SetUpdateWorkers sets max number of updates handled in parallel (4 by default)
+// and max number of queued updates (1024 by default): when it is reached, receiving of new updates is paused.
+// Workers count must be set before first update is received.
+func (c *TGClient) SetUpdateWorkers(workers, queueLimit int) {
+	c.dispatcher.setLimits(workers, queueLimit)
+}
+
+// SetStateStore sets store for updates state. Saved state is restored in AuthAndInitEvents
+// and updates missed while client was stopped are fetched. Must be called before AuthAndInitEvents.
+func (c *TGClient) SetStateStore(store StateStore) {
+	c.updates.store = store
+}
+
+// SaveUpdatesState saves updates state immediately (it is also saved automatically shortly after each change)
+func (c *TGClient) SaveUpdatesState() error {
+	return merry.Wrap(c.updates.saveState())
+}
+
+// SetPeerStore sets store for received users, chats and channels (with their access hashes)
+// and loads previously saved ones.
+func (c *TGClient) SetPeerStore(store PeerStore) error {
+	c.extraData.store = store
+	return merry.Wrap(c.loadPeers())
+}
+
+// SavePeers saves received users, chats and channels immediately (they are also saved automatically shortly after changes)
+func (c *TGClient) SavePeers() error {
+	return merry.Wrap(c.savePeers())
+}
+
+// Stop stops background routines of client (like polling of idle channels) and disconnects.
+// Client must not be used after it.
+func (c *TGClient) Stop() error {
+	c.updates.stopPolling()
+	return merry.Wrap(c.mt.Disconnect())
 }
 
 func (c *TGClient) InitAndConnect() error {
@@ -79,59 +125,59 @@ func (c *TGClient) InitAndConnect() error {
 }
 
 func (c *TGClient) handleEvent(eventObj mtproto.TL) {
-	switch event := eventObj.(type) {
-	case mtproto.TL_updatesTooLong:
-		//TODO: what?
-		// Too many updates, it is necessary to execute updates.getDifference.
-		// https://core.telegram.org/constructor/updatesTooLong
-		c.log.Warn("updates too long")
-	case mtproto.TL_updateShort:
-		c.updatesState.Date = event.Date
-		c.handleUpdate(event.Update)
-	case mtproto.TL_updates:
-		c.updatesState.Date = event.Date
-		c.updatesState.Seq = event.Seq
-		c.rememberEventExtraData(event.Users)
-		c.rememberEventExtraData(event.Chats)
-		for _, u := range event.Updates {
-			c.handleUpdate(u)
-		}
-	case mtproto.TL_updateShortMessage:
-		c.updatesState.Date = event.Date
-		c.updatesState.Pts = event.Pts
-		// update.PtsCount
-		c.handleUpdate(event)
-	case mtproto.TL_updateShortChatMessage:
-		c.updatesState.Date = event.Date
-		c.updatesState.Pts = event.Pts
-		// update.PtsCount
-		c.handleUpdate(event)
-	case mtproto.TL_updatesCombined:
-		c.updatesState.Date = event.Date
-		c.updatesState.Seq = event.Seq
-		c.rememberEventExtraData(event.Users)
-		c.rememberEventExtraData(event.Chats)
-		// update.SeqStart
-		for _, u := range event.Updates {
-			c.handleUpdate(u)
-		}
+	c.dispatcher.waitForRoom()
+	c.updates.handleEvent(eventObj)
+}
+
+func (c *TGClient) deliverUpdate(update mtproto.TL, users, chats []mtproto.TL) {
+	c.rememberMessagePeers(update)
+	c.dispatcher.dispatch(update, users, chats)
+}
+
+func (c *TGClient) handleUpdate(ctx *UpdateContext) {
+	ctx.Client = c
+	c.Route(ctx)
+}
+
+func (c *TGClient) sendUpdatesRequest(msg mtproto.TLReq) mtproto.TL {
+	// not c.SendSyncRetry: responses must not be processed as updates (engine is locked while waiting)
+	return c.mt.SendSyncRetry(msg, time.Second, 0, 30*time.Second)
+}
+
+// handleResponse passes updates received as request response (like result of messages.sendMessage)
+// to updates engine (server does not send them separately) and remembers users and chats of other responses.
+func (c *TGClient) handleResponse(msg mtproto.TLReq, res mtproto.TL) {
+	switch r := res.(type) {
 	case mtproto.TL_updateShortSentMessage:
-		c.updatesState.Pts = event.Pts
-		// update.PtsCount
-		c.handleUpdate(event)
+		if update, ok := normalizeSentMessage(msg, r, c.selfUserID()); ok {
+			c.updates.handleEvent(mtproto.TL_updateShort{Update: update, Date: r.Date})
+		} else {
+			c.updates.handleEvent(res)
+		}
+	case mtproto.TL_updates, mtproto.TL_updatesCombined, mtproto.TL_updateShort,
+		mtproto.TL_updateShortMessage, mtproto.TL_updateShortChatMessage, mtproto.TL_updatesTooLong:
+		c.updates.handleEvent(res)
 	default:
-		c.log.Warn(mtproto.UnexpectedTL("event", eventObj))
+		c.rememberResponseExtraData(res)
 	}
 }
 
-func (e *TGClient) handleUpdate(obj mtproto.TL) {
-	value := reflect.ValueOf(obj).FieldByName("Pts")
-	if value != (reflect.Value{}) {
-		e.updatesState.Pts = int32(value.Int())
-	}
-	if e.handleUpdateExternal != nil {
-		e.handleUpdateExternal(obj)
+// channelAccessHash returns access hash of received channel. Access hash of min channel is not valid for requests.
+func (c *TGClient) channelAccessHash(channelID int32) (int64, bool) {
+	if channel := c.FindExtraChannel(channelID); channel != nil && !channel.Min {
+		return channel.AccessHash, true
 	}
+	return 0, false
+}
+
+// ChannelPts returns local pts of channel (zero if unknown)
+func (c *TGClient) ChannelPts(channelID int32) int32 {
+	return c.updates.ChannelPts(channelID)
+}
+
+// UpdatesState returns current common updates state (pts, qts, date and seq)
+func (c *TGClient) UpdatesState() mtproto.TL_updates_state {
+	return c.updates.State()
 }
 
 func (c *TGClient) AuthExt(authData mtproto.AuthDataProvider, message mtproto.TLReq) (mtproto.TL, error) {
@@ -149,13 +195,10 @@ func (c *TGClient) AuthExt(authData mtproto.AuthDataProvider, message mtproto.TL
 }
 
 func (c *TGClient) AuthAndInitEvents(authData mtproto.AuthDataProvider) error {
-	// after reconnection TG *sometimes* stops sending updates
+	// after reconnection TG *sometimes* stops sending updates,
+	// getDifference resumes them and also fetches ones missed while disconnected
 	c.mt.SetReconnectionHandler(func() error {
-		res := c.SendSync(mtproto.TL_updates_getState{})
-		if _, ok := res.(mtproto.TL_updates_state); !ok {
-			return mtproto.WrongRespError(res)
-		}
-		return nil
+		return merry.Wrap(c.updates.resume())
 	})
 
 	res, err := c.AuthExt(authData, mtproto.TL_updates_getState{})
@@ -165,16 +208,29 @@ func (c *TGClient) AuthAndInitEvents(authData mtproto.AuthDataProvider) error {
 	if _, ok := res.(mtproto.TL_updates_state); !ok {
 		return mtproto.WrongRespError(res)
 	}
-	return nil
+
+	// fetching updates missed while client was stopped
+	loaded, err := c.updates.loadState()
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	if loaded {
+		return merry.Wrap(c.updates.resume())
+	}
+	return merry.Wrap(c.updates.initState(res))
 }
 
 func (c *TGClient) SendSync(msg mtproto.TLReq) mtproto.TL {
-	return c.mt.SendSync(msg)
+	res := c.mt.SendSync(msg)
+	c.handleResponse(msg, res)
+	return res
 }
 
 func (c *TGClient) SendSyncRetry(
 	msg mtproto.TLReq, failRetryInterval time.Duration,
 	floodNumShortRetries int, floodMaxWait time.Duration,
 ) mtproto.TL {
-	return c.mt.SendSyncRetry(msg, failRetryInterval, floodNumShortRetries, floodMaxWait)
+	res := c.mt.SendSyncRetry(msg, failRetryInterval, floodNumShortRetries, floodMaxWait)
+	c.handleResponse(msg, res)
+	return res
 }
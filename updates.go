@@ -0,0 +1,524 @@
+package tgclient
+
+import (
+	"sync"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+// Updates sequence is described here: https://core.telegram.org/api/updates
+// Update with pts/qts/seq is applied only if it follows local state exactly.
+// Already applied ones are dropped. Ones that came after a gap are held for a while
+// and, if the gap is not filled, missing updates are fetched with updates.getDifference.
+
+const (
+	updatesGapTimeout = 500 * time.Millisecond
+	stateSaveInterval = time.Second
+)
+
+type updateOrder int
+
+const (
+	updateApply updateOrder = iota
+	updateSkip
+	updateGap
+)
+
+// checkUpdateOrder compares local state with remote one (pts, qts or seq_start) and its count
+// (pts_count for pts, 1 for qts and seq). Zero local state means that it is unknown yet,
+// zero remote state means that update must be applied without check.
+func checkUpdateOrder(local, remote, count int32) updateOrder {
+	switch {
+	case local == 0 || remote == 0 || local+count == remote:
+		return updateApply
+	case local+count > remote:
+		return updateSkip
+	default:
+		return updateGap
+	}
+}
+
+type updatesEngine struct {
+	mutex      *sync.Mutex
+	state      mtproto.TL_updates_state
+	pending    []mtproto.TL // events and single updates (see wrapUpdate) that came after a gap
+	needDiff   bool         // previous getDifference has failed
+	inDiff     bool         // getDifference is in progress (missing peers must not be fetched meanwhile)
+	diffAgain  bool         // getDifference was requested while previous one was in progress
+	gapTimer   *time.Timer
+	gapTimeout time.Duration
+
+	// channels state is guarded by separate mutex, it is locked after main one (if both are needed)
+	channelsMutex     *sync.Mutex
+	channels          map[int32]*channelUpdates
+	channelDiffSem    chan struct{}
+	channelDiffLimit  int32
+	channelAccessHash func(int32) (int64, bool)
+	stopPoll          chan struct{}
+
+	store      StateStore  //may be nil
+	storeMutex *sync.Mutex //serializes store.Save calls
+	saveMutex  *sync.Mutex //guards saveTimer, must not be held while locking other mutexes
+	saveTimer  *time.Timer
+
+	send             func(mtproto.TLReq) mtproto.TL
+	remember         func([]mtproto.TL)
+	deliver          func(update mtproto.TL, users, chats []mtproto.TL) //must not block, it is called while state is locked
+	waitDeliveryRoom func()                                             //may be nil, called (without locks) before fetching channel differences
+	peers            knownPeers                                         //may be nil, used to check peers of short updates
+	log              mtproto.Logger
+}
+
+// knownPeers provides info about already received users and chats
+type knownPeers interface {
+	selfUserID() int32
+	peerKnown(peer mtproto.TL) bool
+}
+
+func newUpdatesEngine(
+	send func(mtproto.TLReq) mtproto.TL, remember func([]mtproto.TL),
+	deliver func(mtproto.TL, []mtproto.TL, []mtproto.TL),
+	channelAccessHash func(int32) (int64, bool), log mtproto.Logger,
+) *updatesEngine {
+	return &updatesEngine{
+		mutex:             &sync.Mutex{},
+		gapTimeout:        updatesGapTimeout,
+		channelsMutex:     &sync.Mutex{},
+		channels:          make(map[int32]*channelUpdates),
+		channelDiffSem:    make(chan struct{}, channelDifferenceParallelism),
+		channelDiffLimit:  channelDifferenceLimit,
+		channelAccessHash: channelAccessHash,
+		stopPoll:          make(chan struct{}),
+		storeMutex:        &sync.Mutex{},
+		saveMutex:         &sync.Mutex{},
+		send:              send,
+		remember:          remember,
+		deliver:           deliver,
+		log:               log,
+	}
+}
+
+func (e *updatesEngine) State() mtproto.TL_updates_state {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.state
+}
+
+func (e *updatesEngine) setState(stateTL mtproto.TL) error {
+	state, ok := stateTL.(mtproto.TL_updates_state)
+	if !ok {
+		return mtproto.WrongRespError(stateTL)
+	}
+	e.state = state
+	return nil
+}
+
+// initState sets state received from updates.getState
+func (e *updatesEngine) initState(stateTL mtproto.TL) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	defer e.scheduleStateSave()
+	return merry.Wrap(e.setState(stateTL))
+}
+
+// resume fetches updates missed while disconnected (or just the state if it is unknown)
+func (e *updatesEngine) resume() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	defer e.scheduleStateSave()
+	return merry.Wrap(e.getDifference())
+}
+
+// loadState restores state from store. Returns false if there was no saved state.
+// Restored channels will be polled shortly.
+func (e *updatesEngine) loadState() (bool, error) {
+	if e.store == nil {
+		return false, nil
+	}
+	info := &StateInfo{}
+	if err := e.store.Load(info); err != nil {
+		if merry.Is(err, ErrNoUpdatesState) {
+			return false, nil
+		}
+		return false, merry.Wrap(err)
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.state = mtproto.TL_updates_state{Pts: info.Pts, Qts: info.Qts, Date: info.Date, Seq: info.Seq}
+	e.channelsMutex.Lock()
+	defer e.channelsMutex.Unlock()
+	for channelID, pts := range info.Channels {
+		ch := e.channel(channelID)
+		ch.pts = pts
+		ch.nextPoll = time.Now()
+	}
+	return info.Pts != 0, nil
+}
+
+func (e *updatesEngine) stateInfo() *StateInfo {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.channelsMutex.Lock()
+	defer e.channelsMutex.Unlock()
+	info := &StateInfo{Pts: e.state.Pts, Qts: e.state.Qts, Date: e.state.Date, Seq: e.state.Seq, Channels: make(map[int32]int32)}
+	for channelID, ch := range e.channels {
+		if ch.pts != 0 {
+			info.Channels[channelID] = ch.pts
+		}
+	}
+	return info
+}
+
+func (e *updatesEngine) saveState() error {
+	if e.store == nil {
+		return nil
+	}
+	e.saveMutex.Lock()
+	if e.saveTimer != nil {
+		e.saveTimer.Stop()
+		e.saveTimer = nil
+	}
+	e.saveMutex.Unlock()
+
+	e.storeMutex.Lock()
+	defer e.storeMutex.Unlock()
+	return merry.Wrap(e.store.Save(e.stateInfo()))
+}
+
+// scheduleStateSave saves state a bit later, so frequent updates will not cause frequent writes.
+// State is saved after updates are delivered, so some of them may be delivered again after restart.
+func (e *updatesEngine) scheduleStateSave() {
+	if e.store == nil {
+		return
+	}
+	e.saveMutex.Lock()
+	defer e.saveMutex.Unlock()
+	if e.saveTimer == nil {
+		e.saveTimer = time.AfterFunc(stateSaveInterval, func() {
+			if err := e.saveState(); err != nil {
+				e.log.Error(err, "failed to save updates state")
+			}
+		})
+	}
+}
+
+func (e *updatesEngine) handleEvent(event mtproto.TL) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	defer e.scheduleStateSave()
+	if _, ok := event.(mtproto.TL_updatesTooLong); ok {
+		e.getDifferenceLogged()
+		return
+	}
+	if e.inDiff {
+		// state is unlocked while difference is requested, event will be processed after it
+		e.pending = append(e.pending, event)
+		return
+	}
+	e.process(event)
+	e.applyPending()
+}
+
+// process applies event or holds it (if there is a gap before it)
+func (e *updatesEngine) process(eventObj mtproto.TL) {
+	switch event := eventObj.(type) {
+	case mtproto.TL_updateShort:
+		e.processUpdate(event.Update, event.Date, nil, nil)
+	case mtproto.TL_updates:
+		e.processSeq(event, event.Seq, event.Seq, event.Date, event.Users, event.Chats, event.Updates)
+	case mtproto.TL_updatesCombined:
+		e.processSeq(event, event.SeqStart, event.Seq, event.Date, event.Users, event.Chats, event.Updates)
+	case mtproto.TL_updateShortMessage:
+		e.processUpdate(normalizeShortUpdate(event, e.selfUserID()), event.Date, nil, nil)
+	case mtproto.TL_updateShortChatMessage:
+		e.processUpdate(normalizeShortUpdate(event, e.selfUserID()), event.Date, nil, nil)
+	case mtproto.TL_updateShortSentMessage:
+		// request is unknown here, it should be normalized with normalizeSentMessage before
+		e.processUpdate(event, event.Date, nil, nil)
+	default:
+		e.log.Warn(mtproto.UnexpectedTL("event", eventObj))
+	}
+}
+
+func (e *updatesEngine) processSeq(event mtproto.TL, seqStart, seq, date int32, users, chats, updates []mtproto.TL) {
+	switch checkUpdateOrder(e.state.Seq, seqStart, 1) {
+	case updateSkip:
+		e.log.Debug("updates: skipping seq %d-%d (local %d)", seqStart, seq, e.state.Seq)
+		return
+	case updateGap:
+		e.log.Debug("updates: seq gap: %d -> %d", e.state.Seq, seqStart)
+		e.hold(event)
+		return
+	}
+	e.remember(users)
+	e.remember(chats)
+	// date of unordered (seq=0) updates is applied with each update
+	var updateDate int32
+	if seq == 0 {
+		updateDate = date
+	}
+	for _, u := range updates {
+		e.processUpdate(u, updateDate, users, chats)
+	}
+	if seq != 0 {
+		e.state.Seq = seq
+		e.state.Date = date
+	}
+}
+
+// processUpdate checks common pts and qts of update. Channel updates are checked separately with their own pts.
+func (e *updatesEngine) processUpdate(update mtproto.TL, date int32, users, chats []mtproto.TL) {
+	if channelID, isChannel := updateChannelID(update); isChannel {
+		e.processChannelUpdate(channelID, update, users, chats)
+		return
+	}
+	if u, ok := update.(mtproto.TLWithPts); ok {
+		var count int32
+		if uc, ok := update.(mtproto.TLWithPtsCount); ok {
+			count = uc.GetPtsCount()
+		}
+		switch checkUpdateOrder(e.state.Pts, u.GetPts(), count) {
+		case updateSkip:
+			e.log.Debug("updates: skipping pts %d+%d (local %d)", u.GetPts(), count, e.state.Pts)
+			return
+		case updateGap:
+			e.log.Debug("updates: pts gap: %d -> %d-%d", e.state.Pts, u.GetPts(), count)
+			e.hold(wrapUpdate(update, date, users, chats))
+			return
+		}
+		if users == nil && chats == nil && e.state.Pts != 0 && !e.inDiff && !e.peersKnown(update) {
+			// short update mentions unknown user or chat, difference will contain it with full info
+			e.log.Debug("updates: pts %d mentions unknown peers, getting difference", u.GetPts())
+			e.getDifferenceLogged()
+			if e.needDiff || checkUpdateOrder(e.state.Pts, u.GetPts(), count) != updateApply {
+				return //delivered with difference or will be fetched later
+			}
+		}
+		e.state.Pts = u.GetPts()
+	}
+	if u, ok := update.(mtproto.TLWithQts); ok {
+		switch checkUpdateOrder(e.state.Qts, u.GetQts(), 1) {
+		case updateSkip:
+			e.log.Debug("updates: skipping qts %d (local %d)", u.GetQts(), e.state.Qts)
+			return
+		case updateGap:
+			e.log.Debug("updates: qts gap: %d -> %d", e.state.Qts, u.GetQts())
+			e.hold(wrapUpdate(update, date, users, chats))
+			return
+		}
+		e.state.Qts = u.GetQts()
+	}
+	if date != 0 {
+		e.state.Date = date
+	}
+	e.deliver(update, users, chats)
+}
+
+// wrapUpdate wraps single update (with related users and chats) to be held and processed later
+// as unordered (seq=0) updates batch
+func wrapUpdate(update mtproto.TL, date int32, users, chats []mtproto.TL) mtproto.TL {
+	return mtproto.TL_updates{Updates: []mtproto.TL{update}, Users: users, Chats: chats, Date: date}
+}
+
+func unwrapUpdate(wrapped mtproto.TL) (update mtproto.TL, users, chats []mtproto.TL) {
+	u := wrapped.(mtproto.TL_updates)
+	return u.Updates[0], u.Users, u.Chats
+}
+
+func (e *updatesEngine) selfUserID() int32 {
+	if e.peers == nil {
+		return 0
+	}
+	return e.peers.selfUserID()
+}
+
+func (e *updatesEngine) peersKnown(update mtproto.TL) bool {
+	if e.peers == nil {
+		return true
+	}
+	for _, peer := range messagePeers(update) {
+		if !e.peers.peerKnown(peer) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *updatesEngine) hold(event mtproto.TL) {
+	e.pending = append(e.pending, event)
+	e.startGapTimer()
+}
+
+func (e *updatesEngine) startGapTimer() {
+	if e.gapTimer == nil {
+		e.gapTimer = time.AfterFunc(e.gapTimeout, e.onGapTimeout)
+	}
+}
+
+// applyPending retries held events until none of them can be applied
+func (e *updatesEngine) applyPending() {
+	for len(e.pending) > 0 {
+		pending := e.pending
+		e.pending = nil
+		for _, event := range pending {
+			e.process(event)
+		}
+		if len(e.pending) == len(pending) {
+			break
+		}
+	}
+	if len(e.pending) == 0 && !e.needDiff && e.gapTimer != nil {
+		e.gapTimer.Stop()
+		e.gapTimer = nil
+	}
+}
+
+func (e *updatesEngine) onGapTimeout() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.gapTimer = nil
+	if e.inDiff {
+		return //held events will be checked after current difference
+	}
+	if len(e.pending) > 0 || e.needDiff {
+		e.log.Debug("updates: gap was not filled, getting difference")
+		e.getDifferenceLogged()
+		e.scheduleStateSave()
+	}
+}
+
+func (e *updatesEngine) getDifferenceLogged() {
+	if err := e.getDifference(); err != nil {
+		e.log.Error(err, "failed to get updates difference")
+	}
+}
+
+// getDifference fetches updates missed since local state and delivers them in order.
+// On failure it is retried later. State is unlocked during requests, events that come meanwhile
+// are held until difference is applied. If another difference is requested meanwhile,
+// it is fetched right after the current one.
+func (e *updatesEngine) getDifference() error {
+	if e.inDiff {
+		e.diffAgain = true
+		return nil
+	}
+	e.inDiff = true
+	defer func() { e.inDiff = false }()
+	for {
+		e.diffAgain = false
+		if err := e.getDifferenceSteps(); err != nil {
+			e.diffAgain = false
+			e.needDiff = true
+			e.startGapTimer()
+			return merry.Wrap(err)
+		}
+		if !e.diffAgain {
+			break
+		}
+	}
+	e.needDiff = false
+	// held updates covered by difference will be skipped
+	e.applyPending()
+	return nil
+}
+
+func (e *updatesEngine) getDifferenceSteps() error {
+	for {
+		if e.state.Pts == 0 {
+			// state is unknown, there is nothing to get difference from
+			res := e.sendUnlocked(mtproto.TL_updates_getState{})
+			if e.state.Pts != 0 {
+				continue //state was set meanwhile (initState or loadState)
+			}
+			return merry.Wrap(e.setState(res))
+		}
+		done, err := e.getDifferenceStep()
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+func (e *updatesEngine) getDifferenceStep() (bool, error) {
+	req := mtproto.TL_updates_getDifference{Pts: e.state.Pts, Date: e.state.Date, Qts: e.state.Qts}
+	res := e.sendUnlocked(req)
+	if e.state.Pts != req.Pts || e.state.Qts != req.Qts || e.state.Date != req.Date {
+		// state was replaced meanwhile (initState or loadState), response may be outdated
+		e.log.Debug("updates: state changed during getDifference, retrying")
+		return false, nil
+	}
+	switch diff := res.(type) {
+	case mtproto.TL_updates_differenceEmpty:
+		e.state.Date = diff.Date
+		e.state.Seq = diff.Seq
+		return true, nil
+	case mtproto.TL_updates_difference:
+		e.applyDifference(diff.Users, diff.Chats, diff.NewMessages, diff.NewEncryptedMessages, diff.OtherUpdates)
+		return true, merry.Wrap(e.setState(diff.State))
+	case mtproto.TL_updates_differenceSlice:
+		e.applyDifference(diff.Users, diff.Chats, diff.NewMessages, diff.NewEncryptedMessages, diff.OtherUpdates)
+		return false, merry.Wrap(e.setState(diff.IntermediateState))
+	case mtproto.TL_updates_differenceTooLong:
+		// too many updates, some of them are lost, continuing from given pts
+		e.log.Warn("updates: difference too long, pts %d -> %d", e.state.Pts, diff.Pts)
+		e.state.Pts = diff.Pts
+		return false, nil
+	default:
+		return false, mtproto.WrongRespError(res)
+	}
+}
+
+// sendUnlocked sends request with state unlocked, so incoming events are not blocked by it
+func (e *updatesEngine) sendUnlocked(req mtproto.TLReq) mtproto.TL {
+	e.mutex.Unlock()
+	defer e.mutex.Lock()
+	return e.send(req)
+}
+
+// applyDifference delivers new messages as updateNewMessage/updateNewEncryptedMessage (with zero pts/qts)
+// followed by other updates (channel ones are checked with channel pts, like updateChannelTooLong)
+func (e *updatesEngine) applyDifference(users, chats, newMessages, newEncryptedMessages, otherUpdates []mtproto.TL) {
+	e.remember(users)
+	e.remember(chats)
+	for _, msg := range newMessages {
+		e.deliver(mtproto.TL_updateNewMessage{Message: msg}, users, chats)
+	}
+	for _, msg := range newEncryptedMessages {
+		e.deliver(mtproto.TL_updateNewEncryptedMessage{Message: msg}, users, chats)
+	}
+	for _, u := range otherUpdates {
+		if channelID, isChannel := updateChannelID(u); isChannel {
+			e.processChannelUpdate(channelID, u, users, chats)
+		} else {
+			e.deliver(u, users, chats)
+		}
+	}
+}
+
+// updateChannelID returns channel ID for channel-specific updates (with channel pts).
+// Updates with common qts (like updateChannelParticipant) are not channel-specific even if they have channel ID.
+func updateChannelID(update mtproto.TL) (int32, bool) {
+	switch u := update.(type) {
+	case mtproto.TL_updateNewChannelMessage:
+		return messageChannelID(u.Message)
+	case mtproto.TL_updateEditChannelMessage:
+		return messageChannelID(u.Message)
+	case mtproto.TLWithQts:
+		return 0, false
+	case mtproto.TLWithChannelID:
+		return u.GetChannelID(), true
+	}
+	return 0, false
+}
+
+func messageChannelID(message mtproto.TL) (int32, bool) {
+	if msg, ok := message.(mtproto.TLWithPeer); ok {
+		if peer, ok := msg.GetPeer().(mtproto.TL_peerChannel); ok {
+			return peer.ChannelID, true
+		}
+	}
+	return 0, false
+}
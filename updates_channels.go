@@ -0,0 +1,300 @@
+package tgclient
+
+import (
+	"sort"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+// Channels (and supergroups) have their own pts. Gaps are filled with updates.getChannelDifference,
+// same request is used to poll channels that have not received updates for a while.
+// https://core.telegram.org/api/updates#subscribing-to-updates-of-channelssupergroups
+
+const (
+	channelDifferenceLimit       = 100 //max for users (bots may request up to 100000)
+	channelDifferenceParallelism = 4   //max channels polled at once
+	channelIdleTimeout           = 15 * time.Minute
+	channelRetryInterval         = 30 * time.Second
+	channelsPollInterval         = 5 * time.Second
+)
+
+type channelUpdates struct {
+	id       int32
+	pts      int32        //zero if unknown
+	pending  []mtproto.TL //updates (see wrapUpdate) that came after a gap or while fetching difference
+	gapTimer *time.Timer
+	fetching bool      //getChannelDifference is in progress
+	nextPoll time.Time //channel will be polled with getChannelDifference if there are no updates until this moment
+	retryAt  time.Time //previous getChannelDifference has failed, next one should not be sent before this moment
+}
+
+// channel returns channel state creating it if necessary. Channels mutex must be locked.
+func (e *updatesEngine) channel(channelID int32) *channelUpdates {
+	ch, ok := e.channels[channelID]
+	if !ok {
+		ch = &channelUpdates{id: channelID, nextPoll: time.Now().Add(channelIdleTimeout)}
+		e.channels[channelID] = ch
+	}
+	return ch
+}
+
+// ChannelPts returns local pts of channel (zero if unknown)
+func (e *updatesEngine) ChannelPts(channelID int32) int32 {
+	e.channelsMutex.Lock()
+	defer e.channelsMutex.Unlock()
+	if ch, ok := e.channels[channelID]; ok {
+		return ch.pts
+	}
+	return 0
+}
+
+// processChannelUpdate checks channel pts of update
+func (e *updatesEngine) processChannelUpdate(channelID int32, update mtproto.TL, users, chats []mtproto.TL) {
+	e.channelsMutex.Lock()
+	defer e.channelsMutex.Unlock()
+	ch := e.channel(channelID)
+	e.processChannelUpdateUnlocked(ch, update, users, chats)
+	e.applyChannelPending(ch)
+}
+
+func (e *updatesEngine) processChannelUpdateUnlocked(ch *channelUpdates, update mtproto.TL, users, chats []mtproto.TL) {
+	if u, ok := update.(mtproto.TL_updateChannelTooLong); ok {
+		if ch.pts == 0 {
+			ch.pts = u.Pts
+		}
+		if ch.pts == 0 {
+			e.log.Warn("updates: channel %d is too long, but its pts is unknown", ch.id)
+			return
+		}
+		e.startChannelDifference(ch)
+		return
+	}
+	if u, ok := update.(mtproto.TLWithPts); ok {
+		var count int32
+		if uc, ok := update.(mtproto.TLWithPtsCount); ok {
+			count = uc.GetPtsCount()
+		}
+		if ch.fetching {
+			ch.pending = append(ch.pending, wrapUpdate(update, 0, users, chats))
+			return
+		}
+		switch checkUpdateOrder(ch.pts, u.GetPts(), count) {
+		case updateSkip:
+			e.log.Debug("updates: skipping channel %d pts %d+%d (local %d)", ch.id, u.GetPts(), count, ch.pts)
+			return
+		case updateGap:
+			e.log.Debug("updates: channel %d pts gap: %d -> %d-%d", ch.id, ch.pts, u.GetPts(), count)
+			ch.pending = append(ch.pending, wrapUpdate(update, 0, users, chats))
+			if ch.gapTimer == nil {
+				ch.gapTimer = time.AfterFunc(e.gapTimeout, func() { e.onChannelGapTimeout(ch) })
+			}
+			return
+		}
+		ch.pts = u.GetPts()
+	}
+	ch.nextPoll = time.Now().Add(channelIdleTimeout)
+	e.deliver(update, users, chats)
+}
+
+// applyChannelPending retries held channel updates until none of them can be applied
+func (e *updatesEngine) applyChannelPending(ch *channelUpdates) {
+	for len(ch.pending) > 0 && !ch.fetching {
+		pending := ch.pending
+		ch.pending = nil
+		for _, wrapped := range pending {
+			update, users, chats := unwrapUpdate(wrapped)
+			e.processChannelUpdateUnlocked(ch, update, users, chats)
+		}
+		if len(ch.pending) == len(pending) {
+			break
+		}
+	}
+	if len(ch.pending) == 0 && ch.gapTimer != nil {
+		ch.gapTimer.Stop()
+		ch.gapTimer = nil
+	}
+}
+
+func (e *updatesEngine) onChannelGapTimeout(ch *channelUpdates) {
+	e.channelsMutex.Lock()
+	defer e.channelsMutex.Unlock()
+	ch.gapTimer = nil
+	if wait := time.Until(ch.retryAt); wait > 0 {
+		ch.gapTimer = time.AfterFunc(wait, func() { e.onChannelGapTimeout(ch) })
+		return
+	}
+	if len(ch.pending) > 0 {
+		e.log.Debug("updates: channel %d gap was not filled, getting difference", ch.id)
+		e.startChannelDifference(ch)
+	}
+}
+
+// channelsPollRoutine periodically gets difference for channels that have been idle for a long time
+// until stopPolling is called
+func (e *updatesEngine) channelsPollRoutine() {
+	ticker := time.NewTicker(channelsPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			e.pollIdleChannels(now)
+		case <-e.stopPoll:
+			return
+		}
+	}
+}
+
+// stopPolling stops channelsPollRoutine, it must be called only once
+func (e *updatesEngine) stopPolling() {
+	close(e.stopPoll)
+}
+
+func (e *updatesEngine) pollIdleChannels(now time.Time) {
+	e.channelsMutex.Lock()
+	defer e.channelsMutex.Unlock()
+	for _, ch := range e.channels {
+		if ch.pts != 0 && !ch.fetching && now.After(ch.nextPoll) {
+			e.startChannelDifference(ch)
+		}
+	}
+}
+
+// startChannelDifference starts fetching channel difference in background.
+// Channel updates received meanwhile are held and applied afterwards.
+func (e *updatesEngine) startChannelDifference(ch *channelUpdates) {
+	if ch.fetching {
+		return
+	}
+	ch.fetching = true
+	go e.getChannelDifference(ch)
+}
+
+func (e *updatesEngine) getChannelDifference(ch *channelUpdates) {
+	e.channelDiffSem <- struct{}{}
+	err := e.getChannelDifferenceSteps(ch)
+	<-e.channelDiffSem
+
+	e.channelsMutex.Lock()
+	defer e.channelsMutex.Unlock()
+	ch.fetching = false
+	if err != nil {
+		e.log.Error(err, "failed to get channel %d difference", ch.id)
+		switch {
+		case merry.Is(err, errChannelUnavailable):
+			// channel will not be polled anymore
+			e.flushChannelPending(ch)
+			ch.pts = 0
+			return
+		case merry.Is(err, errNoChannelAccessHash):
+			// access hash may become known later (with some update)
+			e.flushChannelPending(ch)
+		}
+		ch.retryAt = time.Now().Add(channelRetryInterval)
+		ch.nextPoll = ch.retryAt
+	}
+	e.applyChannelPending(ch)
+	e.scheduleStateSave()
+}
+
+// flushChannelPending delivers held updates as is (when missing updates can not be fetched)
+func (e *updatesEngine) flushChannelPending(ch *channelUpdates) {
+	if len(ch.pending) == 0 {
+		return
+	}
+	pending := ch.pending
+	ch.pending = nil
+	ch.pts = 0
+	for _, wrapped := range pending {
+		update, users, chats := unwrapUpdate(wrapped)
+		e.processChannelUpdateUnlocked(ch, update, users, chats)
+	}
+}
+
+var errChannelUnavailable = merry.New("channel is unavailable")
+var errNoChannelAccessHash = merry.New("channel access hash is unknown")
+
+func (e *updatesEngine) getChannelDifferenceSteps(ch *channelUpdates) error {
+	accessHash, ok := e.channelAccessHash(ch.id)
+	if !ok {
+		return errNoChannelAccessHash.Here()
+	}
+	for {
+		if e.waitDeliveryRoom != nil {
+			e.waitDeliveryRoom()
+		}
+		e.channelsMutex.Lock()
+		pts := ch.pts
+		e.channelsMutex.Unlock()
+
+		res := e.send(mtproto.TL_updates_getChannelDifference{
+			Channel: mtproto.TL_inputChannel{ChannelID: ch.id, AccessHash: accessHash},
+			Filter:  mtproto.TL_channelMessagesFilterEmpty{},
+			Pts:     pts,
+			Limit:   e.channelDiffLimit,
+		})
+		if mtproto.IsError(res, "CHANNEL_PRIVATE") || mtproto.IsError(res, "CHANNEL_INVALID") {
+			return errChannelUnavailable.Here().WithMessage(mtproto.WrongRespError(res).Error())
+		}
+
+		e.channelsMutex.Lock()
+		final, err := e.applyChannelDifference(ch, res)
+		e.channelsMutex.Unlock()
+		if err != nil || final {
+			return merry.Wrap(err)
+		}
+	}
+}
+
+// applyChannelDifference delivers new messages as updateNewChannelMessage (with zero pts)
+// followed by other updates. Returns true if there are no more updates to fetch.
+func (e *updatesEngine) applyChannelDifference(ch *channelUpdates, res mtproto.TL) (bool, error) {
+	var final bool
+	var timeout int32
+	switch diff := res.(type) {
+	case mtproto.TL_updates_channelDifferenceEmpty:
+		ch.pts = diff.Pts
+		final, timeout = diff.Final, diff.Timeout
+	case mtproto.TL_updates_channelDifference:
+		e.remember(diff.Users)
+		e.remember(diff.Chats)
+		for _, msg := range diff.NewMessages {
+			e.deliver(mtproto.TL_updateNewChannelMessage{Message: msg}, diff.Users, diff.Chats)
+		}
+		for _, u := range diff.OtherUpdates {
+			e.deliver(u, diff.Users, diff.Chats)
+		}
+		ch.pts = diff.Pts
+		final, timeout = diff.Final, diff.Timeout
+	case mtproto.TL_updates_channelDifferenceTooLong:
+		// too many updates, only latest messages are returned, continuing from dialog pts
+		e.remember(diff.Users)
+		e.remember(diff.Chats)
+		messages := append([]mtproto.TL(nil), diff.Messages...)
+		sort.SliceStable(messages, func(i, j int) bool { return messageID(messages[i]) < messageID(messages[j]) })
+		for _, msg := range messages {
+			e.deliver(mtproto.TL_updateNewChannelMessage{Message: msg}, diff.Users, diff.Chats)
+		}
+		if dialog, ok := diff.Dialog.(mtproto.TLWithPts); ok {
+			e.log.Warn("updates: channel %d difference too long, pts %d -> %d", ch.id, ch.pts, dialog.GetPts())
+			ch.pts = dialog.GetPts()
+		}
+		final, timeout = diff.Final, diff.Timeout
+	default:
+		return false, mtproto.WrongRespError(res)
+	}
+	if timeout > 0 {
+		ch.nextPoll = time.Now().Add(time.Duration(timeout) * time.Second)
+	} else {
+		ch.nextPoll = time.Now().Add(channelIdleTimeout)
+	}
+	return final, nil
+}
+
+func messageID(msg mtproto.TL) int32 {
+	if m, ok := msg.(mtproto.TLWithID); ok {
+		return m.GetID()
+	}
+	return 0
+}
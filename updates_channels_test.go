@@ -0,0 +1,223 @@
+package tgclient
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+func newChannelMessage(channelID, id int32) mtproto.TL_message {
+	return mtproto.TL_message{ID: id, PeerID: mtproto.TL_peerChannel{ChannelID: channelID}}
+}
+
+func newChannelMessageUpdate(channelID, pts, ptsCount int32) mtproto.TL_updateShort {
+	return mtproto.TL_updateShort{Update: mtproto.TL_updateNewChannelMessage{
+		Message: newChannelMessage(channelID, pts), Pts: pts, PtsCount: ptsCount}}
+}
+
+func waitChannelIdle(e *updatesEngine, channelID int32) {
+	for i := 0; i < 100; i++ {
+		e.channelsMutex.Lock()
+		ch := e.channels[channelID]
+		busy := ch != nil && ch.fetching
+		e.channelsMutex.Unlock()
+		if !busy {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestChannelUpdatesSeparatePts(t *testing.T) {
+	env := &testUpdatesEnv{}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10})
+
+	e.handleEvent(newChannelMessageUpdate(1, 100, 1)) //unknown channel pts, applied
+	e.handleEvent(newChannelMessageUpdate(2, 200, 1))
+	e.handleEvent(newChannelMessageUpdate(1, 102, 1)) //gap, held
+	e.handleEvent(newMessageUpdate(11, 1))
+	e.handleEvent(newChannelMessageUpdate(1, 101, 1))
+	e.handleEvent(newChannelMessageUpdate(1, 101, 1)) //duplicate
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{100, 200, 11, 101, 102}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+	if s := e.State(); s.Pts != 11 {
+		t.Errorf("wrong common pts: %d", s.Pts)
+	}
+	if pts := e.ChannelPts(1); pts != 102 {
+		t.Errorf("wrong channel pts: %d", pts)
+	}
+	if len(env.requests) != 0 {
+		t.Errorf("unexpected requests: %#v", env.requests)
+	}
+}
+
+func TestChannelUpdatesGapDifference(t *testing.T) {
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_updates_channelDifference{
+			Pts:          102,
+			NewMessages:  []mtproto.TL{newChannelMessage(1, 101)},
+			OtherUpdates: []mtproto.TL{mtproto.TL_updateDeleteChannelMessages{ChannelID: 1, Pts: 102, PtsCount: 1}},
+		},
+		mtproto.TL_updates_channelDifference{
+			Final:       true,
+			Pts:         103,
+			NewMessages: []mtproto.TL{newChannelMessage(1, 103)},
+		},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10})
+
+	e.handleEvent(newChannelMessageUpdate(1, 100, 1))
+	e.handleEvent(newChannelMessageUpdate(1, 103, 1)) //covered by difference
+	e.handleEvent(newChannelMessageUpdate(1, 105, 1))
+	e.onChannelGapTimeout(e.channels[1])
+	e.handleEvent(newChannelMessageUpdate(1, 104, 1)) //held while fetching or applied after
+	waitChannelIdle(e, 1)
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{100, -101, 102, -103, 104, 105}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+	expectedReqs := []mtproto.TLReq{
+		mtproto.TL_updates_getChannelDifference{Channel: mtproto.TL_inputChannel{ChannelID: 1, AccessHash: 1000},
+			Filter: mtproto.TL_channelMessagesFilterEmpty{}, Pts: 100, Limit: channelDifferenceLimit},
+		mtproto.TL_updates_getChannelDifference{Channel: mtproto.TL_inputChannel{ChannelID: 1, AccessHash: 1000},
+			Filter: mtproto.TL_channelMessagesFilterEmpty{}, Pts: 102, Limit: channelDifferenceLimit},
+	}
+	if !reflect.DeepEqual(env.requests, expectedReqs) {
+		t.Errorf("wrong requests: %#v", env.requests)
+	}
+	if pts := e.ChannelPts(1); pts != 105 {
+		t.Errorf("wrong channel pts: %d", pts)
+	}
+}
+
+func TestChannelTooLong(t *testing.T) {
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_updates_difference{
+			OtherUpdates: []mtproto.TL{mtproto.TL_updateChannelTooLong{ChannelID: 1, Pts: 100}},
+			State:        mtproto.TL_updates_state{Pts: 11},
+		},
+		mtproto.TL_updates_channelDifferenceTooLong{
+			Final:    true,
+			Dialog:   mtproto.TL_dialog{Pts: 500},
+			Messages: []mtproto.TL{newChannelMessage(1, 499), newChannelMessage(1, 498)},
+		},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10})
+
+	e.handleEvent(mtproto.TL_updatesTooLong{})
+	waitChannelIdle(e, 1)
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{-498, -499}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+	if pts := e.ChannelPts(1); pts != 500 {
+		t.Errorf("wrong channel pts: %d", pts)
+	}
+	if s := e.State(); s.Pts != 11 {
+		t.Errorf("wrong common pts: %d", s.Pts)
+	}
+}
+
+func TestChannelUnavailable(t *testing.T) {
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_rpc_error{ErrorCode: 400, ErrorMessage: "CHANNEL_PRIVATE"},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10})
+
+	e.handleEvent(newChannelMessageUpdate(1, 100, 1))
+	e.handleEvent(newChannelMessageUpdate(1, 105, 1))
+	e.onChannelGapTimeout(e.channels[1])
+	waitChannelIdle(e, 1)
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{100, 105}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+}
+
+func TestChannelsPollParallelism(t *testing.T) {
+	var mutex sync.Mutex
+	var active, maxActive, total int
+	send := func(msg mtproto.TLReq) mtproto.TL {
+		mutex.Lock()
+		active++
+		total++
+		if active > maxActive {
+			maxActive = active
+		}
+		mutex.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mutex.Lock()
+		active--
+		mutex.Unlock()
+		req := msg.(mtproto.TL_updates_getChannelDifference)
+		return mtproto.TL_updates_channelDifferenceEmpty{Final: true, Pts: req.Pts, Timeout: 30}
+	}
+	env := &testUpdatesEnv{}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10})
+	e.send = send
+
+	const channelsCount = 20
+	for i := int32(1); i <= channelsCount; i++ {
+		e.handleEvent(newChannelMessageUpdate(i, 100, 1))
+	}
+	e.pollIdleChannels(time.Now()) //not idle yet
+	e.pollIdleChannels(time.Now().Add(channelIdleTimeout + time.Second))
+	for i := int32(1); i <= channelsCount; i++ {
+		waitChannelIdle(e, i)
+	}
+	e.pollIdleChannels(time.Now().Add(10 * time.Second)) //server timeout has not passed yet
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if total != channelsCount {
+		t.Errorf("expected %d requests, got %d", channelsCount, total)
+	}
+	if maxActive > channelDifferenceParallelism {
+		t.Errorf("expected at most %d parallel requests, got %d", channelDifferenceParallelism, maxActive)
+	}
+}
+
+func TestChannelParticipantCommonQts(t *testing.T) {
+	env := &testUpdatesEnv{}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10, Qts: 5})
+
+	e.handleEvent(mtproto.TL_updateShort{Update: mtproto.TL_updateChannelParticipant{ChannelID: 1, UserID: 2, Qts: 7}}) //gap, held
+	e.handleEvent(mtproto.TL_updateShort{Update: mtproto.TL_updateBotStopped{UserID: 3, Qts: 6}})
+	e.handleEvent(mtproto.TL_updateShort{Update: mtproto.TL_updateChannelParticipant{ChannelID: 1, UserID: 2, Qts: 7}}) //duplicate
+
+	expected := []mtproto.TL{
+		mtproto.TL_updateBotStopped{UserID: 3, Qts: 6},
+		mtproto.TL_updateChannelParticipant{ChannelID: 1, UserID: 2, Qts: 7},
+	}
+	if !reflect.DeepEqual(env.delivered, expected) {
+		t.Errorf("wrong delivered updates: %#v", env.delivered)
+	}
+	if s := e.State(); s.Qts != 7 {
+		t.Errorf("wrong qts: %d", s.Qts)
+	}
+	if _, ok := e.channels[1]; ok {
+		t.Error("channel state should not be created for qts update")
+	}
+}
+
+func TestChannelsPollStop(t *testing.T) {
+	env := &testUpdatesEnv{}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10})
+
+	done := make(chan struct{})
+	go func() {
+		e.channelsPollRoutine()
+		close(done)
+	}()
+	e.stopPolling()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("poll routine was not stopped")
+	}
+}
@@ -0,0 +1,183 @@
+package tgclient
+
+import (
+	"sync"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+// Updates are passed to handler by several workers. Updates of same peer (user, chat or channel)
+// are handled one by one in receive order, updates of different peers may be handled in parallel.
+// Updates without peer (like updateUserStatus or updateDeleteMessages) are handled in order with each other.
+
+const (
+	updateWorkersCount = 4
+	updatesQueueLimit  = 1024
+)
+
+type updatePeerKind uint8
+
+const (
+	updatePeerNone updatePeerKind = iota
+	updatePeerUser
+	updatePeerChat
+	updatePeerChannel
+)
+
+type updatePeerKey struct {
+	kind updatePeerKind
+	id   int32
+}
+
+type peerUpdates struct {
+	key   updatePeerKey
+	queue []*UpdateContext
+}
+
+type updatesDispatcher struct {
+	mutex     *sync.Mutex
+	readyCond *sync.Cond                     //signaled when some peer becomes ready
+	roomCond  *sync.Cond                     //signaled when queued updates count decreases
+	peers     map[updatePeerKey]*peerUpdates //peers with queued updates or ones being handled
+	ready     []*peerUpdates                 //peers with queued updates that are not handled by any worker
+	queued    int
+	limit     int
+	workers   int
+	started   bool
+	handler   func(*UpdateContext)
+}
+
+func newUpdatesDispatcher(workers, limit int) *updatesDispatcher {
+	mutex := &sync.Mutex{}
+	return &updatesDispatcher{
+		mutex:     mutex,
+		readyCond: sync.NewCond(mutex),
+		roomCond:  sync.NewCond(mutex),
+		peers:     make(map[updatePeerKey]*peerUpdates),
+		limit:     limit,
+		workers:   workers,
+	}
+}
+
+func (d *updatesDispatcher) setHandler(handler func(*UpdateContext)) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.handler = handler
+}
+
+// setLimits changes workers count and queue limit. Workers count can not be changed after first dispatched update.
+func (d *updatesDispatcher) setLimits(workers, limit int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if !d.started {
+		d.workers = workers
+	}
+	d.limit = limit
+	d.roomCond.Broadcast()
+}
+
+// dispatch queues update for handling. It never blocks (it is called while updates state is locked),
+// queue size is limited with waitForRoom.
+func (d *updatesDispatcher) dispatch(update mtproto.TL, users, chats []mtproto.TL) {
+	key := updatePeer(update)
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if !d.started {
+		d.started = true
+		for i := 0; i < d.workers; i++ {
+			go d.workerRoutine()
+		}
+	}
+	peer, ok := d.peers[key]
+	if !ok {
+		peer = &peerUpdates{key: key}
+		d.peers[key] = peer
+		d.ready = append(d.ready, peer)
+		d.readyCond.Signal()
+	}
+	peer.queue = append(peer.queue, &UpdateContext{Update: update, Users: users, Chats: chats})
+	d.queued++
+}
+
+// waitForRoom blocks while too many updates are queued.
+// Must be called before fetching/processing new updates without holding any locks.
+func (d *updatesDispatcher) waitForRoom() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for d.queued >= d.limit {
+		d.roomCond.Wait()
+	}
+}
+
+func (d *updatesDispatcher) workerRoutine() {
+	for {
+		d.mutex.Lock()
+		for len(d.ready) == 0 {
+			d.readyCond.Wait()
+		}
+		peer := d.ready[0]
+		d.ready[0] = nil
+		d.ready = d.ready[1:]
+		ctx := peer.queue[0]
+		peer.queue[0] = nil
+		peer.queue = peer.queue[1:]
+		handler := d.handler
+		d.mutex.Unlock()
+
+		if handler != nil {
+			handler(ctx)
+		}
+
+		d.mutex.Lock()
+		d.queued--
+		d.roomCond.Broadcast()
+		if len(peer.queue) == 0 {
+			delete(d.peers, peer.key)
+		} else {
+			d.ready = append(d.ready, peer)
+			d.readyCond.Signal()
+		}
+		d.mutex.Unlock()
+	}
+}
+
+func updatePeer(update mtproto.TL) updatePeerKey {
+	switch u := update.(type) {
+	case mtproto.TL_updateShortMessage:
+		return updatePeerKey{updatePeerUser, u.UserID}
+	case mtproto.TL_updateShortChatMessage:
+		return updatePeerKey{updatePeerChat, u.ChatID}
+	case mtproto.TL_updateNewMessage:
+		return messagePeer(u.Message)
+	case mtproto.TL_updateEditMessage:
+		return messagePeer(u.Message)
+	case mtproto.TL_updateNewChannelMessage:
+		return messagePeer(u.Message)
+	case mtproto.TL_updateEditChannelMessage:
+		return messagePeer(u.Message)
+	case mtproto.TLWithChannelID:
+		return updatePeerKey{updatePeerChannel, u.GetChannelID()}
+	case mtproto.TLWithPeer:
+		return peerKey(u.GetPeer())
+	}
+	return updatePeerKey{}
+}
+
+func messagePeer(message mtproto.TL) updatePeerKey {
+	if msg, ok := message.(mtproto.TLWithPeer); ok {
+		return peerKey(msg.GetPeer())
+	}
+	return updatePeerKey{}
+}
+
+func peerKey(peer mtproto.TL) updatePeerKey {
+	switch p := peer.(type) {
+	case mtproto.TL_peerUser:
+		return updatePeerKey{updatePeerUser, p.UserID}
+	case mtproto.TL_peerChat:
+		return updatePeerKey{updatePeerChat, p.ChatID}
+	case mtproto.TL_peerChannel:
+		return updatePeerKey{updatePeerChannel, p.ChannelID}
+	}
+	return updatePeerKey{}
+}
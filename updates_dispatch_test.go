@@ -0,0 +1,164 @@
+package tgclient
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+func newPeerMessageUpdate(peer mtproto.TL, id int32) mtproto.TL {
+	return mtproto.TL_updateNewMessage{Message: mtproto.TL_message{ID: id, PeerID: peer}}
+}
+
+func TestUpdatePeer(t *testing.T) {
+	for _, c := range []struct {
+		update mtproto.TL
+		key    updatePeerKey
+	}{
+		{mtproto.TL_updateShortMessage{UserID: 1}, updatePeerKey{updatePeerUser, 1}},
+		{mtproto.TL_updateShortChatMessage{ChatID: 2, FromID: 1}, updatePeerKey{updatePeerChat, 2}},
+		{newPeerMessageUpdate(mtproto.TL_peerChat{ChatID: 3}, 1), updatePeerKey{updatePeerChat, 3}},
+		{mtproto.TL_updateEditMessage{Message: mtproto.TL_message{PeerID: mtproto.TL_peerUser{UserID: 4}}}, updatePeerKey{updatePeerUser, 4}},
+		{mtproto.TL_updateEditChannelMessage{Message: mtproto.TL_message{PeerID: mtproto.TL_peerChannel{ChannelID: 5}}}, updatePeerKey{updatePeerChannel, 5}},
+		{mtproto.TL_updateDeleteChannelMessages{ChannelID: 6}, updatePeerKey{updatePeerChannel, 6}},
+		{mtproto.TL_updateReadHistoryInbox{Peer: mtproto.TL_peerUser{UserID: 7}}, updatePeerKey{updatePeerUser, 7}},
+		{mtproto.TL_updateUserStatus{UserID: 8}, updatePeerKey{}},
+	} {
+		if key := updatePeer(c.update); key != c.key {
+			t.Errorf("%T: expected %v, got %v", c.update, c.key, key)
+		}
+	}
+}
+
+func TestDispatcherOrderAndParallelism(t *testing.T) {
+	const workers = 3
+	const peersCount = 10
+	const perPeer = 50
+	d := newUpdatesDispatcher(workers, 16)
+
+	var mutex sync.Mutex
+	var active, maxActive int
+	lastIDs := make(map[int32]int32)
+	var wg sync.WaitGroup
+	wg.Add(peersCount * perPeer)
+	d.setHandler(func(ctx *UpdateContext) {
+		msg := ctx.Update.(mtproto.TL_updateNewMessage).Message.(mtproto.TL_message)
+		chatID := msg.PeerID.(mtproto.TL_peerChat).ChatID
+		mutex.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		if lastIDs[chatID]+1 != msg.ID {
+			t.Errorf("chat %d: message %d after %d", chatID, msg.ID, lastIDs[chatID])
+		}
+		lastIDs[chatID] = msg.ID
+		mutex.Unlock()
+		time.Sleep(100 * time.Microsecond)
+		mutex.Lock()
+		active--
+		mutex.Unlock()
+		wg.Done()
+	})
+
+	for id := int32(1); id <= perPeer; id++ {
+		for chatID := int32(1); chatID <= peersCount; chatID++ {
+			d.waitForRoom()
+			d.dispatch(newPeerMessageUpdate(mtproto.TL_peerChat{ChatID: chatID}, id), nil, nil)
+		}
+	}
+	wg.Wait()
+
+	if maxActive > workers {
+		t.Errorf("expected at most %d parallel handlers, got %d", workers, maxActive)
+	}
+	if maxActive < 2 {
+		t.Errorf("updates of different peers were not handled in parallel")
+	}
+}
+
+func TestDispatcherBackpressure(t *testing.T) {
+	d := newUpdatesDispatcher(1, 2)
+	release := make(chan struct{})
+	d.setHandler(func(ctx *UpdateContext) { <-release })
+
+	for i := int32(1); i <= 2; i++ {
+		d.waitForRoom()
+		d.dispatch(newPeerMessageUpdate(mtproto.TL_peerUser{UserID: i}, 1), nil, nil)
+	}
+	waited := make(chan struct{})
+	go func() {
+		d.waitForRoom()
+		close(waited)
+	}()
+
+	select {
+	case <-waited:
+		t.Fatal("waitForRoom should block while queue is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+	release <- struct{}{}
+	select {
+	case <-waited:
+	case <-time.After(time.Second):
+		t.Fatal("waitForRoom should return after update is handled")
+	}
+	close(release)
+}
+
+// Events, channel differences and state reads from different goroutines (should be run with -race).
+func TestUpdatesConcurrentDelivery(t *testing.T) {
+	env := &testUpdatesEnv{}
+	d := newUpdatesDispatcher(4, 8)
+	var deliveredMutex sync.Mutex
+	lastPts := make(map[updatePeerKey]int32)
+	var wg sync.WaitGroup
+	d.setHandler(func(ctx *UpdateContext) {
+		defer wg.Done()
+		u := ctx.Update.(mtproto.TL_updateNewChannelMessage)
+		key := updatePeer(ctx.Update)
+		deliveredMutex.Lock()
+		defer deliveredMutex.Unlock()
+		if u.Pts != 0 && lastPts[key] >= u.Pts {
+			t.Errorf("%v: pts %d after %d", key, u.Pts, lastPts[key])
+		}
+		lastPts[key] = u.Pts
+	})
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10})
+	e.deliver = d.dispatch
+	e.waitDeliveryRoom = d.waitForRoom
+	e.send = func(msg mtproto.TLReq) mtproto.TL {
+		req := msg.(mtproto.TL_updates_getChannelDifference)
+		return mtproto.TL_updates_channelDifferenceEmpty{Final: true, Pts: req.Pts}
+	}
+
+	const channelsCount = 8
+	const perChannel = 100
+	wg.Add(channelsCount * perChannel)
+	var sendersWG sync.WaitGroup
+	for c := int32(1); c <= channelsCount; c++ {
+		sendersWG.Add(1)
+		go func(channelID int32) {
+			defer sendersWG.Done()
+			for pts := int32(1); pts <= perChannel; pts++ {
+				d.waitForRoom()
+				e.handleEvent(newChannelMessageUpdate(channelID, pts, 1))
+				if pts%10 == 0 {
+					e.pollIdleChannels(time.Now().Add(channelIdleTimeout * 2))
+					e.State()
+					e.ChannelPts(channelID)
+				}
+			}
+		}(c)
+	}
+	sendersWG.Wait()
+	wg.Wait()
+	for c := int32(1); c <= channelsCount; c++ {
+		waitChannelIdle(e, c)
+		if pts := e.ChannelPts(c); pts != perChannel {
+			t.Errorf("channel %d: expected pts %d, got %d", c, perChannel, pts)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package tgclient
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+)
+
+type noopLogHandler struct{}
+
+func (h noopLogHandler) Log(mtproto.LogLevel, error, string, ...interface{}) {}
+func (h noopLogHandler) Message(bool, mtproto.TL, int64)                     {}
+
+type testUpdatesEnv struct {
+	mutex     sync.Mutex
+	delivered []mtproto.TL
+	requests  []mtproto.TLReq
+	responses []mtproto.TL
+}
+
+func (env *testUpdatesEnv) send(msg mtproto.TLReq) mtproto.TL {
+	env.mutex.Lock()
+	defer env.mutex.Unlock()
+	env.requests = append(env.requests, msg)
+	if len(env.responses) == 0 {
+		return mtproto.TL_rpc_error{ErrorCode: 400, ErrorMessage: "NO_RESPONSE"}
+	}
+	res := env.responses[0]
+	env.responses = env.responses[1:]
+	return res
+}
+
+func (env *testUpdatesEnv) channelAccessHash(channelID int32) (int64, bool) {
+	return int64(channelID) * 1000, channelID > 0
+}
+
+func (env *testUpdatesEnv) deliver(update mtproto.TL, users, chats []mtproto.TL) {
+	env.mutex.Lock()
+	defer env.mutex.Unlock()
+	env.delivered = append(env.delivered, update)
+}
+
+func (env *testUpdatesEnv) deliveredPts() []int32 {
+	env.mutex.Lock()
+	defer env.mutex.Unlock()
+	var res []int32
+	for _, update := range env.delivered {
+		if u, ok := update.(mtproto.TL_updateNewMessage); ok && u.Pts == 0 {
+			res = append(res, -u.Message.(mtproto.TL_message).ID) //from difference
+		} else if u, ok := update.(mtproto.TL_updateNewChannelMessage); ok && u.Pts == 0 {
+			res = append(res, -u.Message.(mtproto.TL_message).ID) //from channel difference
+		} else if u, ok := update.(mtproto.TLWithPts); ok {
+			res = append(res, u.GetPts())
+		}
+	}
+	return res
+}
+
+func newTestUpdatesEngine(env *testUpdatesEnv, state mtproto.TL_updates_state) *updatesEngine {
+	e := newUpdatesEngine(env.send, func([]mtproto.TL) {}, env.deliver, env.channelAccessHash, mtproto.Logger{Hnd: noopLogHandler{}})
+	e.gapTimeout = time.Hour //gap timeouts are triggered manually
+	e.state = state
+	return e
+}
+
+func newMessageUpdate(pts, ptsCount int32) mtproto.TL_updateShort {
+	return mtproto.TL_updateShort{Update: mtproto.TL_updateNewMessage{Message: mtproto.TL_message{ID: pts}, Pts: pts, PtsCount: ptsCount}}
+}
+
+func TestUpdatesInOrderAndDuplicates(t *testing.T) {
+	env := &testUpdatesEnv{}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10, Seq: 5})
+
+	e.handleEvent(newMessageUpdate(11, 1))
+	e.handleEvent(newMessageUpdate(11, 1)) //duplicate
+	e.handleEvent(mtproto.TL_updates{Updates: []mtproto.TL{
+		mtproto.TL_updateNewMessage{Pts: 12, PtsCount: 1},
+		mtproto.TL_updateDeleteMessages{Pts: 14, PtsCount: 2},
+	}, Seq: 6, Date: 100})
+	e.handleEvent(mtproto.TL_updates{Updates: []mtproto.TL{mtproto.TL_updateNewMessage{Pts: 15, PtsCount: 1}}, Seq: 6}) //old seq
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{11, 12, 14}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+	if s := e.State(); s.Pts != 14 || s.Seq != 6 || s.Date != 100 {
+		t.Errorf("wrong state: %#v", s)
+	}
+	if len(env.requests) != 0 {
+		t.Errorf("unexpected requests: %#v", env.requests)
+	}
+}
+
+func TestUpdatesReorder(t *testing.T) {
+	env := &testUpdatesEnv{}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10, Seq: 5})
+
+	e.handleEvent(newMessageUpdate(13, 1))
+	e.handleEvent(newMessageUpdate(12, 1))
+	e.handleEvent(mtproto.TL_updates{Updates: []mtproto.TL{mtproto.TL_updateUserTyping{}}, Seq: 7})
+	e.handleEvent(newMessageUpdate(11, 1))
+	e.handleEvent(mtproto.TL_updatesCombined{SeqStart: 6, Seq: 6})
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{11, 12, 13}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+	if s := e.State(); s.Pts != 13 || s.Seq != 7 {
+		t.Errorf("wrong state: %#v", s)
+	}
+	if len(env.requests) != 0 {
+		t.Errorf("unexpected requests: %#v", env.requests)
+	}
+}
+
+func TestUpdatesHeldWithUsersAndChats(t *testing.T) {
+	env := &testUpdatesEnv{}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10})
+	var deliveredUsers [][]mtproto.TL
+	e.deliver = func(update mtproto.TL, users, chats []mtproto.TL) {
+		deliveredUsers = append(deliveredUsers, users)
+	}
+	users := []mtproto.TL{mtproto.TL_user{ID: 1}}
+
+	e.handleEvent(mtproto.TL_updates{Updates: []mtproto.TL{mtproto.TL_updateNewMessage{Pts: 12, PtsCount: 1}}, Users: users})
+	e.handleEvent(newMessageUpdate(11, 1))
+
+	if !reflect.DeepEqual(deliveredUsers, [][]mtproto.TL{nil, users}) {
+		t.Errorf("wrong delivered users: %v", deliveredUsers)
+	}
+}
+
+func TestUpdatesGapDifference(t *testing.T) {
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_updates_differenceSlice{
+			NewMessages:       []mtproto.TL{mtproto.TL_message{ID: 11}},
+			IntermediateState: mtproto.TL_updates_state{Pts: 11, Qts: 1, Date: 110, Seq: 5},
+		},
+		mtproto.TL_updates_difference{
+			NewMessages:  []mtproto.TL{mtproto.TL_message{ID: 12}},
+			OtherUpdates: []mtproto.TL{mtproto.TL_updateReadHistoryInbox{Pts: 13, PtsCount: 1}},
+			State:        mtproto.TL_updates_state{Pts: 13, Qts: 1, Date: 120, Seq: 6},
+		},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10, Date: 100, Seq: 5})
+
+	e.handleEvent(newMessageUpdate(13, 1)) //covered by difference
+	e.handleEvent(newMessageUpdate(15, 1)) //still after gap
+	e.onGapTimeout()
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{-11, -12, 13}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+	expectedReqs := []mtproto.TLReq{
+		mtproto.TL_updates_getDifference{Pts: 10, Date: 100},
+		mtproto.TL_updates_getDifference{Pts: 11, Date: 110, Qts: 1},
+	}
+	if !reflect.DeepEqual(env.requests, expectedReqs) {
+		t.Errorf("wrong requests: %#v", env.requests)
+	}
+
+	e.handleEvent(newMessageUpdate(14, 1))
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{-11, -12, 13, 14, 15}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+}
+
+func TestUpdatesTooLong(t *testing.T) {
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_updates_differenceTooLong{Pts: 50},
+		mtproto.TL_updates_differenceEmpty{Date: 130, Seq: 6},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10, Date: 100, Seq: 5})
+
+	e.handleEvent(mtproto.TL_updatesTooLong{})
+	expectedReqs := []mtproto.TLReq{
+		mtproto.TL_updates_getDifference{Pts: 10, Date: 100},
+		mtproto.TL_updates_getDifference{Pts: 50, Date: 100},
+	}
+	if !reflect.DeepEqual(env.requests, expectedReqs) {
+		t.Errorf("wrong requests: %#v", env.requests)
+	}
+	if s := e.State(); s.Pts != 50 || s.Seq != 6 || s.Date != 130 {
+		t.Errorf("wrong state: %#v", s)
+	}
+}
+
+func TestUpdatesDifferenceRetry(t *testing.T) {
+	env := &testUpdatesEnv{}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10, Date: 100})
+
+	e.handleEvent(mtproto.TL_updatesTooLong{}) //fails, no response
+	env.responses = []mtproto.TL{mtproto.TL_updates_differenceEmpty{Date: 130, Seq: 6}}
+	e.onGapTimeout()
+
+	if s := e.State(); s.Seq != 6 || s.Date != 130 {
+		t.Errorf("difference was not retried: %#v", s)
+	}
+	if len(env.requests) != 2 {
+		t.Errorf("wrong requests: %#v", env.requests)
+	}
+}
+
+func TestUpdatesEventsDuringDifference(t *testing.T) {
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_updates_difference{
+			NewMessages: []mtproto.TL{mtproto.TL_message{ID: 11}},
+			State:       mtproto.TL_updates_state{Pts: 11, Date: 110},
+		},
+		mtproto.TL_updates_differenceEmpty{Date: 120},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10, Date: 100})
+	requested := make(chan struct{}, 2)
+	release := make(chan struct{})
+	e.send = func(req mtproto.TLReq) mtproto.TL {
+		requested <- struct{}{}
+		<-release
+		return env.send(req)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		e.handleEvent(mtproto.TL_updatesTooLong{})
+		close(done)
+	}()
+	<-requested
+	// state must not be locked during request
+	e.handleEvent(newMessageUpdate(12, 1))
+	e.handleEvent(mtproto.TL_updatesTooLong{}) //difference will be requested again
+	release <- struct{}{}
+	<-requested
+	release <- struct{}{}
+	<-done
+
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{-11, 12}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+	expectedReqs := []mtproto.TLReq{
+		mtproto.TL_updates_getDifference{Pts: 10, Date: 100},
+		mtproto.TL_updates_getDifference{Pts: 11, Date: 110},
+	}
+	if !reflect.DeepEqual(env.requests, expectedReqs) {
+		t.Errorf("wrong requests: %#v", env.requests)
+	}
+	if s := e.State(); s.Pts != 12 || s.Date != 120 {
+		t.Errorf("wrong state: %#v", s)
+	}
+}
+
+func TestUpdatesGapTimer(t *testing.T) {
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_updates_difference{
+			NewMessages: []mtproto.TL{mtproto.TL_message{ID: 11}},
+			State:       mtproto.TL_updates_state{Pts: 11, Date: 110},
+		},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{Pts: 10, Date: 100})
+	e.gapTimeout = 10 * time.Millisecond
+
+	e.handleEvent(newMessageUpdate(12, 1))
+	for i := 0; i < 100 && len(env.deliveredPts()) < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if pts := env.deliveredPts(); !reflect.DeepEqual(pts, []int32{-11, 12}) {
+		t.Errorf("wrong delivered updates: %v", pts)
+	}
+}
+
+func TestUpdatesRestoreState(t *testing.T) {
+	store := &StateMemStore{}
+	store.Save(&StateInfo{Pts: 10, Qts: 1, Date: 100, Seq: 5, Channels: map[int32]int32{1: 100}})
+
+	env := &testUpdatesEnv{responses: []mtproto.TL{
+		mtproto.TL_updates_difference{
+			NewMessages: []mtproto.TL{mtproto.TL_message{ID: 11}},
+			State:       mtproto.TL_updates_state{Pts: 11, Qts: 1, Date: 110, Seq: 6},
+		},
+		mtproto.TL_updates_channelDifferenceEmpty{Final: true, Pts: 105},
+	}}
+	e := newTestUpdatesEngine(env, mtproto.TL_updates_state{})
+	e.store = store
+
+	if loaded, err := e.loadState(); !loaded || err != nil {
+		t.Fatalf("state was not loaded: %v", err)
+	}
+	if err := e.resume(); err != nil {
+		t.Fatal(err)
+	}
+	e.pollIdleChannels(time.Now().Add(time.Second))
+	waitChannelIdle(e, 1)
+
+	expectedReqs := []mtproto.TLReq{
+		mtproto.TL_updates_getDifference{Pts: 10, Date: 100, Qts: 1},
+		mtproto.TL_updates_getChannelDifference{Channel: mtproto.TL_inputChannel{ChannelID: 1, AccessHash: 1000},
+			Filter: mtproto.TL_channelMessagesFilterEmpty{}, Pts: 100, Limit: channelDifferenceLimit},
+	}
+	if !reflect.DeepEqual(env.requests, expectedReqs) {
+		t.Errorf("wrong requests: %#v", env.requests)
+	}
+
+	e.handleEvent(newMessageUpdate(12, 1))
+	if err := e.saveState(); err != nil {
+		t.Fatal(err)
+	}
+	info := &StateInfo{}
+	store.Load(info)
+	expected := &StateInfo{Pts: 12, Qts: 1, Date: 110, Seq: 6, Channels: map[int32]int32{1: 105}}
+	if !reflect.DeepEqual(info, expected) {
+		t.Errorf("wrong saved state: %#v", info)
+	}
+}
@@ -0,0 +1,301 @@
+package tgclient
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+const (
+	uploadBigFileThreshold = 10 * 1024 * 1024 //files larger than this are uploaded with saveBigFilePart
+	uploadMaxParts         = 4000
+	uploadMinPartSize      = 128 * 1024
+	uploadMaxPartSize      = 512 * 1024
+	uploadMaxFileSize      = uploadMaxPartSize * uploadMaxParts
+	uploadPartsInParallel  = 4
+	// server keeps uploaded parts for a limited time (exact period is not documented),
+	// uploads started earlier than this are restarted from scratch
+	uploadPartsLifetime = time.Hour
+)
+
+var ErrEmptyFile = merry.New("can not upload empty file")
+var ErrFileTooLarge = merry.New("file is too large")
+
+// uploadState is saved to <fpath>.upload while file is uploading, so upload can be continued after restart
+type uploadState struct {
+	FileID    int64 `json:"file_id"`
+	Size      int64 `json:"size"`
+	ModTime   int64 `json:"mod_time"`
+	PartSize  int64 `json:"part_size"`
+	PartsDone int   `json:"parts_done"` //number of uploaded parts at the start of file
+	StartedAt int64 `json:"started_at"` //unix time of first part upload, parts expire after uploadPartsLifetime
+}
+
+type uploadPartResult struct {
+	num int
+	err error
+}
+
+type Uploader struct {
+	tg       *TGClient
+	sendPart func(mtproto.TLReq) (mtproto.TL, error)
+	log      mtproto.Logger
+}
+
+func NewUploader(tg *TGClient) *Uploader {
+	u := &Uploader{
+		tg:  tg,
+		log: tg.log,
+	}
+	u.sendPart = u.sendPartToHomeDC
+	return u
+}
+
+// UploadPartSize returns size of upload part for file of given size: smallest one (doubling from 128 KB up to 512 KB)
+// that fits file into parts count limit. Files larger than 512 KB * 4000 parts can not be uploaded.
+func UploadPartSize(size int64) int64 {
+	partSize := int64(uploadMinPartSize)
+	for partSize < uploadMaxPartSize && size > partSize*uploadMaxParts {
+		partSize *= 2
+	}
+	return partSize
+}
+
+// UploadFileFromPath uploads file and returns mtproto.TL_inputFile (with MD5 checksum)
+// or mtproto.TL_inputFileBig (for files larger than 10 MB) which can be used in inputMediaUploaded*.
+// Upload progress is saved to <fpath>.upload, so interrupted upload is continued
+// (if file was not changed and upload was started less than an hour ago, before server has forgotten uploaded parts).
+func (u *Uploader) UploadFileFromPath(fpath string, progressHnd FileProgressHandler) (mtproto.TL, error) {
+	fd, err := os.Open(fpath)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	defer fd.Close()
+
+	stat, err := fd.Stat()
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	statePath := fpath + ".upload"
+	state := uploadState{
+		Size:     stat.Size(),
+		ModTime:  stat.ModTime().UnixNano(),
+		PartSize: UploadPartSize(stat.Size()),
+	}
+	if prevState, err := loadUploadState(statePath); err != nil {
+		u.log.Warn("failed to load upload state from '%s', starting from scratch: %s", statePath, err)
+	} else if prevState != nil && prevState.Size == state.Size &&
+		prevState.ModTime == state.ModTime && prevState.PartSize == state.PartSize {
+		if age := time.Since(time.Unix(prevState.StartedAt, 0)); age < uploadPartsLifetime {
+			state = *prevState
+			u.log.Info("continuing upload of '%s' from part %d", fpath, state.PartsDone)
+		} else {
+			u.log.Info("upload of '%s' was started %s ago, uploaded parts may be expired, starting from scratch", fpath, age)
+		}
+	}
+
+	onPartsDone := func(partsDone int) {
+		state.PartsDone = partsDone
+		if err := saveUploadState(statePath, &state); err != nil {
+			u.log.Warn("failed to save upload state to '%s': %s", statePath, err)
+		}
+	}
+	inputFile, err := u.uploadFileParts(fd, filepath.Base(fpath), &state, progressHnd, onPartsDone)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+
+	if err := os.Remove(statePath); err != nil && !os.IsNotExist(err) {
+		u.log.Warn("failed to remove upload state '%s': %s", statePath, err)
+	}
+	return inputFile, nil
+}
+
+// UploadFile uploads size bytes of file (without resuming), see UploadFileFromPath
+func (u *Uploader) UploadFile(file io.ReaderAt, name string, size int64, progressHnd FileProgressHandler) (mtproto.TL, error) {
+	state := uploadState{Size: size, PartSize: UploadPartSize(size)}
+	return u.uploadFileParts(file, name, &state, progressHnd, nil)
+}
+
+func (u *Uploader) uploadFileParts(
+	file io.ReaderAt, name string, state *uploadState,
+	progressHnd FileProgressHandler, onPartsDone func(int),
+) (mtproto.TL, error) {
+	if state.Size <= 0 {
+		return nil, ErrEmptyFile.Here()
+	}
+	if state.Size > uploadMaxFileSize {
+		return nil, ErrFileTooLarge.Here().WithMessagef("file is too large: %d bytes (max %d)", state.Size, int64(uploadMaxFileSize))
+	}
+	partsCount := int((state.Size + state.PartSize - 1) / state.PartSize)
+	if partsCount > uploadMaxParts {
+		return nil, ErrFileTooLarge.Here().WithMessagef("file is too large: %d parts of %d bytes (max %d parts)",
+			partsCount, state.PartSize, uploadMaxParts)
+	}
+	if state.FileID == 0 || state.PartsDone > partsCount {
+		state.FileID = randomInt64()
+		state.PartsDone = 0
+		state.StartedAt = time.Now().Unix()
+	}
+	isBig := state.Size > uploadBigFileThreshold
+
+	var md5Hash hash.Hash
+	if !isBig {
+		md5Hash = md5.New()
+	}
+	var inputFile mtproto.TL
+	if isBig {
+		inputFile = mtproto.TL_inputFileBig{ID: state.FileID, Parts: int32(partsCount), Name: name}
+	} else {
+		inputFile = mtproto.TL_inputFile{ID: state.FileID, Parts: int32(partsCount), Name: name}
+	}
+
+	done := make([]bool, partsCount)
+	partsDone := state.PartsDone
+	for i := 0; i < partsDone; i++ {
+		done[i] = true
+	}
+	uploadedCount := partsDone
+
+	resChan := make(chan uploadPartResult, uploadPartsInParallel)
+	inFlight := 0
+	var firstErr error
+	for next := 0; ; {
+		for firstErr == nil && next < partsCount && inFlight < uploadPartsInParallel {
+			if done[next] && md5Hash == nil {
+				next++
+				continue
+			}
+			data, err := readUploadPart(file, next, state.PartSize, state.Size)
+			if err != nil {
+				firstErr = merry.Wrap(err)
+				break
+			}
+			if md5Hash != nil {
+				md5Hash.Write(data)
+			}
+			if !done[next] {
+				req := u.savePartRequest(state.FileID, next, partsCount, isBig, data)
+				go func(num int) {
+					resChan <- uploadPartResult{num: num, err: u.savePart(req)}
+				}(next)
+				inFlight++
+			}
+			next++
+		}
+		if inFlight == 0 {
+			break
+		}
+
+		res := <-resChan
+		inFlight--
+		if res.err != nil {
+			if firstErr == nil {
+				firstErr = res.err
+			}
+			continue
+		}
+		done[res.num] = true
+		uploadedCount++
+		if progressHnd != nil {
+			progressHnd.OnProgress(inputFile, minI64(int64(uploadedCount)*state.PartSize, state.Size), state.Size)
+		}
+		if partsDone < partsCount && done[partsDone] {
+			for partsDone < partsCount && done[partsDone] {
+				partsDone++
+			}
+			if onPartsDone != nil {
+				onPartsDone(partsDone)
+			}
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if f, ok := inputFile.(mtproto.TL_inputFile); ok {
+		f.Md5Checksum = hex.EncodeToString(md5Hash.Sum(nil))
+		inputFile = f
+	}
+	return inputFile, nil
+}
+
+func (u *Uploader) savePartRequest(fileID int64, num, partsCount int, isBig bool, data []byte) mtproto.TLReq {
+	if isBig {
+		return mtproto.TL_upload_saveBigFilePart{
+			FileID: fileID, FilePart: int32(num), FileTotalParts: int32(partsCount), Bytes: data}
+	}
+	return mtproto.TL_upload_saveFilePart{FileID: fileID, FilePart: int32(num), Bytes: data}
+}
+
+func (u *Uploader) savePart(req mtproto.TLReq) error {
+	res, err := u.sendPart(req)
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	if _, ok := res.(mtproto.TL_boolTrue); !ok {
+		return merry.New(mtproto.UnexpectedTL("file part saving", res))
+	}
+	return nil
+}
+
+// sendPartToHomeDC sends part through separate file connection, so uploading does not block other requests
+func (u *Uploader) sendPartToHomeDC(req mtproto.TLReq) (mtproto.TL, error) {
+	mt, err := u.tg.getFileMT(u.tg.mt.CopySession().DcID)
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return mt.SendSyncRetry(req, time.Second, 5, 10*time.Second), nil
+}
+
+func readUploadPart(file io.ReaderAt, num int, partSize, size int64) ([]byte, error) {
+	offset := int64(num) * partSize
+	data := make([]byte, minI64(partSize, size-offset))
+	n, err := file.ReadAt(data, offset)
+	if err == io.EOF && n == len(data) {
+		err = nil
+	}
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return data, nil
+}
+
+func loadUploadState(fpath string) (*uploadState, error) {
+	buf, err := ioutil.ReadFile(fpath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, merry.Wrap(err)
+	}
+	state := &uploadState{}
+	if err := json.Unmarshal(buf, state); err != nil {
+		return nil, merry.Wrap(err)
+	}
+	return state, nil
+}
+
+func saveUploadState(fpath string, state *uploadState) error {
+	buf, err := json.Marshal(state)
+	if err != nil {
+		return merry.Wrap(err)
+	}
+	return merry.Wrap(ioutil.WriteFile(fpath, buf, 0644))
+}
+
+func minI64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,220 @@
+package tgclient
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/3bl3gamer/tgclient/mtproto"
+	"github.com/ansel1/merry"
+)
+
+type testPartsServer struct {
+	mutex    sync.Mutex
+	parts    map[int32][]byte
+	total    int32
+	isBig    bool
+	failFrom int32 //parts starting from this one will fail (if >0)
+	sent     int
+}
+
+func (s *testPartsServer) send(req mtproto.TLReq) (mtproto.TL, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var num int32
+	var data []byte
+	switch r := req.(type) {
+	case mtproto.TL_upload_saveFilePart:
+		num, data = r.FilePart, r.Bytes
+	case mtproto.TL_upload_saveBigFilePart:
+		num, data, s.total, s.isBig = r.FilePart, r.Bytes, r.FileTotalParts, true
+	default:
+		return nil, merry.Errorf("unexpected request %T", req)
+	}
+	s.sent++
+	if s.failFrom > 0 && num >= s.failFrom {
+		return mtproto.TL_rpc_error{ErrorCode: 500, ErrorMessage: "INTERNAL"}, nil
+	}
+	s.parts[num] = data
+	return mtproto.TL_boolTrue{}, nil
+}
+
+func (s *testPartsServer) joined() []byte {
+	var buf []byte
+	for i := int32(0); i < int32(len(s.parts)); i++ {
+		buf = append(buf, s.parts[i]...)
+	}
+	return buf
+}
+
+type testProgress struct{ last, size int64 }
+
+func (p *testProgress) OnProgress(fileLocation mtproto.TL, offset, size int64) {
+	p.last, p.size = offset, size
+}
+
+func newTestUploader(server *testPartsServer) *Uploader {
+	u := NewUploader(newTestClient())
+	u.sendPart = server.send
+	return u
+}
+
+func testFileData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 7 % 251)
+	}
+	return data
+}
+
+func TestUploadSmallFile(t *testing.T) {
+	data := testFileData(300 * 1024)
+	server := &testPartsServer{parts: make(map[int32][]byte)}
+	progress := &testProgress{}
+	res, err := newTestUploader(server).UploadFile(bytes.NewReader(data), "a.txt", int64(len(data)), progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, ok := res.(mtproto.TL_inputFile)
+	if !ok {
+		t.Fatalf("expected inputFile, got %#v", res)
+	}
+	hash := md5.Sum(data)
+	if file.Parts != 3 || file.Name != "a.txt" || file.Md5Checksum != hex.EncodeToString(hash[:]) {
+		t.Errorf("wrong input file %#v", file)
+	}
+	if server.isBig || !bytes.Equal(server.joined(), data) {
+		t.Errorf("wrong uploaded data")
+	}
+	if progress.last != int64(len(data)) || progress.size != int64(len(data)) {
+		t.Errorf("wrong progress %#v", progress)
+	}
+
+	if _, err := newTestUploader(server).UploadFile(bytes.NewReader(nil), "a.txt", 0, nil); !merry.Is(err, ErrEmptyFile) {
+		t.Errorf("expected ErrEmptyFile, got %v", err)
+	}
+}
+
+func TestUploadBigFile(t *testing.T) {
+	data := testFileData(uploadBigFileThreshold + 1)
+	server := &testPartsServer{parts: make(map[int32][]byte)}
+	res, err := newTestUploader(server).UploadFile(bytes.NewReader(data), "big.bin", int64(len(data)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, ok := res.(mtproto.TL_inputFileBig)
+	if !ok {
+		t.Fatalf("expected inputFileBig, got %#v", res)
+	}
+	if file.Parts != 81 || server.total != 81 || !server.isBig || !bytes.Equal(server.joined(), data) {
+		t.Errorf("wrong upload: %d parts, %d total", file.Parts, server.total)
+	}
+}
+
+func TestUploadFileResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tgclient")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fpath := filepath.Join(dir, "photo.jpg")
+	data := testFileData(1000 * 1024)
+	if err := ioutil.WriteFile(fpath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	server := &testPartsServer{parts: make(map[int32][]byte), failFrom: 5}
+	if _, err := newTestUploader(server).UploadFileFromPath(fpath, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	state, err := loadUploadState(fpath + ".upload")
+	if err != nil || state == nil || state.PartsDone != 5 {
+		t.Fatalf("wrong saved state %#v (%v)", state, err)
+	}
+
+	server.failFrom = 0
+	server.sent = 0
+	res, err := newTestUploader(server).UploadFileFromPath(fpath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := md5.Sum(data)
+	file := res.(mtproto.TL_inputFile)
+	if file.ID != state.FileID || file.Parts != 8 || file.Md5Checksum != hex.EncodeToString(hash[:]) {
+		t.Errorf("wrong input file %#v", file)
+	}
+	if server.sent != 3 || !bytes.Equal(server.joined(), data) {
+		t.Errorf("wrong resumed upload: %d parts sent", server.sent)
+	}
+	if _, err := os.Stat(fpath + ".upload"); !os.IsNotExist(err) {
+		t.Errorf("upload state should be removed, got %v", err)
+	}
+}
+
+func TestUploadFileResumeExpired(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tgclient")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fpath := filepath.Join(dir, "photo.jpg")
+	data := testFileData(1000 * 1024)
+	if err := ioutil.WriteFile(fpath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	server := &testPartsServer{parts: make(map[int32][]byte), failFrom: 5}
+	if _, err := newTestUploader(server).UploadFileFromPath(fpath, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	// server may have already dropped parts of this upload
+	state, err := loadUploadState(fpath + ".upload")
+	if err != nil || state == nil {
+		t.Fatalf("wrong saved state %#v (%v)", state, err)
+	}
+	state.StartedAt = time.Now().Add(-uploadPartsLifetime).Unix()
+	if err := saveUploadState(fpath+".upload", state); err != nil {
+		t.Fatal(err)
+	}
+
+	server = &testPartsServer{parts: make(map[int32][]byte)}
+	res, err := newTestUploader(server).UploadFileFromPath(fpath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file := res.(mtproto.TL_inputFile); file.ID == state.FileID {
+		t.Errorf("expired upload should be started with new file ID")
+	}
+	if server.sent != 8 || !bytes.Equal(server.joined(), data) {
+		t.Errorf("wrong restarted upload: %d parts sent", server.sent)
+	}
+}
+
+func TestUploadPartSize(t *testing.T) {
+	cases := []struct {
+		size, partSize int64
+	}{
+		{1, 128 * 1024},
+		{128 * 1024 * uploadMaxParts, 128 * 1024},
+		{128*1024*uploadMaxParts + 1, 256 * 1024},
+		{512 * 1024 * uploadMaxParts, 512 * 1024},
+		{512*1024*uploadMaxParts + 1, 512 * 1024},
+	}
+	for _, c := range cases {
+		if partSize := UploadPartSize(c.size); partSize != c.partSize {
+			t.Errorf("size %d: expected part size %d, got %d", c.size, c.partSize, partSize)
+		}
+	}
+
+	u := newTestUploader(&testPartsServer{})
+	_, err := u.UploadFile(bytes.NewReader(nil), "big", uploadMaxFileSize+1, nil)
+	if !merry.Is(err, ErrFileTooLarge) {
+		t.Errorf("expected ErrFileTooLarge, got %v", err)
+	}
+}